@@ -76,6 +76,26 @@ func (r *gitCommitConnectionResolver) compute(ctx context.Context) ([]*gitdomain
 			return []*gitdomain.Commit{}, errors.Wrap(err, "failed to parse afterCursor")
 		}
 
+		opt := gitserver.CommitsOptions{
+			Range:        r.revisionRange,
+			N:            uint(n),
+			MessageQuery: pointers.DerefZero(r.query),
+			Author:       pointers.DerefZero(r.author),
+			Skip:         uint(afterCursor),
+			Path:         pointers.DerefZero(r.path),
+			Follow:       r.follow,
+		}
+		if after := pointers.DerefZero(r.after); after != "" {
+			if opt.After, opt.AfterRelative, err = gitserver.ParseCommitDate(after); err != nil {
+				return nil, errors.Wrap(err, "failed to parse after")
+			}
+		}
+		if before := pointers.DerefZero(r.before); before != "" {
+			if opt.Before, opt.BeforeRelative, err = gitserver.ParseCommitDate(before); err != nil {
+				return nil, errors.Wrap(err, "failed to parse before")
+			}
+		}
+
 		// Make sure the range revisions exist, in case the browser extension makes
 		// a request for a diff of a newly pushed PR.
 		_, err = r.gitserverClient.ResolveRevision(
@@ -88,17 +108,7 @@ func (r *gitCommitConnectionResolver) compute(ctx context.Context) ([]*gitdomain
 			return nil, errors.Wrap(err, "failed to resolve revision range")
 		}
 
-		return r.gitserverClient.Commits(ctx, r.repo.RepoName(), gitserver.CommitsOptions{
-			Range:        r.revisionRange,
-			N:            uint(n),
-			MessageQuery: pointers.DerefZero(r.query),
-			Author:       pointers.DerefZero(r.author),
-			After:        pointers.DerefZero(r.after),
-			Skip:         uint(afterCursor),
-			Before:       pointers.DerefZero(r.before),
-			Path:         pointers.DerefZero(r.path),
-			Follow:       r.follow,
-		})
+		return r.gitserverClient.Commits(ctx, r.repo.RepoName(), opt)
 	}
 
 	r.once.Do(func() { r.commits, r.err = do() })
@@ -50,7 +50,7 @@ func TestGitTree_History(t *testing.T) {
 	oid := api.CommitID("1110324b03e4dc5e98b2543498f44ca269d66d4c")
 
 	ctx := context.Background()
-	gs := gitserver.NewMockClientFrom(gitserver.NewTestClient(t))
+	gs := gitserver.NewMockClientFrom(gitserver.NewLocalTestClient(t))
 	gs.ResolveRevisionFunc.SetDefaultReturn(oid, nil)
 	db := dbmocks.NewMockDB()
 
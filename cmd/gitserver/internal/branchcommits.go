@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// WatchDefaultBranchCommits calls onCommits with the current head of the
+// default branch of the repo, and then with the commits that land on it
+// whenever it moves, until ctx is canceled or onCommits returns an error.
+// Nothing is sent while the default branch has no commits.
+func (s *Server) WatchDefaultBranchCommits(ctx context.Context, repo api.RepoName, onCommits func(gitdomain.BranchCommits) error) error {
+	dir := s.fs.RepoDir(repo)
+	var head api.CommitID
+	return s.WatchRefs(ctx, repo, func(gitdomain.RefChanges) error {
+		// The default branch itself can change without any ref moving, so it
+		// is resolved again on every update.
+		tip, err := defaultBranchHead(ctx, dir)
+		if err != nil {
+			return err
+		}
+		if tip == "" || tip == head {
+			return nil
+		}
+		if head == "" {
+			head = tip
+			return onCommits(gitdomain.BranchCommits{Head: tip})
+		}
+
+		res, err := branchCommitsBetween(ctx, dir, head, tip)
+		if err != nil {
+			return err
+		}
+		head = tip
+		return onCommits(res)
+	})
+}
+
+// defaultBranchHead returns the commit HEAD points at, or an empty commit ID
+// if HEAD points at an unborn branch.
+func defaultBranchHead(ctx context.Context, dir common.GitDir) (api.CommitID, error) {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--verify", "--quiet", "HEAD^{commit}")
+	dir.Set(cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		var e *exec.ExitError
+		if errors.As(err, &e) && e.ExitCode() == 1 {
+			return "", nil
+		}
+		return "", errors.Wrap(err, "git rev-parse")
+	}
+	return api.CommitID(bytes.TrimSpace(out)), nil
+}
+
+// branchCommitsBetween returns the commits reachable from head but not from
+// old, oldest first, and whether head descends from old.
+func branchCommitsBetween(ctx context.Context, dir common.GitDir, old, head api.CommitID) (gitdomain.BranchCommits, error) {
+	res := gitdomain.BranchCommits{Head: head}
+
+	cmd := exec.CommandContext(ctx, "git", "merge-base", "--is-ancestor", string(old), string(head))
+	dir.Set(cmd)
+	if err := cmd.Run(); err != nil {
+		var e *exec.ExitError
+		if !errors.As(err, &e) || e.ExitCode() != 1 {
+			return gitdomain.BranchCommits{}, errors.Wrap(err, "git merge-base")
+		}
+		res.Rewritten = true
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "log", "--reverse", "--topo-order", "--format=%H %ct", string(old)+".."+string(head))
+	dir.Set(cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return gitdomain.BranchCommits{}, errors.Wrap(err, "git log")
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		commit, ts, ok := strings.Cut(line, " ")
+		if !ok {
+			continue
+		}
+		sec, err := strconv.ParseInt(ts, 10, 64)
+		if err != nil {
+			return gitdomain.BranchCommits{}, errors.Wrapf(err, "parsing commit date %q", ts)
+		}
+		res.Commits = append(res.Commits, gitdomain.BranchCommit{
+			ID:          api.CommitID(commit),
+			CommittedAt: time.Unix(sec, 0).UTC(),
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+func TestServer_WatchDefaultBranchCommits(t *testing.T) {
+	old := refWatchPollInterval
+	refWatchPollInterval = time.Millisecond
+	t.Cleanup(func() { refWatchPollInterval = old })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reposDir := t.TempDir()
+	remoteDir := filepath.Join(reposDir, "remote")
+	require.NoError(t, os.Mkdir(remoteDir, os.ModePerm))
+	makeSingleCommitRepo(func(name string, arg ...string) string {
+		return runCmd(t, remoteDir, name, arg...)
+	})
+
+	s := makeTestServer(ctx, t, reposDir, remoteDir, nil)
+
+	repo := api.RepoName("example.com/foo/bar")
+	dir := s.fs.RepoDir(repo)
+	runCmd(t, reposDir, "git", "clone", "--bare", remoteDir, dir.Path())
+	rev := func(spec string) api.CommitID {
+		return api.CommitID(strings.TrimSpace(runCmd(t, dir.Path(), "git", "rev-parse", spec)))
+	}
+	initial := rev("HEAD")
+
+	updates := make(chan gitdomain.BranchCommits)
+	done := make(chan error)
+	go func() {
+		done <- s.WatchDefaultBranchCommits(ctx, repo, func(c gitdomain.BranchCommits) error {
+			updates <- c
+			return nil
+		})
+	}()
+
+	require.Equal(t, gitdomain.BranchCommits{Head: initial}, <-updates)
+
+	// Commits on other branches are not reported.
+	runCmd(t, remoteDir, "git", "checkout", "-q", "-b", "other")
+	runCmd(t, remoteDir, "git", "commit", "-q", "--allow-empty", "-m", "other")
+	runCmd(t, remoteDir, "git", "checkout", "-q", "-")
+	runCmd(t, remoteDir, "git", "commit", "-q", "--allow-empty", "-m", "second")
+	runCmd(t, remoteDir, "git", "commit", "-q", "--allow-empty", "-m", "third")
+	runCmd(t, dir.Path(), "git", "fetch", "-q", remoteDir, "+refs/heads/*:refs/heads/*")
+	require.NoError(t, setLastChanged(logtest.Scoped(t), dir))
+
+	second := <-updates
+	require.Equal(t, rev("HEAD"), second.Head)
+	require.False(t, second.Rewritten)
+	require.Len(t, second.Commits, 2)
+	require.Equal(t, rev("HEAD~1"), second.Commits[0].ID)
+	require.Equal(t, rev("HEAD"), second.Commits[1].ID)
+	require.False(t, second.Commits[0].CommittedAt.IsZero())
+
+	// A force push is reported as a rewrite.
+	runCmd(t, dir.Path(), "git", "update-ref", "HEAD", string(rev("other")))
+	require.NoError(t, setLastChanged(logtest.Scoped(t), dir))
+
+	third := <-updates
+	require.Equal(t, rev("other"), third.Head)
+	require.True(t, third.Rewritten)
+	require.Len(t, third.Commits, 1)
+	require.Equal(t, rev("other"), third.Commits[0].ID)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
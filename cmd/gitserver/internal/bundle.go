@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// invalidBundleError is returned by ApplyBundle when the bundle can't be
+// applied to the repo, because it is malformed or because the repo lacks the
+// commits the bundle was created against.
+type invalidBundleError struct {
+	reason string
+}
+
+func (e *invalidBundleError) Error() string {
+	return "invalid bundle: " + e.reason
+}
+
+// bundleRef is a ref listed in the header of a bundle.
+type bundleRef struct {
+	name string
+	oid  api.CommitID
+}
+
+// ApplyBundle imports the git bundle read from r into repo, as produced by
+// `git bundle create`. This allows to update repos without network access to
+// the code host.
+//
+// Only the branches and tags of the bundle are imported. Existing branches
+// are only fast-forwarded and existing tags are never moved; if the bundle
+// would rewrite the history of a ref, a RefUpdateConflictError is returned.
+// Either all refs are updated or none is.
+func (s *Server) ApplyBundle(ctx context.Context, repo api.RepoName, r io.Reader) error {
+	dir := s.fs.RepoDir(repo)
+
+	tmpDir, err := s.fs.TempDir("bundle-")
+	if err != nil {
+		return errors.Wrap(err, "create temp dir")
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// git bundle can't read bundles from stdin, so we have to spool the bundle
+	// to disk first.
+	bundlePath := filepath.Join(tmpDir, "repo.bundle")
+	if err := writeBundleFile(bundlePath, r); err != nil {
+		return err
+	}
+
+	run := func(stdin io.Reader, args ...string) ([]byte, error) {
+		cmd := exec.CommandContext(ctx, "git", args...)
+		dir.Set(cmd)
+		cmd.Stdin = stdin
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := s.recordingCommandFactory.WrapWithRepoName(ctx, s.logger, repo, cmd).Run(); err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			return stderr.Bytes(), errors.Wrapf(err, "git %s: %s", args[0], bytes.TrimSpace(stderr.Bytes()))
+		}
+		return stdout.Bytes(), nil
+	}
+
+	// Verify checks that the bundle is well-formed and that repo contains all
+	// the commits the bundle depends on.
+	if stderr, err := run(nil, "bundle", "verify", bundlePath); err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+		return &invalidBundleError{reason: string(bytes.TrimSpace(stderr))}
+	}
+
+	out, err := run(nil, "bundle", "list-heads", bundlePath)
+	if err != nil {
+		return err
+	}
+	refs, err := parseBundleHeads(out)
+	if err != nil {
+		return &invalidBundleError{reason: err.Error()}
+	}
+
+	// Unbundle stores the objects of the bundle in the repo without
+	// touching any refs.
+	if _, err := run(nil, "bundle", "unbundle", bundlePath); err != nil {
+		return err
+	}
+
+	var updates bytes.Buffer
+	for _, ref := range refs {
+		current, err := s.resolveRef(ctx, repo, dir, ref.name)
+		if err != nil {
+			return err
+		}
+		if current == ref.oid {
+			continue
+		}
+		if current != "" {
+			if strings.HasPrefix(ref.name, "refs/tags/") {
+				return &gitdomain.RefUpdateConflictError{Repo: repo, Ref: ref.name}
+			}
+			if _, err := run(nil, "merge-base", "--is-ancestor", string(current), string(ref.oid)); err != nil {
+				var e *exec.ExitError
+				if errors.As(err, &e) && e.ExitCode() == 1 {
+					return &gitdomain.RefUpdateConflictError{Repo: repo, Ref: ref.name}
+				}
+				return err
+			}
+		} else {
+			current = zeroOID
+		}
+		fmt.Fprintf(&updates, "update %s %s %s\n", ref.name, ref.oid, current)
+	}
+
+	if updates.Len() == 0 {
+		return nil
+	}
+
+	// All updates are applied in a single transaction, which fails if any of
+	// the refs was changed concurrently.
+	if stderr, err := run(&updates, "update-ref", "--stdin"); err != nil {
+		if bytes.Contains(stderr, []byte("cannot lock ref")) {
+			return &gitdomain.RefUpdateConflictError{Repo: repo, Ref: lockedRef(stderr)}
+		}
+		return err
+	}
+
+	if err := setLastChanged(s.logger, dir); err != nil {
+		s.logger.Warn("failed to update last changed time", log.String("repo", string(repo)), log.Error(err))
+	}
+
+	return nil
+}
+
+// zeroOID is passed as the old value to git update-ref to express that the
+// ref must not exist yet.
+const zeroOID = "0000000000000000000000000000000000000000"
+
+func writeBundleFile(path string, r io.Reader) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.Wrap(err, "create bundle file")
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return errors.Wrap(err, "write bundle file")
+	}
+	return errors.Wrap(f.Close(), "close bundle file")
+}
+
+// parseBundleHeads parses the output of `git bundle list-heads`. Only branches
+// and tags are returned.
+func parseBundleHeads(out []byte) ([]bundleRef, error) {
+	var refs []bundleRef
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		oid, name, ok := strings.Cut(sc.Text(), " ")
+		if !ok || !gitdomain.IsAbsoluteRevision(oid) {
+			return nil, errors.Newf("unexpected line in bundle header: %q", sc.Text())
+		}
+		if !strings.HasPrefix(name, "refs/heads/") && !strings.HasPrefix(name, "refs/tags/") {
+			continue
+		}
+		refs = append(refs, bundleRef{name: name, oid: api.CommitID(oid)})
+	}
+	return refs, sc.Err()
+}
+
+// lockedRef extracts the name of the ref from git's "cannot lock ref" error.
+func lockedRef(stderr []byte) string {
+	_, rest, ok := bytes.Cut(stderr, []byte("cannot lock ref '"))
+	if !ok {
+		return ""
+	}
+	name, _, _ := bytes.Cut(rest, []byte("'"))
+	return string(name)
+}
+
+// resolveRef returns the object ID the ref points at, or an empty string if it
+// doesn't exist.
+func (s *Server) resolveRef(ctx context.Context, repo api.RepoName, dir common.GitDir, ref string) (api.CommitID, error) {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--verify", "--quiet", ref)
+	dir.Set(cmd)
+	out, err := s.recordingCommandFactory.WrapWithRepoName(ctx, s.logger, repo, cmd).Output()
+	if err != nil {
+		var e *exec.ExitError
+		if errors.As(err, &e) && ctx.Err() == nil {
+			return "", nil
+		}
+		return "", err
+	}
+	return api.CommitID(bytes.TrimSpace(out)), nil
+}
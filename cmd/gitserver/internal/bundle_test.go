@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestServer_ApplyBundle(t *testing.T) {
+	ctx := context.Background()
+	reposDir := t.TempDir()
+	remoteDir := filepath.Join(reposDir, "remote")
+	require.NoError(t, os.Mkdir(remoteDir, os.ModePerm))
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remoteDir, name, arg...)
+	}
+	base := strings.TrimSpace(makeSingleCommitRepo(cmd))
+	cmd("git", "branch", "-M", "main")
+
+	s := makeTestServer(ctx, t, reposDir, remoteDir, nil)
+
+	repo := api.RepoName("example.com/foo/bar")
+	repoDir := s.fs.RepoDir(repo).Path()
+	runCmd(t, reposDir, "git", "clone", "--bare", remoteDir, repoDir)
+
+	// createBundle bundles the given refs of the remote and returns the bundle.
+	createBundle := func(t *testing.T, refs ...string) []byte {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "repo.bundle")
+		cmd("git", append([]string{"bundle", "create", path}, refs...)...)
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		return b
+	}
+	revParse := func(t *testing.T, rev string) string {
+		t.Helper()
+		return strings.TrimSpace(runCmd(t, repoDir, "git", "rev-parse", rev))
+	}
+
+	cmd("sh", "-c", "echo goodbye world > hello.txt")
+	head := strings.TrimSpace(addCommitToRepo(cmd))
+	cmd("git", "tag", "v1")
+	cmd("git", "branch", "feature")
+	bundle := createBundle(t, "main", "feature", "v1", "^"+base)
+
+	require.NoError(t, s.ApplyBundle(ctx, repo, bytes.NewReader(bundle)))
+	require.Equal(t, head, revParse(t, "refs/heads/main"))
+	require.Equal(t, head, revParse(t, "refs/heads/feature"))
+	require.Equal(t, head, revParse(t, "refs/tags/v1"))
+
+	t.Run("applying the bundle again is a noop", func(t *testing.T) {
+		require.NoError(t, s.ApplyBundle(ctx, repo, bytes.NewReader(bundle)))
+		require.Equal(t, head, revParse(t, "refs/heads/main"))
+	})
+
+	t.Run("history is not rewritten", func(t *testing.T) {
+		cmd("git", "checkout", "-q", "-b", "rewritten", base)
+		cmd("sh", "-c", "echo rewritten > hello.txt")
+		rewritten := strings.TrimSpace(addCommitToRepo(cmd))
+		cmd("git", "branch", "-f", "feature", rewritten)
+		cmd("git", "branch", "new")
+
+		err := s.ApplyBundle(ctx, repo, bytes.NewReader(createBundle(t, "feature", "new", "^"+base)))
+		var e *gitdomain.RefUpdateConflictError
+		require.True(t, errors.As(err, &e), "unexpected error %v", err)
+		require.Equal(t, "refs/heads/feature", e.Ref)
+
+		// No ref was updated.
+		require.Equal(t, head, revParse(t, "refs/heads/feature"))
+		runCmd(t, repoDir, "sh", "-c", "! git rev-parse --verify --quiet refs/heads/new")
+
+		cmd("git", "tag", "-f", "v1", rewritten)
+		err = s.ApplyBundle(ctx, repo, bytes.NewReader(createBundle(t, "v1", "^"+base)))
+		require.True(t, errors.As(err, &e), "unexpected error %v", err)
+		require.Equal(t, "refs/tags/v1", e.Ref)
+		require.Equal(t, head, revParse(t, "refs/tags/v1"))
+	})
+
+	t.Run("missing prerequisites", func(t *testing.T) {
+		cmd("git", "checkout", "-q", "-b", "unknown", base)
+		cmd("sh", "-c", "echo unknown > hello.txt")
+		unknown := strings.TrimSpace(addCommitToRepo(cmd))
+		cmd("sh", "-c", "echo unknown again > hello.txt")
+		addCommitToRepo(cmd)
+
+		err := s.ApplyBundle(ctx, repo, bytes.NewReader(createBundle(t, "unknown", "^"+unknown)))
+		var e *invalidBundleError
+		require.True(t, errors.As(err, &e), "unexpected error %v", err)
+		require.Contains(t, e.Error(), "prerequisite")
+	})
+
+	t.Run("malformed bundle", func(t *testing.T) {
+		err := s.ApplyBundle(ctx, repo, strings.NewReader("not a bundle"))
+		var e *invalidBundleError
+		require.True(t, errors.As(err, &e), "unexpected error %v", err)
+	})
+}
+
+func TestParseBundleHeads(t *testing.T) {
+	refs, err := parseBundleHeads([]byte(
+		"0000000000000000000000000000000000000001 refs/heads/main\n" +
+			"0000000000000000000000000000000000000002 refs/tags/v1\n" +
+			"0000000000000000000000000000000000000003 refs/pull/1/head\n" +
+			"0000000000000000000000000000000000000001 HEAD\n",
+	))
+	require.NoError(t, err)
+	require.Equal(t, []bundleRef{
+		{name: "refs/heads/main", oid: "0000000000000000000000000000000000000001"},
+		{name: "refs/tags/v1", oid: "0000000000000000000000000000000000000002"},
+	}, refs)
+
+	_, err = parseBundleHeads([]byte("garbage\n"))
+	require.Error(t, err)
+}
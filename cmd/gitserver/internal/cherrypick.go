@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+// CherryPickOpts configures the commit created by CherryPick.
+type CherryPickOpts struct {
+	// Onto is the commit to create the new commit on top of.
+	Onto api.CommitID
+	// Mainline is the parent of a merge commit that the changes are taken
+	// relative to, starting at 1. It must be 0 for other commits.
+	Mainline int
+	// Message is the message of the new commit. If empty, the message of the
+	// cherry-picked commit is used.
+	Message string
+	// RecordOrigin appends a "(cherry picked from commit ...)" line to the
+	// default message, like git cherry-pick -x.
+	RecordOrigin bool
+	Committer    gitdomain.Signature
+}
+
+// CherryPick creates a commit on top of opts.Onto that applies the changes of
+// commit, and returns its ID. The new commit keeps the author of commit. It is
+// created in a temporary worktree and kept under a ref below tempRefPrefix.
+//
+// If the changes can't be applied without conflicts, a MergeConflictError is
+// returned. If they are already contained in opts.Onto, errNoChanges is
+// returned.
+func (s *Server) CherryPick(ctx context.Context, repo api.RepoName, commit api.CommitID, opts CherryPickOpts) (api.CommitID, error) {
+	ctx, cancel := context.WithTimeout(ctx, worktreeExecTimeout)
+	defer cancel()
+
+	for _, c := range []api.CommitID{commit, opts.Onto} {
+		if err := s.checkCommitExists(ctx, repo, c); err != nil {
+			return "", err
+		}
+	}
+
+	w, cleanup, err := s.newTempWorktree(ctx, repo, opts.Onto)
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+
+	args := []string{"cherry-pick", "--no-commit"}
+	if opts.Mainline > 0 {
+		args = append(args, "-m", strconv.Itoa(opts.Mainline))
+	}
+	if opts.RecordOrigin {
+		args = append(args, "-x")
+	}
+	if err := w.apply(ctx, commit, append(args, string(commit))...); err != nil {
+		return "", err
+	}
+
+	message := opts.Message
+	if message == "" {
+		// git cherry-pick --no-commit prepares the message it would use.
+		if message, err = w.preparedMessage(); err != nil {
+			return "", err
+		}
+	}
+
+	author, err := w.author(ctx, commit)
+	if err != nil {
+		return "", err
+	}
+
+	if err := w.commit(ctx, message, author, opts.Committer); err != nil {
+		return "", err
+	}
+	return w.publish(ctx)
+}
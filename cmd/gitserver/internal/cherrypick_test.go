@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestServer_CherryPick(t *testing.T) {
+	ctx := context.Background()
+	reposDir := t.TempDir()
+	remoteDir := filepath.Join(reposDir, "remote")
+	require.NoError(t, os.Mkdir(remoteDir, os.ModePerm))
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remoteDir, name, arg...)
+	}
+	commit := func(msg, file, content string) api.CommitID {
+		t.Helper()
+		cmd("sh", "-c", "echo "+content+" > "+file)
+		cmd("git", "add", file)
+		cmd("git", "commit", "--date=2020-01-02T03:04:05+01:00", "-m", msg)
+		return api.CommitID(strings.TrimSpace(cmd("git", "rev-parse", "HEAD")))
+	}
+
+	cmd("git", "init", ".")
+	base := commit("add a", "a.txt", "a")
+	changeA := commit("change a", "a.txt", "a2")
+	addB := commit("add b\n\nSigned-off-by: Jane Doe <jane@example.com>", "b.txt", "b")
+	cmd("git", "checkout", "-b", "release", string(base))
+	release := commit("change a differently", "a.txt", "a3")
+
+	s := makeTestServer(ctx, t, reposDir, remoteDir, nil)
+
+	repo := api.RepoName("example.com/foo/bar")
+	repoDir := s.fs.RepoDir(repo).Path()
+	runCmd(t, reposDir, "git", "clone", "--bare", remoteDir, repoDir)
+	git := func(args ...string) string {
+		t.Helper()
+		return strings.TrimSpace(runCmd(t, repoDir, "git", args...))
+	}
+
+	bot := gitdomain.Signature{Name: "Bot", Email: "bot@example.com", Date: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+
+	t.Run("default message", func(t *testing.T) {
+		picked, err := s.CherryPick(ctx, repo, addB, CherryPickOpts{Onto: release, RecordOrigin: true, Committer: bot})
+		require.NoError(t, err)
+
+		require.Equal(t, string(picked), git("rev-parse", "refs/sourcegraph/tmp/"+string(picked)))
+		require.Equal(t, string(release), git("rev-parse", string(picked)+"^"))
+		require.Equal(t, "b", git("show", string(picked)+":b.txt"))
+		require.Equal(t, "a3", git("show", string(picked)+":a.txt"))
+		require.Equal(t,
+			"add b\n\nSigned-off-by: Jane Doe <jane@example.com>\n(cherry picked from commit "+string(addB)+")",
+			git("log", "-1", "--format=%B", string(picked)),
+		)
+		// The author of the original commit is kept.
+		require.Equal(t, "a <a@a.com> 2020-01-02T03:04:05+01:00", git("log", "-1", "--format=%an <%ae> %aI", string(picked)))
+		require.Equal(t, "Bot <bot@example.com> 2024-01-02T03:04:05+00:00", git("log", "-1", "--format=%cn <%ce> %cI", string(picked)))
+	})
+
+	t.Run("custom message", func(t *testing.T) {
+		picked, err := s.CherryPick(ctx, repo, addB, CherryPickOpts{Onto: release, Message: "Backport b", RecordOrigin: true, Committer: bot})
+		require.NoError(t, err)
+		require.Equal(t, "Backport b", git("log", "-1", "--format=%B", string(picked)))
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		_, err := s.CherryPick(ctx, repo, changeA, CherryPickOpts{Onto: release, Committer: bot})
+		var e *gitdomain.MergeConflictError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, changeA, e.Commit)
+		require.Equal(t, []string{"a.txt"}, e.Paths)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		_, err := s.CherryPick(ctx, repo, changeA, CherryPickOpts{Onto: addB, Committer: bot})
+		require.ErrorIs(t, err, errNoChanges)
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := s.CherryPick(ctx, repo, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", CherryPickOpts{Onto: release, Committer: bot})
+		var e *gitdomain.RevisionNotFoundError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", e.Spec)
+	})
+}
@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// FetchCommitFromRepo makes commit, which exists in the source repo, available
+// in the object database of repo. It returns whether objects were fetched,
+// which isn't the case if repo already has the commit.
+//
+// Repos of a fork network share most of their history, so only the objects
+// that repo is missing are transferred. The source repo is read directly from
+// disk if it is cloned on this gitserver. Otherwise, it is fetched from the
+// gitserver that hosts it. No ref is created for the fetched commit, so its
+// objects are eventually removed by a gc.
+func (s *Server) FetchCommitFromRepo(ctx context.Context, repo, source api.RepoName, commit api.CommitID) (bool, error) {
+	dir := s.fs.RepoDir(repo)
+	if ok, err := s.hasCommit(ctx, repo, dir, commit); err != nil || ok {
+		return false, err
+	}
+
+	cloned, err := s.fs.RepoCloned(source)
+	if err != nil {
+		return false, errors.Wrap(err, "determine if source repo is cloned")
+	}
+	var remote string
+	if cloned {
+		sourceDir := s.fs.RepoDir(source)
+		ok, err := s.hasCommit(ctx, source, sourceDir, commit)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, &gitdomain.RevisionNotFoundError{Repo: source, Spec: string(commit)}
+		}
+		remote = sourceDir.Path()
+	} else {
+		addr := s.gitserverAddrForRepo(ctx, source)
+		if hostnameMatch(s.hostname, addr) {
+			// The source repo belongs on this gitserver, but isn't cloned
+			// yet.
+			return false, &gitdomain.RepoNotExistError{Repo: source}
+		}
+		// Other gitservers serve their repos over the git smart HTTP
+		// protocol, see NewHTTPHandler.
+		remote = "http://" + addr + "/git/" + string(source)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "fetch", "--quiet", "--no-tags", "--no-write-fetch-head", remote, string(commit))
+	dir.Set(cmd)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := s.recordingCommandFactory.WrapWithRepoName(ctx, s.logger, repo, cmd).Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return false, ctxErr
+		}
+		if bytes.Contains(stderr.Bytes(), []byte("not our ref")) {
+			return false, &gitdomain.RevisionNotFoundError{Repo: source, Spec: string(commit)}
+		}
+		return false, errors.Wrapf(err, "failed to fetch %s from %s: %s", commit, source, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return true, nil
+}
+
+// hasCommit returns whether the repo in dir contains the given commit.
+func (s *Server) hasCommit(ctx context.Context, repo api.RepoName, dir common.GitDir, commit api.CommitID) (bool, error) {
+	cmd := exec.CommandContext(ctx, "git", "cat-file", "-e", string(commit)+"^{commit}")
+	dir.Set(cmd)
+	err := s.recordingCommandFactory.WrapWithRepoName(ctx, s.logger, repo, cmd).Run()
+	if err != nil {
+		var e *exec.ExitError
+		if errors.As(err, &e) && ctx.Err() == nil {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestServer_FetchCommitFromRepo(t *testing.T) {
+	ctx := context.Background()
+	reposDir := t.TempDir()
+	remoteDir := filepath.Join(reposDir, "remote")
+	require.NoError(t, os.Mkdir(remoteDir, os.ModePerm))
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remoteDir, name, arg...)
+	}
+	makeSingleCommitRepo(cmd)
+
+	s := makeTestServer(ctx, t, reposDir, remoteDir, nil)
+
+	// The fork is cloned before the upstream gets a new commit.
+	fork := api.RepoName("example.com/fork/bar")
+	runCmd(t, reposDir, "git", "clone", "--bare", remoteDir, s.fs.RepoDir(fork).Path())
+
+	cmd("sh", "-c", "echo goodbye world > hello.txt")
+	head := api.CommitID(strings.TrimSpace(addCommitToRepo(cmd)))
+	upstream := api.RepoName("example.com/foo/bar")
+	runCmd(t, reposDir, "git", "clone", "--bare", remoteDir, s.fs.RepoDir(upstream).Path())
+
+	fetched, err := s.FetchCommitFromRepo(ctx, fork, upstream, head)
+	require.NoError(t, err)
+	require.True(t, fetched)
+	runCmd(t, s.fs.RepoDir(fork).Path(), "git", "cat-file", "-e", string(head))
+
+	// The fork has the commit now.
+	fetched, err = s.FetchCommitFromRepo(ctx, fork, upstream, head)
+	require.NoError(t, err)
+	require.False(t, fetched)
+
+	t.Run("unknown commit", func(t *testing.T) {
+		_, err := s.FetchCommitFromRepo(ctx, fork, upstream, "1111111111111111111111111111111111111111")
+		var e *gitdomain.RevisionNotFoundError
+		require.True(t, errors.As(err, &e), "unexpected error %v", err)
+		require.Equal(t, upstream, e.Repo)
+	})
+}
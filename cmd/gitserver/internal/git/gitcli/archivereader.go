@@ -4,8 +4,11 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
 	"github.com/sourcegraph/sourcegraph/internal/byteutils"
@@ -14,29 +17,101 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-func (g *gitCLIBackend) ArchiveReader(ctx context.Context, format git.ArchiveFormat, treeish string, paths []string) (io.ReadCloser, error) {
+func (g *gitCLIBackend) ArchiveReader(ctx context.Context, format git.ArchiveFormat, treeish string, paths []string, filter string) (io.ReadCloser, error) {
 	if err := g.verifyPaths(ctx, treeish, paths); err != nil {
 		return nil, err
 	}
 
-	archiveArgs := buildArchiveArgs(format, treeish, paths)
+	var omitted []gitdomain.OmittedBlob
+	if filter != "" {
+		limit, err := gitdomain.ParseBlobFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+		omitted, err = g.omittedBlobs(ctx, treeish, paths, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	archiveArgs, err := buildArchiveArgs(format, treeish, paths, omitted)
+	if err != nil {
+		return nil, err
+	}
 
 	return g.NewCommand(ctx, WithArguments(archiveArgs...))
 }
 
-func buildArchiveArgs(format git.ArchiveFormat, treeish string, paths []string) []string {
+// buildArchiveArgs returns the arguments for git archive. If omitted is not
+// nil, the omitted blobs are excluded from the archive and listed in the
+// manifest at gitdomain.OmittedBlobsManifestPath.
+func buildArchiveArgs(format git.ArchiveFormat, treeish string, paths []string, omitted []gitdomain.OmittedBlob) ([]string, error) {
 	args := []string{"archive", "--worktree-attributes", "--format=" + string(format)}
 
 	if format == git.ArchiveFormatZip {
 		args = append(args, "-0")
 	}
 
+	if omitted != nil {
+		manifest, err := json.Marshal(omitted)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, "--add-virtual-file="+gitdomain.OmittedBlobsManifestPath+":"+string(manifest))
+	}
+
 	args = append(args, treeish, "--")
 	for _, p := range paths {
 		args = append(args, pathspecLiteral(p))
 	}
+	for _, b := range omitted {
+		args = append(args, ":(exclude,literal)"+b.Path)
+	}
+
+	return args, nil
+}
+
+// omittedBlobs lists the blobs in paths of treeish that are at least limit
+// bytes large. The result is never nil, so that an archive for a filter that
+// omits nothing still has a manifest.
+func (g *gitCLIBackend) omittedBlobs(ctx context.Context, treeish string, paths []string, limit int64) ([]gitdomain.OmittedBlob, error) {
+	args := []string{"ls-tree", "-r", "-z", "--long", treeish, "--"}
+	for _, p := range paths {
+		args = append(args, pathspecLiteral(p))
+	}
+	r, err := g.NewCommand(ctx, WithArguments(args...))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
 
-	return args
+	omitted := []gitdomain.OmittedBlob{}
+	scanner := bufio.NewScanner(r)
+	scanner.Split(byteutils.ScanNullLines)
+	for scanner.Scan() {
+		// Every entry is "<mode> <type> <oid> <size>\t<path>", with the size
+		// padded with spaces.
+		info, path, ok := strings.Cut(scanner.Text(), "\t")
+		fields := strings.Fields(info)
+		if !ok || len(fields) != 4 {
+			return nil, errors.Errorf("unexpected output from git ls-tree %q", scanner.Text())
+		}
+		// Submodules are commits and have no size.
+		if fields[1] != "blob" {
+			continue
+		}
+		size, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing blob size")
+		}
+		if size >= limit {
+			omitted = append(omitted, gitdomain.OmittedBlob{Path: path, OID: fields[2], Size: size})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return omitted, nil
 }
 
 // pathspecLiteral constructs a pathspec that matches a path without interpreting "*" or "?" as special
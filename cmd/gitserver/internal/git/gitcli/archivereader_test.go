@@ -5,6 +5,7 @@ import (
 	"archive/zip"
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
 	"os"
 	"testing"
@@ -48,19 +49,38 @@ func readFileContentsFromZip(t *testing.T, zr *zip.Reader, name string) string {
 
 func TestBuildArchiveArgs(t *testing.T) {
 	t.Run("no paths", func(t *testing.T) {
-		args := buildArchiveArgs(git.ArchiveFormatTar, "HEAD", nil)
+		args, err := buildArchiveArgs(git.ArchiveFormatTar, "HEAD", nil, nil)
+		require.NoError(t, err)
 		require.Equal(t, []string{"archive", "--worktree-attributes", "--format=tar", "HEAD", "--"}, args)
 	})
 
 	t.Run("with paths", func(t *testing.T) {
-		args := buildArchiveArgs(git.ArchiveFormatTar, "HEAD", []string{"file1", "file2"})
+		args, err := buildArchiveArgs(git.ArchiveFormatTar, "HEAD", []string{"file1", "file2"}, nil)
+		require.NoError(t, err)
 		require.Equal(t, []string{"archive", "--worktree-attributes", "--format=tar", "HEAD", "--", ":(literal)file1", ":(literal)file2"}, args)
 	})
 
 	t.Run("zip adds -0", func(t *testing.T) {
-		args := buildArchiveArgs(git.ArchiveFormatZip, "HEAD", nil)
+		args, err := buildArchiveArgs(git.ArchiveFormatZip, "HEAD", nil, nil)
+		require.NoError(t, err)
 		require.Equal(t, []string{"archive", "--worktree-attributes", "--format=zip", "-0", "HEAD", "--"}, args)
 	})
+
+	t.Run("omitted blobs", func(t *testing.T) {
+		args, err := buildArchiveArgs(git.ArchiveFormatTar, "HEAD", []string{"dir"}, []gitdomain.OmittedBlob{{Path: "dir/big", OID: "deadbeef", Size: 42}})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"archive", "--worktree-attributes", "--format=tar",
+			`--add-virtual-file=.sourcegraph-omitted-blobs.json:[{"path":"dir/big","oid":"deadbeef","size":42}]`,
+			"HEAD", "--", ":(literal)dir", ":(exclude,literal)dir/big",
+		}, args)
+	})
+
+	t.Run("empty manifest", func(t *testing.T) {
+		args, err := buildArchiveArgs(git.ArchiveFormatTar, "HEAD", nil, []gitdomain.OmittedBlob{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"archive", "--worktree-attributes", "--format=tar", "--add-virtual-file=.sourcegraph-omitted-blobs.json:[]", "HEAD", "--"}, args)
+	})
 }
 
 func TestGitCLIBackend_ArchiveReader(t *testing.T) {
@@ -84,7 +104,7 @@ func TestGitCLIBackend_ArchiveReader(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("read simple tar archive", func(t *testing.T) {
-		r, err := backend.ArchiveReader(ctx, "tar", string(commitID), nil)
+		r, err := backend.ArchiveReader(ctx, "tar", string(commitID), nil, "")
 		require.NoError(t, err)
 		t.Cleanup(func() { r.Close() })
 		tr := tar.NewReader(r)
@@ -93,7 +113,7 @@ func TestGitCLIBackend_ArchiveReader(t *testing.T) {
 	})
 
 	t.Run("read simple zip archive", func(t *testing.T) {
-		r, err := backend.ArchiveReader(ctx, "zip", string(commitID), nil)
+		r, err := backend.ArchiveReader(ctx, "zip", string(commitID), nil, "")
 		require.NoError(t, err)
 		t.Cleanup(func() { r.Close() })
 		contents, err := io.ReadAll(r)
@@ -105,13 +125,13 @@ func TestGitCLIBackend_ArchiveReader(t *testing.T) {
 	})
 
 	t.Run("read multiple files from tar archive using paths", func(t *testing.T) {
-		r, err := backend.ArchiveReader(ctx, "tar", string(commitID), []string{"file1", "dir1/file2"})
+		r, err := backend.ArchiveReader(ctx, "tar", string(commitID), []string{"file1", "dir1/file2"}, "")
 		require.NoError(t, err)
 		t.Cleanup(func() { r.Close() })
 		tr := tar.NewReader(r)
 		contents := readFileContentsFromTar(t, tr, "dir1/file2")
 		require.Equal(t, "efgh\n", contents)
-		r, err = backend.ArchiveReader(ctx, "tar", string(commitID), []string{"file1", "dir1/file2"})
+		r, err = backend.ArchiveReader(ctx, "tar", string(commitID), []string{"file1", "dir1/file2"}, "")
 		require.NoError(t, err)
 		t.Cleanup(func() { r.Close() })
 		tr = tar.NewReader(r)
@@ -120,7 +140,7 @@ func TestGitCLIBackend_ArchiveReader(t *testing.T) {
 	})
 
 	t.Run("read file in directory", func(t *testing.T) {
-		r, err := backend.ArchiveReader(ctx, "tar", string(commitID), nil)
+		r, err := backend.ArchiveReader(ctx, "tar", string(commitID), nil, "")
 		require.NoError(t, err)
 		t.Cleanup(func() { r.Close() })
 		tr := tar.NewReader(r)
@@ -129,14 +149,14 @@ func TestGitCLIBackend_ArchiveReader(t *testing.T) {
 	})
 
 	t.Run("read file with space in name", func(t *testing.T) {
-		r, err := backend.ArchiveReader(ctx, "tar", string(commitID), []string{" file3", "dir1/file with spaces"})
+		r, err := backend.ArchiveReader(ctx, "tar", string(commitID), []string{" file3", "dir1/file with spaces"}, "")
 		require.NoError(t, err)
 		t.Cleanup(func() { r.Close() })
 		tr := tar.NewReader(r)
 		contents := readFileContentsFromTar(t, tr, " file3")
 		require.Equal(t, "ijkl\n", contents)
 
-		r, err = backend.ArchiveReader(ctx, "tar", string(commitID), []string{" file3", "dir1/file with spaces"})
+		r, err = backend.ArchiveReader(ctx, "tar", string(commitID), []string{" file3", "dir1/file with spaces"}, "")
 		require.NoError(t, err)
 		t.Cleanup(func() { r.Close() })
 		tr = tar.NewReader(r)
@@ -145,7 +165,7 @@ func TestGitCLIBackend_ArchiveReader(t *testing.T) {
 	})
 
 	t.Run("read non-ascii filename", func(t *testing.T) {
-		r, err := backend.ArchiveReader(ctx, "tar", string(commitID), []string{" file3", "我的工作"})
+		r, err := backend.ArchiveReader(ctx, "tar", string(commitID), []string{" file3", "我的工作"}, "")
 		require.NoError(t, err)
 		t.Cleanup(func() { r.Close() })
 		tr := tar.NewReader(r)
@@ -154,25 +174,25 @@ func TestGitCLIBackend_ArchiveReader(t *testing.T) {
 	})
 
 	t.Run("non existent commit", func(t *testing.T) {
-		_, err := backend.ArchiveReader(ctx, "tar", "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", nil)
+		_, err := backend.ArchiveReader(ctx, "tar", "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", nil, "")
 		require.Error(t, err)
 		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
 	})
 
 	t.Run("non existent ref", func(t *testing.T) {
-		_, err := backend.ArchiveReader(ctx, "tar", "head-2", nil)
+		_, err := backend.ArchiveReader(ctx, "tar", "head-2", nil, "")
 		require.Error(t, err)
 		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
 	})
 
 	t.Run("non existent file", func(t *testing.T) {
-		_, err := backend.ArchiveReader(ctx, "tar", string(commitID), []string{"no-file"})
+		_, err := backend.ArchiveReader(ctx, "tar", string(commitID), []string{"no-file"}, "")
 		require.Error(t, err)
 		require.True(t, os.IsNotExist(err))
 	})
 
 	t.Run("invalid path pattern", func(t *testing.T) {
-		_, err := backend.ArchiveReader(ctx, "tar", string(commitID), []string{"dir1/*"})
+		_, err := backend.ArchiveReader(ctx, "tar", string(commitID), []string{"dir1/*"}, "")
 		require.Error(t, err)
 		require.True(t, os.IsNotExist(err))
 	})
@@ -182,7 +202,7 @@ func TestGitCLIBackend_ArchiveReader(t *testing.T) {
 		ctx, cancel := context.WithCancel(ctx)
 		t.Cleanup(cancel)
 
-		r, err := backend.ArchiveReader(ctx, git.ArchiveFormatTar, string(commitID), nil)
+		r, err := backend.ArchiveReader(ctx, git.ArchiveFormatTar, string(commitID), nil, "")
 		require.NoError(t, err)
 
 		cancel()
@@ -195,3 +215,91 @@ func TestGitCLIBackend_ArchiveReader(t *testing.T) {
 		require.NoError(t, r.Close())
 	})
 }
+
+func TestGitCLIBackend_ArchiveReader_BlobFilter(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo small > small",
+		"mkdir dir",
+		"head -c 2048 /dev/zero > dir/big",
+		"echo small > dir/small",
+		"git add small dir",
+		"git commit -m commit --author='Foo Author <foo@sourcegraph.com>'",
+	)
+
+	commitID, err := backend.RevParseHead(ctx)
+	require.NoError(t, err)
+	bigOID, err := backend.BlobOID(ctx, commitID, "dir/big")
+	require.NoError(t, err)
+
+	// readArchive returns the contents of all files in the archive.
+	readArchive := func(t *testing.T, paths []string, filter string) map[string]string {
+		t.Helper()
+		r, err := backend.ArchiveReader(ctx, git.ArchiveFormatTar, string(commitID), paths, filter)
+		require.NoError(t, err)
+		t.Cleanup(func() { r.Close() })
+
+		files := map[string]string{}
+		tr := tar.NewReader(r)
+		for {
+			h, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			if h.Typeflag != tar.TypeReg {
+				continue
+			}
+			contents, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			files[h.Name] = string(contents)
+		}
+		return files
+	}
+	manifest := func(t *testing.T, files map[string]string) []gitdomain.OmittedBlob {
+		t.Helper()
+		var omitted []gitdomain.OmittedBlob
+		require.NoError(t, json.Unmarshal([]byte(files[gitdomain.OmittedBlobsManifestPath]), &omitted))
+		return omitted
+	}
+
+	t.Run("no filter", func(t *testing.T) {
+		files := readArchive(t, nil, "")
+		require.Len(t, files["dir/big"], 2048)
+		require.NotContains(t, files, gitdomain.OmittedBlobsManifestPath)
+	})
+
+	t.Run("size limit", func(t *testing.T) {
+		files := readArchive(t, nil, "blob:limit=1k")
+		require.NotContains(t, files, "dir/big")
+		require.Equal(t, "small\n", files["small"])
+		require.Equal(t, "small\n", files["dir/small"])
+		require.Equal(t, []gitdomain.OmittedBlob{{Path: "dir/big", OID: string(bigOID), Size: 2048}}, manifest(t, files))
+	})
+
+	t.Run("size limit with paths", func(t *testing.T) {
+		files := readArchive(t, []string{"dir"}, "blob:limit=1k")
+		require.NotContains(t, files, "small")
+		require.NotContains(t, files, "dir/big")
+		require.Equal(t, "small\n", files["dir/small"])
+		require.Len(t, manifest(t, files), 1)
+	})
+
+	t.Run("nothing omitted", func(t *testing.T) {
+		files := readArchive(t, nil, "blob:limit=1m")
+		require.Len(t, files["dir/big"], 2048)
+		require.Empty(t, manifest(t, files))
+	})
+
+	t.Run("blob:none", func(t *testing.T) {
+		files := readArchive(t, nil, "blob:none")
+		require.Len(t, files, 1)
+		require.Len(t, manifest(t, files), 3)
+	})
+
+	t.Run("unsupported filter", func(t *testing.T) {
+		_, err := backend.ArchiveReader(ctx, git.ArchiveFormatTar, string(commitID), nil, "tree:0")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,53 @@
+package gitcli
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) BehindAhead(ctx context.Context, left, right string) (*gitdomain.BehindAhead, error) {
+	if err := checkSpecArgSafety(left); err != nil {
+		return nil, err
+	}
+	if err := checkSpecArgSafety(right); err != nil {
+		return nil, err
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments("rev-list", "--count", "--left-right", left+"..."+right, "--"))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	stdout, err := io.ReadAll(r)
+	if err != nil {
+		var e *CommandFailedError
+		if errors.As(err, &e) && e.ExitStatus == 128 && (bytes.Contains(e.Stderr, []byte("bad revision")) ||
+			bytes.Contains(e.Stderr, []byte("unknown revision"))) {
+			return nil, &gitdomain.RevisionNotFoundError{Repo: g.repoName, Spec: left + "..." + right}
+		}
+		return nil, err
+	}
+
+	// The output is the number of commits only reachable from left and from
+	// right, separated by a tab.
+	behind, ahead, ok := bytes.Cut(bytes.TrimSpace(stdout), []byte("\t"))
+	if !ok {
+		return nil, errors.Errorf("unexpected output from git rev-list --left-right %q", string(stdout))
+	}
+	b, err := strconv.ParseUint(string(behind), 10, 32)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing behind count")
+	}
+	a, err := strconv.ParseUint(string(ahead), 10, 32)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing ahead count")
+	}
+
+	return &gitdomain.BehindAhead{Behind: uint32(b), Ahead: uint32(a)}, nil
+}
@@ -0,0 +1,57 @@
+package gitcli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_BehindAhead(t *testing.T) {
+	ctx := context.Background()
+
+	// master: a - b - c
+	//              \
+	// feature:      d
+	backend := BackendWithRepoCommands(t,
+		"git commit --allow-empty -m a --author='Foo Author <foo@sourcegraph.com>'",
+		"git commit --allow-empty -m b --author='Foo Author <foo@sourcegraph.com>'",
+		"git branch feature",
+		"git commit --allow-empty -m c --author='Foo Author <foo@sourcegraph.com>'",
+		"git checkout -q feature",
+		"git commit --allow-empty -m d --author='Foo Author <foo@sourcegraph.com>'",
+		"git checkout -q master",
+	)
+
+	t.Run("diverged", func(t *testing.T) {
+		ba, err := backend.BehindAhead(ctx, "master", "feature")
+		require.NoError(t, err)
+		require.Equal(t, &gitdomain.BehindAhead{Behind: 1, Ahead: 1}, ba)
+	})
+
+	t.Run("ancestor", func(t *testing.T) {
+		ba, err := backend.BehindAhead(ctx, "master", "master~2")
+		require.NoError(t, err)
+		require.Equal(t, &gitdomain.BehindAhead{Behind: 2, Ahead: 0}, ba)
+	})
+
+	t.Run("same commit", func(t *testing.T) {
+		ba, err := backend.BehindAhead(ctx, "master", "HEAD")
+		require.NoError(t, err)
+		require.Equal(t, &gitdomain.BehindAhead{}, ba)
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		_, err := backend.BehindAhead(ctx, "master", "missing")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+
+	t.Run("invalid revspec", func(t *testing.T) {
+		_, err := backend.BehindAhead(ctx, "--all", "master")
+		require.Error(t, err)
+	})
+}
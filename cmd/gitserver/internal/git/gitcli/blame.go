@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"bytes"
 	"context"
-	"fmt"
 	"io"
 	"path/filepath"
 	"strconv"
@@ -39,8 +38,28 @@ func buildBlameArgs(startCommit api.CommitID, path string, opt git.BlameOptions)
 	if opt.IgnoreWhitespace {
 		args = append(args, "-w")
 	}
-	if opt.Range != nil {
-		args = append(args, fmt.Sprintf("-L%d,%d", opt.Range.StartLine, opt.Range.EndLine))
+	switch opt.CopyDetection {
+	case git.BlameCopyDetectionMoves:
+		args = append(args, "-M")
+	case git.BlameCopyDetectionCopies:
+		args = append(args, "-C")
+	case git.BlameCopyDetectionCopiesFromCreation:
+		args = append(args, "-C", "-C")
+	case git.BlameCopyDetectionCopiesFromAnyCommit:
+		args = append(args, "-C", "-C", "-C")
+	}
+	if r := opt.Range; r != nil && (r.StartLine > 0 || r.EndLine > 0) {
+		// git blame rejects line 0, so either end of the range can be left
+		// open by omitting it: -L5, blames from line 5 to the end of the file,
+		// -L,10 from the beginning of the file to line 10.
+		var start, end string
+		if r.StartLine > 0 {
+			start = strconv.Itoa(r.StartLine)
+		}
+		if r.EndLine > 0 {
+			end = strconv.Itoa(r.EndLine)
+		}
+		args = append(args, "-L"+start+","+end)
 	}
 	args = append(args, string(startCommit), "--", filepath.ToSlash(path))
 	return args
@@ -105,6 +105,39 @@ func TestGitCLIBackend_Blame(t *testing.T) {
 		require.NoError(t, hr.Close())
 	})
 
+	t.Run("line range", func(t *testing.T) {
+		readAll := func(t *testing.T, r git.BlameRange) (hunks []*gitdomain.Hunk) {
+			hr, err := backend.Blame(ctx, commit, "foo.txt", git.BlameOptions{Range: &r})
+			require.NoError(t, err)
+			t.Cleanup(func() { hr.Close() })
+			for {
+				h, err := hr.Read()
+				if err == io.EOF {
+					return hunks
+				}
+				require.NoError(t, err)
+				hunks = append(hunks, h)
+			}
+		}
+
+		hunks := readAll(t, git.BlameRange{StartLine: 2, EndLine: 3})
+		require.Len(t, hunks, 1)
+		require.Equal(t, uint32(2), hunks[0].StartLine)
+		require.Equal(t, uint32(4), hunks[0].EndLine)
+
+		// Open-ended ranges.
+		hunks = readAll(t, git.BlameRange{EndLine: 2})
+		require.Len(t, hunks, 1)
+		require.Equal(t, uint32(1), hunks[0].StartLine)
+		require.Equal(t, uint32(3), hunks[0].EndLine)
+
+		hunks = readAll(t, git.BlameRange{StartLine: 4})
+		require.Len(t, hunks, 2)
+		require.Equal(t, api.CommitID("53e63d6dd6e61a58369bbc637b0ead2ee58d993c"), hunks[0].CommitID)
+		require.Equal(t, uint32(4), hunks[0].StartLine)
+		require.Equal(t, uint32(5), hunks[1].StartLine)
+	})
+
 	// Verify that if the context is canceled, the hunk reader returns an error.
 	t.Run("context cancelation", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(ctx)
@@ -171,6 +204,80 @@ func TestBuildBlameArgs(t *testing.T) {
 			t.Errorf("unexpected args:\ngot: %v\nwant: %v", got, want)
 		}
 	})
+
+	t.Run("with copy detection", func(t *testing.T) {
+		for level, flags := range map[git.BlameCopyDetection][]string{
+			git.BlameCopyDetectionMoves:               {"-M"},
+			git.BlameCopyDetectionCopies:              {"-C"},
+			git.BlameCopyDetectionCopiesFromCreation:  {"-C", "-C"},
+			git.BlameCopyDetectionCopiesFromAnyCommit: {"-C", "-C", "-C"},
+		} {
+			want := append(append([]string{"blame", "--porcelain", "--incremental"}, flags...), commit, "--", "foo.txt")
+			got := buildBlameArgs(api.CommitID(commit), path, git.BlameOptions{CopyDetection: level})
+			if !equalSlice(got, want) {
+				t.Errorf("unexpected args:\ngot: %v\nwant: %v", got, want)
+			}
+		}
+	})
+
+	t.Run("with open-ended line range", func(t *testing.T) {
+		for _, tc := range []struct {
+			r    git.BlameRange
+			want []string
+		}{
+			{r: git.BlameRange{StartLine: 5}, want: []string{"blame", "--porcelain", "--incremental", "-L5,", commit, "--", "foo.txt"}},
+			{r: git.BlameRange{EndLine: 10}, want: []string{"blame", "--porcelain", "--incremental", "-L,10", commit, "--", "foo.txt"}},
+			{r: git.BlameRange{}, want: []string{"blame", "--porcelain", "--incremental", commit, "--", "foo.txt"}},
+		} {
+			got := buildBlameArgs(api.CommitID(commit), path, git.BlameOptions{Range: &tc.r})
+			if !equalSlice(got, tc.want) {
+				t.Errorf("unexpected args:\ngot: %v\nwant: %v", got, tc.want)
+			}
+		}
+	})
+}
+
+func TestGitCLIBackend_Blame_CopyDetection(t *testing.T) {
+	backend := BackendWithRepoCommands(t,
+		`printf 'func hello() {\n\treturn "the quick brown fox jumps over the lazy dog"\n}\n' > a.go`,
+		"git add a.go",
+		"git commit -m one --author='Foo Author <foo@sourcegraph.com>'",
+		// Copy the function to a new file, while also touching a.go.
+		"printf 'func other() {}\n' >> a.go",
+		"printf 'package b\n' > b.go",
+		"head -3 a.go >> b.go",
+		"git add a.go b.go",
+		"git commit -m two --author='Bar Author <bar@sourcegraph.com>'",
+	)
+
+	ctx := context.Background()
+
+	head, err := backend.RevParseHead(ctx)
+	require.NoError(t, err)
+	first, err := backend.ResolveRevision(ctx, "HEAD~1")
+	require.NoError(t, err)
+
+	// blameLine returns the hunk that contains the given line.
+	blameLine := func(t *testing.T, opt git.BlameOptions, line uint32) *gitdomain.Hunk {
+		hr, err := backend.Blame(ctx, head, "b.go", opt)
+		require.NoError(t, err)
+		t.Cleanup(func() { hr.Close() })
+		for {
+			h, err := hr.Read()
+			require.NoError(t, err)
+			if h.StartLine <= line && line < h.EndLine {
+				return h
+			}
+		}
+	}
+
+	h := blameLine(t, git.BlameOptions{}, 3)
+	require.Equal(t, head, h.CommitID)
+	require.Equal(t, "b.go", h.Filename)
+
+	h = blameLine(t, git.BlameOptions{CopyDetection: git.BlameCopyDetectionCopies}, 3)
+	require.Equal(t, first, h.CommitID)
+	require.Equal(t, "a.go", h.Filename)
 }
 
 func equalSlice(a, b []string) bool {
@@ -0,0 +1,24 @@
+package gitcli
+
+import (
+	"context"
+	"io"
+	"strings"
+)
+
+func (g *gitCLIBackend) CreateBundle(ctx context.Context, refs []string) (io.ReadCloser, error) {
+	// Resolve all refs first, so that we can return a proper
+	// RevisionNotFoundError instead of a generic command failure.
+	for _, ref := range refs {
+		if _, err := g.ResolveRevision(ctx, strings.TrimPrefix(ref, "^")); err != nil {
+			return nil, err
+		}
+	}
+
+	return g.NewCommand(ctx, WithArguments(buildCreateBundleArgs(refs)...))
+}
+
+func buildCreateBundleArgs(refs []string) []string {
+	// "-" writes the bundle to stdout.
+	return append([]string{"bundle", "create", "-"}, refs...)
+}
@@ -0,0 +1,78 @@
+package gitcli
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestBuildCreateBundleArgs(t *testing.T) {
+	args := buildCreateBundleArgs([]string{"refs/heads/main", "^v1"})
+	require.Equal(t, []string{"bundle", "create", "-", "refs/heads/main", "^v1"}, args)
+}
+
+func TestGitCLIBackend_CreateBundle(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo line1 > f",
+		"git add f",
+		"GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m base --author='Foo Author <foo@sourcegraph.com>' --date=2006-01-02T15:04:05Z",
+		"git tag base",
+		"echo line2 >> f",
+		"git add f",
+		"GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m second --author='Foo Author <foo@sourcegraph.com>' --date=2006-01-02T15:04:05Z",
+		"git branch other",
+	)
+
+	// createBundle writes the bundle for refs to a file and returns the output
+	// of `git bundle list-heads` for it.
+	createBundle := func(t *testing.T, refs ...string) string {
+		t.Helper()
+		r, err := backend.CreateBundle(ctx, refs)
+		require.NoError(t, err)
+		t.Cleanup(func() { r.Close() })
+
+		out, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(out), "# v2 git bundle\n"))
+
+		path := filepath.Join(t.TempDir(), "repo.bundle")
+		require.NoError(t, os.WriteFile(path, out, 0o644))
+		heads, err := exec.Command("git", "bundle", "list-heads", path).Output()
+		require.NoError(t, err)
+		return string(heads)
+	}
+
+	head, err := backend.ResolveRevision(ctx, "master")
+	require.NoError(t, err)
+
+	t.Run("refs", func(t *testing.T) {
+		heads := createBundle(t, "refs/heads/master", "refs/heads/other")
+		require.Equal(t, string(head)+" refs/heads/master\n"+string(head)+" refs/heads/other\n", heads)
+	})
+
+	t.Run("incremental", func(t *testing.T) {
+		heads := createBundle(t, "refs/heads/master", "^base")
+		require.Equal(t, string(head)+" refs/heads/master\n", heads)
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		_, err := backend.CreateBundle(ctx, []string{"refs/heads/notfound"})
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+
+		_, err = backend.CreateBundle(ctx, []string{"refs/heads/master", "^notfound"})
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
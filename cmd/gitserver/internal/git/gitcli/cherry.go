@@ -0,0 +1,54 @@
+package gitcli
+
+import (
+	"bufio"
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) Cherry(ctx context.Context, upstreamRevspec, headRevspec string) ([]gitdomain.CherryCommit, error) {
+	// Resolve both branches first, so that we can return a proper
+	// RevisionNotFoundError instead of a generic command failure.
+	upstream, err := g.ResolveRevision(ctx, upstreamRevspec)
+	if err != nil {
+		return nil, err
+	}
+	head, err := g.ResolveRevision(ctx, headRevspec)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments("cherry", string(upstream), string(head)))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	commits := []gitdomain.CherryCommit{}
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		if line == "" {
+			continue
+		}
+		// Every line is "<+|-> <sha>", where - marks commits that have an
+		// equivalent upstream.
+		sign, sha, ok := strings.Cut(line, " ")
+		if !ok || (sign != "+" && sign != "-") || !gitdomain.IsAbsoluteRevision(sha) {
+			return nil, errors.Errorf("unexpected output from git cherry %q", line)
+		}
+		commits = append(commits, gitdomain.CherryCommit{
+			Commit:     api.CommitID(sha),
+			InUpstream: sign == "-",
+		})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
@@ -0,0 +1,79 @@
+package gitcli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_Cherry(t *testing.T) {
+	ctx := context.Background()
+
+	// Prepare repo state:
+	// * picked (master), cherry-pick of first
+	// * other
+	// | * second (feature)
+	// | * first
+	// |/
+	// * base
+	backend := BackendWithRepoCommands(t,
+		"echo base > base",
+		"git add base",
+		"git commit -m base",
+		"git checkout -b feature",
+		"echo first > first",
+		"git add first",
+		"git commit -m first",
+		"echo second > second",
+		"git add second",
+		"git commit -m second",
+		"git checkout master",
+		"echo other > other",
+		"git add other",
+		"git commit -m other",
+		"git cherry-pick feature~1",
+	)
+
+	resolve := func(rev string) api.CommitID {
+		id, err := backend.ResolveRevision(ctx, rev)
+		require.NoError(t, err)
+		return id
+	}
+
+	t.Run("cherry-picked commits are detected", func(t *testing.T) {
+		commits, err := backend.Cherry(ctx, "master", "feature")
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.CherryCommit{
+			{Commit: resolve("feature~1"), InUpstream: true},
+			{Commit: resolve("feature"), InUpstream: false},
+		}, commits)
+	})
+
+	t.Run("other direction", func(t *testing.T) {
+		commits, err := backend.Cherry(ctx, "feature", "master")
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.CherryCommit{
+			{Commit: resolve("master~1"), InUpstream: false},
+			{Commit: resolve("master"), InUpstream: true},
+		}, commits)
+	})
+
+	t.Run("no commits", func(t *testing.T) {
+		commits, err := backend.Cherry(ctx, "master", "master~2")
+		require.NoError(t, err)
+		require.Empty(t, commits)
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		_, err := backend.Cherry(ctx, "unknown", "feature")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+
+		_, err = backend.Cherry(ctx, "master", "unknown")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
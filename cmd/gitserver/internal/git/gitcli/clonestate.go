@@ -0,0 +1,102 @@
+package gitcli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) CloneState(ctx context.Context) (gitdomain.CloneState, error) {
+	var state gitdomain.CloneState
+
+	shallowCommits, err := readShallowFile(g.dir.Path("shallow"))
+	if err != nil {
+		return state, errors.Wrap(err, "reading shallow file")
+	}
+	if len(shallowCommits) > 0 {
+		state.Shallow = true
+		state.ShallowCommits = shallowCommits
+		state.Depth, err = g.firstParentDepth(ctx)
+		if err != nil {
+			return state, err
+		}
+	}
+
+	// extensions.partialClone is the name of the remote missing objects are
+	// fetched from, and the filter is stored with the remote.
+	remote, err := g.Config().Get(ctx, "extensions.partialclone")
+	if err != nil {
+		return state, err
+	}
+	if remote != "" {
+		state.Partial = true
+		state.Filter, err = g.Config().Get(ctx, "remote."+remote+".partialclonefilter")
+		if err != nil {
+			return state, err
+		}
+	}
+
+	return state, nil
+}
+
+// readShallowFile reads the boundary commits of a shallow clone, which git
+// lists one per line in $GIT_DIR/shallow. The file doesn't exist if the
+// repository isn't shallow.
+func readShallowFile(path string) ([]api.CommitID, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var commits []api.CommitID
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if !gitdomain.IsAbsoluteRevision(string(line)) {
+			return nil, errors.Newf("invalid shallow file entry %q", line)
+		}
+		commits = append(commits, api.CommitID(line))
+	}
+	return commits, scanner.Err()
+}
+
+// firstParentDepth counts the commits in the first-parent history of HEAD. It
+// returns 0 if HEAD doesn't point to a commit.
+func (g *gitCLIBackend) firstParentDepth(ctx context.Context) (int, error) {
+	if _, err := g.RevParseHead(ctx); err != nil {
+		if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments("rev-list", "--count", "--first-parent", "HEAD", "--"))
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	stdout, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	depth, err := strconv.Atoi(string(bytes.TrimSpace(stdout)))
+	if err != nil {
+		return 0, errors.Wrap(err, "parsing commit count")
+	}
+	return depth, nil
+}
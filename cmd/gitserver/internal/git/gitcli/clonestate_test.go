@@ -0,0 +1,71 @@
+package gitcli
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+)
+
+func TestGitCLIBackend_CloneState(t *testing.T) {
+	ctx := context.Background()
+	commits := []string{
+		"echo a > a && git add a && git commit -m a --author='Foo Author <foo@sourcegraph.com>'",
+		"echo b > b && git add b && git commit -m b --author='Foo Author <foo@sourcegraph.com>'",
+		"echo c > c && git add c && git commit -m c --author='Foo Author <foo@sourcegraph.com>'",
+	}
+
+	t.Run("full clone", func(t *testing.T) {
+		dir := RepoWithCommands(t, commits...)
+		backend := NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name()))
+
+		state, err := backend.CloneState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, gitdomain.CloneState{}, state)
+	})
+
+	t.Run("shallow clone", func(t *testing.T) {
+		// Marking a commit in the shallow file makes git treat it as the
+		// boundary of a shallow clone, like git clone --depth 2 would.
+		dir := RepoWithCommands(t, append(commits, "git rev-parse HEAD~1 > .git/shallow")...)
+		backend := NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name()))
+		boundary, err := backend.ResolveRevision(ctx, "HEAD~1")
+		require.NoError(t, err)
+
+		state, err := backend.CloneState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, gitdomain.CloneState{
+			Shallow:        true,
+			ShallowCommits: []api.CommitID{boundary},
+			Depth:          2,
+		}, state)
+		require.True(t, state.IsShallowCommit(boundary))
+	})
+
+	t.Run("partial clone", func(t *testing.T) {
+		dir := RepoWithCommands(t, append(commits,
+			"git config extensions.partialClone origin",
+			"git config remote.origin.partialclonefilter blob:limit=1m",
+		)...)
+		backend := NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name()))
+
+		state, err := backend.CloneState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, gitdomain.CloneState{Partial: true, Filter: "blob:limit=1m"}, state)
+	})
+
+	t.Run("invalid shallow file", func(t *testing.T) {
+		dir := RepoWithCommands(t, commits...)
+		require.NoError(t, os.WriteFile(dir.Path("shallow"), []byte("garbage\n"), 0644))
+		backend := NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name()))
+
+		_, err := backend.CloneState(ctx)
+		require.ErrorContains(t, err, "invalid shallow file entry")
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/honey"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
@@ -43,6 +44,8 @@ type commandOpts struct {
 	arguments []string
 
 	stdin io.Reader
+
+	env []string
 }
 
 func optsFromFuncs(optFns ...CommandOptionFunc) commandOpts {
@@ -69,13 +72,21 @@ func WithStdin(stdin io.Reader) CommandOptionFunc {
 	}
 }
 
+// WithEnv specifies additional environment variables of the form KEY=value
+// to set for the command.
+func WithEnv(env ...string) CommandOptionFunc {
+	return func(o *commandOpts) {
+		o.env = append(o.env, env...)
+	}
+}
+
 const gitCommandDefaultTimeout = time.Minute
 
 func (g *gitCLIBackend) NewCommand(ctx context.Context, optFns ...CommandOptionFunc) (_ io.ReadCloser, err error) {
 	opts := optsFromFuncs(optFns...)
 
 	tr, ctx := trace.New(ctx, "gitcli.NewCommand",
-		attribute.StringSlice("args", opts.arguments),
+		attribute.StringSlice("args", gitdomain.SanitizeArgs(opts.arguments)),
 		attribute.String("dir", g.dir.Path()),
 	)
 	defer func() {
@@ -108,6 +119,7 @@ func (g *gitCLIBackend) NewCommand(ctx context.Context, optFns ...CommandOptionF
 
 	cmd := exec.CommandContext(ctx, "git", opts.arguments...)
 	g.dir.Set(cmd)
+	cmd.Env = append(cmd.Env, opts.env...)
 
 	stderr, stderrBuf := stderrBuffer()
 	cmd.Stderr = stderr
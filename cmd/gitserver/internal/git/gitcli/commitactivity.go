@@ -0,0 +1,60 @@
+package gitcli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) CommitActivity(ctx context.Context, commit api.CommitID, opt git.CommitActivityOpts) ([]gitdomain.CommitActivityBucket, error) {
+	// Resolve the commit first, so that we return a useful RevisionNotFound
+	// error.
+	sha, err := g.ResolveRevision(ctx, string(commit))
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"log", "--no-merges", "--format=format:%ct"}
+	if !opt.After.IsZero() {
+		args = append(args, fmt.Sprintf("--after=%d", opt.After.Unix()))
+	}
+	if !opt.Before.IsZero() {
+		args = append(args, fmt.Sprintf("--before=%d", opt.Before.Unix()))
+	}
+	args = append(args, string(sha))
+	if path := strings.Trim(opt.Path, "/"); path != "" {
+		// A literal pathspec lets git skip the commits that don't change the
+		// path using the changed-path Bloom filters of the commit graph.
+		args = append(args, "--", pathspecLiteral(path))
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments(args...))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	// Only the dates are kept, so even long histories need little memory.
+	var dates []time.Time
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		ts, err := strconv.ParseInt(sc.Text(), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing commit date %q", sc.Text())
+		}
+		dates = append(dates, time.Unix(ts, 0))
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return gitdomain.BucketCommitActivity(dates, opt.Interval, opt.After, opt.Before), nil
+}
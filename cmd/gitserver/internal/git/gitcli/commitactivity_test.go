@@ -0,0 +1,99 @@
+package gitcli
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_CommitActivity(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"mkdir dir",
+		"echo a > a.txt",
+		"git add a.txt",
+		"GIT_COMMITTER_DATE='2024-01-01T10:00:00Z' git commit -m first",
+		"echo b > dir/b.txt",
+		"git add dir",
+		"GIT_COMMITTER_DATE='2024-01-01T23:00:00Z' git commit -m second",
+		"git checkout -b side",
+		"echo b2 > dir/b.txt",
+		"GIT_COMMITTER_DATE='2024-01-03T12:00:00Z' git commit -am third",
+		"git checkout master",
+		"echo a2 > a.txt",
+		"GIT_COMMITTER_DATE='2024-01-09T00:00:00Z' git commit -am fourth",
+		// Merges are not counted.
+		"GIT_COMMITTER_DATE='2024-01-10T00:00:00Z' git merge --no-ff -m merge side",
+	)
+
+	head, err := backend.ResolveRevision(ctx, "HEAD")
+	require.NoError(t, err)
+
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	counts := func(buckets []gitdomain.CommitActivityBucket) map[time.Time]int {
+		m := make(map[time.Time]int, len(buckets))
+		for _, b := range buckets {
+			m[b.Start] = b.Count
+		}
+		return m
+	}
+
+	t.Run("days", func(t *testing.T) {
+		buckets, err := backend.CommitActivity(ctx, head, git.CommitActivityOpts{Interval: gitdomain.CommitActivityIntervalDay})
+		require.NoError(t, err)
+		require.Len(t, buckets, 9)
+		require.Equal(t, day(1), buckets[0].Start)
+		require.Equal(t, day(9), buckets[8].Start)
+		require.Equal(t, map[time.Time]int{
+			day(1): 2, day(2): 0, day(3): 1, day(4): 0, day(5): 0,
+			day(6): 0, day(7): 0, day(8): 0, day(9): 1,
+		}, counts(buckets))
+	})
+
+	t.Run("weeks", func(t *testing.T) {
+		buckets, err := backend.CommitActivity(ctx, head, git.CommitActivityOpts{Interval: gitdomain.CommitActivityIntervalWeek})
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.CommitActivityBucket{
+			{Start: day(1), Count: 3},
+			{Start: day(8), Count: 1},
+		}, buckets)
+	})
+
+	t.Run("path", func(t *testing.T) {
+		buckets, err := backend.CommitActivity(ctx, head, git.CommitActivityOpts{Path: "dir/", Interval: gitdomain.CommitActivityIntervalDay})
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.CommitActivityBucket{
+			{Start: day(1), Count: 1},
+			{Start: day(2), Count: 0},
+			{Start: day(3), Count: 1},
+		}, buckets)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		buckets, err := backend.CommitActivity(ctx, head, git.CommitActivityOpts{
+			After:    day(2),
+			Before:   day(5),
+			Interval: gitdomain.CommitActivityIntervalDay,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.CommitActivityBucket{
+			{Start: day(2), Count: 0},
+			{Start: day(3), Count: 1},
+			{Start: day(4), Count: 0},
+			{Start: day(5), Count: 0},
+		}, buckets)
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := backend.CommitActivity(ctx, api.CommitID("e3f0d6b9b2e9f4a1c3b5d7e9f1a3c5b7d9e1f3a5"), git.CommitActivityOpts{})
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}), "unexpected error %v", err)
+	})
+}
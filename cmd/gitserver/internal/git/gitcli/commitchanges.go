@@ -0,0 +1,89 @@
+package gitcli
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) CommitStats(ctx context.Context, commit api.CommitID) ([]gitdomain.FileDiffStat, error) {
+	// Verify the commit exists first, so that we can return a proper
+	// RevisionNotFoundError instead of a generic command failure.
+	id, err := g.ResolveRevision(ctx, string(commit))
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments(buildCommitChangesArgs(id, "--numstat", "-z")...))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	out, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseNumstat(out)
+}
+
+func (g *gitCLIBackend) CommitPatch(ctx context.Context, commit api.CommitID) (io.ReadCloser, error) {
+	id, err := g.ResolveRevision(ctx, string(commit))
+	if err != nil {
+		return nil, err
+	}
+
+	return g.NewCommand(ctx, WithArguments(buildCommitChangesArgs(id, "-p")...))
+}
+
+func buildCommitChangesArgs(commit api.CommitID, format ...string) []string {
+	args := []string{
+		"diff-tree",
+		// Compare the root commit to the empty tree.
+		"--root",
+		"-r",
+		"--no-commit-id",
+		// Merge commits are compared to their first parent, rather than
+		// showing a combined diff.
+		"--diff-merges=first-parent",
+	}
+	args = append(args, format...)
+	return append(args, string(commit), "--")
+}
+
+// parseNumstat parses the output of `git diff-tree --numstat -z`, which is a
+// NUL-terminated "<added>\t<deleted>\t<path>" record per file. Binary files
+// are reported with "-" counts.
+func parseNumstat(out []byte) ([]gitdomain.FileDiffStat, error) {
+	stats := []gitdomain.FileDiffStat{}
+	for _, record := range bytes.Split(out, []byte{0}) {
+		if len(record) == 0 {
+			continue
+		}
+		fields := bytes.SplitN(record, []byte{'\t'}, 3)
+		if len(fields) != 3 {
+			return nil, errors.Errorf("unexpected output from git diff-tree %q", string(record))
+		}
+
+		stat := gitdomain.FileDiffStat{Path: string(fields[2])}
+		if string(fields[0]) == "-" && string(fields[1]) == "-" {
+			stat.Binary = true
+		} else {
+			var err error
+			if stat.Added, err = strconv.Atoi(string(fields[0])); err != nil {
+				return nil, errors.Wrapf(err, "unexpected output from git diff-tree %q", string(record))
+			}
+			if stat.Deleted, err = strconv.Atoi(string(fields[1])); err != nil {
+				return nil, errors.Wrapf(err, "unexpected output from git diff-tree %q", string(record))
+			}
+		}
+		stats = append(stats, stat)
+	}
+	return stats, nil
+}
@@ -0,0 +1,127 @@
+package gitcli
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_CommitStats(t *testing.T) {
+	ctx := context.Background()
+
+	// Prepare repo state:
+	// * merge (master)
+	// |\
+	// | * feature (feature)
+	// * | second
+	// |/
+	// * root
+	backend := BackendWithRepoCommands(t,
+		"printf 'a\\nb\\n' > file",
+		"printf '\\000' > binary",
+		"git add file binary",
+		"git commit -m root",
+		"git checkout -b feature",
+		"echo feature > feature",
+		"git add feature",
+		"git commit -m feature",
+		"git checkout master",
+		"printf 'a\\nc\\nd\\n' > file",
+		"printf '\\001' > binary",
+		"git add file binary",
+		"git commit -m second",
+		"git merge --no-ff -m merge feature",
+	)
+
+	resolve := func(rev string) api.CommitID {
+		id, err := backend.ResolveRevision(ctx, rev)
+		require.NoError(t, err)
+		return id
+	}
+
+	t.Run("root commit", func(t *testing.T) {
+		stats, err := backend.CommitStats(ctx, resolve("master~2"))
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.FileDiffStat{
+			{Path: "binary", Binary: true},
+			{Path: "file", Added: 2},
+		}, stats)
+	})
+
+	t.Run("modified files", func(t *testing.T) {
+		stats, err := backend.CommitStats(ctx, resolve("master~1"))
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.FileDiffStat{
+			{Path: "binary", Binary: true},
+			{Path: "file", Added: 2, Deleted: 1},
+		}, stats)
+	})
+
+	t.Run("merge commit is compared to first parent", func(t *testing.T) {
+		stats, err := backend.CommitStats(ctx, resolve("master"))
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.FileDiffStat{{Path: "feature", Added: 1}}, stats)
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := backend.CommitStats(ctx, "e3889dff4263f2273d73d3937fd3c6f2a008f444")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestGitCLIBackend_CommitPatch(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo a > file",
+		"git add file",
+		"git commit -m first",
+		"echo b > file",
+		"git add file",
+		"git commit -m second",
+	)
+
+	commit, err := backend.ResolveRevision(ctx, "HEAD")
+	require.NoError(t, err)
+
+	t.Run("returns patch", func(t *testing.T) {
+		r, err := backend.CommitPatch(ctx, commit)
+		require.NoError(t, err)
+		t.Cleanup(func() { r.Close() })
+
+		patch, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, `diff --git a/file b/file
+index 7898192..6178079 100644
+--- a/file
++++ b/file
+@@ -1 +1 @@
+-a
++b
+`, string(patch))
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := backend.CommitPatch(ctx, "e3889dff4263f2273d73d3937fd3c6f2a008f444")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestParseNumstat(t *testing.T) {
+	stats, err := parseNumstat([]byte("1\t2\tfile\x00-\t-\timage.png\x003\t0\tpath with\ttab\x00"))
+	require.NoError(t, err)
+	require.Equal(t, []gitdomain.FileDiffStat{
+		{Path: "file", Added: 1, Deleted: 2},
+		{Path: "image.png", Binary: true},
+		{Path: "path with\ttab", Added: 3},
+	}, stats)
+
+	_, err = parseNumstat([]byte("garbage\x00"))
+	require.Error(t, err)
+}
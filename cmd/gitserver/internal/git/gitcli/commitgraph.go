@@ -0,0 +1,96 @@
+package gitcli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) CommitGraph(ctx context.Context, opt git.CommitGraphOpts) (git.CommitGraphIterator, error) {
+	// Resolve the revspecs first, so that we can return a proper
+	// RevisionNotFoundError instead of a generic command failure.
+	head, err := g.ResolveRevision(ctx, opt.Head)
+	if err != nil {
+		return nil, err
+	}
+	var base api.CommitID
+	if opt.Base != "" {
+		base, err = g.ResolveRevision(ctx, opt.Base)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments(buildCommitGraphArgs(head, base, opt.MaxCommits)...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &commitGraphIterator{
+		Closer: r,
+		sc:     bufio.NewScanner(r),
+	}, nil
+}
+
+func buildCommitGraphArgs(head, base api.CommitID, maxCommits int) []string {
+	args := []string{
+		"rev-list",
+		// Prints the parents of every commit on the same line.
+		"--parents",
+		"--topo-order",
+	}
+
+	if maxCommits > 0 {
+		args = append(args, "--max-count="+strconv.Itoa(maxCommits))
+	}
+
+	if base != "" {
+		args = append(args, string(base)+".."+string(head))
+	} else {
+		args = append(args, string(head))
+	}
+
+	return append(args, "--")
+}
+
+type commitGraphIterator struct {
+	io.Closer
+	sc *bufio.Scanner
+}
+
+func (it *commitGraphIterator) Next() (*gitdomain.CommitGraphNode, error) {
+	for it.sc.Scan() {
+		line := it.sc.Bytes()
+		if len(line) == 0 {
+			// Skip over empty output.
+			continue
+		}
+		ids := bytes.Split(line, []byte(" "))
+		node := &gitdomain.CommitGraphNode{
+			Parents: make([]api.CommitID, 0, len(ids)-1),
+		}
+		for i, id := range ids {
+			if !gitdomain.IsAbsoluteRevision(string(id)) {
+				return nil, errors.Errorf("unexpected output from git rev-list %q", string(line))
+			}
+			if i == 0 {
+				node.Commit = api.CommitID(id)
+			} else {
+				node.Parents = append(node.Parents, api.CommitID(id))
+			}
+		}
+		return node, nil
+	}
+	if err := it.sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
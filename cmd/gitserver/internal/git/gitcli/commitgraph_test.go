@@ -0,0 +1,111 @@
+package gitcli
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestBuildCommitGraphArgs(t *testing.T) {
+	require.Equal(t, []string{"rev-list", "--parents", "--topo-order", "head", "--"}, buildCommitGraphArgs("head", "", 0))
+	require.Equal(t, []string{"rev-list", "--parents", "--topo-order", "--max-count=5", "base..head", "--"}, buildCommitGraphArgs("head", "base", 5))
+}
+
+func TestGitCLIBackend_CommitGraph(t *testing.T) {
+	ctx := context.Background()
+
+	// Prepare repo state:
+	// * merge (master)
+	// |\
+	// | * feature (feature)
+	// * | second
+	// |/
+	// * root (root)
+	backend := BackendWithRepoCommands(t,
+		"git commit --allow-empty -m root",
+		"git tag root",
+		"git checkout -b feature",
+		"git commit --allow-empty -m feature",
+		"git checkout master",
+		"git commit --allow-empty -m second",
+		"git merge --no-ff -m merge feature",
+	)
+
+	resolve := func(rev string) api.CommitID {
+		id, err := backend.ResolveRevision(ctx, rev)
+		require.NoError(t, err)
+		return id
+	}
+	root, feature, second, merge := resolve("root"), resolve("feature"), resolve("master~1"), resolve("master")
+
+	readAll := func(t *testing.T, opt git.CommitGraphOpts) map[api.CommitID][]api.CommitID {
+		it, err := backend.CommitGraph(ctx, opt)
+		require.NoError(t, err)
+		t.Cleanup(func() { it.Close() })
+
+		graph := make(map[api.CommitID][]api.CommitID)
+		var order []api.CommitID
+		for {
+			node, err := it.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			graph[node.Commit] = node.Parents
+			order = append(order, node.Commit)
+		}
+		require.NoError(t, it.Close())
+
+		// Children are listed before their parents.
+		seen := make(map[api.CommitID]bool)
+		for _, c := range order {
+			for _, p := range graph[c] {
+				require.False(t, seen[p], "parent %s listed before child %s", p, c)
+			}
+			seen[c] = true
+		}
+
+		return graph
+	}
+
+	t.Run("full history", func(t *testing.T) {
+		require.Equal(t, map[api.CommitID][]api.CommitID{
+			merge:   {second, feature},
+			second:  {root},
+			feature: {root},
+			root:    {},
+		}, readAll(t, git.CommitGraphOpts{Head: "master"}))
+	})
+
+	t.Run("range", func(t *testing.T) {
+		// Parents outside of the range are still reported.
+		require.Equal(t, map[api.CommitID][]api.CommitID{
+			merge:   {second, feature},
+			second:  {root},
+			feature: {root},
+		}, readAll(t, git.CommitGraphOpts{Head: "master", Base: "root"}))
+	})
+
+	t.Run("max commits", func(t *testing.T) {
+		require.Len(t, readAll(t, git.CommitGraphOpts{Head: "master", MaxCommits: 2}), 2)
+	})
+
+	t.Run("empty range", func(t *testing.T) {
+		require.Empty(t, readAll(t, git.CommitGraphOpts{Head: "root", Base: "master"}))
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		_, err := backend.CommitGraph(ctx, git.CommitGraphOpts{Head: "unknown"})
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+
+		_, err = backend.CommitGraph(ctx, git.CommitGraphOpts{Head: "master", Base: "unknown"})
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
@@ -0,0 +1,76 @@
+package gitcli
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+)
+
+func TestGitCLIBackend_CommitGraphStatus(t *testing.T) {
+	ctx := context.Background()
+	commits := []string{
+		"echo a > a && git add a && git commit -m a --author='Foo Author <foo@sourcegraph.com>'",
+		"echo b > b && git add b && git commit -m b --author='Foo Author <foo@sourcegraph.com>'",
+		"echo c > c && git add c && git commit -m c --author='Foo Author <foo@sourcegraph.com>'",
+	}
+
+	t.Run("no commit-graph", func(t *testing.T) {
+		dir := RepoWithCommands(t, commits...)
+		backend := NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name()))
+
+		status, err := backend.CommitGraphStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, gitdomain.CommitGraphStatus{}, status)
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		dir := RepoWithCommands(t, append(commits, "git commit-graph write --reachable --changed-paths")...)
+		backend := NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name()))
+
+		status, err := backend.CommitGraphStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, gitdomain.CommitGraphStatus{
+			Present:            true,
+			Layers:             1,
+			Commits:            3,
+			GenerationVersion:  2,
+			ChangedPathFilters: true,
+		}, status)
+	})
+
+	t.Run("split chain", func(t *testing.T) {
+		dir := RepoWithCommands(t,
+			commits[0],
+			"git -c commitGraph.generationVersion=1 commit-graph write --reachable --split=no-merge",
+			commits[1],
+			commits[2],
+			"git commit-graph write --reachable --split=no-merge",
+		)
+		backend := NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name()))
+
+		status, err := backend.CommitGraphStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, gitdomain.CommitGraphStatus{
+			Present:           true,
+			Layers:            2,
+			Commits:           3,
+			GenerationVersion: 1,
+		}, status)
+	})
+
+	t.Run("corrupt file", func(t *testing.T) {
+		dir := RepoWithCommands(t, commits...)
+		require.NoError(t, os.WriteFile(dir.Path("objects", "info", "commit-graph"), []byte("garbage!"), 0644))
+		backend := NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name()))
+
+		_, err := backend.CommitGraphStatus(ctx)
+		require.ErrorContains(t, err, "invalid signature")
+	})
+}
@@ -0,0 +1,66 @@
+package gitcli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) CreateTag(ctx context.Context, name string, target api.CommitID, opt git.CreateTagOpts) error {
+	if err := checkSpecArgSafety(string(target)); err != nil {
+		return err
+	}
+
+	args, env := buildCreateTagArgs(name, target, opt)
+
+	r, err := g.NewCommand(ctx, WithArguments(args...), WithEnv(env...), WithStdin(strings.NewReader(opt.Message)))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		var e *CommandFailedError
+		if errors.As(err, &e) && e.ExitStatus == 128 {
+			if bytes.Contains(e.Stderr, []byte("already exists")) {
+				return &gitdomain.RefUpdateConflictError{Repo: g.repoName, Ref: "refs/tags/" + name}
+			}
+			if bytes.Contains(e.Stderr, []byte("nonexistent object")) || bytes.Contains(e.Stderr, []byte("Failed to resolve")) {
+				return &gitdomain.RevisionNotFoundError{Repo: g.repoName, Spec: string(target)}
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func buildCreateTagArgs(name string, target api.CommitID, opt git.CreateTagOpts) (args, env []string) {
+	args = []string{"tag"}
+
+	if opt.Sign {
+		args = append(args, "-s", "--file=-")
+	} else if opt.Message != "" {
+		args = append(args, "-a", "--file=-")
+	}
+
+	// The tagger of an annotated tag is taken from the committer identity.
+	if opt.Tagger != nil {
+		env = append(env,
+			"GIT_COMMITTER_NAME="+opt.Tagger.Name,
+			"GIT_COMMITTER_EMAIL="+opt.Tagger.Email,
+		)
+		if !opt.Tagger.Date.IsZero() {
+			env = append(env, fmt.Sprintf("GIT_COMMITTER_DATE=%d %s", opt.Tagger.Date.Unix(), opt.Tagger.Date.Format("-0700")))
+		}
+	}
+
+	return append(args, "--", name, string(target)), env
+}
@@ -0,0 +1,147 @@
+package gitcli
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestBuildCreateTagArgs(t *testing.T) {
+	t.Run("lightweight", func(t *testing.T) {
+		args, env := buildCreateTagArgs("v1", "deadbeef", git.CreateTagOpts{})
+		require.Equal(t, []string{"tag", "--", "v1", "deadbeef"}, args)
+		require.Empty(t, env)
+	})
+
+	t.Run("annotated with tagger", func(t *testing.T) {
+		args, env := buildCreateTagArgs("v1", "deadbeef", git.CreateTagOpts{
+			Message: "Release v1",
+			Tagger: &gitdomain.Signature{
+				Name:  "Foo",
+				Email: "foo@sourcegraph.com",
+				Date:  time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			},
+		})
+		require.Equal(t, []string{"tag", "-a", "--file=-", "--", "v1", "deadbeef"}, args)
+		require.Equal(t, []string{
+			"GIT_COMMITTER_NAME=Foo",
+			"GIT_COMMITTER_EMAIL=foo@sourcegraph.com",
+			"GIT_COMMITTER_DATE=1136214245 +0000",
+		}, env)
+	})
+
+	t.Run("signed", func(t *testing.T) {
+		args, _ := buildCreateTagArgs("v1", "deadbeef", git.CreateTagOpts{Message: "Release v1", Sign: true})
+		require.Equal(t, []string{"tag", "-s", "--file=-", "--", "v1", "deadbeef"}, args)
+	})
+}
+
+func TestGitCLIBackend_CreateTag(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo line1 > f",
+		"git add f",
+		"git commit -m foo --author='Foo Author <foo@sourcegraph.com>'",
+	)
+
+	head, err := backend.ResolveRevision(ctx, "master")
+	require.NoError(t, err)
+
+	readTagObject := func(t *testing.T, name string) string {
+		t.Helper()
+		r, err := backend.Exec(ctx, "cat-file", "-p", "refs/tags/"+name)
+		require.NoError(t, err)
+		defer r.Close()
+		out, err := io.ReadAll(r)
+		require.NoError(t, err)
+		return string(out)
+	}
+
+	t.Run("lightweight", func(t *testing.T) {
+		require.NoError(t, backend.CreateTag(ctx, "light", head, git.CreateTagOpts{}))
+
+		obj, err := backend.GetObject(ctx, "refs/tags/light")
+		require.NoError(t, err)
+		require.Equal(t, gitdomain.ObjectTypeCommit, obj.Type)
+	})
+
+	t.Run("annotated", func(t *testing.T) {
+		require.NoError(t, backend.CreateTag(ctx, "annotated", head, git.CreateTagOpts{
+			Message: "Release notes\n\n-- the team",
+			Tagger: &gitdomain.Signature{
+				Name:  "Release Bot",
+				Email: "release@sourcegraph.com",
+				Date:  time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			},
+		}))
+
+		obj, err := backend.GetObject(ctx, "refs/tags/annotated")
+		require.NoError(t, err)
+		require.Equal(t, gitdomain.ObjectTypeTag, obj.Type)
+
+		tag := readTagObject(t, "annotated")
+		require.Contains(t, tag, "object "+string(head)+"\n")
+		require.Contains(t, tag, "tagger Release Bot <release@sourcegraph.com> 1136214245 +0000\n")
+		require.Contains(t, tag, "\nRelease notes\n\n-- the team\n")
+
+		got, err := backend.ResolveRevision(ctx, "annotated")
+		require.NoError(t, err)
+		require.Equal(t, head, got)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		require.NoError(t, backend.CreateTag(ctx, "exists", head, git.CreateTagOpts{}))
+
+		err := backend.CreateTag(ctx, "exists", head, git.CreateTagOpts{})
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RefUpdateConflictError{}))
+	})
+
+	t.Run("target not found", func(t *testing.T) {
+		err := backend.CreateTag(ctx, "notfound", "e3889dff4263e2273e9c9ddc8e5e6e5e5e5e5e5e", git.CreateTagOpts{})
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestGitCLIBackend_CreateTag_Signed(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen not available")
+	}
+
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo line1 > f",
+		"git add f",
+		"git commit -m foo --author='Foo Author <foo@sourcegraph.com>'",
+		"ssh-keygen -q -t ed25519 -N '' -f .git/signing_key",
+		"git config gpg.format ssh",
+		"git config user.signingkey \"$PWD/.git/signing_key\"",
+	)
+
+	head, err := backend.ResolveRevision(ctx, "master")
+	require.NoError(t, err)
+
+	require.NoError(t, backend.CreateTag(ctx, "signed", head, git.CreateTagOpts{
+		Message: "Signed release",
+		Tagger:  &gitdomain.Signature{Name: "Release Bot", Email: "release@sourcegraph.com"},
+		Sign:    true,
+	}))
+
+	r, err := backend.Exec(ctx, "cat-file", "-p", "refs/tags/signed")
+	require.NoError(t, err)
+	t.Cleanup(func() { r.Close() })
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Contains(t, string(out), "-----BEGIN SSH SIGNATURE-----")
+}
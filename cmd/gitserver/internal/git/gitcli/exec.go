@@ -26,34 +26,44 @@ var (
 		"log":    append([]string{}, gitCommonAllowlist...),
 		"show":   append([]string{}, gitCommonAllowlist...),
 		"remote": {"-v"},
-		"diff":   append([]string{}, gitCommonAllowlist...),
-		"blame":  {"--root", "--incremental", "-w", "-p", "--porcelain", "--"},
+		"diff":   append([]string{"-w", "-b", "--ignore-blank-lines"}, gitCommonAllowlist...),
+		"blame":  {"--root", "--incremental", "-w", "-p", "--porcelain", "-M", "-C", "--"},
 		"branch": {"-r", "-a", "--contains", "--merged", "--format"},
 
 		"rev-parse":    {"--abbrev-ref", "--symbolic-full-name", "--glob", "--exclude"},
-		"rev-list":     {"--first-parent", "--max-parents", "--reverse", "--max-count", "--count", "--after", "--before", "--", "-n", "--date-order", "--skip", "--left-right"},
+		"rev-list":     {"--first-parent", "--max-parents", "--reverse", "--max-count", "--count", "--after", "--before", "--", "-n", "--date-order", "--skip", "--left-right", "--author", "--fixed-strings", "--grep", "--regexp-ignore-case", "--merges", "--no-merges", "--parents", "--topo-order", "--ancestry-path"},
 		"ls-remote":    {"--get-url"},
-		"symbolic-ref": {"--short"},
-		"archive":      {"--worktree-attributes", "--format", "-0", "HEAD", "--"},
+		"symbolic-ref": {"--short", "--quiet"},
+		"archive":      {"--worktree-attributes", "--format", "-0", "--add-virtual-file", "HEAD", "--"},
 		"ls-tree":      {"--name-only", "HEAD", "--long", "--full-name", "--object-only", "--", "-z", "-r", "-t"},
 		"ls-files":     {"--with-tree", "-z"},
-		"for-each-ref": {"--format", "--points-at", "--contains", "--sort", "-creatordate", "-refname", "-HEAD"},
-		"tag":          {"--list", "--sort", "-creatordate", "--format", "--points-at"},
+		"for-each-ref": {"--format", "--points-at", "--contains", "--sort", "-creatordate", "-refname", "-HEAD", "-version:refname", "--count"},
+		"tag":          {"--list", "--sort", "-creatordate", "--format", "--points-at", "-a", "-s"},
 		"merge-base":   {"--"},
-		"show-ref":     {"--heads"},
-		"shortlog":     {"-s", "-n", "-e", "--no-merges", "--after", "--before"},
+		"format-patch": {"--stdout", "--binary", "--no-signature", "--"},
+		"bundle":       {"-"},
+		"diff-tree":    {"--root", "-r", "--no-commit-id", "--diff-merges", "--numstat", "-z", "-p", "--"},
+		"cherry":       {},
+		"range-diff":   {"--no-color"},
+		"show-ref":     {"--heads", "--verify", "--quiet"},
+		"shortlog":     {"-s", "-n", "-e", "--no-merges", "--after", "--before", "-c", "--group"},
 		"cat-file":     {"-p", "-t"},
 		"lfs":          {},
 
+		// Commands used by maintenance tasks:
+		"gc":           {},
+		"repack":       {"-d", "-l", "-A", "--write-bitmap-index", "--window-memory", "--unpack-unreachable"},
+		"commit-graph": {"--reachable", "--changed-paths"},
+
 		// Commands used by GitConfigStore:
 		"config": {"--get", "--unset-all"},
 
 		// Commands used by Batch Changes when publishing changesets.
 		"init":       {},
 		"reset":      {"-q"},
-		"commit":     {"-m"},
-		"push":       {"--force"},
-		"update-ref": {},
+		"commit":     {"-m", "--trailer", "-S"},
+		"push":       {"--force", "--force-with-lease", "--push-option"},
+		"update-ref": {"-d"},
 		"apply":      {"--cached", "-p0"},
 
 		// Used in tests to simulate errors with runCommand in handleExec of gitserver.
@@ -71,11 +81,14 @@ var (
 		"--until", "--since", "--author", "--committer",
 		"--all-match", "--invert-grep", "--extended-regexp",
 		"--no-color", "--decorate", "--no-patch", "--exclude",
+		"--merges",
+		"--ancestry-path",
 		"--no-merges",
 		"--no-renames",
 		"--full-index",
 		"--find-copies",
 		"--find-renames",
+		"--diff-filter",
 		"--first-parent",
 		"--no-abbrev",
 		"--inter-hunk-context",
@@ -182,6 +195,13 @@ func IsAllowedGitCmd(logger log.Logger, args []string, dir common.GitDir) bool {
 		return false
 	}
 
+	// `git bundle` reads and writes bundle files at arbitrary paths, so only
+	// allow creating bundles that are written to stdout.
+	if cmd == "bundle" && (len(args) < 3 || args[1] != "create" || args[2] != "-") {
+		logger.Warn("isAllowedGitCmd: unallowed bundle arguments", log.Strings("args", args))
+		return false
+	}
+
 	// I hate state machines, but I hate them less than complicated multi-argument checking
 	checkFileInput := false
 	for i, arg := range args[1:] {
@@ -213,11 +233,11 @@ func IsAllowedGitCmd(logger log.Logger, args []string, dir common.GitDir) bool {
 				continue // this arg is OK
 			}
 
-			// For `git commit`, allow reading the commit message from stdin
-			// but don't just blindly accept the `--file` or `-F` args
+			// For `git commit` and `git tag`, allow reading the message from
+			// stdin but don't just blindly accept the `--file` or `-F` args
 			// because they could be used to read arbitrary files.
 			// Instead, accept only the forms that read from stdin.
-			if cmd == "commit" {
+			if cmd == "commit" || cmd == "tag" {
 				if arg == "--file=-" {
 					continue
 				}
@@ -26,12 +26,20 @@ func TestIsAllowedGitCmd(t *testing.T) {
 		{"commit", "--file=-"},
 		{"push", "--force", "git@github.com:repo/name", "f22cfd066432e382c24f1eaa867444671e23a136:refs/heads/a-branch"},
 		{"update-ref", "--"},
+		{"tag", "-a", "--file=-", "--", "v1.0.0", "ceed6a398bd66c090b6c24bd8251ac9255d90fb2"},
+
+		// Bundle export.
+		{"bundle", "create", "-", "refs/heads/main", "^v1.0.0"},
 	}
 	notAllowed := [][]string{
 		{"commit", "-F", "/etc/passwd"},
 		{"commit", "--file=/absolute/path"},
 		{"commit", "-F", "relative/passwd"},
 		{"commit", "--file=relative/path"},
+		{"tag", "-a", "--file=/absolute/path", "v1.0.0"},
+		{"bundle", "create", "/absolute/path", "refs/heads/main"},
+		{"bundle", "unbundle", "/absolute/path"},
+		{"bundle", "create"},
 	}
 
 	logger := logtest.Scoped(t)
@@ -56,9 +64,20 @@ func TestIsAllowedDiffGitCmd(t *testing.T) {
 		args []string
 		pass bool
 	}{
+		{args: []string{"log", "--merges", "HEAD"}, pass: true},
+		{args: []string{"rev-list", "--count", "--fixed-strings", "--author=foo", "--regexp-ignore-case", "--grep=bar", "--no-merges", "HEAD", "--", "file"}, pass: true},
+		{args: []string{"rev-list", "--parents", "--topo-order", "--max-count=5", "HEAD", "--"}, pass: true},
+		{args: []string{"log", "--ancestry-path", "HEAD~2..HEAD"}, pass: true},
+		{args: []string{"cherry", "master", "feature"}, pass: true},
+		{args: []string{"range-diff", "--no-color", "a..b", "c..d"}, pass: true},
+		{args: []string{"diff-tree", "--root", "-r", "--no-commit-id", "--diff-merges=first-parent", "--numstat", "-z", "HEAD", "--"}, pass: true},
+		{args: []string{"shortlog", "-s", "-n", "-e", "--no-merges", "-c", "HEAD", "--"}, pass: true},
+		{args: []string{"shortlog", "-s", "-n", "-e", "--no-merges", "--group=format:%aN <%aE>%x09%cN <%cE>", "HEAD", "--"}, pass: true},
+		{args: []string{"log", "--no-merges", "HEAD"}, pass: true},
 		{args: []string{"diff", "HEAD", "83838383"}, pass: true},
 		{args: []string{"diff", "HEAD", "HEAD~10"}, pass: true},
 		{args: []string{"diff", "HEAD", "HEAD~10", "--", "foo"}, pass: true},
+		{args: []string{"diff", "-w", "-b", "--ignore-blank-lines", "HEAD", "HEAD~10", "--", "foo"}, pass: true},
 		{args: []string{"diff", "HEAD", "HEAD~10", "--", "foo/baz"}, pass: true},
 		{args: []string{"diff", "ORIG_HEAD", "@~10", "--", "foo/baz"}, pass: true},
 		{args: []string{"diff", "HEAD~10", "--", "foo"}, pass: true},
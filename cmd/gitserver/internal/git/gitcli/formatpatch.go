@@ -0,0 +1,37 @@
+package gitcli
+
+import (
+	"context"
+	"io"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func (g *gitCLIBackend) FormatPatch(ctx context.Context, baseRevspec, headRevspec string) (io.ReadCloser, error) {
+	// Resolve both ends of the range first, so that we can return a proper
+	// RevisionNotFoundError instead of a generic command failure.
+	base, err := g.ResolveRevision(ctx, baseRevspec)
+	if err != nil {
+		return nil, err
+	}
+	head, err := g.ResolveRevision(ctx, headRevspec)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.NewCommand(ctx, WithArguments(buildFormatPatchArgs(base, head)...))
+}
+
+func buildFormatPatchArgs(base, head api.CommitID) []string {
+	return []string{
+		"format-patch",
+		"--stdout",
+		// Include binary diffs so the series can be applied with git am.
+		"--binary",
+		// Don't emit the "-- \n<git version>" trailer, it depends on the
+		// version of git running on gitserver.
+		"--no-signature",
+		string(base) + ".." + string(head),
+		"--",
+	}
+}
@@ -0,0 +1,94 @@
+package gitcli
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestBuildFormatPatchArgs(t *testing.T) {
+	args := buildFormatPatchArgs("base", "head")
+	require.Equal(t, []string{"format-patch", "--stdout", "--binary", "--no-signature", "base..head", "--"}, args)
+}
+
+func TestGitCLIBackend_FormatPatch(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo line1 > f",
+		"git add f",
+		"GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m base --author='Foo Author <foo@sourcegraph.com>' --date=2006-01-02T15:04:05Z",
+		"git tag base",
+		"echo line2 >> f",
+		"git add f",
+		"GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m first --author='Foo Author <foo@sourcegraph.com>' --date=2006-01-02T15:04:05Z",
+		"echo line3 >> f",
+		"git add f",
+		"GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m second --author='Foo Author <foo@sourcegraph.com>' --date=2006-01-02T15:04:05Z",
+	)
+
+	t.Run("range", func(t *testing.T) {
+		r, err := backend.FormatPatch(ctx, "base", "master")
+		require.NoError(t, err)
+		t.Cleanup(func() { r.Close() })
+
+		out, err := io.ReadAll(r)
+		require.NoError(t, err)
+
+		patches := string(out)
+		// Two commits, oldest first.
+		require.Equal(t, 2, strings.Count(patches, "\nFrom: Foo Author <foo@sourcegraph.com>\n"))
+		first := strings.Index(patches, "Subject: [PATCH 1/2] first")
+		second := strings.Index(patches, "Subject: [PATCH 2/2] second")
+		require.NotEqual(t, -1, first)
+		require.NotEqual(t, -1, second)
+		require.Less(t, first, second)
+		require.Contains(t, patches, "+line2\n")
+		require.Contains(t, patches, "+line3\n")
+		require.NotContains(t, patches, "Subject: [PATCH] base")
+	})
+
+	t.Run("empty range", func(t *testing.T) {
+		r, err := backend.FormatPatch(ctx, "master", "base")
+		require.NoError(t, err)
+		t.Cleanup(func() { r.Close() })
+
+		out, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Empty(t, out)
+	})
+
+	t.Run("commit IDs", func(t *testing.T) {
+		head, err := backend.ResolveRevision(ctx, "master")
+		require.NoError(t, err)
+
+		r, err := backend.FormatPatch(ctx, string(head)+"~1", string(head))
+		require.NoError(t, err)
+		t.Cleanup(func() { r.Close() })
+
+		out, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(out), "From "+string(head)+" "))
+		require.Contains(t, string(out), "Subject: [PATCH] second")
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		_, err := backend.FormatPatch(ctx, "base", "notfound")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+
+		_, err = backend.FormatPatch(ctx, "notfound", "master")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+
+		_, err = backend.FormatPatch(ctx, "base", "e3889dff4263e2273e9c9ddc8e5e6e5e5e5e5e5e")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
@@ -0,0 +1,86 @@
+package gitcli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+func (g *gitCLIBackend) LastCommitsForTree(ctx context.Context, commit api.CommitID, path string) ([]gitdomain.TreeEntryCommit, error) {
+	if err := checkSpecArgSafety(string(commit)); err != nil {
+		return nil, err
+	}
+
+	entries, err := g.GetTree(ctx, string(commit), path)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]gitdomain.TreeEntryCommit, len(entries))
+	pending := make(map[string]int, len(entries))
+	for i, e := range entries {
+		res[i].TreeEntry = e
+		pending[e.Name] = i
+	}
+	if len(pending) == 0 {
+		return res, nil
+	}
+
+	// Walk the history of the directory once, newest commits first, and
+	// attribute each commit to the entries it changed that don't have a
+	// commit yet. Limiting git log to the directory lets it skip the commits
+	// that don't change it using the changed-path Bloom filters of the commit
+	// graph, if they were written.
+	args := []string{"log", logFormatWithoutRefs, "--name-only", "--no-renames", string(commit)}
+	prefix := strings.Trim(path, "/")
+	if prefix != "" {
+		prefix += "/"
+		args = append(args, "--", pathspecLiteral(prefix))
+	}
+
+	r, err := g.NewCommand(ctx,
+		WithArguments(args...),
+		// Print non-ASCII paths as they are, so that they match the names of
+		// the entries.
+		WithEnv("GIT_CONFIG_COUNT=1", "GIT_CONFIG_KEY_0=core.quotePath", "GIT_CONFIG_VALUE_0=false"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	// Closing the command early stops git once all entries have a commit.
+	defer r.Close()
+
+	br := bufio.NewReader(r)
+	for len(pending) > 0 {
+		record, err := br.ReadBytes('\x1e')
+		record = bytes.TrimSuffix(record, []byte{'\x1e'})
+		if len(record) > 0 {
+			c, err := parseCommitLogOutput(record)
+			if err != nil {
+				return nil, err
+			}
+			for _, f := range c.ModifiedFiles {
+				name, _, _ := strings.Cut(strings.TrimPrefix(f, prefix), "/")
+				if i, ok := pending[name]; ok {
+					res[i].Commit = c.Commit
+					delete(pending, name)
+				}
+			}
+		}
+		if err == io.EOF {
+			// The remaining entries were last changed beyond the history of
+			// a shallow clone.
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,92 @@
+package gitcli
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_LastCommitsForTree(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"mkdir -p dir/sub",
+		"echo a > a.txt",
+		"echo b > dir/b.txt",
+		"echo c > dir/sub/c.txt",
+		"git add .",
+		"git commit -m first --author='Foo Author <foo@sourcegraph.com>'",
+		"echo b2 > dir/b.txt",
+		"git commit -am second --author='Foo Author <foo@sourcegraph.com>'",
+		"echo a3 > a.txt",
+		"echo c3 > dir/sub/c.txt",
+		"git commit -am third --author='Foo Author <foo@sourcegraph.com>'",
+		"echo d > dür.txt",
+		"git add dür.txt",
+		"git commit -m fourth --author='Foo Author <foo@sourcegraph.com>'",
+	)
+
+	rev := func(spec string) api.CommitID {
+		c, err := backend.ResolveRevision(ctx, spec)
+		require.NoError(t, err)
+		return c
+	}
+	head := rev("HEAD")
+
+	lastCommits := func(entries []gitdomain.TreeEntryCommit) map[string]api.CommitID {
+		m := make(map[string]api.CommitID, len(entries))
+		for _, e := range entries {
+			require.NotNil(t, e.Commit, e.Name)
+			m[e.Name] = e.Commit.ID
+		}
+		return m
+	}
+
+	t.Run("root", func(t *testing.T) {
+		entries, err := backend.LastCommitsForTree(ctx, head, "")
+		require.NoError(t, err)
+		require.Equal(t, map[string]api.CommitID{
+			"a.txt":   rev("HEAD~1"),
+			"dir":     rev("HEAD~1"),
+			"dür.txt": head,
+		}, lastCommits(entries))
+		require.Equal(t, gitdomain.Message("fourth"), entries[2].Commit.Message)
+		require.Equal(t, gitdomain.ObjectTypeTree, entries[1].Type)
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		entries, err := backend.LastCommitsForTree(ctx, head, "dir/")
+		require.NoError(t, err)
+		require.Equal(t, map[string]api.CommitID{
+			"b.txt": rev("HEAD~2"),
+			"sub":   rev("HEAD~1"),
+		}, lastCommits(entries))
+	})
+
+	t.Run("older commit", func(t *testing.T) {
+		entries, err := backend.LastCommitsForTree(ctx, rev("HEAD~2"), "dir")
+		require.NoError(t, err)
+		require.Equal(t, map[string]api.CommitID{
+			"b.txt": rev("HEAD~2"),
+			"sub":   rev("HEAD~3"),
+		}, lastCommits(entries))
+	})
+
+	t.Run("path not found", func(t *testing.T) {
+		_, err := backend.LastCommitsForTree(ctx, head, "notfound")
+		require.True(t, os.IsNotExist(err))
+		_, err = backend.LastCommitsForTree(ctx, head, "a.txt")
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := backend.LastCommitsForTree(ctx, "e3889dff4263a2273459471739aafabc10269885", "")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
@@ -0,0 +1,62 @@
+package gitcli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/byteutils"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) ListFiles(ctx context.Context, commit api.CommitID, path string) ([]string, error) {
+	if err := checkSpecArgSafety(string(commit)); err != nil {
+		return nil, err
+	}
+
+	args := []string{"ls-tree", "-r", "-z", "--full-name", string(commit), "--"}
+	if path != "" {
+		args = append(args, pathspecLiteral(path))
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments(args...))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var files []string
+	sc := bufio.NewScanner(r)
+	sc.Split(byteutils.ScanNullLines)
+	for sc.Scan() {
+		// format: 100644 blob 3bad331187e39c05c78a9b5e443689f78f4365a7\tREADME.md
+		line := sc.Bytes()
+		meta, name, ok := bytes.Cut(line, []byte("\t"))
+		if !ok {
+			return nil, errors.Newf("unexpected ls-tree output: %q", string(line))
+		}
+		// Skip submodules, they don't have any content we could look at.
+		if fields := bytes.Fields(meta); len(fields) < 2 || string(fields[1]) != "blob" {
+			continue
+		}
+		files = append(files, string(name))
+	}
+	if err := sc.Err(); err != nil {
+		var e *CommandFailedError
+		if errors.As(err, &e) && e.ExitStatus == 128 {
+			if bytes.Contains(e.Stderr, []byte("not a tree object")) || bytes.Contains(e.Stderr, []byte("Not a valid object name")) {
+				return nil, &gitdomain.RevisionNotFoundError{Repo: g.repoName, Spec: string(commit)}
+			}
+		}
+		return nil, err
+	}
+
+	if path != "" && len(files) == 0 {
+		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+
+	return files, nil
+}
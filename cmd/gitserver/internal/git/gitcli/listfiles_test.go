@@ -0,0 +1,64 @@
+package gitcli
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_ListFiles(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"mkdir -p dir/sub",
+		"echo a > a.txt",
+		"echo b > dir/b.txt",
+		"echo c > dir/sub/c.txt",
+		"echo star > 'dir/*'",
+		"git add .",
+		"git commit -m foo --author='Foo Author <foo@sourcegraph.com>'",
+	)
+
+	commit, err := backend.RevParseHead(ctx)
+	require.NoError(t, err)
+
+	t.Run("all files", func(t *testing.T) {
+		files, err := backend.ListFiles(ctx, commit, "")
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"a.txt", "dir/*", "dir/b.txt", "dir/sub/c.txt"}, files)
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		files, err := backend.ListFiles(ctx, commit, "dir/sub")
+		require.NoError(t, err)
+		require.Equal(t, []string{"dir/sub/c.txt"}, files)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		files, err := backend.ListFiles(ctx, commit, "dir/b.txt")
+		require.NoError(t, err)
+		require.Equal(t, []string{"dir/b.txt"}, files)
+
+		// Paths are matched literally.
+		files, err = backend.ListFiles(ctx, commit, "dir/*")
+		require.NoError(t, err)
+		require.Equal(t, []string{"dir/*"}, files)
+	})
+
+	t.Run("path not found", func(t *testing.T) {
+		_, err := backend.ListFiles(ctx, commit, "notfound")
+		require.Error(t, err)
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := backend.ListFiles(ctx, "e3889dff4263a2273459471739aafabc10269885", "")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
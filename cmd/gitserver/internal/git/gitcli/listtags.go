@@ -0,0 +1,72 @@
+package gitcli
+
+import (
+	"bufio"
+	"context"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+func (g *gitCLIBackend) ListTags(ctx context.Context, opt git.ListTagsOpts) (git.RefIterator, error) {
+	r, err := g.NewCommand(
+		ctx,
+		WithArguments(buildListTagsArgs(opt)...),
+		// Sort pre-releases like v1.0.0-rc.1 before the release they precede,
+		// as semver does, instead of after it.
+		WithEnv("GIT_CONFIG_COUNT=1", "GIT_CONFIG_KEY_0=versionsort.suffix", "GIT_CONFIG_VALUE_0=-"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tagIterator{
+		RefIterator: &refIterator{
+			Closer: r,
+			sc:     bufio.NewScanner(r),
+		},
+	}, nil
+}
+
+// tagIterator marks all refs as tags. refIterator derives the type from the
+// object the ref points to, which is a commit for lightweight tags.
+type tagIterator struct {
+	git.RefIterator
+}
+
+func (it *tagIterator) Next() (*gitdomain.Ref, error) {
+	ref, err := it.RefIterator.Next()
+	if err != nil {
+		return nil, err
+	}
+	ref.Type = gitdomain.RefTypeTag
+	return ref, nil
+}
+
+func buildListTagsArgs(opt git.ListTagsOpts) []string {
+	cmdArgs := []string{"for-each-ref"}
+
+	// The last sort key is the primary one.
+	if opt.SortByVersion {
+		cmdArgs = append(cmdArgs, "--sort", "-version:refname")
+	} else {
+		cmdArgs = append(cmdArgs, "--sort", "-refname", "--sort", "-creatordate")
+	}
+
+	cmdArgs = append(cmdArgs, "--format", refFormat)
+
+	if opt.Limit > 0 {
+		cmdArgs = append(cmdArgs, "--count="+strconv.Itoa(opt.Limit))
+	}
+
+	cmdArgs = append(cmdArgs, "--")
+	if len(opt.Patterns) == 0 {
+		cmdArgs = append(cmdArgs, "refs/tags/")
+	}
+	for _, p := range opt.Patterns {
+		cmdArgs = append(cmdArgs, "refs/tags/"+p)
+	}
+
+	return cmdArgs
+}
@@ -0,0 +1,84 @@
+package gitcli
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+func TestGitCLIBackend_ListTags(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"GIT_COMMITTER_DATE='2006-01-02T15:04:05Z' git commit --allow-empty -m first --author='Foo Author <foo@sourcegraph.com>'",
+		"GIT_COMMITTER_DATE='2006-01-02T15:04:05Z' git tag v1.2.0",
+		"GIT_COMMITTER_DATE='2006-01-02T15:04:06Z' git tag v1.10.0",
+		"GIT_COMMITTER_DATE='2006-01-02T15:04:07Z' git tag -a v1.10.0-rc.1 -m rc",
+		"GIT_COMMITTER_DATE='2006-01-02T15:04:08Z' git tag -a v2.0.0-beta -m beta",
+		"GIT_COMMITTER_DATE='2006-01-02T15:04:09Z' git tag other",
+	)
+
+	head, err := backend.RevParseHead(ctx)
+	require.NoError(t, err)
+
+	listTags := func(t *testing.T, opt git.ListTagsOpts) []*gitdomain.Ref {
+		t.Helper()
+		it, err := backend.ListTags(ctx, opt)
+		require.NoError(t, err)
+		var refs []*gitdomain.Ref
+		for {
+			ref, err := it.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			refs = append(refs, ref)
+		}
+		require.NoError(t, it.Close())
+		return refs
+	}
+	names := func(refs []*gitdomain.Ref) []string {
+		var names []string
+		for _, r := range refs {
+			names = append(names, r.ShortName)
+		}
+		return names
+	}
+
+	t.Run("newest first", func(t *testing.T) {
+		refs := listTags(t, git.ListTagsOpts{})
+		// Lightweight tags have the date of the commit they point to.
+		require.Equal(t, []string{"v2.0.0-beta", "v1.10.0-rc.1", "v1.2.0", "v1.10.0", "other"}, names(refs))
+	})
+
+	t.Run("sort by version", func(t *testing.T) {
+		refs := listTags(t, git.ListTagsOpts{SortByVersion: true, Patterns: []string{"v*"}})
+		require.Equal(t, []string{"v2.0.0-beta", "v1.10.0", "v1.10.0-rc.1", "v1.2.0"}, names(refs))
+	})
+
+	t.Run("patterns", func(t *testing.T) {
+		refs := listTags(t, git.ListTagsOpts{SortByVersion: true, Patterns: []string{"v1.10.*", "other"}})
+		require.Equal(t, []string{"v1.10.0", "v1.10.0-rc.1", "other"}, names(refs))
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		refs := listTags(t, git.ListTagsOpts{SortByVersion: true, Patterns: []string{"v*"}, Limit: 2})
+		require.Equal(t, []string{"v2.0.0-beta", "v1.10.0"}, names(refs))
+	})
+
+	t.Run("annotated tags are peeled", func(t *testing.T) {
+		refs := listTags(t, git.ListTagsOpts{Patterns: []string{"v1.10.0-rc.1", "v1.2.0"}})
+		require.Len(t, refs, 2)
+		for _, ref := range refs {
+			require.Equal(t, gitdomain.RefTypeTag, ref.Type)
+			require.Equal(t, head, ref.CommitID)
+		}
+		require.NotEqual(t, head, refs[0].RefOID, "annotated tag should have its own object ID")
+		require.Equal(t, head, refs[1].RefOID)
+	})
+}
@@ -0,0 +1,45 @@
+package gitcli
+
+import (
+	"context"
+	"io"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) RunMaintenanceTask(ctx context.Context, task git.MaintenanceTask) error {
+	args, err := buildMaintenanceTaskArgs(task)
+	if err != nil {
+		return err
+	}
+
+	r, err := g.NewCommand(
+		ctx,
+		WithArguments(args...),
+		// Run gc in the foreground, so that we return once it is done.
+		WithEnv("GIT_CONFIG_COUNT=1", "GIT_CONFIG_KEY_0=gc.autoDetach", "GIT_CONFIG_VALUE_0=false"),
+	)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	_, err = io.Copy(io.Discard, r)
+	return err
+}
+
+// buildMaintenanceTaskArgs returns the arguments for the git command of task.
+// The flags for repack and commit-graph are the ones sg maintenance uses.
+func buildMaintenanceTaskArgs(task git.MaintenanceTask) ([]string, error) {
+	switch task {
+	case git.MaintenanceTaskGC:
+		return []string{"gc"}, nil
+	case git.MaintenanceTaskRepack:
+		return []string{"repack", "-d", "-l", "-A", "--write-bitmap-index", "--window-memory", "100m", "--unpack-unreachable=now"}, nil
+	case git.MaintenanceTaskCommitGraph:
+		return []string{"commit-graph", "write", "--reachable", "--changed-paths"}, nil
+	default:
+		return nil, errors.Newf("unknown maintenance task %q", task)
+	}
+}
@@ -0,0 +1,48 @@
+package gitcli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+)
+
+func TestGitCLIBackend_RunMaintenanceTask(t *testing.T) {
+	ctx := context.Background()
+
+	dir := RepoWithCommands(t,
+		"echo a > a && git add a && git commit -m a --author='Foo Author <foo@sourcegraph.com>'",
+		"echo b > b && git add b && git commit -m b --author='Foo Author <foo@sourcegraph.com>'",
+	)
+	backend := NewBackend(logtest.Scoped(t), wrexec.NewNoOpRecordingCommandFactory(), dir, api.RepoName(t.Name()))
+
+	t.Run("repack", func(t *testing.T) {
+		require.NoError(t, backend.RunMaintenanceTask(ctx, git.MaintenanceTaskRepack))
+		bitmaps, err := filepath.Glob(dir.Path("objects", "pack", "*.bitmap"))
+		require.NoError(t, err)
+		require.Len(t, bitmaps, 1)
+	})
+
+	t.Run("commit-graph", func(t *testing.T) {
+		require.NoError(t, backend.RunMaintenanceTask(ctx, git.MaintenanceTaskCommitGraph))
+		_, err := os.Stat(dir.Path("objects", "info", "commit-graph"))
+		require.NoError(t, err)
+	})
+
+	t.Run("gc", func(t *testing.T) {
+		require.NoError(t, backend.RunMaintenanceTask(ctx, git.MaintenanceTaskGC))
+		_, err := os.Stat(dir.Path("packed-refs"))
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		require.ErrorContains(t, backend.RunMaintenanceTask(ctx, "prune"), "unknown maintenance task")
+	})
+}
@@ -148,6 +148,36 @@ func (g *gitCLIBackend) ReadFile(ctx context.Context, commit api.CommitID, path
 	return g.NewCommand(ctx, WithArguments("cat-file", "-p", string(blobOID)))
 }
 
+func (g *gitCLIBackend) BlobOID(ctx context.Context, commit api.CommitID, path string) (api.CommitID, error) {
+	if err := gitdomain.EnsureAbsoluteCommit(commit); err != nil {
+		return "", err
+	}
+
+	blobOID, err := g.getBlobOID(ctx, commit, path)
+	if err == errIsSubmodule {
+		return "", nil
+	}
+	return blobOID, err
+}
+
+func (g *gitCLIBackend) ReadBlob(ctx context.Context, oid api.CommitID) (io.ReadCloser, error) {
+	if err := gitdomain.EnsureAbsoluteCommit(oid); err != nil {
+		return nil, err
+	}
+
+	// cat-file -p would also print trees and commits, so make sure that
+	// the object is a blob first.
+	objectType, err := g.getObjectType(ctx, string(oid))
+	if err != nil {
+		return nil, err
+	}
+	if objectType != gitdomain.ObjectTypeBlob {
+		return nil, &gitdomain.RevisionNotFoundError{Repo: g.repoName, Spec: string(oid)}
+	}
+
+	return g.NewCommand(ctx, WithArguments("cat-file", "-p", string(oid)))
+}
+
 var errIsSubmodule = errors.New("blob is a submodule")
 
 func (g *gitCLIBackend) getBlobOID(ctx context.Context, commit api.CommitID, path string) (api.CommitID, error) {
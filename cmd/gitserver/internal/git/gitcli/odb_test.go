@@ -165,6 +165,87 @@ func TestGitCLIBackend_ReadFile_GoroutineLeak(t *testing.T) {
 	require.Equal(t, routinesBefore, routinesAfter)
 }
 
+func TestGitCLIBackend_BlobOID(t *testing.T) {
+	ctx := context.Background()
+
+	submodDir := RepoWithCommands(t,
+		"echo abcd > file1",
+		"git add file1",
+		"git commit -m commit --author='Foo Author <foo@sourcegraph.com>'",
+	)
+	backend := BackendWithRepoCommands(t,
+		"echo abcd > file1",
+		"git add file1",
+		"git commit -m commit --author='Foo Author <foo@sourcegraph.com>'",
+		"git -c protocol.file.allow=always submodule add "+filepath.ToSlash(string(submodDir))+" submod",
+		"git commit -m 'add submodule' --author='Foo Author <foo@sourcegraph.com>'",
+	)
+
+	commitID, err := backend.RevParseHead(ctx)
+	require.NoError(t, err)
+
+	t.Run("file", func(t *testing.T) {
+		oid, err := backend.BlobOID(ctx, commitID, "file1")
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("acbe86c7c89586e0912a0a851bacf309c595c308"), oid)
+	})
+
+	t.Run("submodule", func(t *testing.T) {
+		oid, err := backend.BlobOID(ctx, commitID, "submod")
+		require.NoError(t, err)
+		require.Empty(t, oid)
+	})
+
+	t.Run("non existent file", func(t *testing.T) {
+		_, err := backend.BlobOID(ctx, commitID, "filexyz")
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("non existent commit", func(t *testing.T) {
+		_, err := backend.BlobOID(ctx, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", "file1")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestGitCLIBackend_ReadBlob(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo abcd > file1",
+		"git add file1",
+		"git commit -m commit --author='Foo Author <foo@sourcegraph.com>'",
+	)
+
+	commitID, err := backend.RevParseHead(ctx)
+	require.NoError(t, err)
+
+	t.Run("blob", func(t *testing.T) {
+		oid, err := backend.BlobOID(ctx, commitID, "file1")
+		require.NoError(t, err)
+		r, err := backend.ReadBlob(ctx, oid)
+		require.NoError(t, err)
+		t.Cleanup(func() { r.Close() })
+		contents, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "abcd\n", string(contents))
+	})
+
+	t.Run("not a blob", func(t *testing.T) {
+		_, err := backend.ReadBlob(ctx, commitID)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+
+	t.Run("non existent blob", func(t *testing.T) {
+		_, err := backend.ReadBlob(ctx, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+
+	t.Run("not an object ID", func(t *testing.T) {
+		_, err := backend.ReadBlob(ctx, "HEAD")
+		require.Error(t, err)
+	})
+}
+
 func TestRepository_GetCommit(t *testing.T) {
 	ctx := context.Background()
 
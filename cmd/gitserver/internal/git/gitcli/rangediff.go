@@ -0,0 +1,119 @@
+package gitcli
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) RangeDiff(ctx context.Context, opt git.RangeDiffOpts) ([]*gitdomain.RangeDiffPair, error) {
+	// Resolve all ends of the ranges first, so that we can return a proper
+	// RevisionNotFoundError instead of a generic command failure.
+	var ids [4]api.CommitID
+	for i, spec := range []string{opt.OldBase, opt.OldHead, opt.NewBase, opt.NewHead} {
+		id, err := g.ResolveRevision(ctx, spec)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+
+	// git range-diff refuses ranges whose ends are the same commit, but
+	// comparing two empty series trivially yields no pairs.
+	if ids[0] == ids[1] && ids[2] == ids[3] {
+		return []*gitdomain.RangeDiffPair{}, nil
+	}
+
+	r, err := g.NewCommand(
+		ctx,
+		WithArguments(buildRangeDiffArgs(ids[0], ids[1], ids[2], ids[3])...),
+		// Commit IDs are abbreviated by default, and range-diff doesn't
+		// support --no-abbrev.
+		WithEnv("GIT_CONFIG_COUNT=1", "GIT_CONFIG_KEY_0=core.abbrev", "GIT_CONFIG_VALUE_0=40"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return parseRangeDiff(r)
+}
+
+func buildRangeDiffArgs(oldBase, oldHead, newBase, newHead api.CommitID) []string {
+	return []string{
+		"range-diff",
+		"--no-color",
+		string(oldBase) + ".." + string(oldHead),
+		string(newBase) + ".." + string(newHead),
+	}
+}
+
+// rangeDiffPairPattern matches the header line of a pair, e.g.
+// "2:  664fc05 ! 2:  4e80298 subject" or "-:  ------- > 3:  8bf2677 subject".
+var rangeDiffPairPattern = lazyregexp.New(`^(-|\d+): +(-+|[0-9a-f]+) ([=!<>]) (-|\d+): +(-+|[0-9a-f]+) ?(.*)$`)
+
+// rangeDiffIndent is the indentation of the diff lines that follow the header
+// of a modified pair.
+const rangeDiffIndent = "    "
+
+func parseRangeDiff(r io.Reader) ([]*gitdomain.RangeDiffPair, error) {
+	pairs := []*gitdomain.RangeDiffPair{}
+	var diff strings.Builder
+	flushDiff := func() {
+		if len(pairs) > 0 && diff.Len() > 0 {
+			pairs[len(pairs)-1].Diff = diff.String()
+		}
+		diff.Reset()
+	}
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		if len(pairs) > 0 && (line == "" || strings.HasPrefix(line, rangeDiffIndent)) {
+			diff.WriteString(strings.TrimPrefix(line, rangeDiffIndent))
+			diff.WriteByte('\n')
+			continue
+		}
+
+		m := rangeDiffPairPattern.FindStringSubmatch(line)
+		if m == nil {
+			return nil, errors.Errorf("unexpected output from git range-diff %q", line)
+		}
+		flushDiff()
+
+		pair := &gitdomain.RangeDiffPair{Subject: m[6]}
+		switch m[3] {
+		case "=":
+			pair.Status = gitdomain.RangeDiffStatusEqual
+		case "!":
+			pair.Status = gitdomain.RangeDiffStatusModified
+		case "<":
+			pair.Status = gitdomain.RangeDiffStatusRemoved
+		case ">":
+			pair.Status = gitdomain.RangeDiffStatusAdded
+		}
+		if pair.Status != gitdomain.RangeDiffStatusAdded {
+			pair.OldIndex, _ = strconv.Atoi(m[1])
+			pair.OldCommit = api.CommitID(m[2])
+		}
+		if pair.Status != gitdomain.RangeDiffStatusRemoved {
+			pair.NewIndex, _ = strconv.Atoi(m[4])
+			pair.NewCommit = api.CommitID(m[5])
+		}
+		pairs = append(pairs, pair)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	flushDiff()
+
+	return pairs, nil
+}
@@ -0,0 +1,142 @@
+package gitcli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_RangeDiff(t *testing.T) {
+	ctx := context.Background()
+
+	// Prepare repo state:
+	// * four (v2)
+	// * two, with a modified line
+	// * one, rebased with a new date
+	// | * three (v1)
+	// | * two
+	// | * one
+	// |/
+	// * base (master)
+	backend := BackendWithRepoCommands(t,
+		"echo base > base",
+		"git add base",
+		"git commit -m base",
+		"git checkout -b v1",
+		"echo one > one",
+		"git add one",
+		"git commit -m one",
+		"seq 1 20 > two",
+		"git add two",
+		"git commit -m two",
+		"echo three > three",
+		"git add three",
+		"git commit -m three",
+		"git checkout -b v2 master",
+		"echo one > one",
+		"git add one",
+		"GIT_COMMITTER_DATE=2007-01-01T00:00:00Z git commit -m one",
+		"seq 1 20 | sed s/^10$/ten/ > two",
+		"git add two",
+		"git commit -m two",
+		"echo four > four",
+		"git add four",
+		"git commit -m four",
+	)
+
+	resolve := func(rev string) api.CommitID {
+		id, err := backend.ResolveRevision(ctx, rev)
+		require.NoError(t, err)
+		return id
+	}
+
+	t.Run("series are matched", func(t *testing.T) {
+		pairs, err := backend.RangeDiff(ctx, git.RangeDiffOpts{OldBase: "master", OldHead: "v1", NewBase: "master", NewHead: "v2"})
+		require.NoError(t, err)
+		require.Len(t, pairs, 4)
+
+		require.Equal(t, &gitdomain.RangeDiffPair{
+			Status:    gitdomain.RangeDiffStatusEqual,
+			OldIndex:  1,
+			OldCommit: resolve("v1~2"),
+			NewIndex:  1,
+			NewCommit: resolve("v2~2"),
+			Subject:   "one",
+		}, pairs[0])
+
+		require.Equal(t, gitdomain.RangeDiffStatusModified, pairs[1].Status)
+		require.Equal(t, resolve("v1~1"), pairs[1].OldCommit)
+		require.Equal(t, resolve("v2~1"), pairs[1].NewCommit)
+		require.Equal(t, "two", pairs[1].Subject)
+		require.Contains(t, pairs[1].Diff, "-+10\n++ten\n")
+
+		require.Equal(t, &gitdomain.RangeDiffPair{
+			Status:    gitdomain.RangeDiffStatusRemoved,
+			OldIndex:  3,
+			OldCommit: resolve("v1"),
+			Subject:   "three",
+		}, pairs[2])
+
+		require.Equal(t, &gitdomain.RangeDiffPair{
+			Status:    gitdomain.RangeDiffStatusAdded,
+			NewIndex:  3,
+			NewCommit: resolve("v2"),
+			Subject:   "four",
+		}, pairs[3])
+	})
+
+	t.Run("identical series", func(t *testing.T) {
+		pairs, err := backend.RangeDiff(ctx, git.RangeDiffOpts{OldBase: "master", OldHead: "v1", NewBase: "master", NewHead: "v1"})
+		require.NoError(t, err)
+		require.Len(t, pairs, 3)
+		for _, p := range pairs {
+			require.Equal(t, gitdomain.RangeDiffStatusEqual, p.Status)
+			require.Equal(t, p.OldCommit, p.NewCommit)
+		}
+	})
+
+	t.Run("empty series", func(t *testing.T) {
+		pairs, err := backend.RangeDiff(ctx, git.RangeDiffOpts{OldBase: "master", OldHead: "master", NewBase: "master", NewHead: "master"})
+		require.NoError(t, err)
+		require.Empty(t, pairs)
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		_, err := backend.RangeDiff(ctx, git.RangeDiffOpts{OldBase: "master", OldHead: "v1", NewBase: "master", NewHead: "unknown"})
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestParseRangeDiff(t *testing.T) {
+	out := strings.Join([]string{
+		"1:  6b9f0e4c5d2a6f4e5b8c9d0a1b2c3d4e5f6a7b8c = 1:  0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b one",
+		"2:  1111111111111111111111111111111111111111 ! 2:  2222222222222222222222222222222222222222 two: with a colon",
+		"    @@ file (new)",
+		"    -+a",
+		"    ++b",
+		"",
+		"     ## Metadata ##",
+		"3:  3333333333333333333333333333333333333333 < -:  ---------------------------------------- three",
+		"-:  ---------------------------------------- > 3:  4444444444444444444444444444444444444444 four",
+		"",
+	}, "\n")
+
+	pairs, err := parseRangeDiff(strings.NewReader(out))
+	require.NoError(t, err)
+	require.Equal(t, []*gitdomain.RangeDiffPair{
+		{Status: gitdomain.RangeDiffStatusEqual, OldIndex: 1, OldCommit: "6b9f0e4c5d2a6f4e5b8c9d0a1b2c3d4e5f6a7b8c", NewIndex: 1, NewCommit: "0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b", Subject: "one"},
+		{Status: gitdomain.RangeDiffStatusModified, OldIndex: 2, OldCommit: "1111111111111111111111111111111111111111", NewIndex: 2, NewCommit: "2222222222222222222222222222222222222222", Subject: "two: with a colon", Diff: "@@ file (new)\n-+a\n++b\n\n ## Metadata ##\n"},
+		{Status: gitdomain.RangeDiffStatusRemoved, OldIndex: 3, OldCommit: "3333333333333333333333333333333333333333", Subject: "three"},
+		{Status: gitdomain.RangeDiffStatusAdded, NewIndex: 3, NewCommit: "4444444444444444444444444444444444444444", Subject: "four"},
+	}, pairs)
+
+	_, err = parseRangeDiff(strings.NewReader("garbage\n"))
+	require.Error(t, err)
+}
@@ -27,14 +27,18 @@ func (g *gitCLIBackend) ListRefs(ctx context.Context, opt git.ListRefsOpts) (git
 	}, nil
 }
 
+// refFormat is the for-each-ref format parsed by refIterator.
+//
+// tag * refs/tags/v5.3.1-rc.1 v5.3.1-rc.1 26750071c89a4a6536834a10bf9a97c5e70060a 26750071c89a4a6536834a10bf9a97c5e70060a 11708577416
+const refFormat = "%(objecttype)%00%(HEAD)%00%(refname)%00%(refname:short)%00%(objectname)%00%(*objectname)%00%(creatordate:unix)"
+
 func buildListRefsArgs(opt git.ListRefsOpts) []string {
 	cmdArgs := []string{
 		"for-each-ref",
 		"--sort", "-refname",
 		"--sort", "-creatordate",
 		"--sort", "-HEAD",
-		// tag * refs/tags/v5.3.1-rc.1 v5.3.1-rc.1 26750071c89a4a6536834a10bf9a97c5e70060a 26750071c89a4a6536834a10bf9a97c5e70060a 11708577416
-		"--format", "%(objecttype)%00%(HEAD)%00%(refname)%00%(refname:short)%00%(objectname)%00%(*objectname)%00%(creatordate:unix)",
+		"--format", refFormat,
 	}
 
 	for _, c := range opt.PointsAtCommit {
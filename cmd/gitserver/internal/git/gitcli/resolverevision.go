@@ -41,10 +41,17 @@ func (g *gitCLIBackend) revParse(ctx context.Context, spec string) (api.CommitID
 	stdout, err := io.ReadAll(r)
 	if err != nil {
 		var e *CommandFailedError
-		if errors.As(err, &e) && e.ExitStatus == 128 && (bytes.Contains(e.Stderr, []byte("bad revision")) ||
-			bytes.Contains(e.Stderr, []byte("unknown revision")) ||
-			bytes.Contains(e.Stderr, []byte("expected commit type"))) {
-			return "", &gitdomain.RevisionNotFoundError{Repo: g.repoName, Spec: spec}
+		if errors.As(err, &e) && e.ExitStatus == 128 {
+			// git reports ambiguous short IDs as bad revisions, too.
+			var ambiguous *gitdomain.AmbiguousRevisionError
+			if errors.As(gitdomain.ParseGitError(g.repoName, e.Stderr), &ambiguous) {
+				return "", ambiguous
+			}
+			if bytes.Contains(e.Stderr, []byte("bad revision")) ||
+				bytes.Contains(e.Stderr, []byte("unknown revision")) ||
+				bytes.Contains(e.Stderr, []byte("expected commit type")) {
+				return "", &gitdomain.RevisionNotFoundError{Repo: g.repoName, Spec: spec}
+			}
 		}
 
 		return "", err
@@ -123,6 +123,23 @@ func TestGitCLIBackend_ResolveRevision(t *testing.T) {
 		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
 	})
 
+	t.Run("ambiguous short ID", func(t *testing.T) {
+		// The blobs 066cbfe9 and 066ce604 share the prefix 066c.
+		backend := BackendWithRepoCommands(t,
+			"echo 401 | git hash-object -w --stdin",
+			"echo 565 | git hash-object -w --stdin",
+		)
+
+		_, err := backend.ResolveRevision(ctx, "066c")
+		var e *gitdomain.AmbiguousRevisionError
+		require.True(t, errors.As(err, &e), "unexpected error %v", err)
+		require.Equal(t, "066c", e.Spec)
+		require.Equal(t, []gitdomain.AmbiguousRevisionCandidate{
+			{ID: "066cbfe", Type: gitdomain.ObjectTypeBlob},
+			{ID: "066ce60", Type: gitdomain.ObjectTypeBlob},
+		}, e.Candidates)
+	})
+
 	t.Run("HEAD in empty repo", func(t *testing.T) {
 		backend := BackendWithRepoCommands(t)
 
@@ -0,0 +1,58 @@
+package gitcli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/byteutils"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) SparseManifest(ctx context.Context, commit api.CommitID, paths []string) ([]gitdomain.TreeEntry, error) {
+	if err := checkSpecArgSafety(string(commit)); err != nil {
+		return nil, err
+	}
+
+	args := []string{"ls-tree", "-r", "--long", "-z", "--full-name", string(commit), "--"}
+	for _, p := range paths {
+		// With -r, a directory matches all files below it, with or without
+		// a trailing slash. An empty path matches all files.
+		args = append(args, pathspecLiteral(strings.Trim(p, "/")))
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments(args...))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	entries := []gitdomain.TreeEntry{}
+	sc := bufio.NewScanner(r)
+	sc.Split(byteutils.ScanNullLines)
+	for sc.Scan() {
+		entry, err := parseTreeEntry(sc.Bytes(), "")
+		if err != nil {
+			return nil, err
+		}
+		// Skip submodules, they can't be materialized from this repository.
+		if entry.Type != gitdomain.ObjectTypeBlob {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := sc.Err(); err != nil {
+		var e *CommandFailedError
+		if errors.As(err, &e) && e.ExitStatus == 128 {
+			if bytes.Contains(e.Stderr, []byte("not a tree object")) || bytes.Contains(e.Stderr, []byte("Not a valid object name")) {
+				return nil, &gitdomain.RevisionNotFoundError{Repo: g.repoName, Spec: string(commit)}
+			}
+		}
+		return nil, err
+	}
+
+	return entries, nil
+}
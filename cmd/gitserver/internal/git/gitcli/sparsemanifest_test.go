@@ -0,0 +1,75 @@
+package gitcli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_SparseManifest(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"mkdir -p dir/sub dirx",
+		"echo abcd > a.txt",
+		"ln -s a.txt dir/link",
+		"echo b > dir/b.txt",
+		"echo c > dir/sub/c.txt",
+		"echo x > dirx/x.txt",
+		"chmod +x dir/b.txt",
+		"git add .",
+		// A submodule, which has no content in this repository.
+		"git update-index --add --cacheinfo 160000,e3889dff4263a2273459471739aafabc10269885,dir/module",
+		"git commit -m foo --author='Foo Author <foo@sourcegraph.com>'",
+	)
+
+	commit, err := backend.RevParseHead(ctx)
+	require.NoError(t, err)
+
+	names := func(entries []gitdomain.TreeEntry) []string {
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name)
+		}
+		return names
+	}
+
+	t.Run("all files", func(t *testing.T) {
+		entries, err := backend.SparseManifest(ctx, commit, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a.txt", "dir/b.txt", "dir/link", "dir/sub/c.txt", "dirx/x.txt"}, names(entries))
+	})
+
+	t.Run("directories and files", func(t *testing.T) {
+		entries, err := backend.SparseManifest(ctx, commit, []string{"dir/", "a.txt"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a.txt", "dir/b.txt", "dir/link", "dir/sub/c.txt"}, names(entries))
+
+		require.Equal(t, uint32(0o100755), entries[1].Mode)
+		require.Equal(t, uint32(0o120000), entries[2].Mode)
+		require.Equal(t, gitdomain.ObjectTypeBlob, entries[3].Type)
+		require.Equal(t, "f2ad6c76f0115a6ba5b00456a849810e7ec0af20", entries[3].OID.String())
+		require.Equal(t, int64(2), entries[3].Size)
+	})
+
+	t.Run("overlapping paths", func(t *testing.T) {
+		entries, err := backend.SparseManifest(ctx, commit, []string{"dir/sub", "dir"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"dir/b.txt", "dir/link", "dir/sub/c.txt"}, names(entries))
+	})
+
+	t.Run("paths are literal", func(t *testing.T) {
+		entries, err := backend.SparseManifest(ctx, commit, []string{"dir/*.txt", "notfound"})
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := backend.SparseManifest(ctx, "e3889dff4263a2273459471739aafabc10269885", nil)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
@@ -0,0 +1,59 @@
+package gitcli
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) SymbolicRef(ctx context.Context, name string) (string, error) {
+	if err := checkSpecArgSafety(name); err != nil {
+		return "", err
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments("symbolic-ref", "--quiet", name))
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	stdout, err := io.ReadAll(r)
+	if err != nil {
+		var e *CommandFailedError
+		// With --quiet, git symbolic-ref exits with status 1 without printing
+		// an error if name isn't a symbolic ref, which includes refs that
+		// don't exist.
+		if errors.As(err, &e) && e.ExitStatus == 1 {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return string(bytes.TrimSpace(stdout)), nil
+}
+
+func (g *gitCLIBackend) RefExists(ctx context.Context, name string) (bool, error) {
+	if err := checkSpecArgSafety(name); err != nil {
+		return false, err
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments("show-ref", "--verify", "--quiet", name))
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		var e *CommandFailedError
+		// git show-ref --verify exits with status 1 if the ref doesn't exist,
+		// or is a symbolic ref to a ref that doesn't exist.
+		if errors.As(err, &e) && e.ExitStatus == 1 {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,91 @@
+package gitcli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitCLIBackend_SymbolicRef(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo 'hello world' > foo.txt",
+		"git add foo.txt",
+		"git commit -m foo --author='Foo Author <foo@sourcegraph.com>'",
+		"git symbolic-ref refs/heads/alias refs/heads/master",
+		"git symbolic-ref refs/heads/dangling refs/heads/unborn",
+	)
+
+	for name, want := range map[string]string{
+		"HEAD":                "refs/heads/master",
+		"refs/heads/alias":    "refs/heads/master",
+		"refs/heads/dangling": "refs/heads/unborn",
+		// Regular refs and refs that don't exist aren't symbolic refs.
+		"refs/heads/master":  "",
+		"refs/heads/missing": "",
+	} {
+		t.Run(name, func(t *testing.T) {
+			target, err := backend.SymbolicRef(ctx, name)
+			require.NoError(t, err)
+			require.Equal(t, want, target)
+		})
+	}
+
+	t.Run("empty repo", func(t *testing.T) {
+		backend := BackendWithRepoCommands(t)
+
+		target, err := backend.SymbolicRef(ctx, "HEAD")
+		require.NoError(t, err)
+		require.Equal(t, "refs/heads/master", target)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, err := backend.SymbolicRef(ctx, "--delete")
+		require.Error(t, err)
+	})
+}
+
+func TestGitCLIBackend_RefExists(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo 'hello world' > foo.txt",
+		"git add foo.txt",
+		"git commit -m foo --author='Foo Author <foo@sourcegraph.com>'",
+		"git tag v1",
+		"git symbolic-ref refs/heads/alias refs/heads/master",
+		"git symbolic-ref refs/heads/dangling refs/heads/unborn",
+	)
+
+	for name, want := range map[string]bool{
+		"HEAD":                true,
+		"refs/heads/master":   true,
+		"refs/tags/v1":        true,
+		"refs/heads/alias":    true,
+		"refs/heads/dangling": false,
+		"refs/heads/missing":  false,
+		// Only full ref names are accepted.
+		"master": false,
+	} {
+		t.Run(name, func(t *testing.T) {
+			exists, err := backend.RefExists(ctx, name)
+			require.NoError(t, err)
+			require.Equal(t, want, exists)
+		})
+	}
+
+	t.Run("unborn HEAD", func(t *testing.T) {
+		backend := BackendWithRepoCommands(t)
+
+		exists, err := backend.RefExists(ctx, "HEAD")
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, err := backend.RefExists(ctx, "--heads")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,154 @@
+package gitcli
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) GetTag(ctx context.Context, name string) (*gitdomain.Tag, error) {
+	ref := "refs/tags/" + name
+	if err := checkSpecArgSafety(ref); err != nil {
+		return nil, err
+	}
+
+	sha, err := g.revParse(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	oid, err := decodeOID(sha)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode OID")
+	}
+	objectType, err := g.getObjectType(ctx, string(sha))
+	if err != nil {
+		return nil, errors.Wrap(err, "getting object type")
+	}
+
+	if objectType != gitdomain.ObjectTypeTag {
+		// A lightweight tag points to the target directly.
+		return &gitdomain.Tag{
+			Name:   name,
+			Target: gitdomain.GitObject{ID: oid, Type: objectType},
+		}, nil
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments("cat-file", "tag", string(sha)))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := parseTagObject(raw)
+	if err != nil {
+		return nil, err
+	}
+	tag.Name = name
+	tag.OID = sha
+	return tag, nil
+}
+
+// signaturePrefixes are the first lines of the signatures git appends to the
+// message of a signed tag, for the gpg, ssh and x509 signing formats.
+var signaturePrefixes = [][]byte{
+	[]byte("-----BEGIN PGP SIGNATURE-----"),
+	[]byte("-----BEGIN SSH SIGNATURE-----"),
+	[]byte("-----BEGIN SIGNED MESSAGE-----"),
+}
+
+// parseTagObject parses the output of git cat-file tag.
+func parseTagObject(raw []byte) (*gitdomain.Tag, error) {
+	// format:
+	// object 3bad331187e39c05c78a9b5e443689f78f4365a7
+	// type commit
+	// tag v1.0
+	// tagger Foo Author <foo@sourcegraph.com> 1006632000 +0000
+	//
+	// message
+	header, message, _ := bytes.Cut(raw, []byte("\n\n"))
+
+	var tag gitdomain.Tag
+	for _, line := range bytes.Split(header, []byte("\n")) {
+		key, value, _ := bytes.Cut(line, []byte(" "))
+		switch string(key) {
+		case "object":
+			oid, err := decodeOID(api.CommitID(value))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid object in tag: %q", string(line))
+			}
+			tag.Target.ID = oid
+		case "type":
+			tag.Target.Type = gitdomain.ObjectType(value)
+		case "tagger":
+			tagger, err := parseSignatureLine(value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid tagger in tag: %q", string(line))
+			}
+			tag.Tagger = tagger
+		}
+	}
+
+	msg, sig := cutSignature(message)
+	tag.Message, tag.Signature = string(msg), string(sig)
+
+	return &tag, nil
+}
+
+// cutSignature splits the message of a tag object into the message and the
+// signature that git appends to it for signed tags.
+func cutSignature(message []byte) (msg, sig []byte) {
+	for i := 0; i < len(message); {
+		for _, prefix := range signaturePrefixes {
+			if bytes.HasPrefix(message[i:], prefix) {
+				return message[:i], message[i:]
+			}
+		}
+		next := bytes.IndexByte(message[i:], '\n')
+		if next == -1 {
+			break
+		}
+		i += next + 1
+	}
+	return message, nil
+}
+
+// parseSignatureLine parses an identity like "Foo Author <foo@sourcegraph.com>
+// 1006632000 +0000", as git records it for taggers, authors and committers.
+func parseSignatureLine(line []byte) (*gitdomain.Signature, error) {
+	emailStart := bytes.LastIndexByte(line, '<')
+	emailEnd := bytes.LastIndexByte(line, '>')
+	if emailStart == -1 || emailEnd < emailStart {
+		return nil, errors.New("missing email")
+	}
+
+	sig := &gitdomain.Signature{
+		Name:  strings.TrimSpace(string(line[:emailStart])),
+		Email: string(line[emailStart+1 : emailEnd]),
+	}
+
+	fields := strings.Fields(string(line[emailEnd+1:]))
+	if len(fields) == 2 {
+		sec, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid date")
+		}
+		tz, err := time.Parse("-0700", fields[1])
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid time zone")
+		}
+		sig.Date = time.Unix(sec, 0).In(tz.Location())
+	}
+	return sig, nil
+}
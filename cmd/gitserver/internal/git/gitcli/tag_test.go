@@ -0,0 +1,131 @@
+package gitcli
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_GetTag(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo line1 > f",
+		"git add f",
+		"git commit -m foo --author='Foo Author <foo@sourcegraph.com>'",
+		"git tag lightweight",
+	)
+
+	head, err := backend.ResolveRevision(ctx, "master")
+	require.NoError(t, err)
+	headOID, err := decodeOID(head)
+	require.NoError(t, err)
+
+	tagger := &gitdomain.Signature{
+		Name:  "Release Bot",
+		Email: "release@sourcegraph.com",
+		Date:  time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 2*60*60)),
+	}
+	require.NoError(t, backend.CreateTag(ctx, "annotated", head, git.CreateTagOpts{
+		Message: "Release v1\n\nWith notes.\n",
+		Tagger:  tagger,
+	}))
+
+	t.Run("lightweight", func(t *testing.T) {
+		tag, err := backend.GetTag(ctx, "lightweight")
+		require.NoError(t, err)
+		require.Equal(t, &gitdomain.Tag{
+			Name:   "lightweight",
+			Target: gitdomain.GitObject{ID: headOID, Type: gitdomain.ObjectTypeCommit},
+		}, tag)
+	})
+
+	t.Run("annotated", func(t *testing.T) {
+		tag, err := backend.GetTag(ctx, "annotated")
+		require.NoError(t, err)
+		require.Equal(t, "annotated", tag.Name)
+		require.True(t, gitdomain.IsAbsoluteRevision(string(tag.OID)))
+		require.NotEqual(t, head, tag.OID)
+		require.Equal(t, gitdomain.GitObject{ID: headOID, Type: gitdomain.ObjectTypeCommit}, tag.Target)
+		require.Equal(t, tagger.Name, tag.Tagger.Name)
+		require.Equal(t, tagger.Email, tag.Tagger.Email)
+		require.True(t, tagger.Date.Equal(tag.Tagger.Date))
+		require.Equal(t, "Release v1\n\nWith notes.\n", tag.Message)
+		require.Empty(t, tag.Signature)
+	})
+
+	t.Run("tag of a tag", func(t *testing.T) {
+		annotated, err := backend.GetTag(ctx, "annotated")
+		require.NoError(t, err)
+		require.NoError(t, backend.CreateTag(ctx, "nested", annotated.OID, git.CreateTagOpts{Message: "Nested", Tagger: tagger}))
+
+		tag, err := backend.GetTag(ctx, "nested")
+		require.NoError(t, err)
+		require.Equal(t, gitdomain.ObjectTypeTag, tag.Target.Type)
+		require.Equal(t, string(annotated.OID), tag.Target.ID.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := backend.GetTag(ctx, "notfound")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestGitCLIBackend_GetTag_Signed(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen not available")
+	}
+
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo line1 > f",
+		"git add f",
+		"git commit -m foo --author='Foo Author <foo@sourcegraph.com>'",
+		"ssh-keygen -q -t ed25519 -N '' -f .git/signing_key",
+		"git config gpg.format ssh",
+		"git config user.signingkey \"$PWD/.git/signing_key\"",
+	)
+
+	head, err := backend.ResolveRevision(ctx, "master")
+	require.NoError(t, err)
+
+	require.NoError(t, backend.CreateTag(ctx, "signed", head, git.CreateTagOpts{
+		Message: "Signed release",
+		Tagger:  &gitdomain.Signature{Name: "Release Bot", Email: "release@sourcegraph.com"},
+		Sign:    true,
+	}))
+
+	tag, err := backend.GetTag(ctx, "signed")
+	require.NoError(t, err)
+	require.Equal(t, "Signed release\n", tag.Message)
+	require.Contains(t, tag.Signature, "-----BEGIN SSH SIGNATURE-----")
+	require.Contains(t, tag.Signature, "-----END SSH SIGNATURE-----")
+}
+
+func TestParseTagObject(t *testing.T) {
+	raw := "object 3bad331187e39c05c78a9b5e443689f78f4365a7\n" +
+		"type commit\n" +
+		"tag v1.0\n" +
+		"\n" +
+		"Old tag without tagger\n" +
+		"mentioning -----BEGIN PGP SIGNATURE----- inline\n" +
+		"-----BEGIN PGP SIGNATURE-----\n" +
+		"\n" +
+		"iQEzBAABCAAdFiEE\n" +
+		"-----END PGP SIGNATURE-----\n"
+
+	tag, err := parseTagObject([]byte(raw))
+	require.NoError(t, err)
+	require.Nil(t, tag.Tagger)
+	require.Equal(t, "3bad331187e39c05c78a9b5e443689f78f4365a7", tag.Target.ID.String())
+	require.Equal(t, "Old tag without tagger\nmentioning -----BEGIN PGP SIGNATURE----- inline\n", tag.Message)
+	require.Equal(t, "-----BEGIN PGP SIGNATURE-----\n\niQEzBAABCAAdFiEE\n-----END PGP SIGNATURE-----\n", tag.Signature)
+}
@@ -0,0 +1,103 @@
+package gitcli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/byteutils"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (g *gitCLIBackend) GetTree(ctx context.Context, treeish string, path string) ([]gitdomain.TreeEntry, error) {
+	if err := checkSpecArgSafety(treeish); err != nil {
+		return nil, err
+	}
+
+	args := []string{"ls-tree", "--long", "-z", "--full-name", treeish, "--"}
+	// With a trailing slash, ls-tree lists the entries of the directory
+	// instead of the directory itself.
+	prefix := strings.Trim(path, "/")
+	if prefix != "" {
+		prefix += "/"
+		args = append(args, pathspecLiteral(prefix))
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments(args...))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	entries := []gitdomain.TreeEntry{}
+	sc := bufio.NewScanner(r)
+	sc.Split(byteutils.ScanNullLines)
+	for sc.Scan() {
+		entry, err := parseTreeEntry(sc.Bytes(), prefix)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := sc.Err(); err != nil {
+		var e *CommandFailedError
+		if errors.As(err, &e) && e.ExitStatus == 128 {
+			if bytes.Contains(e.Stderr, []byte("not a tree object")) || bytes.Contains(e.Stderr, []byte("Not a valid object name")) {
+				return nil, &gitdomain.RevisionNotFoundError{Repo: g.repoName, Spec: treeish}
+			}
+		}
+		return nil, err
+	}
+
+	// Git doesn't store empty trees below the root, so no entries means that
+	// the path doesn't exist or is a file.
+	if prefix != "" && len(entries) == 0 {
+		return nil, &os.PathError{Op: "ls-tree", Path: path, Err: os.ErrNotExist}
+	}
+
+	return entries, nil
+}
+
+// parseTreeEntry parses a line of git ls-tree --long --full-name output, and
+// trims prefix from the path.
+func parseTreeEntry(line []byte, prefix string) (gitdomain.TreeEntry, error) {
+	// format: 100644 blob 3bad331187e39c05c78a9b5e443689f78f4365a7      12\tREADME.md
+	meta, name, ok := bytes.Cut(line, []byte("\t"))
+	if !ok {
+		return gitdomain.TreeEntry{}, errors.Newf("unexpected ls-tree output: %q", string(line))
+	}
+	fields := bytes.Fields(meta)
+	if len(fields) != 4 {
+		return gitdomain.TreeEntry{}, errors.Newf("unexpected ls-tree output: %q", string(line))
+	}
+
+	mode, err := strconv.ParseUint(string(fields[0]), 8, 32)
+	if err != nil {
+		return gitdomain.TreeEntry{}, errors.Wrapf(err, "invalid mode in ls-tree output: %q", string(line))
+	}
+	oid, err := decodeOID(api.CommitID(fields[2]))
+	if err != nil {
+		return gitdomain.TreeEntry{}, errors.Wrapf(err, "invalid object ID in ls-tree output: %q", string(line))
+	}
+	var size int64
+	// Trees and submodules have a size of "-".
+	if s := string(fields[3]); s != "-" {
+		size, err = strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return gitdomain.TreeEntry{}, errors.Wrapf(err, "invalid size in ls-tree output: %q", string(line))
+		}
+	}
+
+	return gitdomain.TreeEntry{
+		Name: strings.TrimPrefix(string(name), prefix),
+		Mode: uint32(mode),
+		Type: gitdomain.ObjectType(fields[1]),
+		OID:  oid,
+		Size: size,
+	}, nil
+}
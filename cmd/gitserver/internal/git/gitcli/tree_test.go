@@ -0,0 +1,88 @@
+package gitcli
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_GetTree(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"mkdir -p dir/sub",
+		"echo abcd > a.txt",
+		"ln -s a.txt link",
+		"echo b > dir/b.txt",
+		"echo c > dir/sub/c.txt",
+		"chmod +x dir/b.txt",
+		"git add .",
+		"git commit -m foo --author='Foo Author <foo@sourcegraph.com>'",
+	)
+
+	commit, err := backend.RevParseHead(ctx)
+	require.NoError(t, err)
+
+	oid := func(s string) gitdomain.OID {
+		o, err := decodeOID(api.CommitID(s))
+		require.NoError(t, err)
+		return o
+	}
+
+	t.Run("root", func(t *testing.T) {
+		entries, err := backend.GetTree(ctx, string(commit), "")
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		require.Equal(t, gitdomain.TreeEntry{
+			Name: "a.txt",
+			Mode: 0o100644,
+			Type: gitdomain.ObjectTypeBlob,
+			OID:  oid("acbe86c7c89586e0912a0a851bacf309c595c308"),
+			Size: 5,
+		}, entries[0])
+		require.Equal(t, "dir", entries[1].Name)
+		require.Equal(t, uint32(0o040000), entries[1].Mode)
+		require.Equal(t, gitdomain.ObjectTypeTree, entries[1].Type)
+		require.Equal(t, int64(0), entries[1].Size)
+		require.Equal(t, "link", entries[2].Name)
+		require.Equal(t, uint32(0o120000), entries[2].Mode)
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		entries, err := backend.GetTree(ctx, string(commit), "dir/")
+		require.NoError(t, err)
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name)
+		}
+		require.Equal(t, []string{"b.txt", "sub"}, names)
+		require.Equal(t, uint32(0o100755), entries[0].Mode)
+	})
+
+	t.Run("tree ID", func(t *testing.T) {
+		entries, err := backend.GetTree(ctx, string(commit)+"^{tree}", "dir/sub")
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "c.txt", entries[0].Name)
+	})
+
+	t.Run("path not found", func(t *testing.T) {
+		_, err := backend.GetTree(ctx, string(commit), "notfound")
+		require.True(t, os.IsNotExist(err))
+
+		// Files aren't trees.
+		_, err = backend.GetTree(ctx, string(commit), "a.txt")
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("treeish not found", func(t *testing.T) {
+		_, err := backend.GetTree(ctx, "e3889dff4263a2273459471739aafabc10269885", "")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
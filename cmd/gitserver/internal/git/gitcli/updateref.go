@@ -0,0 +1,79 @@
+package gitcli
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// zeroOID is passed as the old value to git update-ref to express that the
+// ref must not exist yet.
+const zeroOID = "0000000000000000000000000000000000000000"
+
+func (g *gitCLIBackend) UpdateRef(ctx context.Context, ref string, newOID, expectedOldOID api.CommitID) error {
+	if err := checkSpecArgSafety(ref); err != nil {
+		return err
+	}
+
+	oldOID := string(expectedOldOID)
+	if oldOID == "" {
+		oldOID = zeroOID
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments("update-ref", ref, string(newOID), oldOID))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		var e *CommandFailedError
+		if errors.As(err, &e) && e.ExitStatus == 128 {
+			// git update-ref fails to lock the ref if it exists when it
+			// shouldn't, doesn't exist when it should, or points at a different
+			// object than expected.
+			if bytes.Contains(e.Stderr, []byte("cannot lock ref")) {
+				return &gitdomain.RefUpdateConflictError{Repo: g.repoName, Ref: ref, ExpectedOldOID: expectedOldOID}
+			}
+			if bytes.Contains(e.Stderr, []byte("nonexistent object")) {
+				return &gitdomain.RevisionNotFoundError{Repo: g.repoName, Spec: string(newOID)}
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (g *gitCLIBackend) DeleteRef(ctx context.Context, ref string, expectedOldOID api.CommitID) error {
+	if err := checkSpecArgSafety(ref); err != nil {
+		return err
+	}
+
+	args := []string{"update-ref", "-d", ref}
+	if expectedOldOID != "" {
+		args = append(args, string(expectedOldOID))
+	}
+
+	r, err := g.NewCommand(ctx, WithArguments(args...))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		var e *CommandFailedError
+		// git update-ref -d exits with status 1 if the ref doesn't point at
+		// the expected object.
+		if errors.As(err, &e) && bytes.Contains(e.Stderr, []byte("cannot lock ref")) {
+			return &gitdomain.RefUpdateConflictError{Repo: g.repoName, Ref: ref, ExpectedOldOID: expectedOldOID}
+		}
+		return err
+	}
+
+	return nil
+}
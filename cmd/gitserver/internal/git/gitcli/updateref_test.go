@@ -0,0 +1,137 @@
+package gitcli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestGitCLIBackend_UpdateRef(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo line1 > f",
+		"git add f",
+		"git commit -m foo --author='Foo Author <foo@sourcegraph.com>'",
+		"echo line2 >> f",
+		"git add f",
+		"git commit -m bar --author='Foo Author <foo@sourcegraph.com>'",
+	)
+
+	head, err := backend.ResolveRevision(ctx, "master")
+	require.NoError(t, err)
+	parent, err := backend.ResolveRevision(ctx, "master~1")
+	require.NoError(t, err)
+
+	t.Run("create", func(t *testing.T) {
+		require.NoError(t, backend.UpdateRef(ctx, "refs/heads/created", parent, ""))
+
+		got, err := backend.ResolveRevision(ctx, "refs/heads/created")
+		require.NoError(t, err)
+		require.Equal(t, parent, got)
+
+		// Creating it again must fail, as the ref already exists.
+		err = backend.UpdateRef(ctx, "refs/heads/created", head, "")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RefUpdateConflictError{}))
+
+		got, err = backend.ResolveRevision(ctx, "refs/heads/created")
+		require.NoError(t, err)
+		require.Equal(t, parent, got)
+	})
+
+	t.Run("update with expected old value", func(t *testing.T) {
+		require.NoError(t, backend.UpdateRef(ctx, "refs/heads/cas", parent, ""))
+		require.NoError(t, backend.UpdateRef(ctx, "refs/heads/cas", head, parent))
+
+		got, err := backend.ResolveRevision(ctx, "refs/heads/cas")
+		require.NoError(t, err)
+		require.Equal(t, head, got)
+	})
+
+	t.Run("update with stale old value", func(t *testing.T) {
+		require.NoError(t, backend.UpdateRef(ctx, "refs/heads/stale", head, ""))
+
+		err := backend.UpdateRef(ctx, "refs/heads/stale", parent, parent)
+		require.Error(t, err)
+		var e *gitdomain.RefUpdateConflictError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, "refs/heads/stale", e.Ref)
+		require.Equal(t, parent, e.ExpectedOldOID)
+
+		got, err := backend.ResolveRevision(ctx, "refs/heads/stale")
+		require.NoError(t, err)
+		require.Equal(t, head, got)
+	})
+
+	t.Run("update non-existent ref with old value", func(t *testing.T) {
+		err := backend.UpdateRef(ctx, "refs/heads/missing", head, parent)
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RefUpdateConflictError{}))
+	})
+
+	t.Run("new commit not found", func(t *testing.T) {
+		err := backend.UpdateRef(ctx, "refs/heads/notfound", api.CommitID("e3889dff4263e2273e9c9ddc8e5e6e5e5e5e5e5e"), "")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestGitCLIBackend_DeleteRef(t *testing.T) {
+	ctx := context.Background()
+
+	backend := BackendWithRepoCommands(t,
+		"echo line1 > f",
+		"git add f",
+		"git commit -m foo --author='Foo Author <foo@sourcegraph.com>'",
+		"echo line2 >> f",
+		"git add f",
+		"git commit -m bar --author='Foo Author <foo@sourcegraph.com>'",
+		"git branch a",
+		"git branch b",
+		"git branch c",
+	)
+
+	head, err := backend.ResolveRevision(ctx, "master")
+	require.NoError(t, err)
+	parent, err := backend.ResolveRevision(ctx, "master~1")
+	require.NoError(t, err)
+
+	t.Run("delete with expected old value", func(t *testing.T) {
+		require.NoError(t, backend.DeleteRef(ctx, "refs/heads/a", head))
+
+		_, err := backend.ResolveRevision(ctx, "refs/heads/a")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+
+	t.Run("delete with stale old value", func(t *testing.T) {
+		err := backend.DeleteRef(ctx, "refs/heads/b", parent)
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RefUpdateConflictError{}))
+
+		got, err := backend.ResolveRevision(ctx, "refs/heads/b")
+		require.NoError(t, err)
+		require.Equal(t, head, got)
+	})
+
+	t.Run("delete without old value", func(t *testing.T) {
+		require.NoError(t, backend.DeleteRef(ctx, "refs/heads/c", ""))
+
+		_, err := backend.ResolveRevision(ctx, "refs/heads/c")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+
+		// Deleting a missing ref unconditionally is a no-op.
+		require.NoError(t, backend.DeleteRef(ctx, "refs/heads/c", ""))
+	})
+
+	t.Run("delete non-existent ref with old value", func(t *testing.T) {
+		err := backend.DeleteRef(ctx, "refs/heads/missing", head)
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RefUpdateConflictError{}))
+	})
+}
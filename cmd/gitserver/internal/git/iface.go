@@ -43,18 +43,41 @@ type GitBackend interface {
 	// If the path points to a submodule, an empty reader is returned and no error.
 	// If the commit does not exist, a RevisionNotFoundError is returned.
 	ReadFile(ctx context.Context, commit api.CommitID, path string) (io.ReadCloser, error)
+	// BlobOID returns the object ID of the blob of the given file at the given
+	// commit. The errors are the same as for ReadFile. If the path points to a
+	// submodule, an empty OID is returned and no error.
+	BlobOID(ctx context.Context, commit api.CommitID, path string) (api.CommitID, error)
+	// ReadBlob returns a reader for the contents of the blob with the given
+	// object ID. If no blob with that ID exists, a RevisionNotFoundError is
+	// returned.
+	ReadBlob(ctx context.Context, oid api.CommitID) (io.ReadCloser, error)
 	// GetCommit retrieves the commit with the given ID from the git ODB.
 	// If includeModifiedFiles is true, the returned GitCommitWithFiles will contain
 	// the list of all files touched in this commit.
 	// If the commit doesn't exist, a RevisionNotFoundError is returned.
 	GetCommit(ctx context.Context, commit api.CommitID, includeModifiedFiles bool) (*GitCommitWithFiles, error)
+	// CommitStats returns the number of lines added and deleted per file by
+	// the given commit, compared to its first parent. For the root commit,
+	// it is compared to the empty tree.
+	// If the commit doesn't exist, a RevisionNotFoundError is returned.
+	CommitStats(ctx context.Context, commit api.CommitID) ([]gitdomain.FileDiffStat, error)
+	// CommitPatch returns a reader for the unified diff of the given commit,
+	// compared to its first parent. For the root commit, it is compared to the
+	// empty tree.
+	// The reader must always be closed.
+	// If the commit doesn't exist, a RevisionNotFoundError is returned.
+	CommitPatch(ctx context.Context, commit api.CommitID) (io.ReadCloser, error)
 	// ArchiveReader returns a reader for an archive in the given format.
 	// Treeish is the tree or commit to archive, and paths is the list of
 	// paths to include in the archive. If empty, all paths are included.
 	//
+	// If filter is set, blobs are omitted like git's --filter option would,
+	// and listed in a manifest file at gitdomain.OmittedBlobsManifestPath.
+	// Only blob filters are supported, see gitdomain.ParseBlobFilter.
+	//
 	// If the commit does not exist, a RevisionNotFoundError is returned.
 	// If any path does not exist, a os.PathError is returned.
-	ArchiveReader(ctx context.Context, format ArchiveFormat, treeish string, paths []string) (io.ReadCloser, error)
+	ArchiveReader(ctx context.Context, format ArchiveFormat, treeish string, paths []string, filter string) (io.ReadCloser, error)
 	// ResolveRevision resolves the given revspec to a commit ID.
 	// I.e., HEAD, deadbeefdeadbeefdeadbeefdeadbeef, or refs/heads/main.
 	// If passed a commit sha, will also verify that the commit exists.
@@ -70,6 +93,13 @@ type GitBackend interface {
 	// If two resources are created at the same timestamp, the records are ordered
 	// alphabetically.
 	ListRefs(ctx context.Context, opt ListRefsOpts) (RefIterator, error)
+	// ListTags returns the tags of the repository matching opt. Refs of
+	// annotated tags have the commit they point at as CommitID, and the tag
+	// object as RefOID.
+	//
+	// By default, the tags are ordered by creation date, newest first. With
+	// opt.SortByVersion, they are ordered by version, highest first.
+	ListTags(ctx context.Context, opt ListTagsOpts) (RefIterator, error)
 
 	// RevAtTime returns the OID of the nearest ancestor of `spec` that has a
 	// commit time before the given time. To simplify the logic, it only
@@ -81,6 +111,150 @@ type GitBackend interface {
 	// commitID is returned.
 	RevAtTime(ctx context.Context, revspec string, time time.Time) (api.CommitID, error)
 
+	// FormatPatch returns a reader for the mbox-formatted patch series of all
+	// commits reachable from headRevspec but not from baseRevspec, oldest
+	// commit first.
+	// The reader must always be closed.
+	//
+	// If one of the two given revspecs does not exist, a RevisionNotFoundError
+	// is returned.
+	FormatPatch(ctx context.Context, baseRevspec, headRevspec string) (io.ReadCloser, error)
+
+	// CreateBundle returns a reader for a git bundle that contains the given
+	// refs and the objects reachable from them. Refs prefixed with ^ exclude
+	// the objects reachable from them from the bundle.
+	// The reader must always be closed.
+	//
+	// If one of the given refs does not exist, a RevisionNotFoundError is
+	// returned.
+	CreateBundle(ctx context.Context, refs []string) (io.ReadCloser, error)
+
+	// UpdateRef atomically updates ref to point at newOID, but only if it
+	// currently points at expectedOldOID. If expectedOldOID is empty, the ref
+	// must not exist yet.
+	//
+	// If the ref does not have the expected value, a RefUpdateConflictError is
+	// returned. If newOID does not exist, a RevisionNotFoundError is returned.
+	UpdateRef(ctx context.Context, ref string, newOID, expectedOldOID api.CommitID) error
+
+	// DeleteRef atomically deletes ref, but only if it currently points at
+	// expectedOldOID. If expectedOldOID is empty, the ref is deleted regardless
+	// of its value. Deleting a ref that doesn't exist with an empty
+	// expectedOldOID is not an error.
+	//
+	// If the ref does not have the expected value, a RefUpdateConflictError is
+	// returned.
+	DeleteRef(ctx context.Context, ref string, expectedOldOID api.CommitID) error
+
+	// CreateTag creates the tag refs/tags/<name> pointing at target. If
+	// opt.Message is empty and opt.Sign is false, a lightweight tag is created,
+	// otherwise an annotated tag object is written.
+	//
+	// If the tag already exists, a RefUpdateConflictError is returned. If
+	// target does not exist, a RevisionNotFoundError is returned.
+	CreateTag(ctx context.Context, name string, target api.CommitID, opt CreateTagOpts) error
+	// GetTag returns the tag refs/tags/<name>. For annotated tags, the
+	// contents of the tag object are returned, for lightweight tags only the
+	// name and target are set.
+	//
+	// If the tag does not exist, a RevisionNotFoundError is returned.
+	GetTag(ctx context.Context, name string) (*gitdomain.Tag, error)
+
+	// ListFiles returns the paths of all files in the tree of commit that are
+	// at or below path, recursively. If path is empty, all files in the
+	// repository are returned. Submodules are not included.
+	//
+	// If the commit does not exist, a RevisionNotFoundError is returned.
+	// If path does not exist, a os.PathError is returned.
+	ListFiles(ctx context.Context, commit api.CommitID, path string) ([]string, error)
+	// GetTree returns the entries of the tree at path in the given treeish,
+	// without descending into subtrees. If path is empty, the root tree is
+	// returned.
+	//
+	// If the treeish does not exist, a RevisionNotFoundError is returned.
+	// If path does not exist or is not a directory, a os.PathError is returned.
+	GetTree(ctx context.Context, treeish string, path string) ([]gitdomain.TreeEntry, error)
+	// SparseManifest returns the blobs in the tree of commit that are at or
+	// below one of paths, recursively, with their full paths as names. If
+	// paths is empty, all blobs of the commit are returned. Paths are matched
+	// literally, paths that don't exist don't match anything. Submodules are
+	// not included.
+	//
+	// If the commit does not exist, a RevisionNotFoundError is returned.
+	SparseManifest(ctx context.Context, commit api.CommitID, paths []string) ([]gitdomain.TreeEntry, error)
+	// LastCommitsForTree returns the entries of the tree at path in commit,
+	// like GetTree, together with the last commit that changed each of them.
+	// Entries whose last commit is beyond the history of a shallow clone have
+	// no commit.
+	//
+	// If the commit does not exist, a RevisionNotFoundError is returned.
+	// If path does not exist or is not a directory, a os.PathError is returned.
+	LastCommitsForTree(ctx context.Context, commit api.CommitID, path string) ([]gitdomain.TreeEntryCommit, error)
+	// CommitActivity counts the non-merge commits in the history of commit
+	// per opt.Interval, by committer date. See gitdomain.BucketCommitActivity
+	// for the range of the returned buckets.
+	//
+	// If the commit does not exist, a RevisionNotFoundError is returned.
+	CommitActivity(ctx context.Context, commit api.CommitID, opt CommitActivityOpts) ([]gitdomain.CommitActivityBucket, error)
+
+	// CommitGraph returns an iterator over the commits reachable from
+	// opt.Head but not from opt.Base and their parents, in topological order,
+	// children before their parents.
+	// CommitGraphIterator must always be closed.
+	//
+	// If one of the given revspecs does not exist, a RevisionNotFoundError
+	// is returned.
+	CommitGraph(ctx context.Context, opt CommitGraphOpts) (CommitGraphIterator, error)
+
+	// Cherry compares the commits reachable from headRevspec but not from
+	// upstreamRevspec to the commits of upstreamRevspec by patch ID, oldest
+	// commit first.
+	//
+	// If one of the two given revspecs does not exist, a RevisionNotFoundError
+	// is returned.
+	Cherry(ctx context.Context, upstreamRevspec, headRevspec string) ([]gitdomain.CherryCommit, error)
+
+	// RangeDiff compares the commit series opt.OldBase..opt.OldHead to the
+	// series opt.NewBase..opt.NewHead, matching every commit with its
+	// counterpart in the other series.
+	//
+	// If one of the given revspecs does not exist, a RevisionNotFoundError
+	// is returned.
+	RangeDiff(ctx context.Context, opt RangeDiffOpts) ([]*gitdomain.RangeDiffPair, error)
+
+	// SymbolicRef returns the name of the ref the symbolic ref name points to,
+	// like refs/heads/main for HEAD, without resolving it any further. The
+	// target doesn't have to exist.
+	// If name doesn't exist or isn't a symbolic ref, an empty string and no
+	// error is returned.
+	SymbolicRef(ctx context.Context, name string) (string, error)
+
+	// RefExists returns true if the fully qualified ref name exists. Symbolic
+	// refs only exist if the ref they point to exists, so an unborn HEAD
+	// doesn't exist.
+	RefExists(ctx context.Context, name string) (bool, error)
+
+	// BehindAhead returns the number of commits only reachable from left
+	// (Behind) and only reachable from right (Ahead).
+	//
+	// If one of the two given revspecs does not exist, a RevisionNotFoundError
+	// is returned.
+	BehindAhead(ctx context.Context, left, right string) (*gitdomain.BehindAhead, error)
+
+	// RunMaintenanceTask runs a housekeeping task on the repository and
+	// returns when it finished. The caller is responsible for preventing
+	// maintenance tasks from running concurrently.
+	RunMaintenanceTask(ctx context.Context, task MaintenanceTask) error
+
+	// CommitGraphStatus inspects the commit-graph files of the repository.
+	// If the repository has no commit-graph, Present is false and no error is
+	// returned.
+	CommitGraphStatus(ctx context.Context) (gitdomain.CommitGraphStatus, error)
+
+	// CloneState reports whether the repository is a shallow or partial
+	// clone. For a full clone, the zero value is returned.
+	CloneState(ctx context.Context) (gitdomain.CloneState, error)
+
 	// Exec is a temporary helper to run arbitrary git commands from the exec endpoint.
 	// No new usages of it should be introduced and once the migration is done we will
 	// remove this method.
@@ -103,15 +277,48 @@ type GitConfigBackend interface {
 type BlameOptions struct {
 	IgnoreWhitespace bool
 	Range            *BlameRange
+	// CopyDetection enables attributing moved or copied lines to the commit
+	// that originally introduced them.
+	CopyDetection BlameCopyDetection
 }
 
+// BlameCopyDetection is the level of move and copy detection used by blame.
+type BlameCopyDetection int
+
+const (
+	// BlameCopyDetectionNone disables move and copy detection.
+	BlameCopyDetectionNone BlameCopyDetection = iota
+	// BlameCopyDetectionMoves detects lines moved within the file (-M).
+	BlameCopyDetectionMoves
+	// BlameCopyDetectionCopies detects lines copied from files modified in
+	// the same commit (-C).
+	BlameCopyDetectionCopies
+	// BlameCopyDetectionCopiesFromCreation also looks at the commit that
+	// created the file (-C -C).
+	BlameCopyDetectionCopiesFromCreation
+	// BlameCopyDetectionCopiesFromAnyCommit looks at all commits (-C -C -C).
+	BlameCopyDetectionCopiesFromAnyCommit
+)
+
 type BlameRange struct {
-	// 1-indexed start line
+	// 1-indexed start line, or 0 for the beginning of the file.
 	StartLine int
-	// 1-indexed end line
+	// 1-indexed end line, or 0 for the end of the file.
 	EndLine int
 }
 
+// CreateTagOpts are options for creating a tag.
+type CreateTagOpts struct {
+	// Message is the message of an annotated tag.
+	Message string
+	// Tagger is the identity recorded in an annotated tag. If nil, the
+	// identity configured for git on gitserver is used.
+	Tagger *gitdomain.Signature
+	// Sign makes git sign the tag, using the signing key and format
+	// configured for git on gitserver.
+	Sign bool
+}
+
 // BlameHunkReader is a reader for git blame hunks.
 type BlameHunkReader interface {
 	// Consume the next hunk. io.EOF is returned at the end of the stream.
@@ -138,6 +345,22 @@ const (
 	ArchiveFormatTar ArchiveFormat = "tar"
 )
 
+// MaintenanceTask is a housekeeping task run by RunMaintenanceTask.
+type MaintenanceTask string
+
+const (
+	// MaintenanceTaskGC runs git gc.
+	MaintenanceTaskGC MaintenanceTask = "gc"
+
+	// MaintenanceTaskRepack repacks all objects into a single pack with a
+	// bitmap index.
+	MaintenanceTaskRepack MaintenanceTask = "repack"
+
+	// MaintenanceTaskCommitGraph writes the commit-graph file, including
+	// changed-path Bloom filters.
+	MaintenanceTaskCommitGraph MaintenanceTask = "commit-graph"
+)
+
 // ListRefsOpts are additional options passed to ListRefs.
 type ListRefsOpts struct {
 	// If true, only heads are returned. Can be combined with HeadsOnly.
@@ -151,6 +374,57 @@ type ListRefsOpts struct {
 	Contains []api.CommitID
 }
 
+// ListTagsOpts are additional options passed to ListTags.
+type ListTagsOpts struct {
+	// Patterns are glob patterns the tag names, like v1.2.3, are matched
+	// against, e.g. v1.*. A tag is returned if it matches any of them. If
+	// empty, all tags are returned.
+	Patterns []string
+	// SortByVersion orders tags by the version numbers in their name, highest
+	// first, with pre-releases like v1.0.0-rc.1 sorted before the release.
+	SortByVersion bool
+	// Limit is the maximum number of tags returned, if non-zero.
+	Limit int
+}
+
+// CommitActivityOpts are options passed to CommitActivity.
+type CommitActivityOpts struct {
+	// Path optionally limits the count to commits that changed something at
+	// or below it. It is matched literally.
+	Path string
+	// After and Before optionally limit the committer dates counted.
+	After  time.Time
+	Before time.Time
+	// Interval is the size of the buckets.
+	Interval gitdomain.CommitActivityInterval
+}
+
+// CommitGraphOpts are options passed to CommitGraph.
+type CommitGraphOpts struct {
+	// Head is the revspec whose history is listed.
+	Head string
+	// Base is optional. If set, commits reachable from it are not listed.
+	Base string
+	// MaxCommits limits the number of commits returned, if non-zero.
+	MaxCommits int
+}
+
+// RangeDiffOpts are the two commit series compared by RangeDiff.
+type RangeDiffOpts struct {
+	OldBase string
+	OldHead string
+	NewBase string
+	NewHead string
+}
+
+// CommitGraphIterator iterates over the nodes of a commit graph.
+type CommitGraphIterator interface {
+	// Next returns the next node. io.EOF is returned at the end of the graph.
+	Next() (*gitdomain.CommitGraphNode, error)
+	// Close releases resources associated with the iterator.
+	Close() error
+}
+
 // RefIterator iterates over refs.
 type RefIterator interface {
 	// Next returns the next ref.
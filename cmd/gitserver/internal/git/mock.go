@@ -279,6 +279,270 @@ func (c BlameHunkReaderReadFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// MockCommitGraphIterator is a mock implementation of the
+// CommitGraphIterator interface (from the package
+// github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git) used for
+// unit testing.
+type MockCommitGraphIterator struct {
+	// CloseFunc is an instance of a mock function object controlling the
+	// behavior of the method Close.
+	CloseFunc *CommitGraphIteratorCloseFunc
+	// NextFunc is an instance of a mock function object controlling the
+	// behavior of the method Next.
+	NextFunc *CommitGraphIteratorNextFunc
+}
+
+// NewMockCommitGraphIterator creates a new mock of the CommitGraphIterator
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockCommitGraphIterator() *MockCommitGraphIterator {
+	return &MockCommitGraphIterator{
+		CloseFunc: &CommitGraphIteratorCloseFunc{
+			defaultHook: func() (r0 error) {
+				return
+			},
+		},
+		NextFunc: &CommitGraphIteratorNextFunc{
+			defaultHook: func() (r0 *gitdomain.CommitGraphNode, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockCommitGraphIterator creates a new mock of the
+// CommitGraphIterator interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockCommitGraphIterator() *MockCommitGraphIterator {
+	return &MockCommitGraphIterator{
+		CloseFunc: &CommitGraphIteratorCloseFunc{
+			defaultHook: func() error {
+				panic("unexpected invocation of MockCommitGraphIterator.Close")
+			},
+		},
+		NextFunc: &CommitGraphIteratorNextFunc{
+			defaultHook: func() (*gitdomain.CommitGraphNode, error) {
+				panic("unexpected invocation of MockCommitGraphIterator.Next")
+			},
+		},
+	}
+}
+
+// NewMockCommitGraphIteratorFrom creates a new mock of the
+// MockCommitGraphIterator interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockCommitGraphIteratorFrom(i CommitGraphIterator) *MockCommitGraphIterator {
+	return &MockCommitGraphIterator{
+		CloseFunc: &CommitGraphIteratorCloseFunc{
+			defaultHook: i.Close,
+		},
+		NextFunc: &CommitGraphIteratorNextFunc{
+			defaultHook: i.Next,
+		},
+	}
+}
+
+// CommitGraphIteratorCloseFunc describes the behavior when the Close method
+// of the parent MockCommitGraphIterator instance is invoked.
+type CommitGraphIteratorCloseFunc struct {
+	defaultHook func() error
+	hooks       []func() error
+	history     []CommitGraphIteratorCloseFuncCall
+	mutex       sync.Mutex
+}
+
+// Close delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockCommitGraphIterator) Close() error {
+	r0 := m.CloseFunc.nextHook()()
+	m.CloseFunc.appendCall(CommitGraphIteratorCloseFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Close method of the
+// parent MockCommitGraphIterator instance is invoked and the hook queue is
+// empty.
+func (f *CommitGraphIteratorCloseFunc) SetDefaultHook(hook func() error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Close method of the parent MockCommitGraphIterator instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *CommitGraphIteratorCloseFunc) PushHook(hook func() error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CommitGraphIteratorCloseFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func() error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CommitGraphIteratorCloseFunc) PushReturn(r0 error) {
+	f.PushHook(func() error {
+		return r0
+	})
+}
+
+func (f *CommitGraphIteratorCloseFunc) nextHook() func() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CommitGraphIteratorCloseFunc) appendCall(r0 CommitGraphIteratorCloseFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CommitGraphIteratorCloseFuncCall objects
+// describing the invocations of this function.
+func (f *CommitGraphIteratorCloseFunc) History() []CommitGraphIteratorCloseFuncCall {
+	f.mutex.Lock()
+	history := make([]CommitGraphIteratorCloseFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CommitGraphIteratorCloseFuncCall is an object that describes an
+// invocation of method Close on an instance of MockCommitGraphIterator.
+type CommitGraphIteratorCloseFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CommitGraphIteratorCloseFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CommitGraphIteratorCloseFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CommitGraphIteratorNextFunc describes the behavior when the Next method
+// of the parent MockCommitGraphIterator instance is invoked.
+type CommitGraphIteratorNextFunc struct {
+	defaultHook func() (*gitdomain.CommitGraphNode, error)
+	hooks       []func() (*gitdomain.CommitGraphNode, error)
+	history     []CommitGraphIteratorNextFuncCall
+	mutex       sync.Mutex
+}
+
+// Next delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockCommitGraphIterator) Next() (*gitdomain.CommitGraphNode, error) {
+	r0, r1 := m.NextFunc.nextHook()()
+	m.NextFunc.appendCall(CommitGraphIteratorNextFuncCall{r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Next method of the
+// parent MockCommitGraphIterator instance is invoked and the hook queue is
+// empty.
+func (f *CommitGraphIteratorNextFunc) SetDefaultHook(hook func() (*gitdomain.CommitGraphNode, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Next method of the parent MockCommitGraphIterator instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *CommitGraphIteratorNextFunc) PushHook(hook func() (*gitdomain.CommitGraphNode, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CommitGraphIteratorNextFunc) SetDefaultReturn(r0 *gitdomain.CommitGraphNode, r1 error) {
+	f.SetDefaultHook(func() (*gitdomain.CommitGraphNode, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CommitGraphIteratorNextFunc) PushReturn(r0 *gitdomain.CommitGraphNode, r1 error) {
+	f.PushHook(func() (*gitdomain.CommitGraphNode, error) {
+		return r0, r1
+	})
+}
+
+func (f *CommitGraphIteratorNextFunc) nextHook() func() (*gitdomain.CommitGraphNode, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CommitGraphIteratorNextFunc) appendCall(r0 CommitGraphIteratorNextFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CommitGraphIteratorNextFuncCall objects
+// describing the invocations of this function.
+func (f *CommitGraphIteratorNextFunc) History() []CommitGraphIteratorNextFuncCall {
+	f.mutex.Lock()
+	history := make([]CommitGraphIteratorNextFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CommitGraphIteratorNextFuncCall is an object that describes an invocation
+// of method Next on an instance of MockCommitGraphIterator.
+type CommitGraphIteratorNextFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitdomain.CommitGraphNode
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CommitGraphIteratorNextFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CommitGraphIteratorNextFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // MockGitBackend is a mock implementation of the GitBackend interface (from
 // the package
 // github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git) used for
@@ -287,30 +551,93 @@ type MockGitBackend struct {
 	// ArchiveReaderFunc is an instance of a mock function object
 	// controlling the behavior of the method ArchiveReader.
 	ArchiveReaderFunc *GitBackendArchiveReaderFunc
+	// BehindAheadFunc is an instance of a mock function object controlling
+	// the behavior of the method BehindAhead.
+	BehindAheadFunc *GitBackendBehindAheadFunc
 	// BlameFunc is an instance of a mock function object controlling the
 	// behavior of the method Blame.
 	BlameFunc *GitBackendBlameFunc
+	// BlobOIDFunc is an instance of a mock function object controlling the
+	// behavior of the method BlobOID.
+	BlobOIDFunc *GitBackendBlobOIDFunc
+	// CherryFunc is an instance of a mock function object controlling the
+	// behavior of the method Cherry.
+	CherryFunc *GitBackendCherryFunc
+	// CloneStateFunc is an instance of a mock function object controlling
+	// the behavior of the method CloneState.
+	CloneStateFunc *GitBackendCloneStateFunc
+	// CommitActivityFunc is an instance of a mock function object
+	// controlling the behavior of the method CommitActivity.
+	CommitActivityFunc *GitBackendCommitActivityFunc
+	// CommitGraphFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitGraph.
+	CommitGraphFunc *GitBackendCommitGraphFunc
+	// CommitGraphStatusFunc is an instance of a mock function object
+	// controlling the behavior of the method CommitGraphStatus.
+	CommitGraphStatusFunc *GitBackendCommitGraphStatusFunc
+	// CommitPatchFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitPatch.
+	CommitPatchFunc *GitBackendCommitPatchFunc
+	// CommitStatsFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitStats.
+	CommitStatsFunc *GitBackendCommitStatsFunc
 	// ConfigFunc is an instance of a mock function object controlling the
 	// behavior of the method Config.
 	ConfigFunc *GitBackendConfigFunc
+	// CreateBundleFunc is an instance of a mock function object controlling
+	// the behavior of the method CreateBundle.
+	CreateBundleFunc *GitBackendCreateBundleFunc
+	// CreateTagFunc is an instance of a mock function object controlling
+	// the behavior of the method CreateTag.
+	CreateTagFunc *GitBackendCreateTagFunc
+	// DeleteRefFunc is an instance of a mock function object controlling
+	// the behavior of the method DeleteRef.
+	DeleteRefFunc *GitBackendDeleteRefFunc
 	// ExecFunc is an instance of a mock function object controlling the
 	// behavior of the method Exec.
 	ExecFunc *GitBackendExecFunc
+	// FormatPatchFunc is an instance of a mock function object controlling
+	// the behavior of the method FormatPatch.
+	FormatPatchFunc *GitBackendFormatPatchFunc
 	// GetCommitFunc is an instance of a mock function object controlling
 	// the behavior of the method GetCommit.
 	GetCommitFunc *GitBackendGetCommitFunc
 	// GetObjectFunc is an instance of a mock function object controlling
 	// the behavior of the method GetObject.
 	GetObjectFunc *GitBackendGetObjectFunc
+	// GetTagFunc is an instance of a mock function object controlling the
+	// behavior of the method GetTag.
+	GetTagFunc *GitBackendGetTagFunc
+	// GetTreeFunc is an instance of a mock function object controlling the
+	// behavior of the method GetTree.
+	GetTreeFunc *GitBackendGetTreeFunc
+	// LastCommitsForTreeFunc is an instance of a mock function object
+	// controlling the behavior of the method LastCommitsForTree.
+	LastCommitsForTreeFunc *GitBackendLastCommitsForTreeFunc
+	// ListFilesFunc is an instance of a mock function object controlling
+	// the behavior of the method ListFiles.
+	ListFilesFunc *GitBackendListFilesFunc
 	// ListRefsFunc is an instance of a mock function object controlling the
 	// behavior of the method ListRefs.
 	ListRefsFunc *GitBackendListRefsFunc
+	// ListTagsFunc is an instance of a mock function object controlling the
+	// behavior of the method ListTags.
+	ListTagsFunc *GitBackendListTagsFunc
 	// MergeBaseFunc is an instance of a mock function object controlling
 	// the behavior of the method MergeBase.
 	MergeBaseFunc *GitBackendMergeBaseFunc
+	// RangeDiffFunc is an instance of a mock function object controlling
+	// the behavior of the method RangeDiff.
+	RangeDiffFunc *GitBackendRangeDiffFunc
+	// ReadBlobFunc is an instance of a mock function object controlling the
+	// behavior of the method ReadBlob.
+	ReadBlobFunc *GitBackendReadBlobFunc
 	// ReadFileFunc is an instance of a mock function object controlling the
 	// behavior of the method ReadFile.
 	ReadFileFunc *GitBackendReadFileFunc
+	// RefExistsFunc is an instance of a mock function object controlling
+	// the behavior of the method RefExists.
+	RefExistsFunc *GitBackendRefExistsFunc
 	// ResolveRevisionFunc is an instance of a mock function object
 	// controlling the behavior of the method ResolveRevision.
 	ResolveRevisionFunc *GitBackendResolveRevisionFunc
@@ -320,9 +647,21 @@ type MockGitBackend struct {
 	// RevParseHeadFunc is an instance of a mock function object controlling
 	// the behavior of the method RevParseHead.
 	RevParseHeadFunc *GitBackendRevParseHeadFunc
+	// RunMaintenanceTaskFunc is an instance of a mock function object
+	// controlling the behavior of the method RunMaintenanceTask.
+	RunMaintenanceTaskFunc *GitBackendRunMaintenanceTaskFunc
+	// SparseManifestFunc is an instance of a mock function object
+	// controlling the behavior of the method SparseManifest.
+	SparseManifestFunc *GitBackendSparseManifestFunc
+	// SymbolicRefFunc is an instance of a mock function object controlling
+	// the behavior of the method SymbolicRef.
+	SymbolicRefFunc *GitBackendSymbolicRefFunc
 	// SymbolicRefHeadFunc is an instance of a mock function object
 	// controlling the behavior of the method SymbolicRefHead.
 	SymbolicRefHeadFunc *GitBackendSymbolicRefHeadFunc
+	// UpdateRefFunc is an instance of a mock function object controlling
+	// the behavior of the method UpdateRef.
+	UpdateRefFunc *GitBackendUpdateRefFunc
 }
 
 // NewMockGitBackend creates a new mock of the GitBackend interface. All
@@ -330,7 +669,12 @@ type MockGitBackend struct {
 func NewMockGitBackend() *MockGitBackend {
 	return &MockGitBackend{
 		ArchiveReaderFunc: &GitBackendArchiveReaderFunc{
-			defaultHook: func(context.Context, ArchiveFormat, string, []string) (r0 io.ReadCloser, r1 error) {
+			defaultHook: func(context.Context, ArchiveFormat, string, []string, string) (r0 io.ReadCloser, r1 error) {
+				return
+			},
+		},
+		BehindAheadFunc: &GitBackendBehindAheadFunc{
+			defaultHook: func(context.Context, string, string) (r0 *gitdomain.BehindAhead, r1 error) {
 				return
 			},
 		},
@@ -339,16 +683,76 @@ func NewMockGitBackend() *MockGitBackend {
 				return
 			},
 		},
+		BlobOIDFunc: &GitBackendBlobOIDFunc{
+			defaultHook: func(context.Context, api.CommitID, string) (r0 api.CommitID, r1 error) {
+				return
+			},
+		},
+		CherryFunc: &GitBackendCherryFunc{
+			defaultHook: func(context.Context, string, string) (r0 []gitdomain.CherryCommit, r1 error) {
+				return
+			},
+		},
+		CloneStateFunc: &GitBackendCloneStateFunc{
+			defaultHook: func(context.Context) (r0 gitdomain.CloneState, r1 error) {
+				return
+			},
+		},
+		CommitActivityFunc: &GitBackendCommitActivityFunc{
+			defaultHook: func(context.Context, api.CommitID, CommitActivityOpts) (r0 []gitdomain.CommitActivityBucket, r1 error) {
+				return
+			},
+		},
+		CommitGraphFunc: &GitBackendCommitGraphFunc{
+			defaultHook: func(context.Context, CommitGraphOpts) (r0 CommitGraphIterator, r1 error) {
+				return
+			},
+		},
+		CommitGraphStatusFunc: &GitBackendCommitGraphStatusFunc{
+			defaultHook: func(context.Context) (r0 gitdomain.CommitGraphStatus, r1 error) {
+				return
+			},
+		},
+		CommitPatchFunc: &GitBackendCommitPatchFunc{
+			defaultHook: func(context.Context, api.CommitID) (r0 io.ReadCloser, r1 error) {
+				return
+			},
+		},
+		CommitStatsFunc: &GitBackendCommitStatsFunc{
+			defaultHook: func(context.Context, api.CommitID) (r0 []gitdomain.FileDiffStat, r1 error) {
+				return
+			},
+		},
 		ConfigFunc: &GitBackendConfigFunc{
 			defaultHook: func() (r0 GitConfigBackend) {
 				return
 			},
 		},
+		CreateBundleFunc: &GitBackendCreateBundleFunc{
+			defaultHook: func(context.Context, []string) (r0 io.ReadCloser, r1 error) {
+				return
+			},
+		},
+		CreateTagFunc: &GitBackendCreateTagFunc{
+			defaultHook: func(context.Context, string, api.CommitID, CreateTagOpts) (r0 error) {
+				return
+			},
+		},
+		DeleteRefFunc: &GitBackendDeleteRefFunc{
+			defaultHook: func(context.Context, string, api.CommitID) (r0 error) {
+				return
+			},
+		},
 		ExecFunc: &GitBackendExecFunc{
 			defaultHook: func(context.Context, ...string) (r0 io.ReadCloser, r1 error) {
 				return
 			},
 		},
+		FormatPatchFunc: &GitBackendFormatPatchFunc{
+			defaultHook: func(context.Context, string, string) (r0 io.ReadCloser, r1 error) {
+				return
+			},
+		},
 		GetCommitFunc: &GitBackendGetCommitFunc{
 			defaultHook: func(context.Context, api.CommitID, bool) (r0 *GitCommitWithFiles, r1 error) {
 				return
@@ -359,21 +763,61 @@ func NewMockGitBackend() *MockGitBackend {
 				return
 			},
 		},
+		GetTagFunc: &GitBackendGetTagFunc{
+			defaultHook: func(context.Context, string) (r0 *gitdomain.Tag, r1 error) {
+				return
+			},
+		},
+		GetTreeFunc: &GitBackendGetTreeFunc{
+			defaultHook: func(context.Context, string, string) (r0 []gitdomain.TreeEntry, r1 error) {
+				return
+			},
+		},
+		LastCommitsForTreeFunc: &GitBackendLastCommitsForTreeFunc{
+			defaultHook: func(context.Context, api.CommitID, string) (r0 []gitdomain.TreeEntryCommit, r1 error) {
+				return
+			},
+		},
+		ListFilesFunc: &GitBackendListFilesFunc{
+			defaultHook: func(context.Context, api.CommitID, string) (r0 []string, r1 error) {
+				return
+			},
+		},
 		ListRefsFunc: &GitBackendListRefsFunc{
 			defaultHook: func(context.Context, ListRefsOpts) (r0 RefIterator, r1 error) {
 				return
 			},
 		},
+		ListTagsFunc: &GitBackendListTagsFunc{
+			defaultHook: func(context.Context, ListTagsOpts) (r0 RefIterator, r1 error) {
+				return
+			},
+		},
 		MergeBaseFunc: &GitBackendMergeBaseFunc{
 			defaultHook: func(context.Context, string, string) (r0 api.CommitID, r1 error) {
 				return
 			},
 		},
+		RangeDiffFunc: &GitBackendRangeDiffFunc{
+			defaultHook: func(context.Context, RangeDiffOpts) (r0 []*gitdomain.RangeDiffPair, r1 error) {
+				return
+			},
+		},
+		ReadBlobFunc: &GitBackendReadBlobFunc{
+			defaultHook: func(context.Context, api.CommitID) (r0 io.ReadCloser, r1 error) {
+				return
+			},
+		},
 		ReadFileFunc: &GitBackendReadFileFunc{
 			defaultHook: func(context.Context, api.CommitID, string) (r0 io.ReadCloser, r1 error) {
 				return
 			},
 		},
+		RefExistsFunc: &GitBackendRefExistsFunc{
+			defaultHook: func(context.Context, string) (r0 bool, r1 error) {
+				return
+			},
+		},
 		ResolveRevisionFunc: &GitBackendResolveRevisionFunc{
 			defaultHook: func(context.Context, string) (r0 api.CommitID, r1 error) {
 				return
@@ -389,38 +833,123 @@ func NewMockGitBackend() *MockGitBackend {
 				return
 			},
 		},
-		SymbolicRefHeadFunc: &GitBackendSymbolicRefHeadFunc{
-			defaultHook: func(context.Context, bool) (r0 string, r1 error) {
+		RunMaintenanceTaskFunc: &GitBackendRunMaintenanceTaskFunc{
+			defaultHook: func(context.Context, MaintenanceTask) (r0 error) {
 				return
 			},
 		},
-	}
-}
+		SparseManifestFunc: &GitBackendSparseManifestFunc{
+			defaultHook: func(context.Context, api.CommitID, []string) (r0 []gitdomain.TreeEntry, r1 error) {
+				return
+			},
+		},
+		SymbolicRefFunc: &GitBackendSymbolicRefFunc{
+			defaultHook: func(context.Context, string) (r0 string, r1 error) {
+				return
+			},
+		},
+		SymbolicRefHeadFunc: &GitBackendSymbolicRefHeadFunc{
+			defaultHook: func(context.Context, bool) (r0 string, r1 error) {
+				return
+			},
+		},
+		UpdateRefFunc: &GitBackendUpdateRefFunc{
+			defaultHook: func(context.Context, string, api.CommitID, api.CommitID) (r0 error) {
+				return
+			},
+		},
+	}
+}
 
 // NewStrictMockGitBackend creates a new mock of the GitBackend interface.
 // All methods panic on invocation, unless overwritten.
 func NewStrictMockGitBackend() *MockGitBackend {
 	return &MockGitBackend{
 		ArchiveReaderFunc: &GitBackendArchiveReaderFunc{
-			defaultHook: func(context.Context, ArchiveFormat, string, []string) (io.ReadCloser, error) {
+			defaultHook: func(context.Context, ArchiveFormat, string, []string, string) (io.ReadCloser, error) {
 				panic("unexpected invocation of MockGitBackend.ArchiveReader")
 			},
 		},
+		BehindAheadFunc: &GitBackendBehindAheadFunc{
+			defaultHook: func(context.Context, string, string) (*gitdomain.BehindAhead, error) {
+				panic("unexpected invocation of MockGitBackend.BehindAhead")
+			},
+		},
 		BlameFunc: &GitBackendBlameFunc{
 			defaultHook: func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error) {
 				panic("unexpected invocation of MockGitBackend.Blame")
 			},
 		},
+		BlobOIDFunc: &GitBackendBlobOIDFunc{
+			defaultHook: func(context.Context, api.CommitID, string) (api.CommitID, error) {
+				panic("unexpected invocation of MockGitBackend.BlobOID")
+			},
+		},
+		CherryFunc: &GitBackendCherryFunc{
+			defaultHook: func(context.Context, string, string) ([]gitdomain.CherryCommit, error) {
+				panic("unexpected invocation of MockGitBackend.Cherry")
+			},
+		},
+		CloneStateFunc: &GitBackendCloneStateFunc{
+			defaultHook: func(context.Context) (gitdomain.CloneState, error) {
+				panic("unexpected invocation of MockGitBackend.CloneState")
+			},
+		},
+		CommitActivityFunc: &GitBackendCommitActivityFunc{
+			defaultHook: func(context.Context, api.CommitID, CommitActivityOpts) ([]gitdomain.CommitActivityBucket, error) {
+				panic("unexpected invocation of MockGitBackend.CommitActivity")
+			},
+		},
+		CommitGraphFunc: &GitBackendCommitGraphFunc{
+			defaultHook: func(context.Context, CommitGraphOpts) (CommitGraphIterator, error) {
+				panic("unexpected invocation of MockGitBackend.CommitGraph")
+			},
+		},
+		CommitGraphStatusFunc: &GitBackendCommitGraphStatusFunc{
+			defaultHook: func(context.Context) (gitdomain.CommitGraphStatus, error) {
+				panic("unexpected invocation of MockGitBackend.CommitGraphStatus")
+			},
+		},
+		CommitPatchFunc: &GitBackendCommitPatchFunc{
+			defaultHook: func(context.Context, api.CommitID) (io.ReadCloser, error) {
+				panic("unexpected invocation of MockGitBackend.CommitPatch")
+			},
+		},
+		CommitStatsFunc: &GitBackendCommitStatsFunc{
+			defaultHook: func(context.Context, api.CommitID) ([]gitdomain.FileDiffStat, error) {
+				panic("unexpected invocation of MockGitBackend.CommitStats")
+			},
+		},
 		ConfigFunc: &GitBackendConfigFunc{
 			defaultHook: func() GitConfigBackend {
 				panic("unexpected invocation of MockGitBackend.Config")
 			},
 		},
+		CreateBundleFunc: &GitBackendCreateBundleFunc{
+			defaultHook: func(context.Context, []string) (io.ReadCloser, error) {
+				panic("unexpected invocation of MockGitBackend.CreateBundle")
+			},
+		},
+		CreateTagFunc: &GitBackendCreateTagFunc{
+			defaultHook: func(context.Context, string, api.CommitID, CreateTagOpts) error {
+				panic("unexpected invocation of MockGitBackend.CreateTag")
+			},
+		},
+		DeleteRefFunc: &GitBackendDeleteRefFunc{
+			defaultHook: func(context.Context, string, api.CommitID) error {
+				panic("unexpected invocation of MockGitBackend.DeleteRef")
+			},
+		},
 		ExecFunc: &GitBackendExecFunc{
 			defaultHook: func(context.Context, ...string) (io.ReadCloser, error) {
 				panic("unexpected invocation of MockGitBackend.Exec")
 			},
 		},
+		FormatPatchFunc: &GitBackendFormatPatchFunc{
+			defaultHook: func(context.Context, string, string) (io.ReadCloser, error) {
+				panic("unexpected invocation of MockGitBackend.FormatPatch")
+			},
+		},
 		GetCommitFunc: &GitBackendGetCommitFunc{
 			defaultHook: func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error) {
 				panic("unexpected invocation of MockGitBackend.GetCommit")
@@ -431,21 +960,61 @@ func NewStrictMockGitBackend() *MockGitBackend {
 				panic("unexpected invocation of MockGitBackend.GetObject")
 			},
 		},
+		GetTagFunc: &GitBackendGetTagFunc{
+			defaultHook: func(context.Context, string) (*gitdomain.Tag, error) {
+				panic("unexpected invocation of MockGitBackend.GetTag")
+			},
+		},
+		GetTreeFunc: &GitBackendGetTreeFunc{
+			defaultHook: func(context.Context, string, string) ([]gitdomain.TreeEntry, error) {
+				panic("unexpected invocation of MockGitBackend.GetTree")
+			},
+		},
+		LastCommitsForTreeFunc: &GitBackendLastCommitsForTreeFunc{
+			defaultHook: func(context.Context, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error) {
+				panic("unexpected invocation of MockGitBackend.LastCommitsForTree")
+			},
+		},
+		ListFilesFunc: &GitBackendListFilesFunc{
+			defaultHook: func(context.Context, api.CommitID, string) ([]string, error) {
+				panic("unexpected invocation of MockGitBackend.ListFiles")
+			},
+		},
 		ListRefsFunc: &GitBackendListRefsFunc{
 			defaultHook: func(context.Context, ListRefsOpts) (RefIterator, error) {
 				panic("unexpected invocation of MockGitBackend.ListRefs")
 			},
 		},
+		ListTagsFunc: &GitBackendListTagsFunc{
+			defaultHook: func(context.Context, ListTagsOpts) (RefIterator, error) {
+				panic("unexpected invocation of MockGitBackend.ListTags")
+			},
+		},
 		MergeBaseFunc: &GitBackendMergeBaseFunc{
 			defaultHook: func(context.Context, string, string) (api.CommitID, error) {
 				panic("unexpected invocation of MockGitBackend.MergeBase")
 			},
 		},
+		RangeDiffFunc: &GitBackendRangeDiffFunc{
+			defaultHook: func(context.Context, RangeDiffOpts) ([]*gitdomain.RangeDiffPair, error) {
+				panic("unexpected invocation of MockGitBackend.RangeDiff")
+			},
+		},
+		ReadBlobFunc: &GitBackendReadBlobFunc{
+			defaultHook: func(context.Context, api.CommitID) (io.ReadCloser, error) {
+				panic("unexpected invocation of MockGitBackend.ReadBlob")
+			},
+		},
 		ReadFileFunc: &GitBackendReadFileFunc{
 			defaultHook: func(context.Context, api.CommitID, string) (io.ReadCloser, error) {
 				panic("unexpected invocation of MockGitBackend.ReadFile")
 			},
 		},
+		RefExistsFunc: &GitBackendRefExistsFunc{
+			defaultHook: func(context.Context, string) (bool, error) {
+				panic("unexpected invocation of MockGitBackend.RefExists")
+			},
+		},
 		ResolveRevisionFunc: &GitBackendResolveRevisionFunc{
 			defaultHook: func(context.Context, string) (api.CommitID, error) {
 				panic("unexpected invocation of MockGitBackend.ResolveRevision")
@@ -461,11 +1030,31 @@ func NewStrictMockGitBackend() *MockGitBackend {
 				panic("unexpected invocation of MockGitBackend.RevParseHead")
 			},
 		},
+		RunMaintenanceTaskFunc: &GitBackendRunMaintenanceTaskFunc{
+			defaultHook: func(context.Context, MaintenanceTask) error {
+				panic("unexpected invocation of MockGitBackend.RunMaintenanceTask")
+			},
+		},
+		SparseManifestFunc: &GitBackendSparseManifestFunc{
+			defaultHook: func(context.Context, api.CommitID, []string) ([]gitdomain.TreeEntry, error) {
+				panic("unexpected invocation of MockGitBackend.SparseManifest")
+			},
+		},
+		SymbolicRefFunc: &GitBackendSymbolicRefFunc{
+			defaultHook: func(context.Context, string) (string, error) {
+				panic("unexpected invocation of MockGitBackend.SymbolicRef")
+			},
+		},
 		SymbolicRefHeadFunc: &GitBackendSymbolicRefHeadFunc{
 			defaultHook: func(context.Context, bool) (string, error) {
 				panic("unexpected invocation of MockGitBackend.SymbolicRefHead")
 			},
 		},
+		UpdateRefFunc: &GitBackendUpdateRefFunc{
+			defaultHook: func(context.Context, string, api.CommitID, api.CommitID) error {
+				panic("unexpected invocation of MockGitBackend.UpdateRef")
+			},
+		},
 	}
 }
 
@@ -476,30 +1065,93 @@ func NewMockGitBackendFrom(i GitBackend) *MockGitBackend {
 		ArchiveReaderFunc: &GitBackendArchiveReaderFunc{
 			defaultHook: i.ArchiveReader,
 		},
+		BehindAheadFunc: &GitBackendBehindAheadFunc{
+			defaultHook: i.BehindAhead,
+		},
 		BlameFunc: &GitBackendBlameFunc{
 			defaultHook: i.Blame,
 		},
+		BlobOIDFunc: &GitBackendBlobOIDFunc{
+			defaultHook: i.BlobOID,
+		},
+		CherryFunc: &GitBackendCherryFunc{
+			defaultHook: i.Cherry,
+		},
+		CloneStateFunc: &GitBackendCloneStateFunc{
+			defaultHook: i.CloneState,
+		},
+		CommitActivityFunc: &GitBackendCommitActivityFunc{
+			defaultHook: i.CommitActivity,
+		},
+		CommitGraphFunc: &GitBackendCommitGraphFunc{
+			defaultHook: i.CommitGraph,
+		},
+		CommitGraphStatusFunc: &GitBackendCommitGraphStatusFunc{
+			defaultHook: i.CommitGraphStatus,
+		},
+		CommitPatchFunc: &GitBackendCommitPatchFunc{
+			defaultHook: i.CommitPatch,
+		},
+		CommitStatsFunc: &GitBackendCommitStatsFunc{
+			defaultHook: i.CommitStats,
+		},
 		ConfigFunc: &GitBackendConfigFunc{
 			defaultHook: i.Config,
 		},
+		CreateBundleFunc: &GitBackendCreateBundleFunc{
+			defaultHook: i.CreateBundle,
+		},
+		CreateTagFunc: &GitBackendCreateTagFunc{
+			defaultHook: i.CreateTag,
+		},
+		DeleteRefFunc: &GitBackendDeleteRefFunc{
+			defaultHook: i.DeleteRef,
+		},
 		ExecFunc: &GitBackendExecFunc{
 			defaultHook: i.Exec,
 		},
+		FormatPatchFunc: &GitBackendFormatPatchFunc{
+			defaultHook: i.FormatPatch,
+		},
 		GetCommitFunc: &GitBackendGetCommitFunc{
 			defaultHook: i.GetCommit,
 		},
 		GetObjectFunc: &GitBackendGetObjectFunc{
 			defaultHook: i.GetObject,
 		},
+		GetTagFunc: &GitBackendGetTagFunc{
+			defaultHook: i.GetTag,
+		},
+		GetTreeFunc: &GitBackendGetTreeFunc{
+			defaultHook: i.GetTree,
+		},
+		LastCommitsForTreeFunc: &GitBackendLastCommitsForTreeFunc{
+			defaultHook: i.LastCommitsForTree,
+		},
+		ListFilesFunc: &GitBackendListFilesFunc{
+			defaultHook: i.ListFiles,
+		},
 		ListRefsFunc: &GitBackendListRefsFunc{
 			defaultHook: i.ListRefs,
 		},
+		ListTagsFunc: &GitBackendListTagsFunc{
+			defaultHook: i.ListTags,
+		},
 		MergeBaseFunc: &GitBackendMergeBaseFunc{
 			defaultHook: i.MergeBase,
 		},
+		RangeDiffFunc: &GitBackendRangeDiffFunc{
+			defaultHook: i.RangeDiff,
+		},
+		ReadBlobFunc: &GitBackendReadBlobFunc{
+			defaultHook: i.ReadBlob,
+		},
 		ReadFileFunc: &GitBackendReadFileFunc{
 			defaultHook: i.ReadFile,
 		},
+		RefExistsFunc: &GitBackendRefExistsFunc{
+			defaultHook: i.RefExists,
+		},
 		ResolveRevisionFunc: &GitBackendResolveRevisionFunc{
 			defaultHook: i.ResolveRevision,
 		},
@@ -509,41 +1161,2679 @@ func NewMockGitBackendFrom(i GitBackend) *MockGitBackend {
 		RevParseHeadFunc: &GitBackendRevParseHeadFunc{
 			defaultHook: i.RevParseHead,
 		},
+		RunMaintenanceTaskFunc: &GitBackendRunMaintenanceTaskFunc{
+			defaultHook: i.RunMaintenanceTask,
+		},
+		SparseManifestFunc: &GitBackendSparseManifestFunc{
+			defaultHook: i.SparseManifest,
+		},
+		SymbolicRefFunc: &GitBackendSymbolicRefFunc{
+			defaultHook: i.SymbolicRef,
+		},
 		SymbolicRefHeadFunc: &GitBackendSymbolicRefHeadFunc{
 			defaultHook: i.SymbolicRefHead,
 		},
+		UpdateRefFunc: &GitBackendUpdateRefFunc{
+			defaultHook: i.UpdateRef,
+		},
+	}
+}
+
+// GitBackendArchiveReaderFunc describes the behavior when the ArchiveReader
+// method of the parent MockGitBackend instance is invoked.
+type GitBackendArchiveReaderFunc struct {
+	defaultHook func(context.Context, ArchiveFormat, string, []string, string) (io.ReadCloser, error)
+	hooks       []func(context.Context, ArchiveFormat, string, []string, string) (io.ReadCloser, error)
+	history     []GitBackendArchiveReaderFuncCall
+	mutex       sync.Mutex
+}
+
+// ArchiveReader delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitBackend) ArchiveReader(v0 context.Context, v1 ArchiveFormat, v2 string, v3 []string, v4 string) (io.ReadCloser, error) {
+	r0, r1 := m.ArchiveReaderFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.ArchiveReaderFunc.appendCall(GitBackendArchiveReaderFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ArchiveReader method
+// of the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendArchiveReaderFunc) SetDefaultHook(hook func(context.Context, ArchiveFormat, string, []string, string) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ArchiveReader method of the parent MockGitBackend instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitBackendArchiveReaderFunc) PushHook(hook func(context.Context, ArchiveFormat, string, []string, string) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendArchiveReaderFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, ArchiveFormat, string, []string, string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendArchiveReaderFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, ArchiveFormat, string, []string, string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendArchiveReaderFunc) nextHook() func(context.Context, ArchiveFormat, string, []string, string) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendArchiveReaderFunc) appendCall(r0 GitBackendArchiveReaderFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendArchiveReaderFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendArchiveReaderFunc) History() []GitBackendArchiveReaderFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendArchiveReaderFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendArchiveReaderFuncCall is an object that describes an invocation
+// of method ArchiveReader on an instance of MockGitBackend.
+type GitBackendArchiveReaderFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ArchiveFormat
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendArchiveReaderFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendArchiveReaderFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendBehindAheadFunc describes the behavior when the BehindAhead
+// method of the parent MockGitBackend instance is invoked.
+type GitBackendBehindAheadFunc struct {
+	defaultHook func(context.Context, string, string) (*gitdomain.BehindAhead, error)
+	hooks       []func(context.Context, string, string) (*gitdomain.BehindAhead, error)
+	history     []GitBackendBehindAheadFuncCall
+	mutex       sync.Mutex
+}
+
+// BehindAhead delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitBackend) BehindAhead(v0 context.Context, v1 string, v2 string) (*gitdomain.BehindAhead, error) {
+	r0, r1 := m.BehindAheadFunc.nextHook()(v0, v1, v2)
+	m.BehindAheadFunc.appendCall(GitBackendBehindAheadFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the BehindAhead method
+// of the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendBehindAheadFunc) SetDefaultHook(hook func(context.Context, string, string) (*gitdomain.BehindAhead, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// BehindAhead method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendBehindAheadFunc) PushHook(hook func(context.Context, string, string) (*gitdomain.BehindAhead, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendBehindAheadFunc) SetDefaultReturn(r0 *gitdomain.BehindAhead, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) (*gitdomain.BehindAhead, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendBehindAheadFunc) PushReturn(r0 *gitdomain.BehindAhead, r1 error) {
+	f.PushHook(func(context.Context, string, string) (*gitdomain.BehindAhead, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendBehindAheadFunc) nextHook() func(context.Context, string, string) (*gitdomain.BehindAhead, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
 	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendBehindAheadFunc) appendCall(r0 GitBackendBehindAheadFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendBehindAheadFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendBehindAheadFunc) History() []GitBackendBehindAheadFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendBehindAheadFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendBehindAheadFuncCall is an object that describes an invocation
+// of method BehindAhead on an instance of MockGitBackend.
+type GitBackendBehindAheadFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitdomain.BehindAhead
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendBehindAheadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendBehindAheadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendBlameFunc describes the behavior when the Blame method of the
+// parent MockGitBackend instance is invoked.
+type GitBackendBlameFunc struct {
+	defaultHook func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error)
+	hooks       []func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error)
+	history     []GitBackendBlameFuncCall
+	mutex       sync.Mutex
+}
+
+// Blame delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) Blame(v0 context.Context, v1 api.CommitID, v2 string, v3 BlameOptions) (BlameHunkReader, error) {
+	r0, r1 := m.BlameFunc.nextHook()(v0, v1, v2, v3)
+	m.BlameFunc.appendCall(GitBackendBlameFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Blame method of the
+// parent MockGitBackend instance is invoked and the hook queue is empty.
+func (f *GitBackendBlameFunc) SetDefaultHook(hook func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Blame method of the parent MockGitBackend instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendBlameFunc) PushHook(hook func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendBlameFunc) SetDefaultReturn(r0 BlameHunkReader, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendBlameFunc) PushReturn(r0 BlameHunkReader, r1 error) {
+	f.PushHook(func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendBlameFunc) nextHook() func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendBlameFunc) appendCall(r0 GitBackendBlameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendBlameFuncCall objects describing
+// the invocations of this function.
+func (f *GitBackendBlameFunc) History() []GitBackendBlameFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendBlameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendBlameFuncCall is an object that describes an invocation of
+// method Blame on an instance of MockGitBackend.
+type GitBackendBlameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.CommitID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 BlameOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 BlameHunkReader
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendBlameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendBlameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendBlobOIDFunc describes the behavior when the BlobOID method of
+// the parent MockGitBackend instance is invoked.
+type GitBackendBlobOIDFunc struct {
+	defaultHook func(context.Context, api.CommitID, string) (api.CommitID, error)
+	hooks       []func(context.Context, api.CommitID, string) (api.CommitID, error)
+	history     []GitBackendBlobOIDFuncCall
+	mutex       sync.Mutex
+}
+
+// BlobOID delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) BlobOID(v0 context.Context, v1 api.CommitID, v2 string) (api.CommitID, error) {
+	r0, r1 := m.BlobOIDFunc.nextHook()(v0, v1, v2)
+	m.BlobOIDFunc.appendCall(GitBackendBlobOIDFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the BlobOID method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendBlobOIDFunc) SetDefaultHook(hook func(context.Context, api.CommitID, string) (api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// BlobOID method of the parent MockGitBackend instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendBlobOIDFunc) PushHook(hook func(context.Context, api.CommitID, string) (api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendBlobOIDFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.CommitID, string) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendBlobOIDFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.CommitID, string) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendBlobOIDFunc) nextHook() func(context.Context, api.CommitID, string) (api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendBlobOIDFunc) appendCall(r0 GitBackendBlobOIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendBlobOIDFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendBlobOIDFunc) History() []GitBackendBlobOIDFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendBlobOIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendBlobOIDFuncCall is an object that describes an invocation of
+// method BlobOID on an instance of MockGitBackend.
+type GitBackendBlobOIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.CommitID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendBlobOIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendBlobOIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendCherryFunc describes the behavior when the Cherry method of the
+// parent MockGitBackend instance is invoked.
+type GitBackendCherryFunc struct {
+	defaultHook func(context.Context, string, string) ([]gitdomain.CherryCommit, error)
+	hooks       []func(context.Context, string, string) ([]gitdomain.CherryCommit, error)
+	history     []GitBackendCherryFuncCall
+	mutex       sync.Mutex
+}
+
+// Cherry delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) Cherry(v0 context.Context, v1 string, v2 string) ([]gitdomain.CherryCommit, error) {
+	r0, r1 := m.CherryFunc.nextHook()(v0, v1, v2)
+	m.CherryFunc.appendCall(GitBackendCherryFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Cherry method of the
+// parent MockGitBackend instance is invoked and the hook queue is empty.
+func (f *GitBackendCherryFunc) SetDefaultHook(hook func(context.Context, string, string) ([]gitdomain.CherryCommit, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Cherry method of the parent MockGitBackend instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendCherryFunc) PushHook(hook func(context.Context, string, string) ([]gitdomain.CherryCommit, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendCherryFunc) SetDefaultReturn(r0 []gitdomain.CherryCommit, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) ([]gitdomain.CherryCommit, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendCherryFunc) PushReturn(r0 []gitdomain.CherryCommit, r1 error) {
+	f.PushHook(func(context.Context, string, string) ([]gitdomain.CherryCommit, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendCherryFunc) nextHook() func(context.Context, string, string) ([]gitdomain.CherryCommit, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendCherryFunc) appendCall(r0 GitBackendCherryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendCherryFuncCall objects describing
+// the invocations of this function.
+func (f *GitBackendCherryFunc) History() []GitBackendCherryFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendCherryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendCherryFuncCall is an object that describes an invocation of
+// method Cherry on an instance of MockGitBackend.
+type GitBackendCherryFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gitdomain.CherryCommit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendCherryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendCherryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendCloneStateFunc describes the behavior when the CloneState
+// method of the parent MockGitBackend instance is invoked.
+type GitBackendCloneStateFunc struct {
+	defaultHook func(context.Context) (gitdomain.CloneState, error)
+	hooks       []func(context.Context) (gitdomain.CloneState, error)
+	history     []GitBackendCloneStateFuncCall
+	mutex       sync.Mutex
+}
+
+// CloneState delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitBackend) CloneState(v0 context.Context) (gitdomain.CloneState, error) {
+	r0, r1 := m.CloneStateFunc.nextHook()(v0)
+	m.CloneStateFunc.appendCall(GitBackendCloneStateFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CloneState method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendCloneStateFunc) SetDefaultHook(hook func(context.Context) (gitdomain.CloneState, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CloneState method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendCloneStateFunc) PushHook(hook func(context.Context) (gitdomain.CloneState, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendCloneStateFunc) SetDefaultReturn(r0 gitdomain.CloneState, r1 error) {
+	f.SetDefaultHook(func(context.Context) (gitdomain.CloneState, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendCloneStateFunc) PushReturn(r0 gitdomain.CloneState, r1 error) {
+	f.PushHook(func(context.Context) (gitdomain.CloneState, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendCloneStateFunc) nextHook() func(context.Context) (gitdomain.CloneState, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendCloneStateFunc) appendCall(r0 GitBackendCloneStateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendCloneStateFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendCloneStateFunc) History() []GitBackendCloneStateFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendCloneStateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendCloneStateFuncCall is an object that describes an invocation of
+// method CloneState on an instance of MockGitBackend.
+type GitBackendCloneStateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gitdomain.CloneState
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendCloneStateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendCloneStateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendCommitActivityFunc describes the behavior when the
+// CommitActivity method of the parent MockGitBackend instance is invoked.
+type GitBackendCommitActivityFunc struct {
+	defaultHook func(context.Context, api.CommitID, CommitActivityOpts) ([]gitdomain.CommitActivityBucket, error)
+	hooks       []func(context.Context, api.CommitID, CommitActivityOpts) ([]gitdomain.CommitActivityBucket, error)
+	history     []GitBackendCommitActivityFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitActivity delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitBackend) CommitActivity(v0 context.Context, v1 api.CommitID, v2 CommitActivityOpts) ([]gitdomain.CommitActivityBucket, error) {
+	r0, r1 := m.CommitActivityFunc.nextHook()(v0, v1, v2)
+	m.CommitActivityFunc.appendCall(GitBackendCommitActivityFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitActivity
+// method of the parent MockGitBackend instance is invoked and the hook
+// queue is empty.
+func (f *GitBackendCommitActivityFunc) SetDefaultHook(hook func(context.Context, api.CommitID, CommitActivityOpts) ([]gitdomain.CommitActivityBucket, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitActivity method of the parent MockGitBackend instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitBackendCommitActivityFunc) PushHook(hook func(context.Context, api.CommitID, CommitActivityOpts) ([]gitdomain.CommitActivityBucket, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendCommitActivityFunc) SetDefaultReturn(r0 []gitdomain.CommitActivityBucket, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.CommitID, CommitActivityOpts) ([]gitdomain.CommitActivityBucket, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendCommitActivityFunc) PushReturn(r0 []gitdomain.CommitActivityBucket, r1 error) {
+	f.PushHook(func(context.Context, api.CommitID, CommitActivityOpts) ([]gitdomain.CommitActivityBucket, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendCommitActivityFunc) nextHook() func(context.Context, api.CommitID, CommitActivityOpts) ([]gitdomain.CommitActivityBucket, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendCommitActivityFunc) appendCall(r0 GitBackendCommitActivityFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendCommitActivityFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendCommitActivityFunc) History() []GitBackendCommitActivityFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendCommitActivityFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendCommitActivityFuncCall is an object that describes an
+// invocation of method CommitActivity on an instance of MockGitBackend.
+type GitBackendCommitActivityFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.CommitID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 CommitActivityOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gitdomain.CommitActivityBucket
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendCommitActivityFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendCommitActivityFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendCommitGraphFunc describes the behavior when the CommitGraph
+// method of the parent MockGitBackend instance is invoked.
+type GitBackendCommitGraphFunc struct {
+	defaultHook func(context.Context, CommitGraphOpts) (CommitGraphIterator, error)
+	hooks       []func(context.Context, CommitGraphOpts) (CommitGraphIterator, error)
+	history     []GitBackendCommitGraphFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitGraph delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitBackend) CommitGraph(v0 context.Context, v1 CommitGraphOpts) (CommitGraphIterator, error) {
+	r0, r1 := m.CommitGraphFunc.nextHook()(v0, v1)
+	m.CommitGraphFunc.appendCall(GitBackendCommitGraphFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitGraph method
+// of the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendCommitGraphFunc) SetDefaultHook(hook func(context.Context, CommitGraphOpts) (CommitGraphIterator, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitGraph method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendCommitGraphFunc) PushHook(hook func(context.Context, CommitGraphOpts) (CommitGraphIterator, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendCommitGraphFunc) SetDefaultReturn(r0 CommitGraphIterator, r1 error) {
+	f.SetDefaultHook(func(context.Context, CommitGraphOpts) (CommitGraphIterator, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendCommitGraphFunc) PushReturn(r0 CommitGraphIterator, r1 error) {
+	f.PushHook(func(context.Context, CommitGraphOpts) (CommitGraphIterator, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendCommitGraphFunc) nextHook() func(context.Context, CommitGraphOpts) (CommitGraphIterator, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendCommitGraphFunc) appendCall(r0 GitBackendCommitGraphFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendCommitGraphFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendCommitGraphFunc) History() []GitBackendCommitGraphFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendCommitGraphFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendCommitGraphFuncCall is an object that describes an invocation
+// of method CommitGraph on an instance of MockGitBackend.
+type GitBackendCommitGraphFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 CommitGraphOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 CommitGraphIterator
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendCommitGraphFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendCommitGraphFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendCommitGraphStatusFunc describes the behavior when the
+// CommitGraphStatus method of the parent MockGitBackend instance is
+// invoked.
+type GitBackendCommitGraphStatusFunc struct {
+	defaultHook func(context.Context) (gitdomain.CommitGraphStatus, error)
+	hooks       []func(context.Context) (gitdomain.CommitGraphStatus, error)
+	history     []GitBackendCommitGraphStatusFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitGraphStatus delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitBackend) CommitGraphStatus(v0 context.Context) (gitdomain.CommitGraphStatus, error) {
+	r0, r1 := m.CommitGraphStatusFunc.nextHook()(v0)
+	m.CommitGraphStatusFunc.appendCall(GitBackendCommitGraphStatusFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitGraphStatus
+// method of the parent MockGitBackend instance is invoked and the hook
+// queue is empty.
+func (f *GitBackendCommitGraphStatusFunc) SetDefaultHook(hook func(context.Context) (gitdomain.CommitGraphStatus, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitGraphStatus method of the parent MockGitBackend instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitBackendCommitGraphStatusFunc) PushHook(hook func(context.Context) (gitdomain.CommitGraphStatus, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendCommitGraphStatusFunc) SetDefaultReturn(r0 gitdomain.CommitGraphStatus, r1 error) {
+	f.SetDefaultHook(func(context.Context) (gitdomain.CommitGraphStatus, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendCommitGraphStatusFunc) PushReturn(r0 gitdomain.CommitGraphStatus, r1 error) {
+	f.PushHook(func(context.Context) (gitdomain.CommitGraphStatus, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendCommitGraphStatusFunc) nextHook() func(context.Context) (gitdomain.CommitGraphStatus, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendCommitGraphStatusFunc) appendCall(r0 GitBackendCommitGraphStatusFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendCommitGraphStatusFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendCommitGraphStatusFunc) History() []GitBackendCommitGraphStatusFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendCommitGraphStatusFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendCommitGraphStatusFuncCall is an object that describes an
+// invocation of method CommitGraphStatus on an instance of MockGitBackend.
+type GitBackendCommitGraphStatusFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gitdomain.CommitGraphStatus
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendCommitGraphStatusFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendCommitGraphStatusFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendCommitPatchFunc describes the behavior when the CommitPatch
+// method of the parent MockGitBackend instance is invoked.
+type GitBackendCommitPatchFunc struct {
+	defaultHook func(context.Context, api.CommitID) (io.ReadCloser, error)
+	hooks       []func(context.Context, api.CommitID) (io.ReadCloser, error)
+	history     []GitBackendCommitPatchFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitPatch delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitBackend) CommitPatch(v0 context.Context, v1 api.CommitID) (io.ReadCloser, error) {
+	r0, r1 := m.CommitPatchFunc.nextHook()(v0, v1)
+	m.CommitPatchFunc.appendCall(GitBackendCommitPatchFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitPatch method
+// of the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendCommitPatchFunc) SetDefaultHook(hook func(context.Context, api.CommitID) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitPatch method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendCommitPatchFunc) PushHook(hook func(context.Context, api.CommitID) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendCommitPatchFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.CommitID) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendCommitPatchFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, api.CommitID) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendCommitPatchFunc) nextHook() func(context.Context, api.CommitID) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendCommitPatchFunc) appendCall(r0 GitBackendCommitPatchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendCommitPatchFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendCommitPatchFunc) History() []GitBackendCommitPatchFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendCommitPatchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendCommitPatchFuncCall is an object that describes an invocation
+// of method CommitPatch on an instance of MockGitBackend.
+type GitBackendCommitPatchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendCommitPatchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendCommitPatchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendCommitStatsFunc describes the behavior when the CommitStats
+// method of the parent MockGitBackend instance is invoked.
+type GitBackendCommitStatsFunc struct {
+	defaultHook func(context.Context, api.CommitID) ([]gitdomain.FileDiffStat, error)
+	hooks       []func(context.Context, api.CommitID) ([]gitdomain.FileDiffStat, error)
+	history     []GitBackendCommitStatsFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitStats delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitBackend) CommitStats(v0 context.Context, v1 api.CommitID) ([]gitdomain.FileDiffStat, error) {
+	r0, r1 := m.CommitStatsFunc.nextHook()(v0, v1)
+	m.CommitStatsFunc.appendCall(GitBackendCommitStatsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitStats method
+// of the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendCommitStatsFunc) SetDefaultHook(hook func(context.Context, api.CommitID) ([]gitdomain.FileDiffStat, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitStats method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendCommitStatsFunc) PushHook(hook func(context.Context, api.CommitID) ([]gitdomain.FileDiffStat, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendCommitStatsFunc) SetDefaultReturn(r0 []gitdomain.FileDiffStat, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.CommitID) ([]gitdomain.FileDiffStat, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendCommitStatsFunc) PushReturn(r0 []gitdomain.FileDiffStat, r1 error) {
+	f.PushHook(func(context.Context, api.CommitID) ([]gitdomain.FileDiffStat, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendCommitStatsFunc) nextHook() func(context.Context, api.CommitID) ([]gitdomain.FileDiffStat, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendCommitStatsFunc) appendCall(r0 GitBackendCommitStatsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendCommitStatsFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendCommitStatsFunc) History() []GitBackendCommitStatsFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendCommitStatsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendCommitStatsFuncCall is an object that describes an invocation
+// of method CommitStats on an instance of MockGitBackend.
+type GitBackendCommitStatsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gitdomain.FileDiffStat
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendCommitStatsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendCommitStatsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendConfigFunc describes the behavior when the Config method of the
+// parent MockGitBackend instance is invoked.
+type GitBackendConfigFunc struct {
+	defaultHook func() GitConfigBackend
+	hooks       []func() GitConfigBackend
+	history     []GitBackendConfigFuncCall
+	mutex       sync.Mutex
+}
+
+// Config delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) Config() GitConfigBackend {
+	r0 := m.ConfigFunc.nextHook()()
+	m.ConfigFunc.appendCall(GitBackendConfigFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Config method of the
+// parent MockGitBackend instance is invoked and the hook queue is empty.
+func (f *GitBackendConfigFunc) SetDefaultHook(hook func() GitConfigBackend) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Config method of the parent MockGitBackend instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendConfigFunc) PushHook(hook func() GitConfigBackend) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendConfigFunc) SetDefaultReturn(r0 GitConfigBackend) {
+	f.SetDefaultHook(func() GitConfigBackend {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendConfigFunc) PushReturn(r0 GitConfigBackend) {
+	f.PushHook(func() GitConfigBackend {
+		return r0
+	})
+}
+
+func (f *GitBackendConfigFunc) nextHook() func() GitConfigBackend {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendConfigFunc) appendCall(r0 GitBackendConfigFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendConfigFuncCall objects describing
+// the invocations of this function.
+func (f *GitBackendConfigFunc) History() []GitBackendConfigFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendConfigFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendConfigFuncCall is an object that describes an invocation of
+// method Config on an instance of MockGitBackend.
+type GitBackendConfigFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 GitConfigBackend
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendConfigFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendConfigFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitBackendCreateBundleFunc describes the behavior when the CreateBundle
+// method of the parent MockGitBackend instance is invoked.
+type GitBackendCreateBundleFunc struct {
+	defaultHook func(context.Context, []string) (io.ReadCloser, error)
+	hooks       []func(context.Context, []string) (io.ReadCloser, error)
+	history     []GitBackendCreateBundleFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateBundle delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitBackend) CreateBundle(v0 context.Context, v1 []string) (io.ReadCloser, error) {
+	r0, r1 := m.CreateBundleFunc.nextHook()(v0, v1)
+	m.CreateBundleFunc.appendCall(GitBackendCreateBundleFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreateBundle method
+// of the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendCreateBundleFunc) SetDefaultHook(hook func(context.Context, []string) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateBundle method of the parent MockGitBackend instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitBackendCreateBundleFunc) PushHook(hook func(context.Context, []string) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendCreateBundleFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, []string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendCreateBundleFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, []string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendCreateBundleFunc) nextHook() func(context.Context, []string) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendCreateBundleFunc) appendCall(r0 GitBackendCreateBundleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendCreateBundleFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendCreateBundleFunc) History() []GitBackendCreateBundleFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendCreateBundleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendCreateBundleFuncCall is an object that describes an invocation
+// of method CreateBundle on an instance of MockGitBackend.
+type GitBackendCreateBundleFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendCreateBundleFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendCreateBundleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendCreateTagFunc describes the behavior when the CreateTag method
+// of the parent MockGitBackend instance is invoked.
+type GitBackendCreateTagFunc struct {
+	defaultHook func(context.Context, string, api.CommitID, CreateTagOpts) error
+	hooks       []func(context.Context, string, api.CommitID, CreateTagOpts) error
+	history     []GitBackendCreateTagFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateTag delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) CreateTag(v0 context.Context, v1 string, v2 api.CommitID, v3 CreateTagOpts) error {
+	r0 := m.CreateTagFunc.nextHook()(v0, v1, v2, v3)
+	m.CreateTagFunc.appendCall(GitBackendCreateTagFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the CreateTag method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendCreateTagFunc) SetDefaultHook(hook func(context.Context, string, api.CommitID, CreateTagOpts) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateTag method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendCreateTagFunc) PushHook(hook func(context.Context, string, api.CommitID, CreateTagOpts) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendCreateTagFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, api.CommitID, CreateTagOpts) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendCreateTagFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, api.CommitID, CreateTagOpts) error {
+		return r0
+	})
+}
+
+func (f *GitBackendCreateTagFunc) nextHook() func(context.Context, string, api.CommitID, CreateTagOpts) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendCreateTagFunc) appendCall(r0 GitBackendCreateTagFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendCreateTagFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendCreateTagFunc) History() []GitBackendCreateTagFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendCreateTagFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendCreateTagFuncCall is an object that describes an invocation of
+// method CreateTag on an instance of MockGitBackend.
+type GitBackendCreateTagFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 CreateTagOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendCreateTagFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendCreateTagFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitBackendDeleteRefFunc describes the behavior when the DeleteRef method
+// of the parent MockGitBackend instance is invoked.
+type GitBackendDeleteRefFunc struct {
+	defaultHook func(context.Context, string, api.CommitID) error
+	hooks       []func(context.Context, string, api.CommitID) error
+	history     []GitBackendDeleteRefFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteRef delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) DeleteRef(v0 context.Context, v1 string, v2 api.CommitID) error {
+	r0 := m.DeleteRefFunc.nextHook()(v0, v1, v2)
+	m.DeleteRefFunc.appendCall(GitBackendDeleteRefFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteRef method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendDeleteRefFunc) SetDefaultHook(hook func(context.Context, string, api.CommitID) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteRef method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendDeleteRefFunc) PushHook(hook func(context.Context, string, api.CommitID) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendDeleteRefFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, api.CommitID) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendDeleteRefFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, api.CommitID) error {
+		return r0
+	})
+}
+
+func (f *GitBackendDeleteRefFunc) nextHook() func(context.Context, string, api.CommitID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendDeleteRefFunc) appendCall(r0 GitBackendDeleteRefFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendDeleteRefFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendDeleteRefFunc) History() []GitBackendDeleteRefFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendDeleteRefFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendDeleteRefFuncCall is an object that describes an invocation of
+// method DeleteRef on an instance of MockGitBackend.
+type GitBackendDeleteRefFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendDeleteRefFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendDeleteRefFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GitBackendExecFunc describes the behavior when the Exec method of the
+// parent MockGitBackend instance is invoked.
+type GitBackendExecFunc struct {
+	defaultHook func(context.Context, ...string) (io.ReadCloser, error)
+	hooks       []func(context.Context, ...string) (io.ReadCloser, error)
+	history     []GitBackendExecFuncCall
+	mutex       sync.Mutex
+}
+
+// Exec delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) Exec(v0 context.Context, v1 ...string) (io.ReadCloser, error) {
+	r0, r1 := m.ExecFunc.nextHook()(v0, v1...)
+	m.ExecFunc.appendCall(GitBackendExecFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Exec method of the
+// parent MockGitBackend instance is invoked and the hook queue is empty.
+func (f *GitBackendExecFunc) SetDefaultHook(hook func(context.Context, ...string) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Exec method of the parent MockGitBackend instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *GitBackendExecFunc) PushHook(hook func(context.Context, ...string) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendExecFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendExecFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, ...string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendExecFunc) nextHook() func(context.Context, ...string) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendExecFunc) appendCall(r0 GitBackendExecFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendExecFuncCall objects describing
+// the invocations of this function.
+func (f *GitBackendExecFunc) History() []GitBackendExecFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendExecFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendExecFuncCall is an object that describes an invocation of
+// method Exec on an instance of MockGitBackend.
+type GitBackendExecFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitBackendExecFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendExecFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendFormatPatchFunc describes the behavior when the FormatPatch
+// method of the parent MockGitBackend instance is invoked.
+type GitBackendFormatPatchFunc struct {
+	defaultHook func(context.Context, string, string) (io.ReadCloser, error)
+	hooks       []func(context.Context, string, string) (io.ReadCloser, error)
+	history     []GitBackendFormatPatchFuncCall
+	mutex       sync.Mutex
+}
+
+// FormatPatch delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitBackend) FormatPatch(v0 context.Context, v1 string, v2 string) (io.ReadCloser, error) {
+	r0, r1 := m.FormatPatchFunc.nextHook()(v0, v1, v2)
+	m.FormatPatchFunc.appendCall(GitBackendFormatPatchFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FormatPatch method
+// of the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendFormatPatchFunc) SetDefaultHook(hook func(context.Context, string, string) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FormatPatch method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendFormatPatchFunc) PushHook(hook func(context.Context, string, string) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendFormatPatchFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendFormatPatchFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, string, string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendFormatPatchFunc) nextHook() func(context.Context, string, string) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendFormatPatchFunc) appendCall(r0 GitBackendFormatPatchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendFormatPatchFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendFormatPatchFunc) History() []GitBackendFormatPatchFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendFormatPatchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendFormatPatchFuncCall is an object that describes an invocation
+// of method FormatPatch on an instance of MockGitBackend.
+type GitBackendFormatPatchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendFormatPatchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendFormatPatchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendGetCommitFunc describes the behavior when the GetCommit method
+// of the parent MockGitBackend instance is invoked.
+type GitBackendGetCommitFunc struct {
+	defaultHook func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error)
+	hooks       []func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error)
+	history     []GitBackendGetCommitFuncCall
+	mutex       sync.Mutex
+}
+
+// GetCommit delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) GetCommit(v0 context.Context, v1 api.CommitID, v2 bool) (*GitCommitWithFiles, error) {
+	r0, r1 := m.GetCommitFunc.nextHook()(v0, v1, v2)
+	m.GetCommitFunc.appendCall(GitBackendGetCommitFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetCommit method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendGetCommitFunc) SetDefaultHook(hook func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetCommit method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendGetCommitFunc) PushHook(hook func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendGetCommitFunc) SetDefaultReturn(r0 *GitCommitWithFiles, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendGetCommitFunc) PushReturn(r0 *GitCommitWithFiles, r1 error) {
+	f.PushHook(func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendGetCommitFunc) nextHook() func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendGetCommitFunc) appendCall(r0 GitBackendGetCommitFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendGetCommitFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendGetCommitFunc) History() []GitBackendGetCommitFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendGetCommitFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendGetCommitFuncCall is an object that describes an invocation of
+// method GetCommit on an instance of MockGitBackend.
+type GitBackendGetCommitFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.CommitID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *GitCommitWithFiles
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendGetCommitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendGetCommitFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendGetObjectFunc describes the behavior when the GetObject method
+// of the parent MockGitBackend instance is invoked.
+type GitBackendGetObjectFunc struct {
+	defaultHook func(context.Context, string) (*gitdomain.GitObject, error)
+	hooks       []func(context.Context, string) (*gitdomain.GitObject, error)
+	history     []GitBackendGetObjectFuncCall
+	mutex       sync.Mutex
+}
+
+// GetObject delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) GetObject(v0 context.Context, v1 string) (*gitdomain.GitObject, error) {
+	r0, r1 := m.GetObjectFunc.nextHook()(v0, v1)
+	m.GetObjectFunc.appendCall(GitBackendGetObjectFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetObject method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendGetObjectFunc) SetDefaultHook(hook func(context.Context, string) (*gitdomain.GitObject, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetObject method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendGetObjectFunc) PushHook(hook func(context.Context, string) (*gitdomain.GitObject, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendGetObjectFunc) SetDefaultReturn(r0 *gitdomain.GitObject, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*gitdomain.GitObject, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendGetObjectFunc) PushReturn(r0 *gitdomain.GitObject, r1 error) {
+	f.PushHook(func(context.Context, string) (*gitdomain.GitObject, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendGetObjectFunc) nextHook() func(context.Context, string) (*gitdomain.GitObject, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendGetObjectFunc) appendCall(r0 GitBackendGetObjectFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendGetObjectFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendGetObjectFunc) History() []GitBackendGetObjectFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendGetObjectFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendGetObjectFuncCall is an object that describes an invocation of
+// method GetObject on an instance of MockGitBackend.
+type GitBackendGetObjectFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitdomain.GitObject
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendGetObjectFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendGetObjectFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendGetTagFunc describes the behavior when the GetTag method of the
+// parent MockGitBackend instance is invoked.
+type GitBackendGetTagFunc struct {
+	defaultHook func(context.Context, string) (*gitdomain.Tag, error)
+	hooks       []func(context.Context, string) (*gitdomain.Tag, error)
+	history     []GitBackendGetTagFuncCall
+	mutex       sync.Mutex
+}
+
+// GetTag delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) GetTag(v0 context.Context, v1 string) (*gitdomain.Tag, error) {
+	r0, r1 := m.GetTagFunc.nextHook()(v0, v1)
+	m.GetTagFunc.appendCall(GitBackendGetTagFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetTag method of the
+// parent MockGitBackend instance is invoked and the hook queue is empty.
+func (f *GitBackendGetTagFunc) SetDefaultHook(hook func(context.Context, string) (*gitdomain.Tag, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetTag method of the parent MockGitBackend instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendGetTagFunc) PushHook(hook func(context.Context, string) (*gitdomain.Tag, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendGetTagFunc) SetDefaultReturn(r0 *gitdomain.Tag, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*gitdomain.Tag, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendGetTagFunc) PushReturn(r0 *gitdomain.Tag, r1 error) {
+	f.PushHook(func(context.Context, string) (*gitdomain.Tag, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendGetTagFunc) nextHook() func(context.Context, string) (*gitdomain.Tag, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendGetTagFunc) appendCall(r0 GitBackendGetTagFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendGetTagFuncCall objects describing
+// the invocations of this function.
+func (f *GitBackendGetTagFunc) History() []GitBackendGetTagFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendGetTagFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendGetTagFuncCall is an object that describes an invocation of
+// method GetTag on an instance of MockGitBackend.
+type GitBackendGetTagFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitdomain.Tag
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendGetTagFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendGetTagFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendGetTreeFunc describes the behavior when the GetTree method of
+// the parent MockGitBackend instance is invoked.
+type GitBackendGetTreeFunc struct {
+	defaultHook func(context.Context, string, string) ([]gitdomain.TreeEntry, error)
+	hooks       []func(context.Context, string, string) ([]gitdomain.TreeEntry, error)
+	history     []GitBackendGetTreeFuncCall
+	mutex       sync.Mutex
+}
+
+// GetTree delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) GetTree(v0 context.Context, v1 string, v2 string) ([]gitdomain.TreeEntry, error) {
+	r0, r1 := m.GetTreeFunc.nextHook()(v0, v1, v2)
+	m.GetTreeFunc.appendCall(GitBackendGetTreeFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetTree method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendGetTreeFunc) SetDefaultHook(hook func(context.Context, string, string) ([]gitdomain.TreeEntry, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetTree method of the parent MockGitBackend instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendGetTreeFunc) PushHook(hook func(context.Context, string, string) ([]gitdomain.TreeEntry, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendGetTreeFunc) SetDefaultReturn(r0 []gitdomain.TreeEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) ([]gitdomain.TreeEntry, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendGetTreeFunc) PushReturn(r0 []gitdomain.TreeEntry, r1 error) {
+	f.PushHook(func(context.Context, string, string) ([]gitdomain.TreeEntry, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendGetTreeFunc) nextHook() func(context.Context, string, string) ([]gitdomain.TreeEntry, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendGetTreeFunc) appendCall(r0 GitBackendGetTreeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendGetTreeFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendGetTreeFunc) History() []GitBackendGetTreeFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendGetTreeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendGetTreeFuncCall is an object that describes an invocation of
+// method GetTree on an instance of MockGitBackend.
+type GitBackendGetTreeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gitdomain.TreeEntry
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendGetTreeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendGetTreeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendLastCommitsForTreeFunc describes the behavior when the
+// LastCommitsForTree method of the parent MockGitBackend instance is
+// invoked.
+type GitBackendLastCommitsForTreeFunc struct {
+	defaultHook func(context.Context, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error)
+	hooks       []func(context.Context, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error)
+	history     []GitBackendLastCommitsForTreeFuncCall
+	mutex       sync.Mutex
+}
+
+// LastCommitsForTree delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitBackend) LastCommitsForTree(v0 context.Context, v1 api.CommitID, v2 string) ([]gitdomain.TreeEntryCommit, error) {
+	r0, r1 := m.LastCommitsForTreeFunc.nextHook()(v0, v1, v2)
+	m.LastCommitsForTreeFunc.appendCall(GitBackendLastCommitsForTreeFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the LastCommitsForTree
+// method of the parent MockGitBackend instance is invoked and the hook
+// queue is empty.
+func (f *GitBackendLastCommitsForTreeFunc) SetDefaultHook(hook func(context.Context, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// LastCommitsForTree method of the parent MockGitBackend instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitBackendLastCommitsForTreeFunc) PushHook(hook func(context.Context, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendLastCommitsForTreeFunc) SetDefaultReturn(r0 []gitdomain.TreeEntryCommit, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendLastCommitsForTreeFunc) PushReturn(r0 []gitdomain.TreeEntryCommit, r1 error) {
+	f.PushHook(func(context.Context, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendLastCommitsForTreeFunc) nextHook() func(context.Context, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendLastCommitsForTreeFunc) appendCall(r0 GitBackendLastCommitsForTreeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendLastCommitsForTreeFuncCall
+// objects describing the invocations of this function.
+func (f *GitBackendLastCommitsForTreeFunc) History() []GitBackendLastCommitsForTreeFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendLastCommitsForTreeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendLastCommitsForTreeFuncCall is an object that describes an
+// invocation of method LastCommitsForTree on an instance of MockGitBackend.
+type GitBackendLastCommitsForTreeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.CommitID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gitdomain.TreeEntryCommit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendLastCommitsForTreeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendLastCommitsForTreeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendListFilesFunc describes the behavior when the ListFiles method
+// of the parent MockGitBackend instance is invoked.
+type GitBackendListFilesFunc struct {
+	defaultHook func(context.Context, api.CommitID, string) ([]string, error)
+	hooks       []func(context.Context, api.CommitID, string) ([]string, error)
+	history     []GitBackendListFilesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListFiles delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) ListFiles(v0 context.Context, v1 api.CommitID, v2 string) ([]string, error) {
+	r0, r1 := m.ListFilesFunc.nextHook()(v0, v1, v2)
+	m.ListFilesFunc.appendCall(GitBackendListFilesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListFiles method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendListFilesFunc) SetDefaultHook(hook func(context.Context, api.CommitID, string) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListFiles method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendListFilesFunc) PushHook(hook func(context.Context, api.CommitID, string) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendListFilesFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.CommitID, string) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendListFilesFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, api.CommitID, string) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendListFilesFunc) nextHook() func(context.Context, api.CommitID, string) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendListFilesFunc) appendCall(r0 GitBackendListFilesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendListFilesFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendListFilesFunc) History() []GitBackendListFilesFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendListFilesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendListFilesFuncCall is an object that describes an invocation of
+// method ListFiles on an instance of MockGitBackend.
+type GitBackendListFilesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.CommitID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendListFilesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendListFilesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendListRefsFunc describes the behavior when the ListRefs method of
+// the parent MockGitBackend instance is invoked.
+type GitBackendListRefsFunc struct {
+	defaultHook func(context.Context, ListRefsOpts) (RefIterator, error)
+	hooks       []func(context.Context, ListRefsOpts) (RefIterator, error)
+	history     []GitBackendListRefsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRefs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) ListRefs(v0 context.Context, v1 ListRefsOpts) (RefIterator, error) {
+	r0, r1 := m.ListRefsFunc.nextHook()(v0, v1)
+	m.ListRefsFunc.appendCall(GitBackendListRefsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListRefs method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendListRefsFunc) SetDefaultHook(hook func(context.Context, ListRefsOpts) (RefIterator, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRefs method of the parent MockGitBackend instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendListRefsFunc) PushHook(hook func(context.Context, ListRefsOpts) (RefIterator, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendListRefsFunc) SetDefaultReturn(r0 RefIterator, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListRefsOpts) (RefIterator, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendListRefsFunc) PushReturn(r0 RefIterator, r1 error) {
+	f.PushHook(func(context.Context, ListRefsOpts) (RefIterator, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitBackendListRefsFunc) nextHook() func(context.Context, ListRefsOpts) (RefIterator, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendListRefsFunc) appendCall(r0 GitBackendListRefsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendListRefsFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendListRefsFunc) History() []GitBackendListRefsFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendListRefsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
 }
 
-// GitBackendArchiveReaderFunc describes the behavior when the ArchiveReader
-// method of the parent MockGitBackend instance is invoked.
-type GitBackendArchiveReaderFunc struct {
-	defaultHook func(context.Context, ArchiveFormat, string, []string) (io.ReadCloser, error)
-	hooks       []func(context.Context, ArchiveFormat, string, []string) (io.ReadCloser, error)
-	history     []GitBackendArchiveReaderFuncCall
+// GitBackendListRefsFuncCall is an object that describes an invocation of
+// method ListRefs on an instance of MockGitBackend.
+type GitBackendListRefsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ListRefsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 RefIterator
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendListRefsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendListRefsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitBackendListTagsFunc describes the behavior when the ListTags method of
+// the parent MockGitBackend instance is invoked.
+type GitBackendListTagsFunc struct {
+	defaultHook func(context.Context, ListTagsOpts) (RefIterator, error)
+	hooks       []func(context.Context, ListTagsOpts) (RefIterator, error)
+	history     []GitBackendListTagsFuncCall
 	mutex       sync.Mutex
 }
 
-// ArchiveReader delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockGitBackend) ArchiveReader(v0 context.Context, v1 ArchiveFormat, v2 string, v3 []string) (io.ReadCloser, error) {
-	r0, r1 := m.ArchiveReaderFunc.nextHook()(v0, v1, v2, v3)
-	m.ArchiveReaderFunc.appendCall(GitBackendArchiveReaderFuncCall{v0, v1, v2, v3, r0, r1})
+// ListTags delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) ListTags(v0 context.Context, v1 ListTagsOpts) (RefIterator, error) {
+	r0, r1 := m.ListTagsFunc.nextHook()(v0, v1)
+	m.ListTagsFunc.appendCall(GitBackendListTagsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ArchiveReader method
-// of the parent MockGitBackend instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the ListTags method of
+// the parent MockGitBackend instance is invoked and the hook queue is
 // empty.
-func (f *GitBackendArchiveReaderFunc) SetDefaultHook(hook func(context.Context, ArchiveFormat, string, []string) (io.ReadCloser, error)) {
+func (f *GitBackendListTagsFunc) SetDefaultHook(hook func(context.Context, ListTagsOpts) (RefIterator, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ArchiveReader method of the parent MockGitBackend instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *GitBackendArchiveReaderFunc) PushHook(hook func(context.Context, ArchiveFormat, string, []string) (io.ReadCloser, error)) {
+// ListTags method of the parent MockGitBackend instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendListTagsFunc) PushHook(hook func(context.Context, ListTagsOpts) (RefIterator, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -551,20 +3841,20 @@ func (f *GitBackendArchiveReaderFunc) PushHook(hook func(context.Context, Archiv
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitBackendArchiveReaderFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
-	f.SetDefaultHook(func(context.Context, ArchiveFormat, string, []string) (io.ReadCloser, error) {
+func (f *GitBackendListTagsFunc) SetDefaultReturn(r0 RefIterator, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListTagsOpts) (RefIterator, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitBackendArchiveReaderFunc) PushReturn(r0 io.ReadCloser, r1 error) {
-	f.PushHook(func(context.Context, ArchiveFormat, string, []string) (io.ReadCloser, error) {
+func (f *GitBackendListTagsFunc) PushReturn(r0 RefIterator, r1 error) {
+	f.PushHook(func(context.Context, ListTagsOpts) (RefIterator, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitBackendArchiveReaderFunc) nextHook() func(context.Context, ArchiveFormat, string, []string) (io.ReadCloser, error) {
+func (f *GitBackendListTagsFunc) nextHook() func(context.Context, ListTagsOpts) (RefIterator, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -577,41 +3867,35 @@ func (f *GitBackendArchiveReaderFunc) nextHook() func(context.Context, ArchiveFo
 	return hook
 }
 
-func (f *GitBackendArchiveReaderFunc) appendCall(r0 GitBackendArchiveReaderFuncCall) {
+func (f *GitBackendListTagsFunc) appendCall(r0 GitBackendListTagsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitBackendArchiveReaderFuncCall objects
+// History returns a sequence of GitBackendListTagsFuncCall objects
 // describing the invocations of this function.
-func (f *GitBackendArchiveReaderFunc) History() []GitBackendArchiveReaderFuncCall {
+func (f *GitBackendListTagsFunc) History() []GitBackendListTagsFuncCall {
 	f.mutex.Lock()
-	history := make([]GitBackendArchiveReaderFuncCall, len(f.history))
+	history := make([]GitBackendListTagsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitBackendArchiveReaderFuncCall is an object that describes an invocation
-// of method ArchiveReader on an instance of MockGitBackend.
-type GitBackendArchiveReaderFuncCall struct {
+// GitBackendListTagsFuncCall is an object that describes an invocation of
+// method ListTags on an instance of MockGitBackend.
+type GitBackendListTagsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 ArchiveFormat
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 []string
+	Arg1 ListTagsOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 io.ReadCloser
+	Result0 RefIterator
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -619,44 +3903,45 @@ type GitBackendArchiveReaderFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitBackendArchiveReaderFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c GitBackendListTagsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitBackendArchiveReaderFuncCall) Results() []interface{} {
+func (c GitBackendListTagsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitBackendBlameFunc describes the behavior when the Blame method of the
-// parent MockGitBackend instance is invoked.
-type GitBackendBlameFunc struct {
-	defaultHook func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error)
-	hooks       []func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error)
-	history     []GitBackendBlameFuncCall
+// GitBackendMergeBaseFunc describes the behavior when the MergeBase method
+// of the parent MockGitBackend instance is invoked.
+type GitBackendMergeBaseFunc struct {
+	defaultHook func(context.Context, string, string) (api.CommitID, error)
+	hooks       []func(context.Context, string, string) (api.CommitID, error)
+	history     []GitBackendMergeBaseFuncCall
 	mutex       sync.Mutex
 }
 
-// Blame delegates to the next hook function in the queue and stores the
+// MergeBase delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockGitBackend) Blame(v0 context.Context, v1 api.CommitID, v2 string, v3 BlameOptions) (BlameHunkReader, error) {
-	r0, r1 := m.BlameFunc.nextHook()(v0, v1, v2, v3)
-	m.BlameFunc.appendCall(GitBackendBlameFuncCall{v0, v1, v2, v3, r0, r1})
+func (m *MockGitBackend) MergeBase(v0 context.Context, v1 string, v2 string) (api.CommitID, error) {
+	r0, r1 := m.MergeBaseFunc.nextHook()(v0, v1, v2)
+	m.MergeBaseFunc.appendCall(GitBackendMergeBaseFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Blame method of the
-// parent MockGitBackend instance is invoked and the hook queue is empty.
-func (f *GitBackendBlameFunc) SetDefaultHook(hook func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error)) {
+// SetDefaultHook sets function that is called when the MergeBase method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendMergeBaseFunc) SetDefaultHook(hook func(context.Context, string, string) (api.CommitID, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Blame method of the parent MockGitBackend instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
+// MergeBase method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *GitBackendBlameFunc) PushHook(hook func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error)) {
+func (f *GitBackendMergeBaseFunc) PushHook(hook func(context.Context, string, string) (api.CommitID, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -664,20 +3949,20 @@ func (f *GitBackendBlameFunc) PushHook(hook func(context.Context, api.CommitID,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitBackendBlameFunc) SetDefaultReturn(r0 BlameHunkReader, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error) {
+func (f *GitBackendMergeBaseFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitBackendBlameFunc) PushReturn(r0 BlameHunkReader, r1 error) {
-	f.PushHook(func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error) {
+func (f *GitBackendMergeBaseFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, string, string) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitBackendBlameFunc) nextHook() func(context.Context, api.CommitID, string, BlameOptions) (BlameHunkReader, error) {
+func (f *GitBackendMergeBaseFunc) nextHook() func(context.Context, string, string) (api.CommitID, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -690,41 +3975,38 @@ func (f *GitBackendBlameFunc) nextHook() func(context.Context, api.CommitID, str
 	return hook
 }
 
-func (f *GitBackendBlameFunc) appendCall(r0 GitBackendBlameFuncCall) {
+func (f *GitBackendMergeBaseFunc) appendCall(r0 GitBackendMergeBaseFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitBackendBlameFuncCall objects describing
-// the invocations of this function.
-func (f *GitBackendBlameFunc) History() []GitBackendBlameFuncCall {
+// History returns a sequence of GitBackendMergeBaseFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendMergeBaseFunc) History() []GitBackendMergeBaseFuncCall {
 	f.mutex.Lock()
-	history := make([]GitBackendBlameFuncCall, len(f.history))
+	history := make([]GitBackendMergeBaseFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitBackendBlameFuncCall is an object that describes an invocation of
-// method Blame on an instance of MockGitBackend.
-type GitBackendBlameFuncCall struct {
+// GitBackendMergeBaseFuncCall is an object that describes an invocation of
+// method MergeBase on an instance of MockGitBackend.
+type GitBackendMergeBaseFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.CommitID
+	Arg1 string
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 BlameOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 BlameHunkReader
+	Result0 api.CommitID
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -732,44 +4014,45 @@ type GitBackendBlameFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitBackendBlameFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c GitBackendMergeBaseFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitBackendBlameFuncCall) Results() []interface{} {
+func (c GitBackendMergeBaseFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitBackendConfigFunc describes the behavior when the Config method of the
-// parent MockGitBackend instance is invoked.
-type GitBackendConfigFunc struct {
-	defaultHook func() GitConfigBackend
-	hooks       []func() GitConfigBackend
-	history     []GitBackendConfigFuncCall
+// GitBackendRangeDiffFunc describes the behavior when the RangeDiff method
+// of the parent MockGitBackend instance is invoked.
+type GitBackendRangeDiffFunc struct {
+	defaultHook func(context.Context, RangeDiffOpts) ([]*gitdomain.RangeDiffPair, error)
+	hooks       []func(context.Context, RangeDiffOpts) ([]*gitdomain.RangeDiffPair, error)
+	history     []GitBackendRangeDiffFuncCall
 	mutex       sync.Mutex
 }
 
-// Config delegates to the next hook function in the queue and stores the
+// RangeDiff delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockGitBackend) Config() GitConfigBackend {
-	r0 := m.ConfigFunc.nextHook()()
-	m.ConfigFunc.appendCall(GitBackendConfigFuncCall{r0})
-	return r0
+func (m *MockGitBackend) RangeDiff(v0 context.Context, v1 RangeDiffOpts) ([]*gitdomain.RangeDiffPair, error) {
+	r0, r1 := m.RangeDiffFunc.nextHook()(v0, v1)
+	m.RangeDiffFunc.appendCall(GitBackendRangeDiffFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Config method of the
-// parent MockGitBackend instance is invoked and the hook queue is empty.
-func (f *GitBackendConfigFunc) SetDefaultHook(hook func() GitConfigBackend) {
+// SetDefaultHook sets function that is called when the RangeDiff method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendRangeDiffFunc) SetDefaultHook(hook func(context.Context, RangeDiffOpts) ([]*gitdomain.RangeDiffPair, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Config method of the parent MockGitBackend instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
+// RangeDiff method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *GitBackendConfigFunc) PushHook(hook func() GitConfigBackend) {
+func (f *GitBackendRangeDiffFunc) PushHook(hook func(context.Context, RangeDiffOpts) ([]*gitdomain.RangeDiffPair, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -777,20 +4060,20 @@ func (f *GitBackendConfigFunc) PushHook(hook func() GitConfigBackend) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitBackendConfigFunc) SetDefaultReturn(r0 GitConfigBackend) {
-	f.SetDefaultHook(func() GitConfigBackend {
-		return r0
+func (f *GitBackendRangeDiffFunc) SetDefaultReturn(r0 []*gitdomain.RangeDiffPair, r1 error) {
+	f.SetDefaultHook(func(context.Context, RangeDiffOpts) ([]*gitdomain.RangeDiffPair, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitBackendConfigFunc) PushReturn(r0 GitConfigBackend) {
-	f.PushHook(func() GitConfigBackend {
-		return r0
+func (f *GitBackendRangeDiffFunc) PushReturn(r0 []*gitdomain.RangeDiffPair, r1 error) {
+	f.PushHook(func(context.Context, RangeDiffOpts) ([]*gitdomain.RangeDiffPair, error) {
+		return r0, r1
 	})
 }
 
-func (f *GitBackendConfigFunc) nextHook() func() GitConfigBackend {
+func (f *GitBackendRangeDiffFunc) nextHook() func(context.Context, RangeDiffOpts) ([]*gitdomain.RangeDiffPair, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -803,71 +4086,81 @@ func (f *GitBackendConfigFunc) nextHook() func() GitConfigBackend {
 	return hook
 }
 
-func (f *GitBackendConfigFunc) appendCall(r0 GitBackendConfigFuncCall) {
+func (f *GitBackendRangeDiffFunc) appendCall(r0 GitBackendRangeDiffFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitBackendConfigFuncCall objects describing
-// the invocations of this function.
-func (f *GitBackendConfigFunc) History() []GitBackendConfigFuncCall {
+// History returns a sequence of GitBackendRangeDiffFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendRangeDiffFunc) History() []GitBackendRangeDiffFuncCall {
 	f.mutex.Lock()
-	history := make([]GitBackendConfigFuncCall, len(f.history))
+	history := make([]GitBackendRangeDiffFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitBackendConfigFuncCall is an object that describes an invocation of
-// method Config on an instance of MockGitBackend.
-type GitBackendConfigFuncCall struct {
+// GitBackendRangeDiffFuncCall is an object that describes an invocation of
+// method RangeDiff on an instance of MockGitBackend.
+type GitBackendRangeDiffFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 RangeDiffOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 GitConfigBackend
+	Result0 []*gitdomain.RangeDiffPair
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitBackendConfigFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c GitBackendRangeDiffFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitBackendConfigFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c GitBackendRangeDiffFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitBackendExecFunc describes the behavior when the Exec method of the
-// parent MockGitBackend instance is invoked.
-type GitBackendExecFunc struct {
-	defaultHook func(context.Context, ...string) (io.ReadCloser, error)
-	hooks       []func(context.Context, ...string) (io.ReadCloser, error)
-	history     []GitBackendExecFuncCall
+// GitBackendReadBlobFunc describes the behavior when the ReadBlob method of
+// the parent MockGitBackend instance is invoked.
+type GitBackendReadBlobFunc struct {
+	defaultHook func(context.Context, api.CommitID) (io.ReadCloser, error)
+	hooks       []func(context.Context, api.CommitID) (io.ReadCloser, error)
+	history     []GitBackendReadBlobFuncCall
 	mutex       sync.Mutex
 }
 
-// Exec delegates to the next hook function in the queue and stores the
+// ReadBlob delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockGitBackend) Exec(v0 context.Context, v1 ...string) (io.ReadCloser, error) {
-	r0, r1 := m.ExecFunc.nextHook()(v0, v1...)
-	m.ExecFunc.appendCall(GitBackendExecFuncCall{v0, v1, r0, r1})
+func (m *MockGitBackend) ReadBlob(v0 context.Context, v1 api.CommitID) (io.ReadCloser, error) {
+	r0, r1 := m.ReadBlobFunc.nextHook()(v0, v1)
+	m.ReadBlobFunc.appendCall(GitBackendReadBlobFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Exec method of the
-// parent MockGitBackend instance is invoked and the hook queue is empty.
-func (f *GitBackendExecFunc) SetDefaultHook(hook func(context.Context, ...string) (io.ReadCloser, error)) {
+// SetDefaultHook sets function that is called when the ReadBlob method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendReadBlobFunc) SetDefaultHook(hook func(context.Context, api.CommitID) (io.ReadCloser, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Exec method of the parent MockGitBackend instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *GitBackendExecFunc) PushHook(hook func(context.Context, ...string) (io.ReadCloser, error)) {
+// ReadBlob method of the parent MockGitBackend instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendReadBlobFunc) PushHook(hook func(context.Context, api.CommitID) (io.ReadCloser, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -875,20 +4168,20 @@ func (f *GitBackendExecFunc) PushHook(hook func(context.Context, ...string) (io.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitBackendExecFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...string) (io.ReadCloser, error) {
+func (f *GitBackendReadBlobFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.CommitID) (io.ReadCloser, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitBackendExecFunc) PushReturn(r0 io.ReadCloser, r1 error) {
-	f.PushHook(func(context.Context, ...string) (io.ReadCloser, error) {
+func (f *GitBackendReadBlobFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, api.CommitID) (io.ReadCloser, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitBackendExecFunc) nextHook() func(context.Context, ...string) (io.ReadCloser, error) {
+func (f *GitBackendReadBlobFunc) nextHook() func(context.Context, api.CommitID) (io.ReadCloser, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -901,32 +4194,32 @@ func (f *GitBackendExecFunc) nextHook() func(context.Context, ...string) (io.Rea
 	return hook
 }
 
-func (f *GitBackendExecFunc) appendCall(r0 GitBackendExecFuncCall) {
+func (f *GitBackendReadBlobFunc) appendCall(r0 GitBackendReadBlobFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitBackendExecFuncCall objects describing
-// the invocations of this function.
-func (f *GitBackendExecFunc) History() []GitBackendExecFuncCall {
+// History returns a sequence of GitBackendReadBlobFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendReadBlobFunc) History() []GitBackendReadBlobFuncCall {
 	f.mutex.Lock()
-	history := make([]GitBackendExecFuncCall, len(f.history))
+	history := make([]GitBackendReadBlobFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitBackendExecFuncCall is an object that describes an invocation of
-// method Exec on an instance of MockGitBackend.
-type GitBackendExecFuncCall struct {
+// GitBackendReadBlobFuncCall is an object that describes an invocation of
+// method ReadBlob on an instance of MockGitBackend.
+type GitBackendReadBlobFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []string
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.CommitID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 io.ReadCloser
@@ -935,54 +4228,47 @@ type GitBackendExecFuncCall struct {
 	Result1 error
 }
 
-// Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c GitBackendExecFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendReadBlobFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitBackendExecFuncCall) Results() []interface{} {
+func (c GitBackendReadBlobFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitBackendGetCommitFunc describes the behavior when the GetCommit method
-// of the parent MockGitBackend instance is invoked.
-type GitBackendGetCommitFunc struct {
-	defaultHook func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error)
-	hooks       []func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error)
-	history     []GitBackendGetCommitFuncCall
+// GitBackendReadFileFunc describes the behavior when the ReadFile method of
+// the parent MockGitBackend instance is invoked.
+type GitBackendReadFileFunc struct {
+	defaultHook func(context.Context, api.CommitID, string) (io.ReadCloser, error)
+	hooks       []func(context.Context, api.CommitID, string) (io.ReadCloser, error)
+	history     []GitBackendReadFileFuncCall
 	mutex       sync.Mutex
 }
 
-// GetCommit delegates to the next hook function in the queue and stores the
+// ReadFile delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockGitBackend) GetCommit(v0 context.Context, v1 api.CommitID, v2 bool) (*GitCommitWithFiles, error) {
-	r0, r1 := m.GetCommitFunc.nextHook()(v0, v1, v2)
-	m.GetCommitFunc.appendCall(GitBackendGetCommitFuncCall{v0, v1, v2, r0, r1})
+func (m *MockGitBackend) ReadFile(v0 context.Context, v1 api.CommitID, v2 string) (io.ReadCloser, error) {
+	r0, r1 := m.ReadFileFunc.nextHook()(v0, v1, v2)
+	m.ReadFileFunc.appendCall(GitBackendReadFileFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetCommit method of
+// SetDefaultHook sets function that is called when the ReadFile method of
 // the parent MockGitBackend instance is invoked and the hook queue is
 // empty.
-func (f *GitBackendGetCommitFunc) SetDefaultHook(hook func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error)) {
+func (f *GitBackendReadFileFunc) SetDefaultHook(hook func(context.Context, api.CommitID, string) (io.ReadCloser, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetCommit method of the parent MockGitBackend instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
+// ReadFile method of the parent MockGitBackend instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *GitBackendGetCommitFunc) PushHook(hook func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error)) {
+func (f *GitBackendReadFileFunc) PushHook(hook func(context.Context, api.CommitID, string) (io.ReadCloser, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -990,20 +4276,20 @@ func (f *GitBackendGetCommitFunc) PushHook(hook func(context.Context, api.Commit
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitBackendGetCommitFunc) SetDefaultReturn(r0 *GitCommitWithFiles, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error) {
+func (f *GitBackendReadFileFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.CommitID, string) (io.ReadCloser, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitBackendGetCommitFunc) PushReturn(r0 *GitCommitWithFiles, r1 error) {
-	f.PushHook(func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error) {
+func (f *GitBackendReadFileFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, api.CommitID, string) (io.ReadCloser, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitBackendGetCommitFunc) nextHook() func(context.Context, api.CommitID, bool) (*GitCommitWithFiles, error) {
+func (f *GitBackendReadFileFunc) nextHook() func(context.Context, api.CommitID, string) (io.ReadCloser, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1016,26 +4302,26 @@ func (f *GitBackendGetCommitFunc) nextHook() func(context.Context, api.CommitID,
 	return hook
 }
 
-func (f *GitBackendGetCommitFunc) appendCall(r0 GitBackendGetCommitFuncCall) {
+func (f *GitBackendReadFileFunc) appendCall(r0 GitBackendReadFileFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitBackendGetCommitFuncCall objects
+// History returns a sequence of GitBackendReadFileFuncCall objects
 // describing the invocations of this function.
-func (f *GitBackendGetCommitFunc) History() []GitBackendGetCommitFuncCall {
+func (f *GitBackendReadFileFunc) History() []GitBackendReadFileFuncCall {
 	f.mutex.Lock()
-	history := make([]GitBackendGetCommitFuncCall, len(f.history))
+	history := make([]GitBackendReadFileFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitBackendGetCommitFuncCall is an object that describes an invocation of
-// method GetCommit on an instance of MockGitBackend.
-type GitBackendGetCommitFuncCall struct {
+// GitBackendReadFileFuncCall is an object that describes an invocation of
+// method ReadFile on an instance of MockGitBackend.
+type GitBackendReadFileFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -1044,10 +4330,10 @@ type GitBackendGetCommitFuncCall struct {
 	Arg1 api.CommitID
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 bool
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *GitCommitWithFiles
+	Result0 io.ReadCloser
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1055,45 +4341,45 @@ type GitBackendGetCommitFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitBackendGetCommitFuncCall) Args() []interface{} {
+func (c GitBackendReadFileFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitBackendGetCommitFuncCall) Results() []interface{} {
+func (c GitBackendReadFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitBackendGetObjectFunc describes the behavior when the GetObject method
+// GitBackendRefExistsFunc describes the behavior when the RefExists method
 // of the parent MockGitBackend instance is invoked.
-type GitBackendGetObjectFunc struct {
-	defaultHook func(context.Context, string) (*gitdomain.GitObject, error)
-	hooks       []func(context.Context, string) (*gitdomain.GitObject, error)
-	history     []GitBackendGetObjectFuncCall
+type GitBackendRefExistsFunc struct {
+	defaultHook func(context.Context, string) (bool, error)
+	hooks       []func(context.Context, string) (bool, error)
+	history     []GitBackendRefExistsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetObject delegates to the next hook function in the queue and stores the
+// RefExists delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockGitBackend) GetObject(v0 context.Context, v1 string) (*gitdomain.GitObject, error) {
-	r0, r1 := m.GetObjectFunc.nextHook()(v0, v1)
-	m.GetObjectFunc.appendCall(GitBackendGetObjectFuncCall{v0, v1, r0, r1})
+func (m *MockGitBackend) RefExists(v0 context.Context, v1 string) (bool, error) {
+	r0, r1 := m.RefExistsFunc.nextHook()(v0, v1)
+	m.RefExistsFunc.appendCall(GitBackendRefExistsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetObject method of
+// SetDefaultHook sets function that is called when the RefExists method of
 // the parent MockGitBackend instance is invoked and the hook queue is
 // empty.
-func (f *GitBackendGetObjectFunc) SetDefaultHook(hook func(context.Context, string) (*gitdomain.GitObject, error)) {
+func (f *GitBackendRefExistsFunc) SetDefaultHook(hook func(context.Context, string) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetObject method of the parent MockGitBackend instance invokes the hook
+// RefExists method of the parent MockGitBackend instance invokes the hook
 // at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *GitBackendGetObjectFunc) PushHook(hook func(context.Context, string) (*gitdomain.GitObject, error)) {
+func (f *GitBackendRefExistsFunc) PushHook(hook func(context.Context, string) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1101,20 +4387,20 @@ func (f *GitBackendGetObjectFunc) PushHook(hook func(context.Context, string) (*
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitBackendGetObjectFunc) SetDefaultReturn(r0 *gitdomain.GitObject, r1 error) {
-	f.SetDefaultHook(func(context.Context, string) (*gitdomain.GitObject, error) {
+func (f *GitBackendRefExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitBackendGetObjectFunc) PushReturn(r0 *gitdomain.GitObject, r1 error) {
-	f.PushHook(func(context.Context, string) (*gitdomain.GitObject, error) {
+func (f *GitBackendRefExistsFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, string) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitBackendGetObjectFunc) nextHook() func(context.Context, string) (*gitdomain.GitObject, error) {
+func (f *GitBackendRefExistsFunc) nextHook() func(context.Context, string) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1127,26 +4413,26 @@ func (f *GitBackendGetObjectFunc) nextHook() func(context.Context, string) (*git
 	return hook
 }
 
-func (f *GitBackendGetObjectFunc) appendCall(r0 GitBackendGetObjectFuncCall) {
+func (f *GitBackendRefExistsFunc) appendCall(r0 GitBackendRefExistsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitBackendGetObjectFuncCall objects
+// History returns a sequence of GitBackendRefExistsFuncCall objects
 // describing the invocations of this function.
-func (f *GitBackendGetObjectFunc) History() []GitBackendGetObjectFuncCall {
+func (f *GitBackendRefExistsFunc) History() []GitBackendRefExistsFuncCall {
 	f.mutex.Lock()
-	history := make([]GitBackendGetObjectFuncCall, len(f.history))
+	history := make([]GitBackendRefExistsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitBackendGetObjectFuncCall is an object that describes an invocation of
-// method GetObject on an instance of MockGitBackend.
-type GitBackendGetObjectFuncCall struct {
+// GitBackendRefExistsFuncCall is an object that describes an invocation of
+// method RefExists on an instance of MockGitBackend.
+type GitBackendRefExistsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -1155,7 +4441,7 @@ type GitBackendGetObjectFuncCall struct {
 	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *gitdomain.GitObject
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1163,45 +4449,45 @@ type GitBackendGetObjectFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitBackendGetObjectFuncCall) Args() []interface{} {
+func (c GitBackendRefExistsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitBackendGetObjectFuncCall) Results() []interface{} {
+func (c GitBackendRefExistsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitBackendListRefsFunc describes the behavior when the ListRefs method of
-// the parent MockGitBackend instance is invoked.
-type GitBackendListRefsFunc struct {
-	defaultHook func(context.Context, ListRefsOpts) (RefIterator, error)
-	hooks       []func(context.Context, ListRefsOpts) (RefIterator, error)
-	history     []GitBackendListRefsFuncCall
+// GitBackendResolveRevisionFunc describes the behavior when the
+// ResolveRevision method of the parent MockGitBackend instance is invoked.
+type GitBackendResolveRevisionFunc struct {
+	defaultHook func(context.Context, string) (api.CommitID, error)
+	hooks       []func(context.Context, string) (api.CommitID, error)
+	history     []GitBackendResolveRevisionFuncCall
 	mutex       sync.Mutex
 }
 
-// ListRefs delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockGitBackend) ListRefs(v0 context.Context, v1 ListRefsOpts) (RefIterator, error) {
-	r0, r1 := m.ListRefsFunc.nextHook()(v0, v1)
-	m.ListRefsFunc.appendCall(GitBackendListRefsFuncCall{v0, v1, r0, r1})
+// ResolveRevision delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitBackend) ResolveRevision(v0 context.Context, v1 string) (api.CommitID, error) {
+	r0, r1 := m.ResolveRevisionFunc.nextHook()(v0, v1)
+	m.ResolveRevisionFunc.appendCall(GitBackendResolveRevisionFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ListRefs method of
-// the parent MockGitBackend instance is invoked and the hook queue is
-// empty.
-func (f *GitBackendListRefsFunc) SetDefaultHook(hook func(context.Context, ListRefsOpts) (RefIterator, error)) {
+// SetDefaultHook sets function that is called when the ResolveRevision
+// method of the parent MockGitBackend instance is invoked and the hook
+// queue is empty.
+func (f *GitBackendResolveRevisionFunc) SetDefaultHook(hook func(context.Context, string) (api.CommitID, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListRefs method of the parent MockGitBackend instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *GitBackendListRefsFunc) PushHook(hook func(context.Context, ListRefsOpts) (RefIterator, error)) {
+// ResolveRevision method of the parent MockGitBackend instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitBackendResolveRevisionFunc) PushHook(hook func(context.Context, string) (api.CommitID, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1209,20 +4495,20 @@ func (f *GitBackendListRefsFunc) PushHook(hook func(context.Context, ListRefsOpt
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitBackendListRefsFunc) SetDefaultReturn(r0 RefIterator, r1 error) {
-	f.SetDefaultHook(func(context.Context, ListRefsOpts) (RefIterator, error) {
+func (f *GitBackendResolveRevisionFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitBackendListRefsFunc) PushReturn(r0 RefIterator, r1 error) {
-	f.PushHook(func(context.Context, ListRefsOpts) (RefIterator, error) {
+func (f *GitBackendResolveRevisionFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, string) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitBackendListRefsFunc) nextHook() func(context.Context, ListRefsOpts) (RefIterator, error) {
+func (f *GitBackendResolveRevisionFunc) nextHook() func(context.Context, string) (api.CommitID, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1235,35 +4521,35 @@ func (f *GitBackendListRefsFunc) nextHook() func(context.Context, ListRefsOpts)
 	return hook
 }
 
-func (f *GitBackendListRefsFunc) appendCall(r0 GitBackendListRefsFuncCall) {
+func (f *GitBackendResolveRevisionFunc) appendCall(r0 GitBackendResolveRevisionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitBackendListRefsFuncCall objects
+// History returns a sequence of GitBackendResolveRevisionFuncCall objects
 // describing the invocations of this function.
-func (f *GitBackendListRefsFunc) History() []GitBackendListRefsFuncCall {
+func (f *GitBackendResolveRevisionFunc) History() []GitBackendResolveRevisionFuncCall {
 	f.mutex.Lock()
-	history := make([]GitBackendListRefsFuncCall, len(f.history))
+	history := make([]GitBackendResolveRevisionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitBackendListRefsFuncCall is an object that describes an invocation of
-// method ListRefs on an instance of MockGitBackend.
-type GitBackendListRefsFuncCall struct {
+// GitBackendResolveRevisionFuncCall is an object that describes an
+// invocation of method ResolveRevision on an instance of MockGitBackend.
+type GitBackendResolveRevisionFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 ListRefsOpts
+	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 RefIterator
+	Result0 api.CommitID
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1271,45 +4557,45 @@ type GitBackendListRefsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitBackendListRefsFuncCall) Args() []interface{} {
+func (c GitBackendResolveRevisionFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitBackendListRefsFuncCall) Results() []interface{} {
+func (c GitBackendResolveRevisionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitBackendMergeBaseFunc describes the behavior when the MergeBase method
+// GitBackendRevAtTimeFunc describes the behavior when the RevAtTime method
 // of the parent MockGitBackend instance is invoked.
-type GitBackendMergeBaseFunc struct {
-	defaultHook func(context.Context, string, string) (api.CommitID, error)
-	hooks       []func(context.Context, string, string) (api.CommitID, error)
-	history     []GitBackendMergeBaseFuncCall
+type GitBackendRevAtTimeFunc struct {
+	defaultHook func(context.Context, string, time.Time) (api.CommitID, error)
+	hooks       []func(context.Context, string, time.Time) (api.CommitID, error)
+	history     []GitBackendRevAtTimeFuncCall
 	mutex       sync.Mutex
 }
 
-// MergeBase delegates to the next hook function in the queue and stores the
+// RevAtTime delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockGitBackend) MergeBase(v0 context.Context, v1 string, v2 string) (api.CommitID, error) {
-	r0, r1 := m.MergeBaseFunc.nextHook()(v0, v1, v2)
-	m.MergeBaseFunc.appendCall(GitBackendMergeBaseFuncCall{v0, v1, v2, r0, r1})
+func (m *MockGitBackend) RevAtTime(v0 context.Context, v1 string, v2 time.Time) (api.CommitID, error) {
+	r0, r1 := m.RevAtTimeFunc.nextHook()(v0, v1, v2)
+	m.RevAtTimeFunc.appendCall(GitBackendRevAtTimeFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the MergeBase method of
+// SetDefaultHook sets function that is called when the RevAtTime method of
 // the parent MockGitBackend instance is invoked and the hook queue is
 // empty.
-func (f *GitBackendMergeBaseFunc) SetDefaultHook(hook func(context.Context, string, string) (api.CommitID, error)) {
+func (f *GitBackendRevAtTimeFunc) SetDefaultHook(hook func(context.Context, string, time.Time) (api.CommitID, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// MergeBase method of the parent MockGitBackend instance invokes the hook
+// RevAtTime method of the parent MockGitBackend instance invokes the hook
 // at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *GitBackendMergeBaseFunc) PushHook(hook func(context.Context, string, string) (api.CommitID, error)) {
+func (f *GitBackendRevAtTimeFunc) PushHook(hook func(context.Context, string, time.Time) (api.CommitID, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1317,20 +4603,20 @@ func (f *GitBackendMergeBaseFunc) PushHook(hook func(context.Context, string, st
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitBackendMergeBaseFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, string) (api.CommitID, error) {
+func (f *GitBackendRevAtTimeFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, time.Time) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitBackendMergeBaseFunc) PushReturn(r0 api.CommitID, r1 error) {
-	f.PushHook(func(context.Context, string, string) (api.CommitID, error) {
+func (f *GitBackendRevAtTimeFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, string, time.Time) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitBackendMergeBaseFunc) nextHook() func(context.Context, string, string) (api.CommitID, error) {
+func (f *GitBackendRevAtTimeFunc) nextHook() func(context.Context, string, time.Time) (api.CommitID, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1343,26 +4629,26 @@ func (f *GitBackendMergeBaseFunc) nextHook() func(context.Context, string, strin
 	return hook
 }
 
-func (f *GitBackendMergeBaseFunc) appendCall(r0 GitBackendMergeBaseFuncCall) {
+func (f *GitBackendRevAtTimeFunc) appendCall(r0 GitBackendRevAtTimeFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitBackendMergeBaseFuncCall objects
+// History returns a sequence of GitBackendRevAtTimeFuncCall objects
 // describing the invocations of this function.
-func (f *GitBackendMergeBaseFunc) History() []GitBackendMergeBaseFuncCall {
+func (f *GitBackendRevAtTimeFunc) History() []GitBackendRevAtTimeFuncCall {
 	f.mutex.Lock()
-	history := make([]GitBackendMergeBaseFuncCall, len(f.history))
+	history := make([]GitBackendRevAtTimeFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitBackendMergeBaseFuncCall is an object that describes an invocation of
-// method MergeBase on an instance of MockGitBackend.
-type GitBackendMergeBaseFuncCall struct {
+// GitBackendRevAtTimeFuncCall is an object that describes an invocation of
+// method RevAtTime on an instance of MockGitBackend.
+type GitBackendRevAtTimeFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -1371,7 +4657,7 @@ type GitBackendMergeBaseFuncCall struct {
 	Arg1 string
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
+	Arg2 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 api.CommitID
@@ -1382,45 +4668,45 @@ type GitBackendMergeBaseFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitBackendMergeBaseFuncCall) Args() []interface{} {
+func (c GitBackendRevAtTimeFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitBackendMergeBaseFuncCall) Results() []interface{} {
+func (c GitBackendRevAtTimeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitBackendReadFileFunc describes the behavior when the ReadFile method of
-// the parent MockGitBackend instance is invoked.
-type GitBackendReadFileFunc struct {
-	defaultHook func(context.Context, api.CommitID, string) (io.ReadCloser, error)
-	hooks       []func(context.Context, api.CommitID, string) (io.ReadCloser, error)
-	history     []GitBackendReadFileFuncCall
+// GitBackendRevParseHeadFunc describes the behavior when the RevParseHead
+// method of the parent MockGitBackend instance is invoked.
+type GitBackendRevParseHeadFunc struct {
+	defaultHook func(context.Context) (api.CommitID, error)
+	hooks       []func(context.Context) (api.CommitID, error)
+	history     []GitBackendRevParseHeadFuncCall
 	mutex       sync.Mutex
 }
 
-// ReadFile delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockGitBackend) ReadFile(v0 context.Context, v1 api.CommitID, v2 string) (io.ReadCloser, error) {
-	r0, r1 := m.ReadFileFunc.nextHook()(v0, v1, v2)
-	m.ReadFileFunc.appendCall(GitBackendReadFileFuncCall{v0, v1, v2, r0, r1})
+// RevParseHead delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitBackend) RevParseHead(v0 context.Context) (api.CommitID, error) {
+	r0, r1 := m.RevParseHeadFunc.nextHook()(v0)
+	m.RevParseHeadFunc.appendCall(GitBackendRevParseHeadFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ReadFile method of
-// the parent MockGitBackend instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the RevParseHead method
+// of the parent MockGitBackend instance is invoked and the hook queue is
 // empty.
-func (f *GitBackendReadFileFunc) SetDefaultHook(hook func(context.Context, api.CommitID, string) (io.ReadCloser, error)) {
+func (f *GitBackendRevParseHeadFunc) SetDefaultHook(hook func(context.Context) (api.CommitID, error)) {
 	f.defaultHook = hook
-}
-
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// ReadFile method of the parent MockGitBackend instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *GitBackendReadFileFunc) PushHook(hook func(context.Context, api.CommitID, string) (io.ReadCloser, error)) {
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RevParseHead method of the parent MockGitBackend instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitBackendRevParseHeadFunc) PushHook(hook func(context.Context) (api.CommitID, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1428,20 +4714,20 @@ func (f *GitBackendReadFileFunc) PushHook(hook func(context.Context, api.CommitI
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitBackendReadFileFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.CommitID, string) (io.ReadCloser, error) {
+func (f *GitBackendRevParseHeadFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitBackendReadFileFunc) PushReturn(r0 io.ReadCloser, r1 error) {
-	f.PushHook(func(context.Context, api.CommitID, string) (io.ReadCloser, error) {
+func (f *GitBackendRevParseHeadFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitBackendReadFileFunc) nextHook() func(context.Context, api.CommitID, string) (io.ReadCloser, error) {
+func (f *GitBackendRevParseHeadFunc) nextHook() func(context.Context) (api.CommitID, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1454,38 +4740,32 @@ func (f *GitBackendReadFileFunc) nextHook() func(context.Context, api.CommitID,
 	return hook
 }
 
-func (f *GitBackendReadFileFunc) appendCall(r0 GitBackendReadFileFuncCall) {
+func (f *GitBackendRevParseHeadFunc) appendCall(r0 GitBackendRevParseHeadFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitBackendReadFileFuncCall objects
+// History returns a sequence of GitBackendRevParseHeadFuncCall objects
 // describing the invocations of this function.
-func (f *GitBackendReadFileFunc) History() []GitBackendReadFileFuncCall {
+func (f *GitBackendRevParseHeadFunc) History() []GitBackendRevParseHeadFuncCall {
 	f.mutex.Lock()
-	history := make([]GitBackendReadFileFuncCall, len(f.history))
+	history := make([]GitBackendRevParseHeadFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitBackendReadFileFuncCall is an object that describes an invocation of
-// method ReadFile on an instance of MockGitBackend.
-type GitBackendReadFileFuncCall struct {
+// GitBackendRevParseHeadFuncCall is an object that describes an invocation
+// of method RevParseHead on an instance of MockGitBackend.
+type GitBackendRevParseHeadFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.CommitID
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 io.ReadCloser
+	Result0 api.CommitID
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1493,45 +4773,46 @@ type GitBackendReadFileFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitBackendReadFileFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c GitBackendRevParseHeadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitBackendReadFileFuncCall) Results() []interface{} {
+func (c GitBackendRevParseHeadFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitBackendResolveRevisionFunc describes the behavior when the
-// ResolveRevision method of the parent MockGitBackend instance is invoked.
-type GitBackendResolveRevisionFunc struct {
-	defaultHook func(context.Context, string) (api.CommitID, error)
-	hooks       []func(context.Context, string) (api.CommitID, error)
-	history     []GitBackendResolveRevisionFuncCall
+// GitBackendRunMaintenanceTaskFunc describes the behavior when the
+// RunMaintenanceTask method of the parent MockGitBackend instance is
+// invoked.
+type GitBackendRunMaintenanceTaskFunc struct {
+	defaultHook func(context.Context, MaintenanceTask) error
+	hooks       []func(context.Context, MaintenanceTask) error
+	history     []GitBackendRunMaintenanceTaskFuncCall
 	mutex       sync.Mutex
 }
 
-// ResolveRevision delegates to the next hook function in the queue and
+// RunMaintenanceTask delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockGitBackend) ResolveRevision(v0 context.Context, v1 string) (api.CommitID, error) {
-	r0, r1 := m.ResolveRevisionFunc.nextHook()(v0, v1)
-	m.ResolveRevisionFunc.appendCall(GitBackendResolveRevisionFuncCall{v0, v1, r0, r1})
-	return r0, r1
+func (m *MockGitBackend) RunMaintenanceTask(v0 context.Context, v1 MaintenanceTask) error {
+	r0 := m.RunMaintenanceTaskFunc.nextHook()(v0, v1)
+	m.RunMaintenanceTaskFunc.appendCall(GitBackendRunMaintenanceTaskFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the ResolveRevision
+// SetDefaultHook sets function that is called when the RunMaintenanceTask
 // method of the parent MockGitBackend instance is invoked and the hook
 // queue is empty.
-func (f *GitBackendResolveRevisionFunc) SetDefaultHook(hook func(context.Context, string) (api.CommitID, error)) {
+func (f *GitBackendRunMaintenanceTaskFunc) SetDefaultHook(hook func(context.Context, MaintenanceTask) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ResolveRevision method of the parent MockGitBackend instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *GitBackendResolveRevisionFunc) PushHook(hook func(context.Context, string) (api.CommitID, error)) {
+// RunMaintenanceTask method of the parent MockGitBackend instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitBackendRunMaintenanceTaskFunc) PushHook(hook func(context.Context, MaintenanceTask) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1539,20 +4820,20 @@ func (f *GitBackendResolveRevisionFunc) PushHook(hook func(context.Context, stri
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitBackendResolveRevisionFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
-	f.SetDefaultHook(func(context.Context, string) (api.CommitID, error) {
-		return r0, r1
+func (f *GitBackendRunMaintenanceTaskFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, MaintenanceTask) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitBackendResolveRevisionFunc) PushReturn(r0 api.CommitID, r1 error) {
-	f.PushHook(func(context.Context, string) (api.CommitID, error) {
-		return r0, r1
+func (f *GitBackendRunMaintenanceTaskFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, MaintenanceTask) error {
+		return r0
 	})
 }
 
-func (f *GitBackendResolveRevisionFunc) nextHook() func(context.Context, string) (api.CommitID, error) {
+func (f *GitBackendRunMaintenanceTaskFunc) nextHook() func(context.Context, MaintenanceTask) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1565,81 +4846,78 @@ func (f *GitBackendResolveRevisionFunc) nextHook() func(context.Context, string)
 	return hook
 }
 
-func (f *GitBackendResolveRevisionFunc) appendCall(r0 GitBackendResolveRevisionFuncCall) {
+func (f *GitBackendRunMaintenanceTaskFunc) appendCall(r0 GitBackendRunMaintenanceTaskFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitBackendResolveRevisionFuncCall objects
-// describing the invocations of this function.
-func (f *GitBackendResolveRevisionFunc) History() []GitBackendResolveRevisionFuncCall {
+// History returns a sequence of GitBackendRunMaintenanceTaskFuncCall
+// objects describing the invocations of this function.
+func (f *GitBackendRunMaintenanceTaskFunc) History() []GitBackendRunMaintenanceTaskFuncCall {
 	f.mutex.Lock()
-	history := make([]GitBackendResolveRevisionFuncCall, len(f.history))
+	history := make([]GitBackendRunMaintenanceTaskFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitBackendResolveRevisionFuncCall is an object that describes an
-// invocation of method ResolveRevision on an instance of MockGitBackend.
-type GitBackendResolveRevisionFuncCall struct {
+// GitBackendRunMaintenanceTaskFuncCall is an object that describes an
+// invocation of method RunMaintenanceTask on an instance of MockGitBackend.
+type GitBackendRunMaintenanceTaskFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
+	Arg1 MaintenanceTask
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 api.CommitID
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitBackendResolveRevisionFuncCall) Args() []interface{} {
+func (c GitBackendRunMaintenanceTaskFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitBackendResolveRevisionFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c GitBackendRunMaintenanceTaskFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// GitBackendRevAtTimeFunc describes the behavior when the RevAtTime method
-// of the parent MockGitBackend instance is invoked.
-type GitBackendRevAtTimeFunc struct {
-	defaultHook func(context.Context, string, time.Time) (api.CommitID, error)
-	hooks       []func(context.Context, string, time.Time) (api.CommitID, error)
-	history     []GitBackendRevAtTimeFuncCall
+// GitBackendSparseManifestFunc describes the behavior when the
+// SparseManifest method of the parent MockGitBackend instance is invoked.
+type GitBackendSparseManifestFunc struct {
+	defaultHook func(context.Context, api.CommitID, []string) ([]gitdomain.TreeEntry, error)
+	hooks       []func(context.Context, api.CommitID, []string) ([]gitdomain.TreeEntry, error)
+	history     []GitBackendSparseManifestFuncCall
 	mutex       sync.Mutex
 }
 
-// RevAtTime delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockGitBackend) RevAtTime(v0 context.Context, v1 string, v2 time.Time) (api.CommitID, error) {
-	r0, r1 := m.RevAtTimeFunc.nextHook()(v0, v1, v2)
-	m.RevAtTimeFunc.appendCall(GitBackendRevAtTimeFuncCall{v0, v1, v2, r0, r1})
+// SparseManifest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitBackend) SparseManifest(v0 context.Context, v1 api.CommitID, v2 []string) ([]gitdomain.TreeEntry, error) {
+	r0, r1 := m.SparseManifestFunc.nextHook()(v0, v1, v2)
+	m.SparseManifestFunc.appendCall(GitBackendSparseManifestFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the RevAtTime method of
-// the parent MockGitBackend instance is invoked and the hook queue is
-// empty.
-func (f *GitBackendRevAtTimeFunc) SetDefaultHook(hook func(context.Context, string, time.Time) (api.CommitID, error)) {
+// SetDefaultHook sets function that is called when the SparseManifest
+// method of the parent MockGitBackend instance is invoked and the hook
+// queue is empty.
+func (f *GitBackendSparseManifestFunc) SetDefaultHook(hook func(context.Context, api.CommitID, []string) ([]gitdomain.TreeEntry, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RevAtTime method of the parent MockGitBackend instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *GitBackendRevAtTimeFunc) PushHook(hook func(context.Context, string, time.Time) (api.CommitID, error)) {
+// SparseManifest method of the parent MockGitBackend instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitBackendSparseManifestFunc) PushHook(hook func(context.Context, api.CommitID, []string) ([]gitdomain.TreeEntry, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1647,20 +4925,20 @@ func (f *GitBackendRevAtTimeFunc) PushHook(hook func(context.Context, string, ti
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitBackendRevAtTimeFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, time.Time) (api.CommitID, error) {
+func (f *GitBackendSparseManifestFunc) SetDefaultReturn(r0 []gitdomain.TreeEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.CommitID, []string) ([]gitdomain.TreeEntry, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitBackendRevAtTimeFunc) PushReturn(r0 api.CommitID, r1 error) {
-	f.PushHook(func(context.Context, string, time.Time) (api.CommitID, error) {
+func (f *GitBackendSparseManifestFunc) PushReturn(r0 []gitdomain.TreeEntry, r1 error) {
+	f.PushHook(func(context.Context, api.CommitID, []string) ([]gitdomain.TreeEntry, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitBackendRevAtTimeFunc) nextHook() func(context.Context, string, time.Time) (api.CommitID, error) {
+func (f *GitBackendSparseManifestFunc) nextHook() func(context.Context, api.CommitID, []string) ([]gitdomain.TreeEntry, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1673,38 +4951,38 @@ func (f *GitBackendRevAtTimeFunc) nextHook() func(context.Context, string, time.
 	return hook
 }
 
-func (f *GitBackendRevAtTimeFunc) appendCall(r0 GitBackendRevAtTimeFuncCall) {
+func (f *GitBackendSparseManifestFunc) appendCall(r0 GitBackendSparseManifestFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitBackendRevAtTimeFuncCall objects
+// History returns a sequence of GitBackendSparseManifestFuncCall objects
 // describing the invocations of this function.
-func (f *GitBackendRevAtTimeFunc) History() []GitBackendRevAtTimeFuncCall {
+func (f *GitBackendSparseManifestFunc) History() []GitBackendSparseManifestFuncCall {
 	f.mutex.Lock()
-	history := make([]GitBackendRevAtTimeFuncCall, len(f.history))
+	history := make([]GitBackendSparseManifestFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitBackendRevAtTimeFuncCall is an object that describes an invocation of
-// method RevAtTime on an instance of MockGitBackend.
-type GitBackendRevAtTimeFuncCall struct {
+// GitBackendSparseManifestFuncCall is an object that describes an
+// invocation of method SparseManifest on an instance of MockGitBackend.
+type GitBackendSparseManifestFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
+	Arg1 api.CommitID
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 time.Time
+	Arg2 []string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 api.CommitID
+	Result0 []gitdomain.TreeEntry
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1712,45 +4990,45 @@ type GitBackendRevAtTimeFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitBackendRevAtTimeFuncCall) Args() []interface{} {
+func (c GitBackendSparseManifestFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitBackendRevAtTimeFuncCall) Results() []interface{} {
+func (c GitBackendSparseManifestFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitBackendRevParseHeadFunc describes the behavior when the RevParseHead
+// GitBackendSymbolicRefFunc describes the behavior when the SymbolicRef
 // method of the parent MockGitBackend instance is invoked.
-type GitBackendRevParseHeadFunc struct {
-	defaultHook func(context.Context) (api.CommitID, error)
-	hooks       []func(context.Context) (api.CommitID, error)
-	history     []GitBackendRevParseHeadFuncCall
+type GitBackendSymbolicRefFunc struct {
+	defaultHook func(context.Context, string) (string, error)
+	hooks       []func(context.Context, string) (string, error)
+	history     []GitBackendSymbolicRefFuncCall
 	mutex       sync.Mutex
 }
 
-// RevParseHead delegates to the next hook function in the queue and stores
+// SymbolicRef delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockGitBackend) RevParseHead(v0 context.Context) (api.CommitID, error) {
-	r0, r1 := m.RevParseHeadFunc.nextHook()(v0)
-	m.RevParseHeadFunc.appendCall(GitBackendRevParseHeadFuncCall{v0, r0, r1})
+func (m *MockGitBackend) SymbolicRef(v0 context.Context, v1 string) (string, error) {
+	r0, r1 := m.SymbolicRefFunc.nextHook()(v0, v1)
+	m.SymbolicRefFunc.appendCall(GitBackendSymbolicRefFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the RevParseHead method
+// SetDefaultHook sets function that is called when the SymbolicRef method
 // of the parent MockGitBackend instance is invoked and the hook queue is
 // empty.
-func (f *GitBackendRevParseHeadFunc) SetDefaultHook(hook func(context.Context) (api.CommitID, error)) {
+func (f *GitBackendSymbolicRefFunc) SetDefaultHook(hook func(context.Context, string) (string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RevParseHead method of the parent MockGitBackend instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *GitBackendRevParseHeadFunc) PushHook(hook func(context.Context) (api.CommitID, error)) {
+// SymbolicRef method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendSymbolicRefFunc) PushHook(hook func(context.Context, string) (string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1758,20 +5036,20 @@ func (f *GitBackendRevParseHeadFunc) PushHook(hook func(context.Context) (api.Co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitBackendRevParseHeadFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
-	f.SetDefaultHook(func(context.Context) (api.CommitID, error) {
+func (f *GitBackendSymbolicRefFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (string, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitBackendRevParseHeadFunc) PushReturn(r0 api.CommitID, r1 error) {
-	f.PushHook(func(context.Context) (api.CommitID, error) {
+func (f *GitBackendSymbolicRefFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context, string) (string, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitBackendRevParseHeadFunc) nextHook() func(context.Context) (api.CommitID, error) {
+func (f *GitBackendSymbolicRefFunc) nextHook() func(context.Context, string) (string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1784,32 +5062,35 @@ func (f *GitBackendRevParseHeadFunc) nextHook() func(context.Context) (api.Commi
 	return hook
 }
 
-func (f *GitBackendRevParseHeadFunc) appendCall(r0 GitBackendRevParseHeadFuncCall) {
+func (f *GitBackendSymbolicRefFunc) appendCall(r0 GitBackendSymbolicRefFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitBackendRevParseHeadFuncCall objects
+// History returns a sequence of GitBackendSymbolicRefFuncCall objects
 // describing the invocations of this function.
-func (f *GitBackendRevParseHeadFunc) History() []GitBackendRevParseHeadFuncCall {
+func (f *GitBackendSymbolicRefFunc) History() []GitBackendSymbolicRefFuncCall {
 	f.mutex.Lock()
-	history := make([]GitBackendRevParseHeadFuncCall, len(f.history))
+	history := make([]GitBackendSymbolicRefFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitBackendRevParseHeadFuncCall is an object that describes an invocation
-// of method RevParseHead on an instance of MockGitBackend.
-type GitBackendRevParseHeadFuncCall struct {
+// GitBackendSymbolicRefFuncCall is an object that describes an invocation
+// of method SymbolicRef on an instance of MockGitBackend.
+type GitBackendSymbolicRefFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 api.CommitID
+	Result0 string
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1817,13 +5098,13 @@ type GitBackendRevParseHeadFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitBackendRevParseHeadFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c GitBackendSymbolicRefFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitBackendRevParseHeadFuncCall) Results() []interface{} {
+func (c GitBackendSymbolicRefFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
@@ -1935,6 +5216,117 @@ func (c GitBackendSymbolicRefHeadFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitBackendUpdateRefFunc describes the behavior when the UpdateRef method
+// of the parent MockGitBackend instance is invoked.
+type GitBackendUpdateRefFunc struct {
+	defaultHook func(context.Context, string, api.CommitID, api.CommitID) error
+	hooks       []func(context.Context, string, api.CommitID, api.CommitID) error
+	history     []GitBackendUpdateRefFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateRef delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitBackend) UpdateRef(v0 context.Context, v1 string, v2 api.CommitID, v3 api.CommitID) error {
+	r0 := m.UpdateRefFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateRefFunc.appendCall(GitBackendUpdateRefFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the UpdateRef method of
+// the parent MockGitBackend instance is invoked and the hook queue is
+// empty.
+func (f *GitBackendUpdateRefFunc) SetDefaultHook(hook func(context.Context, string, api.CommitID, api.CommitID) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateRef method of the parent MockGitBackend instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GitBackendUpdateRefFunc) PushHook(hook func(context.Context, string, api.CommitID, api.CommitID) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitBackendUpdateRefFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, api.CommitID, api.CommitID) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitBackendUpdateRefFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, api.CommitID, api.CommitID) error {
+		return r0
+	})
+}
+
+func (f *GitBackendUpdateRefFunc) nextHook() func(context.Context, string, api.CommitID, api.CommitID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitBackendUpdateRefFunc) appendCall(r0 GitBackendUpdateRefFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitBackendUpdateRefFuncCall objects
+// describing the invocations of this function.
+func (f *GitBackendUpdateRefFunc) History() []GitBackendUpdateRefFuncCall {
+	f.mutex.Lock()
+	history := make([]GitBackendUpdateRefFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitBackendUpdateRefFuncCall is an object that describes an invocation of
+// method UpdateRef on an instance of MockGitBackend.
+type GitBackendUpdateRefFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitBackendUpdateRefFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitBackendUpdateRefFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockGitConfigBackend is a mock implementation of the GitConfigBackend
 // interface (from the package
 // github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git) used for
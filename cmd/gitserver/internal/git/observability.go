@@ -175,6 +175,48 @@ func (b *observableBackend) GetCommit(ctx context.Context, commit api.CommitID,
 	return b.backend.GetCommit(ctx, commit, includeModifiedFiles)
 }
 
+func (b *observableBackend) CommitStats(ctx context.Context, commit api.CommitID) (_ []gitdomain.FileDiffStat, err error) {
+	ctx, _, endObservation := b.operations.commitStats.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("commit", string(commit)),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("CommitStats").Inc()
+	defer concurrentOps.WithLabelValues("CommitStats").Dec()
+
+	return b.backend.CommitStats(ctx, commit)
+}
+
+func (b *observableBackend) CommitPatch(ctx context.Context, commit api.CommitID) (_ io.ReadCloser, err error) {
+	ctx, errCollector, endObservation := b.operations.commitPatch.WithErrors(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("commit", string(commit)),
+		},
+	})
+	ctx, cancel := context.WithCancel(ctx)
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	concurrentOps.WithLabelValues("CommitPatch").Inc()
+
+	r, err := b.backend.CommitPatch(ctx, commit)
+	if err != nil {
+		concurrentOps.WithLabelValues("CommitPatch").Dec()
+		cancel()
+		return nil, err
+	}
+
+	return &observableReadCloser{
+		inner: r,
+		endObservation: func(err error) {
+			concurrentOps.WithLabelValues("CommitPatch").Dec()
+			errCollector.Collect(&err)
+			cancel()
+		},
+	}, nil
+}
+
 func (b *observableBackend) ReadFile(ctx context.Context, commit api.CommitID, path string) (_ io.ReadCloser, err error) {
 	ctx, errCollector, endObservation := b.operations.readFile.WithErrors(ctx, &err, observation.Args{})
 	ctx, cancel := context.WithCancel(ctx)
@@ -199,6 +241,49 @@ func (b *observableBackend) ReadFile(ctx context.Context, commit api.CommitID, p
 	}, nil
 }
 
+func (b *observableBackend) BlobOID(ctx context.Context, commit api.CommitID, path string) (_ api.CommitID, err error) {
+	ctx, _, endObservation := b.operations.blobOID.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("commit", string(commit)),
+			attribute.String("path", path),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("BlobOID").Inc()
+	defer concurrentOps.WithLabelValues("BlobOID").Dec()
+
+	return b.backend.BlobOID(ctx, commit, path)
+}
+
+func (b *observableBackend) ReadBlob(ctx context.Context, oid api.CommitID) (_ io.ReadCloser, err error) {
+	ctx, errCollector, endObservation := b.operations.readBlob.WithErrors(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("oid", string(oid)),
+		},
+	})
+	ctx, cancel := context.WithCancel(ctx)
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	concurrentOps.WithLabelValues("ReadBlob").Inc()
+
+	r, err := b.backend.ReadBlob(ctx, oid)
+	if err != nil {
+		concurrentOps.WithLabelValues("ReadBlob").Dec()
+		cancel()
+		return nil, err
+	}
+
+	return &observableReadCloser{
+		inner: r,
+		endObservation: func(err error) {
+			concurrentOps.WithLabelValues("ReadBlob").Dec()
+			errCollector.Collect(&err)
+			cancel()
+		},
+	}, nil
+}
+
 func (b *observableBackend) Exec(ctx context.Context, args ...string) (_ io.ReadCloser, err error) {
 	ctx, errCollector, endObservation := b.operations.exec.WithErrors(ctx, &err, observation.Args{})
 	ctx, cancel := context.WithCancel(ctx)
@@ -223,14 +308,14 @@ func (b *observableBackend) Exec(ctx context.Context, args ...string) (_ io.Read
 	}, nil
 }
 
-func (b *observableBackend) ArchiveReader(ctx context.Context, format ArchiveFormat, treeish string, paths []string) (_ io.ReadCloser, err error) {
+func (b *observableBackend) ArchiveReader(ctx context.Context, format ArchiveFormat, treeish string, paths []string, filter string) (_ io.ReadCloser, err error) {
 	ctx, errCollector, endObservation := b.operations.archiveReader.WithErrors(ctx, &err, observation.Args{})
 	ctx, cancel := context.WithCancel(ctx)
 	endObservation.OnCancel(ctx, 1, observation.Args{})
 
 	concurrentOps.WithLabelValues("ArchiveReader").Inc()
 
-	r, err := b.backend.ArchiveReader(ctx, format, treeish, paths)
+	r, err := b.backend.ArchiveReader(ctx, format, treeish, paths, filter)
 	if err != nil {
 		concurrentOps.WithLabelValues("ArchiveReader").Dec()
 		cancel()
@@ -275,6 +360,201 @@ func (b *observableBackend) RevAtTime(ctx context.Context, revspec string, t tim
 	return b.backend.RevAtTime(ctx, revspec, t)
 }
 
+func (b *observableBackend) FormatPatch(ctx context.Context, baseRevspec, headRevspec string) (_ io.ReadCloser, err error) {
+	ctx, errCollector, endObservation := b.operations.formatPatch.WithErrors(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("baseRevspec", baseRevspec),
+			attribute.String("headRevspec", headRevspec),
+		},
+	})
+	ctx, cancel := context.WithCancel(ctx)
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	concurrentOps.WithLabelValues("FormatPatch").Inc()
+
+	r, err := b.backend.FormatPatch(ctx, baseRevspec, headRevspec)
+	if err != nil {
+		concurrentOps.WithLabelValues("FormatPatch").Dec()
+		cancel()
+		return nil, err
+	}
+
+	return &observableReadCloser{
+		inner: r,
+		endObservation: func(err error) {
+			concurrentOps.WithLabelValues("FormatPatch").Dec()
+			errCollector.Collect(&err)
+			cancel()
+		},
+	}, nil
+}
+
+func (b *observableBackend) CreateBundle(ctx context.Context, refs []string) (_ io.ReadCloser, err error) {
+	ctx, errCollector, endObservation := b.operations.createBundle.WithErrors(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.StringSlice("refs", refs),
+		},
+	})
+	ctx, cancel := context.WithCancel(ctx)
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	concurrentOps.WithLabelValues("CreateBundle").Inc()
+
+	r, err := b.backend.CreateBundle(ctx, refs)
+	if err != nil {
+		concurrentOps.WithLabelValues("CreateBundle").Dec()
+		cancel()
+		return nil, err
+	}
+
+	return &observableReadCloser{
+		inner: r,
+		endObservation: func(err error) {
+			concurrentOps.WithLabelValues("CreateBundle").Dec()
+			errCollector.Collect(&err)
+			cancel()
+		},
+	}, nil
+}
+
+func (b *observableBackend) UpdateRef(ctx context.Context, ref string, newOID, expectedOldOID api.CommitID) (err error) {
+	ctx, _, endObservation := b.operations.updateRef.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("ref", ref),
+			attribute.String("newOID", string(newOID)),
+			attribute.String("expectedOldOID", string(expectedOldOID)),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("UpdateRef").Inc()
+	defer concurrentOps.WithLabelValues("UpdateRef").Dec()
+
+	return b.backend.UpdateRef(ctx, ref, newOID, expectedOldOID)
+}
+
+func (b *observableBackend) DeleteRef(ctx context.Context, ref string, expectedOldOID api.CommitID) (err error) {
+	ctx, _, endObservation := b.operations.deleteRef.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("ref", ref),
+			attribute.String("expectedOldOID", string(expectedOldOID)),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("DeleteRef").Inc()
+	defer concurrentOps.WithLabelValues("DeleteRef").Dec()
+
+	return b.backend.DeleteRef(ctx, ref, expectedOldOID)
+}
+
+func (b *observableBackend) CreateTag(ctx context.Context, name string, target api.CommitID, opt CreateTagOpts) (err error) {
+	ctx, _, endObservation := b.operations.createTag.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("name", name),
+			attribute.String("target", string(target)),
+			attribute.Bool("annotated", opt.Message != "" || opt.Sign),
+			attribute.Bool("sign", opt.Sign),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("CreateTag").Inc()
+	defer concurrentOps.WithLabelValues("CreateTag").Dec()
+
+	return b.backend.CreateTag(ctx, name, target, opt)
+}
+
+func (b *observableBackend) GetTag(ctx context.Context, name string) (_ *gitdomain.Tag, err error) {
+	ctx, _, endObservation := b.operations.getTag.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("name", name),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("GetTag").Inc()
+	defer concurrentOps.WithLabelValues("GetTag").Dec()
+
+	return b.backend.GetTag(ctx, name)
+}
+
+func (b *observableBackend) ListFiles(ctx context.Context, commit api.CommitID, path string) (_ []string, err error) {
+	ctx, _, endObservation := b.operations.listFiles.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("commit", string(commit)),
+			attribute.String("path", path),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("ListFiles").Inc()
+	defer concurrentOps.WithLabelValues("ListFiles").Dec()
+
+	return b.backend.ListFiles(ctx, commit, path)
+}
+
+func (b *observableBackend) GetTree(ctx context.Context, treeish string, path string) (_ []gitdomain.TreeEntry, err error) {
+	ctx, _, endObservation := b.operations.getTree.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("treeish", treeish),
+			attribute.String("path", path),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("GetTree").Inc()
+	defer concurrentOps.WithLabelValues("GetTree").Dec()
+
+	return b.backend.GetTree(ctx, treeish, path)
+}
+
+func (b *observableBackend) SparseManifest(ctx context.Context, commit api.CommitID, paths []string) (_ []gitdomain.TreeEntry, err error) {
+	ctx, _, endObservation := b.operations.sparseManifest.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("commit", string(commit)),
+			attribute.StringSlice("paths", paths),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("SparseManifest").Inc()
+	defer concurrentOps.WithLabelValues("SparseManifest").Dec()
+
+	return b.backend.SparseManifest(ctx, commit, paths)
+}
+
+func (b *observableBackend) LastCommitsForTree(ctx context.Context, commit api.CommitID, path string) (_ []gitdomain.TreeEntryCommit, err error) {
+	ctx, _, endObservation := b.operations.lastCommitsForTree.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("commit", string(commit)),
+			attribute.String("path", path),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("LastCommitsForTree").Inc()
+	defer concurrentOps.WithLabelValues("LastCommitsForTree").Dec()
+
+	return b.backend.LastCommitsForTree(ctx, commit, path)
+}
+
+func (b *observableBackend) CommitActivity(ctx context.Context, commit api.CommitID, opt CommitActivityOpts) (_ []gitdomain.CommitActivityBucket, err error) {
+	ctx, _, endObservation := b.operations.commitActivity.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("commit", string(commit)),
+			attribute.String("path", opt.Path),
+			attribute.Int("interval", int(opt.Interval)),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("CommitActivity").Inc()
+	defer concurrentOps.WithLabelValues("CommitActivity").Dec()
+
+	return b.backend.CommitActivity(ctx, commit, opt)
+}
+
 type observableReadCloser struct {
 	inner          io.ReadCloser
 	endObservation func(err error)
@@ -329,22 +609,231 @@ func (hr *observableRefIterator) Close() error {
 	return err
 }
 
+func (b *observableBackend) CommitGraph(ctx context.Context, opt CommitGraphOpts) (_ CommitGraphIterator, err error) {
+	ctx, errCollector, endObservation := b.operations.commitGraph.WithErrors(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("head", opt.Head),
+			attribute.String("base", opt.Base),
+			attribute.Int("maxCommits", opt.MaxCommits),
+		},
+	})
+	ctx, cancel := context.WithCancel(ctx)
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	concurrentOps.WithLabelValues("CommitGraph").Inc()
+
+	it, err := b.backend.CommitGraph(ctx, opt)
+	if err != nil {
+		concurrentOps.WithLabelValues("CommitGraph").Dec()
+		cancel()
+		return nil, err
+	}
+
+	return &observableCommitGraphIterator{
+		inner: it,
+		onClose: func(err error) {
+			concurrentOps.WithLabelValues("CommitGraph").Dec()
+			errCollector.Collect(&err)
+			cancel()
+		},
+	}, nil
+}
+
+func (b *observableBackend) Cherry(ctx context.Context, upstreamRevspec, headRevspec string) (_ []gitdomain.CherryCommit, err error) {
+	ctx, _, endObservation := b.operations.cherry.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("upstreamRevspec", upstreamRevspec),
+			attribute.String("headRevspec", headRevspec),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("Cherry").Inc()
+	defer concurrentOps.WithLabelValues("Cherry").Dec()
+
+	return b.backend.Cherry(ctx, upstreamRevspec, headRevspec)
+}
+
+func (b *observableBackend) RangeDiff(ctx context.Context, opt RangeDiffOpts) (_ []*gitdomain.RangeDiffPair, err error) {
+	ctx, _, endObservation := b.operations.rangeDiff.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("oldBase", opt.OldBase),
+			attribute.String("oldHead", opt.OldHead),
+			attribute.String("newBase", opt.NewBase),
+			attribute.String("newHead", opt.NewHead),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("RangeDiff").Inc()
+	defer concurrentOps.WithLabelValues("RangeDiff").Dec()
+
+	return b.backend.RangeDiff(ctx, opt)
+}
+
+type observableCommitGraphIterator struct {
+	inner   CommitGraphIterator
+	onClose func(err error)
+}
+
+func (it *observableCommitGraphIterator) Next() (*gitdomain.CommitGraphNode, error) {
+	return it.inner.Next()
+}
+
+func (it *observableCommitGraphIterator) Close() error {
+	err := it.inner.Close()
+	it.onClose(err)
+	return err
+}
+
+func (b *observableBackend) SymbolicRef(ctx context.Context, name string) (_ string, err error) {
+	ctx, _, endObservation := b.operations.symbolicRef.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("name", name),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("SymbolicRef").Inc()
+	defer concurrentOps.WithLabelValues("SymbolicRef").Dec()
+
+	return b.backend.SymbolicRef(ctx, name)
+}
+
+func (b *observableBackend) RefExists(ctx context.Context, name string) (_ bool, err error) {
+	ctx, _, endObservation := b.operations.refExists.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("name", name),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("RefExists").Inc()
+	defer concurrentOps.WithLabelValues("RefExists").Dec()
+
+	return b.backend.RefExists(ctx, name)
+}
+
+func (b *observableBackend) BehindAhead(ctx context.Context, left, right string) (_ *gitdomain.BehindAhead, err error) {
+	ctx, _, endObservation := b.operations.behindAhead.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("left", left),
+			attribute.String("right", right),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("BehindAhead").Inc()
+	defer concurrentOps.WithLabelValues("BehindAhead").Dec()
+
+	return b.backend.BehindAhead(ctx, left, right)
+}
+
+func (b *observableBackend) RunMaintenanceTask(ctx context.Context, task MaintenanceTask) (err error) {
+	ctx, _, endObservation := b.operations.maintenance.With(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.String("task", string(task)),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("RunMaintenanceTask").Inc()
+	defer concurrentOps.WithLabelValues("RunMaintenanceTask").Dec()
+
+	return b.backend.RunMaintenanceTask(ctx, task)
+}
+
+func (b *observableBackend) CommitGraphStatus(ctx context.Context) (_ gitdomain.CommitGraphStatus, err error) {
+	ctx, _, endObservation := b.operations.commitGraphStatus.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("CommitGraphStatus").Inc()
+	defer concurrentOps.WithLabelValues("CommitGraphStatus").Dec()
+
+	return b.backend.CommitGraphStatus(ctx)
+}
+
+func (b *observableBackend) CloneState(ctx context.Context) (_ gitdomain.CloneState, err error) {
+	ctx, _, endObservation := b.operations.cloneState.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	concurrentOps.WithLabelValues("CloneState").Inc()
+	defer concurrentOps.WithLabelValues("CloneState").Dec()
+
+	return b.backend.CloneState(ctx)
+}
+
+func (b *observableBackend) ListTags(ctx context.Context, opt ListTagsOpts) (_ RefIterator, err error) {
+	ctx, errCollector, endObservation := b.operations.listTags.WithErrors(ctx, &err, observation.Args{
+		Attrs: []attribute.KeyValue{
+			attribute.StringSlice("patterns", opt.Patterns),
+			attribute.Bool("sortByVersion", opt.SortByVersion),
+			attribute.Int("limit", opt.Limit),
+		},
+	})
+	ctx, cancel := context.WithCancel(ctx)
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	concurrentOps.WithLabelValues("ListTags").Inc()
+
+	it, err := b.backend.ListTags(ctx, opt)
+	if err != nil {
+		concurrentOps.WithLabelValues("ListTags").Dec()
+		cancel()
+		return nil, err
+	}
+
+	return &observableRefIterator{
+		inner: it,
+		onClose: func(err error) {
+			concurrentOps.WithLabelValues("ListTags").Dec()
+			errCollector.Collect(&err)
+			cancel()
+		},
+	}, nil
+}
+
 type operations struct {
-	configGet       *observation.Operation
-	configSet       *observation.Operation
-	configUnset     *observation.Operation
-	getObject       *observation.Operation
-	mergeBase       *observation.Operation
-	blame           *observation.Operation
-	symbolicRefHead *observation.Operation
-	revParseHead    *observation.Operation
-	readFile        *observation.Operation
-	exec            *observation.Operation
-	getCommit       *observation.Operation
-	archiveReader   *observation.Operation
-	resolveRevision *observation.Operation
-	listRefs        *observation.Operation
-	revAtTime       *observation.Operation
+	configGet          *observation.Operation
+	configSet          *observation.Operation
+	configUnset        *observation.Operation
+	getObject          *observation.Operation
+	mergeBase          *observation.Operation
+	blame              *observation.Operation
+	symbolicRefHead    *observation.Operation
+	revParseHead       *observation.Operation
+	readFile           *observation.Operation
+	blobOID            *observation.Operation
+	readBlob           *observation.Operation
+	exec               *observation.Operation
+	getCommit          *observation.Operation
+	commitStats        *observation.Operation
+	commitPatch        *observation.Operation
+	archiveReader      *observation.Operation
+	resolveRevision    *observation.Operation
+	listRefs           *observation.Operation
+	revAtTime          *observation.Operation
+	formatPatch        *observation.Operation
+	createBundle       *observation.Operation
+	updateRef          *observation.Operation
+	deleteRef          *observation.Operation
+	createTag          *observation.Operation
+	getTag             *observation.Operation
+	listFiles          *observation.Operation
+	getTree            *observation.Operation
+	sparseManifest     *observation.Operation
+	lastCommitsForTree *observation.Operation
+	commitActivity     *observation.Operation
+	commitGraph        *observation.Operation
+	cherry             *observation.Operation
+	rangeDiff          *observation.Operation
+	symbolicRef        *observation.Operation
+	refExists          *observation.Operation
+	behindAhead        *observation.Operation
+	listTags           *observation.Operation
+	maintenance        *observation.Operation
+	commitGraphStatus  *observation.Operation
+	cloneState         *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -364,6 +853,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 				if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
 					return observation.EmitForNone
 				}
+				if errors.HasType(err, &gitdomain.RefUpdateConflictError{}) {
+					return observation.EmitForNone
+				}
 				if os.IsNotExist(err) {
 					return observation.EmitForNone
 				}
@@ -373,21 +865,46 @@ func newOperations(observationCtx *observation.Context) *operations {
 	}
 
 	return &operations{
-		configGet:       op("config-get"),
-		configSet:       op("config-set"),
-		configUnset:     op("config-unset"),
-		getObject:       op("get-object"),
-		mergeBase:       op("merge-base"),
-		blame:           op("blame"),
-		symbolicRefHead: op("symbolic-ref-head"),
-		revParseHead:    op("rev-parse-head"),
-		readFile:        op("read-file"),
-		exec:            op("exec"),
-		getCommit:       op("get-commit"),
-		archiveReader:   op("archive-reader"),
-		resolveRevision: op("resolve-revision"),
-		listRefs:        op("list-refs"),
-		revAtTime:       op("rev-at-time"),
+		configGet:          op("config-get"),
+		configSet:          op("config-set"),
+		configUnset:        op("config-unset"),
+		getObject:          op("get-object"),
+		mergeBase:          op("merge-base"),
+		blame:              op("blame"),
+		symbolicRefHead:    op("symbolic-ref-head"),
+		revParseHead:       op("rev-parse-head"),
+		readFile:           op("read-file"),
+		blobOID:            op("blob-oid"),
+		readBlob:           op("read-blob"),
+		exec:               op("exec"),
+		getCommit:          op("get-commit"),
+		commitStats:        op("commit-stats"),
+		commitPatch:        op("commit-patch"),
+		archiveReader:      op("archive-reader"),
+		resolveRevision:    op("resolve-revision"),
+		listRefs:           op("list-refs"),
+		revAtTime:          op("rev-at-time"),
+		formatPatch:        op("format-patch"),
+		createBundle:       op("create-bundle"),
+		updateRef:          op("update-ref"),
+		deleteRef:          op("delete-ref"),
+		createTag:          op("create-tag"),
+		getTag:             op("get-tag"),
+		listFiles:          op("list-files"),
+		getTree:            op("get-tree"),
+		sparseManifest:     op("sparse-manifest"),
+		lastCommitsForTree: op("last-commits-for-tree"),
+		commitActivity:     op("commit-activity"),
+		commitGraph:        op("commit-graph"),
+		cherry:             op("cherry"),
+		rangeDiff:          op("range-diff"),
+		symbolicRef:        op("symbolic-ref"),
+		refExists:          op("ref-exists"),
+		behindAhead:        op("behind-ahead"),
+		listTags:           op("list-tags"),
+		maintenance:        op("maintenance"),
+		commitGraphStatus:  op("commit-graph-status"),
+		cloneState:         op("clone-state"),
 	}
 }
 
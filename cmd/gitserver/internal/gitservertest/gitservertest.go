@@ -0,0 +1,172 @@
+// Package gitservertest runs the real gitserver gRPC service in-process, so
+// that tests can exercise the client and server together, including proto
+// marshaling, instead of the LocalGitserver exec shortcut.
+package gitservertest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"golang.org/x/time/rate"
+
+	server "github.com/sourcegraph/sourcegraph/cmd/gitserver/internal"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git/gitcli"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+	internalgrpc "github.com/sourcegraph/sourcegraph/internal/grpc"
+	"github.com/sourcegraph/sourcegraph/internal/grpc/defaults"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Server is a gitserver listening on a local port, storing its repositories
+// in a temporary directory. It is stopped when the test finishes.
+type Server struct {
+	t      testing.TB
+	addr   string
+	client gitserver.Client
+
+	mu      sync.Mutex
+	remotes map[api.RepoName]string
+}
+
+// NewServer starts a gitserver for the duration of the test.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		t:       t,
+		remotes: make(map[api.RepoName]string),
+	}
+
+	logger := logtest.Scoped(t)
+
+	fs := gitserverfs.New(observation.TestContextTB(t), filepath.Join(t.TempDir(), "repos"))
+	if err := fs.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+
+	gs := server.NewServer(&server.ServerOpts{
+		Logger: logger,
+		FS:     fs,
+		GetBackendFunc: func(dir common.GitDir, repoName api.RepoName) git.GitBackend {
+			return gitcli.NewBackend(logger, wrexec.NewNoOpRecordingCommandFactory(), dir, repoName)
+		},
+		GetRemoteURLFunc: s.remoteURL,
+		GetVCSSyncer: func(ctx context.Context, name api.RepoName) (vcssyncer.VCSSyncer, error) {
+			getRemoteURLSource := func(ctx context.Context, name api.RepoName) (vcssyncer.RemoteURLSource, error) {
+				return vcssyncer.RemoteURLSourceFunc(func(ctx context.Context) (*vcs.URL, error) {
+					raw, err := s.remoteURL(ctx, name)
+					if err != nil {
+						return nil, err
+					}
+
+					u, err := vcs.ParseURL(raw)
+					if err != nil {
+						return nil, errors.Wrapf(err, "failed to parse remote URL %q", raw)
+					}
+					return u, nil
+				}), nil
+			}
+			return vcssyncer.NewGitRepoSyncer(logger, wrexec.NewNoOpRecordingCommandFactory(), getRemoteURLSource), nil
+		},
+		DB:                      newMockDB(),
+		RecordingCommandFactory: wrexec.NewNoOpRecordingCommandFactory(),
+		Locker:                  server.NewRepositoryLocker(),
+		RPSLimiter:              ratelimit.NewInstrumentedLimiter("GitserverTest", rate.NewLimiter(100, 10)),
+	})
+
+	grpcServer := defaults.NewServer(logger)
+	proto.RegisterGitserverServiceServer(grpcServer, server.NewGRPCServer(gs))
+
+	srv := httptest.NewServer(internalgrpc.MultiplexHandlers(grpcServer, http.NotFoundHandler()))
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.addr = u.Host
+	s.client = gitserver.NewTestClient(t).WithClientSource(gitserver.NewTestClientSource(t, []string{s.addr}))
+
+	return s
+}
+
+// Client returns a client that sends all requests to the server.
+func (s *Server) Client() gitserver.Client {
+	return s.client
+}
+
+// Addr returns the address the server listens on.
+func (s *Server) Addr() string {
+	return s.addr
+}
+
+// AddRepo clones the git repository at dir into the server, for example one
+// created with gitserver.InitGitRepository or gitserver.TestRepo. The
+// repository is named after the base name of dir.
+func (s *Server) AddRepo(dir string) api.RepoName {
+	s.t.Helper()
+
+	repo := api.RepoName(filepath.Base(dir))
+
+	s.mu.Lock()
+	s.remotes[repo] = dir
+	s.mu.Unlock()
+
+	resp, err := s.client.RequestRepoUpdate(context.Background(), repo)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	if resp.Error != "" {
+		s.t.Fatal(resp.Error)
+	}
+	return repo
+}
+
+func (s *Server) remoteURL(_ context.Context, name api.RepoName) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dir, ok := s.remotes[name]
+	if !ok {
+		return "", errors.Newf("no remote for repo %s", name)
+	}
+	return dir, nil
+}
+
+func newMockDB() *dbmocks.MockDB {
+	db := dbmocks.NewMockDB()
+	db.GitserverReposFunc.SetDefaultReturn(dbmocks.NewMockGitserverRepoStore())
+	db.FeatureFlagsFunc.SetDefaultReturn(dbmocks.NewMockFeatureFlagStore())
+
+	repos := dbmocks.NewMockRepoStore()
+	repos.GetByNameFunc.SetDefaultHook(func(_ context.Context, name api.RepoName) (*types.Repo, error) {
+		return &types.Repo{
+			Name: name,
+			ExternalRepo: api.ExternalRepoSpec{
+				ServiceType: extsvc.TypeGitHub,
+			},
+		}, nil
+	})
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	return db
+}
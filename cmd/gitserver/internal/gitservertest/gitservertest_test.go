@@ -0,0 +1,68 @@
+package gitservertest
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	r := gitserver.NewTestRepo(t)
+	first := r.Commit("first").AddFile("dir/a.txt", "hello")
+	second := r.Commit("second").AddFile("b.txt", "world")
+	r.Tag("v1", first)
+	r.Build()
+
+	s := NewServer(t)
+	repo := s.AddRepo(r.Dir())
+	c := s.Client()
+
+	t.Run("ResolveRevision", func(t *testing.T) {
+		id, err := c.ResolveRevision(ctx, repo, "v1", gitserver.ResolveRevisionOptions{})
+		require.NoError(t, err)
+		require.Equal(t, first.ID(), id)
+
+		_, err = c.ResolveRevision(ctx, repo, "unknown", gitserver.ResolveRevisionOptions{})
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+
+	t.Run("GetCommit", func(t *testing.T) {
+		commit, err := c.GetCommit(ctx, repo, second.ID())
+		require.NoError(t, err)
+		require.Equal(t, "second", commit.Message.Subject())
+		require.Equal(t, []api.CommitID{first.ID()}, commit.Parents)
+	})
+
+	t.Run("NewFileReader", func(t *testing.T) {
+		rc, err := c.NewFileReader(ctx, repo, second.ID(), "dir/a.txt")
+		require.NoError(t, err)
+		defer rc.Close()
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.Equal(t, "hello", string(content))
+	})
+
+	t.Run("ReadDir", func(t *testing.T) {
+		entries, err := c.ReadDir(ctx, repo, second.ID(), "", true)
+		require.NoError(t, err)
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		require.ElementsMatch(t, []string{"b.txt", "dir", "dir/a.txt"}, names)
+	})
+
+	t.Run("repo not found", func(t *testing.T) {
+		_, err := c.ResolveRevision(ctx, "unknown", "HEAD", gitserver.ResolveRevisionOptions{})
+		require.True(t, errors.HasType(err, &gitdomain.RepoNotExistError{}))
+	})
+}
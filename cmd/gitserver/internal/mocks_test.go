@@ -10,8 +10,10 @@ import (
 	"context"
 	"io"
 	"sync"
+	"time"
 
 	api "github.com/sourcegraph/sourcegraph/internal/api"
+	gitdomain "github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	protocol "github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	trace "github.com/sourcegraph/sourcegraph/internal/trace"
 )
@@ -666,30 +668,77 @@ func (c RepositoryLockerTryAcquireFuncCall) Results() []interface{} {
 // package github.com/sourcegraph/sourcegraph/cmd/gitserver/internal) used
 // for unit testing.
 type MockService struct {
+	// ApplyBundleFunc is an instance of a mock function object controlling
+	// the behavior of the method ApplyBundle.
+	ApplyBundleFunc *ServiceApplyBundleFunc
+	// CherryPickFunc is an instance of a mock function object controlling
+	// the behavior of the method CherryPick.
+	CherryPickFunc *ServiceCherryPickFunc
 	// CreateCommitFromPatchFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateCommitFromPatch.
 	CreateCommitFromPatchFunc *ServiceCreateCommitFromPatchFunc
 	// EnsureRevisionFunc is an instance of a mock function object
 	// controlling the behavior of the method EnsureRevision.
 	EnsureRevisionFunc *ServiceEnsureRevisionFunc
+	// ExecInWorktreeFunc is an instance of a mock function object
+	// controlling the behavior of the method ExecInWorktree.
+	ExecInWorktreeFunc *ServiceExecInWorktreeFunc
+	// FetchCommitFromRepoFunc is an instance of a mock function object
+	// controlling the behavior of the method FetchCommitFromRepo.
+	FetchCommitFromRepoFunc *ServiceFetchCommitFromRepoFunc
+	// FetchRefspecFunc is an instance of a mock function object controlling
+	// the behavior of the method FetchRefspec.
+	FetchRefspecFunc *ServiceFetchRefspecFunc
 	// IsRepoCloneableFunc is an instance of a mock function object
 	// controlling the behavior of the method IsRepoCloneable.
 	IsRepoCloneableFunc *ServiceIsRepoCloneableFunc
+	// ListRemoteRefsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListRemoteRefs.
+	ListRemoteRefsFunc *ServiceListRemoteRefsFunc
 	// LogIfCorruptFunc is an instance of a mock function object controlling
 	// the behavior of the method LogIfCorrupt.
 	LogIfCorruptFunc *ServiceLogIfCorruptFunc
+	// RebasePreviewFunc is an instance of a mock function object
+	// controlling the behavior of the method RebasePreview.
+	RebasePreviewFunc *ServiceRebasePreviewFunc
+	// RefsChangedSinceFunc is an instance of a mock function object
+	// controlling the behavior of the method RefsChangedSince.
+	RefsChangedSinceFunc *ServiceRefsChangedSinceFunc
 	// RepoUpdateFunc is an instance of a mock function object controlling
 	// the behavior of the method RepoUpdate.
 	RepoUpdateFunc *ServiceRepoUpdateFunc
+	// RevertCommitFunc is an instance of a mock function object controlling
+	// the behavior of the method RevertCommit.
+	RevertCommitFunc *ServiceRevertCommitFunc
 	// SearchWithObservabilityFunc is an instance of a mock function object
 	// controlling the behavior of the method SearchWithObservability.
 	SearchWithObservabilityFunc *ServiceSearchWithObservabilityFunc
+	// SquashCommitsFunc is an instance of a mock function object
+	// controlling the behavior of the method SquashCommits.
+	SquashCommitsFunc *ServiceSquashCommitsFunc
+	// WatchDefaultBranchCommitsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// WatchDefaultBranchCommits.
+	WatchDefaultBranchCommitsFunc *ServiceWatchDefaultBranchCommitsFunc
+	// WatchRefsFunc is an instance of a mock function object controlling
+	// the behavior of the method WatchRefs.
+	WatchRefsFunc *ServiceWatchRefsFunc
 }
 
 // NewMockService creates a new mock of the service interface. All methods
 // return zero values for all results, unless overwritten.
 func NewMockService() *MockService {
 	return &MockService{
+		ApplyBundleFunc: &ServiceApplyBundleFunc{
+			defaultHook: func(context.Context, api.RepoName, io.Reader) (r0 error) {
+				return
+			},
+		},
+		CherryPickFunc: &ServiceCherryPickFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, CherryPickOpts) (r0 api.CommitID, r1 error) {
+				return
+			},
+		},
 		CreateCommitFromPatchFunc: &ServiceCreateCommitFromPatchFunc{
 			defaultHook: func(context.Context, protocol.CreateCommitFromPatchRequest, io.Reader) (r0 protocol.CreateCommitFromPatchResponse) {
 				return
@@ -700,26 +749,76 @@ func NewMockService() *MockService {
 				return
 			},
 		},
+		ExecInWorktreeFunc: &ServiceExecInWorktreeFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, []string, []byte) (r0 gitdomain.WorktreeExecResult, r1 error) {
+				return
+			},
+		},
+		FetchCommitFromRepoFunc: &ServiceFetchCommitFromRepoFunc{
+			defaultHook: func(context.Context, api.RepoName, api.RepoName, api.CommitID) (r0 bool, r1 error) {
+				return
+			},
+		},
+		FetchRefspecFunc: &ServiceFetchRefspecFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (r0 error) {
+				return
+			},
+		},
 		IsRepoCloneableFunc: &ServiceIsRepoCloneableFunc{
 			defaultHook: func(context.Context, api.RepoName) (r0 protocol.IsRepoCloneableResponse, r1 error) {
 				return
 			},
 		},
+		ListRemoteRefsFunc: &ServiceListRemoteRefsFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 []gitdomain.Ref, r1 error) {
+				return
+			},
+		},
 		LogIfCorruptFunc: &ServiceLogIfCorruptFunc{
 			defaultHook: func(context.Context, api.RepoName, error) {
 				return
 			},
 		},
+		RebasePreviewFunc: &ServiceRebasePreviewFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (r0 gitdomain.RebasePreview, r1 error) {
+				return
+			},
+		},
+		RefsChangedSinceFunc: &ServiceRefsChangedSinceFunc{
+			defaultHook: func(context.Context, api.RepoName, string, time.Time) (r0 gitdomain.RefChanges, r1 error) {
+				return
+			},
+		},
 		RepoUpdateFunc: &ServiceRepoUpdateFunc{
 			defaultHook: func(context.Context, *protocol.RepoUpdateRequest) (r0 protocol.RepoUpdateResponse) {
 				return
 			},
 		},
+		RevertCommitFunc: &ServiceRevertCommitFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, RevertCommitOpts) (r0 api.CommitID, r1 error) {
+				return
+			},
+		},
 		SearchWithObservabilityFunc: &ServiceSearchWithObservabilityFunc{
 			defaultHook: func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (r0 bool, r1 error) {
 				return
 			},
 		},
+		SquashCommitsFunc: &ServiceSquashCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOpts) (r0 api.CommitID, r1 error) {
+				return
+			},
+		},
+		WatchDefaultBranchCommitsFunc: &ServiceWatchDefaultBranchCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, func(gitdomain.BranchCommits) error) (r0 error) {
+				return
+			},
+		},
+		WatchRefsFunc: &ServiceWatchRefsFunc{
+			defaultHook: func(context.Context, api.RepoName, func(gitdomain.RefChanges) error) (r0 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -727,6 +826,16 @@ func NewMockService() *MockService {
 // methods panic on invocation, unless overwritten.
 func NewStrictMockService() *MockService {
 	return &MockService{
+		ApplyBundleFunc: &ServiceApplyBundleFunc{
+			defaultHook: func(context.Context, api.RepoName, io.Reader) error {
+				panic("unexpected invocation of MockService.ApplyBundle")
+			},
+		},
+		CherryPickFunc: &ServiceCherryPickFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, CherryPickOpts) (api.CommitID, error) {
+				panic("unexpected invocation of MockService.CherryPick")
+			},
+		},
 		CreateCommitFromPatchFunc: &ServiceCreateCommitFromPatchFunc{
 			defaultHook: func(context.Context, protocol.CreateCommitFromPatchRequest, io.Reader) protocol.CreateCommitFromPatchResponse {
 				panic("unexpected invocation of MockService.CreateCommitFromPatch")
@@ -737,26 +846,76 @@ func NewStrictMockService() *MockService {
 				panic("unexpected invocation of MockService.EnsureRevision")
 			},
 		},
+		ExecInWorktreeFunc: &ServiceExecInWorktreeFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error) {
+				panic("unexpected invocation of MockService.ExecInWorktree")
+			},
+		},
+		FetchCommitFromRepoFunc: &ServiceFetchCommitFromRepoFunc{
+			defaultHook: func(context.Context, api.RepoName, api.RepoName, api.CommitID) (bool, error) {
+				panic("unexpected invocation of MockService.FetchCommitFromRepo")
+			},
+		},
+		FetchRefspecFunc: &ServiceFetchRefspecFunc{
+			defaultHook: func(context.Context, api.RepoName, string) error {
+				panic("unexpected invocation of MockService.FetchRefspec")
+			},
+		},
 		IsRepoCloneableFunc: &ServiceIsRepoCloneableFunc{
 			defaultHook: func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error) {
 				panic("unexpected invocation of MockService.IsRepoCloneable")
 			},
 		},
+		ListRemoteRefsFunc: &ServiceListRemoteRefsFunc{
+			defaultHook: func(context.Context, api.RepoName) ([]gitdomain.Ref, error) {
+				panic("unexpected invocation of MockService.ListRemoteRefs")
+			},
+		},
 		LogIfCorruptFunc: &ServiceLogIfCorruptFunc{
 			defaultHook: func(context.Context, api.RepoName, error) {
 				panic("unexpected invocation of MockService.LogIfCorrupt")
 			},
 		},
+		RebasePreviewFunc: &ServiceRebasePreviewFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error) {
+				panic("unexpected invocation of MockService.RebasePreview")
+			},
+		},
+		RefsChangedSinceFunc: &ServiceRefsChangedSinceFunc{
+			defaultHook: func(context.Context, api.RepoName, string, time.Time) (gitdomain.RefChanges, error) {
+				panic("unexpected invocation of MockService.RefsChangedSince")
+			},
+		},
 		RepoUpdateFunc: &ServiceRepoUpdateFunc{
 			defaultHook: func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse {
 				panic("unexpected invocation of MockService.RepoUpdate")
 			},
 		},
+		RevertCommitFunc: &ServiceRevertCommitFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, RevertCommitOpts) (api.CommitID, error) {
+				panic("unexpected invocation of MockService.RevertCommit")
+			},
+		},
 		SearchWithObservabilityFunc: &ServiceSearchWithObservabilityFunc{
 			defaultHook: func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error) {
 				panic("unexpected invocation of MockService.SearchWithObservability")
 			},
 		},
+		SquashCommitsFunc: &ServiceSquashCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOpts) (api.CommitID, error) {
+				panic("unexpected invocation of MockService.SquashCommits")
+			},
+		},
+		WatchDefaultBranchCommitsFunc: &ServiceWatchDefaultBranchCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, func(gitdomain.BranchCommits) error) error {
+				panic("unexpected invocation of MockService.WatchDefaultBranchCommits")
+			},
+		},
+		WatchRefsFunc: &ServiceWatchRefsFunc{
+			defaultHook: func(context.Context, api.RepoName, func(gitdomain.RefChanges) error) error {
+				panic("unexpected invocation of MockService.WatchRefs")
+			},
+		},
 	}
 }
 
@@ -764,37 +923,306 @@ func NewStrictMockService() *MockService {
 // github.com/sourcegraph/sourcegraph/cmd/gitserver/internal). It is
 // redefined here as it is unexported in the source package.
 type surrogateMockService interface {
+	ApplyBundle(context.Context, api.RepoName, io.Reader) error
+	CherryPick(context.Context, api.RepoName, api.CommitID, CherryPickOpts) (api.CommitID, error)
 	CreateCommitFromPatch(context.Context, protocol.CreateCommitFromPatchRequest, io.Reader) protocol.CreateCommitFromPatchResponse
 	EnsureRevision(context.Context, api.RepoName, string) bool
+	ExecInWorktree(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error)
+	FetchCommitFromRepo(context.Context, api.RepoName, api.RepoName, api.CommitID) (bool, error)
+	FetchRefspec(context.Context, api.RepoName, string) error
 	IsRepoCloneable(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error)
+	ListRemoteRefs(context.Context, api.RepoName) ([]gitdomain.Ref, error)
 	LogIfCorrupt(context.Context, api.RepoName, error)
+	RebasePreview(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error)
+	RefsChangedSince(context.Context, api.RepoName, string, time.Time) (gitdomain.RefChanges, error)
 	RepoUpdate(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse
+	RevertCommit(context.Context, api.RepoName, api.CommitID, RevertCommitOpts) (api.CommitID, error)
 	SearchWithObservability(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error)
+	SquashCommits(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOpts) (api.CommitID, error)
+	WatchDefaultBranchCommits(context.Context, api.RepoName, func(gitdomain.BranchCommits) error) error
+	WatchRefs(context.Context, api.RepoName, func(gitdomain.RefChanges) error) error
 }
 
 // NewMockServiceFrom creates a new mock of the MockService interface. All
 // methods delegate to the given implementation, unless overwritten.
 func NewMockServiceFrom(i surrogateMockService) *MockService {
 	return &MockService{
+		ApplyBundleFunc: &ServiceApplyBundleFunc{
+			defaultHook: i.ApplyBundle,
+		},
+		CherryPickFunc: &ServiceCherryPickFunc{
+			defaultHook: i.CherryPick,
+		},
 		CreateCommitFromPatchFunc: &ServiceCreateCommitFromPatchFunc{
 			defaultHook: i.CreateCommitFromPatch,
 		},
 		EnsureRevisionFunc: &ServiceEnsureRevisionFunc{
 			defaultHook: i.EnsureRevision,
 		},
+		ExecInWorktreeFunc: &ServiceExecInWorktreeFunc{
+			defaultHook: i.ExecInWorktree,
+		},
+		FetchCommitFromRepoFunc: &ServiceFetchCommitFromRepoFunc{
+			defaultHook: i.FetchCommitFromRepo,
+		},
+		FetchRefspecFunc: &ServiceFetchRefspecFunc{
+			defaultHook: i.FetchRefspec,
+		},
 		IsRepoCloneableFunc: &ServiceIsRepoCloneableFunc{
 			defaultHook: i.IsRepoCloneable,
 		},
+		ListRemoteRefsFunc: &ServiceListRemoteRefsFunc{
+			defaultHook: i.ListRemoteRefs,
+		},
 		LogIfCorruptFunc: &ServiceLogIfCorruptFunc{
 			defaultHook: i.LogIfCorrupt,
 		},
+		RebasePreviewFunc: &ServiceRebasePreviewFunc{
+			defaultHook: i.RebasePreview,
+		},
+		RefsChangedSinceFunc: &ServiceRefsChangedSinceFunc{
+			defaultHook: i.RefsChangedSince,
+		},
 		RepoUpdateFunc: &ServiceRepoUpdateFunc{
 			defaultHook: i.RepoUpdate,
 		},
+		RevertCommitFunc: &ServiceRevertCommitFunc{
+			defaultHook: i.RevertCommit,
+		},
 		SearchWithObservabilityFunc: &ServiceSearchWithObservabilityFunc{
 			defaultHook: i.SearchWithObservability,
 		},
+		SquashCommitsFunc: &ServiceSquashCommitsFunc{
+			defaultHook: i.SquashCommits,
+		},
+		WatchDefaultBranchCommitsFunc: &ServiceWatchDefaultBranchCommitsFunc{
+			defaultHook: i.WatchDefaultBranchCommits,
+		},
+		WatchRefsFunc: &ServiceWatchRefsFunc{
+			defaultHook: i.WatchRefs,
+		},
+	}
+}
+
+// ServiceApplyBundleFunc describes the behavior when the ApplyBundle method
+// of the parent MockService instance is invoked.
+type ServiceApplyBundleFunc struct {
+	defaultHook func(context.Context, api.RepoName, io.Reader) error
+	hooks       []func(context.Context, api.RepoName, io.Reader) error
+	history     []ServiceApplyBundleFuncCall
+	mutex       sync.Mutex
+}
+
+// ApplyBundle delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockService) ApplyBundle(v0 context.Context, v1 api.RepoName, v2 io.Reader) error {
+	r0 := m.ApplyBundleFunc.nextHook()(v0, v1, v2)
+	m.ApplyBundleFunc.appendCall(ServiceApplyBundleFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the ApplyBundle method
+// of the parent MockService instance is invoked and the hook queue is
+// empty.
+func (f *ServiceApplyBundleFunc) SetDefaultHook(hook func(context.Context, api.RepoName, io.Reader) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ApplyBundle method of the parent MockService instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ServiceApplyBundleFunc) PushHook(hook func(context.Context, api.RepoName, io.Reader) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ServiceApplyBundleFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, io.Reader) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ServiceApplyBundleFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, io.Reader) error {
+		return r0
+	})
+}
+
+func (f *ServiceApplyBundleFunc) nextHook() func(context.Context, api.RepoName, io.Reader) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ServiceApplyBundleFunc) appendCall(r0 ServiceApplyBundleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ServiceApplyBundleFuncCall objects
+// describing the invocations of this function.
+func (f *ServiceApplyBundleFunc) History() []ServiceApplyBundleFuncCall {
+	f.mutex.Lock()
+	history := make([]ServiceApplyBundleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ServiceApplyBundleFuncCall is an object that describes an invocation of
+// method ApplyBundle on an instance of MockService.
+type ServiceApplyBundleFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 io.Reader
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ServiceApplyBundleFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ServiceApplyBundleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ServiceCherryPickFunc describes the behavior when the CherryPick method
+// of the parent MockService instance is invoked.
+type ServiceCherryPickFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, CherryPickOpts) (api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, CherryPickOpts) (api.CommitID, error)
+	history     []ServiceCherryPickFuncCall
+	mutex       sync.Mutex
+}
+
+// CherryPick delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockService) CherryPick(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 CherryPickOpts) (api.CommitID, error) {
+	r0, r1 := m.CherryPickFunc.nextHook()(v0, v1, v2, v3)
+	m.CherryPickFunc.appendCall(ServiceCherryPickFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CherryPick method of
+// the parent MockService instance is invoked and the hook queue is empty.
+func (f *ServiceCherryPickFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, CherryPickOpts) (api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CherryPick method of the parent MockService instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ServiceCherryPickFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, CherryPickOpts) (api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ServiceCherryPickFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, CherryPickOpts) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ServiceCherryPickFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, CherryPickOpts) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *ServiceCherryPickFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, CherryPickOpts) (api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
 	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ServiceCherryPickFunc) appendCall(r0 ServiceCherryPickFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ServiceCherryPickFuncCall objects
+// describing the invocations of this function.
+func (f *ServiceCherryPickFunc) History() []ServiceCherryPickFuncCall {
+	f.mutex.Lock()
+	history := make([]ServiceCherryPickFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ServiceCherryPickFuncCall is an object that describes an invocation of
+// method CherryPick on an instance of MockService.
+type ServiceCherryPickFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 CherryPickOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ServiceCherryPickFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ServiceCherryPickFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
 // ServiceCreateCommitFromPatchFunc describes the behavior when the
@@ -1014,35 +1442,35 @@ func (c ServiceEnsureRevisionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// ServiceIsRepoCloneableFunc describes the behavior when the
-// IsRepoCloneable method of the parent MockService instance is invoked.
-type ServiceIsRepoCloneableFunc struct {
-	defaultHook func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error)
-	hooks       []func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error)
-	history     []ServiceIsRepoCloneableFuncCall
+// ServiceExecInWorktreeFunc describes the behavior when the ExecInWorktree
+// method of the parent MockService instance is invoked.
+type ServiceExecInWorktreeFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error)
+	history     []ServiceExecInWorktreeFuncCall
 	mutex       sync.Mutex
 }
 
-// IsRepoCloneable delegates to the next hook function in the queue and
+// ExecInWorktree delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockService) IsRepoCloneable(v0 context.Context, v1 api.RepoName) (protocol.IsRepoCloneableResponse, error) {
-	r0, r1 := m.IsRepoCloneableFunc.nextHook()(v0, v1)
-	m.IsRepoCloneableFunc.appendCall(ServiceIsRepoCloneableFuncCall{v0, v1, r0, r1})
+func (m *MockService) ExecInWorktree(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 []string, v4 []byte) (gitdomain.WorktreeExecResult, error) {
+	r0, r1 := m.ExecInWorktreeFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.ExecInWorktreeFunc.appendCall(ServiceExecInWorktreeFuncCall{v0, v1, v2, v3, v4, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the IsRepoCloneable
+// SetDefaultHook sets function that is called when the ExecInWorktree
 // method of the parent MockService instance is invoked and the hook queue
 // is empty.
-func (f *ServiceIsRepoCloneableFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error)) {
+func (f *ServiceExecInWorktreeFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IsRepoCloneable method of the parent MockService instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *ServiceIsRepoCloneableFunc) PushHook(hook func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error)) {
+// ExecInWorktree method of the parent MockService instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ServiceExecInWorktreeFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1050,20 +1478,20 @@ func (f *ServiceIsRepoCloneableFunc) PushHook(hook func(context.Context, api.Rep
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ServiceIsRepoCloneableFunc) SetDefaultReturn(r0 protocol.IsRepoCloneableResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error) {
+func (f *ServiceExecInWorktreeFunc) SetDefaultReturn(r0 gitdomain.WorktreeExecResult, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ServiceIsRepoCloneableFunc) PushReturn(r0 protocol.IsRepoCloneableResponse, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error) {
+func (f *ServiceExecInWorktreeFunc) PushReturn(r0 gitdomain.WorktreeExecResult, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error) {
 		return r0, r1
 	})
 }
 
-func (f *ServiceIsRepoCloneableFunc) nextHook() func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error) {
+func (f *ServiceExecInWorktreeFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1076,35 +1504,44 @@ func (f *ServiceIsRepoCloneableFunc) nextHook() func(context.Context, api.RepoNa
 	return hook
 }
 
-func (f *ServiceIsRepoCloneableFunc) appendCall(r0 ServiceIsRepoCloneableFuncCall) {
+func (f *ServiceExecInWorktreeFunc) appendCall(r0 ServiceExecInWorktreeFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ServiceIsRepoCloneableFuncCall objects
+// History returns a sequence of ServiceExecInWorktreeFuncCall objects
 // describing the invocations of this function.
-func (f *ServiceIsRepoCloneableFunc) History() []ServiceIsRepoCloneableFuncCall {
+func (f *ServiceExecInWorktreeFunc) History() []ServiceExecInWorktreeFuncCall {
 	f.mutex.Lock()
-	history := make([]ServiceIsRepoCloneableFuncCall, len(f.history))
+	history := make([]ServiceExecInWorktreeFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ServiceIsRepoCloneableFuncCall is an object that describes an invocation
-// of method IsRepoCloneable on an instance of MockService.
-type ServiceIsRepoCloneableFuncCall struct {
+// ServiceExecInWorktreeFuncCall is an object that describes an invocation
+// of method ExecInWorktree on an instance of MockService.
+type ServiceExecInWorktreeFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 []byte
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 protocol.IsRepoCloneableResponse
+	Result0 gitdomain.WorktreeExecResult
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1112,45 +1549,45 @@ type ServiceIsRepoCloneableFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ServiceIsRepoCloneableFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ServiceExecInWorktreeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ServiceIsRepoCloneableFuncCall) Results() []interface{} {
+func (c ServiceExecInWorktreeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ServiceLogIfCorruptFunc describes the behavior when the LogIfCorrupt
-// method of the parent MockService instance is invoked.
-type ServiceLogIfCorruptFunc struct {
-	defaultHook func(context.Context, api.RepoName, error)
-	hooks       []func(context.Context, api.RepoName, error)
-	history     []ServiceLogIfCorruptFuncCall
+// ServiceFetchCommitFromRepoFunc describes the behavior when the
+// FetchCommitFromRepo method of the parent MockService instance is invoked.
+type ServiceFetchCommitFromRepoFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.RepoName, api.CommitID) (bool, error)
+	hooks       []func(context.Context, api.RepoName, api.RepoName, api.CommitID) (bool, error)
+	history     []ServiceFetchCommitFromRepoFuncCall
 	mutex       sync.Mutex
 }
 
-// LogIfCorrupt delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockService) LogIfCorrupt(v0 context.Context, v1 api.RepoName, v2 error) {
-	m.LogIfCorruptFunc.nextHook()(v0, v1, v2)
-	m.LogIfCorruptFunc.appendCall(ServiceLogIfCorruptFuncCall{v0, v1, v2})
-	return
+// FetchCommitFromRepo delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockService) FetchCommitFromRepo(v0 context.Context, v1 api.RepoName, v2 api.RepoName, v3 api.CommitID) (bool, error) {
+	r0, r1 := m.FetchCommitFromRepoFunc.nextHook()(v0, v1, v2, v3)
+	m.FetchCommitFromRepoFunc.appendCall(ServiceFetchCommitFromRepoFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the LogIfCorrupt method
-// of the parent MockService instance is invoked and the hook queue is
-// empty.
-func (f *ServiceLogIfCorruptFunc) SetDefaultHook(hook func(context.Context, api.RepoName, error)) {
+// SetDefaultHook sets function that is called when the FetchCommitFromRepo
+// method of the parent MockService instance is invoked and the hook queue
+// is empty.
+func (f *ServiceFetchCommitFromRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.RepoName, api.CommitID) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// LogIfCorrupt method of the parent MockService instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ServiceLogIfCorruptFunc) PushHook(hook func(context.Context, api.RepoName, error)) {
+// FetchCommitFromRepo method of the parent MockService instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ServiceFetchCommitFromRepoFunc) PushHook(hook func(context.Context, api.RepoName, api.RepoName, api.CommitID) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1158,20 +1595,20 @@ func (f *ServiceLogIfCorruptFunc) PushHook(hook func(context.Context, api.RepoNa
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ServiceLogIfCorruptFunc) SetDefaultReturn() {
-	f.SetDefaultHook(func(context.Context, api.RepoName, error) {
-		return
+func (f *ServiceFetchCommitFromRepoFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.RepoName, api.CommitID) (bool, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ServiceLogIfCorruptFunc) PushReturn() {
-	f.PushHook(func(context.Context, api.RepoName, error) {
-		return
+func (f *ServiceFetchCommitFromRepoFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.RepoName, api.CommitID) (bool, error) {
+		return r0, r1
 	})
 }
 
-func (f *ServiceLogIfCorruptFunc) nextHook() func(context.Context, api.RepoName, error) {
+func (f *ServiceFetchCommitFromRepoFunc) nextHook() func(context.Context, api.RepoName, api.RepoName, api.CommitID) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1184,26 +1621,26 @@ func (f *ServiceLogIfCorruptFunc) nextHook() func(context.Context, api.RepoName,
 	return hook
 }
 
-func (f *ServiceLogIfCorruptFunc) appendCall(r0 ServiceLogIfCorruptFuncCall) {
+func (f *ServiceFetchCommitFromRepoFunc) appendCall(r0 ServiceFetchCommitFromRepoFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ServiceLogIfCorruptFuncCall objects
+// History returns a sequence of ServiceFetchCommitFromRepoFuncCall objects
 // describing the invocations of this function.
-func (f *ServiceLogIfCorruptFunc) History() []ServiceLogIfCorruptFuncCall {
+func (f *ServiceFetchCommitFromRepoFunc) History() []ServiceFetchCommitFromRepoFuncCall {
 	f.mutex.Lock()
-	history := make([]ServiceLogIfCorruptFuncCall, len(f.history))
+	history := make([]ServiceFetchCommitFromRepoFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ServiceLogIfCorruptFuncCall is an object that describes an invocation of
-// method LogIfCorrupt on an instance of MockService.
-type ServiceLogIfCorruptFuncCall struct {
+// ServiceFetchCommitFromRepoFuncCall is an object that describes an
+// invocation of method FetchCommitFromRepo on an instance of MockService.
+type ServiceFetchCommitFromRepoFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -1212,49 +1649,59 @@ type ServiceLogIfCorruptFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 error
+	Arg2 api.RepoName
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ServiceLogIfCorruptFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c ServiceFetchCommitFromRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ServiceLogIfCorruptFuncCall) Results() []interface{} {
-	return []interface{}{}
+func (c ServiceFetchCommitFromRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// ServiceRepoUpdateFunc describes the behavior when the RepoUpdate method
-// of the parent MockService instance is invoked.
-type ServiceRepoUpdateFunc struct {
-	defaultHook func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse
-	hooks       []func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse
-	history     []ServiceRepoUpdateFuncCall
+// ServiceFetchRefspecFunc describes the behavior when the FetchRefspec
+// method of the parent MockService instance is invoked.
+type ServiceFetchRefspecFunc struct {
+	defaultHook func(context.Context, api.RepoName, string) error
+	hooks       []func(context.Context, api.RepoName, string) error
+	history     []ServiceFetchRefspecFuncCall
 	mutex       sync.Mutex
 }
 
-// RepoUpdate delegates to the next hook function in the queue and stores
+// FetchRefspec delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockService) RepoUpdate(v0 context.Context, v1 *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse {
-	r0 := m.RepoUpdateFunc.nextHook()(v0, v1)
-	m.RepoUpdateFunc.appendCall(ServiceRepoUpdateFuncCall{v0, v1, r0})
+func (m *MockService) FetchRefspec(v0 context.Context, v1 api.RepoName, v2 string) error {
+	r0 := m.FetchRefspecFunc.nextHook()(v0, v1, v2)
+	m.FetchRefspecFunc.appendCall(ServiceFetchRefspecFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the RepoUpdate method of
-// the parent MockService instance is invoked and the hook queue is empty.
-func (f *ServiceRepoUpdateFunc) SetDefaultHook(hook func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse) {
+// SetDefaultHook sets function that is called when the FetchRefspec method
+// of the parent MockService instance is invoked and the hook queue is
+// empty.
+func (f *ServiceFetchRefspecFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RepoUpdate method of the parent MockService instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
+// FetchRefspec method of the parent MockService instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *ServiceRepoUpdateFunc) PushHook(hook func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse) {
+func (f *ServiceFetchRefspecFunc) PushHook(hook func(context.Context, api.RepoName, string) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1262,20 +1709,20 @@ func (f *ServiceRepoUpdateFunc) PushHook(hook func(context.Context, *protocol.Re
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ServiceRepoUpdateFunc) SetDefaultReturn(r0 protocol.RepoUpdateResponse) {
-	f.SetDefaultHook(func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse {
+func (f *ServiceFetchRefspecFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ServiceRepoUpdateFunc) PushReturn(r0 protocol.RepoUpdateResponse) {
-	f.PushHook(func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse {
+func (f *ServiceFetchRefspecFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, string) error {
 		return r0
 	})
 }
 
-func (f *ServiceRepoUpdateFunc) nextHook() func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse {
+func (f *ServiceFetchRefspecFunc) nextHook() func(context.Context, api.RepoName, string) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1288,79 +1735,81 @@ func (f *ServiceRepoUpdateFunc) nextHook() func(context.Context, *protocol.RepoU
 	return hook
 }
 
-func (f *ServiceRepoUpdateFunc) appendCall(r0 ServiceRepoUpdateFuncCall) {
+func (f *ServiceFetchRefspecFunc) appendCall(r0 ServiceFetchRefspecFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ServiceRepoUpdateFuncCall objects
+// History returns a sequence of ServiceFetchRefspecFuncCall objects
 // describing the invocations of this function.
-func (f *ServiceRepoUpdateFunc) History() []ServiceRepoUpdateFuncCall {
+func (f *ServiceFetchRefspecFunc) History() []ServiceFetchRefspecFuncCall {
 	f.mutex.Lock()
-	history := make([]ServiceRepoUpdateFuncCall, len(f.history))
+	history := make([]ServiceFetchRefspecFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ServiceRepoUpdateFuncCall is an object that describes an invocation of
-// method RepoUpdate on an instance of MockService.
-type ServiceRepoUpdateFuncCall struct {
+// ServiceFetchRefspecFuncCall is an object that describes an invocation of
+// method FetchRefspec on an instance of MockService.
+type ServiceFetchRefspecFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *protocol.RepoUpdateRequest
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 protocol.RepoUpdateResponse
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ServiceRepoUpdateFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ServiceFetchRefspecFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ServiceRepoUpdateFuncCall) Results() []interface{} {
+func (c ServiceFetchRefspecFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// ServiceSearchWithObservabilityFunc describes the behavior when the
-// SearchWithObservability method of the parent MockService instance is
-// invoked.
-type ServiceSearchWithObservabilityFunc struct {
-	defaultHook func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error)
-	hooks       []func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error)
-	history     []ServiceSearchWithObservabilityFuncCall
+// ServiceIsRepoCloneableFunc describes the behavior when the
+// IsRepoCloneable method of the parent MockService instance is invoked.
+type ServiceIsRepoCloneableFunc struct {
+	defaultHook func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error)
+	hooks       []func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error)
+	history     []ServiceIsRepoCloneableFuncCall
 	mutex       sync.Mutex
 }
 
-// SearchWithObservability delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockService) SearchWithObservability(v0 context.Context, v1 trace.Trace, v2 *protocol.SearchRequest, v3 func(*protocol.CommitMatch) error) (bool, error) {
-	r0, r1 := m.SearchWithObservabilityFunc.nextHook()(v0, v1, v2, v3)
-	m.SearchWithObservabilityFunc.appendCall(ServiceSearchWithObservabilityFuncCall{v0, v1, v2, v3, r0, r1})
+// IsRepoCloneable delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockService) IsRepoCloneable(v0 context.Context, v1 api.RepoName) (protocol.IsRepoCloneableResponse, error) {
+	r0, r1 := m.IsRepoCloneableFunc.nextHook()(v0, v1)
+	m.IsRepoCloneableFunc.appendCall(ServiceIsRepoCloneableFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// SearchWithObservability method of the parent MockService instance is
-// invoked and the hook queue is empty.
-func (f *ServiceSearchWithObservabilityFunc) SetDefaultHook(hook func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error)) {
+// SetDefaultHook sets function that is called when the IsRepoCloneable
+// method of the parent MockService instance is invoked and the hook queue
+// is empty.
+func (f *ServiceIsRepoCloneableFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SearchWithObservability method of the parent MockService instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *ServiceSearchWithObservabilityFunc) PushHook(hook func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error)) {
+// IsRepoCloneable method of the parent MockService instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ServiceIsRepoCloneableFunc) PushHook(hook func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1368,20 +1817,20 @@ func (f *ServiceSearchWithObservabilityFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ServiceSearchWithObservabilityFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error) {
+func (f *ServiceIsRepoCloneableFunc) SetDefaultReturn(r0 protocol.IsRepoCloneableResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ServiceSearchWithObservabilityFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error) {
+func (f *ServiceIsRepoCloneableFunc) PushReturn(r0 protocol.IsRepoCloneableResponse, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *ServiceSearchWithObservabilityFunc) nextHook() func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error) {
+func (f *ServiceIsRepoCloneableFunc) nextHook() func(context.Context, api.RepoName) (protocol.IsRepoCloneableResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1394,42 +1843,35 @@ func (f *ServiceSearchWithObservabilityFunc) nextHook() func(context.Context, tr
 	return hook
 }
 
-func (f *ServiceSearchWithObservabilityFunc) appendCall(r0 ServiceSearchWithObservabilityFuncCall) {
+func (f *ServiceIsRepoCloneableFunc) appendCall(r0 ServiceIsRepoCloneableFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ServiceSearchWithObservabilityFuncCall
-// objects describing the invocations of this function.
-func (f *ServiceSearchWithObservabilityFunc) History() []ServiceSearchWithObservabilityFuncCall {
+// History returns a sequence of ServiceIsRepoCloneableFuncCall objects
+// describing the invocations of this function.
+func (f *ServiceIsRepoCloneableFunc) History() []ServiceIsRepoCloneableFuncCall {
 	f.mutex.Lock()
-	history := make([]ServiceSearchWithObservabilityFuncCall, len(f.history))
+	history := make([]ServiceIsRepoCloneableFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ServiceSearchWithObservabilityFuncCall is an object that describes an
-// invocation of method SearchWithObservability on an instance of
-// MockService.
-type ServiceSearchWithObservabilityFuncCall struct {
+// ServiceIsRepoCloneableFuncCall is an object that describes an invocation
+// of method IsRepoCloneable on an instance of MockService.
+type ServiceIsRepoCloneableFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 trace.Trace
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 *protocol.SearchRequest
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 func(*protocol.CommitMatch) error
+	Arg1 api.RepoName
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 protocol.IsRepoCloneableResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1437,12 +1879,1125 @@ type ServiceSearchWithObservabilityFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ServiceSearchWithObservabilityFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c ServiceIsRepoCloneableFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ServiceSearchWithObservabilityFuncCall) Results() []interface{} {
+func (c ServiceIsRepoCloneableFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
+
+// ServiceListRemoteRefsFunc describes the behavior when the ListRemoteRefs
+// method of the parent MockService instance is invoked.
+type ServiceListRemoteRefsFunc struct {
+	defaultHook func(context.Context, api.RepoName) ([]gitdomain.Ref, error)
+	hooks       []func(context.Context, api.RepoName) ([]gitdomain.Ref, error)
+	history     []ServiceListRemoteRefsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRemoteRefs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockService) ListRemoteRefs(v0 context.Context, v1 api.RepoName) ([]gitdomain.Ref, error) {
+	r0, r1 := m.ListRemoteRefsFunc.nextHook()(v0, v1)
+	m.ListRemoteRefsFunc.appendCall(ServiceListRemoteRefsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListRemoteRefs
+// method of the parent MockService instance is invoked and the hook queue
+// is empty.
+func (f *ServiceListRemoteRefsFunc) SetDefaultHook(hook func(context.Context, api.RepoName) ([]gitdomain.Ref, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRemoteRefs method of the parent MockService instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ServiceListRemoteRefsFunc) PushHook(hook func(context.Context, api.RepoName) ([]gitdomain.Ref, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ServiceListRemoteRefsFunc) SetDefaultReturn(r0 []gitdomain.Ref, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) ([]gitdomain.Ref, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ServiceListRemoteRefsFunc) PushReturn(r0 []gitdomain.Ref, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) ([]gitdomain.Ref, error) {
+		return r0, r1
+	})
+}
+
+func (f *ServiceListRemoteRefsFunc) nextHook() func(context.Context, api.RepoName) ([]gitdomain.Ref, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ServiceListRemoteRefsFunc) appendCall(r0 ServiceListRemoteRefsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ServiceListRemoteRefsFuncCall objects
+// describing the invocations of this function.
+func (f *ServiceListRemoteRefsFunc) History() []ServiceListRemoteRefsFuncCall {
+	f.mutex.Lock()
+	history := make([]ServiceListRemoteRefsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ServiceListRemoteRefsFuncCall is an object that describes an invocation
+// of method ListRemoteRefs on an instance of MockService.
+type ServiceListRemoteRefsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gitdomain.Ref
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ServiceListRemoteRefsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ServiceListRemoteRefsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ServiceLogIfCorruptFunc describes the behavior when the LogIfCorrupt
+// method of the parent MockService instance is invoked.
+type ServiceLogIfCorruptFunc struct {
+	defaultHook func(context.Context, api.RepoName, error)
+	hooks       []func(context.Context, api.RepoName, error)
+	history     []ServiceLogIfCorruptFuncCall
+	mutex       sync.Mutex
+}
+
+// LogIfCorrupt delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockService) LogIfCorrupt(v0 context.Context, v1 api.RepoName, v2 error) {
+	m.LogIfCorruptFunc.nextHook()(v0, v1, v2)
+	m.LogIfCorruptFunc.appendCall(ServiceLogIfCorruptFuncCall{v0, v1, v2})
+	return
+}
+
+// SetDefaultHook sets function that is called when the LogIfCorrupt method
+// of the parent MockService instance is invoked and the hook queue is
+// empty.
+func (f *ServiceLogIfCorruptFunc) SetDefaultHook(hook func(context.Context, api.RepoName, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// LogIfCorrupt method of the parent MockService instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ServiceLogIfCorruptFunc) PushHook(hook func(context.Context, api.RepoName, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ServiceLogIfCorruptFunc) SetDefaultReturn() {
+	f.SetDefaultHook(func(context.Context, api.RepoName, error) {
+		return
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ServiceLogIfCorruptFunc) PushReturn() {
+	f.PushHook(func(context.Context, api.RepoName, error) {
+		return
+	})
+}
+
+func (f *ServiceLogIfCorruptFunc) nextHook() func(context.Context, api.RepoName, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ServiceLogIfCorruptFunc) appendCall(r0 ServiceLogIfCorruptFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ServiceLogIfCorruptFuncCall objects
+// describing the invocations of this function.
+func (f *ServiceLogIfCorruptFunc) History() []ServiceLogIfCorruptFuncCall {
+	f.mutex.Lock()
+	history := make([]ServiceLogIfCorruptFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ServiceLogIfCorruptFuncCall is an object that describes an invocation of
+// method LogIfCorrupt on an instance of MockService.
+type ServiceLogIfCorruptFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ServiceLogIfCorruptFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ServiceLogIfCorruptFuncCall) Results() []interface{} {
+	return []interface{}{}
+}
+
+// ServiceRebasePreviewFunc describes the behavior when the RebasePreview
+// method of the parent MockService instance is invoked.
+type ServiceRebasePreviewFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error)
+	history     []ServiceRebasePreviewFuncCall
+	mutex       sync.Mutex
+}
+
+// RebasePreview delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockService) RebasePreview(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 api.CommitID, v4 api.CommitID) (gitdomain.RebasePreview, error) {
+	r0, r1 := m.RebasePreviewFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.RebasePreviewFunc.appendCall(ServiceRebasePreviewFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RebasePreview method
+// of the parent MockService instance is invoked and the hook queue is
+// empty.
+func (f *ServiceRebasePreviewFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RebasePreview method of the parent MockService instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ServiceRebasePreviewFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ServiceRebasePreviewFunc) SetDefaultReturn(r0 gitdomain.RebasePreview, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ServiceRebasePreviewFunc) PushReturn(r0 gitdomain.RebasePreview, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error) {
+		return r0, r1
+	})
+}
+
+func (f *ServiceRebasePreviewFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ServiceRebasePreviewFunc) appendCall(r0 ServiceRebasePreviewFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ServiceRebasePreviewFuncCall objects
+// describing the invocations of this function.
+func (f *ServiceRebasePreviewFunc) History() []ServiceRebasePreviewFuncCall {
+	f.mutex.Lock()
+	history := make([]ServiceRebasePreviewFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ServiceRebasePreviewFuncCall is an object that describes an invocation of
+// method RebasePreview on an instance of MockService.
+type ServiceRebasePreviewFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gitdomain.RebasePreview
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ServiceRebasePreviewFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ServiceRebasePreviewFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ServiceRefsChangedSinceFunc describes the behavior when the
+// RefsChangedSince method of the parent MockService instance is invoked.
+type ServiceRefsChangedSinceFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, time.Time) (gitdomain.RefChanges, error)
+	hooks       []func(context.Context, api.RepoName, string, time.Time) (gitdomain.RefChanges, error)
+	history     []ServiceRefsChangedSinceFuncCall
+	mutex       sync.Mutex
+}
+
+// RefsChangedSince delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockService) RefsChangedSince(v0 context.Context, v1 api.RepoName, v2 string, v3 time.Time) (gitdomain.RefChanges, error) {
+	r0, r1 := m.RefsChangedSinceFunc.nextHook()(v0, v1, v2, v3)
+	m.RefsChangedSinceFunc.appendCall(ServiceRefsChangedSinceFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RefsChangedSince
+// method of the parent MockService instance is invoked and the hook queue
+// is empty.
+func (f *ServiceRefsChangedSinceFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, time.Time) (gitdomain.RefChanges, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RefsChangedSince method of the parent MockService instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ServiceRefsChangedSinceFunc) PushHook(hook func(context.Context, api.RepoName, string, time.Time) (gitdomain.RefChanges, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ServiceRefsChangedSinceFunc) SetDefaultReturn(r0 gitdomain.RefChanges, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, time.Time) (gitdomain.RefChanges, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ServiceRefsChangedSinceFunc) PushReturn(r0 gitdomain.RefChanges, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, time.Time) (gitdomain.RefChanges, error) {
+		return r0, r1
+	})
+}
+
+func (f *ServiceRefsChangedSinceFunc) nextHook() func(context.Context, api.RepoName, string, time.Time) (gitdomain.RefChanges, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ServiceRefsChangedSinceFunc) appendCall(r0 ServiceRefsChangedSinceFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ServiceRefsChangedSinceFuncCall objects
+// describing the invocations of this function.
+func (f *ServiceRefsChangedSinceFunc) History() []ServiceRefsChangedSinceFuncCall {
+	f.mutex.Lock()
+	history := make([]ServiceRefsChangedSinceFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ServiceRefsChangedSinceFuncCall is an object that describes an invocation
+// of method RefsChangedSince on an instance of MockService.
+type ServiceRefsChangedSinceFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gitdomain.RefChanges
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ServiceRefsChangedSinceFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ServiceRefsChangedSinceFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ServiceRepoUpdateFunc describes the behavior when the RepoUpdate method
+// of the parent MockService instance is invoked.
+type ServiceRepoUpdateFunc struct {
+	defaultHook func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse
+	hooks       []func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse
+	history     []ServiceRepoUpdateFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoUpdate delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockService) RepoUpdate(v0 context.Context, v1 *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse {
+	r0 := m.RepoUpdateFunc.nextHook()(v0, v1)
+	m.RepoUpdateFunc.appendCall(ServiceRepoUpdateFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the RepoUpdate method of
+// the parent MockService instance is invoked and the hook queue is empty.
+func (f *ServiceRepoUpdateFunc) SetDefaultHook(hook func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoUpdate method of the parent MockService instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ServiceRepoUpdateFunc) PushHook(hook func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ServiceRepoUpdateFunc) SetDefaultReturn(r0 protocol.RepoUpdateResponse) {
+	f.SetDefaultHook(func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ServiceRepoUpdateFunc) PushReturn(r0 protocol.RepoUpdateResponse) {
+	f.PushHook(func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse {
+		return r0
+	})
+}
+
+func (f *ServiceRepoUpdateFunc) nextHook() func(context.Context, *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ServiceRepoUpdateFunc) appendCall(r0 ServiceRepoUpdateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ServiceRepoUpdateFuncCall objects
+// describing the invocations of this function.
+func (f *ServiceRepoUpdateFunc) History() []ServiceRepoUpdateFuncCall {
+	f.mutex.Lock()
+	history := make([]ServiceRepoUpdateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ServiceRepoUpdateFuncCall is an object that describes an invocation of
+// method RepoUpdate on an instance of MockService.
+type ServiceRepoUpdateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *protocol.RepoUpdateRequest
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 protocol.RepoUpdateResponse
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ServiceRepoUpdateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ServiceRepoUpdateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ServiceRevertCommitFunc describes the behavior when the RevertCommit
+// method of the parent MockService instance is invoked.
+type ServiceRevertCommitFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, RevertCommitOpts) (api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, RevertCommitOpts) (api.CommitID, error)
+	history     []ServiceRevertCommitFuncCall
+	mutex       sync.Mutex
+}
+
+// RevertCommit delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockService) RevertCommit(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 RevertCommitOpts) (api.CommitID, error) {
+	r0, r1 := m.RevertCommitFunc.nextHook()(v0, v1, v2, v3)
+	m.RevertCommitFunc.appendCall(ServiceRevertCommitFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RevertCommit method
+// of the parent MockService instance is invoked and the hook queue is
+// empty.
+func (f *ServiceRevertCommitFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, RevertCommitOpts) (api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RevertCommit method of the parent MockService instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ServiceRevertCommitFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, RevertCommitOpts) (api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ServiceRevertCommitFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, RevertCommitOpts) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ServiceRevertCommitFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, RevertCommitOpts) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *ServiceRevertCommitFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, RevertCommitOpts) (api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ServiceRevertCommitFunc) appendCall(r0 ServiceRevertCommitFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ServiceRevertCommitFuncCall objects
+// describing the invocations of this function.
+func (f *ServiceRevertCommitFunc) History() []ServiceRevertCommitFuncCall {
+	f.mutex.Lock()
+	history := make([]ServiceRevertCommitFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ServiceRevertCommitFuncCall is an object that describes an invocation of
+// method RevertCommit on an instance of MockService.
+type ServiceRevertCommitFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 RevertCommitOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ServiceRevertCommitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ServiceRevertCommitFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ServiceSearchWithObservabilityFunc describes the behavior when the
+// SearchWithObservability method of the parent MockService instance is
+// invoked.
+type ServiceSearchWithObservabilityFunc struct {
+	defaultHook func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error)
+	hooks       []func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error)
+	history     []ServiceSearchWithObservabilityFuncCall
+	mutex       sync.Mutex
+}
+
+// SearchWithObservability delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockService) SearchWithObservability(v0 context.Context, v1 trace.Trace, v2 *protocol.SearchRequest, v3 func(*protocol.CommitMatch) error) (bool, error) {
+	r0, r1 := m.SearchWithObservabilityFunc.nextHook()(v0, v1, v2, v3)
+	m.SearchWithObservabilityFunc.appendCall(ServiceSearchWithObservabilityFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// SearchWithObservability method of the parent MockService instance is
+// invoked and the hook queue is empty.
+func (f *ServiceSearchWithObservabilityFunc) SetDefaultHook(hook func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SearchWithObservability method of the parent MockService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ServiceSearchWithObservabilityFunc) PushHook(hook func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ServiceSearchWithObservabilityFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ServiceSearchWithObservabilityFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *ServiceSearchWithObservabilityFunc) nextHook() func(context.Context, trace.Trace, *protocol.SearchRequest, func(*protocol.CommitMatch) error) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ServiceSearchWithObservabilityFunc) appendCall(r0 ServiceSearchWithObservabilityFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ServiceSearchWithObservabilityFuncCall
+// objects describing the invocations of this function.
+func (f *ServiceSearchWithObservabilityFunc) History() []ServiceSearchWithObservabilityFuncCall {
+	f.mutex.Lock()
+	history := make([]ServiceSearchWithObservabilityFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ServiceSearchWithObservabilityFuncCall is an object that describes an
+// invocation of method SearchWithObservability on an instance of
+// MockService.
+type ServiceSearchWithObservabilityFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 trace.Trace
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *protocol.SearchRequest
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 func(*protocol.CommitMatch) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ServiceSearchWithObservabilityFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ServiceSearchWithObservabilityFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ServiceSquashCommitsFunc describes the behavior when the SquashCommits
+// method of the parent MockService instance is invoked.
+type ServiceSquashCommitsFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOpts) (api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOpts) (api.CommitID, error)
+	history     []ServiceSquashCommitsFuncCall
+	mutex       sync.Mutex
+}
+
+// SquashCommits delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockService) SquashCommits(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 api.CommitID, v4 SquashCommitsOpts) (api.CommitID, error) {
+	r0, r1 := m.SquashCommitsFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.SquashCommitsFunc.appendCall(ServiceSquashCommitsFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the SquashCommits method
+// of the parent MockService instance is invoked and the hook queue is
+// empty.
+func (f *ServiceSquashCommitsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOpts) (api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SquashCommits method of the parent MockService instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ServiceSquashCommitsFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOpts) (api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ServiceSquashCommitsFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOpts) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ServiceSquashCommitsFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOpts) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *ServiceSquashCommitsFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOpts) (api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ServiceSquashCommitsFunc) appendCall(r0 ServiceSquashCommitsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ServiceSquashCommitsFuncCall objects
+// describing the invocations of this function.
+func (f *ServiceSquashCommitsFunc) History() []ServiceSquashCommitsFuncCall {
+	f.mutex.Lock()
+	history := make([]ServiceSquashCommitsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ServiceSquashCommitsFuncCall is an object that describes an invocation of
+// method SquashCommits on an instance of MockService.
+type ServiceSquashCommitsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 SquashCommitsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ServiceSquashCommitsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ServiceSquashCommitsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ServiceWatchDefaultBranchCommitsFunc describes the behavior when the
+// WatchDefaultBranchCommits method of the parent MockService instance is
+// invoked.
+type ServiceWatchDefaultBranchCommitsFunc struct {
+	defaultHook func(context.Context, api.RepoName, func(gitdomain.BranchCommits) error) error
+	hooks       []func(context.Context, api.RepoName, func(gitdomain.BranchCommits) error) error
+	history     []ServiceWatchDefaultBranchCommitsFuncCall
+	mutex       sync.Mutex
+}
+
+// WatchDefaultBranchCommits delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockService) WatchDefaultBranchCommits(v0 context.Context, v1 api.RepoName, v2 func(gitdomain.BranchCommits) error) error {
+	r0 := m.WatchDefaultBranchCommitsFunc.nextHook()(v0, v1, v2)
+	m.WatchDefaultBranchCommitsFunc.appendCall(ServiceWatchDefaultBranchCommitsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// WatchDefaultBranchCommits method of the parent MockService instance is
+// invoked and the hook queue is empty.
+func (f *ServiceWatchDefaultBranchCommitsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, func(gitdomain.BranchCommits) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WatchDefaultBranchCommits method of the parent MockService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *ServiceWatchDefaultBranchCommitsFunc) PushHook(hook func(context.Context, api.RepoName, func(gitdomain.BranchCommits) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ServiceWatchDefaultBranchCommitsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, func(gitdomain.BranchCommits) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ServiceWatchDefaultBranchCommitsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, func(gitdomain.BranchCommits) error) error {
+		return r0
+	})
+}
+
+func (f *ServiceWatchDefaultBranchCommitsFunc) nextHook() func(context.Context, api.RepoName, func(gitdomain.BranchCommits) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ServiceWatchDefaultBranchCommitsFunc) appendCall(r0 ServiceWatchDefaultBranchCommitsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ServiceWatchDefaultBranchCommitsFuncCall
+// objects describing the invocations of this function.
+func (f *ServiceWatchDefaultBranchCommitsFunc) History() []ServiceWatchDefaultBranchCommitsFuncCall {
+	f.mutex.Lock()
+	history := make([]ServiceWatchDefaultBranchCommitsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ServiceWatchDefaultBranchCommitsFuncCall is an object that describes an
+// invocation of method WatchDefaultBranchCommits on an instance of
+// MockService.
+type ServiceWatchDefaultBranchCommitsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 func(gitdomain.BranchCommits) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ServiceWatchDefaultBranchCommitsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ServiceWatchDefaultBranchCommitsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ServiceWatchRefsFunc describes the behavior when the WatchRefs method of
+// the parent MockService instance is invoked.
+type ServiceWatchRefsFunc struct {
+	defaultHook func(context.Context, api.RepoName, func(gitdomain.RefChanges) error) error
+	hooks       []func(context.Context, api.RepoName, func(gitdomain.RefChanges) error) error
+	history     []ServiceWatchRefsFuncCall
+	mutex       sync.Mutex
+}
+
+// WatchRefs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockService) WatchRefs(v0 context.Context, v1 api.RepoName, v2 func(gitdomain.RefChanges) error) error {
+	r0 := m.WatchRefsFunc.nextHook()(v0, v1, v2)
+	m.WatchRefsFunc.appendCall(ServiceWatchRefsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WatchRefs method of
+// the parent MockService instance is invoked and the hook queue is empty.
+func (f *ServiceWatchRefsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, func(gitdomain.RefChanges) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WatchRefs method of the parent MockService instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ServiceWatchRefsFunc) PushHook(hook func(context.Context, api.RepoName, func(gitdomain.RefChanges) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ServiceWatchRefsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, func(gitdomain.RefChanges) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ServiceWatchRefsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, func(gitdomain.RefChanges) error) error {
+		return r0
+	})
+}
+
+func (f *ServiceWatchRefsFunc) nextHook() func(context.Context, api.RepoName, func(gitdomain.RefChanges) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ServiceWatchRefsFunc) appendCall(r0 ServiceWatchRefsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ServiceWatchRefsFuncCall objects describing
+// the invocations of this function.
+func (f *ServiceWatchRefsFunc) History() []ServiceWatchRefsFuncCall {
+	f.mutex.Lock()
+	history := make([]ServiceWatchRefsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ServiceWatchRefsFuncCall is an object that describes an invocation of
+// method WatchRefs on an instance of MockService.
+type ServiceWatchRefsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 func(gitdomain.RefChanges) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ServiceWatchRefsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ServiceWatchRefsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
@@ -213,10 +213,14 @@ func (s *Server) CreateCommitFromPatch(ctx context.Context, req protocol.CreateC
 	if committerEmail == "" {
 		committerEmail = authorEmail
 	}
+	committerDate := req.CommitInfo.CommitterDate
+	if committerDate.IsZero() {
+		committerDate = req.CommitInfo.Date
+	}
 
 	// Commit messages can be arbitrary strings, so using `-m` runs into problems.
 	// Instead, feed the commit messages to stdin.
-	cmd = exec.CommandContext(ctx, "git", "commit", "-F", "-")
+	cmd = exec.CommandContext(ctx, "git", commitArgs(req.CommitInfo)...)
 	// NOTE: join messages with a blank line in between ("\n\n")
 	// because the previous behavior was to use multiple -m arguments,
 	// which concatenate with a blank line in between.
@@ -231,7 +235,7 @@ func (s *Server) CreateCommitFromPatch(ctx context.Context, req protocol.CreateC
 		fmt.Sprintf("GIT_COMMITTER_EMAIL=%s", committerEmail),
 		fmt.Sprintf("GIT_AUTHOR_NAME=%s", authorName),
 		fmt.Sprintf("GIT_AUTHOR_EMAIL=%s", authorEmail),
-		fmt.Sprintf("GIT_COMMITTER_DATE=%v", req.CommitInfo.Date),
+		fmt.Sprintf("GIT_COMMITTER_DATE=%v", committerDate),
 		fmt.Sprintf("GIT_AUTHOR_DATE=%v", req.CommitInfo.Date),
 	}...)
 
@@ -253,28 +257,7 @@ func (s *Server) CreateCommitFromPatch(ctx context.Context, req protocol.CreateC
 	cmtHash := strings.TrimSpace(string(out))
 
 	// Move objects from tmpObjectsDir to repoObjectsDir.
-	err = filepath.Walk(tmpObjectsDir, func(path string, info fs.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if info.IsDir() {
-			return nil
-		}
-		rel, err := filepath.Rel(tmpObjectsDir, path)
-		if err != nil {
-			return err
-		}
-		dst := filepath.Join(repoObjectsDir, rel)
-		if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
-			return err
-		}
-		// do the actual move. If dst exists we can ignore the error since it
-		// will contain the same content (content addressable FTW).
-		if err := os.Rename(path, dst); err != nil && !os.IsExist(err) {
-			return err
-		}
-		return nil
-	})
+	err = moveObjects(tmpObjectsDir, repoObjectsDir)
 	if err != nil {
 		resp.SetError(repo, "", "", errors.Wrap(err, "copying git objects"))
 		return resp
@@ -292,7 +275,7 @@ func (s *Server) CreateCommitFromPatch(ctx context.Context, req protocol.CreateC
 
 			resp.ChangelistId = cid
 		} else {
-			cmd = exec.CommandContext(ctx, "git", "push", "--force", remoteURL.String(), fmt.Sprintf("%s:%s", cmtHash, ref))
+			cmd = exec.CommandContext(ctx, "git", pushArgs(*req.Push, remoteURL.String(), cmtHash, ref)...)
 			repoGitDir.Set(cmd)
 
 			// If the protocol is SSH and a private key was given, we want to
@@ -322,6 +305,8 @@ func (s *Server) CreateCommitFromPatch(ctx context.Context, req protocol.CreateC
 
 			if out, err = run(cmd, "pushing ref", true); err != nil {
 				logger.Error("Failed to push", log.String("commit", cmtHash), log.String("output", string(out)))
+				// git reports a ref that doesn't match the lease as "stale info".
+				resp.Error.StaleRef = req.Push.ExpectedOldOID != nil && bytes.Contains(out, []byte("(stale info)"))
 				return resp
 			}
 		}
@@ -827,6 +812,62 @@ func digErrorMessage(err error) string {
 	return msg
 }
 
+// commitArgs returns the arguments to git commit to create a commit with the
+// trailers and signature requested in info. The message is read from stdin.
+func commitArgs(info protocol.PatchCommitInfo) []string {
+	args := []string{"commit", "-F", "-"}
+	for _, t := range info.Trailers {
+		args = append(args, "--trailer="+t.Key+": "+t.Value)
+	}
+	if info.Sign {
+		args = append(args, "-S")
+	}
+	return args
+}
+
+// pushArgs returns the arguments to git push to push commit to ref on
+// remoteURL with the options in push. Without an expected old OID, the ref is
+// force-pushed.
+func pushArgs(push protocol.PushConfig, remoteURL, commit, ref string) []string {
+	args := []string{"push"}
+	if push.ExpectedOldOID != nil {
+		args = append(args, "--force-with-lease="+ref+":"+*push.ExpectedOldOID)
+	} else {
+		args = append(args, "--force")
+	}
+	for _, o := range push.PushOptions {
+		args = append(args, "--push-option="+o)
+	}
+	return append(args, remoteURL, commit+":"+ref)
+}
+
+// moveObjects moves the loose objects and packs in the objects directory src
+// to the objects directory dst.
+func moveObjects(src, dst string) error {
+	return filepath.Walk(src, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(dst, rel)
+		if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+			return err
+		}
+		// do the actual move. If dst exists we can ignore the error since it
+		// will contain the same content (content addressable FTW).
+		if err := os.Rename(path, dst); err != nil && !os.IsExist(err) {
+			return err
+		}
+		return nil
+	})
+}
+
 func cleanUpTmpRepo(logger log.Logger, path string) {
 	err := os.RemoveAll(path)
 	if err != nil {
@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git/gitcli"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+)
+
+func TestCommitArgs(t *testing.T) {
+	info := protocol.PatchCommitInfo{
+		Trailers: []protocol.CommitTrailer{
+			{Key: "Co-authored-by", Value: "Jane Doe <jane@example.com>"},
+			{Key: "Change-Id", Value: "I0123456789abcdef"},
+		},
+		Sign: true,
+	}
+	args := commitArgs(info)
+	require.Equal(t, []string{
+		"commit", "-F", "-",
+		"--trailer=Co-authored-by: Jane Doe <jane@example.com>",
+		"--trailer=Change-Id: I0123456789abcdef",
+		"-S",
+	}, args)
+	require.True(t, gitcli.IsAllowedGitCmd(logtest.Scoped(t), args, common.GitDir(t.TempDir())))
+
+	require.Equal(t, []string{"commit", "-F", "-"}, commitArgs(protocol.PatchCommitInfo{}))
+}
+
+func TestCommitArgs_Trailers(t *testing.T) {
+	dir := t.TempDir()
+	run := func(stdin string, args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(cmd.Environ(),
+			"GIT_AUTHOR_NAME=a", "GIT_AUTHOR_EMAIL=a@example.com",
+			"GIT_COMMITTER_NAME=c", "GIT_COMMITTER_EMAIL=c@example.com",
+		)
+		cmd.Stdin = strings.NewReader(stdin)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return string(out)
+	}
+	run("", "init", "--quiet")
+
+	run("Fix the thing\n\nLonger description.", append(commitArgs(protocol.PatchCommitInfo{
+		Trailers: []protocol.CommitTrailer{{Key: "Co-authored-by", Value: "Jane Doe <jane@example.com>"}},
+	}), "--allow-empty")...)
+
+	require.Equal(t,
+		"Fix the thing\n\nLonger description.\n\nCo-authored-by: Jane Doe <jane@example.com>",
+		strings.TrimSpace(run("", "log", "-1", "--format=%B")),
+	)
+}
+
+func TestPushArgs(t *testing.T) {
+	commit := "e3889dff4263a2273459471739aafabc10269885"
+
+	args := pushArgs(protocol.PushConfig{}, "https://example.com/repo", commit, "refs/heads/my-branch")
+	require.Equal(t, []string{"push", "--force", "https://example.com/repo", commit + ":refs/heads/my-branch"}, args)
+
+	old := "4bc5ad7dc3b8ab5ec20c1d7bc27eb5d9e01e2e4b"
+	args = pushArgs(protocol.PushConfig{
+		ExpectedOldOID: &old,
+		PushOptions:    []string{"ci.skip", "merge_request.create"},
+	}, "https://example.com/repo", commit, "refs/heads/my-branch")
+	require.Equal(t, []string{
+		"push",
+		"--force-with-lease=refs/heads/my-branch:" + old,
+		"--push-option=ci.skip",
+		"--push-option=merge_request.create",
+		"https://example.com/repo",
+		commit + ":refs/heads/my-branch",
+	}, args)
+	require.True(t, gitcli.IsAllowedGitCmd(logtest.Scoped(t), args, common.GitDir(t.TempDir())))
+}
+
+func TestPushArgs_Lease(t *testing.T) {
+	dir := t.TempDir()
+	remoteDir := t.TempDir()
+	run := func(dir string, args ...string) (string, error) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(cmd.Environ(),
+			"GIT_AUTHOR_NAME=a", "GIT_AUTHOR_EMAIL=a@example.com",
+			"GIT_COMMITTER_NAME=c", "GIT_COMMITTER_EMAIL=c@example.com",
+		)
+		out, err := cmd.CombinedOutput()
+		return string(out), err
+	}
+	mustRun := func(dir string, args ...string) string {
+		t.Helper()
+		out, err := run(dir, args...)
+		require.NoError(t, err, out)
+		return strings.TrimSpace(out)
+	}
+	mustRun(remoteDir, "init", "--quiet", "--bare")
+	mustRun(dir, "init", "--quiet")
+	mustRun(dir, "commit", "--quiet", "--allow-empty", "-m", "first")
+	first := mustRun(dir, "rev-parse", "HEAD")
+	mustRun(dir, "commit", "--quiet", "--allow-empty", "-m", "second")
+	second := mustRun(dir, "rev-parse", "HEAD")
+
+	const ref = "refs/heads/changeset"
+	push := func(expectedOldOID *string, commit string) (string, error) {
+		return run(dir, pushArgs(protocol.PushConfig{ExpectedOldOID: expectedOldOID}, remoteDir, commit, ref)...)
+	}
+	empty := ""
+
+	// The ref must not exist yet.
+	out, err := push(&empty, first)
+	require.NoError(t, err, out)
+	out, err = push(&empty, second)
+	require.Error(t, err)
+	require.Contains(t, out, "(stale info)")
+
+	// The ref must point at the expected commit.
+	out, err = push(&second, second)
+	require.Error(t, err)
+	require.Contains(t, out, "(stale info)")
+	out, err = push(&first, second)
+	require.NoError(t, err, out)
+	require.Equal(t, second, mustRun(remoteDir, "rev-parse", ref))
+
+	// Without a lease, the ref is overwritten.
+	out, err = push(nil, first)
+	require.NoError(t, err, out)
+	require.Equal(t, first, mustRun(remoteDir, "rev-parse", ref))
+}
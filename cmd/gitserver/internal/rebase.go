@@ -0,0 +1,112 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// rebasePreviewTimeout limits how long replaying a series of commits may take.
+const rebasePreviewTimeout = 5 * time.Minute
+
+// RebasePreview replays the commits in base..head onto onto in a temporary
+// worktree, like git rebase --onto onto base head would, and reports whether
+// they apply cleanly. Nothing is written to the repo.
+//
+// Like git rebase, merge commits are skipped and commits that end up without
+// changes are dropped. Unlike git rebase, the replayed commits keep their
+// original committer, so the resulting commit IDs don't depend on who runs the
+// preview and when.
+func (s *Server) RebasePreview(ctx context.Context, repo api.RepoName, base, head, onto api.CommitID) (gitdomain.RebasePreview, error) {
+	ctx, cancel := context.WithTimeout(ctx, rebasePreviewTimeout)
+	defer cancel()
+
+	for _, commit := range []api.CommitID{base, head, onto} {
+		if err := s.checkCommitExists(ctx, repo, commit); err != nil {
+			return gitdomain.RebasePreview{}, err
+		}
+	}
+
+	w, cleanup, err := s.newTempWorktree(ctx, repo, onto)
+	if err != nil {
+		return gitdomain.RebasePreview{}, err
+	}
+	defer cleanup()
+
+	out, err := w.git(ctx, "rev-list", "--reverse", "--topo-order", "--no-merges", string(base)+".."+string(head))
+	if err != nil {
+		return gitdomain.RebasePreview{}, err
+	}
+
+	var preview gitdomain.RebasePreview
+	for _, commit := range strings.Fields(string(out)) {
+		replayed, conflicts, err := w.cherryPick(ctx, api.CommitID(commit))
+		if err != nil {
+			return gitdomain.RebasePreview{}, errors.Wrapf(err, "replaying commit %s", commit)
+		}
+		if len(conflicts) > 0 {
+			preview.ConflictingCommit = api.CommitID(commit)
+			preview.ConflictingPaths = conflicts
+			return preview, nil
+		}
+		if replayed != "" {
+			preview.Commits = append(preview.Commits, replayed)
+		}
+	}
+	preview.Clean = true
+	return preview, nil
+}
+
+// cherryPick applies the changes of commit on top of HEAD and commits them
+// with the message, author and committer of commit. If the changes conflict,
+// the conflicting paths are returned and the worktree is left in the
+// conflicted state. If the changes are already contained in HEAD, no commit is
+// created and an empty commit ID is returned.
+func (w *tempWorktree) cherryPick(ctx context.Context, commit api.CommitID) (api.CommitID, []string, error) {
+	if err := w.apply(ctx, commit, "cherry-pick", "--no-commit", string(commit)); err != nil {
+		var e *gitdomain.MergeConflictError
+		if errors.As(err, &e) {
+			return "", e.Paths, nil
+		}
+		if errors.Is(err, errNoChanges) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+
+	out, err := w.git(ctx, "show", "-s", "--date=raw", "--format=%cn%x00%ce%x00%cd", string(commit))
+	if err != nil {
+		return "", nil, err
+	}
+	committer := strings.SplitN(strings.TrimSuffix(string(out), "\n"), "\x00", 3)
+	if len(committer) != 3 {
+		return "", nil, errors.Newf("unexpected committer of commit %s: %q", commit, out)
+	}
+
+	// -C reuses the message and author of commit.
+	cmd := w.command(ctx, "commit", "--quiet", "--no-verify", "-C", string(commit))
+	cmd.Env = append(cmd.Env,
+		"GIT_COMMITTER_NAME="+committer[0],
+		"GIT_COMMITTER_EMAIL="+committer[1],
+		"GIT_COMMITTER_DATE="+committer[2],
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := w.run(ctx, cmd); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return "", nil, ctxErr
+		}
+		return "", nil, errors.Wrapf(err, "git commit: %s", bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	out, err = w.git(ctx, "rev-parse", "HEAD")
+	if err != nil {
+		return "", nil, err
+	}
+	return api.CommitID(bytes.TrimSpace(out)), nil, nil
+}
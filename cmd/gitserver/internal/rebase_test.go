@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestServer_RebasePreview(t *testing.T) {
+	ctx := context.Background()
+	reposDir := t.TempDir()
+	remoteDir := filepath.Join(reposDir, "remote")
+	require.NoError(t, os.Mkdir(remoteDir, os.ModePerm))
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remoteDir, name, arg...)
+	}
+	commit := func(msg string, files ...string) api.CommitID {
+		t.Helper()
+		for i := 0; i < len(files); i += 2 {
+			cmd("sh", "-c", "echo "+files[i+1]+" > "+files[i])
+			cmd("git", "add", files[i])
+		}
+		cmd("git", "commit", "--allow-empty", "-m", msg)
+		return api.CommitID(strings.TrimSpace(cmd("git", "rev-parse", "HEAD")))
+	}
+
+	cmd("git", "init", "--initial-branch=main", ".")
+	base := commit("base", "a.txt", "a", "b.txt", "b")
+
+	cmd("git", "checkout", "-b", "feature")
+	commit("change a", "a.txt", "a2")
+	commit("add c", "c.txt", "c")
+	cmd("git", "checkout", "-b", "other", string(base))
+	commit("add d", "d.txt", "d")
+	cmd("git", "checkout", "feature")
+	cmd("git", "merge", "--no-edit", "other")
+	head := api.CommitID(strings.TrimSpace(cmd("git", "rev-parse", "HEAD")))
+
+	cmd("git", "checkout", "main")
+	commit("change b", "b.txt", "b2")
+	onto := commit("add c too", "c.txt", "c")
+	conflictingOnto := commit("change a too", "a.txt", "a3")
+
+	s := makeTestServer(ctx, t, reposDir, remoteDir, nil)
+
+	repo := api.RepoName("example.com/foo/bar")
+	repoDir := s.fs.RepoDir(repo).Path()
+	runCmd(t, reposDir, "git", "clone", "--bare", remoteDir, repoDir)
+
+	t.Run("clean", func(t *testing.T) {
+		preview, err := s.RebasePreview(ctx, repo, base, head, onto)
+		require.NoError(t, err)
+		require.True(t, preview.Clean)
+		require.Empty(t, preview.ConflictingCommit)
+		// The merge commit is skipped and "add c" is dropped because onto
+		// already adds c.txt.
+		require.Len(t, preview.Commits, 2)
+
+		// The replayed commits are not written to the repo.
+		err = exec.Command("git", "--git-dir", repoDir, "cat-file", "-e", string(preview.Commits[0])).Run()
+		require.Error(t, err)
+
+		// The commit IDs don't change when previewing again.
+		again, err := s.RebasePreview(ctx, repo, base, head, onto)
+		require.NoError(t, err)
+		require.Equal(t, preview, again)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		preview, err := s.RebasePreview(ctx, repo, base, head, conflictingOnto)
+		require.NoError(t, err)
+		require.False(t, preview.Clean)
+		require.Empty(t, preview.Commits)
+		require.Equal(t, strings.TrimSpace(cmd("git", "rev-parse", "feature~2")), string(preview.ConflictingCommit))
+		require.Equal(t, []string{"a.txt"}, preview.ConflictingPaths)
+	})
+
+	t.Run("empty series", func(t *testing.T) {
+		preview, err := s.RebasePreview(ctx, repo, head, base, onto)
+		require.NoError(t, err)
+		require.Equal(t, gitdomain.RebasePreview{Clean: true}, preview)
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := s.RebasePreview(ctx, repo, base, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", onto)
+		var e *gitdomain.RevisionNotFoundError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", e.Spec)
+	})
+}
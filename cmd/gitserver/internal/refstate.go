@@ -0,0 +1,353 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// refStateFile is the file in the git dir that records when the refs of the
+// repo last moved. It is updated by setLastChanged.
+const refStateFile = "sg_refstate"
+
+// refStateTombstoneTTL is how long deleted refs are remembered. Changes
+// relative to older states can't be computed, so all refs are returned for
+// them.
+const refStateTombstoneTTL = 7 * 24 * time.Hour
+
+// refStateMu serializes updates of ref state files, so that concurrent
+// updates of a repo don't hand out the same generation for different states.
+var refStateMu sync.Mutex
+
+// refState records, for each ref, the commit it points at and the generation
+// and time at which it last moved. Each update that moves refs increments the
+// generation. Deleted refs are kept as tombstones with an empty commit for
+// refStateTombstoneTTL.
+type refState struct {
+	// epoch identifies the file. It changes when the file is recreated, for
+	// example after a reclone, so that state tokens from before are detected.
+	epoch      string
+	generation int64
+	// horizon is the oldest generation changes can be computed relative to,
+	// and horizonTime the time it was created at.
+	horizon     int64
+	horizonTime time.Time
+	refs        map[string]refStateEntry
+}
+
+type refStateEntry struct {
+	commit     api.CommitID
+	generation int64
+	changed    time.Time
+}
+
+// RefsChangedSince returns the refs of the repo that moved since the state
+// identified by token, or since the given time if token is empty. If the repo
+// has no usable ref state, it is created, and all refs are returned.
+func (s *Server) RefsChangedSince(ctx context.Context, repo api.RepoName, token string, since time.Time) (gitdomain.RefChanges, error) {
+	st, err := loadRefState(s.fs.RepoDir(repo))
+	if err != nil {
+		return gitdomain.RefChanges{}, err
+	}
+
+	changes, full, err := st.changesSince(token, since)
+	if err != nil {
+		return gitdomain.RefChanges{}, err
+	}
+	return gitdomain.RefChanges{Changes: changes, StateToken: st.token(), Full: full}, nil
+}
+
+// refWatchPollInterval is how often WatchRefs checks whether the refs of the
+// repo moved.
+var refWatchPollInterval = time.Second
+
+// WatchRefs calls onChanges with the current state token of the repo, and
+// then with the refs that moved whenever they move, until ctx is canceled or
+// onChanges returns an error.
+func (s *Server) WatchRefs(ctx context.Context, repo api.RepoName, onChanges func(gitdomain.RefChanges) error) error {
+	dir := s.fs.RepoDir(repo)
+	// The state file is replaced on every update, so it only needs to be
+	// read again when its mtime or size changed. It is checked before the
+	// state is loaded, so that no update in between is missed.
+	last, _ := os.Stat(dir.Path(refStateFile))
+	st, err := loadRefState(dir)
+	if err != nil {
+		return err
+	}
+	if err := onChanges(gitdomain.RefChanges{StateToken: st.token()}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(refWatchPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		fi, err := os.Stat(dir.Path(refStateFile))
+		if err != nil || (last != nil && fi.ModTime().Equal(last.ModTime()) && fi.Size() == last.Size()) {
+			continue
+		}
+		last = fi
+
+		next, err := readRefState(dir)
+		if err != nil || next == nil || next.token() == st.token() {
+			// A state that can't be read is recreated by the next update.
+			continue
+		}
+		changes, full, err := next.changesSince(st.token(), time.Time{})
+		if err != nil {
+			return err
+		}
+		st = next
+		if err := onChanges(gitdomain.RefChanges{Changes: changes, StateToken: st.token(), Full: full}); err != nil {
+			return err
+		}
+	}
+}
+
+// loadRefState reads the ref state of the repo, and creates it if the repo
+// has no usable ref state.
+func loadRefState(dir common.GitDir) (*refState, error) {
+	st, err := readRefState(dir)
+	if err != nil || st == nil {
+		st, _, err = updateRefState(dir, time.Now())
+	}
+	return st, err
+}
+
+// token returns the state token of the current generation.
+func (st *refState) token() string {
+	return st.epoch + ":" + strconv.FormatInt(st.generation, 10)
+}
+
+// changesSince returns the refs that moved after the state identified by
+// token, or after since if token is empty. If neither is set, or the changes
+// can't be computed because the state is older than the horizon, all
+// existing refs are returned and full is true.
+func (st *refState) changesSince(token string, since time.Time) (changes []gitdomain.RefChange, full bool, err error) {
+	moved := func(e refStateEntry) bool { return true }
+	full = true
+	if token != "" {
+		epoch, generation, err := parseRefStateToken(token)
+		if err != nil {
+			return nil, false, err
+		}
+		if epoch == st.epoch && generation >= st.horizon && generation <= st.generation {
+			moved = func(e refStateEntry) bool { return e.generation > generation }
+			full = false
+		}
+	} else if !since.IsZero() && !since.Before(st.horizonTime) {
+		moved = func(e refStateEntry) bool { return e.changed.After(since) }
+		full = false
+	}
+
+	for name, e := range st.refs {
+		if (full && e.commit == "") || !moved(e) {
+			continue
+		}
+		changes = append(changes, gitdomain.RefChange{Name: name, CommitID: e.commit, ChangedAt: e.changed})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	return changes, full, nil
+}
+
+func parseRefStateToken(token string) (epoch string, generation int64, err error) {
+	epoch, gen, ok := strings.Cut(token, ":")
+	if ok {
+		generation, err = strconv.ParseInt(gen, 10, 64)
+	}
+	if !ok || err != nil || epoch == "" {
+		return "", 0, errors.Newf("invalid state token %q", token)
+	}
+	return epoch, generation, nil
+}
+
+// readRefState reads the ref state of the repo. It returns nil if the repo
+// has no ref state yet.
+func readRefState(dir common.GitDir) (*refState, error) {
+	f, err := os.Open(dir.Path(refStateFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(nil, 1<<20)
+	if !sc.Scan() {
+		if err := sc.Err(); err != nil {
+			return nil, errors.Wrapf(err, "reading %s", refStateFile)
+		}
+		return nil, errors.Newf("reading %s: missing header", refStateFile)
+	}
+	st := &refState{refs: map[string]refStateEntry{}}
+	var horizonTime int64
+	if _, err := fmt.Sscanf(sc.Text(), "v1 %s %d %d %d", &st.epoch, &st.generation, &st.horizon, &horizonTime); err != nil {
+		return nil, errors.Wrapf(err, "reading %s: invalid header %q", refStateFile, sc.Text())
+	}
+	st.horizonTime = time.Unix(0, horizonTime).UTC()
+
+	// Each line is "<generation> <unix nanos> <commit or -> <ref>".
+	for sc.Scan() {
+		fields := strings.SplitN(sc.Text(), " ", 4)
+		if len(fields) != 4 {
+			return nil, errors.Newf("reading %s: invalid line %q", refStateFile, sc.Text())
+		}
+		generation, err1 := strconv.ParseInt(fields[0], 10, 64)
+		changed, err2 := strconv.ParseInt(fields[1], 10, 64)
+		if err1 != nil || err2 != nil {
+			return nil, errors.Newf("reading %s: invalid line %q", refStateFile, sc.Text())
+		}
+		e := refStateEntry{generation: generation, changed: time.Unix(0, changed).UTC()}
+		if fields[2] != "-" {
+			e.commit = api.CommitID(fields[2])
+		}
+		st.refs[fields[3]] = e
+	}
+	if err := sc.Err(); err != nil {
+		return nil, errors.Wrapf(err, "reading %s", refStateFile)
+	}
+	return st, nil
+}
+
+func writeRefState(dir common.GitDir, st *refState) error {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "v1 %s %d %d %d\n", st.epoch, st.generation, st.horizon, st.horizonTime.UnixNano())
+	names := make([]string, 0, len(st.refs))
+	for name := range st.refs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		e := st.refs[name]
+		commit := string(e.commit)
+		if commit == "" {
+			commit = "-"
+		}
+		fmt.Fprintf(&b, "%d %d %s %s\n", e.generation, e.changed.UnixNano(), commit, name)
+	}
+	_, err := fileutil.UpdateFileIfDifferent(dir.Path(refStateFile), b.Bytes())
+	return err
+}
+
+// updateRefState records the refs that moved since the last update in the ref
+// state of the repo, creating it if needed, and returns the updated state and
+// the refs that moved. Refs created before the state existed are recorded as
+// moved at now, and aren't returned.
+func updateRefState(dir common.GitDir, now time.Time) (*refState, []gitdomain.RefChange, error) {
+	refStateMu.Lock()
+	defer refStateMu.Unlock()
+
+	current, err := listRefTargets(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	st, err := readRefState(dir)
+	if err != nil || st == nil {
+		// Start over if the state is missing or can't be read. The new epoch
+		// invalidates all state tokens handed out before.
+		epoch := make([]byte, 8)
+		if _, err := rand.Read(epoch); err != nil {
+			return nil, nil, err
+		}
+		st = &refState{
+			epoch:       hex.EncodeToString(epoch),
+			generation:  1,
+			horizon:     1,
+			horizonTime: now,
+			refs:        make(map[string]refStateEntry, len(current)),
+		}
+		for name, commit := range current {
+			st.refs[name] = refStateEntry{commit: commit, generation: 1, changed: now}
+		}
+		return st, nil, writeRefState(dir, st)
+	}
+
+	var changes []gitdomain.RefChange
+	for name, commit := range current {
+		if e, ok := st.refs[name]; !ok || e.commit != commit {
+			changes = append(changes, gitdomain.RefChange{Name: name, CommitID: commit, ChangedAt: now})
+		}
+	}
+	for name, e := range st.refs {
+		if _, ok := current[name]; !ok && e.commit != "" {
+			changes = append(changes, gitdomain.RefChange{Name: name, ChangedAt: now})
+		}
+	}
+
+	pruned := false
+	for name, e := range st.refs {
+		if e.commit == "" && now.Sub(e.changed) > refStateTombstoneTTL {
+			delete(st.refs, name)
+			// Changes relative to the generation of the deletion or older
+			// can't be computed anymore.
+			if e.generation >= st.horizon {
+				st.horizon = e.generation + 1
+				st.horizonTime = e.changed
+			}
+			pruned = true
+		}
+	}
+
+	if len(changes) == 0 && !pruned {
+		return st, nil, nil
+	}
+	if len(changes) > 0 {
+		st.generation++
+	}
+	for _, c := range changes {
+		st.refs[c.Name] = refStateEntry{commit: c.CommitID, generation: st.generation, changed: now}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	return st, changes, writeRefState(dir, st)
+}
+
+// listRefTargets returns the commit each ref of the repo points at, peeling
+// annotated tags. Temporary refs below tempRefPrefix are skipped.
+func listRefTargets(dir common.GitDir) (map[string]api.CommitID, error) {
+	// Do not use CommandContext since this is a fast operation we do not want
+	// to interrupt.
+	cmd := exec.Command("git", "for-each-ref", "--format=%(objectname) %(*objectname) %(refname)")
+	dir.Set(cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrap(err, "git for-each-ref")
+	}
+
+	refs := map[string]api.CommitID{}
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) != 3 || strings.HasPrefix(fields[2], tempRefPrefix) {
+			continue
+		}
+		commit := fields[0]
+		if fields[1] != "" {
+			commit = fields[1]
+		}
+		refs[fields[2]] = api.CommitID(commit)
+	}
+	return refs, nil
+}
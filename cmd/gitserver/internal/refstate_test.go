@@ -0,0 +1,203 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+func TestRefState(t *testing.T) {
+	workDir := t.TempDir()
+	dir := common.GitDir(filepath.Join(workDir, ".git"))
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, workDir, name, arg...)
+	}
+	commit := func(msg string) api.CommitID {
+		t.Helper()
+		cmd("git", "commit", "--allow-empty", "-m", msg)
+		return api.CommitID(strings.TrimSpace(cmd("git", "rev-parse", "HEAD")))
+	}
+
+	cmd("git", "init", "--initial-branch=main", ".")
+	first := commit("first")
+	cmd("git", "tag", "-a", "-m", "v1", "v1")
+	cmd("git", "branch", "old")
+	cmd("git", "update-ref", tempRefPrefix+string(first), string(first))
+
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	st, changes, err := updateRefState(dir, t0)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+	initial := st.token()
+
+	// Without a token, all refs are returned.
+	all, full, err := st.changesSince("", time.Time{})
+	require.NoError(t, err)
+	require.True(t, full)
+	require.Equal(t, []gitdomain.RefChange{
+		{Name: "refs/heads/main", CommitID: first, ChangedAt: t0},
+		{Name: "refs/heads/old", CommitID: first, ChangedAt: t0},
+		// Annotated tags are peeled.
+		{Name: "refs/tags/v1", CommitID: first, ChangedAt: t0},
+	}, all)
+
+	// Nothing moved.
+	t1 := t0.Add(time.Hour)
+	st, changes, err = updateRefState(dir, t1)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+	require.Equal(t, initial, st.token())
+
+	second := commit("second")
+	cmd("git", "branch", "-D", "old")
+	cmd("git", "branch", "new")
+	t2 := t0.Add(2 * time.Hour)
+	st, changes, err = updateRefState(dir, t2)
+	require.NoError(t, err)
+	want := []gitdomain.RefChange{
+		{Name: "refs/heads/main", CommitID: second, ChangedAt: t2},
+		{Name: "refs/heads/new", CommitID: second, ChangedAt: t2},
+		{Name: "refs/heads/old", ChangedAt: t2},
+	}
+	require.Equal(t, want, changes)
+	require.NotEqual(t, initial, st.token())
+
+	// The state is persisted.
+	st, err = readRefState(dir)
+	require.NoError(t, err)
+
+	t.Run("since token", func(t *testing.T) {
+		got, full, err := st.changesSince(initial, time.Time{})
+		require.NoError(t, err)
+		require.False(t, full)
+		require.Equal(t, want, got)
+
+		got, full, err = st.changesSince(st.token(), time.Time{})
+		require.NoError(t, err)
+		require.False(t, full)
+		require.Empty(t, got)
+	})
+
+	t.Run("since time", func(t *testing.T) {
+		got, full, err := st.changesSince("", t1)
+		require.NoError(t, err)
+		require.False(t, full)
+		require.Equal(t, want, got)
+
+		// Before the state was created.
+		got, full, err = st.changesSince("", t0.Add(-time.Hour))
+		require.NoError(t, err)
+		require.True(t, full)
+		require.Len(t, got, 3)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		_, full, err := st.changesSince("0123456789abcdef:1", time.Time{})
+		require.NoError(t, err)
+		require.True(t, full)
+
+		_, _, err = st.changesSince("nope", time.Time{})
+		require.Error(t, err)
+	})
+
+	t.Run("tombstones expire", func(t *testing.T) {
+		t3 := t2.Add(refStateTombstoneTTL + time.Hour)
+		st, changes, err := updateRefState(dir, t3)
+		require.NoError(t, err)
+		require.Empty(t, changes)
+
+		// The deletion of old can't be reported anymore.
+		_, full, err := st.changesSince(initial, time.Time{})
+		require.NoError(t, err)
+		require.True(t, full)
+		_, full, err = st.changesSince("", t1)
+		require.NoError(t, err)
+		require.True(t, full)
+
+		got, full, err := st.changesSince("", t2)
+		require.NoError(t, err)
+		require.False(t, full)
+		require.Empty(t, got)
+	})
+
+	t.Run("corrupt state", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(dir.Path(refStateFile), []byte("garbage"), 0o600))
+		st, changes, err := updateRefState(dir, t2)
+		require.NoError(t, err)
+		require.Empty(t, changes)
+		require.Equal(t, int64(1), st.generation)
+	})
+	t.Run("updated by setLastChanged", func(t *testing.T) {
+		before, err := readRefState(dir)
+		require.NoError(t, err)
+		third := commit("third")
+
+		require.NoError(t, setLastChanged(logtest.Scoped(t), dir))
+		st, err := readRefState(dir)
+		require.NoError(t, err)
+		got, full, err := st.changesSince(before.token(), time.Time{})
+		require.NoError(t, err)
+		require.False(t, full)
+		require.Len(t, got, 1)
+		require.Equal(t, third, got[0].CommitID)
+	})
+}
+
+func TestServer_WatchRefs(t *testing.T) {
+	old := refWatchPollInterval
+	refWatchPollInterval = time.Millisecond
+	t.Cleanup(func() { refWatchPollInterval = old })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reposDir := t.TempDir()
+	remoteDir := filepath.Join(reposDir, "remote")
+	require.NoError(t, os.Mkdir(remoteDir, os.ModePerm))
+	makeSingleCommitRepo(func(name string, arg ...string) string {
+		return runCmd(t, remoteDir, name, arg...)
+	})
+
+	s := makeTestServer(ctx, t, reposDir, remoteDir, nil)
+
+	repo := api.RepoName("example.com/foo/bar")
+	dir := s.fs.RepoDir(repo)
+	runCmd(t, reposDir, "git", "clone", "--bare", remoteDir, dir.Path())
+	head := strings.TrimSpace(runCmd(t, dir.Path(), "git", "rev-parse", "HEAD"))
+
+	updates := make(chan gitdomain.RefChanges)
+	done := make(chan error)
+	go func() {
+		done <- s.WatchRefs(ctx, repo, func(c gitdomain.RefChanges) error {
+			updates <- c
+			return nil
+		})
+	}()
+
+	first := <-updates
+	require.NotEmpty(t, first.StateToken)
+	require.Empty(t, first.Changes)
+
+	runCmd(t, dir.Path(), "git", "update-ref", "refs/heads/feature", head)
+	require.NoError(t, setLastChanged(logtest.Scoped(t), dir))
+
+	second := <-updates
+	require.NotEqual(t, first.StateToken, second.StateToken)
+	require.False(t, second.Full)
+	require.Len(t, second.Changes, 1)
+	require.Equal(t, "refs/heads/feature", second.Changes[0].Name)
+	require.Equal(t, api.CommitID(head), second.Changes[0].CommitID)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
@@ -0,0 +1,216 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os/exec"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/urlredactor"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// errNoGitRemote is returned by ListRemoteRefs and FetchRefspec for repos that
+// aren't synced with git, like Perforce depots and package repos.
+var errNoGitRemote = errors.New("repository doesn't have a git remote")
+
+// remoteUnavailableError is returned by ListRemoteRefs and FetchRefspec if the
+// git command talking to the remote failed. The message is redacted.
+type remoteUnavailableError struct {
+	msg string
+}
+
+func (e *remoteUnavailableError) Error() string {
+	return e.msg
+}
+
+// ListRemoteRefs returns the refs of the upstream of repo, as reported by git
+// ls-remote for its remote URL.
+func (s *Server) ListRemoteRefs(ctx context.Context, repo api.RepoName) ([]gitdomain.Ref, error) {
+	// We use an internal actor here as the repo may be private. Callers of
+	// this RPC are trusted, like for IsRepoCloneable.
+	ctx = actor.WithInternalActor(ctx)
+	syncer, err := s.getVCSSyncer(ctx, repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetVCSSyncer")
+	}
+	if syncer.Type() != "git" {
+		return nil, errNoGitRemote
+	}
+
+	remoteURL, err := s.getRemoteURL(ctx, repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "get remote URL")
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "ls-remote", "--symref", remoteURL.String())
+	executil.ConfigureRemoteGitCommand(cmd, remoteURL)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	r := urlredactor.New(remoteURL)
+	if err := s.recordingCommandFactory.WrapWithRepoName(ctx, log.NoOp(), repo, cmd).WithRedactorFunc(r.Redact).Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		msg := "git ls-remote failed: " + err.Error()
+		if out := strings.TrimSpace(stderr.String()); out != "" {
+			msg += ": " + out
+		}
+		return nil, &remoteUnavailableError{msg: r.Redact(msg)}
+	}
+
+	return parseLsRemoteOutput(stdout.Bytes())
+}
+
+// FetchRefspec fetches the refs matching refspec from the upstream of repo.
+// The refspec must have been validated with gitdomain.ValidateFetchRefspec.
+func (s *Server) FetchRefspec(ctx context.Context, repo api.RepoName, refspec string) error {
+	// We use an internal actor here as the repo may be private, see
+	// ListRemoteRefs.
+	ctx = actor.WithInternalActor(ctx)
+	syncer, err := s.getVCSSyncer(ctx, repo)
+	if err != nil {
+		return errors.Wrap(err, "GetVCSSyncer")
+	}
+	if syncer.Type() != "git" {
+		return errNoGitRemote
+	}
+
+	remoteURL, err := s.getRemoteURL(ctx, repo)
+	if err != nil {
+		return errors.Wrap(err, "get remote URL")
+	}
+
+	dir := s.fs.RepoDir(repo)
+	cmd := exec.CommandContext(ctx, "git", "fetch", "--no-tags", "--no-write-fetch-head", remoteURL.String(), refspec)
+	executil.ConfigureRemoteGitCommand(cmd, remoteURL)
+	dir.Set(cmd)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	r := urlredactor.New(remoteURL)
+	if err := s.recordingCommandFactory.WrapWithRepoName(ctx, log.NoOp(), repo, cmd).WithRedactorFunc(r.Redact).Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if bytes.Contains(stderr.Bytes(), []byte("couldn't find remote ref")) {
+			src, _, _ := strings.Cut(strings.TrimPrefix(refspec, "+"), ":")
+			return &gitdomain.RevisionNotFoundError{Repo: repo, Spec: src}
+		}
+		if ref, ok := rejectedRef(stderr.Bytes()); ok {
+			return &gitdomain.RefUpdateConflictError{Repo: repo, Ref: ref}
+		}
+		msg := "git fetch failed: " + err.Error()
+		if out := strings.TrimSpace(stderr.String()); out != "" {
+			msg += ": " + out
+		}
+		return &remoteUnavailableError{msg: r.Redact(msg)}
+	}
+
+	if err := setLastChanged(s.logger, dir); err != nil {
+		s.logger.Warn("failed to update last changed time", log.String("repo", string(repo)), log.Error(err))
+	}
+
+	return nil
+}
+
+// rejectedRef returns the first local ref that git fetch refused to update,
+// because the update isn't a fast-forward and the refspec doesn't force it.
+func rejectedRef(stderr []byte) (string, bool) {
+	sc := bufio.NewScanner(bytes.NewReader(stderr))
+	for sc.Scan() {
+		line := sc.Text()
+		if !strings.Contains(line, "[rejected]") {
+			continue
+		}
+		// The line looks like:
+		//  ! [rejected]        refs/pull/1/head -> refs/pull/1/head  (non-fast-forward)
+		_, rest, ok := strings.Cut(line, " -> ")
+		if fields := strings.Fields(rest); ok && len(fields) > 0 {
+			return fields[0], true
+		}
+	}
+	return "", false
+}
+
+// parseLsRemoteOutput parses the output of git ls-remote --symref. The
+// symbolic ref HEAD isn't returned itself, instead the ref it points to is
+// marked as the head. Peeled tags, which ls-remote lists as <tag>^{}, set the
+// commit ID of the tag they belong to.
+func parseLsRemoteOutput(out []byte) ([]gitdomain.Ref, error) {
+	var (
+		refs  []gitdomain.Ref
+		index = make(map[string]int)
+		head  string
+	)
+
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		line := sc.Text()
+		if line == "" {
+			continue
+		}
+		oid, name, ok := strings.Cut(line, "\t")
+		if !ok {
+			return nil, errors.Errorf("invalid ls-remote output line %q", line)
+		}
+
+		if target, ok := strings.CutPrefix(oid, "ref: "); ok {
+			if name == "HEAD" {
+				head = target
+			}
+			continue
+		}
+		if name == "HEAD" {
+			continue
+		}
+
+		if tag, ok := strings.CutSuffix(name, "^{}"); ok {
+			if i, ok := index[tag]; ok {
+				refs[i].CommitID = api.CommitID(oid)
+			}
+			continue
+		}
+
+		ref := gitdomain.Ref{
+			Name:      name,
+			ShortName: shortRefName(name),
+			Type:      gitdomain.RefTypeBranch,
+			CommitID:  api.CommitID(oid),
+			RefOID:    api.CommitID(oid),
+		}
+		if strings.HasPrefix(name, "refs/tags/") {
+			ref.Type = gitdomain.RefTypeTag
+		}
+		index[name] = len(refs)
+		refs = append(refs, ref)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	if i, ok := index[head]; ok {
+		refs[i].IsHead = true
+	}
+	return refs, nil
+}
+
+// shortRefName abbreviates a fully qualified ref name the way
+// %(refname:short) does for refs that aren't ambiguous.
+func shortRefName(name string) string {
+	for _, prefix := range []string{"refs/heads/", "refs/tags/", "refs/remotes/", "refs/"} {
+		if short, ok := strings.CutPrefix(name, prefix); ok {
+			return short
+		}
+	}
+	return name
+}
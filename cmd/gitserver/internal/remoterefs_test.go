@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestServer_ListRemoteRefs(t *testing.T) {
+	ctx := context.Background()
+	reposDir := t.TempDir()
+	remoteDir := filepath.Join(reposDir, "remote")
+	require.NoError(t, os.Mkdir(remoteDir, os.ModePerm))
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remoteDir, name, arg...)
+	}
+	head := strings.TrimSpace(makeSingleCommitRepo(cmd))
+	cmd("git", "branch", "-M", "main")
+	cmd("git", "branch", "upstream-only")
+	cmd("git", "tag", "v1")
+	cmd("git", "tag", "-a", "v2", "-m", "v2")
+	tagOID := strings.TrimSpace(cmd("git", "rev-parse", "v2"))
+
+	s := makeTestServer(ctx, t, reposDir, remoteDir, nil)
+
+	// The repo doesn't need to be cloned.
+	refs, err := s.ListRemoteRefs(ctx, "example.com/foo/bar")
+	require.NoError(t, err)
+	id := api.CommitID(head)
+	require.Equal(t, []gitdomain.Ref{
+		{Name: "refs/heads/main", ShortName: "main", Type: gitdomain.RefTypeBranch, CommitID: id, RefOID: id, IsHead: true},
+		{Name: "refs/heads/upstream-only", ShortName: "upstream-only", Type: gitdomain.RefTypeBranch, CommitID: id, RefOID: id},
+		{Name: "refs/tags/v1", ShortName: "v1", Type: gitdomain.RefTypeTag, CommitID: id, RefOID: id},
+		{Name: "refs/tags/v2", ShortName: "v2", Type: gitdomain.RefTypeTag, CommitID: id, RefOID: api.CommitID(tagOID)},
+	}, refs)
+
+	t.Run("unreachable remote", func(t *testing.T) {
+		s := makeTestServer(ctx, t, reposDir, filepath.Join(reposDir, "missing"), nil)
+		_, err := s.ListRemoteRefs(ctx, "example.com/foo/bar")
+		var e *remoteUnavailableError
+		require.True(t, errors.As(err, &e), "unexpected error %v", err)
+	})
+}
+
+func TestServer_FetchRefspec(t *testing.T) {
+	ctx := context.Background()
+	reposDir := t.TempDir()
+	remoteDir := filepath.Join(reposDir, "remote")
+	require.NoError(t, os.Mkdir(remoteDir, os.ModePerm))
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remoteDir, name, arg...)
+	}
+	base := strings.TrimSpace(makeSingleCommitRepo(cmd))
+
+	s := makeTestServer(ctx, t, reposDir, remoteDir, nil)
+
+	repo := api.RepoName("example.com/foo/bar")
+	repoDir := s.fs.RepoDir(repo).Path()
+	runCmd(t, reposDir, "git", "clone", "--bare", remoteDir, repoDir)
+
+	// The pull request refs are created after the repo was cloned.
+	cmd("sh", "-c", "echo pr > hello.txt")
+	pr1 := strings.TrimSpace(addCommitToRepo(cmd))
+	cmd("git", "update-ref", "refs/pull/1/head", pr1)
+	cmd("git", "update-ref", "refs/pull/2/head", base)
+
+	require.NoError(t, s.FetchRefspec(ctx, repo, "refs/pull/*/head:refs/pull/*/head"))
+	refs := runCmd(t, repoDir, "git", "for-each-ref", "--format=%(objectname) %(refname)", "refs/pull/")
+	require.Equal(t, pr1+" refs/pull/1/head\n"+base+" refs/pull/2/head\n", refs)
+
+	t.Run("missing ref", func(t *testing.T) {
+		err := s.FetchRefspec(ctx, repo, "refs/pull/3/head:refs/pull/3/head")
+		var e *gitdomain.RevisionNotFoundError
+		require.True(t, errors.As(err, &e), "unexpected error %v", err)
+		require.Equal(t, "refs/pull/3/head", e.Spec)
+	})
+
+	t.Run("non-fast-forward", func(t *testing.T) {
+		cmd("git", "commit", "--amend", "-m", "rewritten")
+		rewritten := strings.TrimSpace(cmd("git", "rev-parse", "HEAD"))
+		cmd("git", "update-ref", "refs/pull/1/head", rewritten)
+
+		err := s.FetchRefspec(ctx, repo, "refs/pull/*/head:refs/pull/*/head")
+		var e *gitdomain.RefUpdateConflictError
+		require.True(t, errors.As(err, &e), "unexpected error %v", err)
+		require.Equal(t, "refs/pull/1/head", e.Ref)
+
+		// Forcing the update succeeds.
+		require.NoError(t, s.FetchRefspec(ctx, repo, "+refs/pull/*/head:refs/pull/*/head"))
+		require.Equal(t, rewritten, strings.TrimSpace(runCmd(t, repoDir, "git", "rev-parse", "refs/pull/1/head")))
+	})
+
+	t.Run("unreachable remote", func(t *testing.T) {
+		s := makeTestServer(ctx, t, reposDir, filepath.Join(reposDir, "missing"), nil)
+		err := s.FetchRefspec(ctx, repo, "refs/pull/1/head:refs/pull/1/head")
+		var e *remoteUnavailableError
+		require.True(t, errors.As(err, &e), "unexpected error %v", err)
+	})
+}
+
+func TestRejectedRef(t *testing.T) {
+	ref, ok := rejectedRef([]byte("From https://github.com/foo/bar\n" +
+		" ! [rejected]        refs/pull/1/head -> refs/pull/1/head  (non-fast-forward)\n"))
+	require.True(t, ok)
+	require.Equal(t, "refs/pull/1/head", ref)
+
+	_, ok = rejectedRef([]byte("fatal: unable to access\n"))
+	require.False(t, ok)
+}
+
+func TestParseLsRemoteOutput(t *testing.T) {
+	out := "ref: refs/heads/trunk\tHEAD\n" +
+		"1111111111111111111111111111111111111111\tHEAD\n" +
+		"2222222222222222222222222222222222222222\trefs/heads/main\n" +
+		"1111111111111111111111111111111111111111\trefs/heads/trunk\n" +
+		"3333333333333333333333333333333333333333\trefs/pull/1/head\n" +
+		"4444444444444444444444444444444444444444\trefs/tags/v1\n" +
+		"2222222222222222222222222222222222222222\trefs/tags/v1^{}\n"
+
+	refs, err := parseLsRemoteOutput([]byte(out))
+	require.NoError(t, err)
+	require.Equal(t, []gitdomain.Ref{
+		{Name: "refs/heads/main", ShortName: "main", Type: gitdomain.RefTypeBranch, CommitID: "2222222222222222222222222222222222222222", RefOID: "2222222222222222222222222222222222222222"},
+		{Name: "refs/heads/trunk", ShortName: "trunk", Type: gitdomain.RefTypeBranch, CommitID: "1111111111111111111111111111111111111111", RefOID: "1111111111111111111111111111111111111111", IsHead: true},
+		{Name: "refs/pull/1/head", ShortName: "pull/1/head", Type: gitdomain.RefTypeBranch, CommitID: "3333333333333333333333333333333333333333", RefOID: "3333333333333333333333333333333333333333"},
+		{Name: "refs/tags/v1", ShortName: "v1", Type: gitdomain.RefTypeTag, CommitID: "2222222222222222222222222222222222222222", RefOID: "4444444444444444444444444444444444444444"},
+	}, refs)
+
+	_, err = parseLsRemoteOutput([]byte("garbage\n"))
+	require.Error(t, err)
+}
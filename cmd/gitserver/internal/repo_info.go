@@ -2,11 +2,13 @@ package internal
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -28,6 +30,48 @@ func repoCloneProgress(fs gitserverfs.FS, locker RepositoryLocker, repo api.Repo
 	return &resp, nil
 }
 
+// gitProgressPattern matches the progress lines git prints while cloning, like
+// "Receiving objects:  45% (450/1000), 1.20 MiB | 2.40 MiB/s".
+var gitProgressPattern = lazyregexp.New(`^(?:remote: )?([A-Za-z][A-Za-z ]*):\s+(\d{1,3})%`)
+
+// parseCloneProgress returns the phase and its completion in percent from a
+// progress message of a clone. The phase is empty if the message isn't a
+// progress line of git.
+func parseCloneProgress(message string) (phase string, percent int) {
+	m := gitProgressPattern.FindStringSubmatch(message)
+	if m == nil {
+		return "", 0
+	}
+	percent, err := strconv.Atoi(m[2])
+	if err != nil || percent > 100 {
+		return "", 0
+	}
+	return m[1], percent
+}
+
+// cloneProgressUpdate is like repoCloneProgress, but also parses the phase
+// and percentage out of the progress message.
+func cloneProgressUpdate(fs gitserverfs.FS, locker RepositoryLocker, repo api.RepoName) (*protocol.CloneProgressUpdate, error) {
+	p, err := repoCloneProgress(fs, locker, repo)
+	if err != nil {
+		return nil, err
+	}
+	if !p.CloneInProgress && !p.Cloned {
+		// The clone might have finished between checking whether the repo is
+		// cloned and checking the lock.
+		if p.Cloned, err = fs.RepoCloned(repo); err != nil {
+			return nil, errors.Wrap(err, "determine clone status")
+		}
+	}
+	u := &protocol.CloneProgressUpdate{
+		CloneInProgress: p.CloneInProgress,
+		Cloned:          p.Cloned,
+		Message:         p.CloneProgress,
+	}
+	u.Phase, u.Percent = parseCloneProgress(p.CloneProgress)
+	return u, nil
+}
+
 func deleteRepo(
 	ctx context.Context,
 	db database.DB,
@@ -15,6 +15,26 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
+func TestParseCloneProgress(t *testing.T) {
+	for _, tc := range []struct {
+		message string
+		phase   string
+		percent int
+	}{
+		{message: "Receiving objects:  45% (450/1000), 1.20 MiB | 2.40 MiB/s", phase: "Receiving objects", percent: 45},
+		{message: "remote: Counting objects: 100% (5/5), done.", phase: "Counting objects", percent: 100},
+		{message: "Resolving deltas:   0% (0/8)", phase: "Resolving deltas", percent: 0},
+		{message: "Fetching remote contents"},
+		{message: "starting clone"},
+		{message: "weird: 500%"},
+		{message: ""},
+	} {
+		phase, percent := parseCloneProgress(tc.message)
+		require.Equal(t, tc.phase, phase, tc.message)
+		require.Equal(t, tc.percent, percent, tc.message)
+	}
+}
+
 func TestDeleteRepo(t *testing.T) {
 	testDeleteRepo(t, false)
 }
@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+// RevertCommitOpts configures the commit created by RevertCommit.
+type RevertCommitOpts struct {
+	// Onto is the commit to create the revert commit on top of.
+	Onto api.CommitID
+	// Mainline is the parent of a merge commit that the changes are reverted
+	// relative to, starting at 1. It must be 0 for other commits.
+	Mainline int
+	// Message is the message of the revert commit. If empty, the message git
+	// revert would use is used.
+	Message   string
+	Author    gitdomain.Signature
+	Committer gitdomain.Signature
+}
+
+// RevertCommit creates a commit on top of opts.Onto that reverts the changes
+// of commit, and returns its ID. The commit is created in a temporary worktree
+// and kept under a ref below tempRefPrefix.
+//
+// If the changes can't be reverted without conflicts, a MergeConflictError is
+// returned. If reverting results in no changes, errNoChanges is returned.
+func (s *Server) RevertCommit(ctx context.Context, repo api.RepoName, commit api.CommitID, opts RevertCommitOpts) (api.CommitID, error) {
+	ctx, cancel := context.WithTimeout(ctx, worktreeExecTimeout)
+	defer cancel()
+
+	for _, c := range []api.CommitID{commit, opts.Onto} {
+		if err := s.checkCommitExists(ctx, repo, c); err != nil {
+			return "", err
+		}
+	}
+
+	w, cleanup, err := s.newTempWorktree(ctx, repo, opts.Onto)
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+
+	args := []string{"revert", "--no-commit"}
+	if opts.Mainline > 0 {
+		args = append(args, "-m", strconv.Itoa(opts.Mainline))
+	}
+	if err := w.apply(ctx, commit, append(args, string(commit))...); err != nil {
+		return "", err
+	}
+
+	message := opts.Message
+	if message == "" {
+		// git revert --no-commit prepares the message it would use.
+		if message, err = w.preparedMessage(); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.commit(ctx, message, opts.Author, opts.Committer); err != nil {
+		return "", err
+	}
+	return w.publish(ctx)
+}
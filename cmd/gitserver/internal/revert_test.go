@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestServer_RevertCommit(t *testing.T) {
+	ctx := context.Background()
+	reposDir := t.TempDir()
+	remoteDir := filepath.Join(reposDir, "remote")
+	require.NoError(t, os.Mkdir(remoteDir, os.ModePerm))
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remoteDir, name, arg...)
+	}
+	commit := func(msg, file, content string) api.CommitID {
+		t.Helper()
+		cmd("sh", "-c", "echo "+content+" > "+file)
+		cmd("git", "add", file)
+		cmd("git", "commit", "-m", msg)
+		return api.CommitID(strings.TrimSpace(cmd("git", "rev-parse", "HEAD")))
+	}
+
+	cmd("git", "init", ".")
+	commit("add a", "a.txt", "a")
+	changeA := commit("change a", "a.txt", "a2")
+	addB := commit("add b", "b.txt", "b")
+	changeAAgain := commit("change a again", "a.txt", "a3")
+
+	s := makeTestServer(ctx, t, reposDir, remoteDir, nil)
+
+	repo := api.RepoName("example.com/foo/bar")
+	repoDir := s.fs.RepoDir(repo).Path()
+	runCmd(t, reposDir, "git", "clone", "--bare", remoteDir, repoDir)
+	git := func(args ...string) string {
+		t.Helper()
+		return strings.TrimSpace(runCmd(t, repoDir, "git", args...))
+	}
+
+	author := gitdomain.Signature{Name: "Jane Doe", Email: "jane@example.com", Date: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+	bot := gitdomain.Signature{Name: "Bot", Email: "bot@example.com"}
+
+	t.Run("default message", func(t *testing.T) {
+		revert, err := s.RevertCommit(ctx, repo, addB, RevertCommitOpts{Onto: changeAAgain, Author: author, Committer: bot})
+		require.NoError(t, err)
+
+		require.Equal(t, string(revert), git("rev-parse", "refs/sourcegraph/tmp/"+string(revert)))
+		require.Equal(t, string(changeAAgain), git("rev-parse", string(revert)+"^"))
+		require.Equal(t, "a.txt", git("ls-tree", "--name-only", string(revert)))
+		require.Equal(t, "Revert \"add b\"\n\nThis reverts commit "+string(addB)+".", git("log", "-1", "--format=%B", string(revert)))
+		require.Equal(t, "Jane Doe <jane@example.com> 2024-01-02T03:04:05+00:00", git("log", "-1", "--format=%an <%ae> %aI", string(revert)))
+		require.Equal(t, "Bot <bot@example.com>", git("log", "-1", "--format=%cn <%ce>", string(revert)))
+	})
+
+	t.Run("custom message", func(t *testing.T) {
+		revert, err := s.RevertCommit(ctx, repo, changeAAgain, RevertCommitOpts{Onto: changeAAgain, Message: "Undo a3", Author: author, Committer: author})
+		require.NoError(t, err)
+		require.Equal(t, "Undo a3", git("log", "-1", "--format=%B", string(revert)))
+		require.Equal(t, "a2", git("show", string(revert)+":a.txt"))
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		_, err := s.RevertCommit(ctx, repo, changeA, RevertCommitOpts{Onto: changeAAgain, Author: author, Committer: author})
+		var e *gitdomain.MergeConflictError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, changeA, e.Commit)
+		require.Equal(t, []string{"a.txt"}, e.Paths)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		_, err := s.RevertCommit(ctx, repo, addB, RevertCommitOpts{Onto: changeA, Author: author, Committer: author})
+		require.ErrorIs(t, err, errNoChanges)
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := s.RevertCommit(ctx, repo, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", RevertCommitOpts{Onto: changeA, Author: author, Committer: author})
+		var e *gitdomain.RevisionNotFoundError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", e.Spec)
+	})
+}
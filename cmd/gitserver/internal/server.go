@@ -32,6 +32,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/fileutil"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/limiter"
 	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
@@ -131,6 +132,10 @@ func NewServer(opt *ServerOpts) *Server {
 		recordingCommandFactory: opt.RecordingCommandFactory,
 		perforce:                opt.Perforce,
 		fs:                      opt.FS,
+		gitserverAddrForRepo: func(ctx context.Context, repo api.RepoName) string {
+			addrs := gitserver.NewGitserverAddresses(conf.Get())
+			return addrs.AddrForRepo(ctx, repo)
+		},
 
 		repoUpdateLocks: make(map[api.RepoName]*locks),
 		cloneLimiter:    cloneLimiter,
@@ -169,6 +174,10 @@ type Server struct {
 	// actual hostname but can also be overridden by the HOSTNAME environment variable.
 	hostname string
 
+	// gitserverAddrForRepo returns the address of the gitserver instance that
+	// hosts a repository. It is used to fetch from other instances.
+	gitserverAddrForRepo func(context.Context, api.RepoName) string
+
 	// db provides access to datastores.
 	db database.DB
 
@@ -1020,6 +1029,9 @@ func (s *Server) doBackgroundRepoUpdate(repo api.RepoName, revspec string) error
 // the show-ref output, and store it in the file if and only if it's
 // different from the current contents.
 //
+// Whenever the hash changes, the refs that moved are also recorded in the ref
+// state of the repo, see updateRefState.
+//
 // If show-ref fails, we use rev-list to determine whether that's just
 // an empty repository (not an error) or some kind of actual error
 // that is possibly causing our data to be incorrect, which should
@@ -1039,11 +1051,18 @@ func setLastChanged(logger log.Logger, dir common.GitDir) error {
 		stamp = git.LatestCommitTimestamp(logger, dir)
 	}
 
-	_, err = fileutil.UpdateFileIfDifferent(hashFile, hash)
+	updated, err := fileutil.UpdateFileIfDifferent(hashFile, hash)
 	if err != nil {
 		return errors.Wrapf(err, "failed to update %s", hashFile)
 	}
 
+	// Record which refs moved, for RefsChangedSince.
+	if _, err := os.Stat(dir.Path(refStateFile)); updated || os.IsNotExist(err) {
+		if _, _, err := updateRefState(dir, time.Now()); err != nil {
+			return errors.Wrap(err, "failed to update ref state")
+		}
+	}
+
 	// If stamp is non-zero we have a more approriate mtime.
 	if !stamp.IsZero() {
 		err = os.Chtimes(hashFile, stamp, stamp)
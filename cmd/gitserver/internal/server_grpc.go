@@ -2,18 +2,25 @@ package internal
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"hash"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	godiff "github.com/sourcegraph/go-diff/diff"
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/accesslog"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git/gitcli"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
@@ -28,6 +35,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/chunk"
+	"github.com/sourcegraph/sourcegraph/internal/grpc/compression"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/streamio"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
@@ -42,6 +50,18 @@ type service interface {
 	RepoUpdate(ctx context.Context, req *protocol.RepoUpdateRequest) protocol.RepoUpdateResponse
 	SearchWithObservability(ctx context.Context, tr trace.Trace, args *protocol.SearchRequest, onMatch func(*protocol.CommitMatch) error) (limitHit bool, err error)
 	EnsureRevision(ctx context.Context, repo api.RepoName, rev string) (didUpdate bool)
+	ListRemoteRefs(ctx context.Context, repo api.RepoName) ([]gitdomain.Ref, error)
+	RefsChangedSince(ctx context.Context, repo api.RepoName, token string, since time.Time) (gitdomain.RefChanges, error)
+	WatchRefs(ctx context.Context, repo api.RepoName, onChanges func(gitdomain.RefChanges) error) error
+	WatchDefaultBranchCommits(ctx context.Context, repo api.RepoName, onCommits func(gitdomain.BranchCommits) error) error
+	FetchRefspec(ctx context.Context, repo api.RepoName, refspec string) error
+	FetchCommitFromRepo(ctx context.Context, repo, source api.RepoName, commit api.CommitID) (fetched bool, err error)
+	ApplyBundle(ctx context.Context, repo api.RepoName, r io.Reader) error
+	ExecInWorktree(ctx context.Context, repo api.RepoName, commit api.CommitID, args []string, stdin []byte) (gitdomain.WorktreeExecResult, error)
+	RebasePreview(ctx context.Context, repo api.RepoName, base, head, onto api.CommitID) (gitdomain.RebasePreview, error)
+	RevertCommit(ctx context.Context, repo api.RepoName, commit api.CommitID, opts RevertCommitOpts) (api.CommitID, error)
+	CherryPick(ctx context.Context, repo api.RepoName, commit api.CommitID, opts CherryPickOpts) (api.CommitID, error)
+	SquashCommits(ctx context.Context, repo api.RepoName, base, head api.CommitID, opts SquashCommitsOpts) (api.CommitID, error)
 }
 
 func NewGRPCServer(server *Server) proto.GitserverServiceServer {
@@ -103,6 +123,16 @@ func (gs *grpcServer) CreateCommitFromPatchBinary(s proto.GitserverService_Creat
 
 	var r protocol.CreateCommitFromPatchRequest
 	r.FromProto(metadata)
+	for _, t := range r.CommitInfo.Trailers {
+		if err := t.Validate(); err != nil {
+			return status.New(codes.InvalidArgument, err.Error()).Err()
+		}
+	}
+	if r.Push != nil {
+		if err := r.Push.Validate(); err != nil {
+			return status.New(codes.InvalidArgument, err.Error()).Err()
+		}
+	}
 	resp := gs.svc.CreateCommitFromPatch(s.Context(), r, patchReader)
 	res, patchErr := resp.ToProto()
 	if patchErr != nil {
@@ -125,6 +155,12 @@ func (gs *grpcServer) DiskInfo(_ context.Context, _ *proto.DiskInfoRequest) (*pr
 	}, nil
 }
 
+func (gs *grpcServer) Capabilities(_ context.Context, _ *proto.CapabilitiesRequest) (*proto.CapabilitiesResponse, error) {
+	return &proto.CapabilitiesResponse{
+		Compressors: compression.Supported(),
+	}, nil
+}
+
 func (gs *grpcServer) Exec(req *proto.ExecRequest, ss proto.GitserverService_ExecServer) error {
 	ctx := ss.Context()
 
@@ -215,10 +251,14 @@ func (gs *grpcServer) Exec(req *proto.ExecRequest, ss proto.GitserverService_Exe
 			if commandFailedErr.Unwrap() != nil {
 				errString = commandFailedErr.Unwrap().Error()
 			}
-			s, err := status.New(gRPCStatus, errString).WithDetails(&proto.ExecStatusPayload{
+			details := []protoadapt.MessageV1{&proto.ExecStatusPayload{
 				StatusCode: int32(commandFailedErr.ExitStatus),
 				Stderr:     string(commandFailedErr.Stderr),
-			})
+			}}
+			if payload := gitErrorPayload(gitdomain.ParseGitError(repoName, commandFailedErr.Stderr)); payload != nil {
+				details = append(details, payload)
+			}
+			s, err := status.New(gRPCStatus, errString).WithDetails(details...)
 			if err != nil {
 				gs.logger.Error("failed to marshal status", log.Error(err))
 				return err
@@ -232,6 +272,30 @@ func (gs *grpcServer) Exec(req *proto.ExecRequest, ss proto.GitserverService_Exe
 	return nil
 }
 
+// gitErrorPayload returns the status detail that describes a typed error
+// returned by gitdomain.ParseGitError, or nil if err is not one of them.
+func gitErrorPayload(err error) protoadapt.MessageV1 {
+	switch e := err.(type) {
+	case *gitdomain.AmbiguousRevisionError:
+		payload := &proto.AmbiguousRevisionPayload{RepoName: string(e.Repo), Spec: e.Spec}
+		for _, c := range e.Candidates {
+			payload.Candidates = append(payload.Candidates, &proto.AmbiguousRevisionCandidate{
+				Id:          c.ID,
+				Type:        string(c.Type),
+				Description: c.Description,
+			})
+		}
+		return payload
+	case *gitdomain.BadObjectError:
+		return &proto.BadObjectPayload{RepoName: string(e.Repo), Object: e.Object}
+	case *gitdomain.ShallowHistoryError:
+		return &proto.ShallowHistoryPayload{RepoName: string(e.Repo), CommitSha: string(e.Commit)}
+	case *gitdomain.HookPermissionDeniedError:
+		return &proto.HookPermissionDeniedPayload{RepoName: string(e.Repo), Hook: e.Hook}
+	}
+	return nil
+}
+
 func (gs *grpcServer) Archive(req *proto.ArchiveRequest, ss proto.GitserverService_ArchiveServer) error {
 	ctx := ss.Context()
 
@@ -257,10 +321,26 @@ func (gs *grpcServer) Archive(req *proto.ArchiveRequest, ss proto.GitserverServi
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	offset := req.GetOffset()
+	if offset < 0 {
+		return status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+	if offset > 0 && !gitdomain.IsAbsoluteRevision(req.GetTreeish()) {
+		return status.Error(codes.InvalidArgument, "treeish must be an absolute commit or tree ID to resume an archive")
+	}
+
+	if req.GetFilter() != "" {
+		if _, err := gitdomain.ParseBlobFilter(req.GetFilter()); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	accesslog.Record(ctx, req.GetRepo(),
 		log.String("treeish", req.GetTreeish()),
 		log.String("format", string(format)),
 		log.Strings("path", req.GetPaths()),
+		log.Int64("offset", offset),
+		log.String("filter", req.GetFilter()),
 	)
 
 	repoName := api.RepoName(req.GetRepo())
@@ -289,7 +369,7 @@ func (gs *grpcServer) Archive(req *proto.ArchiveRequest, ss proto.GitserverServi
 
 	backend := gs.getBackendFunc(repoDir, repoName)
 
-	r, err := backend.ArchiveReader(ctx, format, req.GetTreeish(), req.GetPaths())
+	r, err := backend.ArchiveReader(ctx, format, req.GetTreeish(), req.GetPaths(), req.GetFilter())
 	if err != nil {
 		if os.IsNotExist(err) {
 			var path string
@@ -328,6 +408,19 @@ func (gs *grpcServer) Archive(req *proto.ArchiveRequest, ss proto.GitserverServi
 	}
 	defer r.Close()
 
+	// git archive is deterministic for the same object, so the bytes before
+	// the offset are the ones the client already has. They still need to be
+	// generated, but aren't sent again.
+	if offset > 0 {
+		n, err := io.CopyN(io.Discard, r, offset)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if n < offset {
+			return status.Error(codes.OutOfRange, fmt.Sprintf("offset %d is beyond the end of the archive (%d bytes)", offset, n))
+		}
+	}
+
 	w := streamio.NewWriter(func(p []byte) error {
 		return ss.Send(&proto.ArchiveResponse{
 			Data: p,
@@ -431,6 +524,42 @@ func (gs *grpcServer) RepoCloneProgress(_ context.Context, req *proto.RepoCloneP
 	return progress.ToProto(), nil
 }
 
+// cloneProgressPollInterval is how often CloneProgress checks for changes of
+// the progress of a running clone.
+var cloneProgressPollInterval = 500 * time.Millisecond
+
+func (gs *grpcServer) CloneProgress(req *proto.CloneProgressRequest, ss proto.GitserverService_CloneProgressServer) error {
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	ctx := ss.Context()
+	repoName := api.RepoName(req.GetRepoName())
+
+	var last *protocol.CloneProgressUpdate
+	for {
+		u, err := cloneProgressUpdate(gs.fs, gs.locker, repoName)
+		if err != nil {
+			return err
+		}
+		if last == nil || *u != *last {
+			if err := ss.Send(u.ToProto()); err != nil {
+				return err
+			}
+			last = u
+		}
+		if !u.CloneInProgress {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(cloneProgressPollInterval):
+		}
+	}
+}
+
 func (gs *grpcServer) RepoDelete(ctx context.Context, req *proto.RepoDeleteRequest) (*proto.RepoDeleteResponse, error) {
 	if req.GetRepo() == "" {
 		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
@@ -815,6 +944,8 @@ func (gs *grpcServer) GetCommit(ctx context.Context, req *proto.GetCommitRequest
 		ctx,
 		req.GetRepoName(),
 		log.String("commit", req.GetCommit()),
+		log.Bool("include_stat", req.GetIncludeStat()),
+		log.Bool("include_patch", req.GetIncludePatch()),
 	)
 
 	if req.GetRepoName() == "" {
@@ -873,9 +1004,72 @@ func (gs *grpcServer) GetCommit(ctx context.Context, req *proto.GetCommitRequest
 		return nil, s.Err()
 	}
 
-	return &proto.GetCommitResponse{
+	res := &proto.GetCommitResponse{
 		Commit: commit.ToProto(),
-	}, nil
+	}
+
+	if req.GetIncludeStat() {
+		stats, err := backend.CommitStats(ctx, commit.ID)
+		if err != nil {
+			gs.svc.LogIfCorrupt(ctx, repoName, err)
+			return nil, err
+		}
+		for _, s := range stats {
+			if subRepoPermsEnabled {
+				if ok, err := authz.FilterActorPath(ctx, gs.subRepoChecker, actor.FromContext(ctx), repoName, s.Path); err != nil {
+					return nil, err
+				} else if !ok {
+					continue
+				}
+			}
+			res.Stats = append(res.Stats, s.ToProto())
+		}
+	}
+
+	if req.GetIncludePatch() {
+		patch, err := gs.readCommitPatch(ctx, backend, repoName, commit.ID, subRepoPermsEnabled)
+		if err != nil {
+			gs.svc.LogIfCorrupt(ctx, repoName, err)
+			return nil, err
+		}
+		res.Patch = patch
+	}
+
+	return res, nil
+}
+
+// readCommitPatch reads the patch of the given commit. If subRepoPermsEnabled
+// is true, the diffs of files the actor cannot read are dropped from it.
+func (gs *grpcServer) readCommitPatch(ctx context.Context, backend git.GitBackend, repoName api.RepoName, commit api.CommitID, subRepoPermsEnabled bool) ([]byte, error) {
+	r, err := backend.CommitPatch(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	patch, err := io.ReadAll(r)
+	if err != nil || !subRepoPermsEnabled {
+		return patch, err
+	}
+
+	fileDiffs, err := godiff.ParseMultiFileDiff(patch)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing patch")
+	}
+	a := actor.FromContext(ctx)
+	allowed := fileDiffs[:0]
+	for _, fd := range fileDiffs {
+		name := strings.TrimPrefix(fd.NewName, "b/")
+		if fd.NewName == "/dev/null" {
+			name = strings.TrimPrefix(fd.OrigName, "a/")
+		}
+		if ok, err := authz.FilterActorPath(ctx, gs.subRepoChecker, a, repoName, name); err != nil {
+			return nil, err
+		} else if ok {
+			allowed = append(allowed, fd)
+		}
+	}
+	return godiff.PrintMultiFileDiff(allowed)
 }
 
 func (gs *grpcServer) Blame(req *proto.BlameRequest, ss proto.GitserverService_BlameServer) error {
@@ -900,6 +1094,10 @@ func (gs *grpcServer) Blame(req *proto.BlameRequest, ss proto.GitserverService_B
 		return status.New(codes.InvalidArgument, "path must be specified").Err()
 	}
 
+	if r := req.GetRange(); r != nil && r.GetEndLine() > 0 && r.GetStartLine() > r.GetEndLine() {
+		return status.New(codes.InvalidArgument, "range start line must not be after end line").Err()
+	}
+
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
@@ -931,6 +1129,7 @@ func (gs *grpcServer) Blame(req *proto.BlameRequest, ss proto.GitserverService_B
 
 	opts := git.BlameOptions{
 		IgnoreWhitespace: req.GetIgnoreWhitespace(),
+		CopyDetection:    blameCopyDetectionFromProto(req.GetCopyDetection()),
 	}
 
 	if r := req.GetRange(); r != nil {
@@ -988,6 +1187,178 @@ func (gs *grpcServer) Blame(req *proto.BlameRequest, ss proto.GitserverService_B
 	}
 }
 
+func blameCopyDetectionFromProto(d proto.BlameCopyDetection) git.BlameCopyDetection {
+	switch d {
+	case proto.BlameCopyDetection_BLAME_COPY_DETECTION_MOVES:
+		return git.BlameCopyDetectionMoves
+	case proto.BlameCopyDetection_BLAME_COPY_DETECTION_COPIES:
+		return git.BlameCopyDetectionCopies
+	case proto.BlameCopyDetection_BLAME_COPY_DETECTION_COPIES_FROM_CREATION:
+		return git.BlameCopyDetectionCopiesFromCreation
+	case proto.BlameCopyDetection_BLAME_COPY_DETECTION_COPIES_FROM_ANY_COMMIT:
+		return git.BlameCopyDetectionCopiesFromAnyCommit
+	default:
+		return git.BlameCopyDetectionNone
+	}
+}
+
+// blameSummaryMaxFiles is the maximum number of files BlameSummary blames for
+// a single request, as every file requires a separate git blame invocation.
+const blameSummaryMaxFiles = 1000
+
+func (gs *grpcServer) BlameSummary(ctx context.Context, req *proto.BlameSummaryRequest) (*proto.BlameSummaryResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("path", req.GetPath()),
+		log.String("commit", req.GetCommit()),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if req.GetCommit() == "" {
+		return nil, status.New(codes.InvalidArgument, "commit must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	commit := api.CommitID(req.GetCommit())
+
+	files, err := backend.ListFiles(ctx, commit, req.GetPath())
+	if err != nil {
+		return nil, gs.blameSummaryError(ctx, req, err)
+	}
+
+	if len(files) > blameSummaryMaxFiles {
+		return nil, status.New(codes.ResourceExhausted, fmt.Sprintf("path contains more than %d files", blameSummaryMaxFiles)).Err()
+	}
+
+	// Leave out files the actor doesn't have access to. If a single file was
+	// requested, we return an error instead of an empty summary.
+	readable, err := authz.FilterActorPaths(ctx, gs.subRepoChecker, actor.FromContext(ctx), repoName, files)
+	if err != nil {
+		return nil, err
+	}
+	if len(readable) == 0 && len(files) == 1 && files[0] == req.GetPath() {
+		s, err := status.New(codes.PermissionDenied, "no access to path").WithDetails(&proto.UnauthorizedPayload{
+			RepoName: req.GetRepoName(),
+			Commit:   pointers.Ptr(req.GetCommit()),
+			Path:     pointers.Ptr(req.GetPath()),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.Err()
+	}
+
+	summaries, err := summarizeBlame(ctx, backend, commit, readable)
+	if err != nil {
+		return nil, gs.blameSummaryError(ctx, req, err)
+	}
+
+	res := &proto.BlameSummaryResponse{
+		Authors: make([]*proto.BlameAuthorSummary, 0, len(summaries)),
+	}
+	for _, s := range summaries {
+		res.Authors = append(res.Authors, s.ToProto())
+	}
+	return res, nil
+}
+
+func (gs *grpcServer) blameSummaryError(ctx context.Context, req *proto.BlameSummaryRequest, err error) error {
+	if os.IsNotExist(err) {
+		s, err := status.New(codes.NotFound, "file not found").WithDetails(&proto.FileNotFoundPayload{
+			Repo:   req.GetRepoName(),
+			Commit: req.GetCommit(),
+			Path:   req.GetPath(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.Err()
+	}
+
+	var e *gitdomain.RevisionNotFoundError
+	if errors.As(err, &e) {
+		s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+			Repo: req.GetRepoName(),
+			Spec: e.Spec,
+		})
+		if err != nil {
+			return err
+		}
+		return s.Err()
+	}
+
+	gs.svc.LogIfCorrupt(ctx, api.RepoName(req.GetRepoName()), err)
+	return err
+}
+
+// summarizeBlame blames all the given files and aggregates the line counts per
+// author. The result is ordered by line count, descending.
+func summarizeBlame(ctx context.Context, backend git.GitBackend, commit api.CommitID, files []string) ([]*gitdomain.BlameAuthorSummary, error) {
+	type authorKey struct{ name, email string }
+	byAuthor := make(map[authorKey]*gitdomain.BlameAuthorSummary)
+
+	for _, f := range files {
+		hr, err := backend.Blame(ctx, commit, f, git.BlameOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		for {
+			h, err := hr.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				hr.Close()
+				return nil, err
+			}
+
+			k := authorKey{name: h.Author.Name, email: h.Author.Email}
+			s, ok := byAuthor[k]
+			if !ok {
+				s = &gitdomain.BlameAuthorSummary{Name: k.name, Email: k.email}
+				byAuthor[k] = s
+			}
+			s.LineCount += int(h.EndLine - h.StartLine)
+			if h.Author.Date.After(s.LastTouched) {
+				s.LastTouched = h.Author.Date
+			}
+		}
+
+		if err := hr.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	summaries := make([]*gitdomain.BlameAuthorSummary, 0, len(byAuthor))
+	for _, s := range byAuthor {
+		summaries = append(summaries, s)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].LineCount != summaries[j].LineCount {
+			return summaries[i].LineCount > summaries[j].LineCount
+		}
+		if summaries[i].Email != summaries[j].Email {
+			return summaries[i].Email < summaries[j].Email
+		}
+		return summaries[i].Name < summaries[j].Name
+	})
+
+	return summaries, nil
+}
+
 func (gs *grpcServer) DefaultBranch(ctx context.Context, req *proto.DefaultBranchRequest) (*proto.DefaultBranchResponse, error) {
 	accesslog.Record(
 		ctx,
@@ -1046,6 +1417,8 @@ func (gs *grpcServer) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverSer
 		req.GetRepoName(),
 		log.String("commit", req.GetCommit()),
 		log.String("path", req.GetPath()),
+		log.Int64("offset", req.GetOffset()),
+		log.String("ifNotOID", req.GetIfNotOid()),
 	)
 
 	if req.GetRepoName() == "" {
@@ -1060,6 +1433,10 @@ func (gs *grpcServer) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverSer
 		return status.New(codes.InvalidArgument, "commit must be specified").Err()
 	}
 
+	if req.GetOffset() < 0 {
+		return status.New(codes.InvalidArgument, "offset must not be negative").Err()
+	}
+
 	repoName := api.RepoName(req.GetRepoName())
 	repoDir := gs.fs.RepoDir(repoName)
 
@@ -1090,7 +1467,15 @@ func (gs *grpcServer) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverSer
 
 	backend := gs.getBackendFunc(repoDir, repoName)
 
-	r, err := backend.ReadFile(ctx, api.CommitID(req.GetCommit()), req.GetPath())
+	commit := api.CommitID(req.GetCommit())
+	var r io.ReadCloser
+	blobOID, err := backend.BlobOID(ctx, commit, req.GetPath())
+	if err == nil {
+		if ifNotOID := req.GetIfNotOid(); ifNotOID != "" && ifNotOID == string(blobOID) {
+			return ss.Send(&proto.ReadFileResponse{BlobOid: string(blobOID), NotModified: true})
+		}
+		r, err = backend.ReadFile(ctx, commit, req.GetPath())
+	}
 	if err != nil {
 		if os.IsNotExist(err) {
 			s, err := status.New(codes.NotFound, "file not found").WithDetails(&proto.FileNotFoundPayload{
@@ -1120,12 +1505,40 @@ func (gs *grpcServer) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverSer
 	}
 	defer r.Close()
 
+	// The blob OID is sent before the content, so that clients have it without
+	// reading the whole file.
+	if err := ss.Send(&proto.ReadFileResponse{BlobOid: string(blobOID)}); err != nil {
+		return err
+	}
+
+	var content io.Reader = r
+	var digest hash.Hash
+	if req.GetIncludeSha256() {
+		digest = sha256.New()
+		content = io.TeeReader(r, digest)
+	}
+
+	if offset := req.GetOffset(); offset > 0 {
+		n, err := io.CopyN(io.Discard, content, offset)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if n < offset {
+			return status.Error(codes.OutOfRange, fmt.Sprintf("offset %d is beyond the end of the file (%d bytes)", offset, n))
+		}
+	}
+
 	w := streamio.NewWriter(func(p []byte) error {
 		return ss.Send(&proto.ReadFileResponse{Data: p})
 	})
 
-	_, err = io.Copy(w, r)
-	return err
+	if _, err := io.Copy(w, content); err != nil {
+		return err
+	}
+	if digest != nil {
+		return ss.Send(&proto.ReadFileResponse{Sha256: digest.Sum(nil)})
+	}
+	return nil
 }
 
 func (gs *grpcServer) ResolveRevision(ctx context.Context, req *proto.ResolveRevisionRequest) (*proto.ResolveRevisionResponse, error) {
@@ -1176,6 +1589,15 @@ func (gs *grpcServer) ResolveRevision(ctx context.Context, req *proto.ResolveRev
 			return nil, s.Err()
 		}
 
+		var ambiguous *gitdomain.AmbiguousRevisionError
+		if errors.As(err, &ambiguous) {
+			s, err := status.New(codes.InvalidArgument, "ambiguous revision").WithDetails(gitErrorPayload(ambiguous))
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+
 		gs.svc.LogIfCorrupt(ctx, repoName, err)
 		// TODO: Better error checking.
 		return nil, err
@@ -1310,51 +1732,2136 @@ func (gs *grpcServer) ListRefs(req *proto.ListRefsRequest, ss proto.GitserverSer
 	return nil
 }
 
-// checkRepoExists checks if a given repository is cloned on disk, and returns an
-// error otherwise.
-// On Sourcegraph.com, not all repos are managed by the scheduler. We thus
-// need to enqueue a manual update of a repo that is visited but not cloned to
-// ensure it is cloned and managed.
-func (gs *grpcServer) checkRepoExists(ctx context.Context, repo api.RepoName) error {
-	cloned, err := gs.fs.RepoCloned(repo)
-	if err != nil {
-		return status.New(codes.Internal, errors.Wrap(err, "failed to check if repo is cloned").Error()).Err()
-	}
+func (gs *grpcServer) RefsChangedSince(req *proto.RefsChangedSinceRequest, ss proto.GitserverService_RefsChangedSinceServer) error {
+	accesslog.Record(
+		ss.Context(),
+		req.GetRepoName(),
+		log.String("state_token", req.GetStateToken()),
+	)
 
-	if cloned {
-		return nil
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
 	}
 
-	// On sourcegraph.com, not all repos are managed by the scheduler. We thus
-	// need to enqueue a manual clone of a repo that is visited but not cloned.
-	if dotcom.SourcegraphDotComMode() {
-		if conf.Get().DisableAutoGitUpdates {
-			gs.logger.Debug("not cloning on demand as DisableAutoGitUpdates is set")
-		} else {
-			_, err := repoupdater.DefaultClient.EnqueueRepoUpdate(ctx, repo)
-			if err != nil {
-				return errors.Wrap(err, "failed to enqueue repo clone")
-			}
+	if req.GetStateToken() != "" {
+		if req.GetSince() != nil {
+			return status.New(codes.InvalidArgument, "only one of state_token and since may be set").Err()
+		}
+		if _, _, err := parseRefStateToken(req.GetStateToken()); err != nil {
+			return status.New(codes.InvalidArgument, err.Error()).Err()
 		}
 	}
 
-	cloneProgress, cloneInProgress := gs.locker.Status(repo)
+	var since time.Time
+	if req.GetSince() != nil {
+		since = req.GetSince().AsTime()
+	}
 
-	return newRepoNotFoundError(repo, cloneInProgress, cloneProgress)
-}
+	repoName := api.RepoName(req.GetRepoName())
 
-func hasAccessToCommit(ctx context.Context, repoName api.RepoName, files []string, checker authz.SubRepoPermissionChecker) (bool, error) {
-	if len(files) == 0 {
-		return true, nil // If commit has no files, assume user has access to view the commit.
+	if err := gs.checkRepoExists(ss.Context(), repoName); err != nil {
+		return err
 	}
 
-	if enabled, err := authz.SubRepoEnabledForRepo(ctx, checker, repoName); err != nil {
-		return false, err
-	} else if !enabled {
-		return true, nil
+	res, err := gs.svc.RefsChangedSince(ss.Context(), repoName, req.GetStateToken(), since)
+	if err != nil {
+		return err
 	}
 
-	a := actor.FromContext(ctx)
+	// The state token and full are only sent in the first message.
+	first := &proto.RefsChangedSinceResponse{StateToken: res.StateToken, Full: res.Full}
+	chunker := chunk.New(func(changes []*proto.RefChange) error {
+		msg := &proto.RefsChangedSinceResponse{Changes: changes}
+		if first != nil {
+			msg, first = first, nil
+			msg.Changes = changes
+		}
+		return ss.Send(msg)
+	})
+	for _, c := range res.Changes {
+		if err := chunker.Send(c.ToProto()); err != nil {
+			return errors.Wrap(err, "failed to send ref change chunk")
+		}
+	}
+	if err := chunker.Flush(); err != nil {
+		return errors.Wrap(err, "failed to flush ref changes")
+	}
+	if first != nil {
+		// Nothing changed.
+		return ss.Send(first)
+	}
+	return nil
+}
+
+func (gs *grpcServer) WatchRefs(req *proto.WatchRefsRequest, ss proto.GitserverService_WatchRefsServer) error {
+	accesslog.Record(
+		ss.Context(),
+		req.GetRepoName(),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+
+	if err := gs.checkRepoExists(ss.Context(), repoName); err != nil {
+		return err
+	}
+
+	return gs.svc.WatchRefs(ss.Context(), repoName, func(res gitdomain.RefChanges) error {
+		chunker := chunk.New(func(changes []*proto.RefChange) error {
+			return ss.Send(&proto.WatchRefsResponse{Changes: changes})
+		})
+		for _, c := range res.Changes {
+			if err := chunker.Send(c.ToProto()); err != nil {
+				return errors.Wrap(err, "failed to send ref change chunk")
+			}
+		}
+		if err := chunker.Flush(); err != nil {
+			return errors.Wrap(err, "failed to flush ref changes")
+		}
+		// The last message of an update carries the state token.
+		return ss.Send(&proto.WatchRefsResponse{StateToken: res.StateToken, Full: res.Full})
+	})
+}
+
+func (gs *grpcServer) WatchDefaultBranchCommits(req *proto.WatchDefaultBranchCommitsRequest, ss proto.GitserverService_WatchDefaultBranchCommitsServer) error {
+	accesslog.Record(
+		ss.Context(),
+		req.GetRepoName(),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+
+	if err := gs.checkRepoExists(ss.Context(), repoName); err != nil {
+		return err
+	}
+
+	return gs.svc.WatchDefaultBranchCommits(ss.Context(), repoName, func(res gitdomain.BranchCommits) error {
+		chunker := chunk.New(func(commits []*proto.BranchCommit) error {
+			return ss.Send(&proto.WatchDefaultBranchCommitsResponse{Commits: commits})
+		})
+		for _, c := range res.Commits {
+			if err := chunker.Send(c.ToProto()); err != nil {
+				return errors.Wrap(err, "failed to send branch commit chunk")
+			}
+		}
+		if err := chunker.Flush(); err != nil {
+			return errors.Wrap(err, "failed to flush branch commits")
+		}
+		// The last message of an update carries the new head.
+		return ss.Send(&proto.WatchDefaultBranchCommitsResponse{HeadCommitSha: string(res.Head), Rewritten: res.Rewritten})
+	})
+}
+
+func (gs *grpcServer) FormatPatch(req *proto.FormatPatchRequest, ss proto.GitserverService_FormatPatchServer) error {
+	ctx := ss.Context()
+
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("base", string(req.GetBase())),
+		log.String("head", string(req.GetHead())),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if len(req.GetBase()) == 0 {
+		return status.New(codes.InvalidArgument, "base must be specified").Err()
+	}
+
+	if len(req.GetHead()) == 0 {
+		return status.New(codes.InvalidArgument, "head must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return err
+	}
+
+	// Patches contain the full diff of every commit in the range, and we
+	// currently cannot filter parts of them, so this would be considered
+	// leaking information.
+	if !actor.FromContext(ctx).IsInternal() {
+		if enabled, err := gs.subRepoChecker.EnabledForRepo(ctx, repoName); err != nil {
+			return errors.Wrap(err, "sub-repo permissions check")
+		} else if enabled {
+			return status.New(codes.Unimplemented, "formatPatch invoked for a repo with sub-repo permissions").Err()
+		}
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	r, err := backend.FormatPatch(ctx, string(req.GetBase()), string(req.GetHead()))
+	if err != nil {
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return err
+			}
+			return s.Err()
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		// TODO: Better error checking.
+		return err
+	}
+	defer r.Close()
+
+	w := streamio.NewWriter(func(p []byte) error {
+		return ss.Send(&proto.FormatPatchResponse{
+			Data: p,
+		})
+	})
+
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func (gs *grpcServer) CreateBundle(req *proto.CreateBundleRequest, ss proto.GitserverService_CreateBundleServer) error {
+	ctx := ss.Context()
+
+	refs := make([]string, len(req.GetRefs()))
+	for i, ref := range req.GetRefs() {
+		refs[i] = string(ref)
+	}
+
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.Strings("refs", refs),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if len(refs) == 0 {
+		return status.New(codes.InvalidArgument, "at least one ref must be specified").Err()
+	}
+
+	for _, ref := range refs {
+		if ref == "" || ref == "^" {
+			return status.New(codes.InvalidArgument, "refs must not be empty").Err()
+		}
+		if err := git.CheckSpecArgSafety(strings.TrimPrefix(ref, "^")); err != nil {
+			return status.New(codes.InvalidArgument, err.Error()).Err()
+		}
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return err
+	}
+
+	// A bundle contains every object reachable from the refs, and we cannot
+	// filter parts of it, so this would be considered leaking information.
+	if !actor.FromContext(ctx).IsInternal() {
+		if enabled, err := gs.subRepoChecker.EnabledForRepo(ctx, repoName); err != nil {
+			return errors.Wrap(err, "sub-repo permissions check")
+		} else if enabled {
+			return status.New(codes.Unimplemented, "createBundle invoked for a repo with sub-repo permissions").Err()
+		}
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	r, err := backend.CreateBundle(ctx, refs)
+	if err != nil {
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return err
+			}
+			return s.Err()
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return err
+	}
+	defer r.Close()
+
+	w := streamio.NewWriter(func(p []byte) error {
+		return ss.Send(&proto.CreateBundleResponse{
+			Data: p,
+		})
+	})
+
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func (gs *grpcServer) ApplyBundle(s proto.GitserverService_ApplyBundleServer) error {
+	ctx := s.Context()
+
+	firstMsg, err := s.Recv()
+	if err != nil {
+		return err
+	}
+
+	metadata := firstMsg.GetMetadata()
+	if metadata == nil {
+		return status.New(codes.InvalidArgument, "must send metadata event first").Err()
+	}
+
+	accesslog.Record(ctx, metadata.GetRepoName())
+
+	if metadata.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	repoName := api.RepoName(metadata.GetRepoName())
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return err
+	}
+
+	bundleReader := streamio.NewReader(func() ([]byte, error) {
+		msg, err := s.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		switch msg.Payload.(type) {
+		case *proto.ApplyBundleRequest_Data:
+			return msg.GetData(), nil
+		default:
+			return nil, status.New(codes.InvalidArgument, "must only send data events after metadata").Err()
+		}
+	})
+
+	if err := gs.svc.ApplyBundle(ctx, repoName, bundleReader); err != nil {
+		var conflictErr *gitdomain.RefUpdateConflictError
+		if errors.As(err, &conflictErr) {
+			s, err := status.New(codes.FailedPrecondition, "bundle cannot be applied without rewriting refs").WithDetails(&proto.RefUpdateConflictPayload{
+				RepoName: metadata.GetRepoName(),
+				RefName:  conflictErr.Ref,
+			})
+			if err != nil {
+				return err
+			}
+			return s.Err()
+		}
+
+		var invalidErr *invalidBundleError
+		if errors.As(err, &invalidErr) {
+			return status.New(codes.FailedPrecondition, invalidErr.Error()).Err()
+		}
+
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			return err
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return err
+	}
+
+	return s.SendAndClose(&proto.ApplyBundleResponse{})
+}
+
+func (gs *grpcServer) ExecInWorktree(ctx context.Context, req *proto.ExecInWorktreeRequest) (*proto.ExecInWorktreeResponse, error) {
+	args := byteSlicesToStrings(req.GetArgs())
+	logAttrs := []log.Field{log.String("commit", req.GetCommitSha())}
+	if len(args) > 0 {
+		logAttrs = append(logAttrs,
+			log.String("cmd", args[0]),
+			log.Strings("args", args[1:]),
+		)
+	}
+
+	accesslog.Record(ctx, req.GetRepoName(), logAttrs...)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if !gitdomain.IsAbsoluteRevision(req.GetCommitSha()) {
+		return nil, status.New(codes.InvalidArgument, "commit_sha must be a full commit ID").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	// The worktree contains all files of the commit, so the output of the
+	// command can't be filtered.
+	if !actor.FromContext(ctx).IsInternal() {
+		if enabled, err := gs.subRepoChecker.EnabledForRepo(ctx, repoName); err != nil {
+			return nil, errors.Wrap(err, "sub-repo permissions check")
+		} else if enabled {
+			return nil, status.New(codes.Unimplemented, "execInWorktree invoked for a repo with sub-repo permissions").Err()
+		}
+	}
+
+	result, err := gs.svc.ExecInWorktree(ctx, repoName, api.CommitID(req.GetCommitSha()), args, req.GetStdin())
+	if err != nil {
+		if errors.Is(err, gitcli.ErrBadGitCommand) {
+			return nil, status.New(codes.InvalidArgument, "command not allowed in worktree").Err()
+		}
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, status.New(codes.DeadlineExceeded, "command in worktree timed out").Err()
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return nil, err
+	}
+
+	return result.ToProto(), nil
+}
+
+func (gs *grpcServer) RebasePreview(ctx context.Context, req *proto.RebasePreviewRequest) (*proto.RebasePreviewResponse, error) {
+	accesslog.Record(ctx, req.GetRepoName(),
+		log.String("base", req.GetBaseCommitSha()),
+		log.String("head", req.GetHeadCommitSha()),
+		log.String("onto", req.GetOntoCommitSha()),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	for _, c := range []struct{ field, sha string }{
+		{"base_commit_sha", req.GetBaseCommitSha()},
+		{"head_commit_sha", req.GetHeadCommitSha()},
+		{"onto_commit_sha", req.GetOntoCommitSha()},
+	} {
+		if !gitdomain.IsAbsoluteRevision(c.sha) {
+			return nil, status.New(codes.InvalidArgument, c.field+" must be a full commit ID").Err()
+		}
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	// Conflicting paths and commit IDs can't be filtered by sub-repo
+	// permissions.
+	if !actor.FromContext(ctx).IsInternal() {
+		if enabled, err := gs.subRepoChecker.EnabledForRepo(ctx, repoName); err != nil {
+			return nil, errors.Wrap(err, "sub-repo permissions check")
+		} else if enabled {
+			return nil, status.New(codes.Unimplemented, "rebasePreview invoked for a repo with sub-repo permissions").Err()
+		}
+	}
+
+	preview, err := gs.svc.RebasePreview(ctx, repoName, api.CommitID(req.GetBaseCommitSha()), api.CommitID(req.GetHeadCommitSha()), api.CommitID(req.GetOntoCommitSha()))
+	if err != nil {
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, status.New(codes.DeadlineExceeded, "rebase preview timed out").Err()
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return nil, err
+	}
+
+	return preview.ToProto(), nil
+}
+
+func (gs *grpcServer) RevertCommit(ctx context.Context, req *proto.RevertCommitRequest) (*proto.RevertCommitResponse, error) {
+	accesslog.Record(ctx, req.GetRepoName(),
+		log.String("commit", req.GetCommitSha()),
+		log.String("onto", string(req.GetOnto())),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if !gitdomain.IsAbsoluteRevision(req.GetCommitSha()) {
+		return nil, status.New(codes.InvalidArgument, "commit_sha must be a full commit ID").Err()
+	}
+
+	if req.GetMainline() < 0 {
+		return nil, status.New(codes.InvalidArgument, "mainline must not be negative").Err()
+	}
+
+	author := gitdomain.SignatureFromProto(req.GetAuthor())
+	if author.Name == "" || author.Email == "" {
+		return nil, status.New(codes.InvalidArgument, "author must be specified").Err()
+	}
+	committer := author
+	if req.GetCommitter() != nil {
+		committer = gitdomain.SignatureFromProto(req.GetCommitter())
+		if committer.Name == "" || committer.Email == "" {
+			return nil, status.New(codes.InvalidArgument, "committer must have a name and email").Err()
+		}
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	opts := RevertCommitOpts{
+		Onto:      api.CommitID(req.GetCommitSha()),
+		Mainline:  int(req.GetMainline()),
+		Message:   string(req.GetMessage()),
+		Author:    author,
+		Committer: committer,
+	}
+
+	var commit api.CommitID
+	var err error
+	if onto := string(req.GetOnto()); onto != "" {
+		opts.Onto, err = gs.getBackendFunc(gs.fs.RepoDir(repoName), repoName).ResolveRevision(ctx, onto)
+	}
+	if err == nil {
+		commit, err = gs.svc.RevertCommit(ctx, repoName, api.CommitID(req.GetCommitSha()), opts)
+	}
+	if err != nil {
+		return nil, gs.commitOpError(ctx, repoName, err)
+	}
+
+	return &proto.RevertCommitResponse{CommitSha: string(commit)}, nil
+}
+
+func (gs *grpcServer) CherryPick(ctx context.Context, req *proto.CherryPickRequest) (*proto.CherryPickResponse, error) {
+	accesslog.Record(ctx, req.GetRepoName(),
+		log.String("commit", req.GetCommitSha()),
+		log.String("onto", string(req.GetOnto())),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if !gitdomain.IsAbsoluteRevision(req.GetCommitSha()) {
+		return nil, status.New(codes.InvalidArgument, "commit_sha must be a full commit ID").Err()
+	}
+
+	if len(req.GetOnto()) == 0 {
+		return nil, status.New(codes.InvalidArgument, "onto must be specified").Err()
+	}
+
+	if req.GetMainline() < 0 {
+		return nil, status.New(codes.InvalidArgument, "mainline must not be negative").Err()
+	}
+
+	committer := gitdomain.SignatureFromProto(req.GetCommitter())
+	if committer.Name == "" || committer.Email == "" {
+		return nil, status.New(codes.InvalidArgument, "committer must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	opts := CherryPickOpts{
+		Mainline:     int(req.GetMainline()),
+		Message:      string(req.GetMessage()),
+		RecordOrigin: req.GetRecordOrigin(),
+		Committer:    committer,
+	}
+
+	var commit api.CommitID
+	onto, err := gs.getBackendFunc(gs.fs.RepoDir(repoName), repoName).ResolveRevision(ctx, string(req.GetOnto()))
+	if err == nil {
+		opts.Onto = onto
+		commit, err = gs.svc.CherryPick(ctx, repoName, api.CommitID(req.GetCommitSha()), opts)
+	}
+	if err != nil {
+		return nil, gs.commitOpError(ctx, repoName, err)
+	}
+
+	return &proto.CherryPickResponse{CommitSha: string(commit)}, nil
+}
+
+func (gs *grpcServer) SquashCommits(ctx context.Context, req *proto.SquashCommitsRequest) (*proto.SquashCommitsResponse, error) {
+	accesslog.Record(ctx, req.GetRepoName(),
+		log.String("base", req.GetBaseCommitSha()),
+		log.String("head", req.GetHeadCommitSha()),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	for _, c := range []struct{ field, sha string }{
+		{"base_commit_sha", req.GetBaseCommitSha()},
+		{"head_commit_sha", req.GetHeadCommitSha()},
+	} {
+		if !gitdomain.IsAbsoluteRevision(c.sha) {
+			return nil, status.New(codes.InvalidArgument, c.field+" must be a full commit ID").Err()
+		}
+	}
+
+	committer := gitdomain.SignatureFromProto(req.GetCommitter())
+	if committer.Name == "" || committer.Email == "" {
+		return nil, status.New(codes.InvalidArgument, "committer must be specified").Err()
+	}
+
+	opts := SquashCommitsOpts{
+		Message:   string(req.GetMessage()),
+		Committer: committer,
+	}
+	if req.GetAuthor() != nil {
+		author := gitdomain.SignatureFromProto(req.GetAuthor())
+		if author.Name == "" || author.Email == "" {
+			return nil, status.New(codes.InvalidArgument, "author must have a name and email").Err()
+		}
+		opts.Author = &author
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	commit, err := gs.svc.SquashCommits(ctx, repoName, api.CommitID(req.GetBaseCommitSha()), api.CommitID(req.GetHeadCommitSha()), opts)
+	if err != nil {
+		return nil, gs.commitOpError(ctx, repoName, err)
+	}
+
+	return &proto.SquashCommitsResponse{CommitSha: string(commit)}, nil
+}
+
+// commitOpError converts the errors of operations that create commits in a
+// temporary worktree, like RevertCommit, CherryPick and SquashCommits, to
+// gRPC errors.
+func (gs *grpcServer) commitOpError(ctx context.Context, repo api.RepoName, err error) error {
+	var conflictErr *gitdomain.MergeConflictError
+	if errors.As(err, &conflictErr) {
+		payload := &proto.MergeConflictPayload{
+			RepoName:  string(repo),
+			CommitSha: string(conflictErr.Commit),
+		}
+		for _, p := range conflictErr.Paths {
+			payload.Paths = append(payload.Paths, []byte(p))
+		}
+		s, err := status.New(codes.FailedPrecondition, "changes conflict").WithDetails(payload)
+		if err != nil {
+			return err
+		}
+		return s.Err()
+	}
+	if errors.Is(err, errNoChanges) {
+		return status.New(codes.FailedPrecondition, err.Error()).Err()
+	}
+	var e *gitdomain.RevisionNotFoundError
+	if errors.As(err, &e) {
+		s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+			Repo: string(repo),
+			Spec: e.Spec,
+		})
+		if err != nil {
+			return err
+		}
+		return s.Err()
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr).Err()
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.New(codes.DeadlineExceeded, "operation timed out").Err()
+	}
+
+	gs.svc.LogIfCorrupt(ctx, repo, err)
+	return err
+}
+
+func (gs *grpcServer) CommitGraph(req *proto.CommitGraphRequest, ss proto.GitserverService_CommitGraphServer) error {
+	ctx := ss.Context()
+
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("head", string(req.GetHead())),
+		log.String("base", string(req.GetBase())),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if len(req.GetHead()) == 0 {
+		return status.New(codes.InvalidArgument, "head must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return err
+	}
+
+	// Commits are filtered by the files they touch for sub-repo permissions,
+	// and leaving them out here would break the graph.
+	if !actor.FromContext(ctx).IsInternal() {
+		if enabled, err := gs.subRepoChecker.EnabledForRepo(ctx, repoName); err != nil {
+			return errors.Wrap(err, "sub-repo permissions check")
+		} else if enabled {
+			return status.New(codes.Unimplemented, "commitGraph invoked for a repo with sub-repo permissions").Err()
+		}
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	it, err := backend.CommitGraph(ctx, git.CommitGraphOpts{
+		Head:       string(req.GetHead()),
+		Base:       string(req.GetBase()),
+		MaxCommits: int(req.GetMaxCommits()),
+	})
+	if err != nil {
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return err
+			}
+			return s.Err()
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		// TODO: Better error checking.
+		return err
+	}
+
+	// Histories can contain millions of commits, so we chunk them to stay
+	// below the gRPC message size limit.
+	chunker := chunk.New(func(nodes []*proto.CommitGraphNode) error {
+		return ss.Send(&proto.CommitGraphResponse{Nodes: nodes})
+	})
+
+	for {
+		node, err := it.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			it.Close()
+			return err
+		}
+		if err := chunker.Send(node.ToProto()); err != nil {
+			it.Close()
+			return errors.Wrap(err, "failed to send commit graph chunk")
+		}
+	}
+
+	if err := chunker.Flush(); err != nil {
+		it.Close()
+		return errors.Wrap(err, "failed to flush commit graph")
+	}
+
+	return it.Close()
+}
+
+func (gs *grpcServer) Cherry(ctx context.Context, req *proto.CherryRequest) (*proto.CherryResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("upstream", string(req.GetUpstream())),
+		log.String("head", string(req.GetHead())),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if len(req.GetUpstream()) == 0 {
+		return nil, status.New(codes.InvalidArgument, "upstream must be specified").Err()
+	}
+
+	if len(req.GetHead()) == 0 {
+		return nil, status.New(codes.InvalidArgument, "head must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	// Patch IDs are computed from the full diff of every commit, so the
+	// result reveals whether restricted files changed the same way on both
+	// branches.
+	if !actor.FromContext(ctx).IsInternal() {
+		if enabled, err := gs.subRepoChecker.EnabledForRepo(ctx, repoName); err != nil {
+			return nil, errors.Wrap(err, "sub-repo permissions check")
+		} else if enabled {
+			return nil, status.New(codes.Unimplemented, "cherry invoked for a repo with sub-repo permissions").Err()
+		}
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	commits, err := backend.Cherry(ctx, string(req.GetUpstream()), string(req.GetHead()))
+	if err != nil {
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		// TODO: Better error checking.
+		return nil, err
+	}
+
+	res := &proto.CherryResponse{Commits: make([]*proto.CherryCommit, 0, len(commits))}
+	for _, c := range commits {
+		res.Commits = append(res.Commits, c.ToProto())
+	}
+	return res, nil
+}
+
+func (gs *grpcServer) RangeDiff(ctx context.Context, req *proto.RangeDiffRequest) (*proto.RangeDiffResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("old_base", string(req.GetOldBase())),
+		log.String("old_head", string(req.GetOldHead())),
+		log.String("new_base", string(req.GetNewBase())),
+		log.String("new_head", string(req.GetNewHead())),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if len(req.GetOldBase()) == 0 || len(req.GetOldHead()) == 0 {
+		return nil, status.New(codes.InvalidArgument, "old range must be specified").Err()
+	}
+
+	if len(req.GetNewBase()) == 0 || len(req.GetNewHead()) == 0 {
+		return nil, status.New(codes.InvalidArgument, "new range must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	// The returned diffs contain the patches of both series.
+	if !actor.FromContext(ctx).IsInternal() {
+		if enabled, err := gs.subRepoChecker.EnabledForRepo(ctx, repoName); err != nil {
+			return nil, errors.Wrap(err, "sub-repo permissions check")
+		} else if enabled {
+			return nil, status.New(codes.Unimplemented, "range-diff invoked for a repo with sub-repo permissions").Err()
+		}
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	pairs, err := backend.RangeDiff(ctx, git.RangeDiffOpts{
+		OldBase: string(req.GetOldBase()),
+		OldHead: string(req.GetOldHead()),
+		NewBase: string(req.GetNewBase()),
+		NewHead: string(req.GetNewHead()),
+	})
+	if err != nil {
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		// TODO: Better error checking.
+		return nil, err
+	}
+
+	res := &proto.RangeDiffResponse{Pairs: make([]*proto.RangeDiffPair, 0, len(pairs))}
+	for _, p := range pairs {
+		res.Pairs = append(res.Pairs, p.ToProto())
+	}
+	return res, nil
+}
+
+func (gs *grpcServer) UpdateRef(ctx context.Context, req *proto.UpdateRefRequest) (*proto.UpdateRefResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("ref", req.GetRefName()),
+		log.String("new", req.GetNewCommitSha()),
+		log.String("expectedOld", req.GetExpectedOldCommitSha()),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if !strings.HasPrefix(req.GetRefName(), "refs/") {
+		return nil, status.New(codes.InvalidArgument, "ref must be a fully qualified ref name").Err()
+	}
+
+	if !gitdomain.IsAbsoluteRevision(req.GetNewCommitSha()) {
+		return nil, status.New(codes.InvalidArgument, "new commit must be a full commit SHA").Err()
+	}
+
+	if old := req.GetExpectedOldCommitSha(); old != "" && !gitdomain.IsAbsoluteRevision(old) {
+		return nil, status.New(codes.InvalidArgument, "expected old commit must be empty or a full commit SHA").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	err := backend.UpdateRef(ctx, req.GetRefName(), api.CommitID(req.GetNewCommitSha()), api.CommitID(req.GetExpectedOldCommitSha()))
+	if err != nil {
+		var conflictErr *gitdomain.RefUpdateConflictError
+		if errors.As(err, &conflictErr) {
+			s, err := status.New(codes.FailedPrecondition, "ref does not have the expected value").WithDetails(&proto.RefUpdateConflictPayload{
+				RepoName:             req.GetRepoName(),
+				RefName:              conflictErr.Ref,
+				ExpectedOldCommitSha: string(conflictErr.ExpectedOldOID),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		// TODO: Better error checking.
+		return nil, err
+	}
+
+	return &proto.UpdateRefResponse{}, nil
+}
+
+func (gs *grpcServer) CreateBranch(ctx context.Context, req *proto.CreateBranchRequest) (*proto.CreateBranchResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("branch", req.GetBranchName()),
+		log.String("startPoint", string(req.GetStartPoint())),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if req.GetBranchName() == "" {
+		return nil, status.New(codes.InvalidArgument, "branch must be specified").Err()
+	}
+
+	if !gitdomain.ValidateBranchName(req.GetBranchName()) {
+		return nil, status.New(codes.InvalidArgument, "invalid branch name").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	startPoint := string(req.GetStartPoint())
+	if startPoint == "" {
+		startPoint = "HEAD"
+	}
+
+	commit, err := backend.ResolveRevision(ctx, startPoint)
+	if err == nil {
+		err = backend.UpdateRef(ctx, "refs/heads/"+req.GetBranchName(), commit, "")
+	}
+	if err != nil {
+		var conflictErr *gitdomain.RefUpdateConflictError
+		if errors.As(err, &conflictErr) {
+			s, err := status.New(codes.FailedPrecondition, "branch already exists").WithDetails(&proto.RefUpdateConflictPayload{
+				RepoName: req.GetRepoName(),
+				RefName:  conflictErr.Ref,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		// TODO: Better error checking.
+		return nil, err
+	}
+
+	return &proto.CreateBranchResponse{
+		CommitSha: string(commit),
+	}, nil
+}
+
+func (gs *grpcServer) DeleteBranch(ctx context.Context, req *proto.DeleteBranchRequest) (*proto.DeleteBranchResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("branch", req.GetBranchName()),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if req.GetBranchName() == "" {
+		return nil, status.New(codes.InvalidArgument, "branch must be specified").Err()
+	}
+
+	if !gitdomain.ValidateBranchName(req.GetBranchName()) {
+		return nil, status.New(codes.InvalidArgument, "invalid branch name").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	ref := "refs/heads/" + req.GetBranchName()
+
+	head, err := backend.SymbolicRefHead(ctx, false)
+	if err != nil {
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return nil, err
+	}
+	if head == ref {
+		return nil, status.New(codes.FailedPrecondition, "cannot delete the default branch").Err()
+	}
+
+	// Resolve the branch first and only delete it if it still points at the
+	// same commit, so that we don't drop commits that were pushed to the
+	// branch concurrently.
+	commit, err := backend.ResolveRevision(ctx, ref)
+	if err == nil {
+		err = backend.DeleteRef(ctx, ref, commit)
+	}
+	if err != nil {
+		var conflictErr *gitdomain.RefUpdateConflictError
+		if errors.As(err, &conflictErr) {
+			s, err := status.New(codes.FailedPrecondition, "branch was updated concurrently").WithDetails(&proto.RefUpdateConflictPayload{
+				RepoName:             req.GetRepoName(),
+				RefName:              conflictErr.Ref,
+				ExpectedOldCommitSha: string(conflictErr.ExpectedOldOID),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		// TODO: Better error checking.
+		return nil, err
+	}
+
+	return &proto.DeleteBranchResponse{}, nil
+}
+
+func (gs *grpcServer) CreateTag(ctx context.Context, req *proto.CreateTagRequest) (*proto.CreateTagResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("tag", req.GetTagName()),
+		log.String("target", string(req.GetTarget())),
+		log.Bool("sign", req.GetSign()),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if req.GetTagName() == "" {
+		return nil, status.New(codes.InvalidArgument, "tag must be specified").Err()
+	}
+
+	if !gitdomain.ValidateBranchName(req.GetTagName()) {
+		return nil, status.New(codes.InvalidArgument, "invalid tag name").Err()
+	}
+
+	if len(req.GetTarget()) == 0 {
+		return nil, status.New(codes.InvalidArgument, "target must be specified").Err()
+	}
+
+	if req.GetSign() && len(req.GetMessage()) == 0 {
+		return nil, status.New(codes.InvalidArgument, "message must be specified for signed tags").Err()
+	}
+
+	opt := git.CreateTagOpts{
+		Message: string(req.GetMessage()),
+		Sign:    req.GetSign(),
+	}
+
+	if opt.Message != "" {
+		tagger := req.GetTagger()
+		if len(tagger.GetName()) == 0 || len(tagger.GetEmail()) == 0 {
+			return nil, status.New(codes.InvalidArgument, "tagger must be specified for annotated tags").Err()
+		}
+		opt.Tagger = &gitdomain.Signature{
+			Name:  string(tagger.GetName()),
+			Email: string(tagger.GetEmail()),
+		}
+		if tagger.GetDate() != nil {
+			opt.Tagger.Date = tagger.GetDate().AsTime()
+		}
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	commit, err := backend.ResolveRevision(ctx, string(req.GetTarget()))
+	if err == nil {
+		err = backend.CreateTag(ctx, req.GetTagName(), commit, opt)
+	}
+	if err != nil {
+		var conflictErr *gitdomain.RefUpdateConflictError
+		if errors.As(err, &conflictErr) {
+			s, err := status.New(codes.FailedPrecondition, "tag already exists").WithDetails(&proto.RefUpdateConflictPayload{
+				RepoName: req.GetRepoName(),
+				RefName:  conflictErr.Ref,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		// TODO: Better error checking.
+		return nil, err
+	}
+
+	return &proto.CreateTagResponse{}, nil
+}
+
+func (gs *grpcServer) GetTag(ctx context.Context, req *proto.GetTagRequest) (*proto.GetTagResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("tag", req.GetTagName()),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if req.GetTagName() == "" {
+		return nil, status.New(codes.InvalidArgument, "tag must be specified").Err()
+	}
+
+	if !gitdomain.ValidateBranchName(req.GetTagName()) {
+		return nil, status.New(codes.InvalidArgument, "invalid tag name").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	tag, err := backend.GetTag(ctx, req.GetTagName())
+	if err != nil {
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "tag not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return nil, err
+	}
+
+	return &proto.GetTagResponse{Tag: tag.ToProto()}, nil
+}
+
+// isRefName returns true if name is HEAD or a fully qualified ref name, which
+// are the names git symbolic-ref and git show-ref --verify accept.
+func isRefName(name string) bool {
+	return name == "HEAD" || strings.HasPrefix(name, "refs/")
+}
+
+func (gs *grpcServer) SymbolicRef(ctx context.Context, req *proto.SymbolicRefRequest) (*proto.SymbolicRefResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("ref", req.GetRefName()),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if !isRefName(req.GetRefName()) {
+		return nil, status.New(codes.InvalidArgument, "ref must be HEAD or a fully qualified ref name").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	target, err := backend.SymbolicRef(ctx, req.GetRefName())
+	if err != nil {
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		// TODO: Better error checking.
+		return nil, err
+	}
+
+	return &proto.SymbolicRefResponse{Target: target}, nil
+}
+
+func (gs *grpcServer) RefExists(ctx context.Context, req *proto.RefExistsRequest) (*proto.RefExistsResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("ref", req.GetRefName()),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if !isRefName(req.GetRefName()) {
+		return nil, status.New(codes.InvalidArgument, "ref must be HEAD or a fully qualified ref name").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	exists, err := backend.RefExists(ctx, req.GetRefName())
+	if err != nil {
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		// TODO: Better error checking.
+		return nil, err
+	}
+
+	return &proto.RefExistsResponse{Exists: exists}, nil
+}
+
+func (gs *grpcServer) ListBranches(req *proto.ListBranchesRequest, ss proto.GitserverService_ListBranchesServer) error {
+	ctx := ss.Context()
+
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("base", string(req.GetBase())),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	base := string(req.GetBase())
+	if base == "" {
+		base = "HEAD"
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return err
+	}
+
+	subRepoPermsEnabled, err := authz.SubRepoEnabledForRepo(ctx, gs.subRepoChecker, repoName)
+	if err != nil {
+		return err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	// The base is resolved once, so that all counts are relative to the same
+	// commit even if it moves while we list the branches.
+	baseCommit, err := backend.ResolveRevision(ctx, base)
+	if err != nil && !errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return err
+	}
+
+	it, err := backend.ListRefs(ctx, git.ListRefsOpts{HeadsOnly: true})
+	if err != nil {
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		// TODO: Better error checking.
+		return err
+	}
+
+	// Like for ListRefs, repos can have thousands of branches.
+	chunker := chunk.New(func(branches []*proto.GitBranch) error {
+		return ss.Send(&proto.ListBranchesResponse{Branches: branches})
+	})
+
+	for {
+		ref, err := it.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			it.Close()
+			return err
+		}
+
+		branch, err := gs.describeBranch(ctx, backend, repoName, ref, baseCommit, subRepoPermsEnabled)
+		if err != nil {
+			it.Close()
+			return err
+		}
+
+		if err := chunker.Send(branch.ToProto()); err != nil {
+			it.Close()
+			return errors.Wrap(err, "failed to send branch chunk")
+		}
+	}
+
+	if err := chunker.Flush(); err != nil {
+		it.Close()
+		return errors.Wrap(err, "failed to flush branches")
+	}
+
+	return it.Close()
+}
+
+// checkRepoExists checks if a given repository is cloned on disk, and returns an
+// error otherwise.
+// On Sourcegraph.com, not all repos are managed by the scheduler. We thus
+// need to enqueue a manual update of a repo that is visited but not cloned to
+// ensure it is cloned and managed.
+// describeBranch adds the behind/ahead counts relative to baseCommit, if set,
+// and the commit metadata to ref, leaving out the commit if the actor can't
+// see it.
+func (gs *grpcServer) describeBranch(ctx context.Context, backend git.GitBackend, repoName api.RepoName, ref *gitdomain.Ref, baseCommit api.CommitID, subRepoPermsEnabled bool) (*gitdomain.Branch, error) {
+	branch := &gitdomain.Branch{Ref: *ref}
+
+	if baseCommit != "" {
+		ba, err := backend.BehindAhead(ctx, string(baseCommit), string(ref.CommitID))
+		if err != nil {
+			gs.svc.LogIfCorrupt(ctx, repoName, err)
+			return nil, err
+		}
+		branch.BehindAhead = ba
+	}
+
+	commit, err := backend.GetCommit(ctx, ref.CommitID, subRepoPermsEnabled)
+	if err != nil {
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return nil, err
+	}
+	hasAccess, err := hasAccessToCommit(ctx, repoName, commit.ModifiedFiles, gs.subRepoChecker)
+	if err != nil {
+		return nil, err
+	}
+	if hasAccess {
+		branch.Commit = commit.Commit
+	}
+
+	return branch, nil
+}
+
+func (gs *grpcServer) ListTags(req *proto.ListTagsRequest, ss proto.GitserverService_ListTagsServer) error {
+	accesslog.Record(
+		ss.Context(),
+		req.GetRepoName(),
+		log.Strings("patterns", req.GetPatterns()),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ss.Context(), repoName); err != nil {
+		return err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	it, err := backend.ListTags(ss.Context(), git.ListTagsOpts{
+		Patterns:      req.GetPatterns(),
+		SortByVersion: req.GetSortByVersion(),
+		Limit:         int(req.GetLimit()),
+	})
+	if err != nil {
+		gs.svc.LogIfCorrupt(ss.Context(), repoName, err)
+		// TODO: Better error checking.
+		return err
+	}
+
+	chunker := chunk.New(func(refs []*proto.GitRef) error {
+		return ss.Send(&proto.ListTagsResponse{Refs: refs})
+	})
+
+	for {
+		ref, err := it.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			it.Close()
+			return err
+		}
+		if err := chunker.Send(ref.ToProto()); err != nil {
+			it.Close()
+			return errors.Wrap(err, "failed to send tag chunk")
+		}
+	}
+
+	if err := chunker.Flush(); err != nil {
+		it.Close()
+		return errors.Wrap(err, "failed to flush tags")
+	}
+
+	return it.Close()
+}
+
+func (gs *grpcServer) OptimizeRepo(ctx context.Context, req *proto.OptimizeRepoRequest) (*proto.OptimizeRepoResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.Bool("gc", req.GetGc()),
+		log.Bool("repack", req.GetRepack()),
+		log.Bool("commitGraph", req.GetCommitGraph()),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	var tasks []git.MaintenanceTask
+	if req.GetGc() {
+		tasks = append(tasks, git.MaintenanceTaskGC)
+	}
+	if req.GetRepack() {
+		tasks = append(tasks, git.MaintenanceTaskRepack)
+	}
+	if req.GetCommitGraph() {
+		tasks = append(tasks, git.MaintenanceTaskCommitGraph)
+	}
+	if len(tasks) == 0 {
+		return nil, status.New(codes.InvalidArgument, "at least one maintenance task must be requested").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	resp := &proto.OptimizeRepoResponse{}
+	for _, task := range tasks {
+		start := time.Now()
+		if err := runMaintenanceTask(ctx, backend, repoDir, task); err != nil {
+			gs.svc.LogIfCorrupt(ctx, repoName, err)
+			return nil, err
+		}
+		resp.Tasks = append(resp.Tasks, &proto.MaintenanceTaskResult{
+			Task:     string(task),
+			Duration: durationpb.New(time.Since(start)),
+		})
+	}
+
+	return resp, nil
+}
+
+func (gs *grpcServer) CommitGraphStatus(ctx context.Context, req *proto.CommitGraphStatusRequest) (*proto.CommitGraphStatusResponse, error) {
+	accesslog.Record(ctx, req.GetRepoName())
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	s, err := backend.CommitGraphStatus(ctx)
+	if err != nil {
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return nil, err
+	}
+
+	return s.ToProto(), nil
+}
+
+func (gs *grpcServer) CloneState(ctx context.Context, req *proto.CloneStateRequest) (*proto.CloneStateResponse, error) {
+	accesslog.Record(ctx, req.GetRepoName())
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	s, err := backend.CloneState(ctx)
+	if err != nil {
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return nil, err
+	}
+
+	return s.ToProto(), nil
+}
+
+func (gs *grpcServer) ListRepos(_ *proto.ListReposRequest, ss proto.GitserverService_ListReposServer) error {
+	ctx := ss.Context()
+
+	chunker := chunk.New(func(repos []*proto.HostedRepo) error {
+		return ss.Send(&proto.ListReposResponse{Repos: repos})
+	})
+
+	var visitErr error
+	err := gs.fs.ForEachRepo(func(name api.RepoName, dir common.GitDir) (done bool) {
+		if visitErr = ctx.Err(); visitErr != nil {
+			return true
+		}
+
+		// Repos can be removed by the janitor while we walk the disk, so a
+		// repo we can't inspect is skipped instead of failing the listing.
+		size, err := gs.fs.DirSize(dir.Path())
+		if err != nil {
+			gs.logger.Warn("failed to compute repo size", log.String("repo", string(name)), log.Error(err))
+			return false
+		}
+		lastFetched, err := repoLastFetched(dir)
+		if err != nil {
+			gs.logger.Warn("failed to get last fetched time", log.String("repo", string(name)), log.Error(err))
+			return false
+		}
+
+		r := protocol.HostedRepo{Name: name, SizeBytes: size, LastFetched: lastFetched}
+		if visitErr = chunker.Send(r.ToProto()); visitErr != nil {
+			visitErr = errors.Wrap(visitErr, "failed to send repos chunk")
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	if visitErr != nil {
+		return visitErr
+	}
+
+	if err := chunker.Flush(); err != nil {
+		return errors.Wrap(err, "failed to flush repos")
+	}
+
+	return nil
+}
+
+func (gs *grpcServer) ReadBlob(req *proto.ReadBlobRequest, ss proto.GitserverService_ReadBlobServer) error {
+	ctx := ss.Context()
+
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("oid", req.GetOid()),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if !gitdomain.IsAbsoluteRevision(req.GetOid()) {
+		return status.New(codes.InvalidArgument, "oid must be a full object ID").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return err
+	}
+
+	// A blob doesn't know which paths it is stored at, so we can't tell if
+	// the actor may read it.
+	if !actor.FromContext(ctx).IsInternal() {
+		if enabled, err := gs.subRepoChecker.EnabledForRepo(ctx, repoName); err != nil {
+			return errors.Wrap(err, "sub-repo permissions check")
+		} else if enabled {
+			return status.New(codes.Unimplemented, "readBlob invoked for a repo with sub-repo permissions").Err()
+		}
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	r, err := backend.ReadBlob(ctx, api.CommitID(req.GetOid()))
+	if err != nil {
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "blob not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return err
+			}
+			return s.Err()
+		}
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return err
+	}
+	defer r.Close()
+
+	w := streamio.NewWriter(func(p []byte) error {
+		return ss.Send(&proto.ReadBlobResponse{Data: p})
+	})
+
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func (gs *grpcServer) GetTree(req *proto.GetTreeRequest, ss proto.GitserverService_GetTreeServer) error {
+	ctx := ss.Context()
+
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("treeish", string(req.GetTreeish())),
+		log.String("path", string(req.GetPath())),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if len(req.GetTreeish()) == 0 {
+		return status.New(codes.InvalidArgument, "treeish must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	entries, err := backend.GetTree(ctx, string(req.GetTreeish()), string(req.GetPath()))
+	if err != nil {
+		if os.IsNotExist(err) {
+			s, err := status.New(codes.NotFound, "file not found").WithDetails(&proto.FileNotFoundPayload{
+				Repo:   req.GetRepoName(),
+				Commit: string(req.GetTreeish()),
+				Path:   string(req.GetPath()),
+			})
+			if err != nil {
+				return err
+			}
+			return s.Err()
+		}
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return err
+			}
+			return s.Err()
+		}
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return err
+	}
+
+	entries, err = gs.filterTreeEntries(ctx, repoName, string(req.GetPath()), entries)
+	if err != nil {
+		return err
+	}
+
+	chunker := chunk.New(func(entries []*proto.TreeEntry) error {
+		return ss.Send(&proto.GetTreeResponse{Entries: entries})
+	})
+	for _, e := range entries {
+		if err := chunker.Send(e.ToProto()); err != nil {
+			return errors.Wrap(err, "failed to send tree entry chunk")
+		}
+	}
+	return chunker.Flush()
+}
+
+func (gs *grpcServer) SparseManifest(req *proto.SparseManifestRequest, ss proto.GitserverService_SparseManifestServer) error {
+	ctx := ss.Context()
+
+	paths := make([]string, len(req.GetPaths()))
+	for i, p := range req.GetPaths() {
+		paths[i] = string(p)
+	}
+
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("commit", req.GetCommitSha()),
+		log.Strings("paths", paths),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if req.GetCommitSha() == "" {
+		return status.New(codes.InvalidArgument, "commit_sha must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	entries, err := backend.SparseManifest(ctx, api.CommitID(req.GetCommitSha()), paths)
+	if err != nil {
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return err
+			}
+			return s.Err()
+		}
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return err
+	}
+
+	// The entries are named by their full paths, so they are filtered like
+	// the entries of the root tree.
+	entries, err = gs.filterTreeEntries(ctx, repoName, "", entries)
+	if err != nil {
+		return err
+	}
+
+	chunker := chunk.New(func(entries []*proto.TreeEntry) error {
+		return ss.Send(&proto.SparseManifestResponse{Entries: entries})
+	})
+	for _, e := range entries {
+		if err := chunker.Send(e.ToProto()); err != nil {
+			return errors.Wrap(err, "failed to send tree entry chunk")
+		}
+	}
+	return chunker.Flush()
+}
+
+func (gs *grpcServer) LastCommitsForTree(req *proto.LastCommitsForTreeRequest, ss proto.GitserverService_LastCommitsForTreeServer) error {
+	ctx := ss.Context()
+
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("commit", req.GetCommitSha()),
+		log.String("path", string(req.GetPath())),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if req.GetCommitSha() == "" {
+		return status.New(codes.InvalidArgument, "commit_sha must be specified").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return err
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	entries, err := backend.LastCommitsForTree(ctx, api.CommitID(req.GetCommitSha()), string(req.GetPath()))
+	if err != nil {
+		if os.IsNotExist(err) {
+			s, err := status.New(codes.NotFound, "file not found").WithDetails(&proto.FileNotFoundPayload{
+				Repo:   req.GetRepoName(),
+				Commit: req.GetCommitSha(),
+				Path:   string(req.GetPath()),
+			})
+			if err != nil {
+				return err
+			}
+			return s.Err()
+		}
+		var e *gitdomain.RevisionNotFoundError
+		if errors.As(err, &e) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: e.Spec,
+			})
+			if err != nil {
+				return err
+			}
+			return s.Err()
+		}
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return err
+	}
+
+	treeEntries := make([]gitdomain.TreeEntry, len(entries))
+	for i, e := range entries {
+		treeEntries[i] = e.TreeEntry
+	}
+	treeEntries, err = gs.filterTreeEntries(ctx, repoName, string(req.GetPath()), treeEntries)
+	if err != nil {
+		return err
+	}
+	readable := make(map[string]struct{}, len(treeEntries))
+	for _, e := range treeEntries {
+		readable[e.Name] = struct{}{}
+	}
+
+	chunker := chunk.New(func(entries []*proto.TreeEntryCommit) error {
+		return ss.Send(&proto.LastCommitsForTreeResponse{Entries: entries})
+	})
+	for _, e := range entries {
+		if _, ok := readable[e.Name]; !ok {
+			continue
+		}
+		if err := chunker.Send(e.ToProto()); err != nil {
+			return errors.Wrap(err, "failed to send tree entry commit chunk")
+		}
+	}
+	return chunker.Flush()
+}
+
+func (gs *grpcServer) CommitActivity(ctx context.Context, req *proto.CommitActivityRequest) (*proto.CommitActivityResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("revspec", string(req.GetRevspec())),
+		log.String("path", string(req.GetPath())),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	interval, err := gitdomain.CommitActivityIntervalFromProto(req.GetInterval())
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	opt := git.CommitActivityOpts{
+		Path:     string(req.GetPath()),
+		Interval: interval,
+	}
+	if req.GetAfter() != nil {
+		opt.After = req.GetAfter().AsTime()
+	}
+	if req.GetBefore() != nil {
+		opt.Before = req.GetBefore().AsTime()
+	}
+	if !opt.After.IsZero() && !opt.Before.IsZero() && opt.Before.Before(opt.After) {
+		return nil, status.New(codes.InvalidArgument, "before must not be before after").Err()
+	}
+
+	revspec := string(req.GetRevspec())
+	if revspec == "" {
+		revspec = "HEAD"
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	repoDir := gs.fs.RepoDir(repoName)
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	// The counts can't leave out the commits the actor can't see.
+	if enabled, err := authz.SubRepoEnabledForRepo(ctx, gs.subRepoChecker, repoName); err != nil {
+		return nil, err
+	} else if enabled {
+		return nil, status.New(codes.FailedPrecondition, "commit activity is not available for repos with subrepo permissions").Err()
+	}
+
+	backend := gs.getBackendFunc(repoDir, repoName)
+
+	commit, err := backend.ResolveRevision(ctx, revspec)
+	if err != nil {
+		return nil, gs.commitActivityError(ctx, req, err)
+	}
+	buckets, err := backend.CommitActivity(ctx, commit, opt)
+	if err != nil {
+		return nil, gs.commitActivityError(ctx, req, err)
+	}
+
+	res := &proto.CommitActivityResponse{
+		Buckets: make([]*proto.CommitActivityBucket, 0, len(buckets)),
+	}
+	for _, b := range buckets {
+		res.Buckets = append(res.Buckets, b.ToProto())
+	}
+	return res, nil
+}
+
+func (gs *grpcServer) commitActivityError(ctx context.Context, req *proto.CommitActivityRequest, err error) error {
+	var e *gitdomain.RevisionNotFoundError
+	if errors.As(err, &e) {
+		s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+			Repo: req.GetRepoName(),
+			Spec: e.Spec,
+		})
+		if err != nil {
+			return err
+		}
+		return s.Err()
+	}
+	gs.svc.LogIfCorrupt(ctx, api.RepoName(req.GetRepoName()), err)
+	return err
+}
+
+// filterTreeEntries leaves out the entries of the tree at dir that the actor
+// can't read.
+func (gs *grpcServer) filterTreeEntries(ctx context.Context, repo api.RepoName, dir string, entries []gitdomain.TreeEntry) ([]gitdomain.TreeEntry, error) {
+	if !authz.SubRepoEnabled(gs.subRepoChecker) {
+		return entries, nil
+	}
+
+	prefix := strings.Trim(dir, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	// Like for ReadDir, directories are checked with a trailing slash.
+	paths := make([]string, len(entries))
+	for i, e := range entries {
+		paths[i] = prefix + e.Name
+		if e.Type == gitdomain.ObjectTypeTree {
+			paths[i] += "/"
+		}
+	}
+	readable, err := authz.FilterActorPaths(ctx, gs.subRepoChecker, actor.FromContext(ctx), repo, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	// FilterActorPaths keeps the order of the paths.
+	filtered := make([]gitdomain.TreeEntry, 0, len(readable))
+	for i, p := range paths {
+		if len(readable) > 0 && readable[0] == p {
+			filtered = append(filtered, entries[i])
+			readable = readable[1:]
+		}
+	}
+	return filtered, nil
+}
+
+// runMaintenanceTask runs task unless another maintenance task is running on
+// the repository. git gc holds gc.pid itself and refuses to run while someone
+// else holds it, for the other tasks we take the lock like sg maintenance.
+func runMaintenanceTask(ctx context.Context, backend git.GitBackend, dir common.GitDir, task git.MaintenanceTask) error {
+	if task != git.MaintenanceTaskGC {
+		err, unlock := lockRepoForGC(dir)
+		if err != nil {
+			return status.New(codes.Aborted, errors.Wrap(err, "another maintenance task is running").Error()).Err()
+		}
+		defer func() { _ = unlock() }()
+	}
+
+	return backend.RunMaintenanceTask(ctx, task)
+}
+
+func (gs *grpcServer) checkRepoExists(ctx context.Context, repo api.RepoName) error {
+	cloned, err := gs.fs.RepoCloned(repo)
+	if err != nil {
+		return status.New(codes.Internal, errors.Wrap(err, "failed to check if repo is cloned").Error()).Err()
+	}
+
+	if cloned {
+		return nil
+	}
+
+	// On sourcegraph.com, not all repos are managed by the scheduler. We thus
+	// need to enqueue a manual clone of a repo that is visited but not cloned.
+	if dotcom.SourcegraphDotComMode() {
+		if conf.Get().DisableAutoGitUpdates {
+			gs.logger.Debug("not cloning on demand as DisableAutoGitUpdates is set")
+		} else {
+			_, err := repoupdater.DefaultClient.EnqueueRepoUpdate(ctx, repo)
+			if err != nil {
+				return errors.Wrap(err, "failed to enqueue repo clone")
+			}
+		}
+	}
+
+	cloneProgress, cloneInProgress := gs.locker.Status(repo)
+
+	return newRepoNotFoundError(repo, cloneInProgress, cloneProgress)
+}
+
+func hasAccessToCommit(ctx context.Context, repoName api.RepoName, files []string, checker authz.SubRepoPermissionChecker) (bool, error) {
+	if len(files) == 0 {
+		return true, nil // If commit has no files, assume user has access to view the commit.
+	}
+
+	if enabled, err := authz.SubRepoEnabledForRepo(ctx, checker, repoName); err != nil {
+		return false, err
+	} else if !enabled {
+		return true, nil
+	}
+
+	a := actor.FromContext(ctx)
 	for _, fileName := range files {
 		if hasAccess, err := authz.FilterActorPath(ctx, checker, a, repoName, fileName); err != nil {
 			return false, err
@@ -1377,3 +3884,157 @@ func newRepoNotFoundError(repo api.RepoName, cloneInProgress bool, cloneProgress
 	}
 	return s.Err()
 }
+
+func (gs *grpcServer) ListRemoteRefs(req *proto.ListRemoteRefsRequest, ss proto.GitserverService_ListRemoteRefsServer) error {
+	accesslog.Record(
+		ss.Context(),
+		req.GetRepoName(),
+	)
+
+	if req.GetRepoName() == "" {
+		return status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	refs, err := gs.svc.ListRemoteRefs(ss.Context(), api.RepoName(req.GetRepoName()))
+	if err != nil {
+		if errors.Is(err, errNoGitRemote) {
+			return status.New(codes.Unimplemented, err.Error()).Err()
+		}
+		var e *remoteUnavailableError
+		if errors.As(err, &e) {
+			return status.New(codes.Unavailable, e.Error()).Err()
+		}
+		return err
+	}
+
+	chunker := chunk.New(func(refs []*proto.GitRef) error {
+		return ss.Send(&proto.ListRemoteRefsResponse{Refs: refs})
+	})
+	for _, ref := range refs {
+		if err := chunker.Send(ref.ToProto()); err != nil {
+			return errors.Wrap(err, "failed to send ref chunk")
+		}
+	}
+	return chunker.Flush()
+}
+
+func (gs *grpcServer) FetchRefspec(ctx context.Context, req *proto.FetchRefspecRequest) (*proto.FetchRefspecResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("refspec", req.GetRefspec()),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if err := gitdomain.ValidateFetchRefspec(req.GetRefspec()); err != nil {
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	if err := gs.svc.FetchRefspec(ctx, repoName, req.GetRefspec()); err != nil {
+		if errors.Is(err, errNoGitRemote) {
+			return nil, status.New(codes.Unimplemented, err.Error()).Err()
+		}
+		var unavailableErr *remoteUnavailableError
+		if errors.As(err, &unavailableErr) {
+			return nil, status.New(codes.Unavailable, unavailableErr.Error()).Err()
+		}
+		var notFound *gitdomain.RevisionNotFoundError
+		if errors.As(err, &notFound) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: req.GetRepoName(),
+				Spec: notFound.Spec,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+		var conflictErr *gitdomain.RefUpdateConflictError
+		if errors.As(err, &conflictErr) {
+			s, err := status.New(codes.FailedPrecondition, "ref cannot be fast-forwarded").WithDetails(&proto.RefUpdateConflictPayload{
+				RepoName: req.GetRepoName(),
+				RefName:  conflictErr.Ref,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+		return nil, err
+	}
+
+	return &proto.FetchRefspecResponse{}, nil
+}
+
+func (gs *grpcServer) FetchCommitFromRepo(ctx context.Context, req *proto.FetchCommitFromRepoRequest) (*proto.FetchCommitFromRepoResponse, error) {
+	accesslog.Record(
+		ctx,
+		req.GetRepoName(),
+		log.String("source", req.GetSourceRepoName()),
+		log.String("commit", req.GetCommit()),
+	)
+
+	if req.GetRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "repo must be specified").Err()
+	}
+
+	if req.GetSourceRepoName() == "" {
+		return nil, status.New(codes.InvalidArgument, "source repo must be specified").Err()
+	}
+
+	if !gitdomain.IsAbsoluteRevision(req.GetCommit()) {
+		return nil, status.New(codes.InvalidArgument, "commit must be a full commit SHA").Err()
+	}
+
+	repoName := api.RepoName(req.GetRepoName())
+	sourceRepoName := api.RepoName(req.GetSourceRepoName())
+
+	if err := gs.checkRepoExists(ctx, repoName); err != nil {
+		return nil, err
+	}
+
+	// The fetched commit can be diffed against the commits of repo, which
+	// would leak the contents of files of the source repo that we can't
+	// filter.
+	if !actor.FromContext(ctx).IsInternal() {
+		if enabled, err := gs.subRepoChecker.EnabledForRepo(ctx, sourceRepoName); err != nil {
+			return nil, errors.Wrap(err, "sub-repo permissions check")
+		} else if enabled {
+			return nil, status.New(codes.Unimplemented, "fetchCommitFromRepo invoked for a source repo with sub-repo permissions").Err()
+		}
+	}
+
+	fetched, err := gs.svc.FetchCommitFromRepo(ctx, repoName, sourceRepoName, api.CommitID(req.GetCommit()))
+	if err != nil {
+		var notFound *gitdomain.RevisionNotFoundError
+		if errors.As(err, &notFound) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+				Repo: string(notFound.Repo),
+				Spec: notFound.Spec,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return nil, s.Err()
+		}
+		var notExist *gitdomain.RepoNotExistError
+		if errors.As(err, &notExist) {
+			cloneProgress, cloneInProgress := gs.locker.Status(notExist.Repo)
+			return nil, newRepoNotFoundError(notExist.Repo, cloneInProgress, cloneProgress)
+		}
+
+		gs.svc.LogIfCorrupt(ctx, repoName, err)
+		return nil, err
+	}
+
+	return &proto.FetchCommitFromRepoResponse{Fetched: fetched}, nil
+}
@@ -3,12 +3,17 @@ package internal
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
+	"slices"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,6 +31,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git/gitcli"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -36,6 +42,7 @@ import (
 	v1 "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	internalgrpc "github.com/sourcegraph/sourcegraph/internal/grpc"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/defaults"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
@@ -55,6 +62,9 @@ func TestGRPCServer_Blame(t *testing.T) {
 		err = gs.Blame(&v1.BlameRequest{RepoName: "therepo", Commit: "deadbeef", Path: ""}, mockSS)
 		require.ErrorContains(t, err, "path must be specified")
 		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		err = gs.Blame(&v1.BlameRequest{RepoName: "therepo", Commit: "deadbeef", Path: "thepath", Range: &v1.BlameRange{StartLine: 10, EndLine: 5}}, mockSS)
+		require.ErrorContains(t, err, "range start line must not be after end line")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
 	})
 	t.Run("checks for uncloned repo", func(t *testing.T) {
 		fs := gitserverfs.NewMockFS()
@@ -137,9 +147,10 @@ func TestGRPCServer_Blame(t *testing.T) {
 
 		cli := spawnServer(t, gs)
 		r, err := cli.Blame(context.Background(), &v1.BlameRequest{
-			RepoName: "therepo",
-			Commit:   "deadbeef",
-			Path:     "thepath",
+			RepoName:      "therepo",
+			Commit:        "deadbeef",
+			Path:          "thepath",
+			CopyDetection: v1.BlameCopyDetection_BLAME_COPY_DETECTION_COPIES,
 		})
 		require.NoError(t, err)
 		for {
@@ -161,6 +172,7 @@ func TestGRPCServer_Blame(t *testing.T) {
 				t.Fatalf("unexpected response (-want +got):\n%s", diff)
 			}
 		}
+		require.Equal(t, git.BlameCopyDetectionCopies, b.BlameFunc.History()[0].Arg3.CopyDetection)
 
 		{
 			b.BlameFunc.PushReturn(nil, &os.PathError{Op: "open", Path: "thepath", Err: os.ErrNotExist})
@@ -192,6 +204,189 @@ func TestGRPCServer_Blame(t *testing.T) {
 	})
 }
 
+func TestGRPCServer_BlameSummary(t *testing.T) {
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.BlameSummary(ctx, &v1.BlameSummaryRequest{RepoName: "", Commit: "deadbeef"})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.BlameSummary(ctx, &v1.BlameSummaryRequest{RepoName: "therepo", Commit: ""})
+		require.ErrorContains(t, err, "commit must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.BlameSummary(ctx, &v1.BlameSummaryRequest{RepoName: "therepo", Commit: "deadbeef"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("path not found", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.ListFilesFunc.SetDefaultReturn(nil, &os.PathError{Op: "ls-tree", Path: "nope", Err: os.ErrNotExist})
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+		_, err := gs.BlameSummary(ctx, &v1.BlameSummaryRequest{RepoName: "therepo", Commit: "deadbeef", Path: "nope"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.FileNotFoundPayload{})
+	})
+	t.Run("revision not found", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.ListFilesFunc.SetDefaultReturn(nil, &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: "deadbeef"})
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+		_, err := gs.BlameSummary(ctx, &v1.BlameSummaryRequest{RepoName: "therepo", Commit: "deadbeef"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+	})
+	t.Run("too many files", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.ListFilesFunc.SetDefaultReturn(make([]string, blameSummaryMaxFiles+1), nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+		_, err := gs.BlameSummary(ctx, &v1.BlameSummaryRequest{RepoName: "therepo", Commit: "deadbeef"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.ResourceExhausted)
+		mockassert.NotCalled(t, b.BlameFunc)
+	})
+	t.Run("checks for subrepo perms access to files", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		srp.EnabledFunc.SetDefaultReturn(true)
+		srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+		srp.FilePermissionsFuncFunc.SetDefaultReturn(func(path string) (authz.Perms, error) {
+			if path == "secret" {
+				return authz.None, nil
+			}
+			return authz.Read, nil
+		}, nil)
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.ListFilesFunc.SetDefaultHook(func(_ context.Context, _ api.CommitID, path string) ([]string, error) {
+			if path == "" {
+				return []string{"public", "secret"}, nil
+			}
+			return []string{path}, nil
+		})
+		b.BlameFunc.SetDefaultHook(func(context.Context, api.CommitID, string, git.BlameOptions) (git.BlameHunkReader, error) {
+			hr := git.NewMockBlameHunkReader()
+			hr.ReadFunc.SetDefaultReturn(nil, io.EOF)
+			return hr, nil
+		})
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		t.Run("denied files in a directory are skipped", func(t *testing.T) {
+			_, err := gs.BlameSummary(ctx, &v1.BlameSummaryRequest{RepoName: "therepo", Commit: "deadbeef"})
+			require.NoError(t, err)
+			require.Len(t, b.BlameFunc.History(), 1)
+			require.Equal(t, "public", b.BlameFunc.History()[0].Arg2)
+		})
+
+		t.Run("denied single file", func(t *testing.T) {
+			_, err := gs.BlameSummary(ctx, &v1.BlameSummaryRequest{RepoName: "therepo", Commit: "deadbeef", Path: "secret"})
+			require.Error(t, err)
+			assertGRPCStatusCode(t, err, codes.PermissionDenied)
+			assertHasGRPCErrorDetailOfType(t, err, &proto.UnauthorizedPayload{})
+		})
+	})
+	t.Run("e2e", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		// Skip subrepo perms checks.
+		srp.EnabledFunc.SetDefaultReturn(false)
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.ListFilesFunc.SetDefaultReturn([]string{"dir/a", "dir/b"}, nil)
+		alice := gitdomain.Signature{Name: "Alice", Email: "alice@example.com"}
+		bob := gitdomain.Signature{Name: "Bob", Email: "bob@example.com"}
+		t1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		t2 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		b.BlameFunc.SetDefaultHook(func(_ context.Context, _ api.CommitID, path string, _ git.BlameOptions) (git.BlameHunkReader, error) {
+			hr := git.NewMockBlameHunkReader()
+			switch path {
+			case "dir/a":
+				hr.ReadFunc.PushReturn(&gitdomain.Hunk{StartLine: 1, EndLine: 3, Author: gitdomain.Signature{Name: bob.Name, Email: bob.Email, Date: t1}}, nil)
+				hr.ReadFunc.PushReturn(&gitdomain.Hunk{StartLine: 3, EndLine: 4, Author: gitdomain.Signature{Name: alice.Name, Email: alice.Email, Date: t1}}, nil)
+			case "dir/b":
+				hr.ReadFunc.PushReturn(&gitdomain.Hunk{StartLine: 1, EndLine: 3, Author: gitdomain.Signature{Name: alice.Name, Email: alice.Email, Date: t2}}, nil)
+			}
+			hr.ReadFunc.PushReturn(nil, io.EOF)
+			return hr, nil
+		})
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		res, err := cli.BlameSummary(context.Background(), &v1.BlameSummaryRequest{
+			RepoName: "therepo",
+			Commit:   "deadbeef",
+			Path:     "dir",
+		})
+		require.NoError(t, err)
+		if diff := cmp.Diff(&proto.BlameSummaryResponse{
+			Authors: []*proto.BlameAuthorSummary{
+				{Name: "Alice", Email: "alice@example.com", LineCount: 3, LastTouched: timestamppb.New(t2)},
+				{Name: "Bob", Email: "bob@example.com", LineCount: 2, LastTouched: timestamppb.New(t1)},
+			},
+		}, res, cmpopts.IgnoreUnexported(proto.BlameSummaryResponse{}, proto.BlameAuthorSummary{}, timestamppb.Timestamp{})); diff != "" {
+			t.Fatalf("unexpected response (-want +got):\n%s", diff)
+		}
+		mockrequire.CalledOnceWith(t, b.ListFilesFunc, mockrequire.Values(mockrequire.Skip, api.CommitID("deadbeef"), "dir"))
+		mockrequire.CalledN(t, b.BlameFunc, 2)
+	})
+}
+
+func TestGRPCServer_Capabilities(t *testing.T) {
+	gs := &grpcServer{}
+	res, err := gs.Capabilities(context.Background(), &proto.CapabilitiesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"zstd", "gzip"}, res.GetCompressors())
+}
+
 func TestGRPCServer_DefaultBranch(t *testing.T) {
 	ctx := context.Background()
 	t.Run("argument validation", func(t *testing.T) {
@@ -344,6 +539,9 @@ func TestGRPCServer_ReadFile(t *testing.T) {
 		err = gs.ReadFile(&v1.ReadFileRequest{RepoName: "therepo", Path: "thepath", Commit: ""}, mockSS)
 		require.ErrorContains(t, err, "commit must be specified")
 		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		err = gs.ReadFile(&v1.ReadFileRequest{RepoName: "therepo", Path: "thepath", Commit: "deadbeef", Offset: -1}, mockSS)
+		require.ErrorContains(t, err, "offset must not be negative")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
 	})
 	t.Run("checks for uncloned repo", func(t *testing.T) {
 		fs := gitserverfs.NewMockFS()
@@ -410,6 +608,7 @@ func TestGRPCServer_ReadFile(t *testing.T) {
 		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
 		b := git.NewMockGitBackend()
 		b.ReadFileFunc.SetDefaultReturn(io.NopCloser(bytes.NewReader([]byte("filecontent"))), nil)
+		b.BlobOIDFunc.SetDefaultReturn("acbe86c7c89586e0912a0a851bacf309c595c308", nil)
 		gs := &grpcServer{
 			subRepoChecker: srp,
 			svc:            NewMockService(),
@@ -426,6 +625,7 @@ func TestGRPCServer_ReadFile(t *testing.T) {
 			Path:     "thepath",
 		})
 		require.NoError(t, err)
+		var msgs []*proto.ReadFileResponse
 		for {
 			msg, err := r.Recv()
 			if err != nil {
@@ -434,11 +634,13 @@ func TestGRPCServer_ReadFile(t *testing.T) {
 				}
 				require.NoError(t, err)
 			}
-			if diff := cmp.Diff(&proto.ReadFileResponse{
-				Data: []byte("filecontent"),
-			}, msg, cmpopts.IgnoreUnexported(proto.ReadFileResponse{})); diff != "" {
-				t.Fatalf("unexpected response (-want +got):\n%s", diff)
-			}
+			msgs = append(msgs, msg)
+		}
+		if diff := cmp.Diff([]*proto.ReadFileResponse{
+			{BlobOid: "acbe86c7c89586e0912a0a851bacf309c595c308"},
+			{Data: []byte("filecontent")},
+		}, msgs, cmpopts.IgnoreUnexported(proto.ReadFileResponse{})); diff != "" {
+			t.Fatalf("unexpected response (-want +got):\n%s", diff)
 		}
 
 		b.ReadFileFunc.SetDefaultReturn(nil, os.ErrNotExist)
@@ -464,6 +666,102 @@ func TestGRPCServer_ReadFile(t *testing.T) {
 		require.Error(t, err)
 		assertGRPCStatusCode(t, err, codes.NotFound)
 		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+
+		t.Run("resumes at offset", func(t *testing.T) {
+			b.ReadFileFunc.SetDefaultHook(func(context.Context, api.CommitID, string) (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader([]byte("filecontent"))), nil
+			})
+			read := func(offset int64) (string, error) {
+				cc, err := cli.ReadFile(context.Background(), &v1.ReadFileRequest{
+					RepoName: "therepo",
+					Commit:   "deadbeef",
+					Path:     "thepath",
+					Offset:   offset,
+				})
+				require.NoError(t, err)
+				var data []byte
+				for {
+					msg, err := cc.Recv()
+					if err == io.EOF {
+						return string(data), nil
+					}
+					if err != nil {
+						return "", err
+					}
+					data = append(data, msg.GetData()...)
+				}
+			}
+
+			content, err := read(4)
+			require.NoError(t, err)
+			require.Equal(t, "content", content)
+
+			content, err = read(11)
+			require.NoError(t, err)
+			require.Equal(t, "", content)
+
+			_, err = read(12)
+			assertGRPCStatusCode(t, err, codes.OutOfRange)
+		})
+
+		t.Run("not modified", func(t *testing.T) {
+			cc, err := cli.ReadFile(context.Background(), &v1.ReadFileRequest{
+				RepoName: "therepo",
+				Commit:   "deadbeef",
+				Path:     "thepath",
+				IfNotOid: "acbe86c7c89586e0912a0a851bacf309c595c308",
+			})
+			require.NoError(t, err)
+			msg, err := cc.Recv()
+			require.NoError(t, err)
+			require.True(t, msg.GetNotModified())
+			require.Empty(t, msg.GetData())
+			_, err = cc.Recv()
+			require.Equal(t, io.EOF, err)
+
+			cc, err = cli.ReadFile(context.Background(), &v1.ReadFileRequest{
+				RepoName: "therepo",
+				Commit:   "deadbeef",
+				Path:     "thepath",
+				IfNotOid: "0000000000000000000000000000000000000000",
+			})
+			require.NoError(t, err)
+			var data []byte
+			for {
+				msg, err := cc.Recv()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				require.False(t, msg.GetNotModified())
+				data = append(data, msg.GetData()...)
+			}
+			require.Equal(t, "filecontent", string(data))
+		})
+
+		t.Run("includes sha256", func(t *testing.T) {
+			cc, err := cli.ReadFile(context.Background(), &v1.ReadFileRequest{
+				RepoName:      "therepo",
+				Commit:        "deadbeef",
+				Path:          "thepath",
+				Offset:        4,
+				IncludeSha256: true,
+			})
+			require.NoError(t, err)
+			var last *proto.ReadFileResponse
+			for {
+				msg, err := cc.Recv()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				last = msg
+			}
+			// The digest covers the whole file, not just the part after the
+			// offset.
+			want := sha256.Sum256([]byte("filecontent"))
+			require.Equal(t, want[:], last.GetSha256())
+		})
 	})
 }
 
@@ -485,6 +783,18 @@ func TestGRPCServer_Archive(t *testing.T) {
 		err = gs.Archive(&v1.ArchiveRequest{Repo: "therepo", Treeish: "HEAD"}, mockSS)
 		require.ErrorContains(t, err, "unknown archive format")
 		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.Archive(&v1.ArchiveRequest{Repo: "therepo", Treeish: "HEAD", Format: proto.ArchiveFormat_ARCHIVE_FORMAT_TAR, Offset: -1}, mockSS)
+		require.ErrorContains(t, err, "offset must not be negative")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.Archive(&v1.ArchiveRequest{Repo: "therepo", Treeish: "HEAD", Format: proto.ArchiveFormat_ARCHIVE_FORMAT_TAR, Offset: 10}, mockSS)
+		require.ErrorContains(t, err, "treeish must be an absolute commit or tree ID")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.Archive(&v1.ArchiveRequest{Repo: "therepo", Treeish: "HEAD", Format: proto.ArchiveFormat_ARCHIVE_FORMAT_TAR, Filter: "tree:0"}, mockSS)
+		require.ErrorContains(t, err, "unsupported filter spec")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
 	})
 	t.Run("checks for uncloned repo", func(t *testing.T) {
 		fs := gitserverfs.NewMockFS()
@@ -608,6 +918,89 @@ func TestGRPCServer_Archive(t *testing.T) {
 		assertGRPCStatusCode(t, err, codes.NotFound)
 		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
 	})
+	t.Run("passes the filter to the backend", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.ArchiveReaderFunc.SetDefaultReturn(io.NopCloser(bytes.NewReader([]byte("filecontent"))), nil)
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		r, err := cli.Archive(context.Background(), &v1.ArchiveRequest{
+			Repo:    "therepo",
+			Treeish: "HEAD",
+			Format:  proto.ArchiveFormat_ARCHIVE_FORMAT_TAR,
+			Filter:  "blob:limit=1m",
+		})
+		require.NoError(t, err)
+		for {
+			if _, err := r.Recv(); err != nil {
+				require.Equal(t, io.EOF, err)
+				break
+			}
+		}
+		mockrequire.CalledOnce(t, b.ArchiveReaderFunc)
+		require.Equal(t, "blob:limit=1m", b.ArchiveReaderFunc.History()[0].Arg4)
+	})
+	t.Run("resumes at offset", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.ArchiveReaderFunc.SetDefaultHook(func(context.Context, git.ArchiveFormat, string, []string, string) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader([]byte("filecontent"))), nil
+		})
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+		cli := spawnServer(t, gs)
+
+		readAll := func(offset int64) ([]byte, error) {
+			cc, err := cli.Archive(context.Background(), &v1.ArchiveRequest{
+				Repo:    "therepo",
+				Treeish: "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
+				Format:  proto.ArchiveFormat_ARCHIVE_FORMAT_TAR,
+				Offset:  offset,
+			})
+			require.NoError(t, err)
+			var data []byte
+			for {
+				msg, err := cc.Recv()
+				if err == io.EOF {
+					return data, nil
+				}
+				if err != nil {
+					return nil, err
+				}
+				data = append(data, msg.GetData()...)
+			}
+		}
+
+		data, err := readAll(4)
+		require.NoError(t, err)
+		require.Equal(t, "content", string(data))
+
+		data, err = readAll(11)
+		require.NoError(t, err)
+		require.Empty(t, data)
+
+		_, err = readAll(12)
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.OutOfRange)
+	})
 }
 
 func TestGRPCServer_GetCommit(t *testing.T) {
@@ -695,6 +1088,77 @@ func TestGRPCServer_GetCommit(t *testing.T) {
 			assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
 		})
 	})
+	t.Run("includes stat and patch", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		srp.EnabledFunc.SetDefaultReturn(true)
+		srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+		srp.PermissionsFunc.SetDefaultHook(func(_ context.Context, _ int32, rc authz.RepoContent) (authz.Perms, error) {
+			if rc.Path == "secret" {
+				return authz.None, nil
+			}
+			return authz.Read, nil
+		})
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.GetCommitFunc.SetDefaultReturn(&git.GitCommitWithFiles{Commit: &gitdomain.Commit{ID: "deadbeef", Committer: &gitdomain.Signature{}}, ModifiedFiles: []string{"file", "secret"}}, nil)
+		b.CommitStatsFunc.SetDefaultReturn([]gitdomain.FileDiffStat{
+			{Path: "file", Added: 1, Deleted: 1},
+			{Path: "secret", Added: 1},
+		}, nil)
+		b.CommitPatchFunc.SetDefaultHook(func(context.Context, api.CommitID) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(`diff --git a/file b/file
+index 7898192..6178079 100644
+--- a/file
++++ b/file
+@@ -1 +1 @@
+-a
++b
+diff --git a/secret b/secret
+new file mode 100644
+index 0000000..6178079
+--- /dev/null
++++ b/secret
+@@ -0,0 +1 @@
++b
+`)), nil
+		})
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		res, err := gs.GetCommit(ctx, &v1.GetCommitRequest{RepoName: "therepo", Commit: "deadbeef"})
+		require.NoError(t, err)
+		require.Empty(t, res.GetStats())
+		require.Empty(t, res.GetPatch())
+		mockassert.NotCalled(t, b.CommitStatsFunc)
+		mockassert.NotCalled(t, b.CommitPatchFunc)
+
+		res, err = gs.GetCommit(ctx, &v1.GetCommitRequest{RepoName: "therepo", Commit: "deadbeef", IncludeStat: true, IncludePatch: true})
+		require.NoError(t, err)
+		if diff := cmp.Diff([]*proto.FileDiffStat{
+			{Path: []byte("file"), Added: 1, Deleted: 1},
+		}, res.GetStats(), cmpopts.IgnoreUnexported(proto.FileDiffStat{})); diff != "" {
+			t.Fatalf("unexpected stats (-want +got):\n%s", diff)
+		}
+		// The filtered patch is reformatted, which spells out hunk lengths.
+		require.Equal(t, `diff --git a/file b/file
+index 7898192..6178079 100644
+--- a/file
++++ b/file
+@@ -1,1 +1,1 @@
+-a
++b
+`, string(res.GetPatch()))
+		mockrequire.CalledOnceWith(t, b.CommitStatsFunc, mockrequire.Values(mockrequire.Skip, api.CommitID("deadbeef")))
+		mockrequire.CalledOnceWith(t, b.CommitPatchFunc, mockrequire.Values(mockrequire.Skip, api.CommitID("deadbeef")))
+	})
 	t.Run("e2e", func(t *testing.T) {
 		srp := authz.NewMockSubRepoPermissionChecker()
 		// Skip subrepo perms checks.
@@ -732,19 +1196,81 @@ func TestGRPCServer_GetCommit(t *testing.T) {
 	})
 }
 
-func TestGRPCServer_ResolveRevision(t *testing.T) {
+func TestGRPCServer_CloneProgress(t *testing.T) {
 	ctx := context.Background()
+	cloneProgress := func(gs *grpcServer, req *v1.CloneProgressRequest) ([]*proto.CloneProgressResponse, error) {
+		mockSS := gitserver.NewMockGitserverService_CloneProgressServer()
+		mockSS.ContextFunc.SetDefaultReturn(ctx)
+		var updates []*proto.CloneProgressResponse
+		mockSS.SendFunc.SetDefaultHook(func(res *v1.CloneProgressResponse) error {
+			updates = append(updates, res)
+			return nil
+		})
+		err := gs.CloneProgress(req, mockSS)
+		return updates, err
+	}
 	t.Run("argument validation", func(t *testing.T) {
 		gs := &grpcServer{}
-		_, err := gs.ResolveRevision(ctx, &v1.ResolveRevisionRequest{RepoName: ""})
+		_, err := cloneProgress(gs, &v1.CloneProgressRequest{RepoName: ""})
 		require.ErrorContains(t, err, "repo must be specified")
 		assertGRPCStatusCode(t, err, codes.InvalidArgument)
 	})
-	t.Run("checks for uncloned repo", func(t *testing.T) {
+	t.Run("not cloning", func(t *testing.T) {
 		fs := gitserverfs.NewMockFS()
-		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
 		locker := NewMockRepositoryLocker()
-		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{fs: fs, locker: locker}
+
+		updates, err := cloneProgress(gs, &v1.CloneProgressRequest{RepoName: "therepo"})
+		require.NoError(t, err)
+		require.Len(t, updates, 1)
+		require.True(t, updates[0].GetCloned())
+		require.False(t, updates[0].GetCloneInProgress())
+	})
+	t.Run("streams changes until the clone ended", func(t *testing.T) {
+		old := cloneProgressPollInterval
+		cloneProgressPollInterval = time.Millisecond
+		t.Cleanup(func() { cloneProgressPollInterval = old })
+
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.PushReturn(false, nil)
+		fs.RepoClonedFunc.PushReturn(false, nil)
+		fs.RepoClonedFunc.PushReturn(false, nil)
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.PushReturn("Receiving objects:  10% (1/10)", true)
+		locker.StatusFunc.PushReturn("Receiving objects:  10% (1/10)", true)
+		locker.StatusFunc.PushReturn("remote: Resolving deltas:  50% (1/2)", true)
+		locker.StatusFunc.SetDefaultReturn("", false)
+		gs := &grpcServer{fs: fs, locker: locker}
+
+		updates, err := cloneProgress(gs, &v1.CloneProgressRequest{RepoName: "therepo"})
+		require.NoError(t, err)
+		// The unchanged progress is only sent once.
+		require.Len(t, updates, 3)
+		require.True(t, updates[0].GetCloneInProgress())
+		require.Equal(t, "Receiving objects", updates[0].GetPhase())
+		require.Equal(t, uint32(10), updates[0].GetPercent())
+		require.Equal(t, "Resolving deltas", updates[1].GetPhase())
+		require.Equal(t, uint32(50), updates[1].GetPercent())
+		require.False(t, updates[2].GetCloneInProgress())
+		require.True(t, updates[2].GetCloned())
+	})
+}
+
+func TestGRPCServer_ResolveRevision(t *testing.T) {
+	ctx := context.Background()
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.ResolveRevision(ctx, &v1.ResolveRevisionRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
 		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
 		_, err := gs.ResolveRevision(ctx, &v1.ResolveRevisionRequest{RepoName: "therepo"})
 		require.Error(t, err)
@@ -929,6 +1455,2856 @@ func TestGRPCServer_ListRefs(t *testing.T) {
 	})
 }
 
+func TestGRPCServer_RefsChangedSince(t *testing.T) {
+	ctx := context.Background()
+	refsChangedSince := func(gs *grpcServer, req *v1.RefsChangedSinceRequest) ([]*v1.RefsChangedSinceResponse, error) {
+		mockSS := gitserver.NewMockGitserverService_RefsChangedSinceServer()
+		mockSS.ContextFunc.SetDefaultReturn(ctx)
+		var msgs []*v1.RefsChangedSinceResponse
+		mockSS.SendFunc.SetDefaultHook(func(res *v1.RefsChangedSinceResponse) error {
+			msgs = append(msgs, res)
+			return nil
+		})
+		err := gs.RefsChangedSince(req, mockSS)
+		return msgs, err
+	}
+	newServer := func(svc *MockService) *grpcServer {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		return &grpcServer{svc: svc, fs: fs}
+	}
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := refsChangedSince(gs, &v1.RefsChangedSinceRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = refsChangedSince(gs, &v1.RefsChangedSinceRequest{RepoName: "therepo", StateToken: "abc:1", Since: timestamppb.Now()})
+		require.ErrorContains(t, err, "only one of state_token and since may be set")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = refsChangedSince(gs, &v1.RefsChangedSinceRequest{RepoName: "therepo", StateToken: "abc"})
+		require.ErrorContains(t, err, "invalid state token")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("sends changes", func(t *testing.T) {
+		changed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		svc := NewMockService()
+		svc.RefsChangedSinceFunc.SetDefaultReturn(gitdomain.RefChanges{
+			Changes: []gitdomain.RefChange{
+				{Name: "refs/heads/main", CommitID: "c1", ChangedAt: changed},
+				{Name: "refs/heads/old", ChangedAt: changed},
+			},
+			StateToken: "abc:2",
+		}, nil)
+		msgs, err := refsChangedSince(newServer(svc), &v1.RefsChangedSinceRequest{RepoName: "therepo", Since: timestamppb.New(changed.Add(-time.Hour))})
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.Equal(t, "abc:2", msgs[0].GetStateToken())
+		require.False(t, msgs[0].GetFull())
+		require.Len(t, msgs[0].GetChanges(), 2)
+		require.Equal(t, "c1", msgs[0].GetChanges()[0].GetTargetCommit())
+		require.Empty(t, msgs[0].GetChanges()[1].GetTargetCommit())
+		mockrequire.CalledOnceWith(t, svc.RefsChangedSinceFunc, mockrequire.Values(mockrequire.Skip, api.RepoName("therepo"), "", changed.Add(-time.Hour)))
+	})
+	t.Run("nothing changed", func(t *testing.T) {
+		svc := NewMockService()
+		svc.RefsChangedSinceFunc.SetDefaultReturn(gitdomain.RefChanges{StateToken: "abc:2"}, nil)
+		msgs, err := refsChangedSince(newServer(svc), &v1.RefsChangedSinceRequest{RepoName: "therepo", StateToken: "abc:2"})
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		require.Equal(t, "abc:2", msgs[0].GetStateToken())
+		require.Empty(t, msgs[0].GetChanges())
+		mockrequire.CalledOnceWith(t, svc.RefsChangedSinceFunc, mockrequire.Values(mockrequire.Skip, api.RepoName("therepo"), "abc:2", time.Time{}))
+	})
+}
+
+func TestGRPCServer_WatchRefs(t *testing.T) {
+	ctx := context.Background()
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		mockSS := gitserver.NewMockGitserverService_WatchRefsServer()
+		mockSS.ContextFunc.SetDefaultReturn(ctx)
+		err := gs.WatchRefs(&v1.WatchRefsRequest{RepoName: ""}, mockSS)
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("sends updates", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		svc.WatchRefsFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, onChanges func(gitdomain.RefChanges) error) error {
+			if err := onChanges(gitdomain.RefChanges{StateToken: "abc:1"}); err != nil {
+				return err
+			}
+			return onChanges(gitdomain.RefChanges{
+				Changes:    []gitdomain.RefChange{{Name: "refs/heads/main", CommitID: "c1"}},
+				StateToken: "abc:2",
+			})
+		})
+		gs := &grpcServer{svc: svc, fs: fs}
+
+		mockSS := gitserver.NewMockGitserverService_WatchRefsServer()
+		mockSS.ContextFunc.SetDefaultReturn(ctx)
+		var msgs []*v1.WatchRefsResponse
+		mockSS.SendFunc.SetDefaultHook(func(res *v1.WatchRefsResponse) error {
+			msgs = append(msgs, res)
+			return nil
+		})
+		require.NoError(t, gs.WatchRefs(&v1.WatchRefsRequest{RepoName: "therepo"}, mockSS))
+
+		require.Len(t, msgs, 3)
+		require.Equal(t, "abc:1", msgs[0].GetStateToken())
+		require.Empty(t, msgs[0].GetChanges())
+		require.Equal(t, "refs/heads/main", msgs[1].GetChanges()[0].GetRefName())
+		require.Empty(t, msgs[1].GetStateToken())
+		require.Equal(t, "abc:2", msgs[2].GetStateToken())
+	})
+}
+
+func TestGRPCServer_WatchDefaultBranchCommits(t *testing.T) {
+	ctx := context.Background()
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		mockSS := gitserver.NewMockGitserverService_WatchDefaultBranchCommitsServer()
+		mockSS.ContextFunc.SetDefaultReturn(ctx)
+		err := gs.WatchDefaultBranchCommits(&v1.WatchDefaultBranchCommitsRequest{RepoName: ""}, mockSS)
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("sends updates", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		svc.WatchDefaultBranchCommitsFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, onCommits func(gitdomain.BranchCommits) error) error {
+			if err := onCommits(gitdomain.BranchCommits{Head: "c1"}); err != nil {
+				return err
+			}
+			return onCommits(gitdomain.BranchCommits{
+				Commits:   []gitdomain.BranchCommit{{ID: "c2"}},
+				Head:      "c2",
+				Rewritten: true,
+			})
+		})
+		gs := &grpcServer{svc: svc, fs: fs}
+
+		mockSS := gitserver.NewMockGitserverService_WatchDefaultBranchCommitsServer()
+		mockSS.ContextFunc.SetDefaultReturn(ctx)
+		var msgs []*v1.WatchDefaultBranchCommitsResponse
+		mockSS.SendFunc.SetDefaultHook(func(res *v1.WatchDefaultBranchCommitsResponse) error {
+			msgs = append(msgs, res)
+			return nil
+		})
+		require.NoError(t, gs.WatchDefaultBranchCommits(&v1.WatchDefaultBranchCommitsRequest{RepoName: "therepo"}, mockSS))
+
+		require.Len(t, msgs, 3)
+		require.Equal(t, "c1", msgs[0].GetHeadCommitSha())
+		require.Empty(t, msgs[0].GetCommits())
+		require.Equal(t, "c2", msgs[1].GetCommits()[0].GetCommitSha())
+		require.Empty(t, msgs[1].GetHeadCommitSha())
+		require.Equal(t, "c2", msgs[2].GetHeadCommitSha())
+		require.True(t, msgs[2].GetRewritten())
+	})
+}
+
+func TestGRPCServer_FormatPatch(t *testing.T) {
+	mockSS := gitserver.NewMockGitserverService_FormatPatchServer()
+	// Add an actor to the context.
+	a := actor.FromUser(1)
+	mockSS.ContextFunc.SetDefaultReturn(actor.WithActor(context.Background(), a))
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		err := gs.FormatPatch(&v1.FormatPatchRequest{RepoName: ""}, mockSS)
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.FormatPatch(&v1.FormatPatchRequest{RepoName: "therepo", Head: []byte("HEAD")}, mockSS)
+		require.ErrorContains(t, err, "base must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.FormatPatch(&v1.FormatPatchRequest{RepoName: "therepo", Base: []byte("HEAD~1")}, mockSS)
+		require.ErrorContains(t, err, "head must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		err := gs.FormatPatch(&v1.FormatPatchRequest{RepoName: "therepo", Base: []byte("HEAD~1"), Head: []byte("HEAD")}, mockSS)
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("checks if sub-repo perms are enabled for repo", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.FormatPatchFunc.SetDefaultReturn(io.NopCloser(bytes.NewReader([]byte("patch"))), nil)
+				return b
+			},
+		}
+
+		t.Run("subrepo perms are enabled but actor is internal", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+			mockSS := gitserver.NewMockGitserverService_FormatPatchServer()
+			// Add an internal actor to the context.
+			mockSS.ContextFunc.SetDefaultReturn(actor.WithInternalActor(context.Background()))
+			err := gs.FormatPatch(&v1.FormatPatchRequest{RepoName: "therepo", Base: []byte("HEAD~1"), Head: []byte("HEAD")}, mockSS)
+			assert.NoError(t, err)
+			mockassert.NotCalled(t, srp.EnabledForRepoFunc)
+		})
+
+		t.Run("subrepo perms are not enabled", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(false, nil)
+			err := gs.FormatPatch(&v1.FormatPatchRequest{RepoName: "therepo", Base: []byte("HEAD~1"), Head: []byte("HEAD")}, mockSS)
+			assert.NoError(t, err)
+			mockassert.Called(t, srp.EnabledForRepoFunc)
+		})
+
+		t.Run("subrepo perms are enabled, returns error", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+			err := gs.FormatPatch(&v1.FormatPatchRequest{RepoName: "therepo", Base: []byte("HEAD~1"), Head: []byte("HEAD")}, mockSS)
+			assert.Error(t, err)
+			assertGRPCStatusCode(t, err, codes.Unimplemented)
+			require.Contains(t, err.Error(), "formatPatch invoked for a repo with sub-repo permissions")
+			mockassert.Called(t, srp.EnabledForRepoFunc)
+		})
+	})
+	t.Run("e2e", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		// Skip subrepo perms checks.
+		srp.EnabledForRepoFunc.SetDefaultReturn(false, nil)
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.FormatPatchFunc.SetDefaultReturn(io.NopCloser(bytes.NewReader([]byte("patch"))), nil)
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		r, err := cli.FormatPatch(context.Background(), &v1.FormatPatchRequest{
+			RepoName: "therepo",
+			Base:     []byte("HEAD~1"),
+			Head:     []byte("HEAD"),
+		})
+		require.NoError(t, err)
+		for {
+			msg, err := r.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+			}
+			if diff := cmp.Diff(&proto.FormatPatchResponse{
+				Data: []byte("patch"),
+			}, msg, cmpopts.IgnoreUnexported(proto.FormatPatchResponse{})); diff != "" {
+				t.Fatalf("unexpected response (-want +got):\n%s", diff)
+			}
+		}
+
+		b.FormatPatchFunc.SetDefaultReturn(nil, &gitdomain.RevisionNotFoundError{})
+		cc, err := cli.FormatPatch(context.Background(), &v1.FormatPatchRequest{
+			RepoName: "therepo",
+			Base:     []byte("HEAD~1"),
+			Head:     []byte("HEAD"),
+		})
+		require.NoError(t, err)
+		_, err = cc.Recv()
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+	})
+}
+
+func TestGRPCServer_CreateBundle(t *testing.T) {
+	mockSS := gitserver.NewMockGitserverService_CreateBundleServer()
+	// Add an actor to the context.
+	a := actor.FromUser(1)
+	mockSS.ContextFunc.SetDefaultReturn(actor.WithActor(context.Background(), a))
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		err := gs.CreateBundle(&v1.CreateBundleRequest{RepoName: ""}, mockSS)
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.CreateBundle(&v1.CreateBundleRequest{RepoName: "therepo"}, mockSS)
+		require.ErrorContains(t, err, "at least one ref must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.CreateBundle(&v1.CreateBundleRequest{RepoName: "therepo", Refs: [][]byte{[]byte("HEAD"), []byte("^")}}, mockSS)
+		require.ErrorContains(t, err, "refs must not be empty")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.CreateBundle(&v1.CreateBundleRequest{RepoName: "therepo", Refs: [][]byte{[]byte("--all")}}, mockSS)
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.CreateBundle(&v1.CreateBundleRequest{RepoName: "therepo", Refs: [][]byte{[]byte("HEAD"), []byte("^-x")}}, mockSS)
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		err := gs.CreateBundle(&v1.CreateBundleRequest{RepoName: "therepo", Refs: [][]byte{[]byte("HEAD")}}, mockSS)
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("checks if sub-repo perms are enabled for repo", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.CreateBundleFunc.SetDefaultReturn(io.NopCloser(bytes.NewReader([]byte("bundle"))), nil)
+				return b
+			},
+		}
+
+		t.Run("subrepo perms are enabled but actor is internal", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+			mockSS := gitserver.NewMockGitserverService_CreateBundleServer()
+			// Add an internal actor to the context.
+			mockSS.ContextFunc.SetDefaultReturn(actor.WithInternalActor(context.Background()))
+			err := gs.CreateBundle(&v1.CreateBundleRequest{RepoName: "therepo", Refs: [][]byte{[]byte("HEAD")}}, mockSS)
+			assert.NoError(t, err)
+			mockassert.NotCalled(t, srp.EnabledForRepoFunc)
+		})
+
+		t.Run("subrepo perms are not enabled", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(false, nil)
+			err := gs.CreateBundle(&v1.CreateBundleRequest{RepoName: "therepo", Refs: [][]byte{[]byte("HEAD")}}, mockSS)
+			assert.NoError(t, err)
+			mockassert.Called(t, srp.EnabledForRepoFunc)
+		})
+
+		t.Run("subrepo perms are enabled, returns error", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+			err := gs.CreateBundle(&v1.CreateBundleRequest{RepoName: "therepo", Refs: [][]byte{[]byte("HEAD")}}, mockSS)
+			assert.Error(t, err)
+			assertGRPCStatusCode(t, err, codes.Unimplemented)
+			require.Contains(t, err.Error(), "createBundle invoked for a repo with sub-repo permissions")
+			mockassert.Called(t, srp.EnabledForRepoFunc)
+		})
+	})
+	t.Run("e2e", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		// Skip subrepo perms checks.
+		srp.EnabledForRepoFunc.SetDefaultReturn(false, nil)
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.CreateBundleFunc.SetDefaultReturn(io.NopCloser(bytes.NewReader([]byte("bundle"))), nil)
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		r, err := cli.CreateBundle(context.Background(), &v1.CreateBundleRequest{
+			RepoName: "therepo",
+			Refs:     [][]byte{[]byte("refs/heads/main"), []byte("^v1.0.0")},
+		})
+		require.NoError(t, err)
+		for {
+			msg, err := r.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+			}
+			if diff := cmp.Diff(&proto.CreateBundleResponse{
+				Data: []byte("bundle"),
+			}, msg, cmpopts.IgnoreUnexported(proto.CreateBundleResponse{})); diff != "" {
+				t.Fatalf("unexpected response (-want +got):\n%s", diff)
+			}
+		}
+		mockrequire.CalledOnceWith(t, b.CreateBundleFunc, mockrequire.Values(mockrequire.Skip, []string{"refs/heads/main", "^v1.0.0"}))
+
+		b.CreateBundleFunc.SetDefaultReturn(nil, &gitdomain.RevisionNotFoundError{})
+		cc, err := cli.CreateBundle(context.Background(), &v1.CreateBundleRequest{
+			RepoName: "therepo",
+			Refs:     [][]byte{[]byte("refs/heads/main")},
+		})
+		require.NoError(t, err)
+		_, err = cc.Recv()
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+	})
+}
+
+func TestGRPCServer_CommitGraph(t *testing.T) {
+	mockSS := gitserver.NewMockGitserverService_CommitGraphServer()
+	// Add an actor to the context.
+	a := actor.FromUser(1)
+	mockSS.ContextFunc.SetDefaultReturn(actor.WithActor(context.Background(), a))
+	newIterator := func(nodes ...*gitdomain.CommitGraphNode) git.CommitGraphIterator {
+		it := git.NewMockCommitGraphIterator()
+		for _, n := range nodes {
+			it.NextFunc.PushReturn(n, nil)
+		}
+		it.NextFunc.SetDefaultReturn(nil, io.EOF)
+		return it
+	}
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		err := gs.CommitGraph(&v1.CommitGraphRequest{RepoName: ""}, mockSS)
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = gs.CommitGraph(&v1.CommitGraphRequest{RepoName: "therepo", Base: []byte("HEAD~1")}, mockSS)
+		require.ErrorContains(t, err, "head must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		err := gs.CommitGraph(&v1.CommitGraphRequest{RepoName: "therepo", Head: []byte("HEAD")}, mockSS)
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("checks if sub-repo perms are enabled for repo", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.CommitGraphFunc.SetDefaultHook(func(context.Context, git.CommitGraphOpts) (git.CommitGraphIterator, error) {
+					return newIterator(), nil
+				})
+				return b
+			},
+		}
+
+		t.Run("subrepo perms are enabled but actor is internal", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+			mockSS := gitserver.NewMockGitserverService_CommitGraphServer()
+			// Add an internal actor to the context.
+			mockSS.ContextFunc.SetDefaultReturn(actor.WithInternalActor(context.Background()))
+			err := gs.CommitGraph(&v1.CommitGraphRequest{RepoName: "therepo", Head: []byte("HEAD")}, mockSS)
+			assert.NoError(t, err)
+			mockassert.NotCalled(t, srp.EnabledForRepoFunc)
+		})
+
+		t.Run("subrepo perms are not enabled", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(false, nil)
+			err := gs.CommitGraph(&v1.CommitGraphRequest{RepoName: "therepo", Head: []byte("HEAD")}, mockSS)
+			assert.NoError(t, err)
+			mockassert.Called(t, srp.EnabledForRepoFunc)
+		})
+
+		t.Run("subrepo perms are enabled, returns error", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+			err := gs.CommitGraph(&v1.CommitGraphRequest{RepoName: "therepo", Head: []byte("HEAD")}, mockSS)
+			assert.Error(t, err)
+			assertGRPCStatusCode(t, err, codes.Unimplemented)
+			require.Contains(t, err.Error(), "commitGraph invoked for a repo with sub-repo permissions")
+			mockassert.Called(t, srp.EnabledForRepoFunc)
+		})
+	})
+	t.Run("e2e", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		// Skip subrepo perms checks.
+		srp.EnabledForRepoFunc.SetDefaultReturn(false, nil)
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.CommitGraphFunc.SetDefaultHook(func(context.Context, git.CommitGraphOpts) (git.CommitGraphIterator, error) {
+			return newIterator(
+				&gitdomain.CommitGraphNode{Commit: "c2", Parents: []api.CommitID{"c1", "c0"}},
+				&gitdomain.CommitGraphNode{Commit: "c1", Parents: []api.CommitID{}},
+			), nil
+		})
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		r, err := cli.CommitGraph(context.Background(), &v1.CommitGraphRequest{
+			RepoName:   "therepo",
+			Head:       []byte("HEAD"),
+			Base:       []byte("HEAD~2"),
+			MaxCommits: 10,
+		})
+		require.NoError(t, err)
+		var nodes []*proto.CommitGraphNode
+		for {
+			msg, err := r.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			nodes = append(nodes, msg.GetNodes()...)
+		}
+		if diff := cmp.Diff([]*proto.CommitGraphNode{
+			{CommitSha: "c2", ParentShas: []string{"c1", "c0"}},
+			{CommitSha: "c1"},
+		}, nodes, cmpopts.IgnoreUnexported(proto.CommitGraphNode{}), cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("unexpected response (-want +got):\n%s", diff)
+		}
+		mockrequire.CalledOnceWith(t, b.CommitGraphFunc, mockrequire.Values(mockrequire.Skip, git.CommitGraphOpts{
+			Head:       "HEAD",
+			Base:       "HEAD~2",
+			MaxCommits: 10,
+		}))
+
+		b.CommitGraphFunc.SetDefaultReturn(nil, &gitdomain.RevisionNotFoundError{})
+		cc, err := cli.CommitGraph(context.Background(), &v1.CommitGraphRequest{
+			RepoName: "therepo",
+			Head:     []byte("HEAD"),
+		})
+		require.NoError(t, err)
+		_, err = cc.Recv()
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+	})
+}
+
+func TestGRPCServer_Cherry(t *testing.T) {
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.Cherry(ctx, &v1.CherryRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.Cherry(ctx, &v1.CherryRequest{RepoName: "therepo", Head: []byte("feature")})
+		require.ErrorContains(t, err, "upstream must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.Cherry(ctx, &v1.CherryRequest{RepoName: "therepo", Upstream: []byte("master")})
+		require.ErrorContains(t, err, "head must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.Cherry(ctx, &v1.CherryRequest{RepoName: "therepo", Upstream: []byte("master"), Head: []byte("feature")})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("checks if sub-repo perms are enabled for repo", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return git.NewMockGitBackend()
+			},
+		}
+		req := &v1.CherryRequest{RepoName: "therepo", Upstream: []byte("master"), Head: []byte("feature")}
+
+		t.Run("subrepo perms are enabled but actor is internal", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+			_, err := gs.Cherry(actor.WithInternalActor(context.Background()), req)
+			assert.NoError(t, err)
+			mockassert.NotCalled(t, srp.EnabledForRepoFunc)
+		})
+
+		t.Run("subrepo perms are not enabled", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(false, nil)
+			_, err := gs.Cherry(ctx, req)
+			assert.NoError(t, err)
+			mockassert.Called(t, srp.EnabledForRepoFunc)
+		})
+
+		t.Run("subrepo perms are enabled, returns error", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+			_, err := gs.Cherry(ctx, req)
+			assert.Error(t, err)
+			assertGRPCStatusCode(t, err, codes.Unimplemented)
+			require.Contains(t, err.Error(), "cherry invoked for a repo with sub-repo permissions")
+		})
+	})
+	t.Run("revision not found", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.CherryFunc.SetDefaultReturn(nil, &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: "feature"})
+				return b
+			},
+		}
+		_, err := gs.Cherry(ctx, &v1.CherryRequest{RepoName: "therepo", Upstream: []byte("master"), Head: []byte("feature")})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+		require.Contains(t, err.Error(), "revision not found")
+	})
+	t.Run("e2e", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.CherryFunc.SetDefaultReturn([]gitdomain.CherryCommit{
+			{Commit: "deadbeef", InUpstream: true},
+			{Commit: "beefdead"},
+		}, nil)
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		res, err := cli.Cherry(ctx, &v1.CherryRequest{
+			RepoName: "therepo",
+			Upstream: []byte("master"),
+			Head:     []byte("feature"),
+		})
+		require.NoError(t, err)
+		if diff := cmp.Diff(&proto.CherryResponse{
+			Commits: []*proto.CherryCommit{
+				{CommitSha: "deadbeef", InUpstream: true},
+				{CommitSha: "beefdead"},
+			},
+		}, res, cmpopts.IgnoreUnexported(proto.CherryResponse{}, proto.CherryCommit{})); diff != "" {
+			t.Fatalf("unexpected response (-want +got):\n%s", diff)
+		}
+		mockrequire.CalledOnceWith(t, b.CherryFunc, mockrequire.Values(mockrequire.Skip, "master", "feature"))
+	})
+}
+
+func TestGRPCServer_RangeDiff(t *testing.T) {
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.RangeDiff(ctx, &v1.RangeDiffRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.RangeDiff(ctx, &v1.RangeDiffRequest{RepoName: "therepo", OldBase: []byte("master"), NewBase: []byte("master"), NewHead: []byte("v2")})
+		require.ErrorContains(t, err, "old range must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.RangeDiff(ctx, &v1.RangeDiffRequest{RepoName: "therepo", OldBase: []byte("master"), OldHead: []byte("v1"), NewHead: []byte("v2")})
+		require.ErrorContains(t, err, "new range must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.RangeDiff(ctx, &v1.RangeDiffRequest{RepoName: "therepo", OldBase: []byte("master"), OldHead: []byte("v1"), NewBase: []byte("master"), NewHead: []byte("v2")})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("checks if sub-repo perms are enabled for repo", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return git.NewMockGitBackend()
+			},
+		}
+		req := &v1.RangeDiffRequest{RepoName: "therepo", OldBase: []byte("master"), OldHead: []byte("v1"), NewBase: []byte("master"), NewHead: []byte("v2")}
+
+		t.Run("subrepo perms are enabled but actor is internal", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+			_, err := gs.RangeDiff(actor.WithInternalActor(context.Background()), req)
+			assert.NoError(t, err)
+			mockassert.NotCalled(t, srp.EnabledForRepoFunc)
+		})
+
+		t.Run("subrepo perms are not enabled", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(false, nil)
+			_, err := gs.RangeDiff(ctx, req)
+			assert.NoError(t, err)
+			mockassert.Called(t, srp.EnabledForRepoFunc)
+		})
+
+		t.Run("subrepo perms are enabled, returns error", func(t *testing.T) {
+			srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+			_, err := gs.RangeDiff(ctx, req)
+			assert.Error(t, err)
+			assertGRPCStatusCode(t, err, codes.Unimplemented)
+			require.Contains(t, err.Error(), "range-diff invoked for a repo with sub-repo permissions")
+		})
+	})
+	t.Run("revision not found", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.RangeDiffFunc.SetDefaultReturn(nil, &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: "v2"})
+				return b
+			},
+		}
+		_, err := gs.RangeDiff(ctx, &v1.RangeDiffRequest{RepoName: "therepo", OldBase: []byte("master"), OldHead: []byte("v1"), NewBase: []byte("master"), NewHead: []byte("v2")})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+		require.Contains(t, err.Error(), "revision not found")
+	})
+	t.Run("e2e", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.RangeDiffFunc.SetDefaultReturn([]*gitdomain.RangeDiffPair{
+			{Status: gitdomain.RangeDiffStatusEqual, OldIndex: 1, OldCommit: "deadbeef", NewIndex: 1, NewCommit: "beefdead", Subject: "one"},
+			{Status: gitdomain.RangeDiffStatusRemoved, OldIndex: 2, OldCommit: "f00f00", Subject: "two"},
+		}, nil)
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		res, err := cli.RangeDiff(ctx, &v1.RangeDiffRequest{RepoName: "therepo", OldBase: []byte("master"), OldHead: []byte("v1"), NewBase: []byte("master"), NewHead: []byte("v2")})
+		require.NoError(t, err)
+		if diff := cmp.Diff(&proto.RangeDiffResponse{
+			Pairs: []*proto.RangeDiffPair{
+				{Status: proto.RangeDiffPair_STATUS_EQUAL, OldIndex: 1, OldCommitSha: "deadbeef", NewIndex: 1, NewCommitSha: "beefdead", Subject: []byte("one")},
+				{Status: proto.RangeDiffPair_STATUS_REMOVED, OldIndex: 2, OldCommitSha: "f00f00", Subject: []byte("two")},
+			},
+		}, res, cmpopts.IgnoreUnexported(proto.RangeDiffResponse{}, proto.RangeDiffPair{})); diff != "" {
+			t.Fatalf("unexpected response (-want +got):\n%s", diff)
+		}
+		mockrequire.CalledOnceWith(t, b.RangeDiffFunc, mockrequire.Values(mockrequire.Skip, git.RangeDiffOpts{OldBase: "master", OldHead: "v1", NewBase: "master", NewHead: "v2"}))
+	})
+}
+
+func TestGRPCServer_UpdateRef(t *testing.T) {
+	ctx := context.Background()
+	newSHA := "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef"
+	oldSHA := "beefdeadbeefdeadbeefdeadbeefdeadbeefdead"
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.UpdateRef(ctx, &v1.UpdateRefRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.UpdateRef(ctx, &v1.UpdateRefRequest{RepoName: "therepo", RefName: "master", NewCommitSha: newSHA})
+		require.ErrorContains(t, err, "ref must be a fully qualified ref name")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.UpdateRef(ctx, &v1.UpdateRefRequest{RepoName: "therepo", RefName: "refs/heads/master", NewCommitSha: "HEAD"})
+		require.ErrorContains(t, err, "new commit must be a full commit SHA")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.UpdateRef(ctx, &v1.UpdateRefRequest{RepoName: "therepo", RefName: "refs/heads/master", NewCommitSha: newSHA, ExpectedOldCommitSha: "HEAD~1"})
+		require.ErrorContains(t, err, "expected old commit must be empty or a full commit SHA")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.UpdateRef(ctx, &v1.UpdateRefRequest{RepoName: "therepo", RefName: "refs/heads/master", NewCommitSha: newSHA})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("revision not found", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.UpdateRefFunc.SetDefaultReturn(&gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: newSHA})
+				return b
+			},
+		}
+		_, err := gs.UpdateRef(ctx, &v1.UpdateRefRequest{RepoName: "therepo", RefName: "refs/heads/master", NewCommitSha: newSHA})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+		require.Contains(t, err.Error(), "revision not found")
+	})
+	t.Run("conflict", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.UpdateRefFunc.SetDefaultReturn(&gitdomain.RefUpdateConflictError{Repo: "therepo", Ref: "refs/heads/master", ExpectedOldOID: api.CommitID(oldSHA)})
+				return b
+			},
+		}
+		_, err := gs.UpdateRef(ctx, &v1.UpdateRefRequest{RepoName: "therepo", RefName: "refs/heads/master", NewCommitSha: newSHA, ExpectedOldCommitSha: oldSHA})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.FailedPrecondition)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RefUpdateConflictPayload{})
+	})
+	t.Run("e2e", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		_, err := cli.UpdateRef(ctx, &v1.UpdateRefRequest{
+			RepoName:             "therepo",
+			RefName:              "refs/heads/master",
+			NewCommitSha:         newSHA,
+			ExpectedOldCommitSha: oldSHA,
+		})
+		require.NoError(t, err)
+		mockrequire.CalledOnceWith(t, b.UpdateRefFunc, mockassert.Values(mockassert.Skip, "refs/heads/master", api.CommitID(newSHA), api.CommitID(oldSHA)))
+	})
+}
+
+func TestGRPCServer_SymbolicRef(t *testing.T) {
+	ctx := context.Background()
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.SymbolicRef(ctx, &v1.SymbolicRefRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.SymbolicRef(ctx, &v1.SymbolicRefRequest{RepoName: "therepo", RefName: "master"})
+		require.ErrorContains(t, err, "ref must be HEAD or a fully qualified ref name")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.SymbolicRef(ctx, &v1.SymbolicRefRequest{RepoName: "therepo", RefName: "HEAD"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("e2e", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.SymbolicRefFunc.SetDefaultReturn("refs/heads/main", nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		res, err := cli.SymbolicRef(ctx, &v1.SymbolicRefRequest{
+			RepoName: "therepo",
+			RefName:  "HEAD",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "refs/heads/main", res.GetTarget())
+		mockrequire.CalledOnceWith(t, b.SymbolicRefFunc, mockassert.Values(mockassert.Skip, "HEAD"))
+	})
+}
+
+func TestGRPCServer_RefExists(t *testing.T) {
+	ctx := context.Background()
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.RefExists(ctx, &v1.RefExistsRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.RefExists(ctx, &v1.RefExistsRequest{RepoName: "therepo", RefName: "--heads"})
+		require.ErrorContains(t, err, "ref must be HEAD or a fully qualified ref name")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.RefExists(ctx, &v1.RefExistsRequest{RepoName: "therepo", RefName: "refs/heads/main"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("e2e", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.RefExistsFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		res, err := cli.RefExists(ctx, &v1.RefExistsRequest{
+			RepoName: "therepo",
+			RefName:  "refs/heads/main",
+		})
+		require.NoError(t, err)
+		require.True(t, res.GetExists())
+		mockrequire.CalledOnceWith(t, b.RefExistsFunc, mockassert.Values(mockassert.Skip, "refs/heads/main"))
+	})
+}
+
+func TestGRPCServer_ListBranches(t *testing.T) {
+	// Add an actor to the context.
+	a := actor.FromUser(1)
+	ctx := actor.WithActor(context.Background(), a)
+	newServer := func() (*grpcServer, *git.MockGitBackend, *authz.MockSubRepoPermissionChecker) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		srp.EnabledFunc.SetDefaultReturn(true)
+		srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+		srp.PermissionsFunc.SetDefaultHook(func(_ context.Context, _ int32, rc authz.RepoContent) (authz.Perms, error) {
+			if rc.Path == "secret" {
+				return authz.None, nil
+			}
+			return authz.Read, nil
+		})
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		it := git.NewMockRefIterator()
+		it.NextFunc.PushReturn(&gitdomain.Ref{Name: "refs/heads/master", ShortName: "master", Type: gitdomain.RefTypeBranch, CommitID: "c1", IsHead: true}, nil)
+		it.NextFunc.PushReturn(&gitdomain.Ref{Name: "refs/heads/secret", ShortName: "secret", Type: gitdomain.RefTypeBranch, CommitID: "c2"}, nil)
+		it.NextFunc.SetDefaultReturn(nil, io.EOF)
+		b.ListRefsFunc.SetDefaultReturn(it, nil)
+		b.GetCommitFunc.SetDefaultHook(func(_ context.Context, id api.CommitID, _ bool) (*git.GitCommitWithFiles, error) {
+			files := []string{"file"}
+			if id == "c2" {
+				files = []string{"secret"}
+			}
+			return &git.GitCommitWithFiles{Commit: &gitdomain.Commit{ID: id, Committer: &gitdomain.Signature{}}, ModifiedFiles: files}, nil
+		})
+		b.BehindAheadFunc.SetDefaultHook(func(_ context.Context, _, right string) (*gitdomain.BehindAhead, error) {
+			if right == "c2" {
+				return &gitdomain.BehindAhead{Behind: 1, Ahead: 2}, nil
+			}
+			return &gitdomain.BehindAhead{}, nil
+		})
+		gs := &grpcServer{
+			subRepoChecker: srp,
+			svc:            NewMockService(),
+			fs:             fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+		return gs, b, srp
+	}
+	listBranches := func(gs *grpcServer, req *v1.ListBranchesRequest) ([]*proto.GitBranch, error) {
+		mockSS := gitserver.NewMockGitserverService_ListBranchesServer()
+		mockSS.ContextFunc.SetDefaultReturn(ctx)
+		var branches []*proto.GitBranch
+		mockSS.SendFunc.SetDefaultHook(func(res *v1.ListBranchesResponse) error {
+			branches = append(branches, res.GetBranches()...)
+			return nil
+		})
+		err := gs.ListBranches(req, mockSS)
+		return branches, err
+	}
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := listBranches(gs, &v1.ListBranchesRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := listBranches(gs, &v1.ListBranchesRequest{RepoName: "therepo"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("adds counts and commits", func(t *testing.T) {
+		gs, b, _ := newServer()
+		b.ResolveRevisionFunc.SetDefaultReturn("c1", nil)
+
+		branches, err := listBranches(gs, &v1.ListBranchesRequest{RepoName: "therepo", Base: []byte("main")})
+		require.NoError(t, err)
+		require.Len(t, branches, 2)
+
+		require.Equal(t, "refs/heads/master", branches[0].GetRef().GetRefName())
+		require.Equal(t, uint32(0), branches[0].GetBehindAhead().GetBehind())
+		require.Equal(t, uint32(0), branches[0].GetBehindAhead().GetAhead())
+		require.Equal(t, "c1", branches[0].GetCommit().GetOid())
+
+		require.Equal(t, "refs/heads/secret", branches[1].GetRef().GetRefName())
+		require.Equal(t, uint32(1), branches[1].GetBehindAhead().GetBehind())
+		require.Equal(t, uint32(2), branches[1].GetBehindAhead().GetAhead())
+		// The branch is listed, but the commit only touches a file the actor
+		// can't see.
+		require.Nil(t, branches[1].GetCommit())
+
+		mockrequire.CalledOnceWith(t, b.ResolveRevisionFunc, mockassert.Values(mockassert.Skip, "main"))
+		mockrequire.CalledOnceWith(t, b.ListRefsFunc, mockassert.Values(mockassert.Skip, git.ListRefsOpts{HeadsOnly: true}))
+		mockrequire.CalledWith(t, b.BehindAheadFunc, mockassert.Values(mockassert.Skip, "c1", "c2"))
+	})
+	t.Run("base defaults to HEAD", func(t *testing.T) {
+		gs, b, _ := newServer()
+		b.ResolveRevisionFunc.SetDefaultReturn("c1", nil)
+
+		_, err := listBranches(gs, &v1.ListBranchesRequest{RepoName: "therepo"})
+		require.NoError(t, err)
+		mockrequire.CalledOnceWith(t, b.ResolveRevisionFunc, mockassert.Values(mockassert.Skip, "HEAD"))
+	})
+	t.Run("base not found", func(t *testing.T) {
+		gs, b, _ := newServer()
+		b.ResolveRevisionFunc.SetDefaultReturn("", &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: "HEAD"})
+
+		branches, err := listBranches(gs, &v1.ListBranchesRequest{RepoName: "therepo"})
+		require.NoError(t, err)
+		require.Len(t, branches, 2)
+		for _, b := range branches {
+			require.Nil(t, b.GetBehindAhead())
+		}
+		mockassert.NotCalled(t, b.BehindAheadFunc)
+	})
+}
+
+func TestGRPCServer_ListTags(t *testing.T) {
+	ctx := context.Background()
+	listTags := func(gs *grpcServer, req *v1.ListTagsRequest) ([]*proto.GitRef, error) {
+		mockSS := gitserver.NewMockGitserverService_ListTagsServer()
+		mockSS.ContextFunc.SetDefaultReturn(ctx)
+		var refs []*proto.GitRef
+		mockSS.SendFunc.SetDefaultHook(func(res *v1.ListTagsResponse) error {
+			refs = append(refs, res.GetRefs()...)
+			return nil
+		})
+		err := gs.ListTags(req, mockSS)
+		return refs, err
+	}
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := listTags(gs, &v1.ListTagsRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := listTags(gs, &v1.ListTagsRequest{RepoName: "therepo"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("passes options to the backend", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		it := git.NewMockRefIterator()
+		it.NextFunc.PushReturn(&gitdomain.Ref{Name: "refs/tags/v2.0.0", ShortName: "v2.0.0", Type: gitdomain.RefTypeTag, CommitID: "c1", RefOID: "t1"}, nil)
+		it.NextFunc.PushReturn(&gitdomain.Ref{Name: "refs/tags/v1.0.0", ShortName: "v1.0.0", Type: gitdomain.RefTypeTag, CommitID: "c2", RefOID: "c2"}, nil)
+		it.NextFunc.SetDefaultReturn(nil, io.EOF)
+		b.ListTagsFunc.SetDefaultReturn(it, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		refs, err := listTags(gs, &v1.ListTagsRequest{RepoName: "therepo", Patterns: []string{"v*"}, SortByVersion: true, Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, refs, 2)
+		require.Equal(t, "refs/tags/v2.0.0", refs[0].GetRefName())
+		require.Equal(t, "c1", refs[0].GetTargetCommit())
+		require.Equal(t, "refs/tags/v1.0.0", refs[1].GetRefName())
+
+		mockrequire.CalledOnceWith(t, b.ListTagsFunc, mockassert.Values(mockassert.Skip, git.ListTagsOpts{Patterns: []string{"v*"}, SortByVersion: true, Limit: 2}))
+		mockassert.CalledOnce(t, it.CloseFunc)
+	})
+}
+
+func TestGRPCServer_CreateBranch(t *testing.T) {
+	ctx := context.Background()
+	sha := "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef"
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.CreateBranch(ctx, &v1.CreateBranchRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CreateBranch(ctx, &v1.CreateBranchRequest{RepoName: "therepo"})
+		require.ErrorContains(t, err, "branch must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CreateBranch(ctx, &v1.CreateBranchRequest{RepoName: "therepo", BranchName: "foo..bar"})
+		require.ErrorContains(t, err, "invalid branch name")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.CreateBranch(ctx, &v1.CreateBranchRequest{RepoName: "therepo", BranchName: "feature"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("revision not found", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.ResolveRevisionFunc.SetDefaultReturn("", &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: "notfound"})
+				return b
+			},
+		}
+		_, err := gs.CreateBranch(ctx, &v1.CreateBranchRequest{RepoName: "therepo", BranchName: "feature", StartPoint: []byte("notfound")})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+		require.Contains(t, err.Error(), "revision not found")
+	})
+	t.Run("branch exists", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.ResolveRevisionFunc.SetDefaultReturn(api.CommitID(sha), nil)
+				b.UpdateRefFunc.SetDefaultReturn(&gitdomain.RefUpdateConflictError{Repo: "therepo", Ref: "refs/heads/feature"})
+				return b
+			},
+		}
+		_, err := gs.CreateBranch(ctx, &v1.CreateBranchRequest{RepoName: "therepo", BranchName: "feature"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.FailedPrecondition)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RefUpdateConflictPayload{})
+	})
+	t.Run("e2e", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.ResolveRevisionFunc.SetDefaultReturn(api.CommitID(sha), nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		res, err := cli.CreateBranch(ctx, &v1.CreateBranchRequest{
+			RepoName:   "therepo",
+			BranchName: "feature",
+		})
+		require.NoError(t, err)
+		require.Equal(t, sha, res.GetCommitSha())
+		// An empty start point defaults to HEAD.
+		mockrequire.CalledOnceWith(t, b.ResolveRevisionFunc, mockassert.Values(mockassert.Skip, "HEAD"))
+		mockrequire.CalledOnceWith(t, b.UpdateRefFunc, mockassert.Values(mockassert.Skip, "refs/heads/feature", api.CommitID(sha), api.CommitID("")))
+	})
+}
+
+func TestGRPCServer_DeleteBranch(t *testing.T) {
+	ctx := context.Background()
+	sha := "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef"
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.DeleteBranch(ctx, &v1.DeleteBranchRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.DeleteBranch(ctx, &v1.DeleteBranchRequest{RepoName: "therepo"})
+		require.ErrorContains(t, err, "branch must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.DeleteBranch(ctx, &v1.DeleteBranchRequest{RepoName: "therepo", BranchName: "foo.lock"})
+		require.ErrorContains(t, err, "invalid branch name")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.DeleteBranch(ctx, &v1.DeleteBranchRequest{RepoName: "therepo", BranchName: "feature"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("refuses to delete default branch", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.SymbolicRefHeadFunc.SetDefaultReturn("refs/heads/main", nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+		_, err := gs.DeleteBranch(ctx, &v1.DeleteBranchRequest{RepoName: "therepo", BranchName: "main"})
+		require.ErrorContains(t, err, "cannot delete the default branch")
+		assertGRPCStatusCode(t, err, codes.FailedPrecondition)
+		mockassert.NotCalled(t, b.DeleteRefFunc)
+	})
+	t.Run("revision not found", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.SymbolicRefHeadFunc.SetDefaultReturn("refs/heads/main", nil)
+				b.ResolveRevisionFunc.SetDefaultReturn("", &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: "refs/heads/feature"})
+				return b
+			},
+		}
+		_, err := gs.DeleteBranch(ctx, &v1.DeleteBranchRequest{RepoName: "therepo", BranchName: "feature"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+		require.Contains(t, err.Error(), "revision not found")
+	})
+	t.Run("e2e", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.SymbolicRefHeadFunc.SetDefaultReturn("refs/heads/main", nil)
+		b.ResolveRevisionFunc.SetDefaultReturn(api.CommitID(sha), nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		_, err := cli.DeleteBranch(ctx, &v1.DeleteBranchRequest{
+			RepoName:   "therepo",
+			BranchName: "feature",
+		})
+		require.NoError(t, err)
+		mockrequire.CalledOnceWith(t, b.DeleteRefFunc, mockassert.Values(mockassert.Skip, "refs/heads/feature", api.CommitID(sha)))
+	})
+}
+
+func TestGRPCServer_CreateTag(t *testing.T) {
+	ctx := context.Background()
+	sha := "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef"
+	tagger := &v1.GitSignature{Name: []byte("Release Bot"), Email: []byte("release@sourcegraph.com")}
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.CreateTag(ctx, &v1.CreateTagRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CreateTag(ctx, &v1.CreateTagRequest{RepoName: "therepo"})
+		require.ErrorContains(t, err, "tag must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CreateTag(ctx, &v1.CreateTagRequest{RepoName: "therepo", TagName: "v1^"})
+		require.ErrorContains(t, err, "invalid tag name")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CreateTag(ctx, &v1.CreateTagRequest{RepoName: "therepo", TagName: "v1"})
+		require.ErrorContains(t, err, "target must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CreateTag(ctx, &v1.CreateTagRequest{RepoName: "therepo", TagName: "v1", Target: []byte("HEAD"), Sign: true, Tagger: tagger})
+		require.ErrorContains(t, err, "message must be specified for signed tags")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CreateTag(ctx, &v1.CreateTagRequest{RepoName: "therepo", TagName: "v1", Target: []byte("HEAD"), Message: []byte("Release")})
+		require.ErrorContains(t, err, "tagger must be specified for annotated tags")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.CreateTag(ctx, &v1.CreateTagRequest{RepoName: "therepo", TagName: "v1", Target: []byte("HEAD")})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		require.Contains(t, err.Error(), "repo not found")
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("revision not found", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.ResolveRevisionFunc.SetDefaultReturn("", &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: "notfound"})
+				return b
+			},
+		}
+		_, err := gs.CreateTag(ctx, &v1.CreateTagRequest{RepoName: "therepo", TagName: "v1", Target: []byte("notfound")})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+		require.Contains(t, err.Error(), "revision not found")
+	})
+	t.Run("tag exists", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.ResolveRevisionFunc.SetDefaultReturn(api.CommitID(sha), nil)
+				b.CreateTagFunc.SetDefaultReturn(&gitdomain.RefUpdateConflictError{Repo: "therepo", Ref: "refs/tags/v1"})
+				return b
+			},
+		}
+		_, err := gs.CreateTag(ctx, &v1.CreateTagRequest{RepoName: "therepo", TagName: "v1", Target: []byte("HEAD")})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.FailedPrecondition)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RefUpdateConflictPayload{})
+	})
+	t.Run("e2e", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		// Repo is cloned, proceed!
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.ResolveRevisionFunc.SetDefaultReturn(api.CommitID(sha), nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		cli := spawnServer(t, gs)
+		_, err := cli.CreateTag(ctx, &v1.CreateTagRequest{
+			RepoName: "therepo",
+			TagName:  "v1",
+			Target:   []byte("main"),
+			Message:  []byte("Release v1"),
+			Tagger:   tagger,
+			Sign:     true,
+		})
+		require.NoError(t, err)
+		mockrequire.CalledOnceWith(t, b.ResolveRevisionFunc, mockassert.Values(mockassert.Skip, "main"))
+		mockrequire.CalledOnceWith(t, b.CreateTagFunc, mockassert.Values(mockassert.Skip, "v1", api.CommitID(sha), git.CreateTagOpts{
+			Message: "Release v1",
+			Tagger:  &gitdomain.Signature{Name: "Release Bot", Email: "release@sourcegraph.com"},
+			Sign:    true,
+		}))
+	})
+}
+
+func TestGRPCServer_OptimizeRepo(t *testing.T) {
+	ctx := context.Background()
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.OptimizeRepo(ctx, &v1.OptimizeRepoRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.OptimizeRepo(ctx, &v1.OptimizeRepoRequest{RepoName: "therepo"})
+		require.ErrorContains(t, err, "at least one maintenance task must be requested")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.OptimizeRepo(ctx, &v1.OptimizeRepoRequest{RepoName: "therepo", Gc: true})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		mockassert.Called(t, fs.RepoClonedFunc)
+	})
+	t.Run("runs tasks in order", func(t *testing.T) {
+		dir := common.GitDir(t.TempDir())
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		fs.RepoDirFunc.SetDefaultReturn(dir)
+		b := git.NewMockGitBackend()
+		var locked []bool
+		b.RunMaintenanceTaskFunc.SetDefaultHook(func(_ context.Context, _ git.MaintenanceTask) error {
+			_, err := os.Stat(dir.Path(gcLockFile))
+			locked = append(locked, err == nil)
+			return nil
+		})
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		res, err := gs.OptimizeRepo(ctx, &v1.OptimizeRepoRequest{RepoName: "therepo", Gc: true, Repack: true, CommitGraph: true})
+		require.NoError(t, err)
+		require.Len(t, res.GetTasks(), 3)
+		for i, task := range []string{"gc", "repack", "commit-graph"} {
+			require.Equal(t, task, res.GetTasks()[i].GetTask())
+			require.NotNil(t, res.GetTasks()[i].GetDuration())
+		}
+		mockrequire.CalledN(t, b.RunMaintenanceTaskFunc, 3)
+		mockassert.CalledWith(t, b.RunMaintenanceTaskFunc, mockassert.Values(mockassert.Skip, git.MaintenanceTaskGC))
+		mockassert.CalledWith(t, b.RunMaintenanceTaskFunc, mockassert.Values(mockassert.Skip, git.MaintenanceTaskCommitGraph))
+		// git gc takes gc.pid itself, the other tasks run with the lock held.
+		require.Equal(t, []bool{false, true, true}, locked)
+		_, err = os.Stat(dir.Path(gcLockFile))
+		require.True(t, os.IsNotExist(err))
+	})
+	t.Run("maintenance already running", func(t *testing.T) {
+		dir := common.GitDir(t.TempDir())
+		require.NoError(t, os.WriteFile(dir.Path(gcLockFile), nil, 0644))
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		fs.RepoDirFunc.SetDefaultReturn(dir)
+		b := git.NewMockGitBackend()
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		_, err := gs.OptimizeRepo(ctx, &v1.OptimizeRepoRequest{RepoName: "therepo", Repack: true})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.Aborted)
+		mockassert.NotCalled(t, b.RunMaintenanceTaskFunc)
+	})
+	t.Run("backend errors are returned", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		fs.RepoDirFunc.SetDefaultReturn(common.GitDir(t.TempDir()))
+		b := git.NewMockGitBackend()
+		b.RunMaintenanceTaskFunc.SetDefaultReturn(errors.New("boom"))
+		svc := NewMockService()
+		gs := &grpcServer{
+			svc: svc,
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		_, err := gs.OptimizeRepo(ctx, &v1.OptimizeRepoRequest{RepoName: "therepo", Gc: true, Repack: true})
+		require.ErrorContains(t, err, "boom")
+		mockrequire.CalledOnce(t, b.RunMaintenanceTaskFunc)
+		mockassert.Called(t, svc.LogIfCorruptFunc)
+	})
+}
+
+func TestGRPCServer_CommitGraphStatus(t *testing.T) {
+	ctx := context.Background()
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.CommitGraphStatus(ctx, &v1.CommitGraphStatusRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.CommitGraphStatus(ctx, &v1.CommitGraphStatusRequest{RepoName: "therepo"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		mockassert.Called(t, fs.RepoClonedFunc)
+	})
+	t.Run("returns status", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.CommitGraphStatusFunc.SetDefaultReturn(gitdomain.CommitGraphStatus{Present: true, Layers: 2, Commits: 42, GenerationVersion: 2, ChangedPathFilters: true}, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		res, err := gs.CommitGraphStatus(ctx, &v1.CommitGraphStatusRequest{RepoName: "therepo"})
+		require.NoError(t, err)
+		require.True(t, res.GetPresent())
+		require.Equal(t, uint32(2), res.GetLayers())
+		require.Equal(t, uint32(42), res.GetCommits())
+		require.Equal(t, uint32(2), res.GetGenerationVersion())
+		require.True(t, res.GetChangedPathFilters())
+	})
+	t.Run("backend errors are returned", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.CommitGraphStatusFunc.SetDefaultReturn(gitdomain.CommitGraphStatus{}, errors.New("invalid signature"))
+		svc := NewMockService()
+		gs := &grpcServer{
+			svc: svc,
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		_, err := gs.CommitGraphStatus(ctx, &v1.CommitGraphStatusRequest{RepoName: "therepo"})
+		require.ErrorContains(t, err, "invalid signature")
+		mockassert.Called(t, svc.LogIfCorruptFunc)
+	})
+}
+
+func TestGRPCServer_CloneState(t *testing.T) {
+	ctx := context.Background()
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.CloneState(ctx, &v1.CloneStateRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.CloneState(ctx, &v1.CloneStateRequest{RepoName: "therepo"})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		mockassert.Called(t, fs.RepoClonedFunc)
+	})
+	t.Run("returns state", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.CloneStateFunc.SetDefaultReturn(gitdomain.CloneState{
+			Shallow:        true,
+			ShallowCommits: []api.CommitID{"deadbeef"},
+			Depth:          10,
+			Partial:        true,
+			Filter:         "blob:none",
+		}, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		res, err := gs.CloneState(ctx, &v1.CloneStateRequest{RepoName: "therepo"})
+		require.NoError(t, err)
+		require.True(t, res.GetShallow())
+		require.Equal(t, []string{"deadbeef"}, res.GetShallowCommits())
+		require.Equal(t, uint32(10), res.GetDepth())
+		require.True(t, res.GetPartial())
+		require.Equal(t, "blob:none", res.GetFilter())
+	})
+	t.Run("backend errors are returned", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.CloneStateFunc.SetDefaultReturn(gitdomain.CloneState{}, errors.New("invalid shallow file entry"))
+		svc := NewMockService()
+		gs := &grpcServer{
+			svc: svc,
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+
+		_, err := gs.CloneState(ctx, &v1.CloneStateRequest{RepoName: "therepo"})
+		require.ErrorContains(t, err, "invalid shallow file entry")
+		mockassert.Called(t, svc.LogIfCorruptFunc)
+	})
+}
+
+func TestGRPCServer_ListRepos(t *testing.T) {
+	ctx := context.Background()
+	listRepos := func(gs *grpcServer) ([]*proto.HostedRepo, error) {
+		mockSS := gitserver.NewMockGitserverService_ListReposServer()
+		mockSS.ContextFunc.SetDefaultReturn(ctx)
+		var repos []*proto.HostedRepo
+		mockSS.SendFunc.SetDefaultHook(func(res *v1.ListReposResponse) error {
+			repos = append(repos, res.GetRepos()...)
+			return nil
+		})
+		err := gs.ListRepos(&v1.ListReposRequest{}, mockSS)
+		return repos, err
+	}
+
+	newRepo := func(t *testing.T) common.GitDir {
+		dir := common.GitDir(t.TempDir())
+		require.NoError(t, os.WriteFile(dir.Path("HEAD"), []byte("ref: refs/heads/main\n"), 0644))
+		return dir
+	}
+	fetched := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	dirA, dirB := newRepo(t), newRepo(t)
+	require.NoError(t, os.Chtimes(dirA.Path("HEAD"), fetched, fetched))
+	require.NoError(t, os.WriteFile(dirB.Path("FETCH_HEAD"), nil, 0644))
+	require.NoError(t, os.Chtimes(dirB.Path("FETCH_HEAD"), fetched.Add(time.Hour), fetched.Add(time.Hour)))
+
+	t.Run("lists repos", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.ForEachRepoFunc.SetDefaultHook(func(visit func(api.RepoName, common.GitDir) bool) error {
+			for name, dir := range map[api.RepoName]common.GitDir{"a": dirA, "b": dirB} {
+				if visit(name, dir) {
+					break
+				}
+			}
+			return nil
+		})
+		fs.DirSizeFunc.SetDefaultHook(func(dir string) (int64, error) {
+			if dir == dirA.Path() {
+				return 100, nil
+			}
+			return 200, nil
+		})
+		gs := &grpcServer{logger: logtest.Scoped(t), fs: fs}
+
+		repos, err := listRepos(gs)
+		require.NoError(t, err)
+		sort.Slice(repos, func(i, j int) bool { return repos[i].GetName() < repos[j].GetName() })
+		require.Len(t, repos, 2)
+		require.Equal(t, "a", repos[0].GetName())
+		require.Equal(t, int64(100), repos[0].GetSizeBytes())
+		require.True(t, fetched.Equal(repos[0].GetLastFetched().AsTime()))
+		require.Equal(t, "b", repos[1].GetName())
+		require.Equal(t, int64(200), repos[1].GetSizeBytes())
+		require.True(t, fetched.Add(time.Hour).Equal(repos[1].GetLastFetched().AsTime()))
+	})
+
+	t.Run("skips repos that can't be inspected", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.ForEachRepoFunc.SetDefaultHook(func(visit func(api.RepoName, common.GitDir) bool) error {
+			visit("gone", common.GitDir(filepath.Join(t.TempDir(), "gone", ".git")))
+			visit("a", dirA)
+			return nil
+		})
+		fs.DirSizeFunc.SetDefaultReturn(100, nil)
+		gs := &grpcServer{logger: logtest.Scoped(t), fs: fs}
+
+		repos, err := listRepos(gs)
+		require.NoError(t, err)
+		require.Len(t, repos, 1)
+		require.Equal(t, "a", repos[0].GetName())
+	})
+}
+
+func TestGRPCServer_ReadBlob(t *testing.T) {
+	const oid = "acbe86c7c89586e0912a0a851bacf309c595c308"
+	readBlob := func(ctx context.Context, gs *grpcServer, req *v1.ReadBlobRequest) (string, error) {
+		mockSS := gitserver.NewMockGitserverService_ReadBlobServer()
+		mockSS.ContextFunc.SetDefaultReturn(ctx)
+		var data []byte
+		mockSS.SendFunc.SetDefaultHook(func(res *v1.ReadBlobResponse) error {
+			data = append(data, res.GetData()...)
+			return nil
+		})
+		err := gs.ReadBlob(req, mockSS)
+		return string(data), err
+	}
+	userCtx := actor.WithActor(context.Background(), actor.FromUser(1))
+
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := readBlob(userCtx, gs, &v1.ReadBlobRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = readBlob(userCtx, gs, &v1.ReadBlobRequest{RepoName: "therepo", Oid: "HEAD"})
+		require.ErrorContains(t, err, "oid must be a full object ID")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+
+	fs := gitserverfs.NewMockFS()
+	fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+	srp := authz.NewMockSubRepoPermissionChecker()
+	b := git.NewMockGitBackend()
+	b.ReadBlobFunc.SetDefaultHook(func(_ context.Context, id api.CommitID) (io.ReadCloser, error) {
+		if id != oid {
+			return nil, &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: string(id)}
+		}
+		return io.NopCloser(strings.NewReader("abcd\n")), nil
+	})
+	gs := &grpcServer{
+		subRepoChecker: srp,
+		svc:            NewMockService(),
+		fs:             fs,
+		getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+			return b
+		},
+	}
+
+	t.Run("reads blob", func(t *testing.T) {
+		data, err := readBlob(userCtx, gs, &v1.ReadBlobRequest{RepoName: "therepo", Oid: oid})
+		require.NoError(t, err)
+		require.Equal(t, "abcd\n", data)
+	})
+
+	t.Run("blob not found", func(t *testing.T) {
+		_, err := readBlob(userCtx, gs, &v1.ReadBlobRequest{RepoName: "therepo", Oid: "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef"})
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+	})
+
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+		t.Cleanup(func() { srp.EnabledForRepoFunc.SetDefaultReturn(false, nil) })
+
+		_, err := readBlob(userCtx, gs, &v1.ReadBlobRequest{RepoName: "therepo", Oid: oid})
+		assertGRPCStatusCode(t, err, codes.Unimplemented)
+
+		// Internal actors aren't subject to sub-repo permissions.
+		data, err := readBlob(actor.WithInternalActor(context.Background()), gs, &v1.ReadBlobRequest{RepoName: "therepo", Oid: oid})
+		require.NoError(t, err)
+		require.Equal(t, "abcd\n", data)
+	})
+}
+
+func TestGRPCServer_GetTree(t *testing.T) {
+	getTree := func(gs *grpcServer, req *v1.GetTreeRequest) ([]string, error) {
+		mockSS := gitserver.NewMockGitserverService_GetTreeServer()
+		mockSS.ContextFunc.SetDefaultReturn(actor.WithActor(context.Background(), actor.FromUser(1)))
+		var names []string
+		mockSS.SendFunc.SetDefaultHook(func(res *v1.GetTreeResponse) error {
+			for _, e := range res.GetEntries() {
+				names = append(names, string(e.GetName()))
+			}
+			return nil
+		})
+		err := gs.GetTree(req, mockSS)
+		return names, err
+	}
+
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := getTree(gs, &v1.GetTreeRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = getTree(gs, &v1.GetTreeRequest{RepoName: "therepo"})
+		require.ErrorContains(t, err, "treeish must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+
+	fs := gitserverfs.NewMockFS()
+	fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+	srp := authz.NewMockSubRepoPermissionChecker()
+	b := git.NewMockGitBackend()
+	b.GetTreeFunc.SetDefaultHook(func(_ context.Context, treeish, path string) ([]gitdomain.TreeEntry, error) {
+		if treeish != "HEAD" {
+			return nil, &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: treeish}
+		}
+		if path != "dir" {
+			return nil, &os.PathError{Op: "ls-tree", Path: path, Err: os.ErrNotExist}
+		}
+		return []gitdomain.TreeEntry{
+			{Name: "public", Mode: 0o100644, Type: gitdomain.ObjectTypeBlob},
+			{Name: "secret", Mode: 0o040000, Type: gitdomain.ObjectTypeTree},
+		}, nil
+	})
+	gs := &grpcServer{
+		subRepoChecker: srp,
+		svc:            NewMockService(),
+		fs:             fs,
+		getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+			return b
+		},
+	}
+
+	t.Run("lists entries", func(t *testing.T) {
+		names, err := getTree(gs, &v1.GetTreeRequest{RepoName: "therepo", Treeish: []byte("HEAD"), Path: []byte("dir")})
+		require.NoError(t, err)
+		require.Equal(t, []string{"public", "secret"}, names)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := getTree(gs, &v1.GetTreeRequest{RepoName: "therepo", Treeish: []byte("main"), Path: []byte("dir")})
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+
+		_, err = getTree(gs, &v1.GetTreeRequest{RepoName: "therepo", Treeish: []byte("HEAD"), Path: []byte("other")})
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.FileNotFoundPayload{})
+	})
+
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		srp.EnabledFunc.SetDefaultReturn(true)
+		srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+		srp.FilePermissionsFuncFunc.SetDefaultReturn(func(path string) (authz.Perms, error) {
+			if path == "dir/secret/" {
+				return authz.None, nil
+			}
+			return authz.Read, nil
+		}, nil)
+
+		names, err := getTree(gs, &v1.GetTreeRequest{RepoName: "therepo", Treeish: []byte("HEAD"), Path: []byte("dir")})
+		require.NoError(t, err)
+		require.Equal(t, []string{"public"}, names)
+	})
+}
+
+func TestGRPCServer_SparseManifest(t *testing.T) {
+	sparseManifest := func(gs *grpcServer, req *v1.SparseManifestRequest) ([]string, error) {
+		mockSS := gitserver.NewMockGitserverService_SparseManifestServer()
+		mockSS.ContextFunc.SetDefaultReturn(actor.WithActor(context.Background(), actor.FromUser(1)))
+		var names []string
+		mockSS.SendFunc.SetDefaultHook(func(res *v1.SparseManifestResponse) error {
+			for _, e := range res.GetEntries() {
+				names = append(names, string(e.GetName()))
+			}
+			return nil
+		})
+		err := gs.SparseManifest(req, mockSS)
+		return names, err
+	}
+
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := sparseManifest(gs, &v1.SparseManifestRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = sparseManifest(gs, &v1.SparseManifestRequest{RepoName: "therepo"})
+		require.ErrorContains(t, err, "commit_sha must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+
+	fs := gitserverfs.NewMockFS()
+	fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+	srp := authz.NewMockSubRepoPermissionChecker()
+	b := git.NewMockGitBackend()
+	b.SparseManifestFunc.SetDefaultHook(func(_ context.Context, commit api.CommitID, paths []string) ([]gitdomain.TreeEntry, error) {
+		if commit != "deadbeef" {
+			return nil, &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: string(commit)}
+		}
+		if !slices.Equal(paths, []string{"dir", "README.md"}) {
+			return nil, errors.Newf("unexpected paths %v", paths)
+		}
+		return []gitdomain.TreeEntry{
+			{Name: "README.md", Mode: 0o100644, Type: gitdomain.ObjectTypeBlob},
+			{Name: "dir/public.txt", Mode: 0o100644, Type: gitdomain.ObjectTypeBlob},
+			{Name: "dir/secret/key.txt", Mode: 0o100644, Type: gitdomain.ObjectTypeBlob},
+		}, nil
+	})
+	gs := &grpcServer{
+		subRepoChecker: srp,
+		svc:            NewMockService(),
+		fs:             fs,
+		getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+			return b
+		},
+	}
+	req := &v1.SparseManifestRequest{RepoName: "therepo", CommitSha: "deadbeef", Paths: [][]byte{[]byte("dir"), []byte("README.md")}}
+
+	t.Run("lists files", func(t *testing.T) {
+		names, err := sparseManifest(gs, req)
+		require.NoError(t, err)
+		require.Equal(t, []string{"README.md", "dir/public.txt", "dir/secret/key.txt"}, names)
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := sparseManifest(gs, &v1.SparseManifestRequest{RepoName: "therepo", CommitSha: "cafebabe"})
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+	})
+
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		srp.EnabledFunc.SetDefaultReturn(true)
+		srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+		srp.FilePermissionsFuncFunc.SetDefaultReturn(func(path string) (authz.Perms, error) {
+			if strings.HasPrefix(path, "dir/secret/") {
+				return authz.None, nil
+			}
+			return authz.Read, nil
+		}, nil)
+
+		names, err := sparseManifest(gs, req)
+		require.NoError(t, err)
+		require.Equal(t, []string{"README.md", "dir/public.txt"}, names)
+	})
+}
+
+func TestGRPCServer_LastCommitsForTree(t *testing.T) {
+	lastCommitsForTree := func(gs *grpcServer, req *v1.LastCommitsForTreeRequest) (map[string]string, error) {
+		mockSS := gitserver.NewMockGitserverService_LastCommitsForTreeServer()
+		mockSS.ContextFunc.SetDefaultReturn(actor.WithActor(context.Background(), actor.FromUser(1)))
+		commits := map[string]string{}
+		mockSS.SendFunc.SetDefaultHook(func(res *v1.LastCommitsForTreeResponse) error {
+			for _, e := range res.GetEntries() {
+				commits[string(e.GetEntry().GetName())] = e.GetCommit().GetOid()
+			}
+			return nil
+		})
+		err := gs.LastCommitsForTree(req, mockSS)
+		return commits, err
+	}
+
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := lastCommitsForTree(gs, &v1.LastCommitsForTreeRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = lastCommitsForTree(gs, &v1.LastCommitsForTreeRequest{RepoName: "therepo"})
+		require.ErrorContains(t, err, "commit_sha must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+
+	fs := gitserverfs.NewMockFS()
+	fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+	srp := authz.NewMockSubRepoPermissionChecker()
+	b := git.NewMockGitBackend()
+	b.LastCommitsForTreeFunc.SetDefaultHook(func(_ context.Context, commit api.CommitID, path string) ([]gitdomain.TreeEntryCommit, error) {
+		if commit != "deadbeef" {
+			return nil, &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: string(commit)}
+		}
+		if path != "dir" {
+			return nil, &os.PathError{Op: "ls-tree", Path: path, Err: os.ErrNotExist}
+		}
+		return []gitdomain.TreeEntryCommit{
+			{TreeEntry: gitdomain.TreeEntry{Name: "public.txt", Type: gitdomain.ObjectTypeBlob}, Commit: &gitdomain.Commit{ID: "c1", Committer: &gitdomain.Signature{}}},
+			{TreeEntry: gitdomain.TreeEntry{Name: "secret", Type: gitdomain.ObjectTypeTree}, Commit: &gitdomain.Commit{ID: "c2", Committer: &gitdomain.Signature{}}},
+			{TreeEntry: gitdomain.TreeEntry{Name: "shallow.txt", Type: gitdomain.ObjectTypeBlob}},
+		}, nil
+	})
+	gs := &grpcServer{
+		subRepoChecker: srp,
+		svc:            NewMockService(),
+		fs:             fs,
+		getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+			return b
+		},
+	}
+	req := &v1.LastCommitsForTreeRequest{RepoName: "therepo", CommitSha: "deadbeef", Path: []byte("dir")}
+
+	t.Run("lists entries", func(t *testing.T) {
+		commits, err := lastCommitsForTree(gs, req)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"public.txt": "c1", "secret": "c2", "shallow.txt": ""}, commits)
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := lastCommitsForTree(gs, &v1.LastCommitsForTreeRequest{RepoName: "therepo", CommitSha: "cafebabe"})
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+	})
+
+	t.Run("path not found", func(t *testing.T) {
+		_, err := lastCommitsForTree(gs, &v1.LastCommitsForTreeRequest{RepoName: "therepo", CommitSha: "deadbeef", Path: []byte("notfound")})
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.FileNotFoundPayload{})
+	})
+
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		srp.EnabledFunc.SetDefaultReturn(true)
+		srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+		srp.FilePermissionsFuncFunc.SetDefaultReturn(func(path string) (authz.Perms, error) {
+			if strings.HasPrefix(path, "dir/secret/") {
+				return authz.None, nil
+			}
+			return authz.Read, nil
+		}, nil)
+
+		commits, err := lastCommitsForTree(gs, req)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"public.txt": "c1", "shallow.txt": ""}, commits)
+	})
+}
+
+func TestGRPCServer_CommitActivity(t *testing.T) {
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.CommitActivity(ctx, &v1.CommitActivityRequest{RepoName: "", Interval: v1.CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_DAY})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CommitActivity(ctx, &v1.CommitActivityRequest{RepoName: "therepo"})
+		require.ErrorContains(t, err, "invalid commit activity interval")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CommitActivity(ctx, &v1.CommitActivityRequest{
+			RepoName: "therepo",
+			Interval: v1.CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_DAY,
+			After:    timestamppb.New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+			Before:   timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		})
+		require.ErrorContains(t, err, "before must not be before after")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		gs := &grpcServer{svc: NewMockService(), fs: fs, locker: locker}
+		_, err := gs.CommitActivity(ctx, &v1.CommitActivityRequest{RepoName: "therepo", Interval: v1.CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_DAY})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		mockassert.Called(t, fs.RepoClonedFunc)
+		mockassert.Called(t, locker.StatusFunc)
+	})
+	t.Run("revision not found", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.ResolveRevisionFunc.SetDefaultReturn("", &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: "nope"})
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+		_, err := gs.CommitActivity(ctx, &v1.CommitActivityRequest{RepoName: "therepo", Revspec: []byte("nope"), Interval: v1.CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_DAY})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+		mockassert.NotCalled(t, b.CommitActivityFunc)
+	})
+	t.Run("subrepo permissions", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		srp.EnabledFunc.SetDefaultReturn(true)
+		srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		gs := &grpcServer{
+			svc:            NewMockService(),
+			fs:             fs,
+			subRepoChecker: srp,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+		_, err := gs.CommitActivity(ctx, &v1.CommitActivityRequest{RepoName: "therepo", Interval: v1.CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_DAY})
+		require.Error(t, err)
+		assertGRPCStatusCode(t, err, codes.FailedPrecondition)
+		mockassert.NotCalled(t, b.CommitActivityFunc)
+	})
+	t.Run("e2e", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		b := git.NewMockGitBackend()
+		b.ResolveRevisionFunc.SetDefaultReturn("deadbeef", nil)
+		start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		b.CommitActivityFunc.SetDefaultReturn([]gitdomain.CommitActivityBucket{
+			{Start: start, Count: 3},
+			{Start: start.AddDate(0, 0, 7), Count: 0},
+		}, nil)
+		gs := &grpcServer{
+			svc: NewMockService(),
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				return b
+			},
+		}
+		after := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+		res, err := gs.CommitActivity(ctx, &v1.CommitActivityRequest{
+			RepoName: "therepo",
+			Path:     []byte("dir"),
+			After:    timestamppb.New(after),
+			Interval: v1.CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_WEEK,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.GetBuckets(), 2)
+		require.Equal(t, start, res.GetBuckets()[0].GetStart().AsTime())
+		require.Equal(t, int32(3), res.GetBuckets()[0].GetCount())
+
+		mockrequire.CalledOnceWith(t, b.ResolveRevisionFunc, mockrequire.Values(mockrequire.Skip, "HEAD"))
+		mockrequire.CalledOnceWith(t, b.CommitActivityFunc, mockrequire.Values(mockrequire.Skip, api.CommitID("deadbeef"), git.CommitActivityOpts{
+			Path:     "dir",
+			After:    after,
+			Interval: gitdomain.CommitActivityIntervalWeek,
+		}))
+	})
+}
+
+func TestGRPCServer_GetTag(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.GetTag(ctx, &v1.GetTagRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.GetTag(ctx, &v1.GetTagRequest{RepoName: "therepo"})
+		require.ErrorContains(t, err, "tag must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.GetTag(ctx, &v1.GetTagRequest{RepoName: "therepo", TagName: "a..b"})
+		require.ErrorContains(t, err, "invalid tag name")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+
+	fs := gitserverfs.NewMockFS()
+	fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+	b := git.NewMockGitBackend()
+	tagger := &gitdomain.Signature{Name: "Foo", Email: "foo@sourcegraph.com", Date: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)}
+	b.GetTagFunc.SetDefaultHook(func(_ context.Context, name string) (*gitdomain.Tag, error) {
+		if name != "v1" {
+			return nil, &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: "refs/tags/" + name}
+		}
+		return &gitdomain.Tag{
+			Name:    "v1",
+			OID:     "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
+			Target:  gitdomain.GitObject{ID: gitdomain.OID{1}, Type: gitdomain.ObjectTypeCommit},
+			Tagger:  tagger,
+			Message: "Release v1\n",
+		}, nil
+	})
+	gs := &grpcServer{
+		svc: NewMockService(),
+		fs:  fs,
+		getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+			return b
+		},
+	}
+
+	t.Run("returns tag", func(t *testing.T) {
+		res, err := gs.GetTag(ctx, &v1.GetTagRequest{RepoName: "therepo", TagName: "v1"})
+		require.NoError(t, err)
+		tag := gitdomain.TagFromProto(res.GetTag())
+		require.Equal(t, "Release v1\n", tag.Message)
+		require.Equal(t, gitdomain.ObjectTypeCommit, tag.Target.Type)
+		require.True(t, tagger.Date.Equal(tag.Tagger.Date))
+	})
+
+	t.Run("tag not found", func(t *testing.T) {
+		_, err := gs.GetTag(ctx, &v1.GetTagRequest{RepoName: "therepo", TagName: "v2"})
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+	})
+}
+
+func TestGRPCServer_ListRemoteRefs(t *testing.T) {
+	ctx := context.Background()
+	listRemoteRefs := func(gs *grpcServer, req *v1.ListRemoteRefsRequest) ([]*proto.GitRef, error) {
+		mockSS := gitserver.NewMockGitserverService_ListRemoteRefsServer()
+		mockSS.ContextFunc.SetDefaultReturn(ctx)
+		var refs []*proto.GitRef
+		mockSS.SendFunc.SetDefaultHook(func(res *v1.ListRemoteRefsResponse) error {
+			refs = append(refs, res.GetRefs()...)
+			return nil
+		})
+		err := gs.ListRemoteRefs(req, mockSS)
+		return refs, err
+	}
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := listRemoteRefs(gs, &v1.ListRemoteRefsRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("repo without git remote", func(t *testing.T) {
+		svc := NewMockService()
+		svc.ListRemoteRefsFunc.SetDefaultReturn(nil, errNoGitRemote)
+		gs := &grpcServer{svc: svc}
+		_, err := listRemoteRefs(gs, &v1.ListRemoteRefsRequest{RepoName: "therepo"})
+		assertGRPCStatusCode(t, err, codes.Unimplemented)
+	})
+	t.Run("unreachable remote", func(t *testing.T) {
+		svc := NewMockService()
+		svc.ListRemoteRefsFunc.SetDefaultReturn(nil, &remoteUnavailableError{msg: "git ls-remote failed: exit status 128"})
+		gs := &grpcServer{svc: svc}
+		_, err := listRemoteRefs(gs, &v1.ListRemoteRefsRequest{RepoName: "therepo"})
+		assertGRPCStatusCode(t, err, codes.Unavailable)
+		require.ErrorContains(t, err, "exit status 128")
+	})
+	t.Run("sends refs", func(t *testing.T) {
+		svc := NewMockService()
+		svc.ListRemoteRefsFunc.SetDefaultReturn([]gitdomain.Ref{
+			{Name: "refs/heads/main", ShortName: "main", Type: gitdomain.RefTypeBranch, CommitID: "c1", RefOID: "c1", IsHead: true},
+			{Name: "refs/tags/v1", ShortName: "v1", Type: gitdomain.RefTypeTag, CommitID: "c1", RefOID: "t1"},
+		}, nil)
+		gs := &grpcServer{svc: svc}
+		refs, err := listRemoteRefs(gs, &v1.ListRemoteRefsRequest{RepoName: "therepo"})
+		require.NoError(t, err)
+		require.Len(t, refs, 2)
+		require.Equal(t, "refs/heads/main", refs[0].GetRefName())
+		require.True(t, refs[0].GetIsHead())
+		require.Equal(t, "t1", refs[1].GetRefOid())
+		mockrequire.CalledOnceWith(t, svc.ListRemoteRefsFunc, mockrequire.Values(mockrequire.Skip, api.RepoName("therepo")))
+	})
+}
+
+func TestGRPCServer_FetchCommitFromRepo(t *testing.T) {
+	ctx := context.Background()
+	const commit = "1111111111111111111111111111111111111111"
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.FetchCommitFromRepo(ctx, &v1.FetchCommitFromRepoRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.FetchCommitFromRepo(ctx, &v1.FetchCommitFromRepoRequest{RepoName: "therepo"})
+		require.ErrorContains(t, err, "source repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.FetchCommitFromRepo(ctx, &v1.FetchCommitFromRepoRequest{RepoName: "therepo", SourceRepoName: "upstream", Commit: "main"})
+		require.ErrorContains(t, err, "commit must be a full commit SHA")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("source repo with sub-repo permissions", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		gs := &grpcServer{subRepoChecker: srp, svc: svc, fs: fs}
+		_, err := gs.FetchCommitFromRepo(ctx, &v1.FetchCommitFromRepoRequest{RepoName: "therepo", SourceRepoName: "upstream", Commit: commit})
+		assertGRPCStatusCode(t, err, codes.Unimplemented)
+		mockassert.NotCalled(t, svc.FetchCommitFromRepoFunc)
+	})
+	t.Run("commit not found", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		svc.FetchCommitFromRepoFunc.SetDefaultReturn(false, &gitdomain.RevisionNotFoundError{Repo: "upstream", Spec: commit})
+		gs := &grpcServer{subRepoChecker: srp, svc: svc, fs: fs}
+		_, err := gs.FetchCommitFromRepo(ctx, &v1.FetchCommitFromRepoRequest{RepoName: "therepo", SourceRepoName: "upstream", Commit: commit})
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RevisionNotFoundPayload{})
+	})
+	t.Run("fetches commit", func(t *testing.T) {
+		srp := authz.NewMockSubRepoPermissionChecker()
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		svc.FetchCommitFromRepoFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{subRepoChecker: srp, svc: svc, fs: fs}
+		res, err := gs.FetchCommitFromRepo(ctx, &v1.FetchCommitFromRepoRequest{RepoName: "therepo", SourceRepoName: "upstream", Commit: commit})
+		require.NoError(t, err)
+		require.True(t, res.GetFetched())
+		mockrequire.CalledOnceWith(t, svc.FetchCommitFromRepoFunc, mockrequire.Values(mockrequire.Skip, api.RepoName("therepo"), api.RepoName("upstream"), api.CommitID(commit)))
+	})
+}
+
+func TestGRPCServer_FetchRefspec(t *testing.T) {
+	ctx := context.Background()
+	const refspec = "+refs/pull/*/head:refs/pull/*/head"
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.FetchRefspec(ctx, &v1.FetchRefspecRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.FetchRefspec(ctx, &v1.FetchRefspecRequest{RepoName: "therepo", Refspec: "refs/pull/*/head:refs/heads/pr/*"})
+		require.ErrorContains(t, err, "cannot fetch into refs/heads/ or refs/tags/")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		svc := NewMockService()
+		gs := &grpcServer{svc: svc, fs: fs, locker: locker}
+		_, err := gs.FetchRefspec(ctx, &v1.FetchRefspecRequest{RepoName: "therepo", Refspec: refspec})
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		mockassert.NotCalled(t, svc.FetchRefspecFunc)
+	})
+	t.Run("error mapping", func(t *testing.T) {
+		for err, code := range map[error]codes.Code{
+			errNoGitRemote: codes.Unimplemented,
+			&remoteUnavailableError{msg: "git fetch failed"}:                            codes.Unavailable,
+			&gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: "refs/pull/1/head"}: codes.NotFound,
+			&gitdomain.RefUpdateConflictError{Repo: "therepo", Ref: "refs/pull/1/head"}: codes.FailedPrecondition,
+		} {
+			fs := gitserverfs.NewMockFS()
+			fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+			svc := NewMockService()
+			svc.FetchRefspecFunc.SetDefaultReturn(err)
+			gs := &grpcServer{svc: svc, fs: fs}
+			_, err := gs.FetchRefspec(ctx, &v1.FetchRefspecRequest{RepoName: "therepo", Refspec: refspec})
+			assertGRPCStatusCode(t, err, code)
+		}
+	})
+	t.Run("fetches refspec", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		gs := &grpcServer{svc: svc, fs: fs}
+		_, err := gs.FetchRefspec(ctx, &v1.FetchRefspecRequest{RepoName: "therepo", Refspec: refspec})
+		require.NoError(t, err)
+		mockrequire.CalledOnceWith(t, svc.FetchRefspecFunc, mockrequire.Values(mockrequire.Skip, api.RepoName("therepo"), refspec))
+	})
+}
+
+func TestGRPCServer_ApplyBundle(t *testing.T) {
+	ctx := context.Background()
+	send := func(t *testing.T, cli proto.GitserverServiceClient, msgs ...*v1.ApplyBundleRequest) error {
+		t.Helper()
+		cc, err := cli.ApplyBundle(ctx)
+		require.NoError(t, err)
+		for _, m := range msgs {
+			// The server can close the stream before all messages are sent,
+			// in which case CloseAndRecv returns the error.
+			if err := cc.Send(m); err != nil {
+				require.Equal(t, io.EOF, err)
+				break
+			}
+		}
+		_, err = cc.CloseAndRecv()
+		return err
+	}
+	metadata := func(repo string) *v1.ApplyBundleRequest {
+		return &v1.ApplyBundleRequest{Payload: &v1.ApplyBundleRequest_Metadata_{Metadata: &v1.ApplyBundleRequest_Metadata{RepoName: repo}}}
+	}
+	data := func(d string) *v1.ApplyBundleRequest {
+		return &v1.ApplyBundleRequest{Payload: &v1.ApplyBundleRequest_Data{Data: []byte(d)}}
+	}
+	t.Run("argument validation", func(t *testing.T) {
+		cli := spawnServer(t, &grpcServer{})
+		err := send(t, cli, data("bundle"))
+		require.ErrorContains(t, err, "must send metadata event first")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+
+		err = send(t, cli, metadata(""))
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("checks for uncloned repo", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(false, nil)
+		locker := NewMockRepositoryLocker()
+		locker.StatusFunc.SetDefaultReturn("cloning", true)
+		svc := NewMockService()
+		cli := spawnServer(t, &grpcServer{svc: svc, fs: fs, locker: locker})
+		err := send(t, cli, metadata("therepo"), data("bundle"))
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RepoNotFoundPayload{})
+		mockassert.NotCalled(t, svc.ApplyBundleFunc)
+	})
+	t.Run("applies bundle", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		var received []byte
+		svc.ApplyBundleFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, r io.Reader) (err error) {
+			received, err = io.ReadAll(r)
+			return err
+		})
+		cli := spawnServer(t, &grpcServer{svc: svc, fs: fs})
+		err := send(t, cli, metadata("therepo"), data("# v2 git bundle\n"), data("PACK"))
+		require.NoError(t, err)
+		require.Equal(t, "# v2 git bundle\nPACK", string(received))
+		mockrequire.CalledOnceWith(t, svc.ApplyBundleFunc, mockrequire.Values(mockrequire.Skip, api.RepoName("therepo")))
+	})
+	t.Run("ref update conflict", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		svc.ApplyBundleFunc.SetDefaultReturn(&gitdomain.RefUpdateConflictError{Repo: "therepo", Ref: "refs/heads/main"})
+		cli := spawnServer(t, &grpcServer{svc: svc, fs: fs})
+		err := send(t, cli, metadata("therepo"), data("bundle"))
+		assertGRPCStatusCode(t, err, codes.FailedPrecondition)
+		assertHasGRPCErrorDetailOfType(t, err, &proto.RefUpdateConflictPayload{})
+	})
+	t.Run("invalid bundle", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		svc.ApplyBundleFunc.SetDefaultReturn(&invalidBundleError{reason: "Repository lacks these prerequisite commits"})
+		cli := spawnServer(t, &grpcServer{svc: svc, fs: fs})
+		err := send(t, cli, metadata("therepo"), data("bundle"))
+		require.ErrorContains(t, err, "prerequisite")
+		assertGRPCStatusCode(t, err, codes.FailedPrecondition)
+	})
+}
+
+func TestGRPCServer_ExecInWorktree(t *testing.T) {
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	const commit = "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef"
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.ExecInWorktree(ctx, &v1.ExecInWorktreeRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.ExecInWorktree(ctx, &v1.ExecInWorktreeRequest{RepoName: "therepo", CommitSha: "HEAD"})
+		require.ErrorContains(t, err, "commit_sha must be a full commit ID")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("error mapping", func(t *testing.T) {
+		for err, code := range map[error]codes.Code{
+			gitcli.ErrBadGitCommand: codes.InvalidArgument,
+			&gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: commit}: codes.NotFound,
+			context.DeadlineExceeded: codes.DeadlineExceeded,
+		} {
+			fs := gitserverfs.NewMockFS()
+			fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+			svc := NewMockService()
+			svc.ExecInWorktreeFunc.SetDefaultReturn(gitdomain.WorktreeExecResult{}, err)
+			gs := &grpcServer{svc: svc, fs: fs, subRepoChecker: authz.NewMockSubRepoPermissionChecker()}
+			_, err := gs.ExecInWorktree(ctx, &v1.ExecInWorktreeRequest{RepoName: "therepo", CommitSha: commit, Args: [][]byte{[]byte("status")}})
+			assertGRPCStatusCode(t, err, code)
+		}
+	})
+	t.Run("runs command", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		svc.ExecInWorktreeFunc.SetDefaultReturn(gitdomain.WorktreeExecResult{ExitStatus: 1, Stderr: []byte("error: patch failed")}, nil)
+		gs := &grpcServer{svc: svc, fs: fs, subRepoChecker: authz.NewMockSubRepoPermissionChecker()}
+		res, err := gs.ExecInWorktree(ctx, &v1.ExecInWorktreeRequest{
+			RepoName:  "therepo",
+			CommitSha: commit,
+			Args:      [][]byte{[]byte("apply"), []byte("--check")},
+			Stdin:     []byte("patch"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, int32(1), res.GetExitStatus())
+		require.Equal(t, "error: patch failed", string(res.GetStderr()))
+		mockrequire.CalledOnceWith(t, svc.ExecInWorktreeFunc, mockrequire.Values(mockrequire.Skip, api.RepoName("therepo"), api.CommitID(commit), []string{"apply", "--check"}, []byte("patch")))
+	})
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		srp := authz.NewMockSubRepoPermissionChecker()
+		srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{svc: svc, fs: fs, subRepoChecker: srp}
+		req := &v1.ExecInWorktreeRequest{RepoName: "therepo", CommitSha: commit, Args: [][]byte{[]byte("status")}}
+
+		_, err := gs.ExecInWorktree(ctx, req)
+		assertGRPCStatusCode(t, err, codes.Unimplemented)
+		mockassert.NotCalled(t, svc.ExecInWorktreeFunc)
+
+		// Internal actors aren't subject to sub-repo permissions.
+		_, err = gs.ExecInWorktree(actor.WithInternalActor(context.Background()), req)
+		require.NoError(t, err)
+	})
+}
+
+func TestGRPCServer_RebasePreview(t *testing.T) {
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	const base, head, onto = "1111111111111111111111111111111111111111", "2222222222222222222222222222222222222222", "3333333333333333333333333333333333333333"
+	req := &v1.RebasePreviewRequest{RepoName: "therepo", BaseCommitSha: base, HeadCommitSha: head, OntoCommitSha: onto}
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.RebasePreview(ctx, &v1.RebasePreviewRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.RebasePreview(ctx, &v1.RebasePreviewRequest{RepoName: "therepo", BaseCommitSha: base, HeadCommitSha: "HEAD", OntoCommitSha: onto})
+		require.ErrorContains(t, err, "head_commit_sha must be a full commit ID")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("error mapping", func(t *testing.T) {
+		for err, code := range map[error]codes.Code{
+			&gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: head}: codes.NotFound,
+			context.DeadlineExceeded: codes.DeadlineExceeded,
+		} {
+			fs := gitserverfs.NewMockFS()
+			fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+			svc := NewMockService()
+			svc.RebasePreviewFunc.SetDefaultReturn(gitdomain.RebasePreview{}, err)
+			gs := &grpcServer{svc: svc, fs: fs, subRepoChecker: authz.NewMockSubRepoPermissionChecker()}
+			_, err := gs.RebasePreview(ctx, req)
+			assertGRPCStatusCode(t, err, code)
+		}
+	})
+	t.Run("returns preview", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		svc.RebasePreviewFunc.SetDefaultReturn(gitdomain.RebasePreview{
+			Commits:           []api.CommitID{"4444444444444444444444444444444444444444"},
+			ConflictingCommit: head,
+			ConflictingPaths:  []string{"a.txt"},
+		}, nil)
+		gs := &grpcServer{svc: svc, fs: fs, subRepoChecker: authz.NewMockSubRepoPermissionChecker()}
+		res, err := gs.RebasePreview(ctx, req)
+		require.NoError(t, err)
+		require.False(t, res.GetClean())
+		require.Equal(t, []string{"4444444444444444444444444444444444444444"}, res.GetCommitShas())
+		require.Equal(t, head, res.GetConflictingCommitSha())
+		require.Equal(t, [][]byte{[]byte("a.txt")}, res.GetConflictingPaths())
+		mockrequire.CalledOnceWith(t, svc.RebasePreviewFunc, mockrequire.Values(mockrequire.Skip, api.RepoName("therepo"), api.CommitID(base), api.CommitID(head), api.CommitID(onto)))
+	})
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		svc := NewMockService()
+		srp := authz.NewMockSubRepoPermissionChecker()
+		srp.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+		gs := &grpcServer{svc: svc, fs: fs, subRepoChecker: srp}
+
+		_, err := gs.RebasePreview(ctx, req)
+		assertGRPCStatusCode(t, err, codes.Unimplemented)
+		mockassert.NotCalled(t, svc.RebasePreviewFunc)
+
+		// Internal actors aren't subject to sub-repo permissions.
+		_, err = gs.RebasePreview(actor.WithInternalActor(context.Background()), req)
+		require.NoError(t, err)
+	})
+}
+
+func TestGRPCServer_RevertCommit(t *testing.T) {
+	ctx := context.Background()
+	const commit, onto = "1111111111111111111111111111111111111111", "2222222222222222222222222222222222222222"
+	author := &v1.GitSignature{Name: []byte("Jane Doe"), Email: []byte("jane@example.com")}
+	newServer := func(svc *MockService) *grpcServer {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		return &grpcServer{
+			svc: svc,
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, spec string) (api.CommitID, error) {
+					if spec == "main" {
+						return onto, nil
+					}
+					return "", &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: spec}
+				})
+				return b
+			},
+		}
+	}
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.RevertCommit(ctx, &v1.RevertCommitRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.RevertCommit(ctx, &v1.RevertCommitRequest{RepoName: "therepo", CommitSha: "HEAD", Author: author})
+		require.ErrorContains(t, err, "commit_sha must be a full commit ID")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.RevertCommit(ctx, &v1.RevertCommitRequest{RepoName: "therepo", CommitSha: commit})
+		require.ErrorContains(t, err, "author must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.RevertCommit(ctx, &v1.RevertCommitRequest{RepoName: "therepo", CommitSha: commit, Author: author, Mainline: -1})
+		require.ErrorContains(t, err, "mainline must not be negative")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("creates commit", func(t *testing.T) {
+		svc := NewMockService()
+		svc.RevertCommitFunc.SetDefaultReturn("3333333333333333333333333333333333333333", nil)
+		gs := newServer(svc)
+		res, err := gs.RevertCommit(ctx, &v1.RevertCommitRequest{RepoName: "therepo", CommitSha: commit, Onto: []byte("main"), Mainline: 1, Author: author})
+		require.NoError(t, err)
+		require.Equal(t, "3333333333333333333333333333333333333333", res.GetCommitSha())
+		want := gitdomain.Signature{Name: "Jane Doe", Email: "jane@example.com"}
+		mockrequire.CalledOnceWith(t, svc.RevertCommitFunc, mockrequire.Values(mockrequire.Skip, api.RepoName("therepo"), api.CommitID(commit), RevertCommitOpts{
+			Onto:      onto,
+			Mainline:  1,
+			Author:    want,
+			Committer: want,
+		}))
+
+		// onto defaults to the reverted commit.
+		_, err = gs.RevertCommit(ctx, &v1.RevertCommitRequest{RepoName: "therepo", CommitSha: commit, Author: author})
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID(commit), svc.RevertCommitFunc.History()[1].Arg3.Onto)
+	})
+	t.Run("error mapping", func(t *testing.T) {
+		for err, code := range map[error]codes.Code{
+			&gitdomain.MergeConflictError{Repo: "therepo", Commit: commit, Paths: []string{"a.txt"}}: codes.FailedPrecondition,
+			errNoChanges: codes.FailedPrecondition,
+			&gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: commit}: codes.NotFound,
+			context.DeadlineExceeded: codes.DeadlineExceeded,
+		} {
+			svc := NewMockService()
+			svc.RevertCommitFunc.SetDefaultReturn("", err)
+			_, err := newServer(svc).RevertCommit(ctx, &v1.RevertCommitRequest{RepoName: "therepo", CommitSha: commit, Author: author})
+			assertGRPCStatusCode(t, err, code)
+		}
+
+		// onto doesn't exist.
+		svc := NewMockService()
+		_, err := newServer(svc).RevertCommit(ctx, &v1.RevertCommitRequest{RepoName: "therepo", CommitSha: commit, Onto: []byte("nope"), Author: author})
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		mockassert.NotCalled(t, svc.RevertCommitFunc)
+	})
+}
+
+func TestGRPCServer_CherryPick(t *testing.T) {
+	ctx := context.Background()
+	const commit, onto = "1111111111111111111111111111111111111111", "2222222222222222222222222222222222222222"
+	committer := &v1.GitSignature{Name: []byte("Bot"), Email: []byte("bot@example.com")}
+	newServer := func(svc *MockService) *grpcServer {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		return &grpcServer{
+			svc: svc,
+			fs:  fs,
+			getBackendFunc: func(common.GitDir, api.RepoName) git.GitBackend {
+				b := git.NewMockGitBackend()
+				b.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, spec string) (api.CommitID, error) {
+					if spec == "release" {
+						return onto, nil
+					}
+					return "", &gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: spec}
+				})
+				return b
+			},
+		}
+	}
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.CherryPick(ctx, &v1.CherryPickRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CherryPick(ctx, &v1.CherryPickRequest{RepoName: "therepo", CommitSha: "HEAD", Onto: []byte("release"), Committer: committer})
+		require.ErrorContains(t, err, "commit_sha must be a full commit ID")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CherryPick(ctx, &v1.CherryPickRequest{RepoName: "therepo", CommitSha: commit, Committer: committer})
+		require.ErrorContains(t, err, "onto must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CherryPick(ctx, &v1.CherryPickRequest{RepoName: "therepo", CommitSha: commit, Onto: []byte("release"), Committer: committer, Mainline: -1})
+		require.ErrorContains(t, err, "mainline must not be negative")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.CherryPick(ctx, &v1.CherryPickRequest{RepoName: "therepo", CommitSha: commit, Onto: []byte("release")})
+		require.ErrorContains(t, err, "committer must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("creates commit", func(t *testing.T) {
+		svc := NewMockService()
+		svc.CherryPickFunc.SetDefaultReturn("3333333333333333333333333333333333333333", nil)
+		gs := newServer(svc)
+		res, err := gs.CherryPick(ctx, &v1.CherryPickRequest{
+			RepoName:     "therepo",
+			CommitSha:    commit,
+			Onto:         []byte("release"),
+			Mainline:     1,
+			Message:      []byte("Backport"),
+			RecordOrigin: true,
+			Committer:    committer,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "3333333333333333333333333333333333333333", res.GetCommitSha())
+		mockrequire.CalledOnceWith(t, svc.CherryPickFunc, mockrequire.Values(mockrequire.Skip, api.RepoName("therepo"), api.CommitID(commit), CherryPickOpts{
+			Onto:         onto,
+			Mainline:     1,
+			Message:      "Backport",
+			RecordOrigin: true,
+			Committer:    gitdomain.Signature{Name: "Bot", Email: "bot@example.com"},
+		}))
+	})
+	t.Run("error mapping", func(t *testing.T) {
+		for err, code := range map[error]codes.Code{
+			&gitdomain.MergeConflictError{Repo: "therepo", Commit: commit, Paths: []string{"a.txt"}}: codes.FailedPrecondition,
+			errNoChanges: codes.FailedPrecondition,
+			&gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: commit}: codes.NotFound,
+			context.DeadlineExceeded: codes.DeadlineExceeded,
+		} {
+			svc := NewMockService()
+			svc.CherryPickFunc.SetDefaultReturn("", err)
+			_, err := newServer(svc).CherryPick(ctx, &v1.CherryPickRequest{RepoName: "therepo", CommitSha: commit, Onto: []byte("release"), Committer: committer})
+			assertGRPCStatusCode(t, err, code)
+		}
+
+		// onto doesn't exist.
+		svc := NewMockService()
+		_, err := newServer(svc).CherryPick(ctx, &v1.CherryPickRequest{RepoName: "therepo", CommitSha: commit, Onto: []byte("nope"), Committer: committer})
+		assertGRPCStatusCode(t, err, codes.NotFound)
+		mockassert.NotCalled(t, svc.CherryPickFunc)
+	})
+}
+
+func TestGRPCServer_SquashCommits(t *testing.T) {
+	ctx := context.Background()
+	const base, head = "1111111111111111111111111111111111111111", "2222222222222222222222222222222222222222"
+	committer := &v1.GitSignature{Name: []byte("Bot"), Email: []byte("bot@example.com")}
+	newServer := func(svc *MockService) *grpcServer {
+		fs := gitserverfs.NewMockFS()
+		fs.RepoClonedFunc.SetDefaultReturn(true, nil)
+		return &grpcServer{svc: svc, fs: fs}
+	}
+	t.Run("argument validation", func(t *testing.T) {
+		gs := &grpcServer{}
+		_, err := gs.SquashCommits(ctx, &v1.SquashCommitsRequest{RepoName: ""})
+		require.ErrorContains(t, err, "repo must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.SquashCommits(ctx, &v1.SquashCommitsRequest{RepoName: "therepo", BaseCommitSha: "main", HeadCommitSha: head, Committer: committer})
+		require.ErrorContains(t, err, "base_commit_sha must be a full commit ID")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.SquashCommits(ctx, &v1.SquashCommitsRequest{RepoName: "therepo", BaseCommitSha: base, HeadCommitSha: "HEAD", Committer: committer})
+		require.ErrorContains(t, err, "head_commit_sha must be a full commit ID")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.SquashCommits(ctx, &v1.SquashCommitsRequest{RepoName: "therepo", BaseCommitSha: base, HeadCommitSha: head})
+		require.ErrorContains(t, err, "committer must be specified")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+		_, err = gs.SquashCommits(ctx, &v1.SquashCommitsRequest{RepoName: "therepo", BaseCommitSha: base, HeadCommitSha: head, Committer: committer, Author: &v1.GitSignature{Name: []byte("Jane Doe")}})
+		require.ErrorContains(t, err, "author must have a name and email")
+		assertGRPCStatusCode(t, err, codes.InvalidArgument)
+	})
+	t.Run("creates commit", func(t *testing.T) {
+		svc := NewMockService()
+		svc.SquashCommitsFunc.SetDefaultReturn("3333333333333333333333333333333333333333", nil)
+		gs := newServer(svc)
+		res, err := gs.SquashCommits(ctx, &v1.SquashCommitsRequest{
+			RepoName:      "therepo",
+			BaseCommitSha: base,
+			HeadCommitSha: head,
+			Message:       []byte("Squashed"),
+			Author:        &v1.GitSignature{Name: []byte("Jane Doe"), Email: []byte("jane@example.com")},
+			Committer:     committer,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "3333333333333333333333333333333333333333", res.GetCommitSha())
+		mockrequire.CalledOnceWith(t, svc.SquashCommitsFunc, mockrequire.Values(mockrequire.Skip, api.RepoName("therepo"), api.CommitID(base), api.CommitID(head), SquashCommitsOpts{
+			Message:   "Squashed",
+			Author:    &gitdomain.Signature{Name: "Jane Doe", Email: "jane@example.com"},
+			Committer: gitdomain.Signature{Name: "Bot", Email: "bot@example.com"},
+		}))
+
+		// The author defaults to the author of the oldest squashed commit.
+		_, err = gs.SquashCommits(ctx, &v1.SquashCommitsRequest{RepoName: "therepo", BaseCommitSha: base, HeadCommitSha: head, Committer: committer})
+		require.NoError(t, err)
+		require.Nil(t, svc.SquashCommitsFunc.History()[1].Arg4.Author)
+	})
+	t.Run("error mapping", func(t *testing.T) {
+		for err, code := range map[error]codes.Code{
+			errNoChanges: codes.FailedPrecondition,
+			&gitdomain.RevisionNotFoundError{Repo: "therepo", Spec: head}: codes.NotFound,
+			context.DeadlineExceeded: codes.DeadlineExceeded,
+		} {
+			svc := NewMockService()
+			svc.SquashCommitsFunc.SetDefaultReturn("", err)
+			_, err := newServer(svc).SquashCommits(ctx, &v1.SquashCommitsRequest{RepoName: "therepo", BaseCommitSha: base, HeadCommitSha: head, Committer: committer})
+			assertGRPCStatusCode(t, err, code)
+		}
+	})
+}
+
 func assertGRPCStatusCode(t *testing.T, err error, want codes.Code) {
 	t.Helper()
 	s, ok := status.FromError(err)
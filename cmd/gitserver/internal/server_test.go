@@ -89,6 +89,25 @@ func TestExecRequest(t *testing.T) {
 				Stderr:     "teststderr",
 			}},
 		},
+		{
+			Name: "TypedError",
+			Request: &v1.ExecRequest{
+				Repo: "github.com/gorilla/mux",
+				Args: [][]byte{[]byte("log")},
+			},
+			ExpectedCode:  codes.Unknown,
+			ExpectedError: "exit status 128",
+			ExpectedDetails: []any{
+				&v1.ExecStatusPayload{
+					StatusCode: 128,
+					Stderr:     "fatal: bad object deadbeef",
+				},
+				&v1.BadObjectPayload{
+					RepoName: "github.com/gorilla/mux",
+					Object:   "deadbeef",
+				},
+			},
+		},
 		{
 			Name: "EmptyInput",
 			Request: &v1.ExecRequest{
@@ -148,6 +167,15 @@ func TestExecRequest(t *testing.T) {
 							Inner:      errors.New("testerror"),
 						},
 					}, nil
+				case "log":
+					return &errorReader{
+						ReadCloser: io.NopCloser(&bytes.Buffer{}),
+						err: &gitcli.CommandFailedError{
+							Stderr:     []byte("fatal: bad object deadbeef"),
+							ExitStatus: 128,
+							Inner:      errors.New("exit status 128"),
+						},
+					}, nil
 				}
 				return io.NopCloser(&bytes.Buffer{}), nil
 			})
@@ -219,7 +247,7 @@ func TestExecRequest(t *testing.T) {
 				require.Equal(t, test.ExpectedCode, s.Code(), "wrong error code: expected %v, got %v %v", test.ExpectedCode, s.Code(), err)
 
 				if len(test.ExpectedDetails) > 0 {
-					if diff := cmp.Diff(test.ExpectedDetails, s.Details(), cmpopts.IgnoreUnexported(v1.ExecStatusPayload{}, v1.RepoNotFoundPayload{})); diff != "" {
+					if diff := cmp.Diff(test.ExpectedDetails, s.Details(), cmpopts.IgnoreUnexported(v1.ExecStatusPayload{}, v1.RepoNotFoundPayload{}, v1.BadObjectPayload{})); diff != "" {
 						t.Fatalf("unexpected error details (-want +got):\n%s", diff)
 					}
 				}
@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// SquashCommitsOpts configures the commit created by SquashCommits.
+type SquashCommitsOpts struct {
+	// Message is the message of the new commit. If empty, the messages of the
+	// squashed commits are combined. The Co-authored-by trailers of the
+	// squashed commits are appended in both cases.
+	Message string
+	// Author is the author of the new commit. If nil, the author of the oldest
+	// squashed commit is used.
+	Author    *gitdomain.Signature
+	Committer gitdomain.Signature
+}
+
+// SquashCommits creates a commit on top of base with the tree of head, and
+// returns its ID. It is created in a temporary worktree and kept under a ref
+// below tempRefPrefix.
+//
+// Merge commits in base..head contribute their changes, but not their message
+// or author. If head has the same tree as base, errNoChanges is returned.
+func (s *Server) SquashCommits(ctx context.Context, repo api.RepoName, base, head api.CommitID, opts SquashCommitsOpts) (api.CommitID, error) {
+	ctx, cancel := context.WithTimeout(ctx, worktreeExecTimeout)
+	defer cancel()
+
+	for _, c := range []api.CommitID{base, head} {
+		if err := s.checkCommitExists(ctx, repo, c); err != nil {
+			return "", err
+		}
+	}
+
+	w, cleanup, err := s.newTempWorktree(ctx, repo, head)
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+
+	// Keep the index, and thus the tree of head, but make base the parent of
+	// the next commit.
+	if _, err := w.git(ctx, "reset", "--quiet", "--soft", string(base)); err != nil {
+		return "", err
+	}
+	if staged, err := w.hasStagedChanges(ctx); err != nil {
+		return "", err
+	} else if !staged {
+		return "", errNoChanges
+	}
+
+	out, err := w.git(ctx, "log", "-z", "--reverse", "--topo-order", "--no-merges", "--format=%H%n%B", string(base)+".."+string(head))
+	if err != nil {
+		return "", err
+	}
+	var oldest api.CommitID
+	var messages []string
+	for _, entry := range strings.Split(strings.TrimSuffix(string(out), "\x00"), "\x00") {
+		commit, message, _ := strings.Cut(entry, "\n")
+		if oldest == "" {
+			oldest = api.CommitID(commit)
+		}
+		messages = append(messages, message)
+	}
+	if oldest == "" {
+		// Only merge commits change something, so there is nothing to take
+		// the author and message from.
+		return "", errors.Newf("no commits to squash in %s..%s", base, head)
+	}
+
+	var author gitdomain.Signature
+	if opts.Author != nil {
+		author = *opts.Author
+	} else if author, err = w.author(ctx, oldest); err != nil {
+		return "", err
+	}
+
+	if err := w.commit(ctx, squashMessage(opts.Message, messages), author, opts.Committer); err != nil {
+		return "", err
+	}
+	return w.publish(ctx)
+}
+
+// trailerLinePattern matches a line of a commit message trailer, like
+// "Signed-off-by: Jane Doe <jane@example.com>".
+var trailerLinePattern = regexp.MustCompile(`^[A-Za-z0-9-]+: `)
+
+// squashMessage returns the message of a commit that squashes commits with the
+// given messages. If message is empty, the messages are combined without their
+// trailers. The distinct Co-authored-by trailers of the messages are appended
+// unless message already contains them.
+func squashMessage(message string, messages []string) string {
+	var bodies, coAuthors []string
+	seen := map[string]bool{}
+	for _, m := range messages {
+		body, trailers := splitTrailers(m)
+		if body != "" {
+			bodies = append(bodies, body)
+		}
+		for _, t := range trailers {
+			key, value, _ := strings.Cut(t, ": ")
+			if !strings.EqualFold(key, "Co-authored-by") || seen[value] {
+				continue
+			}
+			seen[value] = true
+			coAuthors = append(coAuthors, "Co-authored-by: "+value)
+		}
+	}
+
+	if message == "" {
+		message = strings.Join(bodies, "\n\n")
+	}
+	message = strings.TrimSpace(message)
+
+	_, existing := splitTrailers(message)
+	var add []string
+	for _, t := range coAuthors {
+		if !slices.ContainsFunc(existing, func(l string) bool { return strings.EqualFold(l, t) }) {
+			add = append(add, t)
+		}
+	}
+	if len(add) == 0 {
+		return message
+	}
+	if len(existing) == 0 {
+		message += "\n"
+	}
+	return message + "\n" + strings.Join(add, "\n")
+}
+
+// splitTrailers splits a commit message into its body and the lines of its
+// trailer, which is the last paragraph if it only consists of "Key: value"
+// lines.
+func splitTrailers(message string) (body string, trailers []string) {
+	message = strings.TrimSpace(message)
+	i := strings.LastIndex(message, "\n\n")
+	if i < 0 {
+		// A message without body has no trailer.
+		return message, nil
+	}
+	lines := strings.Split(message[i+2:], "\n")
+	for _, l := range lines {
+		if !trailerLinePattern.MatchString(l) {
+			return message, nil
+		}
+	}
+	return strings.TrimSpace(message[:i]), lines
+}
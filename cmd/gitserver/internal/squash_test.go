@@ -0,0 +1,145 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestServer_SquashCommits(t *testing.T) {
+	ctx := context.Background()
+	reposDir := t.TempDir()
+	remoteDir := filepath.Join(reposDir, "remote")
+	require.NoError(t, os.Mkdir(remoteDir, os.ModePerm))
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remoteDir, name, arg...)
+	}
+	commit := func(msg, file, content string) api.CommitID {
+		t.Helper()
+		cmd("sh", "-c", "echo "+content+" > "+file)
+		cmd("git", "add", file)
+		cmd("git", "commit", "--date=2020-01-02T03:04:05+01:00", "-m", msg)
+		return api.CommitID(strings.TrimSpace(cmd("git", "rev-parse", "HEAD")))
+	}
+
+	cmd("git", "init", "--initial-branch=main", ".")
+	base := commit("add a", "a.txt", "a")
+	cmd("git", "checkout", "-b", "feature")
+	commit("Change a\n\nCo-authored-by: Jane Doe <jane@example.com>", "a.txt", "a2")
+	cmd("git", "checkout", "-b", "other", string(base))
+	commit("Add c", "c.txt", "c")
+	cmd("git", "checkout", "feature")
+	cmd("git", "merge", "--no-edit", "other")
+	commit("Add b\n\nThe b file.\n\nCo-authored-by: John Doe <john@example.com>\nCo-authored-by: Jane Doe <jane@example.com>", "b.txt", "b")
+	head := commit("Revert b", "b.txt", "b2")
+	cmd("git", "checkout", "main")
+	commit("Add d", "d.txt", "d")
+	cmd("git", "rm", "--quiet", "d.txt")
+	cmd("git", "commit", "-m", "Remove d")
+	noopHead := api.CommitID(strings.TrimSpace(cmd("git", "rev-parse", "HEAD")))
+
+	s := makeTestServer(ctx, t, reposDir, remoteDir, nil)
+
+	repo := api.RepoName("example.com/foo/bar")
+	repoDir := s.fs.RepoDir(repo).Path()
+	runCmd(t, reposDir, "git", "clone", "--bare", remoteDir, repoDir)
+	git := func(args ...string) string {
+		t.Helper()
+		return strings.TrimSpace(runCmd(t, repoDir, "git", args...))
+	}
+
+	bot := gitdomain.Signature{Name: "Bot", Email: "bot@example.com", Date: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+
+	t.Run("default message", func(t *testing.T) {
+		squashed, err := s.SquashCommits(ctx, repo, base, head, SquashCommitsOpts{Committer: bot})
+		require.NoError(t, err)
+
+		require.Equal(t, string(squashed), git("rev-parse", "refs/sourcegraph/tmp/"+string(squashed)))
+		require.Equal(t, string(base), git("rev-parse", string(squashed)+"^@"))
+		require.Equal(t, git("rev-parse", string(head)+"^{tree}"), git("rev-parse", string(squashed)+"^{tree}"))
+		require.Equal(t,
+			"Change a\n\nAdd c\n\nAdd b\n\nThe b file.\n\nRevert b\n\nCo-authored-by: Jane Doe <jane@example.com>\nCo-authored-by: John Doe <john@example.com>",
+			git("log", "-1", "--format=%B", string(squashed)),
+		)
+		require.Equal(t, "a <a@a.com> 2020-01-02T03:04:05+01:00", git("log", "-1", "--format=%an <%ae> %aI", string(squashed)))
+		require.Equal(t, "Bot <bot@example.com> 2024-01-02T03:04:05+00:00", git("log", "-1", "--format=%cn <%ce> %cI", string(squashed)))
+	})
+
+	t.Run("custom message and author", func(t *testing.T) {
+		squashed, err := s.SquashCommits(ctx, repo, base, head, SquashCommitsOpts{
+			Message:   "Update a and c\n\nCo-authored-by: john doe <john@example.com>",
+			Author:    &bot,
+			Committer: bot,
+		})
+		require.NoError(t, err)
+		require.Equal(t,
+			"Update a and c\n\nCo-authored-by: john doe <john@example.com>\nCo-authored-by: Jane Doe <jane@example.com>",
+			git("log", "-1", "--format=%B", string(squashed)),
+		)
+		require.Equal(t, "Bot <bot@example.com>", git("log", "-1", "--format=%an <%ae>", string(squashed)))
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		_, err := s.SquashCommits(ctx, repo, base, base, SquashCommitsOpts{Committer: bot})
+		require.ErrorIs(t, err, errNoChanges)
+		_, err = s.SquashCommits(ctx, repo, base, noopHead, SquashCommitsOpts{Committer: bot})
+		require.ErrorIs(t, err, errNoChanges)
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := s.SquashCommits(ctx, repo, base, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", SquashCommitsOpts{Committer: bot})
+		var e *gitdomain.RevisionNotFoundError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", e.Spec)
+	})
+}
+
+func TestSquashMessage(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		message  string
+		messages []string
+		want     string
+	}{
+		{
+			name:     "single commit",
+			messages: []string{"Fix the thing\n"},
+			want:     "Fix the thing",
+		},
+		{
+			name:     "other trailers are dropped",
+			messages: []string{"Fix\n\nSigned-off-by: A <a@example.com>\nChange-Id: I123\n", "Test\n\nChange-Id: I456\n"},
+			want:     "Fix\n\nTest",
+		},
+		{
+			name:     "key is case-insensitive",
+			messages: []string{"Fix\n\nco-authored-by: A <a@example.com>\n"},
+			want:     "Fix\n\nCo-authored-by: A <a@example.com>",
+		},
+		{
+			name:     "trailer-like subject",
+			messages: []string{"docs: fix typo\n"},
+			want:     "docs: fix typo",
+		},
+		{
+			name:     "custom message with trailer",
+			message:  "Squashed\n\nSigned-off-by: B <b@example.com>",
+			messages: []string{"Fix\n\nCo-authored-by: A <a@example.com>\n"},
+			want:     "Squashed\n\nSigned-off-by: B <b@example.com>\nCo-authored-by: A <a@example.com>",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, squashMessage(tc.message, tc.messages))
+		})
+	}
+}
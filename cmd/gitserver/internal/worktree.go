@@ -0,0 +1,416 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git/gitcli"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// worktreeCmdAllowlist are the git commands and flags that can be run by
+// ExecInWorktree. The worktree is thrown away afterwards, so the commands may
+// modify it. None of the commands or flags can read or write files outside of
+// the worktree, like git apply <patch-file> or git diff --output would, or
+// talk to other hosts. Arguments that aren't flags are only allowed after
+// "--", where git treats them as pathspecs. Git does not confine pathspecs to
+// the worktree: git diff compares paths outside of the repo like git diff
+// --no-index would, so isAllowedWorktreeCmd only accepts relative paths that
+// stay inside the worktree.
+var worktreeCmdAllowlist = map[string][]string{
+	"apply": {
+		"--check", "--stat", "--numstat", "--summary", "--index", "--cached", "-3", "--3way",
+		"-R", "--reverse", "-p", "-C", "--unidiff-zero", "--recount", "--allow-empty",
+		"--whitespace", "--ignore-whitespace", "--ignore-space-change", "--exclude", "--include",
+		"--directory", "-v", "--verbose", "-q", "--quiet",
+	},
+	"diff": {
+		"--check", "--stat", "--numstat", "--shortstat", "--name-only", "--name-status", "--exit-code",
+		"--quiet", "--cached", "--staged", "-z", "--no-color", "--no-ext-diff", "-w", "-b", "--",
+	},
+	"status":   {"--porcelain", "--short", "-s", "-z", "--untracked-files", "-u", "--"},
+	"ls-files": {"-z", "--cached", "--others", "--modified", "--deleted", "--exclude-standard", "--stage", "--"},
+}
+
+// worktreeShortValueFlags are the short flags in worktreeCmdAllowlist that
+// take a value directly after the flag, like -p1.
+var worktreeShortValueFlags = []string{"-p", "-C"}
+
+// isAllowedWorktreeCmd reports whether args are allowed by
+// worktreeCmdAllowlist.
+func isAllowedWorktreeCmd(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	allowed, ok := worktreeCmdAllowlist[args[0]]
+	if !ok {
+		return false
+	}
+	for i, arg := range args[1:] {
+		if arg == "--" {
+			if !slices.Contains(allowed, "--") {
+				return false
+			}
+			for _, p := range args[i+2:] {
+				if !isWorktreePathspec(p) {
+					return false
+				}
+			}
+			return true
+		}
+		flag, _, _ := strings.Cut(arg, "=")
+		if len(flag) > 2 && flag[0] == '-' && flag[1] != '-' {
+			// Short flags like -p1 take their value without a separator.
+			// Any other short flag longer than that bundles several flags,
+			// like -wO<file>, whose later flags we would not check.
+			if !slices.Contains(worktreeShortValueFlags, flag[:2]) {
+				return false
+			}
+			flag = flag[:2]
+		}
+		if !slices.Contains(allowed, flag) {
+			return false
+		}
+	}
+	return true
+}
+
+// isWorktreePathspec reports whether p is a plain path relative to the
+// worktree that doesn't leave it. Pathspec magic like :(top) or :/ is not
+// allowed either.
+func isWorktreePathspec(p string) bool {
+	if p == "" || strings.HasPrefix(p, ":") || strings.ContainsRune(p, 0) {
+		return false
+	}
+	if filepath.IsAbs(p) || strings.HasPrefix(p, "/") || strings.HasPrefix(p, `\`) {
+		return false
+	}
+	clean := filepath.ToSlash(filepath.Clean(p))
+	return clean != ".." && !strings.HasPrefix(clean, "../")
+}
+
+const (
+	// worktreeExecTimeout limits how long checking out the worktree and
+	// running the command may take.
+	worktreeExecTimeout = time.Minute
+	// maxWorktreeExecOutput limits the bytes of stdout and stderr each that
+	// ExecInWorktree returns.
+	maxWorktreeExecOutput = 1 << 20
+)
+
+// ExecInWorktree checks out commit into a temporary worktree and runs git with
+// args in it, passing stdin on standard input. The worktree shares the
+// objects of the repo, but has its own index and refs, so nothing the command
+// does is visible in the repo.
+//
+// If args are not allowed by worktreeCmdAllowlist, gitcli.ErrBadGitCommand is
+// returned. If the command fails, its exit status is returned in the result,
+// not as an error.
+func (s *Server) ExecInWorktree(ctx context.Context, repo api.RepoName, commit api.CommitID, args []string, stdin []byte) (gitdomain.WorktreeExecResult, error) {
+	if !isAllowedWorktreeCmd(args) {
+		return gitdomain.WorktreeExecResult{}, gitcli.ErrBadGitCommand
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, worktreeExecTimeout)
+	defer cancel()
+
+	if err := s.checkCommitExists(ctx, repo, commit); err != nil {
+		return gitdomain.WorktreeExecResult{}, err
+	}
+
+	w, cleanup, err := s.newTempWorktree(ctx, repo, commit)
+	if err != nil {
+		return gitdomain.WorktreeExecResult{}, err
+	}
+	defer cleanup()
+
+	stdout := &cappedBuffer{n: maxWorktreeExecOutput}
+	stderr := &cappedBuffer{n: maxWorktreeExecOutput}
+	cmd := w.command(ctx, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	var result gitdomain.WorktreeExecResult
+	if err := w.run(ctx, cmd); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return result, ctxErr
+		}
+		var e *exec.ExitError
+		if !errors.As(err, &e) {
+			return result, err
+		}
+		result.ExitStatus = e.ExitCode()
+	}
+	result.Stdout = stdout.Bytes()
+	result.Stderr = stderr.Bytes()
+	result.Truncated = stdout.truncated || stderr.truncated
+	return result, nil
+}
+
+// checkCommitExists returns a RevisionNotFoundError if commit doesn't exist in
+// repo. Checking this first gives a proper error, git checkout only complains
+// about a missing tree.
+func (s *Server) checkCommitExists(ctx context.Context, repo api.RepoName, commit api.CommitID) error {
+	_, err := s.getBackendFunc(s.fs.RepoDir(repo), repo).ResolveRevision(ctx, string(commit)+"^{commit}")
+	var e *gitdomain.RevisionNotFoundError
+	if errors.As(err, &e) {
+		e.Spec = string(commit)
+	}
+	return err
+}
+
+// tempWorktree is a temporary repository with a worktree, that borrows the
+// objects of a repo instead of copying them. Objects created in it, like new
+// commits, are only written to the temporary repository.
+type tempWorktree struct {
+	s    *Server
+	repo api.RepoName
+	dir  string
+	env  []string
+}
+
+// newTempWorktree creates a tempWorktree for repo with commit checked out.
+// The returned function removes it again.
+func (s *Server) newTempWorktree(ctx context.Context, repo api.RepoName, commit api.CommitID) (_ *tempWorktree, cleanup func(), err error) {
+	tmpDir, err := s.fs.TempDir("worktree-")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "create temp dir")
+	}
+	cleanup = func() { cleanUpTmpRepo(s.logger, tmpDir) }
+
+	// Like for CreateCommitFromPatch, the temporary repo borrows the objects
+	// of the repo instead of copying them.
+	w := &tempWorktree{
+		s:    s,
+		repo: repo,
+		dir:  tmpDir,
+		env: append(os.Environ(),
+			"GIT_DIR="+filepath.Join(tmpDir, ".git"),
+			"GIT_ALTERNATE_OBJECT_DIRECTORIES="+s.fs.RepoDir(repo).Path("objects"),
+		),
+	}
+	for _, setup := range [][]string{
+		{"init", "--quiet"},
+		{"checkout", "--quiet", "--detach", string(commit)},
+	} {
+		if _, err := w.git(ctx, setup...); err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+	}
+	return w, cleanup, nil
+}
+
+// command returns a git command with args that runs in the worktree.
+func (w *tempWorktree) command(ctx context.Context, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = w.dir
+	cmd.Env = slices.Clone(w.env)
+	return cmd
+}
+
+// run runs cmd, which must have been created by command.
+func (w *tempWorktree) run(ctx context.Context, cmd *exec.Cmd) error {
+	return w.s.recordingCommandFactory.WrapWithRepoName(ctx, w.s.logger, w.repo, cmd).Run()
+}
+
+// git runs git with args in the worktree and returns its standard output. If
+// git fails, the returned error contains its standard error.
+func (w *tempWorktree) git(ctx context.Context, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := w.command(ctx, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := w.run(ctx, cmd); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, errors.Wrapf(err, "git %s: %s", args[0], bytes.TrimSpace(stderr.Bytes()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// tempRefPrefix is the prefix of the refs that keep commits created in a
+// tempWorktree from being garbage collected, until the caller deletes them.
+const tempRefPrefix = "refs/sourcegraph/tmp/"
+
+// errNoChanges is returned when applying the changes of a commit results in
+// no changes.
+var errNoChanges = errors.New("the changes are already applied")
+
+// apply runs git with args, which must be a command like git cherry-pick
+// --no-commit that applies the changes of commit to the index and worktree.
+// If the changes conflict, a MergeConflictError is returned and the worktree
+// is left in the conflicted state. If the changes are already contained in
+// HEAD, errNoChanges is returned.
+func (w *tempWorktree) apply(ctx context.Context, commit api.CommitID, args ...string) error {
+	if _, err := w.git(ctx, args...); err != nil {
+		conflicts, cErr := w.conflictingPaths(ctx)
+		if cErr != nil {
+			return errors.Append(err, cErr)
+		}
+		if len(conflicts) == 0 {
+			return err
+		}
+		return &gitdomain.MergeConflictError{Repo: w.repo, Commit: commit, Paths: conflicts}
+	}
+
+	changed, err := w.hasStagedChanges(ctx)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return errNoChanges
+	}
+	return nil
+}
+
+// conflictingPaths returns the paths with unresolved conflicts in the index.
+func (w *tempWorktree) conflictingPaths(ctx context.Context) ([]string, error) {
+	out, err := w.git(ctx, "diff", "--name-only", "-z", "--diff-filter=U")
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, p := range strings.Split(string(out), "\x00") {
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths, nil
+}
+
+// hasStagedChanges reports whether the index differs from HEAD.
+func (w *tempWorktree) hasStagedChanges(ctx context.Context) (bool, error) {
+	err := w.run(ctx, w.command(ctx, "diff", "--cached", "--quiet", "HEAD"))
+	if err == nil {
+		return false, nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return false, ctxErr
+	}
+	var e *exec.ExitError
+	if errors.As(err, &e) && e.ExitCode() == 1 {
+		return true, nil
+	}
+	return false, errors.Wrap(err, "git diff")
+}
+
+// preparedMessage returns the commit message that a command like git revert
+// --no-commit prepared for the changes it applied.
+func (w *tempWorktree) preparedMessage() (string, error) {
+	b, err := os.ReadFile(filepath.Join(w.dir, ".git", "MERGE_MSG"))
+	if err != nil {
+		return "", errors.Wrap(err, "reading prepared commit message")
+	}
+	return string(b), nil
+}
+
+// author returns the author of commit.
+func (w *tempWorktree) author(ctx context.Context, commit api.CommitID) (gitdomain.Signature, error) {
+	out, err := w.git(ctx, "show", "-s", "--format=%an%x00%ae%x00%at%x00%ai", string(commit))
+	if err != nil {
+		return gitdomain.Signature{}, err
+	}
+	fields := strings.Split(strings.TrimSuffix(string(out), "\n"), "\x00")
+	if len(fields) != 4 {
+		return gitdomain.Signature{}, errors.Newf("unexpected author of commit %s: %q", commit, out)
+	}
+	unix, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return gitdomain.Signature{}, errors.Wrapf(err, "parsing author date of commit %s", commit)
+	}
+	// %ai is like 2006-01-02 15:04:05 -0700, the offset keeps the time zone
+	// of the author.
+	zone, err := time.Parse("2006-01-02 15:04:05 -0700", fields[3])
+	if err != nil {
+		return gitdomain.Signature{}, errors.Wrapf(err, "parsing author date of commit %s", commit)
+	}
+	return gitdomain.Signature{
+		Name:  fields[0],
+		Email: fields[1],
+		Date:  time.Unix(unix, 0).In(zone.Location()),
+	}, nil
+}
+
+// commit commits the changes in the index with message, author and
+// committer. Zero dates default to the current time.
+func (w *tempWorktree) commit(ctx context.Context, message string, author, committer gitdomain.Signature) error {
+	cmd := w.command(ctx, "commit", "--quiet", "--no-verify", "-F", "-")
+	cmd.Stdin = strings.NewReader(message)
+	cmd.Env = append(cmd.Env,
+		"GIT_AUTHOR_NAME="+author.Name,
+		"GIT_AUTHOR_EMAIL="+author.Email,
+		"GIT_COMMITTER_NAME="+committer.Name,
+		"GIT_COMMITTER_EMAIL="+committer.Email,
+	)
+	if !author.Date.IsZero() {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GIT_AUTHOR_DATE=%d %s", author.Date.Unix(), author.Date.Format("-0700")))
+	}
+	if !committer.Date.IsZero() {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("GIT_COMMITTER_DATE=%d %s", committer.Date.Unix(), committer.Date.Format("-0700")))
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := w.run(ctx, cmd); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return errors.Wrapf(err, "git commit: %s", bytes.TrimSpace(stderr.Bytes()))
+	}
+	return nil
+}
+
+// publish moves the objects created in the worktree to the repo and stores
+// HEAD under a ref below tempRefPrefix, so that it isn't garbage collected.
+// It returns the ID of HEAD. The worktree can't be used afterwards.
+func (w *tempWorktree) publish(ctx context.Context) (api.CommitID, error) {
+	out, err := w.git(ctx, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	head := api.CommitID(bytes.TrimSpace(out))
+
+	dir := w.s.fs.RepoDir(w.repo)
+	if err := moveObjects(filepath.Join(w.dir, ".git", "objects"), dir.Path("objects")); err != nil {
+		return "", errors.Wrap(err, "moving git objects")
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "update-ref", "--", tempRefPrefix+string(head), string(head))
+	dir.Set(cmd)
+	if out, err := w.s.recordingCommandFactory.WrapWithRepoName(ctx, w.s.logger, w.repo, cmd).CombinedOutput(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return "", ctxErr
+		}
+		return "", errors.Wrapf(err, "git update-ref: %s", bytes.TrimSpace(out))
+	}
+	return head, nil
+}
+
+// cappedBuffer is a bytes.Buffer that discards everything written after the
+// first n bytes.
+type cappedBuffer struct {
+	bytes.Buffer
+	n         int
+	truncated bool
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if rest := b.n - b.Len(); len(p) > rest {
+		b.truncated = true
+		b.Buffer.Write(p[:max(rest, 0)])
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
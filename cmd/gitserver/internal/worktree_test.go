@@ -0,0 +1,153 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git/gitcli"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestServer_ExecInWorktree(t *testing.T) {
+	ctx := context.Background()
+	reposDir := t.TempDir()
+	remoteDir := filepath.Join(reposDir, "remote")
+	require.NoError(t, os.Mkdir(remoteDir, os.ModePerm))
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remoteDir, name, arg...)
+	}
+	commit := api.CommitID(strings.TrimSpace(makeSingleCommitRepo(cmd)))
+
+	s := makeTestServer(ctx, t, reposDir, remoteDir, nil)
+
+	repo := api.RepoName("example.com/foo/bar")
+	repoDir := s.fs.RepoDir(repo).Path()
+	runCmd(t, reposDir, "git", "clone", "--bare", remoteDir, repoDir)
+
+	patch := []byte(`diff --git a/hello.txt b/hello.txt
+--- a/hello.txt
++++ b/hello.txt
+@@ -1 +1 @@
+-hello world
++goodbye world
+`)
+
+	t.Run("patch applies", func(t *testing.T) {
+		res, err := s.ExecInWorktree(ctx, repo, commit, []string{"apply", "--check", "--stat"}, patch)
+		require.NoError(t, err)
+		require.Equal(t, 0, res.ExitStatus)
+		require.Contains(t, string(res.Stdout), "1 file changed")
+	})
+
+	t.Run("patch does not apply", func(t *testing.T) {
+		stale := []byte(strings.NewReplacer("-hello", "-bye", "+goodbye", "+hello").Replace(string(patch)))
+		res, err := s.ExecInWorktree(ctx, repo, commit, []string{"apply", "--check"}, stale)
+		require.NoError(t, err)
+		require.Equal(t, 1, res.ExitStatus)
+		require.Contains(t, string(res.Stderr), "patch does not apply")
+	})
+
+	t.Run("changes stay in the worktree", func(t *testing.T) {
+		res, err := s.ExecInWorktree(ctx, repo, commit, []string{"apply", "--index"}, patch)
+		require.NoError(t, err)
+		require.Equal(t, 0, res.ExitStatus, string(res.Stderr))
+
+		res, err = s.ExecInWorktree(ctx, repo, commit, []string{"status", "--porcelain"}, nil)
+		require.NoError(t, err)
+		require.Empty(t, res.Stdout)
+		require.Equal(t, string(commit), strings.TrimSpace(runCmd(t, repoDir, "git", "rev-parse", "HEAD")))
+	})
+
+	t.Run("command not allowed", func(t *testing.T) {
+		for _, args := range [][]string{
+			nil,
+			{"push", "origin"},
+			{"apply", "/etc/passwd"},
+			{"diff", "--output=/tmp/out"},
+			{"diff", "HEAD"},
+			{"diff", "--", "/etc/passwd", "/dev/null"},
+		} {
+			_, err := s.ExecInWorktree(ctx, repo, commit, args, nil)
+			require.ErrorIs(t, err, gitcli.ErrBadGitCommand, "%v", args)
+		}
+	})
+
+	t.Run("commit not found", func(t *testing.T) {
+		_, err := s.ExecInWorktree(ctx, repo, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", []string{"status"}, nil)
+		var e *gitdomain.RevisionNotFoundError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", e.Spec)
+	})
+
+	t.Run("paths outside of the worktree", func(t *testing.T) {
+		secret := filepath.Join(t.TempDir(), "secret")
+		require.NoError(t, os.WriteFile(secret, []byte("top secret\n"), 0o600))
+		rel, err := filepath.Rel(repoDir, secret)
+		require.NoError(t, err)
+
+		for _, args := range [][]string{
+			{"diff", "--", secret, "/dev/null"},
+			{"diff", "--", rel, "/dev/null"},
+			{"diff", "--no-color", "--", "hello.txt", "../../" + rel},
+		} {
+			res, err := s.ExecInWorktree(ctx, repo, commit, args, nil)
+			require.ErrorIs(t, err, gitcli.ErrBadGitCommand, "%v", args)
+			require.NotContains(t, string(res.Stdout), "top secret")
+		}
+	})
+}
+
+func TestIsAllowedWorktreeCmd(t *testing.T) {
+	for _, args := range [][]string{
+		{"apply", "--check", "-p1", "--whitespace=nowarn"},
+		{"diff", "--stat", "--", "dir/file.go"},
+		{"diff", "--", "dir/../file.go", "."},
+		{"ls-files", "-z", "--others"},
+	} {
+		require.True(t, isAllowedWorktreeCmd(args), "%v", args)
+	}
+
+	for _, args := range [][]string{
+		{},
+		{"log"},
+		{"diff", "--output=/tmp/out"},
+		{"diff", "dir/file.go"},
+		// Bundled short flags: git parses this as -w -O /etc/shadow.
+		{"diff", "--name-only", "-wO/etc/shadow"},
+		{"status", "-sz"},
+		{"apply", "/tmp/patch"},
+		{"diff", "--", "/etc/passwd", "/dev/null"},
+		{"diff", "--", "dir/../../other-repo/config"},
+		{"status", "--", ".."},
+		{"ls-files", "--", ":(top)../x"},
+		{"ls-files", "--", ":/"},
+	} {
+		require.False(t, isAllowedWorktreeCmd(args), "%v", args)
+	}
+}
+
+func TestCappedBuffer(t *testing.T) {
+	b := &cappedBuffer{n: 5}
+	n, err := b.Write([]byte("abc"))
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	require.False(t, b.truncated)
+
+	n, err = b.Write([]byte("defg"))
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+	require.True(t, b.truncated)
+	require.Equal(t, "abcde", b.String())
+
+	_, err = b.Write([]byte("h"))
+	require.NoError(t, err)
+	require.Equal(t, "abcde", b.String())
+}
@@ -39,11 +39,13 @@ import (
 	connections "github.com/sourcegraph/sourcegraph/internal/database/connections/live"
 	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
 	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine/recorder"
 	internalgrpc "github.com/sourcegraph/sourcegraph/internal/grpc"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/defaults"
+	"github.com/sourcegraph/sourcegraph/internal/grpc/propagator"
 	"github.com/sourcegraph/sourcegraph/internal/httpserver"
 	"github.com/sourcegraph/sourcegraph/internal/instrumentation"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -242,8 +244,14 @@ func makeGRPCServer(logger log.Logger, s *server.Server) *grpc.Server {
 
 	grpcServer := defaults.NewServer(
 		logger,
-		grpc.ChainStreamInterceptor(accesslog.StreamServerInterceptor(scopedLogger, configurationWatcher)),
-		grpc.ChainUnaryInterceptor(accesslog.UnaryServerInterceptor(scopedLogger, configurationWatcher)),
+		grpc.ChainStreamInterceptor(
+			propagator.StreamServerPropagator(gitserver.PriorityPropagator{}),
+			accesslog.StreamServerInterceptor(scopedLogger, configurationWatcher),
+		),
+		grpc.ChainUnaryInterceptor(
+			propagator.UnaryServerPropagator(gitserver.PriorityPropagator{}),
+			accesslog.UnaryServerInterceptor(scopedLogger, configurationWatcher),
+		),
 	)
 	proto.RegisterGitserverServiceServer(grpcServer, server.NewGRPCServer(s))
 
@@ -28,6 +28,38 @@ type RepoContent struct {
 // setup when checking many files in a repository.
 type FilePermissionFunc func(path string) (Perms, error)
 
+// BatchFilePermissionChecker is an optional interface for a
+// SubRepoPermissionChecker which can check many paths of a repository in a
+// single call. Use FilePermissions to take advantage of it.
+type BatchFilePermissionChecker interface {
+	// FilePermissions returns the Perms of each of paths for userID in repo.
+	// The i-th element of the result holds the Perms of paths[i].
+	//
+	// If the userID represents an anonymous user, ErrUnauthenticated is returned.
+	FilePermissions(ctx context.Context, userID int32, repo api.RepoName, paths []string) ([]Perms, error)
+}
+
+// FilePermissionDecision is the Perms of a path together with the sub-repo
+// permission rule that granted or denied them.
+type FilePermissionDecision struct {
+	Perms Perms
+	// Rule is the rule that matched the path, prefixed with "-" for exclusion
+	// rules. It is empty if no rule matched, which denies access, or if the
+	// repo has no rules at all, which grants access to every path.
+	Rule string
+}
+
+// FilePermissionExplainer is an optional interface for a
+// SubRepoPermissionChecker which can tell which rule decided the permissions
+// of a path. It exists for debugging why paths are filtered.
+type FilePermissionExplainer interface {
+	// ExplainFilePermissions returns the decision for each of paths for
+	// userID in repo, in the same order as paths.
+	//
+	// If the userID represents an anonymous user, ErrUnauthenticated is returned.
+	ExplainFilePermissions(ctx context.Context, userID int32, repo api.RepoName, paths []string) ([]FilePermissionDecision, error)
+}
+
 // SubRepoPermissionChecker is the interface exposed by the SubRepoPermsClient and is
 // exposed to allow consumers to mock out the client.
 type SubRepoPermissionChecker interface {
@@ -145,6 +177,53 @@ func SubRepoEnabledForRepo(ctx context.Context, checker SubRepoPermissionChecker
 	return checker.EnabledForRepo(ctx, repo)
 }
 
+// FilePermissions returns the Perms of each of paths for userID in repo, in the
+// same order as paths. If s implements BatchFilePermissionChecker, all paths
+// are checked in one call. Otherwise they are checked one by one with a
+// single FilePermissionFunc.
+func FilePermissions(ctx context.Context, s SubRepoPermissionChecker, userID int32, repo api.RepoName, paths []string) ([]Perms, error) {
+	if b, ok := s.(BatchFilePermissionChecker); ok {
+		perms, err := b.FilePermissions(ctx, userID, repo, paths)
+		if err != nil {
+			return nil, err
+		}
+		if len(perms) != len(paths) {
+			return nil, errors.Newf("got permissions for %d paths, want %d", len(perms), len(paths))
+		}
+		return perms, nil
+	}
+
+	checkPathPerms, err := s.FilePermissionsFunc(ctx, userID, repo)
+	if err != nil {
+		return nil, err
+	}
+	perms := make([]Perms, len(paths))
+	for i, p := range paths {
+		if perms[i], err = checkPathPerms(p); err != nil {
+			return nil, err
+		}
+	}
+	return perms, nil
+}
+
+// ActorFilePermissions returns the Perms of each of paths for the given actor,
+// in the same order as paths. If sub-repo permissions don't apply to the
+// actor, every path is readable.
+//
+// If the actor represents an anonymous user, ErrUnauthenticated is returned.
+func ActorFilePermissions(ctx context.Context, s SubRepoPermissionChecker, a *actor.Actor, repo api.RepoName, paths []string) ([]Perms, error) {
+	if doCheck, err := actorSubRepoEnabled(s, a); err != nil {
+		return nil, err
+	} else if !doCheck {
+		perms := make([]Perms, len(paths))
+		for i := range perms {
+			perms[i] = Read
+		}
+		return perms, nil
+	}
+	return FilePermissions(ctx, s, a.UID, repo, paths)
+}
+
 var (
 	metricCanReadPathsDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Name: "authz_sub_repo_perms_can_read_paths_duration_seconds",
@@ -174,17 +253,13 @@ func canReadPaths(ctx context.Context, checker SubRepoPermissionChecker, repo ap
 		metricCanReadPathsDuration.WithLabelValues(anyS, resultS, errS).Observe(time.Since(start).Seconds())
 	}()
 
-	checkPathPerms, err := checker.FilePermissionsFunc(ctx, a.UID, repo)
+	checkPathPermsCount = len(paths)
+	allPerms, err := FilePermissions(ctx, checker, a.UID, repo, paths)
 	if err != nil {
 		return false, err
 	}
 
-	for _, p := range paths {
-		checkPathPermsCount++
-		perms, err := checkPathPerms(p)
-		if err != nil {
-			return false, err
-		}
+	for _, perms := range allPerms {
 		if !perms.Include(Read) && !any {
 			return false, nil
 		} else if perms.Include(Read) && any {
@@ -232,19 +307,15 @@ func FilterActorPaths(ctx context.Context, checker SubRepoPermissionChecker, a *
 		metricFilterActorPathsDuration.WithLabelValues(strconv.FormatBool(err != nil)).Observe(time.Since(start).Seconds())
 	}()
 
-	checkPathPerms, err := checker.FilePermissionsFunc(ctx, a.UID, repo)
+	checkPathPermsCount = len(paths)
+	perms, err := FilePermissions(ctx, checker, a.UID, repo, paths)
 	if err != nil {
 		return nil, errors.Wrap(err, "checking sub-repo permissions")
 	}
 
 	filtered := make([]string, 0, len(paths))
-	for _, p := range paths {
-		checkPathPermsCount++
-		perms, err := checkPathPerms(p)
-		if err != nil {
-			return nil, errors.Wrap(err, "checking sub-repo permissions")
-		}
-		if perms.Include(Read) {
+	for i, p := range paths {
+		if perms[i].Include(Read) {
 			filtered = append(filtered, p)
 		}
 	}
@@ -283,19 +354,19 @@ func FilterActorFileInfos(ctx context.Context, checker SubRepoPermissionChecker,
 		metricFilterActorPathsDuration.WithLabelValues(strconv.FormatBool(err != nil)).Observe(time.Since(start).Seconds())
 	}()
 
-	checkPathPerms, err := checker.FilePermissionsFunc(ctx, a.UID, repo)
+	paths := make([]string, len(fis))
+	for i, fi := range fis {
+		paths[i] = fileInfoPath(fi)
+	}
+	checkPathPermsCount = len(paths)
+	perms, err := FilePermissions(ctx, checker, a.UID, repo, paths)
 	if err != nil {
 		return nil, errors.Wrap(err, "checking sub-repo permissions")
 	}
 
 	filtered := make([]fs.FileInfo, 0, len(fis))
-	for _, fi := range fis {
-		checkPathPermsCount++
-		perms, err := checkPathPerms(fileInfoPath(fi))
-		if err != nil {
-			return nil, err
-		}
-		if perms.Include(Read) {
+	for i, fi := range fis {
+		if perms[i].Include(Read) {
 			filtered = append(filtered, fi)
 		}
 	}
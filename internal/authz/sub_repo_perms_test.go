@@ -47,6 +47,112 @@ func TestFilterActorPaths(t *testing.T) {
 	}
 }
 
+// batchChecker is a SubRepoPermissionChecker implementing
+// BatchFilePermissionChecker.
+type batchChecker struct {
+	*MockSubRepoPermissionChecker
+	calls [][]string
+	perms func(paths []string) []Perms
+}
+
+func (c *batchChecker) FilePermissions(_ context.Context, _ int32, _ api.RepoName, paths []string) ([]Perms, error) {
+	c.calls = append(c.calls, paths)
+	return c.perms(paths), nil
+}
+
+func TestFilterActorPaths_Batch(t *testing.T) {
+	testPaths := []string{"file1", "file2", "file3"}
+	a := &actor.Actor{
+		UID: 1,
+	}
+	ctx := actor.WithActor(context.Background(), a)
+	repo := api.RepoName("foo")
+
+	checker := &batchChecker{
+		MockSubRepoPermissionChecker: NewMockSubRepoPermissionChecker(),
+		perms: func(paths []string) []Perms {
+			perms := make([]Perms, len(paths))
+			for i, p := range paths {
+				if p == "file1" || p == "dir/" {
+					perms[i] = Read
+				}
+			}
+			return perms
+		},
+	}
+	checker.EnabledFunc.SetDefaultReturn(true)
+
+	filtered, err := FilterActorPaths(ctx, checker, a, repo, testPaths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"file1"}, filtered); diff != "" {
+		t.Fatal(diff)
+	}
+
+	fis := []fs.FileInfo{
+		&fileutil.FileInfo{Name_: "dir", Mode_: fs.ModeDir},
+		&fileutil.FileInfo{Name_: "file2"},
+	}
+	filteredFis, err := FilterActorFileInfos(ctx, checker, a, repo, fis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(fis[:1], filteredFis); diff != "" {
+		t.Fatal(diff)
+	}
+
+	ok, err := CanReadAnyPath(ctx, checker, repo, testPaths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("user can read file1, so CanReadAnyPath should return true")
+	}
+
+	// Every call checked all paths at once, without falling back to the
+	// per-path function.
+	want := [][]string{testPaths, {"dir/", "file2"}, testPaths}
+	if diff := cmp.Diff(want, checker.calls); diff != "" {
+		t.Fatal(diff)
+	}
+	assert.Empty(t, checker.FilePermissionsFuncFunc.History())
+
+	t.Run("wrong number of permissions", func(t *testing.T) {
+		checker.perms = func([]string) []Perms { return []Perms{Read} }
+		if _, err := FilterActorPaths(ctx, checker, a, repo, testPaths); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestActorFilePermissions(t *testing.T) {
+	checker := NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.FilePermissionsFuncFunc.SetDefaultReturn(func(path string) (Perms, error) {
+		return None, nil
+	}, nil)
+	ctx := context.Background()
+	repo := api.RepoName("foo")
+	paths := []string{"file1", "file2"}
+
+	perms, err := ActorFilePermissions(ctx, checker, &actor.Actor{UID: 1}, repo, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []Perms{None, None}, perms)
+
+	// Sub-repo permissions don't apply to internal actors.
+	perms, err = ActorFilePermissions(ctx, checker, actor.FromContext(actor.WithInternalActor(ctx)), repo, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []Perms{Read, Read}, perms)
+
+	_, err = ActorFilePermissions(ctx, checker, &actor.Actor{}, repo, paths)
+	assert.ErrorIs(t, err, &ErrUnauthenticated{})
+}
+
 func TestCanReadAllPaths(t *testing.T) {
 	testPaths := []string{"file1", "file2", "file3"}
 	checker := NewMockSubRepoPermissionChecker()
@@ -47,6 +47,11 @@ type SubRepoPermsClient struct {
 	repoEnabledCache repoEnabledCache
 }
 
+var (
+	_ authz.BatchFilePermissionChecker = &SubRepoPermsClient{}
+	_ authz.FilePermissionExplainer    = &SubRepoPermsClient{}
+)
+
 const (
 	defaultCacheSize = 1000
 	defaultCacheTTL  = 10 * time.Second
@@ -81,17 +86,22 @@ type compiledRules struct {
 // traversed in reverse, and the function returns as soon as a match is found.
 // If no match is found, None is returned.
 func (rules compiledRules) GetPermissionsForPath(path string) authz.Perms {
+	return rules.explain(path).Perms
+}
+
+// explain is GetPermissionsForPath, but also returns the rule that matched.
+func (rules compiledRules) explain(path string) authz.FilePermissionDecision {
 	for i := len(rules.paths) - 1; i >= 0; i-- {
 		if rules.paths[i].globPath.Match(path) {
 			if rules.paths[i].exclusion {
-				return authz.None
+				return authz.FilePermissionDecision{Perms: authz.None, Rule: "-" + rules.paths[i].original}
 			}
-			return authz.Read
+			return authz.FilePermissionDecision{Perms: authz.Read, Rule: rules.paths[i].original}
 		}
 	}
 
 	// Return None if no rule matches
-	return authz.None
+	return authz.FilePermissionDecision{Perms: authz.None}
 }
 
 // NewSubRepoPermsClient instantiates an instance of authz.SubRepoPermsClient
@@ -252,6 +262,59 @@ func (s *SubRepoPermsClient) FilePermissionsFunc(ctx context.Context, userID int
 	}, nil
 }
 
+// FilePermissions implements authz.BatchFilePermissionChecker. The rules of
+// the user are looked up once, and then matched against every path.
+func (s *SubRepoPermsClient) FilePermissions(ctx context.Context, userID int32, repo api.RepoName, paths []string) ([]authz.Perms, error) {
+	f, err := s.FilePermissionsFunc(ctx, userID, repo)
+	if err != nil {
+		return nil, err
+	}
+	perms := make([]authz.Perms, len(paths))
+	for i, p := range paths {
+		if perms[i], err = f(p); err != nil {
+			return nil, err
+		}
+	}
+	return perms, nil
+}
+
+// ExplainFilePermissions implements authz.FilePermissionExplainer.
+func (s *SubRepoPermsClient) ExplainFilePermissions(ctx context.Context, userID int32, repo api.RepoName, paths []string) ([]authz.FilePermissionDecision, error) {
+	decisions := make([]authz.FilePermissionDecision, len(paths))
+
+	if !s.Enabled() {
+		for i := range decisions {
+			decisions[i].Perms = authz.Read
+		}
+		return decisions, nil
+	}
+	if s.permissionsGetter == nil {
+		return nil, errors.New("permissionsGetter is nil")
+	}
+	if userID == 0 {
+		return nil, &authz.ErrUnauthenticated{}
+	}
+
+	repoRules, err := s.getCompiledRules(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "compiling match rules")
+	}
+	rules, rulesExist := repoRules[repo]
+
+	for i, p := range paths {
+		// The same special cases as in FilePermissionsFunc apply.
+		if !rulesExist || p == "" {
+			decisions[i].Perms = authz.Read
+			continue
+		}
+		if !strings.HasPrefix(p, "/") {
+			p = "/" + p
+		}
+		decisions[i] = rules.explain(p)
+	}
+	return decisions, nil
+}
+
 // getCompiledRules fetches rules for the given repo with caching.
 func (s *SubRepoPermsClient) getCompiledRules(ctx context.Context, userID int32) (map[api.RepoName]compiledRules, error) {
 	// Fast path for cached rules
@@ -369,6 +369,45 @@ func TestSubRepoPermissionsCanReadDirectoriesInPath(t *testing.T) {
 	}
 }
 
+func TestSubRepoPermsExplainFilePermissions(t *testing.T) {
+	conf.Mock(&conf.Unified{
+		SiteConfiguration: schema.SiteConfiguration{
+			ExperimentalFeatures: &schema.ExperimentalFeatures{
+				SubRepoPermissions: &schema.SubRepoPermissions{
+					Enabled: true,
+				},
+			},
+		},
+	})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	getter := NewMockSubRepoPermissionsGetter()
+	getter.GetByUserFunc.SetDefaultReturn(map[api.RepoName]authz.SubRepoPermissions{
+		"sample": {
+			Paths: []string{"/dev/*", "-/dev/secret"},
+		},
+	}, nil)
+	client := NewSubRepoPermsClient(getter)
+	ctx := context.Background()
+
+	decisions, err := client.ExplainFilePermissions(ctx, 1, "sample", []string{"dev/thing", "/dev/secret", "other", ""})
+	require.NoError(t, err)
+	require.Equal(t, []authz.FilePermissionDecision{
+		{Perms: authz.Read, Rule: "/dev/*"},
+		{Perms: authz.None, Rule: "-/dev/secret"},
+		{Perms: authz.None},
+		{Perms: authz.Read},
+	}, decisions)
+
+	// Repos without rules are fully readable.
+	decisions, err = client.ExplainFilePermissions(ctx, 1, "unrestricted", []string{"dev/secret"})
+	require.NoError(t, err)
+	require.Equal(t, []authz.FilePermissionDecision{{Perms: authz.Read}}, decisions)
+
+	_, err = client.ExplainFilePermissions(ctx, 0, "sample", []string{"dev/thing"})
+	require.ErrorIs(t, err, &authz.ErrUnauthenticated{})
+}
+
 func TestSubRepoPermsPermissionsCache(t *testing.T) {
 	conf.Mock(&conf.Unified{
 		SiteConfiguration: schema.SiteConfiguration{
@@ -71,7 +71,7 @@ func NewTestClientSource(t testing.TB, addrs []string, options ...func(o *TestCl
 	conns := make(map[string]connAndErr)
 	var testAddresses []AddressWithClient
 	for _, addr := range addrs {
-		conn, err := defaults.Dial(addr, logger)
+		conn, err := defaults.Dial(addr, logger, priorityDialOptions...)
 		conns[addr] = connAndErr{address: addr, conn: conn, err: err}
 		testAddresses = append(testAddresses, &testConnAndErr{
 			address:    addr,
@@ -108,6 +108,10 @@ func (c *testGitserverConns) AddrForRepo(ctx context.Context, repo api.RepoName)
 	return c.conns.AddrForRepo(ctx, repo)
 }
 
+func (c *testGitserverConns) AddressingForRepo(ctx context.Context, repo api.RepoName) RepoAddressing {
+	return c.conns.AddressingForRepo(ctx, repo)
+}
+
 // Addresses returns the current list of gitserver addresses.
 func (c *testGitserverConns) Addresses() []AddressWithClient {
 	return c.testAddresses
@@ -174,20 +178,49 @@ type GitserverAddresses struct {
 func (g *GitserverAddresses) AddrForRepo(ctx context.Context, repoName api.RepoName) string {
 	addrForRepoInvoked.Inc()
 
+	return g.AddressingForRepo(ctx, repoName).Addr
+}
+
+// RepoAddressing explains how AddrForRepo picks the gitserver address of a
+// repo.
+type RepoAddressing struct {
+	// Repo is the name the address is computed for. Deleted repos are
+	// addressed by their name before the deletion.
+	Repo api.RepoName
+	// HashedAddr is the address the repo name hashes to.
+	HashedAddr string
+	// PinnedAddr is the address the repo is pinned to in the site
+	// configuration, or empty if it isn't pinned.
+	PinnedAddr string
+	// Addr is the address AddrForRepo returns: PinnedAddr if the repo is
+	// pinned, HashedAddr otherwise.
+	Addr string
+}
+
+// AddressingForRepo is like AddrForRepo, but also returns how the address was
+// picked.
+func (g *GitserverAddresses) AddressingForRepo(ctx context.Context, repoName api.RepoName) RepoAddressing {
 	// We undelete the repo name for the addr function so that we can still reach the
 	// right gitserver after a repo has been deleted (and the name changed by that).
 	// Ideally we wouldn't need this, but as long as we use RepoName as the identifier
 	// in gitserver, we have to do this.
-	name := string(api.UndeletedRepoName(repoName))
-	if pinnedAddr, ok := g.PinnedServers[name]; ok {
-		return pinnedAddr
-	}
+	name := api.UndeletedRepoName(repoName)
+	a := RepoAddressing{Repo: name}
 
 	// We use the normalize function here, because that's what we did previously.
 	// Ideally, this would not be required, but it would reshuffle GitHub.com repos
 	// with uppercase characters in the name. So until we have a better migration
 	// strategy, we keep this old behavior in.
-	return addrForKey(string(protocol.NormalizeRepo(api.RepoName(name))), g.Addresses)
+	if len(g.Addresses) > 0 {
+		a.HashedAddr = addrForKey(string(protocol.NormalizeRepo(name)), g.Addresses)
+	}
+
+	a.Addr = a.HashedAddr
+	if pinnedAddr, ok := g.PinnedServers[string(name)]; ok {
+		a.PinnedAddr = pinnedAddr
+		a.Addr = pinnedAddr
+	}
+	return a
 }
 
 // addrForKey returns the gitserver address to use for the given string key,
@@ -211,6 +244,9 @@ func (g *GitserverConns) ConnForRepo(ctx context.Context, repo api.RepoName) (*g
 	if !ok {
 		return nil, errors.Newf("no gRPC connection found for address %q", addr)
 	}
+	if err := ce.breaker.allow(); err != nil {
+		return nil, err
+	}
 	return ce.conn, ce.err
 }
 
@@ -224,6 +260,7 @@ type connAndErr struct {
 	address string
 	conn    *grpc.ClientConn
 	err     error
+	breaker *circuitBreaker
 }
 
 func (c *connAndErr) Address() string {
@@ -231,6 +268,9 @@ func (c *connAndErr) Address() string {
 }
 
 func (c *connAndErr) GRPCClient() (proto.GitserverServiceClient, error) {
+	if err := c.breaker.allow(); err != nil {
+		return nil, err
+	}
 	return &errorTranslatingClient{
 		base: &automaticRetryClient{
 			base: proto.NewGitserverServiceClient(c.conn),
@@ -247,6 +287,10 @@ func (a *atomicGitServerConns) AddrForRepo(ctx context.Context, repo api.RepoNam
 	return a.get().AddrForRepo(ctx, repo)
 }
 
+func (a *atomicGitServerConns) AddressingForRepo(ctx context.Context, repo api.RepoName) RepoAddressing {
+	return a.get().AddressingForRepo(ctx, repo)
+}
+
 func (a *atomicGitServerConns) ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error) {
 	conn, err := a.get().ConnForRepo(ctx, repo)
 	if err != nil {
@@ -268,6 +312,7 @@ func (a *atomicGitServerConns) Addresses() []AddressWithClient {
 			address: addr,
 			conn:    conns.grpcConns[addr].conn,
 			err:     conns.grpcConns[addr].err,
+			breaker: conns.grpcConns[addr].breaker,
 		})
 	}
 	return addrs
@@ -281,6 +326,7 @@ func (a *atomicGitServerConns) GetAddressWithClient(addr string) AddressWithClie
 			address: addr,
 			conn:    addrConn.conn,
 			err:     addrConn.err,
+			breaker: addrConn.breaker,
 		}
 	}
 	return nil
@@ -329,11 +375,13 @@ func (a *atomicGitServerConns) update(cfg *conf.Unified) {
 
 	after.grpcConns = make(map[string]connAndErr, len(after.Addresses))
 	for _, addr := range after.Addresses {
+		breaker := newCircuitBreaker(clientLogger, addr, circuitBreakerThreshold, circuitBreakerCooldown)
 		conn, err := defaults.Dial(
 			addr,
 			clientLogger,
+			append(breaker.dialOptions(), priorityDialOptions...)...,
 		)
-		after.grpcConns[addr] = connAndErr{conn: conn, err: err}
+		after.grpcConns[addr] = connAndErr{conn: conn, err: err, breaker: breaker}
 	}
 
 	a.conns.Store(&after)
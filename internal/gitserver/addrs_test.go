@@ -62,3 +62,51 @@ func TestAddrForRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestAddressingForRepo(t *testing.T) {
+	ga := GitserverAddresses{
+		Addresses: []string{"gitserver-1", "gitserver-2", "gitserver-3"},
+		PinnedServers: map[string]string{
+			"repo2": "gitserver-1",
+		},
+	}
+	ctx := context.Background()
+
+	testCases := []struct {
+		name string
+		repo api.RepoName
+		want RepoAddressing
+	}{
+		{
+			name: "hashed",
+			repo: api.RepoName("repo1"),
+			want: RepoAddressing{Repo: "repo1", HashedAddr: "gitserver-3", Addr: "gitserver-3"},
+		},
+		{
+			name: "pinned",
+			repo: api.RepoName("repo2"),
+			want: RepoAddressing{Repo: "repo2", HashedAddr: "gitserver-2", PinnedAddr: "gitserver-1", Addr: "gitserver-1"},
+		},
+		{
+			name: "deleted",
+			repo: api.RepoName("DELETED-123123.123123-repo2"),
+			want: RepoAddressing{Repo: "repo2", HashedAddr: "gitserver-2", PinnedAddr: "gitserver-1", Addr: "gitserver-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ga.AddressingForRepo(ctx, tc.repo)
+			if got != tc.want {
+				t.Fatalf("Want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+
+	t.Run("no addresses", func(t *testing.T) {
+		got := (&GitserverAddresses{}).AddressingForRepo(ctx, "repo1")
+		if got != (RepoAddressing{Repo: "repo1"}) {
+			t.Fatalf("Want empty addresses, got %+v", got)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package gitserver
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// AuditEvent describes a gitserver RPC made on behalf of an actor.
+type AuditEvent struct {
+	// Actor is the actor of the context the call was made with.
+	Actor *actor.Actor
+	// Repo is the repository the call read from or wrote to. It is empty for
+	// calls that aren't for a single repository, like DiskInfo.
+	Repo api.RepoName
+	// Operation is the name of the RPC, like "Exec" or "ReadFile".
+	Operation string
+	// Options is a short summary of the request. It only names the revisions,
+	// paths and sizes that were requested; search patterns and content sent
+	// to gitserver, like patches or commit messages, are left out.
+	Options string
+	// ReceivedBytes is the size of the responses returned by gitserver.
+	ReceivedBytes int
+	// Err is the error the call failed with, if any.
+	Err error
+}
+
+// AuditHook is called with every RPC made by a client created with
+// WithAuditHook, after it finished. It is called synchronously on the
+// goroutine that finishes the call, so it must not block for long; a hook that
+// writes to the security event log should do so asynchronously.
+type AuditHook func(ctx context.Context, event AuditEvent)
+
+// WithAuditHook returns a new client that reports every gitserver RPC to
+// hook, so that it can be recorded who read which repository content.
+func (c *clientImplementor) WithAuditHook(hook AuditHook) Client {
+	if hook == nil {
+		return c
+	}
+	return &clientImplementor{
+		logger:              c.logger,
+		scope:               c.scope,
+		operations:          c.operations,
+		subRepoPermsChecker: c.subRepoPermsChecker,
+		clientSource: &observedClientSource{
+			ClientSource: c.clientSource,
+			observer:     auditObserver{hook: hook},
+		},
+	}
+}
+
+// auditObserver is an rpcObserver that reports RPCs to an AuditHook.
+type auditObserver struct {
+	hook AuditHook
+}
+
+func (o auditObserver) observe(call rpcCallResult) {
+	event := AuditEvent{
+		Actor:         actor.FromContext(call.ctx),
+		Operation:     call.method,
+		ReceivedBytes: call.receivedBytes,
+		Err:           call.err,
+	}
+	if repo, ok := requestRepo(call.req); ok {
+		event.Repo = repo
+	}
+	if call.req != nil {
+		event.Options = summarizeRequest(call.req)
+	}
+	o.hook(call.ctx, event)
+}
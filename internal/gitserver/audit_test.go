@@ -0,0 +1,81 @@
+package gitserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+func TestClient_WithAuditHook(t *testing.T) {
+	var events []AuditEvent
+	c := newGRPCTestClient(t, &stallingGitserver{}).WithAuditHook(func(_ context.Context, event AuditEvent) {
+		events = append(events, event)
+	})
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(42))
+	_, err := c.ResolveRevision(ctx, "github.com/foo/bar", "HEAD", ResolveRevisionOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, events, 1)
+	event := events[0]
+	require.Equal(t, int32(42), event.Actor.UID)
+	require.Equal(t, api.RepoName("github.com/foo/bar"), event.Repo)
+	require.Equal(t, "ResolveRevision", event.Operation)
+	require.Contains(t, event.Options, "HEAD")
+	require.Positive(t, event.ReceivedBytes)
+	require.NoError(t, event.Err)
+}
+
+// worktreeGitserver answers ExecInWorktree with fixed output.
+type worktreeGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+}
+
+func (worktreeGitserver) ExecInWorktree(context.Context, *proto.ExecInWorktreeRequest) (*proto.ExecInWorktreeResponse, error) {
+	return &proto.ExecInWorktreeResponse{Stdout: []byte(" 1 file changed, 1 insertion(+)\n")}, nil
+}
+
+func TestClient_WithAuditHook_ExecInWorktree(t *testing.T) {
+	var events []AuditEvent
+	c := newGRPCTestClient(t, worktreeGitserver{}).WithAuditHook(func(_ context.Context, event AuditEvent) {
+		events = append(events, event)
+	})
+
+	_, err := c.ExecInWorktree(context.Background(), "github.com/foo/bar", "deadbeef", []string{"apply", "--stat"}, nil)
+	require.NoError(t, err)
+
+	require.Len(t, events, 1)
+	require.Equal(t, "ExecInWorktree", events[0].Operation)
+	require.Positive(t, events[0].ReceivedBytes)
+}
+
+func TestAuditObserver_Options(t *testing.T) {
+	var events []AuditEvent
+	o := auditObserver{hook: func(_ context.Context, event AuditEvent) {
+		events = append(events, event)
+	}}
+
+	o.observe(rpcCallResult{ctx: context.Background(), method: "Search", req: &proto.SearchRequest{
+		Repo: "github.com/foo/bar",
+		Query: &proto.QueryNode{Value: &proto.QueryNode_DiffMatches{
+			DiffMatches: &proto.DiffMatchesNode{Expr: "secret-pattern"},
+		}},
+	}})
+	o.observe(rpcCallResult{ctx: context.Background(), method: "ExecInWorktree", req: &proto.ExecInWorktreeRequest{
+		RepoName: "github.com/foo/bar",
+		Args:     [][]byte{[]byte("apply"), []byte("--check")},
+		Stdin:    []byte("+password = hunter2\n"),
+	}})
+
+	require.Len(t, events, 2)
+	for _, event := range events {
+		require.Contains(t, event.Options, "github.com/foo/bar")
+		require.NotContains(t, event.Options, "secret-pattern")
+		require.NotContains(t, event.Options, "hunter2")
+	}
+}
@@ -0,0 +1,150 @@
+package gitserver
+
+import (
+	"context"
+	"io/fs"
+	"time"
+
+	"github.com/sourcegraph/conc/pool"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// defaultBatchMaxConcurrency is the number of calls of a Batch that are in
+// flight at the same time if BatchOptions.MaxConcurrency is not set.
+const defaultBatchMaxConcurrency = 8
+
+// BatchOptions configures how the calls of a Batch are executed.
+type BatchOptions struct {
+	// Timeout is the deadline shared by all calls in the batch, measured from
+	// the time Execute is invoked. Calls that have not finished when the
+	// deadline is hit fail with a context error, all other results are kept.
+	// If zero, only the deadline of the context passed to Execute applies.
+	Timeout time.Duration
+	// MaxConcurrency is the maximum number of calls in flight at the same
+	// time. Defaults to 8.
+	MaxConcurrency int
+}
+
+// Batch collects many small, independent reads against gitserver and executes
+// them together with a shared deadline and bounded concurrency. This is meant
+// for pages that need to issue a lot of cheap calls, like dashboards, where a
+// single failing or slow call should not fail the whole page.
+//
+// Calls are queued by calling the methods on the batch, which return a handle
+// to the result. After Execute has returned, every handle holds either a value
+// or the error of that individual call.
+//
+// A Batch is not safe for concurrent use and can only be executed once.
+type Batch struct {
+	client   Client
+	opts     BatchOptions
+	calls    []func(ctx context.Context)
+	executed bool
+}
+
+// NewBatch creates a new, empty batch that issues its calls through the given
+// client.
+func NewBatch(client Client, opts BatchOptions) *Batch {
+	return &Batch{client: client, opts: opts}
+}
+
+// BatchResult is the handle to the result of a single call in a Batch.
+type BatchResult[T any] struct {
+	done  bool
+	value T
+	err   error
+}
+
+// Get returns the result of the call. If the batch has not been executed yet,
+// an error is returned.
+func (r *BatchResult[T]) Get() (T, error) {
+	if !r.done {
+		var zero T
+		return zero, errors.New("batch has not been executed")
+	}
+	return r.value, r.err
+}
+
+func (r *BatchResult[T]) set(value T, err error) {
+	r.value, r.err, r.done = value, err, true
+}
+
+// GetCommit queues a call to Client.GetCommit.
+func (b *Batch) GetCommit(repo api.RepoName, id api.CommitID) *BatchResult[*gitdomain.Commit] {
+	return addBatchCall(b, func(ctx context.Context) (*gitdomain.Commit, error) {
+		return b.client.GetCommit(ctx, repo, id)
+	})
+}
+
+// Stat queues a call to Client.Stat.
+func (b *Batch) Stat(repo api.RepoName, commit api.CommitID, path string) *BatchResult[fs.FileInfo] {
+	return addBatchCall(b, func(ctx context.Context) (fs.FileInfo, error) {
+		return b.client.Stat(ctx, repo, commit, path)
+	})
+}
+
+// ResolveRevision queues a call to Client.ResolveRevision.
+func (b *Batch) ResolveRevision(repo api.RepoName, spec string, opt ResolveRevisionOptions) *BatchResult[api.CommitID] {
+	return addBatchCall(b, func(ctx context.Context) (api.CommitID, error) {
+		return b.client.ResolveRevision(ctx, repo, spec, opt)
+	})
+}
+
+// Len returns the number of calls queued in the batch.
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+// Execute runs all queued calls and blocks until all of them have finished or
+// the deadline is hit. The error of an individual call does not cause the
+// other calls to be canceled, it is only recorded in that call's BatchResult.
+//
+// The returned error is only non-nil if the batch could not be executed at
+// all, use the individual results to check for errors of calls.
+func (b *Batch) Execute(ctx context.Context) error {
+	if b.executed {
+		return errors.New("batch has already been executed")
+	}
+	b.executed = true
+
+	if b.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, b.opts.Timeout)
+		defer cancel()
+	}
+
+	maxConcurrency := b.opts.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultBatchMaxConcurrency
+	}
+
+	p := pool.New().WithMaxGoroutines(maxConcurrency)
+	for _, call := range b.calls {
+		call := call // capture call
+		p.Go(func() {
+			call(ctx)
+		})
+	}
+	p.Wait()
+
+	return nil
+}
+
+// addBatchCall is a helper to register a call in the batch. It is a function
+// rather than a method, because methods cannot have type parameters.
+func addBatchCall[T any](b *Batch, fn func(ctx context.Context) (T, error)) *BatchResult[T] {
+	r := &BatchResult[T]{}
+	b.calls = append(b.calls, func(ctx context.Context) {
+		// Don't start calls once the shared deadline has been hit.
+		if err := ctx.Err(); err != nil {
+			var zero T
+			r.set(zero, err)
+			return
+		}
+		r.set(fn(ctx))
+	})
+	return r
+}
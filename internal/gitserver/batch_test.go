@@ -0,0 +1,124 @@
+package gitserver
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestBatch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns partial results", func(t *testing.T) {
+		c := NewMockClient()
+		c.GetCommitFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, id api.CommitID) (*gitdomain.Commit, error) {
+			if id == "missing" {
+				return nil, &gitdomain.RevisionNotFoundError{Repo: "repo", Spec: string(id)}
+			}
+			return &gitdomain.Commit{ID: id}, nil
+		})
+		c.StatFunc.SetDefaultReturn(nil, &os.PathError{Op: "open", Path: "file", Err: os.ErrNotExist})
+		c.ResolveRevisionFunc.SetDefaultReturn("deadbeef", nil)
+
+		b := NewBatch(c, BatchOptions{})
+		commit := b.GetCommit("repo", "abc")
+		missing := b.GetCommit("repo", "missing")
+		stat := b.Stat("repo", "abc", "file")
+		rev := b.ResolveRevision("repo", "HEAD", ResolveRevisionOptions{})
+		require.Equal(t, 4, b.Len())
+
+		require.NoError(t, b.Execute(ctx))
+
+		gotCommit, err := commit.Get()
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("abc"), gotCommit.ID)
+
+		_, err = missing.Get()
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+
+		_, err = stat.Get()
+		require.True(t, os.IsNotExist(err))
+
+		gotRev, err := rev.Get()
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("deadbeef"), gotRev)
+	})
+
+	t.Run("results are not available before execution", func(t *testing.T) {
+		b := NewBatch(NewMockClient(), BatchOptions{})
+		r := b.ResolveRevision("repo", "HEAD", ResolveRevisionOptions{})
+		_, err := r.Get()
+		require.Error(t, err)
+
+		require.NoError(t, b.Execute(ctx))
+		_, err = r.Get()
+		require.NoError(t, err)
+
+		require.Error(t, b.Execute(ctx), "batch can only be executed once")
+	})
+
+	t.Run("bounded concurrency", func(t *testing.T) {
+		var inFlight, maxInFlight atomic.Int32
+		c := NewMockClient()
+		c.StatFunc.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return nil, nil
+		})
+
+		b := NewBatch(c, BatchOptions{MaxConcurrency: 2})
+		for range 10 {
+			b.Stat("repo", "abc", "file")
+		}
+		require.NoError(t, b.Execute(ctx))
+		require.LessOrEqual(t, maxInFlight.Load(), int32(2))
+		require.Len(t, c.StatFunc.History(), 10)
+	})
+
+	t.Run("shared deadline", func(t *testing.T) {
+		c := NewMockClient()
+		c.ResolveRevisionFunc.SetDefaultHook(func(ctx context.Context, _ api.RepoName, spec string, _ ResolveRevisionOptions) (api.CommitID, error) {
+			if spec == "slow" {
+				<-ctx.Done()
+				return "", ctx.Err()
+			}
+			return "deadbeef", nil
+		})
+
+		b := NewBatch(c, BatchOptions{Timeout: 10 * time.Millisecond, MaxConcurrency: 1})
+		fast := b.ResolveRevision("repo", "fast", ResolveRevisionOptions{})
+		slow := b.ResolveRevision("repo", "slow", ResolveRevisionOptions{})
+		notStarted := b.ResolveRevision("repo", "fast", ResolveRevisionOptions{})
+
+		require.NoError(t, b.Execute(ctx))
+
+		rev, err := fast.Get()
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("deadbeef"), rev)
+
+		_, err = slow.Get()
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		_, err = notStarted.Get()
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// The last call was never issued to gitserver.
+		require.Len(t, c.ResolveRevisionFunc.History(), 2)
+	})
+}
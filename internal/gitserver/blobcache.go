@@ -0,0 +1,203 @@
+package gitserver
+
+import (
+	"context"
+	"io"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// maxCachedBlobSize is the size up to which blobs are cached. Larger files
+// are rarely read repeatedly, and would evict many small ones.
+const maxCachedBlobSize = 128 * 1024
+
+var blobCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_client_blob_cache_requests_total",
+	Help: "Number of cacheable file reads handled by the gitserver client blob cache, by result.",
+}, []string{"result"})
+
+// WithBlobCache returns a new client that keeps the contents of up to size
+// small files in memory. Only reads at an absolute commit SHA are cached,
+// because their content never changes. The cache is bypassed while sub-repo
+// permissions are enabled, as the permissions are checked by gitserver.
+//
+// Resumed, conditional and checksummed reads are never served from the cache,
+// as their responses depend on more than the file.
+func (c *clientImplementor) WithBlobCache(size int) Client {
+	if size <= 0 {
+		return c
+	}
+	cache, err := lru.New[blobCacheKey, cachedBlob](size)
+	if err != nil {
+		// Can only happen for a non-positive size.
+		return c
+	}
+	return &clientImplementor{
+		logger:              c.logger,
+		scope:               c.scope,
+		operations:          c.operations,
+		subRepoPermsChecker: c.subRepoPermsChecker,
+		clientSource: &blobCacheClientSource{
+			ClientSource: c.clientSource,
+			checker:      c.subRepoPermsChecker,
+			cache:        cache,
+		},
+	}
+}
+
+type blobCacheKey struct {
+	repo   string
+	commit string
+	path   string
+}
+
+// cachedBlob is a cached file, along with the object ID of its blob that was
+// returned on the first response.
+type cachedBlob struct {
+	data []byte
+	oid  string
+}
+
+// blobCacheClientSource wraps the clients returned by a ClientSource, so that
+// they share a blob cache.
+type blobCacheClientSource struct {
+	ClientSource
+	checker authz.SubRepoPermissionChecker
+	cache   *lru.Cache[blobCacheKey, cachedBlob]
+}
+
+func (s *blobCacheClientSource) ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error) {
+	client, err := s.ClientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	return &blobCacheClient{GitserverServiceClient: client, source: s}, nil
+}
+
+// blobCacheClient serves ReadFile requests from the blob cache, and fills it
+// with the responses of successful reads. All other RPCs are passed through
+// unchanged.
+type blobCacheClient struct {
+	proto.GitserverServiceClient
+	source *blobCacheClientSource
+}
+
+func (c *blobCacheClient) ReadFile(ctx context.Context, in *proto.ReadFileRequest, opts ...grpc.CallOption) (proto.GitserverService_ReadFileClient, error) {
+	if !gitdomain.IsAbsoluteRevision(in.GetCommit()) || authz.SubRepoEnabled(c.source.checker) || !isPlainReadFileRequest(in) {
+		return c.GitserverServiceClient.ReadFile(ctx, in, opts...)
+	}
+
+	key := blobCacheKey{repo: in.GetRepoName(), commit: in.GetCommit(), path: in.GetPath()}
+	if blob, ok := c.source.cache.Get(key); ok {
+		blobCacheRequests.WithLabelValues("hit").Inc()
+		return &cachedReadFileClient{ctx: ctx, blob: blob}, nil
+	}
+	blobCacheRequests.WithLabelValues("miss").Inc()
+
+	cli, err := c.GitserverServiceClient.ReadFile(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &cachingReadFileClient{
+		GitserverService_ReadFileClient: cli,
+		store: func(blob cachedBlob) {
+			c.source.cache.Add(key, blob)
+		},
+	}, nil
+}
+
+// isPlainReadFileRequest returns true if the request reads a whole file
+// without conditions, so that the responses only depend on the file.
+func isPlainReadFileRequest(in *proto.ReadFileRequest) bool {
+	return in.GetOffset() == 0 && in.GetIfNotOid() == "" && !in.GetIncludeSha256()
+}
+
+// cachingReadFileClient buffers the content of a file while it is read, and
+// stores it once the stream completed, unless the file is too large.
+type cachingReadFileClient struct {
+	proto.GitserverService_ReadFileClient
+	store func(cachedBlob)
+	buf   []byte
+	oid   string
+	recvd bool
+	done  bool
+}
+
+func (c *cachingReadFileClient) Recv() (*proto.ReadFileResponse, error) {
+	resp, err := c.GitserverService_ReadFileClient.Recv()
+	if c.done {
+		return resp, err
+	}
+	if err != nil {
+		c.done = true
+		if err == io.EOF {
+			c.store(cachedBlob{data: c.buf, oid: c.oid})
+		}
+		c.buf = nil
+		return resp, err
+	}
+	if !c.recvd {
+		c.recvd = true
+		c.oid = resp.GetBlobOid()
+	}
+	if len(c.buf)+len(resp.GetData()) > maxCachedBlobSize {
+		c.done = true
+		c.buf = nil
+	} else {
+		c.buf = append(c.buf, resp.GetData()...)
+	}
+	return resp, nil
+}
+
+// cachedReadFileClient replays a cached file as a single message.
+type cachedReadFileClient struct {
+	ctx  context.Context
+	blob cachedBlob
+	sent bool
+}
+
+var _ proto.GitserverService_ReadFileClient = &cachedReadFileClient{}
+
+func (c *cachedReadFileClient) Recv() (*proto.ReadFileResponse, error) {
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	if c.sent {
+		return nil, io.EOF
+	}
+	c.sent = true
+	return &proto.ReadFileResponse{Data: c.blob.data, BlobOid: c.blob.oid}, nil
+}
+
+func (c *cachedReadFileClient) RecvMsg(m any) error {
+	resp, err := c.Recv()
+	if err != nil {
+		return err
+	}
+	msg, ok := m.(protobuf.Message)
+	if !ok {
+		return errors.Newf("unexpected message type %T", m)
+	}
+	protobuf.Merge(msg, resp)
+	return nil
+}
+
+func (c *cachedReadFileClient) SendMsg(any) error {
+	return errors.New("cannot send on a cached ReadFile stream")
+}
+
+func (c *cachedReadFileClient) Header() (metadata.MD, error) { return metadata.MD{}, nil }
+func (c *cachedReadFileClient) Trailer() metadata.MD         { return metadata.MD{} }
+func (c *cachedReadFileClient) CloseSend() error             { return nil }
+func (c *cachedReadFileClient) Context() context.Context     { return c.ctx }
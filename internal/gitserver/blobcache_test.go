@@ -0,0 +1,167 @@
+package gitserver
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+// blobGitserver serves the content of every file as chunks of at most 64KiB
+// and counts the ReadFile calls. Every file has the blob OID blobOID.
+type blobGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+	content string
+	calls   atomic.Int32
+}
+
+const blobOID = "0123456789abcdef0123456789abcdef01234567"
+
+func (s *blobGitserver) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverService_ReadFileServer) error {
+	s.calls.Add(1)
+	if req.GetIfNotOid() == blobOID {
+		return ss.Send(&proto.ReadFileResponse{BlobOid: blobOID, NotModified: true})
+	}
+	content := s.content
+	first := true
+	for len(content) > 0 {
+		n := min(len(content), 64*1024)
+		resp := &proto.ReadFileResponse{Data: []byte(content[:n])}
+		if first {
+			resp.BlobOid = blobOID
+			first = false
+		}
+		if err := ss.Send(resp); err != nil {
+			return err
+		}
+		content = content[n:]
+	}
+	return nil
+}
+
+func TestClient_WithBlobCache(t *testing.T) {
+	const commit = api.CommitID("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+
+	readFile := func(t *testing.T, c Client, commit api.CommitID, name string) string {
+		r, err := c.NewFileReader(context.Background(), "repo", commit, name)
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		return string(content)
+	}
+
+	t.Run("small files are cached", func(t *testing.T) {
+		s := &blobGitserver{content: "content"}
+		c := newGRPCTestClient(t, s).WithBlobCache(10)
+
+		require.Equal(t, "content", readFile(t, c, commit, "file"))
+		require.Equal(t, "content", readFile(t, c, commit, "file"))
+		require.Equal(t, int32(1), s.calls.Load())
+
+		// Other paths are separate entries.
+		require.Equal(t, "content", readFile(t, c, commit, "other"))
+		require.Equal(t, int32(2), s.calls.Load())
+	})
+
+	t.Run("the blob OID is replayed", func(t *testing.T) {
+		s := &blobGitserver{content: "content"}
+		c := newGRPCTestClient(t, s).WithBlobCache(10)
+
+		for range 2 {
+			r, err := c.NewFileReader(context.Background(), "repo", commit, "file")
+			require.NoError(t, err)
+			require.Equal(t, blobOID, r.(gitdomain.ObjectInfo).OID().String())
+			_, err = io.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+		}
+		require.Equal(t, int32(1), s.calls.Load())
+	})
+
+	t.Run("conditional reads bypass the cache", func(t *testing.T) {
+		s := &blobGitserver{content: "content"}
+		c := newGRPCTestClient(t, s).WithBlobCache(10)
+
+		readFile(t, c, commit, "file")
+		oid, err := decodeOID(blobOID)
+		require.NoError(t, err)
+		_, err = c.NewFileReaderIfNotOID(context.Background(), "repo", commit, "file", oid)
+		require.ErrorIs(t, err, ErrNotModified)
+		require.Equal(t, int32(2), s.calls.Load())
+
+		// The conditional read did not replace the cached content.
+		require.Equal(t, "content", readFile(t, c, commit, "file"))
+		require.Equal(t, int32(2), s.calls.Load())
+	})
+
+	t.Run("resumed reads bypass the cache", func(t *testing.T) {
+		s := &interruptedGitserver{content: "hello resumable world", interruptions: 1}
+		c := newGRPCTestClient(t, s).WithBlobCache(10)
+
+		r, err := c.NewFileReader(WithReadResumption(context.Background(), 1), "repo", commit, "file")
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, "hello resumable world", string(content))
+
+		// Neither the interrupted stream nor the tail read at the offset is
+		// cached, so the next read fetches the whole file again.
+		require.Equal(t, "hello resumable world", readFile(t, c, commit, "file"))
+		require.Equal(t, []int64{0, 4, 0}, s.offsets)
+	})
+
+	t.Run("relative revisions are not cached", func(t *testing.T) {
+		s := &blobGitserver{content: "content"}
+		c := newGRPCTestClient(t, s).WithBlobCache(10)
+
+		readFile(t, c, "HEAD", "file")
+		readFile(t, c, "HEAD", "file")
+		require.Equal(t, int32(2), s.calls.Load())
+	})
+
+	t.Run("large files are not cached", func(t *testing.T) {
+		s := &blobGitserver{content: strings.Repeat("a", maxCachedBlobSize+1)}
+		c := newGRPCTestClient(t, s).WithBlobCache(10)
+
+		require.Len(t, readFile(t, c, commit, "file"), maxCachedBlobSize+1)
+		readFile(t, c, commit, "file")
+		require.Equal(t, int32(2), s.calls.Load())
+	})
+
+	t.Run("partial reads are not cached", func(t *testing.T) {
+		s := &blobGitserver{content: strings.Repeat("a", 100*1024)}
+		c := newGRPCTestClient(t, s).WithBlobCache(10)
+
+		r, err := c.NewFileReader(context.Background(), "repo", commit, "file")
+		require.NoError(t, err)
+		_, err = r.Read(make([]byte, 10))
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+
+		require.Len(t, readFile(t, c, commit, "file"), 100*1024)
+		require.Equal(t, int32(2), s.calls.Load())
+	})
+
+	t.Run("sub-repo permissions bypass the cache", func(t *testing.T) {
+		checker := authz.NewMockSubRepoPermissionChecker()
+		checker.EnabledFunc.SetDefaultReturn(true)
+		checker.EnabledForRepoFunc.SetDefaultReturn(false, nil)
+
+		s := &blobGitserver{content: "content"}
+		c := newGRPCTestClient(t, s).WithChecker(checker).WithBlobCache(10)
+
+		readFile(t, c, commit, "file")
+		readFile(t, c, commit, "file")
+		require.Equal(t, int32(2), s.calls.Load())
+	})
+}
@@ -0,0 +1,193 @@
+package gitserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var (
+	circuitBreakerThreshold = env.MustGetInt("SRC_GITSERVER_CLIENT_CIRCUIT_BREAKER_THRESHOLD", 10, "Number of consecutive unavailable errors from a gitserver after which requests to it fail fast. Set to 0 to disable the circuit breaker.")
+	circuitBreakerCooldown  = env.MustGetDuration("SRC_GITSERVER_CLIENT_CIRCUIT_BREAKER_COOLDOWN", 5*time.Second, "How long requests to an unavailable gitserver fail fast before a request is let through again to probe whether it recovered.")
+
+	circuitBreakerTripped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_client_circuit_breaker_tripped_total",
+		Help: "Number of times requests to a gitserver started to fail fast because it was unavailable.",
+	}, []string{"addr"})
+)
+
+// ShardUnavailableError is returned when a request isn't sent to a gitserver
+// shard, because the recent requests to it failed with connection errors or
+// timeouts.
+type ShardUnavailableError struct {
+	Addr string
+}
+
+func (e *ShardUnavailableError) Error() string {
+	return fmt.Sprintf("gitserver %q is unavailable", e.Addr)
+}
+
+// circuitBreaker tracks the health of a single gitserver address. After
+// threshold consecutive failures it opens, and allow fails fast until the
+// cooldown passed. Then a single request is let through as a probe: if it
+// succeeds the breaker closes again, otherwise another cooldown starts.
+//
+// A nil *circuitBreaker allows all requests.
+type circuitBreaker struct {
+	addr      string
+	threshold int
+	cooldown  time.Duration
+	logger    log.Logger
+	now       func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	open     bool
+	// retryAt is the time at which the next probe may be sent while the
+	// breaker is open.
+	retryAt time.Time
+}
+
+func newCircuitBreaker(logger log.Logger, addr string, threshold int, cooldown time.Duration) *circuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+	return &circuitBreaker{
+		addr:      addr,
+		threshold: threshold,
+		cooldown:  cooldown,
+		logger:    logger.With(log.String("addr", addr)),
+		now:       time.Now,
+	}
+}
+
+// allow returns a *ShardUnavailableError if a request must not be sent to
+// the address right now.
+func (b *circuitBreaker) allow() error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return nil
+	}
+	now := b.now()
+	if now.Before(b.retryAt) {
+		return &ShardUnavailableError{Addr: b.addr}
+	}
+	// Let this request through as a probe, and keep failing fast for everyone
+	// else until it returned, or the probe was abandoned for a full cooldown.
+	b.retryAt = now.Add(b.cooldown)
+	return nil
+}
+
+// record updates the breaker with the outcome of a request.
+func (b *circuitBreaker) record(err error) {
+	if b == nil {
+		return
+	}
+
+	code := status.Code(err)
+	switch {
+	case code == codes.Canceled || errors.Is(err, context.Canceled):
+		// The caller gave up, that says nothing about the server.
+		return
+	case code == codes.Unavailable || code == codes.DeadlineExceeded || errors.Is(err, context.DeadlineExceeded):
+		b.recordFailure()
+	default:
+		// Any other response, including errors, means the server is up.
+		b.recordSuccess()
+	}
+}
+
+func (b *circuitBreaker) recordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.open {
+		b.logger.Info("gitserver recovered, closing circuit breaker")
+	}
+	b.failures = 0
+	b.open = false
+}
+
+func (b *circuitBreaker) recordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.open {
+		// The probe failed, wait for another cooldown.
+		b.retryAt = b.now().Add(b.cooldown)
+		return
+	}
+	if b.failures >= b.threshold {
+		b.logger.Warn("gitserver unavailable, opening circuit breaker", log.Int("failures", b.failures))
+		circuitBreakerTripped.WithLabelValues(b.addr).Inc()
+		b.open = true
+		b.retryAt = b.now().Add(b.cooldown)
+	}
+}
+
+// dialOptions returns the options that feed the outcome of every request on
+// a connection into the breaker. Because they are chained after the retry
+// interceptors, every attempt is recorded individually.
+func (b *circuitBreaker) dialOptions() []grpc.DialOption {
+	if b == nil {
+		return nil
+	}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(b.unaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(b.streamClientInterceptor),
+	}
+}
+
+func (b *circuitBreaker) unaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	b.record(err)
+	return err
+}
+
+func (b *circuitBreaker) streamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	cs, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		b.record(err)
+		return nil, err
+	}
+	return &circuitBreakerClientStream{ClientStream: cs, breaker: b}, nil
+}
+
+// circuitBreakerClientStream records the outcome of the first receive on a
+// stream, which is where connection errors surface for streaming RPCs.
+type circuitBreakerClientStream struct {
+	grpc.ClientStream
+	breaker  *circuitBreaker
+	recorded sync.Once
+}
+
+func (s *circuitBreakerClientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	s.recorded.Do(func() {
+		if err == io.EOF {
+			s.breaker.record(nil)
+		} else {
+			s.breaker.record(err)
+		}
+	})
+	return err
+}
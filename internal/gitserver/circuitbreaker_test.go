@@ -0,0 +1,125 @@
+package gitserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+	internalgrpc "github.com/sourcegraph/sourcegraph/internal/grpc"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	unavailable := status.New(codes.Unavailable, "connection refused").Err()
+
+	newBreaker := func(t *testing.T) (*circuitBreaker, *time.Time) {
+		b := newCircuitBreaker(logtest.Scoped(t), "gitserver-0", 3, time.Minute)
+		now := time.Now()
+		b.now = func() time.Time { return now }
+		return b, &now
+	}
+
+	t.Run("opens after threshold consecutive failures", func(t *testing.T) {
+		b, _ := newBreaker(t)
+
+		b.record(unavailable)
+		b.record(unavailable)
+		// Any response from the server resets the count.
+		b.record(status.New(codes.NotFound, "repo not found").Err())
+		b.record(unavailable)
+		b.record(unavailable)
+		require.NoError(t, b.allow())
+
+		b.record(unavailable)
+		var e *ShardUnavailableError
+		require.True(t, errors.As(b.allow(), &e))
+		require.Equal(t, "gitserver-0", e.Addr)
+	})
+
+	t.Run("canceled requests are ignored", func(t *testing.T) {
+		b, _ := newBreaker(t)
+
+		b.record(unavailable)
+		b.record(unavailable)
+		b.record(context.Canceled)
+		b.record(unavailable)
+		require.Error(t, b.allow())
+	})
+
+	t.Run("probes after cooldown", func(t *testing.T) {
+		b, now := newBreaker(t)
+		for range 3 {
+			b.record(unavailable)
+		}
+		require.Error(t, b.allow())
+
+		*now = now.Add(time.Minute)
+		// Only a single probe is let through.
+		require.NoError(t, b.allow())
+		require.Error(t, b.allow())
+
+		// The probe failed, so we wait for another cooldown.
+		b.record(unavailable)
+		*now = now.Add(30 * time.Second)
+		require.Error(t, b.allow())
+		*now = now.Add(30 * time.Second)
+		require.NoError(t, b.allow())
+
+		// The probe succeeded, so the breaker is closed again.
+		b.record(nil)
+		require.NoError(t, b.allow())
+		require.NoError(t, b.allow())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		b := newCircuitBreaker(logtest.Scoped(t), "gitserver-0", 0, time.Minute)
+		require.Nil(t, b)
+		require.Empty(t, b.dialOptions())
+		b.record(unavailable)
+		require.NoError(t, b.allow())
+	})
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	oldThreshold, oldCooldown := circuitBreakerThreshold, circuitBreakerCooldown
+	circuitBreakerThreshold, circuitBreakerCooldown = 3, time.Hour
+	t.Cleanup(func() { circuitBreakerThreshold, circuitBreakerCooldown = oldThreshold, oldCooldown })
+
+	f := &flakyGitserver{failures: 1000, code: codes.Unavailable}
+	gs := grpc.NewServer()
+	proto.RegisterGitserverServiceServer(gs, f)
+	srv := httptest.NewServer(internalgrpc.MultiplexHandlers(gs, http.NotFoundHandler()))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	conf.Mock(newConfig([]string{u.Host}, nil))
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	c := NewTestClient(t).WithClientSource(&atomicGitServerConns{}).WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     func(context.Context, uint) time.Duration { return 0 },
+	})
+
+	// Every attempt, including the retries, counts as a failure.
+	_, err = c.GetCommit(context.Background(), "repo", "deadbeef")
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, int32(3), f.calls.Load())
+
+	_, err = c.GetCommit(context.Background(), "repo", "deadbeef")
+	var e *ShardUnavailableError
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, u.Host, e.Addr)
+	require.Equal(t, int32(3), f.calls.Load(), "request was sent to an unavailable gitserver")
+}
@@ -5,15 +5,16 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/status"
 
 	"github.com/sourcegraph/conc/pool"
-	"github.com/sourcegraph/go-diff/diff"
 	sglog "github.com/sourcegraph/log"
 	"github.com/sourcegraph/log/logtest"
 
@@ -54,6 +55,9 @@ type ClientSource interface {
 	ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error)
 	// AddrForRepo returns the address of the gitserver for the given repo.
 	AddrForRepo(ctx context.Context, repo api.RepoName) string
+	// AddressingForRepo returns how the address of the gitserver for the
+	// given repo is picked.
+	AddressingForRepo(ctx context.Context, repo api.RepoName) RepoAddressing
 	// Address the current list of gitserver addresses.
 	Addresses() []AddressWithClient
 	// GetAddressWithClient returns the address and client for a gitserver instance.
@@ -109,36 +113,18 @@ func NewMockClientWithExecReader(checker authz.SubRepoPermissionChecker, execRea
 	client := NewMockClient()
 	// NOTE: This hook is the same as DiffFunc, but with `execReader` used above
 	client.DiffFunc.SetDefaultHook(func(ctx context.Context, opts DiffOptions) (*DiffFileIterator, error) {
-		if opts.Base == DevNullSHA {
-			opts.RangeType = ".."
-		} else if opts.RangeType != ".." {
-			opts.RangeType = "..."
-		}
-
-		rangeSpec := opts.Base + opts.RangeType + opts.Head
-		if strings.HasPrefix(rangeSpec, "-") || strings.HasPrefix(rangeSpec, ".") {
-			return nil, errors.Errorf("invalid diff range argument: %q", rangeSpec)
+		args, err := buildDiffArgs(opts)
+		if err != nil {
+			return nil, err
 		}
 
 		// Here is where all the mocking happens!
-		rdr, err := execReader(ctx, opts.Repo, append([]string{
-			"diff",
-			"--find-renames",
-			"--full-index",
-			"--inter-hunk-context=3",
-			"--no-prefix",
-			rangeSpec,
-			"--",
-		}, opts.Paths...))
+		rdr, err := execReader(ctx, opts.Repo, args)
 		if err != nil {
 			return nil, errors.Wrap(err, "executing git diff")
 		}
 
-		return &DiffFileIterator{
-			rdr:            rdr,
-			mfdr:           diff.NewMultiFileDiffReader(rdr),
-			fileFilterFunc: getFilterFunc(ctx, checker, opts.Repo),
-		}, nil
+		return newDiffFileIterator(ctx, rdr, checker, opts), nil
 	})
 
 	return client
@@ -172,6 +158,10 @@ func (c *clientImplementor) Scoped(scope string) Client {
 	}
 }
 
+func (c *clientImplementor) ScopedToPath(repo api.RepoName, pathPrefix string) (*PathScopedClient, error) {
+	return newPathScopedClient(c, repo, pathPrefix)
+}
+
 func appendScope(existing, new string) string {
 	if existing == "" {
 		return new
@@ -184,17 +174,83 @@ type HunkReader interface {
 	Close() error
 }
 
+// CommitGraphNodeReader is a reader for the nodes of a commit graph.
+type CommitGraphNodeReader interface {
+	// Read returns the next node. io.EOF is returned at the end of the graph.
+	Read() (*gitdomain.CommitGraphNode, error)
+	Close() error
+}
+
+// CloneProgressReader is a reader for the clone progress updates of a
+// repository.
+type CloneProgressReader interface {
+	// Read blocks until the next update. io.EOF is returned after the update
+	// which reports that the clone ended.
+	Read() (*protocol.CloneProgressUpdate, error)
+	Close() error
+}
+
+// RefWatcher is a reader for the ref moves of a repository.
+type RefWatcher interface {
+	// Read blocks until refs move and returns them. The first call returns
+	// right away, with only the current state token.
+	Read() (gitdomain.RefChanges, error)
+	Close() error
+}
+
+// BranchCommitWatcher is a reader for the commits that land on a branch.
+type BranchCommitWatcher interface {
+	// Read blocks until the branch moves and returns the commits that landed
+	// on it. The first call returns the current head of the branch, without
+	// commits, as soon as the branch has one.
+	Read() (gitdomain.BranchCommits, error)
+	Close() error
+}
+
+// MaintenanceOptions selects the maintenance tasks OptimizeRepo runs.
+type MaintenanceOptions struct {
+	// Gc runs git gc.
+	Gc bool
+	// Repack repacks all objects into a single pack with a bitmap index.
+	Repack bool
+	// CommitGraph writes the commit-graph, including changed-path Bloom
+	// filters.
+	CommitGraph bool
+}
+
+// MaintenanceTaskResult reports a maintenance task run by OptimizeRepo.
+type MaintenanceTaskResult struct {
+	// Task is the name of the task, one of "gc", "repack" and "commit-graph".
+	Task string
+	// Duration is how long the task ran.
+	Duration time.Duration
+}
+
+// StreamCommitGraphOptions configures StreamCommitGraph.
+type StreamCommitGraphOptions struct {
+	// Head is the revspec whose history is listed.
+	Head string
+	// Base is optional. If set, commits reachable from it are not listed.
+	Base string
+	// MaxCommits limits the number of commits returned, if non-zero.
+	MaxCommits int
+}
+
 // BlameOptions configures a blame.
 type BlameOptions struct {
 	NewestCommit     api.CommitID `json:",omitempty" url:",omitempty"`
 	IgnoreWhitespace bool         `json:",omitempty" url:",omitempty"`
 	Range            *BlameRange  `json:",omitempty" url:",omitempty"`
+	// CopyDetection makes blame attribute moved or copied lines to the commit
+	// that originally introduced them.
+	CopyDetection BlameCopyDetection `json:",omitempty" url:",omitempty"`
 }
 
 func (o *BlameOptions) Attrs() []attribute.KeyValue {
 	kvs := []attribute.KeyValue{
 		attribute.String("newestCommit", string(o.NewestCommit)),
 		attribute.Bool("ignoreWhitespace", o.IgnoreWhitespace),
+		attribute.Int("copyDetection", int(o.CopyDetection)),
 	}
 	if o.Range != nil {
 		kvs = append(kvs, o.Range.Attrs()...)
@@ -207,6 +263,27 @@ type BlameRange struct {
 	EndLine   int `json:",omitempty" url:",omitempty"` // 1-indexed end line (or 0 for end of file)
 }
 
+// BlameCopyDetection is the level of move and copy detection used by blame.
+// Higher levels are more accurate, but also more expensive.
+type BlameCopyDetection int
+
+const (
+	// BlameCopyDetectionNone disables move and copy detection.
+	BlameCopyDetectionNone BlameCopyDetection = iota
+	// BlameCopyDetectionMoves detects lines moved or copied within the file
+	// (git blame -M).
+	BlameCopyDetectionMoves
+	// BlameCopyDetectionCopies additionally detects lines moved or copied from
+	// other files that were modified in the same commit (git blame -C).
+	BlameCopyDetectionCopies
+	// BlameCopyDetectionCopiesFromCreation additionally looks for copies in the
+	// commit that created the file (git blame -C -C).
+	BlameCopyDetectionCopiesFromCreation
+	// BlameCopyDetectionCopiesFromAnyCommit additionally looks for copies in
+	// any commit (git blame -C -C -C).
+	BlameCopyDetectionCopiesFromAnyCommit
+)
+
 func (o *BlameRange) Attrs() []attribute.KeyValue {
 	return []attribute.KeyValue{
 		attribute.Int("startLine", o.StartLine),
@@ -235,11 +312,33 @@ type ListRefsOpts struct {
 	Contains api.CommitID
 }
 
+// RefsChangedSinceOptions selects the state RefsChangedSince compares the
+// refs of a repo to. At most one of the fields may be set.
+type RefsChangedSinceOptions struct {
+	// StateToken is the StateToken returned by a previous call.
+	StateToken string
+	// Since only returns refs that moved after the given time.
+	Since time.Time
+}
+
 // ArchiveOptions contains options for the Archive func.
 type ArchiveOptions struct {
 	Treeish string        // the tree or commit to produce an archive for
 	Format  ArchiveFormat // format of the resulting archive (usually "tar" or "zip")
 	Paths   []string      // if nonempty, only include these paths.
+	// Offset is the number of bytes at the start of the archive to skip. To
+	// resume an interrupted download, request the same archive again with
+	// the number of bytes already read. Treeish must be an absolute commit or
+	// tree ID if Offset is set, so that the archive doesn't change between
+	// the requests.
+	Offset int64
+	// Filter is a filter spec like "blob:limit=1m" that leaves the matching
+	// blobs out of the archive, so that repos with large assets can be
+	// fetched without downloading them. The omitted files are listed in a
+	// manifest file at gitdomain.OmittedBlobsManifestPath, which is only
+	// added to the archive if Filter is set. See gitdomain.ParseBlobFilter
+	// for the supported filters.
+	Filter string
 }
 
 func (a *ArchiveOptions) Attrs() []attribute.KeyValue {
@@ -251,6 +350,8 @@ func (a *ArchiveOptions) Attrs() []attribute.KeyValue {
 		attribute.String("treeish", a.Treeish),
 		attribute.String("format", string(a.Format)),
 		attribute.StringSlice("paths", pathAttrs),
+		attribute.Int64("offset", a.Offset),
+		attribute.String("filter", a.Filter),
 	}
 }
 
@@ -259,6 +360,8 @@ func (o *ArchiveOptions) FromProto(x *proto.ArchiveRequest) {
 		Treeish: x.GetTreeish(),
 		Format:  ArchiveFormatFromProto(x.GetFormat()),
 		Paths:   x.GetPaths(),
+		Offset:  x.GetOffset(),
+		Filter:  x.GetFilter(),
 	}
 }
 
@@ -268,6 +371,8 @@ func (o *ArchiveOptions) ToProto(repo string) *proto.ArchiveRequest {
 		Treeish: o.Treeish,
 		Format:  o.Format.ToProto(),
 		Paths:   o.Paths,
+		Offset:  o.Offset,
+		Filter:  o.Filter,
 	}
 }
 
@@ -284,13 +389,71 @@ type Client interface {
 	// We use scopes to add context to logs and metrics.
 	Scoped(scope string) Client
 
+	// ScopedToPath returns a view of repo that only exposes the files below
+	// pathPrefix, for features that must not reveal the rest of a monorepo.
+	ScopedToPath(repo api.RepoName, pathPrefix string) (*PathScopedClient, error)
+
+	// WithRetryPolicy returns a new client that retries idempotent read RPCs
+	// according to the given policy, instead of the default one.
+	WithRetryPolicy(policy RetryPolicy) Client
+
+	// WithHedging returns a new client that hedges small idempotent RPCs
+	// according to the given policy.
+	WithHedging(policy HedgingPolicy) Client
+
+	// WithCompression returns a new client that compresses large streams with
+	// the given gRPC compressor, where the gitserver supports it.
+	WithCompression(compressor string) Client
+
+	// WithMetrics returns a new client that records Prometheus metrics for
+	// every gitserver RPC in the given registerer.
+	WithMetrics(registerer prometheus.Registerer) Client
+
+	// WithSlowLogging returns a new client that logs every gitserver RPC that
+	// takes longer than threshold.
+	WithSlowLogging(threshold time.Duration) Client
+
+	// WithAuditHook returns a new client that reports the actor, repository,
+	// operation and size of the response of every gitserver RPC to hook.
+	WithAuditHook(hook AuditHook) Client
+
+	// WithRepoConcurrencyLimit returns a new client that limits the number of
+	// concurrent expensive operations per repository.
+	WithRepoConcurrencyLimit(limit int) Client
+
+	// WithActorRateLimit returns a new client that enforces per-actor request
+	// and bandwidth budgets for expensive operations.
+	WithActorRateLimit(limits ActorRateLimits) Client
+
+	// WithBlobCache returns a new client that caches the contents of up to
+	// size small files read at an absolute commit SHA.
+	WithBlobCache(size int) Client
+
+	// WithMergeBaseCache returns a new client that caches up to size merge
+	// bases of two absolute commit SHAs.
+	WithMergeBaseCache(size int) Client
+
+	// WithSubRepoPermsCache returns a new client that caches sub-repo
+	// permission decisions for ttl.
+	WithSubRepoPermsCache(ttl time.Duration) Client
+
+	// WithPermissionTrace returns a new client that records the sub-repo
+	// permission decisions it makes into trace, for debugging.
+	WithPermissionTrace(trace *PermissionTrace) Client
+
 	// AddrForRepo returns the gitserver address to use for the given repo name.
 	AddrForRepo(ctx context.Context, repoName api.RepoName) string
 
+	// DiagnoseAddrForRepo explains AddrForRepo for debugging: it returns the
+	// address the repo hashes to, the address it is pinned to, if any, and
+	// whether the repo is cloned on the address it is served from.
+	DiagnoseAddrForRepo(ctx context.Context, repo api.RepoName) (*RepoAddrDiagnostics, error)
+
 	// ArchiveReader streams back the file contents of an archived git repo.
 	ArchiveReader(ctx context.Context, repo api.RepoName, options ArchiveOptions) (io.ReadCloser, error)
 
 	// StreamBlameFile returns Git blame information about a file in a streaming fashion.
+	// If sub-repo permissions deny access to the file, os.ErrNotExist is returned.
 	StreamBlameFile(ctx context.Context, repo api.RepoName, path string, opt *BlameOptions) (HunkReader, error)
 
 	// CreateCommitFromPatch will attempt to create a commit from a patch
@@ -309,7 +472,7 @@ type Client interface {
 	GetObject(ctx context.Context, repo api.RepoName, objectName string) (*gitdomain.GitObject, error)
 
 	// HasCommitAfter indicates the staleness of a repository. It returns a boolean indicating if a repository
-	// contains a commit past a specified date.
+	// contains a commit past a specified date, which is parsed with ParseCommitDate.
 	HasCommitAfter(ctx context.Context, repo api.RepoName, date string, revspec string) (bool, error)
 
 	// IsRepoCloneable returns nil if the repository is cloneable.
@@ -318,14 +481,128 @@ type Client interface {
 	// ListRefs returns a list of all refs in the repository.
 	ListRefs(ctx context.Context, repo api.RepoName, opt ListRefsOpts) ([]gitdomain.Ref, error)
 
+	// RefsChangedSince returns the refs whose targets moved since the state
+	// identified by opts.StateToken or since opts.Since, including deleted
+	// refs, and a token for the current state. This allows callers that poll
+	// a repo to avoid listing all of its refs every time.
+	//
+	// If neither option is set, or the changes can't be computed, for example
+	// because the state is too old, all refs are returned and Full is set.
+	RefsChangedSince(ctx context.Context, repo api.RepoName, opts RefsChangedSinceOptions) (gitdomain.RefChanges, error)
+
+	// WatchRefs streams the refs of repo that move, for example when
+	// gitserver fetches the repo, until the watcher is closed. The state
+	// tokens of the updates can be passed to RefsChangedSince to catch up on
+	// the moves missed while not watching. The watcher must be closed when no
+	// longer required.
+	WatchRefs(ctx context.Context, repo api.RepoName) (RefWatcher, error)
+
+	// WatchDefaultBranchCommits streams the commits that land on the default
+	// branch of repo, for example when gitserver fetches the repo, until the
+	// watcher is closed. This allows callers to extend what they derived from
+	// the history incrementally instead of walking it again. The watcher must
+	// be closed when no longer required.
+	WatchDefaultBranchCommits(ctx context.Context, repo api.RepoName) (BranchCommitWatcher, error)
+
 	// MergeBase returns the merge base commit sha for the specified revspecs.
 	MergeBase(ctx context.Context, repo api.RepoName, base, head string) (api.CommitID, error)
 
+	// MergeBaseCrossRepo returns the merge base of baseRev in baseRepo and
+	// headRev in headRepo, for repos that share history, like the repos of a
+	// fork network. The base commit is fetched into headRepo on demand, and
+	// the merge base is looked up in headRepo. If the commits have no common
+	// history, an empty commit ID is returned.
+	//
+	// Error cases:
+	// * A revision does not exist: gitdomain.RevisionNotFoundError
+	// * Sub-repo permissions are enabled for baseRepo: an Unimplemented gRPC error
+	MergeBaseCrossRepo(ctx context.Context, baseRepo api.RepoName, baseRev string, headRepo api.RepoName, headRev string) (api.CommitID, error)
+
+	// Cherry returns the commits reachable from head but not from upstream,
+	// oldest first, and whether a commit with the same patch ID exists in
+	// upstream, as done by `git cherry upstream head`. This finds the commits
+	// of head that were already cherry-picked or rebased onto upstream.
+	//
+	// Error cases:
+	// - If one of the revspecs does not exist, a RevisionNotFoundError is
+	//   returned.
+	// - If sub-repo permissions are enabled for the repo, an error is returned,
+	//   as patch IDs depend on the contents of all files.
+	Cherry(ctx context.Context, repo api.RepoName, upstream, head string) ([]gitdomain.CherryCommit, error)
+
+	// CherryCrossRepo is like Cherry, but upstream is a revision of
+	// upstreamRepo instead of headRepo, for repos of the same fork network.
+	// This finds the commits of a branch that were already ported to a
+	// branch of another repo, like fixes that were backported. The upstream
+	// commit is fetched into headRepo on demand.
+	//
+	// Error cases:
+	// - If one of the revspecs does not exist, a RevisionNotFoundError is
+	//   returned.
+	// - If sub-repo permissions are enabled for one of the repos, an error is
+	//   returned.
+	CherryCrossRepo(ctx context.Context, upstreamRepo api.RepoName, upstream string, headRepo api.RepoName, head string) ([]gitdomain.CherryCommit, error)
+
+	// RangeDiff compares two versions of a commit series, as done by
+	// `git range-diff range1 range2`. Both ranges must be of the form
+	// "base..head". Every commit is paired with its counterpart in the other
+	// series, or reported as removed or added if it has none.
+	//
+	// Error cases:
+	// - If one of the ranges is not of the form "base..head", an error is
+	//   returned.
+	// - If one of the revspecs does not exist, a RevisionNotFoundError is
+	//   returned.
+	// - If sub-repo permissions are enabled for the repo, an error is returned,
+	//   as the pairs contain the diffs of all files.
+	RangeDiff(ctx context.Context, repo api.RepoName, range1, range2 string) ([]*gitdomain.RangeDiffPair, error)
+
 	// Remove removes the repository clone from gitserver.
 	Remove(context.Context, api.RepoName) error
 
+	// OptimizeRepo runs the maintenance tasks selected in opts on repo, in the
+	// order gc, repack, commit-graph, and returns once all of them completed.
+	// The tasks can take a long time for large repositories, so ctx should
+	// allow for that.
+	//
+	// Error cases:
+	// - If no task is selected, an error is returned.
+	// - If the repo is not cloned, a RepoNotExistError is returned.
+	// - If maintenance is already running on the repo, an error with code
+	//   Aborted is returned.
+	OptimizeRepo(ctx context.Context, repo api.RepoName, opts MaintenanceOptions) ([]MaintenanceTaskResult, error)
+
+	// CommitGraphStatus returns whether repo has a commit-graph and which
+	// optional data it stores. Callers can use it to pick an algorithm that
+	// relies on fast history walks only when git can do them.
+	//
+	// Error cases:
+	// - If the repo is not cloned, a RepoNotExistError is returned.
+	CommitGraphStatus(ctx context.Context, repo api.RepoName) (gitdomain.CommitGraphStatus, error)
+
+	// CloneState returns whether repo is a shallow or partial clone, and with
+	// which depth or object filter. History and content features can use it
+	// to explain missing data instead of failing unexpectedly.
+	//
+	// Error cases:
+	// - If the repo is not cloned, a RepoNotExistError is returned.
+	CloneState(ctx context.Context, repo api.RepoName) (gitdomain.CloneState, error)
+
+	// WriteCommitGraph (re)writes the commit-graph of repo, including
+	// generation numbers and changed-path Bloom filters, and returns once it
+	// is written. It is a shorthand for OptimizeRepo with only CommitGraph
+	// set, and has the same error cases.
+	WriteCommitGraph(ctx context.Context, repo api.RepoName) error
+
 	RepoCloneProgress(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error)
 
+	// CloneProgress streams the clone progress of repo, with the phase and
+	// percentage of git's progress output, until the clone ended. The first
+	// update is returned right away, further ones only when the progress
+	// changed. If the repo isn't being cloned, there is only one update.
+	// The reader must be closed when no longer required.
+	CloneProgress(ctx context.Context, repo api.RepoName) (CloneProgressReader, error)
+
 	// ResolveRevision will return the absolute commit for a commit-ish spec. If spec is empty, HEAD is
 	// used.
 	//
@@ -333,9 +610,21 @@ type Client interface {
 	// * Repo does not exist: gitdomain.RepoNotExistError
 	// * Commit does not exist: gitdomain.RevisionNotFoundError
 	// * Empty repository: gitdomain.RevisionNotFoundError
+	// * Short commit ID matches several objects: gitdomain.AmbiguousRevisionError,
+	//   which lists the candidates
 	// * Other unexpected errors.
 	ResolveRevision(ctx context.Context, repo api.RepoName, spec string, opt ResolveRevisionOptions) (api.CommitID, error)
 
+	// EnsureRevision resolves rev like ResolveRevision, fetching it if it's
+	// missing and starting a clone if the repository isn't cloned yet. If
+	// opts.Timeout is set, it keeps checking until the revision exists or the
+	// timeout passes.
+	//
+	// The result reports the progress at the last check, also if an error is
+	// returned because the revision didn't become available in time. The
+	// error cases are the same as for ResolveRevision.
+	EnsureRevision(ctx context.Context, repo api.RepoName, rev string, opts WaitOptions) (*EnsureRevisionResult, error)
+
 	// RevAtTime returns the OID of the nearest ancestor of `spec` that has a
 	// commit time before the given time. To simplify the logic, it only
 	// follows the first parent of merge commits to linearize the commit
@@ -346,6 +635,130 @@ type Client interface {
 	// is returned, but the second return value `found` will be false.
 	RevAtTime(ctx context.Context, repo api.RepoName, spec string, t time.Time) (oid api.CommitID, found bool, err error)
 
+	// UpdateRef atomically updates ref to point at newOID, but only if it
+	// currently points at expectedOldOID. This allows to safely mutate refs
+	// even if other writers may update them concurrently:
+	//
+	// * Create: pass an empty expectedOldOID, the update fails if the ref
+	//   already exists.
+	// * Fast-forward or force with lease: pass the commit the caller last saw
+	//   the ref at, the update fails if the ref has moved since.
+	//
+	// ref must be a fully qualified ref name, like refs/heads/main, and newOID
+	// a full commit SHA.
+	//
+	// Error cases:
+	// * Ref doesn't have the expected value: gitdomain.RefUpdateConflictError
+	// * newOID does not exist: gitdomain.RevisionNotFoundError
+	UpdateRef(ctx context.Context, repo api.RepoName, ref string, newOID, expectedOldOID api.CommitID) error
+
+	// CreateBranch creates a new branch with the given short name, like
+	// my-feature, pointing at startPoint. If startPoint is empty, the branch
+	// is created at HEAD. It returns the commit the new branch points at.
+	//
+	// Error cases:
+	// * Branch already exists: gitdomain.RefUpdateConflictError
+	// * startPoint does not exist: gitdomain.RevisionNotFoundError
+	CreateBranch(ctx context.Context, repo api.RepoName, branch, startPoint string) (api.CommitID, error)
+
+	// DeleteBranch deletes the branch with the given short name. The default
+	// branch of the repository cannot be deleted.
+	//
+	// Error cases:
+	// * Branch does not exist: gitdomain.RevisionNotFoundError
+	// * Branch was updated while being deleted: gitdomain.RefUpdateConflictError
+	DeleteBranch(ctx context.Context, repo api.RepoName, branch string) error
+
+	// CreateTag creates a new tag with the given short name, like v1.2.3,
+	// pointing at the commit target resolves to. See TagOptions for how to
+	// create annotated or signed tags, by default a lightweight tag is created.
+	//
+	// Error cases:
+	// * Tag already exists: gitdomain.RefUpdateConflictError
+	// * target does not exist: gitdomain.RevisionNotFoundError
+	CreateTag(ctx context.Context, repo api.RepoName, name, target string, opts TagOptions) error
+
+	// GetTag returns the tag with the given short name. For annotated tags, the
+	// tagger, message and signature of the tag object are included, which
+	// ListRefs and ListTags don't return. Lightweight tags only have a name and
+	// target.
+	//
+	// If the tag does not exist, a gitdomain.RevisionNotFoundError is
+	// returned.
+	GetTag(ctx context.Context, repo api.RepoName, name string) (*gitdomain.Tag, error)
+
+	// SymbolicRef returns the fully qualified name of the ref the symbolic ref
+	// name points to, without resolving it to a commit. name must be HEAD or a
+	// fully qualified ref name, like refs/remotes/origin/HEAD.
+	//
+	// An empty string and no error are returned if name doesn't exist or isn't
+	// a symbolic ref. The target itself doesn't have to exist: together with
+	// RefExists, this tells a branch that is missing apart from the unborn
+	// branch HEAD of an empty repository points to.
+	SymbolicRef(ctx context.Context, repo api.RepoName, name string) (string, error)
+
+	// RefExists returns true if the ref with the given fully qualified name,
+	// or HEAD, exists. A symbolic ref only exists if the ref it points to
+	// exists.
+	RefExists(ctx context.Context, repo api.RepoName, name string) (bool, error)
+
+	// ListBranches returns all branches of the repository together with the
+	// commit they point at and how many commits they are behind and ahead of
+	// the default branch, or opts.Base if set. The branches are ordered like
+	// ListRefs orders them: the default branch first, then the most recently
+	// updated ones.
+	//
+	// If the base doesn't exist, for example in a repository whose HEAD is
+	// unborn, the behind/ahead counts are nil. With sub-repo permissions, the
+	// commits the actor can't see are nil, but the branches are still listed.
+	ListBranches(ctx context.Context, repo api.RepoName, opts ListBranchesOptions) ([]*gitdomain.Branch, error)
+
+	// ListTags returns the tags of the repository, the most recently created
+	// ones first, or the highest versions first if opts.SortByVersion is set.
+	// The CommitID of annotated tags is the commit they point at, RefOID is
+	// the tag object.
+	ListTags(ctx context.Context, repo api.RepoName, opts ListTagsOptions) ([]gitdomain.Ref, error)
+
+	// ListRemoteRefs lists the refs of the upstream of the repository by
+	// running git ls-remote against the remote URL gitserver clones from,
+	// with the credentials gitserver uses. Refs that only exist upstream are
+	// included, and the repository doesn't need to be cloned. The ref HEAD
+	// points to upstream is marked with IsHead. CreatedDate isn't known.
+	//
+	// Error cases:
+	// * Repo isn't synced with git, like a Perforce depot: an Unimplemented gRPC error
+	// * Remote can't be reached or rejects the credentials: an Unavailable gRPC error
+	ListRemoteRefs(ctx context.Context, repo api.RepoName) ([]gitdomain.Ref, error)
+
+	// FetchRefspec fetches the refs matching refspec from the upstream of the
+	// repository, like +refs/pull/*/head:refs/pull/*/head for GitHub pull
+	// request refs. This allows to fetch refs on demand that are not part of
+	// the regular fetches of the repository. The refspec must be valid
+	// according to gitdomain.ValidateFetchRefspec. Refs that cannot be
+	// fast-forwarded are only updated if the refspec starts with +.
+	//
+	// Error cases:
+	// * Invalid refspec: an InvalidRefspecError
+	// * Source ref doesn't exist upstream: a RevisionNotFoundError
+	// * Ref can't be fast-forwarded: a RefUpdateConflictError
+	// * Repo isn't synced with git, like a Perforce depot: an Unimplemented gRPC error
+	// * Remote can't be reached or rejects the credentials: an Unavailable gRPC error
+	FetchRefspec(ctx context.Context, repo api.RepoName, refspec string) error
+
+	// BlameSummary returns the number of lines attributed to each author by
+	// git blame at commit, and when each author last touched any of those
+	// lines. If path is a directory, the summary is aggregated over all files
+	// below it, and an empty path summarizes the whole repository. Authors are
+	// ordered by line count, descending.
+	//
+	// Files the actor doesn't have access to are left out of the summary.
+	//
+	// Error cases:
+	// * Path does not exist or is not accessible: os.PathError with os.ErrNotExist
+	// * Commit does not exist: gitdomain.RevisionNotFoundError
+	// * Directory contains too many files to blame: a ResourceExhausted gRPC error
+	BlameSummary(ctx context.Context, repo api.RepoName, commit api.CommitID, path string) ([]*gitdomain.BlameAuthorSummary, error)
+
 	// RequestRepoUpdate is the new protocol endpoint for synchronous requests
 	// with more detailed responses. Do not use this if you are not repo-updater.
 	//
@@ -358,12 +771,64 @@ type Client interface {
 	// response.
 	Search(_ context.Context, _ *protocol.SearchRequest, onMatches func([]protocol.CommitMatch)) (limitHit bool, _ error)
 
+	// SearchCommits searches the commits of repo that match all the criteria
+	// of q, streaming the results like Search. It builds the query tree for
+	// Search from q, so simple searches don't need to assemble one by hand.
+	SearchCommits(_ context.Context, repo api.RepoName, q CommitSearchQuery, onMatches func([]protocol.CommitMatch)) (limitHit bool, _ error)
+
 	// Stat returns a FileInfo describing the named file at commit.
 	Stat(ctx context.Context, repo api.RepoName, commit api.CommitID, path string) (fs.FileInfo, error)
 
+	// PathExists reports whether path exists at commit, and if so whether it
+	// is a file (blob), a directory (tree) or a submodule (commit). It is
+	// cheaper than Stat when the FileInfo is not needed.
+	//
+	// Unlike Stat, a path that exists but that the actor can't read due to
+	// sub-repo permissions is not reported as missing: a
+	// *gitdomain.PathAccessDeniedError is returned for it instead.
+	//
+	// If the commit does not exist, a RevisionNotFoundError is returned.
+	PathExists(ctx context.Context, repo api.RepoName, commit api.CommitID, path string) (bool, gitdomain.ObjectType, error)
+
 	// ReadDir reads the contents of the named directory at commit.
 	ReadDir(ctx context.Context, repo api.RepoName, commit api.CommitID, path string, recurse bool) ([]fs.FileInfo, error)
 
+	// GetTree returns the entries of the tree at path in treeish, without
+	// descending into subtrees. Unlike ReadDir, the entries are returned as
+	// stored in the tree object, with their names relative to the tree, git
+	// file modes and object IDs. treeish can be any revspec that resolves to a
+	// commit or tree. If path is empty, the root tree is returned.
+	//
+	// Entries the actor can't read due to subrepo permissions are left out.
+	//
+	// If the treeish does not exist, a RevisionNotFoundError is returned. If
+	// path does not exist or is not a directory, an os.PathError is returned.
+	GetTree(ctx context.Context, repo api.RepoName, treeish string, path string) ([]gitdomain.TreeEntry, error)
+
+	// SparseManifest returns the files needed to materialize a sparse
+	// checkout of paths at commit: all blobs at or below one of paths, with
+	// their full paths as names, git file modes and object IDs. Paths are
+	// matched literally and paths that don't exist match nothing. If paths is
+	// empty, all files of the commit are returned. Submodules are left out.
+	//
+	// Files the actor can't read due to subrepo permissions are left out.
+	//
+	// If the commit does not exist, a RevisionNotFoundError is returned.
+	SparseManifest(ctx context.Context, repo api.RepoName, commit api.CommitID, paths []string) ([]gitdomain.TreeEntry, error)
+
+	// LastCommitsForTree returns the entries of the tree at path in commit,
+	// like GetTree, together with the last commit that changed each of them,
+	// as shown by tree views. It replaces a Commits call per entry with a
+	// single walk of the history of the directory on gitserver. Entries whose
+	// last commit is beyond the history of a shallow clone have no commit.
+	//
+	// Entries the actor can't read due to subrepo permissions are left out.
+	//
+	// If the commit does not exist, a RevisionNotFoundError is returned. If
+	// path does not exist or is not a directory, an error satisfying
+	// os.IsNotExist is returned.
+	LastCommitsForTree(ctx context.Context, repo api.RepoName, commit api.CommitID, path string) ([]gitdomain.TreeEntryCommit, error)
+
 	// NewFileReader returns an io.ReadCloser reading from the named file at commit.
 	// The caller should always close the reader after use.
 	//
@@ -377,17 +842,90 @@ type Client interface {
 	// (ie. io.EOF is returned immediately).
 	//
 	// If the specified commit does not exist, a RevisionNotFoundError is returned.
+	//
+	// The returned reader implements gitdomain.ObjectInfo: its OID is the object
+	// ID of the file's blob, which can be used as a cache key for the content.
 	NewFileReader(ctx context.Context, repo api.RepoName, commit api.CommitID, name string) (io.ReadCloser, error)
 
+	// NewFileReaderIfNotOID is like NewFileReader, but if the blob of the file
+	// still has the given object ID, for example the one of a cached copy of
+	// the file, ErrNotModified is returned instead of a reader and no content
+	// is transferred.
+	NewFileReaderIfNotOID(ctx context.Context, repo api.RepoName, commit api.CommitID, name string, oid gitdomain.OID) (io.ReadCloser, error)
+
+	// ReadBlob returns an io.ReadCloser reading the content of the blob with
+	// the given object ID, for callers that got the ID from an earlier call
+	// such as ReadDir, and don't need to resolve a commit and path again. The
+	// caller should always close the reader after use.
+	//
+	// A blob can't be checked against subrepo permissions, so for repos that
+	// have them, ReadBlob fails unless the actor is internal.
+	//
+	// If no blob with the given ID exists, a RevisionNotFoundError is returned.
+	ReadBlob(ctx context.Context, repo api.RepoName, oid gitdomain.OID) (io.ReadCloser, error)
+
 	// DiffSymbols performs a diff command which is expected to be parsed by our symbols package
 	DiffSymbols(ctx context.Context, repo api.RepoName, commitA, commitB api.CommitID) ([]byte, error)
 
 	// Commits returns all commits matching the options.
+	//
+	// Error cases:
+	// - If the history walk reached the boundary of a shallow clone, the
+	//   commits found are returned together with a ShallowHistoryError, so
+	//   callers can decide whether the truncated history is good enough.
 	Commits(ctx context.Context, repo api.RepoName, opt CommitsOptions) ([]*gitdomain.Commit, error)
 
+	// AncestryPath returns the commits connecting from and to, newest first:
+	// all commits that are descendants of from and ancestors of to. to is
+	// included in the result, from is not. If from is not an ancestor of to,
+	// the result is empty.
+	//
+	// Error cases:
+	// - If from or to does not exist, a RevisionNotFoundError is returned.
+	AncestryPath(ctx context.Context, repo api.RepoName, from, to string) ([]*gitdomain.Commit, error)
+
+	// CountCommits returns the number of commits matching the options, without
+	// returning the commits themselves. opt.N and opt.Skip are applied like
+	// they are for Commits.
+	CountCommits(ctx context.Context, repo api.RepoName, opt CommitsOptions) (int, error)
+
 	// FirstEverCommit returns the first commit ever made to the repository.
 	FirstEverCommit(ctx context.Context, repo api.RepoName) (*gitdomain.Commit, error)
 
+	// FirstCommitForPath returns the commit that added path in the history of
+	// rev, following renames, like git log --diff-filter=A --follow. If the
+	// path was deleted and added again, the most recent addition is returned.
+	//
+	// If no commit visible to the user added the path, an error satisfying
+	// os.IsNotExist is returned.
+	FirstCommitForPath(ctx context.Context, repo api.RepoName, rev, path string) (*gitdomain.Commit, error)
+
+	// DirectoryHistory returns a page of the commits in the history of rev
+	// that changed something below path, newest first. An empty path selects
+	// all commits. It uses git's history simplification like git log -- path,
+	// so merges that didn't change the directory are left out.
+	//
+	// The path is matched literally, so that git can skip the commits that
+	// don't touch it using the changed-path Bloom filters of the commit graph.
+	//
+	// The returned cursor is passed as opt.After to get the next page, and is
+	// empty after the last page. It pins the commit rev resolved to for the
+	// first page, so the pages are consistent even if rev moves in between.
+	// Commits the actor can't see due to sub-repo permissions are left out, so
+	// a page can have fewer commits than requested.
+	//
+	// If rev does not exist, a RevisionNotFoundError is returned.
+	DirectoryHistory(ctx context.Context, repo api.RepoName, rev, path string, opt DirectoryHistoryOptions) (_ []*gitdomain.Commit, nextCursor string, _ error)
+
+	// AbbreviateCommit returns the shortest prefix of commitID that is
+	// unambiguous in the repository, but at least as long as the repository's
+	// core.abbrev setting. UIs should use it instead of a fixed number of
+	// characters, which can collide in large repositories.
+	//
+	// If the commit doesn't exist, a gitdomain.RevisionNotFoundError is
+	// returned.
+	AbbreviateCommit(ctx context.Context, repo api.RepoName, commitID api.CommitID) (string, error)
+
 	// ListDirectoryChildren fetches the list of children under the given directory
 	// names. The result is a map keyed by the directory names with the list of files
 	// under each.
@@ -398,12 +936,135 @@ type Client interface {
 	// longer required.
 	Diff(ctx context.Context, opts DiffOptions) (*DiffFileIterator, error)
 
+	// DiffCrossRepo is like Diff, but opts.Base is a revision of baseRepo
+	// instead of opts.Repo, so that changes can be compared across the repos
+	// of a fork network. The base commit is fetched into opts.Repo on demand.
+	// With RangeType "...", the diff starts at the merge base of the two
+	// commits, like a pull request from a fork.
+	//
+	// If sub-repo permissions are enabled for baseRepo, an Unimplemented gRPC
+	// error is returned.
+	DiffCrossRepo(ctx context.Context, baseRepo api.RepoName, opts DiffOptions) (*DiffFileIterator, error)
+
+	// RawDiff writes the unified diff described by opts to w as git prints
+	// it, without parsing it into file diffs. This is cheaper than Diff for
+	// callers that pass the diff on unchanged, like patch downloads. The
+	// OnProgress, MaxFiles and MaxBytes options don't apply.
+	//
+	// If sub-repo permissions are enabled, an error is returned, as the diff
+	// cannot be filtered.
+	RawDiff(ctx context.Context, opts DiffOptions, w io.Writer) error
+
+	// FormatPatch returns a reader for the mbox-formatted patch series of all
+	// commits reachable from head but not from base, oldest commit first, as
+	// produced by `git format-patch --stdout base..head`. The output can be
+	// applied to another repository with `git am`.
+	// The reader must be closed when no longer required.
+	//
+	// If one of the revspecs does not exist, a RevisionNotFoundError is
+	// returned. If sub-repo permissions are enabled for the repo, an error is
+	// returned, as the patches cannot be filtered.
+	FormatPatch(ctx context.Context, repo api.RepoName, base, head string) (io.ReadCloser, error)
+
+	// CreateBundle writes a git bundle containing the given refs to w, as
+	// produced by `git bundle create`. Refs prefixed with ^ are excluded from
+	// the bundle, which can be used to create incremental bundles. The bundle
+	// can be imported into another repository with `git fetch` or
+	// `git bundle unbundle`.
+	//
+	// If one of the refs does not exist, a RevisionNotFoundError is returned.
+	// If sub-repo permissions are enabled for the repo, an error is returned,
+	// as the bundle cannot be filtered.
+	CreateBundle(ctx context.Context, repo api.RepoName, refs []string, w io.Writer) error
+
+	// ApplyBundle imports the git bundle read from r into the repository, as
+	// produced by CreateBundle or `git bundle create`. This allows to ingest
+	// code updates on instances where gitserver has no network access to the
+	// code host.
+	//
+	// Only the branches and tags of the bundle are imported. Existing branches
+	// are only fast-forwarded and existing tags are never moved. If a ref of
+	// the bundle would violate this, no ref is updated and a
+	// RefUpdateConflictError is returned.
+	ApplyBundle(ctx context.Context, repo api.RepoName, r io.Reader) error
+
+	// ExecInWorktree checks out commit into a temporary worktree on gitserver
+	// and runs git with args in it, passing stdin on standard input. This
+	// allows cheap validations like `git apply --check` without downloading an
+	// archive of the repository. Only a few commands and flags are allowed,
+	// see worktreeCmdAllowlist in gitserver. The worktree is discarded
+	// afterwards.
+	//
+	// A command that fails is not an error, its exit status is returned in the
+	// result. If the commit does not exist, a RevisionNotFoundError is returned.
+	// For repositories with sub-repo permissions, only internal actors may run
+	// commands.
+	ExecInWorktree(ctx context.Context, repo api.RepoName, commit api.CommitID, args []string, stdin []byte) (gitdomain.WorktreeExecResult, error)
+
+	// RebasePreview replays the commits in base..head onto onto in a temporary
+	// worktree on gitserver, like `git rebase --onto onto base head` would, and
+	// reports whether they apply cleanly and what the IDs of the resulting
+	// commits are. Nothing is written to the repository. Merge commits are
+	// skipped, and the replayed commits keep their original author and
+	// committer.
+	//
+	// If any of the commits does not exist, a RevisionNotFoundError is
+	// returned. For repositories with sub-repo permissions, only internal
+	// actors may preview rebases.
+	RebasePreview(ctx context.Context, repo api.RepoName, base, head, onto api.CommitID) (gitdomain.RebasePreview, error)
+
+	// RevertCommit creates a commit that reverts the changes of commit, like
+	// `git revert` does, and returns its ID. See RevertCommitOptions for where
+	// the commit is created and what it looks like. The commit is kept under
+	// the ref refs/sourcegraph/tmp/<ID>, which callers should delete with
+	// DeleteRef once they have pushed or otherwise used the commit.
+	//
+	// If the changes can't be reverted without conflicts, a
+	// *gitdomain.MergeConflictError is returned. If commit or opts.Onto does
+	// not exist, a RevisionNotFoundError is returned.
+	RevertCommit(ctx context.Context, repo api.RepoName, commit api.CommitID, opts RevertCommitOptions) (api.CommitID, error)
+
+	// CherryPick creates a commit on top of the commit onto resolves to that
+	// applies the changes of commit, like `git cherry-pick` does, and returns
+	// its ID. The new commit keeps the author of commit, see
+	// CherryPickOptions for its message and committer. It is kept under the
+	// ref refs/sourcegraph/tmp/<ID>, which callers should delete with
+	// DeleteRef once they have pushed or otherwise used the commit.
+	//
+	// If the changes can't be applied without conflicts, a
+	// *gitdomain.MergeConflictError is returned. If commit or onto does not
+	// exist, a RevisionNotFoundError is returned.
+	CherryPick(ctx context.Context, repo api.RepoName, commit api.CommitID, onto string, opts CherryPickOptions) (api.CommitID, error)
+
+	// SquashCommits creates a single commit on top of base with the tree of
+	// head, combining the commits in base..head, and returns its ID. See
+	// SquashCommitsOptions for its message, author and committer. It is kept
+	// under the ref refs/sourcegraph/tmp/<ID>, which callers should delete
+	// with DeleteRef once they have pushed or otherwise used the commit.
+	//
+	// If base or head does not exist, a RevisionNotFoundError is returned.
+	SquashCommits(ctx context.Context, repo api.RepoName, base, head api.CommitID, opts SquashCommitsOptions) (api.CommitID, error)
+
 	// CommitGraph returns the commit graph for the given repository as a mapping
 	// from a commit to its parents. If a commit is supplied, the returned graph will
 	// be rooted at the given commit. If a non-zero limit is supplied, at most that
 	// many commits will be returned.
 	CommitGraph(ctx context.Context, repo api.RepoName, opts CommitGraphOptions) (_ *gitdomain.CommitGraph, err error)
 
+	// StreamCommitGraph streams the commits reachable from opt.Head but not
+	// from opt.Base together with the IDs of their parents, without fetching
+	// any other commit metadata. Commits are returned in topological order,
+	// children before their parents. The parents of the oldest commits in the
+	// range can lie outside of it.
+	// The reader must be closed when no longer required.
+	//
+	// Error cases:
+	// - If one of the revspecs does not exist, a RevisionNotFoundError is
+	//   returned.
+	// - If sub-repo permissions are enabled for the repo, an error is returned,
+	//   as commits cannot be left out without breaking the graph.
+	StreamCommitGraph(ctx context.Context, repo api.RepoName, opt StreamCommitGraphOptions) (CommitGraphNodeReader, error)
+
 	// CommitLog returns the repository commit log, including the file paths that were changed. The general approach to parsing
 	// is to separate the first line (the metadata line) from the remaining lines (the files), and then parse the metadata line
 	// into component parts separately.
@@ -424,13 +1085,43 @@ type Client interface {
 	// exists.
 	GetCommit(ctx context.Context, repo api.RepoName, id api.CommitID) (*gitdomain.Commit, error)
 
+	// GetCommitWithChanges is like GetCommit, but also returns the diff stat
+	// and/or patch of the commit, compared to its first parent, as selected
+	// by opt. This saves a round trip over calling GetCommit and Diff.
+	//
+	// Error cases:
+	// - If the commit doesn't exist, a RevisionNotFoundError is returned.
+	// - If sub-repo permissions are enabled for the repo and the actor cannot
+	//   read any file modified by the commit, a RevisionNotFoundError is
+	//   returned. Files the actor cannot read are omitted from the stats and
+	//   patch.
+	GetCommitWithChanges(ctx context.Context, repo api.RepoName, id api.CommitID, opt GetCommitOptions) (*gitdomain.CommitWithChanges, error)
+
 	// GetBehindAhead returns the behind/ahead commit counts information for right vs. left (both Git
 	// revspecs).
 	GetBehindAhead(ctx context.Context, repo api.RepoName, left, right string) (*gitdomain.BehindAhead, error)
 
-	// ContributorCount returns the number of commits grouped by contributor
+	// ContributorCount returns the number of commits grouped by contributor.
+	// By default commits are grouped by author, see opt.GroupBy. If sub-repo
+	// permissions are enabled, commits which only modify files the actor can't
+	// read are not counted.
 	ContributorCount(ctx context.Context, repo api.RepoName, opt ContributorOptions) ([]*gitdomain.ContributorCount, error)
 
+	// CommitActivity returns the number of non-merge commits in the history
+	// of opt.Range per day or week, by committer date in UTC, for example for
+	// activity sparklines. The counts are computed in gitserver, so the
+	// commits themselves aren't transferred.
+	//
+	// The buckets are consecutive, oldest first. They span opt.After to
+	// opt.Before, including empty buckets at both ends. If either is unset,
+	// the buckets start or end with the oldest or newest commit instead.
+	//
+	// If sub-repo permissions are enabled, commits which only modify files
+	// the actor can't read are not counted.
+	//
+	// If opt.Range does not exist, a RevisionNotFoundError is returned.
+	CommitActivity(ctx context.Context, repo api.RepoName, opt CommitActivityOptions) ([]gitdomain.CommitActivityBucket, error)
+
 	// LogReverseEach runs git log in reverse order and calls the given callback for each entry.
 	LogReverseEach(ctx context.Context, repo string, commit string, n int, onLogEntry func(entry gitdomain.LogEntry) error) error
 
@@ -444,6 +1135,12 @@ type Client interface {
 	// SystemInfo returns information about the gitserver instance at the given address.
 	SystemInfo(ctx context.Context, addr string) (protocol.SystemInfo, error)
 
+	// ListShardRepos returns the repositories cloned on the gitserver instance
+	// at the given address, with their size on disk and last fetch time. This
+	// walks the disk of the instance, so it is meant for admin tooling, not
+	// for regular requests.
+	ListShardRepos(ctx context.Context, addr string) ([]protocol.HostedRepo, error)
+
 	// IsPerforcePathCloneable checks if the given Perforce depot path is cloneable by
 	// checking if it is a valid depot and the given user has permission to access it.
 	IsPerforcePathCloneable(ctx context.Context, conn protocol.PerforceConnectionDetails, depotPath string) error
@@ -529,6 +1226,49 @@ func (c *clientImplementor) SystemInfo(ctx context.Context, addr string) (_ prot
 	}, nil
 }
 
+func (c *clientImplementor) ListShardRepos(ctx context.Context, addr string) (_ []protocol.HostedRepo, err error) {
+	ctx, _, endObservation := c.operations.listShardRepos.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			attribute.String("addr", addr),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	ac := c.clientSource.GetAddressWithClient(addr)
+	if ac == nil {
+		return nil, errors.Newf("no client for address: %s", addr)
+	}
+	client, err := ac.GRPCClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cc, err := client.ListRepos(ctx, &proto.ListReposRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var repos []protocol.HostedRepo
+	for {
+		resp, err := cc.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return repos, nil
+			}
+			return nil, err
+		}
+		for _, p := range resp.GetRepos() {
+			var r protocol.HostedRepo
+			r.FromProto(p)
+			repos = append(repos, r)
+		}
+	}
+}
+
 func (c *clientImplementor) getDiskInfo(ctx context.Context, addr AddressWithClient) (*proto.DiskInfoResponse, error) {
 	client, err := addr.GRPCClient()
 	if err != nil {
@@ -545,6 +1285,52 @@ func (c *clientImplementor) AddrForRepo(ctx context.Context, repo api.RepoName)
 	return c.clientSource.AddrForRepo(ctx, repo)
 }
 
+// RepoAddrDiagnostics is the result of DiagnoseAddrForRepo.
+type RepoAddrDiagnostics struct {
+	RepoAddressing
+	// AddrKnown is false if Addr is not one of the current gitserver
+	// addresses, for example because the repo is pinned to an instance that
+	// was removed. Cloned and CloneInProgress are not checked in that case.
+	AddrKnown bool
+	// Cloned is true if the repo is cloned on Addr.
+	Cloned bool
+	// CloneInProgress is true if the repo is being cloned on Addr.
+	CloneInProgress bool
+}
+
+func (c *clientImplementor) DiagnoseAddrForRepo(ctx context.Context, repo api.RepoName) (_ *RepoAddrDiagnostics, err error) {
+	ctx, _, endObservation := c.operations.diagnoseAddrForRepo.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	d := &RepoAddrDiagnostics{RepoAddressing: c.clientSource.AddressingForRepo(ctx, repo)}
+
+	ac := c.clientSource.GetAddressWithClient(d.Addr)
+	if ac == nil {
+		return d, nil
+	}
+	d.AddrKnown = true
+
+	// We don't use ClientForRepo, so that we ask the instance we just
+	// reported, even if the addresses change in the meantime.
+	client, err := ac.GRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.RepoCloneProgress(ctx, &proto.RepoCloneProgressRequest{RepoName: string(repo)})
+	if err != nil {
+		return nil, err
+	}
+	d.Cloned = res.GetCloned()
+	d.CloneInProgress = res.GetCloneInProgress()
+
+	return d, nil
+}
+
 func (c *clientImplementor) ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error) {
 	return c.clientSource.ClientForRepo(ctx, repo)
 }
@@ -555,7 +1341,7 @@ func (c *RemoteGitCommand) sendExec(ctx context.Context) (_ io.ReadCloser, err e
 		MetricLabelValues: []string{c.scope},
 		Attrs: []attribute.KeyValue{
 			c.repo.Attr(),
-			attribute.StringSlice("args", c.args[1:]),
+			attribute.StringSlice("args", gitdomain.SanitizeArgs(c.args[1:])),
 		},
 	})
 	done := func() {
@@ -652,6 +1438,131 @@ func (c *clientImplementor) Search(ctx context.Context, args *protocol.SearchReq
 	}
 }
 
+// CommitSearchQuery specifies the commits to find with SearchCommits. Commits
+// must match all the criteria that are set.
+type CommitSearchQuery struct {
+	// Revisions are the revisions whose history is searched. Defaults to
+	// HEAD.
+	Revisions []string
+
+	// MessagePattern is a regular expression matched against the commit
+	// message.
+	MessagePattern string
+	// Author is a regular expression matched against the name and email of
+	// the author.
+	Author string
+	// Committer is a regular expression matched against the name and email
+	// of the committer.
+	Committer string
+	// DateRange limits the commits by their committer date.
+	DateRange DateRange
+	// Paths only selects commits that modify files at or below one of the
+	// paths.
+	Paths []string
+	// DiffContains only selects commits with an added or removed line that
+	// contains this string.
+	DiffContains string
+
+	// IgnoreCase makes the patterns and DiffContains match case-insensitively.
+	IgnoreCase bool
+
+	// Limit is the maximum number of commits to return (0 means no limit).
+	Limit int
+	// IncludeDiff includes the diff of the commits in the matches.
+	IncludeDiff bool
+	// IncludeModifiedFiles includes the names of the files the commits
+	// modify in the matches.
+	IncludeModifiedFiles bool
+}
+
+// DateRange is a range of time. A zero bound leaves the range open at that
+// end.
+type DateRange struct {
+	After  time.Time
+	Before time.Time
+}
+
+// searchRequest returns the request for Search that finds the commits matching
+// q.
+func (q CommitSearchQuery) searchRequest(repo api.RepoName) (*protocol.SearchRequest, error) {
+	var nodes []protocol.Node
+	for _, p := range []struct {
+		name, expr string
+		node       func(expr string) protocol.Node
+	}{
+		{"MessagePattern", q.MessagePattern, func(expr string) protocol.Node {
+			return &protocol.MessageMatches{Expr: expr, IgnoreCase: q.IgnoreCase}
+		}},
+		{"Author", q.Author, func(expr string) protocol.Node {
+			return &protocol.AuthorMatches{Expr: expr, IgnoreCase: q.IgnoreCase}
+		}},
+		{"Committer", q.Committer, func(expr string) protocol.Node {
+			return &protocol.CommitterMatches{Expr: expr, IgnoreCase: q.IgnoreCase}
+		}},
+	} {
+		if p.expr == "" {
+			continue
+		}
+		if _, err := regexp.Compile(p.expr); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", p.name)
+		}
+		nodes = append(nodes, p.node(p.expr))
+	}
+
+	if !q.DateRange.After.IsZero() && !q.DateRange.Before.IsZero() && q.DateRange.After.After(q.DateRange.Before) {
+		return nil, errors.New("DateRange.After is later than DateRange.Before")
+	}
+	if !q.DateRange.After.IsZero() {
+		nodes = append(nodes, &protocol.CommitAfter{Time: q.DateRange.After})
+	}
+	if !q.DateRange.Before.IsZero() {
+		nodes = append(nodes, &protocol.CommitBefore{Time: q.DateRange.Before})
+	}
+
+	if len(q.Paths) > 0 {
+		paths := make([]protocol.Node, 0, len(q.Paths))
+		for _, p := range q.Paths {
+			p = strings.Trim(p, "/")
+			if p == "" {
+				return nil, errors.New("paths must not be empty")
+			}
+			paths = append(paths, &protocol.DiffModifiesFile{Expr: "^" + regexp.QuoteMeta(p) + "(/|$)"})
+		}
+		nodes = append(nodes, protocol.NewOr(paths...))
+	}
+
+	if q.DiffContains != "" {
+		nodes = append(nodes, &protocol.DiffMatches{Expr: regexp.QuoteMeta(q.DiffContains), IgnoreCase: q.IgnoreCase})
+	}
+
+	revisions := q.Revisions
+	if len(revisions) == 0 {
+		revisions = []string{"HEAD"}
+	}
+	for _, rev := range revisions {
+		if err := checkSpecArgSafety(rev); err != nil {
+			return nil, err
+		}
+	}
+
+	return &protocol.SearchRequest{
+		Repo:                 repo,
+		Revisions:            revisions,
+		Query:                protocol.NewAnd(nodes...),
+		IncludeDiff:          q.IncludeDiff,
+		Limit:                q.Limit,
+		IncludeModifiedFiles: q.IncludeModifiedFiles,
+	}, nil
+}
+
+func (c *clientImplementor) SearchCommits(ctx context.Context, repo api.RepoName, q CommitSearchQuery, onMatches func([]protocol.CommitMatch)) (bool, error) {
+	args, err := q.searchRequest(repo)
+	if err != nil {
+		return false, err
+	}
+	return c.Search(ctx, args, onMatches)
+}
+
 func (c *clientImplementor) gitCommand(repo api.RepoName, arg ...string) GitCommand {
 	if ClientMocks.LocalGitserver {
 		cmd := NewLocalGitCommand(repo, arg...)
@@ -796,6 +1707,177 @@ func (c *clientImplementor) RepoCloneProgress(ctx context.Context, repo api.Repo
 	return &rcp, nil
 }
 
+func (c *clientImplementor) CloneProgress(ctx context.Context, repo api.RepoName) (_ CloneProgressReader, err error) {
+	ctx, _, endObservation := c.operations.cloneProgress.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+		},
+	})
+
+	client, err := c.ClientForRepo(ctx, repo)
+	if err != nil {
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cc, err := client.CloneProgress(ctx, &proto.CloneProgressRequest{RepoName: string(repo)})
+	if err != nil {
+		cancel()
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	return &grpcCloneProgressReader{
+		c:              cc,
+		cancel:         cancel,
+		endObservation: func() { endObservation(1, observation.Args{}) },
+	}, nil
+}
+
+type grpcCloneProgressReader struct {
+	c              proto.GitserverService_CloneProgressClient
+	cancel         context.CancelFunc
+	endObservation func()
+}
+
+func (r *grpcCloneProgressReader) Read() (*protocol.CloneProgressUpdate, error) {
+	resp, err := r.c.Recv()
+	if err != nil {
+		return nil, err
+	}
+	var u protocol.CloneProgressUpdate
+	u.FromProto(resp)
+	return &u, nil
+}
+
+func (r *grpcCloneProgressReader) Close() error {
+	r.cancel()
+	r.endObservation()
+	return nil
+}
+
+func (c *clientImplementor) WatchRefs(ctx context.Context, repo api.RepoName) (_ RefWatcher, err error) {
+	ctx, _, endObservation := c.operations.watchRefs.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+		},
+	})
+
+	client, err := c.ClientForRepo(ctx, repo)
+	if err != nil {
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cc, err := client.WatchRefs(ctx, &proto.WatchRefsRequest{RepoName: string(repo)})
+	if err != nil {
+		cancel()
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	return &grpcRefWatcher{
+		c:              cc,
+		cancel:         cancel,
+		endObservation: func() { endObservation(1, observation.Args{}) },
+	}, nil
+}
+
+type grpcRefWatcher struct {
+	c              proto.GitserverService_WatchRefsClient
+	cancel         context.CancelFunc
+	endObservation func()
+}
+
+func (r *grpcRefWatcher) Read() (gitdomain.RefChanges, error) {
+	var res gitdomain.RefChanges
+	for {
+		resp, err := r.c.Recv()
+		if err != nil {
+			return gitdomain.RefChanges{}, err
+		}
+		for _, c := range resp.GetChanges() {
+			res.Changes = append(res.Changes, gitdomain.RefChangeFromProto(c))
+		}
+		// The last message of an update carries the state token.
+		if resp.GetStateToken() != "" {
+			res.StateToken = resp.GetStateToken()
+			res.Full = resp.GetFull()
+			return res, nil
+		}
+	}
+}
+
+func (r *grpcRefWatcher) Close() error {
+	r.cancel()
+	r.endObservation()
+	return nil
+}
+
+func (c *clientImplementor) WatchDefaultBranchCommits(ctx context.Context, repo api.RepoName) (_ BranchCommitWatcher, err error) {
+	ctx, _, endObservation := c.operations.watchDefaultBranchCommits.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+		},
+	})
+
+	client, err := c.ClientForRepo(ctx, repo)
+	if err != nil {
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cc, err := client.WatchDefaultBranchCommits(ctx, &proto.WatchDefaultBranchCommitsRequest{RepoName: string(repo)})
+	if err != nil {
+		cancel()
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	return &grpcBranchCommitWatcher{
+		c:              cc,
+		cancel:         cancel,
+		endObservation: func() { endObservation(1, observation.Args{}) },
+	}, nil
+}
+
+type grpcBranchCommitWatcher struct {
+	c              proto.GitserverService_WatchDefaultBranchCommitsClient
+	cancel         context.CancelFunc
+	endObservation func()
+}
+
+func (r *grpcBranchCommitWatcher) Read() (gitdomain.BranchCommits, error) {
+	var res gitdomain.BranchCommits
+	for {
+		resp, err := r.c.Recv()
+		if err != nil {
+			return gitdomain.BranchCommits{}, err
+		}
+		for _, c := range resp.GetCommits() {
+			res.Commits = append(res.Commits, gitdomain.BranchCommitFromProto(c))
+		}
+		// The last message of an update carries the new head.
+		if resp.GetHeadCommitSha() != "" {
+			res.Head = api.CommitID(resp.GetHeadCommitSha())
+			res.Rewritten = resp.GetRewritten()
+			return res, nil
+		}
+	}
+}
+
+func (r *grpcBranchCommitWatcher) Close() error {
+	r.cancel()
+	r.endObservation()
+	return nil
+}
+
 func (c *clientImplementor) Remove(ctx context.Context, repo api.RepoName) (err error) {
 	ctx, _, endObservation := c.operations.remove.With(ctx, &err, observation.Args{
 		MetricLabelValues: []string{c.scope},
@@ -815,6 +1897,92 @@ func (c *clientImplementor) Remove(ctx context.Context, repo api.RepoName) (err
 	return err
 }
 
+func (c *clientImplementor) OptimizeRepo(ctx context.Context, repo api.RepoName, opts MaintenanceOptions) (_ []MaintenanceTaskResult, err error) {
+	ctx, _, endObservation := c.operations.optimizeRepo.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.Bool("gc", opts.Gc),
+			attribute.Bool("repack", opts.Repack),
+			attribute.Bool("commitGraph", opts.CommitGraph),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.OptimizeRepo(ctx, &proto.OptimizeRepoRequest{
+		RepoName:    string(repo),
+		Gc:          opts.Gc,
+		Repack:      opts.Repack,
+		CommitGraph: opts.CommitGraph,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]MaintenanceTaskResult, 0, len(res.GetTasks()))
+	for _, t := range res.GetTasks() {
+		results = append(results, MaintenanceTaskResult{
+			Task:     t.GetTask(),
+			Duration: t.GetDuration().AsDuration(),
+		})
+	}
+	return results, nil
+}
+
+func (c *clientImplementor) CommitGraphStatus(ctx context.Context, repo api.RepoName) (_ gitdomain.CommitGraphStatus, err error) {
+	ctx, _, endObservation := c.operations.commitGraphStatus.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.ClientForRepo(ctx, repo)
+	if err != nil {
+		return gitdomain.CommitGraphStatus{}, err
+	}
+
+	res, err := client.CommitGraphStatus(ctx, &proto.CommitGraphStatusRequest{RepoName: string(repo)})
+	if err != nil {
+		return gitdomain.CommitGraphStatus{}, err
+	}
+
+	return gitdomain.CommitGraphStatusFromProto(res), nil
+}
+
+func (c *clientImplementor) CloneState(ctx context.Context, repo api.RepoName) (_ gitdomain.CloneState, err error) {
+	ctx, _, endObservation := c.operations.cloneState.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.ClientForRepo(ctx, repo)
+	if err != nil {
+		return gitdomain.CloneState{}, err
+	}
+
+	res, err := client.CloneState(ctx, &proto.CloneStateRequest{RepoName: string(repo)})
+	if err != nil {
+		return gitdomain.CloneState{}, err
+	}
+
+	return gitdomain.CloneStateFromProto(res), nil
+}
+
+func (c *clientImplementor) WriteCommitGraph(ctx context.Context, repo api.RepoName) error {
+	_, err := c.OptimizeRepo(ctx, repo, MaintenanceOptions{CommitGraph: true})
+	return err
+}
+
 func (c *clientImplementor) IsPerforcePathCloneable(ctx context.Context, conn protocol.PerforceConnectionDetails, depotPath string) (err error) {
 	ctx, _, endObservation := c.operations.isPerforcePathCloneable.With(ctx, &err, observation.Args{
 		MetricLabelValues: []string{c.scope},
@@ -1150,6 +2318,14 @@ func stringsToByteSlices(in []string) [][]byte {
 }
 
 func (c *clientImplementor) ListGitoliteRepos(ctx context.Context, gitoliteHost string) (list []*gitolite.Repo, err error) {
+	ctx, _, endObservation := c.operations.listGitoliteRepos.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			attribute.String("gitoliteHost", gitoliteHost),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
 	client, err := c.ClientForRepo(ctx, api.RepoName(gitoliteHost))
 	if err != nil {
 		return nil, err
@@ -2,6 +2,7 @@ package gitserver_test
 
 import (
 	"context"
+	"io"
 	"math/rand"
 	"os/exec"
 	"path/filepath"
@@ -16,6 +17,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitolite"
@@ -124,10 +126,15 @@ func TestClient_CreateCommitFromPatchRequest_ProtoRoundTrip(t *testing.T) {
 			pushRef *string,
 
 			commitInfo struct {
-				Messages    []string
-				AuthorName  string
-				AuthorEmail string
-				Date        fuzzTime
+				Messages       []string
+				AuthorName     string
+				AuthorEmail    string
+				CommitterName  string
+				CommitterEmail string
+				Date           fuzzTime
+				CommitterDate  fuzzTime
+				Trailers       []protocol.CommitTrailer
+				Sign           bool
 			},
 
 			pushConfig *protocol.PushConfig,
@@ -139,15 +146,25 @@ func TestClient_CreateCommitFromPatchRequest_ProtoRoundTrip(t *testing.T) {
 				TargetRef:  targetRef,
 				UniqueRef:  uniqueRef,
 				CommitInfo: protocol.PatchCommitInfo{
-					Messages:    commitInfo.Messages,
-					AuthorName:  commitInfo.AuthorName,
-					AuthorEmail: commitInfo.AuthorEmail,
-					Date:        time.Time(commitInfo.Date),
+					Messages:       commitInfo.Messages,
+					AuthorName:     commitInfo.AuthorName,
+					AuthorEmail:    commitInfo.AuthorEmail,
+					CommitterName:  commitInfo.CommitterName,
+					CommitterEmail: commitInfo.CommitterEmail,
+					Date:           time.Time(commitInfo.Date),
+					CommitterDate:  time.Time(commitInfo.CommitterDate),
+					Trailers:       commitInfo.Trailers,
+					Sign:           commitInfo.Sign,
 				},
 				Push:         pushConfig,
 				PushRef:      pushRef,
 				GitApplyArgs: gitApplyArgs,
 			}
+			// The proto message can't tell an empty list of trailers from
+			// none.
+			if len(original.CommitInfo.Trailers) == 0 {
+				original.CommitInfo.Trailers = nil
+			}
 			var converted protocol.CreateCommitFromPatchRequest
 			converted.FromProto(original.ToMetadataProto())
 
@@ -435,6 +452,70 @@ func TestClient_SystemInfo(t *testing.T) {
 	}
 }
 
+func TestClient_ListShardRepos(t *testing.T) {
+	const gitserverAddr = "172.16.8.1:8080"
+	fetched := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	source := gitserver.NewTestClientSource(t, []string{gitserverAddr}, func(o *gitserver.TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			ss := gitserver.NewMockGitserverService_ListReposClient()
+			ss.RecvFunc.PushReturn(&proto.ListReposResponse{Repos: []*proto.HostedRepo{
+				{Name: "github.com/foo/bar", SizeBytes: 1024, LastFetched: timestamppb.New(fetched)},
+			}}, nil)
+			ss.RecvFunc.PushReturn(&proto.ListReposResponse{Repos: []*proto.HostedRepo{
+				{Name: "github.com/foo/baz", SizeBytes: 2048, LastFetched: timestamppb.New(fetched)},
+			}}, nil)
+			ss.RecvFunc.SetDefaultReturn(nil, io.EOF)
+			cli := gitserver.NewStrictMockGitserverServiceClient()
+			cli.ListReposFunc.SetDefaultReturn(ss, nil)
+			return cli
+		}
+	})
+
+	client := gitserver.NewTestClient(t).WithClientSource(source)
+
+	repos, err := client.ListShardRepos(context.Background(), gitserverAddr)
+	require.NoError(t, err)
+	require.Equal(t, []protocol.HostedRepo{
+		{Name: "github.com/foo/bar", SizeBytes: 1024, LastFetched: fetched},
+		{Name: "github.com/foo/baz", SizeBytes: 2048, LastFetched: fetched},
+	}, repos)
+
+	_, err = client.ListShardRepos(context.Background(), "172.16.8.2:8080")
+	require.ErrorContains(t, err, "no client for address")
+}
+
+func TestClient_DiagnoseAddrForRepo(t *testing.T) {
+	const gitserverAddr = "172.16.8.1:8080"
+
+	var got *proto.RepoCloneProgressRequest
+	source := gitserver.NewTestClientSource(t, []string{gitserverAddr}, func(o *gitserver.TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			cli := gitserver.NewStrictMockGitserverServiceClient()
+			cli.RepoCloneProgressFunc.SetDefaultHook(func(_ context.Context, req *proto.RepoCloneProgressRequest, _ ...grpc.CallOption) (*proto.RepoCloneProgressResponse, error) {
+				got = req
+				return &proto.RepoCloneProgressResponse{CloneInProgress: true}, nil
+			})
+			return cli
+		}
+	})
+
+	client := gitserver.NewTestClient(t).WithClientSource(source)
+
+	d, err := client.DiagnoseAddrForRepo(context.Background(), "github.com/foo/bar")
+	require.NoError(t, err)
+	require.Equal(t, &gitserver.RepoAddrDiagnostics{
+		RepoAddressing: gitserver.RepoAddressing{
+			Repo:       "github.com/foo/bar",
+			HashedAddr: gitserverAddr,
+			Addr:       gitserverAddr,
+		},
+		AddrKnown:       true,
+		CloneInProgress: true,
+	}, d)
+	require.Equal(t, "github.com/foo/bar", got.GetRepoName())
+}
+
 type fuzzTime time.Time
 
 func (fuzzTime) Generate(rand *rand.Rand, _ int) reflect.Value {
@@ -4,8 +4,10 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
 	"io/fs"
 	"net/mail"
@@ -31,6 +33,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/fileutil"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitcmd"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/streamio"
@@ -44,6 +47,10 @@ type DiffOptions struct {
 	Repo api.RepoName
 
 	// These fields must be valid <commit> inputs as defined by gitrevisions(7).
+	// If RangeType is "..", they can also both be <tree-ish> or both be <blob>
+	// object IDs, so that objects which are not part of any commit, like a
+	// tree written by gitserver, can be compared. When comparing two blobs,
+	// the file diff is named after the object IDs.
 	Base string
 	Head string
 
@@ -51,12 +58,48 @@ type DiffOptions struct {
 	// For a nice visual explanation of ".." vs "...", see https://stackoverflow.com/a/46345364/2682729
 	RangeType string
 
+	// Paths restricts the diff to the files matching any of the given
+	// pathspecs, for example a directory. They are passed to git after "--",
+	// so they are never interpreted as revisions or flags. If empty, the diff
+	// covers the whole tree.
 	Paths []string
+
+	// IgnoreWhitespace ignores all whitespace when comparing lines (-w).
+	IgnoreWhitespace bool
+	// IgnoreSpaceChange ignores changes in the amount of whitespace, but not
+	// whitespace added where there was none before (-b).
+	IgnoreSpaceChange bool
+	// IgnoreBlankLines ignores changes whose lines are all blank.
+	IgnoreBlankLines bool
+
+	// OnProgress is called by the returned iterator after each file diff it
+	// reads, so that callers can report progress on large diffs (optional).
+	OnProgress func(DiffProgress)
+
+	// MaxFiles and MaxBytes limit the number of file diffs returned and the
+	// bytes of the diff read (optional). Once a limit is hit, the iterator
+	// stops git diff on gitserver and ends with io.EOF, and Truncated
+	// describes what was left out. The file diff that crosses MaxBytes is
+	// still returned in full, so that no file diff is cut off.
+	MaxFiles int
+	MaxBytes int64
+}
+
+// DiffProgress is how far a DiffFileIterator got through a diff.
+type DiffProgress struct {
+	// Files is the number of file diffs read, including the ones that were
+	// skipped because of sub-repo permissions.
+	Files int
+	// Bytes is the number of bytes of the diff that were consumed.
+	Bytes int64
 }
 
 // Diff returns an iterator that can be used to access the diff between two
-// commits on a per-file basis. The iterator must be closed with Close when no
-// longer required.
+// commits, or two trees or blobs, on a per-file basis. The iterator must be
+// closed with Close when no longer required.
+//
+// To highlight the changed words within lines, use HunkWordDiff on the hunks
+// of the returned file diffs.
 func (c *clientImplementor) Diff(ctx context.Context, opts DiffOptions) (_ *DiffFileIterator, err error) {
 	ctx, _, endObservation := c.operations.diff.With(ctx, &err, observation.Args{
 		MetricLabelValues: []string{c.scope},
@@ -66,6 +109,81 @@ func (c *clientImplementor) Diff(ctx context.Context, opts DiffOptions) (_ *Diff
 	})
 	defer endObservation(1, observation.Args{})
 
+	args, err := buildDiffArgs(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rdr, err := c.gitCommand(opts.Repo, args...).StdoutReader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "executing git diff")
+	}
+
+	return newDiffFileIterator(ctx, rdr, c.subRepoPermsChecker, opts), nil
+}
+
+func (c *clientImplementor) DiffCrossRepo(ctx context.Context, baseRepo api.RepoName, opts DiffOptions) (_ *DiffFileIterator, err error) {
+	ctx, _, endObservation := c.operations.diffCrossRepo.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			attribute.String("baseRepo", string(baseRepo)),
+			opts.Repo.Attr(),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	base, err := c.fetchCommitFromRepo(ctx, opts.Repo, baseRepo, opts.Base)
+	if err != nil {
+		return nil, err
+	}
+	opts.Base = string(base)
+	return c.Diff(ctx, opts)
+}
+
+func (c *clientImplementor) RawDiff(ctx context.Context, opts DiffOptions, w io.Writer) (err error) {
+	ctx, _, endObservation := c.operations.rawDiff.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			opts.Repo.Attr(),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	if authz.SubRepoEnabled(c.subRepoPermsChecker) {
+		return errors.New("raw diffs are not supported with sub-repo permissions")
+	}
+
+	args, err := buildDiffArgs(opts)
+	if err != nil {
+		return err
+	}
+
+	rdr, err := c.gitCommand(opts.Repo, args...).StdoutReader(ctx)
+	if err != nil {
+		return errors.Wrap(err, "executing git diff")
+	}
+	defer rdr.Close()
+
+	_, err = io.Copy(w, rdr)
+	return err
+}
+
+// newDiffFileIterator returns an iterator over the diff read from rdr, that
+// applies sub-repo permissions and the options of opts.
+func newDiffFileIterator(ctx context.Context, rdr io.ReadCloser, checker authz.SubRepoPermissionChecker, opts DiffOptions) *DiffFileIterator {
+	it := NewDiffFileIterator(rdr)
+	it.fileFilterFunc = getFilterFunc(ctx, checker, opts.Repo)
+	it.onProgress = opts.OnProgress
+	it.maxFiles = opts.MaxFiles
+	if opts.MaxBytes > 0 {
+		it.limiter = &diffLimitReader{r: rdr, limit: opts.MaxBytes}
+		it.counter.r = it.limiter
+	}
+	return it
+}
+
+// buildDiffArgs returns the arguments to git for the given diff options.
+func buildDiffArgs(opts DiffOptions) ([]string, error) {
 	// Rare case: the base is the empty tree, in which case we must use ..
 	// instead of ... as the latter only works for commits.
 	if opts.Base == DevNullSHA {
@@ -80,87 +198,387 @@ func (c *clientImplementor) Diff(ctx context.Context, opts DiffOptions) (_ *Diff
 		// flags or refer to a file.
 		return nil, errors.Errorf("invalid diff range argument: %q", rangeSpec)
 	}
-	args := append([]string{
-		"diff",
-		"--find-renames",
+	diff := gitcmd.Diff().
+		Flag("--find-renames").
 		// TODO(eseliger): Enable once we have support for copy detection in go-diff
 		// and actually expose a `isCopy` field in the api, otherwise this
 		// information is thrown away anyways.
-		// "--find-copies",
-		"--full-index",
-		"--inter-hunk-context=3",
-		"--no-prefix",
-		rangeSpec,
-		"--",
-	}, opts.Paths...)
-
-	rdr, err := c.gitCommand(opts.Repo, args...).StdoutReader(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, "executing git diff")
+		// Flag("--find-copies").
+		Flag("--full-index").
+		FlagValue("--inter-hunk-context", "3").
+		Flag("--no-prefix")
+	if opts.IgnoreWhitespace {
+		diff.Flag("-w")
 	}
-
-	return &DiffFileIterator{
-		rdr:            rdr,
-		mfdr:           diff.NewMultiFileDiffReader(rdr),
-		fileFilterFunc: getFilterFunc(ctx, c.subRepoPermsChecker, opts.Repo),
-	}, nil
+	if opts.IgnoreSpaceChange {
+		diff.Flag("-b")
+	}
+	if opts.IgnoreBlankLines {
+		diff.Flag("--ignore-blank-lines")
+	}
+	return diff.Rev(rangeSpec).Path(opts.Paths...).SeparatePaths().Args()
 }
 
 type DiffFileIterator struct {
 	rdr            io.ReadCloser
 	mfdr           *diff.MultiFileDiffReader
 	fileFilterFunc diffFileIteratorFilter
+
+	counter    *countingReader
+	files      int
+	onProgress func(DiffProgress)
+
+	limiter    *diffLimitReader
+	maxFiles   int
+	returned   int
+	truncation *DiffTruncation
+	closed     bool
+}
+
+// DiffTruncation describes where a DiffFileIterator stopped because of
+// DiffOptions.MaxFiles or MaxBytes.
+type DiffTruncation struct {
+	// Limit is the limit that was hit, either "files" or "bytes".
+	Limit string
+	// Files is the number of file diffs that were returned.
+	Files int
+	// Path is the path of the first file diff that was left out. It is empty
+	// if the path isn't known, or might not be readable with sub-repo
+	// permissions.
+	Path string
 }
 
 func NewDiffFileIterator(rdr io.ReadCloser) *DiffFileIterator {
+	counter := &countingReader{r: rdr}
 	return &DiffFileIterator{
-		rdr:  rdr,
-		mfdr: diff.NewMultiFileDiffReader(rdr),
+		rdr:     rdr,
+		mfdr:    diff.NewMultiFileDiffReader(counter),
+		counter: counter,
+	}
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// diffLimitReader reads a diff from r up to the first file diff that starts
+// after limit bytes, so that it never cuts a file diff off.
+type diffLimitReader struct {
+	r     io.Reader
+	n     int64
+	limit int64
+
+	// br reads the rest of the file diff that crosses the limit.
+	br          *bufio.Reader
+	atLineStart bool
+	// exceeded is set when a file diff after the limit was left out, and
+	// next is its header line.
+	exceeded bool
+	next     string
+}
+
+var diffGitHeader = []byte("diff --git ")
+
+func (r *diffLimitReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if r.n < r.limit {
+		if remaining := r.limit - r.n; int64(len(p)) > remaining {
+			p = p[:remaining]
+		}
+		n, err := r.r.Read(p)
+		r.n += int64(n)
+		if n > 0 {
+			r.atLineStart = p[n-1] == '\n'
+		}
+		return n, err
+	}
+	if r.exceeded {
+		return 0, io.EOF
+	}
+
+	if r.br == nil {
+		r.br = bufio.NewReader(r.r)
+	}
+	n := 0
+	for n < len(p) {
+		if r.atLineStart {
+			if prefix, _ := r.br.Peek(len(diffGitHeader)); bytes.Equal(prefix, diffGitHeader) {
+				line, _ := r.br.ReadString('\n')
+				r.exceeded, r.next = true, strings.TrimSuffix(line, "\n")
+				break
+			}
+		}
+		b, err := r.br.ReadByte()
+		if err != nil {
+			r.n += int64(n)
+			return n, err
+		}
+		p[n] = b
+		n++
+		r.atLineStart = b == '\n'
+	}
+	r.n += int64(n)
+	if n == 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+// nextPath returns the path of the left out file diff, if its header names
+// it unambiguously.
+func (r *diffLimitReader) nextPath() string {
+	// With --no-prefix, the header is "diff --git <old> <new>". Paths with
+	// spaces can only be split if both sides are the same.
+	names := strings.TrimPrefix(r.next, string(diffGitHeader))
+	if len(names)%2 == 1 {
+		half := len(names) / 2
+		if names[half] == ' ' && names[:half] == names[half+1:] {
+			return names[:half]
+		}
+	}
+	return ""
+}
+
+// Progress returns how far the iterator got through the diff. Bytes are
+// counted as they're read from gitserver, which is ahead of the returned file
+// diffs because of buffering.
+func (i *DiffFileIterator) Progress() DiffProgress {
+	return DiffProgress{Files: i.files, Bytes: i.counter.n}
+}
+
+// Truncated returns what the iterator left out because of DiffOptions.MaxFiles
+// or MaxBytes, or nil if the diff was complete so far.
+func (i *DiffFileIterator) Truncated() *DiffTruncation {
+	return i.truncation
+}
+
+// truncate ends the iterator, and stops reading the rest of the diff.
+func (i *DiffFileIterator) truncate(limit string, path string) error {
+	i.truncation = &DiffTruncation{Limit: limit, Files: i.returned, Path: path}
+	// Closing the reader cancels the git diff on gitserver.
+	i.Close()
+	return io.EOF
+}
+
+// readFile reads the next file diff, and truncates the diff at the end if
+// MaxBytes was exceeded.
+func (i *DiffFileIterator) readFile() (*diff.FileDiff, error) {
+	fd, err := i.mfdr.ReadFile()
+	if err == io.EOF && i.limiter != nil && i.limiter.exceeded {
+		var path string
+		if i.fileFilterFunc == nil {
+			path = i.limiter.nextPath()
+		}
+		return nil, i.truncate("bytes", path)
+	}
+	return fd, err
+}
+
+// fileDiffPath returns the path of a file diff, which is the original path for
+// deleted files.
+func fileDiffPath(fd *diff.FileDiff) string {
+	if fd.NewName == devNull {
+		return fd.OrigName
 	}
+	return fd.NewName
 }
 
-type diffFileIteratorFilter func(fileName string) (bool, error)
+// diffFileIteratorFilter reports for each of fileNames whether the actor can
+// read it.
+type diffFileIteratorFilter func(fileNames ...string) ([]bool, error)
 
 func getFilterFunc(ctx context.Context, checker authz.SubRepoPermissionChecker, repo api.RepoName) diffFileIteratorFilter {
 	if !authz.SubRepoEnabled(checker) {
 		return nil
 	}
-	return func(fileName string) (bool, error) {
-		shouldFilter, err := authz.FilterActorPath(ctx, checker, actor.FromContext(ctx), repo, fileName)
+	return func(fileNames ...string) ([]bool, error) {
+		perms, err := authz.ActorFilePermissions(ctx, checker, actor.FromContext(ctx), repo, fileNames)
 		if err != nil {
-			return false, err
+			return nil, errors.Wrap(err, "checking sub-repo permissions")
 		}
-		return shouldFilter, nil
+		canRead := make([]bool, len(perms))
+		for i, p := range perms {
+			canRead[i] = p.Include(authz.Read)
+		}
+		return canRead, nil
 	}
 }
 
 func (i *DiffFileIterator) Close() error {
+	if i.closed {
+		return nil
+	}
+	i.closed = true
 	return i.rdr.Close()
 }
 
 // Next returns the next file diff. If no more diffs are available, the diff
 // will be nil and the error will be io.EOF.
+//
+// If sub-repo permissions apply, file diffs the actor can't read are skipped.
+// For renames and copies where only one of the two paths is readable, the
+// unreadable side is redacted from the file diff, see redactFileDiff.
+//
+// Once DiffOptions.MaxFiles or MaxBytes is hit, Next returns io.EOF as well,
+// and Truncated describes the rest that was left out.
 func (i *DiffFileIterator) Next() (*diff.FileDiff, error) {
-	fd, err := i.mfdr.ReadFile()
+	if i.truncation != nil {
+		return nil, io.EOF
+	}
+	fd, err := i.nextReadable()
 	if err != nil {
 		return fd, err
 	}
-	if i.fileFilterFunc != nil {
-		if canRead, err := i.fileFilterFunc(fd.NewName); err != nil {
-			return nil, err
-		} else if !canRead {
-			// go to next
-			return i.Next()
+	if i.maxFiles > 0 && i.returned == i.maxFiles {
+		return nil, i.truncate("files", fileDiffPath(fd))
+	}
+	i.returned++
+	return fd, nil
+}
+
+// nextReadable returns the next file diff the actor can read.
+func (i *DiffFileIterator) nextReadable() (*diff.FileDiff, error) {
+	for {
+		fd, err := i.readFile()
+		if err != nil {
+			return fd, err
+		}
+		i.files++
+		if i.onProgress != nil {
+			i.onProgress(i.Progress())
+		}
+		if i.fileFilterFunc == nil {
+			return fd, nil
+		}
+
+		// /dev/null denotes the missing side of an added or deleted file,
+		// and is not a path that permissions apply to.
+		canReadOrig, canReadNew := fd.OrigName == devNull, fd.NewName == devNull
+		var fileNames []string
+		if !canReadOrig {
+			fileNames = append(fileNames, fd.OrigName)
+		}
+		if !canReadNew {
+			fileNames = append(fileNames, fd.NewName)
+		}
+		if len(fileNames) > 0 {
+			canRead, err := i.fileFilterFunc(fileNames...)
+			if err != nil {
+				return nil, err
+			}
+			if !canReadOrig {
+				canReadOrig, canRead = canRead[0], canRead[1:]
+			}
+			if !canReadNew {
+				canReadNew = canRead[0]
+			}
+		}
+
+		switch {
+		case canReadOrig && canReadNew:
+			return fd, nil
+		case canReadOrig && fd.OrigName != devNull:
+			return redactFileDiff(fd, '+'), nil
+		case canReadNew && fd.NewName != devNull:
+			return redactFileDiff(fd, '-'), nil
 		}
+		// Nothing readable in this file diff, go to next.
 	}
-	return fd, err
+}
+
+const devNull = "/dev/null"
+
+// redactFileDiff removes the unreadable side of a file diff. side is '-' if
+// the original file is unreadable, or '+' if the new file is unreadable.
+//
+// The unreadable path is replaced with /dev/null. The extended headers contain
+// that path and the blob OIDs, so they are replaced with a diff --git line that
+// only names the readable path, like git does for added and deleted files.
+//
+// From every hunk, the lines only present on the unreadable side are removed,
+// and hunks that don't contain any changed lines after that are dropped
+// entirely. Context lines are kept, as they are part of the readable file as
+// well.
+func redactFileDiff(fd *diff.FileDiff, side byte) *diff.FileDiff {
+	redacted := &diff.FileDiff{
+		OrigName: fd.OrigName,
+		OrigTime: fd.OrigTime,
+		NewName:  fd.NewName,
+		NewTime:  fd.NewTime,
+	}
+	readable := fd.NewName
+	if side == '-' {
+		redacted.OrigName, redacted.OrigTime = devNull, nil
+	} else {
+		readable = fd.OrigName
+		redacted.NewName, redacted.NewTime = devNull, nil
+	}
+	redacted.Extended = []string{"diff --git " + readable + " " + readable}
+
+	for _, h := range fd.Hunks {
+		if rh := redactHunk(h, side); rh != nil {
+			redacted.Hunks = append(redacted.Hunks, rh)
+		}
+	}
+
+	return redacted
+}
+
+// redactHunk returns a copy of h without the lines prefixed with side. If no
+// changed lines remain, nil is returned.
+func redactHunk(h *diff.Hunk, side byte) *diff.Hunk {
+	rh := *h
+	rh.Body = make([]byte, 0, len(h.Body))
+	rh.OrigNoNewlineAt = 0
+
+	var contextLines int32
+	var changed bool
+	offset := 0
+	for _, line := range bytes.SplitAfter(h.Body, []byte{'\n'}) {
+		if h.OrigNoNewlineAt > 0 && int32(offset) == h.OrigNoNewlineAt && side == '+' {
+			rh.OrigNoNewlineAt = int32(len(rh.Body))
+		}
+		offset += len(line)
+		if len(line) == 0 || line[0] == side {
+			continue
+		}
+		if line[0] == ' ' {
+			contextLines++
+		} else {
+			changed = true
+		}
+		rh.Body = append(rh.Body, line...)
+	}
+	if !changed {
+		return nil
+	}
+
+	// The redacted side now only consists of the context lines.
+	if side == '-' {
+		rh.OrigLines = contextLines
+	} else {
+		rh.NewLines = contextLines
+	}
+
+	return &rh
 }
 
 // ContributorOptions contains options for filtering contributor commit counts
 type ContributorOptions struct {
-	Range string    // the range for which stats will be fetched
-	After time.Time // the date after which to collect commits
-	Path  string    // compute stats for commits that touch this path
+	Range   string             // the range for which stats will be fetched
+	After   time.Time          // the date after which to collect commits
+	Path    string             // compute stats for commits that touch this path
+	GroupBy ContributorGroupBy // the identity commits are counted for
 }
 
 func (o *ContributorOptions) Attrs() []attribute.KeyValue {
@@ -168,6 +586,36 @@ func (o *ContributorOptions) Attrs() []attribute.KeyValue {
 		attribute.String("range", o.Range),
 		attribute.String("after", o.After.Format(time.RFC3339)),
 		attribute.String("path", o.Path),
+		attribute.String("groupBy", o.GroupBy.String()),
+	}
+}
+
+// ContributorGroupBy is the identity of a commit that ContributorCount groups
+// commits by.
+type ContributorGroupBy int
+
+const (
+	// ContributorGroupByAuthor counts commits per author.
+	ContributorGroupByAuthor ContributorGroupBy = iota
+	// ContributorGroupByCommitter counts commits per committer, i.e. the
+	// identity that landed the commit.
+	ContributorGroupByCommitter
+	// ContributorGroupByAuthorAndCommitter counts commits per pair of author
+	// and committer. The committer is returned in the CommitterName and
+	// CommitterEmail fields of each ContributorCount.
+	ContributorGroupByAuthorAndCommitter
+)
+
+func (g ContributorGroupBy) String() string {
+	switch g {
+	case ContributorGroupByAuthor:
+		return "author"
+	case ContributorGroupByCommitter:
+		return "committer"
+	case ContributorGroupByAuthorAndCommitter:
+		return "author-and-committer"
+	default:
+		return fmt.Sprintf("ContributorGroupBy(%d)", int(g))
 	}
 }
 
@@ -183,27 +631,198 @@ func (c *clientImplementor) ContributorCount(ctx context.Context, repo api.RepoN
 	}
 
 	// We split the individual args for the shortlog command instead of -sne for easier arg checking in the allowlist.
-	args := []string{"shortlog", "-s", "-n", "-e", "--no-merges"}
+	shortlog := gitcmd.Shortlog().Flag("-s", "-n", "-e", "--no-merges")
+	switch opt.GroupBy {
+	case ContributorGroupByAuthor:
+	case ContributorGroupByCommitter:
+		shortlog.Flag("-c")
+	case ContributorGroupByAuthorAndCommitter:
+		// Separate both identities by a tab, so that parseShortLog can tell
+		// them apart.
+		shortlog.FlagValue("--group", "format:%aN <%aE>%x09%cN <%cE>")
+	default:
+		return nil, errors.Errorf("invalid contributor grouping %d", opt.GroupBy)
+	}
+	if authz.SubRepoEnabled(c.subRepoPermsChecker) {
+		return c.filteredContributorCount(ctx, repo, opt)
+	}
 	if !opt.After.IsZero() {
-		args = append(args, fmt.Sprintf("--after=%d", opt.After.Unix()))
+		shortlog.FlagValue("--after", strconv.FormatInt(opt.After.Unix(), 10))
 	}
-	args = append(args, opt.Range, "--")
+	shortlog.Rev(opt.Range).SeparatePaths()
 	if opt.Path != "" {
-		args = append(args, opt.Path)
+		shortlog.Path(opt.Path)
+	}
+	args, err := shortlog.Args()
+	if err != nil {
+		return nil, err
 	}
 	cmd := c.gitCommand(repo, args...)
 	out, err := cmd.Output(ctx)
 	if err != nil {
 		return nil, errors.Errorf("exec `git shortlog -s -n -e` failed: %v", err)
 	}
-	return parseShortLog(out)
+	return parseShortLog(out, opt.GroupBy == ContributorGroupByAuthorAndCommitter)
+}
+
+// filteredContributorCount is ContributorCount for when sub-repo permissions
+// are enabled. git shortlog can't leave out commits that only touch files the
+// actor can't read, so the commits are listed with their files and filtered
+// like in Commits, and then counted here. The result is in the same format and
+// order as the output of git shortlog -n.
+func (c *clientImplementor) filteredContributorCount(ctx context.Context, repo api.RepoName, opt ContributorOptions) ([]*gitdomain.ContributorCount, error) {
+	commitsOpt := CommitsOptions{
+		Range:    opt.Range,
+		NoMerges: true,
+		After:    opt.After,
+		Path:     opt.Path,
+		NameOnly: true,
+	}
+	wrappedCommits, err := c.getWrappedCommits(ctx, repo, commitsOpt)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := filterCommits(ctx, c.subRepoPermsChecker, wrappedCommits, repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "filtering commits")
+	}
+
+	counts := make(map[string]int)
+	for _, commit := range commits {
+		author := commit.Author.Name + " <" + commit.Author.Email + ">"
+		var committer string
+		if commit.Committer != nil {
+			committer = commit.Committer.Name + " <" + commit.Committer.Email + ">"
+		}
+		switch opt.GroupBy {
+		case ContributorGroupByAuthor:
+			counts[author]++
+		case ContributorGroupByCommitter:
+			counts[committer]++
+		case ContributorGroupByAuthorAndCommitter:
+			counts[author+"\t"+committer]++
+		}
+	}
+
+	idents := make([]string, 0, len(counts))
+	for ident := range counts {
+		idents = append(idents, ident)
+	}
+	sort.Slice(idents, func(i, j int) bool {
+		if counts[idents[i]] != counts[idents[j]] {
+			return counts[idents[i]] > counts[idents[j]]
+		}
+		return idents[i] < idents[j]
+	})
+
+	var out bytes.Buffer
+	for _, ident := range idents {
+		fmt.Fprintf(&out, "%6d\t%s\n", counts[ident], ident)
+	}
+	return parseShortLog(out.Bytes(), opt.GroupBy == ContributorGroupByAuthorAndCommitter)
+}
+
+// CommitActivityOptions specifies options for CommitActivity.
+type CommitActivityOptions struct {
+	Range    string                           // the revision whose history is counted, HEAD if empty
+	After    time.Time                        // count only commits after this time (optional)
+	Before   time.Time                        // count only commits before this time (optional)
+	Path     string                           // count only commits that touch this path (optional)
+	Interval gitdomain.CommitActivityInterval // the size of the buckets
+}
+
+func (o *CommitActivityOptions) Attrs() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("range", o.Range),
+		attribute.String("after", o.After.Format(time.RFC3339)),
+		attribute.String("before", o.Before.Format(time.RFC3339)),
+		attribute.String("path", o.Path),
+		attribute.Int("interval", int(o.Interval)),
+	}
+}
+
+func (c *clientImplementor) CommitActivity(ctx context.Context, repo api.RepoName, opt CommitActivityOptions) (_ []gitdomain.CommitActivityBucket, err error) {
+	ctx, _, endObservation := c.operations.commitActivity.With(ctx, &err, observation.Args{Attrs: opt.Attrs(), MetricLabelValues: []string{c.scope}})
+	defer endObservation(1, observation.Args{})
+
+	if opt.Range == "" {
+		opt.Range = "HEAD"
+	}
+	if err := checkSpecArgSafety(opt.Range); err != nil {
+		return nil, err
+	}
+	if authz.SubRepoEnabled(c.subRepoPermsChecker) {
+		return c.filteredCommitActivity(ctx, repo, opt)
+	}
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &proto.CommitActivityRequest{
+		RepoName: string(repo),
+		Revspec:  []byte(opt.Range),
+		Path:     []byte(opt.Path),
+		Interval: opt.Interval.ToProto(),
+	}
+	if !opt.After.IsZero() {
+		req.After = timestamppb.New(opt.After)
+	}
+	if !opt.Before.IsZero() {
+		req.Before = timestamppb.New(opt.Before)
+	}
+	res, err := client.CommitActivity(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]gitdomain.CommitActivityBucket, 0, len(res.GetBuckets()))
+	for _, b := range res.GetBuckets() {
+		buckets = append(buckets, gitdomain.CommitActivityBucketFromProto(b))
+	}
+	return buckets, nil
+}
+
+// filteredCommitActivity is CommitActivity for when sub-repo permissions are
+// enabled. Like filteredContributorCount, it lists the commits with their
+// files and filters them like Commits before counting them here, since
+// gitserver can't leave out the commits the actor can't see.
+func (c *clientImplementor) filteredCommitActivity(ctx context.Context, repo api.RepoName, opt CommitActivityOptions) ([]gitdomain.CommitActivityBucket, error) {
+	if _, err := c.ResolveRevision(ctx, repo, opt.Range, ResolveRevisionOptions{}); err != nil {
+		return nil, err
+	}
+	commitsOpt := CommitsOptions{
+		Range:    opt.Range,
+		NoMerges: true,
+		After:    opt.After,
+		Before:   opt.Before,
+		Path:     opt.Path,
+		NameOnly: true,
+	}
+	wrappedCommits, err := c.getWrappedCommits(ctx, repo, commitsOpt)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := filterCommits(ctx, c.subRepoPermsChecker, wrappedCommits, repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "filtering commits")
+	}
+
+	dates := make([]time.Time, 0, len(commits))
+	for _, commit := range commits {
+		if commit.Committer != nil {
+			dates = append(dates, commit.Committer.Date)
+		}
+	}
+	return gitdomain.BucketCommitActivity(dates, opt.Interval, opt.After, opt.Before), nil
 }
 
 // logEntryPattern is the regexp pattern that matches entries in the output of the `git shortlog
 // -sne` command.
 var logEntryPattern = lazyregexp.New(`^\s*([0-9]+)\s+(.*)$`)
 
-func parseShortLog(out []byte) ([]*gitdomain.ContributorCount, error) {
+func parseShortLog(out []byte, withCommitter bool) ([]*gitdomain.ContributorCount, error) {
 	out = bytes.TrimSpace(out)
 	if len(out) == 0 {
 		return nil, nil
@@ -221,19 +840,39 @@ func parseShortLog(out []byte) ([]*gitdomain.ContributorCount, error) {
 		if err != nil {
 			return nil, err
 		}
-		addr, err := lenientParseAddress(string(match[2]))
-		if err != nil || addr == nil {
-			addr = &mail.Address{Name: string(match[2])}
+		ident := match[2]
+		var committer *mail.Address
+		if withCommitter {
+			// example ident: "Jane Doe <jane@sourcegraph.com>\tBot Of Doom <bot@doombot.com>"
+			author, c, ok := bytes.Cut(ident, []byte{'\t'})
+			if !ok {
+				return nil, errors.Errorf("invalid git shortlog line: %q", line)
+			}
+			ident = author
+			committer = parseShortLogAddress(c)
 		}
+		addr := parseShortLogAddress(ident)
 		results[i] = &gitdomain.ContributorCount{
 			Count: int32(count),
 			Name:  addr.Name,
 			Email: addr.Address,
 		}
+		if committer != nil {
+			results[i].CommitterName = committer.Name
+			results[i].CommitterEmail = committer.Address
+		}
 	}
 	return results, nil
 }
 
+func parseShortLogAddress(ident []byte) *mail.Address {
+	addr, err := lenientParseAddress(string(ident))
+	if err != nil || addr == nil {
+		addr = &mail.Address{Name: string(ident)}
+	}
+	return addr
+}
+
 // lenientParseAddress is just like mail.ParseAddress, except that it treats
 // the following somewhat-common malformed syntax where a user has misconfigured
 // their email address as their name:
@@ -287,18 +926,22 @@ func (c *clientImplementor) CommitGraph(ctx context.Context, repo api.RepoName,
 	})
 	defer endObservation(1, observation.Args{})
 
-	args := []string{"log", "--pretty=%H %P", "--topo-order"}
+	log := gitcmd.Log().FlagValue("--pretty", "%H %P").Flag("--topo-order")
 	if opts.AllRefs {
-		args = append(args, "--all")
+		log.Flag("--all")
 	}
 	if opts.Commit != "" {
-		args = append(args, opts.Commit)
+		log.Rev(opts.Commit)
 	}
 	if opts.Since != nil {
-		args = append(args, fmt.Sprintf("--since=%s", opts.Since.Format(time.RFC3339)))
+		log.FlagValue("--since", opts.Since.Format(time.RFC3339))
 	}
 	if opts.Limit > 0 {
-		args = append(args, fmt.Sprintf("-%d", opts.Limit))
+		log.MaxCount(opts.Limit)
+	}
+	args, err := log.Args()
+	if err != nil {
+		return nil, err
 	}
 
 	cmd := c.gitCommand(repo, args...)
@@ -311,22 +954,110 @@ func (c *clientImplementor) CommitGraph(ctx context.Context, repo api.RepoName,
 	return gitdomain.ParseCommitGraph(strings.Split(string(out), "\n")), nil
 }
 
-// CommitLog returns the repository commit log, including the file paths that were changed. The general approach to parsing
-// is to separate the first line (the metadata line) from the remaining lines (the files), and then parse the metadata line
-// into component parts separately.
-func (c *clientImplementor) CommitLog(ctx context.Context, repo api.RepoName, after time.Time) (_ []CommitLog, err error) {
-	ctx, _, endObservation := c.operations.commitLog.With(ctx, &err, observation.Args{
+func (c *clientImplementor) StreamCommitGraph(ctx context.Context, repo api.RepoName, opt StreamCommitGraphOptions) (_ CommitGraphNodeReader, err error) {
+	ctx, _, endObservation := c.operations.streamCommitGraph.With(ctx, &err, observation.Args{
 		MetricLabelValues: []string{c.scope},
 		Attrs: []attribute.KeyValue{
 			repo.Attr(),
-			attribute.Stringer("after", after),
+			attribute.String("head", opt.Head),
+			attribute.String("base", opt.Base),
+			attribute.Int("maxCommits", opt.MaxCommits),
 		},
 	})
-	defer endObservation(1, observation.Args{})
 
-	args := []string{"log", "--pretty=format:%H<!>%ae<!>%an<!>%ad", "--name-only", "--topo-order", "--no-merges"}
-	if !after.IsZero() {
-		args = append(args, fmt.Sprintf("--after=%s", after.Format(time.RFC3339)))
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cc, err := client.CommitGraph(ctx, &proto.CommitGraphRequest{
+		RepoName:   string(repo),
+		Head:       []byte(opt.Head),
+		Base:       []byte(opt.Base),
+		MaxCommits: uint32(opt.MaxCommits),
+	})
+	if err != nil {
+		cancel()
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	// We start by reading the first chunk to early-exit on potential errors,
+	// ie. revision not found errors.
+	first, firstErr := cc.Recv()
+	if firstErr != nil && firstErr != io.EOF {
+		cancel()
+		err = firstErr
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	return &grpcCommitGraphNodeReader{
+		c:              cc,
+		buf:            first.GetNodes(),
+		done:           firstErr == io.EOF,
+		cancel:         cancel,
+		endObservation: func() { endObservation(1, observation.Args{}) },
+	}, nil
+}
+
+type grpcCommitGraphNodeReader struct {
+	c              proto.GitserverService_CommitGraphClient
+	cancel         context.CancelFunc
+	endObservation func()
+	// buf holds the nodes of the last received chunk that have not been
+	// read yet.
+	buf []*proto.CommitGraphNode
+	// done is set if the stream ended while reading the first chunk.
+	done bool
+}
+
+func (r *grpcCommitGraphNodeReader) Read() (*gitdomain.CommitGraphNode, error) {
+	for len(r.buf) == 0 {
+		if r.done {
+			return nil, io.EOF
+		}
+		resp, err := r.c.Recv()
+		if err != nil {
+			return nil, err
+		}
+		r.buf = resp.GetNodes()
+	}
+	node := r.buf[0]
+	r.buf = r.buf[1:]
+	return gitdomain.CommitGraphNodeFromProto(node), nil
+}
+
+func (r *grpcCommitGraphNodeReader) Close() error {
+	r.cancel()
+	r.endObservation()
+	return nil
+}
+
+// CommitLog returns the repository commit log, including the file paths that were changed. The general approach to parsing
+// is to separate the first line (the metadata line) from the remaining lines (the files), and then parse the metadata line
+// into component parts separately.
+func (c *clientImplementor) CommitLog(ctx context.Context, repo api.RepoName, after time.Time) (_ []CommitLog, err error) {
+	ctx, _, endObservation := c.operations.commitLog.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.Stringer("after", after),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	log := gitcmd.Log().
+		FlagValue("--pretty", "format:%H<!>%ae<!>%an<!>%ad").
+		Flag("--name-only", "--topo-order", "--no-merges")
+	if !after.IsZero() {
+		log.FlagValue("--after", after.Format(time.RFC3339))
+	}
+	args, err := log.Args()
+	if err != nil {
+		return nil, err
 	}
 
 	cmd := c.gitCommand(repo, args...)
@@ -397,7 +1128,15 @@ func (c *clientImplementor) DiffSymbols(ctx context.Context, repo api.RepoName,
 	})
 	defer endObservation(1, observation.Args{})
 
-	command := c.gitCommand(repo, "diff", "-z", "--name-status", "--no-renames", string(commitA), string(commitB))
+	args, err := gitcmd.Diff().
+		NullTerminated().
+		Flag("--name-status", "--no-renames").
+		Rev(string(commitA), string(commitB)).
+		Args()
+	if err != nil {
+		return nil, err
+	}
+	command := c.gitCommand(repo, args...)
 	out, err := command.Output(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to run git diff on %s between %s and %s", repo, commitA, commitB)
@@ -428,18 +1167,165 @@ func (c *clientImplementor) ReadDir(ctx context.Context, repo api.RepoName, comm
 		path = filepath.Clean(rel(path)) + "/"
 	}
 	files, err := c.lsTree(ctx, repo, commit, path, recurse)
-
-	if err != nil || !authz.SubRepoEnabled(c.subRepoPermsChecker) {
+	if err != nil {
 		return files, err
 	}
 
-	a := actor.FromContext(ctx)
-	filtered, filteringErr := authz.FilterActorFileInfos(ctx, c.subRepoPermsChecker, a, repo, files)
-	if filteringErr != nil {
-		return nil, errors.Wrap(err, "filtering paths")
-	} else {
-		return filtered, nil
+	if authz.SubRepoEnabled(c.subRepoPermsChecker) {
+		a := actor.FromContext(ctx)
+		files, err = authz.FilterActorFileInfos(ctx, c.subRepoPermsChecker, a, repo, files)
+		if err != nil {
+			return nil, errors.Wrap(err, "filtering paths")
+		}
+	}
+
+	// The limit is applied after filtering, so that the number of truncated
+	// entries doesn't reveal files the actor can't see.
+	return truncateEntries(files, responseLimitsFromContext(ctx).MaxEntries)
+}
+
+func (c *clientImplementor) GetTree(ctx context.Context, repo api.RepoName, treeish string, path string) (_ []gitdomain.TreeEntry, err error) {
+	ctx, _, endObservation := c.operations.getTree.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("treeish", treeish),
+			attribute.String("path", path),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := client.GetTree(ctx, &proto.GetTreeRequest{
+		RepoName: string(repo),
+		Treeish:  []byte(treeish),
+		Path:     []byte(path),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []gitdomain.TreeEntry{}
+	for {
+		resp, err := cc.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+				for _, d := range s.Details() {
+					if _, ok := d.(*proto.FileNotFoundPayload); ok {
+						return nil, &os.PathError{Op: "ls-tree", Path: path, Err: os.ErrNotExist}
+					}
+				}
+			}
+			return nil, err
+		}
+		for _, e := range resp.GetEntries() {
+			entries = append(entries, gitdomain.TreeEntryFromProto(e))
+		}
+	}
+
+	return truncateEntries(entries, responseLimitsFromContext(ctx).MaxEntries)
+}
+
+func (c *clientImplementor) SparseManifest(ctx context.Context, repo api.RepoName, commit api.CommitID, paths []string) (_ []gitdomain.TreeEntry, err error) {
+	ctx, _, endObservation := c.operations.sparseManifest.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			commit.Attr(),
+			attribute.StringSlice("paths", paths),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &proto.SparseManifestRequest{
+		RepoName:  string(repo),
+		CommitSha: string(commit),
+	}
+	for _, p := range paths {
+		req.Paths = append(req.Paths, []byte(p))
+	}
+
+	cc, err := client.SparseManifest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []gitdomain.TreeEntry{}
+	for {
+		resp, err := cc.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		for _, e := range resp.GetEntries() {
+			entries = append(entries, gitdomain.TreeEntryFromProto(e))
+		}
+	}
+
+	return truncateEntries(entries, responseLimitsFromContext(ctx).MaxEntries)
+}
+
+func (c *clientImplementor) LastCommitsForTree(ctx context.Context, repo api.RepoName, commit api.CommitID, path string) (_ []gitdomain.TreeEntryCommit, err error) {
+	ctx, _, endObservation := c.operations.lastCommitsForTree.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			commit.Attr(),
+			attribute.String("path", path),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := client.LastCommitsForTree(ctx, &proto.LastCommitsForTreeRequest{
+		RepoName:  string(repo),
+		CommitSha: string(commit),
+		Path:      []byte(path),
+	})
+	if err != nil {
+		return nil, err
 	}
+
+	entries := []gitdomain.TreeEntryCommit{}
+	for {
+		resp, err := cc.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+				for _, d := range s.Details() {
+					if _, ok := d.(*proto.FileNotFoundPayload); ok {
+						return nil, &os.PathError{Op: "ls-tree", Path: path, Err: os.ErrNotExist}
+					}
+				}
+			}
+			return nil, err
+		}
+		for _, e := range resp.GetEntries() {
+			entries = append(entries, gitdomain.TreeEntryCommitFromProto(e))
+		}
+	}
+
+	return truncateEntries(entries, responseLimitsFromContext(ctx).MaxEntries)
 }
 
 // lsTreeRootCache caches the result of running `git ls-tree ...` on a repository's root path
@@ -576,18 +1462,20 @@ func (c *clientImplementor) lsTreeUncached(ctx context.Context, repo api.RepoNam
 		return nil, err
 	}
 
-	args := []string{
-		"ls-tree",
-		"--long", // show size
-		"--full-name",
-		"-z",
-		string(commit),
-	}
+	lsTree := gitcmd.LsTree().
+		Flag("--long"). // show size
+		Flag("--full-name").
+		NullTerminated().
+		Rev(string(commit))
 	if recurse {
-		args = append(args, "-r", "-t")
+		lsTree.Recursive().Flag("-t")
 	}
 	if path != "" {
-		args = append(args, "--", filepath.ToSlash(path))
+		lsTree.Path(filepath.ToSlash(path))
+	}
+	args, err := lsTree.Args()
+	if err != nil {
+		return nil, err
 	}
 	cmd := c.gitCommand(repo, args...)
 	out, err := cmd.CombinedOutput(ctx)
@@ -669,7 +1557,11 @@ func (c *clientImplementor) lsTreeUncached(ctx context.Context, repo api.RepoNam
 			}
 		case "commit":
 			mode = mode | gitdomain.ModeSubmodule
-			cmd := c.gitCommand(repo, "show", fmt.Sprintf("%s:.gitmodules", commit))
+			args, err := gitcmd.Show().Rev(fmt.Sprintf("%s:.gitmodules", commit)).Args()
+			if err != nil {
+				return nil, err
+			}
+			cmd := c.gitCommand(repo, args...)
 			var submodule gitdomain.Submodule
 			if out, err := cmd.Output(ctx); err == nil {
 
@@ -728,7 +1620,11 @@ func (c *clientImplementor) LogReverseEach(ctx context.Context, repo string, com
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	command := c.gitCommand(api.RepoName(repo), gitdomain.LogReverseArgs(n, commit)...)
+	args, err := gitdomain.LogReverseArgs(n, commit)
+	if err != nil {
+		return err
+	}
+	command := c.gitCommand(api.RepoName(repo), args...)
 
 	// We run a single `git log` command and stream the output while the repo is being processed, which
 	// can take much longer than 1 minute (the default timeout).
@@ -752,6 +1648,18 @@ func (c *clientImplementor) StreamBlameFile(ctx context.Context, repo api.RepoNa
 		}, opt.Attrs()...),
 	})
 
+	// Like Stat, we pretend that files the actor can't read don't exist, and
+	// don't blame them at all, as the hunks would reveal their history.
+	canRead, err := authz.FilterActorPath(ctx, c.subRepoPermsChecker, actor.FromContext(ctx), repo, rel(path))
+	if err != nil {
+		endObservation(1, observation.Args{})
+		return nil, errors.Wrap(err, "filtering paths")
+	}
+	if !canRead {
+		endObservation(1, observation.Args{})
+		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+
 	client, err := c.clientSource.ClientForRepo(ctx, repo)
 	if err != nil {
 		endObservation(1, observation.Args{})
@@ -763,6 +1671,7 @@ func (c *clientImplementor) StreamBlameFile(ctx context.Context, repo api.RepoNa
 		Commit:           string(opt.NewestCommit),
 		Path:             path,
 		IgnoreWhitespace: opt.IgnoreWhitespace,
+		CopyDetection:    opt.CopyDetection.ToProto(),
 	}
 	if opt.Range != nil {
 		req.Range = &proto.BlameRange{
@@ -814,13 +1723,22 @@ func (c *clientImplementor) StreamBlameFile(ctx context.Context, repo api.RepoNa
 	if firstHunkResp != nil {
 		hunk = firstHunkResp.GetHunk()
 	}
-	return &grpcBlameHunkReader{
+	br := &grpcBlameHunkReader{
 		firstHunk:      hunk,
 		firstHunkErr:   err,
 		c:              cli,
 		cancel:         cancel,
 		endObservation: func() { endObservation(1, observation.Args{}) },
-	}, nil
+	}
+	if maxResumes := readResumptionFromContext(ctx); maxResumes > 0 && gitdomain.IsAbsoluteRevision(string(opt.NewestCommit)) {
+		br.resumption = &blameResumption{
+			ctx:        ctx,
+			client:     client,
+			req:        req,
+			maxResumes: maxResumes,
+		}
+	}
+	return br, nil
 }
 
 type grpcBlameHunkReader struct {
@@ -830,6 +1748,10 @@ type grpcBlameHunkReader struct {
 	c              proto.GitserverService_BlameClient
 	cancel         context.CancelFunc
 	endObservation func()
+	// resumption is nil unless the blame is resumed after transient errors.
+	resumption *blameResumption
+	// pending are hunks to return before receiving the next one.
+	pending []*gitdomain.Hunk
 }
 
 func (r *grpcBlameHunkReader) Read() (_ *gitdomain.Hunk, err error) {
@@ -838,13 +1760,28 @@ func (r *grpcBlameHunkReader) Read() (_ *gitdomain.Hunk, err error) {
 		if r.firstHunkErr != nil {
 			return nil, r.firstHunkErr
 		}
-		return gitdomain.HunkFromBlameProto(r.firstHunk), nil
+		r.pending = r.resumption.track(gitdomain.HunkFromBlameProto(r.firstHunk))
 	}
-	p, err := r.c.Recv()
-	if err != nil {
-		return nil, err
+	for {
+		if len(r.pending) > 0 {
+			hunk := r.pending[0]
+			r.pending = r.pending[1:]
+			return hunk, nil
+		}
+		p, err := r.c.Recv()
+		if err != nil {
+			if !r.resumption.canResume(err) {
+				return nil, err
+			}
+			c, err := r.resumption.resume()
+			if err != nil {
+				return nil, err
+			}
+			r.c = c
+			continue
+		}
+		r.pending = r.resumption.track(gitdomain.HunkFromBlameProto(p.GetHunk()))
 	}
-	return gitdomain.HunkFromBlameProto(p.GetHunk()), nil
 }
 
 func (r *grpcBlameHunkReader) Close() error {
@@ -939,18 +1876,15 @@ func (c *clientImplementor) LsFiles(ctx context.Context, repo api.RepoName, comm
 	})
 	defer endObservation(1, observation.Args{})
 
-	args := []string{
-		"ls-files",
-		"-z",
-		"--with-tree",
-		string(commit),
+	lsFiles := gitcmd.LsFiles().
+		NullTerminated().
+		FlagValue("--with-tree", string(commit))
+	for _, pathspec := range pathspecs {
+		lsFiles.Path(string(pathspec))
 	}
-
-	if len(pathspecs) > 0 {
-		args = append(args, "--")
-		for _, pathspec := range pathspecs {
-			args = append(args, string(pathspec))
-		}
+	args, err := lsFiles.Args()
+	if err != nil {
+		return nil, err
 	}
 
 	cmd := c.gitCommand(repo, args...)
@@ -964,7 +1898,11 @@ func (c *clientImplementor) LsFiles(ctx context.Context, repo api.RepoName, comm
 	if len(files) > 0 && files[len(files)-1] == "" {
 		files = files[:len(files)-1]
 	}
-	return filterPaths(ctx, c.subRepoPermsChecker, repo, files)
+	files, err = filterPaths(ctx, c.subRepoPermsChecker, repo, files)
+	if err != nil {
+		return nil, err
+	}
+	return truncateEntries(files, responseLimitsFromContext(ctx).MaxEntries)
 }
 
 // 🚨 SECURITY: All git methods that deal with file or path access need to have
@@ -1000,8 +1938,14 @@ func (c *clientImplementor) ListDirectoryChildren(
 	})
 	defer endObservation(1, observation.Args{})
 
-	args := []string{"ls-tree", "--name-only", string(commit), "--"}
-	args = append(args, cleanDirectoriesForLsTree(dirnames)...)
+	args, err := gitcmd.LsTree().
+		NameOnly().
+		Rev(string(commit)).
+		Path(cleanDirectoriesForLsTree(dirnames)...).
+		Args()
+	if err != nil {
+		return nil, err
+	}
 	cmd := c.gitCommand(repo, args...)
 
 	out, err := cmd.CombinedOutput(ctx)
@@ -1136,59 +2080,639 @@ func (c *clientImplementor) MergeBase(ctx context.Context, repo api.RepoName, ba
 	return api.CommitID(res.GetMergeBaseCommitSha()), nil
 }
 
-// RevList makes a git rev-list call and iterates through the resulting commits, calling the provided onCommit function for each.
-func (c *clientImplementor) RevList(ctx context.Context, repo string, commit string, onCommit func(commit string) (shouldContinue bool, err error)) (err error) {
-	ctx, _, endObservation := c.operations.revList.With(ctx, &err, observation.Args{
+func (c *clientImplementor) MergeBaseCrossRepo(ctx context.Context, baseRepo api.RepoName, baseRev string, headRepo api.RepoName, headRev string) (_ api.CommitID, err error) {
+	ctx, _, endObservation := c.operations.mergeBaseCrossRepo.With(ctx, &err, observation.Args{
 		MetricLabelValues: []string{c.scope},
 		Attrs: []attribute.KeyValue{
-			attribute.String("repo", repo),
-			attribute.String("commit", commit),
+			attribute.String("baseRepo", string(baseRepo)),
+			attribute.String("base", baseRev),
+			attribute.String("headRepo", string(headRepo)),
+			attribute.String("head", headRev),
 		},
 	})
 	defer endObservation(1, observation.Args{})
 
-	command := c.gitCommand(api.RepoName(repo), RevListArgs(commit)...)
-	command.DisableTimeout()
-	stdout, err := command.StdoutReader(ctx)
+	base, err := c.fetchCommitFromRepo(ctx, headRepo, baseRepo, baseRev)
 	if err != nil {
-		return err
+		return "", err
 	}
-	defer stdout.Close()
-
-	return gitdomain.RevListEach(stdout, onCommit)
+	return c.MergeBase(ctx, headRepo, string(base), headRev)
 }
 
-func RevListArgs(givenCommit string) []string {
-	return []string{"rev-list", "--first-parent", givenCommit}
+// fetchCommitFromRepo resolves rev in source and makes the commit available
+// in repo, so that it can be passed to the RPCs for repo.
+func (c *clientImplementor) fetchCommitFromRepo(ctx context.Context, repo, source api.RepoName, rev string) (api.CommitID, error) {
+	commit, err := c.ResolveRevision(ctx, source, rev, ResolveRevisionOptions{})
+	if err != nil {
+		return "", err
+	}
+	if source == repo {
+		return commit, nil
+	}
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+	if _, err := client.FetchCommitFromRepo(ctx, &proto.FetchCommitFromRepoRequest{
+		RepoName:       string(repo),
+		SourceRepoName: string(source),
+		Commit:         string(commit),
+	}); err != nil {
+		return "", err
+	}
+	return commit, nil
 }
 
-// GetBehindAhead returns the behind/ahead commit counts information for right vs. left (both Git
-// revspecs).
-func (c *clientImplementor) GetBehindAhead(ctx context.Context, repo api.RepoName, left, right string) (_ *gitdomain.BehindAhead, err error) {
-	ctx, _, endObservation := c.operations.getBehindAhead.With(ctx, &err, observation.Args{
+func (c *clientImplementor) Cherry(ctx context.Context, repo api.RepoName, upstream, head string) (_ []gitdomain.CherryCommit, err error) {
+	ctx, _, endObservation := c.operations.cherry.With(ctx, &err, observation.Args{
 		MetricLabelValues: []string{c.scope},
 		Attrs: []attribute.KeyValue{
 			repo.Attr(),
-			attribute.String("left", left),
-			attribute.String("right", right),
+			attribute.String("upstream", upstream),
+			attribute.String("head", head),
 		},
 	})
 	defer endObservation(1, observation.Args{})
 
-	if err := checkSpecArgSafety(left); err != nil {
-		return nil, err
-	}
-	if err := checkSpecArgSafety(right); err != nil {
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
 		return nil, err
 	}
 
-	cmd := c.gitCommand(repo, "rev-list", "--count", "--left-right", fmt.Sprintf("%s...%s", left, right))
-	out, err := cmd.Output(ctx)
+	res, err := client.Cherry(ctx, &proto.CherryRequest{
+		RepoName: string(repo),
+		Upstream: []byte(upstream),
+		Head:     []byte(head),
+	})
 	if err != nil {
 		return nil, err
 	}
-	behindAhead := strings.Split(strings.TrimSuffix(string(out), "\n"), "\t")
-	b, err := strconv.ParseUint(behindAhead[0], 10, 0)
+
+	commits := make([]gitdomain.CherryCommit, 0, len(res.GetCommits()))
+	for _, c := range res.GetCommits() {
+		commits = append(commits, gitdomain.CherryCommitFromProto(c))
+	}
+	return commits, nil
+}
+
+func (c *clientImplementor) CherryCrossRepo(ctx context.Context, upstreamRepo api.RepoName, upstream string, headRepo api.RepoName, head string) (_ []gitdomain.CherryCommit, err error) {
+	ctx, _, endObservation := c.operations.cherryCrossRepo.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			attribute.String("upstreamRepo", string(upstreamRepo)),
+			attribute.String("upstream", upstream),
+			attribute.String("headRepo", string(headRepo)),
+			attribute.String("head", head),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	upstreamCommit, err := c.fetchCommitFromRepo(ctx, headRepo, upstreamRepo, upstream)
+	if err != nil {
+		return nil, err
+	}
+	return c.Cherry(ctx, headRepo, string(upstreamCommit), head)
+}
+
+func (c *clientImplementor) RangeDiff(ctx context.Context, repo api.RepoName, range1, range2 string) (_ []*gitdomain.RangeDiffPair, err error) {
+	ctx, _, endObservation := c.operations.rangeDiff.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("range1", range1),
+			attribute.String("range2", range2),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	oldBase, oldHead, err := splitRange(range1)
+	if err != nil {
+		return nil, err
+	}
+	newBase, newHead, err := splitRange(range2)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.RangeDiff(ctx, &proto.RangeDiffRequest{
+		RepoName: string(repo),
+		OldBase:  []byte(oldBase),
+		OldHead:  []byte(oldHead),
+		NewBase:  []byte(newBase),
+		NewHead:  []byte(newHead),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make([]*gitdomain.RangeDiffPair, 0, len(res.GetPairs()))
+	for _, p := range res.GetPairs() {
+		pairs = append(pairs, gitdomain.RangeDiffPairFromProto(p))
+	}
+	return pairs, nil
+}
+
+// splitRange splits a range of the form "base..head" into its ends.
+func splitRange(r string) (base, head string, err error) {
+	base, head, ok := strings.Cut(r, "..")
+	if !ok || base == "" || head == "" || strings.HasPrefix(head, ".") {
+		return "", "", errors.Errorf("invalid range %q, expected base..head", r)
+	}
+	return base, head, nil
+}
+
+func (c *clientImplementor) UpdateRef(ctx context.Context, repo api.RepoName, ref string, newOID, expectedOldOID api.CommitID) (err error) {
+	ctx, _, endObservation := c.operations.updateRef.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("ref", ref),
+			attribute.String("newOID", string(newOID)),
+			attribute.String("expectedOldOID", string(expectedOldOID)),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.UpdateRef(ctx, &proto.UpdateRefRequest{
+		RepoName:             string(repo),
+		RefName:              ref,
+		NewCommitSha:         string(newOID),
+		ExpectedOldCommitSha: string(expectedOldOID),
+	})
+	return err
+}
+
+func (c *clientImplementor) CreateBranch(ctx context.Context, repo api.RepoName, branch, startPoint string) (_ api.CommitID, err error) {
+	ctx, _, endObservation := c.operations.createBranch.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("branch", branch),
+			attribute.String("startPoint", startPoint),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := client.CreateBranch(ctx, &proto.CreateBranchRequest{
+		RepoName:   string(repo),
+		BranchName: branch,
+		StartPoint: []byte(startPoint),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return api.CommitID(res.GetCommitSha()), nil
+}
+
+func (c *clientImplementor) DeleteBranch(ctx context.Context, repo api.RepoName, branch string) (err error) {
+	ctx, _, endObservation := c.operations.deleteBranch.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("branch", branch),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteBranch(ctx, &proto.DeleteBranchRequest{
+		RepoName:   string(repo),
+		BranchName: branch,
+	})
+	return err
+}
+
+// TagOptions configures the tag created by CreateTag.
+type TagOptions struct {
+	// Message is the message of the tag. If set, an annotated tag is created.
+	Message string
+	// Tagger is the identity recorded in an annotated tag. It is required if
+	// Message is set. If the date is zero, the current time is used.
+	Tagger *gitdomain.Signature
+	// Sign creates a signed tag, using the GPG or SSH signing key configured
+	// for git on gitserver. Requires Message to be set.
+	Sign bool
+}
+
+func (c *clientImplementor) CreateTag(ctx context.Context, repo api.RepoName, name, target string, opts TagOptions) (err error) {
+	ctx, _, endObservation := c.operations.createTag.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("name", name),
+			attribute.String("target", target),
+			attribute.Bool("sign", opts.Sign),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	req := &proto.CreateTagRequest{
+		RepoName: string(repo),
+		TagName:  name,
+		Target:   []byte(target),
+		Message:  []byte(opts.Message),
+		Sign:     opts.Sign,
+	}
+	if opts.Tagger != nil {
+		req.Tagger = &proto.GitSignature{
+			Name:  []byte(opts.Tagger.Name),
+			Email: []byte(opts.Tagger.Email),
+		}
+		if !opts.Tagger.Date.IsZero() {
+			req.Tagger.Date = timestamppb.New(opts.Tagger.Date)
+		}
+	}
+
+	_, err = client.CreateTag(ctx, req)
+	return err
+}
+
+func (c *clientImplementor) GetTag(ctx context.Context, repo api.RepoName, name string) (_ *gitdomain.Tag, err error) {
+	ctx, _, endObservation := c.operations.getTag.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("name", name),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.GetTag(ctx, &proto.GetTagRequest{
+		RepoName: string(repo),
+		TagName:  name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return gitdomain.TagFromProto(res.GetTag()), nil
+}
+
+func (c *clientImplementor) SymbolicRef(ctx context.Context, repo api.RepoName, name string) (_ string, err error) {
+	ctx, _, endObservation := c.operations.symbolicRef.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("name", name),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := client.SymbolicRef(ctx, &proto.SymbolicRefRequest{
+		RepoName: string(repo),
+		RefName:  name,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return res.GetTarget(), nil
+}
+
+// ListBranchesOptions configures ListBranches.
+type ListBranchesOptions struct {
+	// Base is the revspec the behind/ahead counts of the branches are
+	// relative to. Defaults to HEAD, the default branch.
+	Base string
+}
+
+func (c *clientImplementor) ListBranches(ctx context.Context, repo api.RepoName, opts ListBranchesOptions) (_ []*gitdomain.Branch, err error) {
+	ctx, _, endObservation := c.operations.listBranches.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("base", opts.Base),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := client.ListBranches(ctx, &proto.ListBranchesRequest{
+		RepoName: string(repo),
+		Base:     []byte(opts.Base),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	branches := make([]*gitdomain.Branch, 0)
+	for {
+		resp, err := cc.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		for _, b := range resp.GetBranches() {
+			branches = append(branches, gitdomain.BranchFromProto(b))
+		}
+	}
+
+	return branches, nil
+}
+
+// ListTagsOptions configures ListTags.
+type ListTagsOptions struct {
+	// Patterns are glob patterns, like "v1.*", matched against the tag names.
+	// If set, only tags matching any of them are returned.
+	Patterns []string
+	// SortByVersion sorts the tags by their names interpreted as versions
+	// instead of by date, highest first. Pre-releases like v1.0.0-rc.1 are
+	// lower than v1.0.0.
+	SortByVersion bool
+	// Limit is the maximum number of tags to return. Zero means no limit.
+	Limit int
+}
+
+func (c *clientImplementor) ListTags(ctx context.Context, repo api.RepoName, opts ListTagsOptions) (_ []gitdomain.Ref, err error) {
+	ctx, _, endObservation := c.operations.listTags.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.StringSlice("patterns", opts.Patterns),
+			attribute.Bool("sortByVersion", opts.SortByVersion),
+			attribute.Int("limit", opts.Limit),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	if opts.Limit < 0 {
+		return nil, errors.New("limit must not be negative")
+	}
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := client.ListTags(ctx, &proto.ListTagsRequest{
+		RepoName:      string(repo),
+		Patterns:      opts.Patterns,
+		SortByVersion: opts.SortByVersion,
+		Limit:         uint32(opts.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]gitdomain.Ref, 0)
+	for {
+		resp, err := cc.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		for _, ref := range resp.GetRefs() {
+			tags = append(tags, gitdomain.RefFromProto(ref))
+		}
+	}
+
+	return tags, nil
+}
+
+func (c *clientImplementor) ListRemoteRefs(ctx context.Context, repo api.RepoName) (_ []gitdomain.Ref, err error) {
+	ctx, _, endObservation := c.operations.listRemoteRefs.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := client.ListRemoteRefs(ctx, &proto.ListRemoteRefsRequest{
+		RepoName: string(repo),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]gitdomain.Ref, 0)
+	for {
+		resp, err := cc.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		for _, ref := range resp.GetRefs() {
+			refs = append(refs, gitdomain.RefFromProto(ref))
+		}
+	}
+
+	return refs, nil
+}
+
+func (c *clientImplementor) FetchRefspec(ctx context.Context, repo api.RepoName, refspec string) (err error) {
+	ctx, _, endObservation := c.operations.fetchRefspec.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("refspec", refspec),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	if err := gitdomain.ValidateFetchRefspec(refspec); err != nil {
+		return err
+	}
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.FetchRefspec(ctx, &proto.FetchRefspecRequest{
+		RepoName: string(repo),
+		Refspec:  refspec,
+	})
+	return err
+}
+
+func (c *clientImplementor) RefExists(ctx context.Context, repo api.RepoName, name string) (_ bool, err error) {
+	ctx, _, endObservation := c.operations.refExists.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("name", name),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := client.RefExists(ctx, &proto.RefExistsRequest{
+		RepoName: string(repo),
+		RefName:  name,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return res.GetExists(), nil
+}
+
+func (c *clientImplementor) BlameSummary(ctx context.Context, repo api.RepoName, commit api.CommitID, path string) (_ []*gitdomain.BlameAuthorSummary, err error) {
+	ctx, _, endObservation := c.operations.blameSummary.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			commit.Attr(),
+			attribute.String("path", path),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.BlameSummary(ctx, &proto.BlameSummaryRequest{
+		RepoName: string(repo),
+		Commit:   string(commit),
+		Path:     path,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			// Don't leak to the caller that the path exists if the actor
+			// has no access to it.
+			if s.Code() == codes.PermissionDenied {
+				return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+			}
+			if s.Code() == codes.NotFound {
+				for _, d := range s.Details() {
+					if _, ok := d.(*proto.FileNotFoundPayload); ok {
+						return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+					}
+				}
+			}
+		}
+		return nil, err
+	}
+
+	authors := make([]*gitdomain.BlameAuthorSummary, 0, len(res.GetAuthors()))
+	for _, a := range res.GetAuthors() {
+		authors = append(authors, gitdomain.BlameAuthorSummaryFromProto(a))
+	}
+	return authors, nil
+}
+
+// RevList makes a git rev-list call and iterates through the resulting commits, calling the provided onCommit function for each.
+func (c *clientImplementor) RevList(ctx context.Context, repo string, commit string, onCommit func(commit string) (shouldContinue bool, err error)) (err error) {
+	ctx, _, endObservation := c.operations.revList.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			attribute.String("repo", repo),
+			attribute.String("commit", commit),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	args, err := RevListArgs(commit)
+	if err != nil {
+		return err
+	}
+	command := c.gitCommand(api.RepoName(repo), args...)
+	command.DisableTimeout()
+	stdout, err := command.StdoutReader(ctx)
+	if err != nil {
+		return err
+	}
+	defer stdout.Close()
+
+	return gitdomain.RevListEach(stdout, onCommit)
+}
+
+func RevListArgs(givenCommit string) ([]string, error) {
+	return gitcmd.RevList().Flag("--first-parent").Rev(givenCommit).Args()
+}
+
+// GetBehindAhead returns the behind/ahead commit counts information for right vs. left (both Git
+// revspecs).
+func (c *clientImplementor) GetBehindAhead(ctx context.Context, repo api.RepoName, left, right string) (_ *gitdomain.BehindAhead, err error) {
+	ctx, _, endObservation := c.operations.getBehindAhead.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("left", left),
+			attribute.String("right", right),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	if err := checkSpecArgSafety(left); err != nil {
+		return nil, err
+	}
+	if err := checkSpecArgSafety(right); err != nil {
+		return nil, err
+	}
+
+	args, err := gitcmd.RevList().
+		Flag("--count", "--left-right").
+		Rev(fmt.Sprintf("%s...%s", left, right)).
+		Args()
+	if err != nil {
+		return nil, err
+	}
+	cmd := c.gitCommand(repo, args...)
+	out, err := cmd.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+	behindAhead := strings.Split(strings.TrimSuffix(string(out), "\n"), "\t")
+	b, err := strconv.ParseUint(behindAhead[0], 10, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -1199,7 +2723,17 @@ func (c *clientImplementor) GetBehindAhead(ctx context.Context, repo api.RepoNam
 	return &gitdomain.BehindAhead{Behind: uint32(b), Ahead: uint32(a)}, nil
 }
 
-func (c *clientImplementor) NewFileReader(ctx context.Context, repo api.RepoName, commit api.CommitID, name string) (_ io.ReadCloser, err error) {
+func (c *clientImplementor) NewFileReader(ctx context.Context, repo api.RepoName, commit api.CommitID, name string) (io.ReadCloser, error) {
+	return c.newFileReader(ctx, repo, commit, name, gitdomain.OID{})
+}
+
+func (c *clientImplementor) NewFileReaderIfNotOID(ctx context.Context, repo api.RepoName, commit api.CommitID, name string, oid gitdomain.OID) (io.ReadCloser, error) {
+	return c.newFileReader(ctx, repo, commit, name, oid)
+}
+
+// newFileReader implements NewFileReader. If ifNotOID is not zero, the read
+// is conditional on the blob of the file having a different object ID.
+func (c *clientImplementor) newFileReader(ctx context.Context, repo api.RepoName, commit api.CommitID, name string, ifNotOID gitdomain.OID) (_ io.ReadCloser, err error) {
 	ctx, _, endObservation := c.operations.newFileReader.With(ctx, &err, observation.Args{
 		MetricLabelValues: []string{c.scope},
 		Attrs: []attribute.KeyValue{
@@ -1216,9 +2750,13 @@ func (c *clientImplementor) NewFileReader(ctx context.Context, repo api.RepoName
 	}
 
 	req := &proto.ReadFileRequest{
-		RepoName: string(repo),
-		Commit:   string(commit),
-		Path:     rel(name),
+		RepoName:      string(repo),
+		Commit:        string(commit),
+		Path:          rel(name),
+		IncludeSha256: contentVerificationFromContext(ctx),
+	}
+	if ifNotOID != (gitdomain.OID{}) {
+		req.IfNotOid = ifNotOID.String()
 	}
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -1261,8 +2799,121 @@ func (c *clientImplementor) NewFileReader(ctx context.Context, repo api.RepoName
 		}
 	}
 
+	// Gitservers that don't support conditional reads still send the OID, so
+	// we don't rely on them setting NotModified.
+	if firstRespErr == nil && req.GetIfNotOid() != "" && (firstResp.GetNotModified() || firstResp.GetBlobOid() == req.GetIfNotOid()) {
+		cancel()
+		endObservation(1, observation.Args{})
+		return nil, ErrNotModified
+	}
+
+	br := &blobReader{
+		cancel: cancel,
+		onClose: func() {
+			endObservation(1, observation.Args{})
+		},
+	}
+	if oid := firstResp.GetBlobOid(); oid != "" {
+		// The OID is only informational, so a malformed one isn't worth
+		// failing the read for.
+		br.oid, _ = decodeOID(oid)
+	}
+
+	maxResumes := readResumptionFromContext(ctx)
+	if !gitdomain.IsAbsoluteRevision(string(commit)) {
+		maxResumes = 0
+	}
+	var received int64
+	resumes := 0
+
+	var digest hash.Hash
+	var wantDigest []byte
+	if req.GetIncludeSha256() {
+		digest = sha256.New()
+	}
+
 	firstRespRead := false
-	r := streamio.NewReader(func() ([]byte, error) {
+	br.Reader = streamio.NewReader(limitRecv(responseLimitsFromContext(ctx).MaxBytes, func() ([]byte, error) {
+		for {
+			var m *proto.ReadFileResponse
+			var err error
+			if !firstRespRead {
+				firstRespRead = true
+				m, err = firstResp, firstRespErr
+			} else {
+				m, err = cli.Recv()
+			}
+			if err == nil {
+				if sum := m.GetSha256(); sum != nil {
+					wantDigest = sum
+				}
+				data := m.GetData()
+				if len(data) == 0 {
+					continue
+				}
+				received += int64(len(data))
+				if digest != nil {
+					digest.Write(data)
+				}
+				return data, nil
+			}
+			if err == io.EOF && digest != nil && wantDigest != nil && !bytes.Equal(digest.Sum(nil), wantDigest) {
+				return nil, &ContentMismatchError{Repo: repo, Commit: commit, Path: req.GetPath()}
+			}
+			if resumes >= maxResumes || !isResumableStreamError(err) {
+				return nil, err
+			}
+
+			// Pick up where the interrupted stream stopped.
+			resumes++
+			req.Offset = received
+			cli, err = client.ReadFile(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}))
+
+	return br, nil
+}
+
+func (c *clientImplementor) ReadBlob(ctx context.Context, repo api.RepoName, oid gitdomain.OID) (_ io.ReadCloser, err error) {
+	ctx, _, endObservation := c.operations.readBlob.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("oid", oid.String()),
+		},
+	})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cli, err := client.ReadBlob(ctx, &proto.ReadBlobRequest{
+		RepoName: string(repo),
+		Oid:      oid.String(),
+	})
+	if err != nil {
+		cancel()
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	// Like NewFileReader, we read the first message to return errors early.
+	firstResp, firstRespErr := cli.Recv()
+	if firstRespErr != nil && firstRespErr != io.EOF {
+		cancel()
+		err = firstRespErr
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	firstRespRead := false
+	r := streamio.NewReader(limitRecv(responseLimitsFromContext(ctx).MaxBytes, func() ([]byte, error) {
 		if !firstRespRead {
 			firstRespRead = true
 			if firstRespErr != nil {
@@ -1276,10 +2927,11 @@ func (c *clientImplementor) NewFileReader(ctx context.Context, repo api.RepoName
 			return nil, err
 		}
 		return m.GetData(), nil
-	})
+	}))
 
 	return &blobReader{
 		Reader: r,
+		oid:    oid,
 		cancel: cancel,
 		onClose: func() {
 			endObservation(1, observation.Args{})
@@ -1289,10 +2941,17 @@ func (c *clientImplementor) NewFileReader(ctx context.Context, repo api.RepoName
 
 type blobReader struct {
 	io.Reader
+	oid     gitdomain.OID
 	cancel  context.CancelFunc
 	onClose func()
 }
 
+// OID returns the object ID of the blob, which makes blobReader a
+// gitdomain.ObjectInfo. It is zero for submodules.
+func (br *blobReader) OID() gitdomain.OID {
+	return br.oid
+}
+
 func (br *blobReader) Close() error {
 	br.cancel()
 	br.onClose()
@@ -1324,6 +2983,90 @@ func (c *clientImplementor) Stat(ctx context.Context, repo api.RepoName, commit
 	return fi, nil
 }
 
+func (c *clientImplementor) PathExists(ctx context.Context, repo api.RepoName, commit api.CommitID, path string) (_ bool, _ gitdomain.ObjectType, err error) {
+	ctx, _, endObservation := c.operations.pathExists.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			commit.Attr(),
+			attribute.String("path", path),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	if err := gitdomain.EnsureAbsoluteCommit(commit); err != nil {
+		return false, "", err
+	}
+
+	path = filepath.ToSlash(filepath.Clean(rel(path)))
+	if path == "." {
+		// The root tree exists in every commit, and is not returned by `git
+		// ls-tree`.
+		if _, err := c.GetObject(ctx, repo, string(commit)+"^{tree}"); err != nil {
+			return false, "", err
+		}
+		return true, gitdomain.ObjectTypeTree, nil
+	}
+
+	if err := checkSpecArgSafety(path); err != nil {
+		return false, "", err
+	}
+
+	// Only the type of the entry is needed, so unlike lStat this doesn't ask
+	// for sizes or read .gitmodules for submodules.
+	args, err := gitcmd.LsTree().
+		Flag("--full-name").
+		NullTerminated().
+		Rev(string(commit)).
+		Path(path).
+		Args()
+	if err != nil {
+		return false, "", err
+	}
+	cmd := c.gitCommand(repo, args...)
+	out, err := cmd.CombinedOutput(ctx)
+	if err != nil {
+		if bytes.Contains(out, []byte("exists on disk, but not in")) {
+			return false, "", nil
+		}
+		if bytes.Contains(out, []byte("not a tree object")) {
+			return false, "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: string(commit)}
+		}
+		return false, "", errors.WithMessage(err, errorMessageTruncatedOutput(cmd.Args(), out))
+	}
+
+	// The output is "<mode> SP <type> SP <object> TAB <name> NUL". A path
+	// through a file or submodule yields no output.
+	var typ gitdomain.ObjectType
+	for _, line := range strings.Split(string(out), "\x00") {
+		info, name, ok := strings.Cut(line, "\t")
+		if !ok || name != path {
+			continue
+		}
+		fields := strings.Fields(info)
+		if len(fields) != 3 {
+			return false, "", errors.Errorf("invalid `git ls-tree` output: %q", out)
+		}
+		typ = gitdomain.ObjectType(fields[1])
+	}
+	if typ == "" {
+		return false, "", nil
+	}
+
+	checkPath := path
+	if typ == gitdomain.ObjectTypeTree {
+		// Sub-repo permissions match directories by a trailing slash.
+		checkPath += "/"
+	}
+	ok, err := authz.FilterActorPath(ctx, c.subRepoPermsChecker, actor.FromContext(ctx), repo, checkPath)
+	if err != nil {
+		return false, "", errors.Wrap(err, "filtering paths")
+	}
+	if !ok {
+		return false, "", &gitdomain.PathAccessDeniedError{Repo: repo, Commit: commit, Path: path}
+	}
+	return true, typ, nil
+}
+
 // CommitsOptions specifies options for Commits.
 type CommitsOptions struct {
 	Range string // commit range (revspec, "A..B", "A...B", etc.)
@@ -1333,18 +3076,91 @@ type CommitsOptions struct {
 
 	MessageQuery string // include only commits whose commit message contains this substring
 
-	Author string // include only commits whose author matches this
-	After  string // include only commits after this date
-	Before string // include only commits before this date
+	Author string    // include only commits whose author matches this
+	After  time.Time // include only commits after this time (optional)
+	Before time.Time // include only commits before this time (optional)
+
+	// AfterRelative and BeforeRelative are relative alternatives to After and
+	// Before, like "2 weeks ago", that git resolves against the time the
+	// command runs at (optional). Each can't be set together with its typed
+	// counterpart.
+	AfterRelative  string
+	BeforeRelative string
 
 	DateOrder bool // Whether or not commits should be sorted by date (optional)
 
+	OnlyMerges bool // include only merge commits (optional)
+	NoMerges   bool // exclude merge commits (optional)
+
+	// AncestryPath only selects commits that are both descendants of the
+	// excluded end and ancestors of the included end of Range, so it is only
+	// useful for ranges like "A..B" (optional).
+	AncestryPath bool
+
 	Path string // only commits modifying the given path are selected (optional)
 
 	Follow bool // follow the history of the path beyond renames (works only for a single path)
 
 	// When true return the names of the files changed in the commit
 	NameOnly bool
+
+	// ChangedFiles populates Commit.ChangedFiles with the files each commit
+	// changed and how, so that callers don't need a diff per commit. As with
+	// git log, merge commits have no changed files (optional).
+	ChangedFiles bool
+}
+
+// relativeDatePattern matches the relative dates accepted for
+// CommitsOptions.AfterRelative and BeforeRelative.
+var relativeDatePattern = lazyregexp.New(`^(?:\d+ (?:second|minute|hour|day|week|month|year)s?,? )+ago$`)
+
+// commitDateLayouts are the layouts of the absolute dates that
+// ParseCommitDate accepts.
+var commitDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"January 2 2006",
+	"January 2, 2006",
+	"Jan 2 2006",
+	"Jan 2, 2006",
+}
+
+// ParseCommitDate parses a user-supplied date, for example from a GraphQL
+// argument or a search query, for use in CommitsOptions. An absolute date is
+// returned as t, and a relative date like "1 year ago" is returned as
+// relative.
+func ParseCommitDate(s string) (t time.Time, relative string, err error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range commitDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, "", nil
+		}
+	}
+	if relativeDatePattern.MatchString(s) {
+		return time.Time{}, s, nil
+	}
+	return time.Time{}, "", errors.Newf(`invalid date %q: expected a date like "2006-01-02", "January 2 2006" or "2 weeks ago"`, s)
+}
+
+// validateDates checks the date options of opt, so that git isn't run with
+// dates it can't interpret.
+func (opt CommitsOptions) validateDates() error {
+	if !opt.After.IsZero() && opt.AfterRelative != "" {
+		return errors.New("After and AfterRelative are mutually exclusive")
+	}
+	if !opt.Before.IsZero() && opt.BeforeRelative != "" {
+		return errors.New("Before and BeforeRelative are mutually exclusive")
+	}
+	for name, relative := range map[string]string{"AfterRelative": opt.AfterRelative, "BeforeRelative": opt.BeforeRelative} {
+		if relative != "" && !relativeDatePattern.MatchString(relative) {
+			return errors.Newf(`invalid %s %q: expected a relative date like "2 weeks ago"`, name, relative)
+		}
+	}
+	if !opt.After.IsZero() && !opt.Before.IsZero() && opt.After.After(opt.Before) {
+		return errors.Newf("After (%s) is later than Before (%s)", opt.After.Format(time.RFC3339), opt.Before.Format(time.RFC3339))
+	}
+	return nil
 }
 
 func (c *clientImplementor) GetCommit(ctx context.Context, repo api.RepoName, id api.CommitID) (_ *gitdomain.Commit, err error) {
@@ -1373,6 +3189,54 @@ func (c *clientImplementor) GetCommit(ctx context.Context, repo api.RepoName, id
 	return gitdomain.CommitFromProto(res.GetCommit()), nil
 }
 
+// GetCommitOptions selects the changes returned by GetCommitWithChanges.
+type GetCommitOptions struct {
+	// IncludeStat returns the number of lines added and deleted per file.
+	IncludeStat bool
+	// IncludePatch returns the unified diff of the commit.
+	IncludePatch bool
+}
+
+func (c *clientImplementor) GetCommitWithChanges(ctx context.Context, repo api.RepoName, id api.CommitID, opt GetCommitOptions) (_ *gitdomain.CommitWithChanges, err error) {
+	ctx, _, endObservation := c.operations.getCommitWithChanges.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			id.Attr(),
+			attribute.Bool("includeStat", opt.IncludeStat),
+			attribute.Bool("includePatch", opt.IncludePatch),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.GetCommit(ctx, &proto.GetCommitRequest{
+		RepoName:     string(repo),
+		Commit:       string(id),
+		IncludeStat:  opt.IncludeStat,
+		IncludePatch: opt.IncludePatch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	commit := &gitdomain.CommitWithChanges{
+		Commit: gitdomain.CommitFromProto(res.GetCommit()),
+		Patch:  res.GetPatch(),
+	}
+	if opt.IncludeStat {
+		commit.Stats = make([]gitdomain.FileDiffStat, 0, len(res.GetStats()))
+		for _, s := range res.GetStats() {
+			commit.Stats = append(commit.Stats, gitdomain.FileDiffStatFromProto(s))
+		}
+	}
+	return commit, nil
+}
+
 // Commits returns all commits matching the options.
 func (c *clientImplementor) Commits(ctx context.Context, repo api.RepoName, opt CommitsOptions) (_ []*gitdomain.Commit, err error) {
 	opt = addNameOnly(opt, c.subRepoPermsChecker)
@@ -1385,41 +3249,188 @@ func (c *clientImplementor) Commits(ctx context.Context, repo api.RepoName, opt
 	})
 	defer endObservation(1, observation.Args{})
 
-	if err := checkSpecArgSafety(opt.Range); err != nil {
-		return nil, err
+	if err := checkSpecArgSafety(opt.Range); err != nil {
+		return nil, err
+	}
+
+	wrappedCommits, err := c.getWrappedCommits(ctx, repo, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered, err := filterCommits(ctx, c.subRepoPermsChecker, wrappedCommits, repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "filtering commits")
+	}
+
+	if needMoreCommits(filtered, wrappedCommits, opt, c.subRepoPermsChecker) {
+		filtered, err = c.getMoreCommits(ctx, repo, opt, filtered)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.checkShallowBoundary(ctx, repo, wrappedCommits); err != nil {
+		return filtered, err
+	}
+	return filtered, nil
+}
+
+// checkShallowBoundary returns a ShallowHistoryError if commits contain a
+// boundary commit of a shallow clone. git log stops at them silently, as if
+// they were root commits. Only commits without parents can be boundary
+// commits, so the clone state is only looked up for histories that reach one.
+func (c *clientImplementor) checkShallowBoundary(ctx context.Context, repo api.RepoName, commits []*wrappedCommit) error {
+	var roots []api.CommitID
+	for _, commit := range commits {
+		if len(commit.Parents) == 0 {
+			roots = append(roots, commit.ID)
+		}
+	}
+	if len(roots) == 0 {
+		return nil
+	}
+
+	state, err := c.CloneState(ctx, repo)
+	if err != nil {
+		return err
+	}
+	if !state.Shallow {
+		return nil
+	}
+	for _, root := range roots {
+		if state.IsShallowCommit(root) {
+			return &gitdomain.ShallowHistoryError{Repo: repo, Commit: root}
+		}
+	}
+	return nil
+}
+
+func (c *clientImplementor) AncestryPath(ctx context.Context, repo api.RepoName, from, to string) (_ []*gitdomain.Commit, err error) {
+	ctx, _, endObservation := c.operations.ancestryPath.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("from", from),
+			attribute.String("to", to),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	if from == "" || to == "" {
+		return nil, errors.New("both ends of the ancestry path must be specified")
+	}
+
+	// Resolve both ends first, so that we return a RevisionNotFoundError
+	// instead of a generic command failure if one of them doesn't exist.
+	fromID, err := c.ResolveRevision(ctx, repo, from, ResolveRevisionOptions{})
+	if err != nil {
+		return nil, err
+	}
+	toID, err := c.ResolveRevision(ctx, repo, to, ResolveRevisionOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Commits(ctx, repo, CommitsOptions{
+		Range:        string(fromID) + ".." + string(toID),
+		AncestryPath: true,
+	})
+}
+
+func (c *clientImplementor) CountCommits(ctx context.Context, repo api.RepoName, opt CommitsOptions) (_ int, err error) {
+	ctx, _, endObservation := c.operations.countCommits.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("opts", fmt.Sprintf("%#v", opt)),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	// With sub-repo permissions, we need to look at the files of every commit
+	// to know whether it is visible. git rev-list also doesn't support
+	// following renames.
+	if authz.SubRepoEnabled(c.subRepoPermsChecker) || opt.Follow {
+		commits, err := c.Commits(ctx, repo, opt)
+		if err != nil {
+			return 0, err
+		}
+		return len(commits), nil
+	}
+
+	if opt.Range == "" {
+		opt.Range = "HEAD"
 	}
+	opt.NameOnly = false
+	opt.ChangedFiles = false
 
-	wrappedCommits, err := c.getWrappedCommits(ctx, repo, opt)
+	args, err := commitLogArgs(gitcmd.RevList().Flag("--count"), opt)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	filtered, err := filterCommits(ctx, c.subRepoPermsChecker, wrappedCommits, repo)
+	cmd := c.gitCommand(repo, args...)
+	out, _, err := cmd.DividedOutput(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "filtering commits")
+		if isBadObjectErr(err, opt.Range) {
+			return 0, &gitdomain.RevisionNotFoundError{Repo: repo, Spec: opt.Range}
+		}
+		return 0, errors.WithMessage(err, fmt.Sprintf("git command %v failed (output: %q)", cmd.Args(), out))
 	}
 
-	if needMoreCommits(filtered, wrappedCommits, opt, c.subRepoPermsChecker) {
-		return c.getMoreCommits(ctx, repo, opt, filtered)
-	}
-	return filtered, err
+	return strconv.Atoi(string(bytes.TrimSpace(out)))
 }
 
 func filterCommits(ctx context.Context, checker authz.SubRepoPermissionChecker, commits []*wrappedCommit, repoName api.RepoName) ([]*gitdomain.Commit, error) {
 	if !authz.SubRepoEnabled(checker) {
 		return unWrapCommits(commits), nil
 	}
+
+	// The files of all commits are checked in one go, perms holds the
+	// permissions of the files of each commit in turn.
+	var paths []string
+	for _, commit := range commits {
+		paths = append(paths, commit.files...)
+	}
+	perms, err := authz.ActorFilePermissions(ctx, checker, actor.FromContext(ctx), repoName, paths)
+	if err != nil {
+		return nil, errors.Wrap(err, "checking sub-repo permissions")
+	}
+
 	filtered := make([]*gitdomain.Commit, 0, len(commits))
 	for _, commit := range commits {
-		if hasAccess, err := hasAccessToCommit(ctx, commit, repoName, checker); hasAccess {
+		n := len(commit.files)
+		if hasAccessToCommit(perms[:n]) {
+			if commit.ChangedFiles != nil {
+				commit.ChangedFiles = filterChangedFiles(commit.ChangedFiles, perms[:n])
+			}
 			filtered = append(filtered, commit.Commit)
-		} else if err != nil {
-			return nil, err
 		}
+		perms = perms[n:]
 	}
 	return filtered, nil
 }
 
+// filterChangedFiles returns the files the user can read both the path and, for
+// renames, the old path of. perms are the permissions for the paths returned by
+// changedFilePaths.
+func filterChangedFiles(files []gitdomain.ChangedFile, perms []authz.Perms) []gitdomain.ChangedFile {
+	filtered := make([]gitdomain.ChangedFile, 0, len(files))
+	for _, f := range files {
+		readable := perms[0].Include(authz.Read)
+		perms = perms[1:]
+		if f.OldPath != "" {
+			readable = readable && perms[0].Include(authz.Read)
+			perms = perms[1:]
+		}
+		if readable {
+			filtered = append(filtered, f)
+		}
+	}
+	return filtered
+}
+
 func unWrapCommits(wrappedCommits []*wrappedCommit) []*gitdomain.Commit {
 	commits := make([]*gitdomain.Commit, 0, len(wrappedCommits))
 	for _, wc := range wrappedCommits {
@@ -1428,20 +3439,18 @@ func unWrapCommits(wrappedCommits []*wrappedCommit) []*gitdomain.Commit {
 	return commits
 }
 
-func hasAccessToCommit(ctx context.Context, commit *wrappedCommit, repoName api.RepoName, checker authz.SubRepoPermissionChecker) (bool, error) {
-	a := actor.FromContext(ctx)
-	if commit.files == nil || len(commit.files) == 0 {
-		return true, nil // If commit has no files, assume user has access to view the commit.
+// hasAccessToCommit returns true if the user can read any of the files
+// modified in a commit, given their permissions for those files.
+func hasAccessToCommit(filePerms []authz.Perms) bool {
+	if len(filePerms) == 0 {
+		return true // If commit has no files, assume user has access to view the commit.
 	}
-	for _, fileName := range commit.files {
-		if hasAccess, err := authz.FilterActorPath(ctx, checker, a, repoName, fileName); err != nil {
-			return false, err
-		} else if hasAccess {
-			// if the user has access to one file modified in the commit, they have access to view the commit
-			return true, nil
+	for _, p := range filePerms {
+		if p.Include(authz.Read) {
+			return true
 		}
 	}
-	return false, nil
+	return false
 }
 
 // CommitsUniqueToBranch returns a map from commits that exist on a particular
@@ -1460,14 +3469,18 @@ func (c *clientImplementor) CommitsUniqueToBranch(ctx context.Context, repo api.
 	})
 	defer endObservation(1, observation.Args{})
 
-	args := []string{"log", "--pretty=format:%H:%cI"}
+	log := gitcmd.Log().FlagValue("--pretty", "format:%H:%cI")
 	if maxAge != nil {
-		args = append(args, fmt.Sprintf("--after=%s", *maxAge))
+		log.FlagValue("--after", maxAge.String())
 	}
 	if isDefaultBranch {
-		args = append(args, "HEAD")
+		log.Rev("HEAD")
 	} else {
-		args = append(args, branchName, "^HEAD")
+		log.Rev(branchName, "^HEAD")
+	}
+	args, err := log.Args()
+	if err != nil {
+		return nil, err
 	}
 
 	cmd := c.gitCommand(repo, args...)
@@ -1544,11 +3557,13 @@ func (c *clientImplementor) HasCommitAfter(ctx context.Context, repo api.RepoNam
 		return false, err
 	}
 
-	args, err := commitLogArgs([]string{"rev-list", "--count"}, CommitsOptions{
-		N:     1,
-		After: date,
-		Range: string(commitid),
-	})
+	opt, err := commitsAfterOptions(date)
+	if err != nil {
+		return false, err
+	}
+	opt.N = 1
+	opt.Range = string(commitid)
+	args, err := commitLogArgs(gitcmd.RevList().Flag("--count"), opt)
 	if err != nil {
 		return false, err
 	}
@@ -1565,7 +3580,12 @@ func (c *clientImplementor) HasCommitAfter(ctx context.Context, repo api.RepoNam
 }
 
 func (c *clientImplementor) hasCommitAfterWithFiltering(ctx context.Context, repo api.RepoName, date, revspec string) (bool, error) {
-	if commits, err := c.Commits(ctx, repo, CommitsOptions{After: date, Range: revspec}); err != nil {
+	opt, err := commitsAfterOptions(date)
+	if err != nil {
+		return false, err
+	}
+	opt.Range = revspec
+	if commits, err := c.Commits(ctx, repo, opt); err != nil {
 		return false, err
 	} else if len(commits) > 0 {
 		return true, nil
@@ -1573,12 +3593,24 @@ func (c *clientImplementor) hasCommitAfterWithFiltering(ctx context.Context, rep
 	return false, nil
 }
 
-func isBadObjectErr(output, obj string) bool {
-	return output == "fatal: bad object "+obj
+// commitsAfterOptions returns the CommitsOptions for commits after the
+// user-supplied date.
+func commitsAfterOptions(date string) (CommitsOptions, error) {
+	after, relative, err := ParseCommitDate(date)
+	if err != nil {
+		return CommitsOptions{}, err
+	}
+	return CommitsOptions{After: after, AfterRelative: relative}, nil
+}
+
+// isBadObjectErr returns true if err reports that obj is a bad object.
+func isBadObjectErr(err error, obj string) bool {
+	var e *gitdomain.BadObjectError
+	return errors.As(err, &e) && e.Object == obj
 }
 
 func (c *clientImplementor) getWrappedCommits(ctx context.Context, repo api.RepoName, opt CommitsOptions) ([]*wrappedCommit, error) {
-	args, err := commitLogArgs([]string{"log", logFormatWithoutRefs}, opt)
+	args, err := commitLogArgs(gitcmd.Log().FlagValue("--format", logFormatWithoutRefs), opt)
 	if err != nil {
 		return nil, err
 	}
@@ -1656,19 +3688,22 @@ func joinCommits(previous, next []*gitdomain.Commit, desiredTotal uint) []*gitdo
 // revision responses and converts them into RevisionNotFoundError.
 // It is declared as a variable so that we can swap it out in tests
 var runCommitLog = func(ctx context.Context, cmd GitCommand, opt CommitsOptions) ([]*wrappedCommit, error) {
-	data, stderr, err := cmd.DividedOutput(ctx)
+	data, _, err := cmd.DividedOutput(ctx)
 	if err != nil {
 		data = bytes.TrimSpace(data)
-		if isBadObjectErr(string(stderr), opt.Range) {
+		if isBadObjectErr(err, opt.Range) {
 			return nil, &gitdomain.RevisionNotFoundError{Repo: cmd.Repo(), Spec: opt.Range}
 		}
 		return nil, errors.WithMessage(err, fmt.Sprintf("git command %v failed (output: %q)", cmd.Args(), data))
 	}
 
-	return parseCommitLogOutput(bytes.NewReader(data))
+	return parseCommitLogOutput(bytes.NewReader(data), opt.ChangedFiles)
 }
 
-func parseCommitLogOutput(r io.Reader) ([]*wrappedCommit, error) {
+// parseCommitLogOutput parses the output of git log in the format of
+// logFormatWithoutRefs. nameStatus must be true if the output includes the
+// changed files in the format of --name-status.
+func parseCommitLogOutput(r io.Reader, nameStatus bool) ([]*wrappedCommit, error) {
 	commitScanner := bufio.NewScanner(r)
 	// We use an increased buffer size since sub-repo permissions
 	// can result in very lengthy output.
@@ -1683,7 +3718,7 @@ func parseCommitLogOutput(r io.Reader) ([]*wrappedCommit, error) {
 			return nil, errors.Newf("internal error: expected %d parts, got %d", partsPerCommit, len(parts))
 		}
 
-		commit, err := parseCommitFromLog(parts)
+		commit, err := parseCommitFromLog(parts, nameStatus)
 		if err != nil {
 			return nil, err
 		}
@@ -1724,50 +3759,78 @@ type wrappedCommit struct {
 	files []string
 }
 
-func commitLogArgs(initialArgs []string, opt CommitsOptions) (args []string, err error) {
+// commitLogArgs adds the options of opt to a git log or git rev-list command,
+// and returns its arguments.
+func commitLogArgs(cmd *gitcmd.Command, opt CommitsOptions) ([]string, error) {
 	if err := checkSpecArgSafety(opt.Range); err != nil {
 		return nil, err
 	}
 
-	args = initialArgs
 	if opt.N != 0 {
-		args = append(args, "-n", strconv.FormatUint(uint64(opt.N), 10))
+		cmd.MaxCount(int(opt.N))
 	}
 	if opt.Skip != 0 {
-		args = append(args, "--skip="+strconv.FormatUint(uint64(opt.Skip), 10))
+		cmd.FlagValue("--skip", strconv.FormatUint(uint64(opt.Skip), 10))
 	}
 
 	if opt.Author != "" {
-		args = append(args, "--fixed-strings", "--author="+opt.Author)
+		cmd.Flag("--fixed-strings").FlagValue("--author", opt.Author)
 	}
 
-	if opt.After != "" {
-		args = append(args, "--after="+opt.After)
+	if err := opt.validateDates(); err != nil {
+		return nil, err
+	}
+	if !opt.After.IsZero() {
+		cmd.FlagValue("--after", opt.After.Format(time.RFC3339))
 	}
-	if opt.Before != "" {
-		args = append(args, "--before="+opt.Before)
+	if opt.AfterRelative != "" {
+		cmd.FlagValue("--after", opt.AfterRelative)
+	}
+	if !opt.Before.IsZero() {
+		cmd.FlagValue("--before", opt.Before.Format(time.RFC3339))
+	}
+	if opt.BeforeRelative != "" {
+		cmd.FlagValue("--before", opt.BeforeRelative)
 	}
 	if opt.DateOrder {
-		args = append(args, "--date-order")
+		cmd.Flag("--date-order")
+	}
+
+	if opt.OnlyMerges && opt.NoMerges {
+		return nil, errors.New("OnlyMerges and NoMerges are mutually exclusive")
+	}
+	if opt.OnlyMerges {
+		cmd.Flag("--merges")
+	}
+	if opt.NoMerges {
+		cmd.Flag("--no-merges")
+	}
+
+	if opt.AncestryPath {
+		cmd.Flag("--ancestry-path")
 	}
 
 	if opt.MessageQuery != "" {
-		args = append(args, "--fixed-strings", "--regexp-ignore-case", "--grep="+opt.MessageQuery)
+		cmd.Flag("--fixed-strings", "--regexp-ignore-case").FlagValue("--grep", opt.MessageQuery)
 	}
 
 	if opt.Range != "" {
-		args = append(args, opt.Range)
+		cmd.Rev(opt.Range)
 	}
-	if opt.NameOnly {
-		args = append(args, "--name-only")
+	if opt.ChangedFiles {
+		// The output of --name-status includes the names, so this covers
+		// NameOnly too.
+		cmd.Flag("--name-status", "--find-renames")
+	} else if opt.NameOnly {
+		cmd.Flag("--name-only")
 	}
 	if opt.Follow {
-		args = append(args, "--follow")
+		cmd.Flag("--follow")
 	}
 	if opt.Path != "" {
-		args = append(args, "--", opt.Path)
+		cmd.Path(opt.Path)
 	}
-	return args, nil
+	return cmd.Args()
 }
 
 // FirstEverCommit returns the first commit ever made to the repository.
@@ -1780,7 +3843,14 @@ func (c *clientImplementor) FirstEverCommit(ctx context.Context, repo api.RepoNa
 	})
 	defer endObservation(1, observation.Args{})
 
-	args := []string{"rev-list", "--reverse", "--date-order", "--max-parents=0", "HEAD"}
+	args, err := gitcmd.RevList().
+		Flag("--reverse", "--date-order").
+		FlagValue("--max-parents", "0").
+		Rev("HEAD").
+		Args()
+	if err != nil {
+		return nil, err
+	}
 	cmd := c.gitCommand(repo, args...)
 	out, err := cmd.Output(ctx)
 	if err != nil {
@@ -1796,6 +3866,187 @@ func (c *clientImplementor) FirstEverCommit(ctx context.Context, repo api.RepoNa
 	return c.GetCommit(ctx, repo, id)
 }
 
+func (c *clientImplementor) FirstCommitForPath(ctx context.Context, repo api.RepoName, rev, path string) (_ *gitdomain.Commit, err error) {
+	ctx, _, endObservation := c.operations.firstCommitForPath.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("rev", rev),
+			attribute.String("path", path),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	if path == "" {
+		return nil, errors.New("path must be specified")
+	}
+
+	// Resolve rev first, so that we return a RevisionNotFoundError instead of
+	// a generic command failure if it doesn't exist.
+	commitID, err := c.ResolveRevision(ctx, repo, rev, ResolveRevisionOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// git log lists the newest commits first, so with -1 it stops at the most
+	// recent commit that added the file under the name it had back then.
+	opt := addNameOnly(CommitsOptions{Range: string(commitID), N: 1, Path: path, Follow: true}, c.subRepoPermsChecker)
+	args, err := commitLogArgs(gitcmd.Log().FlagValue("--format", logFormatWithoutRefs).FlagValue("--diff-filter", "A"), opt)
+	if err != nil {
+		return nil, err
+	}
+	wrappedCommits, err := runCommitLog(ctx, c.gitCommand(repo, args...), opt)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered, err := filterCommits(ctx, c.subRepoPermsChecker, wrappedCommits, repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "filtering commits")
+	}
+	if len(filtered) == 0 {
+		// Either no commit added the path, or the user can't see the commit
+		// that did.
+		return nil, &os.PathError{Op: "git log", Path: path, Err: os.ErrNotExist}
+	}
+
+	// A file that exists at the boundary of a shallow clone looks like it was
+	// added there.
+	if err := c.checkShallowBoundary(ctx, repo, wrappedCommits); err != nil {
+		return nil, err
+	}
+	return filtered[0], nil
+}
+
+// DirectoryHistoryOptions specifies options for DirectoryHistory.
+type DirectoryHistoryOptions struct {
+	// First is the maximum number of commits to return. If zero,
+	// defaultDirectoryHistoryPageSize is used.
+	First int
+	// After is the cursor returned with the previous page. If empty, the
+	// first page is returned.
+	After string
+}
+
+const defaultDirectoryHistoryPageSize = 50
+
+func (c *clientImplementor) DirectoryHistory(ctx context.Context, repo api.RepoName, rev, path string, opt DirectoryHistoryOptions) (_ []*gitdomain.Commit, _ string, err error) {
+	ctx, _, endObservation := c.operations.directoryHistory.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("rev", rev),
+			attribute.String("path", path),
+			attribute.Int("first", opt.First),
+			attribute.String("after", opt.After),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	if opt.First < 0 {
+		return nil, "", errors.Newf("invalid page size %d", opt.First)
+	}
+	first := opt.First
+	if first == 0 {
+		first = defaultDirectoryHistoryPageSize
+	}
+
+	var commitID api.CommitID
+	var offset uint
+	if opt.After != "" {
+		if commitID, offset, err = parseDirectoryHistoryCursor(opt.After); err != nil {
+			return nil, "", err
+		}
+	} else if commitID, err = c.ResolveRevision(ctx, repo, rev, ResolveRevisionOptions{}); err != nil {
+		return nil, "", err
+	}
+
+	// One more commit than requested tells whether there is another page.
+	log := addNameOnly(CommitsOptions{Range: string(commitID), N: uint(first) + 1, Skip: offset}, c.subRepoPermsChecker)
+	cmd := gitcmd.Log().FlagValue("--format", logFormatWithoutRefs)
+	if path = strings.Trim(filepath.ToSlash(filepath.Clean(rel(path))), "/"); path != "." && path != "" {
+		// Bloom filters are only used for a single pathspec without magic
+		// other than literal, which also keeps paths with glob characters
+		// from matching more than the directory.
+		cmd.Path(":(literal)" + path)
+	}
+	args, err := commitLogArgs(cmd, log)
+	if err != nil {
+		return nil, "", err
+	}
+	wrappedCommits, err := runCommitLog(ctx, c.gitCommand(repo, args...), log)
+	if err != nil {
+		if isBadObjectErr(err, string(commitID)) {
+			return nil, "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: string(commitID)}
+		}
+		return nil, "", err
+	}
+
+	var next string
+	if len(wrappedCommits) > first {
+		wrappedCommits = wrappedCommits[:first]
+		next = directoryHistoryCursor(commitID, offset+uint(first))
+	}
+	commits, err := filterCommits(ctx, c.subRepoPermsChecker, wrappedCommits, repo)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "filtering commits")
+	}
+	return commits, next, nil
+}
+
+// directoryHistoryCursor returns the cursor of the DirectoryHistory page that
+// starts after skipping offset commits of the history of commit.
+func directoryHistoryCursor(commit api.CommitID, offset uint) string {
+	return string(commit) + ":" + strconv.FormatUint(uint64(offset), 10)
+}
+
+func parseDirectoryHistoryCursor(cursor string) (api.CommitID, uint, error) {
+	commit, offset, ok := strings.Cut(cursor, ":")
+	n, err := strconv.ParseUint(offset, 10, 0)
+	if !ok || err != nil || gitdomain.EnsureAbsoluteCommit(api.CommitID(commit)) != nil {
+		return "", 0, errors.Newf("invalid cursor %q", cursor)
+	}
+	return api.CommitID(commit), uint(n), nil
+}
+
+func (c *clientImplementor) AbbreviateCommit(ctx context.Context, repo api.RepoName, commitID api.CommitID) (_ string, err error) {
+	ctx, _, endObservation := c.operations.abbreviateCommit.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("commitID", string(commitID)),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	if err := gitdomain.EnsureAbsoluteCommit(commitID); err != nil {
+		return "", err
+	}
+
+	// %h abbreviates like git rev-parse --short: as configured by core.abbrev,
+	// and extended until it is unique.
+	args, err := gitcmd.Log().
+		FlagValue("--format", "%h").
+		MaxCount(1).
+		Rev(string(commitID)).
+		Args()
+	if err != nil {
+		return "", err
+	}
+	out, _, err := c.gitCommand(repo, args...).DividedOutput(ctx)
+	if err != nil {
+		if isBadObjectErr(err, string(commitID)) {
+			return "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: string(commitID)}
+		}
+		return "", err
+	}
+	abbrev := string(bytes.TrimSpace(out))
+	if abbrev == "" {
+		return "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: string(commitID)}
+	}
+	return abbrev, nil
+}
+
 const (
 	partsPerCommit = 10 // number of \x00-separated fields per commit
 
@@ -1815,13 +4066,13 @@ const (
 	// each field of the commit is separated by a null byte (0x00).
 	//
 	// Refs are slow, and are intentionally not included because they are usually not needed.
-	logFormatWithoutRefs = "--format=format:%x1e%H%x00%aN%x00%aE%x00%at%x00%cN%x00%cE%x00%ct%x00%B%x00%P%x00"
+	logFormatWithoutRefs = "format:%x1e%H%x00%aN%x00%aE%x00%at%x00%cN%x00%cE%x00%ct%x00%B%x00%P%x00"
 )
 
 // parseCommitFromLog parses the next commit from data and returns the commit and the remaining
 // data. The data arg is a byte array that contains NUL-separated log fields as formatted by
 // logFormatFlag.
-func parseCommitFromLog(parts [][]byte) (*wrappedCommit, error) {
+func parseCommitFromLog(parts [][]byte, nameStatus bool) (*wrappedCommit, error) {
 	// log outputs are newline separated, so all but the 1st commit ID part
 	// has an erroneous leading newline.
 	parts[0] = bytes.TrimPrefix(parts[0], []byte{'\n'})
@@ -1845,19 +4096,82 @@ func parseCommitFromLog(parts [][]byte) (*wrappedCommit, error) {
 		}
 	}
 
-	fileNames := strings.Split(string(bytes.TrimSpace(parts[9])), "\n")
+	var changedFiles []gitdomain.ChangedFile
+	var fileNames []string
+	if nameStatus {
+		changedFiles, err = parseNameStatus(bytes.TrimSpace(parts[9]))
+		if err != nil {
+			return nil, err
+		}
+		fileNames = changedFilePaths(changedFiles)
+	} else {
+		fileNames = strings.Split(string(bytes.TrimSpace(parts[9])), "\n")
+	}
 
 	return &wrappedCommit{
 		Commit: &gitdomain.Commit{
-			ID:        commitID,
-			Author:    gitdomain.Signature{Name: string(parts[1]), Email: string(parts[2]), Date: time.Unix(authorTime, 0).UTC()},
-			Committer: &gitdomain.Signature{Name: string(parts[4]), Email: string(parts[5]), Date: time.Unix(committerTime, 0).UTC()},
-			Message:   gitdomain.Message(strings.TrimSuffix(string(parts[7]), "\n")),
-			Parents:   parents,
+			ID:           commitID,
+			Author:       gitdomain.Signature{Name: string(parts[1]), Email: string(parts[2]), Date: time.Unix(authorTime, 0).UTC()},
+			Committer:    &gitdomain.Signature{Name: string(parts[4]), Email: string(parts[5]), Date: time.Unix(committerTime, 0).UTC()},
+			Message:      gitdomain.Message(strings.TrimSuffix(string(parts[7]), "\n")),
+			Parents:      parents,
+			ChangedFiles: changedFiles,
 		}, files: fileNames,
 	}, nil
 }
 
+// parseNameStatus parses the list of changed files that git log --name-status
+// prints after a commit, like "M\tfile" or "R100\told\tnew".
+func parseNameStatus(out []byte) ([]gitdomain.ChangedFile, error) {
+	files := []gitdomain.ChangedFile{}
+	if len(out) == 0 {
+		return files, nil
+	}
+	for _, line := range bytes.Split(out, []byte("\n")) {
+		fields := strings.Split(string(line), "\t")
+		if len(fields) < 2 {
+			return nil, errors.Newf("unexpected name-status line: %q", string(line))
+		}
+		file := gitdomain.ChangedFile{
+			Status: gitdomain.ChangedFileStatusFromGit(fields[0]),
+			Path:   unquoteGitPath(fields[len(fields)-1]),
+		}
+		if file.Status == gitdomain.ChangedFileStatusRenamed {
+			if len(fields) != 3 {
+				return nil, errors.Newf("unexpected name-status line: %q", string(line))
+			}
+			file.OldPath = unquoteGitPath(fields[1])
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// unquoteGitPath reverses the C-style quoting git applies to paths with
+// unusual characters.
+func unquoteGitPath(path string) string {
+	if !strings.HasPrefix(path, `"`) {
+		return path
+	}
+	if unquoted, err := strconv.Unquote(path); err == nil {
+		return unquoted
+	}
+	return path
+}
+
+// changedFilePaths returns the paths to check sub-repo permissions for: the
+// path of each file, followed by the old path for renames.
+func changedFilePaths(files []gitdomain.ChangedFile) []string {
+	paths := make([]string, 0, len(files))
+	for _, f := range files {
+		paths = append(paths, f.Path)
+		if f.OldPath != "" {
+			paths = append(paths, f.OldPath)
+		}
+	}
+	return paths
+}
+
 type ArchiveFormat string
 
 const (
@@ -1890,13 +4204,118 @@ func (f ArchiveFormat) ToProto() proto.ArchiveFormat {
 	}
 }
 
-func (c *clientImplementor) ArchiveReader(ctx context.Context, repo api.RepoName, options ArchiveOptions) (_ io.ReadCloser, err error) {
-	ctx, _, endObservation := c.operations.archiveReader.With(ctx, &err, observation.Args{
+func (d BlameCopyDetection) ToProto() proto.BlameCopyDetection {
+	switch d {
+	case BlameCopyDetectionMoves:
+		return proto.BlameCopyDetection_BLAME_COPY_DETECTION_MOVES
+	case BlameCopyDetectionCopies:
+		return proto.BlameCopyDetection_BLAME_COPY_DETECTION_COPIES
+	case BlameCopyDetectionCopiesFromCreation:
+		return proto.BlameCopyDetection_BLAME_COPY_DETECTION_COPIES_FROM_CREATION
+	case BlameCopyDetectionCopiesFromAnyCommit:
+		return proto.BlameCopyDetection_BLAME_COPY_DETECTION_COPIES_FROM_ANY_COMMIT
+	default:
+		return proto.BlameCopyDetection_BLAME_COPY_DETECTION_UNSPECIFIED
+	}
+}
+
+func (c *clientImplementor) ArchiveReader(ctx context.Context, repo api.RepoName, options ArchiveOptions) (_ io.ReadCloser, err error) {
+	ctx, _, endObservation := c.operations.archiveReader.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: append(
+			[]attribute.KeyValue{repo.Attr()},
+			options.Attrs()...,
+		),
+	})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	req := options.ToProto(string(repo))
+
+	ctx, cancel := context.WithCancel(ctx)
+	cli, err := client.Archive(ctx, req)
+	if err != nil {
+		cancel()
+		endObservation(1, observation.Args{})
+		return nil, err
+	}
+
+	// We start by reading the first message to early-exit on potential errors,
+	// ie. revision not found errors or invalid git command.
+	firstMessage, firstErr := cli.Recv()
+	if firstErr != nil {
+		if s, ok := status.FromError(firstErr); ok {
+			if s.Code() == codes.NotFound {
+				for _, d := range s.Details() {
+					switch d.(type) {
+					case *proto.FileNotFoundPayload:
+						cancel()
+						err = firstErr
+						endObservation(1, observation.Args{})
+						// We don't have a specific path here, so we return ErrNotExist instead of PathError.
+						return nil, os.ErrNotExist
+					}
+				}
+			}
+		}
+		if errors.HasType(firstErr, &gitdomain.RevisionNotFoundError{}) {
+			cancel()
+			err = firstErr
+			endObservation(1, observation.Args{})
+			return nil, err
+		}
+	}
+
+	firstRespRead := false
+	r := streamio.NewReader(limitRecv(responseLimitsFromContext(ctx).MaxBytes, func() ([]byte, error) {
+		if !firstRespRead {
+			firstRespRead = true
+			if firstErr != nil {
+				return nil, firstErr
+			}
+			return firstMessage.GetData(), nil
+		}
+
+		m, err := cli.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return m.GetData(), nil
+	}))
+
+	return &archiveReader{
+		Reader: r,
+		cancel: cancel,
+		onClose: func() {
+			endObservation(1, observation.Args{})
+		},
+	}, nil
+}
+
+type archiveReader struct {
+	io.Reader
+	cancel  context.CancelFunc
+	onClose func()
+}
+
+func (br *archiveReader) Close() error {
+	br.cancel()
+	br.onClose()
+	return nil
+}
+
+func (c *clientImplementor) FormatPatch(ctx context.Context, repo api.RepoName, base, head string) (_ io.ReadCloser, err error) {
+	ctx, _, endObservation := c.operations.formatPatch.With(ctx, &err, observation.Args{
 		MetricLabelValues: []string{c.scope},
-		Attrs: append(
-			[]attribute.KeyValue{repo.Attr()},
-			options.Attrs()...,
-		),
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("base", base),
+			attribute.String("head", head),
+		},
 	})
 
 	client, err := c.clientSource.ClientForRepo(ctx, repo)
@@ -1905,10 +4324,12 @@ func (c *clientImplementor) ArchiveReader(ctx context.Context, repo api.RepoName
 		return nil, err
 	}
 
-	req := options.ToProto(string(repo))
-
 	ctx, cancel := context.WithCancel(ctx)
-	cli, err := client.Archive(ctx, req)
+	cli, err := client.FormatPatch(ctx, &proto.FormatPatchRequest{
+		RepoName: string(repo),
+		Base:     []byte(base),
+		Head:     []byte(head),
+	})
 	if err != nil {
 		cancel()
 		endObservation(1, observation.Args{})
@@ -1916,29 +4337,13 @@ func (c *clientImplementor) ArchiveReader(ctx context.Context, repo api.RepoName
 	}
 
 	// We start by reading the first message to early-exit on potential errors,
-	// ie. revision not found errors or invalid git command.
+	// ie. revision not found errors.
 	firstMessage, firstErr := cli.Recv()
-	if firstErr != nil {
-		if s, ok := status.FromError(firstErr); ok {
-			if s.Code() == codes.NotFound {
-				for _, d := range s.Details() {
-					switch d.(type) {
-					case *proto.FileNotFoundPayload:
-						cancel()
-						err = firstErr
-						endObservation(1, observation.Args{})
-						// We don't have a specific path here, so we return ErrNotExist instead of PathError.
-						return nil, os.ErrNotExist
-					}
-				}
-			}
-		}
-		if errors.HasType(firstErr, &gitdomain.RevisionNotFoundError{}) {
-			cancel()
-			err = firstErr
-			endObservation(1, observation.Args{})
-			return nil, err
-		}
+	if firstErr != nil && firstErr != io.EOF {
+		cancel()
+		err = firstErr
+		endObservation(1, observation.Args{})
+		return nil, err
 	}
 
 	firstRespRead := false
@@ -1958,7 +4363,7 @@ func (c *clientImplementor) ArchiveReader(ctx context.Context, repo api.RepoName
 		return m.GetData(), nil
 	})
 
-	return &archiveReader{
+	return &formatPatchReader{
 		Reader: r,
 		cancel: cancel,
 		onClose: func() {
@@ -1967,18 +4372,333 @@ func (c *clientImplementor) ArchiveReader(ctx context.Context, repo api.RepoName
 	}, nil
 }
 
-type archiveReader struct {
+type formatPatchReader struct {
 	io.Reader
 	cancel  context.CancelFunc
 	onClose func()
 }
 
-func (br *archiveReader) Close() error {
-	br.cancel()
-	br.onClose()
+func (r *formatPatchReader) Close() error {
+	r.cancel()
+	r.onClose()
 	return nil
 }
 
+func (c *clientImplementor) CreateBundle(ctx context.Context, repo api.RepoName, refs []string, w io.Writer) (err error) {
+	ctx, _, endObservation := c.operations.createBundle.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.StringSlice("refs", refs),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	if len(refs) == 0 {
+		return errors.New("at least one ref must be specified")
+	}
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	req := &proto.CreateBundleRequest{
+		RepoName: string(repo),
+		Refs:     make([][]byte, len(refs)),
+	}
+	for i, ref := range refs {
+		req.Refs[i] = []byte(ref)
+	}
+
+	cli, err := client.CreateBundle(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	r := streamio.NewReader(func() ([]byte, error) {
+		m, err := cli.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return m.GetData(), nil
+	})
+
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func (c *clientImplementor) ApplyBundle(ctx context.Context, repo api.RepoName, r io.Reader) (err error) {
+	ctx, _, endObservation := c.operations.applyBundle.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cc, err := client.ApplyBundle(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Send the metadata event first.
+	if err := cc.Send(&proto.ApplyBundleRequest{Payload: &proto.ApplyBundleRequest_Metadata_{
+		Metadata: &proto.ApplyBundleRequest_Metadata{RepoName: string(repo)},
+	}}); err != nil {
+		return errors.Wrap(err, "sending metadata")
+	}
+
+	// Then send the bundle in chunks that won't exceed the maximum message
+	// size of gRPC.
+	w := streamio.NewWriter(func(p []byte) error {
+		return cc.Send(&proto.ApplyBundleRequest{Payload: &proto.ApplyBundleRequest_Data{
+			Data: p,
+		}})
+	})
+
+	if _, err := io.Copy(w, r); err != nil {
+		// If the server closed the stream, CloseAndRecv returns the actual
+		// error.
+		if errors.Is(err, io.EOF) {
+			_, err = cc.CloseAndRecv()
+		}
+		return errors.Wrap(err, "sending bundle")
+	}
+
+	_, err = cc.CloseAndRecv()
+	return err
+}
+
+func (c *clientImplementor) ExecInWorktree(ctx context.Context, repo api.RepoName, commit api.CommitID, args []string, stdin []byte) (_ gitdomain.WorktreeExecResult, err error) {
+	ctx, _, endObservation := c.operations.execInWorktree.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			commit.Attr(),
+			attribute.StringSlice("args", args),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return gitdomain.WorktreeExecResult{}, err
+	}
+
+	req := &proto.ExecInWorktreeRequest{
+		RepoName:  string(repo),
+		CommitSha: string(commit),
+		Stdin:     stdin,
+	}
+	for _, a := range args {
+		req.Args = append(req.Args, []byte(a))
+	}
+
+	res, err := client.ExecInWorktree(ctx, req)
+	if err != nil {
+		return gitdomain.WorktreeExecResult{}, err
+	}
+
+	return gitdomain.WorktreeExecResultFromProto(res), nil
+}
+
+func (c *clientImplementor) RebasePreview(ctx context.Context, repo api.RepoName, base, head, onto api.CommitID) (_ gitdomain.RebasePreview, err error) {
+	ctx, _, endObservation := c.operations.rebasePreview.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("base", string(base)),
+			attribute.String("head", string(head)),
+			attribute.String("onto", string(onto)),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return gitdomain.RebasePreview{}, err
+	}
+
+	res, err := client.RebasePreview(ctx, &proto.RebasePreviewRequest{
+		RepoName:      string(repo),
+		BaseCommitSha: string(base),
+		HeadCommitSha: string(head),
+		OntoCommitSha: string(onto),
+	})
+	if err != nil {
+		return gitdomain.RebasePreview{}, err
+	}
+
+	return gitdomain.RebasePreviewFromProto(res), nil
+}
+
+// RevertCommitOptions configures the commit created by RevertCommit.
+type RevertCommitOptions struct {
+	// Onto is the revspec of the commit to create the revert commit on top
+	// of, for example the default branch. If empty, the revert commit is
+	// created on top of the reverted commit.
+	Onto string
+	// Mainline is the number of the parent of a merge commit that the changes
+	// are reverted relative to, starting at 1, like `git revert -m`. It is
+	// required for merge commits and must be 0 for other commits.
+	Mainline int
+	// Message is the message of the revert commit. If empty, the message
+	// `git revert` would use is used.
+	Message string
+	// Author is the author of the revert commit. It is required. If the date
+	// is zero, the current time is used.
+	Author gitdomain.Signature
+	// Committer is the committer of the revert commit. If nil, Author is used.
+	Committer *gitdomain.Signature
+}
+
+func (c *clientImplementor) RevertCommit(ctx context.Context, repo api.RepoName, commit api.CommitID, opts RevertCommitOptions) (_ api.CommitID, err error) {
+	ctx, _, endObservation := c.operations.revertCommit.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			commit.Attr(),
+			attribute.String("onto", opts.Onto),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+
+	req := &proto.RevertCommitRequest{
+		RepoName:  string(repo),
+		CommitSha: string(commit),
+		Onto:      []byte(opts.Onto),
+		Mainline:  int32(opts.Mainline),
+		Message:   []byte(opts.Message),
+		Author:    opts.Author.ToProto(),
+	}
+	if opts.Committer != nil {
+		req.Committer = opts.Committer.ToProto()
+	}
+
+	res, err := client.RevertCommit(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	return api.CommitID(res.GetCommitSha()), nil
+}
+
+// CherryPickOptions configures the commit created by CherryPick.
+type CherryPickOptions struct {
+	// Mainline is the number of the parent of a merge commit that the changes
+	// are taken relative to, starting at 1, like `git cherry-pick -m`. It is
+	// required for merge commits and must be 0 for other commits.
+	Mainline int
+	// Message is the message of the new commit. If empty, the message of the
+	// cherry-picked commit is used.
+	Message string
+	// RecordOrigin appends a "(cherry picked from commit ...)" line to the
+	// default message, like `git cherry-pick -x`.
+	RecordOrigin bool
+	// Committer is the committer of the new commit. It is required. If the
+	// date is zero, the current time is used.
+	Committer gitdomain.Signature
+}
+
+func (c *clientImplementor) CherryPick(ctx context.Context, repo api.RepoName, commit api.CommitID, onto string, opts CherryPickOptions) (_ api.CommitID, err error) {
+	ctx, _, endObservation := c.operations.cherryPick.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			commit.Attr(),
+			attribute.String("onto", onto),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := client.CherryPick(ctx, &proto.CherryPickRequest{
+		RepoName:     string(repo),
+		CommitSha:    string(commit),
+		Onto:         []byte(onto),
+		Mainline:     int32(opts.Mainline),
+		Message:      []byte(opts.Message),
+		RecordOrigin: opts.RecordOrigin,
+		Committer:    opts.Committer.ToProto(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return api.CommitID(res.GetCommitSha()), nil
+}
+
+// SquashCommitsOptions configures the commit created by SquashCommits.
+type SquashCommitsOptions struct {
+	// Message is the message of the new commit. If empty, the messages of the
+	// squashed commits are combined, oldest first and without their trailers.
+	// In both cases, the Co-authored-by trailers of the squashed commits are
+	// appended to the message.
+	Message string
+	// Author is the author of the new commit. If nil, the author of the
+	// oldest squashed commit is used.
+	Author *gitdomain.Signature
+	// Committer is the committer of the new commit. It is required. If the
+	// date is zero, the current time is used.
+	Committer gitdomain.Signature
+}
+
+func (c *clientImplementor) SquashCommits(ctx context.Context, repo api.RepoName, base, head api.CommitID, opts SquashCommitsOptions) (_ api.CommitID, err error) {
+	ctx, _, endObservation := c.operations.squashCommits.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("base", string(base)),
+			attribute.String("head", string(head)),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+
+	req := &proto.SquashCommitsRequest{
+		RepoName:      string(repo),
+		BaseCommitSha: string(base),
+		HeadCommitSha: string(head),
+		Message:       []byte(opts.Message),
+		Committer:     opts.Committer.ToProto(),
+	}
+	if opts.Author != nil {
+		req.Author = opts.Author.ToProto()
+	}
+
+	res, err := client.SquashCommits(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	return api.CommitID(res.GetCommitSha()), nil
+}
+
 func addNameOnly(opt CommitsOptions, checker authz.SubRepoPermissionChecker) CommitsOptions {
 	if authz.SubRepoEnabled(checker) {
 		// If sub-repo permissions enabled, must fetch files modified w/ commits to determine if user has access to view this commit
@@ -2040,6 +4760,56 @@ func (c *clientImplementor) ListRefs(ctx context.Context, repo api.RepoName, opt
 	return refs, nil
 }
 
+func (c *clientImplementor) RefsChangedSince(ctx context.Context, repo api.RepoName, opts RefsChangedSinceOptions) (_ gitdomain.RefChanges, err error) {
+	ctx, _, endObservation := c.operations.refsChangedSince.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("stateToken", opts.StateToken),
+			attribute.String("since", opts.Since.String()),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	client, err := c.clientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return gitdomain.RefChanges{}, err
+	}
+
+	req := &proto.RefsChangedSinceRequest{
+		RepoName:   string(repo),
+		StateToken: opts.StateToken,
+	}
+	if !opts.Since.IsZero() {
+		req.Since = timestamppb.New(opts.Since)
+	}
+
+	cc, err := client.RefsChangedSince(ctx, req)
+	if err != nil {
+		return gitdomain.RefChanges{}, err
+	}
+
+	var res gitdomain.RefChanges
+	for {
+		resp, err := cc.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return gitdomain.RefChanges{}, err
+		}
+		if resp.GetStateToken() != "" {
+			res.StateToken = resp.GetStateToken()
+			res.Full = resp.GetFull()
+		}
+		for _, c := range resp.GetChanges() {
+			res.Changes = append(res.Changes, gitdomain.RefChangeFromProto(c))
+		}
+	}
+
+	return res, nil
+}
+
 // rel strips the leading "/" prefix from the path string, effectively turning
 // an absolute path into one relative to the root directory. A path that is just
 // "/" is treated specially, returning just ".".
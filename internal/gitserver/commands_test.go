@@ -1,15 +1,18 @@
 package gitserver
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"io/fs"
 	"math/rand"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -18,6 +21,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/google/go-cmp/cmp"
@@ -28,6 +32,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -42,10 +47,11 @@ func (f ArchiveFormat) Generate(rand *rand.Rand, _ int) reflect.Value {
 
 func TestParseShortLog(t *testing.T) {
 	tests := []struct {
-		name    string
-		input   string // in the format of `git shortlog -sne`
-		want    []*gitdomain.ContributorCount
-		wantErr error
+		name          string
+		input         string // in the format of `git shortlog -sne`
+		withCommitter bool
+		want          []*gitdomain.ContributorCount
+		wantErr       error
 	}{
 		{
 			name: "basic",
@@ -84,10 +90,38 @@ func TestParseShortLog(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "author and committer",
+			input: "  1125\tJane Doe <jane@sourcegraph.com>\tBot Of Doom <bot@doombot.com>\n" +
+				"   390\tjane@sourcegraph.com <jane@sourcegraph.com>\tJane Doe <jane@sourcegraph.com>\n",
+			withCommitter: true,
+			want: []*gitdomain.ContributorCount{
+				{
+					Name:           "Jane Doe",
+					Email:          "jane@sourcegraph.com",
+					Count:          1125,
+					CommitterName:  "Bot Of Doom",
+					CommitterEmail: "bot@doombot.com",
+				},
+				{
+					Name:           "jane@sourcegraph.com",
+					Email:          "jane@sourcegraph.com",
+					Count:          390,
+					CommitterName:  "Jane Doe",
+					CommitterEmail: "jane@sourcegraph.com",
+				},
+			},
+		},
+		{
+			name:          "missing committer",
+			input:         "  1125\tJane Doe <jane@sourcegraph.com>\n",
+			withCommitter: true,
+			wantErr:       errors.New("invalid git shortlog line"),
+		},
 	}
 	for _, tst := range tests {
 		t.Run(tst.name, func(t *testing.T) {
-			got, gotErr := parseShortLog([]byte(tst.input))
+			got, gotErr := parseShortLog([]byte(tst.input), tst.withCommitter)
 			if (gotErr == nil) != (tst.wantErr == nil) {
 				t.Fatalf("gotErr %+v wantErr %+v", gotErr, tst.wantErr)
 			}
@@ -99,6 +133,155 @@ func TestParseShortLog(t *testing.T) {
 	}
 }
 
+func TestClient_ContributorCount(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := context.Background()
+
+	// Jane authors all commits, the bot lands two of them.
+	r := NewTestRepo(t)
+	r.Commit("one").Author("Jane", "jane@example.com").Committer("Jane", "jane@example.com")
+	r.Commit("two").Author("Jane", "jane@example.com").Committer("Bot", "bot@example.com")
+	r.Commit("three").Author("Jane", "jane@example.com").Committer("Bot", "bot@example.com")
+	repo := r.Build()
+
+	tests := map[string]struct {
+		groupBy ContributorGroupBy
+		want    []*gitdomain.ContributorCount
+	}{
+		"author": {
+			groupBy: ContributorGroupByAuthor,
+			want:    []*gitdomain.ContributorCount{{Name: "Jane", Email: "jane@example.com", Count: 3}},
+		},
+		"committer": {
+			groupBy: ContributorGroupByCommitter,
+			want: []*gitdomain.ContributorCount{
+				{Name: "Bot", Email: "bot@example.com", Count: 2},
+				{Name: "Jane", Email: "jane@example.com", Count: 1},
+			},
+		},
+		"author and committer": {
+			groupBy: ContributorGroupByAuthorAndCommitter,
+			want: []*gitdomain.ContributorCount{
+				{Name: "Jane", Email: "jane@example.com", Count: 2, CommitterName: "Bot", CommitterEmail: "bot@example.com"},
+				{Name: "Jane", Email: "jane@example.com", Count: 1, CommitterName: "Jane", CommitterEmail: "jane@example.com"},
+			},
+		},
+	}
+	for label, test := range tests {
+		t.Run(label, func(t *testing.T) {
+			got, err := NewClient("test").ContributorCount(ctx, repo, ContributorOptions{GroupBy: test.groupBy})
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+
+			// With sub-repo permissions enabled, the counts are computed
+			// from the commit log instead, with the same result.
+			c := NewTestClient(t).WithChecker(getTestSubRepoPermsChecker())
+			got, err = c.ContributorCount(actor.WithActor(ctx, &actor.Actor{UID: 1}), repo, ContributorOptions{GroupBy: test.groupBy})
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+		})
+	}
+
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		r := NewTestRepo(t)
+		r.Commit("public").AddFile("file", "a").Author("Jane", "jane@example.com")
+		r.Commit("secret").AddFile("secret", "a").Author("Joe", "joe@example.com")
+		r.Commit("both").AddFile("file", "b").AddFile("secret", "b").Author("Joe", "joe@example.com")
+		r.Commit("also secret").AddFile("secret", "c").Author("Jane", "jane@example.com")
+		repo := r.Build()
+
+		c := NewTestClient(t).WithChecker(getTestSubRepoPermsChecker("secret"))
+		ctx := actor.WithActor(ctx, &actor.Actor{UID: 1})
+
+		got, err := c.ContributorCount(ctx, repo, ContributorOptions{})
+		require.NoError(t, err)
+		require.Equal(t, []*gitdomain.ContributorCount{
+			{Name: "Jane", Email: "jane@example.com", Count: 1},
+			{Name: "Joe", Email: "joe@example.com", Count: 1},
+		}, got)
+
+		got, err = c.ContributorCount(ctx, repo, ContributorOptions{Path: "secret"})
+		require.NoError(t, err)
+		require.Empty(t, got)
+
+		_, err = c.ContributorCount(context.Background(), repo, ContributorOptions{})
+		require.True(t, errors.HasType(err, &authz.ErrUnauthenticated{}))
+	})
+}
+
+func TestClient_CommitActivity(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	r := NewTestRepo(t)
+	r.Commit("one").AddFile("a.txt", "a\n").At(day(1).Add(10 * time.Hour))
+	r.Commit("two").AddFile("secret.txt", "b\n").At(day(2).Add(10 * time.Hour))
+	r.Commit("three").AddFile("a.txt", "a2\n").At(day(4).Add(10 * time.Hour))
+	repo := r.Build()
+	dir := r.Dir()
+
+	var got *proto.CommitActivityRequest
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.CommitActivityFunc.SetDefaultHook(func(_ context.Context, req *proto.CommitActivityRequest, _ ...grpc.CallOption) (*proto.CommitActivityResponse, error) {
+				got = req
+				return &proto.CommitActivityResponse{
+					Buckets: []*proto.CommitActivityBucket{{Start: timestamppb.New(day(1)), Count: 3}},
+				}, nil
+			})
+			// Revisions are resolved through gRPC, which isn't available with
+			// LocalGitserver, so we resolve them with git in the repo directly.
+			c.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, req *proto.ResolveRevisionRequest, _ ...grpc.CallOption) (*proto.ResolveRevisionResponse, error) {
+				cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", string(req.GetRevSpec())+"^{commit}")
+				cmd.Dir = dir
+				out, err := cmd.Output()
+				if err != nil {
+					return nil, &gitdomain.RevisionNotFoundError{Repo: api.RepoName(req.GetRepoName()), Spec: string(req.GetRevSpec())}
+				}
+				return &proto.ResolveRevisionResponse{CommitSha: strings.TrimSpace(string(out))}, nil
+			})
+			return c
+		}
+	})
+	client := NewTestClient(t).WithClientSource(source)
+
+	t.Run("computed in gitserver", func(t *testing.T) {
+		buckets, err := client.CommitActivity(ctx, repo, CommitActivityOptions{
+			Path:     "dir",
+			After:    day(1),
+			Interval: gitdomain.CommitActivityIntervalWeek,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.CommitActivityBucket{{Start: day(1), Count: 3}}, buckets)
+		require.Equal(t, string(repo), got.GetRepoName())
+		require.Equal(t, "HEAD", string(got.GetRevspec()))
+		require.Equal(t, "dir", string(got.GetPath()))
+		require.Equal(t, day(1), got.GetAfter().AsTime())
+		require.Nil(t, got.GetBefore())
+		require.Equal(t, proto.CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_WEEK, got.GetInterval())
+	})
+
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		// Commits the actor can't see are counted on the client instead.
+		c := client.WithChecker(getTestSubRepoPermsChecker("secret.txt"))
+		buckets, err := c.CommitActivity(ctx, repo, CommitActivityOptions{Interval: gitdomain.CommitActivityIntervalDay})
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.CommitActivityBucket{
+			{Start: day(1), Count: 1},
+			{Start: day(2), Count: 0},
+			{Start: day(3), Count: 0},
+			{Start: day(4), Count: 1},
+		}, buckets)
+
+		_, err = c.CommitActivity(ctx, repo, CommitActivityOptions{Range: "doesnotexist"})
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}), "unexpected error %v", err)
+	})
+}
+
 func TestDiffWithSubRepoFiltering(t *testing.T) {
 	ctx := context.Background()
 	ctx = actor.WithActor(ctx, &actor.Actor{
@@ -108,12 +291,12 @@ func TestDiffWithSubRepoFiltering(t *testing.T) {
 	ClientMocks.LocalGitserver = true
 	defer ResetClientMocks()
 
-	cmds := getGitCommandsWithFileLists([]string{"file0"}, []string{"file1", "file1.1"}, []string{"file2"}, []string{"file3", "file3.3"})
 	checker := getTestSubRepoPermsChecker("file1.1", "file2")
 	testCases := []struct {
 		label               string
-		extraGitCommands    []string
+		extraCommit         func(r *TestRepo)
 		expectedDiffFiles   []string
+		expectedOrigFiles   []string
 		expectedFileStat    *godiff.Stat
 		rangeOverAllCommits bool
 	}{
@@ -125,41 +308,58 @@ func TestDiffWithSubRepoFiltering(t *testing.T) {
 		},
 		{
 			label: "changing filename",
-			extraGitCommands: []string{
-				"mv file1.1 file_can_access",
-				"git add file_can_access",
-				makeGitCommit("rename", 7),
+			extraCommit: func(r *TestRepo) {
+				// Only the new name is staged, file1.1 is kept.
+				r.Commit("rename").AddFile("file_can_access", "my_content_1\n")
 			},
 			expectedDiffFiles: []string{"file_can_access"},
 			expectedFileStat:  &godiff.Stat{Added: 1},
 		},
 		{
 			label: "file modified",
-			extraGitCommands: []string{
-				"echo new_file_content > file2",
-				"echo more_new_file_content > file1",
-				"git add file2",
-				"git add file1",
-				makeGitCommit("edit_files", 7),
+			extraCommit: func(r *TestRepo) {
+				r.Commit("edit_files").AddFile("file2", "new_file_content\n").AddFile("file1", "more_new_file_content\n")
 			},
 			expectedDiffFiles: []string{"file1"}, // file2 is updated but user doesn't have access
 			expectedFileStat:  &godiff.Stat{Changed: 1},
 		},
 		{
 			label: "diff for commit w/ no access returns empty result",
-			extraGitCommands: []string{
-				"echo new_file_content > file2",
-				"git add file2",
-				makeGitCommit("no_access", 7),
+			extraCommit: func(r *TestRepo) {
+				r.Commit("no_access").AddFile("file2", "new_file_content\n")
 			},
 			expectedDiffFiles: []string{},
 			expectedFileStat:  &godiff.Stat{},
 		},
+		{
+			label: "deleting files",
+			extraCommit: func(r *TestRepo) {
+				r.Commit("delete_files").DeleteFile("file1").DeleteFile("file2")
+			},
+			expectedDiffFiles: []string{"/dev/null"}, // file2 is deleted but user doesn't have access
+			expectedOrigFiles: []string{"file1"},
+			expectedFileStat:  &godiff.Stat{Deleted: 1},
+		},
+		{
+			label: "renaming file w/ no access",
+			extraCommit: func(r *TestRepo) {
+				r.Commit("rename_no_access").RenameFile("file2", "file_can_access").AppendFile("file_can_access", "more_content\n")
+			},
+			// The original name is redacted, only the added line is visible.
+			expectedDiffFiles: []string{"file_can_access"},
+			expectedOrigFiles: []string{"/dev/null"},
+			expectedFileStat:  &godiff.Stat{Added: 1},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.label, func(t *testing.T) {
-			repo := MakeGitRepository(t, append(cmds, tc.extraGitCommands...)...)
-			c := NewTestClient(t)
+			r := NewTestRepo(t)
+			addCommitsWithFileLists(r, []string{"file0"}, []string{"file1", "file1.1"}, []string{"file2"}, []string{"file3", "file3.3"})
+			if tc.extraCommit != nil {
+				tc.extraCommit(r)
+			}
+			repo := r.Build()
+			c := NewLocalTestClient(t)
 			commits, err := c.Commits(ctx, repo, CommitsOptions{})
 			if err != nil {
 				t.Fatalf("err fetching commits: %s", err)
@@ -179,6 +379,7 @@ func TestDiffWithSubRepoFiltering(t *testing.T) {
 
 			stat := &godiff.Stat{}
 			fileNames := make([]string, 0, 3)
+			origFileNames := make([]string, 0, 3)
 			for {
 				file, err := iter.Next()
 				if err == io.EOF {
@@ -188,6 +389,7 @@ func TestDiffWithSubRepoFiltering(t *testing.T) {
 				}
 
 				fileNames = append(fileNames, file.NewName)
+				origFileNames = append(origFileNames, file.OrigName)
 
 				fileStat := file.Stat()
 				stat.Added += fileStat.Added
@@ -197,6 +399,11 @@ func TestDiffWithSubRepoFiltering(t *testing.T) {
 			if diff := cmp.Diff(fileNames, tc.expectedDiffFiles); diff != "" {
 				t.Fatal(diff)
 			}
+			if tc.expectedOrigFiles != nil {
+				if diff := cmp.Diff(origFileNames, tc.expectedOrigFiles); diff != "" {
+					t.Fatal(diff)
+				}
+			}
 			if diff := cmp.Diff(stat, tc.expectedFileStat); diff != "" {
 				t.Fatal(diff)
 			}
@@ -204,6 +411,361 @@ func TestDiffWithSubRepoFiltering(t *testing.T) {
 	}
 }
 
+func TestBuildDiffArgs(t *testing.T) {
+	args, err := buildDiffArgs(DiffOptions{
+		Base:              "foo",
+		Head:              "bar",
+		Paths:             []string{"dir"},
+		IgnoreWhitespace:  true,
+		IgnoreSpaceChange: true,
+		IgnoreBlankLines:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"diff",
+		"--find-renames",
+		"--full-index",
+		"--inter-hunk-context=3",
+		"--no-prefix",
+		"-w",
+		"-b",
+		"--ignore-blank-lines",
+		"foo...bar",
+		"--",
+		"dir",
+	}, args)
+
+	// Reflog and tree revisions are passed through as before, only flags and
+	// files are rejected.
+	for _, head := range []string{"HEAD@{1}", "HEAD:dir"} {
+		_, err := buildDiffArgs(DiffOptions{Base: "foo", Head: head})
+		require.NoError(t, err, head)
+	}
+	_, err = buildDiffArgs(DiffOptions{Base: "-foo", Head: "bar"})
+	require.Error(t, err)
+	_, err = buildDiffArgs(DiffOptions{Base: "./foo", Head: "bar"})
+	require.Error(t, err)
+}
+
+func TestDiff_IgnoreWhitespace(t *testing.T) {
+	ctx := context.Background()
+
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+
+	// The second commit changes the spacing of the first line and appends a
+	// blank line, far enough apart to end up in separate hunks.
+	var lines strings.Builder
+	for i := 1; i <= 20; i++ {
+		fmt.Fprintf(&lines, "%d\n", i)
+	}
+	r := NewTestRepo(t)
+	r.Commit("first").AddFile("f", "a b\n"+lines.String())
+	r.Commit("second").AddFile("f", "a  b\n"+lines.String()+"\n")
+	repo := r.Build()
+	c := NewTestClient(t)
+
+	stat := func(t *testing.T, opts DiffOptions) godiff.Stat {
+		t.Helper()
+		opts.Repo, opts.Base, opts.Head = repo, "HEAD~1", "HEAD"
+		iter, err := c.Diff(ctx, opts)
+		require.NoError(t, err)
+		defer iter.Close()
+		var total godiff.Stat
+		for {
+			fd, err := iter.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			s := fd.Stat()
+			total.Added += s.Added
+			total.Changed += s.Changed
+			total.Deleted += s.Deleted
+		}
+		return total
+	}
+
+	require.Equal(t, godiff.Stat{Added: 1, Changed: 1}, stat(t, DiffOptions{}))
+	require.Equal(t, godiff.Stat{Added: 1}, stat(t, DiffOptions{IgnoreSpaceChange: true}))
+	require.Equal(t, godiff.Stat{Changed: 1}, stat(t, DiffOptions{IgnoreBlankLines: true}))
+	require.Equal(t, godiff.Stat{}, stat(t, DiffOptions{IgnoreWhitespace: true, IgnoreBlankLines: true}))
+}
+
+func TestDiff_RangeType(t *testing.T) {
+	ctx := context.Background()
+
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+
+	// main and feature both change a different file after branching off.
+	r := NewTestRepo(t)
+	base := r.Commit("base").AddFile("base", "base\n")
+	r.Commit("main").AddFile("main", "main\n")
+	r.Branch("feature", base)
+	r.Commit("feature").AddFile("feature", "feature\n")
+	repo := r.Build()
+	c := NewTestClient(t)
+
+	diffFiles := func(t *testing.T, rangeType string) []string {
+		t.Helper()
+		iter, err := c.Diff(ctx, DiffOptions{Repo: repo, Base: "master", Head: "feature", RangeType: rangeType})
+		require.NoError(t, err)
+		defer iter.Close()
+		var files []string
+		for {
+			fd, err := iter.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			files = append(files, fd.OrigName+" -> "+fd.NewName)
+		}
+		return files
+	}
+
+	// Three-dot only contains the changes made on feature since the merge base.
+	require.Equal(t, []string{"/dev/null -> feature"}, diffFiles(t, "..."))
+	// Two-dot is the literal difference between both states, so the file only
+	// added on master shows up as deleted.
+	require.Equal(t, []string{"/dev/null -> feature", "main -> /dev/null"}, diffFiles(t, ".."))
+}
+
+func TestDiff_Progress(t *testing.T) {
+	ctx := context.Background()
+
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+
+	r := NewTestRepo(t)
+	r.Commit("base").AddFile("a", "a\n")
+	r.Commit("change").AddFile("a", "a2\n").AddFile("b", "b\n").AddFile("c", "c\n")
+	repo := r.Build()
+	c := NewTestClient(t)
+
+	var progress []DiffProgress
+	iter, err := c.Diff(ctx, DiffOptions{
+		Repo:       repo,
+		Base:       "HEAD~1",
+		Head:       "HEAD",
+		OnProgress: func(p DiffProgress) { progress = append(progress, p) },
+	})
+	require.NoError(t, err)
+	defer iter.Close()
+	for {
+		_, err := iter.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+	}
+
+	require.Len(t, progress, 3)
+	for i, p := range progress {
+		require.Equal(t, i+1, p.Files)
+		require.Positive(t, p.Bytes)
+	}
+	require.Equal(t, progress[2], iter.Progress())
+}
+
+func TestDiff_Limits(t *testing.T) {
+	ctx := context.Background()
+
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+
+	r := NewTestRepo(t)
+	r.Commit("base").AddFile("base", "base\n")
+	r.Commit("change").AddFile("a", "a\n").AddFile("b", "b\n").AddFile("c", "c\n").AddFile("d", "d\n")
+	repo := r.Build()
+	c := NewTestClient(t)
+
+	diffFiles := func(t *testing.T, opts DiffOptions) ([]*godiff.FileDiff, *DiffTruncation) {
+		t.Helper()
+		opts.Repo, opts.Base, opts.Head = repo, "HEAD~1", "HEAD"
+		iter, err := c.Diff(ctx, opts)
+		require.NoError(t, err)
+		defer iter.Close()
+		var files []*godiff.FileDiff
+		for {
+			fd, err := iter.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			files = append(files, fd)
+		}
+		return files, iter.Truncated()
+	}
+
+	all, truncation := diffFiles(t, DiffOptions{})
+	require.Len(t, all, 4)
+	require.Nil(t, truncation)
+
+	files, truncation := diffFiles(t, DiffOptions{MaxFiles: 2})
+	require.Equal(t, all[:2], files)
+	require.Equal(t, &DiffTruncation{Limit: "files", Files: 2, Path: "c"}, truncation)
+
+	_, truncation = diffFiles(t, DiffOptions{MaxFiles: 4})
+	require.Nil(t, truncation)
+
+	// The limit ends the diff before the next file diff that starts after
+	// it.
+	args, err := buildDiffArgs(DiffOptions{Base: "HEAD~1", Head: "HEAD"})
+	require.NoError(t, err)
+	cmd := NewLocalGitCommand(repo, args...)
+	cmd.ReposDir = ClientMocks.LocalGitCommandReposDir
+	raw, err := cmd.Output(ctx)
+	require.NoError(t, err)
+	third := strings.Index(string(raw), "diff --git c c")
+	require.Positive(t, third)
+	files, truncation = diffFiles(t, DiffOptions{MaxBytes: int64(third)})
+	require.Equal(t, all[:2], files)
+	require.Equal(t, &DiffTruncation{Limit: "bytes", Files: 2, Path: "c"}, truncation)
+	files, truncation = diffFiles(t, DiffOptions{MaxBytes: int64(third + 20)})
+	require.Equal(t, all[:3], files)
+	require.Equal(t, &DiffTruncation{Limit: "bytes", Files: 3, Path: "d"}, truncation)
+
+	_, truncation = diffFiles(t, DiffOptions{MaxBytes: int64(len(raw))})
+	require.Nil(t, truncation)
+}
+
+func TestRawDiff(t *testing.T) {
+	ctx := context.Background()
+
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+
+	r := NewTestRepo(t)
+	r.Commit("base").AddFile("a", "a\n")
+	r.Commit("change").AddFile("a", "a2\n").AddFile("b", "b\n")
+	repo := r.Build()
+	opts := DiffOptions{Repo: repo, Base: "HEAD~1", Head: "HEAD"}
+
+	var buf bytes.Buffer
+	require.NoError(t, NewTestClient(t).RawDiff(ctx, opts, &buf))
+	fds, err := godiff.ParseMultiFileDiff(buf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, fds, 2)
+	require.Equal(t, "a", fds[0].NewName)
+	require.Equal(t, "b", fds[1].NewName)
+
+	err = NewTestClient(t).RawDiff(ctx, DiffOptions{Repo: repo, Base: "-x", Head: "HEAD"}, &buf)
+	require.Error(t, err)
+
+	client := NewTestClient(t).WithChecker(getTestSubRepoPermsChecker("b"))
+	require.Error(t, client.RawDiff(ctx, opts, io.Discard))
+}
+
+func TestDiff_Objects(t *testing.T) {
+	ctx := context.Background()
+
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+
+	r := NewTestRepo(t)
+	r.Commit("first").AddFile("f", "line1\n")
+	r.Commit("second").AddFile("f", "line2\n")
+	repo := r.Build()
+	dir := r.Dir()
+	c := NewTestClient(t)
+
+	revParse := func(t *testing.T, spec string) string {
+		t.Helper()
+		cmd := exec.Command("git", "rev-parse", spec)
+		cmd.Dir = dir
+		out, err := cmd.Output()
+		require.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+
+	readDiff := func(t *testing.T, opts DiffOptions) []*godiff.FileDiff {
+		t.Helper()
+		iter, err := c.Diff(ctx, opts)
+		require.NoError(t, err)
+		defer iter.Close()
+		var fds []*godiff.FileDiff
+		for {
+			fd, err := iter.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			fds = append(fds, fd)
+		}
+		return fds
+	}
+
+	t.Run("tree against commit", func(t *testing.T) {
+		fds := readDiff(t, DiffOptions{Repo: repo, Base: revParse(t, "HEAD~1^{tree}"), Head: "HEAD", RangeType: ".."})
+		require.Len(t, fds, 1)
+		require.Equal(t, "f", fds[0].NewName)
+		require.Equal(t, godiff.Stat{Changed: 1}, fds[0].Stat())
+	})
+
+	t.Run("blobs", func(t *testing.T) {
+		baseBlob, headBlob := revParse(t, "HEAD~1:f"), revParse(t, "HEAD:f")
+
+		fds := readDiff(t, DiffOptions{Repo: repo, Base: baseBlob, Head: headBlob, RangeType: ".."})
+		require.Len(t, fds, 1)
+		require.Equal(t, baseBlob, fds[0].OrigName)
+		require.Equal(t, headBlob, fds[0].NewName)
+		require.Equal(t, godiff.Stat{Changed: 1}, fds[0].Stat())
+	})
+}
+
+func TestRedactFileDiff(t *testing.T) {
+	fd, err := godiff.ParseFileDiff([]byte(`diff --git secret/a public/a
+similarity index 60%
+rename from secret/a
+rename to public/a
+index 1111111111111111111111111111111111111111..2222222222222222222222222222222222222222 100644
+--- secret/a
++++ public/a
+@@ -1,4 +1,4 @@
+ one
+-two
++zwei
+ three
+-four
++vier
+@@ -10,2 +10,1 @@
+ ten
+-eleven
+`))
+	require.NoError(t, err)
+
+	t.Run("original side", func(t *testing.T) {
+		redacted := redactFileDiff(fd, '-')
+		require.Equal(t, "/dev/null", redacted.OrigName)
+		require.Equal(t, "public/a", redacted.NewName)
+		require.Equal(t, []string{"diff --git public/a public/a"}, redacted.Extended)
+		// The second hunk only removes lines, so it is dropped entirely.
+		require.Len(t, redacted.Hunks, 1)
+		require.Equal(t, " one\n+zwei\n three\n+vier\n", string(redacted.Hunks[0].Body))
+		require.Equal(t, int32(2), redacted.Hunks[0].OrigLines)
+		require.Equal(t, int32(4), redacted.Hunks[0].NewLines)
+		require.Equal(t, godiff.Stat{Added: 2}, redacted.Stat())
+	})
+
+	t.Run("new side", func(t *testing.T) {
+		redacted := redactFileDiff(fd, '+')
+		require.Equal(t, "secret/a", redacted.OrigName)
+		require.Equal(t, "/dev/null", redacted.NewName)
+		require.Equal(t, []string{"diff --git secret/a secret/a"}, redacted.Extended)
+		require.Len(t, redacted.Hunks, 2)
+		require.Equal(t, " one\n-two\n three\n-four\n", string(redacted.Hunks[0].Body))
+		require.Equal(t, int32(4), redacted.Hunks[0].OrigLines)
+		require.Equal(t, int32(2), redacted.Hunks[0].NewLines)
+		require.Equal(t, godiff.Stat{Deleted: 3}, redacted.Stat())
+	})
+
+	// The input is not modified.
+	require.Equal(t, "secret/a", fd.OrigName)
+	require.Len(t, fd.Extended, 5)
+	require.Len(t, fd.Hunks, 2)
+}
+
 func TestDiff(t *testing.T) {
 	ctx := context.Background()
 
@@ -231,6 +793,10 @@ func TestDiff(t *testing.T) {
 			want string
 		}{
 			{opts: DiffOptions{Base: "foo", Head: "bar"}, want: "foo...bar"},
+			{opts: DiffOptions{Base: "foo", Head: "bar", RangeType: "..."}, want: "foo...bar"},
+			{opts: DiffOptions{Base: "foo", Head: "bar", RangeType: ".."}, want: "foo..bar"},
+			// The empty tree is not a commit, so it has no merge base.
+			{opts: DiffOptions{Base: DevNullSHA, Head: "bar"}, want: DevNullSHA + "..bar"},
 		} {
 			t.Run("rangeSpec: "+tc.want, func(t *testing.T) {
 				c := NewMockClientWithExecReader(nil, func(_ context.Context, _ api.RepoName, args []string) (io.ReadCloser, error) {
@@ -245,6 +811,19 @@ func TestDiff(t *testing.T) {
 		}
 	})
 
+	t.Run("paths", func(t *testing.T) {
+		var got []string
+		c := NewMockClientWithExecReader(nil, func(_ context.Context, _ api.RepoName, args []string) (io.ReadCloser, error) {
+			got = args
+			return io.NopCloser(strings.NewReader("")), nil
+		})
+		i, err := c.Diff(ctx, DiffOptions{Base: "foo", Head: "bar", Paths: []string{"dir/", "-file"}})
+		require.NoError(t, err)
+		defer i.Close()
+		// Paths always come after the "--" separator.
+		require.Equal(t, []string{"foo...bar", "--", "dir/", "-file"}, got[len(got)-4:])
+	})
+
 	t.Run("ExecReader error", func(t *testing.T) {
 		c := NewMockClientWithExecReader(nil, func(_ context.Context, _ api.RepoName, args []string) (io.ReadCloser, error) {
 			return nil, errors.New("ExecReader error")
@@ -391,17 +970,10 @@ func runFileListingTest(t *testing.T,
 	listingFunctionToTest func(context.Context, authz.SubRepoPermissionChecker, api.RepoName, string) ([]string, error),
 ) {
 	t.Helper()
-	gitCommands := []string{
-		"touch file1",
-		"mkdir dir",
-		"touch dir/file2",
-		"touch dir/file3",
-		"git add file1 dir/file2 dir/file3",
-		"git commit -m commit1",
-	}
-
-	repo, dir := MakeGitRepositoryAndReturnDir(t, gitCommands...)
-	headCommit := GetHeadCommitFromGitDir(t, dir)
+	r := NewTestRepo(t)
+	head := r.Commit("commit1").AddFile("file1", "").AddFile("dir/file2", "").AddFile("dir/file3", "")
+	repo := r.Build()
+	headCommit := string(head.ID())
 	ctx := context.Background()
 
 	checker := authz.NewMockSubRepoPermissionChecker()
@@ -522,19 +1094,15 @@ func TestCleanDirectoriesForLsTree(t *testing.T) {
 func TestListDirectoryChildren(t *testing.T) {
 	ClientMocks.LocalGitserver = true
 	defer ResetClientMocks()
-	gitCommands := []string{
-		"mkdir -p dir{1..3}/sub{1..3}",
-		"touch dir1/sub1/file",
-		"touch dir1/sub2/file",
-		"touch dir2/sub1/file",
-		"touch dir2/sub2/file",
-		"touch dir3/sub1/file",
-		"touch dir3/sub3/file",
-		"git add .",
-		"git commit -m commit1",
-	}
-
-	repo := MakeGitRepository(t, gitCommands...)
+	r := NewTestRepo(t)
+	r.Commit("commit1").
+		AddFile("dir1/sub1/file", "").
+		AddFile("dir1/sub2/file", "").
+		AddFile("dir2/sub1/file", "").
+		AddFile("dir2/sub2/file", "").
+		AddFile("dir3/sub1/file", "").
+		AddFile("dir3/sub3/file", "")
+	repo := r.Build()
 
 	ctx := context.Background()
 
@@ -592,15 +1160,12 @@ func TestRepository_FileSystem_Symlinks(t *testing.T) {
 	ClientMocks.LocalGitserver = true
 	defer ResetClientMocks()
 
-	gitCommands := []string{
-		"touch file1",
-		"mkdir dir1",
-		"ln -s file1 link1",
-		"ln -s ../file1 dir1/link2",
-		"touch --date=2006-01-02T15:04:05Z file1 link1 dir1/link2 || touch -t " + Times[0] + " file1 link1 dir1/link2",
-		"git add link1 file1 dir1/link2",
-		"git commit -m commit1",
-	}
+	r := NewTestRepo(t)
+	commit := r.Commit("commit1").
+		AddFile("file1", "").
+		AddSymlink("link1", "file1").
+		AddSymlink("dir1/link2", "../file1")
+	repo := r.Build()
 
 	// map of path to size of content
 	symlinks := map[string]int64{
@@ -608,12 +1173,9 @@ func TestRepository_FileSystem_Symlinks(t *testing.T) {
 		"dir1/link2": 8, // ../file1
 	}
 
-	dir := InitGitRepository(t, gitCommands...)
-	repo := api.RepoName(filepath.Base(dir))
-
 	client := NewClient("test")
 
-	commitID := api.CommitID(ComputeCommitHash(dir, true))
+	commitID := commit.ID()
 
 	ctx := context.Background()
 
@@ -642,10 +1204,7 @@ func TestRepository_FileSystem_Symlinks(t *testing.T) {
 		if err != nil {
 			t.Fatalf("fs.Stat(%s): %s", symlink, err)
 		}
-		if runtime.GOOS != "windows" {
-			// TODO(alexsaveliev) make it work on Windows too
-			checkSymlinkFileInfo(symlink, fi)
-		}
+		checkSymlinkFileInfo(symlink, fi)
 	}
 
 	// Also check the FileInfo returned by ReadDir to ensure it's
@@ -658,9 +1217,7 @@ func TestRepository_FileSystem_Symlinks(t *testing.T) {
 	for _, entry := range entries {
 		if entry.Name() == "link1" {
 			found = true
-			if runtime.GOOS != "windows" {
-				checkSymlinkFileInfo("link1", entry)
-			}
+			checkSymlinkFileInfo("link1", entry)
 		}
 	}
 	if !found {
@@ -688,15 +1245,9 @@ func TestStat(t *testing.T) {
 	ClientMocks.LocalGitserver = true
 	defer ResetClientMocks()
 
-	gitCommands := []string{
-		"mkdir dir1",
-		"touch dir1/file1",
-		"git add dir1/file1",
-		"git commit -m commit1",
-	}
-
-	dir := InitGitRepository(t, gitCommands...)
-	repo := api.RepoName(filepath.Base(dir))
+	r := NewTestRepo(t)
+	head := r.Commit("commit1").AddFile("dir1/file1", "")
+	repo := r.Build()
 	checker := authz.NewMockSubRepoPermissionChecker()
 	// Start disabled
 	checker.EnabledFunc.SetDefaultHook(func() bool {
@@ -704,7 +1255,7 @@ func TestStat(t *testing.T) {
 	})
 	client := NewTestClient(t).WithChecker(checker)
 
-	commitID := api.CommitID(ComputeCommitHash(dir, true))
+	commitID := head.ID()
 
 	ctx := context.Background()
 
@@ -742,6 +1293,61 @@ func TestStat(t *testing.T) {
 	}
 }
 
+func TestPathExists(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+
+	r := NewTestRepo(t)
+	head := r.Commit("commit1").AddFile("dir1/sub/file1", "hello\n").AddFile("dir1/secret", "secret\n")
+	repo := r.Build()
+	commitID := head.ID()
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+	client := NewTestClient(t).WithChecker(getTestSubRepoPermsChecker("dir1/secret", "dir1/sub/"))
+
+	tests := []struct {
+		path       string
+		wantExists bool
+		wantType   gitdomain.ObjectType
+	}{
+		{path: "dir1", wantExists: true, wantType: gitdomain.ObjectTypeTree},
+		{path: "dir1/", wantExists: true, wantType: gitdomain.ObjectTypeTree},
+		{path: "missing", wantExists: false},
+		{path: "dir1/missing", wantExists: false},
+		{path: "dir1/secret/file", wantExists: false},
+		{path: "dir", wantExists: false},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			exists, typ, err := client.PathExists(ctx, repo, commitID, test.path)
+			require.NoError(t, err)
+			require.Equal(t, test.wantExists, exists)
+			require.Equal(t, test.wantType, typ)
+		})
+	}
+
+	t.Run("file", func(t *testing.T) {
+		exists, typ, err := NewTestClient(t).PathExists(ctx, repo, commitID, "dir1/sub/file1")
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, gitdomain.ObjectTypeBlob, typ)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		for _, path := range []string{"dir1/secret", "dir1/sub"} {
+			exists, _, err := client.PathExists(ctx, repo, commitID, path)
+			require.False(t, exists)
+			var e *gitdomain.PathAccessDeniedError
+			require.ErrorAs(t, err, &e)
+			require.Equal(t, path, e.Path)
+		}
+	})
+
+	t.Run("missing commit", func(t *testing.T) {
+		_, _, err := client.PathExists(ctx, repo, NonExistentCommitID, "dir1")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
 var NonExistentCommitID = api.CommitID(strings.Repeat("a", 40))
 
 func TestLogPartsPerCommitInSync(t *testing.T) {
@@ -837,11 +1443,11 @@ func TestRepository_HasCommitAfter(t *testing.T) {
 					}
 				}))
 
-				gitCommands := make([]string, len(tc.commitDates))
-				for i, date := range tc.commitDates {
-					gitCommands[i] = fmt.Sprintf("GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=%s git commit --allow-empty -m foo --author='a <a@a.com>'", date)
+				r := NewTestRepo(t)
+				for _, date := range tc.commitDates {
+					r.Commit("foo").At(*mustParseDate(date, t))
 				}
-				repo := MakeGitRepository(t, gitCommands...)
+				repo := r.Build()
 				got, err := client.HasCommitAfter(ctx, repo, tc.after, tc.revspec)
 				if err != nil || got != tc.want {
 					t.Errorf("got %t hascommitafter, want %t", got, tc.want)
@@ -853,16 +1459,14 @@ func TestRepository_HasCommitAfter(t *testing.T) {
 	t.Run("with sub-repo permissions", func(t *testing.T) {
 		for _, tc := range testCases {
 			t.Run(tc.label, func(t *testing.T) {
-				gitCommands := make([]string, len(tc.commitDates))
+				r := NewTestRepo(t)
 				for i, date := range tc.commitDates {
-					fileName := fmt.Sprintf("file%d", i)
-					gitCommands = append(gitCommands, fmt.Sprintf("touch %s", fileName), fmt.Sprintf("git add %s", fileName))
-					gitCommands = append(gitCommands, fmt.Sprintf("GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=%s git commit -m commit%d --author='a <a@a.com>'", date, i))
+					r.Commit(fmt.Sprintf("commit%d", i)).AddFile(fmt.Sprintf("file%d", i), "").At(*mustParseDate(date, t))
 				}
 				// Case where user can't view commit 2, but can view commits 0 and 1. In each test case the result should match the case where no sub-repo perms enabled
 				checker := getTestSubRepoPermsChecker("file2")
-				client := NewTestClient(t).WithChecker(checker)
-				repo := MakeGitRepository(t, gitCommands...)
+				client := NewLocalTestClient(t).WithChecker(checker)
+				repo := r.Build()
 				got, err := client.HasCommitAfter(ctx, repo, tc.after, tc.revspec)
 				if err != nil {
 					t.Errorf("got error: %s", err)
@@ -873,7 +1477,7 @@ func TestRepository_HasCommitAfter(t *testing.T) {
 
 				// Case where user can't view commit 1 or commit 2, which will mean in some cases since HasCommitAfter will be false due to those commits not being visible.
 				checker = getTestSubRepoPermsChecker("file1", "file2")
-				client = NewTestClient(t).WithChecker(checker)
+				client = NewLocalTestClient(t).WithChecker(checker)
 				got, err = client.HasCommitAfter(ctx, repo, tc.after, tc.revspec)
 				if err != nil {
 					t.Errorf("got error: %s", err)
@@ -917,12 +1521,11 @@ func TestRepository_FirstEverCommit(t *testing.T) {
 
 	t.Run("basic", func(t *testing.T) {
 		for _, tc := range testCases {
-			gitCommands := make([]string, len(tc.commitDates))
-			for i, date := range tc.commitDates {
-				gitCommands[i] = fmt.Sprintf("GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=%s git commit --allow-empty -m foo --author='a <a@a.com>'", date)
+			r := NewTestRepo(t)
+			for _, date := range tc.commitDates {
+				r.Commit("foo").At(*mustParseDate(date, t))
 			}
-
-			repo := MakeGitRepository(t, gitCommands...)
+			repo := r.Build()
 
 			client := NewTestClient(t).WithClientSource(NewTestClientSource(t, []string{"test"}, func(o *TestClientSourceOptions) {
 				o.ClientFunc = func(conn *grpc.ClientConn) proto.GitserverServiceClient {
@@ -953,7 +1556,7 @@ func TestRepository_FirstEverCommit(t *testing.T) {
 
 	// Added for awareness if this error message changes. Insights skip over empty repos and check against error message
 	t.Run("empty repo", func(t *testing.T) {
-		repo := MakeGitRepository(t)
+		repo := NewTestRepo(t).Build()
 		_, err := NewClient("test").FirstEverCommit(ctx, repo)
 		wantErr := `git command [rev-list --reverse --date-order --max-parents=0 HEAD] failed (output: ""): exit status 128`
 		if err.Error() != wantErr {
@@ -962,31 +1565,208 @@ func TestRepository_FirstEverCommit(t *testing.T) {
 	})
 }
 
-func TestRepository_Commits(t *testing.T) {
+func TestClient_FirstCommitForPath(t *testing.T) {
 	ClientMocks.LocalGitserver = true
 	defer ResetClientMocks()
-	ctx := actor.WithActor(context.Background(), &actor.Actor{
-		UID: 1,
+	ctx := actor.WithActor(context.Background(), actor.FromUser(42))
+
+	r := NewTestRepo(t)
+	add := r.Commit("add").AddFile("a.txt", "hello world, this is a file\n")
+	r.Commit("other")
+	r.Commit("rename").RenameFile("a.txt", "b.txt")
+	r.Commit("modify").AppendFile("b.txt", "more\n")
+	repo := r.Build()
+	dir := r.Dir()
+
+	// Revisions are resolved through gRPC, which isn't available with
+	// LocalGitserver, so we resolve them with git in the repo directly.
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, req *proto.ResolveRevisionRequest, _ ...grpc.CallOption) (*proto.ResolveRevisionResponse, error) {
+				cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", string(req.GetRevSpec())+"^{commit}")
+				cmd.Dir = dir
+				out, err := cmd.Output()
+				if err != nil {
+					return nil, &gitdomain.RevisionNotFoundError{Repo: api.RepoName(req.GetRepoName()), Spec: string(req.GetRevSpec())}
+				}
+				return &proto.ResolveRevisionResponse{CommitSha: strings.TrimSpace(string(out))}, nil
+			})
+			return c
+		}
 	})
+	client := NewTestClient(t).WithClientSource(source)
 
-	// TODO(sqs): test CommitsOptions.Base
+	// The file is followed beyond the rename.
+	commit, err := client.FirstCommitForPath(ctx, repo, "HEAD", "b.txt")
+	require.NoError(t, err)
+	require.Equal(t, add.ID(), commit.ID)
+	require.Equal(t, gitdomain.Message("add"), commit.Message)
 
-	gitCommands := []string{
-		"git commit --allow-empty -m foo",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:07Z git commit --allow-empty -m bar --author='a <a@a.com>' --date 2006-01-02T15:04:06Z",
-	}
-	wantGitCommits := []*gitdomain.Commit{
-		{
-			ID:        "b266c7e3ca00b1a17ad0b1449825d0854225c007",
-			Author:    gitdomain.Signature{Name: "a", Email: "a@a.com", Date: MustParseTime(time.RFC3339, "2006-01-02T15:04:06Z")},
-			Committer: &gitdomain.Signature{Name: "c", Email: "c@c.com", Date: MustParseTime(time.RFC3339, "2006-01-02T15:04:07Z")},
-			Message:   "bar",
-			Parents:   []api.CommitID{"ea167fe3d76b1e5fd3ed8ca44cbd2fe3897684f8"},
-		},
-		{
-			ID:        "ea167fe3d76b1e5fd3ed8ca44cbd2fe3897684f8",
-			Author:    gitdomain.Signature{Name: "a", Email: "a@a.com", Date: MustParseTime(time.RFC3339, "2006-01-02T15:04:05Z")},
-			Committer: &gitdomain.Signature{Name: "a", Email: "a@a.com", Date: MustParseTime(time.RFC3339, "2006-01-02T15:04:05Z")},
+	_, err = client.FirstCommitForPath(ctx, repo, "HEAD", "missing.txt")
+	require.True(t, os.IsNotExist(err), "unexpected error %v", err)
+
+	_, err = client.FirstCommitForPath(ctx, repo, "doesnotexist", "b.txt")
+	require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}), "unexpected error %v", err)
+
+	_, err = client.FirstCommitForPath(ctx, repo, "HEAD", "")
+	require.Error(t, err)
+
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		_, err := client.WithChecker(getTestSubRepoPermsChecker("a.txt")).FirstCommitForPath(ctx, repo, "HEAD", "b.txt")
+		require.True(t, os.IsNotExist(err), "unexpected error %v", err)
+	})
+}
+
+func TestClient_DirectoryHistory(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := actor.WithActor(context.Background(), actor.FromUser(42))
+
+	r := NewTestRepo(t)
+	r.Commit("one").AddFile("dir/a.txt", "1\n")
+	r.Commit("two").AddFile("other/b.txt", "1\n")
+	r.Commit("three").AddFile("dir/sub/c.txt", "2\n")
+	four := r.Commit("four").AddFile("dir/a.txt", "3\n").AddFile("glob*/d.txt", "3\n")
+	r.Commit("five").AddFile("other/b.txt", "2\n")
+	repo := r.Build()
+	dir := r.Dir()
+
+	// Revisions are resolved through gRPC, which isn't available with
+	// LocalGitserver, so we resolve them with git in the repo directly.
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, req *proto.ResolveRevisionRequest, _ ...grpc.CallOption) (*proto.ResolveRevisionResponse, error) {
+				cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", string(req.GetRevSpec())+"^{commit}")
+				cmd.Dir = dir
+				out, err := cmd.Output()
+				if err != nil {
+					return nil, &gitdomain.RevisionNotFoundError{Repo: api.RepoName(req.GetRepoName()), Spec: string(req.GetRevSpec())}
+				}
+				return &proto.ResolveRevisionResponse{CommitSha: strings.TrimSpace(string(out))}, nil
+			})
+			return c
+		}
+	})
+	client := NewTestClient(t).WithClientSource(source)
+
+	messages := func(commits []*gitdomain.Commit) []string {
+		var ms []string
+		for _, c := range commits {
+			ms = append(ms, string(c.Message))
+		}
+		return ms
+	}
+
+	t.Run("pages", func(t *testing.T) {
+		commits, cursor, err := client.DirectoryHistory(ctx, repo, "HEAD", "dir/", DirectoryHistoryOptions{First: 2})
+		require.NoError(t, err)
+		require.Equal(t, []string{"four", "three"}, messages(commits))
+		require.NotEmpty(t, cursor)
+
+		// The cursor pins the commit of the first page, so new commits don't
+		// shift the following pages.
+		commits, cursor, err = client.DirectoryHistory(ctx, repo, "doesnotexist", "dir/", DirectoryHistoryOptions{First: 2, After: cursor})
+		require.NoError(t, err)
+		require.Equal(t, []string{"one"}, messages(commits))
+		require.Empty(t, cursor)
+	})
+
+	t.Run("exact page", func(t *testing.T) {
+		commits, cursor, err := client.DirectoryHistory(ctx, repo, "HEAD", "dir/sub", DirectoryHistoryOptions{First: 1})
+		require.NoError(t, err)
+		require.Equal(t, []string{"three"}, messages(commits))
+		require.Empty(t, cursor)
+	})
+
+	t.Run("whole repository", func(t *testing.T) {
+		commits, _, err := client.DirectoryHistory(ctx, repo, "HEAD~1", "", DirectoryHistoryOptions{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"four", "three", "two", "one"}, messages(commits))
+	})
+
+	t.Run("literal path", func(t *testing.T) {
+		commits, _, err := client.DirectoryHistory(ctx, repo, "HEAD", "glob*", DirectoryHistoryOptions{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"four"}, messages(commits))
+		require.Equal(t, four.ID(), commits[0].ID)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, _, err := client.DirectoryHistory(ctx, repo, "doesnotexist", "dir", DirectoryHistoryOptions{})
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}), "unexpected error %v", err)
+
+		_, _, err = client.DirectoryHistory(ctx, repo, "HEAD", "dir", DirectoryHistoryOptions{After: "bogus"})
+		require.Error(t, err)
+
+		_, _, err = client.DirectoryHistory(ctx, repo, "HEAD", "dir", DirectoryHistoryOptions{First: -1})
+		require.Error(t, err)
+	})
+
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		commits, _, err := client.WithChecker(getTestSubRepoPermsChecker("dir/sub/c.txt")).DirectoryHistory(ctx, repo, "HEAD", "dir", DirectoryHistoryOptions{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"four", "one"}, messages(commits))
+	})
+}
+
+func TestClient_AbbreviateCommit(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := context.Background()
+
+	r := NewTestRepo(t)
+	first := r.Commit("first")
+	repo := r.Build()
+	dir := r.Dir()
+	commit := first.ID()
+
+	abbrev, err := NewTestClient(t).AbbreviateCommit(ctx, repo, commit)
+	require.NoError(t, err)
+	require.Len(t, abbrev, 7)
+	require.True(t, strings.HasPrefix(string(commit), abbrev))
+
+	// core.abbrev of the repository is respected.
+	require.NoError(t, exec.Command("git", "-C", dir, "config", "core.abbrev", "12").Run())
+	abbrev, err = NewTestClient(t).AbbreviateCommit(ctx, repo, commit)
+	require.NoError(t, err)
+	require.Equal(t, string(commit[:12]), abbrev)
+
+	_, err = NewTestClient(t).AbbreviateCommit(ctx, repo, "e3f0d6b9b2e9f4a1c3b5d7e9f1a3c5b7d9e1f3a5")
+	require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}), "unexpected error %v", err)
+
+	_, err = NewTestClient(t).AbbreviateCommit(ctx, repo, "HEAD")
+	require.Error(t, err)
+}
+
+func TestRepository_Commits(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := actor.WithActor(context.Background(), &actor.Actor{
+		UID: 1,
+	})
+
+	// TODO(sqs): test CommitsOptions.Base
+
+	r := NewTestRepo(t)
+	r.Commit("foo")
+	r.Commit("bar").
+		At(MustParseTime(time.RFC3339, "2006-01-02T15:04:06Z")).
+		CommittedAt(MustParseTime(time.RFC3339, "2006-01-02T15:04:07Z")).
+		Committer("c", "c@c.com")
+	wantGitCommits := []*gitdomain.Commit{
+		{
+			ID:        "b266c7e3ca00b1a17ad0b1449825d0854225c007",
+			Author:    gitdomain.Signature{Name: "a", Email: "a@a.com", Date: MustParseTime(time.RFC3339, "2006-01-02T15:04:06Z")},
+			Committer: &gitdomain.Signature{Name: "c", Email: "c@c.com", Date: MustParseTime(time.RFC3339, "2006-01-02T15:04:07Z")},
+			Message:   "bar",
+			Parents:   []api.CommitID{"ea167fe3d76b1e5fd3ed8ca44cbd2fe3897684f8"},
+		},
+		{
+			ID:        "ea167fe3d76b1e5fd3ed8ca44cbd2fe3897684f8",
+			Author:    gitdomain.Signature{Name: "a", Email: "a@a.com", Date: MustParseTime(time.RFC3339, "2006-01-02T15:04:05Z")},
+			Committer: &gitdomain.Signature{Name: "a", Email: "a@a.com", Date: MustParseTime(time.RFC3339, "2006-01-02T15:04:05Z")},
 			Message:   "foo",
 			Parents:   nil,
 		},
@@ -998,7 +1778,7 @@ func TestRepository_Commits(t *testing.T) {
 		wantTotal   uint
 	}{
 		"git cmd": {
-			repo:        MakeGitRepository(t, gitCommands...),
+			repo:        r.Build(),
 			id:          "b266c7e3ca00b1a17ad0b1449825d0854225c007",
 			wantCommits: wantGitCommits,
 			wantTotal:   2,
@@ -1028,20 +1808,16 @@ func TestCommits_SubRepoPerms(t *testing.T) {
 	ctx := actor.WithActor(context.Background(), &actor.Actor{
 		UID: 1,
 	})
-	gitCommands := []string{
-		"touch file1",
-		"git add file1",
-		"git commit -m commit1",
-		"touch file2",
-		"git add file2",
-		"touch file2.2",
-		"git add file2.2",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:07Z git commit -m commit2 --author='a <a@a.com>' --date 2006-01-02T15:04:06Z",
-		"touch file3",
-		"git add file3",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:07Z git commit -m commit3 --author='a <a@a.com>' --date 2006-01-02T15:04:07Z",
-	}
-	repo := MakeGitRepository(t, gitCommands...)
+	r := NewTestRepo(t)
+	r.Commit("commit1").AddFile("file1", "")
+	r.Commit("commit2").AddFile("file2", "").AddFile("file2.2", "").
+		At(MustParseTime(time.RFC3339, "2006-01-02T15:04:06Z")).
+		CommittedAt(MustParseTime(time.RFC3339, "2006-01-02T15:04:07Z")).
+		Committer("c", "c@c.com")
+	r.Commit("commit3").AddFile("file3", "").
+		At(MustParseTime(time.RFC3339, "2006-01-02T15:04:07Z")).
+		Committer("c", "c@c.com")
+	repo := r.Build()
 
 	tests := map[string]struct {
 		wantCommits   []*gitdomain.Commit
@@ -1096,7 +1872,7 @@ func TestCommits_SubRepoPerms(t *testing.T) {
 	for label, test := range tests {
 		t.Run(label, func(t *testing.T) {
 			checker := getTestSubRepoPermsChecker(test.noAccessPaths...)
-			client := NewTestClient(t).WithChecker(checker)
+			client := NewLocalTestClient(t).WithChecker(checker)
 			commits, err := client.Commits(ctx, repo, test.opt)
 			if err != nil {
 				t.Errorf("%s: Commits(): %s", label, err)
@@ -1118,31 +1894,23 @@ func TestCommits_SubRepoPerms_ReturnNCommits(t *testing.T) {
 	ctx := actor.WithActor(context.Background(), &actor.Actor{
 		UID: 1,
 	})
-	gitCommands := []string{
-		"touch file1",
-		"git add file1",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:01Z git commit -m commit1 --author='a <a@a.com>' --date 2006-01-02T15:04:01Z",
-		"touch file2",
-		"git add file2",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:02Z git commit -m commit2 --author='a <a@a.com>' --date 2006-01-02T15:04:02Z",
-		"echo foo > file1",
-		"git add file1",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:03Z git commit -m commit3 --author='a <a@a.com>' --date 2006-01-02T15:04:03Z",
-		"echo asdf > file1",
-		"git add file1",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:04Z git commit -m commit4 --author='a <a@a.com>' --date 2006-01-02T15:04:04Z",
-		"echo bar > file1",
-		"git add file1",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m commit5 --author='a <a@a.com>' --date 2006-01-02T15:04:05Z",
-		"echo asdf2 > file2",
-		"git add file2",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:06Z git commit -m commit6 --author='a <a@a.com>' --date 2006-01-02T15:04:06Z",
-		"echo bazz > file1",
-		"git add file1",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:07Z git commit -m commit7 --author='a <a@a.com>' --date 2006-01-02T15:04:07Z",
-		"echo bazz > file2",
-		"git add file2",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:08Z git commit -m commit8 --author='a <a@a.com>' --date 2006-01-02T15:04:08Z",
+	// Each commit changes one file, one second after the previous commit.
+	changes := []struct{ file, content string }{
+		{"file1", ""},
+		{"file2", ""},
+		{"file1", "foo\n"},
+		{"file1", "asdf\n"},
+		{"file1", "bar\n"},
+		{"file2", "asdf2\n"},
+		{"file1", "bazz\n"},
+		{"file2", "bazz\n"},
+	}
+	r := NewTestRepo(t)
+	for i, change := range changes {
+		r.Commit(fmt.Sprintf("commit%d", i+1)).
+			AddFile(change.file, change.content).
+			At(time.Date(2006, 1, 2, 15, 4, i+1, 0, time.UTC)).
+			Committer("c", "c@c.com")
 	}
 
 	tests := map[string]struct {
@@ -1153,7 +1921,7 @@ func TestCommits_SubRepoPerms_ReturnNCommits(t *testing.T) {
 		noAccessPaths []string
 	}{
 		"return the requested number of commits": {
-			repo:      MakeGitRepository(t, gitCommands...),
+			repo:      r.Build(),
 			wantTotal: 3,
 			opt: CommitsOptions{
 				N: 3,
@@ -1212,10 +1980,17 @@ func TestRepository_Commits_options(t *testing.T) {
 	ctx := context.Background()
 	ctx = actor.WithActor(ctx, actor.FromUser(42))
 
-	gitCommands := []string{
-		"git commit --allow-empty -m foo",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:07Z git commit --allow-empty -m bar --author='a <a@a.com>' --date 2006-01-02T15:04:06Z",
-		"GIT_COMMITTER_NAME=c GIT_COMMITTER_EMAIL=c@c.com GIT_COMMITTER_DATE=2006-01-02T15:04:08Z git commit --allow-empty -m qux --author='a <a@a.com>' --date 2006-01-02T15:04:08Z",
+	buildRepo := func(t *testing.T) api.RepoName {
+		r := NewTestRepo(t)
+		r.Commit("foo")
+		r.Commit("bar").
+			At(MustParseTime(time.RFC3339, "2006-01-02T15:04:06Z")).
+			CommittedAt(MustParseTime(time.RFC3339, "2006-01-02T15:04:07Z")).
+			Committer("c", "c@c.com")
+		r.Commit("qux").
+			At(MustParseTime(time.RFC3339, "2006-01-02T15:04:08Z")).
+			Committer("c", "c@c.com")
+		return r.Build()
 	}
 	wantGitCommits := []*gitdomain.Commit{
 		{
@@ -1254,7 +2029,7 @@ func TestRepository_Commits_options(t *testing.T) {
 		},
 		"before": {
 			opt: CommitsOptions{
-				Before: "2006-01-02T15:04:07Z",
+				Before: MustParseTime(time.RFC3339, "2006-01-02T15:04:07Z"),
 				Range:  "HEAD",
 				N:      1,
 			},
@@ -1273,7 +2048,7 @@ func TestRepository_Commits_options(t *testing.T) {
 	runCommitsTests := func(checker authz.SubRepoPermissionChecker) {
 		for label, test := range tests {
 			t.Run(label, func(t *testing.T) {
-				repo := MakeGitRepository(t, gitCommands...)
+				repo := buildRepo(t)
 				testCommits(ctx, label, repo, test.opt, checker, test.wantCommits, t)
 			})
 		}
@@ -1284,15 +2059,14 @@ func TestRepository_Commits_options(t *testing.T) {
 			subRepo = " sub repo enabled"
 		}
 		t.Run("empty repo"+subRepo, func(t *testing.T) {
-			repo := MakeGitRepository(t)
-			before := ""
-			after := time.Date(2022, 11, 11, 12, 10, 0, 4, time.UTC).Format(time.RFC3339)
+			repo := NewTestRepo(t).Build()
+			after := time.Date(2022, 11, 11, 12, 10, 0, 4, time.UTC)
 			client := NewTestClient(t).WithChecker(checker)
-			_, err := client.Commits(ctx, repo, CommitsOptions{N: 0, DateOrder: true, After: after, Before: before})
+			_, err := client.Commits(ctx, repo, CommitsOptions{N: 0, DateOrder: true, After: after})
 			if err == nil {
 				t.Error("expected error, got nil")
 			}
-			wantErr := `git command [git log --format=format:%x1e%H%x00%aN%x00%aE%x00%at%x00%cN%x00%cE%x00%ct%x00%B%x00%P%x00 --after=` + after + " --date-order"
+			wantErr := `git command [git log --format=format:%x1e%H%x00%aN%x00%aE%x00%at%x00%cN%x00%cE%x00%ct%x00%B%x00%P%x00 --after=` + after.Format(time.RFC3339) + " --date-order"
 			if subRepo != "" {
 				wantErr += " --name-only"
 			}
@@ -1371,6 +2145,346 @@ func TestRepository_Commits_options_path(t *testing.T) {
 	runCommitsTest(checker)
 }
 
+func TestRepository_Commits_merges(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := actor.WithActor(context.Background(), actor.FromUser(42))
+
+	r := NewTestRepo(t)
+	r.Commit("base")
+	r.Branch("feature", nil)
+	r.Commit("feature")
+	r.Checkout("master")
+	r.Commit("main")
+	r.Merge("merge", "feature")
+	repo := r.Build()
+	client := NewLocalTestClient(t)
+
+	messages := func(t *testing.T, opt CommitsOptions) []string {
+		t.Helper()
+		commits, err := client.Commits(ctx, repo, opt)
+		require.NoError(t, err)
+		var msgs []string
+		for _, c := range commits {
+			msgs = append(msgs, strings.TrimSpace(string(c.Message)))
+		}
+		sort.Strings(msgs)
+		return msgs
+	}
+
+	require.Equal(t, []string{"base", "feature", "main", "merge"}, messages(t, CommitsOptions{Range: "HEAD"}))
+	require.Equal(t, []string{"merge"}, messages(t, CommitsOptions{Range: "HEAD", OnlyMerges: true}))
+	require.Equal(t, []string{"base", "feature", "main"}, messages(t, CommitsOptions{Range: "HEAD", NoMerges: true}))
+
+	_, err := client.Commits(ctx, repo, CommitsOptions{Range: "HEAD", OnlyMerges: true, NoMerges: true})
+	require.Error(t, err)
+}
+
+func TestRepository_Commits_shallow(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := actor.WithActor(context.Background(), actor.FromUser(42))
+
+	// Marking HEAD~1 in the shallow file makes it the boundary of a shallow
+	// clone, like git clone --depth 2 would.
+	r := NewTestRepo(t)
+	r.Commit("first")
+	second := r.Commit("second")
+	r.Commit("third")
+	repo := r.Build()
+	boundary := second.ID()
+	require.NoError(t, os.WriteFile(filepath.Join(r.Dir(), ".git", "shallow"), []byte(boundary+"\n"), 0o644))
+	client := NewLocalTestClient(t)
+
+	t.Run("history within the boundary", func(t *testing.T) {
+		commits, err := client.Commits(ctx, repo, CommitsOptions{Range: "HEAD", N: 1})
+		require.NoError(t, err)
+		require.Len(t, commits, 1)
+	})
+
+	t.Run("history reaching the boundary", func(t *testing.T) {
+		commits, err := client.Commits(ctx, repo, CommitsOptions{Range: "HEAD"})
+		var e *gitdomain.ShallowHistoryError
+		require.True(t, errors.As(err, &e), "unexpected error %v", err)
+		require.Equal(t, boundary, e.Commit)
+		// The commits found are still returned.
+		require.Len(t, commits, 2)
+	})
+
+	t.Run("root commit of a full clone", func(t *testing.T) {
+		r := NewTestRepo(t)
+		r.Commit("first")
+		repo := r.Build()
+		commits, err := client.Commits(ctx, repo, CommitsOptions{Range: "HEAD"})
+		require.NoError(t, err)
+		require.Len(t, commits, 1)
+	})
+}
+
+func TestRepository_Commits_dates(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := actor.WithActor(context.Background(), actor.FromUser(42))
+
+	r := NewTestRepo(t)
+	r.Commit("first").At(MustParseTime(time.RFC3339, "2006-01-02T15:04:05Z"))
+	r.Commit("second").At(MustParseTime(time.RFC3339, "2007-01-02T15:04:05Z"))
+	r.Commit("third").At(MustParseTime(time.RFC3339, "2008-01-02T15:04:05Z"))
+	repo := r.Build()
+	client := NewLocalTestClient(t)
+
+	messages := func(t *testing.T, opt CommitsOptions) []string {
+		t.Helper()
+		opt.Range = "HEAD"
+		commits, err := client.Commits(ctx, repo, opt)
+		require.NoError(t, err)
+		var msgs []string
+		for _, c := range commits {
+			msgs = append(msgs, strings.TrimSpace(string(c.Message)))
+		}
+		return msgs
+	}
+
+	require.Equal(t, []string{"third", "second"}, messages(t, CommitsOptions{After: MustParseTime(time.RFC3339, "2006-06-01T00:00:00Z")}))
+	require.Equal(t, []string{"second", "first"}, messages(t, CommitsOptions{Before: MustParseTime(time.RFC3339, "2007-06-01T00:00:00Z")}))
+	require.Equal(t, []string{"third", "second", "first"}, messages(t, CommitsOptions{BeforeRelative: "1 year ago"}))
+	require.Empty(t, messages(t, CommitsOptions{AfterRelative: "1 year ago"}))
+
+	for name, opt := range map[string]CommitsOptions{
+		"typed and relative after":  {After: time.Now(), AfterRelative: "1 year ago"},
+		"typed and relative before": {Before: time.Now(), BeforeRelative: "1 year ago"},
+		"invalid relative date":     {AfterRelative: "last tuesday-ish"},
+		"after later than before":   {After: MustParseTime(time.RFC3339, "2008-01-01T00:00:00Z"), Before: MustParseTime(time.RFC3339, "2007-01-01T00:00:00Z")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opt.Range = "HEAD"
+			_, err := client.Commits(ctx, repo, opt)
+			require.Error(t, err)
+			require.NotContains(t, err.Error(), "exit status")
+		})
+	}
+}
+
+func TestParseCommitDate(t *testing.T) {
+	for _, tc := range []struct {
+		in           string
+		wantTime     time.Time
+		wantRelative string
+	}{
+		{in: "2006-01-02T15:04:05Z", wantTime: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{in: "2006-01-02", wantTime: time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{in: "june 25 2017", wantTime: time.Date(2017, 6, 25, 0, 0, 0, 0, time.UTC)},
+		{in: "Jun 25, 2017", wantTime: time.Date(2017, 6, 25, 0, 0, 0, 0, time.UTC)},
+		{in: "1 year ago", wantRelative: "1 year ago"},
+		{in: " 1 year, 3 months ago ", wantRelative: "1 year, 3 months ago"},
+	} {
+		gotTime, gotRelative, err := ParseCommitDate(tc.in)
+		require.NoError(t, err, tc.in)
+		require.True(t, tc.wantTime.Equal(gotTime), "%q: got %s", tc.in, gotTime)
+		require.Equal(t, tc.wantRelative, gotRelative, tc.in)
+	}
+
+	for _, in := range []string{"", "yesterday-ish", "--output=/tmp/x", "1 year"} {
+		_, _, err := ParseCommitDate(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestRepository_Commits_changedFiles(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := actor.WithActor(context.Background(), actor.FromUser(42))
+
+	r := NewTestRepo(t)
+	r.Commit("first").
+		AddFile("a.txt", "a\n").
+		AddFile("b.txt", "some longer content that survives a rename\n").
+		AddFile("secret.txt", "secret\n")
+	r.Commit("second").
+		AddFile("a.txt", "a2\n").
+		RenameFile("b.txt", "c.txt").
+		DeleteFile("secret.txt").
+		AddFile("d e.txt", "d\n")
+	repo := r.Build()
+
+	commits, err := NewLocalTestClient(t).Commits(ctx, repo, CommitsOptions{Range: "HEAD", ChangedFiles: true})
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.Equal(t, []gitdomain.ChangedFile{
+		{Path: "a.txt", Status: gitdomain.ChangedFileStatusModified},
+		{Path: "c.txt", Status: gitdomain.ChangedFileStatusRenamed, OldPath: "b.txt"},
+		{Path: "d e.txt", Status: gitdomain.ChangedFileStatusAdded},
+		{Path: "secret.txt", Status: gitdomain.ChangedFileStatusDeleted},
+	}, commits[0].ChangedFiles)
+	require.Equal(t, []gitdomain.ChangedFile{
+		{Path: "a.txt", Status: gitdomain.ChangedFileStatusAdded},
+		{Path: "b.txt", Status: gitdomain.ChangedFileStatusAdded},
+		{Path: "secret.txt", Status: gitdomain.ChangedFileStatusAdded},
+	}, commits[1].ChangedFiles)
+
+	commits, err = NewLocalTestClient(t).Commits(ctx, repo, CommitsOptions{Range: "HEAD"})
+	require.NoError(t, err)
+	require.Nil(t, commits[0].ChangedFiles)
+
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		client := NewLocalTestClient(t).WithChecker(getTestSubRepoPermsChecker("secret.txt", "b.txt"))
+		commits, err := client.Commits(ctx, repo, CommitsOptions{Range: "HEAD", ChangedFiles: true})
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		require.Equal(t, []gitdomain.ChangedFile{
+			{Path: "a.txt", Status: gitdomain.ChangedFileStatusModified},
+			{Path: "d e.txt", Status: gitdomain.ChangedFileStatusAdded},
+		}, commits[0].ChangedFiles)
+		require.Equal(t, []gitdomain.ChangedFile{
+			{Path: "a.txt", Status: gitdomain.ChangedFileStatusAdded},
+		}, commits[1].ChangedFiles)
+	})
+}
+
+func TestRepository_CountCommits(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := actor.WithActor(context.Background(), actor.FromUser(42))
+
+	buildRepo := func(t *testing.T) api.RepoName {
+		r := NewTestRepo(t)
+		addCommitsWithFileLists(r, []string{"file0"}, []string{"file1"}, []string{"file2"})
+		r.Commit("empty").Author("b", "b@b.com")
+		return r.Build()
+	}
+
+	tests := map[string]struct {
+		opt  CommitsOptions
+		want int
+	}{
+		"all":       {opt: CommitsOptions{}, want: 4},
+		"limit":     {opt: CommitsOptions{Range: "HEAD", N: 2}, want: 2},
+		"skip":      {opt: CommitsOptions{Range: "HEAD", Skip: 3}, want: 1},
+		"author":    {opt: CommitsOptions{Range: "HEAD", Author: "b@b.com"}, want: 1},
+		"message":   {opt: CommitsOptions{Range: "HEAD", MessageQuery: "COMMIT"}, want: 3},
+		"path":      {opt: CommitsOptions{Range: "HEAD", Path: "file1"}, want: 1},
+		"follow":    {opt: CommitsOptions{Range: "HEAD", Path: "file1", Follow: true}, want: 1},
+		"range":     {opt: CommitsOptions{Range: "HEAD~2..HEAD"}, want: 2},
+		"no merges": {opt: CommitsOptions{Range: "HEAD", NoMerges: true}, want: 4},
+	}
+
+	runCountTests := func(checker authz.SubRepoPermissionChecker) {
+		for label, test := range tests {
+			t.Run(label, func(t *testing.T) {
+				repo := buildRepo(t)
+				client := NewLocalTestClient(t).WithChecker(checker)
+				n, err := client.CountCommits(ctx, repo, test.opt)
+				require.NoError(t, err)
+				require.Equal(t, test.want, n)
+			})
+		}
+	}
+	runCountTests(nil)
+	runCountTests(getTestSubRepoPermsChecker())
+
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		repo := buildRepo(t)
+		// The commits only touching file1 are not visible.
+		client := NewLocalTestClient(t).WithChecker(getTestSubRepoPermsChecker("file1"))
+		n, err := client.CountCommits(ctx, repo, CommitsOptions{Range: "HEAD"})
+		require.NoError(t, err)
+		require.Equal(t, 3, n)
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		repo := buildRepo(t)
+		_, err := NewLocalTestClient(t).CountCommits(ctx, repo, CommitsOptions{Range: string(NonExistentCommitID)})
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestRepository_AncestryPath(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := actor.WithActor(context.Background(), actor.FromUser(42))
+
+	// * merge (master)
+	// |\
+	// | * side
+	// * | main (main)
+	// |/
+	// * root (root)
+	buildRepo := func(t *testing.T) (api.RepoName, string) {
+		r := NewTestRepo(t)
+		root := r.Commit("root").AddFile("root", "")
+		r.Tag("root", root)
+		r.Branch("side", nil)
+		r.Commit("side").AddFile("side", "")
+		r.Checkout("master")
+		r.Tag("main", r.Commit("main").AddFile("main", ""))
+		r.Merge("merge", "side")
+		return r.Build(), r.Dir()
+	}
+
+	messages := func(commits []*gitdomain.Commit) []string {
+		msgs := make([]string, 0, len(commits))
+		for _, c := range commits {
+			msgs = append(msgs, string(c.Message))
+		}
+		sort.Strings(msgs)
+		return msgs
+	}
+
+	tests := map[string]struct {
+		from, to string
+		checker  authz.SubRepoPermissionChecker
+		want     []string
+	}{
+		"both branches": {from: "root", to: "master", want: []string{"main", "merge", "side"}},
+		// side is an ancestor of master, but not a descendant of main.
+		"one branch":  {from: "main", to: "master", want: []string{"merge"}},
+		"not related": {from: "side", to: "main", want: []string{}},
+		"reversed":    {from: "master", to: "root", want: []string{}},
+		"sub-repo permissions": {
+			from:    "root",
+			to:      "master",
+			checker: getTestSubRepoPermsChecker("side"),
+			want:    []string{"main", "merge"},
+		},
+	}
+	// Revisions are resolved through gRPC, which isn't available with
+	// LocalGitserver, so we resolve them with git in the repo directly.
+	newClient := func(t *testing.T, dir string) TestClient {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, req *proto.ResolveRevisionRequest, _ ...grpc.CallOption) (*proto.ResolveRevisionResponse, error) {
+					cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", string(req.GetRevSpec())+"^{commit}")
+					cmd.Dir = dir
+					out, err := cmd.Output()
+					if err != nil {
+						return nil, &gitdomain.RevisionNotFoundError{Repo: api.RepoName(req.GetRepoName()), Spec: string(req.GetRevSpec())}
+					}
+					return &proto.ResolveRevisionResponse{CommitSha: strings.TrimSpace(string(out))}, nil
+				})
+				return c
+			}
+		})
+		return NewTestClient(t).WithClientSource(source)
+	}
+
+	for label, test := range tests {
+		t.Run(label, func(t *testing.T) {
+			repo, dir := buildRepo(t)
+			client := newClient(t, dir).WithChecker(test.checker)
+			commits, err := client.AncestryPath(ctx, repo, test.from, test.to)
+			require.NoError(t, err)
+			require.Equal(t, test.want, messages(commits))
+		})
+	}
+
+	t.Run("revision not found", func(t *testing.T) {
+		repo, dir := buildRepo(t)
+		_, err := newClient(t, dir).AncestryPath(ctx, repo, "root", string(NonExistentCommitID))
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
 func TestParseCommitsUniqueToBranch(t *testing.T) { // KEEP
 	commits, err := parseCommitsUniqueToBranch([]string{
 		"c165bfff52e9d4f87891bba497e3b70fea144d89:2020-08-04T08:23:30-05:00",
@@ -1405,9 +2519,11 @@ func TestCommitsUniqueToBranch(t *testing.T) {
 	ctx := actor.WithActor(context.Background(), &actor.Actor{
 		UID: 1,
 	})
-	gitCommands := append([]string{"git checkout -b my-branch"}, getGitCommandsWithFiles("file1", "file2")...)
-	gitCommands = append(gitCommands, getGitCommandsWithFiles("file3", "file-with-no-access")...)
-	repo := MakeGitRepository(t, gitCommands...)
+	r := NewTestRepo(t)
+	r.Branch("my-branch", nil)
+	addCommitsWithFiles(r, "file1", "file2")
+	addCommitsWithFiles(r, "file3", "file-with-no-access")
+	repo := r.Build()
 
 	client := NewClient("test")
 	commits, err := client.CommitsUniqueToBranch(ctx, repo, "my-branch", true, &time.Time{})
@@ -1460,7 +2576,7 @@ func TestFilterCommitsUniqueToBranch(t *testing.T) {
 
 func testCommits(ctx context.Context, label string, repo api.RepoName, opt CommitsOptions, checker authz.SubRepoPermissionChecker, wantCommits []*gitdomain.Commit, t *testing.T) {
 	t.Helper()
-	client := NewTestClient(t).WithChecker(checker)
+	client := NewLocalTestClient(t).WithChecker(checker)
 	commits, err := client.Commits(ctx, repo, opt)
 	if err != nil {
 		t.Errorf("%s: Commits(): %s", label, err)
@@ -1507,34 +2623,22 @@ func getTestSubRepoPermsChecker(noAccessPaths ...string) authz.SubRepoPermission
 	return checker
 }
 
-func getGitCommandsWithFileLists(filenamesPerCommit ...[]string) []string {
-	cmds := make([]string, 0, len(filenamesPerCommit)*3)
+// addCommitsWithFileLists adds a commit to r for each list of file names. The
+// files of the i-th commit contain "my_content_<i>\n".
+func addCommitsWithFileLists(r *TestRepo, filenamesPerCommit ...[]string) {
 	for i, filenames := range filenamesPerCommit {
+		c := r.Commit(fmt.Sprintf("commit%d", i))
 		for _, fn := range filenames {
-			cmds = append(cmds,
-				fmt.Sprintf("touch %s", fn),
-				fmt.Sprintf("echo my_content_%d > %s", i, fn),
-				fmt.Sprintf("git add %s", fn))
+			c.AddFile(fn, fmt.Sprintf("my_content_%d\n", i))
 		}
-		cmds = append(cmds,
-			fmt.Sprintf("GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=2006-01-02T15:04:05=%dZ git commit -m commit%d --author='a <a@a.com>' --date 2006-01-02T15:04:0%dZ", i, i, i))
 	}
-	return cmds
-}
-
-func makeGitCommit(commitMessage string, seconds int) string {
-	return fmt.Sprintf("GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=2006-01-02T15:04:05=%dZ git commit -m %s --author='a <a@a.com>' --date 2006-01-02T15:04:0%dZ", seconds, commitMessage, seconds)
 }
 
-func getGitCommandsWithFiles(fileName1, fileName2 string) []string {
-	return []string{
-		fmt.Sprintf("touch %s", fileName1),
-		fmt.Sprintf("git add %s", fileName1),
-		"GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m commit1 --author='a <a@a.com>' --date 2006-01-02T15:04:05Z",
-		fmt.Sprintf("touch %s", fileName2),
-		fmt.Sprintf("git add %s", fileName2),
-		"GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m commit2 --author='a <a@a.com>' --date 2006-01-02T15:04:05Z",
-	}
+// addCommitsWithFiles adds two commits to r, adding an empty file each. Both
+// commits have the same date.
+func addCommitsWithFiles(r *TestRepo, fileName1, fileName2 string) {
+	r.Commit("commit1").AddFile(fileName1, "").At(testRepoBaseDate)
+	r.Commit("commit2").AddFile(fileName2, "").At(testRepoBaseDate)
 }
 
 func mustParseDate(s string, t *testing.T) *time.Time {
@@ -1609,6 +2713,28 @@ func TestClient_StreamBlameFile(t *testing.T) {
 
 		require.NoError(t, hr.Close())
 	})
+	t.Run("sends copy detection", func(t *testing.T) {
+		var got *proto.BlameRequest
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.BlameFunc.SetDefaultHook(func(_ context.Context, req *proto.BlameRequest, _ ...grpc.CallOption) (proto.GitserverService_BlameClient, error) {
+					got = req
+					bc := NewMockGitserverService_BlameClient()
+					bc.RecvFunc.SetDefaultReturn(nil, io.EOF)
+					return bc, nil
+				})
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		hr, err := c.StreamBlameFile(context.Background(), "repo", "file", &BlameOptions{CopyDetection: BlameCopyDetectionCopiesFromCreation})
+		require.NoError(t, err)
+		require.NoError(t, hr.Close())
+		require.Equal(t, proto.BlameCopyDetection_BLAME_COPY_DETECTION_COPIES_FROM_CREATION, got.GetCopyDetection())
+	})
 	t.Run("permission errors are returned early", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
@@ -1626,6 +2752,36 @@ func TestClient_StreamBlameFile(t *testing.T) {
 		require.Error(t, err)
 		require.True(t, os.IsNotExist(err))
 	})
+	t.Run("sub-repo permissions", func(t *testing.T) {
+		var called bool
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.BlameFunc.SetDefaultHook(func(context.Context, *proto.BlameRequest, ...grpc.CallOption) (proto.GitserverService_BlameClient, error) {
+					called = true
+					bc := NewMockGitserverService_BlameClient()
+					bc.RecvFunc.SetDefaultReturn(nil, io.EOF)
+					return bc, nil
+				})
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source).WithChecker(getTestSubRepoPermsChecker("secret"))
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+
+		_, err := c.StreamBlameFile(ctx, "repo", "secret", &BlameOptions{})
+		require.True(t, os.IsNotExist(err))
+		require.False(t, called, "unreadable files must not be blamed")
+
+		hr, err := c.StreamBlameFile(ctx, "repo", "file", &BlameOptions{})
+		require.NoError(t, err)
+		require.NoError(t, hr.Close())
+		require.True(t, called)
+
+		_, err = c.StreamBlameFile(context.Background(), "repo", "file", &BlameOptions{})
+		require.True(t, errors.HasType(err, &authz.ErrUnauthenticated{}))
+	})
 	t.Run("revision not found errors are returned early", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
@@ -1737,305 +2893,389 @@ func TestClient_GetDefaultBranch(t *testing.T) {
 	})
 }
 
-func TestClient_MergeBase(t *testing.T) {
+func TestClient_SymbolicRef(t *testing.T) {
+	var got *proto.SymbolicRefRequest
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.SymbolicRefFunc.SetDefaultHook(func(_ context.Context, req *proto.SymbolicRefRequest, _ ...grpc.CallOption) (*proto.SymbolicRefResponse, error) {
+				got = req
+				return &proto.SymbolicRefResponse{Target: "refs/heads/main"}, nil
+			})
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	target, err := c.SymbolicRef(context.Background(), "repo", "HEAD")
+	require.NoError(t, err)
+	require.Equal(t, "refs/heads/main", target)
+	require.Equal(t, "repo", got.GetRepoName())
+	require.Equal(t, "HEAD", got.GetRefName())
+}
+
+func TestClient_RefExists(t *testing.T) {
 	t.Run("correctly returns server response", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				c.MergeBaseFunc.SetDefaultReturn(&proto.MergeBaseResponse{MergeBaseCommitSha: "deadbeef"}, nil)
+				c.RefExistsFunc.SetDefaultHook(func(_ context.Context, req *proto.RefExistsRequest, _ ...grpc.CallOption) (*proto.RefExistsResponse, error) {
+					return &proto.RefExistsResponse{Exists: req.GetRefName() == "refs/heads/main"}, nil
+				})
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		sha, err := c.MergeBase(context.Background(), "repo", "master", "b2")
+		exists, err := c.RefExists(context.Background(), "repo", "refs/heads/main")
 		require.NoError(t, err)
-		require.Equal(t, api.CommitID("deadbeef"), sha)
+		require.True(t, exists)
+
+		exists, err = c.RefExists(context.Background(), "repo", "refs/heads/missing")
+		require.NoError(t, err)
+		require.False(t, exists)
 	})
-	t.Run("returns empty for empty merge base", func(t *testing.T) {
+	t.Run("returns repo not found", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				c.MergeBaseFunc.SetDefaultReturn(&proto.MergeBaseResponse{MergeBaseCommitSha: ""}, nil)
+				s, err := status.New(codes.NotFound, "repo not found").WithDetails(&proto.RepoNotFoundPayload{Repo: "repo"})
+				require.NoError(t, err)
+				c.RefExistsFunc.SetDefaultReturn(nil, s.Err())
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		sha, err := c.MergeBase(context.Background(), "repo", "master", "b2")
-		require.NoError(t, err)
-		require.Equal(t, api.CommitID(""), sha)
+		_, err := c.RefExists(context.Background(), "repo", "refs/heads/main")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RepoNotExistError{}))
 	})
-	t.Run("revision not found", func(t *testing.T) {
+}
+
+func TestClient_MergeBase(t *testing.T) {
+	t.Run("correctly returns server response", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				s, err := status.New(codes.NotFound, "bad revision").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "deadbeef"})
-				require.NoError(t, err)
-				c.MergeBaseFunc.SetDefaultReturn(nil, s.Err())
+				c.MergeBaseFunc.SetDefaultReturn(&proto.MergeBaseResponse{MergeBaseCommitSha: "deadbeef"}, nil)
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		_, err := c.MergeBase(context.Background(), "repo", "master", "b2")
-		require.Error(t, err)
-		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+		sha, err := c.MergeBase(context.Background(), "repo", "master", "b2")
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("deadbeef"), sha)
 	})
-}
-
-func TestClient_NewFileReader(t *testing.T) {
-	t.Run("firstChunk memoization", func(t *testing.T) {
+	t.Run("returns empty for empty merge base", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				rfc := NewMockGitserverService_ReadFileClient()
-				rfc.RecvFunc.PushReturn(&proto.ReadFileResponse{Data: []byte("part1\n")}, nil)
-				rfc.RecvFunc.PushReturn(&proto.ReadFileResponse{Data: []byte("part2\n")}, nil)
-				rfc.RecvFunc.PushReturn(nil, io.EOF)
-				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
+				c.MergeBaseFunc.SetDefaultReturn(&proto.MergeBaseResponse{MergeBaseCommitSha: ""}, nil)
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		r, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
-		require.NoError(t, err)
-
-		content, err := io.ReadAll(r)
+		sha, err := c.MergeBase(context.Background(), "repo", "master", "b2")
 		require.NoError(t, err)
-		require.NoError(t, r.Close())
-		require.Equal(t, "part1\npart2\n", string(content))
+		require.Equal(t, api.CommitID(""), sha)
 	})
-	t.Run("firstChunk error memoization", func(t *testing.T) {
+	t.Run("revision not found", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				rfc := NewMockGitserverService_ReadFileClient()
-				rfc.RecvFunc.PushReturn(nil, io.EOF)
-				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
+				s, err := status.New(codes.NotFound, "bad revision").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "deadbeef"})
+				require.NoError(t, err)
+				c.MergeBaseFunc.SetDefaultReturn(nil, s.Err())
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		r, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
-		require.NoError(t, err)
+		_, err := c.MergeBase(context.Background(), "repo", "master", "b2")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
 
-		content, err := io.ReadAll(r)
+func TestClient_MergeBaseCrossRepo(t *testing.T) {
+	const base = "1111111111111111111111111111111111111111"
+	t.Run("fetches the base commit into the head repo", func(t *testing.T) {
+		mockClient := NewMockGitserverServiceClient()
+		mockClient.ResolveRevisionFunc.SetDefaultReturn(&proto.ResolveRevisionResponse{CommitSha: base}, nil)
+		mockClient.FetchCommitFromRepoFunc.SetDefaultReturn(&proto.FetchCommitFromRepoResponse{Fetched: true}, nil)
+		mockClient.MergeBaseFunc.SetDefaultReturn(&proto.MergeBaseResponse{MergeBaseCommitSha: "deadbeef"}, nil)
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				return mockClient
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		sha, err := c.MergeBaseCrossRepo(context.Background(), "upstream", "main", "fork", "feature")
 		require.NoError(t, err)
-		require.NoError(t, r.Close())
-		require.Equal(t, "", string(content))
+		require.Equal(t, api.CommitID("deadbeef"), sha)
+
+		require.Equal(t, "upstream", mockClient.ResolveRevisionFunc.History()[0].Arg1.GetRepoName())
+		fetch := mockClient.FetchCommitFromRepoFunc.History()[0].Arg1
+		require.Equal(t, "fork", fetch.GetRepoName())
+		require.Equal(t, "upstream", fetch.GetSourceRepoName())
+		require.Equal(t, base, fetch.GetCommit())
+		mergeBase := mockClient.MergeBaseFunc.History()[0].Arg1
+		require.Equal(t, "fork", mergeBase.GetRepoName())
+		require.Equal(t, []byte(base), mergeBase.GetBase())
+		require.Equal(t, []byte("feature"), mergeBase.GetHead())
 	})
-	t.Run("permission errors are returned early", func(t *testing.T) {
+	t.Run("same repo does not fetch", func(t *testing.T) {
+		mockClient := NewMockGitserverServiceClient()
+		mockClient.ResolveRevisionFunc.SetDefaultReturn(&proto.ResolveRevisionResponse{CommitSha: base}, nil)
+		mockClient.MergeBaseFunc.SetDefaultReturn(&proto.MergeBaseResponse{MergeBaseCommitSha: "deadbeef"}, nil)
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
-				c := NewMockGitserverServiceClient()
-				rfc := NewMockGitserverService_ReadFileClient()
-				rfc.RecvFunc.PushReturn(nil, status.New(codes.PermissionDenied, "bad actor").Err())
-				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
-				return c
+				return mockClient
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		_, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
-		require.Error(t, err)
-		require.True(t, os.IsNotExist(err))
+		_, err := c.MergeBaseCrossRepo(context.Background(), "repo", "main", "repo", "feature")
+		require.NoError(t, err)
+		require.Empty(t, mockClient.FetchCommitFromRepoFunc.History())
 	})
-	t.Run("file not found errors are returned early", func(t *testing.T) {
+	t.Run("base revision not found", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				rfc := NewMockGitserverService_ReadFileClient()
-				s, err := status.New(codes.NotFound, "bad actor").WithDetails(&proto.FileNotFoundPayload{})
+				s, err := status.New(codes.NotFound, "bad revision").WithDetails(&proto.RevisionNotFoundPayload{Repo: "upstream", Spec: "main"})
 				require.NoError(t, err)
-				rfc.RecvFunc.PushReturn(nil, s.Err())
-				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
+				c.ResolveRevisionFunc.SetDefaultReturn(nil, s.Err())
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		_, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
-		require.Error(t, err)
-		require.True(t, os.IsNotExist(err))
+		_, err := c.MergeBaseCrossRepo(context.Background(), "upstream", "main", "fork", "feature")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
 	})
-	t.Run("revision not found errors are returned early", func(t *testing.T) {
+}
+
+func TestClient_Cherry(t *testing.T) {
+	t.Run("correctly returns server response", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				rfc := NewMockGitserverService_ReadFileClient()
-				s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{})
-				require.NoError(t, err)
-				rfc.RecvFunc.PushReturn(nil, s.Err())
-				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
+				c.CherryFunc.SetDefaultReturn(&proto.CherryResponse{Commits: []*proto.CherryCommit{
+					{CommitSha: "deadbeef", InUpstream: true},
+					{CommitSha: "beefdead"},
+				}}, nil)
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		_, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
-		require.Error(t, err)
-		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+		commits, err := c.Cherry(context.Background(), "repo", "master", "feature")
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.CherryCommit{
+			{Commit: "deadbeef", InUpstream: true},
+			{Commit: "beefdead"},
+		}, commits)
 	})
-	t.Run("empty file", func(t *testing.T) {
+	t.Run("revision not found", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				rfc := NewMockGitserverService_ReadFileClient()
-				rfc.RecvFunc.PushReturn(nil, io.EOF)
-				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
+				s, err := status.New(codes.NotFound, "bad revision").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "feature"})
+				require.NoError(t, err)
+				c.CherryFunc.SetDefaultReturn(nil, s.Err())
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		r, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
-		require.NoError(t, err)
-		content, err := io.ReadAll(r)
-		require.NoError(t, err)
-		require.Empty(t, content)
-		require.NoError(t, r.Close())
+		_, err := c.Cherry(context.Background(), "repo", "master", "feature")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
 	})
 }
 
-func TestClient_GetCommit(t *testing.T) {
+func TestClient_CherryCrossRepo(t *testing.T) {
+	const upstream = "1111111111111111111111111111111111111111"
+	mockClient := NewMockGitserverServiceClient()
+	mockClient.ResolveRevisionFunc.SetDefaultReturn(&proto.ResolveRevisionResponse{CommitSha: upstream}, nil)
+	mockClient.FetchCommitFromRepoFunc.SetDefaultReturn(&proto.FetchCommitFromRepoResponse{Fetched: true}, nil)
+	mockClient.CherryFunc.SetDefaultReturn(&proto.CherryResponse{Commits: []*proto.CherryCommit{
+		{CommitSha: "deadbeef", InUpstream: true},
+	}}, nil)
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			return mockClient
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	commits, err := c.CherryCrossRepo(context.Background(), "upstream", "release-1.0", "fork", "fix")
+	require.NoError(t, err)
+	require.Equal(t, []gitdomain.CherryCommit{{Commit: "deadbeef", InUpstream: true}}, commits)
+
+	fetch := mockClient.FetchCommitFromRepoFunc.History()[0].Arg1
+	require.Equal(t, "fork", fetch.GetRepoName())
+	require.Equal(t, "upstream", fetch.GetSourceRepoName())
+	require.Equal(t, upstream, fetch.GetCommit())
+	cherry := mockClient.CherryFunc.History()[0].Arg1
+	require.Equal(t, "fork", cherry.GetRepoName())
+	require.Equal(t, []byte(upstream), cherry.GetUpstream())
+	require.Equal(t, []byte("fix"), cherry.GetHead())
+}
+
+func TestClient_RangeDiff(t *testing.T) {
 	t.Run("correctly returns server response", func(t *testing.T) {
+		var got *proto.RangeDiffRequest
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				c.GetCommitFunc.SetDefaultReturn(&proto.GetCommitResponse{Commit: &proto.GitCommit{Oid: "deadbeef"}}, nil)
+				c.RangeDiffFunc.SetDefaultHook(func(_ context.Context, req *proto.RangeDiffRequest, _ ...grpc.CallOption) (*proto.RangeDiffResponse, error) {
+					got = req
+					return &proto.RangeDiffResponse{Pairs: []*proto.RangeDiffPair{
+						{Status: proto.RangeDiffPair_STATUS_MODIFIED, OldIndex: 1, OldCommitSha: "deadbeef", NewIndex: 1, NewCommitSha: "beefdead", Subject: []byte("one"), Diff: []byte("-+a\n++b\n")},
+						{Status: proto.RangeDiffPair_STATUS_ADDED, NewIndex: 2, NewCommitSha: "f00f00", Subject: []byte("two")},
+					}}, nil
+				})
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		commit, err := c.GetCommit(context.Background(), "repo", "deadbeef")
+		pairs, err := c.RangeDiff(context.Background(), "repo", "master..v1", "main..v2")
 		require.NoError(t, err)
-		require.Equal(t, api.CommitID("deadbeef"), commit.ID)
+		require.Equal(t, "master", string(got.GetOldBase()))
+		require.Equal(t, "v1", string(got.GetOldHead()))
+		require.Equal(t, "main", string(got.GetNewBase()))
+		require.Equal(t, "v2", string(got.GetNewHead()))
+		require.Equal(t, []*gitdomain.RangeDiffPair{
+			{Status: gitdomain.RangeDiffStatusModified, OldIndex: 1, OldCommit: "deadbeef", NewIndex: 1, NewCommit: "beefdead", Subject: "one", Diff: "-+a\n++b\n"},
+			{Status: gitdomain.RangeDiffStatusAdded, NewIndex: 2, NewCommit: "f00f00", Subject: "two"},
+		}, pairs)
 	})
-	t.Run("returns correct error for not found", func(t *testing.T) {
+	t.Run("invalid range", func(t *testing.T) {
+		c := NewTestClient(t).WithClientSource(NewTestClientSource(t, []string{"gitserver"}))
+
+		for _, r := range []string{"master", "master..", "..v1", "master...v1"} {
+			_, err := c.RangeDiff(context.Background(), "repo", r, "main..v2")
+			require.Error(t, err, r)
+		}
+	})
+	t.Run("revision not found", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				s, err := status.New(codes.NotFound, "bad revision").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "deadbeef"})
+				s, err := status.New(codes.NotFound, "bad revision").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "v2"})
 				require.NoError(t, err)
-				c.GetCommitFunc.PushReturn(nil, s.Err())
+				c.RangeDiffFunc.SetDefaultReturn(nil, s.Err())
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		_, err := c.GetCommit(context.Background(), "repo", "deadbeef")
+		_, err := c.RangeDiff(context.Background(), "repo", "master..v1", "master..v2")
 		require.Error(t, err)
 		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
 	})
 }
 
-func Test_CommitLog(t *testing.T) {
-	ClientMocks.LocalGitserver = true
-	defer ResetClientMocks()
-
-	tests := map[string]struct {
-		extraGitCommands []string
-		wantFiles        [][]string // put these in log reverse order
-		wantCommits      int
-		wantErr          string
-	}{
-		"commit changes files": {
-			extraGitCommands: getGitCommandsWithFileLists([]string{"file1.txt", "file2.txt"}, []string{"file3.txt"}),
-			wantFiles:        [][]string{{"file3.txt"}, {"file1.txt", "file2.txt"}},
-			wantCommits:      2,
-		},
-		"no commits": {
-			wantErr: "gitCommand fatal: your current branch 'master' does not have any commits yet: exit status 128",
-		},
-		"one file two commits": {
-			extraGitCommands: getGitCommandsWithFileLists([]string{"file1.txt"}, []string{"file1.txt"}),
-			wantFiles:        [][]string{{"file1.txt"}, {"file1.txt"}},
-			wantCommits:      2,
-		},
-		"one commit": {
-			extraGitCommands: getGitCommandsWithFileLists([]string{"file1.txt"}),
-			wantFiles:        [][]string{{"file1.txt"}},
-			wantCommits:      1,
-		},
-	}
-
-	for label, test := range tests {
-		t.Run(label, func(t *testing.T) {
-			repo := MakeGitRepository(t, test.extraGitCommands...)
-			logResults, err := NewClient("test").CommitLog(context.Background(), repo, time.Time{})
-			if err != nil {
-				require.ErrorContains(t, err, test.wantErr)
+func TestClient_UpdateRef(t *testing.T) {
+	t.Run("sends request", func(t *testing.T) {
+		var got *proto.UpdateRefRequest
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.UpdateRefFunc.SetDefaultHook(func(_ context.Context, req *proto.UpdateRefRequest, _ ...grpc.CallOption) (*proto.UpdateRefResponse, error) {
+					got = req
+					return &proto.UpdateRefResponse{}, nil
+				})
+				return c
 			}
+		})
 
-			t.Log(test)
-			for i, result := range logResults {
-				t.Log(result)
-				assert.Equal(t, "a@a.com", result.AuthorEmail)
-				assert.Equal(t, "a", result.AuthorName)
-				assert.Equal(t, 40, len(result.SHA))
-				assert.ElementsMatch(t, test.wantFiles[i], result.ChangedFiles)
+		c := NewTestClient(t).WithClientSource(source)
+
+		err := c.UpdateRef(context.Background(), "repo", "refs/heads/feature", "newsha", "oldsha")
+		require.NoError(t, err)
+		require.Equal(t, "repo", got.GetRepoName())
+		require.Equal(t, "refs/heads/feature", got.GetRefName())
+		require.Equal(t, "newsha", got.GetNewCommitSha())
+		require.Equal(t, "oldsha", got.GetExpectedOldCommitSha())
+	})
+	t.Run("conflict", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.FailedPrecondition, "conflict").WithDetails(&proto.RefUpdateConflictPayload{RepoName: "repo", RefName: "refs/heads/feature", ExpectedOldCommitSha: "oldsha"})
+				require.NoError(t, err)
+				c.UpdateRefFunc.SetDefaultReturn(nil, s.Err())
+				return c
 			}
-			assert.Equal(t, test.wantCommits, len(logResults))
 		})
-	}
-}
 
-func TestErrorMessageTruncateOutput(t *testing.T) {
-	cmd := []string{"git", "ls-files"}
-
-	t.Run("short output", func(t *testing.T) {
-		shortOutput := "aaaaaaaaaab"
-		message := errorMessageTruncatedOutput(cmd, []byte(shortOutput))
-		want := fmt.Sprintf("git command [git ls-files] failed (output: %q)", shortOutput)
+		c := NewTestClient(t).WithClientSource(source)
 
-		if diff := cmp.Diff(want, message); diff != "" {
-			t.Fatalf("wrong message. diff: %s", diff)
-		}
+		err := c.UpdateRef(context.Background(), "repo", "refs/heads/feature", "newsha", "oldsha")
+		require.Error(t, err)
+		var e *gitdomain.RefUpdateConflictError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, "refs/heads/feature", e.Ref)
+		require.Equal(t, api.CommitID("oldsha"), e.ExpectedOldOID)
 	})
+	t.Run("revision not found", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.NotFound, "bad revision").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "newsha"})
+				require.NoError(t, err)
+				c.UpdateRefFunc.SetDefaultReturn(nil, s.Err())
+				return c
+			}
+		})
 
-	t.Run("truncating output", func(t *testing.T) {
-		longOutput := strings.Repeat("a", 5000) + "b"
-		message := errorMessageTruncatedOutput(cmd, []byte(longOutput))
-		want := fmt.Sprintf("git command [git ls-files] failed (truncated output: %q, 1 more)", longOutput[:5000])
+		c := NewTestClient(t).WithClientSource(source)
 
-		if diff := cmp.Diff(want, message); diff != "" {
-			t.Fatalf("wrong message. diff: %s", diff)
-		}
+		err := c.UpdateRef(context.Background(), "repo", "refs/heads/feature", "newsha", "")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
 	})
 }
 
-func TestClient_ArchiveReader(t *testing.T) {
+func TestClient_NewFileReader(t *testing.T) {
 	t.Run("firstChunk memoization", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				rfc := NewMockGitserverService_ArchiveClient()
-				rfc.RecvFunc.PushReturn(&proto.ArchiveResponse{Data: []byte("part1\n")}, nil)
-				rfc.RecvFunc.PushReturn(&proto.ArchiveResponse{Data: []byte("part2\n")}, nil)
+				rfc := NewMockGitserverService_ReadFileClient()
+				rfc.RecvFunc.PushReturn(&proto.ReadFileResponse{Data: []byte("part1\n")}, nil)
+				rfc.RecvFunc.PushReturn(&proto.ReadFileResponse{Data: []byte("part2\n")}, nil)
 				rfc.RecvFunc.PushReturn(nil, io.EOF)
-				c.ArchiveFunc.SetDefaultReturn(rfc, nil)
+				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		r, err := c.ArchiveReader(context.Background(), "repo", ArchiveOptions{Treeish: "deadbeef", Format: ArchiveFormatTar, Paths: []string{"file"}})
+		r, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
 		require.NoError(t, err)
 
 		content, err := io.ReadAll(r)
@@ -2047,16 +3287,16 @@ func TestClient_ArchiveReader(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				rfc := NewMockGitserverService_ArchiveClient()
+				rfc := NewMockGitserverService_ReadFileClient()
 				rfc.RecvFunc.PushReturn(nil, io.EOF)
-				c.ArchiveFunc.SetDefaultReturn(rfc, nil)
+				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		r, err := c.ArchiveReader(context.Background(), "repo", ArchiveOptions{Treeish: "deadbeef", Format: ArchiveFormatTar, Paths: []string{"file"}})
+		r, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
 		require.NoError(t, err)
 
 		content, err := io.ReadAll(r)
@@ -2064,22 +3304,39 @@ func TestClient_ArchiveReader(t *testing.T) {
 		require.NoError(t, r.Close())
 		require.Equal(t, "", string(content))
 	})
+	t.Run("permission errors are returned early", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				rfc := NewMockGitserverService_ReadFileClient()
+				rfc.RecvFunc.PushReturn(nil, status.New(codes.PermissionDenied, "bad actor").Err())
+				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
+		require.Error(t, err)
+		require.True(t, os.IsNotExist(err))
+	})
 	t.Run("file not found errors are returned early", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				rfc := NewMockGitserverService_ArchiveClient()
-				s, err := status.New(codes.NotFound, "not found").WithDetails(&proto.FileNotFoundPayload{})
+				rfc := NewMockGitserverService_ReadFileClient()
+				s, err := status.New(codes.NotFound, "bad actor").WithDetails(&proto.FileNotFoundPayload{})
 				require.NoError(t, err)
 				rfc.RecvFunc.PushReturn(nil, s.Err())
-				c.ArchiveFunc.SetDefaultReturn(rfc, nil)
+				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		_, err := c.ArchiveReader(context.Background(), "repo", ArchiveOptions{Treeish: "deadbeef", Format: ArchiveFormatTar, Paths: []string{"file"}})
+		_, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
 		require.Error(t, err)
 		require.True(t, os.IsNotExist(err))
 	})
@@ -2087,195 +3344,1968 @@ func TestClient_ArchiveReader(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				rfc := NewMockGitserverService_ArchiveClient()
+				rfc := NewMockGitserverService_ReadFileClient()
 				s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{})
 				require.NoError(t, err)
 				rfc.RecvFunc.PushReturn(nil, s.Err())
-				c.ArchiveFunc.SetDefaultReturn(rfc, nil)
+				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		_, err := c.ArchiveReader(context.Background(), "repo", ArchiveOptions{Treeish: "deadbeef", Format: ArchiveFormatTar, Paths: []string{"file"}})
+		_, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
 		require.Error(t, err)
 		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
 	})
-	t.Run("empty archive", func(t *testing.T) {
+	t.Run("empty file", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				rfc := NewMockGitserverService_ArchiveClient()
+				rfc := NewMockGitserverService_ReadFileClient()
 				rfc.RecvFunc.PushReturn(nil, io.EOF)
-				c.ArchiveFunc.SetDefaultReturn(rfc, nil)
+				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		r, err := c.ArchiveReader(context.Background(), "repo", ArchiveOptions{Treeish: "deadbeef", Format: ArchiveFormatTar, Paths: []string{"file"}})
+		r, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
 		require.NoError(t, err)
 		content, err := io.ReadAll(r)
 		require.NoError(t, err)
 		require.Empty(t, content)
 		require.NoError(t, r.Close())
 	})
-}
-
-func TestClient_ResolveRevision(t *testing.T) {
-	t.Run("correctly returns server response", func(t *testing.T) {
+	t.Run("exposes blob OID", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				c.ResolveRevisionFunc.SetDefaultReturn(&proto.ResolveRevisionResponse{CommitSha: "deadbeef"}, nil)
+				rfc := NewMockGitserverService_ReadFileClient()
+				rfc.RecvFunc.PushReturn(&proto.ReadFileResponse{BlobOid: "acbe86c7c89586e0912a0a851bacf309c595c308"}, nil)
+				rfc.RecvFunc.PushReturn(&proto.ReadFileResponse{Data: []byte("abcd\n")}, nil)
+				rfc.RecvFunc.PushReturn(nil, io.EOF)
+				c.ReadFileFunc.SetDefaultReturn(rfc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		r, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
+		require.NoError(t, err)
+		info, ok := r.(gitdomain.ObjectInfo)
+		require.True(t, ok)
+		require.Equal(t, "acbe86c7c89586e0912a0a851bacf309c595c308", info.OID().String())
+
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, "abcd\n", string(content))
+	})
+	t.Run("conditional read", func(t *testing.T) {
+		const oid = "acbe86c7c89586e0912a0a851bacf309c595c308"
+		newClient := func(resp *proto.ReadFileResponse) (TestClient, *MockGitserverServiceClient) {
+			c := NewMockGitserverServiceClient()
+			source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+				o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+					rfc := NewMockGitserverService_ReadFileClient()
+					rfc.RecvFunc.PushReturn(resp, nil)
+					rfc.RecvFunc.PushReturn(&proto.ReadFileResponse{Data: []byte("abcd\n")}, nil)
+					rfc.RecvFunc.PushReturn(nil, io.EOF)
+					c.ReadFileFunc.SetDefaultReturn(rfc, nil)
+					return c
+				}
+			})
+			return NewTestClient(t).WithClientSource(source), c
+		}
+		decoded, err := decodeOID(oid)
+		require.NoError(t, err)
+		ctx := context.Background()
+
+		c, mock := newClient(&proto.ReadFileResponse{BlobOid: oid, NotModified: true})
+		_, err = c.NewFileReaderIfNotOID(ctx, "repo", "deadbeef", "file", decoded)
+		require.Equal(t, ErrNotModified, err)
+		require.Len(t, mock.ReadFileFunc.History(), 1)
+		require.Equal(t, oid, mock.ReadFileFunc.History()[0].Arg1.GetIfNotOid())
+
+		// Gitservers that don't know about conditional reads send the content.
+		c, _ = newClient(&proto.ReadFileResponse{BlobOid: oid})
+		_, err = c.NewFileReaderIfNotOID(ctx, "repo", "deadbeef", "file", decoded)
+		require.Equal(t, ErrNotModified, err)
+
+		// Plain reads are never conditional.
+		c, mock = newClient(&proto.ReadFileResponse{BlobOid: oid})
+		r, err := c.NewFileReader(ctx, "repo", "deadbeef", "file")
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Empty(t, mock.ReadFileFunc.History()[0].Arg1.GetIfNotOid())
+
+		c, _ = newClient(&proto.ReadFileResponse{BlobOid: "0000000000000000000000000000000000000000"})
+		r, err = c.NewFileReaderIfNotOID(ctx, "repo", "deadbeef", "file", decoded)
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, "abcd\n", string(content))
+	})
+}
+
+func TestClient_ReadBlob(t *testing.T) {
+	const oid = "acbe86c7c89586e0912a0a851bacf309c595c308"
+	decoded, err := decodeOID(oid)
+	require.NoError(t, err)
+
+	t.Run("reads content", func(t *testing.T) {
+		c := NewMockGitserverServiceClient()
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				rbc := NewMockGitserverService_ReadBlobClient()
+				rbc.RecvFunc.PushReturn(&proto.ReadBlobResponse{Data: []byte("ab")}, nil)
+				rbc.RecvFunc.PushReturn(&proto.ReadBlobResponse{Data: []byte("cd\n")}, nil)
+				rbc.RecvFunc.PushReturn(nil, io.EOF)
+				c.ReadBlobFunc.SetDefaultReturn(rbc, nil)
+				return c
+			}
+		})
+
+		r, err := NewTestClient(t).WithClientSource(source).ReadBlob(context.Background(), "repo", decoded)
+		require.NoError(t, err)
+		require.Equal(t, oid, r.(gitdomain.ObjectInfo).OID().String())
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, "abcd\n", string(content))
+
+		require.Len(t, c.ReadBlobFunc.History(), 1)
+		require.Equal(t, "repo", c.ReadBlobFunc.History()[0].Arg1.GetRepoName())
+		require.Equal(t, oid, c.ReadBlobFunc.History()[0].Arg1.GetOid())
+	})
+	t.Run("not found", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				rbc := NewMockGitserverService_ReadBlobClient()
+				s, err := status.New(codes.NotFound, "blob not found").WithDetails(&proto.RevisionNotFoundPayload{
+					Repo: "repo",
+					Spec: oid,
+				})
+				require.NoError(t, err)
+				rbc.RecvFunc.PushReturn(nil, s.Err())
+				c.ReadBlobFunc.SetDefaultReturn(rbc, nil)
+				return c
+			}
+		})
+
+		_, err := NewTestClient(t).WithClientSource(source).ReadBlob(context.Background(), "repo", decoded)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestClient_GetTree(t *testing.T) {
+	newClient := func(t *testing.T, recv func(*MockGitserverService_GetTreeClient)) (TestClient, *MockGitserverServiceClient) {
+		c := NewMockGitserverServiceClient()
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				gtc := NewMockGitserverService_GetTreeClient()
+				recv(gtc)
+				c.GetTreeFunc.SetDefaultReturn(gtc, nil)
+				return c
+			}
+		})
+		return NewTestClient(t).WithClientSource(source), c
+	}
+
+	t.Run("collects entries", func(t *testing.T) {
+		blob := gitdomain.TreeEntry{Name: "a.txt", Mode: 0o100644, Type: gitdomain.ObjectTypeBlob, OID: gitdomain.OID{1}, Size: 5}
+		tree := gitdomain.TreeEntry{Name: "sub", Mode: 0o040000, Type: gitdomain.ObjectTypeTree, OID: gitdomain.OID{2}}
+		c, mock := newClient(t, func(gtc *MockGitserverService_GetTreeClient) {
+			gtc.RecvFunc.PushReturn(&proto.GetTreeResponse{Entries: []*proto.TreeEntry{blob.ToProto()}}, nil)
+			gtc.RecvFunc.PushReturn(&proto.GetTreeResponse{Entries: []*proto.TreeEntry{tree.ToProto()}}, nil)
+			gtc.RecvFunc.PushReturn(nil, io.EOF)
+		})
+
+		entries, err := c.GetTree(context.Background(), "repo", "HEAD", "dir")
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.TreeEntry{blob, tree}, entries)
+
+		require.Len(t, mock.GetTreeFunc.History(), 1)
+		require.Equal(t, "HEAD", string(mock.GetTreeFunc.History()[0].Arg1.GetTreeish()))
+		require.Equal(t, "dir", string(mock.GetTreeFunc.History()[0].Arg1.GetPath()))
+	})
+	t.Run("path not found", func(t *testing.T) {
+		c, _ := newClient(t, func(gtc *MockGitserverService_GetTreeClient) {
+			s, err := status.New(codes.NotFound, "file not found").WithDetails(&proto.FileNotFoundPayload{Repo: "repo", Commit: "HEAD", Path: "dir"})
+			require.NoError(t, err)
+			gtc.RecvFunc.PushReturn(nil, s.Err())
+		})
+
+		_, err := c.GetTree(context.Background(), "repo", "HEAD", "dir")
+		require.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestClient_SparseManifest(t *testing.T) {
+	newClient := func(t *testing.T, recv func(*MockGitserverService_SparseManifestClient)) (TestClient, *MockGitserverServiceClient) {
+		c := NewMockGitserverServiceClient()
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				smc := NewMockGitserverService_SparseManifestClient()
+				recv(smc)
+				c.SparseManifestFunc.SetDefaultReturn(smc, nil)
+				return c
+			}
+		})
+		return NewTestClient(t).WithClientSource(source), c
+	}
+
+	t.Run("collects entries", func(t *testing.T) {
+		a := gitdomain.TreeEntry{Name: "dir/a.txt", Mode: 0o100644, Type: gitdomain.ObjectTypeBlob, OID: gitdomain.OID{1}, Size: 5}
+		b := gitdomain.TreeEntry{Name: "dir/sub/b.sh", Mode: 0o100755, Type: gitdomain.ObjectTypeBlob, OID: gitdomain.OID{2}, Size: 7}
+		c, mock := newClient(t, func(smc *MockGitserverService_SparseManifestClient) {
+			smc.RecvFunc.PushReturn(&proto.SparseManifestResponse{Entries: []*proto.TreeEntry{a.ToProto()}}, nil)
+			smc.RecvFunc.PushReturn(&proto.SparseManifestResponse{Entries: []*proto.TreeEntry{b.ToProto()}}, nil)
+			smc.RecvFunc.PushReturn(nil, io.EOF)
+		})
+
+		entries, err := c.SparseManifest(context.Background(), "repo", "deadbeef", []string{"dir", "README.md"})
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.TreeEntry{a, b}, entries)
+
+		require.Len(t, mock.SparseManifestFunc.History(), 1)
+		req := mock.SparseManifestFunc.History()[0].Arg1
+		require.Equal(t, "deadbeef", req.GetCommitSha())
+		require.Equal(t, [][]byte{[]byte("dir"), []byte("README.md")}, req.GetPaths())
+	})
+	t.Run("revision not found", func(t *testing.T) {
+		c, _ := newClient(t, func(smc *MockGitserverService_SparseManifestClient) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "deadbeef"})
+			require.NoError(t, err)
+			smc.RecvFunc.PushReturn(nil, s.Err())
+		})
+
+		_, err := c.SparseManifest(context.Background(), "repo", "deadbeef", nil)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestClient_LastCommitsForTree(t *testing.T) {
+	newClient := func(t *testing.T, recv func(*MockGitserverService_LastCommitsForTreeClient)) (TestClient, *MockGitserverServiceClient) {
+		c := NewMockGitserverServiceClient()
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				lc := NewMockGitserverService_LastCommitsForTreeClient()
+				recv(lc)
+				c.LastCommitsForTreeFunc.SetDefaultReturn(lc, nil)
+				return c
+			}
+		})
+		return NewTestClient(t).WithClientSource(source), c
+	}
+
+	t.Run("collects entries", func(t *testing.T) {
+		date := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		a := gitdomain.TreeEntryCommit{
+			TreeEntry: gitdomain.TreeEntry{Name: "a.txt", Mode: 0o100644, Type: gitdomain.ObjectTypeBlob, OID: gitdomain.OID{1}, Size: 5},
+			Commit: &gitdomain.Commit{
+				ID:        "c1",
+				Author:    gitdomain.Signature{Name: "a", Email: "a@example.com", Date: date},
+				Committer: &gitdomain.Signature{Name: "a", Email: "a@example.com", Date: date},
+				Message:   "first",
+				Parents:   []api.CommitID{},
+			},
+		}
+		b := gitdomain.TreeEntryCommit{
+			TreeEntry: gitdomain.TreeEntry{Name: "sub", Mode: 0o040000, Type: gitdomain.ObjectTypeTree, OID: gitdomain.OID{2}},
+		}
+		c, mock := newClient(t, func(lc *MockGitserverService_LastCommitsForTreeClient) {
+			lc.RecvFunc.PushReturn(&proto.LastCommitsForTreeResponse{Entries: []*proto.TreeEntryCommit{a.ToProto()}}, nil)
+			lc.RecvFunc.PushReturn(&proto.LastCommitsForTreeResponse{Entries: []*proto.TreeEntryCommit{b.ToProto()}}, nil)
+			lc.RecvFunc.PushReturn(nil, io.EOF)
+		})
+
+		entries, err := c.LastCommitsForTree(context.Background(), "repo", "deadbeef", "dir")
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.TreeEntryCommit{a, b}, entries)
+
+		require.Len(t, mock.LastCommitsForTreeFunc.History(), 1)
+		req := mock.LastCommitsForTreeFunc.History()[0].Arg1
+		require.Equal(t, "deadbeef", req.GetCommitSha())
+		require.Equal(t, []byte("dir"), req.GetPath())
+	})
+	t.Run("path not found", func(t *testing.T) {
+		c, _ := newClient(t, func(lc *MockGitserverService_LastCommitsForTreeClient) {
+			s, err := status.New(codes.NotFound, "file not found").WithDetails(&proto.FileNotFoundPayload{Repo: "repo", Commit: "deadbeef", Path: "dir"})
+			require.NoError(t, err)
+			lc.RecvFunc.PushReturn(nil, s.Err())
+		})
+
+		_, err := c.LastCommitsForTree(context.Background(), "repo", "deadbeef", "dir")
+		require.True(t, os.IsNotExist(err))
+	})
+	t.Run("revision not found", func(t *testing.T) {
+		c, _ := newClient(t, func(lc *MockGitserverService_LastCommitsForTreeClient) {
+			s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "deadbeef"})
+			require.NoError(t, err)
+			lc.RecvFunc.PushReturn(nil, s.Err())
+		})
+
+		_, err := c.LastCommitsForTree(context.Background(), "repo", "deadbeef", "")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestClient_SearchCommits(t *testing.T) {
+	newClient := func(t *testing.T) (TestClient, *MockGitserverServiceClient) {
+		c := NewMockGitserverServiceClient()
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				sc := NewMockGitserverService_SearchClient()
+				sc.RecvFunc.PushReturn(&proto.SearchResponse{Message: &proto.SearchResponse_Match{Match: (&protocol.CommitMatch{Oid: "deadbeef"}).ToProto()}}, nil)
+				sc.RecvFunc.PushReturn(&proto.SearchResponse{Message: &proto.SearchResponse_LimitHit{LimitHit: true}}, nil)
+				sc.RecvFunc.PushReturn(nil, io.EOF)
+				c.SearchFunc.SetDefaultReturn(sc, nil)
+				return c
+			}
+		})
+		return NewTestClient(t).WithClientSource(source), c
+	}
+
+	t.Run("builds the query", func(t *testing.T) {
+		c, mock := newClient(t)
+		after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		var oids []api.CommitID
+		limitHit, err := c.SearchCommits(context.Background(), "repo", CommitSearchQuery{
+			Revisions:      []string{"main"},
+			MessagePattern: "fix.*bug",
+			Author:         "alice",
+			DateRange:      DateRange{After: after},
+			Paths:          []string{"cmd/", "a.go"},
+			DiffContains:   "foo(",
+			IgnoreCase:     true,
+			Limit:          10,
+		}, func(matches []protocol.CommitMatch) {
+			for _, m := range matches {
+				oids = append(oids, m.Oid)
+			}
+		})
+		require.NoError(t, err)
+		require.True(t, limitHit)
+		require.Equal(t, []api.CommitID{"deadbeef"}, oids)
+
+		require.Len(t, mock.SearchFunc.History(), 1)
+		req, err := protocol.SearchRequestFromProto(mock.SearchFunc.History()[0].Arg1)
+		require.NoError(t, err)
+		require.Equal(t, []string{"main"}, req.Revisions)
+		require.Equal(t, 10, req.Limit)
+		require.Equal(t, protocol.NewAnd(
+			&protocol.MessageMatches{Expr: "fix.*bug", IgnoreCase: true},
+			&protocol.AuthorMatches{Expr: "alice", IgnoreCase: true},
+			&protocol.CommitAfter{Time: after},
+			protocol.NewOr(
+				&protocol.DiffModifiesFile{Expr: "^cmd(/|$)"},
+				&protocol.DiffModifiesFile{Expr: `^a\.go(/|$)`},
+			),
+			&protocol.DiffMatches{Expr: `foo\(`, IgnoreCase: true},
+		), req.Query)
+	})
+
+	t.Run("defaults to HEAD", func(t *testing.T) {
+		c, mock := newClient(t)
+		_, err := c.SearchCommits(context.Background(), "repo", CommitSearchQuery{}, func([]protocol.CommitMatch) {})
+		require.NoError(t, err)
+		req, err := protocol.SearchRequestFromProto(mock.SearchFunc.History()[0].Arg1)
+		require.NoError(t, err)
+		require.Equal(t, []string{"HEAD"}, req.Revisions)
+		require.Equal(t, &protocol.Boolean{Value: true}, req.Query)
+	})
+
+	t.Run("invalid queries", func(t *testing.T) {
+		c, mock := newClient(t)
+		for name, q := range map[string]CommitSearchQuery{
+			"message pattern": {MessagePattern: "("},
+			"author":          {Author: "[a-"},
+			"date range":      {DateRange: DateRange{After: time.Now(), Before: time.Now().Add(-time.Hour)}},
+			"empty path":      {Paths: []string{"/"}},
+			"revision":        {Revisions: []string{"--output=/tmp/x"}},
+		} {
+			_, err := c.SearchCommits(context.Background(), "repo", q, func([]protocol.CommitMatch) {})
+			require.Error(t, err, name)
+		}
+		require.Empty(t, mock.SearchFunc.History())
+	})
+}
+
+func TestClient_GetCommit(t *testing.T) {
+	t.Run("correctly returns server response", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.GetCommitFunc.SetDefaultReturn(&proto.GetCommitResponse{Commit: &proto.GitCommit{Oid: "deadbeef"}}, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		commit, err := c.GetCommit(context.Background(), "repo", "deadbeef")
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("deadbeef"), commit.ID)
+	})
+	t.Run("returns correct error for not found", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.NotFound, "bad revision").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "deadbeef"})
+				require.NoError(t, err)
+				c.GetCommitFunc.PushReturn(nil, s.Err())
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, err := c.GetCommit(context.Background(), "repo", "deadbeef")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestClient_GetCommitWithChanges(t *testing.T) {
+	t.Run("correctly returns server response", func(t *testing.T) {
+		var got *proto.GetCommitRequest
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.GetCommitFunc.SetDefaultHook(func(_ context.Context, req *proto.GetCommitRequest, _ ...grpc.CallOption) (*proto.GetCommitResponse, error) {
+					got = req
+					return &proto.GetCommitResponse{
+						Commit: &proto.GitCommit{Oid: "deadbeef"},
+						Stats:  []*proto.FileDiffStat{{Path: []byte("file"), Added: 2, Deleted: 1}, {Path: []byte("image.png"), Binary: true}},
+						Patch:  []byte("diff --git a/file b/file\n"),
+					}, nil
+				})
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		commit, err := c.GetCommitWithChanges(context.Background(), "repo", "deadbeef", GetCommitOptions{IncludeStat: true, IncludePatch: true})
+		require.NoError(t, err)
+		require.True(t, got.GetIncludeStat())
+		require.True(t, got.GetIncludePatch())
+		require.Equal(t, api.CommitID("deadbeef"), commit.ID)
+		require.Equal(t, []gitdomain.FileDiffStat{
+			{Path: "file", Added: 2, Deleted: 1},
+			{Path: "image.png", Binary: true},
+		}, commit.Stats)
+		require.Equal(t, "diff --git a/file b/file\n", string(commit.Patch))
+	})
+	t.Run("returns correct error for not found", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.NotFound, "bad revision").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "deadbeef"})
+				require.NoError(t, err)
+				c.GetCommitFunc.PushReturn(nil, s.Err())
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, err := c.GetCommitWithChanges(context.Background(), "repo", "deadbeef", GetCommitOptions{IncludePatch: true})
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func Test_CommitLog(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+
+	tests := map[string]struct {
+		commitFiles [][]string
+		wantFiles   [][]string // put these in log reverse order
+		wantCommits int
+		wantErr     string
+	}{
+		"commit changes files": {
+			commitFiles: [][]string{{"file1.txt", "file2.txt"}, {"file3.txt"}},
+			wantFiles:   [][]string{{"file3.txt"}, {"file1.txt", "file2.txt"}},
+			wantCommits: 2,
+		},
+		"no commits": {
+			wantErr: "gitCommand fatal: your current branch 'master' does not have any commits yet: exit status 128",
+		},
+		"one file two commits": {
+			commitFiles: [][]string{{"file1.txt"}, {"file1.txt"}},
+			wantFiles:   [][]string{{"file1.txt"}, {"file1.txt"}},
+			wantCommits: 2,
+		},
+		"one commit": {
+			commitFiles: [][]string{{"file1.txt"}},
+			wantFiles:   [][]string{{"file1.txt"}},
+			wantCommits: 1,
+		},
+	}
+
+	for label, test := range tests {
+		t.Run(label, func(t *testing.T) {
+			r := NewTestRepo(t)
+			addCommitsWithFileLists(r, test.commitFiles...)
+			repo := r.Build()
+			logResults, err := NewClient("test").CommitLog(context.Background(), repo, time.Time{})
+			if err != nil {
+				require.ErrorContains(t, err, test.wantErr)
+			}
+
+			t.Log(test)
+			for i, result := range logResults {
+				t.Log(result)
+				assert.Equal(t, "a@a.com", result.AuthorEmail)
+				assert.Equal(t, "a", result.AuthorName)
+				assert.Equal(t, 40, len(result.SHA))
+				assert.ElementsMatch(t, test.wantFiles[i], result.ChangedFiles)
+			}
+			assert.Equal(t, test.wantCommits, len(logResults))
+		})
+	}
+}
+
+func TestErrorMessageTruncateOutput(t *testing.T) {
+	cmd := []string{"git", "ls-files"}
+
+	t.Run("short output", func(t *testing.T) {
+		shortOutput := "aaaaaaaaaab"
+		message := errorMessageTruncatedOutput(cmd, []byte(shortOutput))
+		want := fmt.Sprintf("git command [git ls-files] failed (output: %q)", shortOutput)
+
+		if diff := cmp.Diff(want, message); diff != "" {
+			t.Fatalf("wrong message. diff: %s", diff)
+		}
+	})
+
+	t.Run("truncating output", func(t *testing.T) {
+		longOutput := strings.Repeat("a", 5000) + "b"
+		message := errorMessageTruncatedOutput(cmd, []byte(longOutput))
+		want := fmt.Sprintf("git command [git ls-files] failed (truncated output: %q, 1 more)", longOutput[:5000])
+
+		if diff := cmp.Diff(want, message); diff != "" {
+			t.Fatalf("wrong message. diff: %s", diff)
+		}
+	})
+}
+
+func TestClient_ArchiveReader(t *testing.T) {
+	t.Run("firstChunk memoization", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				rfc := NewMockGitserverService_ArchiveClient()
+				rfc.RecvFunc.PushReturn(&proto.ArchiveResponse{Data: []byte("part1\n")}, nil)
+				rfc.RecvFunc.PushReturn(&proto.ArchiveResponse{Data: []byte("part2\n")}, nil)
+				rfc.RecvFunc.PushReturn(nil, io.EOF)
+				c.ArchiveFunc.SetDefaultReturn(rfc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		r, err := c.ArchiveReader(context.Background(), "repo", ArchiveOptions{Treeish: "deadbeef", Format: ArchiveFormatTar, Paths: []string{"file"}})
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, "part1\npart2\n", string(content))
+	})
+	t.Run("firstChunk error memoization", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				rfc := NewMockGitserverService_ArchiveClient()
+				rfc.RecvFunc.PushReturn(nil, io.EOF)
+				c.ArchiveFunc.SetDefaultReturn(rfc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		r, err := c.ArchiveReader(context.Background(), "repo", ArchiveOptions{Treeish: "deadbeef", Format: ArchiveFormatTar, Paths: []string{"file"}})
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, "", string(content))
+	})
+	t.Run("file not found errors are returned early", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				rfc := NewMockGitserverService_ArchiveClient()
+				s, err := status.New(codes.NotFound, "not found").WithDetails(&proto.FileNotFoundPayload{})
+				require.NoError(t, err)
+				rfc.RecvFunc.PushReturn(nil, s.Err())
+				c.ArchiveFunc.SetDefaultReturn(rfc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, err := c.ArchiveReader(context.Background(), "repo", ArchiveOptions{Treeish: "deadbeef", Format: ArchiveFormatTar, Paths: []string{"file"}})
+		require.Error(t, err)
+		require.True(t, os.IsNotExist(err))
+	})
+	t.Run("revision not found errors are returned early", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				rfc := NewMockGitserverService_ArchiveClient()
+				s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{})
+				require.NoError(t, err)
+				rfc.RecvFunc.PushReturn(nil, s.Err())
+				c.ArchiveFunc.SetDefaultReturn(rfc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, err := c.ArchiveReader(context.Background(), "repo", ArchiveOptions{Treeish: "deadbeef", Format: ArchiveFormatTar, Paths: []string{"file"}})
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+	t.Run("empty archive", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				rfc := NewMockGitserverService_ArchiveClient()
+				rfc.RecvFunc.PushReturn(nil, io.EOF)
+				c.ArchiveFunc.SetDefaultReturn(rfc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		r, err := c.ArchiveReader(context.Background(), "repo", ArchiveOptions{Treeish: "deadbeef", Format: ArchiveFormatTar, Paths: []string{"file"}})
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Empty(t, content)
+		require.NoError(t, r.Close())
+	})
+}
+
+func TestClient_FormatPatch(t *testing.T) {
+	t.Run("streams the patch series", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				fpc := NewMockGitserverService_FormatPatchClient()
+				fpc.RecvFunc.PushReturn(&proto.FormatPatchResponse{Data: []byte("From deadbeef\n")}, nil)
+				fpc.RecvFunc.PushReturn(&proto.FormatPatchResponse{Data: []byte("Subject: [PATCH] foo\n")}, nil)
+				fpc.RecvFunc.PushReturn(nil, io.EOF)
+				c.FormatPatchFunc.SetDefaultReturn(fpc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		r, err := c.FormatPatch(context.Background(), "repo", "base", "head")
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, "From deadbeef\nSubject: [PATCH] foo\n", string(content))
+	})
+	t.Run("empty range", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				fpc := NewMockGitserverService_FormatPatchClient()
+				fpc.RecvFunc.PushReturn(nil, io.EOF)
+				c.FormatPatchFunc.SetDefaultReturn(fpc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		r, err := c.FormatPatch(context.Background(), "repo", "base", "head")
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Empty(t, content)
+		require.NoError(t, r.Close())
+	})
+	t.Run("revision not found errors are returned early", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				fpc := NewMockGitserverService_FormatPatchClient()
+				s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{})
+				require.NoError(t, err)
+				fpc.RecvFunc.PushReturn(nil, s.Err())
+				c.FormatPatchFunc.SetDefaultReturn(fpc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, err := c.FormatPatch(context.Background(), "repo", "base", "head")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestClient_CreateBundle(t *testing.T) {
+	t.Run("streams the bundle", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				cbc := NewMockGitserverService_CreateBundleClient()
+				cbc.RecvFunc.PushReturn(&proto.CreateBundleResponse{Data: []byte("# v2 git bundle\n")}, nil)
+				cbc.RecvFunc.PushReturn(&proto.CreateBundleResponse{Data: []byte("PACK")}, nil)
+				cbc.RecvFunc.PushReturn(nil, io.EOF)
+				c.CreateBundleFunc.SetDefaultReturn(cbc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		var buf bytes.Buffer
+		err := c.CreateBundle(context.Background(), "repo", []string{"refs/heads/main", "^v1.0.0"}, &buf)
+		require.NoError(t, err)
+		require.Equal(t, "# v2 git bundle\nPACK", buf.String())
+	})
+	t.Run("requires refs", func(t *testing.T) {
+		c := NewTestClient(t)
+
+		err := c.CreateBundle(context.Background(), "repo", nil, io.Discard)
+		require.Error(t, err)
+	})
+	t.Run("revision not found errors are returned", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				cbc := NewMockGitserverService_CreateBundleClient()
+				s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{})
+				require.NoError(t, err)
+				cbc.RecvFunc.PushReturn(nil, s.Err())
+				c.CreateBundleFunc.SetDefaultReturn(cbc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		err := c.CreateBundle(context.Background(), "repo", []string{"refs/heads/main"}, io.Discard)
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestClient_ApplyBundle(t *testing.T) {
+	t.Run("streams the bundle", func(t *testing.T) {
+		var sent []*proto.ApplyBundleRequest
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				abc := NewMockGitserverService_ApplyBundleClient()
+				abc.SendFunc.SetDefaultHook(func(req *proto.ApplyBundleRequest) error {
+					sent = append(sent, req)
+					return nil
+				})
+				abc.CloseAndRecvFunc.SetDefaultReturn(&proto.ApplyBundleResponse{}, nil)
+				c.ApplyBundleFunc.SetDefaultReturn(abc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		err := c.ApplyBundle(context.Background(), "repo", strings.NewReader("# v2 git bundle\nPACK"))
+		require.NoError(t, err)
+		require.Len(t, sent, 2)
+		require.Equal(t, "repo", sent[0].GetMetadata().GetRepoName())
+		require.Equal(t, "# v2 git bundle\nPACK", string(sent[1].GetData()))
+	})
+	t.Run("ref update conflict", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				abc := NewMockGitserverService_ApplyBundleClient()
+				s, err := status.New(codes.FailedPrecondition, "conflict").WithDetails(&proto.RefUpdateConflictPayload{RepoName: "repo", RefName: "refs/heads/main"})
+				require.NoError(t, err)
+				abc.CloseAndRecvFunc.SetDefaultReturn(nil, s.Err())
+				c.ApplyBundleFunc.SetDefaultReturn(abc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		err := c.ApplyBundle(context.Background(), "repo", strings.NewReader("bundle"))
+		var e *gitdomain.RefUpdateConflictError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, "refs/heads/main", e.Ref)
+	})
+	t.Run("stream closed by server", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				abc := NewMockGitserverService_ApplyBundleClient()
+				abc.SendFunc.PushReturn(nil)
+				abc.SendFunc.SetDefaultReturn(io.EOF)
+				abc.CloseAndRecvFunc.SetDefaultReturn(nil, status.New(codes.FailedPrecondition, "invalid bundle").Err())
+				c.ApplyBundleFunc.SetDefaultReturn(abc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		err := c.ApplyBundle(context.Background(), "repo", strings.NewReader("bundle"))
+		require.ErrorContains(t, err, "invalid bundle")
+	})
+}
+
+func TestClient_StreamCommitGraph(t *testing.T) {
+	readAll := func(t *testing.T, r CommitGraphNodeReader) []*gitdomain.CommitGraphNode {
+		var nodes []*gitdomain.CommitGraphNode
+		for {
+			n, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			nodes = append(nodes, n)
+		}
+		require.NoError(t, r.Close())
+		return nodes
+	}
+	t.Run("streams the graph", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				cgc := NewMockGitserverService_CommitGraphClient()
+				cgc.RecvFunc.PushReturn(&proto.CommitGraphResponse{Nodes: []*proto.CommitGraphNode{
+					{CommitSha: "c3", ParentShas: []string{"c2"}},
+					{CommitSha: "c2", ParentShas: []string{"c1", "c0"}},
+				}}, nil)
+				cgc.RecvFunc.PushReturn(&proto.CommitGraphResponse{}, nil)
+				cgc.RecvFunc.PushReturn(&proto.CommitGraphResponse{Nodes: []*proto.CommitGraphNode{
+					{CommitSha: "c1"},
+				}}, nil)
+				cgc.RecvFunc.SetDefaultReturn(nil, io.EOF)
+				c.CommitGraphFunc.SetDefaultHook(func(_ context.Context, req *proto.CommitGraphRequest, _ ...grpc.CallOption) (proto.GitserverService_CommitGraphClient, error) {
+					require.Equal(t, "head", string(req.GetHead()))
+					require.Equal(t, "base", string(req.GetBase()))
+					require.Equal(t, uint32(3), req.GetMaxCommits())
+					return cgc, nil
+				})
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		r, err := c.StreamCommitGraph(context.Background(), "repo", StreamCommitGraphOptions{Head: "head", Base: "base", MaxCommits: 3})
+		require.NoError(t, err)
+		require.Equal(t, []*gitdomain.CommitGraphNode{
+			{Commit: "c3", Parents: []api.CommitID{"c2"}},
+			{Commit: "c2", Parents: []api.CommitID{"c1", "c0"}},
+			{Commit: "c1", Parents: []api.CommitID{}},
+		}, readAll(t, r))
+	})
+	t.Run("empty range", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				cgc := NewMockGitserverService_CommitGraphClient()
+				cgc.RecvFunc.PushReturn(nil, io.EOF)
+				c.CommitGraphFunc.SetDefaultReturn(cgc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		r, err := c.StreamCommitGraph(context.Background(), "repo", StreamCommitGraphOptions{Head: "head"})
+		require.NoError(t, err)
+		require.Empty(t, readAll(t, r))
+	})
+	t.Run("revision not found errors are returned early", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				cgc := NewMockGitserverService_CommitGraphClient()
+				s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{})
+				require.NoError(t, err)
+				cgc.RecvFunc.PushReturn(nil, s.Err())
+				c.CommitGraphFunc.SetDefaultReturn(cgc, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, err := c.StreamCommitGraph(context.Background(), "repo", StreamCommitGraphOptions{Head: "head"})
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestClient_CloneProgress(t *testing.T) {
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			ss := NewMockGitserverService_CloneProgressClient()
+			ss.RecvFunc.PushReturn(&proto.CloneProgressResponse{CloneInProgress: true, Message: "Receiving objects:  45% (45/100)", Phase: "Receiving objects", Percent: 45}, nil)
+			ss.RecvFunc.PushReturn(&proto.CloneProgressResponse{Cloned: true}, nil)
+			ss.RecvFunc.SetDefaultReturn(nil, io.EOF)
+			c.CloneProgressFunc.SetDefaultHook(func(_ context.Context, req *proto.CloneProgressRequest, _ ...grpc.CallOption) (proto.GitserverService_CloneProgressClient, error) {
+				require.Equal(t, "repo", req.GetRepoName())
+				return ss, nil
+			})
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	r, err := c.CloneProgress(context.Background(), "repo")
+	require.NoError(t, err)
+	t.Cleanup(func() { r.Close() })
+
+	u, err := r.Read()
+	require.NoError(t, err)
+	require.Equal(t, &protocol.CloneProgressUpdate{CloneInProgress: true, Message: "Receiving objects:  45% (45/100)", Phase: "Receiving objects", Percent: 45}, u)
+	u, err = r.Read()
+	require.NoError(t, err)
+	require.Equal(t, &protocol.CloneProgressUpdate{Cloned: true}, u)
+	_, err = r.Read()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestClient_ResolveRevision(t *testing.T) {
+	t.Run("correctly returns server response", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.ResolveRevisionFunc.SetDefaultReturn(&proto.ResolveRevisionResponse{CommitSha: "deadbeef"}, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		sha, err := c.ResolveRevision(context.Background(), "repo", "HEAD", ResolveRevisionOptions{})
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("deadbeef"), sha)
+	})
+	t.Run("returns common errors correctly", func(t *testing.T) {
+		calls := 0
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				if calls == 0 {
+					s, err := status.New(codes.NotFound, "bad revision").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "deadbeef"})
+					require.NoError(t, err)
+					c.ResolveRevisionFunc.PushReturn(nil, s.Err())
+					calls++
+					return c
+				}
+				s, err := status.New(codes.NotFound, "repo cloning").WithDetails(&proto.RepoNotFoundPayload{Repo: "repo", CloneInProgress: true})
+				require.NoError(t, err)
+				c.ResolveRevisionFunc.PushReturn(nil, s.Err())
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		// First request fails with revision error
+		_, err := c.ResolveRevision(context.Background(), "repo", "HEAD", ResolveRevisionOptions{})
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+		// First request fails with clone error
+		_, err = c.ResolveRevision(context.Background(), "repo", "HEAD", ResolveRevisionOptions{})
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RepoNotExistError{}))
+	})
+}
+
+func TestClient_RevAtTime(t *testing.T) {
+	t.Run("correctly returns server response", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.RevAtTimeFunc.SetDefaultReturn(&proto.RevAtTimeResponse{CommitSha: "deadbeef"}, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		sha, found, err := c.RevAtTime(context.Background(), "repo", "HEAD", time.Now())
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, api.CommitID("deadbeef"), sha)
+	})
+
+	t.Run("correctly returns not found on empty sha", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.RevAtTimeFunc.SetDefaultReturn(&proto.RevAtTimeResponse{CommitSha: ""}, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, found, err := c.RevAtTime(context.Background(), "repo", "HEAD", time.Now())
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+
+	t.Run("returns common errors correctly", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
+					Repo: "repo",
+					Spec: "HEAD",
+				})
+				require.NoError(t, err)
+				c.RevAtTimeFunc.PushReturn(nil, s.Err())
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, _, err := c.RevAtTime(context.Background(), "repo", "HEAD", time.Now())
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestClient_ListRefs(t *testing.T) {
+	t.Run("correctly returns server response", func(t *testing.T) {
+		now := time.Now().UTC()
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				ss := NewMockGitserverService_ListRefsClient()
+				ss.RecvFunc.SetDefaultReturn(nil, io.EOF)
+				ss.RecvFunc.PushReturn(&proto.ListRefsResponse{Refs: []*proto.GitRef{
+					{
+						RefName:      "refs/heads/master",
+						TargetCommit: "deadbeef",
+						CreatedAt:    timestamppb.New(now),
+					},
+				}}, nil)
+				c.ListRefsFunc.SetDefaultReturn(ss, nil)
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		refs, err := c.ListRefs(context.Background(), "repo", ListRefsOpts{})
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.Ref{
+			{
+				Name:        "refs/heads/master",
+				CommitID:    "deadbeef",
+				CreatedDate: now,
+			},
+		}, refs)
+	})
+	t.Run("returns well known error types", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.NotFound, "repo cloning").WithDetails(&proto.RepoNotFoundPayload{Repo: "repo", CloneInProgress: true})
+				require.NoError(t, err)
+				c.ListRefsFunc.PushReturn(nil, s.Err())
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		// Should fail with clone error
+		_, err := c.ListRefs(context.Background(), "repo", ListRefsOpts{})
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RepoNotExistError{}))
+	})
+}
+
+func TestClient_ListBranches(t *testing.T) {
+	now := time.Now().UTC()
+	var got *proto.ListBranchesRequest
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			ss := NewMockGitserverService_ListBranchesClient()
+			ss.RecvFunc.SetDefaultReturn(nil, io.EOF)
+			ss.RecvFunc.PushReturn(&proto.ListBranchesResponse{Branches: []*proto.GitBranch{
+				{
+					Ref:         &proto.GitRef{RefName: "refs/heads/master", TargetCommit: "deadbeef", CreatedAt: timestamppb.New(now), IsHead: true},
+					BehindAhead: &proto.BehindAhead{},
+					Commit:      (&gitdomain.Commit{ID: "deadbeef", Message: "first", Author: gitdomain.Signature{Name: "a", Date: now}, Committer: &gitdomain.Signature{Name: "a", Date: now}}).ToProto(),
+				},
+			}}, nil)
+			ss.RecvFunc.PushReturn(&proto.ListBranchesResponse{Branches: []*proto.GitBranch{
+				{
+					Ref:         &proto.GitRef{RefName: "refs/heads/hidden", TargetCommit: "beefdead", CreatedAt: timestamppb.New(now)},
+					BehindAhead: &proto.BehindAhead{Behind: 1, Ahead: 3},
+				},
+			}}, nil)
+			c.ListBranchesFunc.SetDefaultHook(func(_ context.Context, req *proto.ListBranchesRequest, _ ...grpc.CallOption) (proto.GitserverService_ListBranchesClient, error) {
+				got = req
+				return ss, nil
+			})
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	branches, err := c.ListBranches(context.Background(), "repo", ListBranchesOptions{Base: "main"})
+	require.NoError(t, err)
+	require.Equal(t, "main", string(got.GetBase()))
+	require.Equal(t, []*gitdomain.Branch{
+		{
+			Ref:         gitdomain.Ref{Name: "refs/heads/master", CommitID: "deadbeef", CreatedDate: now, IsHead: true},
+			BehindAhead: &gitdomain.BehindAhead{},
+			Commit:      &gitdomain.Commit{ID: "deadbeef", Message: "first", Author: gitdomain.Signature{Name: "a", Date: now}, Committer: &gitdomain.Signature{Name: "a", Date: now}, Parents: []api.CommitID{}},
+		},
+		{
+			Ref:         gitdomain.Ref{Name: "refs/heads/hidden", CommitID: "beefdead", CreatedDate: now},
+			BehindAhead: &gitdomain.BehindAhead{Behind: 1, Ahead: 3},
+		},
+	}, branches)
+}
+
+func TestClient_ListTags(t *testing.T) {
+	now := time.Now().UTC()
+	var got *proto.ListTagsRequest
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			ss := NewMockGitserverService_ListTagsClient()
+			ss.RecvFunc.SetDefaultReturn(nil, io.EOF)
+			ss.RecvFunc.PushReturn(&proto.ListTagsResponse{Refs: []*proto.GitRef{
+				{RefName: "refs/tags/v1.10.0", ShortRefName: "v1.10.0", TargetCommit: "deadbeef", RefOid: "beefdead", CreatedAt: timestamppb.New(now), RefType: proto.GitRef_REF_TYPE_TAG},
+			}}, nil)
+			ss.RecvFunc.PushReturn(&proto.ListTagsResponse{Refs: []*proto.GitRef{
+				{RefName: "refs/tags/v1.2.0", ShortRefName: "v1.2.0", TargetCommit: "deadbeef", RefOid: "deadbeef", CreatedAt: timestamppb.New(now), RefType: proto.GitRef_REF_TYPE_TAG},
+			}}, nil)
+			c.ListTagsFunc.SetDefaultHook(func(_ context.Context, req *proto.ListTagsRequest, _ ...grpc.CallOption) (proto.GitserverService_ListTagsClient, error) {
+				got = req
+				return ss, nil
+			})
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	tags, err := c.ListTags(context.Background(), "repo", ListTagsOptions{Patterns: []string{"v1.*"}, SortByVersion: true, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"v1.*"}, got.GetPatterns())
+	require.True(t, got.GetSortByVersion())
+	require.Equal(t, uint32(2), got.GetLimit())
+	require.Equal(t, []gitdomain.Ref{
+		{Name: "refs/tags/v1.10.0", ShortName: "v1.10.0", Type: gitdomain.RefTypeTag, CommitID: "deadbeef", RefOID: "beefdead", CreatedDate: now},
+		{Name: "refs/tags/v1.2.0", ShortName: "v1.2.0", Type: gitdomain.RefTypeTag, CommitID: "deadbeef", RefOID: "deadbeef", CreatedDate: now},
+	}, tags)
+
+	_, err = c.ListTags(context.Background(), "repo", ListTagsOptions{Limit: -1})
+	require.Error(t, err)
+}
+
+func TestClient_ListRemoteRefs(t *testing.T) {
+	var got *proto.ListRemoteRefsRequest
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			ss := NewMockGitserverService_ListRemoteRefsClient()
+			ss.RecvFunc.SetDefaultReturn(nil, io.EOF)
+			ss.RecvFunc.PushReturn(&proto.ListRemoteRefsResponse{Refs: []*proto.GitRef{
+				{RefName: "refs/heads/main", ShortRefName: "main", TargetCommit: "deadbeef", RefOid: "deadbeef", CreatedAt: timestamppb.New(time.Time{}), RefType: proto.GitRef_REF_TYPE_BRANCH, IsHead: true},
+			}}, nil)
+			ss.RecvFunc.PushReturn(&proto.ListRemoteRefsResponse{Refs: []*proto.GitRef{
+				{RefName: "refs/tags/v1", ShortRefName: "v1", TargetCommit: "deadbeef", RefOid: "beefdead", CreatedAt: timestamppb.New(time.Time{}), RefType: proto.GitRef_REF_TYPE_TAG},
+			}}, nil)
+			c.ListRemoteRefsFunc.SetDefaultHook(func(_ context.Context, req *proto.ListRemoteRefsRequest, _ ...grpc.CallOption) (proto.GitserverService_ListRemoteRefsClient, error) {
+				got = req
+				return ss, nil
+			})
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	refs, err := c.ListRemoteRefs(context.Background(), "repo")
+	require.NoError(t, err)
+	require.Equal(t, "repo", got.GetRepoName())
+	require.Equal(t, []gitdomain.Ref{
+		{Name: "refs/heads/main", ShortName: "main", Type: gitdomain.RefTypeBranch, CommitID: "deadbeef", RefOID: "deadbeef", IsHead: true},
+		{Name: "refs/tags/v1", ShortName: "v1", Type: gitdomain.RefTypeTag, CommitID: "deadbeef", RefOID: "beefdead"},
+	}, refs)
+}
+
+func TestClient_RefsChangedSince(t *testing.T) {
+	changed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	var got *proto.RefsChangedSinceRequest
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.RefsChangedSinceFunc.SetDefaultHook(func(_ context.Context, req *proto.RefsChangedSinceRequest, _ ...grpc.CallOption) (proto.GitserverService_RefsChangedSinceClient, error) {
+				got = req
+				ss := NewMockGitserverService_RefsChangedSinceClient()
+				ss.RecvFunc.SetDefaultReturn(nil, io.EOF)
+				ss.RecvFunc.PushReturn(&proto.RefsChangedSinceResponse{
+					StateToken: "abc:2",
+					Full:       true,
+					Changes:    []*proto.RefChange{{RefName: "refs/heads/main", TargetCommit: "deadbeef", ChangedAt: timestamppb.New(changed)}},
+				}, nil)
+				ss.RecvFunc.PushReturn(&proto.RefsChangedSinceResponse{
+					Changes: []*proto.RefChange{{RefName: "refs/tags/v1", TargetCommit: "beefdead", ChangedAt: timestamppb.New(changed)}},
+				}, nil)
+				return ss, nil
+			})
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	res, err := c.RefsChangedSince(context.Background(), "repo", RefsChangedSinceOptions{StateToken: "abc:1"})
+	require.NoError(t, err)
+	require.Equal(t, "abc:1", got.GetStateToken())
+	require.Nil(t, got.GetSince())
+	require.Equal(t, gitdomain.RefChanges{
+		Changes: []gitdomain.RefChange{
+			{Name: "refs/heads/main", CommitID: "deadbeef", ChangedAt: changed},
+			{Name: "refs/tags/v1", CommitID: "beefdead", ChangedAt: changed},
+		},
+		StateToken: "abc:2",
+		Full:       true,
+	}, res)
+
+	_, err = c.RefsChangedSince(context.Background(), "repo", RefsChangedSinceOptions{Since: changed})
+	require.NoError(t, err)
+	require.Empty(t, got.GetStateToken())
+	require.Equal(t, changed, got.GetSince().AsTime())
+}
+
+func TestClient_WatchRefs(t *testing.T) {
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			ss := NewMockGitserverService_WatchRefsClient()
+			ss.RecvFunc.SetDefaultReturn(nil, io.EOF)
+			ss.RecvFunc.PushReturn(&proto.WatchRefsResponse{StateToken: "abc:1"}, nil)
+			ss.RecvFunc.PushReturn(&proto.WatchRefsResponse{Changes: []*proto.RefChange{{RefName: "refs/heads/main", TargetCommit: "deadbeef", ChangedAt: timestamppb.New(time.Time{})}}}, nil)
+			ss.RecvFunc.PushReturn(&proto.WatchRefsResponse{Changes: []*proto.RefChange{{RefName: "refs/heads/old", ChangedAt: timestamppb.New(time.Time{})}}}, nil)
+			ss.RecvFunc.PushReturn(&proto.WatchRefsResponse{StateToken: "abc:2"}, nil)
+			c.WatchRefsFunc.SetDefaultReturn(ss, nil)
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	w, err := c.WatchRefs(context.Background(), "repo")
+	require.NoError(t, err)
+	defer w.Close()
+
+	res, err := w.Read()
+	require.NoError(t, err)
+	require.Equal(t, gitdomain.RefChanges{StateToken: "abc:1"}, res)
+
+	res, err = w.Read()
+	require.NoError(t, err)
+	require.Equal(t, gitdomain.RefChanges{
+		Changes: []gitdomain.RefChange{
+			{Name: "refs/heads/main", CommitID: "deadbeef"},
+			{Name: "refs/heads/old"},
+		},
+		StateToken: "abc:2",
+	}, res)
+	require.True(t, res.Changes[1].Deleted())
+
+	_, err = w.Read()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestClient_WatchDefaultBranchCommits(t *testing.T) {
+	committedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			ss := NewMockGitserverService_WatchDefaultBranchCommitsClient()
+			ss.RecvFunc.SetDefaultReturn(nil, io.EOF)
+			ss.RecvFunc.PushReturn(&proto.WatchDefaultBranchCommitsResponse{HeadCommitSha: "c1"}, nil)
+			ss.RecvFunc.PushReturn(&proto.WatchDefaultBranchCommitsResponse{Commits: []*proto.BranchCommit{{CommitSha: "c2", CommittedAt: timestamppb.New(committedAt)}}}, nil)
+			ss.RecvFunc.PushReturn(&proto.WatchDefaultBranchCommitsResponse{Commits: []*proto.BranchCommit{{CommitSha: "c3", CommittedAt: timestamppb.New(committedAt)}}}, nil)
+			ss.RecvFunc.PushReturn(&proto.WatchDefaultBranchCommitsResponse{HeadCommitSha: "c3"}, nil)
+			c.WatchDefaultBranchCommitsFunc.SetDefaultReturn(ss, nil)
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	w, err := c.WatchDefaultBranchCommits(context.Background(), "repo")
+	require.NoError(t, err)
+	defer w.Close()
+
+	res, err := w.Read()
+	require.NoError(t, err)
+	require.Equal(t, gitdomain.BranchCommits{Head: "c1"}, res)
+
+	res, err = w.Read()
+	require.NoError(t, err)
+	require.Equal(t, gitdomain.BranchCommits{
+		Commits: []gitdomain.BranchCommit{
+			{ID: "c2", CommittedAt: committedAt},
+			{ID: "c3", CommittedAt: committedAt},
+		},
+		Head: "c3",
+	}, res)
+
+	_, err = w.Read()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestClient_FetchRefspec(t *testing.T) {
+	var got *proto.FetchRefspecRequest
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.FetchRefspecFunc.SetDefaultHook(func(_ context.Context, req *proto.FetchRefspecRequest, _ ...grpc.CallOption) (*proto.FetchRefspecResponse, error) {
+				got = req
+				if req.GetRefspec() == "refs/pull/1/head:refs/pull/1/head" {
+					s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "refs/pull/1/head"})
+					require.NoError(t, err)
+					return nil, s.Err()
+				}
+				return &proto.FetchRefspecResponse{}, nil
+			})
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	err := c.FetchRefspec(context.Background(), "repo", "+refs/pull/*/head:refs/pull/*/head")
+	require.NoError(t, err)
+	require.Equal(t, "repo", got.GetRepoName())
+	require.Equal(t, "+refs/pull/*/head:refs/pull/*/head", got.GetRefspec())
+
+	t.Run("missing ref", func(t *testing.T) {
+		err := c.FetchRefspec(context.Background(), "repo", "refs/pull/1/head:refs/pull/1/head")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+
+	t.Run("invalid refspec", func(t *testing.T) {
+		got = nil
+		err := c.FetchRefspec(context.Background(), "repo", "refs/pull/*/head:refs/heads/*")
+		require.True(t, errors.HasType(err, &gitdomain.InvalidRefspecError{}))
+		require.Nil(t, got)
+	})
+}
+
+func TestClient_OptimizeRepo(t *testing.T) {
+	var got *proto.OptimizeRepoRequest
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.OptimizeRepoFunc.SetDefaultHook(func(_ context.Context, req *proto.OptimizeRepoRequest, _ ...grpc.CallOption) (*proto.OptimizeRepoResponse, error) {
+				got = req
+				return &proto.OptimizeRepoResponse{Tasks: []*proto.MaintenanceTaskResult{
+					{Task: "repack", Duration: durationpb.New(2 * time.Second)},
+					{Task: "commit-graph", Duration: durationpb.New(time.Second)},
+				}}, nil
+			})
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	res, err := c.OptimizeRepo(context.Background(), "repo", MaintenanceOptions{Repack: true, CommitGraph: true})
+	require.NoError(t, err)
+	require.Equal(t, "repo", got.GetRepoName())
+	require.False(t, got.GetGc())
+	require.True(t, got.GetRepack())
+	require.True(t, got.GetCommitGraph())
+	require.Equal(t, []MaintenanceTaskResult{
+		{Task: "repack", Duration: 2 * time.Second},
+		{Task: "commit-graph", Duration: time.Second},
+	}, res)
+}
+
+func TestClient_CommitGraphStatus(t *testing.T) {
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.CommitGraphStatusFunc.SetDefaultReturn(&proto.CommitGraphStatusResponse{Present: true, Layers: 1, Commits: 3, GenerationVersion: 1}, nil)
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	status, err := c.CommitGraphStatus(context.Background(), "repo")
+	require.NoError(t, err)
+	require.Equal(t, gitdomain.CommitGraphStatus{Present: true, Layers: 1, Commits: 3, GenerationVersion: 1}, status)
+}
+
+func TestClient_CloneState(t *testing.T) {
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.CloneStateFunc.SetDefaultReturn(&proto.CloneStateResponse{Shallow: true, ShallowCommits: []string{"deadbeef"}, Depth: 1, Partial: true, Filter: "blob:none"}, nil)
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	state, err := c.CloneState(context.Background(), "repo")
+	require.NoError(t, err)
+	require.Equal(t, gitdomain.CloneState{Shallow: true, ShallowCommits: []api.CommitID{"deadbeef"}, Depth: 1, Partial: true, Filter: "blob:none"}, state)
+}
+
+func TestClient_ExecInWorktree(t *testing.T) {
+	var got *proto.ExecInWorktreeRequest
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.ExecInWorktreeFunc.SetDefaultHook(func(_ context.Context, req *proto.ExecInWorktreeRequest, _ ...grpc.CallOption) (*proto.ExecInWorktreeResponse, error) {
+				got = req
+				return &proto.ExecInWorktreeResponse{ExitStatus: 1, Stderr: []byte("error: patch failed"), OutputTruncated: true}, nil
+			})
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	res, err := c.ExecInWorktree(context.Background(), "repo", "deadbeef", []string{"apply", "--check"}, []byte("patch"))
+	require.NoError(t, err)
+	require.Equal(t, gitdomain.WorktreeExecResult{ExitStatus: 1, Stderr: []byte("error: patch failed"), Truncated: true}, res)
+	require.Equal(t, "deadbeef", got.GetCommitSha())
+	require.Equal(t, [][]byte{[]byte("apply"), []byte("--check")}, got.GetArgs())
+	require.Equal(t, "patch", string(got.GetStdin()))
+}
+
+func TestClient_RebasePreview(t *testing.T) {
+	var got *proto.RebasePreviewRequest
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.RebasePreviewFunc.SetDefaultHook(func(_ context.Context, req *proto.RebasePreviewRequest, _ ...grpc.CallOption) (*proto.RebasePreviewResponse, error) {
+				got = req
+				return &proto.RebasePreviewResponse{
+					CommitShas:           []string{"cafe"},
+					ConflictingCommitSha: "head",
+					ConflictingPaths:     [][]byte{[]byte("a.txt")},
+				}, nil
+			})
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	res, err := c.RebasePreview(context.Background(), "repo", "base", "head", "onto")
+	require.NoError(t, err)
+	require.Equal(t, gitdomain.RebasePreview{
+		Commits:           []api.CommitID{"cafe"},
+		ConflictingCommit: "head",
+		ConflictingPaths:  []string{"a.txt"},
+	}, res)
+	require.Equal(t, "base", got.GetBaseCommitSha())
+	require.Equal(t, "head", got.GetHeadCommitSha())
+	require.Equal(t, "onto", got.GetOntoCommitSha())
+}
+
+func TestClient_RevertCommit(t *testing.T) {
+	t.Run("creates commit", func(t *testing.T) {
+		var got *proto.RevertCommitRequest
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.RevertCommitFunc.SetDefaultHook(func(_ context.Context, req *proto.RevertCommitRequest, _ ...grpc.CallOption) (*proto.RevertCommitResponse, error) {
+					got = req
+					return &proto.RevertCommitResponse{CommitSha: "revertsha"}, nil
+				})
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		sha, err := c.ResolveRevision(context.Background(), "repo", "HEAD", ResolveRevisionOptions{})
+		date := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		commit, err := c.RevertCommit(context.Background(), "repo", "deadbeef", RevertCommitOptions{
+			Onto:    "main",
+			Message: "Revert the thing",
+			Author:  gitdomain.Signature{Name: "Jane Doe", Email: "jane@example.com", Date: date},
+		})
 		require.NoError(t, err)
-		require.Equal(t, api.CommitID("deadbeef"), sha)
+		require.Equal(t, api.CommitID("revertsha"), commit)
+		require.Equal(t, "deadbeef", got.GetCommitSha())
+		require.Equal(t, "main", string(got.GetOnto()))
+		require.Equal(t, "Revert the thing", string(got.GetMessage()))
+		require.Equal(t, "Jane Doe", string(got.GetAuthor().GetName()))
+		require.Equal(t, date, got.GetAuthor().GetDate().AsTime())
+		require.Nil(t, got.GetCommitter())
 	})
-	t.Run("returns common errors correctly", func(t *testing.T) {
-		calls := 0
+	t.Run("conflict", func(t *testing.T) {
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				if calls == 0 {
-					s, err := status.New(codes.NotFound, "bad revision").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "deadbeef"})
-					require.NoError(t, err)
-					c.ResolveRevisionFunc.PushReturn(nil, s.Err())
-					calls++
-					return c
-				}
-				s, err := status.New(codes.NotFound, "repo cloning").WithDetails(&proto.RepoNotFoundPayload{Repo: "repo", CloneInProgress: true})
+				s, err := status.New(codes.FailedPrecondition, "changes conflict").WithDetails(&proto.MergeConflictPayload{
+					RepoName:  "repo",
+					CommitSha: "deadbeef",
+					Paths:     [][]byte{[]byte("a.txt")},
+				})
 				require.NoError(t, err)
-				c.ResolveRevisionFunc.PushReturn(nil, s.Err())
+				c.RevertCommitFunc.SetDefaultReturn(nil, s.Err())
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		// First request fails with revision error
-		_, err := c.ResolveRevision(context.Background(), "repo", "HEAD", ResolveRevisionOptions{})
-		require.Error(t, err)
-		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
-		// First request fails with clone error
-		_, err = c.ResolveRevision(context.Background(), "repo", "HEAD", ResolveRevisionOptions{})
-		require.Error(t, err)
-		require.True(t, errors.HasType(err, &gitdomain.RepoNotExistError{}))
+		_, err := c.RevertCommit(context.Background(), "repo", "deadbeef", RevertCommitOptions{})
+		var e *gitdomain.MergeConflictError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, &gitdomain.MergeConflictError{Repo: "repo", Commit: "deadbeef", Paths: []string{"a.txt"}}, e)
 	})
 }
 
-func TestClient_RevAtTime(t *testing.T) {
-	t.Run("correctly returns server response", func(t *testing.T) {
+func TestClient_CherryPick(t *testing.T) {
+	t.Run("creates commit", func(t *testing.T) {
+		var got *proto.CherryPickRequest
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				c.RevAtTimeFunc.SetDefaultReturn(&proto.RevAtTimeResponse{CommitSha: "deadbeef"}, nil)
+				c.CherryPickFunc.SetDefaultHook(func(_ context.Context, req *proto.CherryPickRequest, _ ...grpc.CallOption) (*proto.CherryPickResponse, error) {
+					got = req
+					return &proto.CherryPickResponse{CommitSha: "picksha"}, nil
+				})
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		sha, found, err := c.RevAtTime(context.Background(), "repo", "HEAD", time.Now())
+		commit, err := c.CherryPick(context.Background(), "repo", "deadbeef", "release/1.0", CherryPickOptions{
+			Mainline:     1,
+			RecordOrigin: true,
+			Committer:    gitdomain.Signature{Name: "Bot", Email: "bot@example.com"},
+		})
 		require.NoError(t, err)
-		require.True(t, found)
-		require.Equal(t, api.CommitID("deadbeef"), sha)
+		require.Equal(t, api.CommitID("picksha"), commit)
+		require.Equal(t, "deadbeef", got.GetCommitSha())
+		require.Equal(t, "release/1.0", string(got.GetOnto()))
+		require.Equal(t, int32(1), got.GetMainline())
+		require.True(t, got.GetRecordOrigin())
+		require.Empty(t, got.GetMessage())
+		require.Equal(t, "Bot", string(got.GetCommitter().GetName()))
+		require.Nil(t, got.GetCommitter().GetDate())
 	})
+	t.Run("conflict", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.FailedPrecondition, "changes conflict").WithDetails(&proto.MergeConflictPayload{
+					RepoName:  "repo",
+					CommitSha: "deadbeef",
+					Paths:     [][]byte{[]byte("a.txt"), []byte("b.txt")},
+				})
+				require.NoError(t, err)
+				c.CherryPickFunc.SetDefaultReturn(nil, s.Err())
+				return c
+			}
+		})
 
-	t.Run("correctly returns not found on empty sha", func(t *testing.T) {
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, err := c.CherryPick(context.Background(), "repo", "deadbeef", "release/1.0", CherryPickOptions{})
+		var e *gitdomain.MergeConflictError
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, []string{"a.txt", "b.txt"}, e.Paths)
+	})
+}
+
+func TestClient_SquashCommits(t *testing.T) {
+	var got *proto.SquashCommitsRequest
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.SquashCommitsFunc.SetDefaultHook(func(_ context.Context, req *proto.SquashCommitsRequest, _ ...grpc.CallOption) (*proto.SquashCommitsResponse, error) {
+				got = req
+				return &proto.SquashCommitsResponse{CommitSha: "squashsha"}, nil
+			})
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	commit, err := c.SquashCommits(context.Background(), "repo", "basesha", "headsha", SquashCommitsOptions{
+		Message:   "Squashed",
+		Committer: gitdomain.Signature{Name: "Bot", Email: "bot@example.com"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, api.CommitID("squashsha"), commit)
+	require.Equal(t, "basesha", got.GetBaseCommitSha())
+	require.Equal(t, "headsha", got.GetHeadCommitSha())
+	require.Equal(t, "Squashed", string(got.GetMessage()))
+	require.Nil(t, got.GetAuthor())
+	require.Equal(t, "Bot", string(got.GetCommitter().GetName()))
+
+	author := gitdomain.Signature{Name: "Jane Doe", Email: "jane@example.com"}
+	_, err = c.SquashCommits(context.Background(), "repo", "basesha", "headsha", SquashCommitsOptions{Author: &author})
+	require.NoError(t, err)
+	require.Equal(t, "Jane Doe", string(got.GetAuthor().GetName()))
+}
+
+func TestClient_WriteCommitGraph(t *testing.T) {
+	var got *proto.OptimizeRepoRequest
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.OptimizeRepoFunc.SetDefaultHook(func(_ context.Context, req *proto.OptimizeRepoRequest, _ ...grpc.CallOption) (*proto.OptimizeRepoResponse, error) {
+				got = req
+				return &proto.OptimizeRepoResponse{}, nil
+			})
+			return c
+		}
+	})
+
+	c := NewTestClient(t).WithClientSource(source)
+
+	require.NoError(t, c.WriteCommitGraph(context.Background(), "repo"))
+	require.True(t, got.GetCommitGraph())
+	require.False(t, got.GetGc())
+	require.False(t, got.GetRepack())
+}
+
+func TestClient_CreateBranch(t *testing.T) {
+	t.Run("sends request", func(t *testing.T) {
+		var got *proto.CreateBranchRequest
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				c.RevAtTimeFunc.SetDefaultReturn(&proto.RevAtTimeResponse{CommitSha: ""}, nil)
+				c.CreateBranchFunc.SetDefaultHook(func(_ context.Context, req *proto.CreateBranchRequest, _ ...grpc.CallOption) (*proto.CreateBranchResponse, error) {
+					got = req
+					return &proto.CreateBranchResponse{CommitSha: "deadbeef"}, nil
+				})
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		_, found, err := c.RevAtTime(context.Background(), "repo", "HEAD", time.Now())
+		commit, err := c.CreateBranch(context.Background(), "repo", "feature", "main")
 		require.NoError(t, err)
-		require.False(t, found)
+		require.Equal(t, api.CommitID("deadbeef"), commit)
+		require.Equal(t, "repo", got.GetRepoName())
+		require.Equal(t, "feature", got.GetBranchName())
+		require.Equal(t, []byte("main"), got.GetStartPoint())
 	})
+	t.Run("branch exists", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.FailedPrecondition, "branch already exists").WithDetails(&proto.RefUpdateConflictPayload{RepoName: "repo", RefName: "refs/heads/feature"})
+				require.NoError(t, err)
+				c.CreateBranchFunc.SetDefaultReturn(nil, s.Err())
+				return c
+			}
+		})
 
-	t.Run("returns common errors correctly", func(t *testing.T) {
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, err := c.CreateBranch(context.Background(), "repo", "feature", "")
+		require.Error(t, err)
+		require.True(t, errors.HasType(err, &gitdomain.RefUpdateConflictError{}))
+	})
+}
+
+func TestClient_DeleteBranch(t *testing.T) {
+	t.Run("sends request", func(t *testing.T) {
+		var got *proto.DeleteBranchRequest
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{
-					Repo: "repo",
-					Spec: "HEAD",
+				c.DeleteBranchFunc.SetDefaultHook(func(_ context.Context, req *proto.DeleteBranchRequest, _ ...grpc.CallOption) (*proto.DeleteBranchResponse, error) {
+					got = req
+					return &proto.DeleteBranchResponse{}, nil
 				})
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		require.NoError(t, c.DeleteBranch(context.Background(), "repo", "feature"))
+		require.Equal(t, "repo", got.GetRepoName())
+		require.Equal(t, "feature", got.GetBranchName())
+	})
+	t.Run("branch not found", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "refs/heads/feature"})
 				require.NoError(t, err)
-				c.RevAtTimeFunc.PushReturn(nil, s.Err())
+				c.DeleteBranchFunc.SetDefaultReturn(nil, s.Err())
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		_, _, err := c.RevAtTime(context.Background(), "repo", "HEAD", time.Now())
+		err := c.DeleteBranch(context.Background(), "repo", "feature")
 		require.Error(t, err)
 		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
 	})
 }
 
-func TestClient_ListRefs(t *testing.T) {
-	t.Run("correctly returns server response", func(t *testing.T) {
-		now := time.Now().UTC()
+func TestClient_CreateTag(t *testing.T) {
+	t.Run("sends request", func(t *testing.T) {
+		var got *proto.CreateTagRequest
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				ss := NewMockGitserverService_ListRefsClient()
-				ss.RecvFunc.SetDefaultReturn(nil, io.EOF)
-				ss.RecvFunc.PushReturn(&proto.ListRefsResponse{Refs: []*proto.GitRef{
-					{
-						RefName:      "refs/heads/master",
-						TargetCommit: "deadbeef",
-						CreatedAt:    timestamppb.New(now),
-					},
-				}}, nil)
-				c.ListRefsFunc.SetDefaultReturn(ss, nil)
+				c.CreateTagFunc.SetDefaultHook(func(_ context.Context, req *proto.CreateTagRequest, _ ...grpc.CallOption) (*proto.CreateTagResponse, error) {
+					got = req
+					return &proto.CreateTagResponse{}, nil
+				})
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		refs, err := c.ListRefs(context.Background(), "repo", ListRefsOpts{})
+		date := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+		err := c.CreateTag(context.Background(), "repo", "v1", "main", TagOptions{
+			Message: "Release v1",
+			Tagger:  &gitdomain.Signature{Name: "Release Bot", Email: "release@sourcegraph.com", Date: date},
+			Sign:    true,
+		})
 		require.NoError(t, err)
-		require.Equal(t, []gitdomain.Ref{
-			{
-				Name:        "refs/heads/master",
-				CommitID:    "deadbeef",
-				CreatedDate: now,
-			},
-		}, refs)
+		require.Equal(t, "repo", got.GetRepoName())
+		require.Equal(t, "v1", got.GetTagName())
+		require.Equal(t, []byte("main"), got.GetTarget())
+		require.Equal(t, []byte("Release v1"), got.GetMessage())
+		require.Equal(t, []byte("Release Bot"), got.GetTagger().GetName())
+		require.Equal(t, []byte("release@sourcegraph.com"), got.GetTagger().GetEmail())
+		require.Equal(t, date, got.GetTagger().GetDate().AsTime())
+		require.True(t, got.GetSign())
 	})
-	t.Run("returns well known error types", func(t *testing.T) {
+	t.Run("lightweight", func(t *testing.T) {
+		var got *proto.CreateTagRequest
 		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
 			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
 				c := NewMockGitserverServiceClient()
-				s, err := status.New(codes.NotFound, "repo cloning").WithDetails(&proto.RepoNotFoundPayload{Repo: "repo", CloneInProgress: true})
+				c.CreateTagFunc.SetDefaultHook(func(_ context.Context, req *proto.CreateTagRequest, _ ...grpc.CallOption) (*proto.CreateTagResponse, error) {
+					got = req
+					return &proto.CreateTagResponse{}, nil
+				})
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		require.NoError(t, c.CreateTag(context.Background(), "repo", "v1", "main", TagOptions{}))
+		require.Empty(t, got.GetMessage())
+		require.Nil(t, got.GetTagger())
+		require.False(t, got.GetSign())
+	})
+	t.Run("tag exists", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.FailedPrecondition, "tag already exists").WithDetails(&proto.RefUpdateConflictPayload{RepoName: "repo", RefName: "refs/tags/v1"})
 				require.NoError(t, err)
-				c.ListRefsFunc.PushReturn(nil, s.Err())
+				c.CreateTagFunc.SetDefaultReturn(nil, s.Err())
 				return c
 			}
 		})
 
 		c := NewTestClient(t).WithClientSource(source)
 
-		// Should fail with clone error
-		_, err := c.ListRefs(context.Background(), "repo", ListRefsOpts{})
+		err := c.CreateTag(context.Background(), "repo", "v1", "main", TagOptions{})
 		require.Error(t, err)
-		require.True(t, errors.HasType(err, &gitdomain.RepoNotExistError{}))
+		require.True(t, errors.HasType(err, &gitdomain.RefUpdateConflictError{}))
+	})
+}
+
+func TestClient_GetTag(t *testing.T) {
+	t.Run("returns tag", func(t *testing.T) {
+		want := &gitdomain.Tag{
+			Name:      "v1",
+			OID:       "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
+			Target:    gitdomain.GitObject{ID: gitdomain.OID{1}, Type: gitdomain.ObjectTypeCommit},
+			Tagger:    &gitdomain.Signature{Name: "Release Bot", Email: "release@sourcegraph.com", Date: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+			Message:   "Release v1\n",
+			Signature: "-----BEGIN SSH SIGNATURE-----\n-----END SSH SIGNATURE-----\n",
+		}
+		var got *proto.GetTagRequest
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.GetTagFunc.SetDefaultHook(func(_ context.Context, req *proto.GetTagRequest, _ ...grpc.CallOption) (*proto.GetTagResponse, error) {
+					got = req
+					return &proto.GetTagResponse{Tag: want.ToProto()}, nil
+				})
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		tag, err := c.GetTag(context.Background(), "repo", "v1")
+		require.NoError(t, err)
+		require.Equal(t, want, tag)
+		require.Equal(t, "repo", got.GetRepoName())
+		require.Equal(t, "v1", got.GetTagName())
+	})
+	t.Run("lightweight", func(t *testing.T) {
+		want := &gitdomain.Tag{
+			Name:   "v1",
+			Target: gitdomain.GitObject{ID: gitdomain.OID{1}, Type: gitdomain.ObjectTypeCommit},
+		}
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.GetTagFunc.SetDefaultReturn(&proto.GetTagResponse{Tag: want.ToProto()}, nil)
+				return c
+			}
+		})
+
+		tag, err := NewTestClient(t).WithClientSource(source).GetTag(context.Background(), "repo", "v1")
+		require.NoError(t, err)
+		require.Equal(t, want, tag)
+	})
+	t.Run("not found", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.NotFound, "tag not found").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "refs/tags/v1"})
+				require.NoError(t, err)
+				c.GetTagFunc.SetDefaultReturn(nil, s.Err())
+				return c
+			}
+		})
+
+		_, err := NewTestClient(t).WithClientSource(source).GetTag(context.Background(), "repo", "v1")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+}
+
+func TestClient_BlameSummary(t *testing.T) {
+	t.Run("returns authors", func(t *testing.T) {
+		date := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+		var got *proto.BlameSummaryRequest
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.BlameSummaryFunc.SetDefaultHook(func(_ context.Context, req *proto.BlameSummaryRequest, _ ...grpc.CallOption) (*proto.BlameSummaryResponse, error) {
+					got = req
+					return &proto.BlameSummaryResponse{
+						Authors: []*proto.BlameAuthorSummary{
+							{Name: "Foo", Email: "foo@sourcegraph.com", LineCount: 10, LastTouched: timestamppb.New(date)},
+						},
+					}, nil
+				})
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		authors, err := c.BlameSummary(context.Background(), "repo", "deadbeef", "dir")
+		require.NoError(t, err)
+		require.Equal(t, "repo", got.GetRepoName())
+		require.Equal(t, "deadbeef", got.GetCommit())
+		require.Equal(t, "dir", got.GetPath())
+		require.Equal(t, []*gitdomain.BlameAuthorSummary{
+			{Name: "Foo", Email: "foo@sourcegraph.com", LineCount: 10, LastTouched: date},
+		}, authors)
+	})
+	t.Run("file not found", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.NotFound, "file not found").WithDetails(&proto.FileNotFoundPayload{Repo: "repo", Commit: "deadbeef", Path: "nope"})
+				require.NoError(t, err)
+				c.BlameSummaryFunc.SetDefaultReturn(nil, s.Err())
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, err := c.BlameSummary(context.Background(), "repo", "deadbeef", "nope")
+		require.True(t, os.IsNotExist(err))
+	})
+	t.Run("permission denied", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				c.BlameSummaryFunc.SetDefaultReturn(nil, status.New(codes.PermissionDenied, "no access to path").Err())
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, err := c.BlameSummary(context.Background(), "repo", "deadbeef", "secret")
+		require.True(t, os.IsNotExist(err))
+	})
+	t.Run("revision not found", func(t *testing.T) {
+		source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+			o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+				c := NewMockGitserverServiceClient()
+				s, err := status.New(codes.NotFound, "revision not found").WithDetails(&proto.RevisionNotFoundPayload{Repo: "repo", Spec: "deadbeef"})
+				require.NoError(t, err)
+				c.BlameSummaryFunc.SetDefaultReturn(nil, s.Err())
+				return c
+			}
+		})
+
+		c := NewTestClient(t).WithClientSource(source)
+
+		_, err := c.BlameSummary(context.Background(), "repo", "deadbeef", "")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
 	})
 }
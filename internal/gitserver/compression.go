@@ -0,0 +1,144 @@
+package gitserver
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+	"github.com/sourcegraph/sourcegraph/internal/grpc/compression"
+)
+
+// capabilitiesTTL is how long the capabilities of a gitserver are cached.
+// gitservers are rolled out one by one, so this bounds how long we keep
+// using a stale answer during an upgrade or rollback.
+const capabilitiesTTL = 5 * time.Minute
+
+// WithCompression returns a new client that compresses the potentially large
+// ReadFile, Archive and diff streams with the given gRPC compressor, such as
+// compression.Gzip or compression.Zstd. Compression is only used for
+// gitservers that report support for the compressor.
+func (c *clientImplementor) WithCompression(compressor string) Client {
+	if !slices.Contains(compression.Supported(), compressor) {
+		return c
+	}
+	return &clientImplementor{
+		logger:              c.logger,
+		scope:               c.scope,
+		operations:          c.operations,
+		subRepoPermsChecker: c.subRepoPermsChecker,
+		clientSource: &compressionClientSource{
+			ClientSource: c.clientSource,
+			compressor:   compressor,
+			capabilities: &capabilitiesCache{
+				entries: make(map[string]capabilitiesEntry),
+				now:     time.Now,
+			},
+		},
+	}
+}
+
+// compressionClientSource wraps the clients returned by a ClientSource, so
+// that they compress large streams.
+type compressionClientSource struct {
+	ClientSource
+	compressor   string
+	capabilities *capabilitiesCache
+}
+
+func (s *compressionClientSource) ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error) {
+	client, err := s.ClientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	return &compressionClient{
+		GitserverServiceClient: client,
+		compressor:             s.compressor,
+		addr:                   s.AddrForRepo(ctx, repo),
+		capabilities:           s.capabilities,
+	}, nil
+}
+
+// compressionClient enables compression for the streaming RPCs that can
+// return large amounts of data. All other RPCs are passed through unchanged.
+type compressionClient struct {
+	proto.GitserverServiceClient
+	compressor   string
+	addr         string
+	capabilities *capabilitiesCache
+}
+
+func (c *compressionClient) ReadFile(ctx context.Context, in *proto.ReadFileRequest, opts ...grpc.CallOption) (proto.GitserverService_ReadFileClient, error) {
+	return c.GitserverServiceClient.ReadFile(ctx, in, c.withCompressor(ctx, opts)...)
+}
+
+func (c *compressionClient) Archive(ctx context.Context, in *proto.ArchiveRequest, opts ...grpc.CallOption) (proto.GitserverService_ArchiveClient, error) {
+	return c.GitserverServiceClient.Archive(ctx, in, c.withCompressor(ctx, opts)...)
+}
+
+func (c *compressionClient) Exec(ctx context.Context, in *proto.ExecRequest, opts ...grpc.CallOption) (proto.GitserverService_ExecClient, error) {
+	// Most commands have small outputs, only diffs are worth compressing.
+	if args := in.GetArgs(); len(args) > 0 && string(args[0]) == "diff" {
+		opts = c.withCompressor(ctx, opts)
+	}
+	return c.GitserverServiceClient.Exec(ctx, in, opts...)
+}
+
+func (c *compressionClient) withCompressor(ctx context.Context, opts []grpc.CallOption) []grpc.CallOption {
+	if !slices.Contains(c.capabilities.compressors(ctx, c.addr, c.GitserverServiceClient), c.compressor) {
+		return opts
+	}
+	return append(opts, grpc.UseCompressor(c.compressor))
+}
+
+// capabilitiesCache caches the result of the Capabilities RPC per gitserver
+// address.
+type capabilitiesCache struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]capabilitiesEntry
+}
+
+type capabilitiesEntry struct {
+	compressors []string
+	expires     time.Time
+}
+
+// compressors returns the compressors supported by the gitserver at addr,
+// using client to ask it on a cache miss. If the gitserver can't be asked, no
+// compressors are returned, so that callers fall back to not compressing.
+func (c *capabilitiesCache) compressors(ctx context.Context, addr string, client proto.GitserverServiceClient) []string {
+	c.mu.Lock()
+	e, ok := c.entries[addr]
+	c.mu.Unlock()
+	if ok && c.now().Before(e.expires) {
+		return e.compressors
+	}
+
+	res, err := client.Capabilities(ctx, &proto.CapabilitiesRequest{})
+	if err != nil {
+		if status.Code(err) != codes.Unimplemented {
+			// Don't cache the error, the next request will try again.
+			return nil
+		}
+		// An older gitserver that doesn't support any of the optional
+		// features.
+		res = &proto.CapabilitiesResponse{}
+	}
+
+	c.mu.Lock()
+	c.entries[addr] = capabilitiesEntry{
+		compressors: res.GetCompressors(),
+		expires:     c.now().Add(capabilitiesTTL),
+	}
+	c.mu.Unlock()
+
+	return res.GetCompressors()
+}
@@ -0,0 +1,9 @@
+package gitserver
+
+import (
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrNotModified is returned by NewFileReaderIfNotOID if the blob of the file
+// still has the given object ID.
+var ErrNotModified = errors.New("file not modified")
@@ -0,0 +1,131 @@
+package gitserver
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// defaultEnsureRevisionPollInterval is how often EnsureRevision checks for the
+// revision while waiting, unless WaitOptions.PollInterval is set.
+const defaultEnsureRevisionPollInterval = time.Second
+
+// WaitOptions configures how long EnsureRevision waits for a revision to
+// become available.
+type WaitOptions struct {
+	// Timeout is how long to wait for the revision while the repository is
+	// cloned or fetched. Zero means not to wait: the revision is fetched if
+	// it's missing, but only checked for once.
+	Timeout time.Duration
+	// PollInterval is how often to check for the revision while waiting.
+	// Defaults to one second.
+	PollInterval time.Duration
+	// OnProgress, if set, is called after every unsuccessful check while
+	// waiting.
+	OnProgress func(EnsureRevisionProgress)
+}
+
+// EnsureRevisionProgress describes the state of the repository while
+// EnsureRevision is waiting for a revision.
+type EnsureRevisionProgress struct {
+	// Attempts is the number of times the revision was checked for.
+	Attempts int
+	// Waited is the time spent waiting so far.
+	Waited time.Duration
+	// CloneInProgress is true if the repository is being cloned.
+	CloneInProgress bool
+	// CloneProgress is the last progress message of the running clone.
+	CloneProgress string
+}
+
+// EnsureRevisionResult is the result of EnsureRevision.
+type EnsureRevisionResult struct {
+	// CommitID is the commit the revision resolved to. It is empty if the
+	// revision didn't become available in time.
+	CommitID api.CommitID
+	// Progress is the state of the repository at the last check.
+	Progress EnsureRevisionProgress
+}
+
+func (c *clientImplementor) EnsureRevision(ctx context.Context, repo api.RepoName, rev string, opts WaitOptions) (_ *EnsureRevisionResult, err error) {
+	ctx, _, endObservation := c.operations.ensureRevision.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			attribute.String("rev", rev),
+			attribute.Stringer("timeout", opts.Timeout),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	return ensureRevision(ctx, c, repo, rev, opts)
+}
+
+// ensureRevision implements EnsureRevision on top of ResolveRevision and
+// RequestRepoUpdate, so that all implementations of Client behave the same.
+func ensureRevision(ctx context.Context, c Client, repo api.RepoName, rev string, opts WaitOptions) (*EnsureRevisionResult, error) {
+	if opts.Timeout < 0 {
+		return nil, errors.New("timeout must not be negative")
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = defaultEnsureRevisionPollInterval
+	}
+
+	start := time.Now()
+	res := &EnsureRevisionResult{}
+	requestedClone := false
+	for {
+		res.Progress.Attempts++
+		// gitserver fetches the revision if the repository is cloned but
+		// doesn't contain it yet. Concurrent fetches of the same repository
+		// are coalesced, so checking again doesn't cause more fetches than
+		// gitserver runs anyway.
+		commit, err := c.ResolveRevision(ctx, repo, rev, ResolveRevisionOptions{EnsureRevision: true})
+		res.Progress.Waited = time.Since(start)
+		if err == nil {
+			res.CommitID = commit
+			res.Progress.CloneInProgress, res.Progress.CloneProgress = false, ""
+			return res, nil
+		}
+
+		var notExist *gitdomain.RepoNotExistError
+		switch {
+		case errors.As(err, &notExist):
+			res.Progress.CloneInProgress = notExist.CloneInProgress
+			res.Progress.CloneProgress = notExist.CloneProgress
+			// gitserver only clones some repositories on demand, so make sure a
+			// clone is started, but only once.
+			if !notExist.CloneInProgress && !requestedClone {
+				requestedClone = true
+				if _, err := c.RequestRepoUpdate(ctx, repo); err != nil {
+					return res, err
+				}
+			}
+		case errors.HasType(err, &gitdomain.RevisionNotFoundError{}):
+			res.Progress.CloneInProgress, res.Progress.CloneProgress = false, ""
+		default:
+			return res, err
+		}
+
+		remaining := opts.Timeout - res.Progress.Waited
+		if remaining <= 0 {
+			return res, err
+		}
+		if opts.OnProgress != nil {
+			opts.OnProgress(res.Progress)
+		}
+
+		select {
+		case <-ctx.Done():
+			return res, ctx.Err()
+		case <-time.After(min(interval, remaining)):
+		}
+	}
+}
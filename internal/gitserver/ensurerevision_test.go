@@ -0,0 +1,102 @@
+package gitserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestEnsureRevision(t *testing.T) {
+	ctx := context.Background()
+	notFound := &gitdomain.RevisionNotFoundError{Repo: "repo", Spec: "feature"}
+
+	t.Run("found", func(t *testing.T) {
+		c := NewMockClient()
+		c.ResolveRevisionFunc.SetDefaultReturn("deadbeef", nil)
+
+		res, err := ensureRevision(ctx, c, "repo", "feature", WaitOptions{})
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("deadbeef"), res.CommitID)
+		require.Equal(t, 1, res.Progress.Attempts)
+		history := c.ResolveRevisionFunc.History()
+		require.Len(t, history, 1)
+		require.Equal(t, "feature", history[0].Arg2)
+		require.True(t, history[0].Arg3.EnsureRevision)
+		require.Empty(t, c.RequestRepoUpdateFunc.History())
+	})
+
+	t.Run("doesn't wait without timeout", func(t *testing.T) {
+		c := NewMockClient()
+		c.ResolveRevisionFunc.SetDefaultReturn("", notFound)
+
+		res, err := ensureRevision(ctx, c, "repo", "feature", WaitOptions{})
+		require.ErrorIs(t, err, notFound)
+		require.Empty(t, res.CommitID)
+		require.Equal(t, 1, res.Progress.Attempts)
+	})
+
+	t.Run("clones and waits", func(t *testing.T) {
+		c := NewMockClient()
+		c.ResolveRevisionFunc.PushReturn("", &gitdomain.RepoNotExistError{Repo: "repo"})
+		c.ResolveRevisionFunc.PushReturn("", &gitdomain.RepoNotExistError{Repo: "repo", CloneInProgress: true, CloneProgress: "Receiving objects: 50%"})
+		c.ResolveRevisionFunc.PushReturn("", notFound)
+		c.ResolveRevisionFunc.SetDefaultReturn("deadbeef", nil)
+		c.RequestRepoUpdateFunc.SetDefaultReturn(&protocol.RepoUpdateResponse{}, nil)
+
+		var progress []EnsureRevisionProgress
+		res, err := ensureRevision(ctx, c, "repo", "feature", WaitOptions{
+			Timeout:      time.Minute,
+			PollInterval: time.Millisecond,
+			OnProgress: func(p EnsureRevisionProgress) {
+				progress = append(progress, p)
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("deadbeef"), res.CommitID)
+		require.Equal(t, 4, res.Progress.Attempts)
+		require.False(t, res.Progress.CloneInProgress)
+
+		require.Len(t, progress, 3)
+		require.False(t, progress[0].CloneInProgress)
+		require.True(t, progress[1].CloneInProgress)
+		require.Equal(t, "Receiving objects: 50%", progress[1].CloneProgress)
+		require.False(t, progress[2].CloneInProgress)
+		require.Len(t, c.RequestRepoUpdateFunc.History(), 1)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		c := NewMockClient()
+		c.ResolveRevisionFunc.SetDefaultReturn("", notFound)
+
+		res, err := ensureRevision(ctx, c, "repo", "feature", WaitOptions{Timeout: 20 * time.Millisecond, PollInterval: time.Millisecond})
+		require.ErrorIs(t, err, notFound)
+		require.Greater(t, res.Progress.Attempts, 1)
+		require.GreaterOrEqual(t, res.Progress.Waited, 20*time.Millisecond)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		c := NewMockClient()
+		c.ResolveRevisionFunc.SetDefaultReturn("", notFound)
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := ensureRevision(ctx, c, "repo", "feature", WaitOptions{Timeout: time.Minute})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("other errors are returned", func(t *testing.T) {
+		c := NewMockClient()
+		c.ResolveRevisionFunc.SetDefaultReturn("", errors.New("boom"))
+
+		_, err := ensureRevision(ctx, c, "repo", "feature", WaitOptions{Timeout: time.Minute})
+		require.ErrorContains(t, err, "boom")
+		require.Len(t, c.ResolveRevisionFunc.History(), 1)
+	})
+}
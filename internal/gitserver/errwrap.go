@@ -29,16 +29,52 @@ func convertGRPCErrorToGitDomainError(err error) error {
 		return context.DeadlineExceeded
 	}
 
+	// A failed command can come with a typed error parsed from its stderr,
+	// which becomes the cause of the CommandStatusError.
+	var commandErr *CommandStatusError
+	var cause error
 	for _, detail := range st.Details() {
 		switch payload := detail.(type) {
 
 		case *proto.ExecStatusPayload:
-			return &CommandStatusError{
+			commandErr = &CommandStatusError{
 				Message:    st.Message(),
 				Stderr:     payload.GetStderr(),
 				StatusCode: payload.GetStatusCode(),
 			}
 
+		case *proto.AmbiguousRevisionPayload:
+			e := &gitdomain.AmbiguousRevisionError{
+				Repo: api.RepoName(payload.GetRepoName()),
+				Spec: payload.GetSpec(),
+			}
+			for _, c := range payload.GetCandidates() {
+				e.Candidates = append(e.Candidates, gitdomain.AmbiguousRevisionCandidate{
+					ID:          c.GetId(),
+					Type:        gitdomain.ObjectType(c.GetType()),
+					Description: c.GetDescription(),
+				})
+			}
+			cause = e
+
+		case *proto.BadObjectPayload:
+			cause = &gitdomain.BadObjectError{
+				Repo:   api.RepoName(payload.GetRepoName()),
+				Object: payload.GetObject(),
+			}
+
+		case *proto.ShallowHistoryPayload:
+			cause = &gitdomain.ShallowHistoryError{
+				Repo:   api.RepoName(payload.GetRepoName()),
+				Commit: api.CommitID(payload.GetCommitSha()),
+			}
+
+		case *proto.HookPermissionDeniedPayload:
+			cause = &gitdomain.HookPermissionDeniedError{
+				Repo: api.RepoName(payload.GetRepoName()),
+				Hook: payload.GetHook(),
+			}
+
 		case *proto.RepoNotFoundPayload:
 			return &gitdomain.RepoNotExistError{
 				Repo:            api.RepoName(payload.GetRepo()),
@@ -51,9 +87,33 @@ func convertGRPCErrorToGitDomainError(err error) error {
 				Repo: api.RepoName(payload.GetRepo()),
 				Spec: payload.GetSpec(),
 			}
+
+		case *proto.MergeConflictPayload:
+			e := &gitdomain.MergeConflictError{
+				Repo:   api.RepoName(payload.GetRepoName()),
+				Commit: api.CommitID(payload.GetCommitSha()),
+			}
+			for _, p := range payload.GetPaths() {
+				e.Paths = append(e.Paths, string(p))
+			}
+			return e
+
+		case *proto.RefUpdateConflictPayload:
+			return &gitdomain.RefUpdateConflictError{
+				Repo:           api.RepoName(payload.GetRepoName()),
+				Ref:            payload.GetRefName(),
+				ExpectedOldOID: api.CommitID(payload.GetExpectedOldCommitSha()),
+			}
 		}
 	}
 
+	if commandErr != nil {
+		commandErr.Cause = cause
+		return commandErr
+	}
+	if cause != nil {
+		return cause
+	}
 	return err
 }
 
@@ -61,6 +121,13 @@ type CommandStatusError struct {
 	Message    string
 	StatusCode int32
 	Stderr     string
+	// Cause is the typed error parsed from Stderr, like a
+	// *gitdomain.BadObjectError, or nil.
+	Cause error
+}
+
+func (c *CommandStatusError) Unwrap() error {
+	return c.Cause
 }
 
 func (c *CommandStatusError) Error() string {
@@ -132,6 +199,11 @@ func (r *errorTranslatingClient) DiskInfo(ctx context.Context, in *proto.DiskInf
 	return res, convertGRPCErrorToGitDomainError(err)
 }
 
+func (r *errorTranslatingClient) Capabilities(ctx context.Context, in *proto.CapabilitiesRequest, opts ...grpc.CallOption) (*proto.CapabilitiesResponse, error) {
+	res, err := r.base.Capabilities(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
 func (r *errorTranslatingClient) GetObject(ctx context.Context, in *proto.GetObjectRequest, opts ...grpc.CallOption) (*proto.GetObjectResponse, error) {
 	res, err := r.base.GetObject(ctx, in, opts...)
 	return res, convertGRPCErrorToGitDomainError(err)
@@ -244,6 +316,11 @@ func (r *errorTranslatingClient) Blame(ctx context.Context, in *proto.BlameReque
 	return &errorTranslatingBlameClient{cc}, nil
 }
 
+func (r *errorTranslatingClient) BlameSummary(ctx context.Context, in *proto.BlameSummaryRequest, opts ...grpc.CallOption) (*proto.BlameSummaryResponse, error) {
+	res, err := r.base.BlameSummary(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
 type errorTranslatingBlameClient struct {
 	proto.GitserverService_BlameClient
 }
@@ -307,4 +384,381 @@ func (r *errorTranslatingClient) RevAtTime(ctx context.Context, in *proto.RevAtT
 	return res, convertGRPCErrorToGitDomainError(err)
 }
 
+func (r *errorTranslatingClient) FormatPatch(ctx context.Context, in *proto.FormatPatchRequest, opts ...grpc.CallOption) (proto.GitserverService_FormatPatchClient, error) {
+	cc, err := r.base.FormatPatch(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingFormatPatchClient{cc}, nil
+}
+
+type errorTranslatingFormatPatchClient struct {
+	proto.GitserverService_FormatPatchClient
+}
+
+func (r *errorTranslatingFormatPatchClient) Recv() (*proto.FormatPatchResponse, error) {
+	res, err := r.GitserverService_FormatPatchClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) CommitGraph(ctx context.Context, in *proto.CommitGraphRequest, opts ...grpc.CallOption) (proto.GitserverService_CommitGraphClient, error) {
+	cc, err := r.base.CommitGraph(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingCommitGraphClient{cc}, nil
+}
+
+type errorTranslatingCommitGraphClient struct {
+	proto.GitserverService_CommitGraphClient
+}
+
+func (r *errorTranslatingCommitGraphClient) Recv() (*proto.CommitGraphResponse, error) {
+	res, err := r.GitserverService_CommitGraphClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) Cherry(ctx context.Context, in *proto.CherryRequest, opts ...grpc.CallOption) (*proto.CherryResponse, error) {
+	res, err := r.base.Cherry(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) RangeDiff(ctx context.Context, in *proto.RangeDiffRequest, opts ...grpc.CallOption) (*proto.RangeDiffResponse, error) {
+	res, err := r.base.RangeDiff(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) UpdateRef(ctx context.Context, in *proto.UpdateRefRequest, opts ...grpc.CallOption) (*proto.UpdateRefResponse, error) {
+	res, err := r.base.UpdateRef(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) CreateBranch(ctx context.Context, in *proto.CreateBranchRequest, opts ...grpc.CallOption) (*proto.CreateBranchResponse, error) {
+	res, err := r.base.CreateBranch(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) DeleteBranch(ctx context.Context, in *proto.DeleteBranchRequest, opts ...grpc.CallOption) (*proto.DeleteBranchResponse, error) {
+	res, err := r.base.DeleteBranch(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) CreateTag(ctx context.Context, in *proto.CreateTagRequest, opts ...grpc.CallOption) (*proto.CreateTagResponse, error) {
+	res, err := r.base.CreateTag(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) SymbolicRef(ctx context.Context, in *proto.SymbolicRefRequest, opts ...grpc.CallOption) (*proto.SymbolicRefResponse, error) {
+	res, err := r.base.SymbolicRef(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) RefExists(ctx context.Context, in *proto.RefExistsRequest, opts ...grpc.CallOption) (*proto.RefExistsResponse, error) {
+	res, err := r.base.RefExists(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) ListBranches(ctx context.Context, in *proto.ListBranchesRequest, opts ...grpc.CallOption) (proto.GitserverService_ListBranchesClient, error) {
+	cc, err := r.base.ListBranches(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingListBranchesClient{cc}, nil
+}
+
+type errorTranslatingListBranchesClient struct {
+	proto.GitserverService_ListBranchesClient
+}
+
+func (r *errorTranslatingListBranchesClient) Recv() (*proto.ListBranchesResponse, error) {
+	res, err := r.GitserverService_ListBranchesClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) ListTags(ctx context.Context, in *proto.ListTagsRequest, opts ...grpc.CallOption) (proto.GitserverService_ListTagsClient, error) {
+	cc, err := r.base.ListTags(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingListTagsClient{cc}, nil
+}
+
+type errorTranslatingListTagsClient struct {
+	proto.GitserverService_ListTagsClient
+}
+
+func (r *errorTranslatingListTagsClient) Recv() (*proto.ListTagsResponse, error) {
+	res, err := r.GitserverService_ListTagsClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) CloneProgress(ctx context.Context, in *proto.CloneProgressRequest, opts ...grpc.CallOption) (proto.GitserverService_CloneProgressClient, error) {
+	cc, err := r.base.CloneProgress(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingCloneProgressClient{cc}, nil
+}
+
+type errorTranslatingCloneProgressClient struct {
+	proto.GitserverService_CloneProgressClient
+}
+
+func (r *errorTranslatingCloneProgressClient) Recv() (*proto.CloneProgressResponse, error) {
+	res, err := r.GitserverService_CloneProgressClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) OptimizeRepo(ctx context.Context, in *proto.OptimizeRepoRequest, opts ...grpc.CallOption) (*proto.OptimizeRepoResponse, error) {
+	res, err := r.base.OptimizeRepo(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) CommitGraphStatus(ctx context.Context, in *proto.CommitGraphStatusRequest, opts ...grpc.CallOption) (*proto.CommitGraphStatusResponse, error) {
+	res, err := r.base.CommitGraphStatus(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) ListRepos(ctx context.Context, in *proto.ListReposRequest, opts ...grpc.CallOption) (proto.GitserverService_ListReposClient, error) {
+	cc, err := r.base.ListRepos(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingListReposClient{cc}, nil
+}
+
+type errorTranslatingListReposClient struct {
+	proto.GitserverService_ListReposClient
+}
+
+func (r *errorTranslatingListReposClient) Recv() (*proto.ListReposResponse, error) {
+	res, err := r.GitserverService_ListReposClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) ReadBlob(ctx context.Context, in *proto.ReadBlobRequest, opts ...grpc.CallOption) (proto.GitserverService_ReadBlobClient, error) {
+	cc, err := r.base.ReadBlob(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingReadBlobClient{cc}, nil
+}
+
+type errorTranslatingReadBlobClient struct {
+	proto.GitserverService_ReadBlobClient
+}
+
+func (r *errorTranslatingReadBlobClient) Recv() (*proto.ReadBlobResponse, error) {
+	res, err := r.GitserverService_ReadBlobClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) GetTree(ctx context.Context, in *proto.GetTreeRequest, opts ...grpc.CallOption) (proto.GitserverService_GetTreeClient, error) {
+	cc, err := r.base.GetTree(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingGetTreeClient{cc}, nil
+}
+
+type errorTranslatingGetTreeClient struct {
+	proto.GitserverService_GetTreeClient
+}
+
+func (r *errorTranslatingGetTreeClient) Recv() (*proto.GetTreeResponse, error) {
+	res, err := r.GitserverService_GetTreeClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) GetTag(ctx context.Context, in *proto.GetTagRequest, opts ...grpc.CallOption) (*proto.GetTagResponse, error) {
+	res, err := r.base.GetTag(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) ListRemoteRefs(ctx context.Context, in *proto.ListRemoteRefsRequest, opts ...grpc.CallOption) (proto.GitserverService_ListRemoteRefsClient, error) {
+	cc, err := r.base.ListRemoteRefs(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingListRemoteRefsClient{cc}, nil
+}
+
+type errorTranslatingListRemoteRefsClient struct {
+	proto.GitserverService_ListRemoteRefsClient
+}
+
+func (r *errorTranslatingListRemoteRefsClient) Recv() (*proto.ListRemoteRefsResponse, error) {
+	res, err := r.GitserverService_ListRemoteRefsClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) FetchCommitFromRepo(ctx context.Context, in *proto.FetchCommitFromRepoRequest, opts ...grpc.CallOption) (*proto.FetchCommitFromRepoResponse, error) {
+	res, err := r.base.FetchCommitFromRepo(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) CreateBundle(ctx context.Context, in *proto.CreateBundleRequest, opts ...grpc.CallOption) (proto.GitserverService_CreateBundleClient, error) {
+	cc, err := r.base.CreateBundle(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingCreateBundleClient{cc}, nil
+}
+
+type errorTranslatingCreateBundleClient struct {
+	proto.GitserverService_CreateBundleClient
+}
+
+func (r *errorTranslatingCreateBundleClient) Recv() (*proto.CreateBundleResponse, error) {
+	res, err := r.GitserverService_CreateBundleClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) ApplyBundle(ctx context.Context, opts ...grpc.CallOption) (proto.GitserverService_ApplyBundleClient, error) {
+	cc, err := r.base.ApplyBundle(ctx, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingApplyBundleClient{cc}, nil
+}
+
+type errorTranslatingApplyBundleClient struct {
+	proto.GitserverService_ApplyBundleClient
+}
+
+func (r *errorTranslatingApplyBundleClient) Send(m *proto.ApplyBundleRequest) error {
+	err := r.GitserverService_ApplyBundleClient.Send(m)
+	return convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingApplyBundleClient) CloseAndRecv() (*proto.ApplyBundleResponse, error) {
+	res, err := r.GitserverService_ApplyBundleClient.CloseAndRecv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) FetchRefspec(ctx context.Context, in *proto.FetchRefspecRequest, opts ...grpc.CallOption) (*proto.FetchRefspecResponse, error) {
+	res, err := r.base.FetchRefspec(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) CloneState(ctx context.Context, in *proto.CloneStateRequest, opts ...grpc.CallOption) (*proto.CloneStateResponse, error) {
+	res, err := r.base.CloneState(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) SparseManifest(ctx context.Context, in *proto.SparseManifestRequest, opts ...grpc.CallOption) (proto.GitserverService_SparseManifestClient, error) {
+	cc, err := r.base.SparseManifest(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingSparseManifestClient{cc}, nil
+}
+
+type errorTranslatingSparseManifestClient struct {
+	proto.GitserverService_SparseManifestClient
+}
+
+func (r *errorTranslatingSparseManifestClient) Recv() (*proto.SparseManifestResponse, error) {
+	res, err := r.GitserverService_SparseManifestClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) ExecInWorktree(ctx context.Context, in *proto.ExecInWorktreeRequest, opts ...grpc.CallOption) (*proto.ExecInWorktreeResponse, error) {
+	res, err := r.base.ExecInWorktree(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) RebasePreview(ctx context.Context, in *proto.RebasePreviewRequest, opts ...grpc.CallOption) (*proto.RebasePreviewResponse, error) {
+	res, err := r.base.RebasePreview(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) RevertCommit(ctx context.Context, in *proto.RevertCommitRequest, opts ...grpc.CallOption) (*proto.RevertCommitResponse, error) {
+	res, err := r.base.RevertCommit(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) CherryPick(ctx context.Context, in *proto.CherryPickRequest, opts ...grpc.CallOption) (*proto.CherryPickResponse, error) {
+	res, err := r.base.CherryPick(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) SquashCommits(ctx context.Context, in *proto.SquashCommitsRequest, opts ...grpc.CallOption) (*proto.SquashCommitsResponse, error) {
+	res, err := r.base.SquashCommits(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) RefsChangedSince(ctx context.Context, in *proto.RefsChangedSinceRequest, opts ...grpc.CallOption) (proto.GitserverService_RefsChangedSinceClient, error) {
+	cc, err := r.base.RefsChangedSince(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingRefsChangedSinceClient{cc}, nil
+}
+
+type errorTranslatingRefsChangedSinceClient struct {
+	proto.GitserverService_RefsChangedSinceClient
+}
+
+func (r *errorTranslatingRefsChangedSinceClient) Recv() (*proto.RefsChangedSinceResponse, error) {
+	res, err := r.GitserverService_RefsChangedSinceClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) WatchRefs(ctx context.Context, in *proto.WatchRefsRequest, opts ...grpc.CallOption) (proto.GitserverService_WatchRefsClient, error) {
+	cc, err := r.base.WatchRefs(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingWatchRefsClient{cc}, nil
+}
+
+type errorTranslatingWatchRefsClient struct {
+	proto.GitserverService_WatchRefsClient
+}
+
+func (r *errorTranslatingWatchRefsClient) Recv() (*proto.WatchRefsResponse, error) {
+	res, err := r.GitserverService_WatchRefsClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) WatchDefaultBranchCommits(ctx context.Context, in *proto.WatchDefaultBranchCommitsRequest, opts ...grpc.CallOption) (proto.GitserverService_WatchDefaultBranchCommitsClient, error) {
+	cc, err := r.base.WatchDefaultBranchCommits(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingWatchDefaultBranchCommitsClient{cc}, nil
+}
+
+type errorTranslatingWatchDefaultBranchCommitsClient struct {
+	proto.GitserverService_WatchDefaultBranchCommitsClient
+}
+
+func (r *errorTranslatingWatchDefaultBranchCommitsClient) Recv() (*proto.WatchDefaultBranchCommitsResponse, error) {
+	res, err := r.GitserverService_WatchDefaultBranchCommitsClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) LastCommitsForTree(ctx context.Context, in *proto.LastCommitsForTreeRequest, opts ...grpc.CallOption) (proto.GitserverService_LastCommitsForTreeClient, error) {
+	cc, err := r.base.LastCommitsForTree(ctx, in, opts...)
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return &errorTranslatingLastCommitsForTreeClient{cc}, nil
+}
+
+type errorTranslatingLastCommitsForTreeClient struct {
+	proto.GitserverService_LastCommitsForTreeClient
+}
+
+func (r *errorTranslatingLastCommitsForTreeClient) Recv() (*proto.LastCommitsForTreeResponse, error) {
+	res, err := r.GitserverService_LastCommitsForTreeClient.Recv()
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
+func (r *errorTranslatingClient) CommitActivity(ctx context.Context, in *proto.CommitActivityRequest, opts ...grpc.CallOption) (*proto.CommitActivityResponse, error) {
+	res, err := r.base.CommitActivity(ctx, in, opts...)
+	return res, convertGRPCErrorToGitDomainError(err)
+}
+
 var _ proto.GitserverServiceClient = &errorTranslatingClient{}
@@ -0,0 +1,1929 @@
+package gitserver
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	stdlibpath "path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitolite"
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/perforce"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// FakeRepo describes a repository served by a FakeClient.
+type FakeRepo struct {
+	Name api.RepoName
+	// Commits are created in order. Unless Parents is set, the parent of a
+	// commit is the commit before it.
+	Commits []FakeCommit
+	// Branches maps branch names to commit labels. If no branches are given,
+	// the default branch points at the last commit.
+	Branches map[string]string
+	// Tags maps the names of lightweight tags to commit labels.
+	Tags map[string]string
+	// DefaultBranch is the branch HEAD points at, "main" if empty.
+	DefaultBranch string
+}
+
+// FakeCommit describes a commit of a FakeRepo.
+type FakeCommit struct {
+	// Label identifies the commit in Parents, Branches and Tags.
+	Label string
+	// Parents are the labels of the parents of the commit.
+	Parents []string
+	Message string
+	// Author defaults to a fixed identity. A zero date is replaced by one hour
+	// after the date of the previous commit.
+	Author gitdomain.Signature
+	// Files sets the content of the given files, on top of the tree of the
+	// first parent.
+	Files map[string]string
+	// Deleted removes files from the tree of the first parent.
+	Deleted []string
+}
+
+// FakeClient is an in-memory implementation of Client for tests that need
+// realistic behavior for commits, refs and file contents, without running git.
+// Sub-repo permissions are not applied, and methods that depend on the output
+// of git, such as diffs, blame and search, return an error.
+type FakeClient struct {
+	mu    sync.RWMutex
+	repos map[api.RepoName]*fakeRepo
+}
+
+var _ Client = &FakeClient{}
+
+// NewFakeClient returns a FakeClient serving the given repositories. It
+// panics if a description is invalid, for example because it references an
+// unknown commit label.
+func NewFakeClient(repos ...FakeRepo) *FakeClient {
+	c := &FakeClient{repos: make(map[api.RepoName]*fakeRepo, len(repos))}
+	for _, r := range repos {
+		repo, err := newFakeRepo(r)
+		if err != nil {
+			panic(fmt.Sprintf("invalid fake repo %q: %s", r.Name, err))
+		}
+		c.repos[r.Name] = repo
+	}
+	return c
+}
+
+var fakeBaseDate = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type fakeRepo struct {
+	name    api.RepoName
+	commits map[api.CommitID]*fakeCommit
+	// order holds the commits in the order they were created.
+	order []api.CommitID
+	// refs maps full ref names to commits.
+	refs map[string]api.CommitID
+	head string
+}
+
+type fakeCommit struct {
+	commit *gitdomain.Commit
+	// index is the position of the commit in fakeRepo.order.
+	index int
+	tree  map[string]string
+	// changed holds the paths that differ from the first parent.
+	changed []string
+}
+
+func newFakeRepo(desc FakeRepo) (*fakeRepo, error) {
+	r := &fakeRepo{
+		name:    desc.Name,
+		commits: make(map[api.CommitID]*fakeCommit, len(desc.Commits)),
+		refs:    make(map[string]api.CommitID),
+		head:    "refs/heads/" + desc.DefaultBranch,
+	}
+	if desc.DefaultBranch == "" {
+		r.head = "refs/heads/main"
+	}
+
+	labels := make(map[string]api.CommitID)
+	date := fakeBaseDate
+	for i, fc := range desc.Commits {
+		var parents []api.CommitID
+		for _, label := range fc.Parents {
+			id, ok := labels[label]
+			if !ok {
+				return nil, errors.Newf("commit %d: unknown parent %q", i, label)
+			}
+			parents = append(parents, id)
+		}
+		if fc.Parents == nil && i > 0 {
+			parents = []api.CommitID{r.order[i-1]}
+		}
+
+		author := fc.Author
+		if author.Name == "" {
+			author.Name, author.Email = "Fake Author", "fake@example.com"
+		}
+		if author.Date.IsZero() {
+			author.Date = date.Add(time.Hour)
+		}
+		date = author.Date
+
+		tree := make(map[string]string)
+		if len(parents) > 0 {
+			for p, content := range r.commits[parents[0]].tree {
+				tree[p] = content
+			}
+		}
+		var changed []string
+		for p, content := range fc.Files {
+			p = cleanFakePath(p)
+			if old, ok := tree[p]; !ok || old != content {
+				changed = append(changed, p)
+			}
+			tree[p] = content
+		}
+		for _, p := range fc.Deleted {
+			p = cleanFakePath(p)
+			if _, ok := tree[p]; !ok {
+				return nil, errors.Newf("commit %d: cannot delete unknown file %q", i, p)
+			}
+			delete(tree, p)
+			changed = append(changed, p)
+		}
+		sort.Strings(changed)
+
+		h := sha1.New()
+		fmt.Fprintf(h, "%s\x00%d\x00%v\x00%s\x00%s\x00%s\x00%v", desc.Name, i, parents, fc.Message, author.Name, author.Date, changed)
+		id := api.CommitID(hex.EncodeToString(h.Sum(nil)))
+
+		committer := author
+		r.commits[id] = &fakeCommit{
+			commit: &gitdomain.Commit{
+				ID:        id,
+				Author:    author,
+				Committer: &committer,
+				Message:   gitdomain.Message(fc.Message),
+				Parents:   parents,
+			},
+			index:   i,
+			tree:    tree,
+			changed: changed,
+		}
+		r.order = append(r.order, id)
+		if fc.Label != "" {
+			labels[fc.Label] = id
+		}
+	}
+
+	for branch, label := range desc.Branches {
+		id, ok := labels[label]
+		if !ok {
+			return nil, errors.Newf("branch %q: unknown commit %q", branch, label)
+		}
+		r.refs["refs/heads/"+branch] = id
+	}
+	for tag, label := range desc.Tags {
+		id, ok := labels[label]
+		if !ok {
+			return nil, errors.Newf("tag %q: unknown commit %q", tag, label)
+		}
+		r.refs["refs/tags/"+tag] = id
+	}
+	if len(desc.Branches) == 0 && len(r.order) > 0 {
+		r.refs[r.head] = r.order[len(r.order)-1]
+	}
+
+	return r, nil
+}
+
+func cleanFakePath(p string) string {
+	return strings.TrimPrefix(filepath.ToSlash(filepath.Clean(rel(p))), "./")
+}
+
+func (c *FakeClient) repo(repo api.RepoName) (*fakeRepo, error) {
+	r, ok := c.repos[repo]
+	if !ok {
+		return nil, &gitdomain.RepoNotExistError{Repo: repo}
+	}
+	return r, nil
+}
+
+// resolve resolves a revspec like git rev-parse does, supporting refs, full
+// and abbreviated SHAs, HEAD, and the ~ and ^ suffixes.
+func (r *fakeRepo) resolve(spec string) (api.CommitID, error) {
+	notFound := &gitdomain.RevisionNotFoundError{Repo: r.name, Spec: spec}
+
+	rev := strings.TrimSuffix(spec, "^{commit}")
+	if rev == "" {
+		rev = "HEAD"
+	}
+	i := strings.IndexAny(rev, "~^")
+	if i == -1 {
+		i = len(rev)
+	}
+	id, ok := r.resolveName(rev[:i])
+	if !ok {
+		return "", notFound
+	}
+
+	suffix := rev[i:]
+	for suffix != "" {
+		op := suffix[0]
+		j := 1
+		for j < len(suffix) && suffix[j] >= '0' && suffix[j] <= '9' {
+			j++
+		}
+		n := 1
+		if j > 1 {
+			var err error
+			if n, err = strconv.Atoi(suffix[1:j]); err != nil {
+				return "", notFound
+			}
+		}
+		suffix = suffix[j:]
+
+		switch op {
+		case '~':
+			for ; n > 0; n-- {
+				parents := r.commits[id].commit.Parents
+				if len(parents) == 0 {
+					return "", notFound
+				}
+				id = parents[0]
+			}
+		case '^':
+			if n == 0 {
+				continue
+			}
+			parents := r.commits[id].commit.Parents
+			if n > len(parents) {
+				return "", notFound
+			}
+			id = parents[n-1]
+		default:
+			return "", notFound
+		}
+	}
+
+	return id, nil
+}
+
+func (r *fakeRepo) resolveName(name string) (api.CommitID, bool) {
+	if name == "HEAD" {
+		id, ok := r.refs[r.head]
+		return id, ok
+	}
+	for _, ref := range []string{name, "refs/heads/" + name, "refs/tags/" + name} {
+		if id, ok := r.refs[ref]; ok {
+			return id, true
+		}
+	}
+	if gitdomain.IsAbsoluteRevision(name) {
+		_, ok := r.commits[api.CommitID(name)]
+		return api.CommitID(name), ok
+	}
+	if len(name) < 4 {
+		return "", false
+	}
+	var match api.CommitID
+	for _, id := range r.order {
+		if strings.HasPrefix(string(id), name) {
+			if match != "" {
+				// Ambiguous abbreviation.
+				return "", false
+			}
+			match = id
+		}
+	}
+	return match, match != ""
+}
+
+// reachable returns the set of commits reachable from the given commits.
+func (r *fakeRepo) reachable(ids ...api.CommitID) map[api.CommitID]struct{} {
+	seen := make(map[api.CommitID]struct{})
+	queue := slices.Clone(ids)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		queue = append(queue, r.commits[id].commit.Parents...)
+	}
+	return seen
+}
+
+// sorted returns the given commits newest first, like git log.
+func (r *fakeRepo) sorted(set map[api.CommitID]struct{}) []*fakeCommit {
+	commits := make([]*fakeCommit, 0, len(set))
+	for id := range set {
+		commits = append(commits, r.commits[id])
+	}
+	sort.Slice(commits, func(i, j int) bool {
+		ci, cj := commits[i].commit, commits[j].commit
+		if !ci.Committer.Date.Equal(cj.Committer.Date) {
+			return ci.Committer.Date.After(cj.Committer.Date)
+		}
+		return commits[i].index > commits[j].index
+	})
+	return commits
+}
+
+func (r *fakeRepo) mergeBase(a, b api.CommitID) api.CommitID {
+	reachA, reachB := r.reachable(a), r.reachable(b)
+	var common []api.CommitID
+	for id := range reachA {
+		if _, ok := reachB[id]; ok {
+			common = append(common, id)
+		}
+	}
+
+	// The best common ancestors are those that are not an ancestor of another
+	// common ancestor. Of those, we pick the newest.
+	best := make(map[api.CommitID]struct{})
+	for _, id := range common {
+		best[id] = struct{}{}
+	}
+	for _, id := range common {
+		for ancestor := range r.reachable(r.commits[id].commit.Parents...) {
+			delete(best, ancestor)
+		}
+	}
+	if sorted := r.sorted(best); len(sorted) > 0 {
+		return sorted[0].commit.ID
+	}
+	return ""
+}
+
+// selectRange returns the commits selected by a range like "A..B", "A...B"
+// or a single revision.
+func (r *fakeRepo) selectRange(spec string, ancestryPath bool) (map[api.CommitID]struct{}, error) {
+	if from, to, ok := strings.Cut(spec, "..."); ok {
+		fromID, err := r.resolve(from)
+		if err != nil {
+			return nil, err
+		}
+		toID, err := r.resolve(to)
+		if err != nil {
+			return nil, err
+		}
+		left, right := r.reachable(fromID), r.reachable(toID)
+		set := make(map[api.CommitID]struct{})
+		for id := range left {
+			if _, ok := right[id]; !ok {
+				set[id] = struct{}{}
+			}
+		}
+		for id := range right {
+			if _, ok := left[id]; !ok {
+				set[id] = struct{}{}
+			}
+		}
+		return set, nil
+	}
+
+	if from, to, ok := strings.Cut(spec, ".."); ok {
+		fromID, err := r.resolve(from)
+		if err != nil {
+			return nil, err
+		}
+		toID, err := r.resolve(to)
+		if err != nil {
+			return nil, err
+		}
+		set := r.reachable(toID)
+		for id := range r.reachable(fromID) {
+			delete(set, id)
+		}
+		if ancestryPath {
+			for id := range set {
+				if _, ok := r.reachable(id)[fromID]; !ok {
+					delete(set, id)
+				}
+			}
+		}
+		return set, nil
+	}
+
+	id, err := r.resolve(spec)
+	if err != nil {
+		return nil, err
+	}
+	return r.reachable(id), nil
+}
+
+func (r *fakeRepo) touches(c *fakeCommit, path string) bool {
+	path = cleanFakePath(path)
+	if path == "." {
+		return true
+	}
+	for _, p := range c.changed {
+		if p == path || strings.HasPrefix(p, path+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// changedFiles returns the files c changed relative to its first parent. Like
+// git log, it returns none for merge commits, and it doesn't detect renames.
+func (r *fakeRepo) changedFiles(c *fakeCommit) []gitdomain.ChangedFile {
+	files := []gitdomain.ChangedFile{}
+	if len(c.commit.Parents) > 1 {
+		return files
+	}
+	var parentTree map[string]string
+	if len(c.commit.Parents) == 1 {
+		parentTree = r.commits[c.commit.Parents[0]].tree
+	}
+	for _, path := range c.changed {
+		_, inParent := parentTree[path]
+		_, inCommit := c.tree[path]
+		status := gitdomain.ChangedFileStatusModified
+		switch {
+		case !inParent:
+			status = gitdomain.ChangedFileStatusAdded
+		case !inCommit:
+			status = gitdomain.ChangedFileStatusDeleted
+		}
+		files = append(files, gitdomain.ChangedFile{Path: path, Status: status})
+	}
+	return files
+}
+
+func (r *fakeRepo) log(opt CommitsOptions) ([]*gitdomain.Commit, error) {
+	if opt.Follow {
+		return nil, fakeUnsupported("CommitsOptions.Follow")
+	}
+	if opt.AfterRelative != "" || opt.BeforeRelative != "" {
+		return nil, fakeUnsupported("relative dates in CommitsOptions")
+	}
+	if err := opt.validateDates(); err != nil {
+		return nil, err
+	}
+	after, before := opt.After, opt.Before
+
+	set, err := r.selectRange(opt.Range, opt.AncestryPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []*gitdomain.Commit
+	for _, c := range r.sorted(set) {
+		commit := c.commit
+		switch {
+		case opt.MessageQuery != "" && !strings.Contains(string(commit.Message), opt.MessageQuery),
+			opt.Author != "" && !strings.Contains(commit.Author.Name+" <"+commit.Author.Email+">", opt.Author),
+			!after.IsZero() && !commit.Committer.Date.After(after),
+			!before.IsZero() && commit.Committer.Date.After(before),
+			opt.OnlyMerges && len(commit.Parents) < 2,
+			opt.NoMerges && len(commit.Parents) > 1,
+			opt.Path != "" && !r.touches(c, opt.Path):
+			continue
+		}
+		if opt.ChangedFiles {
+			withFiles := *commit
+			withFiles.ChangedFiles = r.changedFiles(c)
+			commit = &withFiles
+		}
+		commits = append(commits, commit)
+	}
+
+	if opt.Skip >= uint(len(commits)) {
+		return nil, nil
+	}
+	commits = commits[opt.Skip:]
+	if opt.N > 0 && opt.N < uint(len(commits)) {
+		commits = commits[:opt.N]
+	}
+	return commits, nil
+}
+
+func fakeUnsupported(what string) error {
+	return errors.Newf("%s is not supported by the fake gitserver client", what)
+}
+
+func fakeBlobOID(content string) gitdomain.OID {
+	return gitdomain.OID(sha1.Sum([]byte(fmt.Sprintf("blob %d\x00%s", len(content), content))))
+}
+
+// lookupTree returns the tree of commit, after validating that it exists.
+func (c *FakeClient) lookupTree(repo api.RepoName, commit api.CommitID) (*fakeRepo, map[string]string, error) {
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, nil, err
+	}
+	fc, ok := r.commits[commit]
+	if !ok {
+		return nil, nil, &gitdomain.RevisionNotFoundError{Repo: repo, Spec: string(commit)}
+	}
+	return r, fc.tree, nil
+}
+
+// fakeEntries lists the files and directories below dir, which is "." for
+// the root. If recurse is false, only direct children are returned.
+func fakeEntries(tree map[string]string, dir string, recurse bool) []fs.FileInfo {
+	prefix := ""
+	if dir != "." {
+		prefix = dir + "/"
+	}
+	dirs := make(map[string]struct{})
+	var fis []fs.FileInfo
+	for p, content := range tree {
+		if !strings.HasPrefix(p, prefix) {
+			continue
+		}
+		rest := strings.TrimPrefix(p, prefix)
+		parts := strings.Split(rest, "/")
+		for i := 1; i < len(parts); i++ {
+			if !recurse && i > 1 {
+				break
+			}
+			dirs[prefix+strings.Join(parts[:i], "/")] = struct{}{}
+		}
+		if recurse || len(parts) == 1 {
+			fis = append(fis, &fileutil.FileInfo{
+				Name_: p,
+				Mode_: 0o644,
+				Size_: int64(len(content)),
+				Sys_:  objectInfo(fakeBlobOID(content)),
+			})
+		}
+	}
+	for d := range dirs {
+		fis = append(fis, &fileutil.FileInfo{
+			Name_: d,
+			Mode_: os.ModeDir,
+			Sys_:  objectInfo(fakeBlobOID("tree " + d)),
+		})
+	}
+	fileutil.SortFileInfosByName(fis)
+	return fis
+}
+
+func (c *FakeClient) Scoped(string) Client                             { return c }
+func (c *FakeClient) WithRetryPolicy(RetryPolicy) Client               { return c }
+func (c *FakeClient) WithHedging(HedgingPolicy) Client                 { return c }
+func (c *FakeClient) WithCompression(string) Client                    { return c }
+func (c *FakeClient) WithMetrics(prometheus.Registerer) Client         { return c }
+func (c *FakeClient) WithSlowLogging(time.Duration) Client             { return c }
+func (c *FakeClient) WithAuditHook(AuditHook) Client                   { return c }
+func (c *FakeClient) WithRepoConcurrencyLimit(int) Client              { return c }
+func (c *FakeClient) WithActorRateLimit(ActorRateLimits) Client        { return c }
+func (c *FakeClient) WithBlobCache(int) Client                         { return c }
+func (c *FakeClient) WithMergeBaseCache(int) Client                    { return c }
+func (c *FakeClient) WithSubRepoPermsCache(time.Duration) Client       { return c }
+func (c *FakeClient) WithPermissionTrace(*PermissionTrace) Client      { return c }
+func (c *FakeClient) AddrForRepo(context.Context, api.RepoName) string { return "fake-gitserver" }
+
+func (c *FakeClient) DiagnoseAddrForRepo(_ context.Context, repo api.RepoName) (*RepoAddrDiagnostics, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, cloned := c.repos[repo]
+	return &RepoAddrDiagnostics{
+		RepoAddressing: RepoAddressing{Repo: repo, HashedAddr: "fake-gitserver", Addr: "fake-gitserver"},
+		AddrKnown:      true,
+		Cloned:         cloned,
+	}, nil
+}
+
+func (c *FakeClient) ScopedToPath(repo api.RepoName, pathPrefix string) (*PathScopedClient, error) {
+	return newPathScopedClient(c, repo, pathPrefix)
+}
+
+func (c *FakeClient) ArchiveReader(_ context.Context, repo api.RepoName, options ArchiveOptions) (io.ReadCloser, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if options.Offset > 0 && !gitdomain.IsAbsoluteRevision(options.Treeish) {
+		return nil, errors.New("treeish must be an absolute commit or tree ID to resume an archive")
+	}
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	id, err := r.resolve(options.Treeish)
+	if err != nil {
+		return nil, err
+	}
+	tree := r.commits[id].tree
+
+	files := make(map[string]string)
+	for p, content := range tree {
+		if len(options.Paths) == 0 || slices.ContainsFunc(options.Paths, func(want string) bool {
+			want = cleanFakePath(want)
+			return want == "." || p == want || strings.HasPrefix(p, want+"/")
+		}) {
+			files[p] = content
+		}
+	}
+	if options.Filter != "" {
+		limit, err := gitdomain.ParseBlobFilter(options.Filter)
+		if err != nil {
+			return nil, err
+		}
+		omitted := []gitdomain.OmittedBlob{}
+		for p, content := range files {
+			if int64(len(content)) >= limit {
+				omitted = append(omitted, gitdomain.OmittedBlob{Path: p, OID: fakeBlobOID(content).String(), Size: int64(len(content))})
+				delete(files, p)
+			}
+		}
+		sort.Slice(omitted, func(i, j int) bool { return omitted[i].Path < omitted[j].Path })
+		manifest, err := json.Marshal(omitted)
+		if err != nil {
+			return nil, err
+		}
+		files[gitdomain.OmittedBlobsManifestPath] = string(manifest)
+	}
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	switch options.Format {
+	case ArchiveFormatTar:
+		w := tar.NewWriter(&buf)
+		for _, p := range paths {
+			if err := w.WriteHeader(&tar.Header{Name: p, Mode: 0o644, Size: int64(len(files[p]))}); err != nil {
+				return nil, err
+			}
+			if _, err := io.WriteString(w, files[p]); err != nil {
+				return nil, err
+			}
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	case ArchiveFormatZip:
+		w := zip.NewWriter(&buf)
+		for _, p := range paths {
+			f, err := w.Create(p)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := io.WriteString(f, files[p]); err != nil {
+				return nil, err
+			}
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Newf("unknown archive format %q", options.Format)
+	}
+	if options.Offset < 0 || options.Offset > int64(buf.Len()) {
+		return nil, errors.Newf("offset %d is beyond the end of the archive (%d bytes)", options.Offset, buf.Len())
+	}
+	buf.Next(int(options.Offset))
+	return io.NopCloser(&buf), nil
+}
+
+func (c *FakeClient) StreamBlameFile(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error) {
+	return nil, fakeUnsupported("StreamBlameFile")
+}
+
+func (c *FakeClient) CreateCommitFromPatch(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+	return nil, fakeUnsupported("CreateCommitFromPatch")
+}
+
+func (c *FakeClient) GetDefaultBranch(_ context.Context, repo api.RepoName, short bool) (string, api.CommitID, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return "", "", err
+	}
+	id, ok := r.refs[r.head]
+	if !ok {
+		// Empty repository.
+		return "", "", nil
+	}
+	if short {
+		return strings.TrimPrefix(r.head, "refs/heads/"), id, nil
+	}
+	return r.head, id, nil
+}
+
+func (c *FakeClient) GetObject(_ context.Context, repo api.RepoName, objectName string) (*gitdomain.GitObject, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	id, err := r.resolve(objectName)
+	if err != nil {
+		return nil, err
+	}
+	oid, err := decodeOID(string(id))
+	if err != nil {
+		return nil, err
+	}
+	return &gitdomain.GitObject{ID: oid, Type: gitdomain.ObjectTypeCommit}, nil
+}
+
+func (c *FakeClient) HasCommitAfter(_ context.Context, repo api.RepoName, date string, revspec string) (bool, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return false, err
+	}
+	opt, err := commitsAfterOptions(date)
+	if err != nil {
+		return false, err
+	}
+	opt.Range, opt.N = revspec, 1
+	commits, err := r.log(opt)
+	return len(commits) > 0, err
+}
+
+func (c *FakeClient) IsRepoCloneable(_ context.Context, repo api.RepoName) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, err := c.repo(repo)
+	return err
+}
+
+func (c *FakeClient) ListRefs(_ context.Context, repo api.RepoName, opt ListRefsOpts) ([]gitdomain.Ref, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	var contains api.CommitID
+	if opt.Contains != "" {
+		if contains, err = r.resolve(string(opt.Contains)); err != nil {
+			return nil, err
+		}
+	}
+
+	var refs []gitdomain.Ref
+	for name, id := range r.refs {
+		ref := gitdomain.Ref{
+			Name:        name,
+			CommitID:    id,
+			RefOID:      id,
+			CreatedDate: r.commits[id].commit.Committer.Date,
+			IsHead:      name == r.head,
+		}
+		switch {
+		case strings.HasPrefix(name, "refs/heads/"):
+			ref.Type, ref.ShortName = gitdomain.RefTypeBranch, strings.TrimPrefix(name, "refs/heads/")
+		case strings.HasPrefix(name, "refs/tags/"):
+			ref.Type, ref.ShortName = gitdomain.RefTypeTag, strings.TrimPrefix(name, "refs/tags/")
+		default:
+			ref.ShortName = name
+		}
+
+		if (opt.HeadsOnly || opt.TagsOnly) &&
+			!(opt.HeadsOnly && ref.Type == gitdomain.RefTypeBranch) &&
+			!(opt.TagsOnly && ref.Type == gitdomain.RefTypeTag) {
+			continue
+		}
+		if len(opt.PointsAtCommit) > 0 && !slices.Contains(opt.PointsAtCommit, id) {
+			continue
+		}
+		if contains != "" {
+			if _, ok := r.reachable(id)[contains]; !ok {
+				continue
+			}
+		}
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Name < refs[j].Name })
+	return refs, nil
+}
+
+// RefsChangedSince doesn't track ref moves, so it always returns all refs.
+func (c *FakeClient) RefsChangedSince(ctx context.Context, repo api.RepoName, _ RefsChangedSinceOptions) (gitdomain.RefChanges, error) {
+	refs, err := c.ListRefs(ctx, repo, ListRefsOpts{})
+	if err != nil {
+		return gitdomain.RefChanges{}, err
+	}
+	res := gitdomain.RefChanges{Full: true}
+	for _, ref := range refs {
+		res.Changes = append(res.Changes, gitdomain.RefChange{Name: ref.Name, CommitID: ref.CommitID, ChangedAt: ref.CreatedDate})
+	}
+	return res, nil
+}
+
+func (c *FakeClient) WatchRefs(context.Context, api.RepoName) (RefWatcher, error) {
+	return nil, fakeUnsupported("WatchRefs")
+}
+
+func (c *FakeClient) WatchDefaultBranchCommits(context.Context, api.RepoName) (BranchCommitWatcher, error) {
+	return nil, fakeUnsupported("WatchDefaultBranchCommits")
+}
+
+func (c *FakeClient) MergeBase(_ context.Context, repo api.RepoName, base, head string) (api.CommitID, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return "", err
+	}
+	baseID, err := r.resolve(base)
+	if err != nil {
+		return "", err
+	}
+	headID, err := r.resolve(head)
+	if err != nil {
+		return "", err
+	}
+	return r.mergeBase(baseID, headID), nil
+}
+
+func (c *FakeClient) MergeBaseCrossRepo(context.Context, api.RepoName, string, api.RepoName, string) (api.CommitID, error) {
+	return "", fakeUnsupported("MergeBaseCrossRepo")
+}
+
+func (c *FakeClient) Cherry(context.Context, api.RepoName, string, string) ([]gitdomain.CherryCommit, error) {
+	return nil, fakeUnsupported("Cherry")
+}
+
+func (c *FakeClient) CherryCrossRepo(context.Context, api.RepoName, string, api.RepoName, string) ([]gitdomain.CherryCommit, error) {
+	return nil, fakeUnsupported("CherryCrossRepo")
+}
+
+func (c *FakeClient) RangeDiff(context.Context, api.RepoName, string, string) ([]*gitdomain.RangeDiffPair, error) {
+	return nil, fakeUnsupported("RangeDiff")
+}
+
+func (c *FakeClient) Remove(_ context.Context, repo api.RepoName) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.repos, repo)
+	return nil
+}
+
+// OptimizeRepo doesn't do anything, as fake repositories need no maintenance.
+// The selected tasks are reported as completed right away.
+func (c *FakeClient) OptimizeRepo(_ context.Context, repo api.RepoName, opts MaintenanceOptions) ([]MaintenanceTaskResult, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if _, err := c.repo(repo); err != nil {
+		return nil, err
+	}
+
+	var results []MaintenanceTaskResult
+	for _, t := range []struct {
+		name     string
+		selected bool
+	}{{"gc", opts.Gc}, {"repack", opts.Repack}, {"commit-graph", opts.CommitGraph}} {
+		if t.selected {
+			results = append(results, MaintenanceTaskResult{Task: t.name})
+		}
+	}
+	if len(results) == 0 {
+		return nil, errors.New("at least one maintenance task must be requested")
+	}
+	return results, nil
+}
+
+func (c *FakeClient) CommitGraphStatus(context.Context, api.RepoName) (gitdomain.CommitGraphStatus, error) {
+	return gitdomain.CommitGraphStatus{}, fakeUnsupported("CommitGraphStatus")
+}
+
+func (c *FakeClient) CloneState(context.Context, api.RepoName) (gitdomain.CloneState, error) {
+	return gitdomain.CloneState{}, fakeUnsupported("CloneState")
+}
+
+func (c *FakeClient) WriteCommitGraph(ctx context.Context, repo api.RepoName) error {
+	_, err := c.OptimizeRepo(ctx, repo, MaintenanceOptions{CommitGraph: true})
+	return err
+}
+
+func (c *FakeClient) RepoCloneProgress(_ context.Context, repo api.RepoName) (*protocol.RepoCloneProgress, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, ok := c.repos[repo]
+	return &protocol.RepoCloneProgress{Cloned: ok}, nil
+}
+
+// CloneProgress returns a single update, as all repos of a FakeClient are
+// either cloned or don't exist.
+func (c *FakeClient) CloneProgress(ctx context.Context, repo api.RepoName) (CloneProgressReader, error) {
+	p, err := c.RepoCloneProgress(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeCloneProgressReader{update: &protocol.CloneProgressUpdate{Cloned: p.Cloned}}, nil
+}
+
+type fakeCloneProgressReader struct {
+	update *protocol.CloneProgressUpdate
+}
+
+func (r *fakeCloneProgressReader) Read() (*protocol.CloneProgressUpdate, error) {
+	if r.update == nil {
+		return nil, io.EOF
+	}
+	u := r.update
+	r.update = nil
+	return u, nil
+}
+
+func (r *fakeCloneProgressReader) Close() error { return nil }
+
+func (c *FakeClient) ResolveRevision(_ context.Context, repo api.RepoName, spec string, _ ResolveRevisionOptions) (api.CommitID, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return "", err
+	}
+	return r.resolve(spec)
+}
+
+func (c *FakeClient) EnsureRevision(ctx context.Context, repo api.RepoName, rev string, opts WaitOptions) (*EnsureRevisionResult, error) {
+	return ensureRevision(ctx, c, repo, rev, opts)
+}
+
+func (c *FakeClient) RevAtTime(_ context.Context, repo api.RepoName, spec string, t time.Time) (api.CommitID, bool, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return "", false, err
+	}
+	commits, err := r.log(CommitsOptions{Range: spec, Before: t, N: 1})
+	if err != nil || len(commits) == 0 {
+		return "", false, err
+	}
+	return commits[0].ID, true, nil
+}
+
+func (c *FakeClient) UpdateRef(_ context.Context, repo api.RepoName, ref string, newOID, expectedOldOID api.CommitID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return err
+	}
+	if _, ok := r.commits[newOID]; !ok {
+		return &gitdomain.RevisionNotFoundError{Repo: repo, Spec: string(newOID)}
+	}
+	if expectedOldOID != "" && r.refs[ref] != expectedOldOID {
+		return &gitdomain.RefUpdateConflictError{Repo: repo, Ref: ref, ExpectedOldOID: expectedOldOID}
+	}
+	r.refs[ref] = newOID
+	return nil
+}
+
+func (c *FakeClient) CreateBranch(_ context.Context, repo api.RepoName, branch, startPoint string) (api.CommitID, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return "", err
+	}
+	ref := "refs/heads/" + branch
+	if _, ok := r.refs[ref]; ok {
+		return "", &gitdomain.RefUpdateConflictError{Repo: repo, Ref: ref}
+	}
+	id, err := r.resolve(startPoint)
+	if err != nil {
+		return "", err
+	}
+	r.refs[ref] = id
+	return id, nil
+}
+
+func (c *FakeClient) DeleteBranch(_ context.Context, repo api.RepoName, branch string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return err
+	}
+	ref := "refs/heads/" + branch
+	if _, ok := r.refs[ref]; !ok {
+		return &gitdomain.RevisionNotFoundError{Repo: repo, Spec: branch}
+	}
+	delete(r.refs, ref)
+	return nil
+}
+
+func (c *FakeClient) CreateTag(_ context.Context, repo api.RepoName, name, target string, opts TagOptions) error {
+	if opts.Message != "" || opts.Sign {
+		return fakeUnsupported("annotated tags")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return err
+	}
+	ref := "refs/tags/" + name
+	if _, ok := r.refs[ref]; ok {
+		return &gitdomain.RefUpdateConflictError{Repo: repo, Ref: ref}
+	}
+	id, err := r.resolve(target)
+	if err != nil {
+		return err
+	}
+	r.refs[ref] = id
+	return nil
+}
+
+// GetTag only returns lightweight tags, as the fake doesn't support annotated
+// tags.
+func (c *FakeClient) GetTag(_ context.Context, repo api.RepoName, name string) (*gitdomain.Tag, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	ref := "refs/tags/" + name
+	id, ok := r.refs[ref]
+	if !ok {
+		return nil, &gitdomain.RevisionNotFoundError{Repo: repo, Spec: ref}
+	}
+	oid, err := decodeOID(string(id))
+	if err != nil {
+		return nil, err
+	}
+	return &gitdomain.Tag{
+		Name:   name,
+		Target: gitdomain.GitObject{ID: oid, Type: gitdomain.ObjectTypeCommit},
+	}, nil
+}
+
+func (c *FakeClient) SymbolicRef(_ context.Context, repo api.RepoName, name string) (string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return "", err
+	}
+	// HEAD is the only symbolic ref of fake repositories.
+	if name != "HEAD" {
+		return "", nil
+	}
+	return r.head, nil
+}
+
+func (c *FakeClient) RefExists(_ context.Context, repo api.RepoName, name string) (bool, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return false, err
+	}
+	if name == "HEAD" {
+		name = r.head
+	}
+	_, ok := r.refs[name]
+	return ok, nil
+}
+
+func (c *FakeClient) ListBranches(ctx context.Context, repo api.RepoName, opts ListBranchesOptions) ([]*gitdomain.Branch, error) {
+	base := opts.Base
+	if base == "" {
+		base = "HEAD"
+	}
+
+	refs, err := c.ListRefs(ctx, repo, ListRefsOpts{HeadsOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	// gitserver lists the default branch first.
+	sort.SliceStable(refs, func(i, j int) bool { return refs[i].IsHead && !refs[j].IsHead })
+
+	branches := make([]*gitdomain.Branch, 0, len(refs))
+	for _, ref := range refs {
+		b := &gitdomain.Branch{Ref: ref}
+		if b.BehindAhead, err = c.GetBehindAhead(ctx, repo, base, string(ref.CommitID)); err != nil && !errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+			return nil, err
+		}
+		if b.Commit, err = c.GetCommit(ctx, repo, ref.CommitID); err != nil {
+			return nil, err
+		}
+		branches = append(branches, b)
+	}
+	return branches, nil
+}
+
+// ListTags matches the patterns against the whole tag names, like git. Sorting
+// by version is not supported.
+func (c *FakeClient) ListTags(ctx context.Context, repo api.RepoName, opts ListTagsOptions) ([]gitdomain.Ref, error) {
+	if opts.SortByVersion {
+		return nil, fakeUnsupported("ListTags with SortByVersion")
+	}
+
+	refs, err := c.ListRefs(ctx, repo, ListRefsOpts{TagsOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]gitdomain.Ref, 0, len(refs))
+	for _, ref := range refs {
+		matches := len(opts.Patterns) == 0
+		for _, p := range opts.Patterns {
+			if ok, _ := stdlibpath.Match(p, ref.ShortName); ok {
+				matches = true
+				break
+			}
+		}
+		if matches {
+			tags = append(tags, ref)
+		}
+	}
+	// Newest first, ties are broken by descending name.
+	sort.SliceStable(tags, func(i, j int) bool {
+		if !tags[i].CreatedDate.Equal(tags[j].CreatedDate) {
+			return tags[i].CreatedDate.After(tags[j].CreatedDate)
+		}
+		return tags[i].Name > tags[j].Name
+	})
+	if opts.Limit > 0 && len(tags) > opts.Limit {
+		tags = tags[:opts.Limit]
+	}
+	return tags, nil
+}
+
+func (c *FakeClient) ListRemoteRefs(context.Context, api.RepoName) ([]gitdomain.Ref, error) {
+	return nil, fakeUnsupported("ListRemoteRefs")
+}
+
+func (c *FakeClient) FetchRefspec(context.Context, api.RepoName, string) error {
+	return fakeUnsupported("FetchRefspec")
+}
+
+func (c *FakeClient) BlameSummary(context.Context, api.RepoName, api.CommitID, string) ([]*gitdomain.BlameAuthorSummary, error) {
+	return nil, fakeUnsupported("BlameSummary")
+}
+
+func (c *FakeClient) RequestRepoUpdate(_ context.Context, repo api.RepoName) (*protocol.RepoUpdateResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if _, err := c.repo(repo); err != nil {
+		return &protocol.RepoUpdateResponse{Error: err.Error()}, nil
+	}
+	return &protocol.RepoUpdateResponse{}, nil
+}
+
+func (c *FakeClient) Search(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error) {
+	return false, fakeUnsupported("Search")
+}
+
+func (c *FakeClient) SearchCommits(_ context.Context, repo api.RepoName, q CommitSearchQuery, onMatches func([]protocol.CommitMatch)) (bool, error) {
+	if q.DiffContains != "" || q.IncludeDiff {
+		return false, fakeUnsupported("searching diffs")
+	}
+	args, err := q.searchRequest(repo)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return false, err
+	}
+
+	set := make(map[api.CommitID]struct{})
+	for _, rev := range args.Revisions {
+		ids, err := r.selectRange(rev, false)
+		if err != nil {
+			return false, err
+		}
+		maps.Copy(set, ids)
+	}
+
+	compile := func(expr string) *regexp.Regexp {
+		if expr == "" {
+			return nil
+		}
+		if q.IgnoreCase {
+			expr = "(?i)" + expr
+		}
+		// Already validated by searchRequest.
+		return regexp.MustCompile(expr)
+	}
+	message, author, committer := compile(q.MessagePattern), compile(q.Author), compile(q.Committer)
+	matchesSignature := func(re *regexp.Regexp, s *gitdomain.Signature) bool {
+		return re == nil || re.MatchString(s.Name) || re.MatchString(s.Email)
+	}
+
+	var matches []protocol.CommitMatch
+	for _, fc := range r.sorted(set) {
+		commit := fc.commit
+		switch {
+		case message != nil && !message.MatchString(string(commit.Message)),
+			!matchesSignature(author, &commit.Author),
+			!matchesSignature(committer, commit.Committer),
+			!q.DateRange.After.IsZero() && !commit.Committer.Date.After(q.DateRange.After),
+			!q.DateRange.Before.IsZero() && !commit.Committer.Date.Before(q.DateRange.Before),
+			len(q.Paths) > 0 && !slices.ContainsFunc(q.Paths, func(p string) bool { return r.touches(fc, p) }):
+			continue
+		}
+		if q.Limit > 0 && len(matches) == q.Limit {
+			onMatches(matches)
+			return true, nil
+		}
+		match := protocol.CommitMatch{
+			Oid:       commit.ID,
+			Author:    protocol.Signature{Name: commit.Author.Name, Email: commit.Author.Email, Date: commit.Author.Date},
+			Committer: protocol.Signature{Name: commit.Committer.Name, Email: commit.Committer.Email, Date: commit.Committer.Date},
+			Parents:   commit.Parents,
+			Message:   result.MatchedString{Content: string(commit.Message)},
+		}
+		if q.IncludeModifiedFiles {
+			match.ModifiedFiles = slices.Clone(fc.changed)
+		}
+		matches = append(matches, match)
+	}
+	if len(matches) > 0 {
+		onMatches(matches)
+	}
+	return false, nil
+}
+
+func (c *FakeClient) Stat(_ context.Context, repo api.RepoName, commit api.CommitID, path string) (fs.FileInfo, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, tree, err := c.lookupTree(repo, commit)
+	if err != nil {
+		return nil, err
+	}
+	path = cleanFakePath(path)
+	if path == "." {
+		return &fileutil.FileInfo{Mode_: os.ModeDir, Sys_: objectInfo(fakeBlobOID("tree "))}, nil
+	}
+	for _, fi := range fakeEntries(tree, stdlibpath.Dir(path), false) {
+		if fi.Name() == path {
+			return fi, nil
+		}
+	}
+	return nil, &os.PathError{Op: "ls-tree", Path: path, Err: os.ErrNotExist}
+}
+
+func (c *FakeClient) PathExists(_ context.Context, repo api.RepoName, commit api.CommitID, path string) (bool, gitdomain.ObjectType, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, tree, err := c.lookupTree(repo, commit)
+	if err != nil {
+		return false, "", err
+	}
+	path = cleanFakePath(path)
+	if path == "." {
+		return true, gitdomain.ObjectTypeTree, nil
+	}
+	for _, fi := range fakeEntries(tree, stdlibpath.Dir(path), false) {
+		if fi.Name() != path {
+			continue
+		}
+		if fi.IsDir() {
+			return true, gitdomain.ObjectTypeTree, nil
+		}
+		return true, gitdomain.ObjectTypeBlob, nil
+	}
+	return false, "", nil
+}
+
+func (c *FakeClient) ReadDir(ctx context.Context, repo api.RepoName, commit api.CommitID, path string, recurse bool) ([]fs.FileInfo, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, tree, err := c.lookupTree(repo, commit)
+	if err != nil {
+		return nil, err
+	}
+	path = cleanFakePath(path)
+	fis := fakeEntries(tree, path, recurse)
+	if len(fis) == 0 && path != "." {
+		return nil, &os.PathError{Op: "git ls-tree", Path: path + "/", Err: os.ErrNotExist}
+	}
+	return truncateEntries(fis, responseLimitsFromContext(ctx).MaxEntries)
+}
+
+func (c *FakeClient) GetTree(ctx context.Context, repo api.RepoName, treeish string, path string) ([]gitdomain.TreeEntry, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	commit, err := r.resolve(treeish)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := r.treeEntries(commit, path)
+	if err != nil {
+		return nil, err
+	}
+	return truncateEntries(entries, responseLimitsFromContext(ctx).MaxEntries)
+}
+
+// treeEntries returns the entries of the tree at path in commit.
+func (r *fakeRepo) treeEntries(commit api.CommitID, path string) ([]gitdomain.TreeEntry, error) {
+	path = cleanFakePath(path)
+	fis := fakeEntries(r.commits[commit].tree, path, false)
+	if len(fis) == 0 && path != "." {
+		return nil, &os.PathError{Op: "ls-tree", Path: path, Err: os.ErrNotExist}
+	}
+
+	entries := make([]gitdomain.TreeEntry, 0, len(fis))
+	for _, fi := range fis {
+		e := gitdomain.TreeEntry{
+			Name: stdlibpath.Base(fi.Name()),
+			Mode: 0o100644,
+			Type: gitdomain.ObjectTypeBlob,
+			OID:  fi.Sys().(objectInfo).OID(),
+			Size: fi.Size(),
+		}
+		if fi.IsDir() {
+			e.Mode, e.Type = 0o040000, gitdomain.ObjectTypeTree
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func (c *FakeClient) SparseManifest(ctx context.Context, repo api.RepoName, commit api.CommitID, paths []string) ([]gitdomain.TreeEntry, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, tree, err := c.lookupTree(repo, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []gitdomain.TreeEntry{}
+	for _, fi := range fakeEntries(tree, ".", true) {
+		if fi.IsDir() || !fakePathMatches(fi.Name(), paths) {
+			continue
+		}
+		entries = append(entries, gitdomain.TreeEntry{
+			Name: fi.Name(),
+			Mode: 0o100644,
+			Type: gitdomain.ObjectTypeBlob,
+			OID:  fi.Sys().(objectInfo).OID(),
+			Size: fi.Size(),
+		})
+	}
+	return truncateEntries(entries, responseLimitsFromContext(ctx).MaxEntries)
+}
+
+func (c *FakeClient) LastCommitsForTree(ctx context.Context, repo api.RepoName, commit api.CommitID, path string) ([]gitdomain.TreeEntryCommit, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, _, err := c.lookupTree(repo, commit)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := r.treeEntries(commit, path)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]gitdomain.TreeEntryCommit, 0, len(entries))
+	for _, e := range entries {
+		commits, err := r.log(CommitsOptions{Range: string(commit), Path: stdlibpath.Join(path, e.Name), N: 1})
+		if err != nil {
+			return nil, err
+		}
+		ec := gitdomain.TreeEntryCommit{TreeEntry: e}
+		if len(commits) > 0 {
+			ec.Commit = commits[0]
+		}
+		res = append(res, ec)
+	}
+	return truncateEntries(res, responseLimitsFromContext(ctx).MaxEntries)
+}
+
+// fakePathMatches reports whether name is at or below one of paths. All
+// names match if paths is empty.
+func fakePathMatches(name string, paths []string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+	for _, p := range paths {
+		p = strings.Trim(p, "/")
+		if p == "" || name == p || strings.HasPrefix(name, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *FakeClient) NewFileReader(ctx context.Context, repo api.RepoName, commit api.CommitID, name string) (io.ReadCloser, error) {
+	return c.NewFileReaderIfNotOID(ctx, repo, commit, name, gitdomain.OID{})
+}
+
+func (c *FakeClient) NewFileReaderIfNotOID(_ context.Context, repo api.RepoName, commit api.CommitID, name string, ifNotOID gitdomain.OID) (io.ReadCloser, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, tree, err := c.lookupTree(repo, commit)
+	if err != nil {
+		return nil, err
+	}
+	content, ok := tree[cleanFakePath(name)]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: rel(name), Err: os.ErrNotExist}
+	}
+	oid := fakeBlobOID(content)
+	if ifNotOID == oid {
+		return nil, ErrNotModified
+	}
+	return &fakeBlobReader{Reader: strings.NewReader(content), objectInfo: objectInfo(oid)}, nil
+}
+
+func (c *FakeClient) ReadBlob(_ context.Context, repo api.RepoName, oid gitdomain.OID) (io.ReadCloser, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range r.order {
+		for _, content := range r.commits[id].tree {
+			if fakeBlobOID(content) == oid {
+				return &fakeBlobReader{Reader: strings.NewReader(content), objectInfo: objectInfo(oid)}, nil
+			}
+		}
+	}
+	return nil, &gitdomain.RevisionNotFoundError{Repo: repo, Spec: oid.String()}
+}
+
+// fakeBlobReader is returned by FakeClient.NewFileReader and ReadBlob. Like
+// the readers of the real client, it implements gitdomain.ObjectInfo.
+type fakeBlobReader struct {
+	io.Reader
+	objectInfo
+}
+
+func (*fakeBlobReader) Close() error { return nil }
+
+func (c *FakeClient) DiffSymbols(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error) {
+	return nil, fakeUnsupported("DiffSymbols")
+}
+
+func (c *FakeClient) Commits(_ context.Context, repo api.RepoName, opt CommitsOptions) ([]*gitdomain.Commit, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	return r.log(opt)
+}
+
+func (c *FakeClient) AncestryPath(ctx context.Context, repo api.RepoName, from, to string) ([]*gitdomain.Commit, error) {
+	return c.Commits(ctx, repo, CommitsOptions{Range: from + ".." + to, AncestryPath: true})
+}
+
+func (c *FakeClient) CountCommits(ctx context.Context, repo api.RepoName, opt CommitsOptions) (int, error) {
+	commits, err := c.Commits(ctx, repo, opt)
+	return len(commits), err
+}
+
+func (c *FakeClient) FirstEverCommit(_ context.Context, repo api.RepoName) (*gitdomain.Commit, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := r.log(CommitsOptions{Range: "HEAD"})
+	if err != nil {
+		return nil, err
+	}
+	for i := len(commits) - 1; i >= 0; i-- {
+		if len(commits[i].Parents) == 0 {
+			return commits[i], nil
+		}
+	}
+	return nil, &gitdomain.RevisionNotFoundError{Repo: repo, Spec: "HEAD"}
+}
+
+// FirstCommitForPath doesn't follow renames.
+func (c *FakeClient) FirstCommitForPath(_ context.Context, repo api.RepoName, rev, path string) (*gitdomain.Commit, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return nil, errors.New("path must be specified")
+	}
+	commits, err := r.log(CommitsOptions{Range: rev, Path: path, ChangedFiles: true})
+	if err != nil {
+		return nil, err
+	}
+	path = cleanFakePath(path)
+	for _, commit := range commits {
+		for _, f := range commit.ChangedFiles {
+			if f.Path == path && f.Status == gitdomain.ChangedFileStatusAdded {
+				commit.ChangedFiles = nil
+				return commit, nil
+			}
+		}
+	}
+	return nil, &os.PathError{Op: "git log", Path: path, Err: os.ErrNotExist}
+}
+
+func (c *FakeClient) DirectoryHistory(_ context.Context, repo api.RepoName, rev, path string, opt DirectoryHistoryOptions) ([]*gitdomain.Commit, string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, "", err
+	}
+	if opt.First < 0 {
+		return nil, "", errors.Newf("invalid page size %d", opt.First)
+	}
+	first := opt.First
+	if first == 0 {
+		first = defaultDirectoryHistoryPageSize
+	}
+
+	var commitID api.CommitID
+	var offset uint
+	if opt.After != "" {
+		if commitID, offset, err = parseDirectoryHistoryCursor(opt.After); err != nil {
+			return nil, "", err
+		}
+	} else if commitID, err = r.resolve(rev); err != nil {
+		return nil, "", err
+	}
+	if path = cleanFakePath(path); path == "." {
+		path = ""
+	}
+	commits, err := r.log(CommitsOptions{Range: string(commitID), Path: path, N: uint(first) + 1, Skip: offset})
+	if err != nil {
+		return nil, "", err
+	}
+	if len(commits) <= first {
+		return commits, "", nil
+	}
+	return commits[:first], directoryHistoryCursor(commitID, offset+uint(first)), nil
+}
+
+// AbbreviateCommit abbreviates to at least 7 characters, git's default.
+func (c *FakeClient) AbbreviateCommit(_ context.Context, repo api.RepoName, commitID api.CommitID) (string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return "", err
+	}
+	if err := gitdomain.EnsureAbsoluteCommit(commitID); err != nil {
+		return "", err
+	}
+	if _, ok := r.commits[commitID]; !ok {
+		return "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: string(commitID)}
+	}
+
+	n := 7
+	for id := range r.commits {
+		if id == commitID {
+			continue
+		}
+		common := 0
+		for common < len(id) && common < len(commitID) && id[common] == commitID[common] {
+			common++
+		}
+		n = max(n, common+1)
+	}
+	return string(commitID[:min(n, len(commitID))]), nil
+}
+
+func (c *FakeClient) ListDirectoryChildren(_ context.Context, repo api.RepoName, commit api.CommitID, dirnames []string) (map[string][]string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, tree, err := c.lookupTree(repo, commit)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, dir := range dirnames {
+		dir = cleanFakePath(dir)
+		for _, fi := range fakeEntries(tree, dir, false) {
+			paths = append(paths, fi.Name())
+		}
+	}
+	return parseDirectoryChildren(dirnames, paths), nil
+}
+
+func (c *FakeClient) Diff(context.Context, DiffOptions) (*DiffFileIterator, error) {
+	return nil, fakeUnsupported("Diff")
+}
+
+func (c *FakeClient) DiffCrossRepo(context.Context, api.RepoName, DiffOptions) (*DiffFileIterator, error) {
+	return nil, fakeUnsupported("DiffCrossRepo")
+}
+
+func (c *FakeClient) RawDiff(context.Context, DiffOptions, io.Writer) error {
+	return fakeUnsupported("RawDiff")
+}
+
+func (c *FakeClient) FormatPatch(context.Context, api.RepoName, string, string) (io.ReadCloser, error) {
+	return nil, fakeUnsupported("FormatPatch")
+}
+
+func (c *FakeClient) CreateBundle(context.Context, api.RepoName, []string, io.Writer) error {
+	return fakeUnsupported("CreateBundle")
+}
+
+func (c *FakeClient) ApplyBundle(context.Context, api.RepoName, io.Reader) error {
+	return fakeUnsupported("ApplyBundle")
+}
+
+func (c *FakeClient) ExecInWorktree(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error) {
+	return gitdomain.WorktreeExecResult{}, fakeUnsupported("ExecInWorktree")
+}
+
+func (c *FakeClient) RebasePreview(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error) {
+	return gitdomain.RebasePreview{}, fakeUnsupported("RebasePreview")
+}
+
+func (c *FakeClient) RevertCommit(context.Context, api.RepoName, api.CommitID, RevertCommitOptions) (api.CommitID, error) {
+	return "", fakeUnsupported("RevertCommit")
+}
+
+func (c *FakeClient) CherryPick(context.Context, api.RepoName, api.CommitID, string, CherryPickOptions) (api.CommitID, error) {
+	return "", fakeUnsupported("CherryPick")
+}
+
+func (c *FakeClient) SquashCommits(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOptions) (api.CommitID, error) {
+	return "", fakeUnsupported("SquashCommits")
+}
+
+func (c *FakeClient) CommitGraph(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error) {
+	return nil, fakeUnsupported("CommitGraph")
+}
+
+func (c *FakeClient) StreamCommitGraph(context.Context, api.RepoName, StreamCommitGraphOptions) (CommitGraphNodeReader, error) {
+	return nil, fakeUnsupported("StreamCommitGraph")
+}
+
+func (c *FakeClient) CommitLog(context.Context, api.RepoName, time.Time) ([]CommitLog, error) {
+	return nil, fakeUnsupported("CommitLog")
+}
+
+func (c *FakeClient) CommitsUniqueToBranch(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error) {
+	return nil, fakeUnsupported("CommitsUniqueToBranch")
+}
+
+func (c *FakeClient) LsFiles(ctx context.Context, repo api.RepoName, commit api.CommitID, pathspecs ...gitdomain.Pathspec) ([]string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, tree, err := c.lookupTree(repo, commit)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for p := range tree {
+		if len(pathspecs) == 0 || slices.ContainsFunc(pathspecs, func(spec gitdomain.Pathspec) bool {
+			want := cleanFakePath(string(spec))
+			if matched, _ := stdlibpath.Match(want, p); matched {
+				return true
+			}
+			return want == "." || p == want || strings.HasPrefix(p, want+"/")
+		}) {
+			files = append(files, p)
+		}
+	}
+	sort.Strings(files)
+	return truncateEntries(files, responseLimitsFromContext(ctx).MaxEntries)
+}
+
+func (c *FakeClient) GetCommit(_ context.Context, repo api.RepoName, id api.CommitID) (*gitdomain.Commit, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	fc, ok := r.commits[id]
+	if !ok {
+		return nil, &gitdomain.RevisionNotFoundError{Repo: repo, Spec: string(id)}
+	}
+	return fc.commit, nil
+}
+
+func (c *FakeClient) GetCommitWithChanges(context.Context, api.RepoName, api.CommitID, GetCommitOptions) (*gitdomain.CommitWithChanges, error) {
+	return nil, fakeUnsupported("GetCommitWithChanges")
+}
+
+func (c *FakeClient) GetBehindAhead(_ context.Context, repo api.RepoName, left, right string) (*gitdomain.BehindAhead, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	leftOnly, err := r.selectRange(right+".."+left, false)
+	if err != nil {
+		return nil, err
+	}
+	rightOnly, err := r.selectRange(left+".."+right, false)
+	if err != nil {
+		return nil, err
+	}
+	return &gitdomain.BehindAhead{Behind: uint32(len(leftOnly)), Ahead: uint32(len(rightOnly))}, nil
+}
+
+func (c *FakeClient) CommitActivity(_ context.Context, repo api.RepoName, opt CommitActivityOptions) ([]gitdomain.CommitActivityBucket, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := r.log(CommitsOptions{Range: opt.Range, After: opt.After, Before: opt.Before, Path: opt.Path, NoMerges: true})
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make([]time.Time, 0, len(commits))
+	for _, commit := range commits {
+		dates = append(dates, commit.Committer.Date)
+	}
+	return gitdomain.BucketCommitActivity(dates, opt.Interval, opt.After, opt.Before), nil
+}
+
+func (c *FakeClient) ContributorCount(_ context.Context, repo api.RepoName, opt ContributorOptions) ([]*gitdomain.ContributorCount, error) {
+	if opt.GroupBy != ContributorGroupByAuthor {
+		return nil, fakeUnsupported("ContributorOptions.GroupBy")
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	r, err := c.repo(repo)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := r.log(CommitsOptions{Range: opt.Range, After: opt.After, Path: opt.Path})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[gitdomain.ContributorCount]int32)
+	for _, commit := range commits {
+		counts[gitdomain.ContributorCount{Name: commit.Author.Name, Email: commit.Author.Email}]++
+	}
+	contributors := make([]*gitdomain.ContributorCount, 0, len(counts))
+	for contributor, count := range counts {
+		contributor.Count = count
+		contributors = append(contributors, &contributor)
+	}
+	sort.Slice(contributors, func(i, j int) bool {
+		if contributors[i].Count != contributors[j].Count {
+			return contributors[i].Count > contributors[j].Count
+		}
+		return contributors[i].Name < contributors[j].Name
+	})
+	return contributors, nil
+}
+
+func (c *FakeClient) LogReverseEach(context.Context, string, string, int, func(gitdomain.LogEntry) error) error {
+	return fakeUnsupported("LogReverseEach")
+}
+
+func (c *FakeClient) RevList(ctx context.Context, repo string, commit string, onCommit func(string) (bool, error)) error {
+	commits, err := c.Commits(ctx, api.RepoName(repo), CommitsOptions{Range: commit})
+	if err != nil {
+		return err
+	}
+	for _, commit := range commits {
+		next, err := onCommit(string(commit.ID))
+		if err != nil {
+			return err
+		}
+		if !next {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (c *FakeClient) SystemsInfo(context.Context) ([]protocol.SystemInfo, error) {
+	return []protocol.SystemInfo{{Address: "fake-gitserver"}}, nil
+}
+
+func (c *FakeClient) SystemInfo(_ context.Context, addr string) (protocol.SystemInfo, error) {
+	return protocol.SystemInfo{Address: addr}, nil
+}
+
+// ListShardRepos lists all repositories of the fake, sorted by name, as they
+// are all hosted on the single fake gitserver. Sizes and fetch times are not
+// tracked and always zero.
+func (c *FakeClient) ListShardRepos(context.Context, string) ([]protocol.HostedRepo, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	repos := make([]protocol.HostedRepo, 0, len(c.repos))
+	for name := range c.repos {
+		repos = append(repos, protocol.HostedRepo{Name: name})
+	}
+	sort.Slice(repos, func(i, j int) bool { return repos[i].Name < repos[j].Name })
+	return repos, nil
+}
+
+func (c *FakeClient) IsPerforcePathCloneable(context.Context, protocol.PerforceConnectionDetails, string) error {
+	return fakeUnsupported("IsPerforcePathCloneable")
+}
+
+func (c *FakeClient) CheckPerforceCredentials(context.Context, protocol.PerforceConnectionDetails) error {
+	return fakeUnsupported("CheckPerforceCredentials")
+}
+
+func (c *FakeClient) PerforceUsers(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error) {
+	return nil, fakeUnsupported("PerforceUsers")
+}
+
+func (c *FakeClient) PerforceProtectsForUser(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
+	return nil, fakeUnsupported("PerforceProtectsForUser")
+}
+
+func (c *FakeClient) PerforceProtectsForDepot(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
+	return nil, fakeUnsupported("PerforceProtectsForDepot")
+}
+
+func (c *FakeClient) PerforceGroupMembers(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error) {
+	return nil, fakeUnsupported("PerforceGroupMembers")
+}
+
+func (c *FakeClient) IsPerforceSuperUser(context.Context, protocol.PerforceConnectionDetails) error {
+	return fakeUnsupported("IsPerforceSuperUser")
+}
+
+func (c *FakeClient) PerforceGetChangelist(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error) {
+	return nil, fakeUnsupported("PerforceGetChangelist")
+}
+
+func (c *FakeClient) ListGitoliteRepos(context.Context, string) ([]*gitolite.Repo, error) {
+	return nil, fakeUnsupported("ListGitoliteRepos")
+}
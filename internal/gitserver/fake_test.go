@@ -0,0 +1,415 @@
+package gitserver
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestFakeClient(t *testing.T) {
+	ctx := context.Background()
+	const repo = api.RepoName("github.com/sourcegraph/fake")
+
+	// * merge (main)
+	// |\
+	// | * feature (feature)
+	// * | second (v1.0)
+	// |/
+	// * root
+	c := NewFakeClient(FakeRepo{
+		Name: repo,
+		Commits: []FakeCommit{
+			{Label: "root", Message: "root", Files: map[string]string{"README.md": "hello", "dir/a.go": "package a"}},
+			{Label: "second", Message: "second", Files: map[string]string{"README.md": "hello world"}},
+			{Label: "feature", Parents: []string{"root"}, Message: "feature", Files: map[string]string{"dir/sub/b.go": "package sub"}},
+			{Label: "merge", Parents: []string{"second", "feature"}, Message: "merge", Files: map[string]string{"dir/sub/b.go": "package sub"}, Deleted: []string{"dir/a.go"}},
+		},
+		Branches: map[string]string{"main": "merge", "feature": "feature"},
+		Tags:     map[string]string{"v1.0": "second"},
+	})
+
+	resolve := func(spec string) api.CommitID {
+		id, err := c.ResolveRevision(ctx, repo, spec, ResolveRevisionOptions{})
+		require.NoError(t, err, spec)
+		return id
+	}
+	root, second, feature, merge := resolve("main~1~1"), resolve("v1.0"), resolve("feature"), resolve("HEAD")
+
+	t.Run("AbbreviateCommit", func(t *testing.T) {
+		abbrev, err := c.AbbreviateCommit(ctx, repo, merge)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, len(abbrev), 7)
+		require.Equal(t, merge, resolve(abbrev))
+
+		_, err = c.AbbreviateCommit(ctx, repo, "e3f0d6b9b2e9f4a1c3b5d7e9f1a3c5b7d9e1f3a5")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+	})
+
+	t.Run("ResolveRevision", func(t *testing.T) {
+		require.Equal(t, merge, resolve(""))
+		require.Equal(t, merge, resolve("refs/heads/main"))
+		require.Equal(t, second, resolve("refs/tags/v1.0"))
+		require.Equal(t, second, resolve("main^"))
+		require.Equal(t, feature, resolve("main^2"))
+		require.Equal(t, root, resolve("feature~"))
+		require.Equal(t, merge, resolve(string(merge)))
+		require.Equal(t, merge, resolve(string(merge[:8])))
+
+		_, err := c.ResolveRevision(ctx, repo, "unknown", ResolveRevisionOptions{})
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+		_, err = c.ResolveRevision(ctx, "unknown", "HEAD", ResolveRevisionOptions{})
+		require.True(t, errors.HasType(err, &gitdomain.RepoNotExistError{}))
+	})
+
+	t.Run("CloneProgress", func(t *testing.T) {
+		r, err := c.CloneProgress(ctx, repo)
+		require.NoError(t, err)
+		defer r.Close()
+		u, err := r.Read()
+		require.NoError(t, err)
+		require.True(t, u.Cloned)
+		require.False(t, u.CloneInProgress)
+		_, err = r.Read()
+		require.Equal(t, io.EOF, err)
+	})
+
+	t.Run("OptimizeRepo", func(t *testing.T) {
+		res, err := c.OptimizeRepo(ctx, repo, MaintenanceOptions{Gc: true, CommitGraph: true})
+		require.NoError(t, err)
+		require.Equal(t, []MaintenanceTaskResult{{Task: "gc"}, {Task: "commit-graph"}}, res)
+		_, err = c.OptimizeRepo(ctx, repo, MaintenanceOptions{})
+		require.Error(t, err)
+		_, err = c.OptimizeRepo(ctx, "unknown", MaintenanceOptions{Gc: true})
+		require.True(t, errors.HasType(err, &gitdomain.RepoNotExistError{}))
+	})
+
+	t.Run("WriteCommitGraph", func(t *testing.T) {
+		require.NoError(t, c.WriteCommitGraph(ctx, repo))
+		_, err := c.CommitGraphStatus(ctx, repo)
+		require.ErrorContains(t, err, "not supported")
+	})
+
+	t.Run("ListShardRepos", func(t *testing.T) {
+		repos, err := c.ListShardRepos(ctx, "fake-gitserver")
+		require.NoError(t, err)
+		require.Equal(t, []protocol.HostedRepo{{Name: repo}}, repos)
+	})
+
+	t.Run("DiagnoseAddrForRepo", func(t *testing.T) {
+		d, err := c.DiagnoseAddrForRepo(ctx, repo)
+		require.NoError(t, err)
+		require.Equal(t, "fake-gitserver", d.Addr)
+		require.True(t, d.Cloned)
+		d, err = c.DiagnoseAddrForRepo(ctx, "unknown")
+		require.NoError(t, err)
+		require.False(t, d.Cloned)
+	})
+
+	t.Run("GetCommit", func(t *testing.T) {
+		commit, err := c.GetCommit(ctx, repo, merge)
+		require.NoError(t, err)
+		require.Equal(t, gitdomain.Message("merge"), commit.Message)
+		require.Equal(t, []api.CommitID{second, feature}, commit.Parents)
+	})
+
+	t.Run("Commits", func(t *testing.T) {
+		ids := func(opt CommitsOptions) []api.CommitID {
+			commits, err := c.Commits(ctx, repo, opt)
+			require.NoError(t, err)
+			var ids []api.CommitID
+			for _, c := range commits {
+				ids = append(ids, c.ID)
+			}
+			return ids
+		}
+		require.Equal(t, []api.CommitID{merge, feature, second, root}, ids(CommitsOptions{}))
+		require.Equal(t, []api.CommitID{merge, feature}, ids(CommitsOptions{Range: "v1.0..main"}))
+		require.Equal(t, []api.CommitID{feature, second}, ids(CommitsOptions{Range: "v1.0...feature"}))
+		require.Equal(t, []api.CommitID{feature, second}, ids(CommitsOptions{N: 2, Skip: 1}))
+		require.Equal(t, []api.CommitID{merge, feature}, ids(CommitsOptions{Path: "dir/sub"}))
+		require.Equal(t, []api.CommitID{merge}, ids(CommitsOptions{OnlyMerges: true}))
+
+		search := func(q CommitSearchQuery) (oids []api.CommitID) {
+			_, err := c.SearchCommits(ctx, repo, q, func(matches []protocol.CommitMatch) {
+				for _, m := range matches {
+					oids = append(oids, m.Oid)
+				}
+			})
+			require.NoError(t, err)
+			return oids
+		}
+		require.Equal(t, []api.CommitID{merge, feature, second, root}, search(CommitSearchQuery{}))
+		require.Equal(t, []api.CommitID{second, root}, search(CommitSearchQuery{Revisions: []string{"v1.0"}}))
+		require.Equal(t, []api.CommitID{second}, search(CommitSearchQuery{MessagePattern: "^sec"}))
+		require.Equal(t, []api.CommitID{merge, feature}, search(CommitSearchQuery{Paths: []string{"dir/sub"}}))
+		require.Equal(t, []api.CommitID{merge}, search(CommitSearchQuery{Limit: 1}))
+		_, err := c.SearchCommits(ctx, repo, CommitSearchQuery{DiffContains: "hello"}, func([]protocol.CommitMatch) {})
+		require.ErrorContains(t, err, "not supported")
+
+		commits, err := c.Commits(ctx, repo, CommitsOptions{Range: "v1.0", ChangedFiles: true})
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.ChangedFile{{Path: "README.md", Status: gitdomain.ChangedFileStatusModified}}, commits[0].ChangedFiles)
+		require.Equal(t, []gitdomain.ChangedFile{
+			{Path: "README.md", Status: gitdomain.ChangedFileStatusAdded},
+			{Path: "dir/a.go", Status: gitdomain.ChangedFileStatusAdded},
+		}, commits[1].ChangedFiles)
+
+		n, err := c.CountCommits(ctx, repo, CommitsOptions{NoMerges: true})
+		require.NoError(t, err)
+		require.Equal(t, 3, n)
+
+		first, err := c.FirstEverCommit(ctx, repo)
+		require.NoError(t, err)
+		require.Equal(t, root, first.ID)
+
+		added, err := c.FirstCommitForPath(ctx, repo, "main", "dir/sub/b.go")
+		require.NoError(t, err)
+		require.Equal(t, feature, added.ID)
+		_, err = c.FirstCommitForPath(ctx, repo, "v1.0", "dir/sub/b.go")
+		require.True(t, os.IsNotExist(err), "unexpected error %v", err)
+
+		history, cursor, err := c.DirectoryHistory(ctx, repo, "main", "dir/", DirectoryHistoryOptions{First: 2})
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		require.Equal(t, []api.CommitID{merge, feature}, []api.CommitID{history[0].ID, history[1].ID})
+		history, cursor, err = c.DirectoryHistory(ctx, repo, "main", "dir/", DirectoryHistoryOptions{First: 2, After: cursor})
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		require.Equal(t, root, history[0].ID)
+		require.Empty(t, cursor)
+
+		activity, err := c.CommitActivity(ctx, repo, CommitActivityOptions{Range: "main", Interval: gitdomain.CommitActivityIntervalDay})
+		require.NoError(t, err)
+		require.Equal(t, []gitdomain.CommitActivityBucket{{Start: fakeBaseDate, Count: 3}}, activity)
+	})
+
+	t.Run("MergeBase and GetBehindAhead", func(t *testing.T) {
+		base, err := c.MergeBase(ctx, repo, "v1.0", "feature")
+		require.NoError(t, err)
+		require.Equal(t, root, base)
+
+		ba, err := c.GetBehindAhead(ctx, repo, "feature", "main")
+		require.NoError(t, err)
+		require.Equal(t, &gitdomain.BehindAhead{Behind: 0, Ahead: 2}, ba)
+	})
+
+	t.Run("refs", func(t *testing.T) {
+		refName, commit, err := c.GetDefaultBranch(ctx, repo, true)
+		require.NoError(t, err)
+		require.Equal(t, "main", refName)
+		require.Equal(t, merge, commit)
+
+		refs, err := c.ListRefs(ctx, repo, ListRefsOpts{HeadsOnly: true, Contains: feature})
+		require.NoError(t, err)
+		require.Len(t, refs, 2)
+		require.Equal(t, "refs/heads/feature", refs[0].Name)
+		require.True(t, refs[1].IsHead)
+
+		_, err = c.CreateBranch(ctx, repo, "feature", "HEAD")
+		require.True(t, errors.HasType(err, &gitdomain.RefUpdateConflictError{}))
+		created, err := c.CreateBranch(ctx, repo, "new", "v1.0")
+		require.NoError(t, err)
+		require.Equal(t, second, created)
+		require.Equal(t, second, resolve("new"))
+		require.NoError(t, c.DeleteBranch(ctx, repo, "new"))
+		require.NoError(t, c.CreateTag(ctx, repo, "v2.0", "main", TagOptions{}))
+		require.Equal(t, merge, resolve("v2.0"))
+		tag, err := c.GetTag(ctx, repo, "v2.0")
+		require.NoError(t, err)
+		require.Equal(t, string(merge), tag.Target.ID.String())
+		require.Nil(t, tag.Tagger)
+		_, err = c.GetTag(ctx, repo, "missing")
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+
+		ensured, err := c.EnsureRevision(ctx, repo, "v1.0", WaitOptions{})
+		require.NoError(t, err)
+		require.Equal(t, second, ensured.CommitID)
+		_, err = c.EnsureRevision(ctx, repo, "missing", WaitOptions{})
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+
+		target, err := c.SymbolicRef(ctx, repo, "HEAD")
+		require.NoError(t, err)
+		require.Equal(t, "refs/heads/main", target)
+		exists, err := c.RefExists(ctx, repo, "HEAD")
+		require.NoError(t, err)
+		require.True(t, exists)
+		exists, err = c.RefExists(ctx, repo, "refs/heads/new")
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		tags, err := c.ListTags(ctx, repo, ListTagsOptions{})
+		require.NoError(t, err)
+		require.Len(t, tags, 2)
+		require.Equal(t, "refs/tags/v2.0", tags[0].Name)
+		require.Equal(t, "refs/tags/v1.0", tags[1].Name)
+		tags, err = c.ListTags(ctx, repo, ListTagsOptions{Patterns: []string{"v1.*"}})
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		require.Equal(t, second, tags[0].CommitID)
+		tags, err = c.ListTags(ctx, repo, ListTagsOptions{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		require.Equal(t, "refs/tags/v2.0", tags[0].Name)
+
+		branches, err := c.ListBranches(ctx, repo, ListBranchesOptions{})
+		require.NoError(t, err)
+		require.Len(t, branches, 2)
+		require.Equal(t, "refs/heads/main", branches[0].Name)
+		require.Equal(t, &gitdomain.BehindAhead{}, branches[0].BehindAhead)
+		require.Equal(t, "refs/heads/feature", branches[1].Name)
+		require.Equal(t, &gitdomain.BehindAhead{Behind: 2, Ahead: 0}, branches[1].BehindAhead)
+		require.Equal(t, feature, branches[1].Commit.ID)
+	})
+
+	t.Run("files", func(t *testing.T) {
+		r, err := c.NewFileReader(ctx, repo, merge, "README.md")
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "hello world", string(content))
+
+		_, err = c.NewFileReader(ctx, repo, merge, "dir/a.go")
+		require.True(t, os.IsNotExist(err))
+
+		files, err := c.LsFiles(ctx, repo, root)
+		require.NoError(t, err)
+		require.Equal(t, []string{"README.md", "dir/a.go"}, files)
+
+		fi, err := c.Stat(ctx, repo, merge, "dir/sub")
+		require.NoError(t, err)
+		require.True(t, fi.IsDir())
+
+		exists, typ, err := c.PathExists(ctx, repo, merge, "dir/sub")
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, gitdomain.ObjectTypeTree, typ)
+		exists, typ, err = c.PathExists(ctx, repo, merge, "README.md")
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, gitdomain.ObjectTypeBlob, typ)
+		exists, _, err = c.PathExists(ctx, repo, merge, "dir/missing")
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		r, err = c.NewFileReader(ctx, repo, merge, "README.md")
+		require.NoError(t, err)
+		fi, err = c.Stat(ctx, repo, merge, "README.md")
+		require.NoError(t, err)
+		require.Equal(t, fi.Sys().(gitdomain.ObjectInfo).OID(), r.(gitdomain.ObjectInfo).OID())
+		require.NoError(t, r.Close())
+		_, err = c.NewFileReaderIfNotOID(ctx, repo, merge, "README.md", r.(gitdomain.ObjectInfo).OID())
+		require.Equal(t, ErrNotModified, err)
+		r, err = c.NewFileReaderIfNotOID(ctx, repo, root, "README.md", r.(gitdomain.ObjectInfo).OID())
+		require.NoError(t, err)
+		content, err = io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "hello", string(content))
+
+		r, err = c.ReadBlob(ctx, repo, fi.Sys().(gitdomain.ObjectInfo).OID())
+		require.NoError(t, err)
+		content, err = io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "hello world", string(content))
+		_, err = c.ReadBlob(ctx, repo, gitdomain.OID{})
+		require.True(t, errors.HasType(err, &gitdomain.RevisionNotFoundError{}))
+
+		tree, err := c.GetTree(ctx, repo, "main", "")
+		require.NoError(t, err)
+		require.Len(t, tree, 2)
+		require.Equal(t, gitdomain.TreeEntry{Name: "README.md", Mode: 0o100644, Type: gitdomain.ObjectTypeBlob, OID: fakeBlobOID("hello world"), Size: 11}, tree[0])
+		require.Equal(t, "dir", tree[1].Name)
+		require.Equal(t, gitdomain.ObjectTypeTree, tree[1].Type)
+		tree, err = c.GetTree(ctx, repo, "main", "dir")
+		require.NoError(t, err)
+		require.Len(t, tree, 1)
+		require.Equal(t, "sub", tree[0].Name)
+		_, err = c.GetTree(ctx, repo, "main", "README.md")
+		require.True(t, os.IsNotExist(err))
+
+		manifest, err := c.SparseManifest(ctx, repo, merge, []string{"dir/"})
+		require.NoError(t, err)
+		require.Len(t, manifest, 1)
+		require.Equal(t, "dir/sub/b.go", manifest[0].Name)
+		manifest, err = c.SparseManifest(ctx, repo, merge, nil)
+		require.NoError(t, err)
+		require.Len(t, manifest, 2)
+
+		lastCommits, err := c.LastCommitsForTree(ctx, repo, merge, "")
+		require.NoError(t, err)
+		require.Len(t, lastCommits, 2)
+		require.Equal(t, "README.md", lastCommits[0].Name)
+		require.Equal(t, second, lastCommits[0].Commit.ID)
+		require.Equal(t, "dir", lastCommits[1].Name)
+		require.Equal(t, merge, lastCommits[1].Commit.ID)
+
+		fis, err := c.ReadDir(ctx, repo, merge, "", true)
+		require.NoError(t, err)
+		var names []string
+		for _, fi := range fis {
+			names = append(names, fi.Name())
+		}
+		require.Equal(t, []string{"README.md", "dir", "dir/sub", "dir/sub/b.go"}, names)
+
+		children, err := c.ListDirectoryChildren(ctx, repo, merge, []string{"", "dir/"})
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{"": {"README.md", "dir"}, "dir/": {"dir/sub"}}, children)
+
+		archive, err := c.ArchiveReader(ctx, repo, ArchiveOptions{Treeish: "feature", Format: ArchiveFormatTar, Paths: []string{"dir"}})
+		require.NoError(t, err)
+		tr := tar.NewReader(archive)
+		var archived []string
+		for {
+			h, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			archived = append(archived, h.Name)
+		}
+		require.Equal(t, []string{"dir/a.go", "dir/sub/b.go"}, archived)
+
+		opts := ArchiveOptions{Treeish: string(feature), Format: ArchiveFormatTar, Paths: []string{"dir"}}
+		archive, err = c.ArchiveReader(ctx, repo, opts)
+		require.NoError(t, err)
+		full, err := io.ReadAll(archive)
+		require.NoError(t, err)
+		opts.Offset = 512
+		archive, err = c.ArchiveReader(ctx, repo, opts)
+		require.NoError(t, err)
+		rest, err := io.ReadAll(archive)
+		require.NoError(t, err)
+		require.Equal(t, full[512:], rest)
+
+		_, err = c.ArchiveReader(ctx, repo, ArchiveOptions{Treeish: "feature", Format: ArchiveFormatTar, Offset: 512})
+		require.Error(t, err)
+
+		archive, err = c.ArchiveReader(ctx, repo, ArchiveOptions{Treeish: "feature", Format: ArchiveFormatTar, Paths: []string{"dir"}, Filter: "blob:none"})
+		require.NoError(t, err)
+		tr = tar.NewReader(archive)
+		h, err := tr.Next()
+		require.NoError(t, err)
+		require.Equal(t, gitdomain.OmittedBlobsManifestPath, h.Name)
+		var omitted []gitdomain.OmittedBlob
+		require.NoError(t, json.NewDecoder(tr).Decode(&omitted))
+		require.Len(t, omitted, 2)
+		require.Equal(t, "dir/a.go", omitted[0].Path)
+		_, err = tr.Next()
+		require.Equal(t, io.EOF, err)
+	})
+
+	t.Run("invalid description", func(t *testing.T) {
+		require.Panics(t, func() {
+			NewFakeClient(FakeRepo{Name: repo, Commits: []FakeCommit{{Parents: []string{"unknown"}}}})
+		})
+	})
+}
@@ -8,11 +8,11 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
-	"syscall"
 
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -102,11 +102,18 @@ func (l *LocalGitCommand) DividedOutput(ctx context.Context) ([]byte, []byte, er
 	err := cmd.Run()
 	exitStatus := -10810         // sentinel value to indicate not set
 	if cmd.ProcessState != nil { // is nil if process failed to start
-		exitStatus = cmd.ProcessState.Sys().(syscall.WaitStatus).ExitStatus()
+		exitStatus = cmd.ProcessState.ExitCode()
 	}
 	l.exitStatus = exitStatus
 
-	return stdoutBuf.Bytes(), bytes.TrimSpace(stderrBuf.Bytes()), err
+	stderr := bytes.TrimSpace(stderrBuf.Bytes())
+	if err != nil {
+		// Report the same typed errors as gitserver does.
+		if typed := gitdomain.ParseGitError(l.Repo(), stderr); typed != nil {
+			err = typed
+		}
+	}
+	return stdoutBuf.Bytes(), stderr, err
 }
 
 func (l *LocalGitCommand) Output(ctx context.Context) ([]byte, error) {
@@ -163,6 +170,9 @@ func (c *RemoteGitCommand) DividedOutput(ctx context.Context) ([]byte, []byte, e
 	if err != nil {
 		if v := (&CommandStatusError{}); errors.As(err, &v) {
 			c.exitStatus = int(v.StatusCode)
+			if v.Cause != nil {
+				return stdout, []byte(v.Stderr), v.Cause
+			}
 			if v.Message != "" {
 				return stdout, []byte(v.Stderr), errors.New(v.Message)
 			} else {
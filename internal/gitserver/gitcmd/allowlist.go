@@ -0,0 +1,52 @@
+package gitcmd
+
+// allowlist are the subcommands that can be built, and the flags that are
+// allowed for each of them. It is a subset of what gitserver allows to run:
+// gitserver still checks every command it receives.
+var allowlist = map[string]set{
+	"diff": newSet(
+		"--name-only", "--name-status", "--no-renames", "--find-renames",
+		"--no-prefix", "--full-index", "--inter-hunk-context", "--unified",
+		"-w", "-b", "--ignore-blank-lines", "-z",
+	),
+	"log": newSet(commonLogFlags...),
+	"ls-files": newSet(
+		"--with-tree", "-z",
+	),
+	"ls-tree": newSet(
+		"--long", "--full-name", "--name-only", "--object-only",
+		"-r", "-t", "-z",
+	),
+	"rev-list": newSet(
+		"--count", "--left-right", "--reverse", "--date-order", "--topo-order",
+		"--max-parents", "--max-count", "--first-parent", "--ancestry-path",
+		"--after", "--before", "--no-merges", "--merges", "--parents",
+		"--skip", "--author", "--fixed-strings", "--grep", "--regexp-ignore-case",
+	),
+	"shortlog": newSet(
+		"-s", "-n", "-e", "-c", "--group", "--no-merges", "--after", "--before",
+	),
+	"show": newSet(commonLogFlags...),
+}
+
+// commonLogFlags are the flags shared by git log and git show.
+var commonLogFlags = []string{
+	"--format", "--pretty", "--date", "--decorate", "--no-patch",
+	"--name-only", "--name-status", "--find-renames", "--no-renames",
+	"--max-count", "--skip", "--after", "--before", "--since", "--until",
+	"--author", "--committer", "--regexp-ignore-case", "--fixed-strings",
+	"--no-merges", "--merges", "--first-parent", "--topo-order", "--date-order",
+	"--reverse", "--follow", "--full-history", "--parents", "--ancestry-path",
+	"--grep", "--diff-filter", "--all", "--raw", "-m", "--no-abbrev",
+	"--ignore-submodules", "-z",
+}
+
+type set map[string]struct{}
+
+func newSet(items ...string) set {
+	s := make(set, len(items))
+	for _, item := range items {
+		s[item] = struct{}{}
+	}
+	return s
+}
@@ -0,0 +1,172 @@
+// Package gitcmd builds the arguments of the ad-hoc git commands that the
+// gitserver client runs on gitserver.
+//
+// Flags are checked against an allowlist per subcommand, and revisions and
+// paths are kept apart from flags, so that a user-influenced string can't be
+// interpreted as an option:
+//
+//	args, err := gitcmd.LsTree().Recursive().Rev(commit).Path(dir).Args()
+package gitcmd
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Command is a git command that is being built. The methods of Command record
+// the first invalid argument, which Args returns as an error.
+type Command struct {
+	subcommand string
+	flags      []string
+	revs       []string
+	paths      []string
+	separate   bool
+	err        error
+}
+
+// New returns a builder for the given git subcommand, which must be in the
+// allowlist.
+func New(subcommand string) *Command {
+	c := &Command{subcommand: subcommand}
+	if _, ok := allowlist[subcommand]; !ok {
+		c.err = errors.Newf("git subcommand %q is not allowed", subcommand)
+	}
+	return c
+}
+
+// Diff returns a builder for git diff.
+func Diff() *Command { return New("diff") }
+
+// Log returns a builder for git log.
+func Log() *Command { return New("log") }
+
+// LsFiles returns a builder for git ls-files.
+func LsFiles() *Command { return New("ls-files") }
+
+// LsTree returns a builder for git ls-tree.
+func LsTree() *Command { return New("ls-tree") }
+
+// RevList returns a builder for git rev-list.
+func RevList() *Command { return New("rev-list") }
+
+// Show returns a builder for git show.
+func Show() *Command { return New("show") }
+
+// Shortlog returns a builder for git shortlog.
+func Shortlog() *Command { return New("shortlog") }
+
+// Flag adds flags without a value, like --name-only. Every flag must be
+// allowed for the subcommand.
+func (c *Command) Flag(flags ...string) *Command {
+	for _, flag := range flags {
+		if strings.Contains(flag, "=") {
+			c.fail(errors.Newf("flag %q has a value, use FlagValue instead", flag))
+			return c
+		}
+		c.addFlag(flag, flag)
+	}
+	return c
+}
+
+// FlagValue adds a flag with a value, as in --format=%H. The value is attached
+// with "=", so it is never interpreted as an option of its own, whatever it
+// contains.
+func (c *Command) FlagValue(flag, value string) *Command {
+	if strings.Contains(flag, "=") {
+		c.fail(errors.Newf("invalid flag name %q", flag))
+		return c
+	}
+	c.addFlag(flag, flag+"="+value)
+	return c
+}
+
+// NullTerminated separates the entries of the output with NUL bytes instead
+// of newlines (-z).
+func (c *Command) NullTerminated() *Command { return c.Flag("-z") }
+
+// NameOnly adds --name-only.
+func (c *Command) NameOnly() *Command { return c.Flag("--name-only") }
+
+// Recursive makes git ls-tree recurse into subtrees (-r).
+func (c *Command) Recursive() *Command { return c.Flag("-r") }
+
+// MaxCount limits the number of commits to n (--max-count).
+func (c *Command) MaxCount(n int) *Command {
+	if n < 0 {
+		c.fail(errors.Newf("invalid max count %d", n))
+		return c
+	}
+	return c.FlagValue("--max-count", strconv.Itoa(n))
+}
+
+// Rev adds revisions, like commit IDs, ranges or "<rev>:<path>" objects. A
+// revision must not be empty or start with a "-".
+func (c *Command) Rev(revs ...string) *Command {
+	for _, rev := range revs {
+		if rev == "" {
+			c.fail(errors.New("empty revision"))
+			return c
+		}
+		if strings.HasPrefix(rev, "-") {
+			c.fail(errors.Newf("invalid revision %q: must not start with a dash", rev))
+			return c
+		}
+		c.revs = append(c.revs, rev)
+	}
+	return c
+}
+
+// Path adds paths or pathspecs. Paths are placed after "--", so git never
+// interprets them as flags or revisions.
+func (c *Command) Path(paths ...string) *Command {
+	c.paths = append(c.paths, paths...)
+	return c
+}
+
+// SeparatePaths adds the "--" that ends the revisions even if no paths are
+// added, so that git never looks for a file named like a revision.
+func (c *Command) SeparatePaths() *Command {
+	c.separate = true
+	return c
+}
+
+// Args returns the arguments of the command, starting with the subcommand,
+// or the first invalid argument that was added.
+func (c *Command) Args() ([]string, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	args := make([]string, 0, 2+len(c.flags)+len(c.revs)+len(c.paths))
+	args = append(args, c.subcommand)
+	args = append(args, c.flags...)
+	args = append(args, c.revs...)
+	if len(c.paths) > 0 || c.separate {
+		args = append(args, "--")
+		args = append(args, c.paths...)
+	}
+	return args, nil
+}
+
+func (c *Command) addFlag(name, arg string) {
+	if c.err != nil {
+		return
+	}
+	if !strings.HasPrefix(name, "-") || name == "-" || name == "--" {
+		c.fail(errors.Newf("invalid flag %q", name))
+		return
+	}
+	if _, ok := allowlist[c.subcommand][name]; !ok {
+		c.fail(errors.Newf("flag %q is not allowed for git %s", name, c.subcommand))
+		return
+	}
+	c.flags = append(c.flags, arg)
+}
+
+func (c *Command) fail(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+}
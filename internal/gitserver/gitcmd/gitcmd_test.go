@@ -0,0 +1,106 @@
+package gitcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommand_Args(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		cmd  *Command
+		want []string
+	}{
+		{
+			name: "ls-tree",
+			cmd:  LsTree().Flag("--long", "--full-name").NullTerminated().Recursive().Rev("abc").Path("dir/"),
+			want: []string{"ls-tree", "--long", "--full-name", "-z", "-r", "abc", "--", "dir/"},
+		},
+		{
+			name: "paths after revisions",
+			cmd:  LsTree().Path("a").Rev("HEAD").Path("-b"),
+			want: []string{"ls-tree", "HEAD", "--", "a", "-b"},
+		},
+		{
+			name: "no paths",
+			cmd:  RevList().Flag("--count").Rev("a...b"),
+			want: []string{"rev-list", "--count", "a...b"},
+		},
+		{
+			name: "separated without paths",
+			cmd:  Shortlog().Flag("-s", "-n").Rev("HEAD").SeparatePaths(),
+			want: []string{"shortlog", "-s", "-n", "HEAD", "--"},
+		},
+		{
+			name: "flag values are attached",
+			cmd:  Log().FlagValue("--author", "--exec=evil").MaxCount(3).Rev("HEAD"),
+			want: []string{"log", "--author=--exec=evil", "--max-count=3", "HEAD"},
+		},
+		{
+			name: "object with path",
+			cmd:  Show().Rev("abc:.gitmodules"),
+			want: []string{"show", "abc:.gitmodules"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			args, err := tc.cmd.Args()
+			require.NoError(t, err)
+			require.Equal(t, tc.want, args)
+		})
+	}
+}
+
+func TestCommand_Args_invalid(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		cmd     *Command
+		wantErr string
+	}{
+		{
+			name:    "unknown subcommand",
+			cmd:     New("upload-pack"),
+			wantErr: `git subcommand "upload-pack" is not allowed`,
+		},
+		{
+			name:    "flag not in allowlist",
+			cmd:     Log().Flag("--output"),
+			wantErr: `flag "--output" is not allowed for git log`,
+		},
+		{
+			name:    "flag of another subcommand",
+			cmd:     LsTree().Flag("--count"),
+			wantErr: `flag "--count" is not allowed for git ls-tree`,
+		},
+		{
+			name:    "not a flag",
+			cmd:     Diff().Flag("HEAD"),
+			wantErr: `invalid flag "HEAD"`,
+		},
+		{
+			name:    "value smuggled into flag",
+			cmd:     Log().Flag("--format=%H"),
+			wantErr: `flag "--format=%H" has a value, use FlagValue instead`,
+		},
+		{
+			name:    "revision looks like an option",
+			cmd:     RevList().Rev("--output=/tmp/x"),
+			wantErr: `invalid revision "--output=/tmp/x": must not start with a dash`,
+		},
+		{
+			name:    "empty revision",
+			cmd:     Show().Rev(""),
+			wantErr: "empty revision",
+		},
+		{
+			name:    "first error wins",
+			cmd:     Log().Rev("-a").Flag("--bogus"),
+			wantErr: `invalid revision "-a": must not start with a dash`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.cmd.Args()
+			require.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package gitdomain
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// OmittedBlobsManifestPath is the path of the manifest that archives created
+// with a blob filter contain. It lists the omitted files as a JSON array of
+// OmittedBlob.
+const OmittedBlobsManifestPath = ".sourcegraph-omitted-blobs.json"
+
+// OmittedBlob is a file that a blob filter left out of an archive. The blob
+// can be read with its OID if it is needed after all.
+type OmittedBlob struct {
+	Path string `json:"path"`
+	OID  string `json:"oid"`
+	Size int64  `json:"size"`
+}
+
+// ParseBlobFilter parses a filter spec in the syntax of git's --filter option
+// and returns the size in bytes from which blobs are omitted. Only the blob
+// filters are supported: "blob:none" omits all blobs and "blob:limit=<n>"
+// omits blobs of at least n bytes, where n may have a k, m or g suffix.
+func ParseBlobFilter(spec string) (int64, error) {
+	if spec == "blob:none" {
+		return 0, nil
+	}
+	n, ok := strings.CutPrefix(spec, "blob:limit=")
+	if !ok {
+		return 0, errors.Newf("unsupported filter spec %q", spec)
+	}
+
+	unit := int64(1)
+	switch {
+	case strings.HasSuffix(n, "k"):
+		unit = 1 << 10
+	case strings.HasSuffix(n, "m"):
+		unit = 1 << 20
+	case strings.HasSuffix(n, "g"):
+		unit = 1 << 30
+	}
+	if unit != 1 {
+		n = n[:len(n)-1]
+	}
+	limit, err := strconv.ParseInt(n, 10, 64)
+	if err != nil || limit < 0 || limit > (1<<62)/unit {
+		return 0, errors.Newf("invalid size in filter spec %q", spec)
+	}
+	return limit * unit, nil
+}
@@ -0,0 +1,37 @@
+package gitdomain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBlobFilter(t *testing.T) {
+	for spec, want := range map[string]int64{
+		"blob:none":        0,
+		"blob:limit=0":     0,
+		"blob:limit=512":   512,
+		"blob:limit=100k":  100 << 10,
+		"blob:limit=1m":    1 << 20,
+		"blob:limit=2g":    2 << 30,
+		"blob:limit=1024m": 1 << 30,
+	} {
+		limit, err := ParseBlobFilter(spec)
+		require.NoError(t, err, spec)
+		require.Equal(t, want, limit, spec)
+	}
+
+	for _, spec := range []string{
+		"",
+		"tree:0",
+		"blob:limit=",
+		"blob:limit=m",
+		"blob:limit=-1",
+		"blob:limit=1t",
+		"blob:limit=99999999999999999999",
+		"sparse:oid=HEAD:.sparse",
+	} {
+		_, err := ParseBlobFilter(spec)
+		require.Error(t, err, spec)
+	}
+}
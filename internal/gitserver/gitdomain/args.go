@@ -0,0 +1,51 @@
+package gitdomain
+
+import "strings"
+
+// redactedArg replaces sanitized argument values.
+const redactedArg = "<redacted>"
+
+// sensitiveFlags are git flags whose value is a user supplied pattern that is
+// matched against file contents or commit messages.
+var sensitiveFlags = []string{"-S", "-G", "-e", "--grep", "--grep-reflog"}
+
+// SanitizeArgs returns a copy of the given git arguments that is safe to
+// record in traces and logs: the values of flags that search contents, such
+// as git log -S<pattern>, are redacted. Subcommands, other flags, revspecs
+// and paths are kept.
+func SanitizeArgs(args []string) []string {
+	out := make([]string, len(args))
+	redactNext := false
+	for i, arg := range args {
+		out[i] = arg
+
+		if redactNext {
+			out[i] = redactedArg
+			redactNext = false
+			continue
+		}
+		if arg == "--" {
+			// Everything that follows is a path.
+			copy(out[i:], args[i:])
+			break
+		}
+
+		for _, flag := range sensitiveFlags {
+			if arg == flag {
+				redactNext = true
+				break
+			}
+			if strings.HasPrefix(flag, "--") {
+				if strings.HasPrefix(arg, flag+"=") {
+					out[i] = flag + "=" + redactedArg
+					break
+				}
+			} else if strings.HasPrefix(arg, flag) {
+				// The value of short flags can be attached, as in -Sfoo.
+				out[i] = flag + redactedArg
+				break
+			}
+		}
+	}
+	return out
+}
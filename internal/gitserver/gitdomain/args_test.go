@@ -0,0 +1,45 @@
+package gitdomain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeArgs(t *testing.T) {
+	for _, tc := range []struct {
+		args []string
+		want []string
+	}{
+		{
+			args: []string{"log", "--format=%H", "-n", "10", "HEAD", "--", "README.md"},
+			want: []string{"log", "--format=%H", "-n", "10", "HEAD", "--", "README.md"},
+		},
+		{
+			args: []string{"log", "-Ssecret", "-G", "pass.*word", "HEAD"},
+			want: []string{"log", "-S<redacted>", "-G", "<redacted>", "HEAD"},
+		},
+		{
+			args: []string{"log", "--grep=token", "--grep-reflog", "token", "--all-match"},
+			want: []string{"log", "--grep=<redacted>", "--grep-reflog", "<redacted>", "--all-match"},
+		},
+		{
+			// Paths are never redacted, even if they look like flags.
+			args: []string{"grep", "-e", "key", "--", "-Sfile"},
+			want: []string{"grep", "-e", "<redacted>", "--", "-Sfile"},
+		},
+		{
+			args: []string{},
+			want: []string{},
+		},
+	} {
+		got := SanitizeArgs(tc.args)
+		require.Equal(t, tc.want, got)
+	}
+
+	t.Run("does not modify the input", func(t *testing.T) {
+		args := []string{"log", "-Ssecret"}
+		SanitizeArgs(args)
+		require.Equal(t, []string{"log", "-Ssecret"}, args)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -156,6 +157,66 @@ type Commit struct {
 	Message   Message      `json:"Message,omitempty"`
 	// Parents are the commit IDs of this commit's parent commits.
 	Parents []api.CommitID `json:"Parents,omitempty"`
+	// ChangedFiles are the files changed by the commit relative to its first
+	// parent. They're only populated when requested, see
+	// CommitsOptions.ChangedFiles.
+	ChangedFiles []ChangedFile `json:"ChangedFiles,omitempty"`
+}
+
+// ChangedFile is a file changed by a commit.
+type ChangedFile struct {
+	Path   string
+	Status ChangedFileStatus
+	// OldPath is the path of the file before a rename.
+	OldPath string `json:",omitempty"`
+}
+
+// ChangedFileStatus is how a commit changed a file.
+type ChangedFileStatus int
+
+const (
+	ChangedFileStatusUnknown ChangedFileStatus = iota
+	ChangedFileStatusAdded
+	ChangedFileStatusModified
+	ChangedFileStatusDeleted
+	ChangedFileStatusRenamed
+)
+
+// ChangedFileStatusFromGit returns the status for a status letter of git
+// diff --name-status, like "M" or "R100".
+func ChangedFileStatusFromGit(s string) ChangedFileStatus {
+	if s == "" {
+		return ChangedFileStatusUnknown
+	}
+	switch s[0] {
+	case 'A':
+		return ChangedFileStatusAdded
+	case 'M', 'T':
+		// A type change, like a file that became a symlink, is treated as
+		// a modification.
+		return ChangedFileStatusModified
+	case 'D':
+		return ChangedFileStatusDeleted
+	case 'R':
+		return ChangedFileStatusRenamed
+	default:
+		return ChangedFileStatusUnknown
+	}
+}
+
+func (s ChangedFileStatus) String() string {
+	switch s {
+	case ChangedFileStatusAdded:
+		return "A"
+	case ChangedFileStatusModified:
+		return "M"
+	case ChangedFileStatusDeleted:
+		return "D"
+	case ChangedFileStatusRenamed:
+		return "R"
+	default:
+		return "?"
+	}
 }
 
 func (c *Commit) ToProto() *proto.GitCommit {
@@ -208,6 +269,32 @@ func CommitFromProto(p *proto.GitCommit) *Commit {
 	}
 }
 
+// SignatureFromProto converts p to a Signature. If p has no date, the date is
+// zero.
+func SignatureFromProto(p *proto.GitSignature) Signature {
+	s := Signature{
+		Name:  string(p.GetName()),
+		Email: string(p.GetEmail()),
+	}
+	if p.GetDate() != nil {
+		s.Date = p.GetDate().AsTime()
+	}
+	return s
+}
+
+// ToProto converts s to its protobuf representation. A zero date is left
+// unset.
+func (s Signature) ToProto() *proto.GitSignature {
+	p := &proto.GitSignature{
+		Name:  []byte(s.Name),
+		Email: []byte(s.Email),
+	}
+	if !s.Date.IsZero() {
+		p.Date = timestamppb.New(s.Date)
+	}
+	return p
+}
+
 // Message represents a git commit message
 type Message string
 
@@ -310,6 +397,34 @@ func (h *Hunk) ToProto() *proto.BlameHunk {
 	}
 }
 
+// BlameAuthorSummary is the number of lines of a file or directory an author
+// last touched, according to git blame.
+type BlameAuthorSummary struct {
+	Name      string
+	Email     string
+	LineCount int
+	// LastTouched is the most recent author date of the counted lines.
+	LastTouched time.Time
+}
+
+func BlameAuthorSummaryFromProto(p *proto.BlameAuthorSummary) *BlameAuthorSummary {
+	return &BlameAuthorSummary{
+		Name:        p.GetName(),
+		Email:       p.GetEmail(),
+		LineCount:   int(p.GetLineCount()),
+		LastTouched: p.GetLastTouched().AsTime(),
+	}
+}
+
+func (s *BlameAuthorSummary) ToProto() *proto.BlameAuthorSummary {
+	return &proto.BlameAuthorSummary{
+		Name:        s.Name,
+		Email:       s.Email,
+		LineCount:   uint32(s.LineCount),
+		LastTouched: timestamppb.New(s.LastTouched),
+	}
+}
+
 // Signature represents a commit signature
 type Signature struct {
 	// Name is the name of the author or committer.
@@ -360,6 +475,12 @@ type ContributorCount struct {
 	Name  string
 	Email string
 	Count int32
+
+	// CommitterName and CommitterEmail are only set when commits are grouped
+	// by both author and committer, in which case Name and Email are the
+	// author.
+	CommitterName  string
+	CommitterEmail string
 }
 
 func (p *ContributorCount) String() string {
@@ -412,12 +533,689 @@ func (r *Ref) ToProto() *proto.GitRef {
 	}
 }
 
+// RefChange is a ref whose target moved, as returned by RefsChangedSince.
+type RefChange struct {
+	// Name is the full name of the ref (e.g., "refs/heads/mybranch").
+	Name string
+	// CommitID is the commit the ref points at now. For tags, it's the commit
+	// the tag is attached to. It is empty if the ref was deleted.
+	CommitID api.CommitID
+	// ChangedAt is when gitserver recorded the move, usually when it fetched
+	// the repo.
+	ChangedAt time.Time
+}
+
+// Deleted returns true if the ref was deleted.
+func (c RefChange) Deleted() bool {
+	return c.CommitID == ""
+}
+
+func RefChangeFromProto(p *proto.RefChange) RefChange {
+	return RefChange{
+		Name:      p.GetRefName(),
+		CommitID:  api.CommitID(p.GetTargetCommit()),
+		ChangedAt: p.GetChangedAt().AsTime(),
+	}
+}
+
+func (c RefChange) ToProto() *proto.RefChange {
+	return &proto.RefChange{
+		RefName:      c.Name,
+		TargetCommit: string(c.CommitID),
+		ChangedAt:    timestamppb.New(c.ChangedAt),
+	}
+}
+
+// RefChanges is the result of RefsChangedSince.
+type RefChanges struct {
+	// Changes are the refs that moved.
+	Changes []RefChange
+	// StateToken identifies the state of the refs after the changes. Passing
+	// it to the next call only returns refs that moved since.
+	StateToken string
+	// Full is true if Changes contains all refs, because the changes since
+	// the given state could not be computed. Refs missing from Changes were
+	// deleted.
+	Full bool
+}
+
+// BranchCommit is a commit that landed on a branch.
+type BranchCommit struct {
+	ID          api.CommitID
+	CommittedAt time.Time
+}
+
+func BranchCommitFromProto(p *proto.BranchCommit) BranchCommit {
+	return BranchCommit{
+		ID:          api.CommitID(p.GetCommitSha()),
+		CommittedAt: p.GetCommittedAt().AsTime(),
+	}
+}
+
+func (c BranchCommit) ToProto() *proto.BranchCommit {
+	return &proto.BranchCommit{
+		CommitSha:   string(c.ID),
+		CommittedAt: timestamppb.New(c.CommittedAt),
+	}
+}
+
+// BranchCommits are the commits that landed on a branch when it moved, as
+// returned by WatchDefaultBranchCommits.
+type BranchCommits struct {
+	// Commits are the commits that landed, oldest first.
+	Commits []BranchCommit
+	// Head is the commit the branch points at after the move.
+	Head api.CommitID
+	// Rewritten is true if the branch moved to a commit that doesn't descend
+	// from the previous head, for example because of a force push. Commits
+	// then only contain the commits not reachable from the previous head, and
+	// anything derived from the previous history should be recomputed.
+	Rewritten bool
+}
+
+// CommitActivityInterval is the size of the buckets commits are counted in by
+// CommitActivity.
+type CommitActivityInterval int
+
+const (
+	CommitActivityIntervalDay CommitActivityInterval = iota
+	// CommitActivityIntervalWeek buckets start on Monday.
+	CommitActivityIntervalWeek
+)
+
+func CommitActivityIntervalFromProto(p proto.CommitActivityInterval) (CommitActivityInterval, error) {
+	switch p {
+	case proto.CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_DAY:
+		return CommitActivityIntervalDay, nil
+	case proto.CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_WEEK:
+		return CommitActivityIntervalWeek, nil
+	}
+	return 0, errors.Newf("invalid commit activity interval %v", p)
+}
+
+func (i CommitActivityInterval) ToProto() proto.CommitActivityInterval {
+	if i == CommitActivityIntervalWeek {
+		return proto.CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_WEEK
+	}
+	return proto.CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_DAY
+}
+
+// Start returns the start of the bucket t is in, at midnight UTC.
+func (i CommitActivityInterval) Start(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if i == CommitActivityIntervalWeek {
+		// Weekday counts from Sunday, weeks start on Monday.
+		day = day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	}
+	return day
+}
+
+func (i CommitActivityInterval) next(start time.Time) time.Time {
+	if i == CommitActivityIntervalWeek {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// CommitActivityBucket is the number of commits in the day or week that
+// starts at Start.
+type CommitActivityBucket struct {
+	Start time.Time
+	Count int
+}
+
+func CommitActivityBucketFromProto(p *proto.CommitActivityBucket) CommitActivityBucket {
+	return CommitActivityBucket{
+		Start: p.GetStart().AsTime(),
+		Count: int(p.GetCount()),
+	}
+}
+
+func (b CommitActivityBucket) ToProto() *proto.CommitActivityBucket {
+	return &proto.CommitActivityBucket{
+		Start: timestamppb.New(b.Start),
+		Count: int32(b.Count),
+	}
+}
+
+// BucketCommitActivity counts the given commit dates per interval. The
+// buckets are consecutive, oldest first, and span from after, or the oldest
+// date if after is zero, to before, or the newest date if before is zero.
+func BucketCommitActivity(dates []time.Time, interval CommitActivityInterval, after, before time.Time) []CommitActivityBucket {
+	counts := make(map[time.Time]int, len(dates))
+	first, last := after, before
+	for _, d := range dates {
+		counts[interval.Start(d)]++
+		if after.IsZero() && (first.IsZero() || d.Before(first)) {
+			first = d
+		}
+		if before.IsZero() && (last.IsZero() || d.After(last)) {
+			last = d
+		}
+	}
+	if first.IsZero() || last.IsZero() {
+		// An open range without commits has no buckets.
+		return []CommitActivityBucket{}
+	}
+
+	buckets := []CommitActivityBucket{}
+	for start, end := interval.Start(first), interval.Start(last); !start.After(end); start = interval.next(start) {
+		buckets = append(buckets, CommitActivityBucket{Start: start, Count: counts[start]})
+	}
+	return buckets
+}
+
+// Branch is a branch as listed by ListBranches, together with how it compares
+// to the base branch and the commit it points at.
+type Branch struct {
+	Ref
+	// BehindAhead counts the commits only on the base (Behind) and only on the
+	// branch (Ahead). It is nil if the base doesn't exist, for example if HEAD
+	// points to an unborn branch.
+	BehindAhead *BehindAhead
+	// Commit is the commit the branch points at. It is nil if the user can't
+	// see the commit because of sub-repo permissions.
+	Commit *Commit
+}
+
+func BranchFromProto(p *proto.GitBranch) *Branch {
+	b := &Branch{Ref: RefFromProto(p.GetRef())}
+	if ba := p.GetBehindAhead(); ba != nil {
+		b.BehindAhead = &BehindAhead{Behind: ba.GetBehind(), Ahead: ba.GetAhead()}
+	}
+	if c := p.GetCommit(); c != nil {
+		b.Commit = CommitFromProto(c)
+	}
+	return b
+}
+
+func (b *Branch) ToProto() *proto.GitBranch {
+	p := &proto.GitBranch{Ref: b.Ref.ToProto()}
+	if b.BehindAhead != nil {
+		p.BehindAhead = &proto.BehindAhead{Behind: b.BehindAhead.Behind, Ahead: b.BehindAhead.Ahead}
+	}
+	if b.Commit != nil {
+		p.Commit = b.Commit.ToProto()
+	}
+	return p
+}
+
+// CommitGraphNode is a commit in a commit graph, together with the IDs of its
+// parents.
+type CommitGraphNode struct {
+	Commit  api.CommitID
+	Parents []api.CommitID
+}
+
+func CommitGraphNodeFromProto(p *proto.CommitGraphNode) *CommitGraphNode {
+	parents := make([]api.CommitID, 0, len(p.GetParentShas()))
+	for _, sha := range p.GetParentShas() {
+		parents = append(parents, api.CommitID(sha))
+	}
+	return &CommitGraphNode{
+		Commit:  api.CommitID(p.GetCommitSha()),
+		Parents: parents,
+	}
+}
+
+func (n *CommitGraphNode) ToProto() *proto.CommitGraphNode {
+	parents := make([]string, 0, len(n.Parents))
+	for _, id := range n.Parents {
+		parents = append(parents, string(id))
+	}
+	return &proto.CommitGraphNode{
+		CommitSha:  string(n.Commit),
+		ParentShas: parents,
+	}
+}
+
+// CherryCommit is a commit of a branch compared to an upstream branch by
+// patch ID, as done by git cherry.
+type CherryCommit struct {
+	Commit api.CommitID
+	// InUpstream is true if a commit with the same patch ID exists upstream,
+	// for example because the commit was cherry-picked.
+	InUpstream bool
+}
+
+func CherryCommitFromProto(p *proto.CherryCommit) CherryCommit {
+	return CherryCommit{
+		Commit:     api.CommitID(p.GetCommitSha()),
+		InUpstream: p.GetInUpstream(),
+	}
+}
+
+func (c CherryCommit) ToProto() *proto.CherryCommit {
+	return &proto.CherryCommit{
+		CommitSha:  string(c.Commit),
+		InUpstream: c.InUpstream,
+	}
+}
+
+// RangeDiffStatus describes how a commit of a series relates to its
+// counterpart in the other series of a range diff.
+type RangeDiffStatus int
+
+const (
+	RangeDiffStatusUnknown RangeDiffStatus = iota
+	// RangeDiffStatusEqual means both commits have the same patch.
+	RangeDiffStatusEqual
+	// RangeDiffStatusModified means the commits correspond to each other, but
+	// their patches differ.
+	RangeDiffStatusModified
+	// RangeDiffStatusRemoved means the commit of the old series has no
+	// counterpart in the new series.
+	RangeDiffStatusRemoved
+	// RangeDiffStatusAdded means the commit of the new series has no
+	// counterpart in the old series.
+	RangeDiffStatusAdded
+)
+
+func RangeDiffStatusFromProto(s proto.RangeDiffPair_Status) RangeDiffStatus {
+	switch s {
+	case proto.RangeDiffPair_STATUS_EQUAL:
+		return RangeDiffStatusEqual
+	case proto.RangeDiffPair_STATUS_MODIFIED:
+		return RangeDiffStatusModified
+	case proto.RangeDiffPair_STATUS_REMOVED:
+		return RangeDiffStatusRemoved
+	case proto.RangeDiffPair_STATUS_ADDED:
+		return RangeDiffStatusAdded
+	default:
+		return RangeDiffStatusUnknown
+	}
+}
+
+func (s RangeDiffStatus) ToProto() proto.RangeDiffPair_Status {
+	switch s {
+	case RangeDiffStatusEqual:
+		return proto.RangeDiffPair_STATUS_EQUAL
+	case RangeDiffStatusModified:
+		return proto.RangeDiffPair_STATUS_MODIFIED
+	case RangeDiffStatusRemoved:
+		return proto.RangeDiffPair_STATUS_REMOVED
+	case RangeDiffStatusAdded:
+		return proto.RangeDiffPair_STATUS_ADDED
+	default:
+		return proto.RangeDiffPair_STATUS_UNSPECIFIED
+	}
+}
+
+// RangeDiffPair is a commit of one series of a range diff and its counterpart
+// in the other series.
+type RangeDiffPair struct {
+	Status RangeDiffStatus
+	// OldIndex is the 1-based position of OldCommit in the old series, or 0
+	// if the commit was added.
+	OldIndex  int
+	OldCommit api.CommitID
+	// NewIndex is the 1-based position of NewCommit in the new series, or 0
+	// if the commit was removed.
+	NewIndex  int
+	NewCommit api.CommitID
+	Subject   string
+	// Diff is the difference between the patches of both commits, as
+	// formatted by git range-diff. Only set for RangeDiffStatusModified.
+	Diff string
+}
+
+func RangeDiffPairFromProto(p *proto.RangeDiffPair) *RangeDiffPair {
+	return &RangeDiffPair{
+		Status:    RangeDiffStatusFromProto(p.GetStatus()),
+		OldIndex:  int(p.GetOldIndex()),
+		OldCommit: api.CommitID(p.GetOldCommitSha()),
+		NewIndex:  int(p.GetNewIndex()),
+		NewCommit: api.CommitID(p.GetNewCommitSha()),
+		Subject:   string(p.GetSubject()),
+		Diff:      string(p.GetDiff()),
+	}
+}
+
+func (p *RangeDiffPair) ToProto() *proto.RangeDiffPair {
+	return &proto.RangeDiffPair{
+		Status:       p.Status.ToProto(),
+		OldIndex:     uint32(p.OldIndex),
+		OldCommitSha: string(p.OldCommit),
+		NewIndex:     uint32(p.NewIndex),
+		NewCommitSha: string(p.NewCommit),
+		Subject:      []byte(p.Subject),
+		Diff:         []byte(p.Diff),
+	}
+}
+
+// FileDiffStat is the number of lines added and deleted in a file.
+type FileDiffStat struct {
+	Path    string
+	Added   int
+	Deleted int
+	// Binary is true for binary files, for which git doesn't count lines.
+	Binary bool
+}
+
+func FileDiffStatFromProto(p *proto.FileDiffStat) FileDiffStat {
+	return FileDiffStat{
+		Path:    string(p.GetPath()),
+		Added:   int(p.GetAdded()),
+		Deleted: int(p.GetDeleted()),
+		Binary:  p.GetBinary(),
+	}
+}
+
+func (s FileDiffStat) ToProto() *proto.FileDiffStat {
+	return &proto.FileDiffStat{
+		Path:    []byte(s.Path),
+		Added:   uint32(s.Added),
+		Deleted: uint32(s.Deleted),
+		Binary:  s.Binary,
+	}
+}
+
+// CommitWithChanges is a commit along with the changes it introduces,
+// compared to its first parent.
+type CommitWithChanges struct {
+	*Commit
+	// Stats is only set if requested.
+	Stats []FileDiffStat
+	// Patch is the unified diff of the commit, only set if requested.
+	Patch []byte
+}
+
 // BehindAhead is a set of behind/ahead counts.
 type BehindAhead struct {
 	Behind uint32 `json:"Behind,omitempty"`
 	Ahead  uint32 `json:"Ahead,omitempty"`
 }
 
+// CommitGraphStatus describes the commit-graph of a repository. Git uses the
+// commit-graph to walk history without parsing commit objects, which makes
+// commands like merge-base and rev-list much faster for large repositories.
+type CommitGraphStatus struct {
+	// Present is true if the repository has a commit-graph. All other fields
+	// are zero if it doesn't.
+	Present bool
+	// Layers is the number of files in the commit-graph chain. It is 1 unless
+	// the commit-graph was written incrementally with --split.
+	Layers int
+	// Commits is the number of commits in the commit-graph. Commits added
+	// since it was last written are not covered by it.
+	Commits int
+	// GenerationVersion is 2 if all layers store corrected commit dates, and
+	// 1 if only topological levels are available. Corrected commit dates also
+	// allow git to stop walking early when the commit dates are skewed.
+	GenerationVersion int
+	// ChangedPathFilters is true if all layers store Bloom filters of the
+	// paths changed by each commit, which speeds up path-limited history.
+	ChangedPathFilters bool
+}
+
+func CommitGraphStatusFromProto(p *proto.CommitGraphStatusResponse) CommitGraphStatus {
+	return CommitGraphStatus{
+		Present:            p.GetPresent(),
+		Layers:             int(p.GetLayers()),
+		Commits:            int(p.GetCommits()),
+		GenerationVersion:  int(p.GetGenerationVersion()),
+		ChangedPathFilters: p.GetChangedPathFilters(),
+	}
+}
+
+func (s CommitGraphStatus) ToProto() *proto.CommitGraphStatusResponse {
+	return &proto.CommitGraphStatusResponse{
+		Present:            s.Present,
+		Layers:             uint32(s.Layers),
+		Commits:            uint32(s.Commits),
+		GenerationVersion:  uint32(s.GenerationVersion),
+		ChangedPathFilters: s.ChangedPathFilters,
+	}
+}
+
+// CloneState describes whether a repository has its full history and all its
+// objects. gitserver clones repositories fully, but a repository can be cloned
+// shallow or partial to save disk space, in which case features relying on
+// history or on blob contents have to degrade.
+type CloneState struct {
+	// Shallow is true if the history of the repository is truncated. History
+	// walks stop at ShallowCommits as if they were root commits.
+	Shallow bool
+	// ShallowCommits are the boundary commits of a shallow clone, whose
+	// parents are not in the repository.
+	ShallowCommits []api.CommitID
+	// Depth is the number of commits in the first-parent history of HEAD of a
+	// shallow clone. It is the depth the repository was cloned with, unless
+	// it was deepened or HEAD moved since.
+	Depth int
+	// Partial is true if the repository is a partial clone: objects matching
+	// Filter are omitted and fetched from the remote on demand.
+	Partial bool
+	// Filter is the object filter spec of a partial clone, like "blob:none"
+	// or "blob:limit=1m".
+	Filter string
+}
+
+// IsShallowCommit returns true if commit is a boundary commit of a shallow
+// clone.
+func (s CloneState) IsShallowCommit(commit api.CommitID) bool {
+	return slices.Contains(s.ShallowCommits, commit)
+}
+
+func CloneStateFromProto(p *proto.CloneStateResponse) CloneState {
+	s := CloneState{
+		Shallow: p.GetShallow(),
+		Depth:   int(p.GetDepth()),
+		Partial: p.GetPartial(),
+		Filter:  p.GetFilter(),
+	}
+	for _, c := range p.GetShallowCommits() {
+		s.ShallowCommits = append(s.ShallowCommits, api.CommitID(c))
+	}
+	return s
+}
+
+func (s CloneState) ToProto() *proto.CloneStateResponse {
+	p := &proto.CloneStateResponse{
+		Shallow: s.Shallow,
+		Depth:   uint32(s.Depth),
+		Partial: s.Partial,
+		Filter:  s.Filter,
+	}
+	for _, c := range s.ShallowCommits {
+		p.ShallowCommits = append(p.ShallowCommits, string(c))
+	}
+	return p
+}
+
+// WorktreeExecResult is the result of a command run in a temporary worktree.
+type WorktreeExecResult struct {
+	// ExitStatus is the exit status of the command, 0 if it succeeded.
+	ExitStatus int
+	Stdout     []byte
+	Stderr     []byte
+	// Truncated is true if Stdout or Stderr exceeded the output limit of
+	// gitserver and only contain the beginning of the output.
+	Truncated bool
+}
+
+func WorktreeExecResultFromProto(p *proto.ExecInWorktreeResponse) WorktreeExecResult {
+	return WorktreeExecResult{
+		ExitStatus: int(p.GetExitStatus()),
+		Stdout:     p.GetStdout(),
+		Stderr:     p.GetStderr(),
+		Truncated:  p.GetOutputTruncated(),
+	}
+}
+
+func (r WorktreeExecResult) ToProto() *proto.ExecInWorktreeResponse {
+	return &proto.ExecInWorktreeResponse{
+		ExitStatus:      int32(r.ExitStatus),
+		Stdout:          r.Stdout,
+		Stderr:          r.Stderr,
+		OutputTruncated: r.Truncated,
+	}
+}
+
+// RebasePreview is the result of replaying a series of commits onto a new
+// base.
+type RebasePreview struct {
+	// Clean is true if all commits of the series applied without conflicts.
+	Clean bool
+	// Commits are the IDs of the replayed commits, in order. If the rebase is
+	// not clean, these are the commits replayed before the conflict.
+	Commits []api.CommitID
+	// ConflictingCommit is the first commit of the series that could not be
+	// applied cleanly.
+	ConflictingCommit api.CommitID
+	// ConflictingPaths are the paths that have conflicts when applying
+	// ConflictingCommit.
+	ConflictingPaths []string
+}
+
+func RebasePreviewFromProto(p *proto.RebasePreviewResponse) RebasePreview {
+	r := RebasePreview{
+		Clean:             p.GetClean(),
+		ConflictingCommit: api.CommitID(p.GetConflictingCommitSha()),
+	}
+	for _, c := range p.GetCommitShas() {
+		r.Commits = append(r.Commits, api.CommitID(c))
+	}
+	for _, path := range p.GetConflictingPaths() {
+		r.ConflictingPaths = append(r.ConflictingPaths, string(path))
+	}
+	return r
+}
+
+func (r RebasePreview) ToProto() *proto.RebasePreviewResponse {
+	p := &proto.RebasePreviewResponse{
+		Clean:                r.Clean,
+		ConflictingCommitSha: string(r.ConflictingCommit),
+	}
+	for _, c := range r.Commits {
+		p.CommitShas = append(p.CommitShas, string(c))
+	}
+	for _, path := range r.ConflictingPaths {
+		p.ConflictingPaths = append(p.ConflictingPaths, []byte(path))
+	}
+	return p
+}
+
+// TreeEntry is an entry of a git tree object, as listed by git ls-tree.
+type TreeEntry struct {
+	// Name is the name of the entry, without the path of the tree it is in.
+	Name string
+	// Mode is the git file mode of the entry, like 0o100644 for a regular
+	// file, 0o120000 for a symlink, 0o040000 for a tree or 0o160000 for a
+	// submodule.
+	Mode uint32
+	// Type is the type of the object the entry points to. For submodules, it
+	// is a commit of another repository.
+	Type ObjectType
+	OID  OID
+	// Size is the size of the blob in bytes, and 0 for other entries.
+	Size int64
+}
+
+func TreeEntryFromProto(p *proto.TreeEntry) TreeEntry {
+	var o GitObject
+	o.FromProto(p.GetObject())
+	return TreeEntry{
+		Name: string(p.GetName()),
+		Mode: p.GetMode(),
+		Type: o.Type,
+		OID:  o.ID,
+		Size: p.GetSize(),
+	}
+}
+
+func (e TreeEntry) ToProto() *proto.TreeEntry {
+	return &proto.TreeEntry{
+		Name:   []byte(e.Name),
+		Mode:   e.Mode,
+		Object: (&GitObject{ID: e.OID, Type: e.Type}).ToProto(),
+		Size:   e.Size,
+	}
+}
+
+// TreeEntryCommit is an entry of a tree together with the last commit that
+// changed it.
+type TreeEntryCommit struct {
+	TreeEntry
+	// Commit is the last commit that changed the entry. It is nil if that
+	// commit is beyond the history of a shallow clone.
+	Commit *Commit
+}
+
+func TreeEntryCommitFromProto(p *proto.TreeEntryCommit) TreeEntryCommit {
+	e := TreeEntryCommit{TreeEntry: TreeEntryFromProto(p.GetEntry())}
+	if c := p.GetCommit(); c != nil {
+		e.Commit = CommitFromProto(c)
+	}
+	return e
+}
+
+func (e TreeEntryCommit) ToProto() *proto.TreeEntryCommit {
+	p := &proto.TreeEntryCommit{Entry: e.TreeEntry.ToProto()}
+	if e.Commit != nil {
+		p.Commit = e.Commit.ToProto()
+	}
+	return p
+}
+
+// Tag describes a tag. For annotated tags, this is the content of the tag
+// object. Lightweight tags only have a Name and Target.
+type Tag struct {
+	// Name is the name of the tag, without the refs/tags/ prefix.
+	Name string
+	// OID is the object ID of the tag object. It is empty for lightweight
+	// tags.
+	OID api.CommitID
+	// Target is the object the tag points to. This is usually a commit, but
+	// tags can point to any object, including other tags.
+	Target GitObject
+	// Tagger is the identity that created the tag. It is nil for lightweight
+	// tags, and for some tags created by very old versions of git.
+	Tagger *Signature
+	// Message is the message of the tag, without the signature.
+	Message string
+	// Signature is the ASCII-armored PGP, SSH or X.509 signature of a signed
+	// tag, and empty otherwise. It is not verified.
+	Signature string
+}
+
+func TagFromProto(p *proto.GitTag) *Tag {
+	t := &Tag{
+		Name:      p.GetName(),
+		OID:       api.CommitID(p.GetOid()),
+		Message:   string(p.GetMessage()),
+		Signature: string(p.GetSignature()),
+	}
+	t.Target.FromProto(p.GetTarget())
+	if tagger := p.GetTagger(); tagger != nil {
+		t.Tagger = &Signature{
+			Name:  string(tagger.GetName()),
+			Email: string(tagger.GetEmail()),
+			Date:  tagger.GetDate().AsTime(),
+		}
+	}
+	return t
+}
+
+func (t *Tag) ToProto() *proto.GitTag {
+	p := &proto.GitTag{
+		Name:      t.Name,
+		Oid:       string(t.OID),
+		Target:    t.Target.ToProto(),
+		Message:   []byte(t.Message),
+		Signature: []byte(t.Signature),
+	}
+	if t.Tagger != nil {
+		p.Tagger = &proto.GitSignature{
+			Name:  []byte(t.Tagger.Name),
+			Email: []byte(t.Tagger.Email),
+			Date:  timestamppb.New(t.Tagger.Date),
+		}
+	}
+	return p
+}
+
 // EnsureRefPrefix checks whether the ref is a full ref and contains the
 // "refs/heads" prefix (i.e. "refs/heads/master") or just an abbreviated ref
 // (i.e. "master") and adds the "refs/heads/" prefix if the latter is the case.
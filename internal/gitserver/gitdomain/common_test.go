@@ -159,15 +159,16 @@ func TestIsAbsoluteRevision(t *testing.T) {
 func TestRoundTripBlameHunk(t *testing.T) {
 	diff := ""
 
-	err := quick.Check(func(startLine, endLine, startByte, endByte uint32, commitID api.CommitID, message, filename string, authorName, authorEmail string, authorDate fuzzTime) bool {
+	err := quick.Check(func(startLine, endLine, startByte, endByte uint32, commitID api.CommitID, message, filename string, authorName, authorEmail string, authorDate fuzzTime, previous *PreviousCommit) bool {
 		original := &Hunk{
-			StartLine: startLine,
-			EndLine:   endLine,
-			StartByte: startByte,
-			EndByte:   endByte,
-			CommitID:  commitID,
-			Message:   message,
-			Filename:  filename,
+			StartLine:      startLine,
+			EndLine:        endLine,
+			StartByte:      startByte,
+			EndByte:        endByte,
+			CommitID:       commitID,
+			PreviousCommit: previous,
+			Message:        message,
+			Filename:       filename,
 			Author: Signature{
 				Name:  authorName,
 				Email: authorEmail,
@@ -337,3 +338,42 @@ func TestRoundTripRef(t *testing.T) {
 		t.Fatalf("unexpected diff (-want +got):\n%s", diff)
 	}
 }
+
+func TestCommitActivityInterval_Start(t *testing.T) {
+	// 2024-01-07 is a Sunday.
+	sunday := time.Date(2024, 1, 7, 23, 30, 0, 0, time.FixedZone("", -2*60*60))
+	assert.Equal(t, time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), CommitActivityIntervalDay.Start(sunday))
+	// In UTC, sunday is already on Monday.
+	assert.Equal(t, time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), CommitActivityIntervalWeek.Start(sunday))
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), CommitActivityIntervalWeek.Start(time.Date(2024, 1, 7, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), CommitActivityIntervalWeek.Start(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestBucketCommitActivity(t *testing.T) {
+	day := func(d, h int) time.Time { return time.Date(2024, 1, d, h, 0, 0, 0, time.UTC) }
+	dates := []time.Time{day(3, 12), day(1, 10), day(3, 1)}
+
+	t.Run("open range", func(t *testing.T) {
+		assert.Equal(t, []CommitActivityBucket{
+			{Start: day(1, 0), Count: 1},
+			{Start: day(2, 0), Count: 0},
+			{Start: day(3, 0), Count: 2},
+		}, BucketCommitActivity(dates, CommitActivityIntervalDay, time.Time{}, time.Time{}))
+	})
+
+	t.Run("closed range", func(t *testing.T) {
+		assert.Equal(t, []CommitActivityBucket{
+			{Start: day(1, 0), Count: 3},
+			{Start: day(8, 0), Count: 0},
+			{Start: day(15, 0), Count: 0},
+		}, BucketCommitActivity(dates, CommitActivityIntervalWeek, day(2, 0), day(15, 5)))
+	})
+
+	t.Run("no commits", func(t *testing.T) {
+		assert.Equal(t, []CommitActivityBucket{}, BucketCommitActivity(nil, CommitActivityIntervalDay, time.Time{}, day(2, 0)))
+		assert.Equal(t, []CommitActivityBucket{
+			{Start: day(1, 0), Count: 0},
+			{Start: day(2, 0), Count: 0},
+		}, BucketCommitActivity(nil, CommitActivityIntervalDay, day(1, 5), day(2, 0)))
+	})
+}
@@ -1,9 +1,11 @@
 package gitdomain
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -66,3 +68,151 @@ func IsCloneInProgress(err error) bool {
 	var e *RepoNotExistError
 	return errors.As(err, &e) && e.CloneInProgress
 }
+
+// RefUpdateConflictError is returned when a ref could not be updated because it
+// did not point at the expected commit.
+type RefUpdateConflictError struct {
+	Repo api.RepoName
+	Ref  string
+	// ExpectedOldOID is the commit the ref was expected to point at. Empty if
+	// the ref was expected to not exist.
+	ExpectedOldOID api.CommitID
+}
+
+func (e *RefUpdateConflictError) Error() string {
+	if e.ExpectedOldOID == "" {
+		return fmt.Sprintf("cannot update ref %s in %s: ref already exists", e.Ref, e.Repo)
+	}
+	return fmt.Sprintf("cannot update ref %s in %s: ref does not point at %s", e.Ref, e.Repo, e.ExpectedOldOID)
+}
+
+// AmbiguousRevisionError is returned when a short commit ID matches more than
+// one object, so that a longer prefix is needed.
+type AmbiguousRevisionError struct {
+	Repo api.RepoName
+	Spec string
+	// Candidates are the objects that Spec matches, as far as git listed
+	// them.
+	Candidates []AmbiguousRevisionCandidate
+}
+
+// AmbiguousRevisionCandidate is an object that an ambiguous short commit ID
+// matches.
+type AmbiguousRevisionCandidate struct {
+	// ID is the object ID, abbreviated so that it is unique.
+	ID   string
+	Type ObjectType
+	// Description is what git shows about the object besides its type, like
+	// the date and subject of a commit. It is empty for trees and blobs.
+	Description string
+}
+
+func (e *AmbiguousRevisionError) Error() string {
+	return fmt.Sprintf("ambiguous revision: %s@%s", e.Repo, e.Spec)
+}
+
+// BadObjectError is returned when git can't read an object that a command
+// refers to, because it is missing from the repository or corrupt.
+type BadObjectError struct {
+	Repo   api.RepoName
+	Object string
+}
+
+func (e *BadObjectError) Error() string {
+	return fmt.Sprintf("bad object: %s@%s", e.Repo, e.Object)
+}
+
+func (e *BadObjectError) NotFound() bool {
+	return true
+}
+
+// ShallowHistoryError is returned when a command needs history beyond the
+// boundary of a shallow clone: the parents of Commit are not in the
+// repository.
+type ShallowHistoryError struct {
+	Repo   api.RepoName
+	Commit api.CommitID
+}
+
+func (e *ShallowHistoryError) Error() string {
+	return fmt.Sprintf("history of %s is incomplete beyond shallow boundary %s", e.Repo, e.Commit)
+}
+
+// MergeConflictError is returned when the changes of Commit can't be applied
+// without conflicts, for example when cherry-picking or reverting it.
+type MergeConflictError struct {
+	Repo   api.RepoName
+	Commit api.CommitID
+	// Paths are the paths with conflicts.
+	Paths []string
+}
+
+func (e *MergeConflictError) Error() string {
+	return fmt.Sprintf("changes of commit %s in %s conflict in %d paths", e.Commit, e.Repo, len(e.Paths))
+}
+
+// HookPermissionDeniedError is returned when a git hook rejected an
+// operation, or could not be run.
+type HookPermissionDeniedError struct {
+	Repo api.RepoName
+	// Hook is the name of the hook, like pre-receive.
+	Hook string
+}
+
+func (e *HookPermissionDeniedError) Error() string {
+	return fmt.Sprintf("%s hook denied the operation in %s", e.Hook, e.Repo)
+}
+
+// PathAccessDeniedError is returned when a path exists, but sub-repo
+// permissions deny the actor access to it.
+type PathAccessDeniedError struct {
+	Repo   api.RepoName
+	Commit api.CommitID
+	Path   string
+}
+
+func (e *PathAccessDeniedError) Error() string {
+	return fmt.Sprintf("access to %s@%s:%s denied by sub-repo permissions", e.Repo, e.Commit, e.Path)
+}
+
+var (
+	ambiguousShortIDPattern = lazyregexp.New(`short object ID (\S+) is ambiguous`)
+	// ambiguousCandidatePattern matches the candidates git lists after an
+	// ambiguous short ID, like "hint:   3b18e51 commit 2005-04-07 - subject".
+	ambiguousCandidatePattern = lazyregexp.New(`(?m)^hint:\s+([0-9a-f]{4,64}) (\w+)(?: (.*))?$`)
+	badObjectPattern          = lazyregexp.New(`(?m)^fatal: bad object (\S+)$`)
+	shallowBoundaryPattern    = lazyregexp.New(`Failed to traverse parents of commit ([0-9a-f]{40,64})`)
+	hookDeclinedPattern       = lazyregexp.New(`\(([\w-]+) hook declined\)`)
+	hookNotRunnablePattern    = lazyregexp.New(`cannot (?:exec|run) '?(?:[^' ]*/)?([\w-]+)'?: Permission denied`)
+)
+
+// ParseGitError returns the typed error for the git failure that stderr
+// describes: an *AmbiguousRevisionError, *BadObjectError,
+// *ShallowHistoryError or *HookPermissionDeniedError. It returns nil if stderr
+// doesn't describe one of them.
+func ParseGitError(repo api.RepoName, stderr []byte) error {
+	if m := hookDeclinedPattern.FindSubmatch(stderr); m != nil {
+		return &HookPermissionDeniedError{Repo: repo, Hook: string(m[1])}
+	}
+	if m := hookNotRunnablePattern.FindSubmatch(stderr); m != nil {
+		return &HookPermissionDeniedError{Repo: repo, Hook: string(m[1])}
+	}
+	if m := shallowBoundaryPattern.FindSubmatch(stderr); m != nil {
+		return &ShallowHistoryError{Repo: repo, Commit: api.CommitID(m[1])}
+	}
+	if m := ambiguousShortIDPattern.FindSubmatch(stderr); m != nil {
+		e := &AmbiguousRevisionError{Repo: repo, Spec: string(m[1])}
+		for _, c := range ambiguousCandidatePattern.Re().FindAllSubmatch(stderr, -1) {
+			e.Candidates = append(e.Candidates, AmbiguousRevisionCandidate{
+				ID:          string(c[1]),
+				Type:        ObjectType(c[2]),
+				Description: string(bytes.TrimSpace(c[3])),
+			})
+		}
+		return e
+	}
+	if m := badObjectPattern.FindSubmatch(stderr); m != nil {
+		return &BadObjectError{Repo: repo, Object: string(m[1])}
+	}
+	return nil
+}
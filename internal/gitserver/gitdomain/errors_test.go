@@ -0,0 +1,46 @@
+package gitdomain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGitError(t *testing.T) {
+	for _, tc := range []struct {
+		stderr string
+		want   error
+	}{
+		{
+			stderr: "error: short object ID abc1 is ambiguous\nhint: The candidates are:\nhint:   abc1234 commit 2024-01-01 - foo\nhint:   abc1567 tree\nfatal: ambiguous argument 'abc1': unknown revision or path not in the working tree.",
+			want: &AmbiguousRevisionError{Repo: "repo", Spec: "abc1", Candidates: []AmbiguousRevisionCandidate{
+				{ID: "abc1234", Type: ObjectTypeCommit, Description: "2024-01-01 - foo"},
+				{ID: "abc1567", Type: ObjectTypeTree},
+			}},
+		},
+		{
+			stderr: "fatal: bad object deadbeef",
+			want:   &BadObjectError{Repo: "repo", Object: "deadbeef"},
+		},
+		{
+			stderr: "error: Could not read 1b4e0f2c5a4bbbd0e4ec17f1c7b1c4cb5b0bf3f1\nfatal: Failed to traverse parents of commit 0d7e5a8e6c5b0b0cc1c8e2f5e7a9f4c2b1a3d4e5",
+			want:   &ShallowHistoryError{Repo: "repo", Commit: "0d7e5a8e6c5b0b0cc1c8e2f5e7a9f4c2b1a3d4e5"},
+		},
+		{
+			stderr: "remote: rejected\nTo /tmp/remote\n ! [remote rejected] main -> main (pre-receive hook declined)",
+			want:   &HookPermissionDeniedError{Repo: "repo", Hook: "pre-receive"},
+		},
+		{
+			stderr: "fatal: cannot exec '.git/hooks/pre-commit': Permission denied",
+			want:   &HookPermissionDeniedError{Repo: "repo", Hook: "pre-commit"},
+		},
+		{
+			stderr: "fatal: ambiguous argument 'nope': unknown revision or path not in the working tree.",
+			want:   nil,
+		},
+	} {
+		t.Run(tc.stderr, func(t *testing.T) {
+			require.Equal(t, tc.want, ParseGitError("repo", []byte(tc.stderr)))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitcmd"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -26,22 +27,16 @@ const (
 	DeletedAMD  StatusAMD = 2
 )
 
-func LogReverseArgs(n int, givenCommit string) []string {
-	return []string{
-		"log",
-		"--pretty=%H %P",
-		"--raw",
-		"-z",
-		"-m",
+func LogReverseArgs(n int, givenCommit string) ([]string, error) {
+	return gitcmd.Log().
+		FlagValue("--pretty", "%H %P").
+		Flag("--raw", "-z", "-m").
 		// --no-abbrev speeds up git log a lot
-		"--no-abbrev",
-		"--no-renames",
-		"--first-parent",
-		"--reverse",
-		"--ignore-submodules",
-		fmt.Sprintf("-%d", n),
-		givenCommit,
-	}
+		Flag("--no-abbrev").
+		Flag("--no-renames", "--first-parent", "--reverse", "--ignore-submodules").
+		MaxCount(n).
+		Rev(givenCommit).
+		Args()
 }
 
 func RevListEach(stdout io.Reader, onCommit func(commit string) (shouldContinue bool, err error)) error {
@@ -0,0 +1,169 @@
+package gitdomain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+)
+
+// InvalidRevSpecError is returned by ValidateRevSpec for a revspec that is not
+// well-formed.
+type InvalidRevSpecError struct {
+	Spec   string
+	Reason string
+}
+
+func (e *InvalidRevSpecError) Error() string {
+	return fmt.Sprintf("invalid revision spec %q: %s", e.Spec, e.Reason)
+}
+
+func (e *InvalidRevSpecError) HTTPStatusCode() int {
+	return 400
+}
+
+// revSuffixPattern matches the ancestry and peeling suffixes of a revision,
+// like ~2, ^, ^2 or ^{commit}.
+var revSuffixPattern = lazyregexp.New(`(?:~[0-9]*|\^[0-9]*|\^\{[a-z]*\})+$`)
+
+// ValidateRevSpec checks that spec is a revision that gitserver can be asked
+// about, so that API layers can reject invalid input before sending a request.
+// A revision is a commit ID or a ref name that follows git's ref format rules
+// (see git check-ref-format), optionally followed by ~<n>, ^<n> or ^{<type>}
+// suffixes. A range of two revisions separated by ".." or "..." is valid, too.
+//
+// Syntax that selects objects other than commits by path or commit message,
+// like HEAD:README.md or :/fix, is rejected, as are specs that could be taken
+// for command line flags.
+func ValidateRevSpec(spec string) error {
+	invalid := func(reason string) error {
+		return &InvalidRevSpecError{Spec: spec, Reason: reason}
+	}
+
+	if spec == "" {
+		return invalid("empty")
+	}
+	if strings.HasPrefix(spec, "-") {
+		return invalid("begins with '-'")
+	}
+	if strings.HasPrefix(spec, ".") {
+		// Could refer to a file.
+		return invalid("begins with '.'")
+	}
+
+	ends := []string{spec}
+	for _, op := range []string{"...", ".."} {
+		if base, head, ok := strings.Cut(spec, op); ok {
+			ends = []string{base, head}
+			break
+		}
+	}
+	for _, end := range ends {
+		// The head of a range can be omitted, git uses HEAD for it.
+		if end == "" && len(ends) == 2 {
+			continue
+		}
+		if reason := checkRevision(end); reason != "" {
+			return invalid(reason)
+		}
+	}
+	return nil
+}
+
+// checkRevision returns why rev is not a valid revision, or an empty string.
+func checkRevision(rev string) string {
+	name := revSuffixPattern.ReplaceAllString(rev, "")
+	if name == "" {
+		return "missing revision before '~' or '^'"
+	}
+	if name == "@" || name == "HEAD" {
+		return ""
+	}
+	return checkRefFormat(name)
+}
+
+// checkRefFormat returns why name violates git's ref format rules, or an empty
+// string. One-level names like "main" are allowed.
+func checkRefFormat(name string) string {
+	for _, r := range name {
+		if r < 0x20 || r == 0x7f {
+			return "contains a control character"
+		}
+		switch r {
+		case ' ', '~', '^', ':', '?', '*', '[', '\\':
+			return fmt.Sprintf("contains %q", r)
+		}
+	}
+	switch {
+	case strings.Contains(name, ".."):
+		return `contains ".."`
+	case strings.Contains(name, "@{"):
+		return `contains "@{"`
+	case strings.Contains(name, "//"):
+		return `contains "//"`
+	case strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/"):
+		return "begins or ends with '/'"
+	case strings.HasSuffix(name, "."):
+		return "ends with '.'"
+	}
+	for _, component := range strings.Split(name, "/") {
+		if strings.HasPrefix(component, ".") {
+			return "has a component that begins with '.'"
+		}
+		if strings.HasSuffix(component, ".lock") {
+			return `has a component that ends with ".lock"`
+		}
+	}
+	return ""
+}
+
+// InvalidRefspecError is returned by ValidateFetchRefspec for a refspec that
+// cannot be fetched.
+type InvalidRefspecError struct {
+	Refspec string
+	Reason  string
+}
+
+func (e *InvalidRefspecError) Error() string {
+	return fmt.Sprintf("invalid refspec %q: %s", e.Refspec, e.Reason)
+}
+
+func (e *InvalidRefspecError) HTTPStatusCode() int {
+	return 400
+}
+
+// ValidateFetchRefspec checks that refspec can be fetched into a repo with
+// FetchRefspec. The refspec has the form [+]<src>:<dst>, where src and dst are
+// fully qualified ref names. Both can contain a single * to match multiple
+// refs, like in +refs/pull/*/head:refs/pull/*/head.
+//
+// The branches and tags of a repo are managed by the regular fetches of the
+// repo, so dst must not be in refs/heads/ or refs/tags/.
+func ValidateFetchRefspec(refspec string) error {
+	invalid := func(reason string) error {
+		return &InvalidRefspecError{Refspec: refspec, Reason: reason}
+	}
+
+	src, dst, ok := strings.Cut(strings.TrimPrefix(refspec, "+"), ":")
+	if !ok {
+		return invalid("missing ':'")
+	}
+	for _, name := range []string{src, dst} {
+		if !strings.HasPrefix(name, "refs/") {
+			return invalid("ref names must begin with refs/")
+		}
+		if strings.Count(name, "*") > 1 {
+			return invalid("ref names can contain at most one '*'")
+		}
+		if reason := checkRefFormat(strings.Replace(name, "*", "x", 1)); reason != "" {
+			return invalid(reason)
+		}
+	}
+	if strings.Contains(src, "*") != strings.Contains(dst, "*") {
+		return invalid("either both or none of the ref names must contain '*'")
+	}
+	if strings.HasPrefix(dst, "refs/heads/") || strings.HasPrefix(dst, "refs/tags/") {
+		return invalid("cannot fetch into refs/heads/ or refs/tags/")
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package gitdomain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestValidateRevSpec(t *testing.T) {
+	for _, spec := range []string{
+		"HEAD",
+		"@",
+		"main",
+		"refs/heads/feature/foo-bar",
+		"v1.0.0",
+		"f372e36a91bc35e5d99df8be435bdcb1f0660bc5",
+		"f372e36",
+		"HEAD~",
+		"HEAD~2^2",
+		"v1.0^{commit}",
+		"main..feature",
+		"main...feature",
+		"main..",
+		"HEAD~3..HEAD",
+	} {
+		t.Run(spec, func(t *testing.T) {
+			require.NoError(t, ValidateRevSpec(spec))
+		})
+	}
+
+	for spec, reason := range map[string]string{
+		"":                     "empty",
+		"-foo":                 "begins with '-'",
+		"--output=/tmp/x":      "begins with '-'",
+		".foo":                 "begins with '.'",
+		"...HEAD":              "begins with '.'",
+		"HEAD:README.md":       `contains ':'`,
+		":/fix":                `contains ':'`,
+		"main@{yesterday}":     `contains "@{"`,
+		"foo bar":              `contains ' '`,
+		"foo?":                 `contains '?'`,
+		"refs/heads/*":         `contains '*'`,
+		"foo\x01":              "contains a control character",
+		"a..b..c":              `contains ".."`,
+		"a....b":               "has a component that begins with '.'",
+		"refs//heads":          `contains "//"`,
+		"refs/heads/":          "begins or ends with '/'",
+		"refs/heads/main.lock": `has a component that ends with ".lock"`,
+		"refs/.hidden":         "has a component that begins with '.'",
+		"main.":                "ends with '.'",
+		"~2":                   "missing revision before '~' or '^'",
+	} {
+		t.Run(spec, func(t *testing.T) {
+			err := ValidateRevSpec(spec)
+			var e *InvalidRevSpecError
+			require.True(t, errors.As(err, &e), "expected an error for %q", spec)
+			require.Equal(t, reason, e.Reason)
+		})
+	}
+}
+
+func TestValidateFetchRefspec(t *testing.T) {
+	for _, refspec := range []string{
+		"refs/pull/1/head:refs/pull/1/head",
+		"+refs/pull/*/head:refs/pull/*/head",
+		"+refs/heads/*:refs/remotes/upstream/*",
+		"refs/changes/*:refs/changes/*",
+	} {
+		t.Run(refspec, func(t *testing.T) {
+			require.NoError(t, ValidateFetchRefspec(refspec))
+		})
+	}
+
+	for refspec, reason := range map[string]string{
+		"":                                  "missing ':'",
+		"refs/pull/1/head":                  "missing ':'",
+		"pull/1/head:refs/pull/1/head":      "ref names must begin with refs/",
+		"refs/pull/1/head:-x":               "ref names must begin with refs/",
+		"+refs/pull/*/*:refs/pull/*/*":      "ref names can contain at most one '*'",
+		"refs/pull/*/head:refs/pull/1/head": "either both or none of the ref names must contain '*'",
+		"refs/pull/1/head:refs/pull/a..b":   `contains ".."`,
+		"refs/pull/1/head:refs/pull/1 head": `contains ' '`,
+		"+refs/pull/*/head:refs/heads/pr/*": "cannot fetch into refs/heads/ or refs/tags/",
+		"refs/tags/v1:refs/tags/v1":         "cannot fetch into refs/heads/ or refs/tags/",
+	} {
+		t.Run(refspec, func(t *testing.T) {
+			err := ValidateFetchRefspec(refspec)
+			var e *InvalidRefspecError
+			require.True(t, errors.As(err, &e), "expected an error for %q", refspec)
+			require.Equal(t, reason, e.Reason)
+		})
+	}
+}
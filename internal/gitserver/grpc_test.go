@@ -2,20 +2,35 @@ package gitserver
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	internalgrpc "github.com/sourcegraph/sourcegraph/internal/grpc"
+	"github.com/sourcegraph/sourcegraph/internal/grpc/compression"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -133,3 +148,446 @@ func newConfig(addrs []string, pinned map[string]string) *conf.Unified {
 		},
 	}
 }
+
+// flakyGitserver fails the first failures calls to GetCommit, Exec and
+// ReadFile with code.
+type flakyGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+	failures int
+	code     codes.Code
+	calls    atomic.Int32
+}
+
+func (f *flakyGitserver) fail() error {
+	if int(f.calls.Add(1)) <= f.failures {
+		return status.New(f.code, "gitserver is restarting").Err()
+	}
+	return nil
+}
+
+func (f *flakyGitserver) GetCommit(ctx context.Context, req *proto.GetCommitRequest) (*proto.GetCommitResponse, error) {
+	if err := f.fail(); err != nil {
+		return nil, err
+	}
+	return &proto.GetCommitResponse{Commit: &proto.GitCommit{Oid: req.GetCommit()}}, nil
+}
+
+// Exec answers every command with the ls-tree entry of a single file.
+func (f *flakyGitserver) Exec(req *proto.ExecRequest, ss proto.GitserverService_ExecServer) error {
+	if err := f.fail(); err != nil {
+		return err
+	}
+	return ss.Send(&proto.ExecResponse{Data: []byte("100644 blob 0123456789abcdef0123456789abcdef01234567       7\tfile\x00")})
+}
+
+func (f *flakyGitserver) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverService_ReadFileServer) error {
+	if err := f.fail(); err != nil {
+		return err
+	}
+	return ss.Send(&proto.ReadFileResponse{Data: []byte("content")})
+}
+
+// newGRPCTestClient returns a client that talks to a real gRPC server backed
+// by the given implementation, so that interceptors and call options apply.
+func newGRPCTestClient(t *testing.T, impl proto.GitserverServiceServer) TestClient {
+	gs := grpc.NewServer()
+	proto.RegisterGitserverServiceServer(gs, impl)
+	srv := httptest.NewServer(internalgrpc.MultiplexHandlers(gs, http.NotFoundHandler()))
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return NewTestClient(t).WithClientSource(NewTestClientSource(t, []string{u.Host}))
+}
+
+func TestClient_WithRetryPolicy(t *testing.T) {
+	newClient := func(t *testing.T, f *flakyGitserver) TestClient {
+		return newGRPCTestClient(t, f)
+	}
+	noBackoff := func(context.Context, uint) time.Duration { return 0 }
+
+	t.Run("retries up to max attempts", func(t *testing.T) {
+		f := &flakyGitserver{failures: 2, code: codes.Unavailable}
+		c := newClient(t, f).WithRetryPolicy(RetryPolicy{MaxAttempts: 3, Backoff: noBackoff})
+
+		commit, err := c.GetCommit(context.Background(), "repo", "deadbeef")
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("deadbeef"), commit.ID)
+		require.Equal(t, int32(3), f.calls.Load())
+	})
+
+	t.Run("applies to Stat", func(t *testing.T) {
+		f := &flakyGitserver{failures: 2, code: codes.Unavailable}
+		c := newClient(t, f).WithRetryPolicy(RetryPolicy{MaxAttempts: 3, Backoff: noBackoff})
+
+		fi, err := c.Stat(context.Background(), "repo", "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", "file")
+		require.NoError(t, err)
+		require.Equal(t, "file", fi.Name())
+		require.Equal(t, int32(3), f.calls.Load())
+
+		f = &flakyGitserver{failures: 2, code: codes.Unavailable}
+		c = newClient(t, f).WithRetryPolicy(RetryPolicy{MaxAttempts: 2, Backoff: noBackoff})
+
+		_, err = c.Stat(context.Background(), "repo", "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", "file")
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, int32(2), f.calls.Load())
+	})
+
+	t.Run("applies to ReadFile", func(t *testing.T) {
+		f := &flakyGitserver{failures: 2, code: codes.Unavailable}
+		c := newClient(t, f).WithRetryPolicy(RetryPolicy{MaxAttempts: 3, Backoff: noBackoff})
+
+		r, err := c.NewFileReader(context.Background(), "repo", "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", "file")
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, "content", string(content))
+		require.Equal(t, int32(3), f.calls.Load())
+
+		f = &flakyGitserver{failures: 2, code: codes.Unavailable}
+		c = newClient(t, f).WithRetryPolicy(RetryPolicy{MaxAttempts: 2, Backoff: noBackoff})
+
+		r, err = c.NewFileReader(context.Background(), "repo", "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", "file")
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.NoError(t, r.Close())
+		require.Equal(t, int32(2), f.calls.Load())
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		f := &flakyGitserver{failures: 5, code: codes.Unavailable}
+		c := newClient(t, f).WithRetryPolicy(RetryPolicy{MaxAttempts: 2, Backoff: noBackoff})
+
+		_, err := c.GetCommit(context.Background(), "repo", "deadbeef")
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, int32(2), f.calls.Load())
+	})
+
+	t.Run("only retries given codes", func(t *testing.T) {
+		f := &flakyGitserver{failures: 1, code: codes.Unavailable}
+		c := newClient(t, f).WithRetryPolicy(RetryPolicy{MaxAttempts: 3, Backoff: noBackoff, Codes: []codes.Code{codes.Aborted}})
+
+		_, err := c.GetCommit(context.Background(), "repo", "deadbeef")
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, int32(1), f.calls.Load())
+
+		f = &flakyGitserver{failures: 1, code: codes.Aborted}
+		c = newClient(t, f).WithRetryPolicy(RetryPolicy{MaxAttempts: 3, Backoff: noBackoff, Codes: []codes.Code{codes.Aborted}})
+
+		_, err = c.GetCommit(context.Background(), "repo", "deadbeef")
+		require.NoError(t, err)
+		require.Equal(t, int32(2), f.calls.Load())
+	})
+
+	t.Run("zero value disables retries", func(t *testing.T) {
+		f := &flakyGitserver{failures: 1, code: codes.Unavailable}
+		c := newClient(t, f).WithRetryPolicy(RetryPolicy{})
+
+		_, err := c.GetCommit(context.Background(), "repo", "deadbeef")
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, int32(1), f.calls.Load())
+	})
+}
+
+// stallingGitserver stalls the first call to ResolveRevision until it is
+// canceled if stall is set, and answers all other calls right away.
+type stallingGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+	stall    bool
+	calls    atomic.Int32
+	canceled chan struct{}
+}
+
+func (s *stallingGitserver) ResolveRevision(ctx context.Context, req *proto.ResolveRevisionRequest) (*proto.ResolveRevisionResponse, error) {
+	if s.calls.Add(1) == 1 && s.stall {
+		<-ctx.Done()
+		close(s.canceled)
+		return nil, ctx.Err()
+	}
+	return &proto.ResolveRevisionResponse{CommitSha: "deadbeef"}, nil
+}
+
+func TestClient_WithHedging(t *testing.T) {
+	t.Run("sends a second attempt when the first is slow", func(t *testing.T) {
+		s := &stallingGitserver{stall: true, canceled: make(chan struct{})}
+		c := newGRPCTestClient(t, s).WithHedging(HedgingPolicy{Delay: 10 * time.Millisecond})
+
+		commit, err := c.ResolveRevision(context.Background(), "repo", "HEAD", ResolveRevisionOptions{})
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("deadbeef"), commit)
+		require.Equal(t, int32(2), s.calls.Load())
+
+		// The stalled attempt is canceled once the hedged one returned.
+		select {
+		case <-s.canceled:
+		case <-time.After(10 * time.Second):
+			t.Fatal("stalled attempt was not canceled")
+		}
+	})
+
+	t.Run("no second attempt for fast calls", func(t *testing.T) {
+		s := &stallingGitserver{}
+		c := newGRPCTestClient(t, s).WithHedging(HedgingPolicy{Delay: time.Minute})
+
+		_, err := c.ResolveRevision(context.Background(), "repo", "HEAD", ResolveRevisionOptions{})
+		require.NoError(t, err)
+		require.Equal(t, int32(1), s.calls.Load())
+	})
+}
+
+func TestHedge(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the first error if nothing else is pending", func(t *testing.T) {
+		var calls atomic.Int32
+		_, err := hedge(ctx, time.Minute, "test", func(context.Context) (int, error) {
+			calls.Add(1)
+			return 0, errors.New("boom")
+		})
+		require.EqualError(t, err, "boom")
+		require.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("waits for the other attempt on error", func(t *testing.T) {
+		var calls atomic.Int32
+		res, err := hedge(ctx, time.Millisecond, "test", func(context.Context) (int, error) {
+			if calls.Add(1) == 1 {
+				// Fail only after the second attempt was sent.
+				time.Sleep(50 * time.Millisecond)
+				return 0, errors.New("boom")
+			}
+			return 42, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 42, res)
+	})
+
+	t.Run("returns the last error if all attempts fail", func(t *testing.T) {
+		var calls atomic.Int32
+		_, err := hedge(ctx, time.Millisecond, "test", func(ctx context.Context) (int, error) {
+			if calls.Add(1) == 1 {
+				time.Sleep(50 * time.Millisecond)
+				return 0, errors.New("first")
+			}
+			return 0, errors.New("second")
+		})
+		require.Error(t, err)
+		require.Equal(t, int32(2), calls.Load())
+	})
+}
+
+// compressingGitserver records the compression used for ReadFile requests.
+type compressingGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+	// compressors are returned from Capabilities. If nil, Capabilities is
+	// unimplemented, like on older gitservers.
+	compressors      []string
+	capabilityCalls  atomic.Int32
+	recvCompressions chan string
+}
+
+func (s *compressingGitserver) Capabilities(ctx context.Context, req *proto.CapabilitiesRequest) (*proto.CapabilitiesResponse, error) {
+	s.capabilityCalls.Add(1)
+	if s.compressors == nil {
+		return s.UnimplementedGitserverServiceServer.Capabilities(ctx, req)
+	}
+	return &proto.CapabilitiesResponse{Compressors: s.compressors}, nil
+}
+
+func (s *compressingGitserver) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverService_ReadFileServer) error {
+	var compression string
+	if st, ok := grpc.ServerTransportStreamFromContext(ss.Context()).(interface{ RecvCompress() string }); ok {
+		compression = st.RecvCompress()
+	}
+	s.recvCompressions <- compression
+	return ss.Send(&proto.ReadFileResponse{Data: []byte(strings.Repeat("content\n", 100))})
+}
+
+func TestClient_WithCompression(t *testing.T) {
+	readFile := func(t *testing.T, c Client) {
+		r, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, strings.Repeat("content\n", 100), string(content))
+	}
+
+	t.Run("supported compressor", func(t *testing.T) {
+		s := &compressingGitserver{compressors: []string{compression.Zstd, compression.Gzip}, recvCompressions: make(chan string, 2)}
+		c := newGRPCTestClient(t, s).WithCompression(compression.Zstd)
+
+		readFile(t, c)
+		readFile(t, c)
+		require.Equal(t, compression.Zstd, <-s.recvCompressions)
+		require.Equal(t, compression.Zstd, <-s.recvCompressions)
+		// Capabilities are cached.
+		require.Equal(t, int32(1), s.capabilityCalls.Load())
+	})
+
+	t.Run("unsupported compressor", func(t *testing.T) {
+		s := &compressingGitserver{compressors: []string{compression.Gzip}, recvCompressions: make(chan string, 1)}
+		c := newGRPCTestClient(t, s).WithCompression(compression.Zstd)
+
+		readFile(t, c)
+		require.Empty(t, <-s.recvCompressions)
+	})
+
+	t.Run("older gitserver", func(t *testing.T) {
+		s := &compressingGitserver{recvCompressions: make(chan string, 2)}
+		c := newGRPCTestClient(t, s).WithCompression(compression.Gzip)
+
+		readFile(t, c)
+		readFile(t, c)
+		require.Empty(t, <-s.recvCompressions)
+		require.Empty(t, <-s.recvCompressions)
+		require.Equal(t, int32(1), s.capabilityCalls.Load())
+	})
+
+	t.Run("no compression by default", func(t *testing.T) {
+		s := &compressingGitserver{compressors: []string{compression.Gzip}, recvCompressions: make(chan string, 1)}
+		c := newGRPCTestClient(t, s)
+
+		readFile(t, c)
+		require.Empty(t, <-s.recvCompressions)
+		require.Zero(t, s.capabilityCalls.Load())
+	})
+}
+
+func TestClient_WithMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	f := &flakyGitserver{failures: 1, code: codes.InvalidArgument}
+	c := newGRPCTestClient(t, f).Scoped("caller").WithMetrics(reg)
+
+	scope := "gitserver.test." + t.Name() + ".caller"
+
+	_, err := c.GetCommit(context.Background(), "repo", "deadbeef")
+	require.Error(t, err)
+	_, err = c.GetCommit(context.Background(), "repo", "deadbeef")
+	require.NoError(t, err)
+
+	// histogram returns the sample count and sum of the histogram with the
+	// given labels.
+	histogram := func(name string, labels map[string]string) (uint64, float64) {
+		families, err := reg.Gather()
+		require.NoError(t, err)
+		for _, f := range families {
+			if f.GetName() != name {
+				continue
+			}
+		metrics:
+			for _, m := range f.GetMetric() {
+				for _, l := range m.GetLabel() {
+					if labels[l.GetName()] != l.GetValue() {
+						continue metrics
+					}
+				}
+				return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+			}
+		}
+		return 0, 0
+	}
+
+	count, _ := histogram("src_gitserver_client_rpc_duration_seconds", map[string]string{"method": "GetCommit", "scope": scope, "code": "OK"})
+	require.Equal(t, uint64(1), count)
+	count, _ = histogram("src_gitserver_client_rpc_duration_seconds", map[string]string{"method": "GetCommit", "scope": scope, "code": "InvalidArgument"})
+	require.Equal(t, uint64(1), count)
+	errorSeries, err := testutil.GatherAndCount(reg, "src_gitserver_client_rpc_errors_total")
+	require.NoError(t, err)
+	require.Equal(t, 1, errorSeries)
+
+	t.Run("streams", func(t *testing.T) {
+		s := &compressingGitserver{recvCompressions: make(chan string, 1)}
+		c := newGRPCTestClient(t, s).WithMetrics(reg)
+		scope := "gitserver.test." + t.Name()
+
+		r, err := c.NewFileReader(context.Background(), "repo", "deadbeef", "file")
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+
+		count, sum := histogram("src_gitserver_client_rpc_received_bytes", map[string]string{"method": "ReadFile", "scope": scope})
+		require.Equal(t, uint64(1), count)
+		// The message contains the data, plus a few bytes of framing.
+		require.Greater(t, sum, float64(len(strings.Repeat("content\n", 100))))
+		count, _ = histogram("src_gitserver_client_rpc_duration_seconds", map[string]string{"method": "ReadFile", "scope": scope, "code": "OK"})
+		require.Equal(t, uint64(1), count)
+	})
+}
+
+// tracingGitserver records the trace context propagated to ResolveRevision.
+type tracingGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+	traceparents chan []string
+}
+
+func (s *tracingGitserver) ResolveRevision(ctx context.Context, req *proto.ResolveRevisionRequest) (*proto.ResolveRevisionResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.traceparents <- md.Get("traceparent")
+	return &proto.ResolveRevisionResponse{CommitSha: "deadbeef"}, nil
+}
+
+func TestClient_TracePropagation(t *testing.T) {
+	oldPropagator := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(oldPropagator) })
+
+	s := &tracingGitserver{traceparents: make(chan []string, 1)}
+	c := newGRPCTestClient(t, s)
+
+	traceID := oteltrace.TraceID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	ctx := oteltrace.ContextWithSpanContext(context.Background(), oteltrace.NewSpanContext(oteltrace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     oteltrace.SpanID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+		TraceFlags: oteltrace.FlagsSampled,
+	}))
+
+	_, err := c.ResolveRevision(ctx, "repo", "HEAD", ResolveRevisionOptions{})
+	require.NoError(t, err)
+
+	traceparents := <-s.traceparents
+	require.Len(t, traceparents, 1)
+	require.Contains(t, traceparents[0], traceID.String())
+}
+
+func TestConvertGRPCErrorToGitDomainError_typedCause(t *testing.T) {
+	s, err := status.New(codes.Unknown, "exit status 128").WithDetails(
+		&proto.ExecStatusPayload{StatusCode: 128, Stderr: "fatal: bad object deadbeef"},
+		&proto.BadObjectPayload{RepoName: "repo", Object: "deadbeef"},
+	)
+	require.NoError(t, err)
+
+	err = convertGRPCErrorToGitDomainError(s.Err())
+
+	// The command status is kept, and the typed error can be matched.
+	var cmdErr *CommandStatusError
+	require.True(t, errors.As(err, &cmdErr))
+	require.Equal(t, int32(128), cmdErr.StatusCode)
+	var badObject *gitdomain.BadObjectError
+	require.True(t, errors.As(err, &badObject))
+	require.Equal(t, &gitdomain.BadObjectError{Repo: "repo", Object: "deadbeef"}, badObject)
+}
+
+func TestConvertGRPCErrorToGitDomainError_ambiguousRevision(t *testing.T) {
+	s, err := status.New(codes.InvalidArgument, "ambiguous revision").WithDetails(&proto.AmbiguousRevisionPayload{
+		RepoName: "repo",
+		Spec:     "066c",
+		Candidates: []*proto.AmbiguousRevisionCandidate{
+			{Id: "066cbfe", Type: "commit", Description: "2024-01-01 - foo"},
+			{Id: "066ce60", Type: "blob"},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, &gitdomain.AmbiguousRevisionError{
+		Repo: "repo",
+		Spec: "066c",
+		Candidates: []gitdomain.AmbiguousRevisionCandidate{
+			{ID: "066cbfe", Type: gitdomain.ObjectTypeCommit, Description: "2024-01-01 - foo"},
+			{ID: "066ce60", Type: gitdomain.ObjectTypeBlob},
+		},
+	}, convertGRPCErrorToGitDomainError(s.Err()))
+}
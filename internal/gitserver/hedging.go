@@ -0,0 +1,123 @@
+package gitserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+var hedgedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_client_hedged_requests_total",
+	Help: "Number of second attempts sent by the gitserver client because the first attempt was slow.",
+}, []string{"method"})
+
+// HedgingPolicy configures hedged requests: if a small idempotent RPC, such
+// as ResolveRevision or DefaultBranch, hasn't returned after Delay, a second
+// attempt is sent and the first successful response of both is used. This
+// cuts tail latency when a gitserver is briefly slow, at the cost of extra
+// load on it.
+type HedgingPolicy struct {
+	// Delay is how long to wait for the first attempt before sending the
+	// second one. Hedging is disabled if Delay is not positive.
+	Delay time.Duration
+}
+
+func (c *clientImplementor) WithHedging(policy HedgingPolicy) Client {
+	if policy.Delay <= 0 {
+		return c
+	}
+	return &clientImplementor{
+		logger:              c.logger,
+		scope:               c.scope,
+		operations:          c.operations,
+		subRepoPermsChecker: c.subRepoPermsChecker,
+		clientSource: &hedgingClientSource{
+			ClientSource: c.clientSource,
+			delay:        policy.Delay,
+		},
+	}
+}
+
+// hedgingClientSource wraps the clients returned by a ClientSource, so that
+// they hedge requests.
+type hedgingClientSource struct {
+	ClientSource
+	delay time.Duration
+}
+
+func (s *hedgingClientSource) ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error) {
+	client, err := s.ClientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	return &hedgingClient{GitserverServiceClient: client, delay: s.delay}, nil
+}
+
+// hedgingClient hedges the RPCs that are cheap enough to be sent twice. All
+// other RPCs are passed through unchanged.
+type hedgingClient struct {
+	proto.GitserverServiceClient
+	delay time.Duration
+}
+
+func (h *hedgingClient) ResolveRevision(ctx context.Context, in *proto.ResolveRevisionRequest, opts ...grpc.CallOption) (*proto.ResolveRevisionResponse, error) {
+	return hedge(ctx, h.delay, "ResolveRevision", func(ctx context.Context) (*proto.ResolveRevisionResponse, error) {
+		return h.GitserverServiceClient.ResolveRevision(ctx, in, opts...)
+	})
+}
+
+func (h *hedgingClient) DefaultBranch(ctx context.Context, in *proto.DefaultBranchRequest, opts ...grpc.CallOption) (*proto.DefaultBranchResponse, error) {
+	return hedge(ctx, h.delay, "DefaultBranch", func(ctx context.Context) (*proto.DefaultBranchResponse, error) {
+		return h.GitserverServiceClient.DefaultBranch(ctx, in, opts...)
+	})
+}
+
+// hedge invokes call, and invokes it a second time if the first call hasn't
+// returned after delay. It returns the first successful result. If the first
+// call fails before the second one was sent, its error is returned right
+// away, retrying is left to the retry policy.
+func hedge[T any](ctx context.Context, delay time.Duration, method string, call func(context.Context) (T, error)) (T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	// Cancels the attempt that lost the race.
+	defer cancel()
+
+	type result struct {
+		res T
+		err error
+	}
+	// Buffered, so that the losing attempt never blocks.
+	results := make(chan result, 2)
+	attempt := func() {
+		res, err := call(ctx)
+		results <- result{res: res, err: err}
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	go attempt()
+	pending := 1
+	hedgeC := timer.C
+	for {
+		select {
+		case <-hedgeC:
+			hedgeC = nil
+			hedgedRequests.WithLabelValues(method).Inc()
+			pending++
+			go attempt()
+		case r := <-results:
+			pending--
+			if r.err == nil || pending == 0 {
+				return r.res, r.err
+			}
+		}
+	}
+}
+
+var _ proto.GitserverServiceClient = &hedgingClient{}
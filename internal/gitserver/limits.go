@@ -0,0 +1,97 @@
+package gitserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// ResponseLimits caps the size of responses the client accepts from
+// gitserver. Zero values mean no limit.
+type ResponseLimits struct {
+	// MaxBytes limits the number of bytes read from NewFileReader, ReadBlob
+	// and ArchiveReader.
+	MaxBytes int64
+	// MaxEntries limits the number of entries returned by ReadDir, GetTree
+	// and LsFiles.
+	MaxEntries int
+}
+
+type responseLimitsKey struct{}
+
+// WithResponseLimits returns a context that applies the given limits to all
+// gitserver calls made with it. Calls that exceed a limit return the data up
+// to the limit along with a *TruncatedError.
+func WithResponseLimits(ctx context.Context, limits ResponseLimits) context.Context {
+	return context.WithValue(ctx, responseLimitsKey{}, limits)
+}
+
+func responseLimitsFromContext(ctx context.Context) ResponseLimits {
+	limits, _ := ctx.Value(responseLimitsKey{}).(ResponseLimits)
+	return limits
+}
+
+// TruncatedError is returned when a response exceeded one of the
+// ResponseLimits.
+type TruncatedError struct {
+	// Unit is what the limit counts, either "bytes" or "entries".
+	Unit string
+	// Limit is the configured limit.
+	Limit int64
+	// Truncated is how many bytes or entries were dropped.
+	Truncated int64
+}
+
+func (e *TruncatedError) Error() string {
+	return fmt.Sprintf("response truncated: %d %s exceeding the limit of %d were dropped", e.Truncated, e.Unit, e.Limit)
+}
+
+// limitRecv wraps the receive function of a byte stream, so that at most
+// maxBytes are returned. Once the limit is hit, the rest of the stream is
+// discarded to count the truncated bytes, and a *TruncatedError is returned.
+func limitRecv(maxBytes int64, recv func() ([]byte, error)) func() ([]byte, error) {
+	if maxBytes <= 0 {
+		return recv
+	}
+
+	var read, truncated int64
+	return func() ([]byte, error) {
+		if truncated > 0 {
+			for {
+				data, err := recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return nil, err
+				}
+				truncated += int64(len(data))
+			}
+			return nil, &TruncatedError{Unit: "bytes", Limit: maxBytes, Truncated: truncated}
+		}
+
+		data, err := recv()
+		if err != nil {
+			return nil, err
+		}
+		if remaining := maxBytes - read; int64(len(data)) > remaining {
+			truncated = int64(len(data)) - remaining
+			data = data[:remaining]
+		}
+		read += int64(len(data))
+		return data, nil
+	}
+}
+
+// truncateEntries returns at most maxEntries entries, and a *TruncatedError
+// if any entries were dropped.
+func truncateEntries[T any](entries []T, maxEntries int) ([]T, error) {
+	if maxEntries <= 0 || len(entries) <= maxEntries {
+		return entries, nil
+	}
+	return entries[:maxEntries], &TruncatedError{
+		Unit:      "entries",
+		Limit:     int64(maxEntries),
+		Truncated: int64(len(entries) - maxEntries),
+	}
+}
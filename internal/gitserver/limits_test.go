@@ -0,0 +1,133 @@
+package gitserver
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+	"github.com/sourcegraph/sourcegraph/internal/grpc/streamio"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// chunkedGitserver streams files in chunks of ten bytes.
+type chunkedGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+	chunks int
+}
+
+func (s *chunkedGitserver) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverService_ReadFileServer) error {
+	for i := 0; i < s.chunks; i++ {
+		if err := ss.Send(&proto.ReadFileResponse{Data: []byte("0123456789")}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestClient_WithResponseLimits(t *testing.T) {
+	c := newGRPCTestClient(t, &chunkedGitserver{chunks: 3})
+
+	readFile := func(ctx context.Context) (string, error) {
+		r, err := c.NewFileReader(ctx, "repo", "deadbeef", "file")
+		require.NoError(t, err)
+		defer r.Close()
+		content, err := io.ReadAll(r)
+		return string(content), err
+	}
+
+	t.Run("within limit", func(t *testing.T) {
+		content, err := readFile(WithResponseLimits(context.Background(), ResponseLimits{MaxBytes: 30}))
+		require.NoError(t, err)
+		require.Len(t, content, 30)
+	})
+
+	t.Run("exceeding limit", func(t *testing.T) {
+		content, err := readFile(WithResponseLimits(context.Background(), ResponseLimits{MaxBytes: 15}))
+		require.Equal(t, "012345678901234", content)
+		var truncatedErr *TruncatedError
+		require.True(t, errors.As(err, &truncatedErr))
+		require.Equal(t, &TruncatedError{Unit: "bytes", Limit: 15, Truncated: 15}, truncatedErr)
+	})
+
+	t.Run("entries", func(t *testing.T) {
+		ClientMocks.LocalGitserver = true
+		t.Cleanup(ResetClientMocks)
+
+		r := NewTestRepo(t)
+		head := r.Commit("commit1").AddFile("file1", "").AddFile("file2", "").AddFile("file3", "")
+		repo := r.Build()
+		commit := head.ID()
+		ctx := WithResponseLimits(context.Background(), ResponseLimits{MaxEntries: 2})
+
+		files, err := NewTestClient(t).LsFiles(ctx, repo, commit)
+		require.Equal(t, []string{"file1", "file2"}, files)
+		require.Equal(t, &TruncatedError{Unit: "entries", Limit: 2, Truncated: 1}, err)
+
+		infos, err := NewTestClient(t).ReadDir(ctx, repo, commit, "", false)
+		require.Len(t, infos, 2)
+		require.Equal(t, &TruncatedError{Unit: "entries", Limit: 2, Truncated: 1}, err)
+	})
+}
+
+func TestLimitRecv(t *testing.T) {
+	recvChunks := func(chunks ...string) func() ([]byte, error) {
+		return func() ([]byte, error) {
+			if len(chunks) == 0 {
+				return nil, io.EOF
+			}
+			chunk := chunks[0]
+			chunks = chunks[1:]
+			return []byte(chunk), nil
+		}
+	}
+
+	t.Run("no limit", func(t *testing.T) {
+		data, err := io.ReadAll(streamio.NewReader(limitRecv(0, recvChunks("abc", "def"))))
+		require.NoError(t, err)
+		require.Equal(t, "abcdef", string(data))
+	})
+
+	t.Run("exact limit", func(t *testing.T) {
+		data, err := io.ReadAll(streamio.NewReader(limitRecv(6, recvChunks("abc", "def"))))
+		require.NoError(t, err)
+		require.Equal(t, "abcdef", string(data))
+	})
+
+	t.Run("limit on chunk boundary", func(t *testing.T) {
+		data, err := io.ReadAll(streamio.NewReader(limitRecv(3, recvChunks("abc", "def", "gh"))))
+		require.Equal(t, "abc", string(data))
+		require.Equal(t, &TruncatedError{Unit: "bytes", Limit: 3, Truncated: 5}, err)
+	})
+
+	t.Run("stream error while draining", func(t *testing.T) {
+		recvErr := errors.New("broken stream")
+		calls := 0
+		recv := func() ([]byte, error) {
+			calls++
+			if calls > 1 {
+				return nil, recvErr
+			}
+			return []byte("abc"), nil
+		}
+		data, err := io.ReadAll(streamio.NewReader(limitRecv(1, recv)))
+		require.Equal(t, "a", string(data))
+		require.ErrorIs(t, err, recvErr)
+	})
+}
+
+func TestTruncateEntries(t *testing.T) {
+	entries, err := truncateEntries([]int{1, 2, 3}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, entries)
+
+	entries, err = truncateEntries([]int{1, 2, 3}, 3)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, entries)
+
+	entries, err = truncateEntries([]int{1, 2, 3}, 1)
+	require.Equal(t, []int{1}, entries)
+	require.Equal(t, &TruncatedError{Unit: "entries", Limit: 1, Truncated: 2}, err)
+}
@@ -0,0 +1,94 @@
+package gitserver
+
+import (
+	"context"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+var mergeBaseCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_client_merge_base_cache_requests_total",
+	Help: "Number of MergeBase calls for absolute commit SHAs handled by the gitserver client merge base cache, by result.",
+}, []string{"result"})
+
+// WithMergeBaseCache returns a new client that keeps up to size merge bases
+// in memory. Only merge bases of two absolute commit SHAs are cached, because
+// they never change. The compare pages ask for the same merge bases on every
+// load.
+func (c *clientImplementor) WithMergeBaseCache(size int) Client {
+	if size <= 0 {
+		return c
+	}
+	cache, err := lru.New[mergeBaseCacheKey, string](size)
+	if err != nil {
+		// Can only happen for a non-positive size.
+		return c
+	}
+	return &clientImplementor{
+		logger:              c.logger,
+		scope:               c.scope,
+		operations:          c.operations,
+		subRepoPermsChecker: c.subRepoPermsChecker,
+		clientSource: &mergeBaseCacheClientSource{
+			ClientSource: c.clientSource,
+			cache:        cache,
+		},
+	}
+}
+
+type mergeBaseCacheKey struct {
+	repo string
+	base string
+	head string
+}
+
+// mergeBaseCacheClientSource wraps the clients returned by a ClientSource, so
+// that they share a merge base cache.
+type mergeBaseCacheClientSource struct {
+	ClientSource
+	cache *lru.Cache[mergeBaseCacheKey, string]
+}
+
+func (s *mergeBaseCacheClientSource) ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error) {
+	client, err := s.ClientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	return &mergeBaseCacheClient{GitserverServiceClient: client, cache: s.cache}, nil
+}
+
+// mergeBaseCacheClient serves MergeBase requests for absolute commit SHAs from
+// the merge base cache, and fills it with the responses of successful calls.
+// All other RPCs are passed through unchanged.
+type mergeBaseCacheClient struct {
+	proto.GitserverServiceClient
+	cache *lru.Cache[mergeBaseCacheKey, string]
+}
+
+func (c *mergeBaseCacheClient) MergeBase(ctx context.Context, in *proto.MergeBaseRequest, opts ...grpc.CallOption) (*proto.MergeBaseResponse, error) {
+	base, head := string(in.GetBase()), string(in.GetHead())
+	if !gitdomain.IsAbsoluteRevision(base) || !gitdomain.IsAbsoluteRevision(head) {
+		return c.GitserverServiceClient.MergeBase(ctx, in, opts...)
+	}
+
+	key := mergeBaseCacheKey{repo: in.GetRepoName(), base: base, head: head}
+	if sha, ok := c.cache.Get(key); ok {
+		mergeBaseCacheRequests.WithLabelValues("hit").Inc()
+		return &proto.MergeBaseResponse{MergeBaseCommitSha: sha}, nil
+	}
+	mergeBaseCacheRequests.WithLabelValues("miss").Inc()
+
+	res, err := c.GitserverServiceClient.MergeBase(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Add(key, res.GetMergeBaseCommitSha())
+	return res, nil
+}
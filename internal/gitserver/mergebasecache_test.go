@@ -0,0 +1,73 @@
+package gitserver
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+// mergeBaseGitserver answers every MergeBase call with the same commit and
+// counts the calls.
+type mergeBaseGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+	calls atomic.Int32
+}
+
+func (s *mergeBaseGitserver) MergeBase(context.Context, *proto.MergeBaseRequest) (*proto.MergeBaseResponse, error) {
+	s.calls.Add(1)
+	return &proto.MergeBaseResponse{MergeBaseCommitSha: "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef"}, nil
+}
+
+func TestClient_WithMergeBaseCache(t *testing.T) {
+	const (
+		base = "1111111111111111111111111111111111111111"
+		head = "2222222222222222222222222222222222222222"
+	)
+
+	t.Run("absolute commits are cached", func(t *testing.T) {
+		s := &mergeBaseGitserver{}
+		c := newGRPCTestClient(t, s).WithMergeBaseCache(10)
+
+		for range 2 {
+			sha, err := c.MergeBase(context.Background(), "repo", base, head)
+			require.NoError(t, err)
+			require.Equal(t, api.CommitID("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef"), sha)
+		}
+		require.Equal(t, int32(1), s.calls.Load())
+
+		// Other repositories are separate entries.
+		_, err := c.MergeBase(context.Background(), "other", base, head)
+		require.NoError(t, err)
+		require.Equal(t, int32(2), s.calls.Load())
+	})
+
+	t.Run("revisions that can move are not cached", func(t *testing.T) {
+		s := &mergeBaseGitserver{}
+		c := newGRPCTestClient(t, s).WithMergeBaseCache(10)
+
+		for range 2 {
+			_, err := c.MergeBase(context.Background(), "repo", "master", head)
+			require.NoError(t, err)
+			_, err = c.MergeBase(context.Background(), "repo", base, "HEAD")
+			require.NoError(t, err)
+		}
+		require.Equal(t, int32(4), s.calls.Load())
+	})
+
+	t.Run("clients without a cache are not affected", func(t *testing.T) {
+		s := &mergeBaseGitserver{}
+		c := newGRPCTestClient(t, s)
+		_ = c.WithMergeBaseCache(10)
+
+		for range 2 {
+			_, err := c.MergeBase(context.Background(), "repo", base, head)
+			require.NoError(t, err)
+		}
+		require.Equal(t, int32(2), s.calls.Load())
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	prometheus "github.com/prometheus/client_golang/prometheus"
 	api "github.com/sourcegraph/sourcegraph/internal/api"
 	gitolite "github.com/sourcegraph/sourcegraph/internal/extsvc/gitolite"
 	gitdomain "github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
@@ -24,18 +25,51 @@ import (
 // package github.com/sourcegraph/sourcegraph/internal/gitserver) used for
 // unit testing.
 type MockClient struct {
+	// AbbreviateCommitFunc is an instance of a mock function object
+	// controlling the behavior of the method AbbreviateCommit.
+	AbbreviateCommitFunc *ClientAbbreviateCommitFunc
 	// AddrForRepoFunc is an instance of a mock function object controlling
 	// the behavior of the method AddrForRepo.
 	AddrForRepoFunc *ClientAddrForRepoFunc
+	// AncestryPathFunc is an instance of a mock function object controlling
+	// the behavior of the method AncestryPath.
+	AncestryPathFunc *ClientAncestryPathFunc
+	// ApplyBundleFunc is an instance of a mock function object controlling
+	// the behavior of the method ApplyBundle.
+	ApplyBundleFunc *ClientApplyBundleFunc
 	// ArchiveReaderFunc is an instance of a mock function object
 	// controlling the behavior of the method ArchiveReader.
 	ArchiveReaderFunc *ClientArchiveReaderFunc
+	// BlameSummaryFunc is an instance of a mock function object controlling
+	// the behavior of the method BlameSummary.
+	BlameSummaryFunc *ClientBlameSummaryFunc
 	// CheckPerforceCredentialsFunc is an instance of a mock function object
 	// controlling the behavior of the method CheckPerforceCredentials.
 	CheckPerforceCredentialsFunc *ClientCheckPerforceCredentialsFunc
+	// CherryFunc is an instance of a mock function object controlling the
+	// behavior of the method Cherry.
+	CherryFunc *ClientCherryFunc
+	// CherryCrossRepoFunc is an instance of a mock function object
+	// controlling the behavior of the method CherryCrossRepo.
+	CherryCrossRepoFunc *ClientCherryCrossRepoFunc
+	// CherryPickFunc is an instance of a mock function object controlling
+	// the behavior of the method CherryPick.
+	CherryPickFunc *ClientCherryPickFunc
+	// CloneProgressFunc is an instance of a mock function object
+	// controlling the behavior of the method CloneProgress.
+	CloneProgressFunc *ClientCloneProgressFunc
+	// CloneStateFunc is an instance of a mock function object controlling
+	// the behavior of the method CloneState.
+	CloneStateFunc *ClientCloneStateFunc
+	// CommitActivityFunc is an instance of a mock function object
+	// controlling the behavior of the method CommitActivity.
+	CommitActivityFunc *ClientCommitActivityFunc
 	// CommitGraphFunc is an instance of a mock function object controlling
 	// the behavior of the method CommitGraph.
 	CommitGraphFunc *ClientCommitGraphFunc
+	// CommitGraphStatusFunc is an instance of a mock function object
+	// controlling the behavior of the method CommitGraphStatus.
+	CommitGraphStatusFunc *ClientCommitGraphStatusFunc
 	// CommitLogFunc is an instance of a mock function object controlling
 	// the behavior of the method CommitLog.
 	CommitLogFunc *ClientCommitLogFunc
@@ -48,30 +82,78 @@ type MockClient struct {
 	// ContributorCountFunc is an instance of a mock function object
 	// controlling the behavior of the method ContributorCount.
 	ContributorCountFunc *ClientContributorCountFunc
+	// CountCommitsFunc is an instance of a mock function object controlling
+	// the behavior of the method CountCommits.
+	CountCommitsFunc *ClientCountCommitsFunc
+	// CreateBranchFunc is an instance of a mock function object controlling
+	// the behavior of the method CreateBranch.
+	CreateBranchFunc *ClientCreateBranchFunc
+	// CreateBundleFunc is an instance of a mock function object controlling
+	// the behavior of the method CreateBundle.
+	CreateBundleFunc *ClientCreateBundleFunc
 	// CreateCommitFromPatchFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateCommitFromPatch.
 	CreateCommitFromPatchFunc *ClientCreateCommitFromPatchFunc
+	// CreateTagFunc is an instance of a mock function object controlling
+	// the behavior of the method CreateTag.
+	CreateTagFunc *ClientCreateTagFunc
+	// DeleteBranchFunc is an instance of a mock function object controlling
+	// the behavior of the method DeleteBranch.
+	DeleteBranchFunc *ClientDeleteBranchFunc
+	// DiagnoseAddrForRepoFunc is an instance of a mock function object
+	// controlling the behavior of the method DiagnoseAddrForRepo.
+	DiagnoseAddrForRepoFunc *ClientDiagnoseAddrForRepoFunc
 	// DiffFunc is an instance of a mock function object controlling the
 	// behavior of the method Diff.
 	DiffFunc *ClientDiffFunc
+	// DiffCrossRepoFunc is an instance of a mock function object
+	// controlling the behavior of the method DiffCrossRepo.
+	DiffCrossRepoFunc *ClientDiffCrossRepoFunc
 	// DiffSymbolsFunc is an instance of a mock function object controlling
 	// the behavior of the method DiffSymbols.
 	DiffSymbolsFunc *ClientDiffSymbolsFunc
+	// DirectoryHistoryFunc is an instance of a mock function object
+	// controlling the behavior of the method DirectoryHistory.
+	DirectoryHistoryFunc *ClientDirectoryHistoryFunc
+	// EnsureRevisionFunc is an instance of a mock function object
+	// controlling the behavior of the method EnsureRevision.
+	EnsureRevisionFunc *ClientEnsureRevisionFunc
+	// ExecInWorktreeFunc is an instance of a mock function object
+	// controlling the behavior of the method ExecInWorktree.
+	ExecInWorktreeFunc *ClientExecInWorktreeFunc
+	// FetchRefspecFunc is an instance of a mock function object controlling
+	// the behavior of the method FetchRefspec.
+	FetchRefspecFunc *ClientFetchRefspecFunc
+	// FirstCommitForPathFunc is an instance of a mock function object
+	// controlling the behavior of the method FirstCommitForPath.
+	FirstCommitForPathFunc *ClientFirstCommitForPathFunc
 	// FirstEverCommitFunc is an instance of a mock function object
 	// controlling the behavior of the method FirstEverCommit.
 	FirstEverCommitFunc *ClientFirstEverCommitFunc
+	// FormatPatchFunc is an instance of a mock function object controlling
+	// the behavior of the method FormatPatch.
+	FormatPatchFunc *ClientFormatPatchFunc
 	// GetBehindAheadFunc is an instance of a mock function object
 	// controlling the behavior of the method GetBehindAhead.
 	GetBehindAheadFunc *ClientGetBehindAheadFunc
 	// GetCommitFunc is an instance of a mock function object controlling
 	// the behavior of the method GetCommit.
 	GetCommitFunc *ClientGetCommitFunc
+	// GetCommitWithChangesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetCommitWithChanges.
+	GetCommitWithChangesFunc *ClientGetCommitWithChangesFunc
 	// GetDefaultBranchFunc is an instance of a mock function object
 	// controlling the behavior of the method GetDefaultBranch.
 	GetDefaultBranchFunc *ClientGetDefaultBranchFunc
 	// GetObjectFunc is an instance of a mock function object controlling
 	// the behavior of the method GetObject.
 	GetObjectFunc *ClientGetObjectFunc
+	// GetTagFunc is an instance of a mock function object controlling the
+	// behavior of the method GetTag.
+	GetTagFunc *ClientGetTagFunc
+	// GetTreeFunc is an instance of a mock function object controlling the
+	// behavior of the method GetTree.
+	GetTreeFunc *ClientGetTreeFunc
 	// HasCommitAfterFunc is an instance of a mock function object
 	// controlling the behavior of the method HasCommitAfter.
 	HasCommitAfterFunc *ClientHasCommitAfterFunc
@@ -84,6 +166,12 @@ type MockClient struct {
 	// IsRepoCloneableFunc is an instance of a mock function object
 	// controlling the behavior of the method IsRepoCloneable.
 	IsRepoCloneableFunc *ClientIsRepoCloneableFunc
+	// LastCommitsForTreeFunc is an instance of a mock function object
+	// controlling the behavior of the method LastCommitsForTree.
+	LastCommitsForTreeFunc *ClientLastCommitsForTreeFunc
+	// ListBranchesFunc is an instance of a mock function object controlling
+	// the behavior of the method ListBranches.
+	ListBranchesFunc *ClientListBranchesFunc
 	// ListDirectoryChildrenFunc is an instance of a mock function object
 	// controlling the behavior of the method ListDirectoryChildren.
 	ListDirectoryChildrenFunc *ClientListDirectoryChildrenFunc
@@ -93,6 +181,15 @@ type MockClient struct {
 	// ListRefsFunc is an instance of a mock function object controlling the
 	// behavior of the method ListRefs.
 	ListRefsFunc *ClientListRefsFunc
+	// ListRemoteRefsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListRemoteRefs.
+	ListRemoteRefsFunc *ClientListRemoteRefsFunc
+	// ListShardReposFunc is an instance of a mock function object
+	// controlling the behavior of the method ListShardRepos.
+	ListShardReposFunc *ClientListShardReposFunc
+	// ListTagsFunc is an instance of a mock function object controlling the
+	// behavior of the method ListTags.
+	ListTagsFunc *ClientListTagsFunc
 	// LogReverseEachFunc is an instance of a mock function object
 	// controlling the behavior of the method LogReverseEach.
 	LogReverseEachFunc *ClientLogReverseEachFunc
@@ -102,9 +199,21 @@ type MockClient struct {
 	// MergeBaseFunc is an instance of a mock function object controlling
 	// the behavior of the method MergeBase.
 	MergeBaseFunc *ClientMergeBaseFunc
+	// MergeBaseCrossRepoFunc is an instance of a mock function object
+	// controlling the behavior of the method MergeBaseCrossRepo.
+	MergeBaseCrossRepoFunc *ClientMergeBaseCrossRepoFunc
 	// NewFileReaderFunc is an instance of a mock function object
 	// controlling the behavior of the method NewFileReader.
 	NewFileReaderFunc *ClientNewFileReaderFunc
+	// NewFileReaderIfNotOIDFunc is an instance of a mock function object
+	// controlling the behavior of the method NewFileReaderIfNotOID.
+	NewFileReaderIfNotOIDFunc *ClientNewFileReaderIfNotOIDFunc
+	// OptimizeRepoFunc is an instance of a mock function object controlling
+	// the behavior of the method OptimizeRepo.
+	OptimizeRepoFunc *ClientOptimizeRepoFunc
+	// PathExistsFunc is an instance of a mock function object controlling
+	// the behavior of the method PathExists.
+	PathExistsFunc *ClientPathExistsFunc
 	// PerforceGetChangelistFunc is an instance of a mock function object
 	// controlling the behavior of the method PerforceGetChangelist.
 	PerforceGetChangelistFunc *ClientPerforceGetChangelistFunc
@@ -120,9 +229,27 @@ type MockClient struct {
 	// PerforceUsersFunc is an instance of a mock function object
 	// controlling the behavior of the method PerforceUsers.
 	PerforceUsersFunc *ClientPerforceUsersFunc
+	// RangeDiffFunc is an instance of a mock function object controlling
+	// the behavior of the method RangeDiff.
+	RangeDiffFunc *ClientRangeDiffFunc
+	// RawDiffFunc is an instance of a mock function object controlling the
+	// behavior of the method RawDiff.
+	RawDiffFunc *ClientRawDiffFunc
+	// ReadBlobFunc is an instance of a mock function object controlling the
+	// behavior of the method ReadBlob.
+	ReadBlobFunc *ClientReadBlobFunc
 	// ReadDirFunc is an instance of a mock function object controlling the
 	// behavior of the method ReadDir.
 	ReadDirFunc *ClientReadDirFunc
+	// RebasePreviewFunc is an instance of a mock function object
+	// controlling the behavior of the method RebasePreview.
+	RebasePreviewFunc *ClientRebasePreviewFunc
+	// RefExistsFunc is an instance of a mock function object controlling
+	// the behavior of the method RefExists.
+	RefExistsFunc *ClientRefExistsFunc
+	// RefsChangedSinceFunc is an instance of a mock function object
+	// controlling the behavior of the method RefsChangedSince.
+	RefsChangedSinceFunc *ClientRefsChangedSinceFunc
 	// RemoveFunc is an instance of a mock function object controlling the
 	// behavior of the method Remove.
 	RemoveFunc *ClientRemoveFunc
@@ -141,50 +268,175 @@ type MockClient struct {
 	// RevListFunc is an instance of a mock function object controlling the
 	// behavior of the method RevList.
 	RevListFunc *ClientRevListFunc
+	// RevertCommitFunc is an instance of a mock function object controlling
+	// the behavior of the method RevertCommit.
+	RevertCommitFunc *ClientRevertCommitFunc
 	// ScopedFunc is an instance of a mock function object controlling the
 	// behavior of the method Scoped.
 	ScopedFunc *ClientScopedFunc
+	// ScopedToPathFunc is an instance of a mock function object controlling
+	// the behavior of the method ScopedToPath.
+	ScopedToPathFunc *ClientScopedToPathFunc
 	// SearchFunc is an instance of a mock function object controlling the
 	// behavior of the method Search.
 	SearchFunc *ClientSearchFunc
+	// SearchCommitsFunc is an instance of a mock function object
+	// controlling the behavior of the method SearchCommits.
+	SearchCommitsFunc *ClientSearchCommitsFunc
+	// SparseManifestFunc is an instance of a mock function object
+	// controlling the behavior of the method SparseManifest.
+	SparseManifestFunc *ClientSparseManifestFunc
+	// SquashCommitsFunc is an instance of a mock function object
+	// controlling the behavior of the method SquashCommits.
+	SquashCommitsFunc *ClientSquashCommitsFunc
 	// StatFunc is an instance of a mock function object controlling the
 	// behavior of the method Stat.
 	StatFunc *ClientStatFunc
 	// StreamBlameFileFunc is an instance of a mock function object
 	// controlling the behavior of the method StreamBlameFile.
 	StreamBlameFileFunc *ClientStreamBlameFileFunc
+	// StreamCommitGraphFunc is an instance of a mock function object
+	// controlling the behavior of the method StreamCommitGraph.
+	StreamCommitGraphFunc *ClientStreamCommitGraphFunc
+	// SymbolicRefFunc is an instance of a mock function object controlling
+	// the behavior of the method SymbolicRef.
+	SymbolicRefFunc *ClientSymbolicRefFunc
 	// SystemInfoFunc is an instance of a mock function object controlling
 	// the behavior of the method SystemInfo.
 	SystemInfoFunc *ClientSystemInfoFunc
 	// SystemsInfoFunc is an instance of a mock function object controlling
 	// the behavior of the method SystemsInfo.
 	SystemsInfoFunc *ClientSystemsInfoFunc
+	// UpdateRefFunc is an instance of a mock function object controlling
+	// the behavior of the method UpdateRef.
+	UpdateRefFunc *ClientUpdateRefFunc
+	// WatchDefaultBranchCommitsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// WatchDefaultBranchCommits.
+	WatchDefaultBranchCommitsFunc *ClientWatchDefaultBranchCommitsFunc
+	// WatchRefsFunc is an instance of a mock function object controlling
+	// the behavior of the method WatchRefs.
+	WatchRefsFunc *ClientWatchRefsFunc
+	// WithActorRateLimitFunc is an instance of a mock function object
+	// controlling the behavior of the method WithActorRateLimit.
+	WithActorRateLimitFunc *ClientWithActorRateLimitFunc
+	// WithAuditHookFunc is an instance of a mock function object
+	// controlling the behavior of the method WithAuditHook.
+	WithAuditHookFunc *ClientWithAuditHookFunc
+	// WithBlobCacheFunc is an instance of a mock function object
+	// controlling the behavior of the method WithBlobCache.
+	WithBlobCacheFunc *ClientWithBlobCacheFunc
+	// WithCompressionFunc is an instance of a mock function object
+	// controlling the behavior of the method WithCompression.
+	WithCompressionFunc *ClientWithCompressionFunc
+	// WithHedgingFunc is an instance of a mock function object controlling
+	// the behavior of the method WithHedging.
+	WithHedgingFunc *ClientWithHedgingFunc
+	// WithMergeBaseCacheFunc is an instance of a mock function object
+	// controlling the behavior of the method WithMergeBaseCache.
+	WithMergeBaseCacheFunc *ClientWithMergeBaseCacheFunc
+	// WithMetricsFunc is an instance of a mock function object controlling
+	// the behavior of the method WithMetrics.
+	WithMetricsFunc *ClientWithMetricsFunc
+	// WithPermissionTraceFunc is an instance of a mock function object
+	// controlling the behavior of the method WithPermissionTrace.
+	WithPermissionTraceFunc *ClientWithPermissionTraceFunc
+	// WithRepoConcurrencyLimitFunc is an instance of a mock function object
+	// controlling the behavior of the method WithRepoConcurrencyLimit.
+	WithRepoConcurrencyLimitFunc *ClientWithRepoConcurrencyLimitFunc
+	// WithRetryPolicyFunc is an instance of a mock function object
+	// controlling the behavior of the method WithRetryPolicy.
+	WithRetryPolicyFunc *ClientWithRetryPolicyFunc
+	// WithSlowLoggingFunc is an instance of a mock function object
+	// controlling the behavior of the method WithSlowLogging.
+	WithSlowLoggingFunc *ClientWithSlowLoggingFunc
+	// WithSubRepoPermsCacheFunc is an instance of a mock function object
+	// controlling the behavior of the method WithSubRepoPermsCache.
+	WithSubRepoPermsCacheFunc *ClientWithSubRepoPermsCacheFunc
+	// WriteCommitGraphFunc is an instance of a mock function object
+	// controlling the behavior of the method WriteCommitGraph.
+	WriteCommitGraphFunc *ClientWriteCommitGraphFunc
 }
 
 // NewMockClient creates a new mock of the Client interface. All methods
 // return zero values for all results, unless overwritten.
 func NewMockClient() *MockClient {
 	return &MockClient{
+		AbbreviateCommitFunc: &ClientAbbreviateCommitFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID) (r0 string, r1 error) {
+				return
+			},
+		},
 		AddrForRepoFunc: &ClientAddrForRepoFunc{
 			defaultHook: func(context.Context, api.RepoName) (r0 string) {
 				return
 			},
 		},
+		AncestryPathFunc: &ClientAncestryPathFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) (r0 []*gitdomain.Commit, r1 error) {
+				return
+			},
+		},
+		ApplyBundleFunc: &ClientApplyBundleFunc{
+			defaultHook: func(context.Context, api.RepoName, io.Reader) (r0 error) {
+				return
+			},
+		},
 		ArchiveReaderFunc: &ClientArchiveReaderFunc{
 			defaultHook: func(context.Context, api.RepoName, ArchiveOptions) (r0 io.ReadCloser, r1 error) {
 				return
 			},
 		},
+		BlameSummaryFunc: &ClientBlameSummaryFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) (r0 []*gitdomain.BlameAuthorSummary, r1 error) {
+				return
+			},
+		},
 		CheckPerforceCredentialsFunc: &ClientCheckPerforceCredentialsFunc{
 			defaultHook: func(context.Context, protocol.PerforceConnectionDetails) (r0 error) {
 				return
 			},
 		},
+		CherryFunc: &ClientCherryFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) (r0 []gitdomain.CherryCommit, r1 error) {
+				return
+			},
+		},
+		CherryCrossRepoFunc: &ClientCherryCrossRepoFunc{
+			defaultHook: func(context.Context, api.RepoName, string, api.RepoName, string) (r0 []gitdomain.CherryCommit, r1 error) {
+				return
+			},
+		},
+		CherryPickFunc: &ClientCherryPickFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string, CherryPickOptions) (r0 api.CommitID, r1 error) {
+				return
+			},
+		},
+		CloneProgressFunc: &ClientCloneProgressFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 CloneProgressReader, r1 error) {
+				return
+			},
+		},
+		CloneStateFunc: &ClientCloneStateFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 gitdomain.CloneState, r1 error) {
+				return
+			},
+		},
+		CommitActivityFunc: &ClientCommitActivityFunc{
+			defaultHook: func(context.Context, api.RepoName, CommitActivityOptions) (r0 []gitdomain.CommitActivityBucket, r1 error) {
+				return
+			},
+		},
 		CommitGraphFunc: &ClientCommitGraphFunc{
 			defaultHook: func(context.Context, api.RepoName, CommitGraphOptions) (r0 *gitdomain.CommitGraph, r1 error) {
 				return
 			},
 		},
+		CommitGraphStatusFunc: &ClientCommitGraphStatusFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 gitdomain.CommitGraphStatus, r1 error) {
+				return
+			},
+		},
 		CommitLogFunc: &ClientCommitLogFunc{
 			defaultHook: func(context.Context, api.RepoName, time.Time) (r0 []CommitLog, r1 error) {
 				return
@@ -205,26 +457,91 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		CountCommitsFunc: &ClientCountCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, CommitsOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		CreateBranchFunc: &ClientCreateBranchFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) (r0 api.CommitID, r1 error) {
+				return
+			},
+		},
+		CreateBundleFunc: &ClientCreateBundleFunc{
+			defaultHook: func(context.Context, api.RepoName, []string, io.Writer) (r0 error) {
+				return
+			},
+		},
 		CreateCommitFromPatchFunc: &ClientCreateCommitFromPatchFunc{
 			defaultHook: func(context.Context, protocol.CreateCommitFromPatchRequest) (r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
 				return
 			},
 		},
+		CreateTagFunc: &ClientCreateTagFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string, TagOptions) (r0 error) {
+				return
+			},
+		},
+		DeleteBranchFunc: &ClientDeleteBranchFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (r0 error) {
+				return
+			},
+		},
+		DiagnoseAddrForRepoFunc: &ClientDiagnoseAddrForRepoFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 *RepoAddrDiagnostics, r1 error) {
+				return
+			},
+		},
 		DiffFunc: &ClientDiffFunc{
 			defaultHook: func(context.Context, DiffOptions) (r0 *DiffFileIterator, r1 error) {
 				return
 			},
 		},
+		DiffCrossRepoFunc: &ClientDiffCrossRepoFunc{
+			defaultHook: func(context.Context, api.RepoName, DiffOptions) (r0 *DiffFileIterator, r1 error) {
+				return
+			},
+		},
 		DiffSymbolsFunc: &ClientDiffSymbolsFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID) (r0 []byte, r1 error) {
 				return
 			},
 		},
+		DirectoryHistoryFunc: &ClientDirectoryHistoryFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string, DirectoryHistoryOptions) (r0 []*gitdomain.Commit, r1 string, r2 error) {
+				return
+			},
+		},
+		EnsureRevisionFunc: &ClientEnsureRevisionFunc{
+			defaultHook: func(context.Context, api.RepoName, string, WaitOptions) (r0 *EnsureRevisionResult, r1 error) {
+				return
+			},
+		},
+		ExecInWorktreeFunc: &ClientExecInWorktreeFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, []string, []byte) (r0 gitdomain.WorktreeExecResult, r1 error) {
+				return
+			},
+		},
+		FetchRefspecFunc: &ClientFetchRefspecFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (r0 error) {
+				return
+			},
+		},
+		FirstCommitForPathFunc: &ClientFirstCommitForPathFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) (r0 *gitdomain.Commit, r1 error) {
+				return
+			},
+		},
 		FirstEverCommitFunc: &ClientFirstEverCommitFunc{
 			defaultHook: func(context.Context, api.RepoName) (r0 *gitdomain.Commit, r1 error) {
 				return
 			},
 		},
+		FormatPatchFunc: &ClientFormatPatchFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) (r0 io.ReadCloser, r1 error) {
+				return
+			},
+		},
 		GetBehindAheadFunc: &ClientGetBehindAheadFunc{
 			defaultHook: func(context.Context, api.RepoName, string, string) (r0 *gitdomain.BehindAhead, r1 error) {
 				return
@@ -235,6 +552,11 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		GetCommitWithChangesFunc: &ClientGetCommitWithChangesFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, GetCommitOptions) (r0 *gitdomain.CommitWithChanges, r1 error) {
+				return
+			},
+		},
 		GetDefaultBranchFunc: &ClientGetDefaultBranchFunc{
 			defaultHook: func(context.Context, api.RepoName, bool) (r0 string, r1 api.CommitID, r2 error) {
 				return
@@ -245,6 +567,16 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		GetTagFunc: &ClientGetTagFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (r0 *gitdomain.Tag, r1 error) {
+				return
+			},
+		},
+		GetTreeFunc: &ClientGetTreeFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) (r0 []gitdomain.TreeEntry, r1 error) {
+				return
+			},
+		},
 		HasCommitAfterFunc: &ClientHasCommitAfterFunc{
 			defaultHook: func(context.Context, api.RepoName, string, string) (r0 bool, r1 error) {
 				return
@@ -265,6 +597,16 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		LastCommitsForTreeFunc: &ClientLastCommitsForTreeFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) (r0 []gitdomain.TreeEntryCommit, r1 error) {
+				return
+			},
+		},
+		ListBranchesFunc: &ClientListBranchesFunc{
+			defaultHook: func(context.Context, api.RepoName, ListBranchesOptions) (r0 []*gitdomain.Branch, r1 error) {
+				return
+			},
+		},
 		ListDirectoryChildrenFunc: &ClientListDirectoryChildrenFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, []string) (r0 map[string][]string, r1 error) {
 				return
@@ -280,6 +622,21 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		ListRemoteRefsFunc: &ClientListRemoteRefsFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 []gitdomain.Ref, r1 error) {
+				return
+			},
+		},
+		ListShardReposFunc: &ClientListShardReposFunc{
+			defaultHook: func(context.Context, string) (r0 []protocol.HostedRepo, r1 error) {
+				return
+			},
+		},
+		ListTagsFunc: &ClientListTagsFunc{
+			defaultHook: func(context.Context, api.RepoName, ListTagsOptions) (r0 []gitdomain.Ref, r1 error) {
+				return
+			},
+		},
 		LogReverseEachFunc: &ClientLogReverseEachFunc{
 			defaultHook: func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) (r0 error) {
 				return
@@ -295,11 +652,31 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		MergeBaseCrossRepoFunc: &ClientMergeBaseCrossRepoFunc{
+			defaultHook: func(context.Context, api.RepoName, string, api.RepoName, string) (r0 api.CommitID, r1 error) {
+				return
+			},
+		},
 		NewFileReaderFunc: &ClientNewFileReaderFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) (r0 io.ReadCloser, r1 error) {
 				return
 			},
 		},
+		NewFileReaderIfNotOIDFunc: &ClientNewFileReaderIfNotOIDFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string, gitdomain.OID) (r0 io.ReadCloser, r1 error) {
+				return
+			},
+		},
+		OptimizeRepoFunc: &ClientOptimizeRepoFunc{
+			defaultHook: func(context.Context, api.RepoName, MaintenanceOptions) (r0 []MaintenanceTaskResult, r1 error) {
+				return
+			},
+		},
+		PathExistsFunc: &ClientPathExistsFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) (r0 bool, r1 gitdomain.ObjectType, r2 error) {
+				return
+			},
+		},
 		PerforceGetChangelistFunc: &ClientPerforceGetChangelistFunc{
 			defaultHook: func(context.Context, protocol.PerforceConnectionDetails, string) (r0 *perforce.Changelist, r1 error) {
 				return
@@ -325,11 +702,41 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		RangeDiffFunc: &ClientRangeDiffFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) (r0 []*gitdomain.RangeDiffPair, r1 error) {
+				return
+			},
+		},
+		RawDiffFunc: &ClientRawDiffFunc{
+			defaultHook: func(context.Context, DiffOptions, io.Writer) (r0 error) {
+				return
+			},
+		},
+		ReadBlobFunc: &ClientReadBlobFunc{
+			defaultHook: func(context.Context, api.RepoName, gitdomain.OID) (r0 io.ReadCloser, r1 error) {
+				return
+			},
+		},
 		ReadDirFunc: &ClientReadDirFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, string, bool) (r0 []fs.FileInfo, r1 error) {
 				return
 			},
 		},
+		RebasePreviewFunc: &ClientRebasePreviewFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (r0 gitdomain.RebasePreview, r1 error) {
+				return
+			},
+		},
+		RefExistsFunc: &ClientRefExistsFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (r0 bool, r1 error) {
+				return
+			},
+		},
+		RefsChangedSinceFunc: &ClientRefsChangedSinceFunc{
+			defaultHook: func(context.Context, api.RepoName, RefsChangedSinceOptions) (r0 gitdomain.RefChanges, r1 error) {
+				return
+			},
+		},
 		RemoveFunc: &ClientRemoveFunc{
 			defaultHook: func(context.Context, api.RepoName) (r0 error) {
 				return
@@ -360,16 +767,41 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		RevertCommitFunc: &ClientRevertCommitFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, RevertCommitOptions) (r0 api.CommitID, r1 error) {
+				return
+			},
+		},
 		ScopedFunc: &ClientScopedFunc{
 			defaultHook: func(string) (r0 Client) {
 				return
 			},
 		},
+		ScopedToPathFunc: &ClientScopedToPathFunc{
+			defaultHook: func(api.RepoName, string) (r0 *PathScopedClient, r1 error) {
+				return
+			},
+		},
 		SearchFunc: &ClientSearchFunc{
 			defaultHook: func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (r0 bool, r1 error) {
 				return
 			},
 		},
+		SearchCommitsFunc: &ClientSearchCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, CommitSearchQuery, func([]protocol.CommitMatch)) (r0 bool, r1 error) {
+				return
+			},
+		},
+		SparseManifestFunc: &ClientSparseManifestFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, []string) (r0 []gitdomain.TreeEntry, r1 error) {
+				return
+			},
+		},
+		SquashCommitsFunc: &ClientSquashCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOptions) (r0 api.CommitID, r1 error) {
+				return
+			},
+		},
 		StatFunc: &ClientStatFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) (r0 fs.FileInfo, r1 error) {
 				return
@@ -380,6 +812,16 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		StreamCommitGraphFunc: &ClientStreamCommitGraphFunc{
+			defaultHook: func(context.Context, api.RepoName, StreamCommitGraphOptions) (r0 CommitGraphNodeReader, r1 error) {
+				return
+			},
+		},
+		SymbolicRefFunc: &ClientSymbolicRefFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (r0 string, r1 error) {
+				return
+			},
+		},
 		SystemInfoFunc: &ClientSystemInfoFunc{
 			defaultHook: func(context.Context, string) (r0 protocol.SystemInfo, r1 error) {
 				return
@@ -390,6 +832,86 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		UpdateRefFunc: &ClientUpdateRefFunc{
+			defaultHook: func(context.Context, api.RepoName, string, api.CommitID, api.CommitID) (r0 error) {
+				return
+			},
+		},
+		WatchDefaultBranchCommitsFunc: &ClientWatchDefaultBranchCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 BranchCommitWatcher, r1 error) {
+				return
+			},
+		},
+		WatchRefsFunc: &ClientWatchRefsFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 RefWatcher, r1 error) {
+				return
+			},
+		},
+		WithActorRateLimitFunc: &ClientWithActorRateLimitFunc{
+			defaultHook: func(ActorRateLimits) (r0 Client) {
+				return
+			},
+		},
+		WithAuditHookFunc: &ClientWithAuditHookFunc{
+			defaultHook: func(AuditHook) (r0 Client) {
+				return
+			},
+		},
+		WithBlobCacheFunc: &ClientWithBlobCacheFunc{
+			defaultHook: func(int) (r0 Client) {
+				return
+			},
+		},
+		WithCompressionFunc: &ClientWithCompressionFunc{
+			defaultHook: func(string) (r0 Client) {
+				return
+			},
+		},
+		WithHedgingFunc: &ClientWithHedgingFunc{
+			defaultHook: func(HedgingPolicy) (r0 Client) {
+				return
+			},
+		},
+		WithMergeBaseCacheFunc: &ClientWithMergeBaseCacheFunc{
+			defaultHook: func(int) (r0 Client) {
+				return
+			},
+		},
+		WithMetricsFunc: &ClientWithMetricsFunc{
+			defaultHook: func(prometheus.Registerer) (r0 Client) {
+				return
+			},
+		},
+		WithPermissionTraceFunc: &ClientWithPermissionTraceFunc{
+			defaultHook: func(*PermissionTrace) (r0 Client) {
+				return
+			},
+		},
+		WithRepoConcurrencyLimitFunc: &ClientWithRepoConcurrencyLimitFunc{
+			defaultHook: func(int) (r0 Client) {
+				return
+			},
+		},
+		WithRetryPolicyFunc: &ClientWithRetryPolicyFunc{
+			defaultHook: func(RetryPolicy) (r0 Client) {
+				return
+			},
+		},
+		WithSlowLoggingFunc: &ClientWithSlowLoggingFunc{
+			defaultHook: func(time.Duration) (r0 Client) {
+				return
+			},
+		},
+		WithSubRepoPermsCacheFunc: &ClientWithSubRepoPermsCacheFunc{
+			defaultHook: func(time.Duration) (r0 Client) {
+				return
+			},
+		},
+		WriteCommitGraphFunc: &ClientWriteCommitGraphFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -397,26 +919,81 @@ func NewMockClient() *MockClient {
 // methods panic on invocation, unless overwritten.
 func NewStrictMockClient() *MockClient {
 	return &MockClient{
+		AbbreviateCommitFunc: &ClientAbbreviateCommitFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID) (string, error) {
+				panic("unexpected invocation of MockClient.AbbreviateCommit")
+			},
+		},
 		AddrForRepoFunc: &ClientAddrForRepoFunc{
 			defaultHook: func(context.Context, api.RepoName) string {
 				panic("unexpected invocation of MockClient.AddrForRepo")
 			},
 		},
+		AncestryPathFunc: &ClientAncestryPathFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) ([]*gitdomain.Commit, error) {
+				panic("unexpected invocation of MockClient.AncestryPath")
+			},
+		},
+		ApplyBundleFunc: &ClientApplyBundleFunc{
+			defaultHook: func(context.Context, api.RepoName, io.Reader) error {
+				panic("unexpected invocation of MockClient.ApplyBundle")
+			},
+		},
 		ArchiveReaderFunc: &ClientArchiveReaderFunc{
 			defaultHook: func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error) {
 				panic("unexpected invocation of MockClient.ArchiveReader")
 			},
 		},
+		BlameSummaryFunc: &ClientBlameSummaryFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) ([]*gitdomain.BlameAuthorSummary, error) {
+				panic("unexpected invocation of MockClient.BlameSummary")
+			},
+		},
 		CheckPerforceCredentialsFunc: &ClientCheckPerforceCredentialsFunc{
 			defaultHook: func(context.Context, protocol.PerforceConnectionDetails) error {
 				panic("unexpected invocation of MockClient.CheckPerforceCredentials")
 			},
 		},
+		CherryFunc: &ClientCherryFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) ([]gitdomain.CherryCommit, error) {
+				panic("unexpected invocation of MockClient.Cherry")
+			},
+		},
+		CherryCrossRepoFunc: &ClientCherryCrossRepoFunc{
+			defaultHook: func(context.Context, api.RepoName, string, api.RepoName, string) ([]gitdomain.CherryCommit, error) {
+				panic("unexpected invocation of MockClient.CherryCrossRepo")
+			},
+		},
+		CherryPickFunc: &ClientCherryPickFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string, CherryPickOptions) (api.CommitID, error) {
+				panic("unexpected invocation of MockClient.CherryPick")
+			},
+		},
+		CloneProgressFunc: &ClientCloneProgressFunc{
+			defaultHook: func(context.Context, api.RepoName) (CloneProgressReader, error) {
+				panic("unexpected invocation of MockClient.CloneProgress")
+			},
+		},
+		CloneStateFunc: &ClientCloneStateFunc{
+			defaultHook: func(context.Context, api.RepoName) (gitdomain.CloneState, error) {
+				panic("unexpected invocation of MockClient.CloneState")
+			},
+		},
+		CommitActivityFunc: &ClientCommitActivityFunc{
+			defaultHook: func(context.Context, api.RepoName, CommitActivityOptions) ([]gitdomain.CommitActivityBucket, error) {
+				panic("unexpected invocation of MockClient.CommitActivity")
+			},
+		},
 		CommitGraphFunc: &ClientCommitGraphFunc{
 			defaultHook: func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error) {
 				panic("unexpected invocation of MockClient.CommitGraph")
 			},
 		},
+		CommitGraphStatusFunc: &ClientCommitGraphStatusFunc{
+			defaultHook: func(context.Context, api.RepoName) (gitdomain.CommitGraphStatus, error) {
+				panic("unexpected invocation of MockClient.CommitGraphStatus")
+			},
+		},
 		CommitLogFunc: &ClientCommitLogFunc{
 			defaultHook: func(context.Context, api.RepoName, time.Time) ([]CommitLog, error) {
 				panic("unexpected invocation of MockClient.CommitLog")
@@ -437,26 +1014,91 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.ContributorCount")
 			},
 		},
+		CountCommitsFunc: &ClientCountCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, CommitsOptions) (int, error) {
+				panic("unexpected invocation of MockClient.CountCommits")
+			},
+		},
+		CreateBranchFunc: &ClientCreateBranchFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) (api.CommitID, error) {
+				panic("unexpected invocation of MockClient.CreateBranch")
+			},
+		},
+		CreateBundleFunc: &ClientCreateBundleFunc{
+			defaultHook: func(context.Context, api.RepoName, []string, io.Writer) error {
+				panic("unexpected invocation of MockClient.CreateBundle")
+			},
+		},
 		CreateCommitFromPatchFunc: &ClientCreateCommitFromPatchFunc{
 			defaultHook: func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
 				panic("unexpected invocation of MockClient.CreateCommitFromPatch")
 			},
 		},
+		CreateTagFunc: &ClientCreateTagFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string, TagOptions) error {
+				panic("unexpected invocation of MockClient.CreateTag")
+			},
+		},
+		DeleteBranchFunc: &ClientDeleteBranchFunc{
+			defaultHook: func(context.Context, api.RepoName, string) error {
+				panic("unexpected invocation of MockClient.DeleteBranch")
+			},
+		},
+		DiagnoseAddrForRepoFunc: &ClientDiagnoseAddrForRepoFunc{
+			defaultHook: func(context.Context, api.RepoName) (*RepoAddrDiagnostics, error) {
+				panic("unexpected invocation of MockClient.DiagnoseAddrForRepo")
+			},
+		},
 		DiffFunc: &ClientDiffFunc{
 			defaultHook: func(context.Context, DiffOptions) (*DiffFileIterator, error) {
 				panic("unexpected invocation of MockClient.Diff")
 			},
 		},
+		DiffCrossRepoFunc: &ClientDiffCrossRepoFunc{
+			defaultHook: func(context.Context, api.RepoName, DiffOptions) (*DiffFileIterator, error) {
+				panic("unexpected invocation of MockClient.DiffCrossRepo")
+			},
+		},
 		DiffSymbolsFunc: &ClientDiffSymbolsFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error) {
 				panic("unexpected invocation of MockClient.DiffSymbols")
 			},
 		},
+		DirectoryHistoryFunc: &ClientDirectoryHistoryFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string, DirectoryHistoryOptions) ([]*gitdomain.Commit, string, error) {
+				panic("unexpected invocation of MockClient.DirectoryHistory")
+			},
+		},
+		EnsureRevisionFunc: &ClientEnsureRevisionFunc{
+			defaultHook: func(context.Context, api.RepoName, string, WaitOptions) (*EnsureRevisionResult, error) {
+				panic("unexpected invocation of MockClient.EnsureRevision")
+			},
+		},
+		ExecInWorktreeFunc: &ClientExecInWorktreeFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error) {
+				panic("unexpected invocation of MockClient.ExecInWorktree")
+			},
+		},
+		FetchRefspecFunc: &ClientFetchRefspecFunc{
+			defaultHook: func(context.Context, api.RepoName, string) error {
+				panic("unexpected invocation of MockClient.FetchRefspec")
+			},
+		},
+		FirstCommitForPathFunc: &ClientFirstCommitForPathFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) (*gitdomain.Commit, error) {
+				panic("unexpected invocation of MockClient.FirstCommitForPath")
+			},
+		},
 		FirstEverCommitFunc: &ClientFirstEverCommitFunc{
 			defaultHook: func(context.Context, api.RepoName) (*gitdomain.Commit, error) {
 				panic("unexpected invocation of MockClient.FirstEverCommit")
 			},
 		},
+		FormatPatchFunc: &ClientFormatPatchFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) (io.ReadCloser, error) {
+				panic("unexpected invocation of MockClient.FormatPatch")
+			},
+		},
 		GetBehindAheadFunc: &ClientGetBehindAheadFunc{
 			defaultHook: func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error) {
 				panic("unexpected invocation of MockClient.GetBehindAhead")
@@ -467,6 +1109,11 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.GetCommit")
 			},
 		},
+		GetCommitWithChangesFunc: &ClientGetCommitWithChangesFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, GetCommitOptions) (*gitdomain.CommitWithChanges, error) {
+				panic("unexpected invocation of MockClient.GetCommitWithChanges")
+			},
+		},
 		GetDefaultBranchFunc: &ClientGetDefaultBranchFunc{
 			defaultHook: func(context.Context, api.RepoName, bool) (string, api.CommitID, error) {
 				panic("unexpected invocation of MockClient.GetDefaultBranch")
@@ -477,6 +1124,16 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.GetObject")
 			},
 		},
+		GetTagFunc: &ClientGetTagFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (*gitdomain.Tag, error) {
+				panic("unexpected invocation of MockClient.GetTag")
+			},
+		},
+		GetTreeFunc: &ClientGetTreeFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) ([]gitdomain.TreeEntry, error) {
+				panic("unexpected invocation of MockClient.GetTree")
+			},
+		},
 		HasCommitAfterFunc: &ClientHasCommitAfterFunc{
 			defaultHook: func(context.Context, api.RepoName, string, string) (bool, error) {
 				panic("unexpected invocation of MockClient.HasCommitAfter")
@@ -497,9 +1154,19 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.IsRepoCloneable")
 			},
 		},
-		ListDirectoryChildrenFunc: &ClientListDirectoryChildrenFunc{
-			defaultHook: func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error) {
-				panic("unexpected invocation of MockClient.ListDirectoryChildren")
+		LastCommitsForTreeFunc: &ClientLastCommitsForTreeFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error) {
+				panic("unexpected invocation of MockClient.LastCommitsForTree")
+			},
+		},
+		ListBranchesFunc: &ClientListBranchesFunc{
+			defaultHook: func(context.Context, api.RepoName, ListBranchesOptions) ([]*gitdomain.Branch, error) {
+				panic("unexpected invocation of MockClient.ListBranches")
+			},
+		},
+		ListDirectoryChildrenFunc: &ClientListDirectoryChildrenFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error) {
+				panic("unexpected invocation of MockClient.ListDirectoryChildren")
 			},
 		},
 		ListGitoliteReposFunc: &ClientListGitoliteReposFunc{
@@ -512,6 +1179,21 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.ListRefs")
 			},
 		},
+		ListRemoteRefsFunc: &ClientListRemoteRefsFunc{
+			defaultHook: func(context.Context, api.RepoName) ([]gitdomain.Ref, error) {
+				panic("unexpected invocation of MockClient.ListRemoteRefs")
+			},
+		},
+		ListShardReposFunc: &ClientListShardReposFunc{
+			defaultHook: func(context.Context, string) ([]protocol.HostedRepo, error) {
+				panic("unexpected invocation of MockClient.ListShardRepos")
+			},
+		},
+		ListTagsFunc: &ClientListTagsFunc{
+			defaultHook: func(context.Context, api.RepoName, ListTagsOptions) ([]gitdomain.Ref, error) {
+				panic("unexpected invocation of MockClient.ListTags")
+			},
+		},
 		LogReverseEachFunc: &ClientLogReverseEachFunc{
 			defaultHook: func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error {
 				panic("unexpected invocation of MockClient.LogReverseEach")
@@ -527,11 +1209,31 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.MergeBase")
 			},
 		},
+		MergeBaseCrossRepoFunc: &ClientMergeBaseCrossRepoFunc{
+			defaultHook: func(context.Context, api.RepoName, string, api.RepoName, string) (api.CommitID, error) {
+				panic("unexpected invocation of MockClient.MergeBaseCrossRepo")
+			},
+		},
 		NewFileReaderFunc: &ClientNewFileReaderFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
 				panic("unexpected invocation of MockClient.NewFileReader")
 			},
 		},
+		NewFileReaderIfNotOIDFunc: &ClientNewFileReaderIfNotOIDFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string, gitdomain.OID) (io.ReadCloser, error) {
+				panic("unexpected invocation of MockClient.NewFileReaderIfNotOID")
+			},
+		},
+		OptimizeRepoFunc: &ClientOptimizeRepoFunc{
+			defaultHook: func(context.Context, api.RepoName, MaintenanceOptions) ([]MaintenanceTaskResult, error) {
+				panic("unexpected invocation of MockClient.OptimizeRepo")
+			},
+		},
+		PathExistsFunc: &ClientPathExistsFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) (bool, gitdomain.ObjectType, error) {
+				panic("unexpected invocation of MockClient.PathExists")
+			},
+		},
 		PerforceGetChangelistFunc: &ClientPerforceGetChangelistFunc{
 			defaultHook: func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error) {
 				panic("unexpected invocation of MockClient.PerforceGetChangelist")
@@ -557,11 +1259,41 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.PerforceUsers")
 			},
 		},
+		RangeDiffFunc: &ClientRangeDiffFunc{
+			defaultHook: func(context.Context, api.RepoName, string, string) ([]*gitdomain.RangeDiffPair, error) {
+				panic("unexpected invocation of MockClient.RangeDiff")
+			},
+		},
+		RawDiffFunc: &ClientRawDiffFunc{
+			defaultHook: func(context.Context, DiffOptions, io.Writer) error {
+				panic("unexpected invocation of MockClient.RawDiff")
+			},
+		},
+		ReadBlobFunc: &ClientReadBlobFunc{
+			defaultHook: func(context.Context, api.RepoName, gitdomain.OID) (io.ReadCloser, error) {
+				panic("unexpected invocation of MockClient.ReadBlob")
+			},
+		},
 		ReadDirFunc: &ClientReadDirFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error) {
 				panic("unexpected invocation of MockClient.ReadDir")
 			},
 		},
+		RebasePreviewFunc: &ClientRebasePreviewFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error) {
+				panic("unexpected invocation of MockClient.RebasePreview")
+			},
+		},
+		RefExistsFunc: &ClientRefExistsFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (bool, error) {
+				panic("unexpected invocation of MockClient.RefExists")
+			},
+		},
+		RefsChangedSinceFunc: &ClientRefsChangedSinceFunc{
+			defaultHook: func(context.Context, api.RepoName, RefsChangedSinceOptions) (gitdomain.RefChanges, error) {
+				panic("unexpected invocation of MockClient.RefsChangedSince")
+			},
+		},
 		RemoveFunc: &ClientRemoveFunc{
 			defaultHook: func(context.Context, api.RepoName) error {
 				panic("unexpected invocation of MockClient.Remove")
@@ -592,16 +1324,41 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.RevList")
 			},
 		},
+		RevertCommitFunc: &ClientRevertCommitFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, RevertCommitOptions) (api.CommitID, error) {
+				panic("unexpected invocation of MockClient.RevertCommit")
+			},
+		},
 		ScopedFunc: &ClientScopedFunc{
 			defaultHook: func(string) Client {
 				panic("unexpected invocation of MockClient.Scoped")
 			},
 		},
+		ScopedToPathFunc: &ClientScopedToPathFunc{
+			defaultHook: func(api.RepoName, string) (*PathScopedClient, error) {
+				panic("unexpected invocation of MockClient.ScopedToPath")
+			},
+		},
 		SearchFunc: &ClientSearchFunc{
 			defaultHook: func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error) {
 				panic("unexpected invocation of MockClient.Search")
 			},
 		},
+		SearchCommitsFunc: &ClientSearchCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, CommitSearchQuery, func([]protocol.CommitMatch)) (bool, error) {
+				panic("unexpected invocation of MockClient.SearchCommits")
+			},
+		},
+		SparseManifestFunc: &ClientSparseManifestFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, []string) ([]gitdomain.TreeEntry, error) {
+				panic("unexpected invocation of MockClient.SparseManifest")
+			},
+		},
+		SquashCommitsFunc: &ClientSquashCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOptions) (api.CommitID, error) {
+				panic("unexpected invocation of MockClient.SquashCommits")
+			},
+		},
 		StatFunc: &ClientStatFunc{
 			defaultHook: func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error) {
 				panic("unexpected invocation of MockClient.Stat")
@@ -612,6 +1369,16 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.StreamBlameFile")
 			},
 		},
+		StreamCommitGraphFunc: &ClientStreamCommitGraphFunc{
+			defaultHook: func(context.Context, api.RepoName, StreamCommitGraphOptions) (CommitGraphNodeReader, error) {
+				panic("unexpected invocation of MockClient.StreamCommitGraph")
+			},
+		},
+		SymbolicRefFunc: &ClientSymbolicRefFunc{
+			defaultHook: func(context.Context, api.RepoName, string) (string, error) {
+				panic("unexpected invocation of MockClient.SymbolicRef")
+			},
+		},
 		SystemInfoFunc: &ClientSystemInfoFunc{
 			defaultHook: func(context.Context, string) (protocol.SystemInfo, error) {
 				panic("unexpected invocation of MockClient.SystemInfo")
@@ -622,6 +1389,86 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.SystemsInfo")
 			},
 		},
+		UpdateRefFunc: &ClientUpdateRefFunc{
+			defaultHook: func(context.Context, api.RepoName, string, api.CommitID, api.CommitID) error {
+				panic("unexpected invocation of MockClient.UpdateRef")
+			},
+		},
+		WatchDefaultBranchCommitsFunc: &ClientWatchDefaultBranchCommitsFunc{
+			defaultHook: func(context.Context, api.RepoName) (BranchCommitWatcher, error) {
+				panic("unexpected invocation of MockClient.WatchDefaultBranchCommits")
+			},
+		},
+		WatchRefsFunc: &ClientWatchRefsFunc{
+			defaultHook: func(context.Context, api.RepoName) (RefWatcher, error) {
+				panic("unexpected invocation of MockClient.WatchRefs")
+			},
+		},
+		WithActorRateLimitFunc: &ClientWithActorRateLimitFunc{
+			defaultHook: func(ActorRateLimits) Client {
+				panic("unexpected invocation of MockClient.WithActorRateLimit")
+			},
+		},
+		WithAuditHookFunc: &ClientWithAuditHookFunc{
+			defaultHook: func(AuditHook) Client {
+				panic("unexpected invocation of MockClient.WithAuditHook")
+			},
+		},
+		WithBlobCacheFunc: &ClientWithBlobCacheFunc{
+			defaultHook: func(int) Client {
+				panic("unexpected invocation of MockClient.WithBlobCache")
+			},
+		},
+		WithCompressionFunc: &ClientWithCompressionFunc{
+			defaultHook: func(string) Client {
+				panic("unexpected invocation of MockClient.WithCompression")
+			},
+		},
+		WithHedgingFunc: &ClientWithHedgingFunc{
+			defaultHook: func(HedgingPolicy) Client {
+				panic("unexpected invocation of MockClient.WithHedging")
+			},
+		},
+		WithMergeBaseCacheFunc: &ClientWithMergeBaseCacheFunc{
+			defaultHook: func(int) Client {
+				panic("unexpected invocation of MockClient.WithMergeBaseCache")
+			},
+		},
+		WithMetricsFunc: &ClientWithMetricsFunc{
+			defaultHook: func(prometheus.Registerer) Client {
+				panic("unexpected invocation of MockClient.WithMetrics")
+			},
+		},
+		WithPermissionTraceFunc: &ClientWithPermissionTraceFunc{
+			defaultHook: func(*PermissionTrace) Client {
+				panic("unexpected invocation of MockClient.WithPermissionTrace")
+			},
+		},
+		WithRepoConcurrencyLimitFunc: &ClientWithRepoConcurrencyLimitFunc{
+			defaultHook: func(int) Client {
+				panic("unexpected invocation of MockClient.WithRepoConcurrencyLimit")
+			},
+		},
+		WithRetryPolicyFunc: &ClientWithRetryPolicyFunc{
+			defaultHook: func(RetryPolicy) Client {
+				panic("unexpected invocation of MockClient.WithRetryPolicy")
+			},
+		},
+		WithSlowLoggingFunc: &ClientWithSlowLoggingFunc{
+			defaultHook: func(time.Duration) Client {
+				panic("unexpected invocation of MockClient.WithSlowLogging")
+			},
+		},
+		WithSubRepoPermsCacheFunc: &ClientWithSubRepoPermsCacheFunc{
+			defaultHook: func(time.Duration) Client {
+				panic("unexpected invocation of MockClient.WithSubRepoPermsCache")
+			},
+		},
+		WriteCommitGraphFunc: &ClientWriteCommitGraphFunc{
+			defaultHook: func(context.Context, api.RepoName) error {
+				panic("unexpected invocation of MockClient.WriteCommitGraph")
+			},
+		},
 	}
 }
 
@@ -629,18 +1476,51 @@ func NewStrictMockClient() *MockClient {
 // methods delegate to the given implementation, unless overwritten.
 func NewMockClientFrom(i Client) *MockClient {
 	return &MockClient{
+		AbbreviateCommitFunc: &ClientAbbreviateCommitFunc{
+			defaultHook: i.AbbreviateCommit,
+		},
 		AddrForRepoFunc: &ClientAddrForRepoFunc{
 			defaultHook: i.AddrForRepo,
 		},
+		AncestryPathFunc: &ClientAncestryPathFunc{
+			defaultHook: i.AncestryPath,
+		},
+		ApplyBundleFunc: &ClientApplyBundleFunc{
+			defaultHook: i.ApplyBundle,
+		},
 		ArchiveReaderFunc: &ClientArchiveReaderFunc{
 			defaultHook: i.ArchiveReader,
 		},
+		BlameSummaryFunc: &ClientBlameSummaryFunc{
+			defaultHook: i.BlameSummary,
+		},
 		CheckPerforceCredentialsFunc: &ClientCheckPerforceCredentialsFunc{
 			defaultHook: i.CheckPerforceCredentials,
 		},
+		CherryFunc: &ClientCherryFunc{
+			defaultHook: i.Cherry,
+		},
+		CherryCrossRepoFunc: &ClientCherryCrossRepoFunc{
+			defaultHook: i.CherryCrossRepo,
+		},
+		CherryPickFunc: &ClientCherryPickFunc{
+			defaultHook: i.CherryPick,
+		},
+		CloneProgressFunc: &ClientCloneProgressFunc{
+			defaultHook: i.CloneProgress,
+		},
+		CloneStateFunc: &ClientCloneStateFunc{
+			defaultHook: i.CloneState,
+		},
+		CommitActivityFunc: &ClientCommitActivityFunc{
+			defaultHook: i.CommitActivity,
+		},
 		CommitGraphFunc: &ClientCommitGraphFunc{
 			defaultHook: i.CommitGraph,
 		},
+		CommitGraphStatusFunc: &ClientCommitGraphStatusFunc{
+			defaultHook: i.CommitGraphStatus,
+		},
 		CommitLogFunc: &ClientCommitLogFunc{
 			defaultHook: i.CommitLog,
 		},
@@ -653,30 +1533,78 @@ func NewMockClientFrom(i Client) *MockClient {
 		ContributorCountFunc: &ClientContributorCountFunc{
 			defaultHook: i.ContributorCount,
 		},
+		CountCommitsFunc: &ClientCountCommitsFunc{
+			defaultHook: i.CountCommits,
+		},
+		CreateBranchFunc: &ClientCreateBranchFunc{
+			defaultHook: i.CreateBranch,
+		},
+		CreateBundleFunc: &ClientCreateBundleFunc{
+			defaultHook: i.CreateBundle,
+		},
 		CreateCommitFromPatchFunc: &ClientCreateCommitFromPatchFunc{
 			defaultHook: i.CreateCommitFromPatch,
 		},
+		CreateTagFunc: &ClientCreateTagFunc{
+			defaultHook: i.CreateTag,
+		},
+		DeleteBranchFunc: &ClientDeleteBranchFunc{
+			defaultHook: i.DeleteBranch,
+		},
+		DiagnoseAddrForRepoFunc: &ClientDiagnoseAddrForRepoFunc{
+			defaultHook: i.DiagnoseAddrForRepo,
+		},
 		DiffFunc: &ClientDiffFunc{
 			defaultHook: i.Diff,
 		},
+		DiffCrossRepoFunc: &ClientDiffCrossRepoFunc{
+			defaultHook: i.DiffCrossRepo,
+		},
 		DiffSymbolsFunc: &ClientDiffSymbolsFunc{
 			defaultHook: i.DiffSymbols,
 		},
+		DirectoryHistoryFunc: &ClientDirectoryHistoryFunc{
+			defaultHook: i.DirectoryHistory,
+		},
+		EnsureRevisionFunc: &ClientEnsureRevisionFunc{
+			defaultHook: i.EnsureRevision,
+		},
+		ExecInWorktreeFunc: &ClientExecInWorktreeFunc{
+			defaultHook: i.ExecInWorktree,
+		},
+		FetchRefspecFunc: &ClientFetchRefspecFunc{
+			defaultHook: i.FetchRefspec,
+		},
+		FirstCommitForPathFunc: &ClientFirstCommitForPathFunc{
+			defaultHook: i.FirstCommitForPath,
+		},
 		FirstEverCommitFunc: &ClientFirstEverCommitFunc{
 			defaultHook: i.FirstEverCommit,
 		},
+		FormatPatchFunc: &ClientFormatPatchFunc{
+			defaultHook: i.FormatPatch,
+		},
 		GetBehindAheadFunc: &ClientGetBehindAheadFunc{
 			defaultHook: i.GetBehindAhead,
 		},
 		GetCommitFunc: &ClientGetCommitFunc{
 			defaultHook: i.GetCommit,
 		},
+		GetCommitWithChangesFunc: &ClientGetCommitWithChangesFunc{
+			defaultHook: i.GetCommitWithChanges,
+		},
 		GetDefaultBranchFunc: &ClientGetDefaultBranchFunc{
 			defaultHook: i.GetDefaultBranch,
 		},
 		GetObjectFunc: &ClientGetObjectFunc{
 			defaultHook: i.GetObject,
 		},
+		GetTagFunc: &ClientGetTagFunc{
+			defaultHook: i.GetTag,
+		},
+		GetTreeFunc: &ClientGetTreeFunc{
+			defaultHook: i.GetTree,
+		},
 		HasCommitAfterFunc: &ClientHasCommitAfterFunc{
 			defaultHook: i.HasCommitAfter,
 		},
@@ -689,6 +1617,12 @@ func NewMockClientFrom(i Client) *MockClient {
 		IsRepoCloneableFunc: &ClientIsRepoCloneableFunc{
 			defaultHook: i.IsRepoCloneable,
 		},
+		LastCommitsForTreeFunc: &ClientLastCommitsForTreeFunc{
+			defaultHook: i.LastCommitsForTree,
+		},
+		ListBranchesFunc: &ClientListBranchesFunc{
+			defaultHook: i.ListBranches,
+		},
 		ListDirectoryChildrenFunc: &ClientListDirectoryChildrenFunc{
 			defaultHook: i.ListDirectoryChildren,
 		},
@@ -698,6 +1632,15 @@ func NewMockClientFrom(i Client) *MockClient {
 		ListRefsFunc: &ClientListRefsFunc{
 			defaultHook: i.ListRefs,
 		},
+		ListRemoteRefsFunc: &ClientListRemoteRefsFunc{
+			defaultHook: i.ListRemoteRefs,
+		},
+		ListShardReposFunc: &ClientListShardReposFunc{
+			defaultHook: i.ListShardRepos,
+		},
+		ListTagsFunc: &ClientListTagsFunc{
+			defaultHook: i.ListTags,
+		},
 		LogReverseEachFunc: &ClientLogReverseEachFunc{
 			defaultHook: i.LogReverseEach,
 		},
@@ -707,9 +1650,21 @@ func NewMockClientFrom(i Client) *MockClient {
 		MergeBaseFunc: &ClientMergeBaseFunc{
 			defaultHook: i.MergeBase,
 		},
+		MergeBaseCrossRepoFunc: &ClientMergeBaseCrossRepoFunc{
+			defaultHook: i.MergeBaseCrossRepo,
+		},
 		NewFileReaderFunc: &ClientNewFileReaderFunc{
 			defaultHook: i.NewFileReader,
 		},
+		NewFileReaderIfNotOIDFunc: &ClientNewFileReaderIfNotOIDFunc{
+			defaultHook: i.NewFileReaderIfNotOID,
+		},
+		OptimizeRepoFunc: &ClientOptimizeRepoFunc{
+			defaultHook: i.OptimizeRepo,
+		},
+		PathExistsFunc: &ClientPathExistsFunc{
+			defaultHook: i.PathExists,
+		},
 		PerforceGetChangelistFunc: &ClientPerforceGetChangelistFunc{
 			defaultHook: i.PerforceGetChangelist,
 		},
@@ -725,9 +1680,27 @@ func NewMockClientFrom(i Client) *MockClient {
 		PerforceUsersFunc: &ClientPerforceUsersFunc{
 			defaultHook: i.PerforceUsers,
 		},
+		RangeDiffFunc: &ClientRangeDiffFunc{
+			defaultHook: i.RangeDiff,
+		},
+		RawDiffFunc: &ClientRawDiffFunc{
+			defaultHook: i.RawDiff,
+		},
+		ReadBlobFunc: &ClientReadBlobFunc{
+			defaultHook: i.ReadBlob,
+		},
 		ReadDirFunc: &ClientReadDirFunc{
 			defaultHook: i.ReadDir,
 		},
+		RebasePreviewFunc: &ClientRebasePreviewFunc{
+			defaultHook: i.RebasePreview,
+		},
+		RefExistsFunc: &ClientRefExistsFunc{
+			defaultHook: i.RefExists,
+		},
+		RefsChangedSinceFunc: &ClientRefsChangedSinceFunc{
+			defaultHook: i.RefsChangedSince,
+		},
 		RemoveFunc: &ClientRemoveFunc{
 			defaultHook: i.Remove,
 		},
@@ -746,25 +1719,205 @@ func NewMockClientFrom(i Client) *MockClient {
 		RevListFunc: &ClientRevListFunc{
 			defaultHook: i.RevList,
 		},
+		RevertCommitFunc: &ClientRevertCommitFunc{
+			defaultHook: i.RevertCommit,
+		},
 		ScopedFunc: &ClientScopedFunc{
 			defaultHook: i.Scoped,
 		},
+		ScopedToPathFunc: &ClientScopedToPathFunc{
+			defaultHook: i.ScopedToPath,
+		},
 		SearchFunc: &ClientSearchFunc{
 			defaultHook: i.Search,
 		},
+		SearchCommitsFunc: &ClientSearchCommitsFunc{
+			defaultHook: i.SearchCommits,
+		},
+		SparseManifestFunc: &ClientSparseManifestFunc{
+			defaultHook: i.SparseManifest,
+		},
+		SquashCommitsFunc: &ClientSquashCommitsFunc{
+			defaultHook: i.SquashCommits,
+		},
 		StatFunc: &ClientStatFunc{
 			defaultHook: i.Stat,
 		},
 		StreamBlameFileFunc: &ClientStreamBlameFileFunc{
 			defaultHook: i.StreamBlameFile,
 		},
+		StreamCommitGraphFunc: &ClientStreamCommitGraphFunc{
+			defaultHook: i.StreamCommitGraph,
+		},
+		SymbolicRefFunc: &ClientSymbolicRefFunc{
+			defaultHook: i.SymbolicRef,
+		},
 		SystemInfoFunc: &ClientSystemInfoFunc{
 			defaultHook: i.SystemInfo,
 		},
 		SystemsInfoFunc: &ClientSystemsInfoFunc{
 			defaultHook: i.SystemsInfo,
 		},
+		UpdateRefFunc: &ClientUpdateRefFunc{
+			defaultHook: i.UpdateRef,
+		},
+		WatchDefaultBranchCommitsFunc: &ClientWatchDefaultBranchCommitsFunc{
+			defaultHook: i.WatchDefaultBranchCommits,
+		},
+		WatchRefsFunc: &ClientWatchRefsFunc{
+			defaultHook: i.WatchRefs,
+		},
+		WithActorRateLimitFunc: &ClientWithActorRateLimitFunc{
+			defaultHook: i.WithActorRateLimit,
+		},
+		WithAuditHookFunc: &ClientWithAuditHookFunc{
+			defaultHook: i.WithAuditHook,
+		},
+		WithBlobCacheFunc: &ClientWithBlobCacheFunc{
+			defaultHook: i.WithBlobCache,
+		},
+		WithCompressionFunc: &ClientWithCompressionFunc{
+			defaultHook: i.WithCompression,
+		},
+		WithHedgingFunc: &ClientWithHedgingFunc{
+			defaultHook: i.WithHedging,
+		},
+		WithMergeBaseCacheFunc: &ClientWithMergeBaseCacheFunc{
+			defaultHook: i.WithMergeBaseCache,
+		},
+		WithMetricsFunc: &ClientWithMetricsFunc{
+			defaultHook: i.WithMetrics,
+		},
+		WithPermissionTraceFunc: &ClientWithPermissionTraceFunc{
+			defaultHook: i.WithPermissionTrace,
+		},
+		WithRepoConcurrencyLimitFunc: &ClientWithRepoConcurrencyLimitFunc{
+			defaultHook: i.WithRepoConcurrencyLimit,
+		},
+		WithRetryPolicyFunc: &ClientWithRetryPolicyFunc{
+			defaultHook: i.WithRetryPolicy,
+		},
+		WithSlowLoggingFunc: &ClientWithSlowLoggingFunc{
+			defaultHook: i.WithSlowLogging,
+		},
+		WithSubRepoPermsCacheFunc: &ClientWithSubRepoPermsCacheFunc{
+			defaultHook: i.WithSubRepoPermsCache,
+		},
+		WriteCommitGraphFunc: &ClientWriteCommitGraphFunc{
+			defaultHook: i.WriteCommitGraph,
+		},
+	}
+}
+
+// ClientAbbreviateCommitFunc describes the behavior when the
+// AbbreviateCommit method of the parent MockClient instance is invoked.
+type ClientAbbreviateCommitFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID) (string, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID) (string, error)
+	history     []ClientAbbreviateCommitFuncCall
+	mutex       sync.Mutex
+}
+
+// AbbreviateCommit delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) AbbreviateCommit(v0 context.Context, v1 api.RepoName, v2 api.CommitID) (string, error) {
+	r0, r1 := m.AbbreviateCommitFunc.nextHook()(v0, v1, v2)
+	m.AbbreviateCommitFunc.appendCall(ClientAbbreviateCommitFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the AbbreviateCommit
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientAbbreviateCommitFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID) (string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AbbreviateCommit method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientAbbreviateCommitFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID) (string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientAbbreviateCommitFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID) (string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientAbbreviateCommitFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID) (string, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientAbbreviateCommitFunc) nextHook() func(context.Context, api.RepoName, api.CommitID) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
 	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientAbbreviateCommitFunc) appendCall(r0 ClientAbbreviateCommitFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientAbbreviateCommitFuncCall objects
+// describing the invocations of this function.
+func (f *ClientAbbreviateCommitFunc) History() []ClientAbbreviateCommitFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientAbbreviateCommitFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientAbbreviateCommitFuncCall is an object that describes an invocation
+// of method AbbreviateCommit on an instance of MockClient.
+type ClientAbbreviateCommitFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientAbbreviateCommitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientAbbreviateCommitFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
 // ClientAddrForRepoFunc describes the behavior when the AddrForRepo method
@@ -871,34 +2024,34 @@ func (c ClientAddrForRepoFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// ClientArchiveReaderFunc describes the behavior when the ArchiveReader
+// ClientAncestryPathFunc describes the behavior when the AncestryPath
 // method of the parent MockClient instance is invoked.
-type ClientArchiveReaderFunc struct {
-	defaultHook func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error)
-	hooks       []func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error)
-	history     []ClientArchiveReaderFuncCall
+type ClientAncestryPathFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, string) ([]*gitdomain.Commit, error)
+	hooks       []func(context.Context, api.RepoName, string, string) ([]*gitdomain.Commit, error)
+	history     []ClientAncestryPathFuncCall
 	mutex       sync.Mutex
 }
 
-// ArchiveReader delegates to the next hook function in the queue and stores
+// AncestryPath delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockClient) ArchiveReader(v0 context.Context, v1 api.RepoName, v2 ArchiveOptions) (io.ReadCloser, error) {
-	r0, r1 := m.ArchiveReaderFunc.nextHook()(v0, v1, v2)
-	m.ArchiveReaderFunc.appendCall(ClientArchiveReaderFuncCall{v0, v1, v2, r0, r1})
+func (m *MockClient) AncestryPath(v0 context.Context, v1 api.RepoName, v2 string, v3 string) ([]*gitdomain.Commit, error) {
+	r0, r1 := m.AncestryPathFunc.nextHook()(v0, v1, v2, v3)
+	m.AncestryPathFunc.appendCall(ClientAncestryPathFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ArchiveReader method
+// SetDefaultHook sets function that is called when the AncestryPath method
 // of the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientArchiveReaderFunc) SetDefaultHook(hook func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error)) {
+func (f *ClientAncestryPathFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) ([]*gitdomain.Commit, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ArchiveReader method of the parent MockClient instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
+// AncestryPath method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *ClientArchiveReaderFunc) PushHook(hook func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error)) {
+func (f *ClientAncestryPathFunc) PushHook(hook func(context.Context, api.RepoName, string, string) ([]*gitdomain.Commit, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -906,20 +2059,20 @@ func (f *ClientArchiveReaderFunc) PushHook(hook func(context.Context, api.RepoNa
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientArchiveReaderFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error) {
+func (f *ClientAncestryPathFunc) SetDefaultReturn(r0 []*gitdomain.Commit, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) ([]*gitdomain.Commit, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientArchiveReaderFunc) PushReturn(r0 io.ReadCloser, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error) {
+func (f *ClientAncestryPathFunc) PushReturn(r0 []*gitdomain.Commit, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, string) ([]*gitdomain.Commit, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientArchiveReaderFunc) nextHook() func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error) {
+func (f *ClientAncestryPathFunc) nextHook() func(context.Context, api.RepoName, string, string) ([]*gitdomain.Commit, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -932,26 +2085,26 @@ func (f *ClientArchiveReaderFunc) nextHook() func(context.Context, api.RepoName,
 	return hook
 }
 
-func (f *ClientArchiveReaderFunc) appendCall(r0 ClientArchiveReaderFuncCall) {
+func (f *ClientAncestryPathFunc) appendCall(r0 ClientAncestryPathFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientArchiveReaderFuncCall objects
+// History returns a sequence of ClientAncestryPathFuncCall objects
 // describing the invocations of this function.
-func (f *ClientArchiveReaderFunc) History() []ClientArchiveReaderFuncCall {
+func (f *ClientAncestryPathFunc) History() []ClientAncestryPathFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientArchiveReaderFuncCall, len(f.history))
+	history := make([]ClientAncestryPathFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientArchiveReaderFuncCall is an object that describes an invocation of
-// method ArchiveReader on an instance of MockClient.
-type ClientArchiveReaderFuncCall struct {
+// ClientAncestryPathFuncCall is an object that describes an invocation of
+// method AncestryPath on an instance of MockClient.
+type ClientAncestryPathFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -960,10 +2113,13 @@ type ClientArchiveReaderFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 ArchiveOptions
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 io.ReadCloser
+	Result0 []*gitdomain.Commit
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -971,46 +2127,44 @@ type ClientArchiveReaderFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientArchiveReaderFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c ClientAncestryPathFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientArchiveReaderFuncCall) Results() []interface{} {
+func (c ClientAncestryPathFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientCheckPerforceCredentialsFunc describes the behavior when the
-// CheckPerforceCredentials method of the parent MockClient instance is
-// invoked.
-type ClientCheckPerforceCredentialsFunc struct {
-	defaultHook func(context.Context, protocol.PerforceConnectionDetails) error
-	hooks       []func(context.Context, protocol.PerforceConnectionDetails) error
-	history     []ClientCheckPerforceCredentialsFuncCall
+// ClientApplyBundleFunc describes the behavior when the ApplyBundle method
+// of the parent MockClient instance is invoked.
+type ClientApplyBundleFunc struct {
+	defaultHook func(context.Context, api.RepoName, io.Reader) error
+	hooks       []func(context.Context, api.RepoName, io.Reader) error
+	history     []ClientApplyBundleFuncCall
 	mutex       sync.Mutex
 }
 
-// CheckPerforceCredentials delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockClient) CheckPerforceCredentials(v0 context.Context, v1 protocol.PerforceConnectionDetails) error {
-	r0 := m.CheckPerforceCredentialsFunc.nextHook()(v0, v1)
-	m.CheckPerforceCredentialsFunc.appendCall(ClientCheckPerforceCredentialsFuncCall{v0, v1, r0})
+// ApplyBundle delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) ApplyBundle(v0 context.Context, v1 api.RepoName, v2 io.Reader) error {
+	r0 := m.ApplyBundleFunc.nextHook()(v0, v1, v2)
+	m.ApplyBundleFunc.appendCall(ClientApplyBundleFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// CheckPerforceCredentials method of the parent MockClient instance is
-// invoked and the hook queue is empty.
-func (f *ClientCheckPerforceCredentialsFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails) error) {
+// SetDefaultHook sets function that is called when the ApplyBundle method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientApplyBundleFunc) SetDefaultHook(hook func(context.Context, api.RepoName, io.Reader) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CheckPerforceCredentials method of the parent MockClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *ClientCheckPerforceCredentialsFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails) error) {
+// ApplyBundle method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientApplyBundleFunc) PushHook(hook func(context.Context, api.RepoName, io.Reader) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1018,20 +2172,20 @@ func (f *ClientCheckPerforceCredentialsFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientCheckPerforceCredentialsFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails) error {
+func (f *ClientApplyBundleFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, io.Reader) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientCheckPerforceCredentialsFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails) error {
+func (f *ClientApplyBundleFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, io.Reader) error {
 		return r0
 	})
 }
 
-func (f *ClientCheckPerforceCredentialsFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails) error {
+func (f *ClientApplyBundleFunc) nextHook() func(context.Context, api.RepoName, io.Reader) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1044,33 +2198,35 @@ func (f *ClientCheckPerforceCredentialsFunc) nextHook() func(context.Context, pr
 	return hook
 }
 
-func (f *ClientCheckPerforceCredentialsFunc) appendCall(r0 ClientCheckPerforceCredentialsFuncCall) {
+func (f *ClientApplyBundleFunc) appendCall(r0 ClientApplyBundleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientCheckPerforceCredentialsFuncCall
-// objects describing the invocations of this function.
-func (f *ClientCheckPerforceCredentialsFunc) History() []ClientCheckPerforceCredentialsFuncCall {
+// History returns a sequence of ClientApplyBundleFuncCall objects
+// describing the invocations of this function.
+func (f *ClientApplyBundleFunc) History() []ClientApplyBundleFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientCheckPerforceCredentialsFuncCall, len(f.history))
+	history := make([]ClientApplyBundleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientCheckPerforceCredentialsFuncCall is an object that describes an
-// invocation of method CheckPerforceCredentials on an instance of
-// MockClient.
-type ClientCheckPerforceCredentialsFuncCall struct {
+// ClientApplyBundleFuncCall is an object that describes an invocation of
+// method ApplyBundle on an instance of MockClient.
+type ClientApplyBundleFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 protocol.PerforceConnectionDetails
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 io.Reader
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -1078,44 +2234,44 @@ type ClientCheckPerforceCredentialsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientCheckPerforceCredentialsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ClientApplyBundleFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientCheckPerforceCredentialsFuncCall) Results() []interface{} {
+func (c ClientApplyBundleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// ClientCommitGraphFunc describes the behavior when the CommitGraph method
-// of the parent MockClient instance is invoked.
-type ClientCommitGraphFunc struct {
-	defaultHook func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error)
-	hooks       []func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error)
-	history     []ClientCommitGraphFuncCall
+// ClientArchiveReaderFunc describes the behavior when the ArchiveReader
+// method of the parent MockClient instance is invoked.
+type ClientArchiveReaderFunc struct {
+	defaultHook func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error)
+	hooks       []func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error)
+	history     []ClientArchiveReaderFuncCall
 	mutex       sync.Mutex
 }
 
-// CommitGraph delegates to the next hook function in the queue and stores
+// ArchiveReader delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockClient) CommitGraph(v0 context.Context, v1 api.RepoName, v2 CommitGraphOptions) (*gitdomain.CommitGraph, error) {
-	r0, r1 := m.CommitGraphFunc.nextHook()(v0, v1, v2)
-	m.CommitGraphFunc.appendCall(ClientCommitGraphFuncCall{v0, v1, v2, r0, r1})
+func (m *MockClient) ArchiveReader(v0 context.Context, v1 api.RepoName, v2 ArchiveOptions) (io.ReadCloser, error) {
+	r0, r1 := m.ArchiveReaderFunc.nextHook()(v0, v1, v2)
+	m.ArchiveReaderFunc.appendCall(ClientArchiveReaderFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the CommitGraph method
+// SetDefaultHook sets function that is called when the ArchiveReader method
 // of the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientCommitGraphFunc) SetDefaultHook(hook func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error)) {
+func (f *ClientArchiveReaderFunc) SetDefaultHook(hook func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CommitGraph method of the parent MockClient instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
+// ArchiveReader method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *ClientCommitGraphFunc) PushHook(hook func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error)) {
+func (f *ClientArchiveReaderFunc) PushHook(hook func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1123,20 +2279,20 @@ func (f *ClientCommitGraphFunc) PushHook(hook func(context.Context, api.RepoName
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientCommitGraphFunc) SetDefaultReturn(r0 *gitdomain.CommitGraph, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error) {
+func (f *ClientArchiveReaderFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientCommitGraphFunc) PushReturn(r0 *gitdomain.CommitGraph, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error) {
+func (f *ClientArchiveReaderFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientCommitGraphFunc) nextHook() func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error) {
+func (f *ClientArchiveReaderFunc) nextHook() func(context.Context, api.RepoName, ArchiveOptions) (io.ReadCloser, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1149,26 +2305,26 @@ func (f *ClientCommitGraphFunc) nextHook() func(context.Context, api.RepoName, C
 	return hook
 }
 
-func (f *ClientCommitGraphFunc) appendCall(r0 ClientCommitGraphFuncCall) {
+func (f *ClientArchiveReaderFunc) appendCall(r0 ClientArchiveReaderFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientCommitGraphFuncCall objects
+// History returns a sequence of ClientArchiveReaderFuncCall objects
 // describing the invocations of this function.
-func (f *ClientCommitGraphFunc) History() []ClientCommitGraphFuncCall {
+func (f *ClientArchiveReaderFunc) History() []ClientArchiveReaderFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientCommitGraphFuncCall, len(f.history))
+	history := make([]ClientArchiveReaderFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientCommitGraphFuncCall is an object that describes an invocation of
-// method CommitGraph on an instance of MockClient.
-type ClientCommitGraphFuncCall struct {
+// ClientArchiveReaderFuncCall is an object that describes an invocation of
+// method ArchiveReader on an instance of MockClient.
+type ClientArchiveReaderFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -1177,10 +2333,10 @@ type ClientCommitGraphFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 CommitGraphOptions
+	Arg2 ArchiveOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *gitdomain.CommitGraph
+	Result0 io.ReadCloser
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1188,44 +2344,44 @@ type ClientCommitGraphFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientCommitGraphFuncCall) Args() []interface{} {
+func (c ClientArchiveReaderFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientCommitGraphFuncCall) Results() []interface{} {
+func (c ClientArchiveReaderFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientCommitLogFunc describes the behavior when the CommitLog method of
-// the parent MockClient instance is invoked.
-type ClientCommitLogFunc struct {
-	defaultHook func(context.Context, api.RepoName, time.Time) ([]CommitLog, error)
-	hooks       []func(context.Context, api.RepoName, time.Time) ([]CommitLog, error)
-	history     []ClientCommitLogFuncCall
+// ClientBlameSummaryFunc describes the behavior when the BlameSummary
+// method of the parent MockClient instance is invoked.
+type ClientBlameSummaryFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, string) ([]*gitdomain.BlameAuthorSummary, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, string) ([]*gitdomain.BlameAuthorSummary, error)
+	history     []ClientBlameSummaryFuncCall
 	mutex       sync.Mutex
 }
 
-// CommitLog delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) CommitLog(v0 context.Context, v1 api.RepoName, v2 time.Time) ([]CommitLog, error) {
-	r0, r1 := m.CommitLogFunc.nextHook()(v0, v1, v2)
-	m.CommitLogFunc.appendCall(ClientCommitLogFuncCall{v0, v1, v2, r0, r1})
+// BlameSummary delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) BlameSummary(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string) ([]*gitdomain.BlameAuthorSummary, error) {
+	r0, r1 := m.BlameSummaryFunc.nextHook()(v0, v1, v2, v3)
+	m.BlameSummaryFunc.appendCall(ClientBlameSummaryFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the CommitLog method of
-// the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientCommitLogFunc) SetDefaultHook(hook func(context.Context, api.RepoName, time.Time) ([]CommitLog, error)) {
+// SetDefaultHook sets function that is called when the BlameSummary method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientBlameSummaryFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string) ([]*gitdomain.BlameAuthorSummary, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CommitLog method of the parent MockClient instance invokes the hook at
+// BlameSummary method of the parent MockClient instance invokes the hook at
 // the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *ClientCommitLogFunc) PushHook(hook func(context.Context, api.RepoName, time.Time) ([]CommitLog, error)) {
+func (f *ClientBlameSummaryFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string) ([]*gitdomain.BlameAuthorSummary, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1233,20 +2389,20 @@ func (f *ClientCommitLogFunc) PushHook(hook func(context.Context, api.RepoName,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientCommitLogFunc) SetDefaultReturn(r0 []CommitLog, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, time.Time) ([]CommitLog, error) {
+func (f *ClientBlameSummaryFunc) SetDefaultReturn(r0 []*gitdomain.BlameAuthorSummary, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) ([]*gitdomain.BlameAuthorSummary, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientCommitLogFunc) PushReturn(r0 []CommitLog, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, time.Time) ([]CommitLog, error) {
+func (f *ClientBlameSummaryFunc) PushReturn(r0 []*gitdomain.BlameAuthorSummary, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string) ([]*gitdomain.BlameAuthorSummary, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientCommitLogFunc) nextHook() func(context.Context, api.RepoName, time.Time) ([]CommitLog, error) {
+func (f *ClientBlameSummaryFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string) ([]*gitdomain.BlameAuthorSummary, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1259,26 +2415,26 @@ func (f *ClientCommitLogFunc) nextHook() func(context.Context, api.RepoName, tim
 	return hook
 }
 
-func (f *ClientCommitLogFunc) appendCall(r0 ClientCommitLogFuncCall) {
+func (f *ClientBlameSummaryFunc) appendCall(r0 ClientBlameSummaryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientCommitLogFuncCall objects describing
-// the invocations of this function.
-func (f *ClientCommitLogFunc) History() []ClientCommitLogFuncCall {
+// History returns a sequence of ClientBlameSummaryFuncCall objects
+// describing the invocations of this function.
+func (f *ClientBlameSummaryFunc) History() []ClientBlameSummaryFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientCommitLogFuncCall, len(f.history))
+	history := make([]ClientBlameSummaryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientCommitLogFuncCall is an object that describes an invocation of
-// method CommitLog on an instance of MockClient.
-type ClientCommitLogFuncCall struct {
+// ClientBlameSummaryFuncCall is an object that describes an invocation of
+// method BlameSummary on an instance of MockClient.
+type ClientBlameSummaryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -1287,10 +2443,13 @@ type ClientCommitLogFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 time.Time
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []CommitLog
+	Result0 []*gitdomain.BlameAuthorSummary
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1298,44 +2457,46 @@ type ClientCommitLogFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientCommitLogFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c ClientBlameSummaryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientCommitLogFuncCall) Results() []interface{} {
+func (c ClientBlameSummaryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientCommitsFunc describes the behavior when the Commits method of the
-// parent MockClient instance is invoked.
-type ClientCommitsFunc struct {
-	defaultHook func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error)
-	hooks       []func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error)
-	history     []ClientCommitsFuncCall
+// ClientCheckPerforceCredentialsFunc describes the behavior when the
+// CheckPerforceCredentials method of the parent MockClient instance is
+// invoked.
+type ClientCheckPerforceCredentialsFunc struct {
+	defaultHook func(context.Context, protocol.PerforceConnectionDetails) error
+	hooks       []func(context.Context, protocol.PerforceConnectionDetails) error
+	history     []ClientCheckPerforceCredentialsFuncCall
 	mutex       sync.Mutex
 }
 
-// Commits delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) Commits(v0 context.Context, v1 api.RepoName, v2 CommitsOptions) ([]*gitdomain.Commit, error) {
-	r0, r1 := m.CommitsFunc.nextHook()(v0, v1, v2)
-	m.CommitsFunc.appendCall(ClientCommitsFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+// CheckPerforceCredentials delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockClient) CheckPerforceCredentials(v0 context.Context, v1 protocol.PerforceConnectionDetails) error {
+	r0 := m.CheckPerforceCredentialsFunc.nextHook()(v0, v1)
+	m.CheckPerforceCredentialsFunc.appendCall(ClientCheckPerforceCredentialsFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Commits method of
-// the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientCommitsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error)) {
+// SetDefaultHook sets function that is called when the
+// CheckPerforceCredentials method of the parent MockClient instance is
+// invoked and the hook queue is empty.
+func (f *ClientCheckPerforceCredentialsFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Commits method of the parent MockClient instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *ClientCommitsFunc) PushHook(hook func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error)) {
+// CheckPerforceCredentials method of the parent MockClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ClientCheckPerforceCredentialsFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1343,20 +2504,20 @@ func (f *ClientCommitsFunc) PushHook(hook func(context.Context, api.RepoName, Co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientCommitsFunc) SetDefaultReturn(r0 []*gitdomain.Commit, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error) {
-		return r0, r1
+func (f *ClientCheckPerforceCredentialsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientCommitsFunc) PushReturn(r0 []*gitdomain.Commit, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error) {
-		return r0, r1
+func (f *ClientCheckPerforceCredentialsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails) error {
+		return r0
 	})
 }
 
-func (f *ClientCommitsFunc) nextHook() func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error) {
+func (f *ClientCheckPerforceCredentialsFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1369,85 +2530,78 @@ func (f *ClientCommitsFunc) nextHook() func(context.Context, api.RepoName, Commi
 	return hook
 }
 
-func (f *ClientCommitsFunc) appendCall(r0 ClientCommitsFuncCall) {
+func (f *ClientCheckPerforceCredentialsFunc) appendCall(r0 ClientCheckPerforceCredentialsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientCommitsFuncCall objects describing
-// the invocations of this function.
-func (f *ClientCommitsFunc) History() []ClientCommitsFuncCall {
+// History returns a sequence of ClientCheckPerforceCredentialsFuncCall
+// objects describing the invocations of this function.
+func (f *ClientCheckPerforceCredentialsFunc) History() []ClientCheckPerforceCredentialsFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientCommitsFuncCall, len(f.history))
+	history := make([]ClientCheckPerforceCredentialsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientCommitsFuncCall is an object that describes an invocation of method
-// Commits on an instance of MockClient.
-type ClientCommitsFuncCall struct {
+// ClientCheckPerforceCredentialsFuncCall is an object that describes an
+// invocation of method CheckPerforceCredentials on an instance of
+// MockClient.
+type ClientCheckPerforceCredentialsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoName
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 CommitsOptions
+	Arg1 protocol.PerforceConnectionDetails
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*gitdomain.Commit
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientCommitsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c ClientCheckPerforceCredentialsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientCommitsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ClientCheckPerforceCredentialsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// ClientCommitsUniqueToBranchFunc describes the behavior when the
-// CommitsUniqueToBranch method of the parent MockClient instance is
-// invoked.
-type ClientCommitsUniqueToBranchFunc struct {
-	defaultHook func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error)
-	hooks       []func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error)
-	history     []ClientCommitsUniqueToBranchFuncCall
+// ClientCherryFunc describes the behavior when the Cherry method of the
+// parent MockClient instance is invoked.
+type ClientCherryFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, string) ([]gitdomain.CherryCommit, error)
+	hooks       []func(context.Context, api.RepoName, string, string) ([]gitdomain.CherryCommit, error)
+	history     []ClientCherryFuncCall
 	mutex       sync.Mutex
 }
 
-// CommitsUniqueToBranch delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockClient) CommitsUniqueToBranch(v0 context.Context, v1 api.RepoName, v2 string, v3 bool, v4 *time.Time) (map[string]time.Time, error) {
-	r0, r1 := m.CommitsUniqueToBranchFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.CommitsUniqueToBranchFunc.appendCall(ClientCommitsUniqueToBranchFuncCall{v0, v1, v2, v3, v4, r0, r1})
+// Cherry delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) Cherry(v0 context.Context, v1 api.RepoName, v2 string, v3 string) ([]gitdomain.CherryCommit, error) {
+	r0, r1 := m.CherryFunc.nextHook()(v0, v1, v2, v3)
+	m.CherryFunc.appendCall(ClientCherryFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// CommitsUniqueToBranch method of the parent MockClient instance is invoked
-// and the hook queue is empty.
-func (f *ClientCommitsUniqueToBranchFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error)) {
+// SetDefaultHook sets function that is called when the Cherry method of the
+// parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientCherryFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) ([]gitdomain.CherryCommit, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CommitsUniqueToBranch method of the parent MockClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *ClientCommitsUniqueToBranchFunc) PushHook(hook func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error)) {
+// Cherry method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientCherryFunc) PushHook(hook func(context.Context, api.RepoName, string, string) ([]gitdomain.CherryCommit, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1455,20 +2609,20 @@ func (f *ClientCommitsUniqueToBranchFunc) PushHook(hook func(context.Context, ap
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientCommitsUniqueToBranchFunc) SetDefaultReturn(r0 map[string]time.Time, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error) {
+func (f *ClientCherryFunc) SetDefaultReturn(r0 []gitdomain.CherryCommit, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) ([]gitdomain.CherryCommit, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientCommitsUniqueToBranchFunc) PushReturn(r0 map[string]time.Time, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error) {
+func (f *ClientCherryFunc) PushReturn(r0 []gitdomain.CherryCommit, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, string) ([]gitdomain.CherryCommit, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientCommitsUniqueToBranchFunc) nextHook() func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error) {
+func (f *ClientCherryFunc) nextHook() func(context.Context, api.RepoName, string, string) ([]gitdomain.CherryCommit, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1481,26 +2635,26 @@ func (f *ClientCommitsUniqueToBranchFunc) nextHook() func(context.Context, api.R
 	return hook
 }
 
-func (f *ClientCommitsUniqueToBranchFunc) appendCall(r0 ClientCommitsUniqueToBranchFuncCall) {
+func (f *ClientCherryFunc) appendCall(r0 ClientCherryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientCommitsUniqueToBranchFuncCall objects
-// describing the invocations of this function.
-func (f *ClientCommitsUniqueToBranchFunc) History() []ClientCommitsUniqueToBranchFuncCall {
+// History returns a sequence of ClientCherryFuncCall objects describing the
+// invocations of this function.
+func (f *ClientCherryFunc) History() []ClientCherryFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientCommitsUniqueToBranchFuncCall, len(f.history))
+	history := make([]ClientCherryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientCommitsUniqueToBranchFuncCall is an object that describes an
-// invocation of method CommitsUniqueToBranch on an instance of MockClient.
-type ClientCommitsUniqueToBranchFuncCall struct {
+// ClientCherryFuncCall is an object that describes an invocation of method
+// Cherry on an instance of MockClient.
+type ClientCherryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -1512,13 +2666,10 @@ type ClientCommitsUniqueToBranchFuncCall struct {
 	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 bool
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 *time.Time
+	Arg3 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 map[string]time.Time
+	Result0 []gitdomain.CherryCommit
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1526,45 +2677,45 @@ type ClientCommitsUniqueToBranchFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientCommitsUniqueToBranchFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c ClientCherryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientCommitsUniqueToBranchFuncCall) Results() []interface{} {
+func (c ClientCherryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientContributorCountFunc describes the behavior when the
-// ContributorCount method of the parent MockClient instance is invoked.
-type ClientContributorCountFunc struct {
-	defaultHook func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error)
-	hooks       []func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error)
-	history     []ClientContributorCountFuncCall
+// ClientCherryCrossRepoFunc describes the behavior when the CherryCrossRepo
+// method of the parent MockClient instance is invoked.
+type ClientCherryCrossRepoFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, api.RepoName, string) ([]gitdomain.CherryCommit, error)
+	hooks       []func(context.Context, api.RepoName, string, api.RepoName, string) ([]gitdomain.CherryCommit, error)
+	history     []ClientCherryCrossRepoFuncCall
 	mutex       sync.Mutex
 }
 
-// ContributorCount delegates to the next hook function in the queue and
+// CherryCrossRepo delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockClient) ContributorCount(v0 context.Context, v1 api.RepoName, v2 ContributorOptions) ([]*gitdomain.ContributorCount, error) {
-	r0, r1 := m.ContributorCountFunc.nextHook()(v0, v1, v2)
-	m.ContributorCountFunc.appendCall(ClientContributorCountFuncCall{v0, v1, v2, r0, r1})
+func (m *MockClient) CherryCrossRepo(v0 context.Context, v1 api.RepoName, v2 string, v3 api.RepoName, v4 string) ([]gitdomain.CherryCommit, error) {
+	r0, r1 := m.CherryCrossRepoFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.CherryCrossRepoFunc.appendCall(ClientCherryCrossRepoFuncCall{v0, v1, v2, v3, v4, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ContributorCount
+// SetDefaultHook sets function that is called when the CherryCrossRepo
 // method of the parent MockClient instance is invoked and the hook queue is
 // empty.
-func (f *ClientContributorCountFunc) SetDefaultHook(hook func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error)) {
+func (f *ClientCherryCrossRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, api.RepoName, string) ([]gitdomain.CherryCommit, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ContributorCount method of the parent MockClient instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *ClientContributorCountFunc) PushHook(hook func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error)) {
+// CherryCrossRepo method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientCherryCrossRepoFunc) PushHook(hook func(context.Context, api.RepoName, string, api.RepoName, string) ([]gitdomain.CherryCommit, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1572,20 +2723,20 @@ func (f *ClientContributorCountFunc) PushHook(hook func(context.Context, api.Rep
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientContributorCountFunc) SetDefaultReturn(r0 []*gitdomain.ContributorCount, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error) {
+func (f *ClientCherryCrossRepoFunc) SetDefaultReturn(r0 []gitdomain.CherryCommit, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, api.RepoName, string) ([]gitdomain.CherryCommit, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientContributorCountFunc) PushReturn(r0 []*gitdomain.ContributorCount, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error) {
+func (f *ClientCherryCrossRepoFunc) PushReturn(r0 []gitdomain.CherryCommit, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, api.RepoName, string) ([]gitdomain.CherryCommit, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientContributorCountFunc) nextHook() func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error) {
+func (f *ClientCherryCrossRepoFunc) nextHook() func(context.Context, api.RepoName, string, api.RepoName, string) ([]gitdomain.CherryCommit, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1598,26 +2749,26 @@ func (f *ClientContributorCountFunc) nextHook() func(context.Context, api.RepoNa
 	return hook
 }
 
-func (f *ClientContributorCountFunc) appendCall(r0 ClientContributorCountFuncCall) {
+func (f *ClientCherryCrossRepoFunc) appendCall(r0 ClientCherryCrossRepoFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientContributorCountFuncCall objects
+// History returns a sequence of ClientCherryCrossRepoFuncCall objects
 // describing the invocations of this function.
-func (f *ClientContributorCountFunc) History() []ClientContributorCountFuncCall {
+func (f *ClientCherryCrossRepoFunc) History() []ClientCherryCrossRepoFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientContributorCountFuncCall, len(f.history))
+	history := make([]ClientCherryCrossRepoFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientContributorCountFuncCall is an object that describes an invocation
-// of method ContributorCount on an instance of MockClient.
-type ClientContributorCountFuncCall struct {
+// ClientCherryCrossRepoFuncCall is an object that describes an invocation
+// of method CherryCrossRepo on an instance of MockClient.
+type ClientCherryCrossRepoFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -1626,10 +2777,16 @@ type ClientContributorCountFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 ContributorOptions
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.RepoName
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*gitdomain.ContributorCount
+	Result0 []gitdomain.CherryCommit
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1637,46 +2794,44 @@ type ClientContributorCountFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientContributorCountFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c ClientCherryCrossRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientContributorCountFuncCall) Results() []interface{} {
+func (c ClientCherryCrossRepoFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientCreateCommitFromPatchFunc describes the behavior when the
-// CreateCommitFromPatch method of the parent MockClient instance is
-// invoked.
-type ClientCreateCommitFromPatchFunc struct {
-	defaultHook func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error)
-	hooks       []func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error)
-	history     []ClientCreateCommitFromPatchFuncCall
+// ClientCherryPickFunc describes the behavior when the CherryPick method of
+// the parent MockClient instance is invoked.
+type ClientCherryPickFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, string, CherryPickOptions) (api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, string, CherryPickOptions) (api.CommitID, error)
+	history     []ClientCherryPickFuncCall
 	mutex       sync.Mutex
 }
 
-// CreateCommitFromPatch delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockClient) CreateCommitFromPatch(v0 context.Context, v1 protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
-	r0, r1 := m.CreateCommitFromPatchFunc.nextHook()(v0, v1)
-	m.CreateCommitFromPatchFunc.appendCall(ClientCreateCommitFromPatchFuncCall{v0, v1, r0, r1})
+// CherryPick delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) CherryPick(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string, v4 CherryPickOptions) (api.CommitID, error) {
+	r0, r1 := m.CherryPickFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.CherryPickFunc.appendCall(ClientCherryPickFuncCall{v0, v1, v2, v3, v4, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// CreateCommitFromPatch method of the parent MockClient instance is invoked
-// and the hook queue is empty.
-func (f *ClientCreateCommitFromPatchFunc) SetDefaultHook(hook func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error)) {
+// SetDefaultHook sets function that is called when the CherryPick method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientCherryPickFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string, CherryPickOptions) (api.CommitID, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CreateCommitFromPatch method of the parent MockClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *ClientCreateCommitFromPatchFunc) PushHook(hook func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error)) {
+// CherryPick method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientCherryPickFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string, CherryPickOptions) (api.CommitID, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1684,20 +2839,20 @@ func (f *ClientCreateCommitFromPatchFunc) PushHook(hook func(context.Context, pr
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientCreateCommitFromPatchFunc) SetDefaultReturn(r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+func (f *ClientCherryPickFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string, CherryPickOptions) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientCreateCommitFromPatchFunc) PushReturn(r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
-	f.PushHook(func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+func (f *ClientCherryPickFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string, CherryPickOptions) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientCreateCommitFromPatchFunc) nextHook() func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+func (f *ClientCherryPickFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string, CherryPickOptions) (api.CommitID, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1710,35 +2865,44 @@ func (f *ClientCreateCommitFromPatchFunc) nextHook() func(context.Context, proto
 	return hook
 }
 
-func (f *ClientCreateCommitFromPatchFunc) appendCall(r0 ClientCreateCommitFromPatchFuncCall) {
+func (f *ClientCherryPickFunc) appendCall(r0 ClientCherryPickFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientCreateCommitFromPatchFuncCall objects
-// describing the invocations of this function.
-func (f *ClientCreateCommitFromPatchFunc) History() []ClientCreateCommitFromPatchFuncCall {
+// History returns a sequence of ClientCherryPickFuncCall objects describing
+// the invocations of this function.
+func (f *ClientCherryPickFunc) History() []ClientCherryPickFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientCreateCommitFromPatchFuncCall, len(f.history))
+	history := make([]ClientCherryPickFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientCreateCommitFromPatchFuncCall is an object that describes an
-// invocation of method CreateCommitFromPatch on an instance of MockClient.
-type ClientCreateCommitFromPatchFuncCall struct {
+// ClientCherryPickFuncCall is an object that describes an invocation of
+// method CherryPick on an instance of MockClient.
+type ClientCherryPickFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 protocol.CreateCommitFromPatchRequest
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 CherryPickOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *protocol.CreateCommitFromPatchResponse
+	Result0 api.CommitID
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1746,44 +2910,44 @@ type ClientCreateCommitFromPatchFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientCreateCommitFromPatchFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ClientCherryPickFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientCreateCommitFromPatchFuncCall) Results() []interface{} {
+func (c ClientCherryPickFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientDiffFunc describes the behavior when the Diff method of the parent
-// MockClient instance is invoked.
-type ClientDiffFunc struct {
-	defaultHook func(context.Context, DiffOptions) (*DiffFileIterator, error)
-	hooks       []func(context.Context, DiffOptions) (*DiffFileIterator, error)
-	history     []ClientDiffFuncCall
+// ClientCloneProgressFunc describes the behavior when the CloneProgress
+// method of the parent MockClient instance is invoked.
+type ClientCloneProgressFunc struct {
+	defaultHook func(context.Context, api.RepoName) (CloneProgressReader, error)
+	hooks       []func(context.Context, api.RepoName) (CloneProgressReader, error)
+	history     []ClientCloneProgressFuncCall
 	mutex       sync.Mutex
 }
 
-// Diff delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) Diff(v0 context.Context, v1 DiffOptions) (*DiffFileIterator, error) {
-	r0, r1 := m.DiffFunc.nextHook()(v0, v1)
-	m.DiffFunc.appendCall(ClientDiffFuncCall{v0, v1, r0, r1})
+// CloneProgress delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) CloneProgress(v0 context.Context, v1 api.RepoName) (CloneProgressReader, error) {
+	r0, r1 := m.CloneProgressFunc.nextHook()(v0, v1)
+	m.CloneProgressFunc.appendCall(ClientCloneProgressFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Diff method of the
-// parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientDiffFunc) SetDefaultHook(hook func(context.Context, DiffOptions) (*DiffFileIterator, error)) {
+// SetDefaultHook sets function that is called when the CloneProgress method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientCloneProgressFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (CloneProgressReader, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Diff method of the parent MockClient instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *ClientDiffFunc) PushHook(hook func(context.Context, DiffOptions) (*DiffFileIterator, error)) {
+// CloneProgress method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientCloneProgressFunc) PushHook(hook func(context.Context, api.RepoName) (CloneProgressReader, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1791,20 +2955,20 @@ func (f *ClientDiffFunc) PushHook(hook func(context.Context, DiffOptions) (*Diff
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientDiffFunc) SetDefaultReturn(r0 *DiffFileIterator, r1 error) {
-	f.SetDefaultHook(func(context.Context, DiffOptions) (*DiffFileIterator, error) {
+func (f *ClientCloneProgressFunc) SetDefaultReturn(r0 CloneProgressReader, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (CloneProgressReader, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientDiffFunc) PushReturn(r0 *DiffFileIterator, r1 error) {
-	f.PushHook(func(context.Context, DiffOptions) (*DiffFileIterator, error) {
+func (f *ClientCloneProgressFunc) PushReturn(r0 CloneProgressReader, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (CloneProgressReader, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientDiffFunc) nextHook() func(context.Context, DiffOptions) (*DiffFileIterator, error) {
+func (f *ClientCloneProgressFunc) nextHook() func(context.Context, api.RepoName) (CloneProgressReader, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1817,35 +2981,35 @@ func (f *ClientDiffFunc) nextHook() func(context.Context, DiffOptions) (*DiffFil
 	return hook
 }
 
-func (f *ClientDiffFunc) appendCall(r0 ClientDiffFuncCall) {
+func (f *ClientCloneProgressFunc) appendCall(r0 ClientCloneProgressFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientDiffFuncCall objects describing the
-// invocations of this function.
-func (f *ClientDiffFunc) History() []ClientDiffFuncCall {
+// History returns a sequence of ClientCloneProgressFuncCall objects
+// describing the invocations of this function.
+func (f *ClientCloneProgressFunc) History() []ClientCloneProgressFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientDiffFuncCall, len(f.history))
+	history := make([]ClientCloneProgressFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientDiffFuncCall is an object that describes an invocation of method
-// Diff on an instance of MockClient.
-type ClientDiffFuncCall struct {
+// ClientCloneProgressFuncCall is an object that describes an invocation of
+// method CloneProgress on an instance of MockClient.
+type ClientCloneProgressFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 DiffOptions
+	Arg1 api.RepoName
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *DiffFileIterator
+	Result0 CloneProgressReader
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1853,44 +3017,44 @@ type ClientDiffFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientDiffFuncCall) Args() []interface{} {
+func (c ClientCloneProgressFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientDiffFuncCall) Results() []interface{} {
+func (c ClientCloneProgressFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientDiffSymbolsFunc describes the behavior when the DiffSymbols method
-// of the parent MockClient instance is invoked.
-type ClientDiffSymbolsFunc struct {
-	defaultHook func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error)
-	hooks       []func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error)
-	history     []ClientDiffSymbolsFuncCall
+// ClientCloneStateFunc describes the behavior when the CloneState method of
+// the parent MockClient instance is invoked.
+type ClientCloneStateFunc struct {
+	defaultHook func(context.Context, api.RepoName) (gitdomain.CloneState, error)
+	hooks       []func(context.Context, api.RepoName) (gitdomain.CloneState, error)
+	history     []ClientCloneStateFuncCall
 	mutex       sync.Mutex
 }
 
-// DiffSymbols delegates to the next hook function in the queue and stores
+// CloneState delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockClient) DiffSymbols(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 api.CommitID) ([]byte, error) {
-	r0, r1 := m.DiffSymbolsFunc.nextHook()(v0, v1, v2, v3)
-	m.DiffSymbolsFunc.appendCall(ClientDiffSymbolsFuncCall{v0, v1, v2, v3, r0, r1})
+func (m *MockClient) CloneState(v0 context.Context, v1 api.RepoName) (gitdomain.CloneState, error) {
+	r0, r1 := m.CloneStateFunc.nextHook()(v0, v1)
+	m.CloneStateFunc.appendCall(ClientCloneStateFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the DiffSymbols method
-// of the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientDiffSymbolsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error)) {
+// SetDefaultHook sets function that is called when the CloneState method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientCloneStateFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (gitdomain.CloneState, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DiffSymbols method of the parent MockClient instance invokes the hook at
+// CloneState method of the parent MockClient instance invokes the hook at
 // the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *ClientDiffSymbolsFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error)) {
+func (f *ClientCloneStateFunc) PushHook(hook func(context.Context, api.RepoName) (gitdomain.CloneState, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1898,20 +3062,20 @@ func (f *ClientDiffSymbolsFunc) PushHook(hook func(context.Context, api.RepoName
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientDiffSymbolsFunc) SetDefaultReturn(r0 []byte, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error) {
+func (f *ClientCloneStateFunc) SetDefaultReturn(r0 gitdomain.CloneState, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (gitdomain.CloneState, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientDiffSymbolsFunc) PushReturn(r0 []byte, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error) {
+func (f *ClientCloneStateFunc) PushReturn(r0 gitdomain.CloneState, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (gitdomain.CloneState, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientDiffSymbolsFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error) {
+func (f *ClientCloneStateFunc) nextHook() func(context.Context, api.RepoName) (gitdomain.CloneState, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1924,41 +3088,35 @@ func (f *ClientDiffSymbolsFunc) nextHook() func(context.Context, api.RepoName, a
 	return hook
 }
 
-func (f *ClientDiffSymbolsFunc) appendCall(r0 ClientDiffSymbolsFuncCall) {
+func (f *ClientCloneStateFunc) appendCall(r0 ClientCloneStateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientDiffSymbolsFuncCall objects
-// describing the invocations of this function.
-func (f *ClientDiffSymbolsFunc) History() []ClientDiffSymbolsFuncCall {
+// History returns a sequence of ClientCloneStateFuncCall objects describing
+// the invocations of this function.
+func (f *ClientCloneStateFunc) History() []ClientCloneStateFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientDiffSymbolsFuncCall, len(f.history))
+	history := make([]ClientCloneStateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientDiffSymbolsFuncCall is an object that describes an invocation of
-// method DiffSymbols on an instance of MockClient.
-type ClientDiffSymbolsFuncCall struct {
+// ClientCloneStateFuncCall is an object that describes an invocation of
+// method CloneState on an instance of MockClient.
+type ClientCloneStateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 api.RepoName
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 api.CommitID
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 api.CommitID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []byte
+	Result0 gitdomain.CloneState
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1966,45 +3124,45 @@ type ClientDiffSymbolsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientDiffSymbolsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c ClientCloneStateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientDiffSymbolsFuncCall) Results() []interface{} {
+func (c ClientCloneStateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientFirstEverCommitFunc describes the behavior when the FirstEverCommit
+// ClientCommitActivityFunc describes the behavior when the CommitActivity
 // method of the parent MockClient instance is invoked.
-type ClientFirstEverCommitFunc struct {
-	defaultHook func(context.Context, api.RepoName) (*gitdomain.Commit, error)
-	hooks       []func(context.Context, api.RepoName) (*gitdomain.Commit, error)
-	history     []ClientFirstEverCommitFuncCall
+type ClientCommitActivityFunc struct {
+	defaultHook func(context.Context, api.RepoName, CommitActivityOptions) ([]gitdomain.CommitActivityBucket, error)
+	hooks       []func(context.Context, api.RepoName, CommitActivityOptions) ([]gitdomain.CommitActivityBucket, error)
+	history     []ClientCommitActivityFuncCall
 	mutex       sync.Mutex
 }
 
-// FirstEverCommit delegates to the next hook function in the queue and
+// CommitActivity delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockClient) FirstEverCommit(v0 context.Context, v1 api.RepoName) (*gitdomain.Commit, error) {
-	r0, r1 := m.FirstEverCommitFunc.nextHook()(v0, v1)
-	m.FirstEverCommitFunc.appendCall(ClientFirstEverCommitFuncCall{v0, v1, r0, r1})
+func (m *MockClient) CommitActivity(v0 context.Context, v1 api.RepoName, v2 CommitActivityOptions) ([]gitdomain.CommitActivityBucket, error) {
+	r0, r1 := m.CommitActivityFunc.nextHook()(v0, v1, v2)
+	m.CommitActivityFunc.appendCall(ClientCommitActivityFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the FirstEverCommit
+// SetDefaultHook sets function that is called when the CommitActivity
 // method of the parent MockClient instance is invoked and the hook queue is
 // empty.
-func (f *ClientFirstEverCommitFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*gitdomain.Commit, error)) {
+func (f *ClientCommitActivityFunc) SetDefaultHook(hook func(context.Context, api.RepoName, CommitActivityOptions) ([]gitdomain.CommitActivityBucket, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// FirstEverCommit method of the parent MockClient instance invokes the hook
+// CommitActivity method of the parent MockClient instance invokes the hook
 // at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *ClientFirstEverCommitFunc) PushHook(hook func(context.Context, api.RepoName) (*gitdomain.Commit, error)) {
+func (f *ClientCommitActivityFunc) PushHook(hook func(context.Context, api.RepoName, CommitActivityOptions) ([]gitdomain.CommitActivityBucket, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2012,20 +3170,20 @@ func (f *ClientFirstEverCommitFunc) PushHook(hook func(context.Context, api.Repo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientFirstEverCommitFunc) SetDefaultReturn(r0 *gitdomain.Commit, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (*gitdomain.Commit, error) {
+func (f *ClientCommitActivityFunc) SetDefaultReturn(r0 []gitdomain.CommitActivityBucket, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, CommitActivityOptions) ([]gitdomain.CommitActivityBucket, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientFirstEverCommitFunc) PushReturn(r0 *gitdomain.Commit, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName) (*gitdomain.Commit, error) {
+func (f *ClientCommitActivityFunc) PushReturn(r0 []gitdomain.CommitActivityBucket, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, CommitActivityOptions) ([]gitdomain.CommitActivityBucket, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientFirstEverCommitFunc) nextHook() func(context.Context, api.RepoName) (*gitdomain.Commit, error) {
+func (f *ClientCommitActivityFunc) nextHook() func(context.Context, api.RepoName, CommitActivityOptions) ([]gitdomain.CommitActivityBucket, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2038,35 +3196,38 @@ func (f *ClientFirstEverCommitFunc) nextHook() func(context.Context, api.RepoNam
 	return hook
 }
 
-func (f *ClientFirstEverCommitFunc) appendCall(r0 ClientFirstEverCommitFuncCall) {
+func (f *ClientCommitActivityFunc) appendCall(r0 ClientCommitActivityFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientFirstEverCommitFuncCall objects
+// History returns a sequence of ClientCommitActivityFuncCall objects
 // describing the invocations of this function.
-func (f *ClientFirstEverCommitFunc) History() []ClientFirstEverCommitFuncCall {
+func (f *ClientCommitActivityFunc) History() []ClientCommitActivityFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientFirstEverCommitFuncCall, len(f.history))
+	history := make([]ClientCommitActivityFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientFirstEverCommitFuncCall is an object that describes an invocation
-// of method FirstEverCommit on an instance of MockClient.
-type ClientFirstEverCommitFuncCall struct {
+// ClientCommitActivityFuncCall is an object that describes an invocation of
+// method CommitActivity on an instance of MockClient.
+type ClientCommitActivityFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 CommitActivityOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *gitdomain.Commit
+	Result0 []gitdomain.CommitActivityBucket
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2074,45 +3235,44 @@ type ClientFirstEverCommitFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientFirstEverCommitFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ClientCommitActivityFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientFirstEverCommitFuncCall) Results() []interface{} {
+func (c ClientCommitActivityFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientGetBehindAheadFunc describes the behavior when the GetBehindAhead
-// method of the parent MockClient instance is invoked.
-type ClientGetBehindAheadFunc struct {
-	defaultHook func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error)
-	hooks       []func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error)
-	history     []ClientGetBehindAheadFuncCall
+// ClientCommitGraphFunc describes the behavior when the CommitGraph method
+// of the parent MockClient instance is invoked.
+type ClientCommitGraphFunc struct {
+	defaultHook func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error)
+	hooks       []func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error)
+	history     []ClientCommitGraphFuncCall
 	mutex       sync.Mutex
 }
 
-// GetBehindAhead delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockClient) GetBehindAhead(v0 context.Context, v1 api.RepoName, v2 string, v3 string) (*gitdomain.BehindAhead, error) {
-	r0, r1 := m.GetBehindAheadFunc.nextHook()(v0, v1, v2, v3)
-	m.GetBehindAheadFunc.appendCall(ClientGetBehindAheadFuncCall{v0, v1, v2, v3, r0, r1})
+// CommitGraph delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) CommitGraph(v0 context.Context, v1 api.RepoName, v2 CommitGraphOptions) (*gitdomain.CommitGraph, error) {
+	r0, r1 := m.CommitGraphFunc.nextHook()(v0, v1, v2)
+	m.CommitGraphFunc.appendCall(ClientCommitGraphFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetBehindAhead
-// method of the parent MockClient instance is invoked and the hook queue is
-// empty.
-func (f *ClientGetBehindAheadFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error)) {
+// SetDefaultHook sets function that is called when the CommitGraph method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientCommitGraphFunc) SetDefaultHook(hook func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetBehindAhead method of the parent MockClient instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
+// CommitGraph method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *ClientGetBehindAheadFunc) PushHook(hook func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error)) {
+func (f *ClientCommitGraphFunc) PushHook(hook func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2120,20 +3280,20 @@ func (f *ClientGetBehindAheadFunc) PushHook(hook func(context.Context, api.RepoN
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientGetBehindAheadFunc) SetDefaultReturn(r0 *gitdomain.BehindAhead, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error) {
+func (f *ClientCommitGraphFunc) SetDefaultReturn(r0 *gitdomain.CommitGraph, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientGetBehindAheadFunc) PushReturn(r0 *gitdomain.BehindAhead, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error) {
+func (f *ClientCommitGraphFunc) PushReturn(r0 *gitdomain.CommitGraph, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientGetBehindAheadFunc) nextHook() func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error) {
+func (f *ClientCommitGraphFunc) nextHook() func(context.Context, api.RepoName, CommitGraphOptions) (*gitdomain.CommitGraph, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2146,26 +3306,26 @@ func (f *ClientGetBehindAheadFunc) nextHook() func(context.Context, api.RepoName
 	return hook
 }
 
-func (f *ClientGetBehindAheadFunc) appendCall(r0 ClientGetBehindAheadFuncCall) {
+func (f *ClientCommitGraphFunc) appendCall(r0 ClientCommitGraphFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientGetBehindAheadFuncCall objects
+// History returns a sequence of ClientCommitGraphFuncCall objects
 // describing the invocations of this function.
-func (f *ClientGetBehindAheadFunc) History() []ClientGetBehindAheadFuncCall {
+func (f *ClientCommitGraphFunc) History() []ClientCommitGraphFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientGetBehindAheadFuncCall, len(f.history))
+	history := make([]ClientCommitGraphFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientGetBehindAheadFuncCall is an object that describes an invocation of
-// method GetBehindAhead on an instance of MockClient.
-type ClientGetBehindAheadFuncCall struct {
+// ClientCommitGraphFuncCall is an object that describes an invocation of
+// method CommitGraph on an instance of MockClient.
+type ClientCommitGraphFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -2174,13 +3334,10 @@ type ClientGetBehindAheadFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 string
+	Arg2 CommitGraphOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *gitdomain.BehindAhead
+	Result0 *gitdomain.CommitGraph
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2188,44 +3345,45 @@ type ClientGetBehindAheadFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientGetBehindAheadFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c ClientCommitGraphFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientGetBehindAheadFuncCall) Results() []interface{} {
+func (c ClientCommitGraphFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientGetCommitFunc describes the behavior when the GetCommit method of
-// the parent MockClient instance is invoked.
-type ClientGetCommitFunc struct {
-	defaultHook func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error)
-	hooks       []func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error)
-	history     []ClientGetCommitFuncCall
+// ClientCommitGraphStatusFunc describes the behavior when the
+// CommitGraphStatus method of the parent MockClient instance is invoked.
+type ClientCommitGraphStatusFunc struct {
+	defaultHook func(context.Context, api.RepoName) (gitdomain.CommitGraphStatus, error)
+	hooks       []func(context.Context, api.RepoName) (gitdomain.CommitGraphStatus, error)
+	history     []ClientCommitGraphStatusFuncCall
 	mutex       sync.Mutex
 }
 
-// GetCommit delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) GetCommit(v0 context.Context, v1 api.RepoName, v2 api.CommitID) (*gitdomain.Commit, error) {
-	r0, r1 := m.GetCommitFunc.nextHook()(v0, v1, v2)
-	m.GetCommitFunc.appendCall(ClientGetCommitFuncCall{v0, v1, v2, r0, r1})
+// CommitGraphStatus delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) CommitGraphStatus(v0 context.Context, v1 api.RepoName) (gitdomain.CommitGraphStatus, error) {
+	r0, r1 := m.CommitGraphStatusFunc.nextHook()(v0, v1)
+	m.CommitGraphStatusFunc.appendCall(ClientCommitGraphStatusFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetCommit method of
-// the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientGetCommitFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error)) {
+// SetDefaultHook sets function that is called when the CommitGraphStatus
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientCommitGraphStatusFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (gitdomain.CommitGraphStatus, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetCommit method of the parent MockClient instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ClientGetCommitFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error)) {
+// CommitGraphStatus method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientCommitGraphStatusFunc) PushHook(hook func(context.Context, api.RepoName) (gitdomain.CommitGraphStatus, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2233,20 +3391,20 @@ func (f *ClientGetCommitFunc) PushHook(hook func(context.Context, api.RepoName,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientGetCommitFunc) SetDefaultReturn(r0 *gitdomain.Commit, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error) {
+func (f *ClientCommitGraphStatusFunc) SetDefaultReturn(r0 gitdomain.CommitGraphStatus, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (gitdomain.CommitGraphStatus, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientGetCommitFunc) PushReturn(r0 *gitdomain.Commit, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error) {
+func (f *ClientCommitGraphStatusFunc) PushReturn(r0 gitdomain.CommitGraphStatus, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (gitdomain.CommitGraphStatus, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientGetCommitFunc) nextHook() func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error) {
+func (f *ClientCommitGraphStatusFunc) nextHook() func(context.Context, api.RepoName) (gitdomain.CommitGraphStatus, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2259,38 +3417,35 @@ func (f *ClientGetCommitFunc) nextHook() func(context.Context, api.RepoName, api
 	return hook
 }
 
-func (f *ClientGetCommitFunc) appendCall(r0 ClientGetCommitFuncCall) {
+func (f *ClientCommitGraphStatusFunc) appendCall(r0 ClientCommitGraphStatusFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientGetCommitFuncCall objects describing
-// the invocations of this function.
-func (f *ClientGetCommitFunc) History() []ClientGetCommitFuncCall {
+// History returns a sequence of ClientCommitGraphStatusFuncCall objects
+// describing the invocations of this function.
+func (f *ClientCommitGraphStatusFunc) History() []ClientCommitGraphStatusFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientGetCommitFuncCall, len(f.history))
+	history := make([]ClientCommitGraphStatusFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientGetCommitFuncCall is an object that describes an invocation of
-// method GetCommit on an instance of MockClient.
-type ClientGetCommitFuncCall struct {
+// ClientCommitGraphStatusFuncCall is an object that describes an invocation
+// of method CommitGraphStatus on an instance of MockClient.
+type ClientCommitGraphStatusFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 api.RepoName
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 api.CommitID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *gitdomain.Commit
+	Result0 gitdomain.CommitGraphStatus
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2298,45 +3453,44 @@ type ClientGetCommitFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientGetCommitFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c ClientCommitGraphStatusFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientGetCommitFuncCall) Results() []interface{} {
+func (c ClientCommitGraphStatusFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientGetDefaultBranchFunc describes the behavior when the
-// GetDefaultBranch method of the parent MockClient instance is invoked.
-type ClientGetDefaultBranchFunc struct {
-	defaultHook func(context.Context, api.RepoName, bool) (string, api.CommitID, error)
-	hooks       []func(context.Context, api.RepoName, bool) (string, api.CommitID, error)
-	history     []ClientGetDefaultBranchFuncCall
+// ClientCommitLogFunc describes the behavior when the CommitLog method of
+// the parent MockClient instance is invoked.
+type ClientCommitLogFunc struct {
+	defaultHook func(context.Context, api.RepoName, time.Time) ([]CommitLog, error)
+	hooks       []func(context.Context, api.RepoName, time.Time) ([]CommitLog, error)
+	history     []ClientCommitLogFuncCall
 	mutex       sync.Mutex
 }
 
-// GetDefaultBranch delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockClient) GetDefaultBranch(v0 context.Context, v1 api.RepoName, v2 bool) (string, api.CommitID, error) {
-	r0, r1, r2 := m.GetDefaultBranchFunc.nextHook()(v0, v1, v2)
-	m.GetDefaultBranchFunc.appendCall(ClientGetDefaultBranchFuncCall{v0, v1, v2, r0, r1, r2})
-	return r0, r1, r2
+// CommitLog delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) CommitLog(v0 context.Context, v1 api.RepoName, v2 time.Time) ([]CommitLog, error) {
+	r0, r1 := m.CommitLogFunc.nextHook()(v0, v1, v2)
+	m.CommitLogFunc.appendCall(ClientCommitLogFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetDefaultBranch
-// method of the parent MockClient instance is invoked and the hook queue is
-// empty.
-func (f *ClientGetDefaultBranchFunc) SetDefaultHook(hook func(context.Context, api.RepoName, bool) (string, api.CommitID, error)) {
+// SetDefaultHook sets function that is called when the CommitLog method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientCommitLogFunc) SetDefaultHook(hook func(context.Context, api.RepoName, time.Time) ([]CommitLog, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetDefaultBranch method of the parent MockClient instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *ClientGetDefaultBranchFunc) PushHook(hook func(context.Context, api.RepoName, bool) (string, api.CommitID, error)) {
+// CommitLog method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientCommitLogFunc) PushHook(hook func(context.Context, api.RepoName, time.Time) ([]CommitLog, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2344,20 +3498,20 @@ func (f *ClientGetDefaultBranchFunc) PushHook(hook func(context.Context, api.Rep
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientGetDefaultBranchFunc) SetDefaultReturn(r0 string, r1 api.CommitID, r2 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, bool) (string, api.CommitID, error) {
-		return r0, r1, r2
+func (f *ClientCommitLogFunc) SetDefaultReturn(r0 []CommitLog, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, time.Time) ([]CommitLog, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientGetDefaultBranchFunc) PushReturn(r0 string, r1 api.CommitID, r2 error) {
-	f.PushHook(func(context.Context, api.RepoName, bool) (string, api.CommitID, error) {
-		return r0, r1, r2
+func (f *ClientCommitLogFunc) PushReturn(r0 []CommitLog, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, time.Time) ([]CommitLog, error) {
+		return r0, r1
 	})
 }
 
-func (f *ClientGetDefaultBranchFunc) nextHook() func(context.Context, api.RepoName, bool) (string, api.CommitID, error) {
+func (f *ClientCommitLogFunc) nextHook() func(context.Context, api.RepoName, time.Time) ([]CommitLog, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2370,26 +3524,26 @@ func (f *ClientGetDefaultBranchFunc) nextHook() func(context.Context, api.RepoNa
 	return hook
 }
 
-func (f *ClientGetDefaultBranchFunc) appendCall(r0 ClientGetDefaultBranchFuncCall) {
+func (f *ClientCommitLogFunc) appendCall(r0 ClientCommitLogFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientGetDefaultBranchFuncCall objects
-// describing the invocations of this function.
-func (f *ClientGetDefaultBranchFunc) History() []ClientGetDefaultBranchFuncCall {
+// History returns a sequence of ClientCommitLogFuncCall objects describing
+// the invocations of this function.
+func (f *ClientCommitLogFunc) History() []ClientCommitLogFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientGetDefaultBranchFuncCall, len(f.history))
+	history := make([]ClientCommitLogFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientGetDefaultBranchFuncCall is an object that describes an invocation
-// of method GetDefaultBranch on an instance of MockClient.
-type ClientGetDefaultBranchFuncCall struct {
+// ClientCommitLogFuncCall is an object that describes an invocation of
+// method CommitLog on an instance of MockClient.
+type ClientCommitLogFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -2398,58 +3552,55 @@ type ClientGetDefaultBranchFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 bool
+	Arg2 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 string
+	Result0 []CommitLog
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 api.CommitID
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientGetDefaultBranchFuncCall) Args() []interface{} {
+func (c ClientCommitLogFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientGetDefaultBranchFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c ClientCommitLogFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientGetObjectFunc describes the behavior when the GetObject method of
-// the parent MockClient instance is invoked.
-type ClientGetObjectFunc struct {
-	defaultHook func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error)
-	hooks       []func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error)
-	history     []ClientGetObjectFuncCall
+// ClientCommitsFunc describes the behavior when the Commits method of the
+// parent MockClient instance is invoked.
+type ClientCommitsFunc struct {
+	defaultHook func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error)
+	hooks       []func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error)
+	history     []ClientCommitsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetObject delegates to the next hook function in the queue and stores the
+// Commits delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockClient) GetObject(v0 context.Context, v1 api.RepoName, v2 string) (*gitdomain.GitObject, error) {
-	r0, r1 := m.GetObjectFunc.nextHook()(v0, v1, v2)
-	m.GetObjectFunc.appendCall(ClientGetObjectFuncCall{v0, v1, v2, r0, r1})
+func (m *MockClient) Commits(v0 context.Context, v1 api.RepoName, v2 CommitsOptions) ([]*gitdomain.Commit, error) {
+	r0, r1 := m.CommitsFunc.nextHook()(v0, v1, v2)
+	m.CommitsFunc.appendCall(ClientCommitsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetObject method of
+// SetDefaultHook sets function that is called when the Commits method of
 // the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientGetObjectFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error)) {
+func (f *ClientCommitsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetObject method of the parent MockClient instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ClientGetObjectFunc) PushHook(hook func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error)) {
+// Commits method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientCommitsFunc) PushHook(hook func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2457,20 +3608,20 @@ func (f *ClientGetObjectFunc) PushHook(hook func(context.Context, api.RepoName,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientGetObjectFunc) SetDefaultReturn(r0 *gitdomain.GitObject, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error) {
+func (f *ClientCommitsFunc) SetDefaultReturn(r0 []*gitdomain.Commit, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientGetObjectFunc) PushReturn(r0 *gitdomain.GitObject, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error) {
+func (f *ClientCommitsFunc) PushReturn(r0 []*gitdomain.Commit, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientGetObjectFunc) nextHook() func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error) {
+func (f *ClientCommitsFunc) nextHook() func(context.Context, api.RepoName, CommitsOptions) ([]*gitdomain.Commit, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2483,26 +3634,26 @@ func (f *ClientGetObjectFunc) nextHook() func(context.Context, api.RepoName, str
 	return hook
 }
 
-func (f *ClientGetObjectFunc) appendCall(r0 ClientGetObjectFuncCall) {
+func (f *ClientCommitsFunc) appendCall(r0 ClientCommitsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientGetObjectFuncCall objects describing
+// History returns a sequence of ClientCommitsFuncCall objects describing
 // the invocations of this function.
-func (f *ClientGetObjectFunc) History() []ClientGetObjectFuncCall {
+func (f *ClientCommitsFunc) History() []ClientCommitsFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientGetObjectFuncCall, len(f.history))
+	history := make([]ClientCommitsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientGetObjectFuncCall is an object that describes an invocation of
-// method GetObject on an instance of MockClient.
-type ClientGetObjectFuncCall struct {
+// ClientCommitsFuncCall is an object that describes an invocation of method
+// Commits on an instance of MockClient.
+type ClientCommitsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -2511,10 +3662,10 @@ type ClientGetObjectFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
+	Arg2 CommitsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *gitdomain.GitObject
+	Result0 []*gitdomain.Commit
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2522,45 +3673,46 @@ type ClientGetObjectFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientGetObjectFuncCall) Args() []interface{} {
+func (c ClientCommitsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientGetObjectFuncCall) Results() []interface{} {
+func (c ClientCommitsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientHasCommitAfterFunc describes the behavior when the HasCommitAfter
-// method of the parent MockClient instance is invoked.
-type ClientHasCommitAfterFunc struct {
-	defaultHook func(context.Context, api.RepoName, string, string) (bool, error)
-	hooks       []func(context.Context, api.RepoName, string, string) (bool, error)
-	history     []ClientHasCommitAfterFuncCall
+// ClientCommitsUniqueToBranchFunc describes the behavior when the
+// CommitsUniqueToBranch method of the parent MockClient instance is
+// invoked.
+type ClientCommitsUniqueToBranchFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error)
+	hooks       []func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error)
+	history     []ClientCommitsUniqueToBranchFuncCall
 	mutex       sync.Mutex
 }
 
-// HasCommitAfter delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockClient) HasCommitAfter(v0 context.Context, v1 api.RepoName, v2 string, v3 string) (bool, error) {
-	r0, r1 := m.HasCommitAfterFunc.nextHook()(v0, v1, v2, v3)
-	m.HasCommitAfterFunc.appendCall(ClientHasCommitAfterFuncCall{v0, v1, v2, v3, r0, r1})
+// CommitsUniqueToBranch delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockClient) CommitsUniqueToBranch(v0 context.Context, v1 api.RepoName, v2 string, v3 bool, v4 *time.Time) (map[string]time.Time, error) {
+	r0, r1 := m.CommitsUniqueToBranchFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.CommitsUniqueToBranchFunc.appendCall(ClientCommitsUniqueToBranchFuncCall{v0, v1, v2, v3, v4, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the HasCommitAfter
-// method of the parent MockClient instance is invoked and the hook queue is
-// empty.
-func (f *ClientHasCommitAfterFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) (bool, error)) {
+// SetDefaultHook sets function that is called when the
+// CommitsUniqueToBranch method of the parent MockClient instance is invoked
+// and the hook queue is empty.
+func (f *ClientCommitsUniqueToBranchFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// HasCommitAfter method of the parent MockClient instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ClientHasCommitAfterFunc) PushHook(hook func(context.Context, api.RepoName, string, string) (bool, error)) {
+// CommitsUniqueToBranch method of the parent MockClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ClientCommitsUniqueToBranchFunc) PushHook(hook func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2568,20 +3720,20 @@ func (f *ClientHasCommitAfterFunc) PushHook(hook func(context.Context, api.RepoN
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientHasCommitAfterFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) (bool, error) {
+func (f *ClientCommitsUniqueToBranchFunc) SetDefaultReturn(r0 map[string]time.Time, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientHasCommitAfterFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, string, string) (bool, error) {
+func (f *ClientCommitsUniqueToBranchFunc) PushReturn(r0 map[string]time.Time, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientHasCommitAfterFunc) nextHook() func(context.Context, api.RepoName, string, string) (bool, error) {
+func (f *ClientCommitsUniqueToBranchFunc) nextHook() func(context.Context, api.RepoName, string, bool, *time.Time) (map[string]time.Time, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2594,26 +3746,26 @@ func (f *ClientHasCommitAfterFunc) nextHook() func(context.Context, api.RepoName
 	return hook
 }
 
-func (f *ClientHasCommitAfterFunc) appendCall(r0 ClientHasCommitAfterFuncCall) {
+func (f *ClientCommitsUniqueToBranchFunc) appendCall(r0 ClientCommitsUniqueToBranchFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientHasCommitAfterFuncCall objects
+// History returns a sequence of ClientCommitsUniqueToBranchFuncCall objects
 // describing the invocations of this function.
-func (f *ClientHasCommitAfterFunc) History() []ClientHasCommitAfterFuncCall {
+func (f *ClientCommitsUniqueToBranchFunc) History() []ClientCommitsUniqueToBranchFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientHasCommitAfterFuncCall, len(f.history))
+	history := make([]ClientCommitsUniqueToBranchFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientHasCommitAfterFuncCall is an object that describes an invocation of
-// method HasCommitAfter on an instance of MockClient.
-type ClientHasCommitAfterFuncCall struct {
+// ClientCommitsUniqueToBranchFuncCall is an object that describes an
+// invocation of method CommitsUniqueToBranch on an instance of MockClient.
+type ClientCommitsUniqueToBranchFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -2625,10 +3777,13 @@ type ClientHasCommitAfterFuncCall struct {
 	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 string
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 *time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 map[string]time.Time
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2636,46 +3791,45 @@ type ClientHasCommitAfterFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientHasCommitAfterFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c ClientCommitsUniqueToBranchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientHasCommitAfterFuncCall) Results() []interface{} {
+func (c ClientCommitsUniqueToBranchFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientIsPerforcePathCloneableFunc describes the behavior when the
-// IsPerforcePathCloneable method of the parent MockClient instance is
-// invoked.
-type ClientIsPerforcePathCloneableFunc struct {
-	defaultHook func(context.Context, protocol.PerforceConnectionDetails, string) error
-	hooks       []func(context.Context, protocol.PerforceConnectionDetails, string) error
-	history     []ClientIsPerforcePathCloneableFuncCall
+// ClientContributorCountFunc describes the behavior when the
+// ContributorCount method of the parent MockClient instance is invoked.
+type ClientContributorCountFunc struct {
+	defaultHook func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error)
+	hooks       []func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error)
+	history     []ClientContributorCountFuncCall
 	mutex       sync.Mutex
 }
 
-// IsPerforcePathCloneable delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockClient) IsPerforcePathCloneable(v0 context.Context, v1 protocol.PerforceConnectionDetails, v2 string) error {
-	r0 := m.IsPerforcePathCloneableFunc.nextHook()(v0, v1, v2)
-	m.IsPerforcePathCloneableFunc.appendCall(ClientIsPerforcePathCloneableFuncCall{v0, v1, v2, r0})
-	return r0
+// ContributorCount delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) ContributorCount(v0 context.Context, v1 api.RepoName, v2 ContributorOptions) ([]*gitdomain.ContributorCount, error) {
+	r0, r1 := m.ContributorCountFunc.nextHook()(v0, v1, v2)
+	m.ContributorCountFunc.appendCall(ClientContributorCountFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// IsPerforcePathCloneable method of the parent MockClient instance is
-// invoked and the hook queue is empty.
-func (f *ClientIsPerforcePathCloneableFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) error) {
+// SetDefaultHook sets function that is called when the ContributorCount
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientContributorCountFunc) SetDefaultHook(hook func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IsPerforcePathCloneable method of the parent MockClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *ClientIsPerforcePathCloneableFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) error) {
+// ContributorCount method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientContributorCountFunc) PushHook(hook func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2683,20 +3837,20 @@ func (f *ClientIsPerforcePathCloneableFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientIsPerforcePathCloneableFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails, string) error {
-		return r0
+func (f *ClientContributorCountFunc) SetDefaultReturn(r0 []*gitdomain.ContributorCount, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientIsPerforcePathCloneableFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails, string) error {
-		return r0
+func (f *ClientContributorCountFunc) PushReturn(r0 []*gitdomain.ContributorCount, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error) {
+		return r0, r1
 	})
 }
 
-func (f *ClientIsPerforcePathCloneableFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails, string) error {
+func (f *ClientContributorCountFunc) nextHook() func(context.Context, api.RepoName, ContributorOptions) ([]*gitdomain.ContributorCount, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2709,82 +3863,83 @@ func (f *ClientIsPerforcePathCloneableFunc) nextHook() func(context.Context, pro
 	return hook
 }
 
-func (f *ClientIsPerforcePathCloneableFunc) appendCall(r0 ClientIsPerforcePathCloneableFuncCall) {
+func (f *ClientContributorCountFunc) appendCall(r0 ClientContributorCountFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientIsPerforcePathCloneableFuncCall
-// objects describing the invocations of this function.
-func (f *ClientIsPerforcePathCloneableFunc) History() []ClientIsPerforcePathCloneableFuncCall {
+// History returns a sequence of ClientContributorCountFuncCall objects
+// describing the invocations of this function.
+func (f *ClientContributorCountFunc) History() []ClientContributorCountFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientIsPerforcePathCloneableFuncCall, len(f.history))
+	history := make([]ClientContributorCountFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientIsPerforcePathCloneableFuncCall is an object that describes an
-// invocation of method IsPerforcePathCloneable on an instance of
-// MockClient.
-type ClientIsPerforcePathCloneableFuncCall struct {
+// ClientContributorCountFuncCall is an object that describes an invocation
+// of method ContributorCount on an instance of MockClient.
+type ClientContributorCountFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 protocol.PerforceConnectionDetails
+	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
+	Arg2 ContributorOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []*gitdomain.ContributorCount
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientIsPerforcePathCloneableFuncCall) Args() []interface{} {
+func (c ClientContributorCountFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientIsPerforcePathCloneableFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c ClientContributorCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientIsPerforceSuperUserFunc describes the behavior when the
-// IsPerforceSuperUser method of the parent MockClient instance is invoked.
-type ClientIsPerforceSuperUserFunc struct {
-	defaultHook func(context.Context, protocol.PerforceConnectionDetails) error
-	hooks       []func(context.Context, protocol.PerforceConnectionDetails) error
-	history     []ClientIsPerforceSuperUserFuncCall
+// ClientCountCommitsFunc describes the behavior when the CountCommits
+// method of the parent MockClient instance is invoked.
+type ClientCountCommitsFunc struct {
+	defaultHook func(context.Context, api.RepoName, CommitsOptions) (int, error)
+	hooks       []func(context.Context, api.RepoName, CommitsOptions) (int, error)
+	history     []ClientCountCommitsFuncCall
 	mutex       sync.Mutex
 }
 
-// IsPerforceSuperUser delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockClient) IsPerforceSuperUser(v0 context.Context, v1 protocol.PerforceConnectionDetails) error {
-	r0 := m.IsPerforceSuperUserFunc.nextHook()(v0, v1)
-	m.IsPerforceSuperUserFunc.appendCall(ClientIsPerforceSuperUserFuncCall{v0, v1, r0})
-	return r0
+// CountCommits delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) CountCommits(v0 context.Context, v1 api.RepoName, v2 CommitsOptions) (int, error) {
+	r0, r1 := m.CountCommitsFunc.nextHook()(v0, v1, v2)
+	m.CountCommitsFunc.appendCall(ClientCountCommitsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the IsPerforceSuperUser
-// method of the parent MockClient instance is invoked and the hook queue is
-// empty.
-func (f *ClientIsPerforceSuperUserFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails) error) {
+// SetDefaultHook sets function that is called when the CountCommits method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientCountCommitsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, CommitsOptions) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IsPerforceSuperUser method of the parent MockClient instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *ClientIsPerforceSuperUserFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails) error) {
+// CountCommits method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientCountCommitsFunc) PushHook(hook func(context.Context, api.RepoName, CommitsOptions) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2792,20 +3947,20 @@ func (f *ClientIsPerforceSuperUserFunc) PushHook(hook func(context.Context, prot
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientIsPerforceSuperUserFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails) error {
-		return r0
+func (f *ClientCountCommitsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, CommitsOptions) (int, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientIsPerforceSuperUserFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails) error {
-		return r0
+func (f *ClientCountCommitsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, CommitsOptions) (int, error) {
+		return r0, r1
 	})
 }
 
-func (f *ClientIsPerforceSuperUserFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails) error {
+func (f *ClientCountCommitsFunc) nextHook() func(context.Context, api.RepoName, CommitsOptions) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2818,78 +3973,7209 @@ func (f *ClientIsPerforceSuperUserFunc) nextHook() func(context.Context, protoco
 	return hook
 }
 
-func (f *ClientIsPerforceSuperUserFunc) appendCall(r0 ClientIsPerforceSuperUserFuncCall) {
+func (f *ClientCountCommitsFunc) appendCall(r0 ClientCountCommitsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientIsPerforceSuperUserFuncCall objects
+// History returns a sequence of ClientCountCommitsFuncCall objects
 // describing the invocations of this function.
-func (f *ClientIsPerforceSuperUserFunc) History() []ClientIsPerforceSuperUserFuncCall {
+func (f *ClientCountCommitsFunc) History() []ClientCountCommitsFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientIsPerforceSuperUserFuncCall, len(f.history))
+	history := make([]ClientCountCommitsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientIsPerforceSuperUserFuncCall is an object that describes an
-// invocation of method IsPerforceSuperUser on an instance of MockClient.
-type ClientIsPerforceSuperUserFuncCall struct {
+// ClientCountCommitsFuncCall is an object that describes an invocation of
+// method CountCommits on an instance of MockClient.
+type ClientCountCommitsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 protocol.PerforceConnectionDetails
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 CommitsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientIsPerforceSuperUserFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ClientCountCommitsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientIsPerforceSuperUserFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c ClientCountCommitsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientIsRepoCloneableFunc describes the behavior when the IsRepoCloneable
+// ClientCreateBranchFunc describes the behavior when the CreateBranch
 // method of the parent MockClient instance is invoked.
-type ClientIsRepoCloneableFunc struct {
-	defaultHook func(context.Context, api.RepoName) error
-	hooks       []func(context.Context, api.RepoName) error
-	history     []ClientIsRepoCloneableFuncCall
+type ClientCreateBranchFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, string) (api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, string, string) (api.CommitID, error)
+	history     []ClientCreateBranchFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateBranch delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) CreateBranch(v0 context.Context, v1 api.RepoName, v2 string, v3 string) (api.CommitID, error) {
+	r0, r1 := m.CreateBranchFunc.nextHook()(v0, v1, v2, v3)
+	m.CreateBranchFunc.appendCall(ClientCreateBranchFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreateBranch method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientCreateBranchFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) (api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateBranch method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientCreateBranchFunc) PushHook(hook func(context.Context, api.RepoName, string, string) (api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientCreateBranchFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientCreateBranchFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, string) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientCreateBranchFunc) nextHook() func(context.Context, api.RepoName, string, string) (api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientCreateBranchFunc) appendCall(r0 ClientCreateBranchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientCreateBranchFuncCall objects
+// describing the invocations of this function.
+func (f *ClientCreateBranchFunc) History() []ClientCreateBranchFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientCreateBranchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientCreateBranchFuncCall is an object that describes an invocation of
+// method CreateBranch on an instance of MockClient.
+type ClientCreateBranchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientCreateBranchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientCreateBranchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientCreateBundleFunc describes the behavior when the CreateBundle
+// method of the parent MockClient instance is invoked.
+type ClientCreateBundleFunc struct {
+	defaultHook func(context.Context, api.RepoName, []string, io.Writer) error
+	hooks       []func(context.Context, api.RepoName, []string, io.Writer) error
+	history     []ClientCreateBundleFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateBundle delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) CreateBundle(v0 context.Context, v1 api.RepoName, v2 []string, v3 io.Writer) error {
+	r0 := m.CreateBundleFunc.nextHook()(v0, v1, v2, v3)
+	m.CreateBundleFunc.appendCall(ClientCreateBundleFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the CreateBundle method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientCreateBundleFunc) SetDefaultHook(hook func(context.Context, api.RepoName, []string, io.Writer) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateBundle method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientCreateBundleFunc) PushHook(hook func(context.Context, api.RepoName, []string, io.Writer) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientCreateBundleFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, []string, io.Writer) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientCreateBundleFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, []string, io.Writer) error {
+		return r0
+	})
+}
+
+func (f *ClientCreateBundleFunc) nextHook() func(context.Context, api.RepoName, []string, io.Writer) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientCreateBundleFunc) appendCall(r0 ClientCreateBundleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientCreateBundleFuncCall objects
+// describing the invocations of this function.
+func (f *ClientCreateBundleFunc) History() []ClientCreateBundleFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientCreateBundleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientCreateBundleFuncCall is an object that describes an invocation of
+// method CreateBundle on an instance of MockClient.
+type ClientCreateBundleFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 io.Writer
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientCreateBundleFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientCreateBundleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ClientCreateCommitFromPatchFunc describes the behavior when the
+// CreateCommitFromPatch method of the parent MockClient instance is
+// invoked.
+type ClientCreateCommitFromPatchFunc struct {
+	defaultHook func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error)
+	hooks       []func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error)
+	history     []ClientCreateCommitFromPatchFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateCommitFromPatch delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockClient) CreateCommitFromPatch(v0 context.Context, v1 protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+	r0, r1 := m.CreateCommitFromPatchFunc.nextHook()(v0, v1)
+	m.CreateCommitFromPatchFunc.appendCall(ClientCreateCommitFromPatchFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateCommitFromPatch method of the parent MockClient instance is invoked
+// and the hook queue is empty.
+func (f *ClientCreateCommitFromPatchFunc) SetDefaultHook(hook func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateCommitFromPatch method of the parent MockClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ClientCreateCommitFromPatchFunc) PushHook(hook func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientCreateCommitFromPatchFunc) SetDefaultReturn(r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientCreateCommitFromPatchFunc) PushReturn(r0 *protocol.CreateCommitFromPatchResponse, r1 error) {
+	f.PushHook(func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientCreateCommitFromPatchFunc) nextHook() func(context.Context, protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientCreateCommitFromPatchFunc) appendCall(r0 ClientCreateCommitFromPatchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientCreateCommitFromPatchFuncCall objects
+// describing the invocations of this function.
+func (f *ClientCreateCommitFromPatchFunc) History() []ClientCreateCommitFromPatchFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientCreateCommitFromPatchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientCreateCommitFromPatchFuncCall is an object that describes an
+// invocation of method CreateCommitFromPatch on an instance of MockClient.
+type ClientCreateCommitFromPatchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 protocol.CreateCommitFromPatchRequest
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *protocol.CreateCommitFromPatchResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientCreateCommitFromPatchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientCreateCommitFromPatchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientCreateTagFunc describes the behavior when the CreateTag method of
+// the parent MockClient instance is invoked.
+type ClientCreateTagFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, string, TagOptions) error
+	hooks       []func(context.Context, api.RepoName, string, string, TagOptions) error
+	history     []ClientCreateTagFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateTag delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) CreateTag(v0 context.Context, v1 api.RepoName, v2 string, v3 string, v4 TagOptions) error {
+	r0 := m.CreateTagFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.CreateTagFunc.appendCall(ClientCreateTagFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the CreateTag method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientCreateTagFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string, TagOptions) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateTag method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientCreateTagFunc) PushHook(hook func(context.Context, api.RepoName, string, string, TagOptions) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientCreateTagFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, string, TagOptions) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientCreateTagFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, string, TagOptions) error {
+		return r0
+	})
+}
+
+func (f *ClientCreateTagFunc) nextHook() func(context.Context, api.RepoName, string, string, TagOptions) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientCreateTagFunc) appendCall(r0 ClientCreateTagFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientCreateTagFuncCall objects describing
+// the invocations of this function.
+func (f *ClientCreateTagFunc) History() []ClientCreateTagFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientCreateTagFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientCreateTagFuncCall is an object that describes an invocation of
+// method CreateTag on an instance of MockClient.
+type ClientCreateTagFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 TagOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientCreateTagFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientCreateTagFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ClientDeleteBranchFunc describes the behavior when the DeleteBranch
+// method of the parent MockClient instance is invoked.
+type ClientDeleteBranchFunc struct {
+	defaultHook func(context.Context, api.RepoName, string) error
+	hooks       []func(context.Context, api.RepoName, string) error
+	history     []ClientDeleteBranchFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteBranch delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) DeleteBranch(v0 context.Context, v1 api.RepoName, v2 string) error {
+	r0 := m.DeleteBranchFunc.nextHook()(v0, v1, v2)
+	m.DeleteBranchFunc.appendCall(ClientDeleteBranchFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteBranch method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientDeleteBranchFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteBranch method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientDeleteBranchFunc) PushHook(hook func(context.Context, api.RepoName, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientDeleteBranchFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientDeleteBranchFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, string) error {
+		return r0
+	})
+}
+
+func (f *ClientDeleteBranchFunc) nextHook() func(context.Context, api.RepoName, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientDeleteBranchFunc) appendCall(r0 ClientDeleteBranchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientDeleteBranchFuncCall objects
+// describing the invocations of this function.
+func (f *ClientDeleteBranchFunc) History() []ClientDeleteBranchFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientDeleteBranchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientDeleteBranchFuncCall is an object that describes an invocation of
+// method DeleteBranch on an instance of MockClient.
+type ClientDeleteBranchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientDeleteBranchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientDeleteBranchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ClientDiagnoseAddrForRepoFunc describes the behavior when the
+// DiagnoseAddrForRepo method of the parent MockClient instance is invoked.
+type ClientDiagnoseAddrForRepoFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*RepoAddrDiagnostics, error)
+	hooks       []func(context.Context, api.RepoName) (*RepoAddrDiagnostics, error)
+	history     []ClientDiagnoseAddrForRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// DiagnoseAddrForRepo delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) DiagnoseAddrForRepo(v0 context.Context, v1 api.RepoName) (*RepoAddrDiagnostics, error) {
+	r0, r1 := m.DiagnoseAddrForRepoFunc.nextHook()(v0, v1)
+	m.DiagnoseAddrForRepoFunc.appendCall(ClientDiagnoseAddrForRepoFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the DiagnoseAddrForRepo
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientDiagnoseAddrForRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*RepoAddrDiagnostics, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DiagnoseAddrForRepo method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientDiagnoseAddrForRepoFunc) PushHook(hook func(context.Context, api.RepoName) (*RepoAddrDiagnostics, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientDiagnoseAddrForRepoFunc) SetDefaultReturn(r0 *RepoAddrDiagnostics, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*RepoAddrDiagnostics, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientDiagnoseAddrForRepoFunc) PushReturn(r0 *RepoAddrDiagnostics, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*RepoAddrDiagnostics, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientDiagnoseAddrForRepoFunc) nextHook() func(context.Context, api.RepoName) (*RepoAddrDiagnostics, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientDiagnoseAddrForRepoFunc) appendCall(r0 ClientDiagnoseAddrForRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientDiagnoseAddrForRepoFuncCall objects
+// describing the invocations of this function.
+func (f *ClientDiagnoseAddrForRepoFunc) History() []ClientDiagnoseAddrForRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientDiagnoseAddrForRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientDiagnoseAddrForRepoFuncCall is an object that describes an
+// invocation of method DiagnoseAddrForRepo on an instance of MockClient.
+type ClientDiagnoseAddrForRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *RepoAddrDiagnostics
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientDiagnoseAddrForRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientDiagnoseAddrForRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientDiffFunc describes the behavior when the Diff method of the parent
+// MockClient instance is invoked.
+type ClientDiffFunc struct {
+	defaultHook func(context.Context, DiffOptions) (*DiffFileIterator, error)
+	hooks       []func(context.Context, DiffOptions) (*DiffFileIterator, error)
+	history     []ClientDiffFuncCall
+	mutex       sync.Mutex
+}
+
+// Diff delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) Diff(v0 context.Context, v1 DiffOptions) (*DiffFileIterator, error) {
+	r0, r1 := m.DiffFunc.nextHook()(v0, v1)
+	m.DiffFunc.appendCall(ClientDiffFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Diff method of the
+// parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientDiffFunc) SetDefaultHook(hook func(context.Context, DiffOptions) (*DiffFileIterator, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Diff method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientDiffFunc) PushHook(hook func(context.Context, DiffOptions) (*DiffFileIterator, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientDiffFunc) SetDefaultReturn(r0 *DiffFileIterator, r1 error) {
+	f.SetDefaultHook(func(context.Context, DiffOptions) (*DiffFileIterator, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientDiffFunc) PushReturn(r0 *DiffFileIterator, r1 error) {
+	f.PushHook(func(context.Context, DiffOptions) (*DiffFileIterator, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientDiffFunc) nextHook() func(context.Context, DiffOptions) (*DiffFileIterator, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientDiffFunc) appendCall(r0 ClientDiffFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientDiffFuncCall objects describing the
+// invocations of this function.
+func (f *ClientDiffFunc) History() []ClientDiffFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientDiffFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientDiffFuncCall is an object that describes an invocation of method
+// Diff on an instance of MockClient.
+type ClientDiffFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 DiffOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *DiffFileIterator
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientDiffFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientDiffFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientDiffCrossRepoFunc describes the behavior when the DiffCrossRepo
+// method of the parent MockClient instance is invoked.
+type ClientDiffCrossRepoFunc struct {
+	defaultHook func(context.Context, api.RepoName, DiffOptions) (*DiffFileIterator, error)
+	hooks       []func(context.Context, api.RepoName, DiffOptions) (*DiffFileIterator, error)
+	history     []ClientDiffCrossRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// DiffCrossRepo delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) DiffCrossRepo(v0 context.Context, v1 api.RepoName, v2 DiffOptions) (*DiffFileIterator, error) {
+	r0, r1 := m.DiffCrossRepoFunc.nextHook()(v0, v1, v2)
+	m.DiffCrossRepoFunc.appendCall(ClientDiffCrossRepoFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the DiffCrossRepo method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientDiffCrossRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoName, DiffOptions) (*DiffFileIterator, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DiffCrossRepo method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientDiffCrossRepoFunc) PushHook(hook func(context.Context, api.RepoName, DiffOptions) (*DiffFileIterator, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientDiffCrossRepoFunc) SetDefaultReturn(r0 *DiffFileIterator, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, DiffOptions) (*DiffFileIterator, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientDiffCrossRepoFunc) PushReturn(r0 *DiffFileIterator, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, DiffOptions) (*DiffFileIterator, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientDiffCrossRepoFunc) nextHook() func(context.Context, api.RepoName, DiffOptions) (*DiffFileIterator, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientDiffCrossRepoFunc) appendCall(r0 ClientDiffCrossRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientDiffCrossRepoFuncCall objects
+// describing the invocations of this function.
+func (f *ClientDiffCrossRepoFunc) History() []ClientDiffCrossRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientDiffCrossRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientDiffCrossRepoFuncCall is an object that describes an invocation of
+// method DiffCrossRepo on an instance of MockClient.
+type ClientDiffCrossRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 DiffOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *DiffFileIterator
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientDiffCrossRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientDiffCrossRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientDiffSymbolsFunc describes the behavior when the DiffSymbols method
+// of the parent MockClient instance is invoked.
+type ClientDiffSymbolsFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error)
+	history     []ClientDiffSymbolsFuncCall
+	mutex       sync.Mutex
+}
+
+// DiffSymbols delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) DiffSymbols(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 api.CommitID) ([]byte, error) {
+	r0, r1 := m.DiffSymbolsFunc.nextHook()(v0, v1, v2, v3)
+	m.DiffSymbolsFunc.appendCall(ClientDiffSymbolsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the DiffSymbols method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientDiffSymbolsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DiffSymbols method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientDiffSymbolsFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientDiffSymbolsFunc) SetDefaultReturn(r0 []byte, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientDiffSymbolsFunc) PushReturn(r0 []byte, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientDiffSymbolsFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, api.CommitID) ([]byte, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientDiffSymbolsFunc) appendCall(r0 ClientDiffSymbolsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientDiffSymbolsFuncCall objects
+// describing the invocations of this function.
+func (f *ClientDiffSymbolsFunc) History() []ClientDiffSymbolsFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientDiffSymbolsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientDiffSymbolsFuncCall is an object that describes an invocation of
+// method DiffSymbols on an instance of MockClient.
+type ClientDiffSymbolsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []byte
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientDiffSymbolsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientDiffSymbolsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientDirectoryHistoryFunc describes the behavior when the
+// DirectoryHistory method of the parent MockClient instance is invoked.
+type ClientDirectoryHistoryFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, string, DirectoryHistoryOptions) ([]*gitdomain.Commit, string, error)
+	hooks       []func(context.Context, api.RepoName, string, string, DirectoryHistoryOptions) ([]*gitdomain.Commit, string, error)
+	history     []ClientDirectoryHistoryFuncCall
+	mutex       sync.Mutex
+}
+
+// DirectoryHistory delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) DirectoryHistory(v0 context.Context, v1 api.RepoName, v2 string, v3 string, v4 DirectoryHistoryOptions) ([]*gitdomain.Commit, string, error) {
+	r0, r1, r2 := m.DirectoryHistoryFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.DirectoryHistoryFunc.appendCall(ClientDirectoryHistoryFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the DirectoryHistory
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientDirectoryHistoryFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string, DirectoryHistoryOptions) ([]*gitdomain.Commit, string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DirectoryHistory method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientDirectoryHistoryFunc) PushHook(hook func(context.Context, api.RepoName, string, string, DirectoryHistoryOptions) ([]*gitdomain.Commit, string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientDirectoryHistoryFunc) SetDefaultReturn(r0 []*gitdomain.Commit, r1 string, r2 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, string, DirectoryHistoryOptions) ([]*gitdomain.Commit, string, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientDirectoryHistoryFunc) PushReturn(r0 []*gitdomain.Commit, r1 string, r2 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, string, DirectoryHistoryOptions) ([]*gitdomain.Commit, string, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *ClientDirectoryHistoryFunc) nextHook() func(context.Context, api.RepoName, string, string, DirectoryHistoryOptions) ([]*gitdomain.Commit, string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientDirectoryHistoryFunc) appendCall(r0 ClientDirectoryHistoryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientDirectoryHistoryFuncCall objects
+// describing the invocations of this function.
+func (f *ClientDirectoryHistoryFunc) History() []ClientDirectoryHistoryFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientDirectoryHistoryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientDirectoryHistoryFuncCall is an object that describes an invocation
+// of method DirectoryHistory on an instance of MockClient.
+type ClientDirectoryHistoryFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 DirectoryHistoryOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitdomain.Commit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 string
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientDirectoryHistoryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientDirectoryHistoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// ClientEnsureRevisionFunc describes the behavior when the EnsureRevision
+// method of the parent MockClient instance is invoked.
+type ClientEnsureRevisionFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, WaitOptions) (*EnsureRevisionResult, error)
+	hooks       []func(context.Context, api.RepoName, string, WaitOptions) (*EnsureRevisionResult, error)
+	history     []ClientEnsureRevisionFuncCall
+	mutex       sync.Mutex
+}
+
+// EnsureRevision delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) EnsureRevision(v0 context.Context, v1 api.RepoName, v2 string, v3 WaitOptions) (*EnsureRevisionResult, error) {
+	r0, r1 := m.EnsureRevisionFunc.nextHook()(v0, v1, v2, v3)
+	m.EnsureRevisionFunc.appendCall(ClientEnsureRevisionFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the EnsureRevision
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientEnsureRevisionFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, WaitOptions) (*EnsureRevisionResult, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// EnsureRevision method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientEnsureRevisionFunc) PushHook(hook func(context.Context, api.RepoName, string, WaitOptions) (*EnsureRevisionResult, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientEnsureRevisionFunc) SetDefaultReturn(r0 *EnsureRevisionResult, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, WaitOptions) (*EnsureRevisionResult, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientEnsureRevisionFunc) PushReturn(r0 *EnsureRevisionResult, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, WaitOptions) (*EnsureRevisionResult, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientEnsureRevisionFunc) nextHook() func(context.Context, api.RepoName, string, WaitOptions) (*EnsureRevisionResult, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientEnsureRevisionFunc) appendCall(r0 ClientEnsureRevisionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientEnsureRevisionFuncCall objects
+// describing the invocations of this function.
+func (f *ClientEnsureRevisionFunc) History() []ClientEnsureRevisionFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientEnsureRevisionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientEnsureRevisionFuncCall is an object that describes an invocation of
+// method EnsureRevision on an instance of MockClient.
+type ClientEnsureRevisionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 WaitOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *EnsureRevisionResult
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientEnsureRevisionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientEnsureRevisionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientExecInWorktreeFunc describes the behavior when the ExecInWorktree
+// method of the parent MockClient instance is invoked.
+type ClientExecInWorktreeFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error)
+	history     []ClientExecInWorktreeFuncCall
+	mutex       sync.Mutex
+}
+
+// ExecInWorktree delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) ExecInWorktree(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 []string, v4 []byte) (gitdomain.WorktreeExecResult, error) {
+	r0, r1 := m.ExecInWorktreeFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.ExecInWorktreeFunc.appendCall(ClientExecInWorktreeFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ExecInWorktree
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientExecInWorktreeFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ExecInWorktree method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientExecInWorktreeFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientExecInWorktreeFunc) SetDefaultReturn(r0 gitdomain.WorktreeExecResult, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientExecInWorktreeFunc) PushReturn(r0 gitdomain.WorktreeExecResult, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientExecInWorktreeFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, []string, []byte) (gitdomain.WorktreeExecResult, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientExecInWorktreeFunc) appendCall(r0 ClientExecInWorktreeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientExecInWorktreeFuncCall objects
+// describing the invocations of this function.
+func (f *ClientExecInWorktreeFunc) History() []ClientExecInWorktreeFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientExecInWorktreeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientExecInWorktreeFuncCall is an object that describes an invocation of
+// method ExecInWorktree on an instance of MockClient.
+type ClientExecInWorktreeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 []byte
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gitdomain.WorktreeExecResult
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientExecInWorktreeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientExecInWorktreeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientFetchRefspecFunc describes the behavior when the FetchRefspec
+// method of the parent MockClient instance is invoked.
+type ClientFetchRefspecFunc struct {
+	defaultHook func(context.Context, api.RepoName, string) error
+	hooks       []func(context.Context, api.RepoName, string) error
+	history     []ClientFetchRefspecFuncCall
+	mutex       sync.Mutex
+}
+
+// FetchRefspec delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) FetchRefspec(v0 context.Context, v1 api.RepoName, v2 string) error {
+	r0 := m.FetchRefspecFunc.nextHook()(v0, v1, v2)
+	m.FetchRefspecFunc.appendCall(ClientFetchRefspecFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the FetchRefspec method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientFetchRefspecFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FetchRefspec method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientFetchRefspecFunc) PushHook(hook func(context.Context, api.RepoName, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientFetchRefspecFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientFetchRefspecFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, string) error {
+		return r0
+	})
+}
+
+func (f *ClientFetchRefspecFunc) nextHook() func(context.Context, api.RepoName, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientFetchRefspecFunc) appendCall(r0 ClientFetchRefspecFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientFetchRefspecFuncCall objects
+// describing the invocations of this function.
+func (f *ClientFetchRefspecFunc) History() []ClientFetchRefspecFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientFetchRefspecFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientFetchRefspecFuncCall is an object that describes an invocation of
+// method FetchRefspec on an instance of MockClient.
+type ClientFetchRefspecFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientFetchRefspecFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientFetchRefspecFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ClientFirstCommitForPathFunc describes the behavior when the
+// FirstCommitForPath method of the parent MockClient instance is invoked.
+type ClientFirstCommitForPathFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, string) (*gitdomain.Commit, error)
+	hooks       []func(context.Context, api.RepoName, string, string) (*gitdomain.Commit, error)
+	history     []ClientFirstCommitForPathFuncCall
+	mutex       sync.Mutex
+}
+
+// FirstCommitForPath delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) FirstCommitForPath(v0 context.Context, v1 api.RepoName, v2 string, v3 string) (*gitdomain.Commit, error) {
+	r0, r1 := m.FirstCommitForPathFunc.nextHook()(v0, v1, v2, v3)
+	m.FirstCommitForPathFunc.appendCall(ClientFirstCommitForPathFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FirstCommitForPath
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientFirstCommitForPathFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) (*gitdomain.Commit, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FirstCommitForPath method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientFirstCommitForPathFunc) PushHook(hook func(context.Context, api.RepoName, string, string) (*gitdomain.Commit, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientFirstCommitForPathFunc) SetDefaultReturn(r0 *gitdomain.Commit, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) (*gitdomain.Commit, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientFirstCommitForPathFunc) PushReturn(r0 *gitdomain.Commit, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, string) (*gitdomain.Commit, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientFirstCommitForPathFunc) nextHook() func(context.Context, api.RepoName, string, string) (*gitdomain.Commit, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientFirstCommitForPathFunc) appendCall(r0 ClientFirstCommitForPathFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientFirstCommitForPathFuncCall objects
+// describing the invocations of this function.
+func (f *ClientFirstCommitForPathFunc) History() []ClientFirstCommitForPathFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientFirstCommitForPathFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientFirstCommitForPathFuncCall is an object that describes an
+// invocation of method FirstCommitForPath on an instance of MockClient.
+type ClientFirstCommitForPathFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitdomain.Commit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientFirstCommitForPathFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientFirstCommitForPathFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientFirstEverCommitFunc describes the behavior when the FirstEverCommit
+// method of the parent MockClient instance is invoked.
+type ClientFirstEverCommitFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*gitdomain.Commit, error)
+	hooks       []func(context.Context, api.RepoName) (*gitdomain.Commit, error)
+	history     []ClientFirstEverCommitFuncCall
+	mutex       sync.Mutex
+}
+
+// FirstEverCommit delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) FirstEverCommit(v0 context.Context, v1 api.RepoName) (*gitdomain.Commit, error) {
+	r0, r1 := m.FirstEverCommitFunc.nextHook()(v0, v1)
+	m.FirstEverCommitFunc.appendCall(ClientFirstEverCommitFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FirstEverCommit
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientFirstEverCommitFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*gitdomain.Commit, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FirstEverCommit method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientFirstEverCommitFunc) PushHook(hook func(context.Context, api.RepoName) (*gitdomain.Commit, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientFirstEverCommitFunc) SetDefaultReturn(r0 *gitdomain.Commit, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*gitdomain.Commit, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientFirstEverCommitFunc) PushReturn(r0 *gitdomain.Commit, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*gitdomain.Commit, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientFirstEverCommitFunc) nextHook() func(context.Context, api.RepoName) (*gitdomain.Commit, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientFirstEverCommitFunc) appendCall(r0 ClientFirstEverCommitFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientFirstEverCommitFuncCall objects
+// describing the invocations of this function.
+func (f *ClientFirstEverCommitFunc) History() []ClientFirstEverCommitFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientFirstEverCommitFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientFirstEverCommitFuncCall is an object that describes an invocation
+// of method FirstEverCommit on an instance of MockClient.
+type ClientFirstEverCommitFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitdomain.Commit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientFirstEverCommitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientFirstEverCommitFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientFormatPatchFunc describes the behavior when the FormatPatch method
+// of the parent MockClient instance is invoked.
+type ClientFormatPatchFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, string) (io.ReadCloser, error)
+	hooks       []func(context.Context, api.RepoName, string, string) (io.ReadCloser, error)
+	history     []ClientFormatPatchFuncCall
+	mutex       sync.Mutex
+}
+
+// FormatPatch delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) FormatPatch(v0 context.Context, v1 api.RepoName, v2 string, v3 string) (io.ReadCloser, error) {
+	r0, r1 := m.FormatPatchFunc.nextHook()(v0, v1, v2, v3)
+	m.FormatPatchFunc.appendCall(ClientFormatPatchFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FormatPatch method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientFormatPatchFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FormatPatch method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientFormatPatchFunc) PushHook(hook func(context.Context, api.RepoName, string, string) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientFormatPatchFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientFormatPatchFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientFormatPatchFunc) nextHook() func(context.Context, api.RepoName, string, string) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientFormatPatchFunc) appendCall(r0 ClientFormatPatchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientFormatPatchFuncCall objects
+// describing the invocations of this function.
+func (f *ClientFormatPatchFunc) History() []ClientFormatPatchFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientFormatPatchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientFormatPatchFuncCall is an object that describes an invocation of
+// method FormatPatch on an instance of MockClient.
+type ClientFormatPatchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientFormatPatchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientFormatPatchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientGetBehindAheadFunc describes the behavior when the GetBehindAhead
+// method of the parent MockClient instance is invoked.
+type ClientGetBehindAheadFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error)
+	hooks       []func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error)
+	history     []ClientGetBehindAheadFuncCall
+	mutex       sync.Mutex
+}
+
+// GetBehindAhead delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) GetBehindAhead(v0 context.Context, v1 api.RepoName, v2 string, v3 string) (*gitdomain.BehindAhead, error) {
+	r0, r1 := m.GetBehindAheadFunc.nextHook()(v0, v1, v2, v3)
+	m.GetBehindAheadFunc.appendCall(ClientGetBehindAheadFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetBehindAhead
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientGetBehindAheadFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetBehindAhead method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientGetBehindAheadFunc) PushHook(hook func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientGetBehindAheadFunc) SetDefaultReturn(r0 *gitdomain.BehindAhead, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientGetBehindAheadFunc) PushReturn(r0 *gitdomain.BehindAhead, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientGetBehindAheadFunc) nextHook() func(context.Context, api.RepoName, string, string) (*gitdomain.BehindAhead, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientGetBehindAheadFunc) appendCall(r0 ClientGetBehindAheadFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientGetBehindAheadFuncCall objects
+// describing the invocations of this function.
+func (f *ClientGetBehindAheadFunc) History() []ClientGetBehindAheadFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientGetBehindAheadFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientGetBehindAheadFuncCall is an object that describes an invocation of
+// method GetBehindAhead on an instance of MockClient.
+type ClientGetBehindAheadFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitdomain.BehindAhead
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientGetBehindAheadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientGetBehindAheadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientGetCommitFunc describes the behavior when the GetCommit method of
+// the parent MockClient instance is invoked.
+type ClientGetCommitFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error)
+	history     []ClientGetCommitFuncCall
+	mutex       sync.Mutex
+}
+
+// GetCommit delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) GetCommit(v0 context.Context, v1 api.RepoName, v2 api.CommitID) (*gitdomain.Commit, error) {
+	r0, r1 := m.GetCommitFunc.nextHook()(v0, v1, v2)
+	m.GetCommitFunc.appendCall(ClientGetCommitFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetCommit method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientGetCommitFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetCommit method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientGetCommitFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientGetCommitFunc) SetDefaultReturn(r0 *gitdomain.Commit, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientGetCommitFunc) PushReturn(r0 *gitdomain.Commit, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientGetCommitFunc) nextHook() func(context.Context, api.RepoName, api.CommitID) (*gitdomain.Commit, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientGetCommitFunc) appendCall(r0 ClientGetCommitFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientGetCommitFuncCall objects describing
+// the invocations of this function.
+func (f *ClientGetCommitFunc) History() []ClientGetCommitFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientGetCommitFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientGetCommitFuncCall is an object that describes an invocation of
+// method GetCommit on an instance of MockClient.
+type ClientGetCommitFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitdomain.Commit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientGetCommitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientGetCommitFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientGetCommitWithChangesFunc describes the behavior when the
+// GetCommitWithChanges method of the parent MockClient instance is invoked.
+type ClientGetCommitWithChangesFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, GetCommitOptions) (*gitdomain.CommitWithChanges, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, GetCommitOptions) (*gitdomain.CommitWithChanges, error)
+	history     []ClientGetCommitWithChangesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetCommitWithChanges delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) GetCommitWithChanges(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 GetCommitOptions) (*gitdomain.CommitWithChanges, error) {
+	r0, r1 := m.GetCommitWithChangesFunc.nextHook()(v0, v1, v2, v3)
+	m.GetCommitWithChangesFunc.appendCall(ClientGetCommitWithChangesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetCommitWithChanges
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientGetCommitWithChangesFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, GetCommitOptions) (*gitdomain.CommitWithChanges, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetCommitWithChanges method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientGetCommitWithChangesFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, GetCommitOptions) (*gitdomain.CommitWithChanges, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientGetCommitWithChangesFunc) SetDefaultReturn(r0 *gitdomain.CommitWithChanges, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, GetCommitOptions) (*gitdomain.CommitWithChanges, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientGetCommitWithChangesFunc) PushReturn(r0 *gitdomain.CommitWithChanges, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, GetCommitOptions) (*gitdomain.CommitWithChanges, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientGetCommitWithChangesFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, GetCommitOptions) (*gitdomain.CommitWithChanges, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientGetCommitWithChangesFunc) appendCall(r0 ClientGetCommitWithChangesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientGetCommitWithChangesFuncCall objects
+// describing the invocations of this function.
+func (f *ClientGetCommitWithChangesFunc) History() []ClientGetCommitWithChangesFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientGetCommitWithChangesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientGetCommitWithChangesFuncCall is an object that describes an
+// invocation of method GetCommitWithChanges on an instance of MockClient.
+type ClientGetCommitWithChangesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 GetCommitOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitdomain.CommitWithChanges
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientGetCommitWithChangesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientGetCommitWithChangesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientGetDefaultBranchFunc describes the behavior when the
+// GetDefaultBranch method of the parent MockClient instance is invoked.
+type ClientGetDefaultBranchFunc struct {
+	defaultHook func(context.Context, api.RepoName, bool) (string, api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, bool) (string, api.CommitID, error)
+	history     []ClientGetDefaultBranchFuncCall
+	mutex       sync.Mutex
+}
+
+// GetDefaultBranch delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) GetDefaultBranch(v0 context.Context, v1 api.RepoName, v2 bool) (string, api.CommitID, error) {
+	r0, r1, r2 := m.GetDefaultBranchFunc.nextHook()(v0, v1, v2)
+	m.GetDefaultBranchFunc.appendCall(ClientGetDefaultBranchFuncCall{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetDefaultBranch
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientGetDefaultBranchFunc) SetDefaultHook(hook func(context.Context, api.RepoName, bool) (string, api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetDefaultBranch method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientGetDefaultBranchFunc) PushHook(hook func(context.Context, api.RepoName, bool) (string, api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientGetDefaultBranchFunc) SetDefaultReturn(r0 string, r1 api.CommitID, r2 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, bool) (string, api.CommitID, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientGetDefaultBranchFunc) PushReturn(r0 string, r1 api.CommitID, r2 error) {
+	f.PushHook(func(context.Context, api.RepoName, bool) (string, api.CommitID, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *ClientGetDefaultBranchFunc) nextHook() func(context.Context, api.RepoName, bool) (string, api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientGetDefaultBranchFunc) appendCall(r0 ClientGetDefaultBranchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientGetDefaultBranchFuncCall objects
+// describing the invocations of this function.
+func (f *ClientGetDefaultBranchFunc) History() []ClientGetDefaultBranchFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientGetDefaultBranchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientGetDefaultBranchFuncCall is an object that describes an invocation
+// of method GetDefaultBranch on an instance of MockClient.
+type ClientGetDefaultBranchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 api.CommitID
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientGetDefaultBranchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientGetDefaultBranchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// ClientGetObjectFunc describes the behavior when the GetObject method of
+// the parent MockClient instance is invoked.
+type ClientGetObjectFunc struct {
+	defaultHook func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error)
+	hooks       []func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error)
+	history     []ClientGetObjectFuncCall
+	mutex       sync.Mutex
+}
+
+// GetObject delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) GetObject(v0 context.Context, v1 api.RepoName, v2 string) (*gitdomain.GitObject, error) {
+	r0, r1 := m.GetObjectFunc.nextHook()(v0, v1, v2)
+	m.GetObjectFunc.appendCall(ClientGetObjectFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetObject method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientGetObjectFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetObject method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientGetObjectFunc) PushHook(hook func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientGetObjectFunc) SetDefaultReturn(r0 *gitdomain.GitObject, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientGetObjectFunc) PushReturn(r0 *gitdomain.GitObject, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientGetObjectFunc) nextHook() func(context.Context, api.RepoName, string) (*gitdomain.GitObject, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientGetObjectFunc) appendCall(r0 ClientGetObjectFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientGetObjectFuncCall objects describing
+// the invocations of this function.
+func (f *ClientGetObjectFunc) History() []ClientGetObjectFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientGetObjectFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientGetObjectFuncCall is an object that describes an invocation of
+// method GetObject on an instance of MockClient.
+type ClientGetObjectFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitdomain.GitObject
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientGetObjectFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientGetObjectFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientGetTagFunc describes the behavior when the GetTag method of the
+// parent MockClient instance is invoked.
+type ClientGetTagFunc struct {
+	defaultHook func(context.Context, api.RepoName, string) (*gitdomain.Tag, error)
+	hooks       []func(context.Context, api.RepoName, string) (*gitdomain.Tag, error)
+	history     []ClientGetTagFuncCall
+	mutex       sync.Mutex
+}
+
+// GetTag delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) GetTag(v0 context.Context, v1 api.RepoName, v2 string) (*gitdomain.Tag, error) {
+	r0, r1 := m.GetTagFunc.nextHook()(v0, v1, v2)
+	m.GetTagFunc.appendCall(ClientGetTagFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetTag method of the
+// parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientGetTagFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string) (*gitdomain.Tag, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetTag method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientGetTagFunc) PushHook(hook func(context.Context, api.RepoName, string) (*gitdomain.Tag, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientGetTagFunc) SetDefaultReturn(r0 *gitdomain.Tag, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string) (*gitdomain.Tag, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientGetTagFunc) PushReturn(r0 *gitdomain.Tag, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string) (*gitdomain.Tag, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientGetTagFunc) nextHook() func(context.Context, api.RepoName, string) (*gitdomain.Tag, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientGetTagFunc) appendCall(r0 ClientGetTagFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientGetTagFuncCall objects describing the
+// invocations of this function.
+func (f *ClientGetTagFunc) History() []ClientGetTagFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientGetTagFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientGetTagFuncCall is an object that describes an invocation of method
+// GetTag on an instance of MockClient.
+type ClientGetTagFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitdomain.Tag
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientGetTagFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientGetTagFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientGetTreeFunc describes the behavior when the GetTree method of the
+// parent MockClient instance is invoked.
+type ClientGetTreeFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, string) ([]gitdomain.TreeEntry, error)
+	hooks       []func(context.Context, api.RepoName, string, string) ([]gitdomain.TreeEntry, error)
+	history     []ClientGetTreeFuncCall
+	mutex       sync.Mutex
+}
+
+// GetTree delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) GetTree(v0 context.Context, v1 api.RepoName, v2 string, v3 string) ([]gitdomain.TreeEntry, error) {
+	r0, r1 := m.GetTreeFunc.nextHook()(v0, v1, v2, v3)
+	m.GetTreeFunc.appendCall(ClientGetTreeFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetTree method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientGetTreeFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) ([]gitdomain.TreeEntry, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetTree method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientGetTreeFunc) PushHook(hook func(context.Context, api.RepoName, string, string) ([]gitdomain.TreeEntry, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientGetTreeFunc) SetDefaultReturn(r0 []gitdomain.TreeEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) ([]gitdomain.TreeEntry, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientGetTreeFunc) PushReturn(r0 []gitdomain.TreeEntry, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, string) ([]gitdomain.TreeEntry, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientGetTreeFunc) nextHook() func(context.Context, api.RepoName, string, string) ([]gitdomain.TreeEntry, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientGetTreeFunc) appendCall(r0 ClientGetTreeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientGetTreeFuncCall objects describing
+// the invocations of this function.
+func (f *ClientGetTreeFunc) History() []ClientGetTreeFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientGetTreeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientGetTreeFuncCall is an object that describes an invocation of method
+// GetTree on an instance of MockClient.
+type ClientGetTreeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gitdomain.TreeEntry
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientGetTreeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientGetTreeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientHasCommitAfterFunc describes the behavior when the HasCommitAfter
+// method of the parent MockClient instance is invoked.
+type ClientHasCommitAfterFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, string) (bool, error)
+	hooks       []func(context.Context, api.RepoName, string, string) (bool, error)
+	history     []ClientHasCommitAfterFuncCall
+	mutex       sync.Mutex
+}
+
+// HasCommitAfter delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) HasCommitAfter(v0 context.Context, v1 api.RepoName, v2 string, v3 string) (bool, error) {
+	r0, r1 := m.HasCommitAfterFunc.nextHook()(v0, v1, v2, v3)
+	m.HasCommitAfterFunc.appendCall(ClientHasCommitAfterFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the HasCommitAfter
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientHasCommitAfterFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// HasCommitAfter method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientHasCommitAfterFunc) PushHook(hook func(context.Context, api.RepoName, string, string) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientHasCommitAfterFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientHasCommitAfterFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientHasCommitAfterFunc) nextHook() func(context.Context, api.RepoName, string, string) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientHasCommitAfterFunc) appendCall(r0 ClientHasCommitAfterFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientHasCommitAfterFuncCall objects
+// describing the invocations of this function.
+func (f *ClientHasCommitAfterFunc) History() []ClientHasCommitAfterFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientHasCommitAfterFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientHasCommitAfterFuncCall is an object that describes an invocation of
+// method HasCommitAfter on an instance of MockClient.
+type ClientHasCommitAfterFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientHasCommitAfterFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientHasCommitAfterFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientIsPerforcePathCloneableFunc describes the behavior when the
+// IsPerforcePathCloneable method of the parent MockClient instance is
+// invoked.
+type ClientIsPerforcePathCloneableFunc struct {
+	defaultHook func(context.Context, protocol.PerforceConnectionDetails, string) error
+	hooks       []func(context.Context, protocol.PerforceConnectionDetails, string) error
+	history     []ClientIsPerforcePathCloneableFuncCall
+	mutex       sync.Mutex
+}
+
+// IsPerforcePathCloneable delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockClient) IsPerforcePathCloneable(v0 context.Context, v1 protocol.PerforceConnectionDetails, v2 string) error {
+	r0 := m.IsPerforcePathCloneableFunc.nextHook()(v0, v1, v2)
+	m.IsPerforcePathCloneableFunc.appendCall(ClientIsPerforcePathCloneableFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// IsPerforcePathCloneable method of the parent MockClient instance is
+// invoked and the hook queue is empty.
+func (f *ClientIsPerforcePathCloneableFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// IsPerforcePathCloneable method of the parent MockClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ClientIsPerforcePathCloneableFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientIsPerforcePathCloneableFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientIsPerforcePathCloneableFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails, string) error {
+		return r0
+	})
+}
+
+func (f *ClientIsPerforcePathCloneableFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientIsPerforcePathCloneableFunc) appendCall(r0 ClientIsPerforcePathCloneableFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientIsPerforcePathCloneableFuncCall
+// objects describing the invocations of this function.
+func (f *ClientIsPerforcePathCloneableFunc) History() []ClientIsPerforcePathCloneableFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientIsPerforcePathCloneableFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientIsPerforcePathCloneableFuncCall is an object that describes an
+// invocation of method IsPerforcePathCloneable on an instance of
+// MockClient.
+type ClientIsPerforcePathCloneableFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 protocol.PerforceConnectionDetails
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientIsPerforcePathCloneableFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientIsPerforcePathCloneableFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ClientIsPerforceSuperUserFunc describes the behavior when the
+// IsPerforceSuperUser method of the parent MockClient instance is invoked.
+type ClientIsPerforceSuperUserFunc struct {
+	defaultHook func(context.Context, protocol.PerforceConnectionDetails) error
+	hooks       []func(context.Context, protocol.PerforceConnectionDetails) error
+	history     []ClientIsPerforceSuperUserFuncCall
+	mutex       sync.Mutex
+}
+
+// IsPerforceSuperUser delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) IsPerforceSuperUser(v0 context.Context, v1 protocol.PerforceConnectionDetails) error {
+	r0 := m.IsPerforceSuperUserFunc.nextHook()(v0, v1)
+	m.IsPerforceSuperUserFunc.appendCall(ClientIsPerforceSuperUserFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the IsPerforceSuperUser
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientIsPerforceSuperUserFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// IsPerforceSuperUser method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientIsPerforceSuperUserFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientIsPerforceSuperUserFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientIsPerforceSuperUserFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails) error {
+		return r0
+	})
+}
+
+func (f *ClientIsPerforceSuperUserFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientIsPerforceSuperUserFunc) appendCall(r0 ClientIsPerforceSuperUserFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientIsPerforceSuperUserFuncCall objects
+// describing the invocations of this function.
+func (f *ClientIsPerforceSuperUserFunc) History() []ClientIsPerforceSuperUserFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientIsPerforceSuperUserFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientIsPerforceSuperUserFuncCall is an object that describes an
+// invocation of method IsPerforceSuperUser on an instance of MockClient.
+type ClientIsPerforceSuperUserFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 protocol.PerforceConnectionDetails
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientIsPerforceSuperUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientIsPerforceSuperUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ClientIsRepoCloneableFunc describes the behavior when the IsRepoCloneable
+// method of the parent MockClient instance is invoked.
+type ClientIsRepoCloneableFunc struct {
+	defaultHook func(context.Context, api.RepoName) error
+	hooks       []func(context.Context, api.RepoName) error
+	history     []ClientIsRepoCloneableFuncCall
+	mutex       sync.Mutex
+}
+
+// IsRepoCloneable delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) IsRepoCloneable(v0 context.Context, v1 api.RepoName) error {
+	r0 := m.IsRepoCloneableFunc.nextHook()(v0, v1)
+	m.IsRepoCloneableFunc.appendCall(ClientIsRepoCloneableFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the IsRepoCloneable
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientIsRepoCloneableFunc) SetDefaultHook(hook func(context.Context, api.RepoName) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// IsRepoCloneable method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientIsRepoCloneableFunc) PushHook(hook func(context.Context, api.RepoName) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientIsRepoCloneableFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientIsRepoCloneableFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName) error {
+		return r0
+	})
+}
+
+func (f *ClientIsRepoCloneableFunc) nextHook() func(context.Context, api.RepoName) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientIsRepoCloneableFunc) appendCall(r0 ClientIsRepoCloneableFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientIsRepoCloneableFuncCall objects
+// describing the invocations of this function.
+func (f *ClientIsRepoCloneableFunc) History() []ClientIsRepoCloneableFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientIsRepoCloneableFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientIsRepoCloneableFuncCall is an object that describes an invocation
+// of method IsRepoCloneable on an instance of MockClient.
+type ClientIsRepoCloneableFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientIsRepoCloneableFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientIsRepoCloneableFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ClientLastCommitsForTreeFunc describes the behavior when the
+// LastCommitsForTree method of the parent MockClient instance is invoked.
+type ClientLastCommitsForTreeFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error)
+	history     []ClientLastCommitsForTreeFuncCall
+	mutex       sync.Mutex
+}
+
+// LastCommitsForTree delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) LastCommitsForTree(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string) ([]gitdomain.TreeEntryCommit, error) {
+	r0, r1 := m.LastCommitsForTreeFunc.nextHook()(v0, v1, v2, v3)
+	m.LastCommitsForTreeFunc.appendCall(ClientLastCommitsForTreeFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the LastCommitsForTree
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientLastCommitsForTreeFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// LastCommitsForTree method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientLastCommitsForTreeFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientLastCommitsForTreeFunc) SetDefaultReturn(r0 []gitdomain.TreeEntryCommit, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientLastCommitsForTreeFunc) PushReturn(r0 []gitdomain.TreeEntryCommit, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientLastCommitsForTreeFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string) ([]gitdomain.TreeEntryCommit, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientLastCommitsForTreeFunc) appendCall(r0 ClientLastCommitsForTreeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientLastCommitsForTreeFuncCall objects
+// describing the invocations of this function.
+func (f *ClientLastCommitsForTreeFunc) History() []ClientLastCommitsForTreeFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientLastCommitsForTreeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientLastCommitsForTreeFuncCall is an object that describes an
+// invocation of method LastCommitsForTree on an instance of MockClient.
+type ClientLastCommitsForTreeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gitdomain.TreeEntryCommit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientLastCommitsForTreeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientLastCommitsForTreeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientListBranchesFunc describes the behavior when the ListBranches
+// method of the parent MockClient instance is invoked.
+type ClientListBranchesFunc struct {
+	defaultHook func(context.Context, api.RepoName, ListBranchesOptions) ([]*gitdomain.Branch, error)
+	hooks       []func(context.Context, api.RepoName, ListBranchesOptions) ([]*gitdomain.Branch, error)
+	history     []ClientListBranchesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListBranches delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) ListBranches(v0 context.Context, v1 api.RepoName, v2 ListBranchesOptions) ([]*gitdomain.Branch, error) {
+	r0, r1 := m.ListBranchesFunc.nextHook()(v0, v1, v2)
+	m.ListBranchesFunc.appendCall(ClientListBranchesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListBranches method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientListBranchesFunc) SetDefaultHook(hook func(context.Context, api.RepoName, ListBranchesOptions) ([]*gitdomain.Branch, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListBranches method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientListBranchesFunc) PushHook(hook func(context.Context, api.RepoName, ListBranchesOptions) ([]*gitdomain.Branch, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientListBranchesFunc) SetDefaultReturn(r0 []*gitdomain.Branch, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, ListBranchesOptions) ([]*gitdomain.Branch, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientListBranchesFunc) PushReturn(r0 []*gitdomain.Branch, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, ListBranchesOptions) ([]*gitdomain.Branch, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientListBranchesFunc) nextHook() func(context.Context, api.RepoName, ListBranchesOptions) ([]*gitdomain.Branch, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientListBranchesFunc) appendCall(r0 ClientListBranchesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientListBranchesFuncCall objects
+// describing the invocations of this function.
+func (f *ClientListBranchesFunc) History() []ClientListBranchesFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientListBranchesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientListBranchesFuncCall is an object that describes an invocation of
+// method ListBranches on an instance of MockClient.
+type ClientListBranchesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 ListBranchesOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitdomain.Branch
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientListBranchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientListBranchesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientListDirectoryChildrenFunc describes the behavior when the
+// ListDirectoryChildren method of the parent MockClient instance is
+// invoked.
+type ClientListDirectoryChildrenFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error)
+	history     []ClientListDirectoryChildrenFuncCall
+	mutex       sync.Mutex
+}
+
+// ListDirectoryChildren delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockClient) ListDirectoryChildren(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 []string) (map[string][]string, error) {
+	r0, r1 := m.ListDirectoryChildrenFunc.nextHook()(v0, v1, v2, v3)
+	m.ListDirectoryChildrenFunc.appendCall(ClientListDirectoryChildrenFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListDirectoryChildren method of the parent MockClient instance is invoked
+// and the hook queue is empty.
+func (f *ClientListDirectoryChildrenFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListDirectoryChildren method of the parent MockClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ClientListDirectoryChildrenFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientListDirectoryChildrenFunc) SetDefaultReturn(r0 map[string][]string, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientListDirectoryChildrenFunc) PushReturn(r0 map[string][]string, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientListDirectoryChildrenFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientListDirectoryChildrenFunc) appendCall(r0 ClientListDirectoryChildrenFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientListDirectoryChildrenFuncCall objects
+// describing the invocations of this function.
+func (f *ClientListDirectoryChildrenFunc) History() []ClientListDirectoryChildrenFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientListDirectoryChildrenFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientListDirectoryChildrenFuncCall is an object that describes an
+// invocation of method ListDirectoryChildren on an instance of MockClient.
+type ClientListDirectoryChildrenFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[string][]string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientListDirectoryChildrenFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientListDirectoryChildrenFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientListGitoliteReposFunc describes the behavior when the
+// ListGitoliteRepos method of the parent MockClient instance is invoked.
+type ClientListGitoliteReposFunc struct {
+	defaultHook func(context.Context, string) ([]*gitolite.Repo, error)
+	hooks       []func(context.Context, string) ([]*gitolite.Repo, error)
+	history     []ClientListGitoliteReposFuncCall
+	mutex       sync.Mutex
+}
+
+// ListGitoliteRepos delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) ListGitoliteRepos(v0 context.Context, v1 string) ([]*gitolite.Repo, error) {
+	r0, r1 := m.ListGitoliteReposFunc.nextHook()(v0, v1)
+	m.ListGitoliteReposFunc.appendCall(ClientListGitoliteReposFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListGitoliteRepos
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientListGitoliteReposFunc) SetDefaultHook(hook func(context.Context, string) ([]*gitolite.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListGitoliteRepos method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientListGitoliteReposFunc) PushHook(hook func(context.Context, string) ([]*gitolite.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientListGitoliteReposFunc) SetDefaultReturn(r0 []*gitolite.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) ([]*gitolite.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientListGitoliteReposFunc) PushReturn(r0 []*gitolite.Repo, r1 error) {
+	f.PushHook(func(context.Context, string) ([]*gitolite.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientListGitoliteReposFunc) nextHook() func(context.Context, string) ([]*gitolite.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientListGitoliteReposFunc) appendCall(r0 ClientListGitoliteReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientListGitoliteReposFuncCall objects
+// describing the invocations of this function.
+func (f *ClientListGitoliteReposFunc) History() []ClientListGitoliteReposFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientListGitoliteReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientListGitoliteReposFuncCall is an object that describes an invocation
+// of method ListGitoliteRepos on an instance of MockClient.
+type ClientListGitoliteReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitolite.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientListGitoliteReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientListGitoliteReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientListRefsFunc describes the behavior when the ListRefs method of the
+// parent MockClient instance is invoked.
+type ClientListRefsFunc struct {
+	defaultHook func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error)
+	hooks       []func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error)
+	history     []ClientListRefsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRefs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) ListRefs(v0 context.Context, v1 api.RepoName, v2 ListRefsOpts) ([]gitdomain.Ref, error) {
+	r0, r1 := m.ListRefsFunc.nextHook()(v0, v1, v2)
+	m.ListRefsFunc.appendCall(ClientListRefsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListRefs method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientListRefsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRefs method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientListRefsFunc) PushHook(hook func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientListRefsFunc) SetDefaultReturn(r0 []gitdomain.Ref, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientListRefsFunc) PushReturn(r0 []gitdomain.Ref, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientListRefsFunc) nextHook() func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientListRefsFunc) appendCall(r0 ClientListRefsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientListRefsFuncCall objects describing
+// the invocations of this function.
+func (f *ClientListRefsFunc) History() []ClientListRefsFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientListRefsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientListRefsFuncCall is an object that describes an invocation of
+// method ListRefs on an instance of MockClient.
+type ClientListRefsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 ListRefsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gitdomain.Ref
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientListRefsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientListRefsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientListRemoteRefsFunc describes the behavior when the ListRemoteRefs
+// method of the parent MockClient instance is invoked.
+type ClientListRemoteRefsFunc struct {
+	defaultHook func(context.Context, api.RepoName) ([]gitdomain.Ref, error)
+	hooks       []func(context.Context, api.RepoName) ([]gitdomain.Ref, error)
+	history     []ClientListRemoteRefsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRemoteRefs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) ListRemoteRefs(v0 context.Context, v1 api.RepoName) ([]gitdomain.Ref, error) {
+	r0, r1 := m.ListRemoteRefsFunc.nextHook()(v0, v1)
+	m.ListRemoteRefsFunc.appendCall(ClientListRemoteRefsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListRemoteRefs
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientListRemoteRefsFunc) SetDefaultHook(hook func(context.Context, api.RepoName) ([]gitdomain.Ref, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRemoteRefs method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientListRemoteRefsFunc) PushHook(hook func(context.Context, api.RepoName) ([]gitdomain.Ref, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientListRemoteRefsFunc) SetDefaultReturn(r0 []gitdomain.Ref, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) ([]gitdomain.Ref, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientListRemoteRefsFunc) PushReturn(r0 []gitdomain.Ref, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) ([]gitdomain.Ref, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientListRemoteRefsFunc) nextHook() func(context.Context, api.RepoName) ([]gitdomain.Ref, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientListRemoteRefsFunc) appendCall(r0 ClientListRemoteRefsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientListRemoteRefsFuncCall objects
+// describing the invocations of this function.
+func (f *ClientListRemoteRefsFunc) History() []ClientListRemoteRefsFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientListRemoteRefsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientListRemoteRefsFuncCall is an object that describes an invocation of
+// method ListRemoteRefs on an instance of MockClient.
+type ClientListRemoteRefsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gitdomain.Ref
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientListRemoteRefsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientListRemoteRefsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientListShardReposFunc describes the behavior when the ListShardRepos
+// method of the parent MockClient instance is invoked.
+type ClientListShardReposFunc struct {
+	defaultHook func(context.Context, string) ([]protocol.HostedRepo, error)
+	hooks       []func(context.Context, string) ([]protocol.HostedRepo, error)
+	history     []ClientListShardReposFuncCall
+	mutex       sync.Mutex
+}
+
+// ListShardRepos delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) ListShardRepos(v0 context.Context, v1 string) ([]protocol.HostedRepo, error) {
+	r0, r1 := m.ListShardReposFunc.nextHook()(v0, v1)
+	m.ListShardReposFunc.appendCall(ClientListShardReposFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListShardRepos
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientListShardReposFunc) SetDefaultHook(hook func(context.Context, string) ([]protocol.HostedRepo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListShardRepos method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientListShardReposFunc) PushHook(hook func(context.Context, string) ([]protocol.HostedRepo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientListShardReposFunc) SetDefaultReturn(r0 []protocol.HostedRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) ([]protocol.HostedRepo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientListShardReposFunc) PushReturn(r0 []protocol.HostedRepo, r1 error) {
+	f.PushHook(func(context.Context, string) ([]protocol.HostedRepo, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientListShardReposFunc) nextHook() func(context.Context, string) ([]protocol.HostedRepo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientListShardReposFunc) appendCall(r0 ClientListShardReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientListShardReposFuncCall objects
+// describing the invocations of this function.
+func (f *ClientListShardReposFunc) History() []ClientListShardReposFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientListShardReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientListShardReposFuncCall is an object that describes an invocation of
+// method ListShardRepos on an instance of MockClient.
+type ClientListShardReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []protocol.HostedRepo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientListShardReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientListShardReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientListTagsFunc describes the behavior when the ListTags method of the
+// parent MockClient instance is invoked.
+type ClientListTagsFunc struct {
+	defaultHook func(context.Context, api.RepoName, ListTagsOptions) ([]gitdomain.Ref, error)
+	hooks       []func(context.Context, api.RepoName, ListTagsOptions) ([]gitdomain.Ref, error)
+	history     []ClientListTagsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListTags delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) ListTags(v0 context.Context, v1 api.RepoName, v2 ListTagsOptions) ([]gitdomain.Ref, error) {
+	r0, r1 := m.ListTagsFunc.nextHook()(v0, v1, v2)
+	m.ListTagsFunc.appendCall(ClientListTagsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListTags method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientListTagsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, ListTagsOptions) ([]gitdomain.Ref, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListTags method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientListTagsFunc) PushHook(hook func(context.Context, api.RepoName, ListTagsOptions) ([]gitdomain.Ref, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientListTagsFunc) SetDefaultReturn(r0 []gitdomain.Ref, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, ListTagsOptions) ([]gitdomain.Ref, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientListTagsFunc) PushReturn(r0 []gitdomain.Ref, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, ListTagsOptions) ([]gitdomain.Ref, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientListTagsFunc) nextHook() func(context.Context, api.RepoName, ListTagsOptions) ([]gitdomain.Ref, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientListTagsFunc) appendCall(r0 ClientListTagsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientListTagsFuncCall objects describing
+// the invocations of this function.
+func (f *ClientListTagsFunc) History() []ClientListTagsFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientListTagsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientListTagsFuncCall is an object that describes an invocation of
+// method ListTags on an instance of MockClient.
+type ClientListTagsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 ListTagsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gitdomain.Ref
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientListTagsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientListTagsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientLogReverseEachFunc describes the behavior when the LogReverseEach
+// method of the parent MockClient instance is invoked.
+type ClientLogReverseEachFunc struct {
+	defaultHook func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error
+	hooks       []func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error
+	history     []ClientLogReverseEachFuncCall
+	mutex       sync.Mutex
+}
+
+// LogReverseEach delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) LogReverseEach(v0 context.Context, v1 string, v2 string, v3 int, v4 func(entry gitdomain.LogEntry) error) error {
+	r0 := m.LogReverseEachFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.LogReverseEachFunc.appendCall(ClientLogReverseEachFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the LogReverseEach
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientLogReverseEachFunc) SetDefaultHook(hook func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// LogReverseEach method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientLogReverseEachFunc) PushHook(hook func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientLogReverseEachFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientLogReverseEachFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error {
+		return r0
+	})
+}
+
+func (f *ClientLogReverseEachFunc) nextHook() func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientLogReverseEachFunc) appendCall(r0 ClientLogReverseEachFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientLogReverseEachFuncCall objects
+// describing the invocations of this function.
+func (f *ClientLogReverseEachFunc) History() []ClientLogReverseEachFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientLogReverseEachFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientLogReverseEachFuncCall is an object that describes an invocation of
+// method LogReverseEach on an instance of MockClient.
+type ClientLogReverseEachFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 func(entry gitdomain.LogEntry) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientLogReverseEachFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientLogReverseEachFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ClientLsFilesFunc describes the behavior when the LsFiles method of the
+// parent MockClient instance is invoked.
+type ClientLsFilesFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error)
+	history     []ClientLsFilesFuncCall
+	mutex       sync.Mutex
+}
+
+// LsFiles delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) LsFiles(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 ...gitdomain.Pathspec) ([]string, error) {
+	r0, r1 := m.LsFilesFunc.nextHook()(v0, v1, v2, v3...)
+	m.LsFilesFunc.appendCall(ClientLsFilesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the LsFiles method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientLsFilesFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// LsFiles method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientLsFilesFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientLsFilesFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientLsFilesFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientLsFilesFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientLsFilesFunc) appendCall(r0 ClientLsFilesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientLsFilesFuncCall objects describing
+// the invocations of this function.
+func (f *ClientLsFilesFunc) History() []ClientLsFilesFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientLsFilesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientLsFilesFuncCall is an object that describes an invocation of method
+// LsFiles on an instance of MockClient.
+type ClientLsFilesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg3 []gitdomain.Pathspec
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c ClientLsFilesFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg3 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1, c.Arg2}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientLsFilesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientMergeBaseFunc describes the behavior when the MergeBase method of
+// the parent MockClient instance is invoked.
+type ClientMergeBaseFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, string) (api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, string, string) (api.CommitID, error)
+	history     []ClientMergeBaseFuncCall
+	mutex       sync.Mutex
+}
+
+// MergeBase delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) MergeBase(v0 context.Context, v1 api.RepoName, v2 string, v3 string) (api.CommitID, error) {
+	r0, r1 := m.MergeBaseFunc.nextHook()(v0, v1, v2, v3)
+	m.MergeBaseFunc.appendCall(ClientMergeBaseFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the MergeBase method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientMergeBaseFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) (api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MergeBase method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientMergeBaseFunc) PushHook(hook func(context.Context, api.RepoName, string, string) (api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientMergeBaseFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientMergeBaseFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, string) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientMergeBaseFunc) nextHook() func(context.Context, api.RepoName, string, string) (api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientMergeBaseFunc) appendCall(r0 ClientMergeBaseFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientMergeBaseFuncCall objects describing
+// the invocations of this function.
+func (f *ClientMergeBaseFunc) History() []ClientMergeBaseFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientMergeBaseFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientMergeBaseFuncCall is an object that describes an invocation of
+// method MergeBase on an instance of MockClient.
+type ClientMergeBaseFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientMergeBaseFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientMergeBaseFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientMergeBaseCrossRepoFunc describes the behavior when the
+// MergeBaseCrossRepo method of the parent MockClient instance is invoked.
+type ClientMergeBaseCrossRepoFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, api.RepoName, string) (api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, string, api.RepoName, string) (api.CommitID, error)
+	history     []ClientMergeBaseCrossRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// MergeBaseCrossRepo delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) MergeBaseCrossRepo(v0 context.Context, v1 api.RepoName, v2 string, v3 api.RepoName, v4 string) (api.CommitID, error) {
+	r0, r1 := m.MergeBaseCrossRepoFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.MergeBaseCrossRepoFunc.appendCall(ClientMergeBaseCrossRepoFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the MergeBaseCrossRepo
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientMergeBaseCrossRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, api.RepoName, string) (api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MergeBaseCrossRepo method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientMergeBaseCrossRepoFunc) PushHook(hook func(context.Context, api.RepoName, string, api.RepoName, string) (api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientMergeBaseCrossRepoFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, api.RepoName, string) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientMergeBaseCrossRepoFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, api.RepoName, string) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientMergeBaseCrossRepoFunc) nextHook() func(context.Context, api.RepoName, string, api.RepoName, string) (api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientMergeBaseCrossRepoFunc) appendCall(r0 ClientMergeBaseCrossRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientMergeBaseCrossRepoFuncCall objects
+// describing the invocations of this function.
+func (f *ClientMergeBaseCrossRepoFunc) History() []ClientMergeBaseCrossRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientMergeBaseCrossRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientMergeBaseCrossRepoFuncCall is an object that describes an
+// invocation of method MergeBaseCrossRepo on an instance of MockClient.
+type ClientMergeBaseCrossRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.RepoName
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientMergeBaseCrossRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientMergeBaseCrossRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientNewFileReaderFunc describes the behavior when the NewFileReader
+// method of the parent MockClient instance is invoked.
+type ClientNewFileReaderFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)
+	history     []ClientNewFileReaderFuncCall
+	mutex       sync.Mutex
+}
+
+// NewFileReader delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) NewFileReader(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string) (io.ReadCloser, error) {
+	r0, r1 := m.NewFileReaderFunc.nextHook()(v0, v1, v2, v3)
+	m.NewFileReaderFunc.appendCall(ClientNewFileReaderFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the NewFileReader method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientNewFileReaderFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// NewFileReader method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientNewFileReaderFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientNewFileReaderFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientNewFileReaderFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientNewFileReaderFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientNewFileReaderFunc) appendCall(r0 ClientNewFileReaderFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientNewFileReaderFuncCall objects
+// describing the invocations of this function.
+func (f *ClientNewFileReaderFunc) History() []ClientNewFileReaderFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientNewFileReaderFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientNewFileReaderFuncCall is an object that describes an invocation of
+// method NewFileReader on an instance of MockClient.
+type ClientNewFileReaderFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientNewFileReaderFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientNewFileReaderFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientNewFileReaderIfNotOIDFunc describes the behavior when the
+// NewFileReaderIfNotOID method of the parent MockClient instance is
+// invoked.
+type ClientNewFileReaderIfNotOIDFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, string, gitdomain.OID) (io.ReadCloser, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, string, gitdomain.OID) (io.ReadCloser, error)
+	history     []ClientNewFileReaderIfNotOIDFuncCall
+	mutex       sync.Mutex
+}
+
+// NewFileReaderIfNotOID delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockClient) NewFileReaderIfNotOID(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string, v4 gitdomain.OID) (io.ReadCloser, error) {
+	r0, r1 := m.NewFileReaderIfNotOIDFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.NewFileReaderIfNotOIDFunc.appendCall(ClientNewFileReaderIfNotOIDFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// NewFileReaderIfNotOID method of the parent MockClient instance is invoked
+// and the hook queue is empty.
+func (f *ClientNewFileReaderIfNotOIDFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string, gitdomain.OID) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// NewFileReaderIfNotOID method of the parent MockClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ClientNewFileReaderIfNotOIDFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string, gitdomain.OID) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientNewFileReaderIfNotOIDFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string, gitdomain.OID) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientNewFileReaderIfNotOIDFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string, gitdomain.OID) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientNewFileReaderIfNotOIDFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string, gitdomain.OID) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientNewFileReaderIfNotOIDFunc) appendCall(r0 ClientNewFileReaderIfNotOIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientNewFileReaderIfNotOIDFuncCall objects
+// describing the invocations of this function.
+func (f *ClientNewFileReaderIfNotOIDFunc) History() []ClientNewFileReaderIfNotOIDFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientNewFileReaderIfNotOIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientNewFileReaderIfNotOIDFuncCall is an object that describes an
+// invocation of method NewFileReaderIfNotOID on an instance of MockClient.
+type ClientNewFileReaderIfNotOIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 gitdomain.OID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientNewFileReaderIfNotOIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientNewFileReaderIfNotOIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientOptimizeRepoFunc describes the behavior when the OptimizeRepo
+// method of the parent MockClient instance is invoked.
+type ClientOptimizeRepoFunc struct {
+	defaultHook func(context.Context, api.RepoName, MaintenanceOptions) ([]MaintenanceTaskResult, error)
+	hooks       []func(context.Context, api.RepoName, MaintenanceOptions) ([]MaintenanceTaskResult, error)
+	history     []ClientOptimizeRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// OptimizeRepo delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) OptimizeRepo(v0 context.Context, v1 api.RepoName, v2 MaintenanceOptions) ([]MaintenanceTaskResult, error) {
+	r0, r1 := m.OptimizeRepoFunc.nextHook()(v0, v1, v2)
+	m.OptimizeRepoFunc.appendCall(ClientOptimizeRepoFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the OptimizeRepo method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientOptimizeRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoName, MaintenanceOptions) ([]MaintenanceTaskResult, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// OptimizeRepo method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientOptimizeRepoFunc) PushHook(hook func(context.Context, api.RepoName, MaintenanceOptions) ([]MaintenanceTaskResult, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientOptimizeRepoFunc) SetDefaultReturn(r0 []MaintenanceTaskResult, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, MaintenanceOptions) ([]MaintenanceTaskResult, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientOptimizeRepoFunc) PushReturn(r0 []MaintenanceTaskResult, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, MaintenanceOptions) ([]MaintenanceTaskResult, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientOptimizeRepoFunc) nextHook() func(context.Context, api.RepoName, MaintenanceOptions) ([]MaintenanceTaskResult, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientOptimizeRepoFunc) appendCall(r0 ClientOptimizeRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientOptimizeRepoFuncCall objects
+// describing the invocations of this function.
+func (f *ClientOptimizeRepoFunc) History() []ClientOptimizeRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientOptimizeRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientOptimizeRepoFuncCall is an object that describes an invocation of
+// method OptimizeRepo on an instance of MockClient.
+type ClientOptimizeRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 MaintenanceOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []MaintenanceTaskResult
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientOptimizeRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientOptimizeRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientPathExistsFunc describes the behavior when the PathExists method of
+// the parent MockClient instance is invoked.
+type ClientPathExistsFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, string) (bool, gitdomain.ObjectType, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, string) (bool, gitdomain.ObjectType, error)
+	history     []ClientPathExistsFuncCall
+	mutex       sync.Mutex
+}
+
+// PathExists delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) PathExists(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string) (bool, gitdomain.ObjectType, error) {
+	r0, r1, r2 := m.PathExistsFunc.nextHook()(v0, v1, v2, v3)
+	m.PathExistsFunc.appendCall(ClientPathExistsFuncCall{v0, v1, v2, v3, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the PathExists method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientPathExistsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string) (bool, gitdomain.ObjectType, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PathExists method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientPathExistsFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string) (bool, gitdomain.ObjectType, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientPathExistsFunc) SetDefaultReturn(r0 bool, r1 gitdomain.ObjectType, r2 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) (bool, gitdomain.ObjectType, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientPathExistsFunc) PushReturn(r0 bool, r1 gitdomain.ObjectType, r2 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string) (bool, gitdomain.ObjectType, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *ClientPathExistsFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string) (bool, gitdomain.ObjectType, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientPathExistsFunc) appendCall(r0 ClientPathExistsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientPathExistsFuncCall objects describing
+// the invocations of this function.
+func (f *ClientPathExistsFunc) History() []ClientPathExistsFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientPathExistsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientPathExistsFuncCall is an object that describes an invocation of
+// method PathExists on an instance of MockClient.
+type ClientPathExistsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 gitdomain.ObjectType
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientPathExistsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientPathExistsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// ClientPerforceGetChangelistFunc describes the behavior when the
+// PerforceGetChangelist method of the parent MockClient instance is
+// invoked.
+type ClientPerforceGetChangelistFunc struct {
+	defaultHook func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error)
+	hooks       []func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error)
+	history     []ClientPerforceGetChangelistFuncCall
+	mutex       sync.Mutex
+}
+
+// PerforceGetChangelist delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockClient) PerforceGetChangelist(v0 context.Context, v1 protocol.PerforceConnectionDetails, v2 string) (*perforce.Changelist, error) {
+	r0, r1 := m.PerforceGetChangelistFunc.nextHook()(v0, v1, v2)
+	m.PerforceGetChangelistFunc.appendCall(ClientPerforceGetChangelistFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// PerforceGetChangelist method of the parent MockClient instance is invoked
+// and the hook queue is empty.
+func (f *ClientPerforceGetChangelistFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PerforceGetChangelist method of the parent MockClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ClientPerforceGetChangelistFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientPerforceGetChangelistFunc) SetDefaultReturn(r0 *perforce.Changelist, r1 error) {
+	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientPerforceGetChangelistFunc) PushReturn(r0 *perforce.Changelist, r1 error) {
+	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientPerforceGetChangelistFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientPerforceGetChangelistFunc) appendCall(r0 ClientPerforceGetChangelistFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientPerforceGetChangelistFuncCall objects
+// describing the invocations of this function.
+func (f *ClientPerforceGetChangelistFunc) History() []ClientPerforceGetChangelistFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientPerforceGetChangelistFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientPerforceGetChangelistFuncCall is an object that describes an
+// invocation of method PerforceGetChangelist on an instance of MockClient.
+type ClientPerforceGetChangelistFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 protocol.PerforceConnectionDetails
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *perforce.Changelist
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientPerforceGetChangelistFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientPerforceGetChangelistFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientPerforceGroupMembersFunc describes the behavior when the
+// PerforceGroupMembers method of the parent MockClient instance is invoked.
+type ClientPerforceGroupMembersFunc struct {
+	defaultHook func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error)
+	hooks       []func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error)
+	history     []ClientPerforceGroupMembersFuncCall
+	mutex       sync.Mutex
+}
+
+// PerforceGroupMembers delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) PerforceGroupMembers(v0 context.Context, v1 protocol.PerforceConnectionDetails, v2 string) ([]string, error) {
+	r0, r1 := m.PerforceGroupMembersFunc.nextHook()(v0, v1, v2)
+	m.PerforceGroupMembersFunc.appendCall(ClientPerforceGroupMembersFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the PerforceGroupMembers
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientPerforceGroupMembersFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PerforceGroupMembers method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientPerforceGroupMembersFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientPerforceGroupMembersFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientPerforceGroupMembersFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientPerforceGroupMembersFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientPerforceGroupMembersFunc) appendCall(r0 ClientPerforceGroupMembersFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientPerforceGroupMembersFuncCall objects
+// describing the invocations of this function.
+func (f *ClientPerforceGroupMembersFunc) History() []ClientPerforceGroupMembersFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientPerforceGroupMembersFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientPerforceGroupMembersFuncCall is an object that describes an
+// invocation of method PerforceGroupMembers on an instance of MockClient.
+type ClientPerforceGroupMembersFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 protocol.PerforceConnectionDetails
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientPerforceGroupMembersFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientPerforceGroupMembersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientPerforceProtectsForDepotFunc describes the behavior when the
+// PerforceProtectsForDepot method of the parent MockClient instance is
+// invoked.
+type ClientPerforceProtectsForDepotFunc struct {
+	defaultHook func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)
+	hooks       []func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)
+	history     []ClientPerforceProtectsForDepotFuncCall
+	mutex       sync.Mutex
+}
+
+// PerforceProtectsForDepot delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockClient) PerforceProtectsForDepot(v0 context.Context, v1 protocol.PerforceConnectionDetails, v2 string) ([]*perforce.Protect, error) {
+	r0, r1 := m.PerforceProtectsForDepotFunc.nextHook()(v0, v1, v2)
+	m.PerforceProtectsForDepotFunc.appendCall(ClientPerforceProtectsForDepotFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// PerforceProtectsForDepot method of the parent MockClient instance is
+// invoked and the hook queue is empty.
+func (f *ClientPerforceProtectsForDepotFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PerforceProtectsForDepot method of the parent MockClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ClientPerforceProtectsForDepotFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientPerforceProtectsForDepotFunc) SetDefaultReturn(r0 []*perforce.Protect, r1 error) {
+	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientPerforceProtectsForDepotFunc) PushReturn(r0 []*perforce.Protect, r1 error) {
+	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientPerforceProtectsForDepotFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientPerforceProtectsForDepotFunc) appendCall(r0 ClientPerforceProtectsForDepotFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientPerforceProtectsForDepotFuncCall
+// objects describing the invocations of this function.
+func (f *ClientPerforceProtectsForDepotFunc) History() []ClientPerforceProtectsForDepotFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientPerforceProtectsForDepotFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientPerforceProtectsForDepotFuncCall is an object that describes an
+// invocation of method PerforceProtectsForDepot on an instance of
+// MockClient.
+type ClientPerforceProtectsForDepotFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 protocol.PerforceConnectionDetails
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*perforce.Protect
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientPerforceProtectsForDepotFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientPerforceProtectsForDepotFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientPerforceProtectsForUserFunc describes the behavior when the
+// PerforceProtectsForUser method of the parent MockClient instance is
+// invoked.
+type ClientPerforceProtectsForUserFunc struct {
+	defaultHook func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)
+	hooks       []func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)
+	history     []ClientPerforceProtectsForUserFuncCall
+	mutex       sync.Mutex
+}
+
+// PerforceProtectsForUser delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockClient) PerforceProtectsForUser(v0 context.Context, v1 protocol.PerforceConnectionDetails, v2 string) ([]*perforce.Protect, error) {
+	r0, r1 := m.PerforceProtectsForUserFunc.nextHook()(v0, v1, v2)
+	m.PerforceProtectsForUserFunc.appendCall(ClientPerforceProtectsForUserFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// PerforceProtectsForUser method of the parent MockClient instance is
+// invoked and the hook queue is empty.
+func (f *ClientPerforceProtectsForUserFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PerforceProtectsForUser method of the parent MockClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ClientPerforceProtectsForUserFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientPerforceProtectsForUserFunc) SetDefaultReturn(r0 []*perforce.Protect, r1 error) {
+	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientPerforceProtectsForUserFunc) PushReturn(r0 []*perforce.Protect, r1 error) {
+	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientPerforceProtectsForUserFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientPerforceProtectsForUserFunc) appendCall(r0 ClientPerforceProtectsForUserFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientPerforceProtectsForUserFuncCall
+// objects describing the invocations of this function.
+func (f *ClientPerforceProtectsForUserFunc) History() []ClientPerforceProtectsForUserFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientPerforceProtectsForUserFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientPerforceProtectsForUserFuncCall is an object that describes an
+// invocation of method PerforceProtectsForUser on an instance of
+// MockClient.
+type ClientPerforceProtectsForUserFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 protocol.PerforceConnectionDetails
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*perforce.Protect
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientPerforceProtectsForUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientPerforceProtectsForUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientPerforceUsersFunc describes the behavior when the PerforceUsers
+// method of the parent MockClient instance is invoked.
+type ClientPerforceUsersFunc struct {
+	defaultHook func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error)
+	hooks       []func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error)
+	history     []ClientPerforceUsersFuncCall
+	mutex       sync.Mutex
+}
+
+// PerforceUsers delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) PerforceUsers(v0 context.Context, v1 protocol.PerforceConnectionDetails) ([]*perforce.User, error) {
+	r0, r1 := m.PerforceUsersFunc.nextHook()(v0, v1)
+	m.PerforceUsersFunc.appendCall(ClientPerforceUsersFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the PerforceUsers method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientPerforceUsersFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PerforceUsers method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientPerforceUsersFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientPerforceUsersFunc) SetDefaultReturn(r0 []*perforce.User, r1 error) {
+	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientPerforceUsersFunc) PushReturn(r0 []*perforce.User, r1 error) {
+	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientPerforceUsersFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientPerforceUsersFunc) appendCall(r0 ClientPerforceUsersFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientPerforceUsersFuncCall objects
+// describing the invocations of this function.
+func (f *ClientPerforceUsersFunc) History() []ClientPerforceUsersFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientPerforceUsersFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientPerforceUsersFuncCall is an object that describes an invocation of
+// method PerforceUsers on an instance of MockClient.
+type ClientPerforceUsersFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 protocol.PerforceConnectionDetails
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*perforce.User
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientPerforceUsersFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientPerforceUsersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientRangeDiffFunc describes the behavior when the RangeDiff method of
+// the parent MockClient instance is invoked.
+type ClientRangeDiffFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, string) ([]*gitdomain.RangeDiffPair, error)
+	hooks       []func(context.Context, api.RepoName, string, string) ([]*gitdomain.RangeDiffPair, error)
+	history     []ClientRangeDiffFuncCall
+	mutex       sync.Mutex
+}
+
+// RangeDiff delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) RangeDiff(v0 context.Context, v1 api.RepoName, v2 string, v3 string) ([]*gitdomain.RangeDiffPair, error) {
+	r0, r1 := m.RangeDiffFunc.nextHook()(v0, v1, v2, v3)
+	m.RangeDiffFunc.appendCall(ClientRangeDiffFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RangeDiff method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientRangeDiffFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) ([]*gitdomain.RangeDiffPair, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RangeDiff method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientRangeDiffFunc) PushHook(hook func(context.Context, api.RepoName, string, string) ([]*gitdomain.RangeDiffPair, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRangeDiffFunc) SetDefaultReturn(r0 []*gitdomain.RangeDiffPair, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) ([]*gitdomain.RangeDiffPair, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRangeDiffFunc) PushReturn(r0 []*gitdomain.RangeDiffPair, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, string) ([]*gitdomain.RangeDiffPair, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientRangeDiffFunc) nextHook() func(context.Context, api.RepoName, string, string) ([]*gitdomain.RangeDiffPair, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRangeDiffFunc) appendCall(r0 ClientRangeDiffFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRangeDiffFuncCall objects describing
+// the invocations of this function.
+func (f *ClientRangeDiffFunc) History() []ClientRangeDiffFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRangeDiffFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRangeDiffFuncCall is an object that describes an invocation of
+// method RangeDiff on an instance of MockClient.
+type ClientRangeDiffFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*gitdomain.RangeDiffPair
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRangeDiffFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRangeDiffFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientRawDiffFunc describes the behavior when the RawDiff method of the
+// parent MockClient instance is invoked.
+type ClientRawDiffFunc struct {
+	defaultHook func(context.Context, DiffOptions, io.Writer) error
+	hooks       []func(context.Context, DiffOptions, io.Writer) error
+	history     []ClientRawDiffFuncCall
+	mutex       sync.Mutex
+}
+
+// RawDiff delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) RawDiff(v0 context.Context, v1 DiffOptions, v2 io.Writer) error {
+	r0 := m.RawDiffFunc.nextHook()(v0, v1, v2)
+	m.RawDiffFunc.appendCall(ClientRawDiffFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the RawDiff method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientRawDiffFunc) SetDefaultHook(hook func(context.Context, DiffOptions, io.Writer) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RawDiff method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientRawDiffFunc) PushHook(hook func(context.Context, DiffOptions, io.Writer) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRawDiffFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, DiffOptions, io.Writer) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRawDiffFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, DiffOptions, io.Writer) error {
+		return r0
+	})
+}
+
+func (f *ClientRawDiffFunc) nextHook() func(context.Context, DiffOptions, io.Writer) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRawDiffFunc) appendCall(r0 ClientRawDiffFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRawDiffFuncCall objects describing
+// the invocations of this function.
+func (f *ClientRawDiffFunc) History() []ClientRawDiffFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRawDiffFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRawDiffFuncCall is an object that describes an invocation of method
+// RawDiff on an instance of MockClient.
+type ClientRawDiffFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 DiffOptions
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 io.Writer
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRawDiffFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRawDiffFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ClientReadBlobFunc describes the behavior when the ReadBlob method of the
+// parent MockClient instance is invoked.
+type ClientReadBlobFunc struct {
+	defaultHook func(context.Context, api.RepoName, gitdomain.OID) (io.ReadCloser, error)
+	hooks       []func(context.Context, api.RepoName, gitdomain.OID) (io.ReadCloser, error)
+	history     []ClientReadBlobFuncCall
+	mutex       sync.Mutex
+}
+
+// ReadBlob delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) ReadBlob(v0 context.Context, v1 api.RepoName, v2 gitdomain.OID) (io.ReadCloser, error) {
+	r0, r1 := m.ReadBlobFunc.nextHook()(v0, v1, v2)
+	m.ReadBlobFunc.appendCall(ClientReadBlobFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ReadBlob method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientReadBlobFunc) SetDefaultHook(hook func(context.Context, api.RepoName, gitdomain.OID) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReadBlob method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientReadBlobFunc) PushHook(hook func(context.Context, api.RepoName, gitdomain.OID) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientReadBlobFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, gitdomain.OID) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientReadBlobFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, gitdomain.OID) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientReadBlobFunc) nextHook() func(context.Context, api.RepoName, gitdomain.OID) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientReadBlobFunc) appendCall(r0 ClientReadBlobFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientReadBlobFuncCall objects describing
+// the invocations of this function.
+func (f *ClientReadBlobFunc) History() []ClientReadBlobFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientReadBlobFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientReadBlobFuncCall is an object that describes an invocation of
+// method ReadBlob on an instance of MockClient.
+type ClientReadBlobFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gitdomain.OID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientReadBlobFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientReadBlobFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientReadDirFunc describes the behavior when the ReadDir method of the
+// parent MockClient instance is invoked.
+type ClientReadDirFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error)
+	history     []ClientReadDirFuncCall
+	mutex       sync.Mutex
+}
+
+// ReadDir delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) ReadDir(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string, v4 bool) ([]fs.FileInfo, error) {
+	r0, r1 := m.ReadDirFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.ReadDirFunc.appendCall(ClientReadDirFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ReadDir method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientReadDirFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReadDir method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientReadDirFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientReadDirFunc) SetDefaultReturn(r0 []fs.FileInfo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientReadDirFunc) PushReturn(r0 []fs.FileInfo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientReadDirFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientReadDirFunc) appendCall(r0 ClientReadDirFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientReadDirFuncCall objects describing
+// the invocations of this function.
+func (f *ClientReadDirFunc) History() []ClientReadDirFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientReadDirFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientReadDirFuncCall is an object that describes an invocation of method
+// ReadDir on an instance of MockClient.
+type ClientReadDirFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []fs.FileInfo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientReadDirFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientReadDirFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientRebasePreviewFunc describes the behavior when the RebasePreview
+// method of the parent MockClient instance is invoked.
+type ClientRebasePreviewFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error)
+	history     []ClientRebasePreviewFuncCall
+	mutex       sync.Mutex
+}
+
+// RebasePreview delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) RebasePreview(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 api.CommitID, v4 api.CommitID) (gitdomain.RebasePreview, error) {
+	r0, r1 := m.RebasePreviewFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.RebasePreviewFunc.appendCall(ClientRebasePreviewFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RebasePreview method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientRebasePreviewFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RebasePreview method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientRebasePreviewFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRebasePreviewFunc) SetDefaultReturn(r0 gitdomain.RebasePreview, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRebasePreviewFunc) PushReturn(r0 gitdomain.RebasePreview, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientRebasePreviewFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, api.CommitID, api.CommitID) (gitdomain.RebasePreview, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRebasePreviewFunc) appendCall(r0 ClientRebasePreviewFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRebasePreviewFuncCall objects
+// describing the invocations of this function.
+func (f *ClientRebasePreviewFunc) History() []ClientRebasePreviewFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRebasePreviewFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRebasePreviewFuncCall is an object that describes an invocation of
+// method RebasePreview on an instance of MockClient.
+type ClientRebasePreviewFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gitdomain.RebasePreview
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRebasePreviewFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRebasePreviewFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientRefExistsFunc describes the behavior when the RefExists method of
+// the parent MockClient instance is invoked.
+type ClientRefExistsFunc struct {
+	defaultHook func(context.Context, api.RepoName, string) (bool, error)
+	hooks       []func(context.Context, api.RepoName, string) (bool, error)
+	history     []ClientRefExistsFuncCall
+	mutex       sync.Mutex
+}
+
+// RefExists delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) RefExists(v0 context.Context, v1 api.RepoName, v2 string) (bool, error) {
+	r0, r1 := m.RefExistsFunc.nextHook()(v0, v1, v2)
+	m.RefExistsFunc.appendCall(ClientRefExistsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RefExists method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientRefExistsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RefExists method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientRefExistsFunc) PushHook(hook func(context.Context, api.RepoName, string) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRefExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRefExistsFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientRefExistsFunc) nextHook() func(context.Context, api.RepoName, string) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRefExistsFunc) appendCall(r0 ClientRefExistsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRefExistsFuncCall objects describing
+// the invocations of this function.
+func (f *ClientRefExistsFunc) History() []ClientRefExistsFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRefExistsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRefExistsFuncCall is an object that describes an invocation of
+// method RefExists on an instance of MockClient.
+type ClientRefExistsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRefExistsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRefExistsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientRefsChangedSinceFunc describes the behavior when the
+// RefsChangedSince method of the parent MockClient instance is invoked.
+type ClientRefsChangedSinceFunc struct {
+	defaultHook func(context.Context, api.RepoName, RefsChangedSinceOptions) (gitdomain.RefChanges, error)
+	hooks       []func(context.Context, api.RepoName, RefsChangedSinceOptions) (gitdomain.RefChanges, error)
+	history     []ClientRefsChangedSinceFuncCall
+	mutex       sync.Mutex
+}
+
+// RefsChangedSince delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) RefsChangedSince(v0 context.Context, v1 api.RepoName, v2 RefsChangedSinceOptions) (gitdomain.RefChanges, error) {
+	r0, r1 := m.RefsChangedSinceFunc.nextHook()(v0, v1, v2)
+	m.RefsChangedSinceFunc.appendCall(ClientRefsChangedSinceFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RefsChangedSince
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientRefsChangedSinceFunc) SetDefaultHook(hook func(context.Context, api.RepoName, RefsChangedSinceOptions) (gitdomain.RefChanges, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RefsChangedSince method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientRefsChangedSinceFunc) PushHook(hook func(context.Context, api.RepoName, RefsChangedSinceOptions) (gitdomain.RefChanges, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRefsChangedSinceFunc) SetDefaultReturn(r0 gitdomain.RefChanges, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, RefsChangedSinceOptions) (gitdomain.RefChanges, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRefsChangedSinceFunc) PushReturn(r0 gitdomain.RefChanges, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, RefsChangedSinceOptions) (gitdomain.RefChanges, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientRefsChangedSinceFunc) nextHook() func(context.Context, api.RepoName, RefsChangedSinceOptions) (gitdomain.RefChanges, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRefsChangedSinceFunc) appendCall(r0 ClientRefsChangedSinceFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRefsChangedSinceFuncCall objects
+// describing the invocations of this function.
+func (f *ClientRefsChangedSinceFunc) History() []ClientRefsChangedSinceFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRefsChangedSinceFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRefsChangedSinceFuncCall is an object that describes an invocation
+// of method RefsChangedSince on an instance of MockClient.
+type ClientRefsChangedSinceFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 RefsChangedSinceOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gitdomain.RefChanges
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRefsChangedSinceFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRefsChangedSinceFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientRemoveFunc describes the behavior when the Remove method of the
+// parent MockClient instance is invoked.
+type ClientRemoveFunc struct {
+	defaultHook func(context.Context, api.RepoName) error
+	hooks       []func(context.Context, api.RepoName) error
+	history     []ClientRemoveFuncCall
+	mutex       sync.Mutex
+}
+
+// Remove delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) Remove(v0 context.Context, v1 api.RepoName) error {
+	r0 := m.RemoveFunc.nextHook()(v0, v1)
+	m.RemoveFunc.appendCall(ClientRemoveFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Remove method of the
+// parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientRemoveFunc) SetDefaultHook(hook func(context.Context, api.RepoName) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Remove method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientRemoveFunc) PushHook(hook func(context.Context, api.RepoName) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRemoveFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRemoveFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName) error {
+		return r0
+	})
+}
+
+func (f *ClientRemoveFunc) nextHook() func(context.Context, api.RepoName) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRemoveFunc) appendCall(r0 ClientRemoveFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRemoveFuncCall objects describing the
+// invocations of this function.
+func (f *ClientRemoveFunc) History() []ClientRemoveFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRemoveFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRemoveFuncCall is an object that describes an invocation of method
+// Remove on an instance of MockClient.
+type ClientRemoveFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRemoveFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRemoveFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ClientRepoCloneProgressFunc describes the behavior when the
+// RepoCloneProgress method of the parent MockClient instance is invoked.
+type ClientRepoCloneProgressFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error)
+	hooks       []func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error)
+	history     []ClientRepoCloneProgressFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoCloneProgress delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) RepoCloneProgress(v0 context.Context, v1 api.RepoName) (*protocol.RepoCloneProgress, error) {
+	r0, r1 := m.RepoCloneProgressFunc.nextHook()(v0, v1)
+	m.RepoCloneProgressFunc.appendCall(ClientRepoCloneProgressFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RepoCloneProgress
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientRepoCloneProgressFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoCloneProgress method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientRepoCloneProgressFunc) PushHook(hook func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRepoCloneProgressFunc) SetDefaultReturn(r0 *protocol.RepoCloneProgress, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRepoCloneProgressFunc) PushReturn(r0 *protocol.RepoCloneProgress, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientRepoCloneProgressFunc) nextHook() func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRepoCloneProgressFunc) appendCall(r0 ClientRepoCloneProgressFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRepoCloneProgressFuncCall objects
+// describing the invocations of this function.
+func (f *ClientRepoCloneProgressFunc) History() []ClientRepoCloneProgressFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRepoCloneProgressFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRepoCloneProgressFuncCall is an object that describes an invocation
+// of method RepoCloneProgress on an instance of MockClient.
+type ClientRepoCloneProgressFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *protocol.RepoCloneProgress
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRepoCloneProgressFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRepoCloneProgressFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientRequestRepoUpdateFunc describes the behavior when the
+// RequestRepoUpdate method of the parent MockClient instance is invoked.
+type ClientRequestRepoUpdateFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)
+	hooks       []func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)
+	history     []ClientRequestRepoUpdateFuncCall
+	mutex       sync.Mutex
+}
+
+// RequestRepoUpdate delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) RequestRepoUpdate(v0 context.Context, v1 api.RepoName) (*protocol.RepoUpdateResponse, error) {
+	r0, r1 := m.RequestRepoUpdateFunc.nextHook()(v0, v1)
+	m.RequestRepoUpdateFunc.appendCall(ClientRequestRepoUpdateFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RequestRepoUpdate
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientRequestRepoUpdateFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RequestRepoUpdate method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientRequestRepoUpdateFunc) PushHook(hook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRequestRepoUpdateFunc) SetDefaultReturn(r0 *protocol.RepoUpdateResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRequestRepoUpdateFunc) PushReturn(r0 *protocol.RepoUpdateResponse, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientRequestRepoUpdateFunc) nextHook() func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRequestRepoUpdateFunc) appendCall(r0 ClientRequestRepoUpdateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRequestRepoUpdateFuncCall objects
+// describing the invocations of this function.
+func (f *ClientRequestRepoUpdateFunc) History() []ClientRequestRepoUpdateFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRequestRepoUpdateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRequestRepoUpdateFuncCall is an object that describes an invocation
+// of method RequestRepoUpdate on an instance of MockClient.
+type ClientRequestRepoUpdateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *protocol.RepoUpdateResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRequestRepoUpdateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRequestRepoUpdateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientResolveRevisionFunc describes the behavior when the ResolveRevision
+// method of the parent MockClient instance is invoked.
+type ClientResolveRevisionFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error)
+	history     []ClientResolveRevisionFuncCall
+	mutex       sync.Mutex
+}
+
+// ResolveRevision delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) ResolveRevision(v0 context.Context, v1 api.RepoName, v2 string, v3 ResolveRevisionOptions) (api.CommitID, error) {
+	r0, r1 := m.ResolveRevisionFunc.nextHook()(v0, v1, v2, v3)
+	m.ResolveRevisionFunc.appendCall(ClientResolveRevisionFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ResolveRevision
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientResolveRevisionFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ResolveRevision method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientResolveRevisionFunc) PushHook(hook func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientResolveRevisionFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientResolveRevisionFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientResolveRevisionFunc) nextHook() func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientResolveRevisionFunc) appendCall(r0 ClientResolveRevisionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientResolveRevisionFuncCall objects
+// describing the invocations of this function.
+func (f *ClientResolveRevisionFunc) History() []ClientResolveRevisionFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientResolveRevisionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientResolveRevisionFuncCall is an object that describes an invocation
+// of method ResolveRevision on an instance of MockClient.
+type ClientResolveRevisionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 ResolveRevisionOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientResolveRevisionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientResolveRevisionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientRevAtTimeFunc describes the behavior when the RevAtTime method of
+// the parent MockClient instance is invoked.
+type ClientRevAtTimeFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error)
+	hooks       []func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error)
+	history     []ClientRevAtTimeFuncCall
+	mutex       sync.Mutex
+}
+
+// RevAtTime delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) RevAtTime(v0 context.Context, v1 api.RepoName, v2 string, v3 time.Time) (api.CommitID, bool, error) {
+	r0, r1, r2 := m.RevAtTimeFunc.nextHook()(v0, v1, v2, v3)
+	m.RevAtTimeFunc.appendCall(ClientRevAtTimeFuncCall{v0, v1, v2, v3, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the RevAtTime method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientRevAtTimeFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RevAtTime method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientRevAtTimeFunc) PushHook(hook func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRevAtTimeFunc) SetDefaultReturn(r0 api.CommitID, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRevAtTimeFunc) PushReturn(r0 api.CommitID, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *ClientRevAtTimeFunc) nextHook() func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRevAtTimeFunc) appendCall(r0 ClientRevAtTimeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRevAtTimeFuncCall objects describing
+// the invocations of this function.
+func (f *ClientRevAtTimeFunc) History() []ClientRevAtTimeFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRevAtTimeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRevAtTimeFuncCall is an object that describes an invocation of
+// method RevAtTime on an instance of MockClient.
+type ClientRevAtTimeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRevAtTimeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRevAtTimeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// ClientRevListFunc describes the behavior when the RevList method of the
+// parent MockClient instance is invoked.
+type ClientRevListFunc struct {
+	defaultHook func(context.Context, string, string, func(commit string) (bool, error)) error
+	hooks       []func(context.Context, string, string, func(commit string) (bool, error)) error
+	history     []ClientRevListFuncCall
+	mutex       sync.Mutex
+}
+
+// RevList delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) RevList(v0 context.Context, v1 string, v2 string, v3 func(commit string) (bool, error)) error {
+	r0 := m.RevListFunc.nextHook()(v0, v1, v2, v3)
+	m.RevListFunc.appendCall(ClientRevListFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the RevList method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientRevListFunc) SetDefaultHook(hook func(context.Context, string, string, func(commit string) (bool, error)) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RevList method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientRevListFunc) PushHook(hook func(context.Context, string, string, func(commit string) (bool, error)) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRevListFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, string, func(commit string) (bool, error)) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRevListFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, string, func(commit string) (bool, error)) error {
+		return r0
+	})
+}
+
+func (f *ClientRevListFunc) nextHook() func(context.Context, string, string, func(commit string) (bool, error)) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRevListFunc) appendCall(r0 ClientRevListFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRevListFuncCall objects describing
+// the invocations of this function.
+func (f *ClientRevListFunc) History() []ClientRevListFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRevListFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRevListFuncCall is an object that describes an invocation of method
+// RevList on an instance of MockClient.
+type ClientRevListFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 func(commit string) (bool, error)
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRevListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRevListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ClientRevertCommitFunc describes the behavior when the RevertCommit
+// method of the parent MockClient instance is invoked.
+type ClientRevertCommitFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, RevertCommitOptions) (api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, RevertCommitOptions) (api.CommitID, error)
+	history     []ClientRevertCommitFuncCall
+	mutex       sync.Mutex
+}
+
+// RevertCommit delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) RevertCommit(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 RevertCommitOptions) (api.CommitID, error) {
+	r0, r1 := m.RevertCommitFunc.nextHook()(v0, v1, v2, v3)
+	m.RevertCommitFunc.appendCall(ClientRevertCommitFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RevertCommit method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientRevertCommitFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, RevertCommitOptions) (api.CommitID, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RevertCommit method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientRevertCommitFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, RevertCommitOptions) (api.CommitID, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientRevertCommitFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, RevertCommitOptions) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientRevertCommitFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, RevertCommitOptions) (api.CommitID, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientRevertCommitFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, RevertCommitOptions) (api.CommitID, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientRevertCommitFunc) appendCall(r0 ClientRevertCommitFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientRevertCommitFuncCall objects
+// describing the invocations of this function.
+func (f *ClientRevertCommitFunc) History() []ClientRevertCommitFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientRevertCommitFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientRevertCommitFuncCall is an object that describes an invocation of
+// method RevertCommit on an instance of MockClient.
+type ClientRevertCommitFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 RevertCommitOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.CommitID
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientRevertCommitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientRevertCommitFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientScopedFunc describes the behavior when the Scoped method of the
+// parent MockClient instance is invoked.
+type ClientScopedFunc struct {
+	defaultHook func(string) Client
+	hooks       []func(string) Client
+	history     []ClientScopedFuncCall
+	mutex       sync.Mutex
+}
+
+// Scoped delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) Scoped(v0 string) Client {
+	r0 := m.ScopedFunc.nextHook()(v0)
+	m.ScopedFunc.appendCall(ClientScopedFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Scoped method of the
+// parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientScopedFunc) SetDefaultHook(hook func(string) Client) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Scoped method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientScopedFunc) PushHook(hook func(string) Client) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientScopedFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(string) Client {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientScopedFunc) PushReturn(r0 Client) {
+	f.PushHook(func(string) Client {
+		return r0
+	})
+}
+
+func (f *ClientScopedFunc) nextHook() func(string) Client {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientScopedFunc) appendCall(r0 ClientScopedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientScopedFuncCall objects describing the
+// invocations of this function.
+func (f *ClientScopedFunc) History() []ClientScopedFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientScopedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientScopedFuncCall is an object that describes an invocation of method
+// Scoped on an instance of MockClient.
+type ClientScopedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 Client
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientScopedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientScopedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// ClientScopedToPathFunc describes the behavior when the ScopedToPath
+// method of the parent MockClient instance is invoked.
+type ClientScopedToPathFunc struct {
+	defaultHook func(api.RepoName, string) (*PathScopedClient, error)
+	hooks       []func(api.RepoName, string) (*PathScopedClient, error)
+	history     []ClientScopedToPathFuncCall
+	mutex       sync.Mutex
+}
+
+// ScopedToPath delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) ScopedToPath(v0 api.RepoName, v1 string) (*PathScopedClient, error) {
+	r0, r1 := m.ScopedToPathFunc.nextHook()(v0, v1)
+	m.ScopedToPathFunc.appendCall(ClientScopedToPathFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ScopedToPath method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientScopedToPathFunc) SetDefaultHook(hook func(api.RepoName, string) (*PathScopedClient, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ScopedToPath method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientScopedToPathFunc) PushHook(hook func(api.RepoName, string) (*PathScopedClient, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientScopedToPathFunc) SetDefaultReturn(r0 *PathScopedClient, r1 error) {
+	f.SetDefaultHook(func(api.RepoName, string) (*PathScopedClient, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientScopedToPathFunc) PushReturn(r0 *PathScopedClient, r1 error) {
+	f.PushHook(func(api.RepoName, string) (*PathScopedClient, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientScopedToPathFunc) nextHook() func(api.RepoName, string) (*PathScopedClient, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientScopedToPathFunc) appendCall(r0 ClientScopedToPathFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientScopedToPathFuncCall objects
+// describing the invocations of this function.
+func (f *ClientScopedToPathFunc) History() []ClientScopedToPathFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientScopedToPathFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientScopedToPathFuncCall is an object that describes an invocation of
+// method ScopedToPath on an instance of MockClient.
+type ClientScopedToPathFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 api.RepoName
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *PathScopedClient
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientScopedToPathFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientScopedToPathFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ClientSearchFunc describes the behavior when the Search method of the
+// parent MockClient instance is invoked.
+type ClientSearchFunc struct {
+	defaultHook func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error)
+	hooks       []func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error)
+	history     []ClientSearchFuncCall
 	mutex       sync.Mutex
 }
 
-// IsRepoCloneable delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockClient) IsRepoCloneable(v0 context.Context, v1 api.RepoName) error {
-	r0 := m.IsRepoCloneableFunc.nextHook()(v0, v1)
-	m.IsRepoCloneableFunc.appendCall(ClientIsRepoCloneableFuncCall{v0, v1, r0})
-	return r0
+// Search delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) Search(v0 context.Context, v1 *protocol.SearchRequest, v2 func([]protocol.CommitMatch)) (bool, error) {
+	r0, r1 := m.SearchFunc.nextHook()(v0, v1, v2)
+	m.SearchFunc.appendCall(ClientSearchFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the IsRepoCloneable
-// method of the parent MockClient instance is invoked and the hook queue is
-// empty.
-func (f *ClientIsRepoCloneableFunc) SetDefaultHook(hook func(context.Context, api.RepoName) error) {
+// SetDefaultHook sets function that is called when the Search method of the
+// parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientSearchFunc) SetDefaultHook(hook func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IsRepoCloneable method of the parent MockClient instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ClientIsRepoCloneableFunc) PushHook(hook func(context.Context, api.RepoName) error) {
+// Search method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientSearchFunc) PushHook(hook func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2897,20 +11183,20 @@ func (f *ClientIsRepoCloneableFunc) PushHook(hook func(context.Context, api.Repo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientIsRepoCloneableFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) error {
-		return r0
+func (f *ClientSearchFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientIsRepoCloneableFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, api.RepoName) error {
-		return r0
+func (f *ClientSearchFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error) {
+		return r0, r1
 	})
 }
 
-func (f *ClientIsRepoCloneableFunc) nextHook() func(context.Context, api.RepoName) error {
+func (f *ClientSearchFunc) nextHook() func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2923,79 +11209,83 @@ func (f *ClientIsRepoCloneableFunc) nextHook() func(context.Context, api.RepoNam
 	return hook
 }
 
-func (f *ClientIsRepoCloneableFunc) appendCall(r0 ClientIsRepoCloneableFuncCall) {
+func (f *ClientSearchFunc) appendCall(r0 ClientSearchFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientIsRepoCloneableFuncCall objects
-// describing the invocations of this function.
-func (f *ClientIsRepoCloneableFunc) History() []ClientIsRepoCloneableFuncCall {
+// History returns a sequence of ClientSearchFuncCall objects describing the
+// invocations of this function.
+func (f *ClientSearchFunc) History() []ClientSearchFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientIsRepoCloneableFuncCall, len(f.history))
+	history := make([]ClientSearchFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientIsRepoCloneableFuncCall is an object that describes an invocation
-// of method IsRepoCloneable on an instance of MockClient.
-type ClientIsRepoCloneableFuncCall struct {
+// ClientSearchFuncCall is an object that describes an invocation of method
+// Search on an instance of MockClient.
+type ClientSearchFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoName
+	Arg1 *protocol.SearchRequest
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 func([]protocol.CommitMatch)
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientIsRepoCloneableFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ClientSearchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientIsRepoCloneableFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c ClientSearchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientListDirectoryChildrenFunc describes the behavior when the
-// ListDirectoryChildren method of the parent MockClient instance is
-// invoked.
-type ClientListDirectoryChildrenFunc struct {
-	defaultHook func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error)
-	hooks       []func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error)
-	history     []ClientListDirectoryChildrenFuncCall
+// ClientSearchCommitsFunc describes the behavior when the SearchCommits
+// method of the parent MockClient instance is invoked.
+type ClientSearchCommitsFunc struct {
+	defaultHook func(context.Context, api.RepoName, CommitSearchQuery, func([]protocol.CommitMatch)) (bool, error)
+	hooks       []func(context.Context, api.RepoName, CommitSearchQuery, func([]protocol.CommitMatch)) (bool, error)
+	history     []ClientSearchCommitsFuncCall
 	mutex       sync.Mutex
 }
 
-// ListDirectoryChildren delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockClient) ListDirectoryChildren(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 []string) (map[string][]string, error) {
-	r0, r1 := m.ListDirectoryChildrenFunc.nextHook()(v0, v1, v2, v3)
-	m.ListDirectoryChildrenFunc.appendCall(ClientListDirectoryChildrenFuncCall{v0, v1, v2, v3, r0, r1})
+// SearchCommits delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) SearchCommits(v0 context.Context, v1 api.RepoName, v2 CommitSearchQuery, v3 func([]protocol.CommitMatch)) (bool, error) {
+	r0, r1 := m.SearchCommitsFunc.nextHook()(v0, v1, v2, v3)
+	m.SearchCommitsFunc.appendCall(ClientSearchCommitsFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// ListDirectoryChildren method of the parent MockClient instance is invoked
-// and the hook queue is empty.
-func (f *ClientListDirectoryChildrenFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error)) {
+// SetDefaultHook sets function that is called when the SearchCommits method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientSearchCommitsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, CommitSearchQuery, func([]protocol.CommitMatch)) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListDirectoryChildren method of the parent MockClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *ClientListDirectoryChildrenFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error)) {
+// SearchCommits method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientSearchCommitsFunc) PushHook(hook func(context.Context, api.RepoName, CommitSearchQuery, func([]protocol.CommitMatch)) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3003,20 +11293,20 @@ func (f *ClientListDirectoryChildrenFunc) PushHook(hook func(context.Context, ap
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientListDirectoryChildrenFunc) SetDefaultReturn(r0 map[string][]string, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error) {
+func (f *ClientSearchCommitsFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, CommitSearchQuery, func([]protocol.CommitMatch)) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientListDirectoryChildrenFunc) PushReturn(r0 map[string][]string, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error) {
+func (f *ClientSearchCommitsFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, CommitSearchQuery, func([]protocol.CommitMatch)) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientListDirectoryChildrenFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, []string) (map[string][]string, error) {
+func (f *ClientSearchCommitsFunc) nextHook() func(context.Context, api.RepoName, CommitSearchQuery, func([]protocol.CommitMatch)) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3029,26 +11319,26 @@ func (f *ClientListDirectoryChildrenFunc) nextHook() func(context.Context, api.R
 	return hook
 }
 
-func (f *ClientListDirectoryChildrenFunc) appendCall(r0 ClientListDirectoryChildrenFuncCall) {
+func (f *ClientSearchCommitsFunc) appendCall(r0 ClientSearchCommitsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientListDirectoryChildrenFuncCall objects
+// History returns a sequence of ClientSearchCommitsFuncCall objects
 // describing the invocations of this function.
-func (f *ClientListDirectoryChildrenFunc) History() []ClientListDirectoryChildrenFuncCall {
+func (f *ClientSearchCommitsFunc) History() []ClientSearchCommitsFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientListDirectoryChildrenFuncCall, len(f.history))
+	history := make([]ClientSearchCommitsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientListDirectoryChildrenFuncCall is an object that describes an
-// invocation of method ListDirectoryChildren on an instance of MockClient.
-type ClientListDirectoryChildrenFuncCall struct {
+// ClientSearchCommitsFuncCall is an object that describes an invocation of
+// method SearchCommits on an instance of MockClient.
+type ClientSearchCommitsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -3057,13 +11347,13 @@ type ClientListDirectoryChildrenFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 api.CommitID
+	Arg2 CommitSearchQuery
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 []string
+	Arg3 func([]protocol.CommitMatch)
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 map[string][]string
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3071,45 +11361,45 @@ type ClientListDirectoryChildrenFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientListDirectoryChildrenFuncCall) Args() []interface{} {
+func (c ClientSearchCommitsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientListDirectoryChildrenFuncCall) Results() []interface{} {
+func (c ClientSearchCommitsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientListGitoliteReposFunc describes the behavior when the
-// ListGitoliteRepos method of the parent MockClient instance is invoked.
-type ClientListGitoliteReposFunc struct {
-	defaultHook func(context.Context, string) ([]*gitolite.Repo, error)
-	hooks       []func(context.Context, string) ([]*gitolite.Repo, error)
-	history     []ClientListGitoliteReposFuncCall
+// ClientSparseManifestFunc describes the behavior when the SparseManifest
+// method of the parent MockClient instance is invoked.
+type ClientSparseManifestFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, []string) ([]gitdomain.TreeEntry, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, []string) ([]gitdomain.TreeEntry, error)
+	history     []ClientSparseManifestFuncCall
 	mutex       sync.Mutex
 }
 
-// ListGitoliteRepos delegates to the next hook function in the queue and
+// SparseManifest delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockClient) ListGitoliteRepos(v0 context.Context, v1 string) ([]*gitolite.Repo, error) {
-	r0, r1 := m.ListGitoliteReposFunc.nextHook()(v0, v1)
-	m.ListGitoliteReposFunc.appendCall(ClientListGitoliteReposFuncCall{v0, v1, r0, r1})
+func (m *MockClient) SparseManifest(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 []string) ([]gitdomain.TreeEntry, error) {
+	r0, r1 := m.SparseManifestFunc.nextHook()(v0, v1, v2, v3)
+	m.SparseManifestFunc.appendCall(ClientSparseManifestFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ListGitoliteRepos
+// SetDefaultHook sets function that is called when the SparseManifest
 // method of the parent MockClient instance is invoked and the hook queue is
 // empty.
-func (f *ClientListGitoliteReposFunc) SetDefaultHook(hook func(context.Context, string) ([]*gitolite.Repo, error)) {
+func (f *ClientSparseManifestFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, []string) ([]gitdomain.TreeEntry, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListGitoliteRepos method of the parent MockClient instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *ClientListGitoliteReposFunc) PushHook(hook func(context.Context, string) ([]*gitolite.Repo, error)) {
+// SparseManifest method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientSparseManifestFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, []string) ([]gitdomain.TreeEntry, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3117,20 +11407,20 @@ func (f *ClientListGitoliteReposFunc) PushHook(hook func(context.Context, string
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientListGitoliteReposFunc) SetDefaultReturn(r0 []*gitolite.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, string) ([]*gitolite.Repo, error) {
+func (f *ClientSparseManifestFunc) SetDefaultReturn(r0 []gitdomain.TreeEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, []string) ([]gitdomain.TreeEntry, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientListGitoliteReposFunc) PushReturn(r0 []*gitolite.Repo, r1 error) {
-	f.PushHook(func(context.Context, string) ([]*gitolite.Repo, error) {
+func (f *ClientSparseManifestFunc) PushReturn(r0 []gitdomain.TreeEntry, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, []string) ([]gitdomain.TreeEntry, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientListGitoliteReposFunc) nextHook() func(context.Context, string) ([]*gitolite.Repo, error) {
+func (f *ClientSparseManifestFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, []string) ([]gitdomain.TreeEntry, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3143,35 +11433,41 @@ func (f *ClientListGitoliteReposFunc) nextHook() func(context.Context, string) (
 	return hook
 }
 
-func (f *ClientListGitoliteReposFunc) appendCall(r0 ClientListGitoliteReposFuncCall) {
+func (f *ClientSparseManifestFunc) appendCall(r0 ClientSparseManifestFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientListGitoliteReposFuncCall objects
+// History returns a sequence of ClientSparseManifestFuncCall objects
 // describing the invocations of this function.
-func (f *ClientListGitoliteReposFunc) History() []ClientListGitoliteReposFuncCall {
+func (f *ClientSparseManifestFunc) History() []ClientSparseManifestFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientListGitoliteReposFuncCall, len(f.history))
+	history := make([]ClientSparseManifestFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientListGitoliteReposFuncCall is an object that describes an invocation
-// of method ListGitoliteRepos on an instance of MockClient.
-type ClientListGitoliteReposFuncCall struct {
+// ClientSparseManifestFuncCall is an object that describes an invocation of
+// method SparseManifest on an instance of MockClient.
+type ClientSparseManifestFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*gitolite.Repo
+	Result0 []gitdomain.TreeEntry
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3179,44 +11475,44 @@ type ClientListGitoliteReposFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientListGitoliteReposFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ClientSparseManifestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientListGitoliteReposFuncCall) Results() []interface{} {
+func (c ClientSparseManifestFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientListRefsFunc describes the behavior when the ListRefs method of the
-// parent MockClient instance is invoked.
-type ClientListRefsFunc struct {
-	defaultHook func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error)
-	hooks       []func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error)
-	history     []ClientListRefsFuncCall
+// ClientSquashCommitsFunc describes the behavior when the SquashCommits
+// method of the parent MockClient instance is invoked.
+type ClientSquashCommitsFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOptions) (api.CommitID, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOptions) (api.CommitID, error)
+	history     []ClientSquashCommitsFuncCall
 	mutex       sync.Mutex
 }
 
-// ListRefs delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) ListRefs(v0 context.Context, v1 api.RepoName, v2 ListRefsOpts) ([]gitdomain.Ref, error) {
-	r0, r1 := m.ListRefsFunc.nextHook()(v0, v1, v2)
-	m.ListRefsFunc.appendCall(ClientListRefsFuncCall{v0, v1, v2, r0, r1})
+// SquashCommits delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) SquashCommits(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 api.CommitID, v4 SquashCommitsOptions) (api.CommitID, error) {
+	r0, r1 := m.SquashCommitsFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.SquashCommitsFunc.appendCall(ClientSquashCommitsFuncCall{v0, v1, v2, v3, v4, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ListRefs method of
-// the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientListRefsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error)) {
+// SetDefaultHook sets function that is called when the SquashCommits method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientSquashCommitsFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOptions) (api.CommitID, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListRefs method of the parent MockClient instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *ClientListRefsFunc) PushHook(hook func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error)) {
+// SquashCommits method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientSquashCommitsFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOptions) (api.CommitID, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3224,20 +11520,20 @@ func (f *ClientListRefsFunc) PushHook(hook func(context.Context, api.RepoName, L
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientListRefsFunc) SetDefaultReturn(r0 []gitdomain.Ref, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error) {
+func (f *ClientSquashCommitsFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOptions) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientListRefsFunc) PushReturn(r0 []gitdomain.Ref, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error) {
+func (f *ClientSquashCommitsFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOptions) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientListRefsFunc) nextHook() func(context.Context, api.RepoName, ListRefsOpts) ([]gitdomain.Ref, error) {
+func (f *ClientSquashCommitsFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, api.CommitID, SquashCommitsOptions) (api.CommitID, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3250,26 +11546,26 @@ func (f *ClientListRefsFunc) nextHook() func(context.Context, api.RepoName, List
 	return hook
 }
 
-func (f *ClientListRefsFunc) appendCall(r0 ClientListRefsFuncCall) {
+func (f *ClientSquashCommitsFunc) appendCall(r0 ClientSquashCommitsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientListRefsFuncCall objects describing
-// the invocations of this function.
-func (f *ClientListRefsFunc) History() []ClientListRefsFuncCall {
+// History returns a sequence of ClientSquashCommitsFuncCall objects
+// describing the invocations of this function.
+func (f *ClientSquashCommitsFunc) History() []ClientSquashCommitsFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientListRefsFuncCall, len(f.history))
+	history := make([]ClientSquashCommitsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientListRefsFuncCall is an object that describes an invocation of
-// method ListRefs on an instance of MockClient.
-type ClientListRefsFuncCall struct {
+// ClientSquashCommitsFuncCall is an object that describes an invocation of
+// method SquashCommits on an instance of MockClient.
+type ClientSquashCommitsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -3278,10 +11574,16 @@ type ClientListRefsFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 ListRefsOpts
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 SquashCommitsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []gitdomain.Ref
+	Result0 api.CommitID
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3289,45 +11591,44 @@ type ClientListRefsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientListRefsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c ClientSquashCommitsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientListRefsFuncCall) Results() []interface{} {
+func (c ClientSquashCommitsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientLogReverseEachFunc describes the behavior when the LogReverseEach
-// method of the parent MockClient instance is invoked.
-type ClientLogReverseEachFunc struct {
-	defaultHook func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error
-	hooks       []func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error
-	history     []ClientLogReverseEachFuncCall
+// ClientStatFunc describes the behavior when the Stat method of the parent
+// MockClient instance is invoked.
+type ClientStatFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error)
+	history     []ClientStatFuncCall
 	mutex       sync.Mutex
 }
 
-// LogReverseEach delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockClient) LogReverseEach(v0 context.Context, v1 string, v2 string, v3 int, v4 func(entry gitdomain.LogEntry) error) error {
-	r0 := m.LogReverseEachFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.LogReverseEachFunc.appendCall(ClientLogReverseEachFuncCall{v0, v1, v2, v3, v4, r0})
-	return r0
+// Stat delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) Stat(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string) (fs.FileInfo, error) {
+	r0, r1 := m.StatFunc.nextHook()(v0, v1, v2, v3)
+	m.StatFunc.appendCall(ClientStatFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the LogReverseEach
-// method of the parent MockClient instance is invoked and the hook queue is
-// empty.
-func (f *ClientLogReverseEachFunc) SetDefaultHook(hook func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error) {
+// SetDefaultHook sets function that is called when the Stat method of the
+// parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientStatFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// LogReverseEach method of the parent MockClient instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ClientLogReverseEachFunc) PushHook(hook func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error) {
+// Stat method of the parent MockClient instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *ClientStatFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3335,20 +11636,20 @@ func (f *ClientLogReverseEachFunc) PushHook(hook func(context.Context, string, s
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientLogReverseEachFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error {
-		return r0
+func (f *ClientStatFunc) SetDefaultReturn(r0 fs.FileInfo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientLogReverseEachFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error {
-		return r0
+func (f *ClientStatFunc) PushReturn(r0 fs.FileInfo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error) {
+		return r0, r1
 	})
 }
 
-func (f *ClientLogReverseEachFunc) nextHook() func(context.Context, string, string, int, func(entry gitdomain.LogEntry) error) error {
+func (f *ClientStatFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3361,86 +11662,87 @@ func (f *ClientLogReverseEachFunc) nextHook() func(context.Context, string, stri
 	return hook
 }
 
-func (f *ClientLogReverseEachFunc) appendCall(r0 ClientLogReverseEachFuncCall) {
+func (f *ClientStatFunc) appendCall(r0 ClientStatFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientLogReverseEachFuncCall objects
-// describing the invocations of this function.
-func (f *ClientLogReverseEachFunc) History() []ClientLogReverseEachFuncCall {
+// History returns a sequence of ClientStatFuncCall objects describing the
+// invocations of this function.
+func (f *ClientStatFunc) History() []ClientStatFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientLogReverseEachFuncCall, len(f.history))
+	history := make([]ClientStatFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientLogReverseEachFuncCall is an object that describes an invocation of
-// method LogReverseEach on an instance of MockClient.
-type ClientLogReverseEachFuncCall struct {
+// ClientStatFuncCall is an object that describes an invocation of method
+// Stat on an instance of MockClient.
+type ClientStatFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
+	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
+	Arg2 api.CommitID
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 func(entry gitdomain.LogEntry) error
+	Arg3 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 fs.FileInfo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientLogReverseEachFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c ClientStatFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientLogReverseEachFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c ClientStatFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientLsFilesFunc describes the behavior when the LsFiles method of the
-// parent MockClient instance is invoked.
-type ClientLsFilesFunc struct {
-	defaultHook func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error)
-	hooks       []func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error)
-	history     []ClientLsFilesFuncCall
+// ClientStreamBlameFileFunc describes the behavior when the StreamBlameFile
+// method of the parent MockClient instance is invoked.
+type ClientStreamBlameFileFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error)
+	hooks       []func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error)
+	history     []ClientStreamBlameFileFuncCall
 	mutex       sync.Mutex
 }
 
-// LsFiles delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) LsFiles(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 ...gitdomain.Pathspec) ([]string, error) {
-	r0, r1 := m.LsFilesFunc.nextHook()(v0, v1, v2, v3...)
-	m.LsFilesFunc.appendCall(ClientLsFilesFuncCall{v0, v1, v2, v3, r0, r1})
+// StreamBlameFile delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) StreamBlameFile(v0 context.Context, v1 api.RepoName, v2 string, v3 *BlameOptions) (HunkReader, error) {
+	r0, r1 := m.StreamBlameFileFunc.nextHook()(v0, v1, v2, v3)
+	m.StreamBlameFileFunc.appendCall(ClientStreamBlameFileFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the LsFiles method of
-// the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientLsFilesFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error)) {
+// SetDefaultHook sets function that is called when the StreamBlameFile
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientStreamBlameFileFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// LsFiles method of the parent MockClient instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *ClientLsFilesFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error)) {
+// StreamBlameFile method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientStreamBlameFileFunc) PushHook(hook func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3448,20 +11750,20 @@ func (f *ClientLsFilesFunc) PushHook(hook func(context.Context, api.RepoName, ap
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientLsFilesFunc) SetDefaultReturn(r0 []string, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error) {
+func (f *ClientStreamBlameFileFunc) SetDefaultReturn(r0 HunkReader, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientLsFilesFunc) PushReturn(r0 []string, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error) {
+func (f *ClientStreamBlameFileFunc) PushReturn(r0 HunkReader, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientLsFilesFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, ...gitdomain.Pathspec) ([]string, error) {
+func (f *ClientStreamBlameFileFunc) nextHook() func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3474,26 +11776,26 @@ func (f *ClientLsFilesFunc) nextHook() func(context.Context, api.RepoName, api.C
 	return hook
 }
 
-func (f *ClientLsFilesFunc) appendCall(r0 ClientLsFilesFuncCall) {
+func (f *ClientStreamBlameFileFunc) appendCall(r0 ClientStreamBlameFileFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientLsFilesFuncCall objects describing
-// the invocations of this function.
-func (f *ClientLsFilesFunc) History() []ClientLsFilesFuncCall {
+// History returns a sequence of ClientStreamBlameFileFuncCall objects
+// describing the invocations of this function.
+func (f *ClientStreamBlameFileFunc) History() []ClientStreamBlameFileFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientLsFilesFuncCall, len(f.history))
+	history := make([]ClientStreamBlameFileFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientLsFilesFuncCall is an object that describes an invocation of method
-// LsFiles on an instance of MockClient.
-type ClientLsFilesFuncCall struct {
+// ClientStreamBlameFileFuncCall is an object that describes an invocation
+// of method StreamBlameFile on an instance of MockClient.
+type ClientStreamBlameFileFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -3502,65 +11804,59 @@ type ClientLsFilesFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 api.CommitID
-	// Arg3 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg3 []gitdomain.Pathspec
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 *BlameOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []string
+	Result0 HunkReader
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c ClientLsFilesFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg3 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0, c.Arg1, c.Arg2}, trailing...)
+// invocation.
+func (c ClientStreamBlameFileFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientLsFilesFuncCall) Results() []interface{} {
+func (c ClientStreamBlameFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientMergeBaseFunc describes the behavior when the MergeBase method of
-// the parent MockClient instance is invoked.
-type ClientMergeBaseFunc struct {
-	defaultHook func(context.Context, api.RepoName, string, string) (api.CommitID, error)
-	hooks       []func(context.Context, api.RepoName, string, string) (api.CommitID, error)
-	history     []ClientMergeBaseFuncCall
+// ClientStreamCommitGraphFunc describes the behavior when the
+// StreamCommitGraph method of the parent MockClient instance is invoked.
+type ClientStreamCommitGraphFunc struct {
+	defaultHook func(context.Context, api.RepoName, StreamCommitGraphOptions) (CommitGraphNodeReader, error)
+	hooks       []func(context.Context, api.RepoName, StreamCommitGraphOptions) (CommitGraphNodeReader, error)
+	history     []ClientStreamCommitGraphFuncCall
 	mutex       sync.Mutex
 }
 
-// MergeBase delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) MergeBase(v0 context.Context, v1 api.RepoName, v2 string, v3 string) (api.CommitID, error) {
-	r0, r1 := m.MergeBaseFunc.nextHook()(v0, v1, v2, v3)
-	m.MergeBaseFunc.appendCall(ClientMergeBaseFuncCall{v0, v1, v2, v3, r0, r1})
+// StreamCommitGraph delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) StreamCommitGraph(v0 context.Context, v1 api.RepoName, v2 StreamCommitGraphOptions) (CommitGraphNodeReader, error) {
+	r0, r1 := m.StreamCommitGraphFunc.nextHook()(v0, v1, v2)
+	m.StreamCommitGraphFunc.appendCall(ClientStreamCommitGraphFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the MergeBase method of
-// the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientMergeBaseFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, string) (api.CommitID, error)) {
+// SetDefaultHook sets function that is called when the StreamCommitGraph
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientStreamCommitGraphFunc) SetDefaultHook(hook func(context.Context, api.RepoName, StreamCommitGraphOptions) (CommitGraphNodeReader, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// MergeBase method of the parent MockClient instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ClientMergeBaseFunc) PushHook(hook func(context.Context, api.RepoName, string, string) (api.CommitID, error)) {
+// StreamCommitGraph method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientStreamCommitGraphFunc) PushHook(hook func(context.Context, api.RepoName, StreamCommitGraphOptions) (CommitGraphNodeReader, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3568,20 +11864,20 @@ func (f *ClientMergeBaseFunc) PushHook(hook func(context.Context, api.RepoName,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientMergeBaseFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, string, string) (api.CommitID, error) {
+func (f *ClientStreamCommitGraphFunc) SetDefaultReturn(r0 CommitGraphNodeReader, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, StreamCommitGraphOptions) (CommitGraphNodeReader, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientMergeBaseFunc) PushReturn(r0 api.CommitID, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, string, string) (api.CommitID, error) {
+func (f *ClientStreamCommitGraphFunc) PushReturn(r0 CommitGraphNodeReader, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, StreamCommitGraphOptions) (CommitGraphNodeReader, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientMergeBaseFunc) nextHook() func(context.Context, api.RepoName, string, string) (api.CommitID, error) {
+func (f *ClientStreamCommitGraphFunc) nextHook() func(context.Context, api.RepoName, StreamCommitGraphOptions) (CommitGraphNodeReader, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3594,26 +11890,26 @@ func (f *ClientMergeBaseFunc) nextHook() func(context.Context, api.RepoName, str
 	return hook
 }
 
-func (f *ClientMergeBaseFunc) appendCall(r0 ClientMergeBaseFuncCall) {
+func (f *ClientStreamCommitGraphFunc) appendCall(r0 ClientStreamCommitGraphFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientMergeBaseFuncCall objects describing
-// the invocations of this function.
-func (f *ClientMergeBaseFunc) History() []ClientMergeBaseFuncCall {
+// History returns a sequence of ClientStreamCommitGraphFuncCall objects
+// describing the invocations of this function.
+func (f *ClientStreamCommitGraphFunc) History() []ClientStreamCommitGraphFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientMergeBaseFuncCall, len(f.history))
+	history := make([]ClientStreamCommitGraphFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientMergeBaseFuncCall is an object that describes an invocation of
-// method MergeBase on an instance of MockClient.
-type ClientMergeBaseFuncCall struct {
+// ClientStreamCommitGraphFuncCall is an object that describes an invocation
+// of method StreamCommitGraph on an instance of MockClient.
+type ClientStreamCommitGraphFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -3622,13 +11918,10 @@ type ClientMergeBaseFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 string
+	Arg2 StreamCommitGraphOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 api.CommitID
+	Result0 CommitGraphNodeReader
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3636,44 +11929,44 @@ type ClientMergeBaseFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientMergeBaseFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c ClientStreamCommitGraphFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientMergeBaseFuncCall) Results() []interface{} {
+func (c ClientStreamCommitGraphFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientNewFileReaderFunc describes the behavior when the NewFileReader
-// method of the parent MockClient instance is invoked.
-type ClientNewFileReaderFunc struct {
-	defaultHook func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)
-	hooks       []func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)
-	history     []ClientNewFileReaderFuncCall
+// ClientSymbolicRefFunc describes the behavior when the SymbolicRef method
+// of the parent MockClient instance is invoked.
+type ClientSymbolicRefFunc struct {
+	defaultHook func(context.Context, api.RepoName, string) (string, error)
+	hooks       []func(context.Context, api.RepoName, string) (string, error)
+	history     []ClientSymbolicRefFuncCall
 	mutex       sync.Mutex
 }
 
-// NewFileReader delegates to the next hook function in the queue and stores
+// SymbolicRef delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockClient) NewFileReader(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string) (io.ReadCloser, error) {
-	r0, r1 := m.NewFileReaderFunc.nextHook()(v0, v1, v2, v3)
-	m.NewFileReaderFunc.appendCall(ClientNewFileReaderFuncCall{v0, v1, v2, v3, r0, r1})
+func (m *MockClient) SymbolicRef(v0 context.Context, v1 api.RepoName, v2 string) (string, error) {
+	r0, r1 := m.SymbolicRefFunc.nextHook()(v0, v1, v2)
+	m.SymbolicRefFunc.appendCall(ClientSymbolicRefFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the NewFileReader method
+// SetDefaultHook sets function that is called when the SymbolicRef method
 // of the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientNewFileReaderFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)) {
+func (f *ClientSymbolicRefFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string) (string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// NewFileReader method of the parent MockClient instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
+// SymbolicRef method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *ClientNewFileReaderFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error)) {
+func (f *ClientSymbolicRefFunc) PushHook(hook func(context.Context, api.RepoName, string) (string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3681,20 +11974,20 @@ func (f *ClientNewFileReaderFunc) PushHook(hook func(context.Context, api.RepoNa
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientNewFileReaderFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+func (f *ClientSymbolicRefFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string) (string, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientNewFileReaderFunc) PushReturn(r0 io.ReadCloser, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+func (f *ClientSymbolicRefFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, string) (string, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientNewFileReaderFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string) (io.ReadCloser, error) {
+func (f *ClientSymbolicRefFunc) nextHook() func(context.Context, api.RepoName, string) (string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3707,26 +12000,26 @@ func (f *ClientNewFileReaderFunc) nextHook() func(context.Context, api.RepoName,
 	return hook
 }
 
-func (f *ClientNewFileReaderFunc) appendCall(r0 ClientNewFileReaderFuncCall) {
+func (f *ClientSymbolicRefFunc) appendCall(r0 ClientSymbolicRefFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientNewFileReaderFuncCall objects
+// History returns a sequence of ClientSymbolicRefFuncCall objects
 // describing the invocations of this function.
-func (f *ClientNewFileReaderFunc) History() []ClientNewFileReaderFuncCall {
+func (f *ClientSymbolicRefFunc) History() []ClientSymbolicRefFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientNewFileReaderFuncCall, len(f.history))
+	history := make([]ClientSymbolicRefFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientNewFileReaderFuncCall is an object that describes an invocation of
-// method NewFileReader on an instance of MockClient.
-type ClientNewFileReaderFuncCall struct {
+// ClientSymbolicRefFuncCall is an object that describes an invocation of
+// method SymbolicRef on an instance of MockClient.
+type ClientSymbolicRefFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -3735,13 +12028,10 @@ type ClientNewFileReaderFuncCall struct {
 	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 api.CommitID
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 string
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 io.ReadCloser
+	Result0 string
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3749,46 +12039,44 @@ type ClientNewFileReaderFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientNewFileReaderFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c ClientSymbolicRefFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientNewFileReaderFuncCall) Results() []interface{} {
+func (c ClientSymbolicRefFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientPerforceGetChangelistFunc describes the behavior when the
-// PerforceGetChangelist method of the parent MockClient instance is
-// invoked.
-type ClientPerforceGetChangelistFunc struct {
-	defaultHook func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error)
-	hooks       []func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error)
-	history     []ClientPerforceGetChangelistFuncCall
+// ClientSystemInfoFunc describes the behavior when the SystemInfo method of
+// the parent MockClient instance is invoked.
+type ClientSystemInfoFunc struct {
+	defaultHook func(context.Context, string) (protocol.SystemInfo, error)
+	hooks       []func(context.Context, string) (protocol.SystemInfo, error)
+	history     []ClientSystemInfoFuncCall
 	mutex       sync.Mutex
 }
 
-// PerforceGetChangelist delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockClient) PerforceGetChangelist(v0 context.Context, v1 protocol.PerforceConnectionDetails, v2 string) (*perforce.Changelist, error) {
-	r0, r1 := m.PerforceGetChangelistFunc.nextHook()(v0, v1, v2)
-	m.PerforceGetChangelistFunc.appendCall(ClientPerforceGetChangelistFuncCall{v0, v1, v2, r0, r1})
+// SystemInfo delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) SystemInfo(v0 context.Context, v1 string) (protocol.SystemInfo, error) {
+	r0, r1 := m.SystemInfoFunc.nextHook()(v0, v1)
+	m.SystemInfoFunc.appendCall(ClientSystemInfoFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
-
-// SetDefaultHook sets function that is called when the
-// PerforceGetChangelist method of the parent MockClient instance is invoked
-// and the hook queue is empty.
-func (f *ClientPerforceGetChangelistFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error)) {
+
+// SetDefaultHook sets function that is called when the SystemInfo method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientSystemInfoFunc) SetDefaultHook(hook func(context.Context, string) (protocol.SystemInfo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// PerforceGetChangelist method of the parent MockClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *ClientPerforceGetChangelistFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error)) {
+// SystemInfo method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientSystemInfoFunc) PushHook(hook func(context.Context, string) (protocol.SystemInfo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3796,20 +12084,20 @@ func (f *ClientPerforceGetChangelistFunc) PushHook(hook func(context.Context, pr
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientPerforceGetChangelistFunc) SetDefaultReturn(r0 *perforce.Changelist, r1 error) {
-	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error) {
+func (f *ClientSystemInfoFunc) SetDefaultReturn(r0 protocol.SystemInfo, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (protocol.SystemInfo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientPerforceGetChangelistFunc) PushReturn(r0 *perforce.Changelist, r1 error) {
-	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error) {
+func (f *ClientSystemInfoFunc) PushReturn(r0 protocol.SystemInfo, r1 error) {
+	f.PushHook(func(context.Context, string) (protocol.SystemInfo, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientPerforceGetChangelistFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails, string) (*perforce.Changelist, error) {
+func (f *ClientSystemInfoFunc) nextHook() func(context.Context, string) (protocol.SystemInfo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3822,38 +12110,35 @@ func (f *ClientPerforceGetChangelistFunc) nextHook() func(context.Context, proto
 	return hook
 }
 
-func (f *ClientPerforceGetChangelistFunc) appendCall(r0 ClientPerforceGetChangelistFuncCall) {
+func (f *ClientSystemInfoFunc) appendCall(r0 ClientSystemInfoFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientPerforceGetChangelistFuncCall objects
-// describing the invocations of this function.
-func (f *ClientPerforceGetChangelistFunc) History() []ClientPerforceGetChangelistFuncCall {
+// History returns a sequence of ClientSystemInfoFuncCall objects describing
+// the invocations of this function.
+func (f *ClientSystemInfoFunc) History() []ClientSystemInfoFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientPerforceGetChangelistFuncCall, len(f.history))
+	history := make([]ClientSystemInfoFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientPerforceGetChangelistFuncCall is an object that describes an
-// invocation of method PerforceGetChangelist on an instance of MockClient.
-type ClientPerforceGetChangelistFuncCall struct {
+// ClientSystemInfoFuncCall is an object that describes an invocation of
+// method SystemInfo on an instance of MockClient.
+type ClientSystemInfoFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 protocol.PerforceConnectionDetails
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
+	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *perforce.Changelist
+	Result0 protocol.SystemInfo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3861,45 +12146,44 @@ type ClientPerforceGetChangelistFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientPerforceGetChangelistFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c ClientSystemInfoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientPerforceGetChangelistFuncCall) Results() []interface{} {
+func (c ClientSystemInfoFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientPerforceGroupMembersFunc describes the behavior when the
-// PerforceGroupMembers method of the parent MockClient instance is invoked.
-type ClientPerforceGroupMembersFunc struct {
-	defaultHook func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error)
-	hooks       []func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error)
-	history     []ClientPerforceGroupMembersFuncCall
+// ClientSystemsInfoFunc describes the behavior when the SystemsInfo method
+// of the parent MockClient instance is invoked.
+type ClientSystemsInfoFunc struct {
+	defaultHook func(context.Context) ([]protocol.SystemInfo, error)
+	hooks       []func(context.Context) ([]protocol.SystemInfo, error)
+	history     []ClientSystemsInfoFuncCall
 	mutex       sync.Mutex
 }
 
-// PerforceGroupMembers delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockClient) PerforceGroupMembers(v0 context.Context, v1 protocol.PerforceConnectionDetails, v2 string) ([]string, error) {
-	r0, r1 := m.PerforceGroupMembersFunc.nextHook()(v0, v1, v2)
-	m.PerforceGroupMembersFunc.appendCall(ClientPerforceGroupMembersFuncCall{v0, v1, v2, r0, r1})
+// SystemsInfo delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) SystemsInfo(v0 context.Context) ([]protocol.SystemInfo, error) {
+	r0, r1 := m.SystemsInfoFunc.nextHook()(v0)
+	m.SystemsInfoFunc.appendCall(ClientSystemsInfoFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the PerforceGroupMembers
-// method of the parent MockClient instance is invoked and the hook queue is
-// empty.
-func (f *ClientPerforceGroupMembersFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error)) {
+// SetDefaultHook sets function that is called when the SystemsInfo method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientSystemsInfoFunc) SetDefaultHook(hook func(context.Context) ([]protocol.SystemInfo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// PerforceGroupMembers method of the parent MockClient instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *ClientPerforceGroupMembersFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error)) {
+// SystemsInfo method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientSystemsInfoFunc) PushHook(hook func(context.Context) ([]protocol.SystemInfo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3907,20 +12191,20 @@ func (f *ClientPerforceGroupMembersFunc) PushHook(hook func(context.Context, pro
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientPerforceGroupMembersFunc) SetDefaultReturn(r0 []string, r1 error) {
-	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error) {
+func (f *ClientSystemsInfoFunc) SetDefaultReturn(r0 []protocol.SystemInfo, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]protocol.SystemInfo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientPerforceGroupMembersFunc) PushReturn(r0 []string, r1 error) {
-	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error) {
+func (f *ClientSystemsInfoFunc) PushReturn(r0 []protocol.SystemInfo, r1 error) {
+	f.PushHook(func(context.Context) ([]protocol.SystemInfo, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientPerforceGroupMembersFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails, string) ([]string, error) {
+func (f *ClientSystemsInfoFunc) nextHook() func(context.Context) ([]protocol.SystemInfo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3933,38 +12217,32 @@ func (f *ClientPerforceGroupMembersFunc) nextHook() func(context.Context, protoc
 	return hook
 }
 
-func (f *ClientPerforceGroupMembersFunc) appendCall(r0 ClientPerforceGroupMembersFuncCall) {
+func (f *ClientSystemsInfoFunc) appendCall(r0 ClientSystemsInfoFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientPerforceGroupMembersFuncCall objects
+// History returns a sequence of ClientSystemsInfoFuncCall objects
 // describing the invocations of this function.
-func (f *ClientPerforceGroupMembersFunc) History() []ClientPerforceGroupMembersFuncCall {
+func (f *ClientSystemsInfoFunc) History() []ClientSystemsInfoFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientPerforceGroupMembersFuncCall, len(f.history))
+	history := make([]ClientSystemsInfoFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientPerforceGroupMembersFuncCall is an object that describes an
-// invocation of method PerforceGroupMembers on an instance of MockClient.
-type ClientPerforceGroupMembersFuncCall struct {
+// ClientSystemsInfoFuncCall is an object that describes an invocation of
+// method SystemsInfo on an instance of MockClient.
+type ClientSystemsInfoFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 protocol.PerforceConnectionDetails
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []string
+	Result0 []protocol.SystemInfo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3972,46 +12250,44 @@ type ClientPerforceGroupMembersFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientPerforceGroupMembersFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c ClientSystemsInfoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientPerforceGroupMembersFuncCall) Results() []interface{} {
+func (c ClientSystemsInfoFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientPerforceProtectsForDepotFunc describes the behavior when the
-// PerforceProtectsForDepot method of the parent MockClient instance is
-// invoked.
-type ClientPerforceProtectsForDepotFunc struct {
-	defaultHook func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)
-	hooks       []func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)
-	history     []ClientPerforceProtectsForDepotFuncCall
+// ClientUpdateRefFunc describes the behavior when the UpdateRef method of
+// the parent MockClient instance is invoked.
+type ClientUpdateRefFunc struct {
+	defaultHook func(context.Context, api.RepoName, string, api.CommitID, api.CommitID) error
+	hooks       []func(context.Context, api.RepoName, string, api.CommitID, api.CommitID) error
+	history     []ClientUpdateRefFuncCall
 	mutex       sync.Mutex
 }
 
-// PerforceProtectsForDepot delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockClient) PerforceProtectsForDepot(v0 context.Context, v1 protocol.PerforceConnectionDetails, v2 string) ([]*perforce.Protect, error) {
-	r0, r1 := m.PerforceProtectsForDepotFunc.nextHook()(v0, v1, v2)
-	m.PerforceProtectsForDepotFunc.appendCall(ClientPerforceProtectsForDepotFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+// UpdateRef delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) UpdateRef(v0 context.Context, v1 api.RepoName, v2 string, v3 api.CommitID, v4 api.CommitID) error {
+	r0 := m.UpdateRefFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.UpdateRefFunc.appendCall(ClientUpdateRefFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// PerforceProtectsForDepot method of the parent MockClient instance is
-// invoked and the hook queue is empty.
-func (f *ClientPerforceProtectsForDepotFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)) {
+// SetDefaultHook sets function that is called when the UpdateRef method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientUpdateRefFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, api.CommitID, api.CommitID) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// PerforceProtectsForDepot method of the parent MockClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *ClientPerforceProtectsForDepotFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)) {
+// UpdateRef method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientUpdateRefFunc) PushHook(hook func(context.Context, api.RepoName, string, api.CommitID, api.CommitID) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4019,20 +12295,20 @@ func (f *ClientPerforceProtectsForDepotFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientPerforceProtectsForDepotFunc) SetDefaultReturn(r0 []*perforce.Protect, r1 error) {
-	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
-		return r0, r1
+func (f *ClientUpdateRefFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, string, api.CommitID, api.CommitID) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientPerforceProtectsForDepotFunc) PushReturn(r0 []*perforce.Protect, r1 error) {
-	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
-		return r0, r1
+func (f *ClientUpdateRefFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, string, api.CommitID, api.CommitID) error {
+		return r0
 	})
 }
 
-func (f *ClientPerforceProtectsForDepotFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
+func (f *ClientUpdateRefFunc) nextHook() func(context.Context, api.RepoName, string, api.CommitID, api.CommitID) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4045,86 +12321,89 @@ func (f *ClientPerforceProtectsForDepotFunc) nextHook() func(context.Context, pr
 	return hook
 }
 
-func (f *ClientPerforceProtectsForDepotFunc) appendCall(r0 ClientPerforceProtectsForDepotFuncCall) {
+func (f *ClientUpdateRefFunc) appendCall(r0 ClientUpdateRefFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientPerforceProtectsForDepotFuncCall
-// objects describing the invocations of this function.
-func (f *ClientPerforceProtectsForDepotFunc) History() []ClientPerforceProtectsForDepotFuncCall {
+// History returns a sequence of ClientUpdateRefFuncCall objects describing
+// the invocations of this function.
+func (f *ClientUpdateRefFunc) History() []ClientUpdateRefFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientPerforceProtectsForDepotFuncCall, len(f.history))
+	history := make([]ClientUpdateRefFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientPerforceProtectsForDepotFuncCall is an object that describes an
-// invocation of method PerforceProtectsForDepot on an instance of
-// MockClient.
-type ClientPerforceProtectsForDepotFuncCall struct {
+// ClientUpdateRefFuncCall is an object that describes an invocation of
+// method UpdateRef on an instance of MockClient.
+type ClientUpdateRefFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 protocol.PerforceConnectionDetails
+	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
-	// Result0 is the value of the 1st result returned from this method
+	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Result0 []*perforce.Protect
-	// Result1 is the value of the 2nd result returned from this method
+	Arg3 api.CommitID
+	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
-	Result1 error
+	Arg4 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientPerforceProtectsForDepotFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c ClientUpdateRefFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientPerforceProtectsForDepotFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ClientUpdateRefFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// ClientPerforceProtectsForUserFunc describes the behavior when the
-// PerforceProtectsForUser method of the parent MockClient instance is
+// ClientWatchDefaultBranchCommitsFunc describes the behavior when the
+// WatchDefaultBranchCommits method of the parent MockClient instance is
 // invoked.
-type ClientPerforceProtectsForUserFunc struct {
-	defaultHook func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)
-	hooks       []func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)
-	history     []ClientPerforceProtectsForUserFuncCall
+type ClientWatchDefaultBranchCommitsFunc struct {
+	defaultHook func(context.Context, api.RepoName) (BranchCommitWatcher, error)
+	hooks       []func(context.Context, api.RepoName) (BranchCommitWatcher, error)
+	history     []ClientWatchDefaultBranchCommitsFuncCall
 	mutex       sync.Mutex
 }
 
-// PerforceProtectsForUser delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockClient) PerforceProtectsForUser(v0 context.Context, v1 protocol.PerforceConnectionDetails, v2 string) ([]*perforce.Protect, error) {
-	r0, r1 := m.PerforceProtectsForUserFunc.nextHook()(v0, v1, v2)
-	m.PerforceProtectsForUserFunc.appendCall(ClientPerforceProtectsForUserFuncCall{v0, v1, v2, r0, r1})
+// WatchDefaultBranchCommits delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockClient) WatchDefaultBranchCommits(v0 context.Context, v1 api.RepoName) (BranchCommitWatcher, error) {
+	r0, r1 := m.WatchDefaultBranchCommitsFunc.nextHook()(v0, v1)
+	m.WatchDefaultBranchCommitsFunc.appendCall(ClientWatchDefaultBranchCommitsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// PerforceProtectsForUser method of the parent MockClient instance is
+// WatchDefaultBranchCommits method of the parent MockClient instance is
 // invoked and the hook queue is empty.
-func (f *ClientPerforceProtectsForUserFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)) {
+func (f *ClientWatchDefaultBranchCommitsFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (BranchCommitWatcher, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// PerforceProtectsForUser method of the parent MockClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *ClientPerforceProtectsForUserFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error)) {
+// WatchDefaultBranchCommits method of the parent MockClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *ClientWatchDefaultBranchCommitsFunc) PushHook(hook func(context.Context, api.RepoName) (BranchCommitWatcher, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4132,20 +12411,20 @@ func (f *ClientPerforceProtectsForUserFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientPerforceProtectsForUserFunc) SetDefaultReturn(r0 []*perforce.Protect, r1 error) {
-	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
+func (f *ClientWatchDefaultBranchCommitsFunc) SetDefaultReturn(r0 BranchCommitWatcher, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (BranchCommitWatcher, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientPerforceProtectsForUserFunc) PushReturn(r0 []*perforce.Protect, r1 error) {
-	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
+func (f *ClientWatchDefaultBranchCommitsFunc) PushReturn(r0 BranchCommitWatcher, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (BranchCommitWatcher, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientPerforceProtectsForUserFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails, string) ([]*perforce.Protect, error) {
+func (f *ClientWatchDefaultBranchCommitsFunc) nextHook() func(context.Context, api.RepoName) (BranchCommitWatcher, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4158,39 +12437,36 @@ func (f *ClientPerforceProtectsForUserFunc) nextHook() func(context.Context, pro
 	return hook
 }
 
-func (f *ClientPerforceProtectsForUserFunc) appendCall(r0 ClientPerforceProtectsForUserFuncCall) {
+func (f *ClientWatchDefaultBranchCommitsFunc) appendCall(r0 ClientWatchDefaultBranchCommitsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientPerforceProtectsForUserFuncCall
+// History returns a sequence of ClientWatchDefaultBranchCommitsFuncCall
 // objects describing the invocations of this function.
-func (f *ClientPerforceProtectsForUserFunc) History() []ClientPerforceProtectsForUserFuncCall {
+func (f *ClientWatchDefaultBranchCommitsFunc) History() []ClientWatchDefaultBranchCommitsFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientPerforceProtectsForUserFuncCall, len(f.history))
+	history := make([]ClientWatchDefaultBranchCommitsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientPerforceProtectsForUserFuncCall is an object that describes an
-// invocation of method PerforceProtectsForUser on an instance of
+// ClientWatchDefaultBranchCommitsFuncCall is an object that describes an
+// invocation of method WatchDefaultBranchCommits on an instance of
 // MockClient.
-type ClientPerforceProtectsForUserFuncCall struct {
+type ClientWatchDefaultBranchCommitsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 protocol.PerforceConnectionDetails
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
+	Arg1 api.RepoName
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*perforce.Protect
+	Result0 BranchCommitWatcher
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -4198,44 +12474,44 @@ type ClientPerforceProtectsForUserFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientPerforceProtectsForUserFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c ClientWatchDefaultBranchCommitsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientPerforceProtectsForUserFuncCall) Results() []interface{} {
+func (c ClientWatchDefaultBranchCommitsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientPerforceUsersFunc describes the behavior when the PerforceUsers
-// method of the parent MockClient instance is invoked.
-type ClientPerforceUsersFunc struct {
-	defaultHook func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error)
-	hooks       []func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error)
-	history     []ClientPerforceUsersFuncCall
+// ClientWatchRefsFunc describes the behavior when the WatchRefs method of
+// the parent MockClient instance is invoked.
+type ClientWatchRefsFunc struct {
+	defaultHook func(context.Context, api.RepoName) (RefWatcher, error)
+	hooks       []func(context.Context, api.RepoName) (RefWatcher, error)
+	history     []ClientWatchRefsFuncCall
 	mutex       sync.Mutex
 }
 
-// PerforceUsers delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockClient) PerforceUsers(v0 context.Context, v1 protocol.PerforceConnectionDetails) ([]*perforce.User, error) {
-	r0, r1 := m.PerforceUsersFunc.nextHook()(v0, v1)
-	m.PerforceUsersFunc.appendCall(ClientPerforceUsersFuncCall{v0, v1, r0, r1})
+// WatchRefs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockClient) WatchRefs(v0 context.Context, v1 api.RepoName) (RefWatcher, error) {
+	r0, r1 := m.WatchRefsFunc.nextHook()(v0, v1)
+	m.WatchRefsFunc.appendCall(ClientWatchRefsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the PerforceUsers method
-// of the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientPerforceUsersFunc) SetDefaultHook(hook func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error)) {
+// SetDefaultHook sets function that is called when the WatchRefs method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientWatchRefsFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (RefWatcher, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// PerforceUsers method of the parent MockClient instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
+// WatchRefs method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *ClientPerforceUsersFunc) PushHook(hook func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error)) {
+func (f *ClientWatchRefsFunc) PushHook(hook func(context.Context, api.RepoName) (RefWatcher, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4243,20 +12519,20 @@ func (f *ClientPerforceUsersFunc) PushHook(hook func(context.Context, protocol.P
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientPerforceUsersFunc) SetDefaultReturn(r0 []*perforce.User, r1 error) {
-	f.SetDefaultHook(func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error) {
+func (f *ClientWatchRefsFunc) SetDefaultReturn(r0 RefWatcher, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (RefWatcher, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientPerforceUsersFunc) PushReturn(r0 []*perforce.User, r1 error) {
-	f.PushHook(func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error) {
+func (f *ClientWatchRefsFunc) PushReturn(r0 RefWatcher, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (RefWatcher, error) {
 		return r0, r1
 	})
 }
 
-func (f *ClientPerforceUsersFunc) nextHook() func(context.Context, protocol.PerforceConnectionDetails) ([]*perforce.User, error) {
+func (f *ClientWatchRefsFunc) nextHook() func(context.Context, api.RepoName) (RefWatcher, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4269,35 +12545,35 @@ func (f *ClientPerforceUsersFunc) nextHook() func(context.Context, protocol.Perf
 	return hook
 }
 
-func (f *ClientPerforceUsersFunc) appendCall(r0 ClientPerforceUsersFuncCall) {
+func (f *ClientWatchRefsFunc) appendCall(r0 ClientWatchRefsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientPerforceUsersFuncCall objects
-// describing the invocations of this function.
-func (f *ClientPerforceUsersFunc) History() []ClientPerforceUsersFuncCall {
+// History returns a sequence of ClientWatchRefsFuncCall objects describing
+// the invocations of this function.
+func (f *ClientWatchRefsFunc) History() []ClientWatchRefsFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientPerforceUsersFuncCall, len(f.history))
+	history := make([]ClientWatchRefsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientPerforceUsersFuncCall is an object that describes an invocation of
-// method PerforceUsers on an instance of MockClient.
-type ClientPerforceUsersFuncCall struct {
+// ClientWatchRefsFuncCall is an object that describes an invocation of
+// method WatchRefs on an instance of MockClient.
+type ClientWatchRefsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 protocol.PerforceConnectionDetails
+	Arg1 api.RepoName
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*perforce.User
+	Result0 RefWatcher
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -4305,44 +12581,45 @@ type ClientPerforceUsersFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientPerforceUsersFuncCall) Args() []interface{} {
+func (c ClientWatchRefsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientPerforceUsersFuncCall) Results() []interface{} {
+func (c ClientWatchRefsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ClientReadDirFunc describes the behavior when the ReadDir method of the
-// parent MockClient instance is invoked.
-type ClientReadDirFunc struct {
-	defaultHook func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error)
-	hooks       []func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error)
-	history     []ClientReadDirFuncCall
+// ClientWithActorRateLimitFunc describes the behavior when the
+// WithActorRateLimit method of the parent MockClient instance is invoked.
+type ClientWithActorRateLimitFunc struct {
+	defaultHook func(ActorRateLimits) Client
+	hooks       []func(ActorRateLimits) Client
+	history     []ClientWithActorRateLimitFuncCall
 	mutex       sync.Mutex
 }
 
-// ReadDir delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) ReadDir(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string, v4 bool) ([]fs.FileInfo, error) {
-	r0, r1 := m.ReadDirFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.ReadDirFunc.appendCall(ClientReadDirFuncCall{v0, v1, v2, v3, v4, r0, r1})
-	return r0, r1
+// WithActorRateLimit delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) WithActorRateLimit(v0 ActorRateLimits) Client {
+	r0 := m.WithActorRateLimitFunc.nextHook()(v0)
+	m.WithActorRateLimitFunc.appendCall(ClientWithActorRateLimitFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the ReadDir method of
-// the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientReadDirFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error)) {
+// SetDefaultHook sets function that is called when the WithActorRateLimit
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientWithActorRateLimitFunc) SetDefaultHook(hook func(ActorRateLimits) Client) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ReadDir method of the parent MockClient instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *ClientReadDirFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error)) {
+// WithActorRateLimit method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientWithActorRateLimitFunc) PushHook(hook func(ActorRateLimits) Client) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4350,20 +12627,20 @@ func (f *ClientReadDirFunc) PushHook(hook func(context.Context, api.RepoName, ap
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientReadDirFunc) SetDefaultReturn(r0 []fs.FileInfo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error) {
-		return r0, r1
+func (f *ClientWithActorRateLimitFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(ActorRateLimits) Client {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientReadDirFunc) PushReturn(r0 []fs.FileInfo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error) {
-		return r0, r1
+func (f *ClientWithActorRateLimitFunc) PushReturn(r0 Client) {
+	f.PushHook(func(ActorRateLimits) Client {
+		return r0
 	})
 }
 
-func (f *ClientReadDirFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string, bool) ([]fs.FileInfo, error) {
+func (f *ClientWithActorRateLimitFunc) nextHook() func(ActorRateLimits) Client {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4376,89 +12653,74 @@ func (f *ClientReadDirFunc) nextHook() func(context.Context, api.RepoName, api.C
 	return hook
 }
 
-func (f *ClientReadDirFunc) appendCall(r0 ClientReadDirFuncCall) {
+func (f *ClientWithActorRateLimitFunc) appendCall(r0 ClientWithActorRateLimitFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientReadDirFuncCall objects describing
-// the invocations of this function.
-func (f *ClientReadDirFunc) History() []ClientReadDirFuncCall {
+// History returns a sequence of ClientWithActorRateLimitFuncCall objects
+// describing the invocations of this function.
+func (f *ClientWithActorRateLimitFunc) History() []ClientWithActorRateLimitFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientReadDirFuncCall, len(f.history))
+	history := make([]ClientWithActorRateLimitFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientReadDirFuncCall is an object that describes an invocation of method
-// ReadDir on an instance of MockClient.
-type ClientReadDirFuncCall struct {
+// ClientWithActorRateLimitFuncCall is an object that describes an
+// invocation of method WithActorRateLimit on an instance of MockClient.
+type ClientWithActorRateLimitFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoName
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 api.CommitID
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 string
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 bool
+	Arg0 ActorRateLimits
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []fs.FileInfo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 Client
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientReadDirFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c ClientWithActorRateLimitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientReadDirFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ClientWithActorRateLimitFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// ClientRemoveFunc describes the behavior when the Remove method of the
-// parent MockClient instance is invoked.
-type ClientRemoveFunc struct {
-	defaultHook func(context.Context, api.RepoName) error
-	hooks       []func(context.Context, api.RepoName) error
-	history     []ClientRemoveFuncCall
+// ClientWithAuditHookFunc describes the behavior when the WithAuditHook
+// method of the parent MockClient instance is invoked.
+type ClientWithAuditHookFunc struct {
+	defaultHook func(AuditHook) Client
+	hooks       []func(AuditHook) Client
+	history     []ClientWithAuditHookFuncCall
 	mutex       sync.Mutex
 }
 
-// Remove delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) Remove(v0 context.Context, v1 api.RepoName) error {
-	r0 := m.RemoveFunc.nextHook()(v0, v1)
-	m.RemoveFunc.appendCall(ClientRemoveFuncCall{v0, v1, r0})
+// WithAuditHook delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) WithAuditHook(v0 AuditHook) Client {
+	r0 := m.WithAuditHookFunc.nextHook()(v0)
+	m.WithAuditHookFunc.appendCall(ClientWithAuditHookFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Remove method of the
-// parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientRemoveFunc) SetDefaultHook(hook func(context.Context, api.RepoName) error) {
+// SetDefaultHook sets function that is called when the WithAuditHook method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientWithAuditHookFunc) SetDefaultHook(hook func(AuditHook) Client) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Remove method of the parent MockClient instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *ClientRemoveFunc) PushHook(hook func(context.Context, api.RepoName) error) {
+// WithAuditHook method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientWithAuditHookFunc) PushHook(hook func(AuditHook) Client) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4466,20 +12728,20 @@ func (f *ClientRemoveFunc) PushHook(hook func(context.Context, api.RepoName) err
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientRemoveFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) error {
+func (f *ClientWithAuditHookFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(AuditHook) Client {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientRemoveFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, api.RepoName) error {
+func (f *ClientWithAuditHookFunc) PushReturn(r0 Client) {
+	f.PushHook(func(AuditHook) Client {
 		return r0
 	})
 }
 
-func (f *ClientRemoveFunc) nextHook() func(context.Context, api.RepoName) error {
+func (f *ClientWithAuditHookFunc) nextHook() func(AuditHook) Client {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4492,78 +12754,74 @@ func (f *ClientRemoveFunc) nextHook() func(context.Context, api.RepoName) error
 	return hook
 }
 
-func (f *ClientRemoveFunc) appendCall(r0 ClientRemoveFuncCall) {
+func (f *ClientWithAuditHookFunc) appendCall(r0 ClientWithAuditHookFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientRemoveFuncCall objects describing the
-// invocations of this function.
-func (f *ClientRemoveFunc) History() []ClientRemoveFuncCall {
+// History returns a sequence of ClientWithAuditHookFuncCall objects
+// describing the invocations of this function.
+func (f *ClientWithAuditHookFunc) History() []ClientWithAuditHookFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientRemoveFuncCall, len(f.history))
+	history := make([]ClientWithAuditHookFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientRemoveFuncCall is an object that describes an invocation of method
-// Remove on an instance of MockClient.
-type ClientRemoveFuncCall struct {
+// ClientWithAuditHookFuncCall is an object that describes an invocation of
+// method WithAuditHook on an instance of MockClient.
+type ClientWithAuditHookFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoName
+	Arg0 AuditHook
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 Client
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientRemoveFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ClientWithAuditHookFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientRemoveFuncCall) Results() []interface{} {
+func (c ClientWithAuditHookFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// ClientRepoCloneProgressFunc describes the behavior when the
-// RepoCloneProgress method of the parent MockClient instance is invoked.
-type ClientRepoCloneProgressFunc struct {
-	defaultHook func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error)
-	hooks       []func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error)
-	history     []ClientRepoCloneProgressFuncCall
+// ClientWithBlobCacheFunc describes the behavior when the WithBlobCache
+// method of the parent MockClient instance is invoked.
+type ClientWithBlobCacheFunc struct {
+	defaultHook func(int) Client
+	hooks       []func(int) Client
+	history     []ClientWithBlobCacheFuncCall
 	mutex       sync.Mutex
 }
 
-// RepoCloneProgress delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockClient) RepoCloneProgress(v0 context.Context, v1 api.RepoName) (*protocol.RepoCloneProgress, error) {
-	r0, r1 := m.RepoCloneProgressFunc.nextHook()(v0, v1)
-	m.RepoCloneProgressFunc.appendCall(ClientRepoCloneProgressFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// WithBlobCache delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) WithBlobCache(v0 int) Client {
+	r0 := m.WithBlobCacheFunc.nextHook()(v0)
+	m.WithBlobCacheFunc.appendCall(ClientWithBlobCacheFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the RepoCloneProgress
-// method of the parent MockClient instance is invoked and the hook queue is
-// empty.
-func (f *ClientRepoCloneProgressFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error)) {
+// SetDefaultHook sets function that is called when the WithBlobCache method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientWithBlobCacheFunc) SetDefaultHook(hook func(int) Client) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RepoCloneProgress method of the parent MockClient instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *ClientRepoCloneProgressFunc) PushHook(hook func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error)) {
+// WithBlobCache method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientWithBlobCacheFunc) PushHook(hook func(int) Client) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4571,20 +12829,20 @@ func (f *ClientRepoCloneProgressFunc) PushHook(hook func(context.Context, api.Re
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientRepoCloneProgressFunc) SetDefaultReturn(r0 *protocol.RepoCloneProgress, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error) {
-		return r0, r1
+func (f *ClientWithBlobCacheFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(int) Client {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientRepoCloneProgressFunc) PushReturn(r0 *protocol.RepoCloneProgress, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error) {
-		return r0, r1
+func (f *ClientWithBlobCacheFunc) PushReturn(r0 Client) {
+	f.PushHook(func(int) Client {
+		return r0
 	})
 }
 
-func (f *ClientRepoCloneProgressFunc) nextHook() func(context.Context, api.RepoName) (*protocol.RepoCloneProgress, error) {
+func (f *ClientWithBlobCacheFunc) nextHook() func(int) Client {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4597,81 +12855,75 @@ func (f *ClientRepoCloneProgressFunc) nextHook() func(context.Context, api.RepoN
 	return hook
 }
 
-func (f *ClientRepoCloneProgressFunc) appendCall(r0 ClientRepoCloneProgressFuncCall) {
+func (f *ClientWithBlobCacheFunc) appendCall(r0 ClientWithBlobCacheFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientRepoCloneProgressFuncCall objects
+// History returns a sequence of ClientWithBlobCacheFuncCall objects
 // describing the invocations of this function.
-func (f *ClientRepoCloneProgressFunc) History() []ClientRepoCloneProgressFuncCall {
+func (f *ClientWithBlobCacheFunc) History() []ClientWithBlobCacheFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientRepoCloneProgressFuncCall, len(f.history))
+	history := make([]ClientWithBlobCacheFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientRepoCloneProgressFuncCall is an object that describes an invocation
-// of method RepoCloneProgress on an instance of MockClient.
-type ClientRepoCloneProgressFuncCall struct {
+// ClientWithBlobCacheFuncCall is an object that describes an invocation of
+// method WithBlobCache on an instance of MockClient.
+type ClientWithBlobCacheFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoName
+	Arg0 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *protocol.RepoCloneProgress
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 Client
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientRepoCloneProgressFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ClientWithBlobCacheFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientRepoCloneProgressFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ClientWithBlobCacheFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// ClientRequestRepoUpdateFunc describes the behavior when the
-// RequestRepoUpdate method of the parent MockClient instance is invoked.
-type ClientRequestRepoUpdateFunc struct {
-	defaultHook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)
-	hooks       []func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)
-	history     []ClientRequestRepoUpdateFuncCall
+// ClientWithCompressionFunc describes the behavior when the WithCompression
+// method of the parent MockClient instance is invoked.
+type ClientWithCompressionFunc struct {
+	defaultHook func(string) Client
+	hooks       []func(string) Client
+	history     []ClientWithCompressionFuncCall
 	mutex       sync.Mutex
 }
 
-// RequestRepoUpdate delegates to the next hook function in the queue and
+// WithCompression delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockClient) RequestRepoUpdate(v0 context.Context, v1 api.RepoName) (*protocol.RepoUpdateResponse, error) {
-	r0, r1 := m.RequestRepoUpdateFunc.nextHook()(v0, v1)
-	m.RequestRepoUpdateFunc.appendCall(ClientRequestRepoUpdateFuncCall{v0, v1, r0, r1})
-	return r0, r1
+func (m *MockClient) WithCompression(v0 string) Client {
+	r0 := m.WithCompressionFunc.nextHook()(v0)
+	m.WithCompressionFunc.appendCall(ClientWithCompressionFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the RequestRepoUpdate
+// SetDefaultHook sets function that is called when the WithCompression
 // method of the parent MockClient instance is invoked and the hook queue is
 // empty.
-func (f *ClientRequestRepoUpdateFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)) {
+func (f *ClientWithCompressionFunc) SetDefaultHook(hook func(string) Client) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RequestRepoUpdate method of the parent MockClient instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *ClientRequestRepoUpdateFunc) PushHook(hook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)) {
+// WithCompression method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientWithCompressionFunc) PushHook(hook func(string) Client) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4679,20 +12931,20 @@ func (f *ClientRequestRepoUpdateFunc) PushHook(hook func(context.Context, api.Re
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientRequestRepoUpdateFunc) SetDefaultReturn(r0 *protocol.RepoUpdateResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
-		return r0, r1
+func (f *ClientWithCompressionFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(string) Client {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientRequestRepoUpdateFunc) PushReturn(r0 *protocol.RepoUpdateResponse, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
-		return r0, r1
+func (f *ClientWithCompressionFunc) PushReturn(r0 Client) {
+	f.PushHook(func(string) Client {
+		return r0
 	})
 }
 
-func (f *ClientRequestRepoUpdateFunc) nextHook() func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+func (f *ClientWithCompressionFunc) nextHook() func(string) Client {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4705,81 +12957,74 @@ func (f *ClientRequestRepoUpdateFunc) nextHook() func(context.Context, api.RepoN
 	return hook
 }
 
-func (f *ClientRequestRepoUpdateFunc) appendCall(r0 ClientRequestRepoUpdateFuncCall) {
+func (f *ClientWithCompressionFunc) appendCall(r0 ClientWithCompressionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientRequestRepoUpdateFuncCall objects
+// History returns a sequence of ClientWithCompressionFuncCall objects
 // describing the invocations of this function.
-func (f *ClientRequestRepoUpdateFunc) History() []ClientRequestRepoUpdateFuncCall {
+func (f *ClientWithCompressionFunc) History() []ClientWithCompressionFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientRequestRepoUpdateFuncCall, len(f.history))
+	history := make([]ClientWithCompressionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientRequestRepoUpdateFuncCall is an object that describes an invocation
-// of method RequestRepoUpdate on an instance of MockClient.
-type ClientRequestRepoUpdateFuncCall struct {
+// ClientWithCompressionFuncCall is an object that describes an invocation
+// of method WithCompression on an instance of MockClient.
+type ClientWithCompressionFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoName
+	Arg0 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *protocol.RepoUpdateResponse
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 Client
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientRequestRepoUpdateFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ClientWithCompressionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientRequestRepoUpdateFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ClientWithCompressionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// ClientResolveRevisionFunc describes the behavior when the ResolveRevision
-// method of the parent MockClient instance is invoked.
-type ClientResolveRevisionFunc struct {
-	defaultHook func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error)
-	hooks       []func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error)
-	history     []ClientResolveRevisionFuncCall
+// ClientWithHedgingFunc describes the behavior when the WithHedging method
+// of the parent MockClient instance is invoked.
+type ClientWithHedgingFunc struct {
+	defaultHook func(HedgingPolicy) Client
+	hooks       []func(HedgingPolicy) Client
+	history     []ClientWithHedgingFuncCall
 	mutex       sync.Mutex
 }
 
-// ResolveRevision delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockClient) ResolveRevision(v0 context.Context, v1 api.RepoName, v2 string, v3 ResolveRevisionOptions) (api.CommitID, error) {
-	r0, r1 := m.ResolveRevisionFunc.nextHook()(v0, v1, v2, v3)
-	m.ResolveRevisionFunc.appendCall(ClientResolveRevisionFuncCall{v0, v1, v2, v3, r0, r1})
-	return r0, r1
-}
-
-// SetDefaultHook sets function that is called when the ResolveRevision
-// method of the parent MockClient instance is invoked and the hook queue is
-// empty.
-func (f *ClientResolveRevisionFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error)) {
+// WithHedging delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) WithHedging(v0 HedgingPolicy) Client {
+	r0 := m.WithHedgingFunc.nextHook()(v0)
+	m.WithHedgingFunc.appendCall(ClientWithHedgingFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WithHedging method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientWithHedgingFunc) SetDefaultHook(hook func(HedgingPolicy) Client) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ResolveRevision method of the parent MockClient instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
+// WithHedging method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *ClientResolveRevisionFunc) PushHook(hook func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error)) {
+func (f *ClientWithHedgingFunc) PushHook(hook func(HedgingPolicy) Client) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4787,20 +13032,20 @@ func (f *ClientResolveRevisionFunc) PushHook(hook func(context.Context, api.Repo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientResolveRevisionFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error) {
-		return r0, r1
+func (f *ClientWithHedgingFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(HedgingPolicy) Client {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientResolveRevisionFunc) PushReturn(r0 api.CommitID, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error) {
-		return r0, r1
+func (f *ClientWithHedgingFunc) PushReturn(r0 Client) {
+	f.PushHook(func(HedgingPolicy) Client {
+		return r0
 	})
 }
 
-func (f *ClientResolveRevisionFunc) nextHook() func(context.Context, api.RepoName, string, ResolveRevisionOptions) (api.CommitID, error) {
+func (f *ClientWithHedgingFunc) nextHook() func(HedgingPolicy) Client {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4813,86 +13058,75 @@ func (f *ClientResolveRevisionFunc) nextHook() func(context.Context, api.RepoNam
 	return hook
 }
 
-func (f *ClientResolveRevisionFunc) appendCall(r0 ClientResolveRevisionFuncCall) {
+func (f *ClientWithHedgingFunc) appendCall(r0 ClientWithHedgingFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientResolveRevisionFuncCall objects
+// History returns a sequence of ClientWithHedgingFuncCall objects
 // describing the invocations of this function.
-func (f *ClientResolveRevisionFunc) History() []ClientResolveRevisionFuncCall {
+func (f *ClientWithHedgingFunc) History() []ClientWithHedgingFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientResolveRevisionFuncCall, len(f.history))
+	history := make([]ClientWithHedgingFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientResolveRevisionFuncCall is an object that describes an invocation
-// of method ResolveRevision on an instance of MockClient.
-type ClientResolveRevisionFuncCall struct {
+// ClientWithHedgingFuncCall is an object that describes an invocation of
+// method WithHedging on an instance of MockClient.
+type ClientWithHedgingFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoName
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 ResolveRevisionOptions
+	Arg0 HedgingPolicy
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 api.CommitID
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 Client
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientResolveRevisionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c ClientWithHedgingFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientResolveRevisionFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ClientWithHedgingFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// ClientRevAtTimeFunc describes the behavior when the RevAtTime method of
-// the parent MockClient instance is invoked.
-type ClientRevAtTimeFunc struct {
-	defaultHook func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error)
-	hooks       []func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error)
-	history     []ClientRevAtTimeFuncCall
+// ClientWithMergeBaseCacheFunc describes the behavior when the
+// WithMergeBaseCache method of the parent MockClient instance is invoked.
+type ClientWithMergeBaseCacheFunc struct {
+	defaultHook func(int) Client
+	hooks       []func(int) Client
+	history     []ClientWithMergeBaseCacheFuncCall
 	mutex       sync.Mutex
 }
 
-// RevAtTime delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) RevAtTime(v0 context.Context, v1 api.RepoName, v2 string, v3 time.Time) (api.CommitID, bool, error) {
-	r0, r1, r2 := m.RevAtTimeFunc.nextHook()(v0, v1, v2, v3)
-	m.RevAtTimeFunc.appendCall(ClientRevAtTimeFuncCall{v0, v1, v2, v3, r0, r1, r2})
-	return r0, r1, r2
+// WithMergeBaseCache delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) WithMergeBaseCache(v0 int) Client {
+	r0 := m.WithMergeBaseCacheFunc.nextHook()(v0)
+	m.WithMergeBaseCacheFunc.appendCall(ClientWithMergeBaseCacheFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the RevAtTime method of
-// the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientRevAtTimeFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error)) {
+// SetDefaultHook sets function that is called when the WithMergeBaseCache
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientWithMergeBaseCacheFunc) SetDefaultHook(hook func(int) Client) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RevAtTime method of the parent MockClient instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ClientRevAtTimeFunc) PushHook(hook func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error)) {
+// WithMergeBaseCache method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientWithMergeBaseCacheFunc) PushHook(hook func(int) Client) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4900,20 +13134,20 @@ func (f *ClientRevAtTimeFunc) PushHook(hook func(context.Context, api.RepoName,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientRevAtTimeFunc) SetDefaultReturn(r0 api.CommitID, r1 bool, r2 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error) {
-		return r0, r1, r2
+func (f *ClientWithMergeBaseCacheFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(int) Client {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientRevAtTimeFunc) PushReturn(r0 api.CommitID, r1 bool, r2 error) {
-	f.PushHook(func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error) {
-		return r0, r1, r2
+func (f *ClientWithMergeBaseCacheFunc) PushReturn(r0 Client) {
+	f.PushHook(func(int) Client {
+		return r0
 	})
 }
 
-func (f *ClientRevAtTimeFunc) nextHook() func(context.Context, api.RepoName, string, time.Time) (api.CommitID, bool, error) {
+func (f *ClientWithMergeBaseCacheFunc) nextHook() func(int) Client {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4926,89 +13160,74 @@ func (f *ClientRevAtTimeFunc) nextHook() func(context.Context, api.RepoName, str
 	return hook
 }
 
-func (f *ClientRevAtTimeFunc) appendCall(r0 ClientRevAtTimeFuncCall) {
+func (f *ClientWithMergeBaseCacheFunc) appendCall(r0 ClientWithMergeBaseCacheFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientRevAtTimeFuncCall objects describing
-// the invocations of this function.
-func (f *ClientRevAtTimeFunc) History() []ClientRevAtTimeFuncCall {
+// History returns a sequence of ClientWithMergeBaseCacheFuncCall objects
+// describing the invocations of this function.
+func (f *ClientWithMergeBaseCacheFunc) History() []ClientWithMergeBaseCacheFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientRevAtTimeFuncCall, len(f.history))
+	history := make([]ClientWithMergeBaseCacheFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientRevAtTimeFuncCall is an object that describes an invocation of
-// method RevAtTime on an instance of MockClient.
-type ClientRevAtTimeFuncCall struct {
+// ClientWithMergeBaseCacheFuncCall is an object that describes an
+// invocation of method WithMergeBaseCache on an instance of MockClient.
+type ClientWithMergeBaseCacheFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoName
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 time.Time
+	Arg0 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 api.CommitID
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 bool
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result0 Client
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientRevAtTimeFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c ClientWithMergeBaseCacheFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientRevAtTimeFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c ClientWithMergeBaseCacheFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// ClientRevListFunc describes the behavior when the RevList method of the
-// parent MockClient instance is invoked.
-type ClientRevListFunc struct {
-	defaultHook func(context.Context, string, string, func(commit string) (bool, error)) error
-	hooks       []func(context.Context, string, string, func(commit string) (bool, error)) error
-	history     []ClientRevListFuncCall
+// ClientWithMetricsFunc describes the behavior when the WithMetrics method
+// of the parent MockClient instance is invoked.
+type ClientWithMetricsFunc struct {
+	defaultHook func(prometheus.Registerer) Client
+	hooks       []func(prometheus.Registerer) Client
+	history     []ClientWithMetricsFuncCall
 	mutex       sync.Mutex
 }
 
-// RevList delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) RevList(v0 context.Context, v1 string, v2 string, v3 func(commit string) (bool, error)) error {
-	r0 := m.RevListFunc.nextHook()(v0, v1, v2, v3)
-	m.RevListFunc.appendCall(ClientRevListFuncCall{v0, v1, v2, v3, r0})
+// WithMetrics delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) WithMetrics(v0 prometheus.Registerer) Client {
+	r0 := m.WithMetricsFunc.nextHook()(v0)
+	m.WithMetricsFunc.appendCall(ClientWithMetricsFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the RevList method of
-// the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientRevListFunc) SetDefaultHook(hook func(context.Context, string, string, func(commit string) (bool, error)) error) {
+// SetDefaultHook sets function that is called when the WithMetrics method
+// of the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientWithMetricsFunc) SetDefaultHook(hook func(prometheus.Registerer) Client) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RevList method of the parent MockClient instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *ClientRevListFunc) PushHook(hook func(context.Context, string, string, func(commit string) (bool, error)) error) {
+// WithMetrics method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientWithMetricsFunc) PushHook(hook func(prometheus.Registerer) Client) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5016,20 +13235,20 @@ func (f *ClientRevListFunc) PushHook(hook func(context.Context, string, string,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientRevListFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, string, string, func(commit string) (bool, error)) error {
+func (f *ClientWithMetricsFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(prometheus.Registerer) Client {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientRevListFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, string, string, func(commit string) (bool, error)) error {
+func (f *ClientWithMetricsFunc) PushReturn(r0 Client) {
+	f.PushHook(func(prometheus.Registerer) Client {
 		return r0
 	})
 }
 
-func (f *ClientRevListFunc) nextHook() func(context.Context, string, string, func(commit string) (bool, error)) error {
+func (f *ClientWithMetricsFunc) nextHook() func(prometheus.Registerer) Client {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5042,83 +13261,75 @@ func (f *ClientRevListFunc) nextHook() func(context.Context, string, string, fun
 	return hook
 }
 
-func (f *ClientRevListFunc) appendCall(r0 ClientRevListFuncCall) {
+func (f *ClientWithMetricsFunc) appendCall(r0 ClientWithMetricsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientRevListFuncCall objects describing
-// the invocations of this function.
-func (f *ClientRevListFunc) History() []ClientRevListFuncCall {
+// History returns a sequence of ClientWithMetricsFuncCall objects
+// describing the invocations of this function.
+func (f *ClientWithMetricsFunc) History() []ClientWithMetricsFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientRevListFuncCall, len(f.history))
+	history := make([]ClientWithMetricsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientRevListFuncCall is an object that describes an invocation of method
-// RevList on an instance of MockClient.
-type ClientRevListFuncCall struct {
+// ClientWithMetricsFuncCall is an object that describes an invocation of
+// method WithMetrics on an instance of MockClient.
+type ClientWithMetricsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 string
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 func(commit string) (bool, error)
+	Arg0 prometheus.Registerer
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 Client
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientRevListFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c ClientWithMetricsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientRevListFuncCall) Results() []interface{} {
+func (c ClientWithMetricsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// ClientScopedFunc describes the behavior when the Scoped method of the
-// parent MockClient instance is invoked.
-type ClientScopedFunc struct {
-	defaultHook func(string) Client
-	hooks       []func(string) Client
-	history     []ClientScopedFuncCall
+// ClientWithPermissionTraceFunc describes the behavior when the
+// WithPermissionTrace method of the parent MockClient instance is invoked.
+type ClientWithPermissionTraceFunc struct {
+	defaultHook func(*PermissionTrace) Client
+	hooks       []func(*PermissionTrace) Client
+	history     []ClientWithPermissionTraceFuncCall
 	mutex       sync.Mutex
 }
 
-// Scoped delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) Scoped(v0 string) Client {
-	r0 := m.ScopedFunc.nextHook()(v0)
-	m.ScopedFunc.appendCall(ClientScopedFuncCall{v0, r0})
+// WithPermissionTrace delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) WithPermissionTrace(v0 *PermissionTrace) Client {
+	r0 := m.WithPermissionTraceFunc.nextHook()(v0)
+	m.WithPermissionTraceFunc.appendCall(ClientWithPermissionTraceFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Scoped method of the
-// parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientScopedFunc) SetDefaultHook(hook func(string) Client) {
+// SetDefaultHook sets function that is called when the WithPermissionTrace
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientWithPermissionTraceFunc) SetDefaultHook(hook func(*PermissionTrace) Client) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Scoped method of the parent MockClient instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *ClientScopedFunc) PushHook(hook func(string) Client) {
+// WithPermissionTrace method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientWithPermissionTraceFunc) PushHook(hook func(*PermissionTrace) Client) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5126,20 +13337,20 @@ func (f *ClientScopedFunc) PushHook(hook func(string) Client) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientScopedFunc) SetDefaultReturn(r0 Client) {
-	f.SetDefaultHook(func(string) Client {
+func (f *ClientWithPermissionTraceFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(*PermissionTrace) Client {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientScopedFunc) PushReturn(r0 Client) {
-	f.PushHook(func(string) Client {
+func (f *ClientWithPermissionTraceFunc) PushReturn(r0 Client) {
+	f.PushHook(func(*PermissionTrace) Client {
 		return r0
 	})
 }
 
-func (f *ClientScopedFunc) nextHook() func(string) Client {
+func (f *ClientWithPermissionTraceFunc) nextHook() func(*PermissionTrace) Client {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5152,29 +13363,29 @@ func (f *ClientScopedFunc) nextHook() func(string) Client {
 	return hook
 }
 
-func (f *ClientScopedFunc) appendCall(r0 ClientScopedFuncCall) {
+func (f *ClientWithPermissionTraceFunc) appendCall(r0 ClientWithPermissionTraceFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientScopedFuncCall objects describing the
-// invocations of this function.
-func (f *ClientScopedFunc) History() []ClientScopedFuncCall {
+// History returns a sequence of ClientWithPermissionTraceFuncCall objects
+// describing the invocations of this function.
+func (f *ClientWithPermissionTraceFunc) History() []ClientWithPermissionTraceFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientScopedFuncCall, len(f.history))
+	history := make([]ClientWithPermissionTraceFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientScopedFuncCall is an object that describes an invocation of method
-// Scoped on an instance of MockClient.
-type ClientScopedFuncCall struct {
+// ClientWithPermissionTraceFuncCall is an object that describes an
+// invocation of method WithPermissionTrace on an instance of MockClient.
+type ClientWithPermissionTraceFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 string
+	Arg0 *PermissionTrace
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 Client
@@ -5182,44 +13393,46 @@ type ClientScopedFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientScopedFuncCall) Args() []interface{} {
+func (c ClientWithPermissionTraceFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientScopedFuncCall) Results() []interface{} {
+func (c ClientWithPermissionTraceFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// ClientSearchFunc describes the behavior when the Search method of the
-// parent MockClient instance is invoked.
-type ClientSearchFunc struct {
-	defaultHook func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error)
-	hooks       []func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error)
-	history     []ClientSearchFuncCall
+// ClientWithRepoConcurrencyLimitFunc describes the behavior when the
+// WithRepoConcurrencyLimit method of the parent MockClient instance is
+// invoked.
+type ClientWithRepoConcurrencyLimitFunc struct {
+	defaultHook func(int) Client
+	hooks       []func(int) Client
+	history     []ClientWithRepoConcurrencyLimitFuncCall
 	mutex       sync.Mutex
 }
 
-// Search delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) Search(v0 context.Context, v1 *protocol.SearchRequest, v2 func([]protocol.CommitMatch)) (bool, error) {
-	r0, r1 := m.SearchFunc.nextHook()(v0, v1, v2)
-	m.SearchFunc.appendCall(ClientSearchFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+// WithRepoConcurrencyLimit delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockClient) WithRepoConcurrencyLimit(v0 int) Client {
+	r0 := m.WithRepoConcurrencyLimitFunc.nextHook()(v0)
+	m.WithRepoConcurrencyLimitFunc.appendCall(ClientWithRepoConcurrencyLimitFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Search method of the
-// parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientSearchFunc) SetDefaultHook(hook func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error)) {
+// SetDefaultHook sets function that is called when the
+// WithRepoConcurrencyLimit method of the parent MockClient instance is
+// invoked and the hook queue is empty.
+func (f *ClientWithRepoConcurrencyLimitFunc) SetDefaultHook(hook func(int) Client) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Search method of the parent MockClient instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *ClientSearchFunc) PushHook(hook func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error)) {
+// WithRepoConcurrencyLimit method of the parent MockClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ClientWithRepoConcurrencyLimitFunc) PushHook(hook func(int) Client) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5227,20 +13440,20 @@ func (f *ClientSearchFunc) PushHook(hook func(context.Context, *protocol.SearchR
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientSearchFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error) {
-		return r0, r1
+func (f *ClientWithRepoConcurrencyLimitFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(int) Client {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientSearchFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error) {
-		return r0, r1
+func (f *ClientWithRepoConcurrencyLimitFunc) PushReturn(r0 Client) {
+	f.PushHook(func(int) Client {
+		return r0
 	})
 }
 
-func (f *ClientSearchFunc) nextHook() func(context.Context, *protocol.SearchRequest, func([]protocol.CommitMatch)) (bool, error) {
+func (f *ClientWithRepoConcurrencyLimitFunc) nextHook() func(int) Client {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5253,83 +13466,76 @@ func (f *ClientSearchFunc) nextHook() func(context.Context, *protocol.SearchRequ
 	return hook
 }
 
-func (f *ClientSearchFunc) appendCall(r0 ClientSearchFuncCall) {
+func (f *ClientWithRepoConcurrencyLimitFunc) appendCall(r0 ClientWithRepoConcurrencyLimitFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientSearchFuncCall objects describing the
-// invocations of this function.
-func (f *ClientSearchFunc) History() []ClientSearchFuncCall {
+// History returns a sequence of ClientWithRepoConcurrencyLimitFuncCall
+// objects describing the invocations of this function.
+func (f *ClientWithRepoConcurrencyLimitFunc) History() []ClientWithRepoConcurrencyLimitFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientSearchFuncCall, len(f.history))
+	history := make([]ClientWithRepoConcurrencyLimitFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
-}
-
-// ClientSearchFuncCall is an object that describes an invocation of method
-// Search on an instance of MockClient.
-type ClientSearchFuncCall struct {
+}
+
+// ClientWithRepoConcurrencyLimitFuncCall is an object that describes an
+// invocation of method WithRepoConcurrencyLimit on an instance of
+// MockClient.
+type ClientWithRepoConcurrencyLimitFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 *protocol.SearchRequest
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 func([]protocol.CommitMatch)
+	Arg0 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 Client
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientSearchFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c ClientWithRepoConcurrencyLimitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientSearchFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ClientWithRepoConcurrencyLimitFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// ClientStatFunc describes the behavior when the Stat method of the parent
-// MockClient instance is invoked.
-type ClientStatFunc struct {
-	defaultHook func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error)
-	hooks       []func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error)
-	history     []ClientStatFuncCall
+// ClientWithRetryPolicyFunc describes the behavior when the WithRetryPolicy
+// method of the parent MockClient instance is invoked.
+type ClientWithRetryPolicyFunc struct {
+	defaultHook func(RetryPolicy) Client
+	hooks       []func(RetryPolicy) Client
+	history     []ClientWithRetryPolicyFuncCall
 	mutex       sync.Mutex
 }
 
-// Stat delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockClient) Stat(v0 context.Context, v1 api.RepoName, v2 api.CommitID, v3 string) (fs.FileInfo, error) {
-	r0, r1 := m.StatFunc.nextHook()(v0, v1, v2, v3)
-	m.StatFunc.appendCall(ClientStatFuncCall{v0, v1, v2, v3, r0, r1})
-	return r0, r1
+// WithRetryPolicy delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) WithRetryPolicy(v0 RetryPolicy) Client {
+	r0 := m.WithRetryPolicyFunc.nextHook()(v0)
+	m.WithRetryPolicyFunc.appendCall(ClientWithRetryPolicyFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Stat method of the
-// parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientStatFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error)) {
+// SetDefaultHook sets function that is called when the WithRetryPolicy
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientWithRetryPolicyFunc) SetDefaultHook(hook func(RetryPolicy) Client) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Stat method of the parent MockClient instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *ClientStatFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error)) {
+// WithRetryPolicy method of the parent MockClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientWithRetryPolicyFunc) PushHook(hook func(RetryPolicy) Client) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5337,20 +13543,20 @@ func (f *ClientStatFunc) PushHook(hook func(context.Context, api.RepoName, api.C
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientStatFunc) SetDefaultReturn(r0 fs.FileInfo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error) {
-		return r0, r1
+func (f *ClientWithRetryPolicyFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(RetryPolicy) Client {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientStatFunc) PushReturn(r0 fs.FileInfo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error) {
-		return r0, r1
+func (f *ClientWithRetryPolicyFunc) PushReturn(r0 Client) {
+	f.PushHook(func(RetryPolicy) Client {
+		return r0
 	})
 }
 
-func (f *ClientStatFunc) nextHook() func(context.Context, api.RepoName, api.CommitID, string) (fs.FileInfo, error) {
+func (f *ClientWithRetryPolicyFunc) nextHook() func(RetryPolicy) Client {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5363,87 +13569,75 @@ func (f *ClientStatFunc) nextHook() func(context.Context, api.RepoName, api.Comm
 	return hook
 }
 
-func (f *ClientStatFunc) appendCall(r0 ClientStatFuncCall) {
+func (f *ClientWithRetryPolicyFunc) appendCall(r0 ClientWithRetryPolicyFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientStatFuncCall objects describing the
-// invocations of this function.
-func (f *ClientStatFunc) History() []ClientStatFuncCall {
+// History returns a sequence of ClientWithRetryPolicyFuncCall objects
+// describing the invocations of this function.
+func (f *ClientWithRetryPolicyFunc) History() []ClientWithRetryPolicyFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientStatFuncCall, len(f.history))
+	history := make([]ClientWithRetryPolicyFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientStatFuncCall is an object that describes an invocation of method
-// Stat on an instance of MockClient.
-type ClientStatFuncCall struct {
+// ClientWithRetryPolicyFuncCall is an object that describes an invocation
+// of method WithRetryPolicy on an instance of MockClient.
+type ClientWithRetryPolicyFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoName
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 api.CommitID
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 string
+	Arg0 RetryPolicy
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 fs.FileInfo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 Client
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientStatFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c ClientWithRetryPolicyFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientStatFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ClientWithRetryPolicyFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// ClientStreamBlameFileFunc describes the behavior when the StreamBlameFile
+// ClientWithSlowLoggingFunc describes the behavior when the WithSlowLogging
 // method of the parent MockClient instance is invoked.
-type ClientStreamBlameFileFunc struct {
-	defaultHook func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error)
-	hooks       []func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error)
-	history     []ClientStreamBlameFileFuncCall
+type ClientWithSlowLoggingFunc struct {
+	defaultHook func(time.Duration) Client
+	hooks       []func(time.Duration) Client
+	history     []ClientWithSlowLoggingFuncCall
 	mutex       sync.Mutex
 }
 
-// StreamBlameFile delegates to the next hook function in the queue and
+// WithSlowLogging delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockClient) StreamBlameFile(v0 context.Context, v1 api.RepoName, v2 string, v3 *BlameOptions) (HunkReader, error) {
-	r0, r1 := m.StreamBlameFileFunc.nextHook()(v0, v1, v2, v3)
-	m.StreamBlameFileFunc.appendCall(ClientStreamBlameFileFuncCall{v0, v1, v2, v3, r0, r1})
-	return r0, r1
+func (m *MockClient) WithSlowLogging(v0 time.Duration) Client {
+	r0 := m.WithSlowLoggingFunc.nextHook()(v0)
+	m.WithSlowLoggingFunc.appendCall(ClientWithSlowLoggingFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the StreamBlameFile
+// SetDefaultHook sets function that is called when the WithSlowLogging
 // method of the parent MockClient instance is invoked and the hook queue is
 // empty.
-func (f *ClientStreamBlameFileFunc) SetDefaultHook(hook func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error)) {
+func (f *ClientWithSlowLoggingFunc) SetDefaultHook(hook func(time.Duration) Client) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// StreamBlameFile method of the parent MockClient instance invokes the hook
+// WithSlowLogging method of the parent MockClient instance invokes the hook
 // at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *ClientStreamBlameFileFunc) PushHook(hook func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error)) {
+func (f *ClientWithSlowLoggingFunc) PushHook(hook func(time.Duration) Client) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5451,20 +13645,20 @@ func (f *ClientStreamBlameFileFunc) PushHook(hook func(context.Context, api.Repo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientStreamBlameFileFunc) SetDefaultReturn(r0 HunkReader, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error) {
-		return r0, r1
+func (f *ClientWithSlowLoggingFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(time.Duration) Client {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientStreamBlameFileFunc) PushReturn(r0 HunkReader, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error) {
-		return r0, r1
+func (f *ClientWithSlowLoggingFunc) PushReturn(r0 Client) {
+	f.PushHook(func(time.Duration) Client {
+		return r0
 	})
 }
 
-func (f *ClientStreamBlameFileFunc) nextHook() func(context.Context, api.RepoName, string, *BlameOptions) (HunkReader, error) {
+func (f *ClientWithSlowLoggingFunc) nextHook() func(time.Duration) Client {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5477,86 +13671,76 @@ func (f *ClientStreamBlameFileFunc) nextHook() func(context.Context, api.RepoNam
 	return hook
 }
 
-func (f *ClientStreamBlameFileFunc) appendCall(r0 ClientStreamBlameFileFuncCall) {
+func (f *ClientWithSlowLoggingFunc) appendCall(r0 ClientWithSlowLoggingFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientStreamBlameFileFuncCall objects
+// History returns a sequence of ClientWithSlowLoggingFuncCall objects
 // describing the invocations of this function.
-func (f *ClientStreamBlameFileFunc) History() []ClientStreamBlameFileFuncCall {
+func (f *ClientWithSlowLoggingFunc) History() []ClientWithSlowLoggingFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientStreamBlameFileFuncCall, len(f.history))
+	history := make([]ClientWithSlowLoggingFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientStreamBlameFileFuncCall is an object that describes an invocation
-// of method StreamBlameFile on an instance of MockClient.
-type ClientStreamBlameFileFuncCall struct {
+// ClientWithSlowLoggingFuncCall is an object that describes an invocation
+// of method WithSlowLogging on an instance of MockClient.
+type ClientWithSlowLoggingFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoName
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 *BlameOptions
+	Arg0 time.Duration
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 HunkReader
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 Client
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientStreamBlameFileFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c ClientWithSlowLoggingFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientStreamBlameFileFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ClientWithSlowLoggingFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// ClientSystemInfoFunc describes the behavior when the SystemInfo method of
-// the parent MockClient instance is invoked.
-type ClientSystemInfoFunc struct {
-	defaultHook func(context.Context, string) (protocol.SystemInfo, error)
-	hooks       []func(context.Context, string) (protocol.SystemInfo, error)
-	history     []ClientSystemInfoFuncCall
+// ClientWithSubRepoPermsCacheFunc describes the behavior when the
+// WithSubRepoPermsCache method of the parent MockClient instance is
+// invoked.
+type ClientWithSubRepoPermsCacheFunc struct {
+	defaultHook func(time.Duration) Client
+	hooks       []func(time.Duration) Client
+	history     []ClientWithSubRepoPermsCacheFuncCall
 	mutex       sync.Mutex
 }
 
-// SystemInfo delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockClient) SystemInfo(v0 context.Context, v1 string) (protocol.SystemInfo, error) {
-	r0, r1 := m.SystemInfoFunc.nextHook()(v0, v1)
-	m.SystemInfoFunc.appendCall(ClientSystemInfoFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// WithSubRepoPermsCache delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockClient) WithSubRepoPermsCache(v0 time.Duration) Client {
+	r0 := m.WithSubRepoPermsCacheFunc.nextHook()(v0)
+	m.WithSubRepoPermsCacheFunc.appendCall(ClientWithSubRepoPermsCacheFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the SystemInfo method of
-// the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientSystemInfoFunc) SetDefaultHook(hook func(context.Context, string) (protocol.SystemInfo, error)) {
+// SetDefaultHook sets function that is called when the
+// WithSubRepoPermsCache method of the parent MockClient instance is invoked
+// and the hook queue is empty.
+func (f *ClientWithSubRepoPermsCacheFunc) SetDefaultHook(hook func(time.Duration) Client) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SystemInfo method of the parent MockClient instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ClientSystemInfoFunc) PushHook(hook func(context.Context, string) (protocol.SystemInfo, error)) {
+// WithSubRepoPermsCache method of the parent MockClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ClientWithSubRepoPermsCacheFunc) PushHook(hook func(time.Duration) Client) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5564,20 +13748,20 @@ func (f *ClientSystemInfoFunc) PushHook(hook func(context.Context, string) (prot
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientSystemInfoFunc) SetDefaultReturn(r0 protocol.SystemInfo, r1 error) {
-	f.SetDefaultHook(func(context.Context, string) (protocol.SystemInfo, error) {
-		return r0, r1
+func (f *ClientWithSubRepoPermsCacheFunc) SetDefaultReturn(r0 Client) {
+	f.SetDefaultHook(func(time.Duration) Client {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientSystemInfoFunc) PushReturn(r0 protocol.SystemInfo, r1 error) {
-	f.PushHook(func(context.Context, string) (protocol.SystemInfo, error) {
-		return r0, r1
+func (f *ClientWithSubRepoPermsCacheFunc) PushReturn(r0 Client) {
+	f.PushHook(func(time.Duration) Client {
+		return r0
 	})
 }
 
-func (f *ClientSystemInfoFunc) nextHook() func(context.Context, string) (protocol.SystemInfo, error) {
+func (f *ClientWithSubRepoPermsCacheFunc) nextHook() func(time.Duration) Client {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5590,80 +13774,75 @@ func (f *ClientSystemInfoFunc) nextHook() func(context.Context, string) (protoco
 	return hook
 }
 
-func (f *ClientSystemInfoFunc) appendCall(r0 ClientSystemInfoFuncCall) {
+func (f *ClientWithSubRepoPermsCacheFunc) appendCall(r0 ClientWithSubRepoPermsCacheFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientSystemInfoFuncCall objects describing
-// the invocations of this function.
-func (f *ClientSystemInfoFunc) History() []ClientSystemInfoFuncCall {
+// History returns a sequence of ClientWithSubRepoPermsCacheFuncCall objects
+// describing the invocations of this function.
+func (f *ClientWithSubRepoPermsCacheFunc) History() []ClientWithSubRepoPermsCacheFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientSystemInfoFuncCall, len(f.history))
+	history := make([]ClientWithSubRepoPermsCacheFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientSystemInfoFuncCall is an object that describes an invocation of
-// method SystemInfo on an instance of MockClient.
-type ClientSystemInfoFuncCall struct {
+// ClientWithSubRepoPermsCacheFuncCall is an object that describes an
+// invocation of method WithSubRepoPermsCache on an instance of MockClient.
+type ClientWithSubRepoPermsCacheFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 string
+	Arg0 time.Duration
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 protocol.SystemInfo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 Client
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientSystemInfoFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c ClientWithSubRepoPermsCacheFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientSystemInfoFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ClientWithSubRepoPermsCacheFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// ClientSystemsInfoFunc describes the behavior when the SystemsInfo method
-// of the parent MockClient instance is invoked.
-type ClientSystemsInfoFunc struct {
-	defaultHook func(context.Context) ([]protocol.SystemInfo, error)
-	hooks       []func(context.Context) ([]protocol.SystemInfo, error)
-	history     []ClientSystemsInfoFuncCall
+// ClientWriteCommitGraphFunc describes the behavior when the
+// WriteCommitGraph method of the parent MockClient instance is invoked.
+type ClientWriteCommitGraphFunc struct {
+	defaultHook func(context.Context, api.RepoName) error
+	hooks       []func(context.Context, api.RepoName) error
+	history     []ClientWriteCommitGraphFuncCall
 	mutex       sync.Mutex
 }
 
-// SystemsInfo delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockClient) SystemsInfo(v0 context.Context) ([]protocol.SystemInfo, error) {
-	r0, r1 := m.SystemsInfoFunc.nextHook()(v0)
-	m.SystemsInfoFunc.appendCall(ClientSystemsInfoFuncCall{v0, r0, r1})
-	return r0, r1
+// WriteCommitGraph delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockClient) WriteCommitGraph(v0 context.Context, v1 api.RepoName) error {
+	r0 := m.WriteCommitGraphFunc.nextHook()(v0, v1)
+	m.WriteCommitGraphFunc.appendCall(ClientWriteCommitGraphFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the SystemsInfo method
-// of the parent MockClient instance is invoked and the hook queue is empty.
-func (f *ClientSystemsInfoFunc) SetDefaultHook(hook func(context.Context) ([]protocol.SystemInfo, error)) {
+// SetDefaultHook sets function that is called when the WriteCommitGraph
+// method of the parent MockClient instance is invoked and the hook queue is
+// empty.
+func (f *ClientWriteCommitGraphFunc) SetDefaultHook(hook func(context.Context, api.RepoName) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SystemsInfo method of the parent MockClient instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *ClientSystemsInfoFunc) PushHook(hook func(context.Context) ([]protocol.SystemInfo, error)) {
+// WriteCommitGraph method of the parent MockClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ClientWriteCommitGraphFunc) PushHook(hook func(context.Context, api.RepoName) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5671,20 +13850,20 @@ func (f *ClientSystemsInfoFunc) PushHook(hook func(context.Context) ([]protocol.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *ClientSystemsInfoFunc) SetDefaultReturn(r0 []protocol.SystemInfo, r1 error) {
-	f.SetDefaultHook(func(context.Context) ([]protocol.SystemInfo, error) {
-		return r0, r1
+func (f *ClientWriteCommitGraphFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *ClientSystemsInfoFunc) PushReturn(r0 []protocol.SystemInfo, r1 error) {
-	f.PushHook(func(context.Context) ([]protocol.SystemInfo, error) {
-		return r0, r1
+func (f *ClientWriteCommitGraphFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName) error {
+		return r0
 	})
 }
 
-func (f *ClientSystemsInfoFunc) nextHook() func(context.Context) ([]protocol.SystemInfo, error) {
+func (f *ClientWriteCommitGraphFunc) nextHook() func(context.Context, api.RepoName) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5697,45 +13876,45 @@ func (f *ClientSystemsInfoFunc) nextHook() func(context.Context) ([]protocol.Sys
 	return hook
 }
 
-func (f *ClientSystemsInfoFunc) appendCall(r0 ClientSystemsInfoFuncCall) {
+func (f *ClientWriteCommitGraphFunc) appendCall(r0 ClientWriteCommitGraphFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ClientSystemsInfoFuncCall objects
+// History returns a sequence of ClientWriteCommitGraphFuncCall objects
 // describing the invocations of this function.
-func (f *ClientSystemsInfoFunc) History() []ClientSystemsInfoFuncCall {
+func (f *ClientWriteCommitGraphFunc) History() []ClientWriteCommitGraphFuncCall {
 	f.mutex.Lock()
-	history := make([]ClientSystemsInfoFuncCall, len(f.history))
+	history := make([]ClientWriteCommitGraphFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ClientSystemsInfoFuncCall is an object that describes an invocation of
-// method SystemsInfo on an instance of MockClient.
-type ClientSystemsInfoFuncCall struct {
+// ClientWriteCommitGraphFuncCall is an object that describes an invocation
+// of method WriteCommitGraph on an instance of MockClient.
+type ClientWriteCommitGraphFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Result0 is the value of the 1st result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result0 []protocol.SystemInfo
-	// Result1 is the value of the 2nd result returned from this method
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c ClientSystemsInfoFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c ClientWriteCommitGraphFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c ClientSystemsInfoFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c ClientWriteCommitGraphFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
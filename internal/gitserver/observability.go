@@ -13,50 +13,100 @@ import (
 )
 
 type operations struct {
-	archiveReader            *observation.Operation
-	commits                  *observation.Operation
-	contributorCount         *observation.Operation
-	exec                     *observation.Operation
-	firstEverCommit          *observation.Operation
-	getBehindAhead           *observation.Operation
-	getCommit                *observation.Operation
-	hasCommitAfter           *observation.Operation
-	listRefs                 *observation.Operation
-	lstat                    *observation.Operation
-	mergeBase                *observation.Operation
-	newFileReader            *observation.Operation
-	readDir                  *observation.Operation
-	resolveRevision          *observation.Operation
-	revAtTime                *observation.Operation
-	revList                  *observation.Operation
-	search                   *observation.Operation
-	stat                     *observation.Operation
-	streamBlameFile          *observation.Operation
-	systemsInfo              *observation.Operation
-	systemInfo               *observation.Operation
-	requestRepoUpdate        *observation.Operation
-	isRepoCloneable          *observation.Operation
-	repoCloneProgress        *observation.Operation
-	remove                   *observation.Operation
-	isPerforcePathCloneable  *observation.Operation
-	checkPerforceCredentials *observation.Operation
-	perforceUsers            *observation.Operation
-	perforceProtectsForUser  *observation.Operation
-	perforceProtectsForDepot *observation.Operation
-	perforceGroupMembers     *observation.Operation
-	isPerforceSuperUser      *observation.Operation
-	perforceGetChangelist    *observation.Operation
-	createCommitFromPatch    *observation.Operation
-	getObject                *observation.Operation
-	commitGraph              *observation.Operation
-	commitsUniqueToBranch    *observation.Operation
-	getDefaultBranch         *observation.Operation
-	listDirectoryChildren    *observation.Operation
-	lsFiles                  *observation.Operation
-	logReverseEach           *observation.Operation
-	diffSymbols              *observation.Operation
-	commitLog                *observation.Operation
-	diff                     *observation.Operation
+	archiveReader             *observation.Operation
+	commits                   *observation.Operation
+	countCommits              *observation.Operation
+	ancestryPath              *observation.Operation
+	contributorCount          *observation.Operation
+	exec                      *observation.Operation
+	firstEverCommit           *observation.Operation
+	firstCommitForPath        *observation.Operation
+	directoryHistory          *observation.Operation
+	formatPatch               *observation.Operation
+	createBundle              *observation.Operation
+	applyBundle               *observation.Operation
+	execInWorktree            *observation.Operation
+	rebasePreview             *observation.Operation
+	revertCommit              *observation.Operation
+	cherryPick                *observation.Operation
+	squashCommits             *observation.Operation
+	getBehindAhead            *observation.Operation
+	getCommit                 *observation.Operation
+	getCommitWithChanges      *observation.Operation
+	hasCommitAfter            *observation.Operation
+	listGitoliteRepos         *observation.Operation
+	listRefs                  *observation.Operation
+	refsChangedSince          *observation.Operation
+	watchRefs                 *observation.Operation
+	watchDefaultBranchCommits *observation.Operation
+	lstat                     *observation.Operation
+	mergeBase                 *observation.Operation
+	mergeBaseCrossRepo        *observation.Operation
+	newFileReader             *observation.Operation
+	readDir                   *observation.Operation
+	resolveRevision           *observation.Operation
+	revAtTime                 *observation.Operation
+	revList                   *observation.Operation
+	search                    *observation.Operation
+	stat                      *observation.Operation
+	pathExists                *observation.Operation
+	streamBlameFile           *observation.Operation
+	systemsInfo               *observation.Operation
+	systemInfo                *observation.Operation
+	requestRepoUpdate         *observation.Operation
+	isRepoCloneable           *observation.Operation
+	repoCloneProgress         *observation.Operation
+	remove                    *observation.Operation
+	isPerforcePathCloneable   *observation.Operation
+	checkPerforceCredentials  *observation.Operation
+	perforceUsers             *observation.Operation
+	perforceProtectsForUser   *observation.Operation
+	perforceProtectsForDepot  *observation.Operation
+	perforceGroupMembers      *observation.Operation
+	isPerforceSuperUser       *observation.Operation
+	perforceGetChangelist     *observation.Operation
+	createCommitFromPatch     *observation.Operation
+	getObject                 *observation.Operation
+	commitGraph               *observation.Operation
+	commitsUniqueToBranch     *observation.Operation
+	getDefaultBranch          *observation.Operation
+	listDirectoryChildren     *observation.Operation
+	lsFiles                   *observation.Operation
+	logReverseEach            *observation.Operation
+	diffSymbols               *observation.Operation
+	commitLog                 *observation.Operation
+	diff                      *observation.Operation
+	diffCrossRepo             *observation.Operation
+	updateRef                 *observation.Operation
+	createBranch              *observation.Operation
+	deleteBranch              *observation.Operation
+	createTag                 *observation.Operation
+	blameSummary              *observation.Operation
+	streamCommitGraph         *observation.Operation
+	cherry                    *observation.Operation
+	cherryCrossRepo           *observation.Operation
+	rangeDiff                 *observation.Operation
+	symbolicRef               *observation.Operation
+	refExists                 *observation.Operation
+	listBranches              *observation.Operation
+	listTags                  *observation.Operation
+	listRemoteRefs            *observation.Operation
+	fetchRefspec              *observation.Operation
+	ensureRevision            *observation.Operation
+	cloneProgress             *observation.Operation
+	optimizeRepo              *observation.Operation
+	commitGraphStatus         *observation.Operation
+	cloneState                *observation.Operation
+	listShardRepos            *observation.Operation
+	diagnoseAddrForRepo       *observation.Operation
+	readBlob                  *observation.Operation
+	getTree                   *observation.Operation
+	sparseManifest            *observation.Operation
+	lastCommitsForTree        *observation.Operation
+	commitActivity            *observation.Operation
+	getTag                    *observation.Operation
+	rawDiff                   *observation.Operation
+	abbreviateCommit          *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -105,50 +155,100 @@ func newOperations(observationCtx *observation.Context) *operations {
 	})
 
 	return &operations{
-		archiveReader:            op("ArchiveReader"),
-		commits:                  op("Commits"),
-		contributorCount:         op("ContributorCount"),
-		exec:                     op("Exec"),
-		firstEverCommit:          op("FirstEverCommit"),
-		getBehindAhead:           op("GetBehindAhead"),
-		getCommit:                op("GetCommit"),
-		hasCommitAfter:           op("HasCommitAfter"),
-		listRefs:                 op("ListRefs"),
-		lstat:                    subOp("lStat"),
-		mergeBase:                op("MergeBase"),
-		newFileReader:            op("NewFileReader"),
-		readDir:                  op("ReadDir"),
-		resolveRevision:          resolveRevisionOperation,
-		revAtTime:                op("RevAtTime"),
-		revList:                  op("RevList"),
-		search:                   op("Search"),
-		stat:                     op("Stat"),
-		streamBlameFile:          op("StreamBlameFile"),
-		systemsInfo:              op("SystemsInfo"),
-		systemInfo:               op("SystemInfo"),
-		requestRepoUpdate:        op("RequestRepoUpdate"),
-		isRepoCloneable:          op("IsRepoCloneable"),
-		repoCloneProgress:        op("RepoCloneProgress"),
-		remove:                   op("Remove"),
-		isPerforcePathCloneable:  op("IsPerforcePathCloneable"),
-		checkPerforceCredentials: op("CheckPerforceCredentials"),
-		perforceUsers:            op("PerforceUsers"),
-		perforceProtectsForUser:  op("PerforceProtectsForUser"),
-		perforceProtectsForDepot: op("PerforceProtectsForDepot"),
-		perforceGroupMembers:     op("PerforceGroupMembers"),
-		isPerforceSuperUser:      op("IsPerforceSuperUser"),
-		perforceGetChangelist:    op("PerforceGetChangelist"),
-		createCommitFromPatch:    op("CreateCommitFromPatch"),
-		getObject:                op("GetObject"),
-		commitGraph:              op("CommitGraph"),
-		commitsUniqueToBranch:    op("CommitsUniqueToBranch"),
-		getDefaultBranch:         op("GetDefaultBranch"),
-		listDirectoryChildren:    op("ListDirectoryChildren"),
-		lsFiles:                  op("LsFiles"),
-		logReverseEach:           op("LogReverseEach"),
-		diffSymbols:              op("DiffSymbols"),
-		commitLog:                op("CommitLog"),
-		diff:                     op("Diff"),
+		archiveReader:             op("ArchiveReader"),
+		commits:                   op("Commits"),
+		countCommits:              op("CountCommits"),
+		ancestryPath:              op("AncestryPath"),
+		contributorCount:          op("ContributorCount"),
+		exec:                      op("Exec"),
+		firstEverCommit:           op("FirstEverCommit"),
+		firstCommitForPath:        op("FirstCommitForPath"),
+		directoryHistory:          op("DirectoryHistory"),
+		formatPatch:               op("FormatPatch"),
+		createBundle:              op("CreateBundle"),
+		applyBundle:               op("ApplyBundle"),
+		execInWorktree:            op("ExecInWorktree"),
+		rebasePreview:             op("RebasePreview"),
+		revertCommit:              op("RevertCommit"),
+		cherryPick:                op("CherryPick"),
+		squashCommits:             op("SquashCommits"),
+		getBehindAhead:            op("GetBehindAhead"),
+		getCommit:                 op("GetCommit"),
+		getCommitWithChanges:      op("GetCommitWithChanges"),
+		hasCommitAfter:            op("HasCommitAfter"),
+		listGitoliteRepos:         op("ListGitoliteRepos"),
+		listRefs:                  op("ListRefs"),
+		refsChangedSince:          op("RefsChangedSince"),
+		watchRefs:                 op("WatchRefs"),
+		watchDefaultBranchCommits: op("WatchDefaultBranchCommits"),
+		lstat:                     subOp("lStat"),
+		mergeBase:                 op("MergeBase"),
+		mergeBaseCrossRepo:        op("MergeBaseCrossRepo"),
+		newFileReader:             op("NewFileReader"),
+		readDir:                   op("ReadDir"),
+		resolveRevision:           resolveRevisionOperation,
+		revAtTime:                 op("RevAtTime"),
+		revList:                   op("RevList"),
+		search:                    op("Search"),
+		stat:                      op("Stat"),
+		pathExists:                op("PathExists"),
+		streamBlameFile:           op("StreamBlameFile"),
+		systemsInfo:               op("SystemsInfo"),
+		systemInfo:                op("SystemInfo"),
+		requestRepoUpdate:         op("RequestRepoUpdate"),
+		isRepoCloneable:           op("IsRepoCloneable"),
+		repoCloneProgress:         op("RepoCloneProgress"),
+		remove:                    op("Remove"),
+		isPerforcePathCloneable:   op("IsPerforcePathCloneable"),
+		checkPerforceCredentials:  op("CheckPerforceCredentials"),
+		perforceUsers:             op("PerforceUsers"),
+		perforceProtectsForUser:   op("PerforceProtectsForUser"),
+		perforceProtectsForDepot:  op("PerforceProtectsForDepot"),
+		perforceGroupMembers:      op("PerforceGroupMembers"),
+		isPerforceSuperUser:       op("IsPerforceSuperUser"),
+		perforceGetChangelist:     op("PerforceGetChangelist"),
+		createCommitFromPatch:     op("CreateCommitFromPatch"),
+		getObject:                 op("GetObject"),
+		commitGraph:               op("CommitGraph"),
+		commitsUniqueToBranch:     op("CommitsUniqueToBranch"),
+		getDefaultBranch:          op("GetDefaultBranch"),
+		listDirectoryChildren:     op("ListDirectoryChildren"),
+		lsFiles:                   op("LsFiles"),
+		logReverseEach:            op("LogReverseEach"),
+		diffSymbols:               op("DiffSymbols"),
+		commitLog:                 op("CommitLog"),
+		diff:                      op("Diff"),
+		diffCrossRepo:             op("DiffCrossRepo"),
+		updateRef:                 op("UpdateRef"),
+		createBranch:              op("CreateBranch"),
+		deleteBranch:              op("DeleteBranch"),
+		createTag:                 op("CreateTag"),
+		blameSummary:              op("BlameSummary"),
+		streamCommitGraph:         op("StreamCommitGraph"),
+		cherry:                    op("Cherry"),
+		cherryCrossRepo:           op("CherryCrossRepo"),
+		rangeDiff:                 op("RangeDiff"),
+		symbolicRef:               op("SymbolicRef"),
+		refExists:                 op("RefExists"),
+		listBranches:              op("ListBranches"),
+		listTags:                  op("ListTags"),
+		listRemoteRefs:            op("ListRemoteRefs"),
+		fetchRefspec:              op("FetchRefspec"),
+		ensureRevision:            op("EnsureRevision"),
+		cloneProgress:             op("CloneProgress"),
+		optimizeRepo:              op("OptimizeRepo"),
+		commitGraphStatus:         op("CommitGraphStatus"),
+		cloneState:                op("CloneState"),
+		listShardRepos:            op("ListShardRepos"),
+		diagnoseAddrForRepo:       op("DiagnoseAddrForRepo"),
+		readBlob:                  op("ReadBlob"),
+		getTree:                   op("GetTree"),
+		sparseManifest:            op("SparseManifest"),
+		lastCommitsForTree:        op("LastCommitsForTree"),
+		commitActivity:            op("CommitActivity"),
+		getTag:                    op("GetTag"),
+		rawDiff:                   op("RawDiff"),
+		abbreviateCommit:          op("AbbreviateCommit"),
 	}
 }
 
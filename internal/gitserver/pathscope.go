@@ -0,0 +1,233 @@
+package gitserver
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// PathScopedClient is a view of a single repository that only exposes the
+// files below a path prefix. Paths are relative to the repository root, as
+// with Client, but paths outside of the subtree are treated as if they don't
+// exist, and results never contain them.
+//
+// Revisions must be commits, ref names or ranges of them, as accepted by
+// gitdomain.ValidateRevSpec. Revisions that select a tree by path, like
+// HEAD:secret, would make the paths relative to another directory and are
+// rejected.
+//
+// Create one with Client.ScopedToPath.
+type PathScopedClient struct {
+	client Client
+	repo   api.RepoName
+	prefix string
+}
+
+func newPathScopedClient(c Client, repo api.RepoName, pathPrefix string) (*PathScopedClient, error) {
+	prefix := path.Clean(strings.Trim(pathPrefix, "/"))
+	switch {
+	case prefix == ".", prefix == "..", strings.HasPrefix(prefix, "../"):
+		return nil, errors.Newf("invalid path prefix %q: must be a directory inside the repository", pathPrefix)
+	case strings.HasPrefix(prefix, ":"), strings.ContainsAny(prefix, `*?[\`):
+		// The prefix is passed to git as a pathspec, so it must not contain
+		// any pathspec magic or glob characters that could match other paths.
+		return nil, errors.Newf("invalid path prefix %q: must not contain pathspec magic or glob characters", pathPrefix)
+	}
+	return &PathScopedClient{client: c, repo: repo, prefix: prefix}, nil
+}
+
+// Repo returns the repository the client is scoped to.
+func (c *PathScopedClient) Repo() api.RepoName {
+	return c.repo
+}
+
+// PathPrefix returns the directory the client is scoped to, without leading
+// or trailing slashes.
+func (c *PathScopedClient) PathPrefix() string {
+	return c.prefix
+}
+
+// contains returns true if p is the prefix itself or below it. Pathspecs with
+// magic are never considered to be below the prefix.
+func (c *PathScopedClient) contains(p string) bool {
+	if strings.HasPrefix(p, ":") {
+		return false
+	}
+	p = path.Clean(strings.TrimPrefix(p, "/"))
+	return p == c.prefix || strings.HasPrefix(p, c.prefix+"/")
+}
+
+// checkPaths returns the paths to pass to git for the requested paths: the
+// prefix if none were requested, or the cleaned requested ones if they are all
+// inside the subtree.
+func (c *PathScopedClient) checkPaths(op string, paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return []string{c.prefix}, nil
+	}
+	cleaned := make([]string, len(paths))
+	for i, p := range paths {
+		if !c.contains(p) {
+			return nil, &os.PathError{Op: op, Path: p, Err: os.ErrNotExist}
+		}
+		// Pass on what was checked, so that git never resolves ".."
+		// differently.
+		cleaned[i] = path.Clean(strings.TrimPrefix(p, "/"))
+	}
+	return cleaned, nil
+}
+
+// checkRevs returns an error if any of the non-empty revs is not a valid
+// revision. Git resolves paths relative to the tree a revision selects, so
+// HEAD:secret with the prefix as path would leave the subtree.
+func checkRevs(revs ...string) error {
+	for _, rev := range revs {
+		if rev == "" {
+			continue
+		}
+		if err := gitdomain.ValidateRevSpec(rev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LsFiles is like Client.LsFiles for the scoped repository. Without
+// pathspecs, all files below the prefix are returned.
+func (c *PathScopedClient) LsFiles(ctx context.Context, commit api.CommitID, pathspecs ...gitdomain.Pathspec) ([]string, error) {
+	if err := checkRevs(string(commit)); err != nil {
+		return nil, err
+	}
+	paths := make([]string, len(pathspecs))
+	for i, p := range pathspecs {
+		paths[i] = string(p)
+	}
+	paths, err := c.checkPaths("ls-files", paths)
+	if err != nil {
+		return nil, err
+	}
+	pathspecs = make([]gitdomain.Pathspec, len(paths))
+	for i, p := range paths {
+		pathspecs[i] = gitdomain.Pathspec(p)
+	}
+
+	files, err := c.client.LsFiles(ctx, c.repo, commit, pathspecs...)
+	if err != nil {
+		return nil, err
+	}
+	// The pathspecs already restrict the result, this is only a safeguard.
+	filtered := files[:0]
+	for _, f := range files {
+		if c.contains(f) {
+			filtered = append(filtered, f)
+		}
+	}
+	return filtered, nil
+}
+
+// ReadDir is like Client.ReadDir for the scoped repository. The path must be
+// the prefix or a directory below it.
+func (c *PathScopedClient) ReadDir(ctx context.Context, commit api.CommitID, dir string, recurse bool) ([]fs.FileInfo, error) {
+	if err := checkRevs(string(commit)); err != nil {
+		return nil, err
+	}
+	paths, err := c.checkPaths("ls-tree", []string{dir})
+	if err != nil {
+		return nil, err
+	}
+	return c.client.ReadDir(ctx, c.repo, commit, paths[0], recurse)
+}
+
+// Diff is like Client.Diff for the scoped repository. opts.Repo must be empty
+// or the scoped repository. If opts.Paths is empty, the diff covers the whole
+// subtree. Renames across the boundary of the subtree show up as added or
+// deleted files.
+func (c *PathScopedClient) Diff(ctx context.Context, opts DiffOptions) (*DiffFileIterator, error) {
+	if opts.Repo != "" && opts.Repo != c.repo {
+		return nil, errors.Newf("client is scoped to repo %s", c.repo)
+	}
+	opts.Repo = c.repo
+	if err := checkRevs(opts.Base, opts.Head); err != nil {
+		return nil, err
+	}
+
+	paths, err := c.checkPaths("diff", opts.Paths)
+	if err != nil {
+		return nil, err
+	}
+	opts.Paths = paths
+
+	it, err := c.client.Diff(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like for LsFiles, this is a safeguard in addition to the paths. File
+	// names outside of the subtree are redacted like unreadable files.
+	next := it.fileFilterFunc
+	it.fileFilterFunc = func(fileNames ...string) ([]bool, error) {
+		canRead := make([]bool, len(fileNames))
+		var inside []string
+		for i, name := range fileNames {
+			if canRead[i] = c.contains(name); canRead[i] {
+				inside = append(inside, name)
+			}
+		}
+		if next == nil || len(inside) == 0 {
+			return canRead, nil
+		}
+		insideCanRead, err := next(inside...)
+		if err != nil {
+			return nil, err
+		}
+		for i := range canRead {
+			if canRead[i] {
+				canRead[i], insideCanRead = insideCanRead[0], insideCanRead[1:]
+			}
+		}
+		return canRead, nil
+	}
+	return it, nil
+}
+
+// Commits is like Client.Commits for the scoped repository. If opt.Path is
+// empty, all commits modifying the subtree are returned. Following renames is
+// not supported, as it leads outside of the subtree.
+func (c *PathScopedClient) Commits(ctx context.Context, opt CommitsOptions) ([]*gitdomain.Commit, error) {
+	if opt.Follow {
+		return nil, errors.New("following renames is not supported by path scoped clients")
+	}
+	if err := checkRevs(opt.Range); err != nil {
+		return nil, err
+	}
+	var paths []string
+	if opt.Path != "" {
+		paths = []string{opt.Path}
+	}
+	paths, err := c.checkPaths("log", paths)
+	if err != nil {
+		return nil, err
+	}
+	opt.Path = paths[0]
+	return c.client.Commits(ctx, c.repo, opt)
+}
+
+// ArchiveReader is like Client.ArchiveReader for the scoped repository. If
+// options.Paths is empty, the archive contains the whole subtree.
+func (c *PathScopedClient) ArchiveReader(ctx context.Context, options ArchiveOptions) (io.ReadCloser, error) {
+	if err := checkRevs(options.Treeish); err != nil {
+		return nil, err
+	}
+	paths, err := c.checkPaths("archive", options.Paths)
+	if err != nil {
+		return nil, err
+	}
+	options.Paths = paths
+	return c.client.ArchiveReader(ctx, c.repo, options)
+}
@@ -0,0 +1,167 @@
+package gitserver
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestPathScopedClient(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := context.Background()
+
+	r := NewTestRepo(t)
+	first := r.Commit("first").
+		AddFile("team/a.txt", "a").
+		AddFile("team/sub/b.txt", "b").
+		AddFile("other/c.txt", "c").
+		AddFile("teammate.txt", "d")
+	r.Commit("outside").AddFile("other/c.txt", "c2")
+	second := r.Commit("inside").AddFile("team/a.txt", "a2").RenameFile("other/c.txt", "team/c.txt")
+	repo := r.Build()
+
+	c, err := NewLocalTestClient(t).ScopedToPath(repo, "/team/")
+	require.NoError(t, err)
+	require.Equal(t, "team", c.PathPrefix())
+
+	t.Run("LsFiles", func(t *testing.T) {
+		files, err := c.LsFiles(ctx, second.ID())
+		require.NoError(t, err)
+		require.Equal(t, []string{"team/a.txt", "team/c.txt", "team/sub/b.txt"}, files)
+
+		files, err = c.LsFiles(ctx, second.ID(), "team/sub")
+		require.NoError(t, err)
+		require.Equal(t, []string{"team/sub/b.txt"}, files)
+
+		for _, pathspec := range []string{"other", "team/../other", ":(top)other", "*"} {
+			_, err = c.LsFiles(ctx, second.ID(), gitdomain.Pathspec(pathspec))
+			require.True(t, os.IsNotExist(err), pathspec)
+		}
+	})
+
+	t.Run("ReadDir", func(t *testing.T) {
+		fis, err := c.ReadDir(ctx, second.ID(), "team", false)
+		require.NoError(t, err)
+		var names []string
+		for _, fi := range fis {
+			names = append(names, fi.Name())
+		}
+		require.Equal(t, []string{"team/a.txt", "team/c.txt", "team/sub"}, names)
+
+		_, err = c.ReadDir(ctx, second.ID(), "", false)
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Diff", func(t *testing.T) {
+		it, err := c.Diff(ctx, DiffOptions{Base: string(first.ID()), Head: string(second.ID())})
+		require.NoError(t, err)
+		defer it.Close()
+
+		var names []string
+		for {
+			fd, err := it.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			names = append(names, fd.OrigName+" -> "+fd.NewName)
+		}
+		// The rename into the subtree shows up as an added file.
+		require.Equal(t, []string{"team/a.txt -> team/a.txt", "/dev/null -> team/c.txt"}, names)
+
+		_, err = c.Diff(ctx, DiffOptions{Base: string(first.ID()), Head: string(second.ID()), Paths: []string{"other"}})
+		require.True(t, os.IsNotExist(err))
+
+		_, err = c.Diff(ctx, DiffOptions{Repo: "other-repo", Base: string(first.ID()), Head: string(second.ID())})
+		require.Error(t, err)
+	})
+
+	t.Run("Commits", func(t *testing.T) {
+		commits, err := c.Commits(ctx, CommitsOptions{Range: string(second.ID())})
+		require.NoError(t, err)
+		var messages []string
+		for _, commit := range commits {
+			messages = append(messages, strings.TrimSpace(string(commit.Message)))
+		}
+		require.Equal(t, []string{"inside", "first"}, messages)
+
+		_, err = c.Commits(ctx, CommitsOptions{Range: string(second.ID()), Path: "teammate.txt"})
+		require.True(t, os.IsNotExist(err))
+
+		_, err = c.Commits(ctx, CommitsOptions{Range: string(second.ID()), Path: "team/c.txt", Follow: true})
+		require.Error(t, err)
+	})
+
+	t.Run("ArchiveReader", func(t *testing.T) {
+		m := NewMockClient()
+		m.ArchiveReaderFunc.SetDefaultReturn(io.NopCloser(strings.NewReader("")), nil)
+		c, err := newPathScopedClient(m, "repo", "team")
+		require.NoError(t, err)
+
+		rc, err := c.ArchiveReader(ctx, ArchiveOptions{Treeish: "HEAD", Format: ArchiveFormatTar})
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		rc, err = c.ArchiveReader(ctx, ArchiveOptions{Treeish: "HEAD", Format: ArchiveFormatTar, Paths: []string{"team/./sub"}})
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+
+		_, err = c.ArchiveReader(ctx, ArchiveOptions{Treeish: "HEAD", Format: ArchiveFormatTar, Paths: []string{"team/sub", "other"}})
+		require.True(t, os.IsNotExist(err))
+
+		history := m.ArchiveReaderFunc.History()
+		require.Len(t, history, 2)
+		require.Equal(t, api.RepoName("repo"), history[0].Arg1)
+		require.Equal(t, []string{"team"}, history[0].Arg2.Paths)
+		require.Equal(t, []string{"team/sub"}, history[1].Arg2.Paths)
+	})
+
+	t.Run("revisions selecting a tree", func(t *testing.T) {
+		// HEAD:other with the prefix as path would read other/team.
+		for _, rev := range []string{"HEAD:other", string(second.ID()) + ":other", ":other", "HEAD@{1}"} {
+			_, err := c.LsFiles(ctx, api.CommitID(rev))
+			require.True(t, errors.HasType(err, &gitdomain.InvalidRevSpecError{}), rev)
+
+			_, err = c.ReadDir(ctx, api.CommitID(rev), "team", false)
+			require.True(t, errors.HasType(err, &gitdomain.InvalidRevSpecError{}), rev)
+
+			_, err = c.Diff(ctx, DiffOptions{Base: string(first.ID()), Head: rev})
+			require.True(t, errors.HasType(err, &gitdomain.InvalidRevSpecError{}), rev)
+
+			_, err = c.Commits(ctx, CommitsOptions{Range: rev})
+			require.True(t, errors.HasType(err, &gitdomain.InvalidRevSpecError{}), rev)
+
+			m := NewMockClient()
+			c, err := newPathScopedClient(m, "repo", "team")
+			require.NoError(t, err)
+			_, err = c.ArchiveReader(ctx, ArchiveOptions{Treeish: rev, Format: ArchiveFormatTar})
+			require.True(t, errors.HasType(err, &gitdomain.InvalidRevSpecError{}), rev)
+			require.Empty(t, m.ArchiveReaderFunc.History())
+		}
+	})
+
+	t.Run("sub-repo permissions still apply", func(t *testing.T) {
+		c, err := NewLocalTestClient(t).WithChecker(getTestSubRepoPermsChecker("team/sub/b.txt")).ScopedToPath(repo, "team")
+		require.NoError(t, err)
+
+		files, err := c.LsFiles(actor.WithActor(ctx, &actor.Actor{UID: 1}), second.ID())
+		require.NoError(t, err)
+		require.Equal(t, []string{"team/a.txt", "team/c.txt"}, files)
+	})
+
+	t.Run("invalid prefixes", func(t *testing.T) {
+		for _, prefix := range []string{"", "/", ".", "..", "../x", "a/../..", "*", "te?m", ":(top)team"} {
+			_, err := NewClient("test").ScopedToPath(repo, prefix)
+			require.Error(t, err, prefix)
+		}
+	})
+}
@@ -0,0 +1,146 @@
+package gitserver
+
+import (
+	"context"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+)
+
+// permsCacheSize is the number of sub-repo permission decisions a client
+// created with WithSubRepoPermsCache remembers.
+const permsCacheSize = 10000
+
+// WithSubRepoPermsCache returns a new client that remembers the sub-repo
+// permission decisions made while filtering results for ttl, so that a
+// request which stats, lists and diffs the same paths only evaluates the
+// rules for each of them once. Decisions are cached per user, repo and path.
+//
+// Changes to the permissions of a user take up to ttl to apply, so it should
+// be a few seconds at most.
+func (c *clientImplementor) WithSubRepoPermsCache(ttl time.Duration) Client {
+	if ttl <= 0 || c.subRepoPermsChecker == nil {
+		return c
+	}
+	cache, err := lru.New[permsCacheKey, permsCacheEntry](permsCacheSize)
+	if err != nil {
+		// Can only happen for a non-positive size.
+		return c
+	}
+	return &clientImplementor{
+		logger:     c.logger,
+		scope:      c.scope,
+		operations: c.operations,
+		subRepoPermsChecker: &cachingPermsChecker{
+			SubRepoPermissionChecker: c.subRepoPermsChecker,
+			ttl:                      ttl,
+			clock:                    time.Now,
+			cache:                    cache,
+		},
+		clientSource: c.clientSource,
+	}
+}
+
+type permsCacheKey struct {
+	userID int32
+	repo   api.RepoName
+	path   string
+}
+
+type permsCacheEntry struct {
+	perms   authz.Perms
+	expires time.Time
+}
+
+// cachingPermsChecker is a SubRepoPermissionChecker which caches the
+// decisions of the checker it wraps. Errors are never cached.
+type cachingPermsChecker struct {
+	authz.SubRepoPermissionChecker
+	ttl   time.Duration
+	clock func() time.Time
+	cache *lru.Cache[permsCacheKey, permsCacheEntry]
+}
+
+var _ authz.BatchFilePermissionChecker = &cachingPermsChecker{}
+
+func (c *cachingPermsChecker) get(key permsCacheKey) (authz.Perms, bool) {
+	e, ok := c.cache.Get(key)
+	if !ok || !c.clock().Before(e.expires) {
+		return authz.None, false
+	}
+	return e.perms, true
+}
+
+func (c *cachingPermsChecker) add(key permsCacheKey, perms authz.Perms) {
+	c.cache.Add(key, permsCacheEntry{perms: perms, expires: c.clock().Add(c.ttl)})
+}
+
+func (c *cachingPermsChecker) Permissions(ctx context.Context, userID int32, content authz.RepoContent) (authz.Perms, error) {
+	key := permsCacheKey{userID: userID, repo: content.Repo, path: content.Path}
+	if perms, ok := c.get(key); ok {
+		return perms, nil
+	}
+	perms, err := c.SubRepoPermissionChecker.Permissions(ctx, userID, content)
+	if err != nil {
+		return authz.None, err
+	}
+	c.add(key, perms)
+	return perms, nil
+}
+
+func (c *cachingPermsChecker) FilePermissionsFunc(ctx context.Context, userID int32, repo api.RepoName) (authz.FilePermissionFunc, error) {
+	// The wrapped function is only created once a path isn't cached.
+	var checkPathPerms authz.FilePermissionFunc
+	return func(path string) (authz.Perms, error) {
+		key := permsCacheKey{userID: userID, repo: repo, path: path}
+		if perms, ok := c.get(key); ok {
+			return perms, nil
+		}
+		if checkPathPerms == nil {
+			f, err := c.SubRepoPermissionChecker.FilePermissionsFunc(ctx, userID, repo)
+			if err != nil {
+				return authz.None, err
+			}
+			checkPathPerms = f
+		}
+		perms, err := checkPathPerms(path)
+		if err != nil {
+			return authz.None, err
+		}
+		c.add(key, perms)
+		return perms, nil
+	}, nil
+}
+
+// FilePermissions implements authz.BatchFilePermissionChecker. Only the paths
+// without a cached decision are passed on to the wrapped checker, in one
+// batch.
+func (c *cachingPermsChecker) FilePermissions(ctx context.Context, userID int32, repo api.RepoName, paths []string) ([]authz.Perms, error) {
+	perms := make([]authz.Perms, len(paths))
+	var missing []int
+	var missingPaths []string
+	for i, p := range paths {
+		if cached, ok := c.get(permsCacheKey{userID: userID, repo: repo, path: p}); ok {
+			perms[i] = cached
+			continue
+		}
+		missing = append(missing, i)
+		missingPaths = append(missingPaths, p)
+	}
+	if len(missing) == 0 {
+		return perms, nil
+	}
+
+	fetched, err := authz.FilePermissions(ctx, c.SubRepoPermissionChecker, userID, repo, missingPaths)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range missing {
+		perms[i] = fetched[j]
+		c.add(permsCacheKey{userID: userID, repo: repo, path: paths[i]}, fetched[j])
+	}
+	return perms, nil
+}
@@ -0,0 +1,120 @@
+package gitserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestClient_WithSubRepoPermsCache(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+
+	r := NewTestRepo(t)
+	commit := r.Commit("commit").AddFile("file1", "").AddFile("file2", "").AddFile("secret", "")
+	repo := r.Build()
+
+	checker := getTestSubRepoPermsChecker("secret")
+	c := NewTestClient(t).WithChecker(checker).WithSubRepoPermsCache(time.Minute)
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+
+	for range 2 {
+		files, err := c.LsFiles(ctx, repo, commit.ID())
+		require.NoError(t, err)
+		require.Equal(t, []string{"file1", "file2"}, files)
+
+		fi, err := c.Stat(ctx, repo, commit.ID(), "file1")
+		require.NoError(t, err)
+		require.Equal(t, "file1", fi.Name())
+	}
+
+	// Each path was only checked once.
+	require.Len(t, checker.(*authz.MockSubRepoPermissionChecker).PermissionsFunc.History(), 3)
+}
+
+func TestCachingPermsChecker(t *testing.T) {
+	ctx := context.Background()
+	repo := api.RepoName("repo")
+
+	newChecker := func(t *testing.T) (*cachingPermsChecker, *authz.MockSubRepoPermissionChecker, *time.Time) {
+		m := authz.NewMockSubRepoPermissionChecker()
+		m.EnabledFunc.SetDefaultReturn(true)
+		m.PermissionsFunc.SetDefaultHook(func(_ context.Context, userID int32, content authz.RepoContent) (authz.Perms, error) {
+			if userID == 1 {
+				return authz.Read, nil
+			}
+			return authz.None, nil
+		})
+		usePermissionsForFilePermissionsFunc(m)
+
+		cache, err := lru.New[permsCacheKey, permsCacheEntry](10)
+		require.NoError(t, err)
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		return &cachingPermsChecker{
+			SubRepoPermissionChecker: m,
+			ttl:                      time.Second,
+			clock:                    func() time.Time { return now },
+			cache:                    cache,
+		}, m, &now
+	}
+
+	t.Run("decisions are per user", func(t *testing.T) {
+		c, m, _ := newChecker(t)
+
+		perms, err := authz.FilePermissions(ctx, c, 1, repo, []string{"a", "b"})
+		require.NoError(t, err)
+		require.Equal(t, []authz.Perms{authz.Read, authz.Read}, perms)
+
+		perms, err = authz.FilePermissions(ctx, c, 2, repo, []string{"a", "b"})
+		require.NoError(t, err)
+		require.Equal(t, []authz.Perms{authz.None, authz.None}, perms)
+
+		// Only the new path is checked again.
+		perms, err = authz.FilePermissions(ctx, c, 1, repo, []string{"a", "c"})
+		require.NoError(t, err)
+		require.Equal(t, []authz.Perms{authz.Read, authz.Read}, perms)
+		require.Len(t, m.PermissionsFunc.History(), 5)
+
+		p, err := c.Permissions(ctx, 2, authz.RepoContent{Repo: repo, Path: "b"})
+		require.NoError(t, err)
+		require.Equal(t, authz.None, p)
+		require.Len(t, m.PermissionsFunc.History(), 5)
+	})
+
+	t.Run("decisions expire", func(t *testing.T) {
+		c, m, now := newChecker(t)
+
+		f, err := c.FilePermissionsFunc(ctx, 1, repo)
+		require.NoError(t, err)
+		_, err = f("a")
+		require.NoError(t, err)
+		*now = now.Add(500 * time.Millisecond)
+		_, err = f("a")
+		require.NoError(t, err)
+		require.Len(t, m.PermissionsFunc.History(), 1)
+
+		*now = now.Add(time.Second)
+		_, err = f("a")
+		require.NoError(t, err)
+		require.Len(t, m.PermissionsFunc.History(), 2)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		c, m, _ := newChecker(t)
+		m.PermissionsFunc.PushReturn(authz.None, errors.New("boom"))
+
+		_, err := c.Permissions(ctx, 1, authz.RepoContent{Repo: repo, Path: "a"})
+		require.Error(t, err)
+		p, err := c.Permissions(ctx, 1, authz.RepoContent{Repo: repo, Path: "a"})
+		require.NoError(t, err)
+		require.Equal(t, authz.Read, p)
+	})
+}
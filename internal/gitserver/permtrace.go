@@ -0,0 +1,130 @@
+package gitserver
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// PermissionDecision is a sub-repo permission decision the client made for a
+// path while filtering results.
+type PermissionDecision struct {
+	UserID int32
+	Repo   api.RepoName
+	Path   string
+	Perms  authz.Perms
+	// Rule is the sub-repo permission rule that decided Perms, see
+	// authz.FilePermissionDecision. It is always empty if the permission
+	// checker can't explain its decisions.
+	Rule string
+}
+
+// PermissionTrace collects the sub-repo permission decisions made by a client
+// created with WithPermissionTrace. It is safe for concurrent use.
+type PermissionTrace struct {
+	mu        sync.Mutex
+	decisions []PermissionDecision
+}
+
+// Decisions returns the decisions recorded so far, in the order they were
+// made.
+func (t *PermissionTrace) Decisions() []PermissionDecision {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]PermissionDecision(nil), t.decisions...)
+}
+
+func (t *PermissionTrace) record(d ...PermissionDecision) {
+	t.mu.Lock()
+	t.decisions = append(t.decisions, d...)
+	t.mu.Unlock()
+}
+
+// WithPermissionTrace returns a new client that records every sub-repo
+// permission decision it makes into trace, together with the rule behind it.
+// This is meant for debugging why a user can't see a file, e.g. in a diff:
+//
+//	trace := &gitserver.PermissionTrace{}
+//	it, err := client.WithPermissionTrace(trace).Diff(ctx, opts)
+//	...
+//	for _, d := range trace.Decisions() { ... }
+//
+// Evaluating the rules for every path is slower than the normal checks, so
+// tracing shouldn't be used for regular requests.
+func (c *clientImplementor) WithPermissionTrace(trace *PermissionTrace) Client {
+	if trace == nil || c.subRepoPermsChecker == nil {
+		return c
+	}
+	return &clientImplementor{
+		logger:     c.logger,
+		scope:      c.scope,
+		operations: c.operations,
+		subRepoPermsChecker: &tracingPermsChecker{
+			SubRepoPermissionChecker: c.subRepoPermsChecker,
+			trace:                    trace,
+		},
+		clientSource: c.clientSource,
+	}
+}
+
+// tracingPermsChecker is a SubRepoPermissionChecker which records the
+// decisions of the checker it wraps. If the wrapped checker implements
+// authz.FilePermissionExplainer, the rules are recorded as well.
+type tracingPermsChecker struct {
+	authz.SubRepoPermissionChecker
+	trace *PermissionTrace
+}
+
+var _ authz.BatchFilePermissionChecker = &tracingPermsChecker{}
+
+func (c *tracingPermsChecker) Permissions(ctx context.Context, userID int32, content authz.RepoContent) (authz.Perms, error) {
+	perms, err := c.FilePermissions(ctx, userID, content.Repo, []string{content.Path})
+	if err != nil {
+		return authz.None, err
+	}
+	return perms[0], nil
+}
+
+func (c *tracingPermsChecker) FilePermissionsFunc(ctx context.Context, userID int32, repo api.RepoName) (authz.FilePermissionFunc, error) {
+	return func(path string) (authz.Perms, error) {
+		perms, err := c.FilePermissions(ctx, userID, repo, []string{path})
+		if err != nil {
+			return authz.None, err
+		}
+		return perms[0], nil
+	}, nil
+}
+
+// FilePermissions implements authz.BatchFilePermissionChecker.
+func (c *tracingPermsChecker) FilePermissions(ctx context.Context, userID int32, repo api.RepoName, paths []string) ([]authz.Perms, error) {
+	decisions := make([]PermissionDecision, len(paths))
+	perms := make([]authz.Perms, len(paths))
+
+	if e, ok := c.SubRepoPermissionChecker.(authz.FilePermissionExplainer); ok {
+		explained, err := e.ExplainFilePermissions(ctx, userID, repo, paths)
+		if err != nil {
+			return nil, err
+		}
+		if len(explained) != len(paths) {
+			return nil, errors.Newf("got decisions for %d paths, want %d", len(explained), len(paths))
+		}
+		for i, d := range explained {
+			perms[i] = d.Perms
+			decisions[i] = PermissionDecision{UserID: userID, Repo: repo, Path: paths[i], Perms: d.Perms, Rule: d.Rule}
+		}
+	} else {
+		var err error
+		if perms, err = authz.FilePermissions(ctx, c.SubRepoPermissionChecker, userID, repo, paths); err != nil {
+			return nil, err
+		}
+		for i, p := range perms {
+			decisions[i] = PermissionDecision{UserID: userID, Repo: repo, Path: paths[i], Perms: p}
+		}
+	}
+
+	c.trace.record(decisions...)
+	return perms, nil
+}
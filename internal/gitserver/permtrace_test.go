@@ -0,0 +1,89 @@
+package gitserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+)
+
+// explainingChecker denies access to every path below secret/ and explains
+// its decisions.
+type explainingChecker struct {
+	*authz.MockSubRepoPermissionChecker
+}
+
+func (c *explainingChecker) ExplainFilePermissions(_ context.Context, _ int32, _ api.RepoName, paths []string) ([]authz.FilePermissionDecision, error) {
+	decisions := make([]authz.FilePermissionDecision, len(paths))
+	for i, p := range paths {
+		if strings.HasPrefix(p, "secret/") {
+			decisions[i] = authz.FilePermissionDecision{Perms: authz.None, Rule: "-/secret/**"}
+		} else {
+			decisions[i] = authz.FilePermissionDecision{Perms: authz.Read, Rule: "/**"}
+		}
+	}
+	return decisions, nil
+}
+
+func TestClient_WithPermissionTrace(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+
+	r := NewTestRepo(t)
+	first := r.Commit("first").AddFile("file", "a").AddFile("secret/key", "a")
+	second := r.Commit("second").AddFile("file", "b").AddFile("secret/key", "b")
+	repo := r.Build()
+
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+
+	t.Run("rules are recorded", func(t *testing.T) {
+		m := authz.NewMockSubRepoPermissionChecker()
+		m.EnabledFunc.SetDefaultReturn(true)
+		trace := &PermissionTrace{}
+		c := NewTestClient(t).WithChecker(&explainingChecker{m}).WithPermissionTrace(trace)
+
+		files, err := c.LsFiles(ctx, repo, second.ID())
+		require.NoError(t, err)
+		require.Equal(t, []string{"file"}, files)
+
+		it, err := c.Diff(ctx, DiffOptions{Repo: repo, Base: string(first.ID()), Head: string(second.ID())})
+		require.NoError(t, err)
+		defer it.Close()
+		fd, err := it.Next()
+		require.NoError(t, err)
+		require.Equal(t, "file", fd.NewName)
+
+		decisions := trace.Decisions()
+		require.Equal(t, []PermissionDecision{
+			{UserID: 1, Repo: repo, Path: "file", Perms: authz.Read, Rule: "/**"},
+			{UserID: 1, Repo: repo, Path: "secret/key", Perms: authz.None, Rule: "-/secret/**"},
+			{UserID: 1, Repo: repo, Path: "file", Perms: authz.Read, Rule: "/**"},
+			{UserID: 1, Repo: repo, Path: "file", Perms: authz.Read, Rule: "/**"},
+		}, decisions)
+	})
+
+	t.Run("checker without explanations", func(t *testing.T) {
+		trace := &PermissionTrace{}
+		c := NewTestClient(t).WithChecker(getTestSubRepoPermsChecker("secret/key")).WithPermissionTrace(trace)
+
+		_, err := c.Stat(ctx, repo, second.ID(), "secret/key")
+		require.Error(t, err)
+		require.Equal(t, []PermissionDecision{
+			{UserID: 1, Repo: repo, Path: "secret/key", Perms: authz.None},
+		}, trace.Decisions())
+	})
+
+	t.Run("disabled sub-repo permissions are not traced", func(t *testing.T) {
+		trace := &PermissionTrace{}
+		c := NewTestClient(t).WithChecker(authz.NewMockSubRepoPermissionChecker()).WithPermissionTrace(trace)
+
+		_, err := c.LsFiles(ctx, repo, second.ID())
+		require.NoError(t, err)
+		require.Empty(t, trace.Decisions())
+	})
+}
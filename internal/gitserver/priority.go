@@ -0,0 +1,89 @@
+package gitserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/sourcegraph/sourcegraph/internal/grpc/propagator"
+)
+
+// Priority describes how urgently gitserver should handle a request.
+type Priority int
+
+const (
+	// PriorityInteractive is used for requests a user is waiting on. It is the
+	// default for requests that don't carry a priority.
+	PriorityInteractive Priority = iota
+	// PriorityBackground is used for requests issued by background jobs, such
+	// as indexing, that can be scheduled behind interactive requests.
+	PriorityBackground
+)
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityBackground:
+		return "background"
+	default:
+		return "interactive"
+	}
+}
+
+// headerKeyPriority is the gRPC metadata key used to propagate the priority.
+const headerKeyPriority = "x-sourcegraph-gitserver-priority"
+
+type priorityKey struct{}
+
+// WithPriority returns a context that makes all gitserver requests issued
+// with it carry the given priority.
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// PriorityFromContext returns the priority attached to ctx, on the client side
+// by WithPriority and on the server side by PriorityPropagator. Contexts
+// without a priority are interactive.
+func PriorityFromContext(ctx context.Context) Priority {
+	p, ok := ctx.Value(priorityKey{}).(Priority)
+	if !ok {
+		return PriorityInteractive
+	}
+	return p
+}
+
+// PriorityPropagator propagates the request priority from the gitserver
+// client to gitserver.
+type PriorityPropagator struct{}
+
+func (PriorityPropagator) FromContext(ctx context.Context) metadata.MD {
+	p, ok := ctx.Value(priorityKey{}).(Priority)
+	if !ok {
+		return metadata.New(nil)
+	}
+	return metadata.Pairs(headerKeyPriority, p.String())
+}
+
+func (PriorityPropagator) InjectContext(ctx context.Context, md metadata.MD) context.Context {
+	vals := md.Get(headerKeyPriority)
+	if len(vals) == 0 {
+		return ctx
+	}
+	switch vals[0] {
+	case PriorityBackground.String():
+		return WithPriority(ctx, PriorityBackground)
+	case PriorityInteractive.String():
+		return WithPriority(ctx, PriorityInteractive)
+	}
+	// Unknown values, for example from newer clients, are ignored.
+	return ctx
+}
+
+var _ propagator.Propagator = PriorityPropagator{}
+
+// priorityDialOptions are added to every gitserver connection, so that all
+// RPCs carry the priority of their context.
+var priorityDialOptions = []grpc.DialOption{
+	grpc.WithChainUnaryInterceptor(propagator.UnaryClientPropagator(PriorityPropagator{})),
+	grpc.WithChainStreamInterceptor(propagator.StreamClientPropagator(PriorityPropagator{})),
+}
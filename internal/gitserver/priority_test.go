@@ -0,0 +1,68 @@
+package gitserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+// priorityGitserver records the priority metadata of incoming requests.
+type priorityGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+	priorities chan []string
+}
+
+func (s *priorityGitserver) ResolveRevision(ctx context.Context, req *proto.ResolveRevisionRequest) (*proto.ResolveRevisionResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.priorities <- md.Get(headerKeyPriority)
+	return &proto.ResolveRevisionResponse{CommitSha: "deadbeef"}, nil
+}
+
+func (s *priorityGitserver) Archive(req *proto.ArchiveRequest, ss proto.GitserverService_ArchiveServer) error {
+	md, _ := metadata.FromIncomingContext(ss.Context())
+	s.priorities <- md.Get(headerKeyPriority)
+	return ss.Send(&proto.ArchiveResponse{Data: []byte("archive")})
+}
+
+func TestClient_Priority(t *testing.T) {
+	s := &priorityGitserver{priorities: make(chan []string, 1)}
+	c := newGRPCTestClient(t, s)
+	ctx := context.Background()
+
+	_, err := c.ResolveRevision(ctx, "repo", "HEAD", ResolveRevisionOptions{})
+	require.NoError(t, err)
+	require.Empty(t, <-s.priorities)
+
+	_, err = c.ResolveRevision(WithPriority(ctx, PriorityBackground), "repo", "HEAD", ResolveRevisionOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"background"}, <-s.priorities)
+
+	// Streaming RPCs carry the priority as well.
+	r, err := c.ArchiveReader(WithPriority(ctx, PriorityInteractive), api.RepoName("repo"), ArchiveOptions{Treeish: "HEAD", Format: ArchiveFormatTar})
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, []string{"interactive"}, <-s.priorities)
+}
+
+func TestPriorityPropagator(t *testing.T) {
+	ctx := context.Background()
+	prop := PriorityPropagator{}
+
+	require.Equal(t, PriorityInteractive, PriorityFromContext(ctx))
+	require.Empty(t, prop.FromContext(ctx))
+
+	md := prop.FromContext(WithPriority(ctx, PriorityBackground))
+	require.Equal(t, PriorityBackground, PriorityFromContext(prop.InjectContext(ctx, md)))
+
+	md = prop.FromContext(WithPriority(ctx, PriorityInteractive))
+	require.Equal(t, PriorityInteractive, PriorityFromContext(prop.InjectContext(ctx, md)))
+
+	// Unknown values are ignored.
+	injected := prop.InjectContext(ctx, metadata.Pairs(headerKeyPriority, "urgent"))
+	require.Equal(t, PriorityInteractive, PriorityFromContext(injected))
+}
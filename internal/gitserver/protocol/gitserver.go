@@ -2,6 +2,7 @@ package protocol
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -349,6 +350,38 @@ func (r *RepoCloneProgress) FromProto(p *proto.RepoCloneProgressResponse) {
 	}
 }
 
+// CloneProgressUpdate is an update of the clone progress of a repo.
+type CloneProgressUpdate struct {
+	CloneInProgress bool   // whether the repository is currently being cloned
+	Cloned          bool   // whether the repository has been cloned successfully
+	Message         string // the last progress message from the running clone command.
+	// Phase is the phase of the clone the message reports on, like "Receiving
+	// objects". It is empty if the message isn't a progress line of git.
+	Phase string
+	// Percent is the completion of Phase, from 0 to 100.
+	Percent int
+}
+
+func (u *CloneProgressUpdate) ToProto() *proto.CloneProgressResponse {
+	return &proto.CloneProgressResponse{
+		CloneInProgress: u.CloneInProgress,
+		Cloned:          u.Cloned,
+		Message:         u.Message,
+		Phase:           u.Phase,
+		Percent:         uint32(u.Percent),
+	}
+}
+
+func (u *CloneProgressUpdate) FromProto(p *proto.CloneProgressResponse) {
+	*u = CloneProgressUpdate{
+		CloneInProgress: p.GetCloneInProgress(),
+		Cloned:          p.GetCloned(),
+		Message:         p.GetMessage(),
+		Phase:           p.GetPhase(),
+		Percent:         int(p.GetPercent()),
+	}
+}
+
 // CreateCommitFromPatchRequest is the request information needed for creating
 // the simulated staging area git object for a repo.
 type CreateCommitFromPatchRequest struct {
@@ -423,28 +456,80 @@ type PatchCommitInfo struct {
 	CommitterName  string
 	CommitterEmail string
 	Date           time.Time
+	// CommitterDate is the committer date of the commit. If zero, Date is
+	// used.
+	CommitterDate time.Time
+	// Trailers are added to the end of the commit message, in order.
+	Trailers []CommitTrailer
+	// Sign makes gitserver sign the commit, using the signing key configured
+	// for git on gitserver.
+	Sign bool
 }
 
 func (p *PatchCommitInfo) ToProto() *proto.PatchCommitInfo {
-	return &proto.PatchCommitInfo{
+	pp := &proto.PatchCommitInfo{
 		Messages:       p.Messages,
 		AuthorName:     p.AuthorName,
 		AuthorEmail:    p.AuthorEmail,
 		CommitterName:  p.CommitterName,
 		CommitterEmail: p.CommitterEmail,
 		Date:           timestamppb.New(p.Date),
+		Sign:           p.Sign,
+	}
+	if !p.CommitterDate.IsZero() {
+		pp.CommitterDate = timestamppb.New(p.CommitterDate)
+	}
+	for _, t := range p.Trailers {
+		pp.Trailers = append(pp.Trailers, &proto.CommitTrailer{Key: t.Key, Value: t.Value})
 	}
+	return pp
 }
 
 func PatchCommitInfoFromProto(p *proto.PatchCommitInfo) PatchCommitInfo {
-	return PatchCommitInfo{
+	info := PatchCommitInfo{
 		Messages:       p.GetMessages(),
 		AuthorName:     p.GetAuthorName(),
 		AuthorEmail:    p.GetAuthorEmail(),
 		CommitterName:  p.GetCommitterName(),
 		CommitterEmail: p.GetCommitterEmail(),
 		Date:           p.GetDate().AsTime(),
+		Sign:           p.GetSign(),
+	}
+	if p.GetCommitterDate() != nil {
+		info.CommitterDate = p.GetCommitterDate().AsTime()
+	}
+	for _, t := range p.GetTrailers() {
+		info.Trailers = append(info.Trailers, CommitTrailer{Key: t.GetKey(), Value: t.GetValue()})
+	}
+	return info
+}
+
+// CommitTrailer is a key-value pair at the end of a commit message, like
+// "Co-authored-by: Jane Doe <jane@example.com>".
+type CommitTrailer struct {
+	Key   string
+	Value string
+}
+
+// Validate returns an error if the trailer can't be added to a commit message
+// unambiguously: the key may only contain letters, digits and dashes, and the
+// value must not be empty or span multiple lines.
+func (t CommitTrailer) Validate() error {
+	if t.Key == "" {
+		return errors.New("trailer key must not be empty")
 	}
+	for _, r := range t.Key {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+			return errors.Newf("invalid character %q in trailer key %q", r, t.Key)
+		}
+	}
+	if strings.TrimSpace(t.Value) == "" {
+		return errors.Newf("trailer %q must have a value", t.Key)
+	}
+	if strings.ContainsAny(t.Value, "\r\n") {
+		return errors.Newf("value of trailer %q must not contain line breaks", t.Key)
+	}
+	return nil
 }
 
 // PushConfig provides the configuration required to push one or more commits to
@@ -463,6 +548,30 @@ type PushConfig struct {
 	// Passphrase is the passphrase to decrypt the private key. It is required
 	// when passing PrivateKey.
 	Passphrase string
+
+	// ExpectedOldOID, if set, makes the push only succeed if the ref on the
+	// remote currently points at this commit, like git push --force-with-lease.
+	// This prevents overwriting commits that were pushed by someone else in
+	// the meantime. If set to an empty string, the ref must not exist on the
+	// remote yet. If nil, the ref is force-pushed unconditionally.
+	ExpectedOldOID *string
+
+	// PushOptions are sent to the remote with git push --push-option, for
+	// example to control CI on the code host.
+	PushOptions []string
+}
+
+// Validate returns an error if p can't be passed to git push safely.
+func (p *PushConfig) Validate() error {
+	if p.ExpectedOldOID != nil && *p.ExpectedOldOID != "" && !gitdomain.IsAbsoluteRevision(*p.ExpectedOldOID) {
+		return errors.Newf("expected old OID %q is not a full commit SHA", *p.ExpectedOldOID)
+	}
+	for _, o := range p.PushOptions {
+		if strings.ContainsAny(o, "\x00\r\n") {
+			return errors.Newf("push option %q must not contain line breaks or NUL bytes", o)
+		}
+	}
+	return nil
 }
 
 func (p *PushConfig) ToProto() *proto.PushConfig {
@@ -471,17 +580,21 @@ func (p *PushConfig) ToProto() *proto.PushConfig {
 	}
 
 	return &proto.PushConfig{
-		RemoteUrl:  p.RemoteURL,
-		PrivateKey: p.PrivateKey,
-		Passphrase: p.Passphrase,
+		RemoteUrl:      p.RemoteURL,
+		PrivateKey:     p.PrivateKey,
+		Passphrase:     p.Passphrase,
+		ExpectedOldOid: p.ExpectedOldOID,
+		PushOptions:    p.PushOptions,
 	}
 }
 
 func (pc *PushConfig) FromProto(p *proto.PushConfig) {
 	*pc = PushConfig{
-		RemoteURL:  p.GetRemoteUrl(),
-		PrivateKey: p.GetPrivateKey(),
-		Passphrase: p.GetPassphrase(),
+		RemoteURL:      p.GetRemoteUrl(),
+		PrivateKey:     p.GetPrivateKey(),
+		Passphrase:     p.GetPassphrase(),
+		ExpectedOldOID: p.ExpectedOldOid,
+		PushOptions:    p.GetPushOptions(),
 	}
 }
 
@@ -554,6 +667,9 @@ type CreateCommitFromPatchError struct {
 	Command string
 	// CombinedOutput is the combined stderr and stdout from running the command
 	CombinedOutput string
+	// StaleRef is set if the push was rejected because the ref on the remote
+	// did not point at PushConfig.ExpectedOldOID.
+	StaleRef bool
 }
 
 func (e *CreateCommitFromPatchError) ToProto() *proto.CreateCommitFromPatchError {
@@ -562,6 +678,7 @@ func (e *CreateCommitFromPatchError) ToProto() *proto.CreateCommitFromPatchError
 		InternalError:  e.InternalError,
 		Command:        e.Command,
 		CombinedOutput: e.CombinedOutput,
+		StaleRef:       e.StaleRef,
 	}
 }
 
@@ -571,6 +688,7 @@ func (e *CreateCommitFromPatchError) FromProto(p *proto.CreateCommitFromPatchErr
 		InternalError:  p.GetInternalError(),
 		Command:        p.GetCommand(),
 		CombinedOutput: p.GetCombinedOutput(),
+		StaleRef:       p.GetStaleRef(),
 	}
 }
 
@@ -663,6 +781,33 @@ type SystemInfo struct {
 	PercentUsed float32
 }
 
+// HostedRepo is a repository cloned on a gitserver instance, as listed by
+// ListShardRepos.
+type HostedRepo struct {
+	Name api.RepoName
+	// SizeBytes is the size of the repository directory on disk.
+	SizeBytes int64
+	// LastFetched is the time of the last successful fetch, or of the clone
+	// if the repository wasn't fetched since.
+	LastFetched time.Time
+}
+
+func (r *HostedRepo) ToProto() *proto.HostedRepo {
+	return &proto.HostedRepo{
+		Name:        string(r.Name),
+		SizeBytes:   r.SizeBytes,
+		LastFetched: timestamppb.New(r.LastFetched),
+	}
+}
+
+func (r *HostedRepo) FromProto(p *proto.HostedRepo) {
+	*r = HostedRepo{
+		Name:        api.RepoName(p.GetName()),
+		SizeBytes:   p.GetSizeBytes(),
+		LastFetched: p.GetLastFetched().AsTime(),
+	}
+}
+
 type PerforceUsersRequest struct {
 	P4Port   string `json:"p4port"`
 	P4User   string `json:"p4user"`
@@ -79,3 +79,44 @@ func TestCommitMatchProtoRoundtrip(t *testing.T) {
 	roundtripped := CommitMatchFromProto(protoReq)
 	require.Equal(t, req, roundtripped)
 }
+
+func TestCommitTrailer_Validate(t *testing.T) {
+	for _, tr := range []CommitTrailer{
+		{Key: "Co-authored-by", Value: "Jane Doe <jane@example.com>"},
+		{Key: "Signed-off-by", Value: "bot <bot@example.com>"},
+		{Key: "X-Batch-Change-1", Value: "https://sourcegraph.example.com/batch-changes/1"},
+	} {
+		require.NoError(t, tr.Validate(), tr.Key)
+	}
+
+	for _, tr := range []CommitTrailer{
+		{Key: "", Value: "value"},
+		{Key: "Co-authored by", Value: "Jane Doe <jane@example.com>"},
+		{Key: "Key:", Value: "value"},
+		{Key: "Co-authored-by", Value: " "},
+		{Key: "Co-authored-by", Value: "Jane Doe <jane@example.com>\nSigned-off-by: someone"},
+	} {
+		require.Error(t, tr.Validate(), tr.Key)
+	}
+}
+
+func TestPushConfig_Validate(t *testing.T) {
+	oid := func(s string) *string { return &s }
+
+	for _, p := range []PushConfig{
+		{},
+		{ExpectedOldOID: oid("")},
+		{ExpectedOldOID: oid("e3889dff4263a2273459471739aafabc10269885")},
+		{PushOptions: []string{"ci.skip", "merge_request.label=batch change"}},
+	} {
+		require.NoError(t, p.Validate())
+	}
+
+	for _, p := range []PushConfig{
+		{ExpectedOldOID: oid("HEAD")},
+		{ExpectedOldOID: oid("e3889df")},
+		{PushOptions: []string{"ci.skip\nci.variable=FOO=bar"}},
+	} {
+		require.Error(t, p.Validate())
+	}
+}
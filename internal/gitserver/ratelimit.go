@@ -0,0 +1,276 @@
+package gitserver
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+// maxRateLimitedActors is the number of actors whose budgets are tracked. The
+// budgets of the least recently seen actors are dropped first, which resets
+// them.
+const maxRateLimitedActors = 10000
+
+// ActorRateLimits are the budgets of a single actor for expensive gitserver
+// operations: archives, diffs and file reads. A zero rate disables the
+// respective budget.
+type ActorRateLimits struct {
+	// RequestsPerSecond is the sustained rate of expensive requests an actor
+	// can make, and RequestBurst the number of requests it can make at once.
+	RequestsPerSecond float64
+	RequestBurst      int
+	// BytesPerSecond is the sustained bandwidth an actor can use for the
+	// responses of expensive requests, and BytesBurst the number of bytes it
+	// can receive at once.
+	BytesPerSecond float64
+	BytesBurst     int
+}
+
+// RateLimitExceededError is returned when an actor used up its budget of
+// expensive gitserver operations.
+type RateLimitExceededError struct {
+	// Actor identifies the actor, like "user:42".
+	Actor string
+	// Budget is the budget that was exceeded, "requests" or "bytes".
+	Budget string
+	// RetryAfter is how long it takes until a request is allowed again.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitExceededError) Error() string {
+	return fmt.Sprintf("gitserver %s rate limit exceeded for %s, retry after %s", e.Budget, e.Actor, e.RetryAfter)
+}
+
+// WithActorRateLimit returns a new client that enforces limits for every
+// actor, so that a single runaway API consumer can't overload gitserver.
+// Requests of an actor that exceeded a budget fail with a
+// *RateLimitExceededError until the budget recovered. The bytes of a response
+// are charged as they are received, so a large response can put an actor into
+// debt that later requests wait out. Internal actors are not limited, and all
+// unauthenticated actors without an anonymous UID share one budget.
+func (c *clientImplementor) WithActorRateLimit(limits ActorRateLimits) Client {
+	if limits.RequestsPerSecond <= 0 && limits.BytesPerSecond <= 0 {
+		return c
+	}
+	budgets, err := lru.New[string, *actorBudget](maxRateLimitedActors)
+	if err != nil {
+		// Can only happen for a non-positive size.
+		return c
+	}
+	return &clientImplementor{
+		logger:              c.logger,
+		scope:               c.scope,
+		operations:          c.operations,
+		subRepoPermsChecker: c.subRepoPermsChecker,
+		clientSource: &rateLimitedClientSource{
+			ClientSource: c.clientSource,
+			limiter: &actorRateLimiter{
+				limits:  limits,
+				budgets: budgets,
+				now:     time.Now,
+			},
+		},
+	}
+}
+
+// rateLimitedClientSource wraps the clients returned by a ClientSource, so
+// that they share an actorRateLimiter.
+type rateLimitedClientSource struct {
+	ClientSource
+	limiter *actorRateLimiter
+}
+
+func (s *rateLimitedClientSource) ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error) {
+	client, err := s.ClientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	return &rateLimitedClient{GitserverServiceClient: client, limiter: s.limiter}, nil
+}
+
+// rateLimitedClient applies the budgets of the actor of the call context to
+// the expensive streaming RPCs. All other RPCs are passed through unchanged.
+type rateLimitedClient struct {
+	proto.GitserverServiceClient
+	limiter *actorRateLimiter
+}
+
+func (c *rateLimitedClient) Archive(ctx context.Context, in *proto.ArchiveRequest, opts ...grpc.CallOption) (proto.GitserverService_ArchiveClient, error) {
+	budget, err := c.limiter.allow(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cli, err := c.GitserverServiceClient.Archive(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if budget == nil {
+		return cli, nil
+	}
+	return &chargedRecvClient[*proto.ArchiveResponse]{ClientStream: cli, recv: cli.Recv, budget: budget}, nil
+}
+
+func (c *rateLimitedClient) ReadFile(ctx context.Context, in *proto.ReadFileRequest, opts ...grpc.CallOption) (proto.GitserverService_ReadFileClient, error) {
+	budget, err := c.limiter.allow(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cli, err := c.GitserverServiceClient.ReadFile(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if budget == nil {
+		return cli, nil
+	}
+	return &chargedRecvClient[*proto.ReadFileResponse]{ClientStream: cli, recv: cli.Recv, budget: budget}, nil
+}
+
+func (c *rateLimitedClient) Exec(ctx context.Context, in *proto.ExecRequest, opts ...grpc.CallOption) (proto.GitserverService_ExecClient, error) {
+	// Most commands are cheap, only diffs are limited.
+	if args := in.GetArgs(); len(args) == 0 || string(args[0]) != "diff" {
+		return c.GitserverServiceClient.Exec(ctx, in, opts...)
+	}
+
+	budget, err := c.limiter.allow(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cli, err := c.GitserverServiceClient.Exec(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if budget == nil {
+		return cli, nil
+	}
+	return &chargedRecvClient[*proto.ExecResponse]{ClientStream: cli, recv: cli.Recv, budget: budget}, nil
+}
+
+// chargedRecvClient wraps a server stream, so that the size of every received
+// message is charged to the byte budget of an actor.
+type chargedRecvClient[T protobuf.Message] struct {
+	grpc.ClientStream
+	recv   func() (T, error)
+	budget *actorBudget
+}
+
+func (s *chargedRecvClient[T]) Recv() (T, error) {
+	msg, err := s.recv()
+	if err == nil {
+		s.budget.charge(protobuf.Size(msg))
+	}
+	return msg, err
+}
+
+// actorRateLimiter keeps the budgets of the actors.
+type actorRateLimiter struct {
+	limits  ActorRateLimits
+	budgets *lru.Cache[string, *actorBudget]
+	now     func() time.Time
+}
+
+// allow returns the budget that the call of the actor of ctx is charged to,
+// or a *RateLimitExceededError if one of the budgets of the actor is used up.
+// The budget is nil for actors that aren't limited.
+func (l *actorRateLimiter) allow(ctx context.Context) (*actorBudget, error) {
+	key, ok := rateLimitKey(actor.FromContext(ctx))
+	if !ok {
+		return nil, nil
+	}
+
+	budget, ok := l.budgets.Get(key)
+	if !ok {
+		budget = l.newBudget()
+		// Another call of the same actor could have added a budget
+		// concurrently, in which case we use that one.
+		if previous, ok, _ := l.budgets.PeekOrAdd(key, budget); ok {
+			budget = previous
+		}
+	}
+
+	now := l.now()
+	if budget.bytes != nil {
+		if tokens := budget.bytes.TokensAt(now); tokens < 0 {
+			return nil, &RateLimitExceededError{
+				Actor:      key,
+				Budget:     "bytes",
+				RetryAfter: time.Duration(-tokens / float64(budget.bytes.Limit()) * float64(time.Second)),
+			}
+		}
+	}
+	if budget.requests != nil {
+		r := budget.requests.ReserveN(now, 1)
+		if delay := r.DelayFrom(now); delay > 0 {
+			r.CancelAt(now)
+			return nil, &RateLimitExceededError{Actor: key, Budget: "requests", RetryAfter: delay}
+		}
+	}
+	return budget, nil
+}
+
+func (l *actorRateLimiter) newBudget() *actorBudget {
+	b := &actorBudget{now: l.now}
+	if r := l.limits.RequestsPerSecond; r > 0 {
+		b.requests = rate.NewLimiter(rate.Limit(r), burstOrDefault(l.limits.RequestBurst, r))
+	}
+	if r := l.limits.BytesPerSecond; r > 0 {
+		b.bytes = rate.NewLimiter(rate.Limit(r), burstOrDefault(l.limits.BytesBurst, r))
+	}
+	return b
+}
+
+// burstOrDefault returns burst, or the number of tokens added per second if
+// it isn't set.
+func burstOrDefault(burst int, perSecond float64) int {
+	if burst > 0 {
+		return burst
+	}
+	return int(math.Max(1, math.Ceil(perSecond)))
+}
+
+// rateLimitKey returns the key of the budget of an actor, or false if the
+// actor isn't limited.
+func rateLimitKey(a *actor.Actor) (string, bool) {
+	switch {
+	case a.IsInternal():
+		return "", false
+	case a.IsAuthenticated():
+		return "user:" + strconv.Itoa(int(a.UID)), true
+	case a.AnonymousUID != "":
+		return "anonymous:" + a.AnonymousUID, true
+	default:
+		return "anonymous", true
+	}
+}
+
+// actorBudget are the request and byte budgets of a single actor. Either can
+// be nil if it is disabled.
+type actorBudget struct {
+	requests *rate.Limiter
+	bytes    *rate.Limiter
+	now      func() time.Time
+}
+
+// charge takes n bytes from the byte budget. The budget can go into debt,
+// which makes allow fail until it recovered.
+func (b *actorBudget) charge(n int) {
+	if b.bytes == nil {
+		return
+	}
+	now := b.now()
+	// A reservation can't exceed the burst, so large messages are charged in
+	// parts.
+	for burst := b.bytes.Burst(); n > 0; n -= burst {
+		b.bytes.ReserveN(now, min(n, burst))
+	}
+}
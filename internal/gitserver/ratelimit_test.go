@@ -0,0 +1,78 @@
+package gitserver
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestClient_WithActorRateLimit(t *testing.T) {
+	c := newGRPCTestClient(t, &archivingGitserver{}).WithActorRateLimit(ActorRateLimits{
+		RequestsPerSecond: 0.001,
+		RequestBurst:      1,
+	})
+
+	archive := func(ctx context.Context) error {
+		r, err := c.ArchiveReader(ctx, "repo", ArchiveOptions{Treeish: "HEAD", Format: ArchiveFormatTar})
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		_, err = io.ReadAll(r)
+		return err
+	}
+
+	alice := actor.WithActor(context.Background(), actor.FromUser(1))
+	require.NoError(t, archive(alice))
+
+	err := archive(alice)
+	var e *RateLimitExceededError
+	require.True(t, errors.As(err, &e), "unexpected error %v", err)
+	require.Equal(t, "user:1", e.Actor)
+	require.Equal(t, "requests", e.Budget)
+	require.Positive(t, e.RetryAfter)
+
+	// Other actors have their own budget.
+	bob := actor.WithActor(context.Background(), actor.FromUser(2))
+	require.NoError(t, archive(bob))
+
+	// Internal actors are not limited.
+	internal := actor.WithInternalActor(context.Background())
+	require.NoError(t, archive(internal))
+	require.NoError(t, archive(internal))
+}
+
+func TestActorRateLimiter_bytes(t *testing.T) {
+	now := time.Unix(1000, 0)
+	budgets, err := lru.New[string, *actorBudget](10)
+	require.NoError(t, err)
+	l := &actorRateLimiter{
+		limits:  ActorRateLimits{BytesPerSecond: 100, BytesBurst: 100},
+		budgets: budgets,
+		now:     func() time.Time { return now },
+	}
+	ctx := actor.WithActor(context.Background(), actor.FromAnonymousUser("anon"))
+
+	budget, err := l.allow(ctx)
+	require.NoError(t, err)
+	// A response larger than the burst puts the actor into debt.
+	budget.charge(350)
+
+	_, err = l.allow(ctx)
+	var e *RateLimitExceededError
+	require.True(t, errors.As(err, &e), "unexpected error %v", err)
+	require.Equal(t, "anonymous:anon", e.Actor)
+	require.Equal(t, "bytes", e.Budget)
+	require.Equal(t, 2500*time.Millisecond, e.RetryAfter)
+
+	now = now.Add(e.RetryAfter)
+	_, err = l.allow(ctx)
+	require.NoError(t, err)
+}
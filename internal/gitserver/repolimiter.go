@@ -0,0 +1,168 @@
+package gitserver
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+// WithRepoConcurrencyLimit returns a new client that allows at most limit
+// concurrent expensive operations, such as archives, diffs and blames, per
+// repository. Further calls block until a slot frees up or their context is
+// done. The limit applies to the returned client and all clients derived from
+// it, so a single misbehaving caller can't saturate a gitserver with requests
+// for the same large repository.
+func (c *clientImplementor) WithRepoConcurrencyLimit(limit int) Client {
+	if limit <= 0 {
+		return c
+	}
+	return &clientImplementor{
+		logger:              c.logger,
+		scope:               c.scope,
+		operations:          c.operations,
+		subRepoPermsChecker: c.subRepoPermsChecker,
+		clientSource: &limitedClientSource{
+			ClientSource: c.clientSource,
+			limiter: &repoLimiter{
+				limit: limit,
+				repos: make(map[string]*repoSemaphore),
+			},
+		},
+	}
+}
+
+// limitedClientSource wraps the clients returned by a ClientSource, so that
+// they share a repoLimiter.
+type limitedClientSource struct {
+	ClientSource
+	limiter *repoLimiter
+}
+
+func (s *limitedClientSource) ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error) {
+	client, err := s.ClientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	return &limitedClient{GitserverServiceClient: client, limiter: s.limiter}, nil
+}
+
+// limitedClient limits the concurrency of the expensive streaming RPCs. A
+// slot is held until the stream finished or its context is done. All other
+// RPCs are passed through unchanged.
+type limitedClient struct {
+	proto.GitserverServiceClient
+	limiter *repoLimiter
+}
+
+func (c *limitedClient) Archive(ctx context.Context, in *proto.ArchiveRequest, opts ...grpc.CallOption) (proto.GitserverService_ArchiveClient, error) {
+	release, err := c.limiter.acquire(ctx, in.GetRepo())
+	if err != nil {
+		return nil, err
+	}
+	cli, err := c.GitserverServiceClient.Archive(ctx, in, opts...)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	call := startCall(ctx, releaseObserver(release), "Archive", in)
+	return &observedRecvClient[*proto.ArchiveResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (c *limitedClient) Blame(ctx context.Context, in *proto.BlameRequest, opts ...grpc.CallOption) (proto.GitserverService_BlameClient, error) {
+	release, err := c.limiter.acquire(ctx, in.GetRepoName())
+	if err != nil {
+		return nil, err
+	}
+	cli, err := c.GitserverServiceClient.Blame(ctx, in, opts...)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	call := startCall(ctx, releaseObserver(release), "Blame", in)
+	return &observedRecvClient[*proto.BlameResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (c *limitedClient) Exec(ctx context.Context, in *proto.ExecRequest, opts ...grpc.CallOption) (proto.GitserverService_ExecClient, error) {
+	// Most commands are cheap, only diffs are limited.
+	if args := in.GetArgs(); len(args) == 0 || string(args[0]) != "diff" {
+		return c.GitserverServiceClient.Exec(ctx, in, opts...)
+	}
+
+	release, err := c.limiter.acquire(ctx, in.GetRepo())
+	if err != nil {
+		return nil, err
+	}
+	cli, err := c.GitserverServiceClient.Exec(ctx, in, opts...)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	call := startCall(ctx, releaseObserver(release), "Exec", in)
+	return &observedRecvClient[*proto.ExecResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+// releaseObserver is an rpcObserver that releases a limiter slot once the
+// RPC finished.
+type releaseObserver func()
+
+func (r releaseObserver) observe(rpcCallResult) {
+	r()
+}
+
+// repoLimiter is a set of semaphores keyed by repo name.
+type repoLimiter struct {
+	limit int
+
+	mu    sync.Mutex
+	repos map[string]*repoSemaphore
+}
+
+type repoSemaphore struct {
+	slots chan struct{}
+	// refs is the number of callers holding or waiting for a slot. The
+	// semaphore is removed once it drops to zero.
+	refs int
+}
+
+// acquire blocks until a slot for repo is free, or ctx is done. The returned
+// release function must be called to free the slot, calling it more than
+// once has no effect.
+func (l *repoLimiter) acquire(ctx context.Context, repo string) (release func(), err error) {
+	l.mu.Lock()
+	sem, ok := l.repos[repo]
+	if !ok {
+		sem = &repoSemaphore{slots: make(chan struct{}, l.limit)}
+		l.repos[repo] = sem
+	}
+	sem.refs++
+	l.mu.Unlock()
+
+	select {
+	case sem.slots <- struct{}{}:
+	case <-ctx.Done():
+		l.unref(repo, sem)
+		return nil, ctx.Err()
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			<-sem.slots
+			l.unref(repo, sem)
+		})
+	}, nil
+}
+
+func (l *repoLimiter) unref(repo string, sem *repoSemaphore) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	sem.refs--
+	if sem.refs == 0 {
+		delete(l.repos, repo)
+	}
+}
@@ -0,0 +1,89 @@
+package gitserver
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+// archivingGitserver answers every Archive request with a single message.
+type archivingGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+}
+
+func (s *archivingGitserver) Archive(req *proto.ArchiveRequest, ss proto.GitserverService_ArchiveServer) error {
+	return ss.Send(&proto.ArchiveResponse{Data: []byte("archive")})
+}
+
+func TestClient_WithRepoConcurrencyLimit(t *testing.T) {
+	c := newGRPCTestClient(t, &archivingGitserver{}).WithRepoConcurrencyLimit(1)
+	ctx := context.Background()
+
+	archive := func(ctx context.Context, repo api.RepoName) (io.ReadCloser, error) {
+		return c.ArchiveReader(ctx, repo, ArchiveOptions{Treeish: "HEAD", Format: ArchiveFormatTar})
+	}
+
+	first, err := archive(ctx, "repo")
+	require.NoError(t, err)
+
+	// The slot for repo is taken until the first archive has been read.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = archive(timeoutCtx, "repo")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Other repos are not affected.
+	other, err := archive(ctx, "other")
+	require.NoError(t, err)
+	_, err = io.ReadAll(other)
+	require.NoError(t, err)
+	require.NoError(t, other.Close())
+
+	data, err := io.ReadAll(first)
+	require.NoError(t, err)
+	require.Equal(t, "archive", string(data))
+	require.NoError(t, first.Close())
+
+	second, err := archive(ctx, "repo")
+	require.NoError(t, err)
+	// Closing the reader early frees the slot as well.
+	require.NoError(t, second.Close())
+
+	timeoutCtx, cancel = context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	third, err := archive(timeoutCtx, "repo")
+	require.NoError(t, err)
+	require.NoError(t, third.Close())
+}
+
+func TestRepoLimiter(t *testing.T) {
+	l := &repoLimiter{limit: 2, repos: make(map[string]*repoSemaphore)}
+	ctx := context.Background()
+
+	release1, err := l.acquire(ctx, "repo")
+	require.NoError(t, err)
+	release2, err := l.acquire(ctx, "repo")
+	require.NoError(t, err)
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = l.acquire(canceledCtx, "repo")
+	require.ErrorIs(t, err, context.Canceled)
+
+	release1()
+	// Releasing twice has no effect.
+	release1()
+	release3, err := l.acquire(ctx, "repo")
+	require.NoError(t, err)
+
+	release2()
+	release3()
+	// Unused semaphores are removed.
+	require.Empty(t, l.repos)
+}
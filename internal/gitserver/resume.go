@@ -0,0 +1,130 @@
+package gitserver
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+type readResumptionKey struct{}
+
+// WithReadResumption returns a context that makes NewFileReader and
+// StreamBlameFile resume a stream that fails midway with a transient error,
+// for example because the gitserver instance restarts during a rolling
+// deployment. The request is sent again for the part that wasn't received yet,
+// so callers see one uninterrupted stream. At most maxResumes resumptions are
+// made per call.
+//
+// Only calls at an absolute commit ID are resumed: the content at a ref could
+// change between the attempts.
+func WithReadResumption(ctx context.Context, maxResumes int) context.Context {
+	return context.WithValue(ctx, readResumptionKey{}, maxResumes)
+}
+
+func readResumptionFromContext(ctx context.Context) int {
+	maxResumes, _ := ctx.Value(readResumptionKey{}).(int)
+	return maxResumes
+}
+
+// isResumableStreamError returns true if a stream that failed with err can be
+// resumed. Unavailable is what a client sees when the server goes away.
+func isResumableStreamError(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
+
+// blameResumption restarts a blame stream after a transient error. git blame
+// --incremental returns hunks in no particular order, so the stream can't be
+// resumed at an offset. Instead, the blame is restarted at the last hunk of the
+// lines that were returned without a gap, and the lines that were already
+// returned are cut out of the hunks of the new stream.
+//
+// A nil *blameResumption never resumes, and returns hunks as they are.
+type blameResumption struct {
+	ctx        context.Context
+	client     proto.GitserverServiceClient
+	req        *proto.BlameRequest
+	maxResumes int
+	resumes    int
+
+	// returned are the line ranges of the returned hunks, sorted by start
+	// line.
+	returned []lineRange
+}
+
+// lineRange is a range of 1-indexed lines, excluding end.
+type lineRange struct {
+	start, end uint32
+}
+
+func (b *blameResumption) canResume(err error) bool {
+	return b != nil && b.resumes < b.maxResumes && isResumableStreamError(err)
+}
+
+func (b *blameResumption) resume() (proto.GitserverService_BlameClient, error) {
+	b.resumes++
+
+	var start, end uint32 = 1, 0
+	if r := b.req.GetRange(); r != nil {
+		start, end = max(r.GetStartLine(), 1), r.GetEndLine()
+	}
+	// Blame again from the start of the last hunk that follows the returned
+	// lines without a gap. Starting at the line after it could be beyond the
+	// end of the file, which git blame rejects.
+	restart := start
+	for i := b.indexOf(start); i >= 0; i = b.indexOf(b.returned[i].end) {
+		restart = max(b.returned[i].start, start)
+	}
+	b.req.Range = &proto.BlameRange{StartLine: restart, EndLine: end}
+
+	return b.client.Blame(b.ctx, b.req)
+}
+
+// indexOf returns the index of the returned range containing line, or -1.
+func (b *blameResumption) indexOf(line uint32) int {
+	i := sort.Search(len(b.returned), func(i int) bool { return b.returned[i].end > line })
+	if i < len(b.returned) && b.returned[i].start <= line {
+		return i
+	}
+	return -1
+}
+
+// track records that the hunk is returned, and returns the parts of it with
+// lines that weren't returned before. Those are usually the whole hunk, but a
+// restarted blame may group lines differently.
+func (b *blameResumption) track(h *gitdomain.Hunk) []*gitdomain.Hunk {
+	if b == nil || h == nil {
+		return []*gitdomain.Hunk{h}
+	}
+
+	var gaps []lineRange
+	line := h.StartLine
+	i := sort.Search(len(b.returned), func(i int) bool { return b.returned[i].end > line })
+	for ; i < len(b.returned) && b.returned[i].start < h.EndLine; i++ {
+		if b.returned[i].start > line {
+			gaps = append(gaps, lineRange{start: line, end: b.returned[i].start})
+		}
+		line = max(line, b.returned[i].end)
+	}
+	if line < h.EndLine {
+		gaps = append(gaps, lineRange{start: line, end: h.EndLine})
+	}
+
+	parts := make([]*gitdomain.Hunk, 0, len(gaps))
+	for _, gap := range gaps {
+		part := h
+		if gap.start != h.StartLine || gap.end != h.EndLine {
+			trimmed := *h
+			trimmed.StartLine, trimmed.EndLine = gap.start, gap.end
+			part = &trimmed
+		}
+		parts = append(parts, part)
+	}
+	b.returned = append(b.returned, gaps...)
+	sort.Slice(b.returned, func(i, j int) bool { return b.returned[i].start < b.returned[j].start })
+	return parts
+}
@@ -0,0 +1,212 @@
+package gitserver
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+// interruptedGitserver streams a file in chunks of four bytes. The first
+// interruptions calls fail with Unavailable after sending one chunk.
+type interruptedGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+	content       string
+	interruptions int
+
+	mu      sync.Mutex
+	offsets []int64
+}
+
+func (s *interruptedGitserver) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverService_ReadFileServer) error {
+	s.mu.Lock()
+	s.offsets = append(s.offsets, req.GetOffset())
+	interrupt := len(s.offsets) <= s.interruptions
+	s.mu.Unlock()
+
+	rest := s.content[req.GetOffset():]
+	for len(rest) > 0 {
+		n := min(4, len(rest))
+		if err := ss.Send(&proto.ReadFileResponse{Data: []byte(rest[:n])}); err != nil {
+			return err
+		}
+		rest = rest[n:]
+		if interrupt {
+			return status.Error(codes.Unavailable, "server is shutting down")
+		}
+	}
+	return nil
+}
+
+func TestClient_WithReadResumption(t *testing.T) {
+	const commit = api.CommitID("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+	const content = "hello resumable world"
+
+	readFile := func(ctx context.Context, c TestClient, commit api.CommitID) (string, error) {
+		r, err := c.NewFileReader(ctx, "repo", commit, "file")
+		require.NoError(t, err)
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		return string(data), err
+	}
+
+	t.Run("resumes at the received offset", func(t *testing.T) {
+		s := &interruptedGitserver{content: content, interruptions: 2}
+		c := newGRPCTestClient(t, s)
+
+		data, err := readFile(WithReadResumption(context.Background(), 2), c, commit)
+		require.NoError(t, err)
+		require.Equal(t, content, data)
+		require.Equal(t, []int64{0, 4, 8}, s.offsets)
+	})
+
+	t.Run("gives up after max resumes", func(t *testing.T) {
+		s := &interruptedGitserver{content: content, interruptions: 2}
+		c := newGRPCTestClient(t, s)
+
+		data, err := readFile(WithReadResumption(context.Background(), 1), c, commit)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, content[:8], data)
+		require.Equal(t, []int64{0, 4}, s.offsets)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		s := &interruptedGitserver{content: content, interruptions: 1}
+		c := newGRPCTestClient(t, s)
+
+		_, err := readFile(context.Background(), c, commit)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, []int64{0}, s.offsets)
+	})
+
+	t.Run("not resumed for refs", func(t *testing.T) {
+		s := &interruptedGitserver{content: content, interruptions: 1}
+		c := newGRPCTestClient(t, s)
+
+		_, err := readFile(WithReadResumption(context.Background(), 2), c, "main")
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, []int64{0}, s.offsets)
+	})
+}
+
+// interruptedBlameGitserver returns hunks in the given order, dropping those
+// outside of the requested range and clipping those at the start of it. The
+// first interruptions calls fail with Unavailable after sending two hunks. If
+// set, regrouped replaces hunks after the first call.
+type interruptedBlameGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+	hunks         []*proto.BlameHunk
+	regrouped     []*proto.BlameHunk
+	interruptions int
+
+	mu     sync.Mutex
+	starts []uint32
+}
+
+func (s *interruptedBlameGitserver) Blame(req *proto.BlameRequest, ss proto.GitserverService_BlameServer) error {
+	start := max(req.GetRange().GetStartLine(), 1)
+	s.mu.Lock()
+	s.starts = append(s.starts, start)
+	interrupt := len(s.starts) <= s.interruptions
+	hunks := s.hunks
+	if len(s.starts) > 1 && s.regrouped != nil {
+		hunks = s.regrouped
+	}
+	s.mu.Unlock()
+
+	sent := 0
+	for _, h := range hunks {
+		if h.GetEndLine() <= start {
+			continue
+		}
+		h := &proto.BlameHunk{StartLine: max(h.GetStartLine(), start), EndLine: h.GetEndLine(), Commit: h.GetCommit()}
+		if err := ss.Send(&proto.BlameResponse{Hunk: h}); err != nil {
+			return err
+		}
+		sent++
+		if interrupt && sent == 2 {
+			return status.Error(codes.Unavailable, "server is shutting down")
+		}
+	}
+	return nil
+}
+
+func TestClient_WithReadResumption_Blame(t *testing.T) {
+	const commit = api.CommitID("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+
+	blame := func(ctx context.Context, c TestClient, commit api.CommitID) ([][2]uint32, error) {
+		r, err := c.StreamBlameFile(ctx, "repo", "file", &BlameOptions{NewestCommit: commit})
+		require.NoError(t, err)
+		defer r.Close()
+		var lines [][2]uint32
+		for {
+			h, err := r.Read()
+			if err == io.EOF {
+				return lines, nil
+			}
+			if err != nil {
+				return lines, err
+			}
+			lines = append(lines, [2]uint32{h.StartLine, h.EndLine})
+		}
+	}
+	hunk := func(start, end uint32) *proto.BlameHunk {
+		return &proto.BlameHunk{StartLine: start, EndLine: end, Commit: string(commit)}
+	}
+
+	t.Run("restarts after the returned lines", func(t *testing.T) {
+		s := &interruptedBlameGitserver{
+			hunks:         []*proto.BlameHunk{hunk(1, 3), hunk(3, 5), hunk(8, 10), hunk(5, 8)},
+			interruptions: 1,
+		}
+		c := newGRPCTestClient(t, s)
+
+		lines, err := blame(WithReadResumption(context.Background(), 1), c, commit)
+		require.NoError(t, err)
+		require.Equal(t, [][2]uint32{{1, 3}, {3, 5}, {8, 10}, {5, 8}}, lines)
+		require.Equal(t, []uint32{1, 3}, s.starts)
+	})
+
+	t.Run("drops returned hunks out of order", func(t *testing.T) {
+		s := &interruptedBlameGitserver{
+			hunks:         []*proto.BlameHunk{hunk(5, 8), hunk(1, 3), hunk(3, 5), hunk(8, 10)},
+			interruptions: 1,
+		}
+		c := newGRPCTestClient(t, s)
+
+		lines, err := blame(WithReadResumption(context.Background(), 1), c, commit)
+		require.NoError(t, err)
+		require.Equal(t, [][2]uint32{{5, 8}, {1, 3}, {3, 5}, {8, 10}}, lines)
+		require.Equal(t, []uint32{1, 1}, s.starts)
+	})
+
+	t.Run("trims partially returned hunks", func(t *testing.T) {
+		s := &interruptedBlameGitserver{
+			hunks:         []*proto.BlameHunk{hunk(1, 4), hunk(6, 9), hunk(4, 6), hunk(9, 10)},
+			regrouped:     []*proto.BlameHunk{hunk(1, 5), hunk(5, 10)},
+			interruptions: 1,
+		}
+		c := newGRPCTestClient(t, s)
+
+		lines, err := blame(WithReadResumption(context.Background(), 1), c, commit)
+		require.NoError(t, err)
+		require.Equal(t, [][2]uint32{{1, 4}, {6, 9}, {4, 5}, {5, 6}, {9, 10}}, lines)
+		require.Equal(t, []uint32{1, 1}, s.starts)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		s := &interruptedBlameGitserver{hunks: []*proto.BlameHunk{hunk(1, 3), hunk(3, 5), hunk(5, 8)}, interruptions: 1}
+		c := newGRPCTestClient(t, s)
+
+		lines, err := blame(context.Background(), c, commit)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, [][2]uint32{{1, 3}, {3, 5}}, lines)
+	})
+}
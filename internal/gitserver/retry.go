@@ -33,6 +33,29 @@ func (r *automaticRetryClient) CreateCommitFromPatchBinary(ctx context.Context,
 	return r.base.CreateCommitFromPatchBinary(ctx, opts...)
 }
 
+func (r *automaticRetryClient) UpdateRef(ctx context.Context, in *proto.UpdateRefRequest, opts ...grpc.CallOption) (*proto.UpdateRefResponse, error) {
+	// UpdateRef isn't idempotent: retrying a compare-and-swap that already
+	// succeeded would fail with a conflict.
+	return r.base.UpdateRef(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) CreateBranch(ctx context.Context, in *proto.CreateBranchRequest, opts ...grpc.CallOption) (*proto.CreateBranchResponse, error) {
+	// CreateBranch isn't idempotent: a retry after a successful attempt would
+	// fail because the branch already exists.
+	return r.base.CreateBranch(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) DeleteBranch(ctx context.Context, in *proto.DeleteBranchRequest, opts ...grpc.CallOption) (*proto.DeleteBranchResponse, error) {
+	// DeleteBranch isn't idempotent.
+	return r.base.DeleteBranch(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) CreateTag(ctx context.Context, in *proto.CreateTagRequest, opts ...grpc.CallOption) (*proto.CreateTagResponse, error) {
+	// CreateTag isn't idempotent: a retry after a successful attempt would
+	// fail because the tag already exists.
+	return r.base.CreateTag(ctx, in, opts...)
+}
+
 // Idempotent methods.
 
 func (r *automaticRetryClient) Exec(ctx context.Context, in *proto.ExecRequest, opts ...grpc.CallOption) (proto.GitserverService_ExecClient, error) {
@@ -50,6 +73,11 @@ func (r *automaticRetryClient) DiskInfo(ctx context.Context, in *proto.DiskInfoR
 	return r.base.DiskInfo(ctx, in, opts...)
 }
 
+func (r *automaticRetryClient) Capabilities(ctx context.Context, in *proto.CapabilitiesRequest, opts ...grpc.CallOption) (*proto.CapabilitiesResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.Capabilities(ctx, in, opts...)
+}
+
 func (r *automaticRetryClient) GetObject(ctx context.Context, in *proto.GetObjectRequest, opts ...grpc.CallOption) (*proto.GetObjectResponse, error) {
 	opts = append(defaults.RetryPolicy, opts...)
 	return r.base.GetObject(ctx, in, opts...)
@@ -135,6 +163,11 @@ func (r *automaticRetryClient) Blame(ctx context.Context, in *proto.BlameRequest
 	return r.base.Blame(ctx, in, opts...)
 }
 
+func (r *automaticRetryClient) BlameSummary(ctx context.Context, in *proto.BlameSummaryRequest, opts ...grpc.CallOption) (*proto.BlameSummaryResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.BlameSummary(ctx, in, opts...)
+}
+
 func (r *automaticRetryClient) DefaultBranch(ctx context.Context, in *proto.DefaultBranchRequest, opts ...grpc.CallOption) (*proto.DefaultBranchResponse, error) {
 	opts = append(defaults.RetryPolicy, opts...)
 	return r.base.DefaultBranch(ctx, in, opts...)
@@ -165,4 +198,173 @@ func (r *automaticRetryClient) RevAtTime(ctx context.Context, in *proto.RevAtTim
 	return r.base.RevAtTime(ctx, in, opts...)
 }
 
+func (r *automaticRetryClient) FormatPatch(ctx context.Context, in *proto.FormatPatchRequest, opts ...grpc.CallOption) (proto.GitserverService_FormatPatchClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.FormatPatch(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) CommitGraph(ctx context.Context, in *proto.CommitGraphRequest, opts ...grpc.CallOption) (proto.GitserverService_CommitGraphClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.CommitGraph(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) Cherry(ctx context.Context, in *proto.CherryRequest, opts ...grpc.CallOption) (*proto.CherryResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.Cherry(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) RangeDiff(ctx context.Context, in *proto.RangeDiffRequest, opts ...grpc.CallOption) (*proto.RangeDiffResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.RangeDiff(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) SymbolicRef(ctx context.Context, in *proto.SymbolicRefRequest, opts ...grpc.CallOption) (*proto.SymbolicRefResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.SymbolicRef(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) RefExists(ctx context.Context, in *proto.RefExistsRequest, opts ...grpc.CallOption) (*proto.RefExistsResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.RefExists(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) ListBranches(ctx context.Context, in *proto.ListBranchesRequest, opts ...grpc.CallOption) (proto.GitserverService_ListBranchesClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.ListBranches(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) ListTags(ctx context.Context, in *proto.ListTagsRequest, opts ...grpc.CallOption) (proto.GitserverService_ListTagsClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.ListTags(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) CloneProgress(ctx context.Context, in *proto.CloneProgressRequest, opts ...grpc.CallOption) (proto.GitserverService_CloneProgressClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.CloneProgress(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) OptimizeRepo(ctx context.Context, in *proto.OptimizeRepoRequest, opts ...grpc.CallOption) (*proto.OptimizeRepoResponse, error) {
+	// OptimizeRepo can run for a long time. Retrying it would start the
+	// maintenance over again, or fail because it's still running.
+	return r.base.OptimizeRepo(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) CommitGraphStatus(ctx context.Context, in *proto.CommitGraphStatusRequest, opts ...grpc.CallOption) (*proto.CommitGraphStatusResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.CommitGraphStatus(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) ListRepos(ctx context.Context, in *proto.ListReposRequest, opts ...grpc.CallOption) (proto.GitserverService_ListReposClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.ListRepos(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) ReadBlob(ctx context.Context, in *proto.ReadBlobRequest, opts ...grpc.CallOption) (proto.GitserverService_ReadBlobClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.ReadBlob(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) GetTree(ctx context.Context, in *proto.GetTreeRequest, opts ...grpc.CallOption) (proto.GitserverService_GetTreeClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.GetTree(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) GetTag(ctx context.Context, in *proto.GetTagRequest, opts ...grpc.CallOption) (*proto.GetTagResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.GetTag(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) ListRemoteRefs(ctx context.Context, in *proto.ListRemoteRefsRequest, opts ...grpc.CallOption) (proto.GitserverService_ListRemoteRefsClient, error) {
+	// Unavailable errors are returned when the code host can't be reached.
+	// Retrying them right away would only add load to a code host that is
+	// already struggling.
+	return r.base.ListRemoteRefs(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) FetchCommitFromRepo(ctx context.Context, in *proto.FetchCommitFromRepoRequest, opts ...grpc.CallOption) (*proto.FetchCommitFromRepoResponse, error) {
+	// FetchCommitFromRepo doesn't create refs, so fetching again is harmless.
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.FetchCommitFromRepo(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) CreateBundle(ctx context.Context, in *proto.CreateBundleRequest, opts ...grpc.CallOption) (proto.GitserverService_CreateBundleClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.CreateBundle(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) ApplyBundle(ctx context.Context, opts ...grpc.CallOption) (proto.GitserverService_ApplyBundleClient, error) {
+	// ApplyBundle is a client-streaming method, which is currently unsupported
+	// by our automatic retry logic.
+	return r.base.ApplyBundle(ctx, opts...)
+}
+
+func (r *automaticRetryClient) FetchRefspec(ctx context.Context, in *proto.FetchRefspecRequest, opts ...grpc.CallOption) (*proto.FetchRefspecResponse, error) {
+	// Like for ListRemoteRefs, we don't retry, as Unavailable errors are
+	// returned when the code host can't be reached.
+	return r.base.FetchRefspec(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) CloneState(ctx context.Context, in *proto.CloneStateRequest, opts ...grpc.CallOption) (*proto.CloneStateResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.CloneState(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) SparseManifest(ctx context.Context, in *proto.SparseManifestRequest, opts ...grpc.CallOption) (proto.GitserverService_SparseManifestClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.SparseManifest(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) ExecInWorktree(ctx context.Context, in *proto.ExecInWorktreeRequest, opts ...grpc.CallOption) (*proto.ExecInWorktreeResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.ExecInWorktree(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) RebasePreview(ctx context.Context, in *proto.RebasePreviewRequest, opts ...grpc.CallOption) (*proto.RebasePreviewResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.RebasePreview(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) RevertCommit(ctx context.Context, in *proto.RevertCommitRequest, opts ...grpc.CallOption) (*proto.RevertCommitResponse, error) {
+	// RevertCommit writes a commit and a ref to the repo, so it isn't retried
+	// automatically.
+	return r.base.RevertCommit(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) CherryPick(ctx context.Context, in *proto.CherryPickRequest, opts ...grpc.CallOption) (*proto.CherryPickResponse, error) {
+	// CherryPick writes a commit and a ref to the repo, so it isn't retried
+	// automatically.
+	return r.base.CherryPick(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) SquashCommits(ctx context.Context, in *proto.SquashCommitsRequest, opts ...grpc.CallOption) (*proto.SquashCommitsResponse, error) {
+	// SquashCommits writes a commit and a ref to the repo, so it isn't retried
+	// automatically.
+	return r.base.SquashCommits(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) RefsChangedSince(ctx context.Context, in *proto.RefsChangedSinceRequest, opts ...grpc.CallOption) (proto.GitserverService_RefsChangedSinceClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.RefsChangedSince(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) WatchRefs(ctx context.Context, in *proto.WatchRefsRequest, opts ...grpc.CallOption) (proto.GitserverService_WatchRefsClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.WatchRefs(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) WatchDefaultBranchCommits(ctx context.Context, in *proto.WatchDefaultBranchCommitsRequest, opts ...grpc.CallOption) (proto.GitserverService_WatchDefaultBranchCommitsClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.WatchDefaultBranchCommits(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) LastCommitsForTree(ctx context.Context, in *proto.LastCommitsForTreeRequest, opts ...grpc.CallOption) (proto.GitserverService_LastCommitsForTreeClient, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.LastCommitsForTree(ctx, in, opts...)
+}
+
+func (r *automaticRetryClient) CommitActivity(ctx context.Context, in *proto.CommitActivityRequest, opts ...grpc.CallOption) (*proto.CommitActivityResponse, error) {
+	opts = append(defaults.RetryPolicy, opts...)
+	return r.base.CommitActivity(ctx, in, opts...)
+}
+
 var _ proto.GitserverServiceClient = &automaticRetryClient{}
@@ -0,0 +1,138 @@
+package gitserver
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+	"github.com/sourcegraph/sourcegraph/internal/grpc/retry"
+)
+
+// RetryPolicy configures how a Client retries idempotent read RPCs, such as
+// GetCommit and ResolveRevision, that fail with a transient error, for
+// example while a gitserver instance restarts.
+//
+// Without a RetryPolicy, the policy defined in
+// internal/grpc/defaults.RetryPolicy is used.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the initial
+	// one. Zero and one disable retries.
+	MaxAttempts uint
+	// Backoff returns the delay before the given retry attempt. If nil, the
+	// default backoff is used.
+	Backoff retry.BackoffFunc
+	// Codes are the status codes that are retried. If empty, the default
+	// codes are used.
+	Codes []codes.Code
+}
+
+func (p RetryPolicy) callOptions() []grpc.CallOption {
+	// The retry interceptor counts the initial attempt towards its max.
+	opts := []grpc.CallOption{retry.WithMax(p.MaxAttempts)}
+	if p.Backoff != nil {
+		opts = append(opts, retry.WithBackoff(p.Backoff))
+	}
+	if len(p.Codes) > 0 {
+		opts = append(opts, retry.WithCodes(p.Codes...))
+	}
+	// Clip the slice, so that appending the options of concurrent calls
+	// always copies it.
+	return slices.Clip(opts)
+}
+
+func (c *clientImplementor) WithRetryPolicy(policy RetryPolicy) Client {
+	return &clientImplementor{
+		logger:              c.logger,
+		scope:               c.scope,
+		operations:          c.operations,
+		subRepoPermsChecker: c.subRepoPermsChecker,
+		clientSource: &retryPolicyClientSource{
+			ClientSource: c.clientSource,
+			opts:         policy.callOptions(),
+		},
+	}
+}
+
+// retryPolicyClientSource wraps the clients returned by a ClientSource, so
+// that they follow a RetryPolicy.
+type retryPolicyClientSource struct {
+	ClientSource
+	opts []grpc.CallOption
+}
+
+func (s *retryPolicyClientSource) ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error) {
+	client, err := s.ClientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	return &retryPolicyClient{GitserverServiceClient: client, opts: s.opts}, nil
+}
+
+// retryPolicyClient passes the call options of a RetryPolicy to idempotent
+// read RPCs. The options are prepended to those given by the caller, so that
+// callers can still override them. All other RPCs use the default policy of
+// the automaticRetryClient.
+type retryPolicyClient struct {
+	proto.GitserverServiceClient
+	opts []grpc.CallOption
+}
+
+func (r *retryPolicyClient) GetObject(ctx context.Context, in *proto.GetObjectRequest, opts ...grpc.CallOption) (*proto.GetObjectResponse, error) {
+	return r.GitserverServiceClient.GetObject(ctx, in, append(r.opts, opts...)...)
+}
+
+func (r *retryPolicyClient) MergeBase(ctx context.Context, in *proto.MergeBaseRequest, opts ...grpc.CallOption) (*proto.MergeBaseResponse, error) {
+	return r.GitserverServiceClient.MergeBase(ctx, in, append(r.opts, opts...)...)
+}
+
+func (r *retryPolicyClient) BlameSummary(ctx context.Context, in *proto.BlameSummaryRequest, opts ...grpc.CallOption) (*proto.BlameSummaryResponse, error) {
+	return r.GitserverServiceClient.BlameSummary(ctx, in, append(r.opts, opts...)...)
+}
+
+func (r *retryPolicyClient) DefaultBranch(ctx context.Context, in *proto.DefaultBranchRequest, opts ...grpc.CallOption) (*proto.DefaultBranchResponse, error) {
+	return r.GitserverServiceClient.DefaultBranch(ctx, in, append(r.opts, opts...)...)
+}
+
+func (r *retryPolicyClient) GetCommit(ctx context.Context, in *proto.GetCommitRequest, opts ...grpc.CallOption) (*proto.GetCommitResponse, error) {
+	return r.GitserverServiceClient.GetCommit(ctx, in, append(r.opts, opts...)...)
+}
+
+func (r *retryPolicyClient) ResolveRevision(ctx context.Context, in *proto.ResolveRevisionRequest, opts ...grpc.CallOption) (*proto.ResolveRevisionResponse, error) {
+	return r.GitserverServiceClient.ResolveRevision(ctx, in, append(r.opts, opts...)...)
+}
+
+func (r *retryPolicyClient) RevAtTime(ctx context.Context, in *proto.RevAtTimeRequest, opts ...grpc.CallOption) (*proto.RevAtTimeResponse, error) {
+	return r.GitserverServiceClient.RevAtTime(ctx, in, append(r.opts, opts...)...)
+}
+
+func (r *retryPolicyClient) Cherry(ctx context.Context, in *proto.CherryRequest, opts ...grpc.CallOption) (*proto.CherryResponse, error) {
+	return r.GitserverServiceClient.Cherry(ctx, in, append(r.opts, opts...)...)
+}
+
+func (r *retryPolicyClient) RangeDiff(ctx context.Context, in *proto.RangeDiffRequest, opts ...grpc.CallOption) (*proto.RangeDiffResponse, error) {
+	return r.GitserverServiceClient.RangeDiff(ctx, in, append(r.opts, opts...)...)
+}
+
+func (r *retryPolicyClient) CommitActivity(ctx context.Context, in *proto.CommitActivityRequest, opts ...grpc.CallOption) (*proto.CommitActivityResponse, error) {
+	return r.GitserverServiceClient.CommitActivity(ctx, in, append(r.opts, opts...)...)
+}
+
+// Exec only runs allowlisted, read-only git commands, such as the ls-tree
+// used by Stat and ReadDir, see the automaticRetryClient.
+func (r *retryPolicyClient) Exec(ctx context.Context, in *proto.ExecRequest, opts ...grpc.CallOption) (proto.GitserverService_ExecClient, error) {
+	return r.GitserverServiceClient.Exec(ctx, in, append(r.opts, opts...)...)
+}
+
+func (r *retryPolicyClient) ReadFile(ctx context.Context, in *proto.ReadFileRequest, opts ...grpc.CallOption) (proto.GitserverService_ReadFileClient, error) {
+	return r.GitserverServiceClient.ReadFile(ctx, in, append(r.opts, opts...)...)
+}
+
+func (r *retryPolicyClient) ListRefs(ctx context.Context, in *proto.ListRefsRequest, opts ...grpc.CallOption) (proto.GitserverService_ListRefsClient, error) {
+	return r.GitserverServiceClient.ListRefs(ctx, in, append(r.opts, opts...)...)
+}
+
+var _ proto.GitserverServiceClient = &retryPolicyClient{}
@@ -0,0 +1,60 @@
+package gitserver
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+)
+
+// rpcMetrics is an rpcObserver that records Prometheus metrics.
+type rpcMetrics struct {
+	duration      *prometheus.HistogramVec
+	receivedBytes *prometheus.HistogramVec
+	errors        *prometheus.CounterVec
+	scope         string
+}
+
+func newRPCMetrics(r prometheus.Registerer, scope string) *rpcMetrics {
+	return &rpcMetrics{
+		duration: metrics.MustRegisterIgnoreDuplicate(r, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "src_gitserver_client_rpc_duration_seconds",
+			Help:    "Time spent on gitserver RPCs, until the last message of a stream was received.",
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 14),
+		}, []string{"method", "scope", "code"})),
+		receivedBytes: metrics.MustRegisterIgnoreDuplicate(r, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "src_gitserver_client_rpc_received_bytes",
+			Help:    "Size of the messages received from gitserver per RPC.",
+			Buckets: prometheus.ExponentialBuckets(256, 4, 12),
+		}, []string{"method", "scope"})),
+		errors: metrics.MustRegisterIgnoreDuplicate(r, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "src_gitserver_client_rpc_errors_total",
+			Help: "Number of gitserver RPCs that failed, by gRPC status code.",
+		}, []string{"method", "scope", "code"})),
+		scope: scope,
+	}
+}
+
+func (m *rpcMetrics) observe(call rpcCallResult) {
+	m.duration.WithLabelValues(call.method, m.scope, call.code.String()).Observe(call.duration.Seconds())
+	m.receivedBytes.WithLabelValues(call.method, m.scope).Observe(float64(call.receivedBytes))
+	if call.err != nil {
+		m.errors.WithLabelValues(call.method, m.scope, call.code.String()).Inc()
+	}
+}
+
+// WithMetrics returns a new client that records the latency, received bytes
+// and errors of every gitserver RPC it makes in the given registerer. The
+// metrics are labeled with the RPC method and the scope of this client, so
+// that it's visible which callers cause the load on gitserver.
+func (c *clientImplementor) WithMetrics(registerer prometheus.Registerer) Client {
+	return &clientImplementor{
+		logger:              c.logger,
+		scope:               c.scope,
+		operations:          c.operations,
+		subRepoPermsChecker: c.subRepoPermsChecker,
+		clientSource: &observedClientSource{
+			ClientSource: c.clientSource,
+			observer:     newRPCMetrics(registerer, c.scope),
+		},
+	}
+}
@@ -0,0 +1,732 @@
+package gitserver
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+// rpcObserver is notified about every finished RPC of an observedClient.
+type rpcObserver interface {
+	observe(call rpcCallResult)
+}
+
+// rpcCallResult describes a finished RPC.
+type rpcCallResult struct {
+	// ctx is the context the call was made with.
+	ctx    context.Context
+	method string
+	// req is the request message, nil for client streaming RPCs.
+	req      protobuf.Message
+	duration time.Duration
+	// receivedBytes is the total size of the messages received.
+	receivedBytes int
+	code          codes.Code
+	err           error
+}
+
+// observedClientSource wraps the clients returned by a ClientSource, so that
+// their RPCs are reported to an rpcObserver.
+type observedClientSource struct {
+	ClientSource
+	observer rpcObserver
+}
+
+func (s *observedClientSource) ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error) {
+	client, err := s.ClientSource.ClientForRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	return &observedClient{base: client, observer: s.observer}, nil
+}
+
+// rpcCall tracks a single RPC until it finished.
+type rpcCall struct {
+	ctx      context.Context
+	observer rpcObserver
+	method   string
+	req      protobuf.Message
+	start    time.Time
+
+	mu    sync.Mutex
+	bytes int
+	done  bool
+	// stop unregisters the cancelation callback of the call context.
+	stop func() bool
+}
+
+// startCall starts tracking a call. Streams are often abandoned by canceling
+// the context instead of being read to the end, so the call is also finished
+// when ctx is canceled.
+func startCall(ctx context.Context, observer rpcObserver, method string, req protobuf.Message) *rpcCall {
+	call := &rpcCall{ctx: ctx, observer: observer, method: method, req: req, start: time.Now()}
+	call.stop = context.AfterFunc(ctx, func() { call.finish(ctx.Err()) })
+	return call
+}
+
+// received records a message received from gitserver.
+func (c *rpcCall) received(msg protobuf.Message) {
+	size := protobuf.Size(msg)
+	c.mu.Lock()
+	c.bytes += size
+	c.mu.Unlock()
+}
+
+// finish reports the outcome of the call. Only the first call has an effect.
+func (c *rpcCall) finish(err error) {
+	c.mu.Lock()
+	if c.done {
+		c.mu.Unlock()
+		return
+	}
+	c.done = true
+	bytes := c.bytes
+	c.mu.Unlock()
+	c.stop()
+
+	code := status.Code(err)
+	if code == codes.Unknown {
+		// The wrapped client translates canceled and deadline exceeded
+		// statuses to context errors, recover their codes.
+		code = status.FromContextError(err).Code()
+	}
+
+	c.observer.observe(rpcCallResult{
+		ctx:           c.ctx,
+		method:        c.method,
+		req:           c.req,
+		duration:      time.Since(c.start),
+		receivedBytes: bytes,
+		code:          code,
+		err:           err,
+	})
+}
+
+// observedRecvClient wraps a server stream, so that the received messages and
+// the end of the stream are recorded.
+type observedRecvClient[T protobuf.Message] struct {
+	grpc.ClientStream
+	recv func() (T, error)
+	call *rpcCall
+}
+
+func (s *observedRecvClient[T]) Recv() (T, error) {
+	msg, err := s.recv()
+	if err != nil {
+		if err == io.EOF {
+			s.call.finish(nil)
+		} else {
+			s.call.finish(err)
+		}
+		return msg, err
+	}
+	s.call.received(msg)
+	return msg, nil
+}
+
+type observedCreateCommitFromPatchBinaryClient struct {
+	proto.GitserverService_CreateCommitFromPatchBinaryClient
+	call *rpcCall
+}
+
+func (s *observedCreateCommitFromPatchBinaryClient) CloseAndRecv() (*proto.CreateCommitFromPatchBinaryResponse, error) {
+	res, err := s.GitserverService_CreateCommitFromPatchBinaryClient.CloseAndRecv()
+	s.call.received(res)
+	s.call.finish(err)
+	return res, err
+}
+
+// observedClient reports every RPC to an rpcObserver.
+type observedClient struct {
+	base     proto.GitserverServiceClient
+	observer rpcObserver
+}
+
+func (m *observedClient) CreateCommitFromPatchBinary(ctx context.Context, opts ...grpc.CallOption) (proto.GitserverService_CreateCommitFromPatchBinaryClient, error) {
+	call := startCall(ctx, m.observer, "CreateCommitFromPatchBinary", nil)
+	cli, err := m.base.CreateCommitFromPatchBinary(ctx, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedCreateCommitFromPatchBinaryClient{GitserverService_CreateCommitFromPatchBinaryClient: cli, call: call}, nil
+}
+
+func (m *observedClient) DiskInfo(ctx context.Context, in *proto.DiskInfoRequest, opts ...grpc.CallOption) (*proto.DiskInfoResponse, error) {
+	call := startCall(ctx, m.observer, "DiskInfo", in)
+	res, err := m.base.DiskInfo(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) Capabilities(ctx context.Context, in *proto.CapabilitiesRequest, opts ...grpc.CallOption) (*proto.CapabilitiesResponse, error) {
+	call := startCall(ctx, m.observer, "Capabilities", in)
+	res, err := m.base.Capabilities(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) Exec(ctx context.Context, in *proto.ExecRequest, opts ...grpc.CallOption) (proto.GitserverService_ExecClient, error) {
+	call := startCall(ctx, m.observer, "Exec", in)
+	cli, err := m.base.Exec(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.ExecResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) GetObject(ctx context.Context, in *proto.GetObjectRequest, opts ...grpc.CallOption) (*proto.GetObjectResponse, error) {
+	call := startCall(ctx, m.observer, "GetObject", in)
+	res, err := m.base.GetObject(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) IsRepoCloneable(ctx context.Context, in *proto.IsRepoCloneableRequest, opts ...grpc.CallOption) (*proto.IsRepoCloneableResponse, error) {
+	call := startCall(ctx, m.observer, "IsRepoCloneable", in)
+	res, err := m.base.IsRepoCloneable(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) ListGitolite(ctx context.Context, in *proto.ListGitoliteRequest, opts ...grpc.CallOption) (*proto.ListGitoliteResponse, error) {
+	call := startCall(ctx, m.observer, "ListGitolite", in)
+	res, err := m.base.ListGitolite(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) Search(ctx context.Context, in *proto.SearchRequest, opts ...grpc.CallOption) (proto.GitserverService_SearchClient, error) {
+	call := startCall(ctx, m.observer, "Search", in)
+	cli, err := m.base.Search(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.SearchResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) Archive(ctx context.Context, in *proto.ArchiveRequest, opts ...grpc.CallOption) (proto.GitserverService_ArchiveClient, error) {
+	call := startCall(ctx, m.observer, "Archive", in)
+	cli, err := m.base.Archive(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.ArchiveResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) RepoCloneProgress(ctx context.Context, in *proto.RepoCloneProgressRequest, opts ...grpc.CallOption) (*proto.RepoCloneProgressResponse, error) {
+	call := startCall(ctx, m.observer, "RepoCloneProgress", in)
+	res, err := m.base.RepoCloneProgress(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) RepoDelete(ctx context.Context, in *proto.RepoDeleteRequest, opts ...grpc.CallOption) (*proto.RepoDeleteResponse, error) {
+	call := startCall(ctx, m.observer, "RepoDelete", in)
+	res, err := m.base.RepoDelete(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) RepoUpdate(ctx context.Context, in *proto.RepoUpdateRequest, opts ...grpc.CallOption) (*proto.RepoUpdateResponse, error) {
+	call := startCall(ctx, m.observer, "RepoUpdate", in)
+	res, err := m.base.RepoUpdate(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) IsPerforcePathCloneable(ctx context.Context, in *proto.IsPerforcePathCloneableRequest, opts ...grpc.CallOption) (*proto.IsPerforcePathCloneableResponse, error) {
+	call := startCall(ctx, m.observer, "IsPerforcePathCloneable", in)
+	res, err := m.base.IsPerforcePathCloneable(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) CheckPerforceCredentials(ctx context.Context, in *proto.CheckPerforceCredentialsRequest, opts ...grpc.CallOption) (*proto.CheckPerforceCredentialsResponse, error) {
+	call := startCall(ctx, m.observer, "CheckPerforceCredentials", in)
+	res, err := m.base.CheckPerforceCredentials(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) PerforceUsers(ctx context.Context, in *proto.PerforceUsersRequest, opts ...grpc.CallOption) (*proto.PerforceUsersResponse, error) {
+	call := startCall(ctx, m.observer, "PerforceUsers", in)
+	res, err := m.base.PerforceUsers(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) PerforceProtectsForUser(ctx context.Context, in *proto.PerforceProtectsForUserRequest, opts ...grpc.CallOption) (*proto.PerforceProtectsForUserResponse, error) {
+	call := startCall(ctx, m.observer, "PerforceProtectsForUser", in)
+	res, err := m.base.PerforceProtectsForUser(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) PerforceProtectsForDepot(ctx context.Context, in *proto.PerforceProtectsForDepotRequest, opts ...grpc.CallOption) (*proto.PerforceProtectsForDepotResponse, error) {
+	call := startCall(ctx, m.observer, "PerforceProtectsForDepot", in)
+	res, err := m.base.PerforceProtectsForDepot(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) PerforceGroupMembers(ctx context.Context, in *proto.PerforceGroupMembersRequest, opts ...grpc.CallOption) (*proto.PerforceGroupMembersResponse, error) {
+	call := startCall(ctx, m.observer, "PerforceGroupMembers", in)
+	res, err := m.base.PerforceGroupMembers(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) IsPerforceSuperUser(ctx context.Context, in *proto.IsPerforceSuperUserRequest, opts ...grpc.CallOption) (*proto.IsPerforceSuperUserResponse, error) {
+	call := startCall(ctx, m.observer, "IsPerforceSuperUser", in)
+	res, err := m.base.IsPerforceSuperUser(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) PerforceGetChangelist(ctx context.Context, in *proto.PerforceGetChangelistRequest, opts ...grpc.CallOption) (*proto.PerforceGetChangelistResponse, error) {
+	call := startCall(ctx, m.observer, "PerforceGetChangelist", in)
+	res, err := m.base.PerforceGetChangelist(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) MergeBase(ctx context.Context, in *proto.MergeBaseRequest, opts ...grpc.CallOption) (*proto.MergeBaseResponse, error) {
+	call := startCall(ctx, m.observer, "MergeBase", in)
+	res, err := m.base.MergeBase(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) Blame(ctx context.Context, in *proto.BlameRequest, opts ...grpc.CallOption) (proto.GitserverService_BlameClient, error) {
+	call := startCall(ctx, m.observer, "Blame", in)
+	cli, err := m.base.Blame(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.BlameResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) BlameSummary(ctx context.Context, in *proto.BlameSummaryRequest, opts ...grpc.CallOption) (*proto.BlameSummaryResponse, error) {
+	call := startCall(ctx, m.observer, "BlameSummary", in)
+	res, err := m.base.BlameSummary(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) DefaultBranch(ctx context.Context, in *proto.DefaultBranchRequest, opts ...grpc.CallOption) (*proto.DefaultBranchResponse, error) {
+	call := startCall(ctx, m.observer, "DefaultBranch", in)
+	res, err := m.base.DefaultBranch(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) ReadFile(ctx context.Context, in *proto.ReadFileRequest, opts ...grpc.CallOption) (proto.GitserverService_ReadFileClient, error) {
+	call := startCall(ctx, m.observer, "ReadFile", in)
+	cli, err := m.base.ReadFile(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.ReadFileResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) GetCommit(ctx context.Context, in *proto.GetCommitRequest, opts ...grpc.CallOption) (*proto.GetCommitResponse, error) {
+	call := startCall(ctx, m.observer, "GetCommit", in)
+	res, err := m.base.GetCommit(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) ResolveRevision(ctx context.Context, in *proto.ResolveRevisionRequest, opts ...grpc.CallOption) (*proto.ResolveRevisionResponse, error) {
+	call := startCall(ctx, m.observer, "ResolveRevision", in)
+	res, err := m.base.ResolveRevision(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) ListRefs(ctx context.Context, in *proto.ListRefsRequest, opts ...grpc.CallOption) (proto.GitserverService_ListRefsClient, error) {
+	call := startCall(ctx, m.observer, "ListRefs", in)
+	cli, err := m.base.ListRefs(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.ListRefsResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) RevAtTime(ctx context.Context, in *proto.RevAtTimeRequest, opts ...grpc.CallOption) (*proto.RevAtTimeResponse, error) {
+	call := startCall(ctx, m.observer, "RevAtTime", in)
+	res, err := m.base.RevAtTime(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) FormatPatch(ctx context.Context, in *proto.FormatPatchRequest, opts ...grpc.CallOption) (proto.GitserverService_FormatPatchClient, error) {
+	call := startCall(ctx, m.observer, "FormatPatch", in)
+	cli, err := m.base.FormatPatch(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.FormatPatchResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) CommitGraph(ctx context.Context, in *proto.CommitGraphRequest, opts ...grpc.CallOption) (proto.GitserverService_CommitGraphClient, error) {
+	call := startCall(ctx, m.observer, "CommitGraph", in)
+	cli, err := m.base.CommitGraph(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.CommitGraphResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) Cherry(ctx context.Context, in *proto.CherryRequest, opts ...grpc.CallOption) (*proto.CherryResponse, error) {
+	call := startCall(ctx, m.observer, "Cherry", in)
+	res, err := m.base.Cherry(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) RangeDiff(ctx context.Context, in *proto.RangeDiffRequest, opts ...grpc.CallOption) (*proto.RangeDiffResponse, error) {
+	call := startCall(ctx, m.observer, "RangeDiff", in)
+	res, err := m.base.RangeDiff(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) UpdateRef(ctx context.Context, in *proto.UpdateRefRequest, opts ...grpc.CallOption) (*proto.UpdateRefResponse, error) {
+	call := startCall(ctx, m.observer, "UpdateRef", in)
+	res, err := m.base.UpdateRef(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) CreateBranch(ctx context.Context, in *proto.CreateBranchRequest, opts ...grpc.CallOption) (*proto.CreateBranchResponse, error) {
+	call := startCall(ctx, m.observer, "CreateBranch", in)
+	res, err := m.base.CreateBranch(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) DeleteBranch(ctx context.Context, in *proto.DeleteBranchRequest, opts ...grpc.CallOption) (*proto.DeleteBranchResponse, error) {
+	call := startCall(ctx, m.observer, "DeleteBranch", in)
+	res, err := m.base.DeleteBranch(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) CreateTag(ctx context.Context, in *proto.CreateTagRequest, opts ...grpc.CallOption) (*proto.CreateTagResponse, error) {
+	call := startCall(ctx, m.observer, "CreateTag", in)
+	res, err := m.base.CreateTag(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) SymbolicRef(ctx context.Context, in *proto.SymbolicRefRequest, opts ...grpc.CallOption) (*proto.SymbolicRefResponse, error) {
+	call := startCall(ctx, m.observer, "SymbolicRef", in)
+	res, err := m.base.SymbolicRef(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) RefExists(ctx context.Context, in *proto.RefExistsRequest, opts ...grpc.CallOption) (*proto.RefExistsResponse, error) {
+	call := startCall(ctx, m.observer, "RefExists", in)
+	res, err := m.base.RefExists(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) ListBranches(ctx context.Context, in *proto.ListBranchesRequest, opts ...grpc.CallOption) (proto.GitserverService_ListBranchesClient, error) {
+	call := startCall(ctx, m.observer, "ListBranches", in)
+	cli, err := m.base.ListBranches(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.ListBranchesResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) ListTags(ctx context.Context, in *proto.ListTagsRequest, opts ...grpc.CallOption) (proto.GitserverService_ListTagsClient, error) {
+	call := startCall(ctx, m.observer, "ListTags", in)
+	cli, err := m.base.ListTags(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.ListTagsResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) CloneProgress(ctx context.Context, in *proto.CloneProgressRequest, opts ...grpc.CallOption) (proto.GitserverService_CloneProgressClient, error) {
+	call := startCall(ctx, m.observer, "CloneProgress", in)
+	cli, err := m.base.CloneProgress(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.CloneProgressResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) OptimizeRepo(ctx context.Context, in *proto.OptimizeRepoRequest, opts ...grpc.CallOption) (*proto.OptimizeRepoResponse, error) {
+	call := startCall(ctx, m.observer, "OptimizeRepo", in)
+	res, err := m.base.OptimizeRepo(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) CommitGraphStatus(ctx context.Context, in *proto.CommitGraphStatusRequest, opts ...grpc.CallOption) (*proto.CommitGraphStatusResponse, error) {
+	call := startCall(ctx, m.observer, "CommitGraphStatus", in)
+	res, err := m.base.CommitGraphStatus(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) ListRepos(ctx context.Context, in *proto.ListReposRequest, opts ...grpc.CallOption) (proto.GitserverService_ListReposClient, error) {
+	call := startCall(ctx, m.observer, "ListRepos", in)
+	cli, err := m.base.ListRepos(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.ListReposResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) ReadBlob(ctx context.Context, in *proto.ReadBlobRequest, opts ...grpc.CallOption) (proto.GitserverService_ReadBlobClient, error) {
+	call := startCall(ctx, m.observer, "ReadBlob", in)
+	cli, err := m.base.ReadBlob(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.ReadBlobResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) GetTree(ctx context.Context, in *proto.GetTreeRequest, opts ...grpc.CallOption) (proto.GitserverService_GetTreeClient, error) {
+	call := startCall(ctx, m.observer, "GetTree", in)
+	cli, err := m.base.GetTree(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.GetTreeResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) GetTag(ctx context.Context, in *proto.GetTagRequest, opts ...grpc.CallOption) (*proto.GetTagResponse, error) {
+	call := startCall(ctx, m.observer, "GetTag", in)
+	res, err := m.base.GetTag(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) ListRemoteRefs(ctx context.Context, in *proto.ListRemoteRefsRequest, opts ...grpc.CallOption) (proto.GitserverService_ListRemoteRefsClient, error) {
+	call := startCall(ctx, m.observer, "ListRemoteRefs", in)
+	cli, err := m.base.ListRemoteRefs(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.ListRemoteRefsResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) FetchCommitFromRepo(ctx context.Context, in *proto.FetchCommitFromRepoRequest, opts ...grpc.CallOption) (*proto.FetchCommitFromRepoResponse, error) {
+	call := startCall(ctx, m.observer, "FetchCommitFromRepo", in)
+	res, err := m.base.FetchCommitFromRepo(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) CreateBundle(ctx context.Context, in *proto.CreateBundleRequest, opts ...grpc.CallOption) (proto.GitserverService_CreateBundleClient, error) {
+	call := startCall(ctx, m.observer, "CreateBundle", in)
+	cli, err := m.base.CreateBundle(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.CreateBundleResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) ApplyBundle(ctx context.Context, opts ...grpc.CallOption) (proto.GitserverService_ApplyBundleClient, error) {
+	call := startCall(ctx, m.observer, "ApplyBundle", nil)
+	cli, err := m.base.ApplyBundle(ctx, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedApplyBundleClient{GitserverService_ApplyBundleClient: cli, call: call}, nil
+}
+
+type observedApplyBundleClient struct {
+	proto.GitserverService_ApplyBundleClient
+	call *rpcCall
+}
+
+func (s *observedApplyBundleClient) CloseAndRecv() (*proto.ApplyBundleResponse, error) {
+	res, err := s.GitserverService_ApplyBundleClient.CloseAndRecv()
+	s.call.received(res)
+	s.call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) FetchRefspec(ctx context.Context, in *proto.FetchRefspecRequest, opts ...grpc.CallOption) (*proto.FetchRefspecResponse, error) {
+	call := startCall(ctx, m.observer, "FetchRefspec", in)
+	res, err := m.base.FetchRefspec(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) CloneState(ctx context.Context, in *proto.CloneStateRequest, opts ...grpc.CallOption) (*proto.CloneStateResponse, error) {
+	call := startCall(ctx, m.observer, "CloneState", in)
+	res, err := m.base.CloneState(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) SparseManifest(ctx context.Context, in *proto.SparseManifestRequest, opts ...grpc.CallOption) (proto.GitserverService_SparseManifestClient, error) {
+	call := startCall(ctx, m.observer, "SparseManifest", in)
+	cli, err := m.base.SparseManifest(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.SparseManifestResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) ExecInWorktree(ctx context.Context, in *proto.ExecInWorktreeRequest, opts ...grpc.CallOption) (*proto.ExecInWorktreeResponse, error) {
+	call := startCall(ctx, m.observer, "ExecInWorktree", in)
+	res, err := m.base.ExecInWorktree(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) RebasePreview(ctx context.Context, in *proto.RebasePreviewRequest, opts ...grpc.CallOption) (*proto.RebasePreviewResponse, error) {
+	call := startCall(ctx, m.observer, "RebasePreview", in)
+	res, err := m.base.RebasePreview(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) RevertCommit(ctx context.Context, in *proto.RevertCommitRequest, opts ...grpc.CallOption) (*proto.RevertCommitResponse, error) {
+	call := startCall(ctx, m.observer, "RevertCommit", in)
+	res, err := m.base.RevertCommit(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) CherryPick(ctx context.Context, in *proto.CherryPickRequest, opts ...grpc.CallOption) (*proto.CherryPickResponse, error) {
+	call := startCall(ctx, m.observer, "CherryPick", in)
+	res, err := m.base.CherryPick(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) SquashCommits(ctx context.Context, in *proto.SquashCommitsRequest, opts ...grpc.CallOption) (*proto.SquashCommitsResponse, error) {
+	call := startCall(ctx, m.observer, "SquashCommits", in)
+	res, err := m.base.SquashCommits(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+func (m *observedClient) RefsChangedSince(ctx context.Context, in *proto.RefsChangedSinceRequest, opts ...grpc.CallOption) (proto.GitserverService_RefsChangedSinceClient, error) {
+	call := startCall(ctx, m.observer, "RefsChangedSince", in)
+	cli, err := m.base.RefsChangedSince(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.RefsChangedSinceResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) WatchRefs(ctx context.Context, in *proto.WatchRefsRequest, opts ...grpc.CallOption) (proto.GitserverService_WatchRefsClient, error) {
+	call := startCall(ctx, m.observer, "WatchRefs", in)
+	cli, err := m.base.WatchRefs(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.WatchRefsResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) WatchDefaultBranchCommits(ctx context.Context, in *proto.WatchDefaultBranchCommitsRequest, opts ...grpc.CallOption) (proto.GitserverService_WatchDefaultBranchCommitsClient, error) {
+	call := startCall(ctx, m.observer, "WatchDefaultBranchCommits", in)
+	cli, err := m.base.WatchDefaultBranchCommits(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.WatchDefaultBranchCommitsResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) LastCommitsForTree(ctx context.Context, in *proto.LastCommitsForTreeRequest, opts ...grpc.CallOption) (proto.GitserverService_LastCommitsForTreeClient, error) {
+	call := startCall(ctx, m.observer, "LastCommitsForTree", in)
+	cli, err := m.base.LastCommitsForTree(ctx, in, opts...)
+	if err != nil {
+		call.finish(err)
+		return nil, err
+	}
+	return &observedRecvClient[*proto.LastCommitsForTreeResponse]{ClientStream: cli, recv: cli.Recv, call: call}, nil
+}
+
+func (m *observedClient) CommitActivity(ctx context.Context, in *proto.CommitActivityRequest, opts ...grpc.CallOption) (*proto.CommitActivityResponse, error) {
+	call := startCall(ctx, m.observer, "CommitActivity", in)
+	res, err := m.base.CommitActivity(ctx, in, opts...)
+	call.received(res)
+	call.finish(err)
+	return res, err
+}
+
+var _ proto.GitserverServiceClient = &observedClient{}
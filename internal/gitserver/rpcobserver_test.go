@@ -0,0 +1,57 @@
+package gitserver
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	protobuf "google.golang.org/protobuf/proto"
+
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+type recordingObserver struct {
+	calls []rpcCallResult
+}
+
+func (o *recordingObserver) observe(call rpcCallResult) {
+	o.calls = append(o.calls, call)
+}
+
+func TestObservedClient_UnaryResponsesAreReceived(t *testing.T) {
+	messageType := reflect.TypeOf((*protobuf.Message)(nil)).Elem()
+	clientType := reflect.TypeOf((*proto.GitserverServiceClient)(nil)).Elem()
+
+	for i := 0; i < clientType.NumMethod(); i++ {
+		method := clientType.Method(i)
+		// Unary RPCs take a request and return a response message, streams
+		// return a stream client instead.
+		if method.Type.NumIn() != 3 || !method.Type.Out(0).Implements(messageType) {
+			continue
+		}
+
+		t.Run(method.Name, func(t *testing.T) {
+			// Unknown fields count towards the size of a message, so every
+			// response can be made non-empty the same way.
+			res := reflect.New(method.Type.Out(0).Elem())
+			msg := res.Interface().(protobuf.Message).ProtoReflect()
+			msg.SetUnknown(protowire.AppendVarint(protowire.AppendTag(nil, 1000, protowire.VarintType), 1))
+
+			base := NewMockGitserverServiceClient()
+			reflect.ValueOf(base).Elem().FieldByName(method.Name + "Func").
+				MethodByName("SetDefaultReturn").
+				Call([]reflect.Value{res, reflect.Zero(reflect.TypeOf((*error)(nil)).Elem())})
+
+			observer := &recordingObserver{}
+			client := &observedClient{base: base, observer: observer}
+			req := reflect.New(method.Type.In(1).Elem())
+			reflect.ValueOf(client).MethodByName(method.Name).
+				Call([]reflect.Value{reflect.ValueOf(context.Background()), req})
+
+			require.Len(t, observer.calls, 1)
+			require.Positive(t, observer.calls[0].receivedBytes)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package gitserver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/sourcegraph/conc/pool"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// defaultForEachRepoMaxConcurrency is the number of repos ForEachRepo processes
+// at the same time if ForEachRepoOptions.MaxConcurrency is not set.
+const defaultForEachRepoMaxConcurrency = 16
+
+// ForEachRepoOptions configures how ForEachRepo and MapRepos fan out.
+type ForEachRepoOptions struct {
+	// MaxConcurrency is the maximum number of repos processed at the same
+	// time. Defaults to 16.
+	MaxConcurrency int
+	// MaxConcurrencyPerShard is the maximum number of repos on the same
+	// gitserver instance processed at the same time. This keeps a shard that
+	// hosts many of the repos from being overloaded while the other shards
+	// are idle. If zero, only MaxConcurrency applies.
+	MaxConcurrencyPerShard int
+}
+
+// RepoError is the error of a single repo in ForEachRepo and MapRepos.
+type RepoError struct {
+	Repo api.RepoName
+	Err  error
+}
+
+func (e *RepoError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Repo, e.Err)
+}
+
+func (e *RepoError) Unwrap() error {
+	return e.Err
+}
+
+// ForEachRepo calls fn for every repo in repos, with bounded concurrency. The
+// repos are grouped by the gitserver instance they live on, and the instances
+// are worked on in parallel, so that the load is spread across the shards
+// instead of hitting them one after another.
+//
+// An error of fn does not stop the other calls. Once all calls are done, the
+// errors are returned as an errors.MultiError of *RepoError, in the order of
+// repos. Repos which weren't started when ctx was canceled get the context
+// error. If all calls succeeded, nil is returned.
+//
+// This is intended for read operations like searching or computing stats over
+// many repos. fn must be safe for concurrent use.
+func ForEachRepo(ctx context.Context, c Client, repos []api.RepoName, opts ForEachRepoOptions, fn func(ctx context.Context, repo api.RepoName) error) error {
+	maxConcurrency := opts.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultForEachRepoMaxConcurrency
+	}
+	perShard := opts.MaxConcurrencyPerShard
+	if perShard <= 0 || perShard > maxConcurrency {
+		perShard = maxConcurrency
+	}
+
+	// Group the repos by shard, keeping the order of repos within a shard.
+	var addrs []string
+	shards := make(map[string][]int)
+	for i, repo := range repos {
+		addr := c.AddrForRepo(ctx, repo)
+		if _, ok := shards[addr]; !ok {
+			addrs = append(addrs, addr)
+		}
+		shards[addr] = append(shards[addr], i)
+	}
+
+	errs := make([]error, len(repos))
+	sem := make(chan struct{}, maxConcurrency)
+	run := func(i int) {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			return
+		}
+		defer func() { <-sem }()
+
+		// Don't start calls once the context is done.
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			return
+		}
+		errs[i] = fn(ctx, repos[i])
+	}
+
+	// Every shard gets up to perShard workers, which take the shard's repos
+	// one after another. The semaphore limits the total across shards.
+	p := pool.New()
+	for _, addr := range addrs {
+		indexes := shards[addr]
+		var mu sync.Mutex
+		next := func() (int, bool) {
+			mu.Lock()
+			defer mu.Unlock()
+			if len(indexes) == 0 {
+				return 0, false
+			}
+			i := indexes[0]
+			indexes = indexes[1:]
+			return i, true
+		}
+		for range min(perShard, len(indexes)) {
+			p.Go(func() {
+				for i, ok := next(); ok; i, ok = next() {
+					run(i)
+				}
+			})
+		}
+	}
+	p.Wait()
+
+	var err error
+	for i, e := range errs {
+		if e != nil {
+			err = errors.Append(err, &RepoError{Repo: repos[i], Err: e})
+		}
+	}
+	return err
+}
+
+// MapRepos is like ForEachRepo, but collects the values returned by fn. The
+// returned map contains the values of all repos for which fn succeeded, even
+// if an error is returned for others.
+func MapRepos[T any](ctx context.Context, c Client, repos []api.RepoName, opts ForEachRepoOptions, fn func(ctx context.Context, repo api.RepoName) (T, error)) (map[api.RepoName]T, error) {
+	var mu sync.Mutex
+	results := make(map[api.RepoName]T, len(repos))
+	err := ForEachRepo(ctx, c, repos, opts, func(ctx context.Context, repo api.RepoName) error {
+		v, err := fn(ctx, repo)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		results[repo] = v
+		mu.Unlock()
+		return nil
+	})
+	return results, err
+}
@@ -0,0 +1,126 @@
+package gitserver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestForEachRepo(t *testing.T) {
+	ctx := context.Background()
+
+	// Repos are named "<shard>/<n>" and live on the gitserver named <shard>.
+	newClient := func() *MockClient {
+		c := NewMockClient()
+		c.AddrForRepoFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName) string {
+			return string(repo)[:1]
+		})
+		return c
+	}
+	repos := func(shard string, n int) []api.RepoName {
+		var rs []api.RepoName
+		for i := range n {
+			rs = append(rs, api.RepoName(fmt.Sprintf("%s/%d", shard, i)))
+		}
+		return rs
+	}
+
+	t.Run("collects errors per repo", func(t *testing.T) {
+		all := append(repos("a", 3), repos("b", 3)...)
+		var mu sync.Mutex
+		var called []api.RepoName
+		err := ForEachRepo(ctx, newClient(), all, ForEachRepoOptions{}, func(_ context.Context, repo api.RepoName) error {
+			mu.Lock()
+			called = append(called, repo)
+			mu.Unlock()
+			if repo == "a/1" || repo == "b/2" {
+				return errors.Newf("failed %s", repo)
+			}
+			return nil
+		})
+		require.ElementsMatch(t, all, called)
+
+		var multi errors.MultiError
+		require.True(t, errors.As(err, &multi))
+		errs := multi.Errors()
+		require.Len(t, errs, 2)
+		var repoErr *RepoError
+		require.True(t, errors.As(errs[0], &repoErr))
+		require.Equal(t, api.RepoName("a/1"), repoErr.Repo)
+		require.True(t, errors.As(errs[1], &repoErr))
+		require.Equal(t, api.RepoName("b/2"), repoErr.Repo)
+		require.ErrorContains(t, repoErr, "failed b/2")
+	})
+
+	t.Run("no errors", func(t *testing.T) {
+		require.NoError(t, ForEachRepo(ctx, newClient(), repos("a", 5), ForEachRepoOptions{}, func(context.Context, api.RepoName) error {
+			return nil
+		}))
+	})
+
+	t.Run("bounds concurrency", func(t *testing.T) {
+		all := append(repos("a", 20), append(repos("b", 20), repos("c", 2)...)...)
+		var mu sync.Mutex
+		inFlight := map[string]int{}
+		total, maxTotal := 0, 0
+		maxPerShard := map[string]int{}
+		err := ForEachRepo(ctx, newClient(), all, ForEachRepoOptions{MaxConcurrency: 5, MaxConcurrencyPerShard: 2}, func(_ context.Context, repo api.RepoName) error {
+			shard := string(repo)[:1]
+			mu.Lock()
+			inFlight[shard]++
+			total++
+			maxPerShard[shard] = max(maxPerShard[shard], inFlight[shard])
+			maxTotal = max(maxTotal, total)
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			inFlight[shard]--
+			total--
+			mu.Unlock()
+			return nil
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, maxTotal, 5)
+		for shard, n := range maxPerShard {
+			require.LessOrEqual(t, n, 2, "shard %s", shard)
+		}
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		called := false
+		err := ForEachRepo(ctx, newClient(), repos("a", 3), ForEachRepoOptions{}, func(context.Context, api.RepoName) error {
+			called = true
+			return nil
+		})
+		require.False(t, called)
+		require.ErrorIs(t, err, context.Canceled)
+		var multi errors.MultiError
+		require.True(t, errors.As(err, &multi))
+		require.Len(t, multi.Errors(), 3)
+	})
+}
+
+func TestMapRepos(t *testing.T) {
+	c := NewMockClient()
+	c.AddrForRepoFunc.SetDefaultReturn("gitserver")
+
+	results, err := MapRepos(context.Background(), c, []api.RepoName{"a", "b", "c"}, ForEachRepoOptions{}, func(_ context.Context, repo api.RepoName) (string, error) {
+		if repo == "b" {
+			return "", errors.New("boom")
+		}
+		return "value of " + string(repo), nil
+	})
+	require.ErrorContains(t, err, "b: boom")
+	require.Equal(t, map[api.RepoName]string{"a": "value of a", "c": "value of c"}, results)
+}
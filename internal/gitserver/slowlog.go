@@ -0,0 +1,206 @@
+package gitserver
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	sglog "github.com/sourcegraph/log"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+// maxRequestSummaryLength caps the size of the request summaries in slow
+// call logs.
+const maxRequestSummaryLength = 512
+
+// WithSlowLogging returns a new client that logs a warning for every
+// gitserver RPC that takes longer than threshold, with the repo, the method
+// and a summary of the request. For streams, the time until the last message
+// was received counts.
+func (c *clientImplementor) WithSlowLogging(threshold time.Duration) Client {
+	if threshold <= 0 {
+		return c
+	}
+	return &clientImplementor{
+		logger:              c.logger,
+		scope:               c.scope,
+		operations:          c.operations,
+		subRepoPermsChecker: c.subRepoPermsChecker,
+		clientSource: &observedClientSource{
+			ClientSource: c.clientSource,
+			observer: &slowCallLogger{
+				logger:    c.logger.Scoped("slow"),
+				threshold: threshold,
+				scope:     c.scope,
+			},
+		},
+	}
+}
+
+// slowCallLogger is an rpcObserver that logs slow RPCs.
+type slowCallLogger struct {
+	logger    sglog.Logger
+	threshold time.Duration
+	scope     string
+}
+
+func (l *slowCallLogger) observe(call rpcCallResult) {
+	if call.duration < l.threshold {
+		return
+	}
+
+	fields := []sglog.Field{
+		sglog.String("method", call.method),
+		sglog.String("scope", l.scope),
+		sglog.Duration("duration", call.duration),
+		sglog.Int("receivedBytes", call.receivedBytes),
+		sglog.String("code", call.code.String()),
+	}
+	if repo, ok := requestRepo(call.req); ok {
+		fields = append(fields, sglog.String("repo", string(repo)))
+	}
+	if call.req != nil {
+		fields = append(fields, sglog.String("request", summarizeRequest(call.req)))
+	}
+	if call.err != nil {
+		fields = append(fields, sglog.Error(call.err))
+	}
+	l.logger.Warn("slow gitserver call", fields...)
+}
+
+// requestRepo returns the repository an RPC request is for, if it has one.
+func requestRepo(req protobuf.Message) (api.RepoName, bool) {
+	// Older requests name the field repo instead of repo_name.
+	switch r := req.(type) {
+	case interface{ GetRepoName() string }:
+		return api.RepoName(r.GetRepoName()), true
+	case interface{ GetRepo() string }:
+		return api.RepoName(r.GetRepo()), true
+	}
+	return "", false
+}
+
+// summarizedFields are the request fields that summarizeRequest includes,
+// besides numbers and booleans. They identify the repository content that was
+// requested, unlike fields with the content itself, like patches, stdin or
+// commit messages, or with search patterns.
+var summarizedFields = map[protoreflect.Name]bool{
+	"repo":             true,
+	"repo_name":        true,
+	"repository_name":  true,
+	"source_repo_name": true,
+	"commit":           true,
+	"commit_sha":       true,
+	"commit_shas":      true,
+	"base_commit_sha":  true,
+	"head_commit_sha":  true,
+	"onto_commit_sha":  true,
+	"rev":              true,
+	"rev_spec":         true,
+	"revspec":          true,
+	"revisions":        true,
+	"spec":             true,
+	"base":             true,
+	"head":             true,
+	"onto":             true,
+	"upstream":         true,
+	"treeish":          true,
+	"ref_name":         true,
+	"refs":             true,
+	"branch_name":      true,
+	"tag_name":         true,
+	"target_ref":       true,
+	"path":             true,
+	"paths":            true,
+	"oid":              true,
+	"blob_oid":         true,
+	"args":             true,
+}
+
+// summarizeRequest returns a short, single line description of an RPC
+// request for logging. Only the fields in summarizedFields and numbers and
+// booleans, like sizes and limits, are included.
+func summarizeRequest(req protobuf.Message) string {
+	var b strings.Builder
+	summarizeMessage(&b, req.ProtoReflect())
+	summary := strings.TrimSpace(b.String())
+	if len(summary) > maxRequestSummaryLength {
+		summary = summary[:maxRequestSummaryLength] + "..."
+	}
+	return summary
+}
+
+func summarizeMessage(b *strings.Builder, m protoreflect.Message) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !m.Has(fd) || fd.IsMap() || !(summarizedFields[fd.Name()] || isSummarizedKind(fd.Kind())) {
+			continue
+		}
+		v := m.Get(fd)
+		b.WriteString(string(fd.Name()))
+		b.WriteByte(':')
+		switch {
+		case fd.Name() == "args" && fd.IsList():
+			// Git arguments can contain search patterns, which we don't log.
+			args := make([]string, 0, v.List().Len())
+			for j := 0; j < v.List().Len(); j++ {
+				args = append(args, summarizeValue(fd, v.List().Get(j)))
+			}
+			b.WriteString(strconv.Quote(strings.Join(gitdomain.SanitizeArgs(args), " ")))
+		case fd.IsList():
+			b.WriteByte('[')
+			for j := 0; j < v.List().Len(); j++ {
+				if j > 0 {
+					b.WriteByte(' ')
+				}
+				writeSummarizedValue(b, fd, v.List().Get(j))
+			}
+			b.WriteByte(']')
+		default:
+			writeSummarizedValue(b, fd, v)
+		}
+		b.WriteByte(' ')
+	}
+}
+
+func writeSummarizedValue(b *strings.Builder, fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		b.WriteByte('{')
+		var inner strings.Builder
+		summarizeMessage(&inner, v.Message())
+		b.WriteString(strings.TrimSpace(inner.String()))
+		b.WriteByte('}')
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		b.WriteString(strconv.Quote(summarizeValue(fd, v)))
+	default:
+		b.WriteString(summarizeValue(fd, v))
+	}
+}
+
+func summarizeValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		return string(v.Bytes())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+	}
+	return v.String()
+}
+
+// isSummarizedKind reports whether fields of kind k are always included in
+// request summaries.
+func isSummarizedKind(k protoreflect.Kind) bool {
+	switch k {
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return false
+	}
+	return true
+}
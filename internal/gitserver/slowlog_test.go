@@ -0,0 +1,81 @@
+package gitserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+func TestClient_WithSlowLogging(t *testing.T) {
+	logger, exportLogs := logtest.Captured(t)
+
+	s := &stallingGitserver{stall: true, canceled: make(chan struct{})}
+	tc := newGRPCTestClient(t, s)
+	tc.(*clientImplementor).logger = logger
+	c := tc.WithSlowLogging(20 * time.Millisecond)
+
+	// The first call stalls until the deadline passed.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := c.ResolveRevision(ctx, "github.com/foo/bar", "HEAD", ResolveRevisionOptions{})
+	require.Error(t, err)
+
+	// The second one is fast and not logged.
+	_, err = c.ResolveRevision(context.Background(), "github.com/foo/bar", "HEAD", ResolveRevisionOptions{})
+	require.NoError(t, err)
+
+	logs := exportLogs()
+	require.Len(t, logs, 1)
+	require.Equal(t, "slow gitserver call", logs[0].Message)
+	require.Equal(t, "ResolveRevision", logs[0].Fields["method"])
+	require.Equal(t, "github.com/foo/bar", logs[0].Fields["repo"])
+	require.Equal(t, "DeadlineExceeded", logs[0].Fields["code"])
+	require.Contains(t, logs[0].Fields["request"], "HEAD")
+}
+
+func TestSummarizeRequest(t *testing.T) {
+	t.Run("exec args are sanitized", func(t *testing.T) {
+		summary := summarizeRequest(&proto.ExecRequest{
+			Repo: "repo",
+			Args: [][]byte{[]byte("log"), []byte("-Ssecret"), []byte("HEAD")},
+		})
+		require.Equal(t, `repo:"repo" args:"log -S<redacted> HEAD"`, summary)
+	})
+
+	t.Run("only identifying fields are included", func(t *testing.T) {
+		summary := summarizeRequest(&proto.SearchRequest{
+			Repo:      "repo",
+			Revisions: []*proto.RevisionSpecifier{{RevSpec: "main"}},
+			Limit:     10,
+			Query: &proto.QueryNode{Value: &proto.QueryNode_DiffMatches{
+				DiffMatches: &proto.DiffMatchesNode{Expr: "secret-pattern"},
+			}},
+		})
+		require.Equal(t, `repo:"repo" revisions:[{rev_spec:"main"}] limit:10`, summary)
+
+		summary = summarizeRequest(&proto.ExecInWorktreeRequest{
+			RepoName:  "repo",
+			CommitSha: "deadbeef",
+			Args:      [][]byte{[]byte("apply"), []byte("--check")},
+			Stdin:     []byte("+password = hunter2"),
+		})
+		require.Equal(t, `repo_name:"repo" commit_sha:"deadbeef" args:"apply --check"`, summary)
+
+		summary = summarizeRequest(&proto.CreateTagRequest{RepoName: "repo", TagName: "v1", Message: []byte("secret release notes")})
+		require.NotContains(t, summary, "secret")
+	})
+
+	t.Run("long requests are truncated", func(t *testing.T) {
+		paths := make([]string, 1000)
+		for i := range paths {
+			paths[i] = "some/path"
+		}
+		summary := summarizeRequest(&proto.ArchiveRequest{Repo: "repo", Paths: paths})
+		require.Len(t, summary, maxRequestSummaryLength+len("..."))
+	})
+}
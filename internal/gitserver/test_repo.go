@@ -0,0 +1,277 @@
+package gitserver
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// testRepoBaseDate is the date of the first commit of a TestRepo, unless
+// overridden with At. Every following commit is one second later.
+var testRepoBaseDate = time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+// TestRepo builds a git repository for tests from typed steps, as an
+// alternative to passing shell commands to MakeGitRepository:
+//
+//	repo := NewTestRepo(t)
+//	first := repo.Commit("first").AddFile("a.go", "package a")
+//	repo.Branch("feature", first)
+//	second := repo.Commit("second").AddFile("b.go", "package b").At(date)
+//	name := repo.Build()
+//
+// Authors and dates are fixed, so the same steps always produce the same
+// commit SHAs. The steps run in order when Build is called, after which the
+// IDs of the returned commits are available.
+//
+// Files are written from Go and git is run without a shell, so the builder
+// works on every platform, including Windows machines without symlink
+// support.
+type TestRepo struct {
+	t       *testing.T
+	steps   []func(dir string)
+	commits int
+	dir     string
+}
+
+// NewTestRepo returns a builder for a repository with an empty master branch.
+func NewTestRepo(t *testing.T) *TestRepo {
+	return &TestRepo{t: t}
+}
+
+// TestCommit is a commit of a TestRepo.
+type TestCommit struct {
+	repo           *TestRepo
+	message        string
+	date           time.Time
+	commitDate     time.Time
+	author         string
+	email          string
+	committer      string
+	committerEmail string
+	changes        []func(dir string)
+	id             api.CommitID
+}
+
+// Commit adds a commit with the given message to the current branch. The
+// changes of the commit are configured on the returned TestCommit.
+func (r *TestRepo) Commit(message string) *TestCommit {
+	c := &TestCommit{
+		repo:    r,
+		message: message,
+		date:    testRepoBaseDate.Add(time.Duration(r.commits) * time.Second),
+		author:  "a",
+		email:   "a@a.com",
+	}
+	r.commits++
+	r.steps = append(r.steps, func(dir string) {
+		for _, change := range c.changes {
+			change(dir)
+		}
+		r.git(dir, c.env(), "add", "-A")
+		r.git(dir, c.env(), "commit", "-q", "--allow-empty", "-m", c.message)
+		c.id = api.CommitID(r.git(dir, nil, "rev-parse", "HEAD"))
+	})
+	return c
+}
+
+// Merge adds a merge commit of branch into the current branch. Merges always
+// create a merge commit, even if they could be fast-forwarded.
+func (r *TestRepo) Merge(message, branch string) *TestCommit {
+	c := &TestCommit{
+		repo:    r,
+		message: message,
+		date:    testRepoBaseDate.Add(time.Duration(r.commits) * time.Second),
+		author:  "a",
+		email:   "a@a.com",
+	}
+	r.commits++
+	r.steps = append(r.steps, func(dir string) {
+		r.git(dir, c.env(), "merge", "-q", "--no-ff", "-m", c.message, branch)
+		c.id = api.CommitID(r.git(dir, nil, "rev-parse", "HEAD"))
+	})
+	return c
+}
+
+// Branch creates a branch at the given commit, or at the current commit if
+// from is nil, and makes it the current branch.
+func (r *TestRepo) Branch(name string, from *TestCommit) *TestRepo {
+	r.steps = append(r.steps, func(dir string) {
+		args := []string{"checkout", "-q", "-b", name}
+		if from != nil {
+			args = append(args, string(from.id))
+		}
+		r.git(dir, nil, args...)
+	})
+	return r
+}
+
+// Checkout makes an existing branch the current branch.
+func (r *TestRepo) Checkout(branch string) *TestRepo {
+	r.steps = append(r.steps, func(dir string) {
+		r.git(dir, nil, "checkout", "-q", branch)
+	})
+	return r
+}
+
+// Tag creates a lightweight tag pointing at the given commit.
+func (r *TestRepo) Tag(name string, c *TestCommit) *TestRepo {
+	r.steps = append(r.steps, func(dir string) {
+		r.git(dir, nil, "tag", name, string(c.id))
+	})
+	return r
+}
+
+// Build creates the repository and returns its name. It must be called once,
+// after all steps were added.
+func (r *TestRepo) Build() api.RepoName {
+	r.t.Helper()
+	if r.dir != "" {
+		r.t.Fatal("TestRepo.Build called twice")
+	}
+	r.dir = InitGitRepository(r.t)
+	for _, step := range r.steps {
+		step(r.dir)
+	}
+	return api.RepoName(filepath.Base(r.dir))
+}
+
+// Dir returns the directory of the repository. It is only available after
+// Build was called.
+func (r *TestRepo) Dir() string {
+	if r.dir == "" {
+		r.t.Fatal("TestRepo.Dir called before Build")
+	}
+	return r.dir
+}
+
+func (r *TestRepo) git(dir string, env []string, args ...string) string {
+	r.t.Helper()
+	cmd := CreateGitCommand(dir, "git", args...)
+	cmd.Env = append(cmd.Env, env...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		r.t.Fatalf("git %s failed. Output was:\n\n%s", strings.Join(args, " "), out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// AddFile writes content to the file at path, creating the file and its
+// parent directories if necessary.
+func (c *TestCommit) AddFile(path, content string) *TestCommit {
+	c.changes = append(c.changes, func(dir string) {
+		full := filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			c.repo.t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0o644); err != nil {
+			c.repo.t.Fatal(err)
+		}
+	})
+	return c
+}
+
+// AppendFile appends content to the file at path, creating the file if
+// necessary.
+func (c *TestCommit) AppendFile(path, content string) *TestCommit {
+	c.changes = append(c.changes, func(dir string) {
+		f, err := os.OpenFile(filepath.Join(dir, filepath.FromSlash(path)), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			c.repo.t.Fatal(err)
+		}
+		defer f.Close()
+		if _, err := f.WriteString(content); err != nil {
+			c.repo.t.Fatal(err)
+		}
+	})
+	return c
+}
+
+// AddSymlink creates a symbolic link at path pointing to target. The work tree
+// only holds a plain file with the target; the link itself is recorded in the
+// index with git plumbing.
+func (c *TestCommit) AddSymlink(path, target string) *TestCommit {
+	c.AddFile(path, target)
+	c.changes = append(c.changes, func(dir string) {
+		// Keep the recorded symlink mode when the work tree is staged again,
+		// as git does by default on Windows.
+		c.repo.git(dir, nil, "config", "core.symlinks", "false")
+		oid := c.repo.git(dir, nil, "hash-object", "-w", "--", path)
+		c.repo.git(dir, nil, "update-index", "--add", "--cacheinfo", "120000,"+oid+","+path)
+	})
+	return c
+}
+
+// DeleteFile removes the file at path.
+func (c *TestCommit) DeleteFile(path string) *TestCommit {
+	c.changes = append(c.changes, func(dir string) {
+		c.repo.git(dir, nil, "rm", "-q", "--", path)
+	})
+	return c
+}
+
+// RenameFile moves the file at from to to.
+func (c *TestCommit) RenameFile(from, to string) *TestCommit {
+	c.changes = append(c.changes, func(dir string) {
+		if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(filepath.FromSlash(to))), 0o755); err != nil {
+			c.repo.t.Fatal(err)
+		}
+		c.repo.git(dir, nil, "mv", "--", from, to)
+	})
+	return c
+}
+
+// At sets the author and committer date of the commit.
+func (c *TestCommit) At(date time.Time) *TestCommit {
+	c.date = date
+	return c
+}
+
+// CommittedAt sets the committer date of the commit, if it differs from the
+// author date set with At.
+func (c *TestCommit) CommittedAt(date time.Time) *TestCommit {
+	c.commitDate = date
+	return c
+}
+
+// Author sets the author of the commit. The committer is not changed.
+func (c *TestCommit) Author(name, email string) *TestCommit {
+	c.author, c.email = name, email
+	return c
+}
+
+// Committer sets the committer of the commit, which is "a <a@a.com>" by
+// default.
+func (c *TestCommit) Committer(name, email string) *TestCommit {
+	c.committer, c.committerEmail = name, email
+	return c
+}
+
+// ID returns the SHA of the commit. It is only available after the
+// repository was built.
+func (c *TestCommit) ID() api.CommitID {
+	if c.id == "" {
+		c.repo.t.Fatalf("commit %q has not been built yet", c.message)
+	}
+	return c.id
+}
+
+func (c *TestCommit) env() []string {
+	commitDate := c.date
+	if !c.commitDate.IsZero() {
+		commitDate = c.commitDate
+	}
+	env := []string{
+		"GIT_AUTHOR_NAME=" + c.author,
+		"GIT_AUTHOR_EMAIL=" + c.email,
+		"GIT_AUTHOR_DATE=" + c.date.UTC().Format(time.RFC3339),
+		"GIT_COMMITTER_DATE=" + commitDate.UTC().Format(time.RFC3339),
+	}
+	if c.committer != "" {
+		env = append(env, "GIT_COMMITTER_NAME="+c.committer, "GIT_COMMITTER_EMAIL="+c.committerEmail)
+	}
+	return env
+}
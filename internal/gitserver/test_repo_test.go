@@ -0,0 +1,55 @@
+package gitserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestTestRepo(t *testing.T) {
+	ClientMocks.LocalGitserver = true
+	defer ResetClientMocks()
+	ctx := context.Background()
+
+	date := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	build := func(t *testing.T) (api.RepoName, *TestRepo, []*TestCommit) {
+		r := NewTestRepo(t)
+		first := r.Commit("first").AddFile("dir/a.go", "package a\n").AddFile("b.go", "package b\n")
+		r.Branch("feature", first)
+		feature := r.Commit("feature").RenameFile("b.go", "dir/b.go").Author("b", "b@b.com").At(date)
+		r.Checkout("master")
+		second := r.Commit("second").DeleteFile("dir/a.go")
+		merge := r.Merge("merge", "feature")
+		r.Tag("v1", second)
+		return r.Build(), r, []*TestCommit{first, feature, second, merge}
+	}
+
+	// Building the same steps again results in the same commits.
+	_, _, again := build(t)
+	repo, r, commits := build(t)
+	for i := range commits {
+		require.Equal(t, again[i].ID(), commits[i].ID())
+	}
+	first, feature, second, merge := commits[0], commits[1], commits[2], commits[3]
+
+	git := func(args ...string) string {
+		t.Helper()
+		out, err := CreateGitCommand(r.Dir(), "git", args...).CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+
+	require.Equal(t, string(second.ID())+" "+string(feature.ID()), git("log", "-1", "--format=%P", string(merge.ID())))
+	require.Equal(t, string(first.ID())+" b <b@b.com> 2020-01-01T00:00:00+00:00", git("log", "-1", "--format=%P %an <%ae> %aI", string(feature.ID())))
+	require.Equal(t, string(second.ID()), git("rev-parse", "v1"))
+	require.Equal(t, "package a", git("show", string(first.ID())+":dir/a.go"))
+
+	files, err := NewTestClient(t).LsFiles(ctx, repo, merge.ID())
+	require.NoError(t, err)
+	require.Equal(t, []string{"dir/b.go"}, files)
+}
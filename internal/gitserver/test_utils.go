@@ -2,16 +2,20 @@ package gitserver
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"os"
 	"os/exec"
-	"path"
 	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"google.golang.org/grpc"
+
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 )
 
 // CreateRepoDir creates a repo directory for testing purposes.
@@ -46,6 +50,30 @@ func MustParseTime(layout, value string) time.Time {
 	return tm
 }
 
+// NewLocalTestClient returns a client for tests that set
+// ClientMocks.LocalGitserver. Git commands run locally, and the CloneState
+// RPC, which history walks use to detect the boundary of a shallow clone,
+// reads the shallow file of the local repository.
+func NewLocalTestClient(t *testing.T) TestClient {
+	source := NewTestClientSource(t, []string{"gitserver"}, func(o *TestClientSourceOptions) {
+		o.ClientFunc = func(cc *grpc.ClientConn) proto.GitserverServiceClient {
+			c := NewMockGitserverServiceClient()
+			c.CloneStateFunc.SetDefaultHook(func(_ context.Context, req *proto.CloneStateRequest, _ ...grpc.CallOption) (*proto.CloneStateResponse, error) {
+				dir := filepath.Join(ClientMocks.LocalGitCommandReposDir, string(protocol.NormalizeRepo(api.RepoName(req.GetRepoName()))))
+				shallow, err := os.ReadFile(filepath.Join(dir, ".git", "shallow"))
+				if os.IsNotExist(err) {
+					return &proto.CloneStateResponse{}, nil
+				} else if err != nil {
+					return nil, err
+				}
+				return &proto.CloneStateResponse{Shallow: true, ShallowCommits: strings.Fields(string(shallow))}, nil
+			})
+			return c
+		}
+	})
+	return NewTestClient(t).WithClientSource(source)
+}
+
 // MakeGitRepository calls initGitRepository to create a new Git repository and returns a handle to
 // it.
 func MakeGitRepository(t *testing.T, cmds ...string) api.RepoName {
@@ -66,7 +94,7 @@ func MakeGitRepositoryAndReturnDir(t *testing.T, cmds ...string) (api.RepoName,
 
 func GetHeadCommitFromGitDir(t *testing.T, gitDir string) string {
 	t.Helper()
-	cmd := CreateGitCommand(gitDir, "bash", []string{"-c", "git rev-parse HEAD"}...)
+	cmd := CreateGitCommand(gitDir, "git", "rev-parse", "HEAD")
 	out, err := cmd.CombinedOutput()
 	if err != nil {
 		t.Fatalf("Command %q failed. Output was: %s, Error: %+v\n ", cmd, out, err)
@@ -92,7 +120,11 @@ func InitGitRepository(t *testing.T, cmds ...string) string {
 	// setting git repo which is needed for successful run of git command against local file system
 	ClientMocks.LocalGitCommandReposDir = remotes
 
-	cmds = append([]string{"git init --initial-branch=master"}, cmds...)
+	// git init runs without a shell, so that repositories built only from
+	// plumbing commands (see TestRepo) don't need bash.
+	if out, err := CreateGitCommand(dir, "git", "init", "--initial-branch=master").CombinedOutput(); err != nil {
+		t.Fatalf("git init failed. Output was:\n\n%s", out)
+	}
 	for _, cmd := range cmds {
 		out, err := CreateGitCommand(dir, "bash", "-c", cmd).CombinedOutput()
 		if err != nil {
@@ -106,7 +138,7 @@ func CreateGitCommand(dir, name string, args ...string) *exec.Cmd {
 	c := exec.Command(name, args...)
 	c.Dir = dir
 	c.Env = []string{
-		"GIT_CONFIG=" + path.Join(dir, ".git", "config"),
+		"GIT_CONFIG=" + filepath.Join(dir, ".git", "config"),
 		"GIT_COMMITTER_NAME=a",
 		"GIT_COMMITTER_EMAIL=a@a.com",
 		"GIT_COMMITTER_DATE=2006-01-02T15:04:05Z",
@@ -22,6 +22,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// BlameCopyDetection maps to the -M and -C levels of git blame.
+type BlameCopyDetection int32
+
+const (
+	// No move or copy detection.
+	BlameCopyDetection_BLAME_COPY_DETECTION_UNSPECIFIED BlameCopyDetection = 0
+	// Detect lines moved or copied within the file (-M).
+	BlameCopyDetection_BLAME_COPY_DETECTION_MOVES BlameCopyDetection = 1
+	// Additionally detect lines moved or copied from other files that were
+	// modified in the same commit (-C).
+	BlameCopyDetection_BLAME_COPY_DETECTION_COPIES BlameCopyDetection = 2
+	// Additionally look for copies in the commit that created the file (-C -C).
+	BlameCopyDetection_BLAME_COPY_DETECTION_COPIES_FROM_CREATION BlameCopyDetection = 3
+	// Additionally look for copies in all commits (-C -C -C). This can be very
+	// expensive on large repositories.
+	BlameCopyDetection_BLAME_COPY_DETECTION_COPIES_FROM_ANY_COMMIT BlameCopyDetection = 4
+)
+
+// Enum value maps for BlameCopyDetection.
+var (
+	BlameCopyDetection_name = map[int32]string{
+		0: "BLAME_COPY_DETECTION_UNSPECIFIED",
+		1: "BLAME_COPY_DETECTION_MOVES",
+		2: "BLAME_COPY_DETECTION_COPIES",
+		3: "BLAME_COPY_DETECTION_COPIES_FROM_CREATION",
+		4: "BLAME_COPY_DETECTION_COPIES_FROM_ANY_COMMIT",
+	}
+	BlameCopyDetection_value = map[string]int32{
+		"BLAME_COPY_DETECTION_UNSPECIFIED":            0,
+		"BLAME_COPY_DETECTION_MOVES":                  1,
+		"BLAME_COPY_DETECTION_COPIES":                 2,
+		"BLAME_COPY_DETECTION_COPIES_FROM_CREATION":   3,
+		"BLAME_COPY_DETECTION_COPIES_FROM_ANY_COMMIT": 4,
+	}
+)
+
+func (x BlameCopyDetection) Enum() *BlameCopyDetection {
+	p := new(BlameCopyDetection)
+	*p = x
+	return p
+}
+
+func (x BlameCopyDetection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlameCopyDetection) Descriptor() protoreflect.EnumDescriptor {
+	return file_gitserver_proto_enumTypes[0].Descriptor()
+}
+
+func (BlameCopyDetection) Type() protoreflect.EnumType {
+	return &file_gitserver_proto_enumTypes[0]
+}
+
+func (x BlameCopyDetection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlameCopyDetection.Descriptor instead.
+func (BlameCopyDetection) EnumDescriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{0}
+}
+
 type OperatorKind int32
 
 const (
@@ -58,11 +121,11 @@ func (x OperatorKind) String() string {
 }
 
 func (OperatorKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_gitserver_proto_enumTypes[0].Descriptor()
+	return file_gitserver_proto_enumTypes[1].Descriptor()
 }
 
 func (OperatorKind) Type() protoreflect.EnumType {
-	return &file_gitserver_proto_enumTypes[0]
+	return &file_gitserver_proto_enumTypes[1]
 }
 
 func (x OperatorKind) Number() protoreflect.EnumNumber {
@@ -71,7 +134,7 @@ func (x OperatorKind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OperatorKind.Descriptor instead.
 func (OperatorKind) EnumDescriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{0}
+	return file_gitserver_proto_rawDescGZIP(), []int{1}
 }
 
 type ArchiveFormat int32
@@ -107,11 +170,11 @@ func (x ArchiveFormat) String() string {
 }
 
 func (ArchiveFormat) Descriptor() protoreflect.EnumDescriptor {
-	return file_gitserver_proto_enumTypes[1].Descriptor()
+	return file_gitserver_proto_enumTypes[2].Descriptor()
 }
 
 func (ArchiveFormat) Type() protoreflect.EnumType {
-	return &file_gitserver_proto_enumTypes[1]
+	return &file_gitserver_proto_enumTypes[2]
 }
 
 func (x ArchiveFormat) Number() protoreflect.EnumNumber {
@@ -120,7 +183,58 @@ func (x ArchiveFormat) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ArchiveFormat.Descriptor instead.
 func (ArchiveFormat) EnumDescriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{1}
+	return file_gitserver_proto_rawDescGZIP(), []int{2}
+}
+
+type CommitActivityInterval int32
+
+const (
+	CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_UNSPECIFIED CommitActivityInterval = 0
+	// Commits are counted per day.
+	CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_DAY CommitActivityInterval = 1
+	// Commits are counted per week, starting on Monday.
+	CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_WEEK CommitActivityInterval = 2
+)
+
+// Enum value maps for CommitActivityInterval.
+var (
+	CommitActivityInterval_name = map[int32]string{
+		0: "COMMIT_ACTIVITY_INTERVAL_UNSPECIFIED",
+		1: "COMMIT_ACTIVITY_INTERVAL_DAY",
+		2: "COMMIT_ACTIVITY_INTERVAL_WEEK",
+	}
+	CommitActivityInterval_value = map[string]int32{
+		"COMMIT_ACTIVITY_INTERVAL_UNSPECIFIED": 0,
+		"COMMIT_ACTIVITY_INTERVAL_DAY":         1,
+		"COMMIT_ACTIVITY_INTERVAL_WEEK":        2,
+	}
+)
+
+func (x CommitActivityInterval) Enum() *CommitActivityInterval {
+	p := new(CommitActivityInterval)
+	*p = x
+	return p
+}
+
+func (x CommitActivityInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommitActivityInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_gitserver_proto_enumTypes[3].Descriptor()
+}
+
+func (CommitActivityInterval) Type() protoreflect.EnumType {
+	return &file_gitserver_proto_enumTypes[3]
+}
+
+func (x CommitActivityInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommitActivityInterval.Descriptor instead.
+func (CommitActivityInterval) EnumDescriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{3}
 }
 
 type GitRef_RefType int32
@@ -156,11 +270,11 @@ func (x GitRef_RefType) String() string {
 }
 
 func (GitRef_RefType) Descriptor() protoreflect.EnumDescriptor {
-	return file_gitserver_proto_enumTypes[2].Descriptor()
+	return file_gitserver_proto_enumTypes[4].Descriptor()
 }
 
 func (GitRef_RefType) Type() protoreflect.EnumType {
-	return &file_gitserver_proto_enumTypes[2]
+	return &file_gitserver_proto_enumTypes[4]
 }
 
 func (x GitRef_RefType) Number() protoreflect.EnumNumber {
@@ -169,7 +283,7 @@ func (x GitRef_RefType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GitRef_RefType.Descriptor instead.
 func (GitRef_RefType) EnumDescriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{2, 0}
+	return file_gitserver_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type GitObject_ObjectType int32
@@ -211,11 +325,11 @@ func (x GitObject_ObjectType) String() string {
 }
 
 func (GitObject_ObjectType) Descriptor() protoreflect.EnumDescriptor {
-	return file_gitserver_proto_enumTypes[3].Descriptor()
+	return file_gitserver_proto_enumTypes[5].Descriptor()
 }
 
 func (GitObject_ObjectType) Type() protoreflect.EnumType {
-	return &file_gitserver_proto_enumTypes[3]
+	return &file_gitserver_proto_enumTypes[5]
 }
 
 func (x GitObject_ObjectType) Number() protoreflect.EnumNumber {
@@ -224,7 +338,7 @@ func (x GitObject_ObjectType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GitObject_ObjectType.Descriptor instead.
 func (GitObject_ObjectType) EnumDescriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{64, 0}
+	return file_gitserver_proto_rawDescGZIP(), []int{85, 0}
 }
 
 // PerforceChangelistState is the valid state values of a Perforce changelist.
@@ -269,11 +383,11 @@ func (x PerforceChangelist_PerforceChangelistState) String() string {
 }
 
 func (PerforceChangelist_PerforceChangelistState) Descriptor() protoreflect.EnumDescriptor {
-	return file_gitserver_proto_enumTypes[4].Descriptor()
+	return file_gitserver_proto_enumTypes[6].Descriptor()
 }
 
 func (PerforceChangelist_PerforceChangelistState) Type() protoreflect.EnumType {
-	return &file_gitserver_proto_enumTypes[4]
+	return &file_gitserver_proto_enumTypes[6]
 }
 
 func (x PerforceChangelist_PerforceChangelistState) Number() protoreflect.EnumNumber {
@@ -282,7 +396,66 @@ func (x PerforceChangelist_PerforceChangelistState) Number() protoreflect.EnumNu
 
 // Deprecated: Use PerforceChangelist_PerforceChangelistState.Descriptor instead.
 func (PerforceChangelist_PerforceChangelistState) EnumDescriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{72, 0}
+	return file_gitserver_proto_rawDescGZIP(), []int{93, 0}
+}
+
+type RangeDiffPair_Status int32
+
+const (
+	RangeDiffPair_STATUS_UNSPECIFIED RangeDiffPair_Status = 0
+	// Both commits have the same patch.
+	RangeDiffPair_STATUS_EQUAL RangeDiffPair_Status = 1
+	// The commits correspond to each other, but their patches differ.
+	RangeDiffPair_STATUS_MODIFIED RangeDiffPair_Status = 2
+	// The commit of the old series has no counterpart in the new series.
+	RangeDiffPair_STATUS_REMOVED RangeDiffPair_Status = 3
+	// The commit of the new series has no counterpart in the old series.
+	RangeDiffPair_STATUS_ADDED RangeDiffPair_Status = 4
+)
+
+// Enum value maps for RangeDiffPair_Status.
+var (
+	RangeDiffPair_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_EQUAL",
+		2: "STATUS_MODIFIED",
+		3: "STATUS_REMOVED",
+		4: "STATUS_ADDED",
+	}
+	RangeDiffPair_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_EQUAL":       1,
+		"STATUS_MODIFIED":    2,
+		"STATUS_REMOVED":     3,
+		"STATUS_ADDED":       4,
+	}
+)
+
+func (x RangeDiffPair_Status) Enum() *RangeDiffPair_Status {
+	p := new(RangeDiffPair_Status)
+	*p = x
+	return p
+}
+
+func (x RangeDiffPair_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RangeDiffPair_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_gitserver_proto_enumTypes[7].Descriptor()
+}
+
+func (RangeDiffPair_Status) Type() protoreflect.EnumType {
+	return &file_gitserver_proto_enumTypes[7]
+}
+
+func (x RangeDiffPair_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RangeDiffPair_Status.Descriptor instead.
+func (RangeDiffPair_Status) EnumDescriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{117, 0}
 }
 
 type ListRefsRequest struct {
@@ -418,35 +591,23 @@ func (x *ListRefsResponse) GetRefs() []*GitRef {
 	return nil
 }
 
-type GitRef struct {
+type RefsChangedSinceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ref_name is the unabbreviated name of the reference, i.e., refs/heads/main, or refs/tags/1.0.
-	RefName string `protobuf:"bytes,1,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
-	// short_ref_name is the abbreviated name of the reference, if unambiguous.
-	// I.e., main, or 1.0.
-	ShortRefName string `protobuf:"bytes,2,opt,name=short_ref_name,json=shortRefName,proto3" json:"short_ref_name,omitempty"`
-	// target_commit is the hash of the commit the reference is currently pointing at.
-	// For a head reference, this is the commit the head is currently pointing at.
-	// For a tag, this is the commit that the tag is attached to.
-	TargetCommit string `protobuf:"bytes,3,opt,name=target_commit,json=targetCommit,proto3" json:"target_commit,omitempty"`
-	// ref_oid is the full object ID of the reference. For a head reference and
-	// a lightweight tag, this value is the same as target_commit. For annotated tags,
-	// it is the object ID of the tag.
-	RefOid string `protobuf:"bytes,4,opt,name=ref_oid,json=refOid,proto3" json:"ref_oid,omitempty"`
-	// created_at is the timestamp when the ref was created or modified last.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// ref_type is the type of the reference. Non-tag references will be of
-	// type branch. I.e., refs/pull/123/head will be a branch as well.
-	RefType GitRef_RefType `protobuf:"varint,6,opt,name=ref_type,json=refType,proto3,enum=gitserver.v1.GitRef_RefType" json:"ref_type,omitempty"`
-	// is_head is true if the reference is the HEAD reference.
-	IsHead bool `protobuf:"varint,7,opt,name=is_head,json=isHead,proto3" json:"is_head,omitempty"`
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// state_token is the state_token returned by a previous call. At most one
+	// of state_token and since may be set. If neither is set, all refs are
+	// returned.
+	StateToken string `protobuf:"bytes,3,opt,name=state_token,json=stateToken,proto3" json:"state_token,omitempty"`
+	// since only returns refs that moved after the given time.
+	Since *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
 }
 
-func (x *GitRef) Reset() {
-	*x = GitRef{}
+func (x *RefsChangedSinceRequest) Reset() {
+	*x = RefsChangedSinceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -454,13 +615,13 @@ func (x *GitRef) Reset() {
 	}
 }
 
-func (x *GitRef) String() string {
+func (x *RefsChangedSinceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitRef) ProtoMessage() {}
+func (*RefsChangedSinceRequest) ProtoMessage() {}
 
-func (x *GitRef) ProtoReflect() protoreflect.Message {
+func (x *RefsChangedSinceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,81 +633,51 @@ func (x *GitRef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitRef.ProtoReflect.Descriptor instead.
-func (*GitRef) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefsChangedSinceRequest.ProtoReflect.Descriptor instead.
+func (*RefsChangedSinceRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GitRef) GetRefName() string {
-	if x != nil {
-		return x.RefName
-	}
-	return ""
-}
-
-func (x *GitRef) GetShortRefName() string {
-	if x != nil {
-		return x.ShortRefName
-	}
-	return ""
-}
-
-func (x *GitRef) GetTargetCommit() string {
+func (x *RefsChangedSinceRequest) GetRepoName() string {
 	if x != nil {
-		return x.TargetCommit
+		return x.RepoName
 	}
 	return ""
 }
 
-func (x *GitRef) GetRefOid() string {
+func (x *RefsChangedSinceRequest) GetStateToken() string {
 	if x != nil {
-		return x.RefOid
+		return x.StateToken
 	}
 	return ""
 }
 
-func (x *GitRef) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RefsChangedSinceRequest) GetSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.Since
 	}
 	return nil
 }
 
-func (x *GitRef) GetRefType() GitRef_RefType {
-	if x != nil {
-		return x.RefType
-	}
-	return GitRef_REF_TYPE_UNSPECIFIED
-}
-
-func (x *GitRef) GetIsHead() bool {
-	if x != nil {
-		return x.IsHead
-	}
-	return false
-}
-
-type ResolveRevisionRequest struct {
+type RefsChangedSinceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repo_name is the name of the repo to run the blame operation in.
-	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
-	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	// The rev spec to resolve. I.e., HEAD, deadbeefdeadbeefdeadbeefdeadbeef, or
-	// refs/heads/main.
-	RevSpec []byte `protobuf:"bytes,3,opt,name=rev_spec,json=revSpec,proto3" json:"rev_spec,omitempty"`
-	// ensure_revision tries to ensure that the revision is present in the repo by
-	// triggering a git fetch if it is not present. It's not guaranteed that
-	// a revision exists in the repo after this call, if it really doesn't exist.
-	// This can slow down the response time significantly, only use it if you have
-	// good reason to do so.
-	EnsureRevision *bool `protobuf:"varint,4,opt,name=ensure_revision,json=ensureRevision,proto3,oneof" json:"ensure_revision,omitempty"`
+	// changes are the refs that moved. The changes may be split across
+	// multiple messages.
+	Changes []*RefChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// state_token identifies the current state of the refs. Only set in the
+	// first message.
+	StateToken string `protobuf:"bytes,2,opt,name=state_token,json=stateToken,proto3" json:"state_token,omitempty"`
+	// full is true if changes contains all refs instead of only the ones that
+	// moved, and refs missing from it were deleted. Only set in the first
+	// message.
+	Full bool `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
 }
 
-func (x *ResolveRevisionRequest) Reset() {
-	*x = ResolveRevisionRequest{}
+func (x *RefsChangedSinceResponse) Reset() {
+	*x = RefsChangedSinceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -554,13 +685,13 @@ func (x *ResolveRevisionRequest) Reset() {
 	}
 }
 
-func (x *ResolveRevisionRequest) String() string {
+func (x *RefsChangedSinceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveRevisionRequest) ProtoMessage() {}
+func (*RefsChangedSinceResponse) ProtoMessage() {}
 
-func (x *ResolveRevisionRequest) ProtoReflect() protoreflect.Message {
+func (x *RefsChangedSinceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -572,43 +703,43 @@ func (x *ResolveRevisionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveRevisionRequest.ProtoReflect.Descriptor instead.
-func (*ResolveRevisionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefsChangedSinceResponse.ProtoReflect.Descriptor instead.
+func (*RefsChangedSinceResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ResolveRevisionRequest) GetRepoName() string {
+func (x *RefsChangedSinceResponse) GetChanges() []*RefChange {
 	if x != nil {
-		return x.RepoName
+		return x.Changes
 	}
-	return ""
+	return nil
 }
 
-func (x *ResolveRevisionRequest) GetRevSpec() []byte {
+func (x *RefsChangedSinceResponse) GetStateToken() string {
 	if x != nil {
-		return x.RevSpec
+		return x.StateToken
 	}
-	return nil
+	return ""
 }
 
-func (x *ResolveRevisionRequest) GetEnsureRevision() bool {
-	if x != nil && x.EnsureRevision != nil {
-		return *x.EnsureRevision
+func (x *RefsChangedSinceResponse) GetFull() bool {
+	if x != nil {
+		return x.Full
 	}
 	return false
 }
 
-type ResolveRevisionResponse struct {
+type WatchRefsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// commit is the commit SHA of the resolved revision.
-	CommitSha string `protobuf:"bytes,1,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
 }
 
-func (x *ResolveRevisionResponse) Reset() {
-	*x = ResolveRevisionResponse{}
+func (x *WatchRefsRequest) Reset() {
+	*x = WatchRefsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -616,13 +747,13 @@ func (x *ResolveRevisionResponse) Reset() {
 	}
 }
 
-func (x *ResolveRevisionResponse) String() string {
+func (x *WatchRefsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveRevisionResponse) ProtoMessage() {}
+func (*WatchRefsRequest) ProtoMessage() {}
 
-func (x *ResolveRevisionResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchRefsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -634,38 +765,36 @@ func (x *ResolveRevisionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveRevisionResponse.ProtoReflect.Descriptor instead.
-func (*ResolveRevisionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchRefsRequest.ProtoReflect.Descriptor instead.
+func (*WatchRefsRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ResolveRevisionResponse) GetCommitSha() string {
+func (x *WatchRefsRequest) GetRepoName() string {
 	if x != nil {
-		return x.CommitSha
+		return x.RepoName
 	}
 	return ""
 }
 
-type RevAtTimeRequest struct {
+type WatchRefsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repo_name is the name of the repo to run the operation in.
-	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
-	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	// rev_spec is the starting revision for the search. We iterate
-	// through its --first-parent ancestors looking for the first ancestor
-	// that has a committer time before `time`.
-	//
-	// e.g., HEAD, deadbeefdeadbeefdeadbeefdeadbeef, or refs/heads/main.
-	RevSpec []byte `protobuf:"bytes,3,opt,name=rev_spec,json=revSpec,proto3" json:"rev_spec,omitempty"`
-	// time is the timestamp we are looking for commits before
-	Time *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	// changes are refs that moved.
+	Changes []*RefChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// state_token identifies the state of the refs after the update. Only set
+	// on the last message of an update.
+	StateToken string `protobuf:"bytes,2,opt,name=state_token,json=stateToken,proto3" json:"state_token,omitempty"`
+	// full is true if the update contains all refs instead of only the ones
+	// that moved, for example because the state of the refs was reset. Refs
+	// missing from it were deleted. Only set on the last message of an update.
+	Full bool `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
 }
 
-func (x *RevAtTimeRequest) Reset() {
-	*x = RevAtTimeRequest{}
+func (x *WatchRefsResponse) Reset() {
+	*x = WatchRefsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -673,13 +802,13 @@ func (x *RevAtTimeRequest) Reset() {
 	}
 }
 
-func (x *RevAtTimeRequest) String() string {
+func (x *WatchRefsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevAtTimeRequest) ProtoMessage() {}
+func (*WatchRefsResponse) ProtoMessage() {}
 
-func (x *RevAtTimeRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchRefsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -691,43 +820,43 @@ func (x *RevAtTimeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevAtTimeRequest.ProtoReflect.Descriptor instead.
-func (*RevAtTimeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchRefsResponse.ProtoReflect.Descriptor instead.
+func (*WatchRefsResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RevAtTimeRequest) GetRepoName() string {
+func (x *WatchRefsResponse) GetChanges() []*RefChange {
 	if x != nil {
-		return x.RepoName
+		return x.Changes
 	}
-	return ""
+	return nil
 }
 
-func (x *RevAtTimeRequest) GetRevSpec() []byte {
+func (x *WatchRefsResponse) GetStateToken() string {
 	if x != nil {
-		return x.RevSpec
+		return x.StateToken
 	}
-	return nil
+	return ""
 }
 
-func (x *RevAtTimeRequest) GetTime() *timestamppb.Timestamp {
+func (x *WatchRefsResponse) GetFull() bool {
 	if x != nil {
-		return x.Time
+		return x.Full
 	}
-	return nil
+	return false
 }
 
-type RevAtTimeResponse struct {
+type WatchDefaultBranchCommitsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// commit is the commit SHA of the resolved revision.
-	CommitSha string `protobuf:"bytes,1,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
 }
 
-func (x *RevAtTimeResponse) Reset() {
-	*x = RevAtTimeResponse{}
+func (x *WatchDefaultBranchCommitsRequest) Reset() {
+	*x = WatchDefaultBranchCommitsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -735,13 +864,13 @@ func (x *RevAtTimeResponse) Reset() {
 	}
 }
 
-func (x *RevAtTimeResponse) String() string {
+func (x *WatchDefaultBranchCommitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevAtTimeResponse) ProtoMessage() {}
+func (*WatchDefaultBranchCommitsRequest) ProtoMessage() {}
 
-func (x *RevAtTimeResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchDefaultBranchCommitsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -753,31 +882,37 @@ func (x *RevAtTimeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevAtTimeResponse.ProtoReflect.Descriptor instead.
-func (*RevAtTimeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchDefaultBranchCommitsRequest.ProtoReflect.Descriptor instead.
+func (*WatchDefaultBranchCommitsRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RevAtTimeResponse) GetCommitSha() string {
+func (x *WatchDefaultBranchCommitsRequest) GetRepoName() string {
 	if x != nil {
-		return x.CommitSha
+		return x.RepoName
 	}
 	return ""
 }
 
-type GetCommitRequest struct {
+type WatchDefaultBranchCommitsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repo_name is the name of the repo to run the blame operation in.
-	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
-	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	Commit   string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// commits are the commits that landed on the default branch, oldest first.
+	Commits []*BranchCommit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	// head_commit_sha is the commit the default branch points at after the
+	// update. Only set on the last message of an update.
+	HeadCommitSha string `protobuf:"bytes,2,opt,name=head_commit_sha,json=headCommitSha,proto3" json:"head_commit_sha,omitempty"`
+	// rewritten is true if the default branch moved to a commit that doesn't
+	// descend from the previous head, for example because of a force push.
+	// commits then only contains the commits not reachable from the previous
+	// head. Only set on the last message of an update.
+	Rewritten bool `protobuf:"varint,3,opt,name=rewritten,proto3" json:"rewritten,omitempty"`
 }
 
-func (x *GetCommitRequest) Reset() {
-	*x = GetCommitRequest{}
+func (x *WatchDefaultBranchCommitsResponse) Reset() {
+	*x = WatchDefaultBranchCommitsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -785,13 +920,13 @@ func (x *GetCommitRequest) Reset() {
 	}
 }
 
-func (x *GetCommitRequest) String() string {
+func (x *WatchDefaultBranchCommitsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommitRequest) ProtoMessage() {}
+func (*WatchDefaultBranchCommitsResponse) ProtoMessage() {}
 
-func (x *GetCommitRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchDefaultBranchCommitsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -803,35 +938,44 @@ func (x *GetCommitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommitRequest.ProtoReflect.Descriptor instead.
-func (*GetCommitRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchDefaultBranchCommitsResponse.ProtoReflect.Descriptor instead.
+func (*WatchDefaultBranchCommitsResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetCommitRequest) GetRepoName() string {
+func (x *WatchDefaultBranchCommitsResponse) GetCommits() []*BranchCommit {
 	if x != nil {
-		return x.RepoName
+		return x.Commits
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCommitRequest) GetCommit() string {
+func (x *WatchDefaultBranchCommitsResponse) GetHeadCommitSha() string {
 	if x != nil {
-		return x.Commit
+		return x.HeadCommitSha
 	}
 	return ""
 }
 
-type GetCommitResponse struct {
+func (x *WatchDefaultBranchCommitsResponse) GetRewritten() bool {
+	if x != nil {
+		return x.Rewritten
+	}
+	return false
+}
+
+type BranchCommit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Commit *GitCommit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	CommitSha string `protobuf:"bytes,1,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	// committed_at is the committer date of the commit.
+	CommittedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=committed_at,json=committedAt,proto3" json:"committed_at,omitempty"`
 }
 
-func (x *GetCommitResponse) Reset() {
-	*x = GetCommitResponse{}
+func (x *BranchCommit) Reset() {
+	*x = BranchCommit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -839,13 +983,13 @@ func (x *GetCommitResponse) Reset() {
 	}
 }
 
-func (x *GetCommitResponse) String() string {
+func (x *BranchCommit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommitResponse) ProtoMessage() {}
+func (*BranchCommit) ProtoMessage() {}
 
-func (x *GetCommitResponse) ProtoReflect() protoreflect.Message {
+func (x *BranchCommit) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -857,32 +1001,42 @@ func (x *GetCommitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommitResponse.ProtoReflect.Descriptor instead.
-func (*GetCommitResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BranchCommit.ProtoReflect.Descriptor instead.
+func (*BranchCommit) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetCommitResponse) GetCommit() *GitCommit {
+func (x *BranchCommit) GetCommitSha() string {
 	if x != nil {
-		return x.Commit
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *BranchCommit) GetCommittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommittedAt
 	}
 	return nil
 }
 
-type GitCommit struct {
+type RefChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Oid       string        `protobuf:"bytes,1,opt,name=oid,proto3" json:"oid,omitempty"`
-	Author    *GitSignature `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
-	Committer *GitSignature `protobuf:"bytes,3,opt,name=committer,proto3" json:"committer,omitempty"`
-	Message   []byte        `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	Parents   []string      `protobuf:"bytes,5,rep,name=parents,proto3" json:"parents,omitempty"`
+	// ref_name is the unabbreviated name of the reference, i.e., refs/heads/main.
+	RefName string `protobuf:"bytes,1,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
+	// target_commit is the commit the reference points at now. For a tag, this
+	// is the commit that the tag is attached to. Empty if the reference was
+	// deleted.
+	TargetCommit string `protobuf:"bytes,2,opt,name=target_commit,json=targetCommit,proto3" json:"target_commit,omitempty"`
+	// changed_at is when the move was recorded.
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
 }
 
-func (x *GitCommit) Reset() {
-	*x = GitCommit{}
+func (x *RefChange) Reset() {
+	*x = RefChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -890,13 +1044,13 @@ func (x *GitCommit) Reset() {
 	}
 }
 
-func (x *GitCommit) String() string {
+func (x *RefChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitCommit) ProtoMessage() {}
+func (*RefChange) ProtoMessage() {}
 
-func (x *GitCommit) ProtoReflect() protoreflect.Message {
+func (x *RefChange) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -908,58 +1062,61 @@ func (x *GitCommit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitCommit.ProtoReflect.Descriptor instead.
-func (*GitCommit) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefChange.ProtoReflect.Descriptor instead.
+func (*RefChange) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GitCommit) GetOid() string {
+func (x *RefChange) GetRefName() string {
 	if x != nil {
-		return x.Oid
+		return x.RefName
 	}
 	return ""
 }
 
-func (x *GitCommit) GetAuthor() *GitSignature {
-	if x != nil {
-		return x.Author
-	}
-	return nil
-}
-
-func (x *GitCommit) GetCommitter() *GitSignature {
-	if x != nil {
-		return x.Committer
-	}
-	return nil
-}
-
-func (x *GitCommit) GetMessage() []byte {
+func (x *RefChange) GetTargetCommit() string {
 	if x != nil {
-		return x.Message
+		return x.TargetCommit
 	}
-	return nil
+	return ""
 }
 
-func (x *GitCommit) GetParents() []string {
+func (x *RefChange) GetChangedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Parents
+		return x.ChangedAt
 	}
 	return nil
 }
 
-type GitSignature struct {
+type GitRef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  []byte                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email []byte                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Date  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// ref_name is the unabbreviated name of the reference, i.e., refs/heads/main, or refs/tags/1.0.
+	RefName string `protobuf:"bytes,1,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
+	// short_ref_name is the abbreviated name of the reference, if unambiguous.
+	// I.e., main, or 1.0.
+	ShortRefName string `protobuf:"bytes,2,opt,name=short_ref_name,json=shortRefName,proto3" json:"short_ref_name,omitempty"`
+	// target_commit is the hash of the commit the reference is currently pointing at.
+	// For a head reference, this is the commit the head is currently pointing at.
+	// For a tag, this is the commit that the tag is attached to.
+	TargetCommit string `protobuf:"bytes,3,opt,name=target_commit,json=targetCommit,proto3" json:"target_commit,omitempty"`
+	// ref_oid is the full object ID of the reference. For a head reference and
+	// a lightweight tag, this value is the same as target_commit. For annotated tags,
+	// it is the object ID of the tag.
+	RefOid string `protobuf:"bytes,4,opt,name=ref_oid,json=refOid,proto3" json:"ref_oid,omitempty"`
+	// created_at is the timestamp when the ref was created or modified last.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// ref_type is the type of the reference. Non-tag references will be of
+	// type branch. I.e., refs/pull/123/head will be a branch as well.
+	RefType GitRef_RefType `protobuf:"varint,6,opt,name=ref_type,json=refType,proto3,enum=gitserver.v1.GitRef_RefType" json:"ref_type,omitempty"`
+	// is_head is true if the reference is the HEAD reference.
+	IsHead bool `protobuf:"varint,7,opt,name=is_head,json=isHead,proto3" json:"is_head,omitempty"`
 }
 
-func (x *GitSignature) Reset() {
-	*x = GitSignature{}
+func (x *GitRef) Reset() {
+	*x = GitRef{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -967,13 +1124,13 @@ func (x *GitSignature) Reset() {
 	}
 }
 
-func (x *GitSignature) String() string {
+func (x *GitRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitSignature) ProtoMessage() {}
+func (*GitRef) ProtoMessage() {}
 
-func (x *GitSignature) ProtoReflect() protoreflect.Message {
+func (x *GitRef) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -985,33 +1142,61 @@ func (x *GitSignature) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitSignature.ProtoReflect.Descriptor instead.
-func (*GitSignature) Descriptor() ([]byte, []int) {
+// Deprecated: Use GitRef.ProtoReflect.Descriptor instead.
+func (*GitRef) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GitSignature) GetName() []byte {
+func (x *GitRef) GetRefName() string {
 	if x != nil {
-		return x.Name
+		return x.RefName
 	}
-	return nil
+	return ""
 }
 
-func (x *GitSignature) GetEmail() []byte {
+func (x *GitRef) GetShortRefName() string {
 	if x != nil {
-		return x.Email
+		return x.ShortRefName
 	}
-	return nil
+	return ""
 }
 
-func (x *GitSignature) GetDate() *timestamppb.Timestamp {
+func (x *GitRef) GetTargetCommit() string {
 	if x != nil {
-		return x.Date
+		return x.TargetCommit
+	}
+	return ""
+}
+
+func (x *GitRef) GetRefOid() string {
+	if x != nil {
+		return x.RefOid
+	}
+	return ""
+}
+
+func (x *GitRef) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type BlameRequest struct {
+func (x *GitRef) GetRefType() GitRef_RefType {
+	if x != nil {
+		return x.RefType
+	}
+	return GitRef_REF_TYPE_UNSPECIFIED
+}
+
+func (x *GitRef) GetIsHead() bool {
+	if x != nil {
+		return x.IsHead
+	}
+	return false
+}
+
+type ResolveRevisionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1019,15 +1204,19 @@ type BlameRequest struct {
 	// repo_name is the name of the repo to run the blame operation in.
 	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
 	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	// commit is the commit sha to start the blame operation at.
-	Commit           string      `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
-	Path             string      `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
-	IgnoreWhitespace bool        `protobuf:"varint,5,opt,name=ignore_whitespace,json=ignoreWhitespace,proto3" json:"ignore_whitespace,omitempty"`
-	Range            *BlameRange `protobuf:"bytes,8,opt,name=range,proto3,oneof" json:"range,omitempty"`
+	// The rev spec to resolve. I.e., HEAD, deadbeefdeadbeefdeadbeefdeadbeef, or
+	// refs/heads/main.
+	RevSpec []byte `protobuf:"bytes,3,opt,name=rev_spec,json=revSpec,proto3" json:"rev_spec,omitempty"`
+	// ensure_revision tries to ensure that the revision is present in the repo by
+	// triggering a git fetch if it is not present. It's not guaranteed that
+	// a revision exists in the repo after this call, if it really doesn't exist.
+	// This can slow down the response time significantly, only use it if you have
+	// good reason to do so.
+	EnsureRevision *bool `protobuf:"varint,4,opt,name=ensure_revision,json=ensureRevision,proto3,oneof" json:"ensure_revision,omitempty"`
 }
 
-func (x *BlameRequest) Reset() {
-	*x = BlameRequest{}
+func (x *ResolveRevisionRequest) Reset() {
+	*x = ResolveRevisionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1035,13 +1224,13 @@ func (x *BlameRequest) Reset() {
 	}
 }
 
-func (x *BlameRequest) String() string {
+func (x *ResolveRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlameRequest) ProtoMessage() {}
+func (*ResolveRevisionRequest) ProtoMessage() {}
 
-func (x *BlameRequest) ProtoReflect() protoreflect.Message {
+func (x *ResolveRevisionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1053,57 +1242,43 @@ func (x *BlameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlameRequest.ProtoReflect.Descriptor instead.
-func (*BlameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolveRevisionRequest.ProtoReflect.Descriptor instead.
+func (*ResolveRevisionRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BlameRequest) GetRepoName() string {
+func (x *ResolveRevisionRequest) GetRepoName() string {
 	if x != nil {
 		return x.RepoName
 	}
 	return ""
 }
 
-func (x *BlameRequest) GetCommit() string {
-	if x != nil {
-		return x.Commit
-	}
-	return ""
-}
-
-func (x *BlameRequest) GetPath() string {
+func (x *ResolveRevisionRequest) GetRevSpec() []byte {
 	if x != nil {
-		return x.Path
+		return x.RevSpec
 	}
-	return ""
+	return nil
 }
 
-func (x *BlameRequest) GetIgnoreWhitespace() bool {
-	if x != nil {
-		return x.IgnoreWhitespace
+func (x *ResolveRevisionRequest) GetEnsureRevision() bool {
+	if x != nil && x.EnsureRevision != nil {
+		return *x.EnsureRevision
 	}
 	return false
 }
 
-func (x *BlameRequest) GetRange() *BlameRange {
-	if x != nil {
-		return x.Range
-	}
-	return nil
-}
-
-type BlameRange struct {
+type ResolveRevisionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StartLine uint32 `protobuf:"varint,1,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`
-	EndLine   uint32 `protobuf:"varint,2,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`
+	// commit is the commit SHA of the resolved revision.
+	CommitSha string `protobuf:"bytes,1,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
 }
 
-func (x *BlameRange) Reset() {
-	*x = BlameRange{}
+func (x *ResolveRevisionResponse) Reset() {
+	*x = ResolveRevisionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1111,13 +1286,13 @@ func (x *BlameRange) Reset() {
 	}
 }
 
-func (x *BlameRange) String() string {
+func (x *ResolveRevisionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlameRange) ProtoMessage() {}
+func (*ResolveRevisionResponse) ProtoMessage() {}
 
-func (x *BlameRange) ProtoReflect() protoreflect.Message {
+func (x *ResolveRevisionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1129,35 +1304,38 @@ func (x *BlameRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlameRange.ProtoReflect.Descriptor instead.
-func (*BlameRange) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolveRevisionResponse.ProtoReflect.Descriptor instead.
+func (*ResolveRevisionResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BlameRange) GetStartLine() uint32 {
-	if x != nil {
-		return x.StartLine
-	}
-	return 0
-}
-
-func (x *BlameRange) GetEndLine() uint32 {
+func (x *ResolveRevisionResponse) GetCommitSha() string {
 	if x != nil {
-		return x.EndLine
+		return x.CommitSha
 	}
-	return 0
+	return ""
 }
 
-type BlameResponse struct {
+type RevAtTimeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hunk *BlameHunk `protobuf:"bytes,1,opt,name=hunk,proto3" json:"hunk,omitempty"`
+	// repo_name is the name of the repo to run the operation in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// rev_spec is the starting revision for the search. We iterate
+	// through its --first-parent ancestors looking for the first ancestor
+	// that has a committer time before `time`.
+	//
+	// e.g., HEAD, deadbeefdeadbeefdeadbeefdeadbeef, or refs/heads/main.
+	RevSpec []byte `protobuf:"bytes,3,opt,name=rev_spec,json=revSpec,proto3" json:"rev_spec,omitempty"`
+	// time is the timestamp we are looking for commits before
+	Time *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
 }
 
-func (x *BlameResponse) Reset() {
-	*x = BlameResponse{}
+func (x *RevAtTimeRequest) Reset() {
+	*x = RevAtTimeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1165,13 +1343,13 @@ func (x *BlameResponse) Reset() {
 	}
 }
 
-func (x *BlameResponse) String() string {
+func (x *RevAtTimeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlameResponse) ProtoMessage() {}
+func (*RevAtTimeRequest) ProtoMessage() {}
 
-func (x *BlameResponse) ProtoReflect() protoreflect.Message {
+func (x *RevAtTimeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1183,36 +1361,43 @@ func (x *BlameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlameResponse.ProtoReflect.Descriptor instead.
-func (*BlameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevAtTimeRequest.ProtoReflect.Descriptor instead.
+func (*RevAtTimeRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *BlameResponse) GetHunk() *BlameHunk {
+func (x *RevAtTimeRequest) GetRepoName() string {
 	if x != nil {
-		return x.Hunk
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *RevAtTimeRequest) GetRevSpec() []byte {
+	if x != nil {
+		return x.RevSpec
 	}
 	return nil
 }
 
-type BlameHunk struct {
+func (x *RevAtTimeRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type RevAtTimeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StartLine      uint32          `protobuf:"varint,1,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`
-	EndLine        uint32          `protobuf:"varint,2,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`
-	StartByte      uint32          `protobuf:"varint,3,opt,name=start_byte,json=startByte,proto3" json:"start_byte,omitempty"`
-	EndByte        uint32          `protobuf:"varint,4,opt,name=end_byte,json=endByte,proto3" json:"end_byte,omitempty"`
-	Commit         string          `protobuf:"bytes,5,opt,name=commit,proto3" json:"commit,omitempty"`
-	Author         *BlameAuthor    `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
-	Message        string          `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
-	Filename       string          `protobuf:"bytes,8,opt,name=filename,proto3" json:"filename,omitempty"`
-	PreviousCommit *PreviousCommit `protobuf:"bytes,9,opt,name=previous_commit,json=previousCommit,proto3,oneof" json:"previous_commit,omitempty"`
+	// commit is the commit SHA of the resolved revision.
+	CommitSha string `protobuf:"bytes,1,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
 }
 
-func (x *BlameHunk) Reset() {
-	*x = BlameHunk{}
+func (x *RevAtTimeResponse) Reset() {
+	*x = RevAtTimeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1220,13 +1405,13 @@ func (x *BlameHunk) Reset() {
 	}
 }
 
-func (x *BlameHunk) String() string {
+func (x *RevAtTimeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlameHunk) ProtoMessage() {}
+func (*RevAtTimeResponse) ProtoMessage() {}
 
-func (x *BlameHunk) ProtoReflect() protoreflect.Message {
+func (x *RevAtTimeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1238,86 +1423,37 @@ func (x *BlameHunk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlameHunk.ProtoReflect.Descriptor instead.
-func (*BlameHunk) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevAtTimeResponse.ProtoReflect.Descriptor instead.
+func (*RevAtTimeResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *BlameHunk) GetStartLine() uint32 {
-	if x != nil {
-		return x.StartLine
-	}
-	return 0
-}
-
-func (x *BlameHunk) GetEndLine() uint32 {
-	if x != nil {
-		return x.EndLine
-	}
-	return 0
-}
-
-func (x *BlameHunk) GetStartByte() uint32 {
-	if x != nil {
-		return x.StartByte
-	}
-	return 0
-}
-
-func (x *BlameHunk) GetEndByte() uint32 {
-	if x != nil {
-		return x.EndByte
-	}
-	return 0
-}
-
-func (x *BlameHunk) GetCommit() string {
-	if x != nil {
-		return x.Commit
-	}
-	return ""
-}
-
-func (x *BlameHunk) GetAuthor() *BlameAuthor {
-	if x != nil {
-		return x.Author
-	}
-	return nil
-}
-
-func (x *BlameHunk) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *BlameHunk) GetFilename() string {
+func (x *RevAtTimeResponse) GetCommitSha() string {
 	if x != nil {
-		return x.Filename
+		return x.CommitSha
 	}
 	return ""
 }
 
-func (x *BlameHunk) GetPreviousCommit() *PreviousCommit {
-	if x != nil {
-		return x.PreviousCommit
-	}
-	return nil
-}
-
-type BlameAuthor struct {
+type GetCommitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Date  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// repo_name is the name of the repo to run the blame operation in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	Commit   string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// include_stat requests the number of lines added and deleted per file,
+	// compared to the first parent of the commit.
+	IncludeStat bool `protobuf:"varint,4,opt,name=include_stat,json=includeStat,proto3" json:"include_stat,omitempty"`
+	// include_patch requests the patch of the commit, compared to its first
+	// parent.
+	IncludePatch bool `protobuf:"varint,5,opt,name=include_patch,json=includePatch,proto3" json:"include_patch,omitempty"`
 }
 
-func (x *BlameAuthor) Reset() {
-	*x = BlameAuthor{}
+func (x *GetCommitRequest) Reset() {
+	*x = GetCommitRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1325,13 +1461,13 @@ func (x *BlameAuthor) Reset() {
 	}
 }
 
-func (x *BlameAuthor) String() string {
+func (x *GetCommitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlameAuthor) ProtoMessage() {}
+func (*GetCommitRequest) ProtoMessage() {}
 
-func (x *BlameAuthor) ProtoReflect() protoreflect.Message {
+func (x *GetCommitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1343,43 +1479,56 @@ func (x *BlameAuthor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlameAuthor.ProtoReflect.Descriptor instead.
-func (*BlameAuthor) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCommitRequest.ProtoReflect.Descriptor instead.
+func (*GetCommitRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *BlameAuthor) GetName() string {
+func (x *GetCommitRequest) GetRepoName() string {
 	if x != nil {
-		return x.Name
+		return x.RepoName
 	}
 	return ""
 }
 
-func (x *BlameAuthor) GetEmail() string {
+func (x *GetCommitRequest) GetCommit() string {
 	if x != nil {
-		return x.Email
+		return x.Commit
 	}
 	return ""
 }
 
-func (x *BlameAuthor) GetDate() *timestamppb.Timestamp {
+func (x *GetCommitRequest) GetIncludeStat() bool {
 	if x != nil {
-		return x.Date
+		return x.IncludeStat
 	}
-	return nil
+	return false
 }
 
-type PreviousCommit struct {
+func (x *GetCommitRequest) GetIncludePatch() bool {
+	if x != nil {
+		return x.IncludePatch
+	}
+	return false
+}
+
+type GetCommitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Commit   string `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
-	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Commit *GitCommit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// stats is only set if include_stat was requested. If sub-repo permissions
+	// are enabled for the repo, files the actor cannot read are omitted.
+	Stats []*FileDiffStat `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	// patch is only set if include_patch was requested. If sub-repo
+	// permissions are enabled for the repo, files the actor cannot read are
+	// omitted.
+	Patch []byte `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
 }
 
-func (x *PreviousCommit) Reset() {
-	*x = PreviousCommit{}
+func (x *GetCommitResponse) Reset() {
+	*x = GetCommitResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1387,13 +1536,13 @@ func (x *PreviousCommit) Reset() {
 	}
 }
 
-func (x *PreviousCommit) String() string {
+func (x *GetCommitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PreviousCommit) ProtoMessage() {}
+func (*GetCommitResponse) ProtoMessage() {}
 
-func (x *PreviousCommit) ProtoReflect() protoreflect.Message {
+func (x *GetCommitResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1405,38 +1554,46 @@ func (x *PreviousCommit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PreviousCommit.ProtoReflect.Descriptor instead.
-func (*PreviousCommit) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCommitResponse.ProtoReflect.Descriptor instead.
+func (*GetCommitResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PreviousCommit) GetCommit() string {
+func (x *GetCommitResponse) GetCommit() *GitCommit {
 	if x != nil {
 		return x.Commit
 	}
-	return ""
+	return nil
 }
 
-func (x *PreviousCommit) GetFilename() string {
+func (x *GetCommitResponse) GetStats() []*FileDiffStat {
 	if x != nil {
-		return x.Filename
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
-type DefaultBranchRequest struct {
+func (x *GetCommitResponse) GetPatch() []byte {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+type FileDiffStat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repo_name is the name of the repo to get the default branch for.
-	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
-	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	ShortRef bool   `protobuf:"varint,3,opt,name=short_ref,json=shortRef,proto3" json:"short_ref,omitempty"`
+	Path    []byte `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Added   uint32 `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+	Deleted uint32 `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// binary is true for binary files, which have no line counts.
+	Binary bool `protobuf:"varint,4,opt,name=binary,proto3" json:"binary,omitempty"`
 }
 
-func (x *DefaultBranchRequest) Reset() {
-	*x = DefaultBranchRequest{}
+func (x *FileDiffStat) Reset() {
+	*x = FileDiffStat{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1444,13 +1601,13 @@ func (x *DefaultBranchRequest) Reset() {
 	}
 }
 
-func (x *DefaultBranchRequest) String() string {
+func (x *FileDiffStat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefaultBranchRequest) ProtoMessage() {}
+func (*FileDiffStat) ProtoMessage() {}
 
-func (x *DefaultBranchRequest) ProtoReflect() protoreflect.Message {
+func (x *FileDiffStat) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1462,36 +1619,53 @@ func (x *DefaultBranchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefaultBranchRequest.ProtoReflect.Descriptor instead.
-func (*DefaultBranchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FileDiffStat.ProtoReflect.Descriptor instead.
+func (*FileDiffStat) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DefaultBranchRequest) GetRepoName() string {
+func (x *FileDiffStat) GetPath() []byte {
 	if x != nil {
-		return x.RepoName
+		return x.Path
 	}
-	return ""
+	return nil
 }
 
-func (x *DefaultBranchRequest) GetShortRef() bool {
+func (x *FileDiffStat) GetAdded() uint32 {
 	if x != nil {
-		return x.ShortRef
+		return x.Added
+	}
+	return 0
+}
+
+func (x *FileDiffStat) GetDeleted() uint32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *FileDiffStat) GetBinary() bool {
+	if x != nil {
+		return x.Binary
 	}
 	return false
 }
 
-type DefaultBranchResponse struct {
+type GitCommit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RefName string `protobuf:"bytes,1,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
-	Commit  string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Oid       string        `protobuf:"bytes,1,opt,name=oid,proto3" json:"oid,omitempty"`
+	Author    *GitSignature `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Committer *GitSignature `protobuf:"bytes,3,opt,name=committer,proto3" json:"committer,omitempty"`
+	Message   []byte        `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Parents   []string      `protobuf:"bytes,5,rep,name=parents,proto3" json:"parents,omitempty"`
 }
 
-func (x *DefaultBranchResponse) Reset() {
-	*x = DefaultBranchResponse{}
+func (x *GitCommit) Reset() {
+	*x = GitCommit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1499,13 +1673,13 @@ func (x *DefaultBranchResponse) Reset() {
 	}
 }
 
-func (x *DefaultBranchResponse) String() string {
+func (x *GitCommit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefaultBranchResponse) ProtoMessage() {}
+func (*GitCommit) ProtoMessage() {}
 
-func (x *DefaultBranchResponse) ProtoReflect() protoreflect.Message {
+func (x *GitCommit) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1517,39 +1691,58 @@ func (x *DefaultBranchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefaultBranchResponse.ProtoReflect.Descriptor instead.
-func (*DefaultBranchResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GitCommit.ProtoReflect.Descriptor instead.
+func (*GitCommit) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DefaultBranchResponse) GetRefName() string {
+func (x *GitCommit) GetOid() string {
 	if x != nil {
-		return x.RefName
+		return x.Oid
 	}
 	return ""
 }
 
-func (x *DefaultBranchResponse) GetCommit() string {
+func (x *GitCommit) GetAuthor() *GitSignature {
 	if x != nil {
-		return x.Commit
+		return x.Author
 	}
-	return ""
+	return nil
 }
 
-type ReadFileRequest struct {
+func (x *GitCommit) GetCommitter() *GitSignature {
+	if x != nil {
+		return x.Committer
+	}
+	return nil
+}
+
+func (x *GitCommit) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *GitCommit) GetParents() []string {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+type GitSignature struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repo_name is the name of the repo to get the default branch for.
-	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
-	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	Commit   string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
-	Path     string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Name  []byte                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email []byte                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Date  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (x *ReadFileRequest) Reset() {
-	*x = ReadFileRequest{}
+func (x *GitSignature) Reset() {
+	*x = GitSignature{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1557,13 +1750,13 @@ func (x *ReadFileRequest) Reset() {
 	}
 }
 
-func (x *ReadFileRequest) String() string {
+func (x *GitSignature) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadFileRequest) ProtoMessage() {}
+func (*GitSignature) ProtoMessage() {}
 
-func (x *ReadFileRequest) ProtoReflect() protoreflect.Message {
+func (x *GitSignature) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1575,42 +1768,52 @@ func (x *ReadFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadFileRequest.ProtoReflect.Descriptor instead.
-func (*ReadFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GitSignature.ProtoReflect.Descriptor instead.
+func (*GitSignature) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ReadFileRequest) GetRepoName() string {
+func (x *GitSignature) GetName() []byte {
 	if x != nil {
-		return x.RepoName
+		return x.Name
 	}
-	return ""
+	return nil
 }
 
-func (x *ReadFileRequest) GetCommit() string {
+func (x *GitSignature) GetEmail() []byte {
 	if x != nil {
-		return x.Commit
+		return x.Email
 	}
-	return ""
+	return nil
 }
 
-func (x *ReadFileRequest) GetPath() string {
+func (x *GitSignature) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Path
+		return x.Date
 	}
-	return ""
+	return nil
 }
 
-type ReadFileResponse struct {
+type BlameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// repo_name is the name of the repo to run the blame operation in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// commit is the commit sha to start the blame operation at.
+	Commit           string      `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	Path             string      `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	IgnoreWhitespace bool        `protobuf:"varint,5,opt,name=ignore_whitespace,json=ignoreWhitespace,proto3" json:"ignore_whitespace,omitempty"`
+	Range            *BlameRange `protobuf:"bytes,8,opt,name=range,proto3,oneof" json:"range,omitempty"`
+	// copy_detection controls whether lines that were moved or copied are
+	// attributed to the commit that originally introduced them.
+	CopyDetection BlameCopyDetection `protobuf:"varint,9,opt,name=copy_detection,json=copyDetection,proto3,enum=gitserver.v1.BlameCopyDetection" json:"copy_detection,omitempty"`
 }
 
-func (x *ReadFileResponse) Reset() {
-	*x = ReadFileResponse{}
+func (x *BlameRequest) Reset() {
+	*x = BlameRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1618,13 +1821,13 @@ func (x *ReadFileResponse) Reset() {
 	}
 }
 
-func (x *ReadFileResponse) String() string {
+func (x *BlameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadFileResponse) ProtoMessage() {}
+func (*BlameRequest) ProtoMessage() {}
 
-func (x *ReadFileResponse) ProtoReflect() protoreflect.Message {
+func (x *BlameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1636,27 +1839,64 @@ func (x *ReadFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadFileResponse.ProtoReflect.Descriptor instead.
-func (*ReadFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlameRequest.ProtoReflect.Descriptor instead.
+func (*BlameRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ReadFileResponse) GetData() []byte {
+func (x *BlameRequest) GetRepoName() string {
 	if x != nil {
-		return x.Data
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *BlameRequest) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *BlameRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *BlameRequest) GetIgnoreWhitespace() bool {
+	if x != nil {
+		return x.IgnoreWhitespace
+	}
+	return false
+}
+
+func (x *BlameRequest) GetRange() *BlameRange {
+	if x != nil {
+		return x.Range
 	}
 	return nil
 }
 
-// DiskInfoRequest is a empty request for the DiskInfo RPC.
-type DiskInfoRequest struct {
+func (x *BlameRequest) GetCopyDetection() BlameCopyDetection {
+	if x != nil {
+		return x.CopyDetection
+	}
+	return BlameCopyDetection_BLAME_COPY_DETECTION_UNSPECIFIED
+}
+
+type BlameRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StartLine uint32 `protobuf:"varint,1,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`
+	EndLine   uint32 `protobuf:"varint,2,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`
 }
 
-func (x *DiskInfoRequest) Reset() {
-	*x = DiskInfoRequest{}
+func (x *BlameRange) Reset() {
+	*x = BlameRange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1664,13 +1904,13 @@ func (x *DiskInfoRequest) Reset() {
 	}
 }
 
-func (x *DiskInfoRequest) String() string {
+func (x *BlameRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskInfoRequest) ProtoMessage() {}
+func (*BlameRange) ProtoMessage() {}
 
-func (x *DiskInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *BlameRange) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1682,27 +1922,35 @@ func (x *DiskInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskInfoRequest.ProtoReflect.Descriptor instead.
-func (*DiskInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlameRange.ProtoReflect.Descriptor instead.
+func (*BlameRange) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{21}
 }
 
-// DiskInfoResponse contains the results of the DiskInfo RPC request.
-type DiskInfoResponse struct {
+func (x *BlameRange) GetStartLine() uint32 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *BlameRange) GetEndLine() uint32 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
+type BlameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// free_space is the amount of space avaialable on a gitserver instance.
-	FreeSpace uint64 `protobuf:"varint,1,opt,name=free_space,json=freeSpace,proto3" json:"free_space,omitempty"`
-	// total_space is the total amount of space on a gitserver instance.
-	TotalSpace uint64 `protobuf:"varint,2,opt,name=total_space,json=totalSpace,proto3" json:"total_space,omitempty"`
-	// percent_used is the percent of disk space used on a gitserver instance.
-	PercentUsed float32 `protobuf:"fixed32,3,opt,name=percent_used,json=percentUsed,proto3" json:"percent_used,omitempty"`
+	Hunk *BlameHunk `protobuf:"bytes,1,opt,name=hunk,proto3" json:"hunk,omitempty"`
 }
 
-func (x *DiskInfoResponse) Reset() {
-	*x = DiskInfoResponse{}
+func (x *BlameResponse) Reset() {
+	*x = BlameResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1710,13 +1958,13 @@ func (x *DiskInfoResponse) Reset() {
 	}
 }
 
-func (x *DiskInfoResponse) String() string {
+func (x *BlameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskInfoResponse) ProtoMessage() {}
+func (*BlameResponse) ProtoMessage() {}
 
-func (x *DiskInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *BlameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1728,53 +1976,36 @@ func (x *DiskInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskInfoResponse.ProtoReflect.Descriptor instead.
-func (*DiskInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlameResponse.ProtoReflect.Descriptor instead.
+func (*BlameResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DiskInfoResponse) GetFreeSpace() uint64 {
-	if x != nil {
-		return x.FreeSpace
-	}
-	return 0
-}
-
-func (x *DiskInfoResponse) GetTotalSpace() uint64 {
-	if x != nil {
-		return x.TotalSpace
-	}
-	return 0
-}
-
-func (x *DiskInfoResponse) GetPercentUsed() float32 {
+func (x *BlameResponse) GetHunk() *BlameHunk {
 	if x != nil {
-		return x.PercentUsed
+		return x.Hunk
 	}
-	return 0
+	return nil
 }
 
-type PatchCommitInfo struct {
+type BlameHunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// messages are the commit messages to be used for the commit
-	Messages []string `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	// author_name is the name of the author to be used for the commit
-	AuthorName string `protobuf:"bytes,2,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
-	// author_email is the email of the author to be used for the commit
-	AuthorEmail string `protobuf:"bytes,3,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
-	// committer_name is the name of the committer to be used for the commit
-	CommitterName string `protobuf:"bytes,4,opt,name=committer_name,json=committerName,proto3" json:"committer_name,omitempty"`
-	// committer_email is the email of the committer to be used for the commit
-	CommitterEmail string `protobuf:"bytes,5,opt,name=committer_email,json=committerEmail,proto3" json:"committer_email,omitempty"`
-	// author_date is the date of the author to be used for the commit
-	Date *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	StartLine      uint32          `protobuf:"varint,1,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`
+	EndLine        uint32          `protobuf:"varint,2,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`
+	StartByte      uint32          `protobuf:"varint,3,opt,name=start_byte,json=startByte,proto3" json:"start_byte,omitempty"`
+	EndByte        uint32          `protobuf:"varint,4,opt,name=end_byte,json=endByte,proto3" json:"end_byte,omitempty"`
+	Commit         string          `protobuf:"bytes,5,opt,name=commit,proto3" json:"commit,omitempty"`
+	Author         *BlameAuthor    `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	Message        string          `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Filename       string          `protobuf:"bytes,8,opt,name=filename,proto3" json:"filename,omitempty"`
+	PreviousCommit *PreviousCommit `protobuf:"bytes,9,opt,name=previous_commit,json=previousCommit,proto3,oneof" json:"previous_commit,omitempty"`
 }
 
-func (x *PatchCommitInfo) Reset() {
-	*x = PatchCommitInfo{}
+func (x *BlameHunk) Reset() {
+	*x = BlameHunk{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1782,13 +2013,13 @@ func (x *PatchCommitInfo) Reset() {
 	}
 }
 
-func (x *PatchCommitInfo) String() string {
+func (x *BlameHunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchCommitInfo) ProtoMessage() {}
+func (*BlameHunk) ProtoMessage() {}
 
-func (x *PatchCommitInfo) ProtoReflect() protoreflect.Message {
+func (x *BlameHunk) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1800,73 +2031,86 @@ func (x *PatchCommitInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchCommitInfo.ProtoReflect.Descriptor instead.
-func (*PatchCommitInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlameHunk.ProtoReflect.Descriptor instead.
+func (*BlameHunk) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *PatchCommitInfo) GetMessages() []string {
+func (x *BlameHunk) GetStartLine() uint32 {
 	if x != nil {
-		return x.Messages
+		return x.StartLine
 	}
-	return nil
+	return 0
 }
 
-func (x *PatchCommitInfo) GetAuthorName() string {
+func (x *BlameHunk) GetEndLine() uint32 {
 	if x != nil {
-		return x.AuthorName
+		return x.EndLine
 	}
-	return ""
+	return 0
 }
 
-func (x *PatchCommitInfo) GetAuthorEmail() string {
+func (x *BlameHunk) GetStartByte() uint32 {
 	if x != nil {
-		return x.AuthorEmail
+		return x.StartByte
+	}
+	return 0
+}
+
+func (x *BlameHunk) GetEndByte() uint32 {
+	if x != nil {
+		return x.EndByte
+	}
+	return 0
+}
+
+func (x *BlameHunk) GetCommit() string {
+	if x != nil {
+		return x.Commit
 	}
 	return ""
 }
 
-func (x *PatchCommitInfo) GetCommitterName() string {
+func (x *BlameHunk) GetAuthor() *BlameAuthor {
 	if x != nil {
-		return x.CommitterName
+		return x.Author
+	}
+	return nil
+}
+
+func (x *BlameHunk) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *PatchCommitInfo) GetCommitterEmail() string {
+func (x *BlameHunk) GetFilename() string {
 	if x != nil {
-		return x.CommitterEmail
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *PatchCommitInfo) GetDate() *timestamppb.Timestamp {
+func (x *BlameHunk) GetPreviousCommit() *PreviousCommit {
 	if x != nil {
-		return x.Date
+		return x.PreviousCommit
 	}
 	return nil
 }
 
-type PushConfig struct {
+type BlameAuthor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// remote_url is the git remote URL to which to push the commits.
-	// The URL needs to include HTTP basic auth credentials if no
-	// unauthenticated requests are allowed by the remote host.
-	RemoteUrl string `protobuf:"bytes,1,opt,name=remote_url,json=remoteUrl,proto3" json:"remote_url,omitempty"`
-	// private_key is used when the remote URL uses scheme `ssh`. If set,
-	// this value is used as the content of the private key. Needs to be
-	// set in conjunction with a passphrase.
-	PrivateKey string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	// passphrase is the passphrase to decrypt the private key. It is required
-	// when passing PrivateKey.
-	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Date  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (x *PushConfig) Reset() {
-	*x = PushConfig{}
+func (x *BlameAuthor) Reset() {
+	*x = BlameAuthor{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1874,13 +2118,13 @@ func (x *PushConfig) Reset() {
 	}
 }
 
-func (x *PushConfig) String() string {
+func (x *BlameAuthor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushConfig) ProtoMessage() {}
+func (*BlameAuthor) ProtoMessage() {}
 
-func (x *PushConfig) ProtoReflect() protoreflect.Message {
+func (x *BlameAuthor) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1892,48 +2136,49 @@ func (x *PushConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushConfig.ProtoReflect.Descriptor instead.
-func (*PushConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlameAuthor.ProtoReflect.Descriptor instead.
+func (*BlameAuthor) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *PushConfig) GetRemoteUrl() string {
+func (x *BlameAuthor) GetName() string {
 	if x != nil {
-		return x.RemoteUrl
+		return x.Name
 	}
 	return ""
 }
 
-func (x *PushConfig) GetPrivateKey() string {
+func (x *BlameAuthor) GetEmail() string {
 	if x != nil {
-		return x.PrivateKey
+		return x.Email
 	}
 	return ""
 }
 
-func (x *PushConfig) GetPassphrase() string {
+func (x *BlameAuthor) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Passphrase
+		return x.Date
 	}
-	return ""
+	return nil
 }
 
-// CreateCommitFromPatchBinaryRequest is the request information needed for
-// creating the simulated staging area git object for a repo.
-type CreateCommitFromPatchBinaryRequest struct {
+type BlameSummaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Payload:
-	//
-	//	*CreateCommitFromPatchBinaryRequest_Metadata_
-	//	*CreateCommitFromPatchBinaryRequest_Patch_
-	Payload isCreateCommitFromPatchBinaryRequest_Payload `protobuf_oneof:"payload"`
+	// repo_name is the name of the repo to run the blame operations in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// commit is the commit sha to run the blame operations at.
+	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// path is the file or directory to summarize. If empty, the whole repository
+	// is summarized.
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *CreateCommitFromPatchBinaryRequest) Reset() {
-	*x = CreateCommitFromPatchBinaryRequest{}
+func (x *BlameSummaryRequest) Reset() {
+	*x = BlameSummaryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1941,13 +2186,13 @@ func (x *CreateCommitFromPatchBinaryRequest) Reset() {
 	}
 }
 
-func (x *CreateCommitFromPatchBinaryRequest) String() string {
+func (x *BlameSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommitFromPatchBinaryRequest) ProtoMessage() {}
+func (*BlameSummaryRequest) ProtoMessage() {}
 
-func (x *CreateCommitFromPatchBinaryRequest) ProtoReflect() protoreflect.Message {
+func (x *BlameSummaryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1959,65 +2204,43 @@ func (x *CreateCommitFromPatchBinaryRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommitFromPatchBinaryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCommitFromPatchBinaryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlameSummaryRequest.ProtoReflect.Descriptor instead.
+func (*BlameSummaryRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{25}
 }
 
-func (m *CreateCommitFromPatchBinaryRequest) GetPayload() isCreateCommitFromPatchBinaryRequest_Payload {
-	if m != nil {
-		return m.Payload
+func (x *BlameSummaryRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateCommitFromPatchBinaryRequest) GetMetadata() *CreateCommitFromPatchBinaryRequest_Metadata {
-	if x, ok := x.GetPayload().(*CreateCommitFromPatchBinaryRequest_Metadata_); ok {
-		return x.Metadata
+func (x *BlameSummaryRequest) GetCommit() string {
+	if x != nil {
+		return x.Commit
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateCommitFromPatchBinaryRequest) GetPatch() *CreateCommitFromPatchBinaryRequest_Patch {
-	if x, ok := x.GetPayload().(*CreateCommitFromPatchBinaryRequest_Patch_); ok {
-		return x.Patch
+func (x *BlameSummaryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
-	return nil
-}
-
-type isCreateCommitFromPatchBinaryRequest_Payload interface {
-	isCreateCommitFromPatchBinaryRequest_Payload()
-}
-
-type CreateCommitFromPatchBinaryRequest_Metadata_ struct {
-	Metadata *CreateCommitFromPatchBinaryRequest_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
-}
-
-type CreateCommitFromPatchBinaryRequest_Patch_ struct {
-	Patch *CreateCommitFromPatchBinaryRequest_Patch `protobuf:"bytes,2,opt,name=patch,proto3,oneof"`
+	return ""
 }
 
-func (*CreateCommitFromPatchBinaryRequest_Metadata_) isCreateCommitFromPatchBinaryRequest_Payload() {}
-
-func (*CreateCommitFromPatchBinaryRequest_Patch_) isCreateCommitFromPatchBinaryRequest_Payload() {}
-
-type CreateCommitFromPatchError struct {
+type BlameSummaryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repository_name is the name of the repository that the error occurred on
-	RepositoryName string `protobuf:"bytes,1,opt,name=repository_name,json=repositoryName,proto3" json:"repository_name,omitempty"`
-	// internal_error is the error that occurred on the server
-	InternalError string `protobuf:"bytes,2,opt,name=internal_error,json=internalError,proto3" json:"internal_error,omitempty"`
-	// command is the git command that was attempted
-	Command string `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
-	// combined_output is the combined stderr and stdout from running the command
-	CombinedOutput string `protobuf:"bytes,4,opt,name=combined_output,json=combinedOutput,proto3" json:"combined_output,omitempty"`
+	// authors are ordered by line count, descending.
+	Authors []*BlameAuthorSummary `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
 }
 
-func (x *CreateCommitFromPatchError) Reset() {
-	*x = CreateCommitFromPatchError{}
+func (x *BlameSummaryResponse) Reset() {
+	*x = BlameSummaryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2025,13 +2248,13 @@ func (x *CreateCommitFromPatchError) Reset() {
 	}
 }
 
-func (x *CreateCommitFromPatchError) String() string {
+func (x *BlameSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommitFromPatchError) ProtoMessage() {}
+func (*BlameSummaryResponse) ProtoMessage() {}
 
-func (x *CreateCommitFromPatchError) ProtoReflect() protoreflect.Message {
+func (x *BlameSummaryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2043,54 +2266,33 @@ func (x *CreateCommitFromPatchError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommitFromPatchError.ProtoReflect.Descriptor instead.
-func (*CreateCommitFromPatchError) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlameSummaryResponse.ProtoReflect.Descriptor instead.
+func (*BlameSummaryResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateCommitFromPatchError) GetRepositoryName() string {
-	if x != nil {
-		return x.RepositoryName
-	}
-	return ""
-}
-
-func (x *CreateCommitFromPatchError) GetInternalError() string {
-	if x != nil {
-		return x.InternalError
-	}
-	return ""
-}
-
-func (x *CreateCommitFromPatchError) GetCommand() string {
-	if x != nil {
-		return x.Command
-	}
-	return ""
-}
-
-func (x *CreateCommitFromPatchError) GetCombinedOutput() string {
+func (x *BlameSummaryResponse) GetAuthors() []*BlameAuthorSummary {
 	if x != nil {
-		return x.CombinedOutput
+		return x.Authors
 	}
-	return ""
+	return nil
 }
 
-// CreateCommitFromPatchBinaryResponse is the response type returned after
-// creating a commit from a patch
-type CreateCommitFromPatchBinaryResponse struct {
+type BlameAuthorSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// rev is the tag that the staging object can be found at
-	Rev string `protobuf:"bytes,1,opt,name=rev,proto3" json:"rev,omitempty"`
-	// changelistid is the Perforce changelist id
-	ChangelistId string `protobuf:"bytes,3,opt,name=changelist_id,json=changelistId,proto3" json:"changelist_id,omitempty"`
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// line_count is the number of lines this author last touched.
+	LineCount uint32 `protobuf:"varint,3,opt,name=line_count,json=lineCount,proto3" json:"line_count,omitempty"`
+	// last_touched is the most recent author date of the lines counted.
+	LastTouched *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_touched,json=lastTouched,proto3" json:"last_touched,omitempty"`
 }
 
-func (x *CreateCommitFromPatchBinaryResponse) Reset() {
-	*x = CreateCommitFromPatchBinaryResponse{}
+func (x *BlameAuthorSummary) Reset() {
+	*x = BlameAuthorSummary{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2098,13 +2300,13 @@ func (x *CreateCommitFromPatchBinaryResponse) Reset() {
 	}
 }
 
-func (x *CreateCommitFromPatchBinaryResponse) String() string {
+func (x *BlameAuthorSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommitFromPatchBinaryResponse) ProtoMessage() {}
+func (*BlameAuthorSummary) ProtoMessage() {}
 
-func (x *CreateCommitFromPatchBinaryResponse) ProtoReflect() protoreflect.Message {
+func (x *BlameAuthorSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2116,41 +2318,50 @@ func (x *CreateCommitFromPatchBinaryResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommitFromPatchBinaryResponse.ProtoReflect.Descriptor instead.
-func (*CreateCommitFromPatchBinaryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlameAuthorSummary.ProtoReflect.Descriptor instead.
+func (*BlameAuthorSummary) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CreateCommitFromPatchBinaryResponse) GetRev() string {
+func (x *BlameAuthorSummary) GetName() string {
 	if x != nil {
-		return x.Rev
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateCommitFromPatchBinaryResponse) GetChangelistId() string {
+func (x *BlameAuthorSummary) GetEmail() string {
 	if x != nil {
-		return x.ChangelistId
+		return x.Email
 	}
 	return ""
 }
 
-type ExecRequest struct {
+func (x *BlameAuthorSummary) GetLineCount() uint32 {
+	if x != nil {
+		return x.LineCount
+	}
+	return 0
+}
+
+func (x *BlameAuthorSummary) GetLastTouched() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTouched
+	}
+	return nil
+}
+
+type PreviousCommit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	// Deprecated: Marked as deprecated in gitserver.proto.
-	EnsureRevision []byte   `protobuf:"bytes,2,opt,name=ensure_revision,json=ensureRevision,proto3" json:"ensure_revision,omitempty"`
-	Args           [][]byte `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
-	// Deprecated: Marked as deprecated in gitserver.proto.
-	Stdin     []byte `protobuf:"bytes,4,opt,name=stdin,proto3" json:"stdin,omitempty"`
-	NoTimeout bool   `protobuf:"varint,5,opt,name=no_timeout,json=noTimeout,proto3" json:"no_timeout,omitempty"`
+	Commit   string `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
 }
 
-func (x *ExecRequest) Reset() {
-	*x = ExecRequest{}
+func (x *PreviousCommit) Reset() {
+	*x = PreviousCommit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2158,13 +2369,13 @@ func (x *ExecRequest) Reset() {
 	}
 }
 
-func (x *ExecRequest) String() string {
+func (x *PreviousCommit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecRequest) ProtoMessage() {}
+func (*PreviousCommit) ProtoMessage() {}
 
-func (x *ExecRequest) ProtoReflect() protoreflect.Message {
+func (x *PreviousCommit) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2176,58 +2387,38 @@ func (x *ExecRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecRequest.ProtoReflect.Descriptor instead.
-func (*ExecRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviousCommit.ProtoReflect.Descriptor instead.
+func (*PreviousCommit) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ExecRequest) GetRepo() string {
+func (x *PreviousCommit) GetCommit() string {
 	if x != nil {
-		return x.Repo
+		return x.Commit
 	}
 	return ""
 }
 
-// Deprecated: Marked as deprecated in gitserver.proto.
-func (x *ExecRequest) GetEnsureRevision() []byte {
-	if x != nil {
-		return x.EnsureRevision
-	}
-	return nil
-}
-
-func (x *ExecRequest) GetArgs() [][]byte {
+func (x *PreviousCommit) GetFilename() string {
 	if x != nil {
-		return x.Args
+		return x.Filename
 	}
-	return nil
+	return ""
 }
 
-// Deprecated: Marked as deprecated in gitserver.proto.
-func (x *ExecRequest) GetStdin() []byte {
-	if x != nil {
-		return x.Stdin
-	}
-	return nil
-}
-
-func (x *ExecRequest) GetNoTimeout() bool {
-	if x != nil {
-		return x.NoTimeout
-	}
-	return false
-}
-
-type ExecResponse struct {
+type DefaultBranchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// repo_name is the name of the repo to get the default branch for.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	ShortRef bool   `protobuf:"varint,3,opt,name=short_ref,json=shortRef,proto3" json:"short_ref,omitempty"`
 }
 
-func (x *ExecResponse) Reset() {
-	*x = ExecResponse{}
+func (x *DefaultBranchRequest) Reset() {
+	*x = DefaultBranchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2235,13 +2426,13 @@ func (x *ExecResponse) Reset() {
 	}
 }
 
-func (x *ExecResponse) String() string {
+func (x *DefaultBranchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecResponse) ProtoMessage() {}
+func (*DefaultBranchRequest) ProtoMessage() {}
 
-func (x *ExecResponse) ProtoReflect() protoreflect.Message {
+func (x *DefaultBranchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2253,30 +2444,36 @@ func (x *ExecResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecResponse.ProtoReflect.Descriptor instead.
-func (*ExecResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DefaultBranchRequest.ProtoReflect.Descriptor instead.
+func (*DefaultBranchRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ExecResponse) GetData() []byte {
+func (x *DefaultBranchRequest) GetRepoName() string {
 	if x != nil {
-		return x.Data
+		return x.RepoName
 	}
-	return nil
+	return ""
 }
 
-type RepoNotFoundPayload struct {
+func (x *DefaultBranchRequest) GetShortRef() bool {
+	if x != nil {
+		return x.ShortRef
+	}
+	return false
+}
+
+type DefaultBranchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Repo            string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	CloneInProgress bool   `protobuf:"varint,2,opt,name=clone_in_progress,json=cloneInProgress,proto3" json:"clone_in_progress,omitempty"`
-	CloneProgress   string `protobuf:"bytes,3,opt,name=clone_progress,json=cloneProgress,proto3" json:"clone_progress,omitempty"`
+	RefName string `protobuf:"bytes,1,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
+	Commit  string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
 }
 
-func (x *RepoNotFoundPayload) Reset() {
-	*x = RepoNotFoundPayload{}
+func (x *DefaultBranchResponse) Reset() {
+	*x = DefaultBranchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2284,13 +2481,13 @@ func (x *RepoNotFoundPayload) Reset() {
 	}
 }
 
-func (x *RepoNotFoundPayload) String() string {
+func (x *DefaultBranchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoNotFoundPayload) ProtoMessage() {}
+func (*DefaultBranchResponse) ProtoMessage() {}
 
-func (x *RepoNotFoundPayload) ProtoReflect() protoreflect.Message {
+func (x *DefaultBranchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2302,43 +2499,49 @@ func (x *RepoNotFoundPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoNotFoundPayload.ProtoReflect.Descriptor instead.
-func (*RepoNotFoundPayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use DefaultBranchResponse.ProtoReflect.Descriptor instead.
+func (*DefaultBranchResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RepoNotFoundPayload) GetRepo() string {
+func (x *DefaultBranchResponse) GetRefName() string {
 	if x != nil {
-		return x.Repo
+		return x.RefName
 	}
 	return ""
 }
 
-func (x *RepoNotFoundPayload) GetCloneInProgress() bool {
-	if x != nil {
-		return x.CloneInProgress
-	}
-	return false
-}
-
-func (x *RepoNotFoundPayload) GetCloneProgress() string {
+func (x *DefaultBranchResponse) GetCommit() string {
 	if x != nil {
-		return x.CloneProgress
+		return x.Commit
 	}
 	return ""
 }
 
-type RevisionNotFoundPayload struct {
+type ReadFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Spec string `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// repo_name is the name of the repo to get the default branch for.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	Commit   string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	Path     string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// offset is the number of bytes to skip at the start of the file. It is
+	// used to resume an interrupted read. If the offset is past the end of the
+	// file, an OutOfRange error is returned.
+	Offset int64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// include_sha256 requests the SHA-256 of the file content, which is returned
+	// on the last response.
+	IncludeSha256 bool `protobuf:"varint,6,opt,name=include_sha256,json=includeSha256,proto3" json:"include_sha256,omitempty"`
+	// if_not_oid makes the read conditional: if the blob of the file has this
+	// object ID, only a response with not_modified set is returned.
+	IfNotOid string `protobuf:"bytes,7,opt,name=if_not_oid,json=ifNotOid,proto3" json:"if_not_oid,omitempty"`
 }
 
-func (x *RevisionNotFoundPayload) Reset() {
-	*x = RevisionNotFoundPayload{}
+func (x *ReadFileRequest) Reset() {
+	*x = ReadFileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2346,13 +2549,13 @@ func (x *RevisionNotFoundPayload) Reset() {
 	}
 }
 
-func (x *RevisionNotFoundPayload) String() string {
+func (x *ReadFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevisionNotFoundPayload) ProtoMessage() {}
+func (*ReadFileRequest) ProtoMessage() {}
 
-func (x *RevisionNotFoundPayload) ProtoReflect() protoreflect.Message {
+func (x *ReadFileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2364,37 +2567,73 @@ func (x *RevisionNotFoundPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevisionNotFoundPayload.ProtoReflect.Descriptor instead.
-func (*RevisionNotFoundPayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadFileRequest.ProtoReflect.Descriptor instead.
+func (*ReadFileRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *RevisionNotFoundPayload) GetRepo() string {
+func (x *ReadFileRequest) GetRepoName() string {
 	if x != nil {
-		return x.Repo
+		return x.RepoName
 	}
 	return ""
 }
 
-func (x *RevisionNotFoundPayload) GetSpec() string {
+func (x *ReadFileRequest) GetCommit() string {
 	if x != nil {
-		return x.Spec
+		return x.Commit
 	}
 	return ""
 }
 
-type FileNotFoundPayload struct {
+func (x *ReadFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ReadFileRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReadFileRequest) GetIncludeSha256() bool {
+	if x != nil {
+		return x.IncludeSha256
+	}
+	return false
+}
+
+func (x *ReadFileRequest) GetIfNotOid() string {
+	if x != nil {
+		return x.IfNotOid
+	}
+	return ""
+}
+
+type ReadFileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Repo   string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
-	Path   string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// blob_oid is the object ID of the blob of the file. It is only set on the
+	// first response, and is empty for submodules.
+	BlobOid string `protobuf:"bytes,2,opt,name=blob_oid,json=blobOid,proto3" json:"blob_oid,omitempty"`
+	// sha256 is the SHA-256 of the whole file content, including the bytes
+	// skipped by the offset. It is only set on the last response, and only if
+	// include_sha256 was set on the request.
+	Sha256 []byte `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// not_modified is set on the only response if the blob has the object ID
+	// given by if_not_oid.
+	NotModified bool `protobuf:"varint,4,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
 }
 
-func (x *FileNotFoundPayload) Reset() {
-	*x = FileNotFoundPayload{}
+func (x *ReadFileResponse) Reset() {
+	*x = ReadFileResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2402,13 +2641,13 @@ func (x *FileNotFoundPayload) Reset() {
 	}
 }
 
-func (x *FileNotFoundPayload) String() string {
+func (x *ReadFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileNotFoundPayload) ProtoMessage() {}
+func (*ReadFileResponse) ProtoMessage() {}
 
-func (x *FileNotFoundPayload) ProtoReflect() protoreflect.Message {
+func (x *ReadFileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2420,43 +2659,48 @@ func (x *FileNotFoundPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileNotFoundPayload.ProtoReflect.Descriptor instead.
-func (*FileNotFoundPayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadFileResponse.ProtoReflect.Descriptor instead.
+func (*ReadFileResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *FileNotFoundPayload) GetRepo() string {
+func (x *ReadFileResponse) GetData() []byte {
 	if x != nil {
-		return x.Repo
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *FileNotFoundPayload) GetCommit() string {
+func (x *ReadFileResponse) GetBlobOid() string {
 	if x != nil {
-		return x.Commit
+		return x.BlobOid
 	}
 	return ""
 }
 
-func (x *FileNotFoundPayload) GetPath() string {
+func (x *ReadFileResponse) GetSha256() []byte {
 	if x != nil {
-		return x.Path
+		return x.Sha256
 	}
-	return ""
+	return nil
 }
 
-type ExecStatusPayload struct {
+func (x *ReadFileResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+// DiskInfoRequest is a empty request for the DiskInfo RPC.
+type DiskInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Stderr     string `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
 }
 
-func (x *ExecStatusPayload) Reset() {
-	*x = ExecStatusPayload{}
+func (x *DiskInfoRequest) Reset() {
+	*x = DiskInfoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2464,13 +2708,13 @@ func (x *ExecStatusPayload) Reset() {
 	}
 }
 
-func (x *ExecStatusPayload) String() string {
+func (x *DiskInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecStatusPayload) ProtoMessage() {}
+func (*DiskInfoRequest) ProtoMessage() {}
 
-func (x *ExecStatusPayload) ProtoReflect() protoreflect.Message {
+func (x *DiskInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2482,40 +2726,27 @@ func (x *ExecStatusPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecStatusPayload.ProtoReflect.Descriptor instead.
-func (*ExecStatusPayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiskInfoRequest.ProtoReflect.Descriptor instead.
+func (*DiskInfoRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ExecStatusPayload) GetStatusCode() int32 {
-	if x != nil {
-		return x.StatusCode
-	}
-	return 0
-}
-
-func (x *ExecStatusPayload) GetStderr() string {
-	if x != nil {
-		return x.Stderr
-	}
-	return ""
-}
-
-// UnauthorizedPayload is the payload returned when an actor cannot access
-// a commit or file due to subrepo permissions.
-type UnauthorizedPayload struct {
+// DiskInfoResponse contains the results of the DiskInfo RPC request.
+type DiskInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
-	RepoName string  `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	Path     *string `protobuf:"bytes,3,opt,name=path,proto3,oneof" json:"path,omitempty"`
-	Commit   *string `protobuf:"bytes,4,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
+	// free_space is the amount of space avaialable on a gitserver instance.
+	FreeSpace uint64 `protobuf:"varint,1,opt,name=free_space,json=freeSpace,proto3" json:"free_space,omitempty"`
+	// total_space is the total amount of space on a gitserver instance.
+	TotalSpace uint64 `protobuf:"varint,2,opt,name=total_space,json=totalSpace,proto3" json:"total_space,omitempty"`
+	// percent_used is the percent of disk space used on a gitserver instance.
+	PercentUsed float32 `protobuf:"fixed32,3,opt,name=percent_used,json=percentUsed,proto3" json:"percent_used,omitempty"`
 }
 
-func (x *UnauthorizedPayload) Reset() {
-	*x = UnauthorizedPayload{}
+func (x *DiskInfoResponse) Reset() {
+	*x = DiskInfoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2523,13 +2754,13 @@ func (x *UnauthorizedPayload) Reset() {
 	}
 }
 
-func (x *UnauthorizedPayload) String() string {
+func (x *DiskInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnauthorizedPayload) ProtoMessage() {}
+func (*DiskInfoResponse) ProtoMessage() {}
 
-func (x *UnauthorizedPayload) ProtoReflect() protoreflect.Message {
+func (x *DiskInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2541,58 +2772,41 @@ func (x *UnauthorizedPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnauthorizedPayload.ProtoReflect.Descriptor instead.
-func (*UnauthorizedPayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiskInfoResponse.ProtoReflect.Descriptor instead.
+func (*DiskInfoResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UnauthorizedPayload) GetRepoName() string {
+func (x *DiskInfoResponse) GetFreeSpace() uint64 {
 	if x != nil {
-		return x.RepoName
+		return x.FreeSpace
 	}
-	return ""
+	return 0
 }
 
-func (x *UnauthorizedPayload) GetPath() string {
-	if x != nil && x.Path != nil {
-		return *x.Path
+func (x *DiskInfoResponse) GetTotalSpace() uint64 {
+	if x != nil {
+		return x.TotalSpace
 	}
-	return ""
+	return 0
 }
 
-func (x *UnauthorizedPayload) GetCommit() string {
-	if x != nil && x.Commit != nil {
-		return *x.Commit
+func (x *DiskInfoResponse) GetPercentUsed() float32 {
+	if x != nil {
+		return x.PercentUsed
 	}
-	return ""
+	return 0
 }
 
-type SearchRequest struct {
+// CapabilitiesRequest is an empty request for the Capabilities RPC.
+type CapabilitiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// repo is the name of the repo to be searched
-	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	// revisions is the list of git revision to be searched. They are all passed
-	// to the same underlying git command, so the searched commits will be the
-	// union of all revisions listed.
-	Revisions []*RevisionSpecifier `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
-	// limit is a limit on the number of search results returned. Additional
-	// results will be ignored.
-	Limit int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	// include_diff specifies whether the full diff should be included on the
-	// result messages. This can be expensive, so is disabled by default.
-	IncludeDiff bool `protobuf:"varint,4,opt,name=include_diff,json=includeDiff,proto3" json:"include_diff,omitempty"`
-	// include_modified specifies whether to include the list of modified files
-	// in the search results. This can be expensive, so is disabled by default.
-	IncludeModifiedFiles bool `protobuf:"varint,5,opt,name=include_modified_files,json=includeModifiedFiles,proto3" json:"include_modified_files,omitempty"`
-	// query is a tree of filters to apply to commits being searched.
-	Query *QueryNode `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *SearchRequest) Reset() {
-	*x = SearchRequest{}
+func (x *CapabilitiesRequest) Reset() {
+	*x = CapabilitiesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2600,13 +2814,13 @@ func (x *SearchRequest) Reset() {
 	}
 }
 
-func (x *SearchRequest) String() string {
+func (x *CapabilitiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest) ProtoMessage() {}
+func (*CapabilitiesRequest) ProtoMessage() {}
 
-func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+func (x *CapabilitiesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2618,65 +2832,25 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
-func (*SearchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*CapabilitiesRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SearchRequest) GetRepo() string {
-	if x != nil {
-		return x.Repo
-	}
-	return ""
-}
-
-func (x *SearchRequest) GetRevisions() []*RevisionSpecifier {
-	if x != nil {
-		return x.Revisions
-	}
-	return nil
-}
-
-func (x *SearchRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *SearchRequest) GetIncludeDiff() bool {
-	if x != nil {
-		return x.IncludeDiff
-	}
-	return false
-}
-
-func (x *SearchRequest) GetIncludeModifiedFiles() bool {
-	if x != nil {
-		return x.IncludeModifiedFiles
-	}
-	return false
-}
-
-func (x *SearchRequest) GetQuery() *QueryNode {
-	if x != nil {
-		return x.Query
-	}
-	return nil
-}
-
-type RevisionSpecifier struct {
+// CapabilitiesResponse contains the results of the Capabilities RPC request.
+type CapabilitiesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// RevSpec is a revision range specifier suitable for passing to git. See
-	// the manpage gitrevisions(7).
-	RevSpec string `protobuf:"bytes,1,opt,name=rev_spec,json=revSpec,proto3" json:"rev_spec,omitempty"`
+	// compressors are the names of the gRPC compressors, such as "gzip" or
+	// "zstd", that the gitserver can decompress requests with. Responses are
+	// compressed with the same compressor as the request.
+	Compressors []string `protobuf:"bytes,1,rep,name=compressors,proto3" json:"compressors,omitempty"`
 }
 
-func (x *RevisionSpecifier) Reset() {
-	*x = RevisionSpecifier{}
+func (x *CapabilitiesResponse) Reset() {
+	*x = CapabilitiesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2684,13 +2858,13 @@ func (x *RevisionSpecifier) Reset() {
 	}
 }
 
-func (x *RevisionSpecifier) String() string {
+func (x *CapabilitiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevisionSpecifier) ProtoMessage() {}
+func (*CapabilitiesResponse) ProtoMessage() {}
 
-func (x *RevisionSpecifier) ProtoReflect() protoreflect.Message {
+func (x *CapabilitiesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2702,31 +2876,48 @@ func (x *RevisionSpecifier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevisionSpecifier.ProtoReflect.Descriptor instead.
-func (*RevisionSpecifier) Descriptor() ([]byte, []int) {
+// Deprecated: Use CapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*CapabilitiesResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *RevisionSpecifier) GetRevSpec() string {
+func (x *CapabilitiesResponse) GetCompressors() []string {
 	if x != nil {
-		return x.RevSpec
+		return x.Compressors
 	}
-	return ""
+	return nil
 }
 
-// AuthorMatchesNode is a predicate that matches if the author's name or email
-// address matches the regex pattern.
-type AuthorMatchesNode struct {
+type PatchCommitInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Expr       string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
-	IgnoreCase bool   `protobuf:"varint,2,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	// messages are the commit messages to be used for the commit
+	Messages []string `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// author_name is the name of the author to be used for the commit
+	AuthorName string `protobuf:"bytes,2,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	// author_email is the email of the author to be used for the commit
+	AuthorEmail string `protobuf:"bytes,3,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	// committer_name is the name of the committer to be used for the commit
+	CommitterName string `protobuf:"bytes,4,opt,name=committer_name,json=committerName,proto3" json:"committer_name,omitempty"`
+	// committer_email is the email of the committer to be used for the commit
+	CommitterEmail string `protobuf:"bytes,5,opt,name=committer_email,json=committerEmail,proto3" json:"committer_email,omitempty"`
+	// author_date is the date of the author to be used for the commit
+	Date *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	// committer_date is the date of the committer to be used for the commit. If
+	// not set, date is used.
+	CommitterDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=committer_date,json=committerDate,proto3" json:"committer_date,omitempty"`
+	// trailers are added to the end of the commit message, like
+	// Co-authored-by: Name <email>, in the given order.
+	Trailers []*CommitTrailer `protobuf:"bytes,8,rep,name=trailers,proto3" json:"trailers,omitempty"`
+	// sign makes gitserver sign the commit, using the signing key configured
+	// for git on gitserver.
+	Sign bool `protobuf:"varint,9,opt,name=sign,proto3" json:"sign,omitempty"`
 }
 
-func (x *AuthorMatchesNode) Reset() {
-	*x = AuthorMatchesNode{}
+func (x *PatchCommitInfo) Reset() {
+	*x = PatchCommitInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2734,13 +2925,13 @@ func (x *AuthorMatchesNode) Reset() {
 	}
 }
 
-func (x *AuthorMatchesNode) String() string {
+func (x *PatchCommitInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorMatchesNode) ProtoMessage() {}
+func (*PatchCommitInfo) ProtoMessage() {}
 
-func (x *AuthorMatchesNode) ProtoReflect() protoreflect.Message {
+func (x *PatchCommitInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2752,38 +2943,90 @@ func (x *AuthorMatchesNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorMatchesNode.ProtoReflect.Descriptor instead.
-func (*AuthorMatchesNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use PatchCommitInfo.ProtoReflect.Descriptor instead.
+func (*PatchCommitInfo) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AuthorMatchesNode) GetExpr() string {
+func (x *PatchCommitInfo) GetMessages() []string {
 	if x != nil {
-		return x.Expr
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *PatchCommitInfo) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
 	}
 	return ""
 }
 
-func (x *AuthorMatchesNode) GetIgnoreCase() bool {
+func (x *PatchCommitInfo) GetAuthorEmail() string {
 	if x != nil {
-		return x.IgnoreCase
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *PatchCommitInfo) GetCommitterName() string {
+	if x != nil {
+		return x.CommitterName
+	}
+	return ""
+}
+
+func (x *PatchCommitInfo) GetCommitterEmail() string {
+	if x != nil {
+		return x.CommitterEmail
+	}
+	return ""
+}
+
+func (x *PatchCommitInfo) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *PatchCommitInfo) GetCommitterDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommitterDate
+	}
+	return nil
+}
+
+func (x *PatchCommitInfo) GetTrailers() []*CommitTrailer {
+	if x != nil {
+		return x.Trailers
+	}
+	return nil
+}
+
+func (x *PatchCommitInfo) GetSign() bool {
+	if x != nil {
+		return x.Sign
 	}
 	return false
 }
 
-// CommitterMatchesNode is a predicate that matches if the author's name or
-// email address matches the regex pattern.
-type CommitterMatchesNode struct {
+// CommitTrailer is a key-value pair at the end of a commit message, like
+// Co-authored-by: Name <email>.
+type CommitTrailer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Expr       string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
-	IgnoreCase bool   `protobuf:"varint,2,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	// key is the key of the trailer, like Co-authored-by. It may only contain
+	// letters, digits and dashes.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the value of the trailer. It must not contain line breaks.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *CommitterMatchesNode) Reset() {
-	*x = CommitterMatchesNode{}
+func (x *CommitTrailer) Reset() {
+	*x = CommitTrailer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2791,13 +3034,13 @@ func (x *CommitterMatchesNode) Reset() {
 	}
 }
 
-func (x *CommitterMatchesNode) String() string {
+func (x *CommitTrailer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitterMatchesNode) ProtoMessage() {}
+func (*CommitTrailer) ProtoMessage() {}
 
-func (x *CommitterMatchesNode) ProtoReflect() protoreflect.Message {
+func (x *CommitTrailer) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2809,37 +3052,53 @@ func (x *CommitterMatchesNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitterMatchesNode.ProtoReflect.Descriptor instead.
-func (*CommitterMatchesNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitTrailer.ProtoReflect.Descriptor instead.
+func (*CommitTrailer) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CommitterMatchesNode) GetExpr() string {
+func (x *CommitTrailer) GetKey() string {
 	if x != nil {
-		return x.Expr
+		return x.Key
 	}
 	return ""
 }
 
-func (x *CommitterMatchesNode) GetIgnoreCase() bool {
+func (x *CommitTrailer) GetValue() string {
 	if x != nil {
-		return x.IgnoreCase
+		return x.Value
 	}
-	return false
+	return ""
 }
 
-// CommitBeforeNode is a predicate that matches if the commit is before the
-// given date
-type CommitBeforeNode struct {
+type PushConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// remote_url is the git remote URL to which to push the commits.
+	// The URL needs to include HTTP basic auth credentials if no
+	// unauthenticated requests are allowed by the remote host.
+	RemoteUrl string `protobuf:"bytes,1,opt,name=remote_url,json=remoteUrl,proto3" json:"remote_url,omitempty"`
+	// private_key is used when the remote URL uses scheme `ssh`. If set,
+	// this value is used as the content of the private key. Needs to be
+	// set in conjunction with a passphrase.
+	PrivateKey string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// passphrase is the passphrase to decrypt the private key. It is required
+	// when passing PrivateKey.
+	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// expected_old_oid, if set, makes the push only succeed if the ref on the
+	// remote currently points at this commit, like git push --force-with-lease.
+	// If set to an empty string, the ref must not exist on the remote yet.
+	// If unset, the ref is force-pushed unconditionally.
+	ExpectedOldOid *string `protobuf:"bytes,4,opt,name=expected_old_oid,json=expectedOldOid,proto3,oneof" json:"expected_old_oid,omitempty"`
+	// push_options are sent to the remote with git push --push-option, for
+	// example to control CI on the code host.
+	PushOptions []string `protobuf:"bytes,5,rep,name=push_options,json=pushOptions,proto3" json:"push_options,omitempty"`
 }
 
-func (x *CommitBeforeNode) Reset() {
-	*x = CommitBeforeNode{}
+func (x *PushConfig) Reset() {
+	*x = PushConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2847,13 +3106,13 @@ func (x *CommitBeforeNode) Reset() {
 	}
 }
 
-func (x *CommitBeforeNode) String() string {
+func (x *PushConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitBeforeNode) ProtoMessage() {}
+func (*PushConfig) ProtoMessage() {}
 
-func (x *CommitBeforeNode) ProtoReflect() protoreflect.Message {
+func (x *PushConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2865,44 +3124,76 @@ func (x *CommitBeforeNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitBeforeNode.ProtoReflect.Descriptor instead.
-func (*CommitBeforeNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use PushConfig.ProtoReflect.Descriptor instead.
+func (*PushConfig) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *CommitBeforeNode) GetTimestamp() *timestamppb.Timestamp {
+func (x *PushConfig) GetRemoteUrl() string {
 	if x != nil {
-		return x.Timestamp
+		return x.RemoteUrl
 	}
-	return nil
+	return ""
 }
 
-// CommitAfterNode is a predicate that matches if the commit is after the given
-// date
-type CommitAfterNode struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *PushConfig) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
 
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+func (x *PushConfig) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
 }
 
-func (x *CommitAfterNode) Reset() {
-	*x = CommitAfterNode{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[40]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PushConfig) GetExpectedOldOid() string {
+	if x != nil && x.ExpectedOldOid != nil {
+		return *x.ExpectedOldOid
 	}
+	return ""
 }
 
-func (x *CommitAfterNode) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PushConfig) GetPushOptions() []string {
+	if x != nil {
+		return x.PushOptions
+	}
+	return nil
 }
 
-func (*CommitAfterNode) ProtoMessage() {}
+// CreateCommitFromPatchBinaryRequest is the request information needed for
+// creating the simulated staging area git object for a repo.
+type CreateCommitFromPatchBinaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *CommitAfterNode) ProtoReflect() protoreflect.Message {
+	// Types that are assignable to Payload:
+	//
+	//	*CreateCommitFromPatchBinaryRequest_Metadata_
+	//	*CreateCommitFromPatchBinaryRequest_Patch_
+	Payload isCreateCommitFromPatchBinaryRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *CreateCommitFromPatchBinaryRequest) Reset() {
+	*x = CreateCommitFromPatchBinaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCommitFromPatchBinaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommitFromPatchBinaryRequest) ProtoMessage() {}
+
+func (x *CreateCommitFromPatchBinaryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2914,31 +3205,68 @@ func (x *CommitAfterNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitAfterNode.ProtoReflect.Descriptor instead.
-func (*CommitAfterNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCommitFromPatchBinaryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommitFromPatchBinaryRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CommitAfterNode) GetTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Timestamp
+func (m *CreateCommitFromPatchBinaryRequest) GetPayload() isCreateCommitFromPatchBinaryRequest_Payload {
+	if m != nil {
+		return m.Payload
 	}
 	return nil
 }
 
-// MessageMatchesNode is a predicate that matches if the commit message matches
-// the provided regex pattern.
-type MessageMatchesNode struct {
+func (x *CreateCommitFromPatchBinaryRequest) GetMetadata() *CreateCommitFromPatchBinaryRequest_Metadata {
+	if x, ok := x.GetPayload().(*CreateCommitFromPatchBinaryRequest_Metadata_); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateCommitFromPatchBinaryRequest) GetPatch() *CreateCommitFromPatchBinaryRequest_Patch {
+	if x, ok := x.GetPayload().(*CreateCommitFromPatchBinaryRequest_Patch_); ok {
+		return x.Patch
+	}
+	return nil
+}
+
+type isCreateCommitFromPatchBinaryRequest_Payload interface {
+	isCreateCommitFromPatchBinaryRequest_Payload()
+}
+
+type CreateCommitFromPatchBinaryRequest_Metadata_ struct {
+	Metadata *CreateCommitFromPatchBinaryRequest_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type CreateCommitFromPatchBinaryRequest_Patch_ struct {
+	Patch *CreateCommitFromPatchBinaryRequest_Patch `protobuf:"bytes,2,opt,name=patch,proto3,oneof"`
+}
+
+func (*CreateCommitFromPatchBinaryRequest_Metadata_) isCreateCommitFromPatchBinaryRequest_Payload() {}
+
+func (*CreateCommitFromPatchBinaryRequest_Patch_) isCreateCommitFromPatchBinaryRequest_Payload() {}
+
+type CreateCommitFromPatchError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Expr       string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
-	IgnoreCase bool   `protobuf:"varint,2,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	// repository_name is the name of the repository that the error occurred on
+	RepositoryName string `protobuf:"bytes,1,opt,name=repository_name,json=repositoryName,proto3" json:"repository_name,omitempty"`
+	// internal_error is the error that occurred on the server
+	InternalError string `protobuf:"bytes,2,opt,name=internal_error,json=internalError,proto3" json:"internal_error,omitempty"`
+	// command is the git command that was attempted
+	Command string `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	// combined_output is the combined stderr and stdout from running the command
+	CombinedOutput string `protobuf:"bytes,4,opt,name=combined_output,json=combinedOutput,proto3" json:"combined_output,omitempty"`
+	// stale_ref is set if the push was rejected because the ref on the remote
+	// did not point at PushConfig.expected_old_oid.
+	StaleRef bool `protobuf:"varint,5,opt,name=stale_ref,json=staleRef,proto3" json:"stale_ref,omitempty"`
 }
 
-func (x *MessageMatchesNode) Reset() {
-	*x = MessageMatchesNode{}
+func (x *CreateCommitFromPatchError) Reset() {
+	*x = CreateCommitFromPatchError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2946,13 +3274,13 @@ func (x *MessageMatchesNode) Reset() {
 	}
 }
 
-func (x *MessageMatchesNode) String() string {
+func (x *CreateCommitFromPatchError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageMatchesNode) ProtoMessage() {}
+func (*CreateCommitFromPatchError) ProtoMessage() {}
 
-func (x *MessageMatchesNode) ProtoReflect() protoreflect.Message {
+func (x *CreateCommitFromPatchError) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2964,38 +3292,61 @@ func (x *MessageMatchesNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageMatchesNode.ProtoReflect.Descriptor instead.
-func (*MessageMatchesNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCommitFromPatchError.ProtoReflect.Descriptor instead.
+func (*CreateCommitFromPatchError) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *MessageMatchesNode) GetExpr() string {
+func (x *CreateCommitFromPatchError) GetRepositoryName() string {
 	if x != nil {
-		return x.Expr
+		return x.RepositoryName
 	}
 	return ""
 }
 
-func (x *MessageMatchesNode) GetIgnoreCase() bool {
+func (x *CreateCommitFromPatchError) GetInternalError() string {
 	if x != nil {
-		return x.IgnoreCase
+		return x.InternalError
+	}
+	return ""
+}
+
+func (x *CreateCommitFromPatchError) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *CreateCommitFromPatchError) GetCombinedOutput() string {
+	if x != nil {
+		return x.CombinedOutput
+	}
+	return ""
+}
+
+func (x *CreateCommitFromPatchError) GetStaleRef() bool {
+	if x != nil {
+		return x.StaleRef
 	}
 	return false
 }
 
-// DiffMatchesNode is a a predicate that matches if any of the lines changed by
-// the commit match the given regex pattern.
-type DiffMatchesNode struct {
+// CreateCommitFromPatchBinaryResponse is the response type returned after
+// creating a commit from a patch
+type CreateCommitFromPatchBinaryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Expr       string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
-	IgnoreCase bool   `protobuf:"varint,2,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	// rev is the tag that the staging object can be found at
+	Rev string `protobuf:"bytes,1,opt,name=rev,proto3" json:"rev,omitempty"`
+	// changelistid is the Perforce changelist id
+	ChangelistId string `protobuf:"bytes,3,opt,name=changelist_id,json=changelistId,proto3" json:"changelist_id,omitempty"`
 }
 
-func (x *DiffMatchesNode) Reset() {
-	*x = DiffMatchesNode{}
+func (x *CreateCommitFromPatchBinaryResponse) Reset() {
+	*x = CreateCommitFromPatchBinaryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3003,13 +3354,13 @@ func (x *DiffMatchesNode) Reset() {
 	}
 }
 
-func (x *DiffMatchesNode) String() string {
+func (x *CreateCommitFromPatchBinaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffMatchesNode) ProtoMessage() {}
+func (*CreateCommitFromPatchBinaryResponse) ProtoMessage() {}
 
-func (x *DiffMatchesNode) ProtoReflect() protoreflect.Message {
+func (x *CreateCommitFromPatchBinaryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3021,38 +3372,41 @@ func (x *DiffMatchesNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffMatchesNode.ProtoReflect.Descriptor instead.
-func (*DiffMatchesNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCommitFromPatchBinaryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommitFromPatchBinaryResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DiffMatchesNode) GetExpr() string {
+func (x *CreateCommitFromPatchBinaryResponse) GetRev() string {
 	if x != nil {
-		return x.Expr
+		return x.Rev
 	}
 	return ""
 }
 
-func (x *DiffMatchesNode) GetIgnoreCase() bool {
+func (x *CreateCommitFromPatchBinaryResponse) GetChangelistId() string {
 	if x != nil {
-		return x.IgnoreCase
+		return x.ChangelistId
 	}
-	return false
+	return ""
 }
 
-// DiffModifiesFileNode is a predicate that matches if the commit modifies any
-// files that match the given regex pattern.
-type DiffModifiesFileNode struct {
+type ExecRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Expr       string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
-	IgnoreCase bool   `protobuf:"varint,2,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Deprecated: Marked as deprecated in gitserver.proto.
+	EnsureRevision []byte   `protobuf:"bytes,2,opt,name=ensure_revision,json=ensureRevision,proto3" json:"ensure_revision,omitempty"`
+	Args           [][]byte `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	// Deprecated: Marked as deprecated in gitserver.proto.
+	Stdin     []byte `protobuf:"bytes,4,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	NoTimeout bool   `protobuf:"varint,5,opt,name=no_timeout,json=noTimeout,proto3" json:"no_timeout,omitempty"`
 }
 
-func (x *DiffModifiesFileNode) Reset() {
-	*x = DiffModifiesFileNode{}
+func (x *ExecRequest) Reset() {
+	*x = ExecRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3060,13 +3414,13 @@ func (x *DiffModifiesFileNode) Reset() {
 	}
 }
 
-func (x *DiffModifiesFileNode) String() string {
+func (x *ExecRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffModifiesFileNode) ProtoMessage() {}
+func (*ExecRequest) ProtoMessage() {}
 
-func (x *DiffModifiesFileNode) ProtoReflect() protoreflect.Message {
+func (x *ExecRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3078,36 +3432,58 @@ func (x *DiffModifiesFileNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffModifiesFileNode.ProtoReflect.Descriptor instead.
-func (*DiffModifiesFileNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecRequest.ProtoReflect.Descriptor instead.
+func (*ExecRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DiffModifiesFileNode) GetExpr() string {
+func (x *ExecRequest) GetRepo() string {
 	if x != nil {
-		return x.Expr
+		return x.Repo
 	}
 	return ""
 }
 
-func (x *DiffModifiesFileNode) GetIgnoreCase() bool {
+// Deprecated: Marked as deprecated in gitserver.proto.
+func (x *ExecRequest) GetEnsureRevision() []byte {
 	if x != nil {
-		return x.IgnoreCase
+		return x.EnsureRevision
+	}
+	return nil
+}
+
+func (x *ExecRequest) GetArgs() [][]byte {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in gitserver.proto.
+func (x *ExecRequest) GetStdin() []byte {
+	if x != nil {
+		return x.Stdin
+	}
+	return nil
+}
+
+func (x *ExecRequest) GetNoTimeout() bool {
+	if x != nil {
+		return x.NoTimeout
 	}
 	return false
 }
 
-// BooleanNode is a predicate that will either always match or never match
-type BooleanNode struct {
+type ExecResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *BooleanNode) Reset() {
-	*x = BooleanNode{}
+func (x *ExecResponse) Reset() {
+	*x = ExecResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3115,13 +3491,13 @@ func (x *BooleanNode) Reset() {
 	}
 }
 
-func (x *BooleanNode) String() string {
+func (x *ExecResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BooleanNode) ProtoMessage() {}
+func (*ExecResponse) ProtoMessage() {}
 
-func (x *BooleanNode) ProtoReflect() protoreflect.Message {
+func (x *ExecResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3133,29 +3509,30 @@ func (x *BooleanNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BooleanNode.ProtoReflect.Descriptor instead.
-func (*BooleanNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecResponse.ProtoReflect.Descriptor instead.
+func (*ExecResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *BooleanNode) GetValue() bool {
+func (x *ExecResponse) GetData() []byte {
 	if x != nil {
-		return x.Value
+		return x.Data
 	}
-	return false
+	return nil
 }
 
-type OperatorNode struct {
+type RepoNotFoundPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Kind     OperatorKind `protobuf:"varint,1,opt,name=kind,proto3,enum=gitserver.v1.OperatorKind" json:"kind,omitempty"`
-	Operands []*QueryNode `protobuf:"bytes,2,rep,name=operands,proto3" json:"operands,omitempty"`
+	Repo            string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	CloneInProgress bool   `protobuf:"varint,2,opt,name=clone_in_progress,json=cloneInProgress,proto3" json:"clone_in_progress,omitempty"`
+	CloneProgress   string `protobuf:"bytes,3,opt,name=clone_progress,json=cloneProgress,proto3" json:"clone_progress,omitempty"`
 }
 
-func (x *OperatorNode) Reset() {
-	*x = OperatorNode{}
+func (x *RepoNotFoundPayload) Reset() {
+	*x = RepoNotFoundPayload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3163,13 +3540,13 @@ func (x *OperatorNode) Reset() {
 	}
 }
 
-func (x *OperatorNode) String() string {
+func (x *RepoNotFoundPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperatorNode) ProtoMessage() {}
+func (*RepoNotFoundPayload) ProtoMessage() {}
 
-func (x *OperatorNode) ProtoReflect() protoreflect.Message {
+func (x *RepoNotFoundPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3181,46 +3558,43 @@ func (x *OperatorNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperatorNode.ProtoReflect.Descriptor instead.
-func (*OperatorNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepoNotFoundPayload.ProtoReflect.Descriptor instead.
+func (*RepoNotFoundPayload) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *OperatorNode) GetKind() OperatorKind {
+func (x *RepoNotFoundPayload) GetRepo() string {
 	if x != nil {
-		return x.Kind
+		return x.Repo
 	}
-	return OperatorKind_OPERATOR_KIND_UNSPECIFIED
+	return ""
 }
 
-func (x *OperatorNode) GetOperands() []*QueryNode {
+func (x *RepoNotFoundPayload) GetCloneInProgress() bool {
 	if x != nil {
-		return x.Operands
+		return x.CloneInProgress
 	}
-	return nil
+	return false
 }
 
-type QueryNode struct {
+func (x *RepoNotFoundPayload) GetCloneProgress() string {
+	if x != nil {
+		return x.CloneProgress
+	}
+	return ""
+}
+
+type RevisionNotFoundPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Value:
-	//
-	//	*QueryNode_AuthorMatches
-	//	*QueryNode_CommitterMatches
-	//	*QueryNode_CommitBefore
-	//	*QueryNode_CommitAfter
-	//	*QueryNode_MessageMatches
-	//	*QueryNode_DiffMatches
-	//	*QueryNode_DiffModifiesFile
-	//	*QueryNode_Boolean
-	//	*QueryNode_Operator
-	Value isQueryNode_Value `protobuf_oneof:"value"`
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Spec string `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
 }
 
-func (x *QueryNode) Reset() {
-	*x = QueryNode{}
+func (x *RevisionNotFoundPayload) Reset() {
+	*x = RevisionNotFoundPayload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3228,13 +3602,13 @@ func (x *QueryNode) Reset() {
 	}
 }
 
-func (x *QueryNode) String() string {
+func (x *RevisionNotFoundPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryNode) ProtoMessage() {}
+func (*RevisionNotFoundPayload) ProtoMessage() {}
 
-func (x *QueryNode) ProtoReflect() protoreflect.Message {
+func (x *RevisionNotFoundPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3246,168 +3620,114 @@ func (x *QueryNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryNode.ProtoReflect.Descriptor instead.
-func (*QueryNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevisionNotFoundPayload.ProtoReflect.Descriptor instead.
+func (*RevisionNotFoundPayload) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{46}
 }
 
-func (m *QueryNode) GetValue() isQueryNode_Value {
-	if m != nil {
-		return m.Value
+func (x *RevisionNotFoundPayload) GetRepo() string {
+	if x != nil {
+		return x.Repo
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryNode) GetAuthorMatches() *AuthorMatchesNode {
-	if x, ok := x.GetValue().(*QueryNode_AuthorMatches); ok {
-		return x.AuthorMatches
+func (x *RevisionNotFoundPayload) GetSpec() string {
+	if x != nil {
+		return x.Spec
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryNode) GetCommitterMatches() *CommitterMatchesNode {
-	if x, ok := x.GetValue().(*QueryNode_CommitterMatches); ok {
-		return x.CommitterMatches
-	}
-	return nil
-}
+type FileNotFoundPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *QueryNode) GetCommitBefore() *CommitBeforeNode {
-	if x, ok := x.GetValue().(*QueryNode_CommitBefore); ok {
-		return x.CommitBefore
-	}
-	return nil
+	Repo   string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Path   string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *QueryNode) GetCommitAfter() *CommitAfterNode {
-	if x, ok := x.GetValue().(*QueryNode_CommitAfter); ok {
-		return x.CommitAfter
+func (x *FileNotFoundPayload) Reset() {
+	*x = FileNotFoundPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *QueryNode) GetMessageMatches() *MessageMatchesNode {
-	if x, ok := x.GetValue().(*QueryNode_MessageMatches); ok {
-		return x.MessageMatches
-	}
-	return nil
+func (x *FileNotFoundPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *QueryNode) GetDiffMatches() *DiffMatchesNode {
-	if x, ok := x.GetValue().(*QueryNode_DiffMatches); ok {
-		return x.DiffMatches
-	}
-	return nil
-}
+func (*FileNotFoundPayload) ProtoMessage() {}
 
-func (x *QueryNode) GetDiffModifiesFile() *DiffModifiesFileNode {
-	if x, ok := x.GetValue().(*QueryNode_DiffModifiesFile); ok {
-		return x.DiffModifiesFile
+func (x *FileNotFoundPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *QueryNode) GetBoolean() *BooleanNode {
-	if x, ok := x.GetValue().(*QueryNode_Boolean); ok {
-		return x.Boolean
-	}
-	return nil
+// Deprecated: Use FileNotFoundPayload.ProtoReflect.Descriptor instead.
+func (*FileNotFoundPayload) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *QueryNode) GetOperator() *OperatorNode {
-	if x, ok := x.GetValue().(*QueryNode_Operator); ok {
-		return x.Operator
+func (x *FileNotFoundPayload) GetRepo() string {
+	if x != nil {
+		return x.Repo
 	}
-	return nil
-}
-
-type isQueryNode_Value interface {
-	isQueryNode_Value()
-}
-
-type QueryNode_AuthorMatches struct {
-	AuthorMatches *AuthorMatchesNode `protobuf:"bytes,1,opt,name=author_matches,json=authorMatches,proto3,oneof"`
-}
-
-type QueryNode_CommitterMatches struct {
-	CommitterMatches *CommitterMatchesNode `protobuf:"bytes,2,opt,name=committer_matches,json=committerMatches,proto3,oneof"`
-}
-
-type QueryNode_CommitBefore struct {
-	CommitBefore *CommitBeforeNode `protobuf:"bytes,3,opt,name=commit_before,json=commitBefore,proto3,oneof"`
-}
-
-type QueryNode_CommitAfter struct {
-	CommitAfter *CommitAfterNode `protobuf:"bytes,4,opt,name=commit_after,json=commitAfter,proto3,oneof"`
-}
-
-type QueryNode_MessageMatches struct {
-	MessageMatches *MessageMatchesNode `protobuf:"bytes,5,opt,name=message_matches,json=messageMatches,proto3,oneof"`
-}
-
-type QueryNode_DiffMatches struct {
-	DiffMatches *DiffMatchesNode `protobuf:"bytes,6,opt,name=diff_matches,json=diffMatches,proto3,oneof"`
-}
-
-type QueryNode_DiffModifiesFile struct {
-	DiffModifiesFile *DiffModifiesFileNode `protobuf:"bytes,7,opt,name=diff_modifies_file,json=diffModifiesFile,proto3,oneof"`
+	return ""
 }
 
-type QueryNode_Boolean struct {
-	Boolean *BooleanNode `protobuf:"bytes,8,opt,name=boolean,proto3,oneof"`
+func (x *FileNotFoundPayload) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
 }
 
-type QueryNode_Operator struct {
-	Operator *OperatorNode `protobuf:"bytes,9,opt,name=operator,proto3,oneof"`
+func (x *FileNotFoundPayload) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
 }
 
-func (*QueryNode_AuthorMatches) isQueryNode_Value() {}
-
-func (*QueryNode_CommitterMatches) isQueryNode_Value() {}
-
-func (*QueryNode_CommitBefore) isQueryNode_Value() {}
-
-func (*QueryNode_CommitAfter) isQueryNode_Value() {}
-
-func (*QueryNode_MessageMatches) isQueryNode_Value() {}
-
-func (*QueryNode_DiffMatches) isQueryNode_Value() {}
-
-func (*QueryNode_DiffModifiesFile) isQueryNode_Value() {}
-
-func (*QueryNode_Boolean) isQueryNode_Value() {}
-
-func (*QueryNode_Operator) isQueryNode_Value() {}
-
-type SearchResponse struct {
+type ExecStatusPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Message:
-	//
-	//	*SearchResponse_Match
-	//	*SearchResponse_LimitHit
-	Message isSearchResponse_Message `protobuf_oneof:"message"`
+	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Stderr     string `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
 }
 
-func (x *SearchResponse) Reset() {
-	*x = SearchResponse{}
+func (x *ExecStatusPayload) Reset() {
+	*x = ExecStatusPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[47]
+		mi := &file_gitserver_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchResponse) String() string {
+func (x *ExecStatusPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchResponse) ProtoMessage() {}
+func (*ExecStatusPayload) ProtoMessage() {}
 
-func (x *SearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[47]
+func (x *ExecStatusPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3418,90 +3738,123 @@ func (x *SearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
-func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ExecStatusPayload.ProtoReflect.Descriptor instead.
+func (*ExecStatusPayload) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{48}
 }
 
-func (m *SearchResponse) GetMessage() isSearchResponse_Message {
-	if m != nil {
-		return m.Message
+func (x *ExecStatusPayload) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
 	}
-	return nil
+	return 0
 }
 
-func (x *SearchResponse) GetMatch() *CommitMatch {
-	if x, ok := x.GetMessage().(*SearchResponse_Match); ok {
-		return x.Match
+func (x *ExecStatusPayload) GetStderr() string {
+	if x != nil {
+		return x.Stderr
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchResponse) GetLimitHit() bool {
-	if x, ok := x.GetMessage().(*SearchResponse_LimitHit); ok {
-		return x.LimitHit
+// UnauthorizedPayload is the payload returned when an actor cannot access
+// a commit or file due to subrepo permissions.
+type UnauthorizedPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string  `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	Path     *string `protobuf:"bytes,3,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	Commit   *string `protobuf:"bytes,4,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
+}
+
+func (x *UnauthorizedPayload) Reset() {
+	*x = UnauthorizedPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-type isSearchResponse_Message interface {
-	isSearchResponse_Message()
+func (x *UnauthorizedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type SearchResponse_Match struct {
-	Match *CommitMatch `protobuf:"bytes,1,opt,name=match,proto3,oneof"`
+func (*UnauthorizedPayload) ProtoMessage() {}
+
+func (x *UnauthorizedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type SearchResponse_LimitHit struct {
-	LimitHit bool `protobuf:"varint,2,opt,name=limit_hit,json=limitHit,proto3,oneof"`
+// Deprecated: Use UnauthorizedPayload.ProtoReflect.Descriptor instead.
+func (*UnauthorizedPayload) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{49}
 }
 
-func (*SearchResponse_Match) isSearchResponse_Message() {}
+func (x *UnauthorizedPayload) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
 
-func (*SearchResponse_LimitHit) isSearchResponse_Message() {}
+func (x *UnauthorizedPayload) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
 
-type CommitMatch struct {
+func (x *UnauthorizedPayload) GetCommit() string {
+	if x != nil && x.Commit != nil {
+		return *x.Commit
+	}
+	return ""
+}
+
+// RefUpdateConflictPayload is the payload returned when a ref could not be
+// updated because it did not point at the expected commit.
+type RefUpdateConflictPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// oid is the 40-character, hex-encoded commit hash
-	Oid       string                 `protobuf:"bytes,1,opt,name=oid,proto3" json:"oid,omitempty"`
-	Author    *CommitMatch_Signature `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
-	Committer *CommitMatch_Signature `protobuf:"bytes,3,opt,name=committer,proto3" json:"committer,omitempty"`
-	// parents is the list of commit hashes for this commit's parents
-	Parents    []string `protobuf:"bytes,4,rep,name=parents,proto3" json:"parents,omitempty"`
-	Refs       []string `protobuf:"bytes,5,rep,name=refs,proto3" json:"refs,omitempty"`
-	SourceRefs []string `protobuf:"bytes,6,rep,name=source_refs,json=sourceRefs,proto3" json:"source_refs,omitempty"`
-	// message is the commits message and a list of ranges that match
-	// the search query.
-	Message *CommitMatch_MatchedString `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
-	// diff is the diff between this commit and its first parent.
-	// May be unset if `include_diff` was not specified in the request.
-	Diff *CommitMatch_MatchedString `protobuf:"bytes,8,opt,name=diff,proto3" json:"diff,omitempty"`
-	// modified_files is the list of files modified by this commit compared
-	// to its first parent. May be unset if `include_modified_files` is not
-	// specified in the request.
-	ModifiedFiles []string `protobuf:"bytes,9,rep,name=modified_files,json=modifiedFiles,proto3" json:"modified_files,omitempty"`
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	RefName  string `protobuf:"bytes,3,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
+	// expected_old_commit_sha is the value the ref was expected to have. Empty
+	// if the ref was expected not to exist.
+	ExpectedOldCommitSha string `protobuf:"bytes,4,opt,name=expected_old_commit_sha,json=expectedOldCommitSha,proto3" json:"expected_old_commit_sha,omitempty"`
 }
 
-func (x *CommitMatch) Reset() {
-	*x = CommitMatch{}
+func (x *RefUpdateConflictPayload) Reset() {
+	*x = RefUpdateConflictPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[48]
+		mi := &file_gitserver_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommitMatch) String() string {
+func (x *RefUpdateConflictPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitMatch) ProtoMessage() {}
+func (*RefUpdateConflictPayload) ProtoMessage() {}
 
-func (x *CommitMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[48]
+func (x *RefUpdateConflictPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3512,108 +3865,132 @@ func (x *CommitMatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitMatch.ProtoReflect.Descriptor instead.
-func (*CommitMatch) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use RefUpdateConflictPayload.ProtoReflect.Descriptor instead.
+func (*RefUpdateConflictPayload) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CommitMatch) GetOid() string {
+func (x *RefUpdateConflictPayload) GetRepoName() string {
 	if x != nil {
-		return x.Oid
+		return x.RepoName
 	}
 	return ""
 }
 
-func (x *CommitMatch) GetAuthor() *CommitMatch_Signature {
+func (x *RefUpdateConflictPayload) GetRefName() string {
 	if x != nil {
-		return x.Author
+		return x.RefName
 	}
-	return nil
+	return ""
 }
 
-func (x *CommitMatch) GetCommitter() *CommitMatch_Signature {
+func (x *RefUpdateConflictPayload) GetExpectedOldCommitSha() string {
 	if x != nil {
-		return x.Committer
+		return x.ExpectedOldCommitSha
 	}
-	return nil
+	return ""
 }
 
-func (x *CommitMatch) GetParents() []string {
-	if x != nil {
-		return x.Parents
-	}
-	return nil
+// AmbiguousRevisionPayload is the payload returned when a short commit ID
+// matches more than one object.
+type AmbiguousRevisionPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	Spec     string `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	// candidates are the objects the spec matches.
+	Candidates []*AmbiguousRevisionCandidate `protobuf:"bytes,4,rep,name=candidates,proto3" json:"candidates,omitempty"`
 }
 
-func (x *CommitMatch) GetRefs() []string {
-	if x != nil {
-		return x.Refs
+func (x *AmbiguousRevisionPayload) Reset() {
+	*x = AmbiguousRevisionPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CommitMatch) GetSourceRefs() []string {
-	if x != nil {
-		return x.SourceRefs
+func (x *AmbiguousRevisionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmbiguousRevisionPayload) ProtoMessage() {}
+
+func (x *AmbiguousRevisionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CommitMatch) GetMessage() *CommitMatch_MatchedString {
+// Deprecated: Use AmbiguousRevisionPayload.ProtoReflect.Descriptor instead.
+func (*AmbiguousRevisionPayload) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AmbiguousRevisionPayload) GetRepoName() string {
 	if x != nil {
-		return x.Message
+		return x.RepoName
 	}
-	return nil
+	return ""
 }
 
-func (x *CommitMatch) GetDiff() *CommitMatch_MatchedString {
+func (x *AmbiguousRevisionPayload) GetSpec() string {
 	if x != nil {
-		return x.Diff
+		return x.Spec
 	}
-	return nil
+	return ""
 }
 
-func (x *CommitMatch) GetModifiedFiles() []string {
+func (x *AmbiguousRevisionPayload) GetCandidates() []*AmbiguousRevisionCandidate {
 	if x != nil {
-		return x.ModifiedFiles
+		return x.Candidates
 	}
 	return nil
 }
 
-// ArchiveRequest is set of parameters for the Archive RPC.
-type ArchiveRequest struct {
+// AmbiguousRevisionCandidate is an object that an ambiguous short commit ID
+// matches.
+type AmbiguousRevisionCandidate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repo is the name of the repo to be archived.
-	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	// treeish is the tree or commit to produce an archive for.
-	Treeish string `protobuf:"bytes,2,opt,name=treeish,proto3" json:"treeish,omitempty"`
-	// format is the format of the resulting archive (either ZIP or TAR).
-	Format ArchiveFormat `protobuf:"varint,3,opt,name=format,proto3,enum=gitserver.v1.ArchiveFormat" json:"format,omitempty"`
-	// paths is the list of paths to include in the archive. If empty, all
-	// paths are included.
-	Paths []string `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`
+	// id is the object ID, abbreviated so that it is unique.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type is the object type, like commit or tree.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// description is what git shows about the object besides its type, like
+	// the date and subject of a commit.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *ArchiveRequest) Reset() {
-	*x = ArchiveRequest{}
+func (x *AmbiguousRevisionCandidate) Reset() {
+	*x = AmbiguousRevisionCandidate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[49]
+		mi := &file_gitserver_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ArchiveRequest) String() string {
+func (x *AmbiguousRevisionCandidate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveRequest) ProtoMessage() {}
+func (*AmbiguousRevisionCandidate) ProtoMessage() {}
 
-func (x *ArchiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[49]
+func (x *AmbiguousRevisionCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3624,66 +4001,61 @@ func (x *ArchiveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveRequest.ProtoReflect.Descriptor instead.
-func (*ArchiveRequest) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use AmbiguousRevisionCandidate.ProtoReflect.Descriptor instead.
+func (*AmbiguousRevisionCandidate) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ArchiveRequest) GetRepo() string {
+func (x *AmbiguousRevisionCandidate) GetId() string {
 	if x != nil {
-		return x.Repo
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ArchiveRequest) GetTreeish() string {
+func (x *AmbiguousRevisionCandidate) GetType() string {
 	if x != nil {
-		return x.Treeish
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ArchiveRequest) GetFormat() ArchiveFormat {
-	if x != nil {
-		return x.Format
-	}
-	return ArchiveFormat_ARCHIVE_FORMAT_UNSPECIFIED
-}
-
-func (x *ArchiveRequest) GetPaths() []string {
+func (x *AmbiguousRevisionCandidate) GetDescription() string {
 	if x != nil {
-		return x.Paths
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-// ArchiveResponse is the response from the Archive RPC that returns a chunk of
-// the archive.
-type ArchiveResponse struct {
+// BadObjectPayload is the payload returned when git can't read an object
+// that a command refers to, because it is missing or corrupt.
+type BadObjectPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	Object   string `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
 }
 
-func (x *ArchiveResponse) Reset() {
-	*x = ArchiveResponse{}
+func (x *BadObjectPayload) Reset() {
+	*x = BadObjectPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[50]
+		mi := &file_gitserver_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ArchiveResponse) String() string {
+func (x *BadObjectPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveResponse) ProtoMessage() {}
+func (*BadObjectPayload) ProtoMessage() {}
 
-func (x *ArchiveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[50]
+func (x *BadObjectPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3694,45 +4066,55 @@ func (x *ArchiveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveResponse.ProtoReflect.Descriptor instead.
-func (*ArchiveResponse) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use BadObjectPayload.ProtoReflect.Descriptor instead.
+func (*BadObjectPayload) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ArchiveResponse) GetData() []byte {
+func (x *BadObjectPayload) GetRepoName() string {
 	if x != nil {
-		return x.Data
+		return x.RepoName
 	}
-	return nil
+	return ""
 }
 
-// IsRepoCloneableRequest is a request to check if a repository is cloneable.
-type IsRepoCloneableRequest struct {
+func (x *BadObjectPayload) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+// ShallowHistoryPayload is the payload returned when a command needs history
+// beyond the boundary of a shallow clone.
+type ShallowHistoryPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repo is the name of the repo to check.
-	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// commit_sha is the commit whose parents are missing.
+	CommitSha string `protobuf:"bytes,3,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
 }
 
-func (x *IsRepoCloneableRequest) Reset() {
-	*x = IsRepoCloneableRequest{}
+func (x *ShallowHistoryPayload) Reset() {
+	*x = ShallowHistoryPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[51]
+		mi := &file_gitserver_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IsRepoCloneableRequest) String() string {
+func (x *ShallowHistoryPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsRepoCloneableRequest) ProtoMessage() {}
+func (*ShallowHistoryPayload) ProtoMessage() {}
 
-func (x *IsRepoCloneableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[51]
+func (x *ShallowHistoryPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3743,49 +4125,55 @@ func (x *IsRepoCloneableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsRepoCloneableRequest.ProtoReflect.Descriptor instead.
-func (*IsRepoCloneableRequest) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ShallowHistoryPayload.ProtoReflect.Descriptor instead.
+func (*ShallowHistoryPayload) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *IsRepoCloneableRequest) GetRepo() string {
+func (x *ShallowHistoryPayload) GetRepoName() string {
 	if x != nil {
-		return x.Repo
+		return x.RepoName
 	}
 	return ""
 }
 
-// IsRepoCloneableResponse is the response from the IsCloneable RPC.
-type IsRepoCloneableResponse struct {
+func (x *ShallowHistoryPayload) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+// HookPermissionDeniedPayload is the payload returned when a git hook
+// rejected an operation or could not be run.
+type HookPermissionDeniedPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// cloneable is true if the repository is cloneable.
-	Cloneable bool `protobuf:"varint,1,opt,name=cloneable,proto3" json:"cloneable,omitempty"`
-	// cloned is true if the repository was cloned in the past.
-	Cloned bool `protobuf:"varint,2,opt,name=cloned,proto3" json:"cloned,omitempty"`
-	// reason is why the repository is not cloneable.
-	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// hook is the name of the hook, like pre-receive.
+	Hook string `protobuf:"bytes,3,opt,name=hook,proto3" json:"hook,omitempty"`
 }
 
-func (x *IsRepoCloneableResponse) Reset() {
-	*x = IsRepoCloneableResponse{}
+func (x *HookPermissionDeniedPayload) Reset() {
+	*x = HookPermissionDeniedPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[52]
+		mi := &file_gitserver_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IsRepoCloneableResponse) String() string {
+func (x *HookPermissionDeniedPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsRepoCloneableResponse) ProtoMessage() {}
+func (*HookPermissionDeniedPayload) ProtoMessage() {}
 
-func (x *IsRepoCloneableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[52]
+func (x *HookPermissionDeniedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3796,60 +4184,66 @@ func (x *IsRepoCloneableResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsRepoCloneableResponse.ProtoReflect.Descriptor instead.
-func (*IsRepoCloneableResponse) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *IsRepoCloneableResponse) GetCloneable() bool {
-	if x != nil {
-		return x.Cloneable
-	}
-	return false
+// Deprecated: Use HookPermissionDeniedPayload.ProtoReflect.Descriptor instead.
+func (*HookPermissionDeniedPayload) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *IsRepoCloneableResponse) GetCloned() bool {
+func (x *HookPermissionDeniedPayload) GetRepoName() string {
 	if x != nil {
-		return x.Cloned
+		return x.RepoName
 	}
-	return false
+	return ""
 }
 
-func (x *IsRepoCloneableResponse) GetReason() string {
+func (x *HookPermissionDeniedPayload) GetHook() string {
 	if x != nil {
-		return x.Reason
+		return x.Hook
 	}
 	return ""
 }
 
-// RepoCloneProgressRequest is a request for information about the clone
-// progress of multiple repositories on gitserver.
-type RepoCloneProgressRequest struct {
+type SearchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repo_name is the name of the repo to get the progress for.
-	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// repo is the name of the repo to be searched
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// revisions is the list of git revision to be searched. They are all passed
+	// to the same underlying git command, so the searched commits will be the
+	// union of all revisions listed.
+	Revisions []*RevisionSpecifier `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// limit is a limit on the number of search results returned. Additional
+	// results will be ignored.
+	Limit int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// include_diff specifies whether the full diff should be included on the
+	// result messages. This can be expensive, so is disabled by default.
+	IncludeDiff bool `protobuf:"varint,4,opt,name=include_diff,json=includeDiff,proto3" json:"include_diff,omitempty"`
+	// include_modified specifies whether to include the list of modified files
+	// in the search results. This can be expensive, so is disabled by default.
+	IncludeModifiedFiles bool `protobuf:"varint,5,opt,name=include_modified_files,json=includeModifiedFiles,proto3" json:"include_modified_files,omitempty"`
+	// query is a tree of filters to apply to commits being searched.
+	Query *QueryNode `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *RepoCloneProgressRequest) Reset() {
-	*x = RepoCloneProgressRequest{}
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[53]
+		mi := &file_gitserver_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepoCloneProgressRequest) String() string {
+func (x *SearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoCloneProgressRequest) ProtoMessage() {}
+func (*SearchRequest) ProtoMessage() {}
 
-func (x *RepoCloneProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[53]
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3860,113 +4254,80 @@ func (x *RepoCloneProgressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoCloneProgressRequest.ProtoReflect.Descriptor instead.
-func (*RepoCloneProgressRequest) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *RepoCloneProgressRequest) GetRepoName() string {
+func (x *SearchRequest) GetRepo() string {
 	if x != nil {
-		return x.RepoName
+		return x.Repo
 	}
 	return ""
 }
 
-// RepoCloneProgressResponse is the response to a repository clone progress
-// request.
-type RepoCloneProgressResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// clone_in_progress is whether the repository is currently being cloned
-	CloneInProgress bool `protobuf:"varint,1,opt,name=clone_in_progress,json=cloneInProgress,proto3" json:"clone_in_progress,omitempty"`
-	// clone_progress is a progress message from the running clone command.
-	CloneProgress string `protobuf:"bytes,2,opt,name=clone_progress,json=cloneProgress,proto3" json:"clone_progress,omitempty"`
-	// cloned is whether the repository has been cloned successfully
-	Cloned bool `protobuf:"varint,3,opt,name=cloned,proto3" json:"cloned,omitempty"`
-}
-
-func (x *RepoCloneProgressResponse) Reset() {
-	*x = RepoCloneProgressResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[54]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SearchRequest) GetRevisions() []*RevisionSpecifier {
+	if x != nil {
+		return x.Revisions
 	}
+	return nil
 }
 
-func (x *RepoCloneProgressResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RepoCloneProgressResponse) ProtoMessage() {}
-
-func (x *RepoCloneProgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[54]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SearchRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RepoCloneProgressResponse.ProtoReflect.Descriptor instead.
-func (*RepoCloneProgressResponse) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{54}
+	return 0
 }
 
-func (x *RepoCloneProgressResponse) GetCloneInProgress() bool {
+func (x *SearchRequest) GetIncludeDiff() bool {
 	if x != nil {
-		return x.CloneInProgress
+		return x.IncludeDiff
 	}
 	return false
 }
 
-func (x *RepoCloneProgressResponse) GetCloneProgress() string {
+func (x *SearchRequest) GetIncludeModifiedFiles() bool {
 	if x != nil {
-		return x.CloneProgress
+		return x.IncludeModifiedFiles
 	}
-	return ""
+	return false
 }
 
-func (x *RepoCloneProgressResponse) GetCloned() bool {
+func (x *SearchRequest) GetQuery() *QueryNode {
 	if x != nil {
-		return x.Cloned
+		return x.Query
 	}
-	return false
+	return nil
 }
 
-// RepoDeleteRequest is a request to delete a repository.
-type RepoDeleteRequest struct {
+type RevisionSpecifier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repo is the name of the repo to delete.
-	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// RevSpec is a revision range specifier suitable for passing to git. See
+	// the manpage gitrevisions(7).
+	RevSpec string `protobuf:"bytes,1,opt,name=rev_spec,json=revSpec,proto3" json:"rev_spec,omitempty"`
 }
 
-func (x *RepoDeleteRequest) Reset() {
-	*x = RepoDeleteRequest{}
+func (x *RevisionSpecifier) Reset() {
+	*x = RevisionSpecifier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[55]
+		mi := &file_gitserver_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepoDeleteRequest) String() string {
+func (x *RevisionSpecifier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoDeleteRequest) ProtoMessage() {}
+func (*RevisionSpecifier) ProtoMessage() {}
 
-func (x *RepoDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[55]
+func (x *RevisionSpecifier) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3977,42 +4338,46 @@ func (x *RepoDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoDeleteRequest.ProtoReflect.Descriptor instead.
-func (*RepoDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use RevisionSpecifier.ProtoReflect.Descriptor instead.
+func (*RevisionSpecifier) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *RepoDeleteRequest) GetRepo() string {
+func (x *RevisionSpecifier) GetRevSpec() string {
 	if x != nil {
-		return x.Repo
+		return x.RevSpec
 	}
 	return ""
 }
 
-// RepoDeleteResponse is the response from the RepoDelete RPC.
-type RepoDeleteResponse struct {
+// AuthorMatchesNode is a predicate that matches if the author's name or email
+// address matches the regex pattern.
+type AuthorMatchesNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Expr       string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	IgnoreCase bool   `protobuf:"varint,2,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
 }
 
-func (x *RepoDeleteResponse) Reset() {
-	*x = RepoDeleteResponse{}
+func (x *AuthorMatchesNode) Reset() {
+	*x = AuthorMatchesNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[56]
+		mi := &file_gitserver_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepoDeleteResponse) String() string {
+func (x *AuthorMatchesNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoDeleteResponse) ProtoMessage() {}
+func (*AuthorMatchesNode) ProtoMessage() {}
 
-func (x *RepoDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[56]
+func (x *AuthorMatchesNode) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4023,47 +4388,53 @@ func (x *RepoDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoDeleteResponse.ProtoReflect.Descriptor instead.
-func (*RepoDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use AuthorMatchesNode.ProtoReflect.Descriptor instead.
+func (*AuthorMatchesNode) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{58}
 }
 
-// RepoUpdateRequest is a request to update a repository.
-type RepoUpdateRequest struct {
+func (x *AuthorMatchesNode) GetExpr() string {
+	if x != nil {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *AuthorMatchesNode) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+// CommitterMatchesNode is a predicate that matches if the author's name or
+// email address matches the regex pattern.
+type CommitterMatchesNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repo is the name of the repo to update.
-	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	// since is the debounce interval for queries, used only with
-	// request-repo-update.
-	// This field has now been deprecated and is no longer respected.
-	// The caller should make sure to not call this endpoint more often
-	// than needed, and gitserver may make it's own internal decisions
-	// on when to fast-pass a request.
-	//
-	// Deprecated: Marked as deprecated in gitserver.proto.
-	Since *durationpb.Duration `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	Expr       string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	IgnoreCase bool   `protobuf:"varint,2,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
 }
 
-func (x *RepoUpdateRequest) Reset() {
-	*x = RepoUpdateRequest{}
+func (x *CommitterMatchesNode) Reset() {
+	*x = CommitterMatchesNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[57]
+		mi := &file_gitserver_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepoUpdateRequest) String() string {
+func (x *CommitterMatchesNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoUpdateRequest) ProtoMessage() {}
+func (*CommitterMatchesNode) ProtoMessage() {}
 
-func (x *RepoUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[57]
+func (x *CommitterMatchesNode) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4074,57 +4445,52 @@ func (x *RepoUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoUpdateRequest.ProtoReflect.Descriptor instead.
-func (*RepoUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use CommitterMatchesNode.ProtoReflect.Descriptor instead.
+func (*CommitterMatchesNode) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *RepoUpdateRequest) GetRepo() string {
+func (x *CommitterMatchesNode) GetExpr() string {
 	if x != nil {
-		return x.Repo
+		return x.Expr
 	}
 	return ""
 }
 
-// Deprecated: Marked as deprecated in gitserver.proto.
-func (x *RepoUpdateRequest) GetSince() *durationpb.Duration {
+func (x *CommitterMatchesNode) GetIgnoreCase() bool {
 	if x != nil {
-		return x.Since
+		return x.IgnoreCase
 	}
-	return nil
+	return false
 }
 
-// RepoUpdateResponse is the response from the RepoUpdate RPC.
-type RepoUpdateResponse struct {
+// CommitBeforeNode is a predicate that matches if the commit is before the
+// given date
+type CommitBeforeNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// last_fetched is the time the repository was last fetched.
-	LastFetched *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_fetched,json=lastFetched,proto3" json:"last_fetched,omitempty"`
-	// last_changed is the time the repository was last changed.
-	LastChanged *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_changed,json=lastChanged,proto3" json:"last_changed,omitempty"`
-	// error is the error that occurred during the update.
-	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *RepoUpdateResponse) Reset() {
-	*x = RepoUpdateResponse{}
+func (x *CommitBeforeNode) Reset() {
+	*x = CommitBeforeNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[58]
+		mi := &file_gitserver_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RepoUpdateResponse) String() string {
+func (x *CommitBeforeNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepoUpdateResponse) ProtoMessage() {}
+func (*CommitBeforeNode) ProtoMessage() {}
 
-func (x *RepoUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[58]
+func (x *CommitBeforeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4135,59 +4501,45 @@ func (x *RepoUpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepoUpdateResponse.ProtoReflect.Descriptor instead.
-func (*RepoUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{58}
-}
-
-func (x *RepoUpdateResponse) GetLastFetched() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastFetched
-	}
-	return nil
+// Deprecated: Use CommitBeforeNode.ProtoReflect.Descriptor instead.
+func (*CommitBeforeNode) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *RepoUpdateResponse) GetLastChanged() *timestamppb.Timestamp {
+func (x *CommitBeforeNode) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastChanged
+		return x.Timestamp
 	}
 	return nil
 }
 
-func (x *RepoUpdateResponse) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-// ListGitoliteRequest is a request to list all repositories in gitolite.
-type ListGitoliteRequest struct {
+// CommitAfterNode is a predicate that matches if the commit is after the given
+// date
+type CommitAfterNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// host is the hostname of the gitolite instance
-	GitoliteHost string `protobuf:"bytes,1,opt,name=gitolite_host,json=gitoliteHost,proto3" json:"gitolite_host,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *ListGitoliteRequest) Reset() {
-	*x = ListGitoliteRequest{}
+func (x *CommitAfterNode) Reset() {
+	*x = CommitAfterNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[59]
+		mi := &file_gitserver_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListGitoliteRequest) String() string {
+func (x *CommitAfterNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGitoliteRequest) ProtoMessage() {}
+func (*CommitAfterNode) ProtoMessage() {}
 
-func (x *ListGitoliteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[59]
+func (x *CommitAfterNode) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4198,47 +4550,46 @@ func (x *ListGitoliteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGitoliteRequest.ProtoReflect.Descriptor instead.
-func (*ListGitoliteRequest) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use CommitAfterNode.ProtoReflect.Descriptor instead.
+func (*CommitAfterNode) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ListGitoliteRequest) GetGitoliteHost() string {
+func (x *CommitAfterNode) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.GitoliteHost
+		return x.Timestamp
 	}
-	return ""
+	return nil
 }
 
-// GitoliteRepo is a repository in gitolite.
-type GitoliteRepo struct {
+// MessageMatchesNode is a predicate that matches if the commit message matches
+// the provided regex pattern.
+type MessageMatchesNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// name is the name of the repository
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// url is the URL of the repository
-	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Expr       string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	IgnoreCase bool   `protobuf:"varint,2,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
 }
 
-func (x *GitoliteRepo) Reset() {
-	*x = GitoliteRepo{}
+func (x *MessageMatchesNode) Reset() {
+	*x = MessageMatchesNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[60]
+		mi := &file_gitserver_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GitoliteRepo) String() string {
+func (x *MessageMatchesNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitoliteRepo) ProtoMessage() {}
+func (*MessageMatchesNode) ProtoMessage() {}
 
-func (x *GitoliteRepo) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[60]
+func (x *MessageMatchesNode) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4249,52 +4600,53 @@ func (x *GitoliteRepo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitoliteRepo.ProtoReflect.Descriptor instead.
-func (*GitoliteRepo) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use MessageMatchesNode.ProtoReflect.Descriptor instead.
+func (*MessageMatchesNode) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GitoliteRepo) GetName() string {
+func (x *MessageMatchesNode) GetExpr() string {
 	if x != nil {
-		return x.Name
+		return x.Expr
 	}
 	return ""
 }
 
-func (x *GitoliteRepo) GetUrl() string {
+func (x *MessageMatchesNode) GetIgnoreCase() bool {
 	if x != nil {
-		return x.Url
+		return x.IgnoreCase
 	}
-	return ""
+	return false
 }
 
-// ListGitoliteResponse is the response from the ListGitolite RPC.
-type ListGitoliteResponse struct {
+// DiffMatchesNode is a a predicate that matches if any of the lines changed by
+// the commit match the given regex pattern.
+type DiffMatchesNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repos is the list of repositories in gitolite
-	Repos []*GitoliteRepo `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	Expr       string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	IgnoreCase bool   `protobuf:"varint,2,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
 }
 
-func (x *ListGitoliteResponse) Reset() {
-	*x = ListGitoliteResponse{}
+func (x *DiffMatchesNode) Reset() {
+	*x = DiffMatchesNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[61]
+		mi := &file_gitserver_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListGitoliteResponse) String() string {
+func (x *DiffMatchesNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGitoliteResponse) ProtoMessage() {}
+func (*DiffMatchesNode) ProtoMessage() {}
 
-func (x *ListGitoliteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[61]
+func (x *DiffMatchesNode) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4305,47 +4657,53 @@ func (x *ListGitoliteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGitoliteResponse.ProtoReflect.Descriptor instead.
-func (*ListGitoliteResponse) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use DiffMatchesNode.ProtoReflect.Descriptor instead.
+func (*DiffMatchesNode) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ListGitoliteResponse) GetRepos() []*GitoliteRepo {
+func (x *DiffMatchesNode) GetExpr() string {
 	if x != nil {
-		return x.Repos
+		return x.Expr
 	}
-	return nil
+	return ""
 }
 
-// GetObjectRequest is a request to get a git object.
-type GetObjectRequest struct {
+func (x *DiffMatchesNode) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+// DiffModifiesFileNode is a predicate that matches if the commit modifies any
+// files that match the given regex pattern.
+type DiffModifiesFileNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repo is the name of the repo to get the object from.
-	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	// object_name is the name of the object to get.
-	ObjectName string `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	Expr       string `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	IgnoreCase bool   `protobuf:"varint,2,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
 }
 
-func (x *GetObjectRequest) Reset() {
-	*x = GetObjectRequest{}
+func (x *DiffModifiesFileNode) Reset() {
+	*x = DiffModifiesFileNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[62]
+		mi := &file_gitserver_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetObjectRequest) String() string {
+func (x *DiffModifiesFileNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetObjectRequest) ProtoMessage() {}
+func (*DiffModifiesFileNode) ProtoMessage() {}
 
-func (x *GetObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[62]
+func (x *DiffModifiesFileNode) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4356,52 +4714,51 @@ func (x *GetObjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetObjectRequest.ProtoReflect.Descriptor instead.
-func (*GetObjectRequest) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use DiffModifiesFileNode.ProtoReflect.Descriptor instead.
+func (*DiffModifiesFileNode) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetObjectRequest) GetRepo() string {
+func (x *DiffModifiesFileNode) GetExpr() string {
 	if x != nil {
-		return x.Repo
+		return x.Expr
 	}
 	return ""
 }
 
-func (x *GetObjectRequest) GetObjectName() string {
+func (x *DiffModifiesFileNode) GetIgnoreCase() bool {
 	if x != nil {
-		return x.ObjectName
+		return x.IgnoreCase
 	}
-	return ""
+	return false
 }
 
-// GetObjectResponse is the response from the GetObject RPC.
-type GetObjectResponse struct {
+// BooleanNode is a predicate that will either always match or never match
+type BooleanNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// object is the git object.
-	Object *GitObject `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *GetObjectResponse) Reset() {
-	*x = GetObjectResponse{}
+func (x *BooleanNode) Reset() {
+	*x = BooleanNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[63]
+		mi := &file_gitserver_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetObjectResponse) String() string {
+func (x *BooleanNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetObjectResponse) ProtoMessage() {}
+func (*BooleanNode) ProtoMessage() {}
 
-func (x *GetObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[63]
+func (x *BooleanNode) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4412,47 +4769,44 @@ func (x *GetObjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetObjectResponse.ProtoReflect.Descriptor instead.
-func (*GetObjectResponse) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use BooleanNode.ProtoReflect.Descriptor instead.
+func (*BooleanNode) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetObjectResponse) GetObject() *GitObject {
+func (x *BooleanNode) GetValue() bool {
 	if x != nil {
-		return x.Object
+		return x.Value
 	}
-	return nil
+	return false
 }
 
-// GitObject is a git object.
-type GitObject struct {
+type OperatorNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the object id.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// type is the type of the object.
-	Type GitObject_ObjectType `protobuf:"varint,2,opt,name=type,proto3,enum=gitserver.v1.GitObject_ObjectType" json:"type,omitempty"`
+	Kind     OperatorKind `protobuf:"varint,1,opt,name=kind,proto3,enum=gitserver.v1.OperatorKind" json:"kind,omitempty"`
+	Operands []*QueryNode `protobuf:"bytes,2,rep,name=operands,proto3" json:"operands,omitempty"`
 }
 
-func (x *GitObject) Reset() {
-	*x = GitObject{}
+func (x *OperatorNode) Reset() {
+	*x = OperatorNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[64]
+		mi := &file_gitserver_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GitObject) String() string {
+func (x *OperatorNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitObject) ProtoMessage() {}
+func (*OperatorNode) ProtoMessage() {}
 
-func (x *GitObject) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[64]
+func (x *OperatorNode) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4463,53 +4817,61 @@ func (x *GitObject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitObject.ProtoReflect.Descriptor instead.
-func (*GitObject) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use OperatorNode.ProtoReflect.Descriptor instead.
+func (*OperatorNode) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GitObject) GetId() []byte {
+func (x *OperatorNode) GetKind() OperatorKind {
 	if x != nil {
-		return x.Id
+		return x.Kind
 	}
-	return nil
+	return OperatorKind_OPERATOR_KIND_UNSPECIFIED
 }
 
-func (x *GitObject) GetType() GitObject_ObjectType {
+func (x *OperatorNode) GetOperands() []*QueryNode {
 	if x != nil {
-		return x.Type
+		return x.Operands
 	}
-	return GitObject_OBJECT_TYPE_UNSPECIFIED
+	return nil
 }
 
-// IsPerforcePathCloneableRequest is the request to check if a Perforce path is
-// cloneable.
-type IsPerforcePathCloneableRequest struct {
+type QueryNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
-	DepotPath         string                     `protobuf:"bytes,2,opt,name=depot_path,json=depotPath,proto3" json:"depot_path,omitempty"`
+	// Types that are assignable to Value:
+	//
+	//	*QueryNode_AuthorMatches
+	//	*QueryNode_CommitterMatches
+	//	*QueryNode_CommitBefore
+	//	*QueryNode_CommitAfter
+	//	*QueryNode_MessageMatches
+	//	*QueryNode_DiffMatches
+	//	*QueryNode_DiffModifiesFile
+	//	*QueryNode_Boolean
+	//	*QueryNode_Operator
+	Value isQueryNode_Value `protobuf_oneof:"value"`
 }
 
-func (x *IsPerforcePathCloneableRequest) Reset() {
-	*x = IsPerforcePathCloneableRequest{}
+func (x *QueryNode) Reset() {
+	*x = QueryNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[65]
+		mi := &file_gitserver_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IsPerforcePathCloneableRequest) String() string {
+func (x *QueryNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsPerforcePathCloneableRequest) ProtoMessage() {}
+func (*QueryNode) ProtoMessage() {}
 
-func (x *IsPerforcePathCloneableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[65]
+func (x *QueryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4520,92 +4882,168 @@ func (x *IsPerforcePathCloneableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsPerforcePathCloneableRequest.ProtoReflect.Descriptor instead.
-func (*IsPerforcePathCloneableRequest) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use QueryNode.ProtoReflect.Descriptor instead.
+func (*QueryNode) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *IsPerforcePathCloneableRequest) GetConnectionDetails() *PerforceConnectionDetails {
-	if x != nil {
-		return x.ConnectionDetails
+func (m *QueryNode) GetValue() isQueryNode_Value {
+	if m != nil {
+		return m.Value
 	}
 	return nil
 }
 
-func (x *IsPerforcePathCloneableRequest) GetDepotPath() string {
-	if x != nil {
-		return x.DepotPath
+func (x *QueryNode) GetAuthorMatches() *AuthorMatchesNode {
+	if x, ok := x.GetValue().(*QueryNode_AuthorMatches); ok {
+		return x.AuthorMatches
 	}
-	return ""
+	return nil
 }
 
-// IsPerforcePathCloneableResponse is the response from checking if a Perforce
-// path is cloneable.
-type IsPerforcePathCloneableResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *QueryNode) GetCommitterMatches() *CommitterMatchesNode {
+	if x, ok := x.GetValue().(*QueryNode_CommitterMatches); ok {
+		return x.CommitterMatches
+	}
+	return nil
 }
 
-func (x *IsPerforcePathCloneableResponse) Reset() {
-	*x = IsPerforcePathCloneableResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[66]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryNode) GetCommitBefore() *CommitBeforeNode {
+	if x, ok := x.GetValue().(*QueryNode_CommitBefore); ok {
+		return x.CommitBefore
 	}
+	return nil
 }
 
-func (x *IsPerforcePathCloneableResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryNode) GetCommitAfter() *CommitAfterNode {
+	if x, ok := x.GetValue().(*QueryNode_CommitAfter); ok {
+		return x.CommitAfter
+	}
+	return nil
 }
 
-func (*IsPerforcePathCloneableResponse) ProtoMessage() {}
+func (x *QueryNode) GetMessageMatches() *MessageMatchesNode {
+	if x, ok := x.GetValue().(*QueryNode_MessageMatches); ok {
+		return x.MessageMatches
+	}
+	return nil
+}
 
-func (x *IsPerforcePathCloneableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[66]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *QueryNode) GetDiffMatches() *DiffMatchesNode {
+	if x, ok := x.GetValue().(*QueryNode_DiffMatches); ok {
+		return x.DiffMatches
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use IsPerforcePathCloneableResponse.ProtoReflect.Descriptor instead.
-func (*IsPerforcePathCloneableResponse) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{66}
+func (x *QueryNode) GetDiffModifiesFile() *DiffModifiesFileNode {
+	if x, ok := x.GetValue().(*QueryNode_DiffModifiesFile); ok {
+		return x.DiffModifiesFile
+	}
+	return nil
 }
 
-// CheckPerforceCredentialsRequest is the request to check if given Perforce
-// credentials are valid.
-type CheckPerforceCredentialsRequest struct {
+func (x *QueryNode) GetBoolean() *BooleanNode {
+	if x, ok := x.GetValue().(*QueryNode_Boolean); ok {
+		return x.Boolean
+	}
+	return nil
+}
+
+func (x *QueryNode) GetOperator() *OperatorNode {
+	if x, ok := x.GetValue().(*QueryNode_Operator); ok {
+		return x.Operator
+	}
+	return nil
+}
+
+type isQueryNode_Value interface {
+	isQueryNode_Value()
+}
+
+type QueryNode_AuthorMatches struct {
+	AuthorMatches *AuthorMatchesNode `protobuf:"bytes,1,opt,name=author_matches,json=authorMatches,proto3,oneof"`
+}
+
+type QueryNode_CommitterMatches struct {
+	CommitterMatches *CommitterMatchesNode `protobuf:"bytes,2,opt,name=committer_matches,json=committerMatches,proto3,oneof"`
+}
+
+type QueryNode_CommitBefore struct {
+	CommitBefore *CommitBeforeNode `protobuf:"bytes,3,opt,name=commit_before,json=commitBefore,proto3,oneof"`
+}
+
+type QueryNode_CommitAfter struct {
+	CommitAfter *CommitAfterNode `protobuf:"bytes,4,opt,name=commit_after,json=commitAfter,proto3,oneof"`
+}
+
+type QueryNode_MessageMatches struct {
+	MessageMatches *MessageMatchesNode `protobuf:"bytes,5,opt,name=message_matches,json=messageMatches,proto3,oneof"`
+}
+
+type QueryNode_DiffMatches struct {
+	DiffMatches *DiffMatchesNode `protobuf:"bytes,6,opt,name=diff_matches,json=diffMatches,proto3,oneof"`
+}
+
+type QueryNode_DiffModifiesFile struct {
+	DiffModifiesFile *DiffModifiesFileNode `protobuf:"bytes,7,opt,name=diff_modifies_file,json=diffModifiesFile,proto3,oneof"`
+}
+
+type QueryNode_Boolean struct {
+	Boolean *BooleanNode `protobuf:"bytes,8,opt,name=boolean,proto3,oneof"`
+}
+
+type QueryNode_Operator struct {
+	Operator *OperatorNode `protobuf:"bytes,9,opt,name=operator,proto3,oneof"`
+}
+
+func (*QueryNode_AuthorMatches) isQueryNode_Value() {}
+
+func (*QueryNode_CommitterMatches) isQueryNode_Value() {}
+
+func (*QueryNode_CommitBefore) isQueryNode_Value() {}
+
+func (*QueryNode_CommitAfter) isQueryNode_Value() {}
+
+func (*QueryNode_MessageMatches) isQueryNode_Value() {}
+
+func (*QueryNode_DiffMatches) isQueryNode_Value() {}
+
+func (*QueryNode_DiffModifiesFile) isQueryNode_Value() {}
+
+func (*QueryNode_Boolean) isQueryNode_Value() {}
+
+func (*QueryNode_Operator) isQueryNode_Value() {}
+
+type SearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
+	// Types that are assignable to Message:
+	//
+	//	*SearchResponse_Match
+	//	*SearchResponse_LimitHit
+	Message isSearchResponse_Message `protobuf_oneof:"message"`
 }
 
-func (x *CheckPerforceCredentialsRequest) Reset() {
-	*x = CheckPerforceCredentialsRequest{}
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[67]
+		mi := &file_gitserver_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckPerforceCredentialsRequest) String() string {
+func (x *SearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckPerforceCredentialsRequest) ProtoMessage() {}
+func (*SearchResponse) ProtoMessage() {}
 
-func (x *CheckPerforceCredentialsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[67]
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4616,72 +5054,75 @@ func (x *CheckPerforceCredentialsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckPerforceCredentialsRequest.ProtoReflect.Descriptor instead.
-func (*CheckPerforceCredentialsRequest) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *CheckPerforceCredentialsRequest) GetConnectionDetails() *PerforceConnectionDetails {
-	if x != nil {
-		return x.ConnectionDetails
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{68}
+}
+
+func (m *SearchResponse) GetMessage() isSearchResponse_Message {
+	if m != nil {
+		return m.Message
 	}
 	return nil
 }
 
-// IsPerforcePathCloneableResponse is the response from checking if given
-// Perforce credentials are valid.
-type CheckPerforceCredentialsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SearchResponse) GetMatch() *CommitMatch {
+	if x, ok := x.GetMessage().(*SearchResponse_Match); ok {
+		return x.Match
+	}
+	return nil
 }
 
-func (x *CheckPerforceCredentialsResponse) Reset() {
-	*x = CheckPerforceCredentialsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[68]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SearchResponse) GetLimitHit() bool {
+	if x, ok := x.GetMessage().(*SearchResponse_LimitHit); ok {
+		return x.LimitHit
 	}
+	return false
 }
 
-func (x *CheckPerforceCredentialsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isSearchResponse_Message interface {
+	isSearchResponse_Message()
 }
 
-func (*CheckPerforceCredentialsResponse) ProtoMessage() {}
-
-func (x *CheckPerforceCredentialsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[68]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type SearchResponse_Match struct {
+	Match *CommitMatch `protobuf:"bytes,1,opt,name=match,proto3,oneof"`
 }
 
-// Deprecated: Use CheckPerforceCredentialsResponse.ProtoReflect.Descriptor instead.
-func (*CheckPerforceCredentialsResponse) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{68}
+type SearchResponse_LimitHit struct {
+	LimitHit bool `protobuf:"varint,2,opt,name=limit_hit,json=limitHit,proto3,oneof"`
 }
 
-// PerforceConnectionDetails holds all the details required to talk to a
-// Perforce server.
-type PerforceConnectionDetails struct {
+func (*SearchResponse_Match) isSearchResponse_Message() {}
+
+func (*SearchResponse_LimitHit) isSearchResponse_Message() {}
+
+type CommitMatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	P4Port   string `protobuf:"bytes,1,opt,name=p4port,proto3" json:"p4port,omitempty"`
-	P4User   string `protobuf:"bytes,2,opt,name=p4user,proto3" json:"p4user,omitempty"`
-	P4Passwd string `protobuf:"bytes,3,opt,name=p4passwd,proto3" json:"p4passwd,omitempty"`
+	// oid is the 40-character, hex-encoded commit hash
+	Oid       string                 `protobuf:"bytes,1,opt,name=oid,proto3" json:"oid,omitempty"`
+	Author    *CommitMatch_Signature `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Committer *CommitMatch_Signature `protobuf:"bytes,3,opt,name=committer,proto3" json:"committer,omitempty"`
+	// parents is the list of commit hashes for this commit's parents
+	Parents    []string `protobuf:"bytes,4,rep,name=parents,proto3" json:"parents,omitempty"`
+	Refs       []string `protobuf:"bytes,5,rep,name=refs,proto3" json:"refs,omitempty"`
+	SourceRefs []string `protobuf:"bytes,6,rep,name=source_refs,json=sourceRefs,proto3" json:"source_refs,omitempty"`
+	// message is the commits message and a list of ranges that match
+	// the search query.
+	Message *CommitMatch_MatchedString `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	// diff is the diff between this commit and its first parent.
+	// May be unset if `include_diff` was not specified in the request.
+	Diff *CommitMatch_MatchedString `protobuf:"bytes,8,opt,name=diff,proto3" json:"diff,omitempty"`
+	// modified_files is the list of files modified by this commit compared
+	// to its first parent. May be unset if `include_modified_files` is not
+	// specified in the request.
+	ModifiedFiles []string `protobuf:"bytes,9,rep,name=modified_files,json=modifiedFiles,proto3" json:"modified_files,omitempty"`
 }
 
-func (x *PerforceConnectionDetails) Reset() {
-	*x = PerforceConnectionDetails{}
+func (x *CommitMatch) Reset() {
+	*x = CommitMatch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4689,13 +5130,13 @@ func (x *PerforceConnectionDetails) Reset() {
 	}
 }
 
-func (x *PerforceConnectionDetails) String() string {
+func (x *CommitMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceConnectionDetails) ProtoMessage() {}
+func (*CommitMatch) ProtoMessage() {}
 
-func (x *PerforceConnectionDetails) ProtoReflect() protoreflect.Message {
+func (x *CommitMatch) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4707,45 +5148,108 @@ func (x *PerforceConnectionDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceConnectionDetails.ProtoReflect.Descriptor instead.
-func (*PerforceConnectionDetails) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitMatch.ProtoReflect.Descriptor instead.
+func (*CommitMatch) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *PerforceConnectionDetails) GetP4Port() string {
+func (x *CommitMatch) GetOid() string {
 	if x != nil {
-		return x.P4Port
+		return x.Oid
 	}
 	return ""
 }
 
-func (x *PerforceConnectionDetails) GetP4User() string {
+func (x *CommitMatch) GetAuthor() *CommitMatch_Signature {
 	if x != nil {
-		return x.P4User
+		return x.Author
 	}
-	return ""
+	return nil
 }
 
-func (x *PerforceConnectionDetails) GetP4Passwd() string {
+func (x *CommitMatch) GetCommitter() *CommitMatch_Signature {
 	if x != nil {
-		return x.P4Passwd
+		return x.Committer
 	}
-	return ""
+	return nil
 }
 
-// PerforceGetChangelistRequest is used to retrieve information about a specific
-// Perforce changelist.
-type PerforceGetChangelistRequest struct {
+func (x *CommitMatch) GetParents() []string {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+func (x *CommitMatch) GetRefs() []string {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+func (x *CommitMatch) GetSourceRefs() []string {
+	if x != nil {
+		return x.SourceRefs
+	}
+	return nil
+}
+
+func (x *CommitMatch) GetMessage() *CommitMatch_MatchedString {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *CommitMatch) GetDiff() *CommitMatch_MatchedString {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *CommitMatch) GetModifiedFiles() []string {
+	if x != nil {
+		return x.ModifiedFiles
+	}
+	return nil
+}
+
+// ArchiveRequest is set of parameters for the Archive RPC.
+type ArchiveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
-	ChangelistId      string                     `protobuf:"bytes,2,opt,name=changelist_id,json=changelistId,proto3" json:"changelist_id,omitempty"`
+	// repo is the name of the repo to be archived.
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// treeish is the tree or commit to produce an archive for.
+	Treeish string `protobuf:"bytes,2,opt,name=treeish,proto3" json:"treeish,omitempty"`
+	// format is the format of the resulting archive (either ZIP or TAR).
+	Format ArchiveFormat `protobuf:"varint,3,opt,name=format,proto3,enum=gitserver.v1.ArchiveFormat" json:"format,omitempty"`
+	// paths is the list of paths to include in the archive. If empty, all
+	// paths are included.
+	Paths []string `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`
+	// offset is the number of bytes at the start of the archive to skip. It is
+	// used to resume an interrupted download by requesting the same archive
+	// again with the number of bytes already received. Archives are only
+	// guaranteed to be identical for the same commit or tree, so if offset is
+	// set, treeish must be an absolute object ID.
+	//
+	// If offset is larger than the archive, an OutOfRange error is returned.
+	Offset int64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// filter is a filter spec in the syntax of git's --filter option, like
+	// "blob:limit=1m". If set, the blobs matching it are left out of the
+	// archive and listed in a JSON manifest file named
+	// .sourcegraph-omitted-blobs.json at the root of the archive. Only
+	// "blob:none" and "blob:limit=<n>" are supported, other filters result in an
+	// InvalidArgument error.
+	Filter string `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *PerforceGetChangelistRequest) Reset() {
-	*x = PerforceGetChangelistRequest{}
+func (x *ArchiveRequest) Reset() {
+	*x = ArchiveRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4753,13 +5257,13 @@ func (x *PerforceGetChangelistRequest) Reset() {
 	}
 }
 
-func (x *PerforceGetChangelistRequest) String() string {
+func (x *ArchiveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceGetChangelistRequest) ProtoMessage() {}
+func (*ArchiveRequest) ProtoMessage() {}
 
-func (x *PerforceGetChangelistRequest) ProtoReflect() protoreflect.Message {
+func (x *ArchiveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4771,37 +5275,65 @@ func (x *PerforceGetChangelistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceGetChangelistRequest.ProtoReflect.Descriptor instead.
-func (*PerforceGetChangelistRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArchiveRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *PerforceGetChangelistRequest) GetConnectionDetails() *PerforceConnectionDetails {
+func (x *ArchiveRequest) GetRepo() string {
 	if x != nil {
-		return x.ConnectionDetails
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *ArchiveRequest) GetTreeish() string {
+	if x != nil {
+		return x.Treeish
+	}
+	return ""
+}
+
+func (x *ArchiveRequest) GetFormat() ArchiveFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ArchiveFormat_ARCHIVE_FORMAT_UNSPECIFIED
+}
+
+func (x *ArchiveRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
 	}
 	return nil
 }
 
-func (x *PerforceGetChangelistRequest) GetChangelistId() string {
+func (x *ArchiveRequest) GetOffset() int64 {
 	if x != nil {
-		return x.ChangelistId
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ArchiveRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
 	}
 	return ""
 }
 
-// PerforceGetChangelistResponse returns information about the requested
-// changelist.
-type PerforceGetChangelistResponse struct {
+// ArchiveResponse is the response from the Archive RPC that returns a chunk of
+// the archive.
+type ArchiveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Changelist *PerforceChangelist `protobuf:"bytes,1,opt,name=changelist,proto3" json:"changelist,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *PerforceGetChangelistResponse) Reset() {
-	*x = PerforceGetChangelistResponse{}
+func (x *ArchiveResponse) Reset() {
+	*x = ArchiveResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4809,13 +5341,13 @@ func (x *PerforceGetChangelistResponse) Reset() {
 	}
 }
 
-func (x *PerforceGetChangelistResponse) String() string {
+func (x *ArchiveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceGetChangelistResponse) ProtoMessage() {}
+func (*ArchiveResponse) ProtoMessage() {}
 
-func (x *PerforceGetChangelistResponse) ProtoReflect() protoreflect.Message {
+func (x *ArchiveResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4827,34 +5359,30 @@ func (x *PerforceGetChangelistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceGetChangelistResponse.ProtoReflect.Descriptor instead.
-func (*PerforceGetChangelistResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArchiveResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *PerforceGetChangelistResponse) GetChangelist() *PerforceChangelist {
+func (x *ArchiveResponse) GetData() []byte {
 	if x != nil {
-		return x.Changelist
+		return x.Data
 	}
 	return nil
 }
 
-// PerforceChangelist represents a changelist in Perforce.
-type PerforceChangelist struct {
+// IsRepoCloneableRequest is a request to check if a repository is cloneable.
+type IsRepoCloneableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string                                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreationDate *timestamppb.Timestamp                     `protobuf:"bytes,2,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
-	State        PerforceChangelist_PerforceChangelistState `protobuf:"varint,3,opt,name=state,proto3,enum=gitserver.v1.PerforceChangelist_PerforceChangelistState" json:"state,omitempty"`
-	Author       string                                     `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
-	Title        string                                     `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	Message      string                                     `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// repo is the name of the repo to check.
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 }
 
-func (x *PerforceChangelist) Reset() {
-	*x = PerforceChangelist{}
+func (x *IsRepoCloneableRequest) Reset() {
+	*x = IsRepoCloneableRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4862,13 +5390,13 @@ func (x *PerforceChangelist) Reset() {
 	}
 }
 
-func (x *PerforceChangelist) String() string {
+func (x *IsRepoCloneableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceChangelist) ProtoMessage() {}
+func (*IsRepoCloneableRequest) ProtoMessage() {}
 
-func (x *PerforceChangelist) ProtoReflect() protoreflect.Message {
+func (x *IsRepoCloneableRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4880,65 +5408,34 @@ func (x *PerforceChangelist) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceChangelist.ProtoReflect.Descriptor instead.
-func (*PerforceChangelist) Descriptor() ([]byte, []int) {
+// Deprecated: Use IsRepoCloneableRequest.ProtoReflect.Descriptor instead.
+func (*IsRepoCloneableRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *PerforceChangelist) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *PerforceChangelist) GetCreationDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreationDate
-	}
-	return nil
-}
-
-func (x *PerforceChangelist) GetState() PerforceChangelist_PerforceChangelistState {
-	if x != nil {
-		return x.State
-	}
-	return PerforceChangelist_PERFORCE_CHANGELIST_STATE_UNSPECIFIED
-}
-
-func (x *PerforceChangelist) GetAuthor() string {
-	if x != nil {
-		return x.Author
-	}
-	return ""
-}
-
-func (x *PerforceChangelist) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *PerforceChangelist) GetMessage() string {
+func (x *IsRepoCloneableRequest) GetRepo() string {
 	if x != nil {
-		return x.Message
+		return x.Repo
 	}
 	return ""
 }
 
-// IsPerforceSuperUserRequest can be used to check if a given Perforce user is a
-// super user.
-type IsPerforceSuperUserRequest struct {
+// IsRepoCloneableResponse is the response from the IsCloneable RPC.
+type IsRepoCloneableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
+	// cloneable is true if the repository is cloneable.
+	Cloneable bool `protobuf:"varint,1,opt,name=cloneable,proto3" json:"cloneable,omitempty"`
+	// cloned is true if the repository was cloned in the past.
+	Cloned bool `protobuf:"varint,2,opt,name=cloned,proto3" json:"cloned,omitempty"`
+	// reason is why the repository is not cloneable.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *IsPerforceSuperUserRequest) Reset() {
-	*x = IsPerforceSuperUserRequest{}
+func (x *IsRepoCloneableResponse) Reset() {
+	*x = IsRepoCloneableResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4946,13 +5443,13 @@ func (x *IsPerforceSuperUserRequest) Reset() {
 	}
 }
 
-func (x *IsPerforceSuperUserRequest) String() string {
+func (x *IsRepoCloneableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsPerforceSuperUserRequest) ProtoMessage() {}
+func (*IsRepoCloneableResponse) ProtoMessage() {}
 
-func (x *IsPerforceSuperUserRequest) ProtoReflect() protoreflect.Message {
+func (x *IsRepoCloneableResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4964,29 +5461,45 @@ func (x *IsPerforceSuperUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsPerforceSuperUserRequest.ProtoReflect.Descriptor instead.
-func (*IsPerforceSuperUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IsRepoCloneableResponse.ProtoReflect.Descriptor instead.
+func (*IsRepoCloneableResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *IsPerforceSuperUserRequest) GetConnectionDetails() *PerforceConnectionDetails {
+func (x *IsRepoCloneableResponse) GetCloneable() bool {
 	if x != nil {
-		return x.ConnectionDetails
+		return x.Cloneable
 	}
-	return nil
+	return false
 }
 
-// IsPerforceSuperUserResponse is the response from checking if a given Perforce
-// user is a super user.
-// No fields here, returning an error means "no".
-type IsPerforceSuperUserResponse struct {
+func (x *IsRepoCloneableResponse) GetCloned() bool {
+	if x != nil {
+		return x.Cloned
+	}
+	return false
+}
+
+func (x *IsRepoCloneableResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// RepoCloneProgressRequest is a request for information about the clone
+// progress of multiple repositories on gitserver.
+type RepoCloneProgressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to get the progress for.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
 }
 
-func (x *IsPerforceSuperUserResponse) Reset() {
-	*x = IsPerforceSuperUserResponse{}
+func (x *RepoCloneProgressRequest) Reset() {
+	*x = RepoCloneProgressRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4994,13 +5507,13 @@ func (x *IsPerforceSuperUserResponse) Reset() {
 	}
 }
 
-func (x *IsPerforceSuperUserResponse) String() string {
+func (x *RepoCloneProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsPerforceSuperUserResponse) ProtoMessage() {}
+func (*RepoCloneProgressRequest) ProtoMessage() {}
 
-func (x *IsPerforceSuperUserResponse) ProtoReflect() protoreflect.Message {
+func (x *RepoCloneProgressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5012,24 +5525,35 @@ func (x *IsPerforceSuperUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsPerforceSuperUserResponse.ProtoReflect.Descriptor instead.
-func (*IsPerforceSuperUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepoCloneProgressRequest.ProtoReflect.Descriptor instead.
+func (*RepoCloneProgressRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{74}
 }
 
-// PerforceProtectsForDepotRequest requests all the protections that apply to
-// the given depot.
-type PerforceProtectsForDepotRequest struct {
+func (x *RepoCloneProgressRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+// RepoCloneProgressResponse is the response to a repository clone progress
+// request.
+type RepoCloneProgressResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
-	Depot             string                     `protobuf:"bytes,2,opt,name=depot,proto3" json:"depot,omitempty"`
+	// clone_in_progress is whether the repository is currently being cloned
+	CloneInProgress bool `protobuf:"varint,1,opt,name=clone_in_progress,json=cloneInProgress,proto3" json:"clone_in_progress,omitempty"`
+	// clone_progress is a progress message from the running clone command.
+	CloneProgress string `protobuf:"bytes,2,opt,name=clone_progress,json=cloneProgress,proto3" json:"clone_progress,omitempty"`
+	// cloned is whether the repository has been cloned successfully
+	Cloned bool `protobuf:"varint,3,opt,name=cloned,proto3" json:"cloned,omitempty"`
 }
 
-func (x *PerforceProtectsForDepotRequest) Reset() {
-	*x = PerforceProtectsForDepotRequest{}
+func (x *RepoCloneProgressResponse) Reset() {
+	*x = RepoCloneProgressResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5037,13 +5561,13 @@ func (x *PerforceProtectsForDepotRequest) Reset() {
 	}
 }
 
-func (x *PerforceProtectsForDepotRequest) String() string {
+func (x *RepoCloneProgressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceProtectsForDepotRequest) ProtoMessage() {}
+func (*RepoCloneProgressResponse) ProtoMessage() {}
 
-func (x *PerforceProtectsForDepotRequest) ProtoReflect() protoreflect.Message {
+func (x *RepoCloneProgressResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5055,37 +5579,44 @@ func (x *PerforceProtectsForDepotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceProtectsForDepotRequest.ProtoReflect.Descriptor instead.
-func (*PerforceProtectsForDepotRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepoCloneProgressResponse.ProtoReflect.Descriptor instead.
+func (*RepoCloneProgressResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *PerforceProtectsForDepotRequest) GetConnectionDetails() *PerforceConnectionDetails {
+func (x *RepoCloneProgressResponse) GetCloneInProgress() bool {
 	if x != nil {
-		return x.ConnectionDetails
+		return x.CloneInProgress
 	}
-	return nil
+	return false
 }
 
-func (x *PerforceProtectsForDepotRequest) GetDepot() string {
+func (x *RepoCloneProgressResponse) GetCloneProgress() string {
 	if x != nil {
-		return x.Depot
+		return x.CloneProgress
 	}
 	return ""
 }
 
-// PerforceProtectsForDepotResponse returns all the protections that apply to
-// the given depot.
-type PerforceProtectsForDepotResponse struct {
+func (x *RepoCloneProgressResponse) GetCloned() bool {
+	if x != nil {
+		return x.Cloned
+	}
+	return false
+}
+
+// RepoDeleteRequest is a request to delete a repository.
+type RepoDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Protects []*PerforceProtect `protobuf:"bytes,1,rep,name=protects,proto3" json:"protects,omitempty"`
+	// repo is the name of the repo to delete.
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 }
 
-func (x *PerforceProtectsForDepotResponse) Reset() {
-	*x = PerforceProtectsForDepotResponse{}
+func (x *RepoDeleteRequest) Reset() {
+	*x = RepoDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5093,13 +5624,13 @@ func (x *PerforceProtectsForDepotResponse) Reset() {
 	}
 }
 
-func (x *PerforceProtectsForDepotResponse) String() string {
+func (x *RepoDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceProtectsForDepotResponse) ProtoMessage() {}
+func (*RepoDeleteRequest) ProtoMessage() {}
 
-func (x *PerforceProtectsForDepotResponse) ProtoReflect() protoreflect.Message {
+func (x *RepoDeleteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5111,31 +5642,27 @@ func (x *PerforceProtectsForDepotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceProtectsForDepotResponse.ProtoReflect.Descriptor instead.
-func (*PerforceProtectsForDepotResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepoDeleteRequest.ProtoReflect.Descriptor instead.
+func (*RepoDeleteRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *PerforceProtectsForDepotResponse) GetProtects() []*PerforceProtect {
+func (x *RepoDeleteRequest) GetRepo() string {
 	if x != nil {
-		return x.Protects
+		return x.Repo
 	}
-	return nil
+	return ""
 }
 
-// PerforceProtectsForUserRequest requests all the protections that apply to the
-// given user.
-type PerforceProtectsForUserRequest struct {
+// RepoDeleteResponse is the response from the RepoDelete RPC.
+type RepoDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
-	Username          string                     `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *PerforceProtectsForUserRequest) Reset() {
-	*x = PerforceProtectsForUserRequest{}
+func (x *RepoDeleteResponse) Reset() {
+	*x = RepoDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5143,13 +5670,13 @@ func (x *PerforceProtectsForUserRequest) Reset() {
 	}
 }
 
-func (x *PerforceProtectsForUserRequest) String() string {
+func (x *RepoDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceProtectsForUserRequest) ProtoMessage() {}
+func (*RepoDeleteResponse) ProtoMessage() {}
 
-func (x *PerforceProtectsForUserRequest) ProtoReflect() protoreflect.Message {
+func (x *RepoDeleteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5161,51 +5688,46 @@ func (x *PerforceProtectsForUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceProtectsForUserRequest.ProtoReflect.Descriptor instead.
-func (*PerforceProtectsForUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepoDeleteResponse.ProtoReflect.Descriptor instead.
+func (*RepoDeleteResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *PerforceProtectsForUserRequest) GetConnectionDetails() *PerforceConnectionDetails {
-	if x != nil {
-		return x.ConnectionDetails
-	}
-	return nil
-}
-
-func (x *PerforceProtectsForUserRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-// PerforceProtectsForUserResponse returns all the protections that apply to the
-// given user.
-type PerforceProtectsForUserResponse struct {
+// RepoUpdateRequest is a request to update a repository.
+type RepoUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Protects []*PerforceProtect `protobuf:"bytes,1,rep,name=protects,proto3" json:"protects,omitempty"`
-}
-
-func (x *PerforceProtectsForUserResponse) Reset() {
-	*x = PerforceProtectsForUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[78]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	// repo is the name of the repo to update.
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// since is the debounce interval for queries, used only with
+	// request-repo-update.
+	// This field has now been deprecated and is no longer respected.
+	// The caller should make sure to not call this endpoint more often
+	// than needed, and gitserver may make it's own internal decisions
+	// on when to fast-pass a request.
+	//
+	// Deprecated: Marked as deprecated in gitserver.proto.
+	Since *durationpb.Duration `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
 }
 
-func (x *PerforceProtectsForUserResponse) String() string {
+func (x *RepoUpdateRequest) Reset() {
+	*x = RepoUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceProtectsForUserResponse) ProtoMessage() {}
+func (*RepoUpdateRequest) ProtoMessage() {}
 
-func (x *PerforceProtectsForUserResponse) ProtoReflect() protoreflect.Message {
+func (x *RepoUpdateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5217,34 +5739,42 @@ func (x *PerforceProtectsForUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceProtectsForUserResponse.ProtoReflect.Descriptor instead.
-func (*PerforceProtectsForUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepoUpdateRequest.ProtoReflect.Descriptor instead.
+func (*RepoUpdateRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *PerforceProtectsForUserResponse) GetProtects() []*PerforceProtect {
+func (x *RepoUpdateRequest) GetRepo() string {
 	if x != nil {
-		return x.Protects
+		return x.Repo
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in gitserver.proto.
+func (x *RepoUpdateRequest) GetSince() *durationpb.Duration {
+	if x != nil {
+		return x.Since
 	}
 	return nil
 }
 
-// PerforceProtect is a single line definition of a protection in Perforce.
-type PerforceProtect struct {
+// RepoUpdateResponse is the response from the RepoUpdate RPC.
+type RepoUpdateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Level       string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
-	EntityType  string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
-	EntityName  string `protobuf:"bytes,3,opt,name=entity_name,json=entityName,proto3" json:"entity_name,omitempty"`
-	Match       string `protobuf:"bytes,4,opt,name=match,proto3" json:"match,omitempty"`
-	IsExclusion bool   `protobuf:"varint,5,opt,name=is_exclusion,json=isExclusion,proto3" json:"is_exclusion,omitempty"`
-	Host        string `protobuf:"bytes,6,opt,name=host,proto3" json:"host,omitempty"`
+	// last_fetched is the time the repository was last fetched.
+	LastFetched *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_fetched,json=lastFetched,proto3" json:"last_fetched,omitempty"`
+	// last_changed is the time the repository was last changed.
+	LastChanged *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_changed,json=lastChanged,proto3" json:"last_changed,omitempty"`
+	// error is the error that occurred during the update.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *PerforceProtect) Reset() {
-	*x = PerforceProtect{}
+func (x *RepoUpdateResponse) Reset() {
+	*x = RepoUpdateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5252,13 +5782,13 @@ func (x *PerforceProtect) Reset() {
 	}
 }
 
-func (x *PerforceProtect) String() string {
+func (x *RepoUpdateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceProtect) ProtoMessage() {}
+func (*RepoUpdateResponse) ProtoMessage() {}
 
-func (x *PerforceProtect) ProtoReflect() protoreflect.Message {
+func (x *RepoUpdateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5270,65 +5800,44 @@ func (x *PerforceProtect) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceProtect.ProtoReflect.Descriptor instead.
-func (*PerforceProtect) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepoUpdateResponse.ProtoReflect.Descriptor instead.
+func (*RepoUpdateResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *PerforceProtect) GetLevel() string {
-	if x != nil {
-		return x.Level
-	}
-	return ""
-}
-
-func (x *PerforceProtect) GetEntityType() string {
-	if x != nil {
-		return x.EntityType
-	}
-	return ""
-}
-
-func (x *PerforceProtect) GetEntityName() string {
-	if x != nil {
-		return x.EntityName
-	}
-	return ""
-}
-
-func (x *PerforceProtect) GetMatch() string {
+func (x *RepoUpdateResponse) GetLastFetched() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Match
+		return x.LastFetched
 	}
-	return ""
+	return nil
 }
 
-func (x *PerforceProtect) GetIsExclusion() bool {
+func (x *RepoUpdateResponse) GetLastChanged() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IsExclusion
+		return x.LastChanged
 	}
-	return false
+	return nil
 }
 
-func (x *PerforceProtect) GetHost() string {
+func (x *RepoUpdateResponse) GetError() string {
 	if x != nil {
-		return x.Host
+		return x.Error
 	}
 	return ""
 }
 
-// PerforceGroupMembersRequest requests the members of the given Perforce group.
-type PerforceGroupMembersRequest struct {
+// ListGitoliteRequest is a request to list all repositories in gitolite.
+type ListGitoliteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
-	Group             string                     `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	// host is the hostname of the gitolite instance
+	GitoliteHost string `protobuf:"bytes,1,opt,name=gitolite_host,json=gitoliteHost,proto3" json:"gitolite_host,omitempty"`
 }
 
-func (x *PerforceGroupMembersRequest) Reset() {
-	*x = PerforceGroupMembersRequest{}
+func (x *ListGitoliteRequest) Reset() {
+	*x = ListGitoliteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5336,13 +5845,13 @@ func (x *PerforceGroupMembersRequest) Reset() {
 	}
 }
 
-func (x *PerforceGroupMembersRequest) String() string {
+func (x *ListGitoliteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceGroupMembersRequest) ProtoMessage() {}
+func (*ListGitoliteRequest) ProtoMessage() {}
 
-func (x *PerforceGroupMembersRequest) ProtoReflect() protoreflect.Message {
+func (x *ListGitoliteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5354,37 +5863,32 @@ func (x *PerforceGroupMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceGroupMembersRequest.ProtoReflect.Descriptor instead.
-func (*PerforceGroupMembersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGitoliteRequest.ProtoReflect.Descriptor instead.
+func (*ListGitoliteRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *PerforceGroupMembersRequest) GetConnectionDetails() *PerforceConnectionDetails {
-	if x != nil {
-		return x.ConnectionDetails
-	}
-	return nil
-}
-
-func (x *PerforceGroupMembersRequest) GetGroup() string {
+func (x *ListGitoliteRequest) GetGitoliteHost() string {
 	if x != nil {
-		return x.Group
+		return x.GitoliteHost
 	}
 	return ""
 }
 
-// PerforceGroupMembersResponse returns the members of the requested Perforce
-// group.
-type PerforceGroupMembersResponse struct {
+// GitoliteRepo is a repository in gitolite.
+type GitoliteRepo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Usernames []string `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	// name is the name of the repository
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// url is the URL of the repository
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 }
 
-func (x *PerforceGroupMembersResponse) Reset() {
-	*x = PerforceGroupMembersResponse{}
+func (x *GitoliteRepo) Reset() {
+	*x = GitoliteRepo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5392,13 +5896,13 @@ func (x *PerforceGroupMembersResponse) Reset() {
 	}
 }
 
-func (x *PerforceGroupMembersResponse) String() string {
+func (x *GitoliteRepo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceGroupMembersResponse) ProtoMessage() {}
+func (*GitoliteRepo) ProtoMessage() {}
 
-func (x *PerforceGroupMembersResponse) ProtoReflect() protoreflect.Message {
+func (x *GitoliteRepo) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5410,29 +5914,37 @@ func (x *PerforceGroupMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceGroupMembersResponse.ProtoReflect.Descriptor instead.
-func (*PerforceGroupMembersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GitoliteRepo.ProtoReflect.Descriptor instead.
+func (*GitoliteRepo) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *PerforceGroupMembersResponse) GetUsernames() []string {
+func (x *GitoliteRepo) GetName() string {
 	if x != nil {
-		return x.Usernames
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// PerforceUsersRequest lists all the users known to the Perforce server.
-type PerforceUsersRequest struct {
+func (x *GitoliteRepo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// ListGitoliteResponse is the response from the ListGitolite RPC.
+type ListGitoliteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
+	// repos is the list of repositories in gitolite
+	Repos []*GitoliteRepo `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
 }
 
-func (x *PerforceUsersRequest) Reset() {
-	*x = PerforceUsersRequest{}
+func (x *ListGitoliteResponse) Reset() {
+	*x = ListGitoliteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5440,13 +5952,13 @@ func (x *PerforceUsersRequest) Reset() {
 	}
 }
 
-func (x *PerforceUsersRequest) String() string {
+func (x *ListGitoliteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceUsersRequest) ProtoMessage() {}
+func (*ListGitoliteResponse) ProtoMessage() {}
 
-func (x *PerforceUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *ListGitoliteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5458,29 +5970,32 @@ func (x *PerforceUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceUsersRequest.ProtoReflect.Descriptor instead.
-func (*PerforceUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGitoliteResponse.ProtoReflect.Descriptor instead.
+func (*ListGitoliteResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *PerforceUsersRequest) GetConnectionDetails() *PerforceConnectionDetails {
+func (x *ListGitoliteResponse) GetRepos() []*GitoliteRepo {
 	if x != nil {
-		return x.ConnectionDetails
+		return x.Repos
 	}
 	return nil
 }
 
-// PerforceUsersResponse contains the list of users known by the server.
-type PerforceUsersResponse struct {
+// GetObjectRequest is a request to get a git object.
+type GetObjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*PerforceUser `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// repo is the name of the repo to get the object from.
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// object_name is the name of the object to get.
+	ObjectName string `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
 }
 
-func (x *PerforceUsersResponse) Reset() {
-	*x = PerforceUsersResponse{}
+func (x *GetObjectRequest) Reset() {
+	*x = GetObjectRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5488,13 +6003,13 @@ func (x *PerforceUsersResponse) Reset() {
 	}
 }
 
-func (x *PerforceUsersResponse) String() string {
+func (x *GetObjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceUsersResponse) ProtoMessage() {}
+func (*GetObjectRequest) ProtoMessage() {}
 
-func (x *PerforceUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *GetObjectRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5506,30 +6021,37 @@ func (x *PerforceUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceUsersResponse.ProtoReflect.Descriptor instead.
-func (*PerforceUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetObjectRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *PerforceUsersResponse) GetUsers() []*PerforceUser {
+func (x *GetObjectRequest) GetRepo() string {
 	if x != nil {
-		return x.Users
+		return x.Repo
 	}
-	return nil
+	return ""
 }
 
-// PerforceUser is a representation of a user account in Perforce.
-type PerforceUser struct {
+func (x *GetObjectRequest) GetObjectName() string {
+	if x != nil {
+		return x.ObjectName
+	}
+	return ""
+}
+
+// GetObjectResponse is the response from the GetObject RPC.
+type GetObjectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// object is the git object.
+	Object *GitObject `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
 }
 
-func (x *PerforceUser) Reset() {
-	*x = PerforceUser{}
+func (x *GetObjectResponse) Reset() {
+	*x = GetObjectResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5537,13 +6059,13 @@ func (x *PerforceUser) Reset() {
 	}
 }
 
-func (x *PerforceUser) String() string {
+func (x *GetObjectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PerforceUser) ProtoMessage() {}
+func (*GetObjectResponse) ProtoMessage() {}
 
-func (x *PerforceUser) ProtoReflect() protoreflect.Message {
+func (x *GetObjectResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5555,42 +6077,32 @@ func (x *PerforceUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PerforceUser.ProtoReflect.Descriptor instead.
-func (*PerforceUser) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetObjectResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectResponse) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *PerforceUser) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *PerforceUser) GetEmail() string {
+func (x *GetObjectResponse) GetObject() *GitObject {
 	if x != nil {
-		return x.Email
+		return x.Object
 	}
-	return ""
+	return nil
 }
 
-// MergeBaseRequest is a request to find the merge base of two revspecs.
-type MergeBaseRequest struct {
+// GitObject is a git object.
+type GitObject struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repo_name is the name of the repo to get the merge base for.
-	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
-	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	// base is a revspec, for now, we allow non-utf8 revspecs.
-	Base []byte `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
-	// head is a revspec, for now, we allow non-utf8 revspecs.
-	Head []byte `protobuf:"bytes,4,opt,name=head,proto3" json:"head,omitempty"`
+	// id is the object id.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type is the type of the object.
+	Type GitObject_ObjectType `protobuf:"varint,2,opt,name=type,proto3,enum=gitserver.v1.GitObject_ObjectType" json:"type,omitempty"`
 }
 
-func (x *MergeBaseRequest) Reset() {
-	*x = MergeBaseRequest{}
+func (x *GitObject) Reset() {
+	*x = GitObject{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5598,13 +6110,13 @@ func (x *MergeBaseRequest) Reset() {
 	}
 }
 
-func (x *MergeBaseRequest) String() string {
+func (x *GitObject) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeBaseRequest) ProtoMessage() {}
+func (*GitObject) ProtoMessage() {}
 
-func (x *MergeBaseRequest) ProtoReflect() protoreflect.Message {
+func (x *GitObject) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5616,44 +6128,38 @@ func (x *MergeBaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeBaseRequest.ProtoReflect.Descriptor instead.
-func (*MergeBaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GitObject.ProtoReflect.Descriptor instead.
+func (*GitObject) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *MergeBaseRequest) GetRepoName() string {
-	if x != nil {
-		return x.RepoName
-	}
-	return ""
-}
-
-func (x *MergeBaseRequest) GetBase() []byte {
+func (x *GitObject) GetId() []byte {
 	if x != nil {
-		return x.Base
+		return x.Id
 	}
 	return nil
 }
 
-func (x *MergeBaseRequest) GetHead() []byte {
+func (x *GitObject) GetType() GitObject_ObjectType {
 	if x != nil {
-		return x.Head
+		return x.Type
 	}
-	return nil
+	return GitObject_OBJECT_TYPE_UNSPECIFIED
 }
 
-// MergeBaseResponse is the response from finding the merge base of two
-// revspecs.
-type MergeBaseResponse struct {
+// IsPerforcePathCloneableRequest is the request to check if a Perforce path is
+// cloneable.
+type IsPerforcePathCloneableRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MergeBaseCommitSha string `protobuf:"bytes,1,opt,name=merge_base_commit_sha,json=mergeBaseCommitSha,proto3" json:"merge_base_commit_sha,omitempty"`
+	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
+	DepotPath         string                     `protobuf:"bytes,2,opt,name=depot_path,json=depotPath,proto3" json:"depot_path,omitempty"`
 }
 
-func (x *MergeBaseResponse) Reset() {
-	*x = MergeBaseResponse{}
+func (x *IsPerforcePathCloneableRequest) Reset() {
+	*x = IsPerforcePathCloneableRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5661,13 +6167,13 @@ func (x *MergeBaseResponse) Reset() {
 	}
 }
 
-func (x *MergeBaseResponse) String() string {
+func (x *IsPerforcePathCloneableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeBaseResponse) ProtoMessage() {}
+func (*IsPerforcePathCloneableRequest) ProtoMessage() {}
 
-func (x *MergeBaseResponse) ProtoReflect() protoreflect.Message {
+func (x *IsPerforcePathCloneableRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5679,45 +6185,35 @@ func (x *MergeBaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeBaseResponse.ProtoReflect.Descriptor instead.
-func (*MergeBaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IsPerforcePathCloneableRequest.ProtoReflect.Descriptor instead.
+func (*IsPerforcePathCloneableRequest) Descriptor() ([]byte, []int) {
 	return file_gitserver_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *MergeBaseResponse) GetMergeBaseCommitSha() string {
+func (x *IsPerforcePathCloneableRequest) GetConnectionDetails() *PerforceConnectionDetails {
 	if x != nil {
-		return x.MergeBaseCommitSha
+		return x.ConnectionDetails
+	}
+	return nil
+}
+
+func (x *IsPerforcePathCloneableRequest) GetDepotPath() string {
+	if x != nil {
+		return x.DepotPath
 	}
 	return ""
 }
 
-type CreateCommitFromPatchBinaryRequest_Metadata struct {
+// IsPerforcePathCloneableResponse is the response from checking if a Perforce
+// path is cloneable.
+type IsPerforcePathCloneableResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// repo is the name of the repo to be updated
-	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	// base_commit is the revision that the staging area object is based on
-	BaseCommit string `protobuf:"bytes,2,opt,name=base_commit,json=baseCommit,proto3" json:"base_commit,omitempty"`
-	// target_ref is the ref that will be created for this patch
-	TargetRef string `protobuf:"bytes,3,opt,name=target_ref,json=targetRef,proto3" json:"target_ref,omitempty"`
-	// unique_ref is a boolean that indicates whether a unique number will be
-	// appended to the end (ie TargetRef-{#}). The generated ref will be
-	// returned.
-	UniqueRef bool `protobuf:"varint,4,opt,name=unique_ref,json=uniqueRef,proto3" json:"unique_ref,omitempty"`
-	// commit_info is the information to be used for the commit
-	CommitInfo *PatchCommitInfo `protobuf:"bytes,5,opt,name=commit_info,json=commitInfo,proto3" json:"commit_info,omitempty"`
-	// push_config is the configuration to be used for pushing the commit
-	Push *PushConfig `protobuf:"bytes,6,opt,name=push,proto3" json:"push,omitempty"`
-	// git_apply_args are the arguments to be passed to git apply
-	GitApplyArgs []string `protobuf:"bytes,7,rep,name=git_apply_args,json=gitApplyArgs,proto3" json:"git_apply_args,omitempty"`
-	// push_ref is the optional override for the ref that is pushed to
-	PushRef *string `protobuf:"bytes,8,opt,name=push_ref,json=pushRef,proto3,oneof" json:"push_ref,omitempty"`
 }
 
-func (x *CreateCommitFromPatchBinaryRequest_Metadata) Reset() {
-	*x = CreateCommitFromPatchBinaryRequest_Metadata{}
+func (x *IsPerforcePathCloneableResponse) Reset() {
+	*x = IsPerforcePathCloneableResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5725,13 +6221,13 @@ func (x *CreateCommitFromPatchBinaryRequest_Metadata) Reset() {
 	}
 }
 
-func (x *CreateCommitFromPatchBinaryRequest_Metadata) String() string {
+func (x *IsPerforcePathCloneableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommitFromPatchBinaryRequest_Metadata) ProtoMessage() {}
+func (*IsPerforcePathCloneableResponse) ProtoMessage() {}
 
-func (x *CreateCommitFromPatchBinaryRequest_Metadata) ProtoReflect() protoreflect.Message {
+func (x *IsPerforcePathCloneableResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5743,78 +6239,23 @@ func (x *CreateCommitFromPatchBinaryRequest_Metadata) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommitFromPatchBinaryRequest_Metadata.ProtoReflect.Descriptor instead.
-func (*CreateCommitFromPatchBinaryRequest_Metadata) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{25, 0}
-}
-
-func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetRepo() string {
-	if x != nil {
-		return x.Repo
-	}
-	return ""
-}
-
-func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetBaseCommit() string {
-	if x != nil {
-		return x.BaseCommit
-	}
-	return ""
-}
-
-func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetTargetRef() string {
-	if x != nil {
-		return x.TargetRef
-	}
-	return ""
-}
-
-func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetUniqueRef() bool {
-	if x != nil {
-		return x.UniqueRef
-	}
-	return false
-}
-
-func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetCommitInfo() *PatchCommitInfo {
-	if x != nil {
-		return x.CommitInfo
-	}
-	return nil
-}
-
-func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetPush() *PushConfig {
-	if x != nil {
-		return x.Push
-	}
-	return nil
-}
-
-func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetGitApplyArgs() []string {
-	if x != nil {
-		return x.GitApplyArgs
-	}
-	return nil
-}
-
-func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetPushRef() string {
-	if x != nil && x.PushRef != nil {
-		return *x.PushRef
-	}
-	return ""
+// Deprecated: Use IsPerforcePathCloneableResponse.ProtoReflect.Descriptor instead.
+func (*IsPerforcePathCloneableResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{87}
 }
 
-type CreateCommitFromPatchBinaryRequest_Patch struct {
+// CheckPerforceCredentialsRequest is the request to check if given Perforce
+// credentials are valid.
+type CheckPerforceCredentialsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// data is the diff contents to be used to create the staging area revision
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
 }
 
-func (x *CreateCommitFromPatchBinaryRequest_Patch) Reset() {
-	*x = CreateCommitFromPatchBinaryRequest_Patch{}
+func (x *CheckPerforceCredentialsRequest) Reset() {
+	*x = CheckPerforceCredentialsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5822,13 +6263,13 @@ func (x *CreateCommitFromPatchBinaryRequest_Patch) Reset() {
 	}
 }
 
-func (x *CreateCommitFromPatchBinaryRequest_Patch) String() string {
+func (x *CheckPerforceCredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommitFromPatchBinaryRequest_Patch) ProtoMessage() {}
+func (*CheckPerforceCredentialsRequest) ProtoMessage() {}
 
-func (x *CreateCommitFromPatchBinaryRequest_Patch) ProtoReflect() protoreflect.Message {
+func (x *CheckPerforceCredentialsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5840,30 +6281,28 @@ func (x *CreateCommitFromPatchBinaryRequest_Patch) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommitFromPatchBinaryRequest_Patch.ProtoReflect.Descriptor instead.
-func (*CreateCommitFromPatchBinaryRequest_Patch) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{25, 1}
+// Deprecated: Use CheckPerforceCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*CheckPerforceCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *CreateCommitFromPatchBinaryRequest_Patch) GetData() []byte {
+func (x *CheckPerforceCredentialsRequest) GetConnectionDetails() *PerforceConnectionDetails {
 	if x != nil {
-		return x.Data
+		return x.ConnectionDetails
 	}
 	return nil
 }
 
-type CommitMatch_Signature struct {
+// IsPerforcePathCloneableResponse is the response from checking if given
+// Perforce credentials are valid.
+type CheckPerforceCredentialsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Date  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (x *CommitMatch_Signature) Reset() {
-	*x = CommitMatch_Signature{}
+func (x *CheckPerforceCredentialsResponse) Reset() {
+	*x = CheckPerforceCredentialsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gitserver_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5871,13 +6310,13 @@ func (x *CommitMatch_Signature) Reset() {
 	}
 }
 
-func (x *CommitMatch_Signature) String() string {
+func (x *CheckPerforceCredentialsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitMatch_Signature) ProtoMessage() {}
+func (*CheckPerforceCredentialsResponse) ProtoMessage() {}
 
-func (x *CommitMatch_Signature) ProtoReflect() protoreflect.Message {
+func (x *CheckPerforceCredentialsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gitserver_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5889,58 +6328,104 @@ func (x *CommitMatch_Signature) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitMatch_Signature.ProtoReflect.Descriptor instead.
-func (*CommitMatch_Signature) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{48, 0}
+// Deprecated: Use CheckPerforceCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*CheckPerforceCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *CommitMatch_Signature) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// PerforceConnectionDetails holds all the details required to talk to a
+// Perforce server.
+type PerforceConnectionDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	P4Port   string `protobuf:"bytes,1,opt,name=p4port,proto3" json:"p4port,omitempty"`
+	P4User   string `protobuf:"bytes,2,opt,name=p4user,proto3" json:"p4user,omitempty"`
+	P4Passwd string `protobuf:"bytes,3,opt,name=p4passwd,proto3" json:"p4passwd,omitempty"`
 }
 
-func (x *CommitMatch_Signature) GetEmail() string {
-	if x != nil {
-		return x.Email
+func (x *PerforceConnectionDetails) Reset() {
+	*x = PerforceConnectionDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerforceConnectionDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerforceConnectionDetails) ProtoMessage() {}
+
+func (x *PerforceConnectionDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerforceConnectionDetails.ProtoReflect.Descriptor instead.
+func (*PerforceConnectionDetails) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PerforceConnectionDetails) GetP4Port() string {
+	if x != nil {
+		return x.P4Port
 	}
 	return ""
 }
 
-func (x *CommitMatch_Signature) GetDate() *timestamppb.Timestamp {
+func (x *PerforceConnectionDetails) GetP4User() string {
 	if x != nil {
-		return x.Date
+		return x.P4User
 	}
-	return nil
+	return ""
 }
 
-type CommitMatch_MatchedString struct {
+func (x *PerforceConnectionDetails) GetP4Passwd() string {
+	if x != nil {
+		return x.P4Passwd
+	}
+	return ""
+}
+
+// PerforceGetChangelistRequest is used to retrieve information about a specific
+// Perforce changelist.
+type PerforceGetChangelistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string               `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Ranges  []*CommitMatch_Range `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
+	ChangelistId      string                     `protobuf:"bytes,2,opt,name=changelist_id,json=changelistId,proto3" json:"changelist_id,omitempty"`
 }
 
-func (x *CommitMatch_MatchedString) Reset() {
-	*x = CommitMatch_MatchedString{}
+func (x *PerforceGetChangelistRequest) Reset() {
+	*x = PerforceGetChangelistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[90]
+		mi := &file_gitserver_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommitMatch_MatchedString) String() string {
+func (x *PerforceGetChangelistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitMatch_MatchedString) ProtoMessage() {}
+func (*PerforceGetChangelistRequest) ProtoMessage() {}
 
-func (x *CommitMatch_MatchedString) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[90]
+func (x *PerforceGetChangelistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5951,52 +6436,105 @@ func (x *CommitMatch_MatchedString) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitMatch_MatchedString.ProtoReflect.Descriptor instead.
-func (*CommitMatch_MatchedString) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{48, 1}
+// Deprecated: Use PerforceGetChangelistRequest.ProtoReflect.Descriptor instead.
+func (*PerforceGetChangelistRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *CommitMatch_MatchedString) GetContent() string {
+func (x *PerforceGetChangelistRequest) GetConnectionDetails() *PerforceConnectionDetails {
 	if x != nil {
-		return x.Content
+		return x.ConnectionDetails
+	}
+	return nil
+}
+
+func (x *PerforceGetChangelistRequest) GetChangelistId() string {
+	if x != nil {
+		return x.ChangelistId
 	}
 	return ""
 }
 
-func (x *CommitMatch_MatchedString) GetRanges() []*CommitMatch_Range {
+// PerforceGetChangelistResponse returns information about the requested
+// changelist.
+type PerforceGetChangelistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changelist *PerforceChangelist `protobuf:"bytes,1,opt,name=changelist,proto3" json:"changelist,omitempty"`
+}
+
+func (x *PerforceGetChangelistResponse) Reset() {
+	*x = PerforceGetChangelistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerforceGetChangelistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerforceGetChangelistResponse) ProtoMessage() {}
+
+func (x *PerforceGetChangelistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerforceGetChangelistResponse.ProtoReflect.Descriptor instead.
+func (*PerforceGetChangelistResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PerforceGetChangelistResponse) GetChangelist() *PerforceChangelist {
 	if x != nil {
-		return x.Ranges
+		return x.Changelist
 	}
 	return nil
 }
 
-// TODO move this into a shared package
-type CommitMatch_Range struct {
+// PerforceChangelist represents a changelist in Perforce.
+type PerforceChangelist struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start *CommitMatch_Location `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
-	End   *CommitMatch_Location `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Id           string                                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreationDate *timestamppb.Timestamp                     `protobuf:"bytes,2,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	State        PerforceChangelist_PerforceChangelistState `protobuf:"varint,3,opt,name=state,proto3,enum=gitserver.v1.PerforceChangelist_PerforceChangelistState" json:"state,omitempty"`
+	Author       string                                     `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Title        string                                     `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Message      string                                     `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *CommitMatch_Range) Reset() {
-	*x = CommitMatch_Range{}
+func (x *PerforceChangelist) Reset() {
+	*x = PerforceChangelist{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[91]
+		mi := &file_gitserver_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommitMatch_Range) String() string {
+func (x *PerforceChangelist) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitMatch_Range) ProtoMessage() {}
+func (*PerforceChangelist) ProtoMessage() {}
 
-func (x *CommitMatch_Range) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[91]
+func (x *PerforceChangelist) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6007,52 +6545,80 @@ func (x *CommitMatch_Range) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitMatch_Range.ProtoReflect.Descriptor instead.
-func (*CommitMatch_Range) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{48, 2}
+// Deprecated: Use PerforceChangelist.ProtoReflect.Descriptor instead.
+func (*PerforceChangelist) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *CommitMatch_Range) GetStart() *CommitMatch_Location {
+func (x *PerforceChangelist) GetId() string {
 	if x != nil {
-		return x.Start
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *CommitMatch_Range) GetEnd() *CommitMatch_Location {
+func (x *PerforceChangelist) GetCreationDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.End
+		return x.CreationDate
 	}
 	return nil
 }
 
-type CommitMatch_Location struct {
+func (x *PerforceChangelist) GetState() PerforceChangelist_PerforceChangelistState {
+	if x != nil {
+		return x.State
+	}
+	return PerforceChangelist_PERFORCE_CHANGELIST_STATE_UNSPECIFIED
+}
+
+func (x *PerforceChangelist) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *PerforceChangelist) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PerforceChangelist) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// IsPerforceSuperUserRequest can be used to check if a given Perforce user is a
+// super user.
+type IsPerforceSuperUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offset uint32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Line   uint32 `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
-	Column uint32 `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
 }
 
-func (x *CommitMatch_Location) Reset() {
-	*x = CommitMatch_Location{}
+func (x *IsPerforceSuperUserRequest) Reset() {
+	*x = IsPerforceSuperUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gitserver_proto_msgTypes[92]
+		mi := &file_gitserver_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommitMatch_Location) String() string {
+func (x *IsPerforceSuperUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommitMatch_Location) ProtoMessage() {}
+func (*IsPerforceSuperUserRequest) ProtoMessage() {}
 
-func (x *CommitMatch_Location) ProtoReflect() protoreflect.Message {
-	mi := &file_gitserver_proto_msgTypes[92]
+func (x *IsPerforceSuperUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6063,1181 +6629,9479 @@ func (x *CommitMatch_Location) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommitMatch_Location.ProtoReflect.Descriptor instead.
-func (*CommitMatch_Location) Descriptor() ([]byte, []int) {
-	return file_gitserver_proto_rawDescGZIP(), []int{48, 3}
+// Deprecated: Use IsPerforceSuperUserRequest.ProtoReflect.Descriptor instead.
+func (*IsPerforceSuperUserRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *CommitMatch_Location) GetOffset() uint32 {
+func (x *IsPerforceSuperUserRequest) GetConnectionDetails() *PerforceConnectionDetails {
 	if x != nil {
-		return x.Offset
+		return x.ConnectionDetails
 	}
-	return 0
+	return nil
 }
 
-func (x *CommitMatch_Location) GetLine() uint32 {
-	if x != nil {
-		return x.Line
-	}
-	return 0
+// IsPerforceSuperUserResponse is the response from checking if a given Perforce
+// user is a super user.
+// No fields here, returning an error means "no".
+type IsPerforceSuperUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CommitMatch_Location) GetColumn() uint32 {
-	if x != nil {
-		return x.Column
+func (x *IsPerforceSuperUserResponse) Reset() {
+	*x = IsPerforceSuperUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-var File_gitserver_proto protoreflect.FileDescriptor
+func (x *IsPerforceSuperUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_gitserver_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x0c, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a,
-	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xcd, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x73, 0x4f, 0x6e, 0x6c, 0x79,
-	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x67, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x61, 0x67, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x28, 0x0a,
-	0x10, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x41,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x73, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x53, 0x68, 0x61, 0x88, 0x01, 0x01, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x73, 0x68, 0x61,
-	0x22, 0x3c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x22, 0xe0,
-	0x02, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x66,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65,
-	0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x5f, 0x6f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x66, 0x4f, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x07, 0x72, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07,
-	0x69, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69,
-	0x73, 0x48, 0x65, 0x61, 0x64, 0x22, 0x4a, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45,
-	0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x10, 0x01, 0x12,
-	0x10, 0x0a, 0x0c, 0x52, 0x45, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x47, 0x10,
-	0x02, 0x22, 0x92, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x76,
-	0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65, 0x76,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x2c, 0x0a, 0x0f, 0x65, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x72,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
-	0x0e, 0x65, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88,
-	0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x38, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61,
-	0x22, 0x7a, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65, 0x76, 0x53, 0x70, 0x65, 0x63, 0x12, 0x2e, 0x0a, 0x04,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x11,
-	0x52, 0x65, 0x76, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61,
-	0x22, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
-	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22,
-	0xbf, 0x01, 0x0a, 0x09, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12,
-	0x32, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x06, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x68, 0x0a, 0x0c, 0x47, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xe5, 0x01, 0x0a, 0x0c,
-	0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f,
-	0x77, 0x68, 0x69, 0x74, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x10, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x57, 0x68, 0x69, 0x74, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x72,
-	0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x61, 0x6e, 0x67,
-	0x65, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0a, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x6c,
-	0x69, 0x6e, 0x65, 0x22, 0x46, 0x0a, 0x0a, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0x3c, 0x0a, 0x0d, 0x42,
-	0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04,
-	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x48,
-	0x75, 0x6e, 0x6b, 0x52, 0x04, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0xe0, 0x02, 0x0a, 0x09, 0x42, 0x6c,
-	0x61, 0x6d, 0x65, 0x48, 0x75, 0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69,
-	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x79, 0x74, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x42, 0x79, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x06,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0f,
-	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x72, 0x65,
-	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x67, 0x0a, 0x0b,
-	0x42, 0x6c, 0x61, 0x6d, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x44, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
-	0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x14, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x66, 0x22, 0x4a, 0x0a,
-	0x15, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x5a, 0x0a, 0x0f, 0x52, 0x65, 0x61,
-	0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x26, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x11, 0x0a,
-	0x0f, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x75, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x65, 0x65, 0x53, 0x70,
-	0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f,
-	0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x74, 0x63,
-	0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x6c, 0x0a, 0x0a, 0x50,
-	0x75, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73,
-	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0xb6, 0x04, 0x0a, 0x22, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61,
-	0x74, 0x63, 0x68, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x57, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72,
-	0x6f, 0x6d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4e, 0x0a, 0x05, 0x70, 0x61, 0x74,
-	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x69, 0x6e,
-	0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68,
-	0x48, 0x00, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x1a, 0xbe, 0x02, 0x0a, 0x08, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65, 0x66, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x75, 0x73,
-	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x04, 0x70, 0x75, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x67, 0x69, 0x74, 0x5f, 0x61,
-	0x70, 0x70, 0x6c, 0x79, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0c, 0x67, 0x69, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e, 0x0a,
-	0x08, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x07, 0x70, 0x75, 0x73, 0x68, 0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x66, 0x1a, 0x1b, 0x0a, 0x05, 0x50, 0x61,
-	0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63,
-	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x22, 0x69, 0x0a, 0x23, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x69, 0x6e,
-	0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72,
-	0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x76, 0x12, 0x23, 0x0a,
-	0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74,
-	0x49, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x9b, 0x01, 0x0a, 0x0b, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
-	0x65, 0x70, 0x6f, 0x12, 0x2b, 0x0a, 0x0f, 0x65, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x02, 0x18, 0x01,
-	0x52, 0x0e, 0x65, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04,
-	0x61, 0x72, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6e, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x22, 0x0a,
-	0x0c, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x7c, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e,
-	0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x2a, 0x0a, 0x11,
-	0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x49, 0x6e,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x6e,
-	0x65, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x41, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x46, 0x6f,
-	0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65,
-	0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70,
-	0x65, 0x63, 0x22, 0x55, 0x0a, 0x13, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75,
-	0x6e, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a,
-	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x4c, 0x0a, 0x11, 0x45, 0x78, 0x65,
-	0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x22, 0x7c, 0x0a, 0x13, 0x55, 0x6e, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x88, 0x01,
-	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x80, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x3d, 0x0a, 0x09, 0x72,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
-	0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44,
-	0x69, 0x66, 0x66, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d,
-	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x3a, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x19, 0x0a,
-	0x08, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x72, 0x65, 0x76, 0x53, 0x70, 0x65, 0x63, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04,
-	0x08, 0x03, 0x10, 0x04, 0x22, 0x48, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4d, 0x61,
-	0x74, 0x63, 0x68, 0x65, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x70,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x1f, 0x0a,
-	0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x22, 0x4b,
-	0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68,
-	0x65, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67,
-	0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12,
-	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x4b, 0x0a, 0x0f, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x49, 0x0a, 0x12, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72,
-	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73,
-	0x65, 0x22, 0x46, 0x0a, 0x0f, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
-	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
-	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x14, 0x44, 0x69, 0x66,
-	0x66, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x64,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f,
-	0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x0b, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61,
-	0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x73, 0x0a, 0x0c, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x6b,
-	0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73,
-	0x22, 0x92, 0x05, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x48,
-	0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x65, 0x66, 0x6f,
-	0x72, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x61, 0x66, 0x74,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x66,
-	0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4e, 0x6f, 0x64,
-	0x65, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x6d, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x69, 0x66, 0x66,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x64, 0x69, 0x66, 0x66, 0x5f,
-	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x73, 0x46,
-	0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x64, 0x69, 0x66, 0x66, 0x4d,
-	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x62,
-	0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x65, 0x61, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65,
-	0x61, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x64, 0x65,
-	0x48, 0x00, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6d, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x09, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
-	0x08, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0xa9, 0x06, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x06, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x72, 0x65, 0x66, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72,
-	0x65, 0x66, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x66, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d,
-	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x65, 0x0a, 0x09,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x1a, 0x62, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37,
-	0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x1a, 0x77, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x12, 0x38, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x03, 0x65, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x65, 0x6e, 0x64,
-	0x1a, 0x4e, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x22, 0x89, 0x01, 0x0a, 0x0e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x65, 0x65, 0x69,
-	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x65, 0x65, 0x69, 0x73,
-	0x68, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22, 0x25, 0x0a, 0x0f,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x2c, 0x0a, 0x16, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f,
-	0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70,
-	0x6f, 0x22, 0x67, 0x0a, 0x17, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c,
-	0x6f, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x6e,
-	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x18, 0x52, 0x65,
-	0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e,
-	0x61, 0x6d, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73,
-	0x22, 0x86, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
-	0x0a, 0x11, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x6e, 0x65,
-	0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c,
-	0x6f, 0x6e, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x65, 0x70,
-	0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65,
-	0x70, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70,
-	0x6f, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x02, 0x18, 0x01, 0x52,
-	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xa8, 0x01, 0x0a,
-	0x12, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x65, 0x74, 0x63,
-	0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x47,
-	0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x67, 0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74, 0x65, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74, 0x65, 0x48,
-	0x6f, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x0c, 0x47, 0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x48, 0x0a, 0x14, 0x4c, 0x69, 0x73,
-	0x74, 0x47, 0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x05, 0x72, 0x65,
-	0x70, 0x6f, 0x73, 0x22, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x69, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x22, 0xd8, 0x01, 0x0a, 0x09, 0x47, 0x69, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
-	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69,
-	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0a, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x42, 0x4a, 0x45, 0x43,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
-	0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x47, 0x10,
-	0x02, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x54, 0x52, 0x45, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x42, 0x4a, 0x45, 0x43,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x42, 0x10, 0x04, 0x22, 0x97, 0x01,
-	0x0a, 0x1e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x56, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67,
-	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6f,
-	0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65,
-	0x70, 0x6f, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x21, 0x0a, 0x1f, 0x49, 0x73, 0x50, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x0a, 0x1f, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a,
-	0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65,
-	0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x19, 0x50, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x34, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x34, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x34, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x34, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x34, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x34, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x1c, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x47,
-	0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64,
-	0x22, 0x61, 0x0a, 0x1d, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c,
-	0x69, 0x73, 0x74, 0x22, 0xe1, 0x03, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x4e, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x67, 0x69, 0x74,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x50, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0xe1, 0x01, 0x0a, 0x17, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x29, 0x0a, 0x25, 0x50, 0x45, 0x52, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
-	0x47, 0x45, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x50, 0x45,
-	0x52, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x4c, 0x49, 0x53,
-	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x54, 0x45,
-	0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x45, 0x52, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f,
-	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x45,
-	0x52, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x4c, 0x49, 0x53,
-	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x48, 0x45, 0x4c, 0x56, 0x45, 0x44, 0x10,
-	0x03, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x45, 0x52, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43,
-	0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x04, 0x22, 0x74, 0x0a, 0x1a, 0x49, 0x73, 0x50, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x1d, 0x0a,
-	0x1b, 0x49, 0x73, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01, 0x0a,
-	0x1f, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
-	0x73, 0x46, 0x6f, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x56, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67,
-	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x6f,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x22, 0x5d,
-	0x0a, 0x20, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
-	0x74, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x22, 0x94, 0x01,
-	0x0a, 0x1e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
-	0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x56, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67,
-	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x1f, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x65,
-	0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
-	0x74, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50,
-	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x45, 0x78,
-	0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x1b,
-	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x3c, 0x0a, 0x1c, 0x50, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x14, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x56, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69,
-	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x30, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x22, 0x40, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x22, 0x57, 0x0a, 0x10, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
-	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x61,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x22, 0x46, 0x0a,
-	0x11, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x12, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x53, 0x68, 0x61, 0x2a, 0x71, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f,
-	0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
-	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4f,
-	0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4f, 0x52, 0x10,
-	0x02, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4b, 0x49,
-	0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x10, 0x03, 0x2a, 0x5f, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x52, 0x43,
-	0x48, 0x49, 0x56, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x52, 0x43,
-	0x48, 0x49, 0x56, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x5a, 0x49, 0x50, 0x10,
-	0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x46, 0x4f, 0x52,
-	0x4d, 0x41, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x10, 0x02, 0x32, 0xd0, 0x14, 0x0a, 0x10, 0x47, 0x69,
-	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x86,
-	0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46,
-	0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x30,
-	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61,
-	0x74, 0x63, 0x68, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x31, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d,
-	0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x4e, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x41, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12,
-	0x19, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x69, 0x74,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x63, 0x0a,
-	0x0f, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x24, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e,
-	0x65, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90,
-	0x02, 0x01, 0x12, 0x5a, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x6f, 0x6c, 0x69,
-	0x74, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x4a,
-	0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x07, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x11, 0x52, 0x65, 0x70,
-	0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26,
-	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x03, 0x90, 0x02, 0x01, 0x12, 0x51, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x02, 0x12, 0x7b, 0x0a,
-	0x17, 0x49, 0x73, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43,
-	0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x7e, 0x0a, 0x18, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x5d, 0x0a, 0x0d, 0x50, 0x65,
-	0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x67, 0x69,
-	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x7b, 0x0a, 0x17, 0x50, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x46, 0x6f, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x65, 0x63, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
-	0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x7e, 0x0a, 0x18, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x65, 0x70,
-	0x6f, 0x74, 0x12, 0x2d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
-	0x74, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
-	0x73, 0x46, 0x6f, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x72, 0x0a, 0x14, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x29,
-	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65,
-	0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x69, 0x74, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x6f, 0x0a, 0x13, 0x49, 0x73,
-	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x28, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x69,
-	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x75, 0x0a, 0x15, 0x50,
-	0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x6c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90,
-	0x02, 0x01, 0x12, 0x51, 0x0a, 0x09, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12,
-	0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x47, 0x0a, 0x05, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x69, 0x74,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x5d,
-	0x0a, 0x0d, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12,
-	0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x50, 0x0a,
-	0x08, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12,
-	0x51, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x2e, 0x67,
-	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67,
-	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90,
-	0x02, 0x01, 0x12, 0x63, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x69,
-	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c,
-	0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x02, 0x12, 0x50, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x66, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x09, 0x52, 0x65, 0x76,
-	0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x42, 0x3a, 0x5a, 0x38,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x67, 0x72, 0x61,
-	0x70, 0x68, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x69, 0x74, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (*IsPerforceSuperUserResponse) ProtoMessage() {}
+
+func (x *IsPerforceSuperUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var (
-	file_gitserver_proto_rawDescOnce sync.Once
-	file_gitserver_proto_rawDescData = file_gitserver_proto_rawDesc
-)
+// Deprecated: Use IsPerforceSuperUserResponse.ProtoReflect.Descriptor instead.
+func (*IsPerforceSuperUserResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{95}
+}
 
-func file_gitserver_proto_rawDescGZIP() []byte {
-	file_gitserver_proto_rawDescOnce.Do(func() {
-		file_gitserver_proto_rawDescData = protoimpl.X.CompressGZIP(file_gitserver_proto_rawDescData)
-	})
-	return file_gitserver_proto_rawDescData
+// PerforceProtectsForDepotRequest requests all the protections that apply to
+// the given depot.
+type PerforceProtectsForDepotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
+	Depot             string                     `protobuf:"bytes,2,opt,name=depot,proto3" json:"depot,omitempty"`
 }
 
-var file_gitserver_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_gitserver_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
-var file_gitserver_proto_goTypes = []interface{}{
-	(OperatorKind)(0),                                   // 0: gitserver.v1.OperatorKind
-	(ArchiveFormat)(0),                                  // 1: gitserver.v1.ArchiveFormat
-	(GitRef_RefType)(0),                                 // 2: gitserver.v1.GitRef.RefType
-	(GitObject_ObjectType)(0),                           // 3: gitserver.v1.GitObject.ObjectType
-	(PerforceChangelist_PerforceChangelistState)(0),     // 4: gitserver.v1.PerforceChangelist.PerforceChangelistState
-	(*ListRefsRequest)(nil),                             // 5: gitserver.v1.ListRefsRequest
-	(*ListRefsResponse)(nil),                            // 6: gitserver.v1.ListRefsResponse
-	(*GitRef)(nil),                                      // 7: gitserver.v1.GitRef
-	(*ResolveRevisionRequest)(nil),                      // 8: gitserver.v1.ResolveRevisionRequest
-	(*ResolveRevisionResponse)(nil),                     // 9: gitserver.v1.ResolveRevisionResponse
-	(*RevAtTimeRequest)(nil),                            // 10: gitserver.v1.RevAtTimeRequest
-	(*RevAtTimeResponse)(nil),                           // 11: gitserver.v1.RevAtTimeResponse
-	(*GetCommitRequest)(nil),                            // 12: gitserver.v1.GetCommitRequest
-	(*GetCommitResponse)(nil),                           // 13: gitserver.v1.GetCommitResponse
-	(*GitCommit)(nil),                                   // 14: gitserver.v1.GitCommit
-	(*GitSignature)(nil),                                // 15: gitserver.v1.GitSignature
-	(*BlameRequest)(nil),                                // 16: gitserver.v1.BlameRequest
-	(*BlameRange)(nil),                                  // 17: gitserver.v1.BlameRange
-	(*BlameResponse)(nil),                               // 18: gitserver.v1.BlameResponse
-	(*BlameHunk)(nil),                                   // 19: gitserver.v1.BlameHunk
-	(*BlameAuthor)(nil),                                 // 20: gitserver.v1.BlameAuthor
-	(*PreviousCommit)(nil),                              // 21: gitserver.v1.PreviousCommit
-	(*DefaultBranchRequest)(nil),                        // 22: gitserver.v1.DefaultBranchRequest
-	(*DefaultBranchResponse)(nil),                       // 23: gitserver.v1.DefaultBranchResponse
-	(*ReadFileRequest)(nil),                             // 24: gitserver.v1.ReadFileRequest
-	(*ReadFileResponse)(nil),                            // 25: gitserver.v1.ReadFileResponse
-	(*DiskInfoRequest)(nil),                             // 26: gitserver.v1.DiskInfoRequest
-	(*DiskInfoResponse)(nil),                            // 27: gitserver.v1.DiskInfoResponse
-	(*PatchCommitInfo)(nil),                             // 28: gitserver.v1.PatchCommitInfo
-	(*PushConfig)(nil),                                  // 29: gitserver.v1.PushConfig
-	(*CreateCommitFromPatchBinaryRequest)(nil),          // 30: gitserver.v1.CreateCommitFromPatchBinaryRequest
-	(*CreateCommitFromPatchError)(nil),                  // 31: gitserver.v1.CreateCommitFromPatchError
-	(*CreateCommitFromPatchBinaryResponse)(nil),         // 32: gitserver.v1.CreateCommitFromPatchBinaryResponse
-	(*ExecRequest)(nil),                                 // 33: gitserver.v1.ExecRequest
-	(*ExecResponse)(nil),                                // 34: gitserver.v1.ExecResponse
-	(*RepoNotFoundPayload)(nil),                         // 35: gitserver.v1.RepoNotFoundPayload
-	(*RevisionNotFoundPayload)(nil),                     // 36: gitserver.v1.RevisionNotFoundPayload
-	(*FileNotFoundPayload)(nil),                         // 37: gitserver.v1.FileNotFoundPayload
-	(*ExecStatusPayload)(nil),                           // 38: gitserver.v1.ExecStatusPayload
-	(*UnauthorizedPayload)(nil),                         // 39: gitserver.v1.UnauthorizedPayload
-	(*SearchRequest)(nil),                               // 40: gitserver.v1.SearchRequest
-	(*RevisionSpecifier)(nil),                           // 41: gitserver.v1.RevisionSpecifier
-	(*AuthorMatchesNode)(nil),                           // 42: gitserver.v1.AuthorMatchesNode
-	(*CommitterMatchesNode)(nil),                        // 43: gitserver.v1.CommitterMatchesNode
-	(*CommitBeforeNode)(nil),                            // 44: gitserver.v1.CommitBeforeNode
-	(*CommitAfterNode)(nil),                             // 45: gitserver.v1.CommitAfterNode
-	(*MessageMatchesNode)(nil),                          // 46: gitserver.v1.MessageMatchesNode
-	(*DiffMatchesNode)(nil),                             // 47: gitserver.v1.DiffMatchesNode
-	(*DiffModifiesFileNode)(nil),                        // 48: gitserver.v1.DiffModifiesFileNode
-	(*BooleanNode)(nil),                                 // 49: gitserver.v1.BooleanNode
-	(*OperatorNode)(nil),                                // 50: gitserver.v1.OperatorNode
-	(*QueryNode)(nil),                                   // 51: gitserver.v1.QueryNode
-	(*SearchResponse)(nil),                              // 52: gitserver.v1.SearchResponse
-	(*CommitMatch)(nil),                                 // 53: gitserver.v1.CommitMatch
-	(*ArchiveRequest)(nil),                              // 54: gitserver.v1.ArchiveRequest
-	(*ArchiveResponse)(nil),                             // 55: gitserver.v1.ArchiveResponse
-	(*IsRepoCloneableRequest)(nil),                      // 56: gitserver.v1.IsRepoCloneableRequest
-	(*IsRepoCloneableResponse)(nil),                     // 57: gitserver.v1.IsRepoCloneableResponse
-	(*RepoCloneProgressRequest)(nil),                    // 58: gitserver.v1.RepoCloneProgressRequest
-	(*RepoCloneProgressResponse)(nil),                   // 59: gitserver.v1.RepoCloneProgressResponse
-	(*RepoDeleteRequest)(nil),                           // 60: gitserver.v1.RepoDeleteRequest
-	(*RepoDeleteResponse)(nil),                          // 61: gitserver.v1.RepoDeleteResponse
-	(*RepoUpdateRequest)(nil),                           // 62: gitserver.v1.RepoUpdateRequest
-	(*RepoUpdateResponse)(nil),                          // 63: gitserver.v1.RepoUpdateResponse
-	(*ListGitoliteRequest)(nil),                         // 64: gitserver.v1.ListGitoliteRequest
-	(*GitoliteRepo)(nil),                                // 65: gitserver.v1.GitoliteRepo
-	(*ListGitoliteResponse)(nil),                        // 66: gitserver.v1.ListGitoliteResponse
-	(*GetObjectRequest)(nil),                            // 67: gitserver.v1.GetObjectRequest
-	(*GetObjectResponse)(nil),                           // 68: gitserver.v1.GetObjectResponse
-	(*GitObject)(nil),                                   // 69: gitserver.v1.GitObject
-	(*IsPerforcePathCloneableRequest)(nil),              // 70: gitserver.v1.IsPerforcePathCloneableRequest
-	(*IsPerforcePathCloneableResponse)(nil),             // 71: gitserver.v1.IsPerforcePathCloneableResponse
-	(*CheckPerforceCredentialsRequest)(nil),             // 72: gitserver.v1.CheckPerforceCredentialsRequest
-	(*CheckPerforceCredentialsResponse)(nil),            // 73: gitserver.v1.CheckPerforceCredentialsResponse
-	(*PerforceConnectionDetails)(nil),                   // 74: gitserver.v1.PerforceConnectionDetails
-	(*PerforceGetChangelistRequest)(nil),                // 75: gitserver.v1.PerforceGetChangelistRequest
-	(*PerforceGetChangelistResponse)(nil),               // 76: gitserver.v1.PerforceGetChangelistResponse
-	(*PerforceChangelist)(nil),                          // 77: gitserver.v1.PerforceChangelist
-	(*IsPerforceSuperUserRequest)(nil),                  // 78: gitserver.v1.IsPerforceSuperUserRequest
-	(*IsPerforceSuperUserResponse)(nil),                 // 79: gitserver.v1.IsPerforceSuperUserResponse
-	(*PerforceProtectsForDepotRequest)(nil),             // 80: gitserver.v1.PerforceProtectsForDepotRequest
-	(*PerforceProtectsForDepotResponse)(nil),            // 81: gitserver.v1.PerforceProtectsForDepotResponse
-	(*PerforceProtectsForUserRequest)(nil),              // 82: gitserver.v1.PerforceProtectsForUserRequest
-	(*PerforceProtectsForUserResponse)(nil),             // 83: gitserver.v1.PerforceProtectsForUserResponse
-	(*PerforceProtect)(nil),                             // 84: gitserver.v1.PerforceProtect
-	(*PerforceGroupMembersRequest)(nil),                 // 85: gitserver.v1.PerforceGroupMembersRequest
-	(*PerforceGroupMembersResponse)(nil),                // 86: gitserver.v1.PerforceGroupMembersResponse
-	(*PerforceUsersRequest)(nil),                        // 87: gitserver.v1.PerforceUsersRequest
-	(*PerforceUsersResponse)(nil),                       // 88: gitserver.v1.PerforceUsersResponse
-	(*PerforceUser)(nil),                                // 89: gitserver.v1.PerforceUser
-	(*MergeBaseRequest)(nil),                            // 90: gitserver.v1.MergeBaseRequest
-	(*MergeBaseResponse)(nil),                           // 91: gitserver.v1.MergeBaseResponse
-	(*CreateCommitFromPatchBinaryRequest_Metadata)(nil), // 92: gitserver.v1.CreateCommitFromPatchBinaryRequest.Metadata
-	(*CreateCommitFromPatchBinaryRequest_Patch)(nil),    // 93: gitserver.v1.CreateCommitFromPatchBinaryRequest.Patch
-	(*CommitMatch_Signature)(nil),                       // 94: gitserver.v1.CommitMatch.Signature
-	(*CommitMatch_MatchedString)(nil),                   // 95: gitserver.v1.CommitMatch.MatchedString
-	(*CommitMatch_Range)(nil),                           // 96: gitserver.v1.CommitMatch.Range
-	(*CommitMatch_Location)(nil),                        // 97: gitserver.v1.CommitMatch.Location
-	(*timestamppb.Timestamp)(nil),                       // 98: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),                         // 99: google.protobuf.Duration
+func (x *PerforceProtectsForDepotRequest) Reset() {
+	*x = PerforceProtectsForDepotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_gitserver_proto_depIdxs = []int32{
-	7,  // 0: gitserver.v1.ListRefsResponse.refs:type_name -> gitserver.v1.GitRef
-	98, // 1: gitserver.v1.GitRef.created_at:type_name -> google.protobuf.Timestamp
-	2,  // 2: gitserver.v1.GitRef.ref_type:type_name -> gitserver.v1.GitRef.RefType
-	98, // 3: gitserver.v1.RevAtTimeRequest.time:type_name -> google.protobuf.Timestamp
-	14, // 4: gitserver.v1.GetCommitResponse.commit:type_name -> gitserver.v1.GitCommit
-	15, // 5: gitserver.v1.GitCommit.author:type_name -> gitserver.v1.GitSignature
-	15, // 6: gitserver.v1.GitCommit.committer:type_name -> gitserver.v1.GitSignature
-	98, // 7: gitserver.v1.GitSignature.date:type_name -> google.protobuf.Timestamp
-	17, // 8: gitserver.v1.BlameRequest.range:type_name -> gitserver.v1.BlameRange
-	19, // 9: gitserver.v1.BlameResponse.hunk:type_name -> gitserver.v1.BlameHunk
-	20, // 10: gitserver.v1.BlameHunk.author:type_name -> gitserver.v1.BlameAuthor
-	21, // 11: gitserver.v1.BlameHunk.previous_commit:type_name -> gitserver.v1.PreviousCommit
-	98, // 12: gitserver.v1.BlameAuthor.date:type_name -> google.protobuf.Timestamp
-	98, // 13: gitserver.v1.PatchCommitInfo.date:type_name -> google.protobuf.Timestamp
-	92, // 14: gitserver.v1.CreateCommitFromPatchBinaryRequest.metadata:type_name -> gitserver.v1.CreateCommitFromPatchBinaryRequest.Metadata
-	93, // 15: gitserver.v1.CreateCommitFromPatchBinaryRequest.patch:type_name -> gitserver.v1.CreateCommitFromPatchBinaryRequest.Patch
-	41, // 16: gitserver.v1.SearchRequest.revisions:type_name -> gitserver.v1.RevisionSpecifier
-	51, // 17: gitserver.v1.SearchRequest.query:type_name -> gitserver.v1.QueryNode
-	98, // 18: gitserver.v1.CommitBeforeNode.timestamp:type_name -> google.protobuf.Timestamp
-	98, // 19: gitserver.v1.CommitAfterNode.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 20: gitserver.v1.OperatorNode.kind:type_name -> gitserver.v1.OperatorKind
-	51, // 21: gitserver.v1.OperatorNode.operands:type_name -> gitserver.v1.QueryNode
-	42, // 22: gitserver.v1.QueryNode.author_matches:type_name -> gitserver.v1.AuthorMatchesNode
-	43, // 23: gitserver.v1.QueryNode.committer_matches:type_name -> gitserver.v1.CommitterMatchesNode
-	44, // 24: gitserver.v1.QueryNode.commit_before:type_name -> gitserver.v1.CommitBeforeNode
-	45, // 25: gitserver.v1.QueryNode.commit_after:type_name -> gitserver.v1.CommitAfterNode
-	46, // 26: gitserver.v1.QueryNode.message_matches:type_name -> gitserver.v1.MessageMatchesNode
-	47, // 27: gitserver.v1.QueryNode.diff_matches:type_name -> gitserver.v1.DiffMatchesNode
-	48, // 28: gitserver.v1.QueryNode.diff_modifies_file:type_name -> gitserver.v1.DiffModifiesFileNode
-	49, // 29: gitserver.v1.QueryNode.boolean:type_name -> gitserver.v1.BooleanNode
-	50, // 30: gitserver.v1.QueryNode.operator:type_name -> gitserver.v1.OperatorNode
-	53, // 31: gitserver.v1.SearchResponse.match:type_name -> gitserver.v1.CommitMatch
-	94, // 32: gitserver.v1.CommitMatch.author:type_name -> gitserver.v1.CommitMatch.Signature
-	94, // 33: gitserver.v1.CommitMatch.committer:type_name -> gitserver.v1.CommitMatch.Signature
-	95, // 34: gitserver.v1.CommitMatch.message:type_name -> gitserver.v1.CommitMatch.MatchedString
-	95, // 35: gitserver.v1.CommitMatch.diff:type_name -> gitserver.v1.CommitMatch.MatchedString
-	1,  // 36: gitserver.v1.ArchiveRequest.format:type_name -> gitserver.v1.ArchiveFormat
-	99, // 37: gitserver.v1.RepoUpdateRequest.since:type_name -> google.protobuf.Duration
-	98, // 38: gitserver.v1.RepoUpdateResponse.last_fetched:type_name -> google.protobuf.Timestamp
-	98, // 39: gitserver.v1.RepoUpdateResponse.last_changed:type_name -> google.protobuf.Timestamp
-	65, // 40: gitserver.v1.ListGitoliteResponse.repos:type_name -> gitserver.v1.GitoliteRepo
-	69, // 41: gitserver.v1.GetObjectResponse.object:type_name -> gitserver.v1.GitObject
-	3,  // 42: gitserver.v1.GitObject.type:type_name -> gitserver.v1.GitObject.ObjectType
-	74, // 43: gitserver.v1.IsPerforcePathCloneableRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
-	74, // 44: gitserver.v1.CheckPerforceCredentialsRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
-	74, // 45: gitserver.v1.PerforceGetChangelistRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
-	77, // 46: gitserver.v1.PerforceGetChangelistResponse.changelist:type_name -> gitserver.v1.PerforceChangelist
-	98, // 47: gitserver.v1.PerforceChangelist.creation_date:type_name -> google.protobuf.Timestamp
-	4,  // 48: gitserver.v1.PerforceChangelist.state:type_name -> gitserver.v1.PerforceChangelist.PerforceChangelistState
-	74, // 49: gitserver.v1.IsPerforceSuperUserRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
-	74, // 50: gitserver.v1.PerforceProtectsForDepotRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
-	84, // 51: gitserver.v1.PerforceProtectsForDepotResponse.protects:type_name -> gitserver.v1.PerforceProtect
-	74, // 52: gitserver.v1.PerforceProtectsForUserRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
-	84, // 53: gitserver.v1.PerforceProtectsForUserResponse.protects:type_name -> gitserver.v1.PerforceProtect
-	74, // 54: gitserver.v1.PerforceGroupMembersRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
-	74, // 55: gitserver.v1.PerforceUsersRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
-	89, // 56: gitserver.v1.PerforceUsersResponse.users:type_name -> gitserver.v1.PerforceUser
-	28, // 57: gitserver.v1.CreateCommitFromPatchBinaryRequest.Metadata.commit_info:type_name -> gitserver.v1.PatchCommitInfo
-	29, // 58: gitserver.v1.CreateCommitFromPatchBinaryRequest.Metadata.push:type_name -> gitserver.v1.PushConfig
-	98, // 59: gitserver.v1.CommitMatch.Signature.date:type_name -> google.protobuf.Timestamp
-	96, // 60: gitserver.v1.CommitMatch.MatchedString.ranges:type_name -> gitserver.v1.CommitMatch.Range
-	97, // 61: gitserver.v1.CommitMatch.Range.start:type_name -> gitserver.v1.CommitMatch.Location
-	97, // 62: gitserver.v1.CommitMatch.Range.end:type_name -> gitserver.v1.CommitMatch.Location
-	30, // 63: gitserver.v1.GitserverService.CreateCommitFromPatchBinary:input_type -> gitserver.v1.CreateCommitFromPatchBinaryRequest
-	26, // 64: gitserver.v1.GitserverService.DiskInfo:input_type -> gitserver.v1.DiskInfoRequest
-	33, // 65: gitserver.v1.GitserverService.Exec:input_type -> gitserver.v1.ExecRequest
-	67, // 66: gitserver.v1.GitserverService.GetObject:input_type -> gitserver.v1.GetObjectRequest
-	56, // 67: gitserver.v1.GitserverService.IsRepoCloneable:input_type -> gitserver.v1.IsRepoCloneableRequest
-	64, // 68: gitserver.v1.GitserverService.ListGitolite:input_type -> gitserver.v1.ListGitoliteRequest
-	40, // 69: gitserver.v1.GitserverService.Search:input_type -> gitserver.v1.SearchRequest
-	54, // 70: gitserver.v1.GitserverService.Archive:input_type -> gitserver.v1.ArchiveRequest
-	58, // 71: gitserver.v1.GitserverService.RepoCloneProgress:input_type -> gitserver.v1.RepoCloneProgressRequest
-	60, // 72: gitserver.v1.GitserverService.RepoDelete:input_type -> gitserver.v1.RepoDeleteRequest
-	62, // 73: gitserver.v1.GitserverService.RepoUpdate:input_type -> gitserver.v1.RepoUpdateRequest
-	70, // 74: gitserver.v1.GitserverService.IsPerforcePathCloneable:input_type -> gitserver.v1.IsPerforcePathCloneableRequest
-	72, // 75: gitserver.v1.GitserverService.CheckPerforceCredentials:input_type -> gitserver.v1.CheckPerforceCredentialsRequest
-	87, // 76: gitserver.v1.GitserverService.PerforceUsers:input_type -> gitserver.v1.PerforceUsersRequest
-	82, // 77: gitserver.v1.GitserverService.PerforceProtectsForUser:input_type -> gitserver.v1.PerforceProtectsForUserRequest
-	80, // 78: gitserver.v1.GitserverService.PerforceProtectsForDepot:input_type -> gitserver.v1.PerforceProtectsForDepotRequest
-	85, // 79: gitserver.v1.GitserverService.PerforceGroupMembers:input_type -> gitserver.v1.PerforceGroupMembersRequest
-	78, // 80: gitserver.v1.GitserverService.IsPerforceSuperUser:input_type -> gitserver.v1.IsPerforceSuperUserRequest
-	75, // 81: gitserver.v1.GitserverService.PerforceGetChangelist:input_type -> gitserver.v1.PerforceGetChangelistRequest
-	90, // 82: gitserver.v1.GitserverService.MergeBase:input_type -> gitserver.v1.MergeBaseRequest
-	16, // 83: gitserver.v1.GitserverService.Blame:input_type -> gitserver.v1.BlameRequest
-	22, // 84: gitserver.v1.GitserverService.DefaultBranch:input_type -> gitserver.v1.DefaultBranchRequest
-	24, // 85: gitserver.v1.GitserverService.ReadFile:input_type -> gitserver.v1.ReadFileRequest
-	12, // 86: gitserver.v1.GitserverService.GetCommit:input_type -> gitserver.v1.GetCommitRequest
-	8,  // 87: gitserver.v1.GitserverService.ResolveRevision:input_type -> gitserver.v1.ResolveRevisionRequest
-	5,  // 88: gitserver.v1.GitserverService.ListRefs:input_type -> gitserver.v1.ListRefsRequest
-	10, // 89: gitserver.v1.GitserverService.RevAtTime:input_type -> gitserver.v1.RevAtTimeRequest
-	32, // 90: gitserver.v1.GitserverService.CreateCommitFromPatchBinary:output_type -> gitserver.v1.CreateCommitFromPatchBinaryResponse
-	27, // 91: gitserver.v1.GitserverService.DiskInfo:output_type -> gitserver.v1.DiskInfoResponse
-	34, // 92: gitserver.v1.GitserverService.Exec:output_type -> gitserver.v1.ExecResponse
-	68, // 93: gitserver.v1.GitserverService.GetObject:output_type -> gitserver.v1.GetObjectResponse
-	57, // 94: gitserver.v1.GitserverService.IsRepoCloneable:output_type -> gitserver.v1.IsRepoCloneableResponse
-	66, // 95: gitserver.v1.GitserverService.ListGitolite:output_type -> gitserver.v1.ListGitoliteResponse
-	52, // 96: gitserver.v1.GitserverService.Search:output_type -> gitserver.v1.SearchResponse
-	55, // 97: gitserver.v1.GitserverService.Archive:output_type -> gitserver.v1.ArchiveResponse
-	59, // 98: gitserver.v1.GitserverService.RepoCloneProgress:output_type -> gitserver.v1.RepoCloneProgressResponse
-	61, // 99: gitserver.v1.GitserverService.RepoDelete:output_type -> gitserver.v1.RepoDeleteResponse
-	63, // 100: gitserver.v1.GitserverService.RepoUpdate:output_type -> gitserver.v1.RepoUpdateResponse
-	71, // 101: gitserver.v1.GitserverService.IsPerforcePathCloneable:output_type -> gitserver.v1.IsPerforcePathCloneableResponse
-	73, // 102: gitserver.v1.GitserverService.CheckPerforceCredentials:output_type -> gitserver.v1.CheckPerforceCredentialsResponse
-	88, // 103: gitserver.v1.GitserverService.PerforceUsers:output_type -> gitserver.v1.PerforceUsersResponse
-	83, // 104: gitserver.v1.GitserverService.PerforceProtectsForUser:output_type -> gitserver.v1.PerforceProtectsForUserResponse
-	81, // 105: gitserver.v1.GitserverService.PerforceProtectsForDepot:output_type -> gitserver.v1.PerforceProtectsForDepotResponse
-	86, // 106: gitserver.v1.GitserverService.PerforceGroupMembers:output_type -> gitserver.v1.PerforceGroupMembersResponse
-	79, // 107: gitserver.v1.GitserverService.IsPerforceSuperUser:output_type -> gitserver.v1.IsPerforceSuperUserResponse
-	76, // 108: gitserver.v1.GitserverService.PerforceGetChangelist:output_type -> gitserver.v1.PerforceGetChangelistResponse
-	91, // 109: gitserver.v1.GitserverService.MergeBase:output_type -> gitserver.v1.MergeBaseResponse
-	18, // 110: gitserver.v1.GitserverService.Blame:output_type -> gitserver.v1.BlameResponse
-	23, // 111: gitserver.v1.GitserverService.DefaultBranch:output_type -> gitserver.v1.DefaultBranchResponse
-	25, // 112: gitserver.v1.GitserverService.ReadFile:output_type -> gitserver.v1.ReadFileResponse
-	13, // 113: gitserver.v1.GitserverService.GetCommit:output_type -> gitserver.v1.GetCommitResponse
-	9,  // 114: gitserver.v1.GitserverService.ResolveRevision:output_type -> gitserver.v1.ResolveRevisionResponse
-	6,  // 115: gitserver.v1.GitserverService.ListRefs:output_type -> gitserver.v1.ListRefsResponse
-	11, // 116: gitserver.v1.GitserverService.RevAtTime:output_type -> gitserver.v1.RevAtTimeResponse
-	90, // [90:117] is the sub-list for method output_type
-	63, // [63:90] is the sub-list for method input_type
-	63, // [63:63] is the sub-list for extension type_name
-	63, // [63:63] is the sub-list for extension extendee
-	0,  // [0:63] is the sub-list for field type_name
+
+func (x *PerforceProtectsForDepotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_gitserver_proto_init() }
-func file_gitserver_proto_init() {
-	if File_gitserver_proto != nil {
-		return
+func (*PerforceProtectsForDepotRequest) ProtoMessage() {}
+
+func (x *PerforceProtectsForDepotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_gitserver_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRefsRequest); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerforceProtectsForDepotRequest.ProtoReflect.Descriptor instead.
+func (*PerforceProtectsForDepotRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PerforceProtectsForDepotRequest) GetConnectionDetails() *PerforceConnectionDetails {
+	if x != nil {
+		return x.ConnectionDetails
+	}
+	return nil
+}
+
+func (x *PerforceProtectsForDepotRequest) GetDepot() string {
+	if x != nil {
+		return x.Depot
+	}
+	return ""
+}
+
+// PerforceProtectsForDepotResponse returns all the protections that apply to
+// the given depot.
+type PerforceProtectsForDepotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protects []*PerforceProtect `protobuf:"bytes,1,rep,name=protects,proto3" json:"protects,omitempty"`
+}
+
+func (x *PerforceProtectsForDepotResponse) Reset() {
+	*x = PerforceProtectsForDepotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerforceProtectsForDepotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerforceProtectsForDepotResponse) ProtoMessage() {}
+
+func (x *PerforceProtectsForDepotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerforceProtectsForDepotResponse.ProtoReflect.Descriptor instead.
+func (*PerforceProtectsForDepotResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PerforceProtectsForDepotResponse) GetProtects() []*PerforceProtect {
+	if x != nil {
+		return x.Protects
+	}
+	return nil
+}
+
+// PerforceProtectsForUserRequest requests all the protections that apply to the
+// given user.
+type PerforceProtectsForUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
+	Username          string                     `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *PerforceProtectsForUserRequest) Reset() {
+	*x = PerforceProtectsForUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerforceProtectsForUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerforceProtectsForUserRequest) ProtoMessage() {}
+
+func (x *PerforceProtectsForUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerforceProtectsForUserRequest.ProtoReflect.Descriptor instead.
+func (*PerforceProtectsForUserRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *PerforceProtectsForUserRequest) GetConnectionDetails() *PerforceConnectionDetails {
+	if x != nil {
+		return x.ConnectionDetails
+	}
+	return nil
+}
+
+func (x *PerforceProtectsForUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// PerforceProtectsForUserResponse returns all the protections that apply to the
+// given user.
+type PerforceProtectsForUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protects []*PerforceProtect `protobuf:"bytes,1,rep,name=protects,proto3" json:"protects,omitempty"`
+}
+
+func (x *PerforceProtectsForUserResponse) Reset() {
+	*x = PerforceProtectsForUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerforceProtectsForUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerforceProtectsForUserResponse) ProtoMessage() {}
+
+func (x *PerforceProtectsForUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerforceProtectsForUserResponse.ProtoReflect.Descriptor instead.
+func (*PerforceProtectsForUserResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *PerforceProtectsForUserResponse) GetProtects() []*PerforceProtect {
+	if x != nil {
+		return x.Protects
+	}
+	return nil
+}
+
+// PerforceProtect is a single line definition of a protection in Perforce.
+type PerforceProtect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level       string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	EntityType  string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityName  string `protobuf:"bytes,3,opt,name=entity_name,json=entityName,proto3" json:"entity_name,omitempty"`
+	Match       string `protobuf:"bytes,4,opt,name=match,proto3" json:"match,omitempty"`
+	IsExclusion bool   `protobuf:"varint,5,opt,name=is_exclusion,json=isExclusion,proto3" json:"is_exclusion,omitempty"`
+	Host        string `protobuf:"bytes,6,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *PerforceProtect) Reset() {
+	*x = PerforceProtect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerforceProtect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerforceProtect) ProtoMessage() {}
+
+func (x *PerforceProtect) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerforceProtect.ProtoReflect.Descriptor instead.
+func (*PerforceProtect) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *PerforceProtect) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *PerforceProtect) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *PerforceProtect) GetEntityName() string {
+	if x != nil {
+		return x.EntityName
+	}
+	return ""
+}
+
+func (x *PerforceProtect) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *PerforceProtect) GetIsExclusion() bool {
+	if x != nil {
+		return x.IsExclusion
+	}
+	return false
+}
+
+func (x *PerforceProtect) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+// PerforceGroupMembersRequest requests the members of the given Perforce group.
+type PerforceGroupMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
+	Group             string                     `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *PerforceGroupMembersRequest) Reset() {
+	*x = PerforceGroupMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerforceGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerforceGroupMembersRequest) ProtoMessage() {}
+
+func (x *PerforceGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerforceGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*PerforceGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PerforceGroupMembersRequest) GetConnectionDetails() *PerforceConnectionDetails {
+	if x != nil {
+		return x.ConnectionDetails
+	}
+	return nil
+}
+
+func (x *PerforceGroupMembersRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+// PerforceGroupMembersResponse returns the members of the requested Perforce
+// group.
+type PerforceGroupMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usernames []string `protobuf:"bytes,1,rep,name=usernames,proto3" json:"usernames,omitempty"`
+}
+
+func (x *PerforceGroupMembersResponse) Reset() {
+	*x = PerforceGroupMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerforceGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerforceGroupMembersResponse) ProtoMessage() {}
+
+func (x *PerforceGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerforceGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*PerforceGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *PerforceGroupMembersResponse) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+// PerforceUsersRequest lists all the users known to the Perforce server.
+type PerforceUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectionDetails *PerforceConnectionDetails `protobuf:"bytes,1,opt,name=connection_details,json=connectionDetails,proto3" json:"connection_details,omitempty"`
+}
+
+func (x *PerforceUsersRequest) Reset() {
+	*x = PerforceUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerforceUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerforceUsersRequest) ProtoMessage() {}
+
+func (x *PerforceUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerforceUsersRequest.ProtoReflect.Descriptor instead.
+func (*PerforceUsersRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *PerforceUsersRequest) GetConnectionDetails() *PerforceConnectionDetails {
+	if x != nil {
+		return x.ConnectionDetails
+	}
+	return nil
+}
+
+// PerforceUsersResponse contains the list of users known by the server.
+type PerforceUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*PerforceUser `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *PerforceUsersResponse) Reset() {
+	*x = PerforceUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerforceUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerforceUsersResponse) ProtoMessage() {}
+
+func (x *PerforceUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerforceUsersResponse.ProtoReflect.Descriptor instead.
+func (*PerforceUsersResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *PerforceUsersResponse) GetUsers() []*PerforceUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// PerforceUser is a representation of a user account in Perforce.
+type PerforceUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *PerforceUser) Reset() {
+	*x = PerforceUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerforceUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerforceUser) ProtoMessage() {}
+
+func (x *PerforceUser) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerforceUser.ProtoReflect.Descriptor instead.
+func (*PerforceUser) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *PerforceUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PerforceUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// MergeBaseRequest is a request to find the merge base of two revspecs.
+type MergeBaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to get the merge base for.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// base is a revspec, for now, we allow non-utf8 revspecs.
+	Base []byte `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	// head is a revspec, for now, we allow non-utf8 revspecs.
+	Head []byte `protobuf:"bytes,4,opt,name=head,proto3" json:"head,omitempty"`
+}
+
+func (x *MergeBaseRequest) Reset() {
+	*x = MergeBaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeBaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeBaseRequest) ProtoMessage() {}
+
+func (x *MergeBaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeBaseRequest.ProtoReflect.Descriptor instead.
+func (*MergeBaseRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *MergeBaseRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *MergeBaseRequest) GetBase() []byte {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *MergeBaseRequest) GetHead() []byte {
+	if x != nil {
+		return x.Head
+	}
+	return nil
+}
+
+// MergeBaseResponse is the response from finding the merge base of two
+// revspecs.
+type MergeBaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MergeBaseCommitSha string `protobuf:"bytes,1,opt,name=merge_base_commit_sha,json=mergeBaseCommitSha,proto3" json:"merge_base_commit_sha,omitempty"`
+}
+
+func (x *MergeBaseResponse) Reset() {
+	*x = MergeBaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeBaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeBaseResponse) ProtoMessage() {}
+
+func (x *MergeBaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeBaseResponse.ProtoReflect.Descriptor instead.
+func (*MergeBaseResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *MergeBaseResponse) GetMergeBaseCommitSha() string {
+	if x != nil {
+		return x.MergeBaseCommitSha
+	}
+	return ""
+}
+
+// FormatPatchRequest is a request to export a range of commits as a patch
+// series.
+type FormatPatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to format patches in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// base is the revspec marking the exclusive start of the range. Commits
+	// reachable from base are not part of the patch series.
+	// For now, we allow non-utf8 revspecs.
+	Base []byte `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	// head is the revspec marking the inclusive end of the range.
+	// For now, we allow non-utf8 revspecs.
+	Head []byte `protobuf:"bytes,4,opt,name=head,proto3" json:"head,omitempty"`
+}
+
+func (x *FormatPatchRequest) Reset() {
+	*x = FormatPatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FormatPatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatPatchRequest) ProtoMessage() {}
+
+func (x *FormatPatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatPatchRequest.ProtoReflect.Descriptor instead.
+func (*FormatPatchRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *FormatPatchRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *FormatPatchRequest) GetBase() []byte {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *FormatPatchRequest) GetHead() []byte {
+	if x != nil {
+		return x.Head
+	}
+	return nil
+}
+
+// FormatPatchResponse is a chunk of the mbox-formatted patch series.
+type FormatPatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *FormatPatchResponse) Reset() {
+	*x = FormatPatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FormatPatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatPatchResponse) ProtoMessage() {}
+
+func (x *FormatPatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatPatchResponse.ProtoReflect.Descriptor instead.
+func (*FormatPatchResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *FormatPatchResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CommitGraphRequest is a request to list the parent relationships of a range
+// of commits.
+type CommitGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to list the commit graph of.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// head is the revspec whose history is listed.
+	// For now, we allow non-utf8 revspecs.
+	Head []byte `protobuf:"bytes,3,opt,name=head,proto3" json:"head,omitempty"`
+	// base is an optional revspec. Commits reachable from base are not listed.
+	// For now, we allow non-utf8 revspecs.
+	Base []byte `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"`
+	// max_commits limits the number of commits returned, if non-zero.
+	MaxCommits uint32 `protobuf:"varint,5,opt,name=max_commits,json=maxCommits,proto3" json:"max_commits,omitempty"`
+}
+
+func (x *CommitGraphRequest) Reset() {
+	*x = CommitGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitGraphRequest) ProtoMessage() {}
+
+func (x *CommitGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitGraphRequest.ProtoReflect.Descriptor instead.
+func (*CommitGraphRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CommitGraphRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CommitGraphRequest) GetHead() []byte {
+	if x != nil {
+		return x.Head
+	}
+	return nil
+}
+
+func (x *CommitGraphRequest) GetBase() []byte {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CommitGraphRequest) GetMaxCommits() uint32 {
+	if x != nil {
+		return x.MaxCommits
+	}
+	return 0
+}
+
+// CommitGraphResponse is a chunk of the commit graph.
+type CommitGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*CommitGraphNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *CommitGraphResponse) Reset() {
+	*x = CommitGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitGraphResponse) ProtoMessage() {}
+
+func (x *CommitGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitGraphResponse.ProtoReflect.Descriptor instead.
+func (*CommitGraphResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *CommitGraphResponse) GetNodes() []*CommitGraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// CherryRequest is a request to find the commits of a branch that are already
+// present upstream.
+type CherryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to compare the branches in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// upstream is the revspec of the branch to look for equivalent changes in.
+	// For now, we allow non-utf8 revspecs.
+	Upstream []byte `protobuf:"bytes,3,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	// head is the revspec of the branch whose commits are checked.
+	// For now, we allow non-utf8 revspecs.
+	Head []byte `protobuf:"bytes,4,opt,name=head,proto3" json:"head,omitempty"`
+}
+
+func (x *CherryRequest) Reset() {
+	*x = CherryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CherryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CherryRequest) ProtoMessage() {}
+
+func (x *CherryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CherryRequest.ProtoReflect.Descriptor instead.
+func (*CherryRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *CherryRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CherryRequest) GetUpstream() []byte {
+	if x != nil {
+		return x.Upstream
+	}
+	return nil
+}
+
+func (x *CherryRequest) GetHead() []byte {
+	if x != nil {
+		return x.Head
+	}
+	return nil
+}
+
+// CherryResponse is the response from comparing two branches by patch ID.
+type CherryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commits []*CherryCommit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+}
+
+func (x *CherryResponse) Reset() {
+	*x = CherryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CherryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CherryResponse) ProtoMessage() {}
+
+func (x *CherryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CherryResponse.ProtoReflect.Descriptor instead.
+func (*CherryResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CherryResponse) GetCommits() []*CherryCommit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+// CherryCommit is a commit of the compared branch.
+type CherryCommit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitSha string `protobuf:"bytes,1,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	// in_upstream is true if a commit with the same patch ID exists in
+	// upstream.
+	InUpstream bool `protobuf:"varint,2,opt,name=in_upstream,json=inUpstream,proto3" json:"in_upstream,omitempty"`
+}
+
+func (x *CherryCommit) Reset() {
+	*x = CherryCommit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CherryCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CherryCommit) ProtoMessage() {}
+
+func (x *CherryCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CherryCommit.ProtoReflect.Descriptor instead.
+func (*CherryCommit) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CherryCommit) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *CherryCommit) GetInUpstream() bool {
+	if x != nil {
+		return x.InUpstream
+	}
+	return false
+}
+
+// RangeDiffRequest is a request to compare two commit series.
+type RangeDiffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to compare the series in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// old_base is the revspec marking the exclusive start of the old series.
+	// For now, we allow non-utf8 revspecs.
+	OldBase []byte `protobuf:"bytes,3,opt,name=old_base,json=oldBase,proto3" json:"old_base,omitempty"`
+	// old_head is the revspec marking the inclusive end of the old series.
+	// For now, we allow non-utf8 revspecs.
+	OldHead []byte `protobuf:"bytes,4,opt,name=old_head,json=oldHead,proto3" json:"old_head,omitempty"`
+	// new_base is the revspec marking the exclusive start of the new series.
+	// For now, we allow non-utf8 revspecs.
+	NewBase []byte `protobuf:"bytes,5,opt,name=new_base,json=newBase,proto3" json:"new_base,omitempty"`
+	// new_head is the revspec marking the inclusive end of the new series.
+	// For now, we allow non-utf8 revspecs.
+	NewHead []byte `protobuf:"bytes,6,opt,name=new_head,json=newHead,proto3" json:"new_head,omitempty"`
+}
+
+func (x *RangeDiffRequest) Reset() {
+	*x = RangeDiffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeDiffRequest) ProtoMessage() {}
+
+func (x *RangeDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeDiffRequest.ProtoReflect.Descriptor instead.
+func (*RangeDiffRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *RangeDiffRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *RangeDiffRequest) GetOldBase() []byte {
+	if x != nil {
+		return x.OldBase
+	}
+	return nil
+}
+
+func (x *RangeDiffRequest) GetOldHead() []byte {
+	if x != nil {
+		return x.OldHead
+	}
+	return nil
+}
+
+func (x *RangeDiffRequest) GetNewBase() []byte {
+	if x != nil {
+		return x.NewBase
+	}
+	return nil
+}
+
+func (x *RangeDiffRequest) GetNewHead() []byte {
+	if x != nil {
+		return x.NewHead
+	}
+	return nil
+}
+
+// RangeDiffResponse is the response from comparing two commit series.
+type RangeDiffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pairs are the matched commits, in the order of the new series. Commits
+	// that were removed from the old series are listed where the old series
+	// had them.
+	Pairs []*RangeDiffPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (x *RangeDiffResponse) Reset() {
+	*x = RangeDiffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeDiffResponse) ProtoMessage() {}
+
+func (x *RangeDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeDiffResponse.ProtoReflect.Descriptor instead.
+func (*RangeDiffResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *RangeDiffResponse) GetPairs() []*RangeDiffPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+// RangeDiffPair is a commit of one series and its counterpart in the other
+// series.
+type RangeDiffPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RangeDiffPair_Status `protobuf:"varint,1,opt,name=status,proto3,enum=gitserver.v1.RangeDiffPair_Status" json:"status,omitempty"`
+	// old_index is the 1-based position of the commit in the old series, or 0
+	// if it was added.
+	OldIndex uint32 `protobuf:"varint,2,opt,name=old_index,json=oldIndex,proto3" json:"old_index,omitempty"`
+	// old_commit_sha is the commit in the old series, or empty if it was added.
+	OldCommitSha string `protobuf:"bytes,3,opt,name=old_commit_sha,json=oldCommitSha,proto3" json:"old_commit_sha,omitempty"`
+	// new_index is the 1-based position of the commit in the new series, or 0
+	// if it was removed.
+	NewIndex uint32 `protobuf:"varint,4,opt,name=new_index,json=newIndex,proto3" json:"new_index,omitempty"`
+	// new_commit_sha is the commit in the new series, or empty if it was
+	// removed.
+	NewCommitSha string `protobuf:"bytes,5,opt,name=new_commit_sha,json=newCommitSha,proto3" json:"new_commit_sha,omitempty"`
+	// subject is the subject of the commit message.
+	Subject []byte `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	// diff is the difference between the two patches, if status is
+	// STATUS_MODIFIED.
+	Diff []byte `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *RangeDiffPair) Reset() {
+	*x = RangeDiffPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeDiffPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeDiffPair) ProtoMessage() {}
+
+func (x *RangeDiffPair) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeDiffPair.ProtoReflect.Descriptor instead.
+func (*RangeDiffPair) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *RangeDiffPair) GetStatus() RangeDiffPair_Status {
+	if x != nil {
+		return x.Status
+	}
+	return RangeDiffPair_STATUS_UNSPECIFIED
+}
+
+func (x *RangeDiffPair) GetOldIndex() uint32 {
+	if x != nil {
+		return x.OldIndex
+	}
+	return 0
+}
+
+func (x *RangeDiffPair) GetOldCommitSha() string {
+	if x != nil {
+		return x.OldCommitSha
+	}
+	return ""
+}
+
+func (x *RangeDiffPair) GetNewIndex() uint32 {
+	if x != nil {
+		return x.NewIndex
+	}
+	return 0
+}
+
+func (x *RangeDiffPair) GetNewCommitSha() string {
+	if x != nil {
+		return x.NewCommitSha
+	}
+	return ""
+}
+
+func (x *RangeDiffPair) GetSubject() []byte {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *RangeDiffPair) GetDiff() []byte {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+// CommitGraphNode is a commit and its parents.
+type CommitGraphNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitSha string `protobuf:"bytes,1,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	// parent_shas are the SHAs of the parents of the commit, in order. The
+	// first parent is the commit that was checked out when the commit was
+	// created.
+	ParentShas []string `protobuf:"bytes,2,rep,name=parent_shas,json=parentShas,proto3" json:"parent_shas,omitempty"`
+}
+
+func (x *CommitGraphNode) Reset() {
+	*x = CommitGraphNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitGraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitGraphNode) ProtoMessage() {}
+
+func (x *CommitGraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitGraphNode.ProtoReflect.Descriptor instead.
+func (*CommitGraphNode) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *CommitGraphNode) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *CommitGraphNode) GetParentShas() []string {
+	if x != nil {
+		return x.ParentShas
+	}
+	return nil
+}
+
+// UpdateRefRequest is a request to update a ref with compare-and-swap
+// semantics.
+type UpdateRefRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to update the ref in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// ref_name is the fully qualified name of the ref to update, for example
+	// refs/heads/main.
+	RefName string `protobuf:"bytes,3,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
+	// new_commit_sha is the full commit SHA the ref should point to after the
+	// update.
+	NewCommitSha string `protobuf:"bytes,4,opt,name=new_commit_sha,json=newCommitSha,proto3" json:"new_commit_sha,omitempty"`
+	// expected_old_commit_sha is the full commit SHA the ref is expected to point
+	// to before the update. If empty, the ref is expected to not exist.
+	ExpectedOldCommitSha string `protobuf:"bytes,5,opt,name=expected_old_commit_sha,json=expectedOldCommitSha,proto3" json:"expected_old_commit_sha,omitempty"`
+}
+
+func (x *UpdateRefRequest) Reset() {
+	*x = UpdateRefRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRefRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRefRequest) ProtoMessage() {}
+
+func (x *UpdateRefRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRefRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRefRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *UpdateRefRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *UpdateRefRequest) GetRefName() string {
+	if x != nil {
+		return x.RefName
+	}
+	return ""
+}
+
+func (x *UpdateRefRequest) GetNewCommitSha() string {
+	if x != nil {
+		return x.NewCommitSha
+	}
+	return ""
+}
+
+func (x *UpdateRefRequest) GetExpectedOldCommitSha() string {
+	if x != nil {
+		return x.ExpectedOldCommitSha
+	}
+	return ""
+}
+
+type UpdateRefResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateRefResponse) Reset() {
+	*x = UpdateRefResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRefResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRefResponse) ProtoMessage() {}
+
+func (x *UpdateRefResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRefResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRefResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{120}
+}
+
+type CreateBranchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to create the branch in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// branch_name is the short name of the branch to create, for example
+	// my-feature.
+	BranchName string `protobuf:"bytes,3,opt,name=branch_name,json=branchName,proto3" json:"branch_name,omitempty"`
+	// start_point is the revspec the new branch should point at. If empty, HEAD
+	// is used.
+	StartPoint []byte `protobuf:"bytes,4,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty"`
+}
+
+func (x *CreateBranchRequest) Reset() {
+	*x = CreateBranchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBranchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBranchRequest) ProtoMessage() {}
+
+func (x *CreateBranchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBranchRequest.ProtoReflect.Descriptor instead.
+func (*CreateBranchRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CreateBranchRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CreateBranchRequest) GetBranchName() string {
+	if x != nil {
+		return x.BranchName
+	}
+	return ""
+}
+
+func (x *CreateBranchRequest) GetStartPoint() []byte {
+	if x != nil {
+		return x.StartPoint
+	}
+	return nil
+}
+
+type CreateBranchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// commit_sha is the commit the new branch points at.
+	CommitSha string `protobuf:"bytes,1,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+}
+
+func (x *CreateBranchResponse) Reset() {
+	*x = CreateBranchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBranchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBranchResponse) ProtoMessage() {}
+
+func (x *CreateBranchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBranchResponse.ProtoReflect.Descriptor instead.
+func (*CreateBranchResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CreateBranchResponse) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+type DeleteBranchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to delete the branch in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// branch_name is the short name of the branch to delete, for example
+	// my-feature.
+	BranchName string `protobuf:"bytes,3,opt,name=branch_name,json=branchName,proto3" json:"branch_name,omitempty"`
+}
+
+func (x *DeleteBranchRequest) Reset() {
+	*x = DeleteBranchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBranchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBranchRequest) ProtoMessage() {}
+
+func (x *DeleteBranchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBranchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBranchRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *DeleteBranchRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *DeleteBranchRequest) GetBranchName() string {
+	if x != nil {
+		return x.BranchName
+	}
+	return ""
+}
+
+type DeleteBranchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteBranchResponse) Reset() {
+	*x = DeleteBranchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBranchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBranchResponse) ProtoMessage() {}
+
+func (x *DeleteBranchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBranchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBranchResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{124}
+}
+
+type CreateTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to create the tag in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// tag_name is the short name of the tag to create, for example v1.2.3.
+	TagName string `protobuf:"bytes,3,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	// target is the revspec of the commit the tag should point at.
+	Target []byte `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	// message is the message of an annotated tag. Required if sign is set.
+	Message []byte `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// tagger is the identity recorded in an annotated tag. Required for
+	// annotated tags. The date defaults to the current time if not set.
+	Tagger *GitSignature `protobuf:"bytes,6,opt,name=tagger,proto3" json:"tagger,omitempty"`
+	// sign makes gitserver create a signed tag.
+	Sign bool `protobuf:"varint,7,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagRequest) ProtoMessage() {}
+
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *CreateTagRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CreateTagRequest) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *CreateTagRequest) GetTarget() []byte {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *CreateTagRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *CreateTagRequest) GetTagger() *GitSignature {
+	if x != nil {
+		return x.Tagger
+	}
+	return nil
+}
+
+func (x *CreateTagRequest) GetSign() bool {
+	if x != nil {
+		return x.Sign
+	}
+	return false
+}
+
+type CreateTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateTagResponse) Reset() {
+	*x = CreateTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagResponse) ProtoMessage() {}
+
+func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagResponse.ProtoReflect.Descriptor instead.
+func (*CreateTagResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{126}
+}
+
+type SymbolicRefRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to read the symbolic ref in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// ref_name is HEAD or the fully qualified name of a symbolic ref, for
+	// example refs/remotes/origin/HEAD.
+	RefName string `protobuf:"bytes,3,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
+}
+
+func (x *SymbolicRefRequest) Reset() {
+	*x = SymbolicRefRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SymbolicRefRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolicRefRequest) ProtoMessage() {}
+
+func (x *SymbolicRefRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolicRefRequest.ProtoReflect.Descriptor instead.
+func (*SymbolicRefRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *SymbolicRefRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *SymbolicRefRequest) GetRefName() string {
+	if x != nil {
+		return x.RefName
+	}
+	return ""
+}
+
+type SymbolicRefResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// target is the fully qualified name of the ref ref_name points to. Empty
+	// if ref_name is not a symbolic ref.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *SymbolicRefResponse) Reset() {
+	*x = SymbolicRefResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SymbolicRefResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolicRefResponse) ProtoMessage() {}
+
+func (x *SymbolicRefResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolicRefResponse.ProtoReflect.Descriptor instead.
+func (*SymbolicRefResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *SymbolicRefResponse) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type RefExistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to look up the ref in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// ref_name is HEAD or a fully qualified ref name, for example
+	// refs/heads/main.
+	RefName string `protobuf:"bytes,3,opt,name=ref_name,json=refName,proto3" json:"ref_name,omitempty"`
+}
+
+func (x *RefExistsRequest) Reset() {
+	*x = RefExistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefExistsRequest) ProtoMessage() {}
+
+func (x *RefExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefExistsRequest.ProtoReflect.Descriptor instead.
+func (*RefExistsRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *RefExistsRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *RefExistsRequest) GetRefName() string {
+	if x != nil {
+		return x.RefName
+	}
+	return ""
+}
+
+type RefExistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists bool `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+}
+
+func (x *RefExistsResponse) Reset() {
+	*x = RefExistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefExistsResponse) ProtoMessage() {}
+
+func (x *RefExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefExistsResponse.ProtoReflect.Descriptor instead.
+func (*RefExistsResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *RefExistsResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+type ListBranchesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to list the branches of.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// base is the revspec behind/ahead counts are computed against. Defaults to
+	// HEAD.
+	Base []byte `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+}
+
+func (x *ListBranchesRequest) Reset() {
+	*x = ListBranchesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBranchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBranchesRequest) ProtoMessage() {}
+
+func (x *ListBranchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBranchesRequest.ProtoReflect.Descriptor instead.
+func (*ListBranchesRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ListBranchesRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *ListBranchesRequest) GetBase() []byte {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListBranchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Branches []*GitBranch `protobuf:"bytes,1,rep,name=branches,proto3" json:"branches,omitempty"`
+}
+
+func (x *ListBranchesResponse) Reset() {
+	*x = ListBranchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBranchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBranchesResponse) ProtoMessage() {}
+
+func (x *ListBranchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBranchesResponse.ProtoReflect.Descriptor instead.
+func (*ListBranchesResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListBranchesResponse) GetBranches() []*GitBranch {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+type GitBranch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref *GitRef `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// behind_ahead is unset if the base doesn't exist.
+	BehindAhead *BehindAhead `protobuf:"bytes,2,opt,name=behind_ahead,json=behindAhead,proto3" json:"behind_ahead,omitempty"`
+	// commit is the commit the branch points at. Unset if the actor can't see
+	// it because of sub-repo permissions.
+	Commit *GitCommit `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (x *GitBranch) Reset() {
+	*x = GitBranch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitBranch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitBranch) ProtoMessage() {}
+
+func (x *GitBranch) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitBranch.ProtoReflect.Descriptor instead.
+func (*GitBranch) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GitBranch) GetRef() *GitRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *GitBranch) GetBehindAhead() *BehindAhead {
+	if x != nil {
+		return x.BehindAhead
+	}
+	return nil
+}
+
+func (x *GitBranch) GetCommit() *GitCommit {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+type BehindAhead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Behind uint32 `protobuf:"varint,1,opt,name=behind,proto3" json:"behind,omitempty"`
+	Ahead  uint32 `protobuf:"varint,2,opt,name=ahead,proto3" json:"ahead,omitempty"`
+}
+
+func (x *BehindAhead) Reset() {
+	*x = BehindAhead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BehindAhead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BehindAhead) ProtoMessage() {}
+
+func (x *BehindAhead) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BehindAhead.ProtoReflect.Descriptor instead.
+func (*BehindAhead) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *BehindAhead) GetBehind() uint32 {
+	if x != nil {
+		return x.Behind
+	}
+	return 0
+}
+
+func (x *BehindAhead) GetAhead() uint32 {
+	if x != nil {
+		return x.Ahead
+	}
+	return 0
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to list the tags of.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// patterns are glob patterns for the tag names, like "v1.*". If set, only
+	// tags matching any of them are returned.
+	Patterns []string `protobuf:"bytes,3,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	// sort_by_version sorts the tags by their names interpreted as versions,
+	// highest first. Pre-releases like v1.0.0-rc.1 are lower than v1.0.0.
+	SortByVersion bool `protobuf:"varint,4,opt,name=sort_by_version,json=sortByVersion,proto3" json:"sort_by_version,omitempty"`
+	// limit is the maximum number of tags to return. Zero means no limit.
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ListTagsRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *ListTagsRequest) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *ListTagsRequest) GetSortByVersion() bool {
+	if x != nil {
+		return x.SortByVersion
+	}
+	return false
+}
+
+func (x *ListTagsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Refs []*GitRef `protobuf:"bytes,1,rep,name=refs,proto3" json:"refs,omitempty"`
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ListTagsResponse) GetRefs() []*GitRef {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+type CloneProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to get the clone progress of.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+}
+
+func (x *CloneProgressRequest) Reset() {
+	*x = CloneProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneProgressRequest) ProtoMessage() {}
+
+func (x *CloneProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneProgressRequest.ProtoReflect.Descriptor instead.
+func (*CloneProgressRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CloneProgressRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+type CloneProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// clone_in_progress is whether the repository is currently being cloned.
+	CloneInProgress bool `protobuf:"varint,1,opt,name=clone_in_progress,json=cloneInProgress,proto3" json:"clone_in_progress,omitempty"`
+	// cloned is whether the repository has been cloned successfully.
+	Cloned bool `protobuf:"varint,2,opt,name=cloned,proto3" json:"cloned,omitempty"`
+	// message is the last progress message of the running clone.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// phase is the phase of git's progress output the message is about, like
+	// "Receiving objects". It is empty if the message is no git progress line.
+	Phase string `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	// percent is the completion of the phase, from 0 to 100.
+	Percent uint32 `protobuf:"varint,5,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (x *CloneProgressResponse) Reset() {
+	*x = CloneProgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneProgressResponse) ProtoMessage() {}
+
+func (x *CloneProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneProgressResponse.ProtoReflect.Descriptor instead.
+func (*CloneProgressResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *CloneProgressResponse) GetCloneInProgress() bool {
+	if x != nil {
+		return x.CloneInProgress
+	}
+	return false
+}
+
+func (x *CloneProgressResponse) GetCloned() bool {
+	if x != nil {
+		return x.Cloned
+	}
+	return false
+}
+
+func (x *CloneProgressResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CloneProgressResponse) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *CloneProgressResponse) GetPercent() uint32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type OptimizeRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to optimize.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// gc runs git gc.
+	Gc bool `protobuf:"varint,3,opt,name=gc,proto3" json:"gc,omitempty"`
+	// repack repacks all objects into a single pack with a bitmap index.
+	Repack bool `protobuf:"varint,4,opt,name=repack,proto3" json:"repack,omitempty"`
+	// commit_graph writes the commit-graph file.
+	CommitGraph bool `protobuf:"varint,5,opt,name=commit_graph,json=commitGraph,proto3" json:"commit_graph,omitempty"`
+}
+
+func (x *OptimizeRepoRequest) Reset() {
+	*x = OptimizeRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizeRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeRepoRequest) ProtoMessage() {}
+
+func (x *OptimizeRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeRepoRequest.ProtoReflect.Descriptor instead.
+func (*OptimizeRepoRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *OptimizeRepoRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *OptimizeRepoRequest) GetGc() bool {
+	if x != nil {
+		return x.Gc
+	}
+	return false
+}
+
+func (x *OptimizeRepoRequest) GetRepack() bool {
+	if x != nil {
+		return x.Repack
+	}
+	return false
+}
+
+func (x *OptimizeRepoRequest) GetCommitGraph() bool {
+	if x != nil {
+		return x.CommitGraph
+	}
+	return false
+}
+
+type OptimizeRepoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tasks are the tasks that ran, in order.
+	Tasks []*MaintenanceTaskResult `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+}
+
+func (x *OptimizeRepoResponse) Reset() {
+	*x = OptimizeRepoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizeRepoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeRepoResponse) ProtoMessage() {}
+
+func (x *OptimizeRepoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeRepoResponse.ProtoReflect.Descriptor instead.
+func (*OptimizeRepoResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *OptimizeRepoResponse) GetTasks() []*MaintenanceTaskResult {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type MaintenanceTaskResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// task is the name of the task, like "gc".
+	Task string `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// duration is how long the task took.
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *MaintenanceTaskResult) Reset() {
+	*x = MaintenanceTaskResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceTaskResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceTaskResult) ProtoMessage() {}
+
+func (x *MaintenanceTaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceTaskResult.ProtoReflect.Descriptor instead.
+func (*MaintenanceTaskResult) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *MaintenanceTaskResult) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *MaintenanceTaskResult) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type CommitGraphStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to inspect.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+}
+
+func (x *CommitGraphStatusRequest) Reset() {
+	*x = CommitGraphStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitGraphStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitGraphStatusRequest) ProtoMessage() {}
+
+func (x *CommitGraphStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitGraphStatusRequest.ProtoReflect.Descriptor instead.
+func (*CommitGraphStatusRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *CommitGraphStatusRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+type CommitGraphStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// present is true if the repository has a commit-graph. All other fields
+	// are only set if it does.
+	Present bool `protobuf:"varint,1,opt,name=present,proto3" json:"present,omitempty"`
+	// layers is the number of files of the commit-graph, which is more than
+	// one if it was written incrementally.
+	Layers uint32 `protobuf:"varint,2,opt,name=layers,proto3" json:"layers,omitempty"`
+	// commits is the number of commits in the commit-graph.
+	Commits uint32 `protobuf:"varint,3,opt,name=commits,proto3" json:"commits,omitempty"`
+	// generation_version is 2 if all layers store corrected commit dates as
+	// generation numbers, and 1 if only topological levels are available.
+	GenerationVersion uint32 `protobuf:"varint,4,opt,name=generation_version,json=generationVersion,proto3" json:"generation_version,omitempty"`
+	// changed_path_filters is true if all layers store changed-path Bloom
+	// filters.
+	ChangedPathFilters bool `protobuf:"varint,5,opt,name=changed_path_filters,json=changedPathFilters,proto3" json:"changed_path_filters,omitempty"`
+}
+
+func (x *CommitGraphStatusResponse) Reset() {
+	*x = CommitGraphStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitGraphStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitGraphStatusResponse) ProtoMessage() {}
+
+func (x *CommitGraphStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitGraphStatusResponse.ProtoReflect.Descriptor instead.
+func (*CommitGraphStatusResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *CommitGraphStatusResponse) GetPresent() bool {
+	if x != nil {
+		return x.Present
+	}
+	return false
+}
+
+func (x *CommitGraphStatusResponse) GetLayers() uint32 {
+	if x != nil {
+		return x.Layers
+	}
+	return 0
+}
+
+func (x *CommitGraphStatusResponse) GetCommits() uint32 {
+	if x != nil {
+		return x.Commits
+	}
+	return 0
+}
+
+func (x *CommitGraphStatusResponse) GetGenerationVersion() uint32 {
+	if x != nil {
+		return x.GenerationVersion
+	}
+	return 0
+}
+
+func (x *CommitGraphStatusResponse) GetChangedPathFilters() bool {
+	if x != nil {
+		return x.ChangedPathFilters
+	}
+	return false
+}
+
+type CloneStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to inspect.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+}
+
+func (x *CloneStateRequest) Reset() {
+	*x = CloneStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneStateRequest) ProtoMessage() {}
+
+func (x *CloneStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneStateRequest.ProtoReflect.Descriptor instead.
+func (*CloneStateRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *CloneStateRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+type CloneStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// shallow is true if the history of the repository is truncated.
+	Shallow bool `protobuf:"varint,1,opt,name=shallow,proto3" json:"shallow,omitempty"`
+	// shallow_commits are the boundary commits of a shallow clone, whose
+	// parents are not in the repository.
+	ShallowCommits []string `protobuf:"bytes,2,rep,name=shallow_commits,json=shallowCommits,proto3" json:"shallow_commits,omitempty"`
+	// depth is the number of commits in the first-parent history of HEAD of a
+	// shallow clone.
+	Depth uint32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// partial is true if the repository is a partial clone, which omits the
+	// objects matching filter.
+	Partial bool `protobuf:"varint,4,opt,name=partial,proto3" json:"partial,omitempty"`
+	// filter is the object filter spec of a partial clone, like "blob:none".
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *CloneStateResponse) Reset() {
+	*x = CloneStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneStateResponse) ProtoMessage() {}
+
+func (x *CloneStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneStateResponse.ProtoReflect.Descriptor instead.
+func (*CloneStateResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *CloneStateResponse) GetShallow() bool {
+	if x != nil {
+		return x.Shallow
+	}
+	return false
+}
+
+func (x *CloneStateResponse) GetShallowCommits() []string {
+	if x != nil {
+		return x.ShallowCommits
+	}
+	return nil
+}
+
+func (x *CloneStateResponse) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *CloneStateResponse) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+func (x *CloneStateResponse) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ListReposRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListReposRequest) Reset() {
+	*x = ListReposRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReposRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReposRequest) ProtoMessage() {}
+
+func (x *ListReposRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReposRequest.ProtoReflect.Descriptor instead.
+func (*ListReposRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{146}
+}
+
+type ListReposResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repos []*HostedRepo `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+}
+
+func (x *ListReposResponse) Reset() {
+	*x = ListReposResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReposResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReposResponse) ProtoMessage() {}
+
+func (x *ListReposResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReposResponse.ProtoReflect.Descriptor instead.
+func (*ListReposResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ListReposResponse) GetRepos() []*HostedRepo {
+	if x != nil {
+		return x.Repos
+	}
+	return nil
+}
+
+type HostedRepo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the repo.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// size_bytes is the size of the repo directory on disk.
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// last_fetched is the time of the last successful fetch, or of the clone if
+	// the repo was never fetched since.
+	LastFetched *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_fetched,json=lastFetched,proto3" json:"last_fetched,omitempty"`
+}
+
+func (x *HostedRepo) Reset() {
+	*x = HostedRepo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostedRepo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostedRepo) ProtoMessage() {}
+
+func (x *HostedRepo) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostedRepo.ProtoReflect.Descriptor instead.
+func (*HostedRepo) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *HostedRepo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HostedRepo) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *HostedRepo) GetLastFetched() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFetched
+	}
+	return nil
+}
+
+type ReadBlobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to read the blob from.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// oid is the full object ID of the blob.
+	Oid string `protobuf:"bytes,3,opt,name=oid,proto3" json:"oid,omitempty"`
+}
+
+func (x *ReadBlobRequest) Reset() {
+	*x = ReadBlobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadBlobRequest) ProtoMessage() {}
+
+func (x *ReadBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadBlobRequest.ProtoReflect.Descriptor instead.
+func (*ReadBlobRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ReadBlobRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *ReadBlobRequest) GetOid() string {
+	if x != nil {
+		return x.Oid
+	}
+	return ""
+}
+
+type ReadBlobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ReadBlobResponse) Reset() {
+	*x = ReadBlobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadBlobResponse) ProtoMessage() {}
+
+func (x *ReadBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadBlobResponse.ProtoReflect.Descriptor instead.
+func (*ReadBlobResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *ReadBlobResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// GetTreeRequest is a request to list one level of a tree.
+type GetTreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to read the tree from.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// treeish is the revspec of the commit or tree to read.
+	// For now, we allow non-utf8 revspecs.
+	Treeish []byte `protobuf:"bytes,3,opt,name=treeish,proto3" json:"treeish,omitempty"`
+	// path is the path of the directory to list, relative to the root of the
+	// treeish. If empty, the root is listed.
+	Path []byte `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetTreeRequest) Reset() {
+	*x = GetTreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreeRequest) ProtoMessage() {}
+
+func (x *GetTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetTreeRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetTreeRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *GetTreeRequest) GetTreeish() []byte {
+	if x != nil {
+		return x.Treeish
+	}
+	return nil
+}
+
+func (x *GetTreeRequest) GetPath() []byte {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type GetTreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*TreeEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetTreeResponse) Reset() {
+	*x = GetTreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreeResponse) ProtoMessage() {}
+
+func (x *GetTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetTreeResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetTreeResponse) GetEntries() []*TreeEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// TreeEntry is an entry of a git tree object.
+type TreeEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the entry, without the path of the tree it is in.
+	Name []byte `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// mode is the git file mode of the entry, like 0100644 for a regular file.
+	Mode uint32 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// object is the object the entry points to. For submodules, it is a commit
+	// of another repository.
+	Object *GitObject `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	// size is the size of the blob in bytes, and 0 for other entries.
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *TreeEntry) Reset() {
+	*x = TreeEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TreeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeEntry) ProtoMessage() {}
+
+func (x *TreeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeEntry.ProtoReflect.Descriptor instead.
+func (*TreeEntry) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *TreeEntry) GetName() []byte {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *TreeEntry) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *TreeEntry) GetObject() *GitObject {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *TreeEntry) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// SparseManifestRequest is a request to list the files of a sparse checkout.
+type SparseManifestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to read the files from.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// commit_sha is the commit to read the files from.
+	CommitSha string `protobuf:"bytes,3,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	// paths are the files and directories to check out, relative to the root
+	// of the repository. They are matched literally, like the directories of a
+	// cone mode sparse checkout. If empty, all files of the commit are listed.
+	Paths [][]byte `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *SparseManifestRequest) Reset() {
+	*x = SparseManifestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SparseManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SparseManifestRequest) ProtoMessage() {}
+
+func (x *SparseManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SparseManifestRequest.ProtoReflect.Descriptor instead.
+func (*SparseManifestRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *SparseManifestRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *SparseManifestRequest) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *SparseManifestRequest) GetPaths() [][]byte {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type SparseManifestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// entries are the files of the sparse checkout. Their names are the full
+	// paths of the files, relative to the root of the repository.
+	Entries []*TreeEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *SparseManifestResponse) Reset() {
+	*x = SparseManifestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SparseManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SparseManifestResponse) ProtoMessage() {}
+
+func (x *SparseManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SparseManifestResponse.ProtoReflect.Descriptor instead.
+func (*SparseManifestResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *SparseManifestResponse) GetEntries() []*TreeEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type LastCommitsForTreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to read the tree from.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// commit_sha is the commit to read the tree and history from.
+	CommitSha string `protobuf:"bytes,3,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	// path is the path of the directory to list, relative to the root of the
+	// repository. If empty, the root is listed.
+	Path []byte `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *LastCommitsForTreeRequest) Reset() {
+	*x = LastCommitsForTreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastCommitsForTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastCommitsForTreeRequest) ProtoMessage() {}
+
+func (x *LastCommitsForTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastCommitsForTreeRequest.ProtoReflect.Descriptor instead.
+func (*LastCommitsForTreeRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *LastCommitsForTreeRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *LastCommitsForTreeRequest) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *LastCommitsForTreeRequest) GetPath() []byte {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type LastCommitsForTreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*TreeEntryCommit `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *LastCommitsForTreeResponse) Reset() {
+	*x = LastCommitsForTreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LastCommitsForTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LastCommitsForTreeResponse) ProtoMessage() {}
+
+func (x *LastCommitsForTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LastCommitsForTreeResponse.ProtoReflect.Descriptor instead.
+func (*LastCommitsForTreeResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *LastCommitsForTreeResponse) GetEntries() []*TreeEntryCommit {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type TreeEntryCommit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *TreeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// commit is the last commit that changed the entry. Unset if it is beyond
+	// the history of a shallow clone.
+	Commit *GitCommit `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (x *TreeEntryCommit) Reset() {
+	*x = TreeEntryCommit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TreeEntryCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeEntryCommit) ProtoMessage() {}
+
+func (x *TreeEntryCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeEntryCommit.ProtoReflect.Descriptor instead.
+func (*TreeEntryCommit) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *TreeEntryCommit) GetEntry() *TreeEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *TreeEntryCommit) GetCommit() *GitCommit {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+type CommitActivityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to count commits in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// revspec is the revision whose history is counted. If empty, HEAD is used.
+	Revspec []byte `protobuf:"bytes,3,opt,name=revspec,proto3" json:"revspec,omitempty"`
+	// path optionally limits the count to commits that changed something at or
+	// below this path. It is matched literally.
+	Path []byte `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// after and before optionally limit the range of committer dates counted.
+	// If set, the buckets span the whole range, including empty ones at both
+	// ends. Otherwise they start or end with the oldest or newest commit.
+	After  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`
+	Before *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	// interval is the size of the buckets. It must be set.
+	Interval CommitActivityInterval `protobuf:"varint,7,opt,name=interval,proto3,enum=gitserver.v1.CommitActivityInterval" json:"interval,omitempty"`
+}
+
+func (x *CommitActivityRequest) Reset() {
+	*x = CommitActivityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitActivityRequest) ProtoMessage() {}
+
+func (x *CommitActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitActivityRequest.ProtoReflect.Descriptor instead.
+func (*CommitActivityRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *CommitActivityRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CommitActivityRequest) GetRevspec() []byte {
+	if x != nil {
+		return x.Revspec
+	}
+	return nil
+}
+
+func (x *CommitActivityRequest) GetPath() []byte {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *CommitActivityRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CommitActivityRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CommitActivityRequest) GetInterval() CommitActivityInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return CommitActivityInterval_COMMIT_ACTIVITY_INTERVAL_UNSPECIFIED
+}
+
+type CommitActivityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// buckets are consecutive, oldest first.
+	Buckets []*CommitActivityBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *CommitActivityResponse) Reset() {
+	*x = CommitActivityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitActivityResponse) ProtoMessage() {}
+
+func (x *CommitActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitActivityResponse.ProtoReflect.Descriptor instead.
+func (*CommitActivityResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *CommitActivityResponse) GetBuckets() []*CommitActivityBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type CommitActivityBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start is the start of the day or week of the bucket, at midnight UTC.
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CommitActivityBucket) Reset() {
+	*x = CommitActivityBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitActivityBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitActivityBucket) ProtoMessage() {}
+
+func (x *CommitActivityBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitActivityBucket.ProtoReflect.Descriptor instead.
+func (*CommitActivityBucket) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *CommitActivityBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CommitActivityBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// GetTagRequest is a request to get a tag.
+type GetTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to get the tag from.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// tag_name is the name of the tag, without the refs/tags/ prefix.
+	TagName string `protobuf:"bytes,3,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+}
+
+func (x *GetTagRequest) Reset() {
+	*x = GetTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagRequest) ProtoMessage() {}
+
+func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagRequest.ProtoReflect.Descriptor instead.
+func (*GetTagRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *GetTagRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *GetTagRequest) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+type GetTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag *GitTag `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *GetTagResponse) Reset() {
+	*x = GetTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagResponse) ProtoMessage() {}
+
+func (x *GetTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagResponse.ProtoReflect.Descriptor instead.
+func (*GetTagResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *GetTagResponse) GetTag() *GitTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// GitTag is a tag. Only name and target are set for lightweight tags.
+type GitTag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the tag, without the refs/tags/ prefix.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// oid is the object ID of the tag object of an annotated tag.
+	Oid string `protobuf:"bytes,2,opt,name=oid,proto3" json:"oid,omitempty"`
+	// target is the object the tag points to.
+	Target *GitObject `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// tagger is the identity that created an annotated tag, if recorded.
+	Tagger *GitSignature `protobuf:"bytes,4,opt,name=tagger,proto3" json:"tagger,omitempty"`
+	// message is the message of the tag, without the signature.
+	Message []byte `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// signature is the ASCII-armored signature of a signed tag.
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GitTag) Reset() {
+	*x = GitTag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitTag) ProtoMessage() {}
+
+func (x *GitTag) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitTag.ProtoReflect.Descriptor instead.
+func (*GitTag) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *GitTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GitTag) GetOid() string {
+	if x != nil {
+		return x.Oid
+	}
+	return ""
+}
+
+func (x *GitTag) GetTarget() *GitObject {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *GitTag) GetTagger() *GitSignature {
+	if x != nil {
+		return x.Tagger
+	}
+	return nil
+}
+
+func (x *GitTag) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *GitTag) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type ListRemoteRefsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to list the upstream refs of.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+}
+
+func (x *ListRemoteRefsRequest) Reset() {
+	*x = ListRemoteRefsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRemoteRefsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRemoteRefsRequest) ProtoMessage() {}
+
+func (x *ListRemoteRefsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRemoteRefsRequest.ProtoReflect.Descriptor instead.
+func (*ListRemoteRefsRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *ListRemoteRefsRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+type ListRemoteRefsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// refs are upstream refs. The remote doesn't tell when a ref changed, so
+	// created_at is always the zero time.
+	Refs []*GitRef `protobuf:"bytes,1,rep,name=refs,proto3" json:"refs,omitempty"`
+}
+
+func (x *ListRemoteRefsResponse) Reset() {
+	*x = ListRemoteRefsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRemoteRefsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRemoteRefsResponse) ProtoMessage() {}
+
+func (x *ListRemoteRefsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRemoteRefsResponse.ProtoReflect.Descriptor instead.
+func (*ListRemoteRefsResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *ListRemoteRefsResponse) GetRefs() []*GitRef {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+type FetchCommitFromRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to fetch the commit into.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// source_repo_name is the name of the repo to fetch the commit from.
+	SourceRepoName string `protobuf:"bytes,3,opt,name=source_repo_name,json=sourceRepoName,proto3" json:"source_repo_name,omitempty"`
+	// commit is the full SHA of the commit to fetch.
+	Commit string `protobuf:"bytes,4,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (x *FetchCommitFromRepoRequest) Reset() {
+	*x = FetchCommitFromRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchCommitFromRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchCommitFromRepoRequest) ProtoMessage() {}
+
+func (x *FetchCommitFromRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchCommitFromRepoRequest.ProtoReflect.Descriptor instead.
+func (*FetchCommitFromRepoRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *FetchCommitFromRepoRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *FetchCommitFromRepoRequest) GetSourceRepoName() string {
+	if x != nil {
+		return x.SourceRepoName
+	}
+	return ""
+}
+
+func (x *FetchCommitFromRepoRequest) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+type FetchCommitFromRepoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fetched is false if repo_name already had the commit.
+	Fetched bool `protobuf:"varint,1,opt,name=fetched,proto3" json:"fetched,omitempty"`
+}
+
+func (x *FetchCommitFromRepoResponse) Reset() {
+	*x = FetchCommitFromRepoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchCommitFromRepoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchCommitFromRepoResponse) ProtoMessage() {}
+
+func (x *FetchCommitFromRepoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchCommitFromRepoResponse.ProtoReflect.Descriptor instead.
+func (*FetchCommitFromRepoResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *FetchCommitFromRepoResponse) GetFetched() bool {
+	if x != nil {
+		return x.Fetched
+	}
+	return false
+}
+
+// CreateBundleRequest is a request to export refs of a repo as a git bundle.
+type CreateBundleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to bundle.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// refs are the refs to include in the bundle, like refs/heads/main or v1.0.
+	// A ref prefixed with ^ excludes the commits reachable from it, which
+	// creates an incremental bundle for a receiver that already has them.
+	// At least one ref must be given.
+	// For now, we allow non-utf8 revspecs.
+	Refs [][]byte `protobuf:"bytes,3,rep,name=refs,proto3" json:"refs,omitempty"`
+}
+
+func (x *CreateBundleRequest) Reset() {
+	*x = CreateBundleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBundleRequest) ProtoMessage() {}
+
+func (x *CreateBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBundleRequest.ProtoReflect.Descriptor instead.
+func (*CreateBundleRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *CreateBundleRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CreateBundleRequest) GetRefs() [][]byte {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+// CreateBundleResponse is a chunk of the bundle.
+type CreateBundleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CreateBundleResponse) Reset() {
+	*x = CreateBundleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBundleResponse) ProtoMessage() {}
+
+func (x *CreateBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBundleResponse.ProtoReflect.Descriptor instead.
+func (*CreateBundleResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *CreateBundleResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ApplyBundleRequest is a message of the ApplyBundle stream.
+type ApplyBundleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//
+	//	*ApplyBundleRequest_Metadata_
+	//	*ApplyBundleRequest_Data
+	Payload isApplyBundleRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *ApplyBundleRequest) Reset() {
+	*x = ApplyBundleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBundleRequest) ProtoMessage() {}
+
+func (x *ApplyBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBundleRequest.ProtoReflect.Descriptor instead.
+func (*ApplyBundleRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{171}
+}
+
+func (m *ApplyBundleRequest) GetPayload() isApplyBundleRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *ApplyBundleRequest) GetMetadata() *ApplyBundleRequest_Metadata {
+	if x, ok := x.GetPayload().(*ApplyBundleRequest_Metadata_); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ApplyBundleRequest) GetData() []byte {
+	if x, ok := x.GetPayload().(*ApplyBundleRequest_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+type isApplyBundleRequest_Payload interface {
+	isApplyBundleRequest_Payload()
+}
+
+type ApplyBundleRequest_Metadata_ struct {
+	Metadata *ApplyBundleRequest_Metadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type ApplyBundleRequest_Data struct {
+	// data is a chunk of the bundle.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*ApplyBundleRequest_Metadata_) isApplyBundleRequest_Payload() {}
+
+func (*ApplyBundleRequest_Data) isApplyBundleRequest_Payload() {}
+
+type ApplyBundleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ApplyBundleResponse) Reset() {
+	*x = ApplyBundleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBundleResponse) ProtoMessage() {}
+
+func (x *ApplyBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBundleResponse.ProtoReflect.Descriptor instead.
+func (*ApplyBundleResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{172}
+}
+
+type FetchRefspecRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to fetch into.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// refspec is the refspec to fetch, like +refs/pull/*/head:refs/pull/*/head.
+	Refspec string `protobuf:"bytes,3,opt,name=refspec,proto3" json:"refspec,omitempty"`
+}
+
+func (x *FetchRefspecRequest) Reset() {
+	*x = FetchRefspecRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchRefspecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchRefspecRequest) ProtoMessage() {}
+
+func (x *FetchRefspecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchRefspecRequest.ProtoReflect.Descriptor instead.
+func (*FetchRefspecRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *FetchRefspecRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *FetchRefspecRequest) GetRefspec() string {
+	if x != nil {
+		return x.Refspec
+	}
+	return ""
+}
+
+type FetchRefspecResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FetchRefspecResponse) Reset() {
+	*x = FetchRefspecResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchRefspecResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchRefspecResponse) ProtoMessage() {}
+
+func (x *FetchRefspecResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchRefspecResponse.ProtoReflect.Descriptor instead.
+func (*FetchRefspecResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{174}
+}
+
+type ExecInWorktreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to check out.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// commit_sha is the commit to check out into the worktree.
+	CommitSha string `protobuf:"bytes,3,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	// args are the arguments to git, starting with the subcommand.
+	Args [][]byte `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	// stdin is passed to the command on standard input.
+	Stdin []byte `protobuf:"bytes,5,opt,name=stdin,proto3" json:"stdin,omitempty"`
+}
+
+func (x *ExecInWorktreeRequest) Reset() {
+	*x = ExecInWorktreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecInWorktreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecInWorktreeRequest) ProtoMessage() {}
+
+func (x *ExecInWorktreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecInWorktreeRequest.ProtoReflect.Descriptor instead.
+func (*ExecInWorktreeRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ExecInWorktreeRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *ExecInWorktreeRequest) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *ExecInWorktreeRequest) GetArgs() [][]byte {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ExecInWorktreeRequest) GetStdin() []byte {
+	if x != nil {
+		return x.Stdin
+	}
+	return nil
+}
+
+type ExecInWorktreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// exit_status is the exit status of the command.
+	ExitStatus int32 `protobuf:"varint,1,opt,name=exit_status,json=exitStatus,proto3" json:"exit_status,omitempty"`
+	// stdout is the standard output of the command.
+	Stdout []byte `protobuf:"bytes,2,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	// stderr is the standard error of the command.
+	Stderr []byte `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// output_truncated is true if stdout or stderr were longer than the limit
+	// of gitserver and have been truncated.
+	OutputTruncated bool `protobuf:"varint,4,opt,name=output_truncated,json=outputTruncated,proto3" json:"output_truncated,omitempty"`
+}
+
+func (x *ExecInWorktreeResponse) Reset() {
+	*x = ExecInWorktreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecInWorktreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecInWorktreeResponse) ProtoMessage() {}
+
+func (x *ExecInWorktreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecInWorktreeResponse.ProtoReflect.Descriptor instead.
+func (*ExecInWorktreeResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *ExecInWorktreeResponse) GetExitStatus() int32 {
+	if x != nil {
+		return x.ExitStatus
+	}
+	return 0
+}
+
+func (x *ExecInWorktreeResponse) GetStdout() []byte {
+	if x != nil {
+		return x.Stdout
+	}
+	return nil
+}
+
+func (x *ExecInWorktreeResponse) GetStderr() []byte {
+	if x != nil {
+		return x.Stderr
+	}
+	return nil
+}
+
+func (x *ExecInWorktreeResponse) GetOutputTruncated() bool {
+	if x != nil {
+		return x.OutputTruncated
+	}
+	return false
+}
+
+type RevertCommitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to create the commit in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// commit_sha is the commit to revert.
+	CommitSha string `protobuf:"bytes,3,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	// onto is the revspec of the commit to create the revert commit on top of,
+	// for example the default branch. Defaults to commit_sha.
+	Onto []byte `protobuf:"bytes,4,opt,name=onto,proto3" json:"onto,omitempty"`
+	// mainline is the number of the parent of a merge commit that the changes
+	// are reverted relative to, starting at 1, like git revert -m. Required for
+	// merge commits, must not be set for other commits.
+	Mainline int32 `protobuf:"varint,5,opt,name=mainline,proto3" json:"mainline,omitempty"`
+	// message is the message of the revert commit. Defaults to the message
+	// git revert would use.
+	Message []byte `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// author is the author of the revert commit. The date defaults to the
+	// current time if not set.
+	Author *GitSignature `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
+	// committer is the committer of the revert commit. Defaults to author.
+	Committer *GitSignature `protobuf:"bytes,8,opt,name=committer,proto3" json:"committer,omitempty"`
+}
+
+func (x *RevertCommitRequest) Reset() {
+	*x = RevertCommitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertCommitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertCommitRequest) ProtoMessage() {}
+
+func (x *RevertCommitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertCommitRequest.ProtoReflect.Descriptor instead.
+func (*RevertCommitRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *RevertCommitRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *RevertCommitRequest) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *RevertCommitRequest) GetOnto() []byte {
+	if x != nil {
+		return x.Onto
+	}
+	return nil
+}
+
+func (x *RevertCommitRequest) GetMainline() int32 {
+	if x != nil {
+		return x.Mainline
+	}
+	return 0
+}
+
+func (x *RevertCommitRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *RevertCommitRequest) GetAuthor() *GitSignature {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *RevertCommitRequest) GetCommitter() *GitSignature {
+	if x != nil {
+		return x.Committer
+	}
+	return nil
+}
+
+type RevertCommitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// commit_sha is the ID of the revert commit.
+	CommitSha string `protobuf:"bytes,1,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+}
+
+func (x *RevertCommitResponse) Reset() {
+	*x = RevertCommitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertCommitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertCommitResponse) ProtoMessage() {}
+
+func (x *RevertCommitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertCommitResponse.ProtoReflect.Descriptor instead.
+func (*RevertCommitResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *RevertCommitResponse) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+type CherryPickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to create the commit in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// commit_sha is the commit to cherry-pick.
+	CommitSha string `protobuf:"bytes,3,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	// onto is the revspec of the commit to create the new commit on top of,
+	// for example a release branch.
+	Onto []byte `protobuf:"bytes,4,opt,name=onto,proto3" json:"onto,omitempty"`
+	// mainline is the number of the parent of a merge commit that the changes
+	// are taken relative to, starting at 1, like git cherry-pick -m. Required
+	// for merge commits, must not be set for other commits.
+	Mainline int32 `protobuf:"varint,5,opt,name=mainline,proto3" json:"mainline,omitempty"`
+	// message is the message of the new commit. Defaults to the message of the
+	// original commit.
+	Message []byte `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// record_origin appends a "(cherry picked from commit ...)" line to the
+	// default message, like git cherry-pick -x. Ignored if message is set.
+	RecordOrigin bool `protobuf:"varint,7,opt,name=record_origin,json=recordOrigin,proto3" json:"record_origin,omitempty"`
+	// committer is the committer of the new commit. The date defaults to the
+	// current time if not set.
+	Committer *GitSignature `protobuf:"bytes,8,opt,name=committer,proto3" json:"committer,omitempty"`
+}
+
+func (x *CherryPickRequest) Reset() {
+	*x = CherryPickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CherryPickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CherryPickRequest) ProtoMessage() {}
+
+func (x *CherryPickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CherryPickRequest.ProtoReflect.Descriptor instead.
+func (*CherryPickRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *CherryPickRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *CherryPickRequest) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *CherryPickRequest) GetOnto() []byte {
+	if x != nil {
+		return x.Onto
+	}
+	return nil
+}
+
+func (x *CherryPickRequest) GetMainline() int32 {
+	if x != nil {
+		return x.Mainline
+	}
+	return 0
+}
+
+func (x *CherryPickRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *CherryPickRequest) GetRecordOrigin() bool {
+	if x != nil {
+		return x.RecordOrigin
+	}
+	return false
+}
+
+func (x *CherryPickRequest) GetCommitter() *GitSignature {
+	if x != nil {
+		return x.Committer
+	}
+	return nil
+}
+
+type CherryPickResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// commit_sha is the ID of the new commit.
+	CommitSha string `protobuf:"bytes,1,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+}
+
+func (x *CherryPickResponse) Reset() {
+	*x = CherryPickResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CherryPickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CherryPickResponse) ProtoMessage() {}
+
+func (x *CherryPickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CherryPickResponse.ProtoReflect.Descriptor instead.
+func (*CherryPickResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *CherryPickResponse) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+type SquashCommitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to create the commit in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// base_commit_sha is the parent of the new commit. It is excluded from the
+	// squashed commits.
+	BaseCommitSha string `protobuf:"bytes,3,opt,name=base_commit_sha,json=baseCommitSha,proto3" json:"base_commit_sha,omitempty"`
+	// head_commit_sha is the last of the squashed commits. The new commit has
+	// the same tree as it.
+	HeadCommitSha string `protobuf:"bytes,4,opt,name=head_commit_sha,json=headCommitSha,proto3" json:"head_commit_sha,omitempty"`
+	// message is the message of the new commit. Defaults to the messages of
+	// the squashed commits, oldest first, without their trailers. In both
+	// cases, the Co-authored-by trailers of the squashed commits are appended.
+	Message []byte `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// author is the author of the new commit. Defaults to the author of the
+	// oldest squashed commit.
+	Author *GitSignature `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
+	// committer is the committer of the new commit. The date defaults to the
+	// current time if not set.
+	Committer *GitSignature `protobuf:"bytes,7,opt,name=committer,proto3" json:"committer,omitempty"`
+}
+
+func (x *SquashCommitsRequest) Reset() {
+	*x = SquashCommitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SquashCommitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SquashCommitsRequest) ProtoMessage() {}
+
+func (x *SquashCommitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SquashCommitsRequest.ProtoReflect.Descriptor instead.
+func (*SquashCommitsRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *SquashCommitsRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *SquashCommitsRequest) GetBaseCommitSha() string {
+	if x != nil {
+		return x.BaseCommitSha
+	}
+	return ""
+}
+
+func (x *SquashCommitsRequest) GetHeadCommitSha() string {
+	if x != nil {
+		return x.HeadCommitSha
+	}
+	return ""
+}
+
+func (x *SquashCommitsRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *SquashCommitsRequest) GetAuthor() *GitSignature {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *SquashCommitsRequest) GetCommitter() *GitSignature {
+	if x != nil {
+		return x.Committer
+	}
+	return nil
+}
+
+type SquashCommitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// commit_sha is the ID of the new commit.
+	CommitSha string `protobuf:"bytes,1,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+}
+
+func (x *SquashCommitsResponse) Reset() {
+	*x = SquashCommitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SquashCommitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SquashCommitsResponse) ProtoMessage() {}
+
+func (x *SquashCommitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SquashCommitsResponse.ProtoReflect.Descriptor instead.
+func (*SquashCommitsResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *SquashCommitsResponse) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+// MergeConflictPayload is the payload returned when the changes of a commit
+// can't be applied without conflicts.
+type MergeConflictPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// commit_sha is the commit whose changes conflict.
+	CommitSha string `protobuf:"bytes,3,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	// paths are the paths with conflicts.
+	Paths [][]byte `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *MergeConflictPayload) Reset() {
+	*x = MergeConflictPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeConflictPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeConflictPayload) ProtoMessage() {}
+
+func (x *MergeConflictPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeConflictPayload.ProtoReflect.Descriptor instead.
+func (*MergeConflictPayload) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *MergeConflictPayload) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *MergeConflictPayload) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *MergeConflictPayload) GetPaths() [][]byte {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type RebasePreviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to rebase in.
+	// Note: We use field ID 2 here to reserve 1 for a future repo int32 field.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// base_commit_sha is the commit the series starts after, it is not
+	// replayed itself.
+	BaseCommitSha string `protobuf:"bytes,3,opt,name=base_commit_sha,json=baseCommitSha,proto3" json:"base_commit_sha,omitempty"`
+	// head_commit_sha is the last commit of the series.
+	HeadCommitSha string `protobuf:"bytes,4,opt,name=head_commit_sha,json=headCommitSha,proto3" json:"head_commit_sha,omitempty"`
+	// onto_commit_sha is the commit to replay the series onto.
+	OntoCommitSha string `protobuf:"bytes,5,opt,name=onto_commit_sha,json=ontoCommitSha,proto3" json:"onto_commit_sha,omitempty"`
+}
+
+func (x *RebasePreviewRequest) Reset() {
+	*x = RebasePreviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebasePreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebasePreviewRequest) ProtoMessage() {}
+
+func (x *RebasePreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebasePreviewRequest.ProtoReflect.Descriptor instead.
+func (*RebasePreviewRequest) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *RebasePreviewRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *RebasePreviewRequest) GetBaseCommitSha() string {
+	if x != nil {
+		return x.BaseCommitSha
+	}
+	return ""
+}
+
+func (x *RebasePreviewRequest) GetHeadCommitSha() string {
+	if x != nil {
+		return x.HeadCommitSha
+	}
+	return ""
+}
+
+func (x *RebasePreviewRequest) GetOntoCommitSha() string {
+	if x != nil {
+		return x.OntoCommitSha
+	}
+	return ""
+}
+
+type RebasePreviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// clean is true if all commits of the series applied without conflicts.
+	Clean bool `protobuf:"varint,1,opt,name=clean,proto3" json:"clean,omitempty"`
+	// commit_shas are the IDs of the replayed commits, in order. If the rebase
+	// is not clean, these are the commits replayed before the conflict.
+	CommitShas []string `protobuf:"bytes,2,rep,name=commit_shas,json=commitShas,proto3" json:"commit_shas,omitempty"`
+	// conflicting_commit_sha is the first commit of the series that could not
+	// be applied cleanly. Empty if the rebase is clean.
+	ConflictingCommitSha string `protobuf:"bytes,3,opt,name=conflicting_commit_sha,json=conflictingCommitSha,proto3" json:"conflicting_commit_sha,omitempty"`
+	// conflicting_paths are the paths that have conflicts when applying
+	// conflicting_commit_sha.
+	ConflictingPaths [][]byte `protobuf:"bytes,4,rep,name=conflicting_paths,json=conflictingPaths,proto3" json:"conflicting_paths,omitempty"`
+}
+
+func (x *RebasePreviewResponse) Reset() {
+	*x = RebasePreviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebasePreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebasePreviewResponse) ProtoMessage() {}
+
+func (x *RebasePreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebasePreviewResponse.ProtoReflect.Descriptor instead.
+func (*RebasePreviewResponse) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *RebasePreviewResponse) GetClean() bool {
+	if x != nil {
+		return x.Clean
+	}
+	return false
+}
+
+func (x *RebasePreviewResponse) GetCommitShas() []string {
+	if x != nil {
+		return x.CommitShas
+	}
+	return nil
+}
+
+func (x *RebasePreviewResponse) GetConflictingCommitSha() string {
+	if x != nil {
+		return x.ConflictingCommitSha
+	}
+	return ""
+}
+
+func (x *RebasePreviewResponse) GetConflictingPaths() [][]byte {
+	if x != nil {
+		return x.ConflictingPaths
+	}
+	return nil
+}
+
+type CreateCommitFromPatchBinaryRequest_Metadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo is the name of the repo to be updated
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// base_commit is the revision that the staging area object is based on
+	BaseCommit string `protobuf:"bytes,2,opt,name=base_commit,json=baseCommit,proto3" json:"base_commit,omitempty"`
+	// target_ref is the ref that will be created for this patch
+	TargetRef string `protobuf:"bytes,3,opt,name=target_ref,json=targetRef,proto3" json:"target_ref,omitempty"`
+	// unique_ref is a boolean that indicates whether a unique number will be
+	// appended to the end (ie TargetRef-{#}). The generated ref will be
+	// returned.
+	UniqueRef bool `protobuf:"varint,4,opt,name=unique_ref,json=uniqueRef,proto3" json:"unique_ref,omitempty"`
+	// commit_info is the information to be used for the commit
+	CommitInfo *PatchCommitInfo `protobuf:"bytes,5,opt,name=commit_info,json=commitInfo,proto3" json:"commit_info,omitempty"`
+	// push_config is the configuration to be used for pushing the commit
+	Push *PushConfig `protobuf:"bytes,6,opt,name=push,proto3" json:"push,omitempty"`
+	// git_apply_args are the arguments to be passed to git apply
+	GitApplyArgs []string `protobuf:"bytes,7,rep,name=git_apply_args,json=gitApplyArgs,proto3" json:"git_apply_args,omitempty"`
+	// push_ref is the optional override for the ref that is pushed to
+	PushRef *string `protobuf:"bytes,8,opt,name=push_ref,json=pushRef,proto3,oneof" json:"push_ref,omitempty"`
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Metadata) Reset() {
+	*x = CreateCommitFromPatchBinaryRequest_Metadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommitFromPatchBinaryRequest_Metadata) ProtoMessage() {}
+
+func (x *CreateCommitFromPatchBinaryRequest_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommitFromPatchBinaryRequest_Metadata.ProtoReflect.Descriptor instead.
+func (*CreateCommitFromPatchBinaryRequest_Metadata) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{40, 0}
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetBaseCommit() string {
+	if x != nil {
+		return x.BaseCommit
+	}
+	return ""
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetTargetRef() string {
+	if x != nil {
+		return x.TargetRef
+	}
+	return ""
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetUniqueRef() bool {
+	if x != nil {
+		return x.UniqueRef
+	}
+	return false
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetCommitInfo() *PatchCommitInfo {
+	if x != nil {
+		return x.CommitInfo
+	}
+	return nil
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetPush() *PushConfig {
+	if x != nil {
+		return x.Push
+	}
+	return nil
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetGitApplyArgs() []string {
+	if x != nil {
+		return x.GitApplyArgs
+	}
+	return nil
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Metadata) GetPushRef() string {
+	if x != nil && x.PushRef != nil {
+		return *x.PushRef
+	}
+	return ""
+}
+
+type CreateCommitFromPatchBinaryRequest_Patch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data is the diff contents to be used to create the staging area revision
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Patch) Reset() {
+	*x = CreateCommitFromPatchBinaryRequest_Patch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Patch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommitFromPatchBinaryRequest_Patch) ProtoMessage() {}
+
+func (x *CreateCommitFromPatchBinaryRequest_Patch) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommitFromPatchBinaryRequest_Patch.ProtoReflect.Descriptor instead.
+func (*CreateCommitFromPatchBinaryRequest_Patch) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{40, 1}
+}
+
+func (x *CreateCommitFromPatchBinaryRequest_Patch) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CommitMatch_Signature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Date  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *CommitMatch_Signature) Reset() {
+	*x = CommitMatch_Signature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitMatch_Signature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitMatch_Signature) ProtoMessage() {}
+
+func (x *CommitMatch_Signature) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitMatch_Signature.ProtoReflect.Descriptor instead.
+func (*CommitMatch_Signature) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{69, 0}
+}
+
+func (x *CommitMatch_Signature) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommitMatch_Signature) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CommitMatch_Signature) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type CommitMatch_MatchedString struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string               `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Ranges  []*CommitMatch_Range `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty"`
+}
+
+func (x *CommitMatch_MatchedString) Reset() {
+	*x = CommitMatch_MatchedString{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitMatch_MatchedString) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitMatch_MatchedString) ProtoMessage() {}
+
+func (x *CommitMatch_MatchedString) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitMatch_MatchedString.ProtoReflect.Descriptor instead.
+func (*CommitMatch_MatchedString) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{69, 1}
+}
+
+func (x *CommitMatch_MatchedString) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CommitMatch_MatchedString) GetRanges() []*CommitMatch_Range {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+// TODO move this into a shared package
+type CommitMatch_Range struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start *CommitMatch_Location `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   *CommitMatch_Location `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *CommitMatch_Range) Reset() {
+	*x = CommitMatch_Range{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitMatch_Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitMatch_Range) ProtoMessage() {}
+
+func (x *CommitMatch_Range) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitMatch_Range.ProtoReflect.Descriptor instead.
+func (*CommitMatch_Range) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{69, 2}
+}
+
+func (x *CommitMatch_Range) GetStart() *CommitMatch_Location {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CommitMatch_Range) GetEnd() *CommitMatch_Location {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type CommitMatch_Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset uint32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Line   uint32 `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	Column uint32 `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+}
+
+func (x *CommitMatch_Location) Reset() {
+	*x = CommitMatch_Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitMatch_Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitMatch_Location) ProtoMessage() {}
+
+func (x *CommitMatch_Location) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitMatch_Location.ProtoReflect.Descriptor instead.
+func (*CommitMatch_Location) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{69, 3}
+}
+
+func (x *CommitMatch_Location) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *CommitMatch_Location) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *CommitMatch_Location) GetColumn() uint32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+type ApplyBundleRequest_Metadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repo_name is the name of the repo to import the bundle into.
+	RepoName string `protobuf:"bytes,1,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+}
+
+func (x *ApplyBundleRequest_Metadata) Reset() {
+	*x = ApplyBundleRequest_Metadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gitserver_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyBundleRequest_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBundleRequest_Metadata) ProtoMessage() {}
+
+func (x *ApplyBundleRequest_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_gitserver_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBundleRequest_Metadata.ProtoReflect.Descriptor instead.
+func (*ApplyBundleRequest_Metadata) Descriptor() ([]byte, []int) {
+	return file_gitserver_proto_rawDescGZIP(), []int{171, 0}
+}
+
+func (x *ApplyBundleRequest_Metadata) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+var File_gitserver_proto protoreflect.FileDescriptor
+
+var file_gitserver_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xcd, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x73, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x67, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x61, 0x67, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x28, 0x0a,
+	0x10, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x41,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x73, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x53, 0x68, 0x61, 0x88, 0x01, 0x01, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x73, 0x68, 0x61,
+	0x22, 0x3c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x22, 0x89,
+	0x01, 0x0a, 0x17, 0x52, 0x65, 0x66, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x53, 0x69,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x18, 0x52,
+	0x65, 0x66, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x22,
+	0x2f, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x7b, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x22, 0x3f, 0x0a,
+	0x20, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9f,
+	0x01, 0x0a, 0x21, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x65,
+	0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
+	0x68, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x22, 0x6c, 0x0a, 0x0c, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12,
+	0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x86,
+	0x01, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x65, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe0, 0x02, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x52,
+	0x65, 0x66, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x66, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x5f,
+	0x6f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x66, 0x4f, 0x69,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x08,
+	0x72, 0x65, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69,
+	0x74, 0x52, 0x65, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x72, 0x65,
+	0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x48, 0x65, 0x61, 0x64, 0x22, 0x4a,
+	0x0a, 0x07, 0x52, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x46,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x42, 0x52, 0x41, 0x4e, 0x43, 0x48, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x46, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x47, 0x10, 0x02, 0x22, 0x92, 0x01, 0x0a, 0x16, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65, 0x76, 0x53, 0x70, 0x65, 0x63, 0x12, 0x2c, 0x0a,
+	0x0f, 0x65, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0e, 0x65, 0x6e, 0x73, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x65, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x38, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0x7a, 0x0a, 0x10, 0x52, 0x65, 0x76,
+	0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65,
+	0x76, 0x53, 0x70, 0x65, 0x63, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x41, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0x8f, 0x01, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x22, 0x8c, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x22, 0x6a, 0x0a, 0x0c, 0x46, 0x69,
+	0x6c, 0x65, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x22, 0xbf, 0x01, 0x0a, 0x09, 0x47, 0x69, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x68, 0x0a, 0x0c, 0x47, 0x69, 0x74, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x22, 0xae, 0x02, 0x0a, 0x0c, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x11,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x57,
+	0x68, 0x69, 0x74, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x47,
+	0x0a, 0x0e, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x70, 0x79, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x22, 0x46, 0x0a, 0x0a, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0x3c, 0x0a, 0x0d, 0x42,
+	0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04,
+	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x48,
+	0x75, 0x6e, 0x6b, 0x52, 0x04, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0xe0, 0x02, 0x0a, 0x09, 0x42, 0x6c,
+	0x61, 0x6d, 0x65, 0x48, 0x75, 0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x79, 0x74, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x42, 0x79, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0f,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x67, 0x0a, 0x0b,
+	0x42, 0x6c, 0x61, 0x6d, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x5e, 0x0a, 0x13, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x52, 0x0a, 0x14, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c,
+	0x61, 0x6d, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x12, 0x42, 0x6c,
+	0x61, 0x6d, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x54, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x50,
+	0x0a, 0x14, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x66,
+	0x22, 0x4a, 0x0a, 0x15, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x66,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0xb7, 0x01, 0x0a,
+	0x0f, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x1c, 0x0a, 0x0a, 0x69, 0x66, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x6f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x66,
+	0x4e, 0x6f, 0x74, 0x4f, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x6f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x6c, 0x6f, 0x62, 0x4f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x75, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
+	0x72, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x66, 0x72, 0x65, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x64, 0x22, 0x15,
+	0x0a, 0x13, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x38, 0x0a, 0x14, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x22,
+	0x81, 0x03, 0x0a, 0x0f, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73,
+	0x69, 0x67, 0x6e, 0x22, 0x37, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd3, 0x01, 0x0a,
+	0x0a, 0x50, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x10, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6c, 0x64, 0x5f, 0x6f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x4f, 0x6c, 0x64, 0x4f, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75,
+	0x73, 0x68, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6c, 0x64, 0x5f, 0x6f,
+	0x69, 0x64, 0x22, 0xb6, 0x04, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x4e, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f,
+	0x6d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x05, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x1a, 0xbe, 0x02, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x65, 0x70, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x52, 0x65, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x52,
+	0x65, 0x66, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x75, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x70, 0x75, 0x73, 0x68,
+	0x12, 0x24, 0x0a, 0x0e, 0x67, 0x69, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x61, 0x72,
+	0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x69, 0x74, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x70, 0x75, 0x73, 0x68,
+	0x52, 0x65, 0x66, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x5f,
+	0x72, 0x65, 0x66, 0x1a, 0x1b, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xcc, 0x01, 0x0a, 0x1a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64,
+	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x22, 0x69, 0x0a, 0x23, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x72, 0x65, 0x76, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x9b, 0x01, 0x0a, 0x0b, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x2b, 0x0a, 0x0f, 0x65, 0x6e, 0x73,
+	0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x65, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x05, 0x73, 0x74,
+	0x64, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x73,
+	0x74, 0x64, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x22, 0x22, 0x0a, 0x0c, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7c, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x4e,
+	0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63,
+	0x6c, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x41, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x55, 0x0a, 0x13, 0x46, 0x69, 0x6c, 0x65,
+	0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0x4c, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x22, 0x7c, 0x0a,
+	0x13, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x18,
+	0x52, 0x65, 0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6c, 0x64,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x6c, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0x95, 0x01, 0x0a, 0x18, 0x41, 0x6d, 0x62, 0x69,
+	0x67, 0x75, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6d, 0x62, 0x69, 0x67, 0x75, 0x6f,
+	0x75, 0x73, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22,
+	0x62, 0x0a, 0x1a, 0x41, 0x6d, 0x62, 0x69, 0x67, 0x75, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x10, 0x42, 0x61, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x53, 0x0a, 0x15,
+	0x53, 0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68,
+	0x61, 0x22, 0x4e, 0x0a, 0x1b, 0x48, 0x6f, 0x6f, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x6f,
+	0x6b, 0x22, 0x80, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x74,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12,
+	0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x22, 0x3a, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x76,
+	0x53, 0x70, 0x65, 0x63, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
+	0x22, 0x48, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x14, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x4b, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x22, 0x49, 0x0a, 0x12, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x22, 0x46, 0x0a,
+	0x0f, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x65, 0x78, 0x70, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x63,
+	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x43, 0x61, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x14, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x61, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61,
+	0x73, 0x65, 0x22, 0x23, 0x0a, 0x0b, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x73, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4b, 0x69, 0x6e,
+	0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x92, 0x05, 0x0a,
+	0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x0e, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4e,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4e,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00,
+	0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x42,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4e,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
+	0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x42, 0x0a, 0x0c, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x69, 0x66, 0x66, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x66, 0x66, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x64, 0x69, 0x66, 0x66, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65,
+	0x61, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x4e,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x38,
+	0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x6d, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52,
+	0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x09, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f,
+	0x68, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x48, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0xa9, 0x06, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f,
+	0x69, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
+	0x41, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x65, 0x66, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66,
+	0x73, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x65, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x1a,
+	0x62, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x74,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x1a, 0x77, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x1a, 0x4e, 0x0a, 0x08,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0xb9, 0x01, 0x0a,
+	0x0e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x65, 0x70, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x65, 0x65, 0x69, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x65, 0x65, 0x69, 0x73, 0x68, 0x12, 0x33, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x25, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x2c, 0x0a, 0x16, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x67, 0x0a,
+	0x17, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x6e,
+	0x65, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6c, 0x6f,
+	0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c,
+	0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x4a,
+	0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0x86, 0x01, 0x0a,
+	0x19, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6c,
+	0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63,
+	0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x14,
+	0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x33, 0x0a,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xa8, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70,
+	0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x3d,
+	0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3a, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x6f, 0x6c,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x69,
+	0x74, 0x6f, 0x6c, 0x69, 0x74, 0x65, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x67, 0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x22,
+	0x34, 0x0a, 0x0c, 0x47, 0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x48, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74,
+	0x6f, 0x6c, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x6f,
+	0x6c, 0x69, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22,
+	0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xd8,
+	0x01, 0x0a, 0x09, 0x47, 0x69, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x74,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x16, 0x0a, 0x12, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x42, 0x4a, 0x45,
+	0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x47, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x45,
+	0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x42, 0x10, 0x04, 0x22, 0x97, 0x01, 0x0a, 0x1e, 0x49, 0x73,
+	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6c, 0x6f, 0x6e,
+	0x65, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x12,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x22, 0x21, 0x0a, 0x1f, 0x49, 0x73, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x0a, 0x1f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x11,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x22, 0x22, 0x0a, 0x20, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x19, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x34, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x34, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x34,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x34, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x34, 0x70, 0x61, 0x73, 0x73, 0x77, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x34, 0x70, 0x61, 0x73, 0x73, 0x77, 0x64, 0x22, 0x9b,
+	0x01, 0x0a, 0x1c, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x56, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x1d,
+	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x22,
+	0xe1, 0x03, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x4e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xe1, 0x01, 0x0a, 0x17, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x25, 0x50,
+	0x45, 0x52, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x4c, 0x49,
+	0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x50, 0x45, 0x52, 0x46, 0x4f, 0x52,
+	0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x25, 0x0a, 0x21, 0x50, 0x45, 0x52, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x45, 0x52, 0x46, 0x4f, 0x52,
+	0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x53, 0x48, 0x45, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x24, 0x0a,
+	0x20, 0x50, 0x45, 0x52, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x44, 0x10, 0x04, 0x22, 0x74, 0x0a, 0x1a, 0x49, 0x73, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x56, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x49, 0x73, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x1f, 0x50, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x46, 0x6f, 0x72,
+	0x44, 0x65, 0x70, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x12,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x22, 0x5d, 0x0a, 0x20, 0x50, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x46, 0x6f,
+	0x72, 0x44, 0x65, 0x70, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x1e, 0x50, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x46, 0x6f,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x12,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x5c, 0x0a, 0x1f, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x22, 0xb6,
+	0x01, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x1b, 0x50, 0x65, 0x72, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x11, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x3c, 0x0a, 0x1c, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x14, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x40,
+	0x0a, 0x0c, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x22, 0x57, 0x0a, 0x10, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x22, 0x46, 0x0a, 0x11, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x15, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68,
+	0x61, 0x22, 0x59, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x22, 0x29, 0x0a, 0x13,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7a, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65,
+	0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x22, 0x4a, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22,
+	0x5c, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x61,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x65, 0x61, 0x64, 0x22, 0x46, 0x0a,
+	0x0e, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f,
+	0x73, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x53, 0x68, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x5f, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x55, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x9b, 0x01, 0x0a, 0x10, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x42, 0x61,
+	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x48, 0x65, 0x61, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x48,
+	0x65, 0x61, 0x64, 0x22, 0x46, 0x0a, 0x11, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x66, 0x66,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x22, 0xee, 0x02, 0x0a, 0x0d,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x66, 0x66, 0x50, 0x61, 0x69, 0x72, 0x12, 0x3a, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x44, 0x69, 0x66, 0x66, 0x50, 0x61, 0x69, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6f, 0x6c,
+	0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x65, 0x77, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x6e, 0x65, 0x77, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x6d, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10,
+	0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4d, 0x4f, 0x44, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x04, 0x22, 0x51, 0x0a, 0x0f,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x68, 0x61, 0x73, 0x22,
+	0xa7, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53,
+	0x68, 0x61, 0x12, 0x35, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f,
+	0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x6c, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0x13, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0x53, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61,
+	0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61,
+	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x74, 0x61, 0x67, 0x67, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x06, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x67, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x22,
+	0x13, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x12, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63,
+	0x52, 0x65, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x52, 0x65,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x22, 0x4a, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a,
+	0x11, 0x52, 0x65, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x46, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x22, 0x4b, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x22,
+	0xa2, 0x01, 0x0a, 0x09, 0x47, 0x69, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x26, 0x0a,
+	0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x69, 0x74,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66,
+	0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x3c, 0x0a, 0x0c, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x5f,
+	0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x68, 0x69, 0x6e,
+	0x64, 0x41, 0x68, 0x65, 0x61, 0x64, 0x52, 0x0b, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x41, 0x68,
+	0x65, 0x61, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x22, 0x3b, 0x0a, 0x0b, 0x42, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x41, 0x68,
+	0x65, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x68, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x68, 0x65, 0x61,
+	0x64, 0x22, 0x88, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3c, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69,
+	0x74, 0x52, 0x65, 0x66, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x22, 0x33, 0x0a, 0x14, 0x43, 0x6c,
+	0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0xa5, 0x01, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6c, 0x6f,
+	0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x7d, 0x0a, 0x13, 0x4f, 0x70, 0x74, 0x69, 0x6d,
+	0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x67,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x67, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70,
+	0x61, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x22, 0x51, 0x0a, 0x14, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69,
+	0x7a, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x62, 0x0a, 0x15, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a,
+	0x18, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70,
+	0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x30, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x30, 0x0a, 0x11, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x68, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73,
+	0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x65,
+	0x70, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0x7e,
+	0x0a, 0x0a, 0x48, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x22, 0x40,
+	0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x69, 0x64,
+	0x22, 0x26, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x65, 0x65, 0x69,
+	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x72, 0x65, 0x65, 0x69, 0x73,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x44, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x09, 0x54,
+	0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x69, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x69, 0x0a, 0x15, 0x53, 0x70, 0x61, 0x72, 0x73, 0x65, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73,
+	0x22, 0x4b, 0x0a, 0x16, 0x53, 0x70, 0x61, 0x72, 0x73, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x6b, 0x0a,
+	0x19, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x54,
+	0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x55, 0x0a, 0x1a, 0x4c, 0x61,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x71, 0x0a, 0x0f, 0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x22, 0x8a, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x76, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65,
+	0x76, 0x73, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x40, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x22, 0x56, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67,
+	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x5e, 0x0a, 0x14, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x67, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x38, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x69, 0x74, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0xcb, 0x01, 0x0a,
+	0x06, 0x47, 0x69, 0x74, 0x54, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x69, 0x64, 0x12, 0x2f, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x32,
+	0x0a, 0x06, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x06, 0x74, 0x61, 0x67, 0x67,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x34, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x42, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x65,
+	0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x52, 0x65, 0x66, 0x52, 0x04,
+	0x72, 0x65, 0x66, 0x73, 0x22, 0x7b, 0x0a, 0x1a, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x22, 0x37, 0x0a, 0x1b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x22, 0x46, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x65,
+	0x66, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa7,
+	0x01, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x27, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4c, 0x0a, 0x13, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x66, 0x73, 0x70, 0x65, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x73, 0x70, 0x65, 0x63, 0x22, 0x16, 0x0a,
+	0x14, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x66, 0x73, 0x70, 0x65, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x49, 0x6e, 0x57,
+	0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73,
+	0x74, 0x64, 0x69, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x65, 0x63, 0x49, 0x6e, 0x57,
+	0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x78, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65,
+	0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72,
+	0x12, 0x29, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x89, 0x02, 0x0a, 0x13,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x6f, 0x6e, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6f,
+	0x6e, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0xf8,
+	0x01, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x6f, 0x6e, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x6f, 0x6e, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
+	0x38, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x12, 0x43, 0x68, 0x65,
+	0x72, 0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0x8b,
+	0x02, 0x0a, 0x14, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62,
+	0x61, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x26, 0x0a, 0x0f,
+	0x68, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x53, 0x68, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32,
+	0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x22, 0x36, 0x0a, 0x15,
+	0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f,
+	0x73, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x53, 0x68, 0x61, 0x22, 0x68, 0x0a, 0x14, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22, 0xab,
+	0x01, 0x0a, 0x14, 0x52, 0x65, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62,
+	0x61, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x12, 0x26, 0x0a, 0x0f,
+	0x68, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x53, 0x68, 0x61, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6e, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f,
+	0x6e, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x22, 0xb1, 0x01, 0x0a,
+	0x15, 0x52, 0x65, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x68, 0x61, 0x73, 0x12, 0x34, 0x0a,
+	0x16, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x53, 0x68, 0x61, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x73,
+	0x2a, 0xdb, 0x01, 0x0a, 0x12, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x4c, 0x41, 0x4d, 0x45,
+	0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a,
+	0x1a, 0x42, 0x4c, 0x41, 0x4d, 0x45, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x44, 0x45, 0x54, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x53, 0x10, 0x01, 0x12, 0x1f, 0x0a,
+	0x1b, 0x42, 0x4c, 0x41, 0x4d, 0x45, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x44, 0x45, 0x54, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x50, 0x49, 0x45, 0x53, 0x10, 0x02, 0x12, 0x2d,
+	0x0a, 0x29, 0x42, 0x4c, 0x41, 0x4d, 0x45, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x44, 0x45, 0x54,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x50, 0x49, 0x45, 0x53, 0x5f, 0x46, 0x52,
+	0x4f, 0x4d, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x2f, 0x0a,
+	0x2b, 0x42, 0x4c, 0x41, 0x4d, 0x45, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x44, 0x45, 0x54, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x50, 0x49, 0x45, 0x53, 0x5f, 0x46, 0x52, 0x4f,
+	0x4d, 0x5f, 0x41, 0x4e, 0x59, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x04, 0x2a, 0x71,
+	0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d,
+	0x0a, 0x19, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x10,
+	0x03, 0x2a, 0x5f, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x5a, 0x49, 0x50, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x52,
+	0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x41, 0x52,
+	0x10, 0x02, 0x2a, 0x87, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x28, 0x0a,
+	0x24, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4d, 0x4d, 0x49,
+	0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x56, 0x41, 0x4c, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4d,
+	0x4d, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x32, 0xef, 0x2f, 0x0a,
+	0x10, 0x47, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x86, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x12, 0x30, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f,
+	0x6d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46,
+	0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x63, 0x68, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x4e, 0x0a, 0x08, 0x44, 0x69,
+	0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x5a, 0x0a, 0x0c, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x67, 0x69, 0x74,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x41, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x19,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x63, 0x0a, 0x0f,
+	0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x24, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x73, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02,
+	0x01, 0x12, 0x5a, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74,
+	0x65, 0x12, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x74, 0x6f, 0x6c, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x4a, 0x0a,
+	0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x07, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03,
+	0x90, 0x02, 0x01, 0x12, 0x51, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x02, 0x12, 0x7b, 0x0a, 0x17,
+	0x49, 0x73, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6c,
+	0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x7e, 0x0a, 0x18, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x5d, 0x0a, 0x0d, 0x50, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x67, 0x69, 0x74,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x7b, 0x0a, 0x17, 0x50, 0x65, 0x72, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x7e, 0x0a, 0x18, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x65, 0x70, 0x6f,
+	0x74, 0x12, 0x2d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x73, 0x46, 0x6f, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x73,
+	0x46, 0x6f, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x72, 0x0a, 0x14, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x6f, 0x0a, 0x13, 0x49, 0x73, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x28, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x73, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x69, 0x74,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x50, 0x65, 0x72, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x75, 0x0a, 0x15, 0x50, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02,
+	0x01, 0x12, 0x51, 0x0a, 0x09, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12, 0x1e,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x03, 0x90, 0x02, 0x01, 0x12, 0x47, 0x0a, 0x05, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x5a, 0x0a,
+	0x0c, 0x42, 0x6c, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x21, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x61,
+	0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6c, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x5d, 0x0a, 0x0d, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x22, 0x2e, 0x67, 0x69, 0x74,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x50, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x63, 0x0a,
+	0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90,
+	0x02, 0x02, 0x12, 0x50, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x12, 0x1d,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90,
+	0x02, 0x01, 0x30, 0x01, 0x12, 0x68, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x66, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x53,
+	0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x66, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02,
+	0x01, 0x30, 0x01, 0x12, 0x83, 0x01, 0x0a, 0x19, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x2e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x09, 0x52, 0x65, 0x76,
+	0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x59, 0x0a, 0x0b,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x2e, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01,
+	0x30, 0x01, 0x12, 0x48, 0x0a, 0x06, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x67,
+	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x72,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x51, 0x0a, 0x09,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12,
+	0x4e, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x12, 0x1e, 0x2e, 0x67,
+	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67,
+	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x57, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12,
+	0x21, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x1e,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x57, 0x0a, 0x0b, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x66,
+	0x12, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x66, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x51, 0x0a, 0x09, 0x52, 0x65,
+	0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x5c, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x21, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x08, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x5f, 0x0a,
+	0x0d, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x57,
+	0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x21,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x2e, 0x67,
+	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90,
+	0x02, 0x01, 0x12, 0x54, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x53, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x50, 0x0a,
+	0x08, 0x52, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12,
+	0x4d, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x69, 0x74,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x62,
+	0x0a, 0x0e, 0x53, 0x70, 0x61, 0x72, 0x73, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x70, 0x61, 0x72, 0x73, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x61, 0x72, 0x73, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01,
+	0x30, 0x01, 0x12, 0x6e, 0x0a, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x46, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x12, 0x27, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x54,
+	0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01,
+	0x30, 0x01, 0x12, 0x60, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x12, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x03, 0x90, 0x02, 0x01, 0x12, 0x48, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1b,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x62,
+	0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x66, 0x73,
+	0x12, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01,
+	0x30, 0x01, 0x12, 0x6f, 0x0a, 0x13, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x28, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x46, 0x72,
+	0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03,
+	0x90, 0x02, 0x02, 0x12, 0x5c, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30,
+	0x01, 0x12, 0x56, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x12, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x5a, 0x0a, 0x0c, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x66, 0x73, 0x70, 0x65, 0x63, 0x12, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x66, 0x73, 0x70, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67,
+	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x66, 0x73, 0x70, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x03, 0x90, 0x02, 0x02, 0x12, 0x60, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x49, 0x6e, 0x57,
+	0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x12, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x49, 0x6e, 0x57, 0x6f, 0x72,
+	0x6b, 0x74, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67,
+	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x49, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x74, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x5d, 0x0a, 0x0d, 0x52, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67,
+	0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x57, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x72, 0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x12, 0x1f, 0x2e,
+	0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65,
+	0x72, 0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x65, 0x72, 0x72, 0x79, 0x50, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x71, 0x75, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3a,
+	0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x69,
+	0x74, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_gitserver_proto_rawDescOnce sync.Once
+	file_gitserver_proto_rawDescData = file_gitserver_proto_rawDesc
+)
+
+func file_gitserver_proto_rawDescGZIP() []byte {
+	file_gitserver_proto_rawDescOnce.Do(func() {
+		file_gitserver_proto_rawDescData = protoimpl.X.CompressGZIP(file_gitserver_proto_rawDescData)
+	})
+	return file_gitserver_proto_rawDescData
+}
+
+var file_gitserver_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_gitserver_proto_msgTypes = make([]protoimpl.MessageInfo, 193)
+var file_gitserver_proto_goTypes = []interface{}{
+	(BlameCopyDetection)(0),                             // 0: gitserver.v1.BlameCopyDetection
+	(OperatorKind)(0),                                   // 1: gitserver.v1.OperatorKind
+	(ArchiveFormat)(0),                                  // 2: gitserver.v1.ArchiveFormat
+	(CommitActivityInterval)(0),                         // 3: gitserver.v1.CommitActivityInterval
+	(GitRef_RefType)(0),                                 // 4: gitserver.v1.GitRef.RefType
+	(GitObject_ObjectType)(0),                           // 5: gitserver.v1.GitObject.ObjectType
+	(PerforceChangelist_PerforceChangelistState)(0),     // 6: gitserver.v1.PerforceChangelist.PerforceChangelistState
+	(RangeDiffPair_Status)(0),                           // 7: gitserver.v1.RangeDiffPair.Status
+	(*ListRefsRequest)(nil),                             // 8: gitserver.v1.ListRefsRequest
+	(*ListRefsResponse)(nil),                            // 9: gitserver.v1.ListRefsResponse
+	(*RefsChangedSinceRequest)(nil),                     // 10: gitserver.v1.RefsChangedSinceRequest
+	(*RefsChangedSinceResponse)(nil),                    // 11: gitserver.v1.RefsChangedSinceResponse
+	(*WatchRefsRequest)(nil),                            // 12: gitserver.v1.WatchRefsRequest
+	(*WatchRefsResponse)(nil),                           // 13: gitserver.v1.WatchRefsResponse
+	(*WatchDefaultBranchCommitsRequest)(nil),            // 14: gitserver.v1.WatchDefaultBranchCommitsRequest
+	(*WatchDefaultBranchCommitsResponse)(nil),           // 15: gitserver.v1.WatchDefaultBranchCommitsResponse
+	(*BranchCommit)(nil),                                // 16: gitserver.v1.BranchCommit
+	(*RefChange)(nil),                                   // 17: gitserver.v1.RefChange
+	(*GitRef)(nil),                                      // 18: gitserver.v1.GitRef
+	(*ResolveRevisionRequest)(nil),                      // 19: gitserver.v1.ResolveRevisionRequest
+	(*ResolveRevisionResponse)(nil),                     // 20: gitserver.v1.ResolveRevisionResponse
+	(*RevAtTimeRequest)(nil),                            // 21: gitserver.v1.RevAtTimeRequest
+	(*RevAtTimeResponse)(nil),                           // 22: gitserver.v1.RevAtTimeResponse
+	(*GetCommitRequest)(nil),                            // 23: gitserver.v1.GetCommitRequest
+	(*GetCommitResponse)(nil),                           // 24: gitserver.v1.GetCommitResponse
+	(*FileDiffStat)(nil),                                // 25: gitserver.v1.FileDiffStat
+	(*GitCommit)(nil),                                   // 26: gitserver.v1.GitCommit
+	(*GitSignature)(nil),                                // 27: gitserver.v1.GitSignature
+	(*BlameRequest)(nil),                                // 28: gitserver.v1.BlameRequest
+	(*BlameRange)(nil),                                  // 29: gitserver.v1.BlameRange
+	(*BlameResponse)(nil),                               // 30: gitserver.v1.BlameResponse
+	(*BlameHunk)(nil),                                   // 31: gitserver.v1.BlameHunk
+	(*BlameAuthor)(nil),                                 // 32: gitserver.v1.BlameAuthor
+	(*BlameSummaryRequest)(nil),                         // 33: gitserver.v1.BlameSummaryRequest
+	(*BlameSummaryResponse)(nil),                        // 34: gitserver.v1.BlameSummaryResponse
+	(*BlameAuthorSummary)(nil),                          // 35: gitserver.v1.BlameAuthorSummary
+	(*PreviousCommit)(nil),                              // 36: gitserver.v1.PreviousCommit
+	(*DefaultBranchRequest)(nil),                        // 37: gitserver.v1.DefaultBranchRequest
+	(*DefaultBranchResponse)(nil),                       // 38: gitserver.v1.DefaultBranchResponse
+	(*ReadFileRequest)(nil),                             // 39: gitserver.v1.ReadFileRequest
+	(*ReadFileResponse)(nil),                            // 40: gitserver.v1.ReadFileResponse
+	(*DiskInfoRequest)(nil),                             // 41: gitserver.v1.DiskInfoRequest
+	(*DiskInfoResponse)(nil),                            // 42: gitserver.v1.DiskInfoResponse
+	(*CapabilitiesRequest)(nil),                         // 43: gitserver.v1.CapabilitiesRequest
+	(*CapabilitiesResponse)(nil),                        // 44: gitserver.v1.CapabilitiesResponse
+	(*PatchCommitInfo)(nil),                             // 45: gitserver.v1.PatchCommitInfo
+	(*CommitTrailer)(nil),                               // 46: gitserver.v1.CommitTrailer
+	(*PushConfig)(nil),                                  // 47: gitserver.v1.PushConfig
+	(*CreateCommitFromPatchBinaryRequest)(nil),          // 48: gitserver.v1.CreateCommitFromPatchBinaryRequest
+	(*CreateCommitFromPatchError)(nil),                  // 49: gitserver.v1.CreateCommitFromPatchError
+	(*CreateCommitFromPatchBinaryResponse)(nil),         // 50: gitserver.v1.CreateCommitFromPatchBinaryResponse
+	(*ExecRequest)(nil),                                 // 51: gitserver.v1.ExecRequest
+	(*ExecResponse)(nil),                                // 52: gitserver.v1.ExecResponse
+	(*RepoNotFoundPayload)(nil),                         // 53: gitserver.v1.RepoNotFoundPayload
+	(*RevisionNotFoundPayload)(nil),                     // 54: gitserver.v1.RevisionNotFoundPayload
+	(*FileNotFoundPayload)(nil),                         // 55: gitserver.v1.FileNotFoundPayload
+	(*ExecStatusPayload)(nil),                           // 56: gitserver.v1.ExecStatusPayload
+	(*UnauthorizedPayload)(nil),                         // 57: gitserver.v1.UnauthorizedPayload
+	(*RefUpdateConflictPayload)(nil),                    // 58: gitserver.v1.RefUpdateConflictPayload
+	(*AmbiguousRevisionPayload)(nil),                    // 59: gitserver.v1.AmbiguousRevisionPayload
+	(*AmbiguousRevisionCandidate)(nil),                  // 60: gitserver.v1.AmbiguousRevisionCandidate
+	(*BadObjectPayload)(nil),                            // 61: gitserver.v1.BadObjectPayload
+	(*ShallowHistoryPayload)(nil),                       // 62: gitserver.v1.ShallowHistoryPayload
+	(*HookPermissionDeniedPayload)(nil),                 // 63: gitserver.v1.HookPermissionDeniedPayload
+	(*SearchRequest)(nil),                               // 64: gitserver.v1.SearchRequest
+	(*RevisionSpecifier)(nil),                           // 65: gitserver.v1.RevisionSpecifier
+	(*AuthorMatchesNode)(nil),                           // 66: gitserver.v1.AuthorMatchesNode
+	(*CommitterMatchesNode)(nil),                        // 67: gitserver.v1.CommitterMatchesNode
+	(*CommitBeforeNode)(nil),                            // 68: gitserver.v1.CommitBeforeNode
+	(*CommitAfterNode)(nil),                             // 69: gitserver.v1.CommitAfterNode
+	(*MessageMatchesNode)(nil),                          // 70: gitserver.v1.MessageMatchesNode
+	(*DiffMatchesNode)(nil),                             // 71: gitserver.v1.DiffMatchesNode
+	(*DiffModifiesFileNode)(nil),                        // 72: gitserver.v1.DiffModifiesFileNode
+	(*BooleanNode)(nil),                                 // 73: gitserver.v1.BooleanNode
+	(*OperatorNode)(nil),                                // 74: gitserver.v1.OperatorNode
+	(*QueryNode)(nil),                                   // 75: gitserver.v1.QueryNode
+	(*SearchResponse)(nil),                              // 76: gitserver.v1.SearchResponse
+	(*CommitMatch)(nil),                                 // 77: gitserver.v1.CommitMatch
+	(*ArchiveRequest)(nil),                              // 78: gitserver.v1.ArchiveRequest
+	(*ArchiveResponse)(nil),                             // 79: gitserver.v1.ArchiveResponse
+	(*IsRepoCloneableRequest)(nil),                      // 80: gitserver.v1.IsRepoCloneableRequest
+	(*IsRepoCloneableResponse)(nil),                     // 81: gitserver.v1.IsRepoCloneableResponse
+	(*RepoCloneProgressRequest)(nil),                    // 82: gitserver.v1.RepoCloneProgressRequest
+	(*RepoCloneProgressResponse)(nil),                   // 83: gitserver.v1.RepoCloneProgressResponse
+	(*RepoDeleteRequest)(nil),                           // 84: gitserver.v1.RepoDeleteRequest
+	(*RepoDeleteResponse)(nil),                          // 85: gitserver.v1.RepoDeleteResponse
+	(*RepoUpdateRequest)(nil),                           // 86: gitserver.v1.RepoUpdateRequest
+	(*RepoUpdateResponse)(nil),                          // 87: gitserver.v1.RepoUpdateResponse
+	(*ListGitoliteRequest)(nil),                         // 88: gitserver.v1.ListGitoliteRequest
+	(*GitoliteRepo)(nil),                                // 89: gitserver.v1.GitoliteRepo
+	(*ListGitoliteResponse)(nil),                        // 90: gitserver.v1.ListGitoliteResponse
+	(*GetObjectRequest)(nil),                            // 91: gitserver.v1.GetObjectRequest
+	(*GetObjectResponse)(nil),                           // 92: gitserver.v1.GetObjectResponse
+	(*GitObject)(nil),                                   // 93: gitserver.v1.GitObject
+	(*IsPerforcePathCloneableRequest)(nil),              // 94: gitserver.v1.IsPerforcePathCloneableRequest
+	(*IsPerforcePathCloneableResponse)(nil),             // 95: gitserver.v1.IsPerforcePathCloneableResponse
+	(*CheckPerforceCredentialsRequest)(nil),             // 96: gitserver.v1.CheckPerforceCredentialsRequest
+	(*CheckPerforceCredentialsResponse)(nil),            // 97: gitserver.v1.CheckPerforceCredentialsResponse
+	(*PerforceConnectionDetails)(nil),                   // 98: gitserver.v1.PerforceConnectionDetails
+	(*PerforceGetChangelistRequest)(nil),                // 99: gitserver.v1.PerforceGetChangelistRequest
+	(*PerforceGetChangelistResponse)(nil),               // 100: gitserver.v1.PerforceGetChangelistResponse
+	(*PerforceChangelist)(nil),                          // 101: gitserver.v1.PerforceChangelist
+	(*IsPerforceSuperUserRequest)(nil),                  // 102: gitserver.v1.IsPerforceSuperUserRequest
+	(*IsPerforceSuperUserResponse)(nil),                 // 103: gitserver.v1.IsPerforceSuperUserResponse
+	(*PerforceProtectsForDepotRequest)(nil),             // 104: gitserver.v1.PerforceProtectsForDepotRequest
+	(*PerforceProtectsForDepotResponse)(nil),            // 105: gitserver.v1.PerforceProtectsForDepotResponse
+	(*PerforceProtectsForUserRequest)(nil),              // 106: gitserver.v1.PerforceProtectsForUserRequest
+	(*PerforceProtectsForUserResponse)(nil),             // 107: gitserver.v1.PerforceProtectsForUserResponse
+	(*PerforceProtect)(nil),                             // 108: gitserver.v1.PerforceProtect
+	(*PerforceGroupMembersRequest)(nil),                 // 109: gitserver.v1.PerforceGroupMembersRequest
+	(*PerforceGroupMembersResponse)(nil),                // 110: gitserver.v1.PerforceGroupMembersResponse
+	(*PerforceUsersRequest)(nil),                        // 111: gitserver.v1.PerforceUsersRequest
+	(*PerforceUsersResponse)(nil),                       // 112: gitserver.v1.PerforceUsersResponse
+	(*PerforceUser)(nil),                                // 113: gitserver.v1.PerforceUser
+	(*MergeBaseRequest)(nil),                            // 114: gitserver.v1.MergeBaseRequest
+	(*MergeBaseResponse)(nil),                           // 115: gitserver.v1.MergeBaseResponse
+	(*FormatPatchRequest)(nil),                          // 116: gitserver.v1.FormatPatchRequest
+	(*FormatPatchResponse)(nil),                         // 117: gitserver.v1.FormatPatchResponse
+	(*CommitGraphRequest)(nil),                          // 118: gitserver.v1.CommitGraphRequest
+	(*CommitGraphResponse)(nil),                         // 119: gitserver.v1.CommitGraphResponse
+	(*CherryRequest)(nil),                               // 120: gitserver.v1.CherryRequest
+	(*CherryResponse)(nil),                              // 121: gitserver.v1.CherryResponse
+	(*CherryCommit)(nil),                                // 122: gitserver.v1.CherryCommit
+	(*RangeDiffRequest)(nil),                            // 123: gitserver.v1.RangeDiffRequest
+	(*RangeDiffResponse)(nil),                           // 124: gitserver.v1.RangeDiffResponse
+	(*RangeDiffPair)(nil),                               // 125: gitserver.v1.RangeDiffPair
+	(*CommitGraphNode)(nil),                             // 126: gitserver.v1.CommitGraphNode
+	(*UpdateRefRequest)(nil),                            // 127: gitserver.v1.UpdateRefRequest
+	(*UpdateRefResponse)(nil),                           // 128: gitserver.v1.UpdateRefResponse
+	(*CreateBranchRequest)(nil),                         // 129: gitserver.v1.CreateBranchRequest
+	(*CreateBranchResponse)(nil),                        // 130: gitserver.v1.CreateBranchResponse
+	(*DeleteBranchRequest)(nil),                         // 131: gitserver.v1.DeleteBranchRequest
+	(*DeleteBranchResponse)(nil),                        // 132: gitserver.v1.DeleteBranchResponse
+	(*CreateTagRequest)(nil),                            // 133: gitserver.v1.CreateTagRequest
+	(*CreateTagResponse)(nil),                           // 134: gitserver.v1.CreateTagResponse
+	(*SymbolicRefRequest)(nil),                          // 135: gitserver.v1.SymbolicRefRequest
+	(*SymbolicRefResponse)(nil),                         // 136: gitserver.v1.SymbolicRefResponse
+	(*RefExistsRequest)(nil),                            // 137: gitserver.v1.RefExistsRequest
+	(*RefExistsResponse)(nil),                           // 138: gitserver.v1.RefExistsResponse
+	(*ListBranchesRequest)(nil),                         // 139: gitserver.v1.ListBranchesRequest
+	(*ListBranchesResponse)(nil),                        // 140: gitserver.v1.ListBranchesResponse
+	(*GitBranch)(nil),                                   // 141: gitserver.v1.GitBranch
+	(*BehindAhead)(nil),                                 // 142: gitserver.v1.BehindAhead
+	(*ListTagsRequest)(nil),                             // 143: gitserver.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),                            // 144: gitserver.v1.ListTagsResponse
+	(*CloneProgressRequest)(nil),                        // 145: gitserver.v1.CloneProgressRequest
+	(*CloneProgressResponse)(nil),                       // 146: gitserver.v1.CloneProgressResponse
+	(*OptimizeRepoRequest)(nil),                         // 147: gitserver.v1.OptimizeRepoRequest
+	(*OptimizeRepoResponse)(nil),                        // 148: gitserver.v1.OptimizeRepoResponse
+	(*MaintenanceTaskResult)(nil),                       // 149: gitserver.v1.MaintenanceTaskResult
+	(*CommitGraphStatusRequest)(nil),                    // 150: gitserver.v1.CommitGraphStatusRequest
+	(*CommitGraphStatusResponse)(nil),                   // 151: gitserver.v1.CommitGraphStatusResponse
+	(*CloneStateRequest)(nil),                           // 152: gitserver.v1.CloneStateRequest
+	(*CloneStateResponse)(nil),                          // 153: gitserver.v1.CloneStateResponse
+	(*ListReposRequest)(nil),                            // 154: gitserver.v1.ListReposRequest
+	(*ListReposResponse)(nil),                           // 155: gitserver.v1.ListReposResponse
+	(*HostedRepo)(nil),                                  // 156: gitserver.v1.HostedRepo
+	(*ReadBlobRequest)(nil),                             // 157: gitserver.v1.ReadBlobRequest
+	(*ReadBlobResponse)(nil),                            // 158: gitserver.v1.ReadBlobResponse
+	(*GetTreeRequest)(nil),                              // 159: gitserver.v1.GetTreeRequest
+	(*GetTreeResponse)(nil),                             // 160: gitserver.v1.GetTreeResponse
+	(*TreeEntry)(nil),                                   // 161: gitserver.v1.TreeEntry
+	(*SparseManifestRequest)(nil),                       // 162: gitserver.v1.SparseManifestRequest
+	(*SparseManifestResponse)(nil),                      // 163: gitserver.v1.SparseManifestResponse
+	(*LastCommitsForTreeRequest)(nil),                   // 164: gitserver.v1.LastCommitsForTreeRequest
+	(*LastCommitsForTreeResponse)(nil),                  // 165: gitserver.v1.LastCommitsForTreeResponse
+	(*TreeEntryCommit)(nil),                             // 166: gitserver.v1.TreeEntryCommit
+	(*CommitActivityRequest)(nil),                       // 167: gitserver.v1.CommitActivityRequest
+	(*CommitActivityResponse)(nil),                      // 168: gitserver.v1.CommitActivityResponse
+	(*CommitActivityBucket)(nil),                        // 169: gitserver.v1.CommitActivityBucket
+	(*GetTagRequest)(nil),                               // 170: gitserver.v1.GetTagRequest
+	(*GetTagResponse)(nil),                              // 171: gitserver.v1.GetTagResponse
+	(*GitTag)(nil),                                      // 172: gitserver.v1.GitTag
+	(*ListRemoteRefsRequest)(nil),                       // 173: gitserver.v1.ListRemoteRefsRequest
+	(*ListRemoteRefsResponse)(nil),                      // 174: gitserver.v1.ListRemoteRefsResponse
+	(*FetchCommitFromRepoRequest)(nil),                  // 175: gitserver.v1.FetchCommitFromRepoRequest
+	(*FetchCommitFromRepoResponse)(nil),                 // 176: gitserver.v1.FetchCommitFromRepoResponse
+	(*CreateBundleRequest)(nil),                         // 177: gitserver.v1.CreateBundleRequest
+	(*CreateBundleResponse)(nil),                        // 178: gitserver.v1.CreateBundleResponse
+	(*ApplyBundleRequest)(nil),                          // 179: gitserver.v1.ApplyBundleRequest
+	(*ApplyBundleResponse)(nil),                         // 180: gitserver.v1.ApplyBundleResponse
+	(*FetchRefspecRequest)(nil),                         // 181: gitserver.v1.FetchRefspecRequest
+	(*FetchRefspecResponse)(nil),                        // 182: gitserver.v1.FetchRefspecResponse
+	(*ExecInWorktreeRequest)(nil),                       // 183: gitserver.v1.ExecInWorktreeRequest
+	(*ExecInWorktreeResponse)(nil),                      // 184: gitserver.v1.ExecInWorktreeResponse
+	(*RevertCommitRequest)(nil),                         // 185: gitserver.v1.RevertCommitRequest
+	(*RevertCommitResponse)(nil),                        // 186: gitserver.v1.RevertCommitResponse
+	(*CherryPickRequest)(nil),                           // 187: gitserver.v1.CherryPickRequest
+	(*CherryPickResponse)(nil),                          // 188: gitserver.v1.CherryPickResponse
+	(*SquashCommitsRequest)(nil),                        // 189: gitserver.v1.SquashCommitsRequest
+	(*SquashCommitsResponse)(nil),                       // 190: gitserver.v1.SquashCommitsResponse
+	(*MergeConflictPayload)(nil),                        // 191: gitserver.v1.MergeConflictPayload
+	(*RebasePreviewRequest)(nil),                        // 192: gitserver.v1.RebasePreviewRequest
+	(*RebasePreviewResponse)(nil),                       // 193: gitserver.v1.RebasePreviewResponse
+	(*CreateCommitFromPatchBinaryRequest_Metadata)(nil), // 194: gitserver.v1.CreateCommitFromPatchBinaryRequest.Metadata
+	(*CreateCommitFromPatchBinaryRequest_Patch)(nil),    // 195: gitserver.v1.CreateCommitFromPatchBinaryRequest.Patch
+	(*CommitMatch_Signature)(nil),                       // 196: gitserver.v1.CommitMatch.Signature
+	(*CommitMatch_MatchedString)(nil),                   // 197: gitserver.v1.CommitMatch.MatchedString
+	(*CommitMatch_Range)(nil),                           // 198: gitserver.v1.CommitMatch.Range
+	(*CommitMatch_Location)(nil),                        // 199: gitserver.v1.CommitMatch.Location
+	(*ApplyBundleRequest_Metadata)(nil),                 // 200: gitserver.v1.ApplyBundleRequest.Metadata
+	(*timestamppb.Timestamp)(nil),                       // 201: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                         // 202: google.protobuf.Duration
+}
+var file_gitserver_proto_depIdxs = []int32{
+	18,  // 0: gitserver.v1.ListRefsResponse.refs:type_name -> gitserver.v1.GitRef
+	201, // 1: gitserver.v1.RefsChangedSinceRequest.since:type_name -> google.protobuf.Timestamp
+	17,  // 2: gitserver.v1.RefsChangedSinceResponse.changes:type_name -> gitserver.v1.RefChange
+	17,  // 3: gitserver.v1.WatchRefsResponse.changes:type_name -> gitserver.v1.RefChange
+	16,  // 4: gitserver.v1.WatchDefaultBranchCommitsResponse.commits:type_name -> gitserver.v1.BranchCommit
+	201, // 5: gitserver.v1.BranchCommit.committed_at:type_name -> google.protobuf.Timestamp
+	201, // 6: gitserver.v1.RefChange.changed_at:type_name -> google.protobuf.Timestamp
+	201, // 7: gitserver.v1.GitRef.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 8: gitserver.v1.GitRef.ref_type:type_name -> gitserver.v1.GitRef.RefType
+	201, // 9: gitserver.v1.RevAtTimeRequest.time:type_name -> google.protobuf.Timestamp
+	26,  // 10: gitserver.v1.GetCommitResponse.commit:type_name -> gitserver.v1.GitCommit
+	25,  // 11: gitserver.v1.GetCommitResponse.stats:type_name -> gitserver.v1.FileDiffStat
+	27,  // 12: gitserver.v1.GitCommit.author:type_name -> gitserver.v1.GitSignature
+	27,  // 13: gitserver.v1.GitCommit.committer:type_name -> gitserver.v1.GitSignature
+	201, // 14: gitserver.v1.GitSignature.date:type_name -> google.protobuf.Timestamp
+	29,  // 15: gitserver.v1.BlameRequest.range:type_name -> gitserver.v1.BlameRange
+	0,   // 16: gitserver.v1.BlameRequest.copy_detection:type_name -> gitserver.v1.BlameCopyDetection
+	31,  // 17: gitserver.v1.BlameResponse.hunk:type_name -> gitserver.v1.BlameHunk
+	32,  // 18: gitserver.v1.BlameHunk.author:type_name -> gitserver.v1.BlameAuthor
+	36,  // 19: gitserver.v1.BlameHunk.previous_commit:type_name -> gitserver.v1.PreviousCommit
+	201, // 20: gitserver.v1.BlameAuthor.date:type_name -> google.protobuf.Timestamp
+	35,  // 21: gitserver.v1.BlameSummaryResponse.authors:type_name -> gitserver.v1.BlameAuthorSummary
+	201, // 22: gitserver.v1.BlameAuthorSummary.last_touched:type_name -> google.protobuf.Timestamp
+	201, // 23: gitserver.v1.PatchCommitInfo.date:type_name -> google.protobuf.Timestamp
+	201, // 24: gitserver.v1.PatchCommitInfo.committer_date:type_name -> google.protobuf.Timestamp
+	46,  // 25: gitserver.v1.PatchCommitInfo.trailers:type_name -> gitserver.v1.CommitTrailer
+	194, // 26: gitserver.v1.CreateCommitFromPatchBinaryRequest.metadata:type_name -> gitserver.v1.CreateCommitFromPatchBinaryRequest.Metadata
+	195, // 27: gitserver.v1.CreateCommitFromPatchBinaryRequest.patch:type_name -> gitserver.v1.CreateCommitFromPatchBinaryRequest.Patch
+	60,  // 28: gitserver.v1.AmbiguousRevisionPayload.candidates:type_name -> gitserver.v1.AmbiguousRevisionCandidate
+	65,  // 29: gitserver.v1.SearchRequest.revisions:type_name -> gitserver.v1.RevisionSpecifier
+	75,  // 30: gitserver.v1.SearchRequest.query:type_name -> gitserver.v1.QueryNode
+	201, // 31: gitserver.v1.CommitBeforeNode.timestamp:type_name -> google.protobuf.Timestamp
+	201, // 32: gitserver.v1.CommitAfterNode.timestamp:type_name -> google.protobuf.Timestamp
+	1,   // 33: gitserver.v1.OperatorNode.kind:type_name -> gitserver.v1.OperatorKind
+	75,  // 34: gitserver.v1.OperatorNode.operands:type_name -> gitserver.v1.QueryNode
+	66,  // 35: gitserver.v1.QueryNode.author_matches:type_name -> gitserver.v1.AuthorMatchesNode
+	67,  // 36: gitserver.v1.QueryNode.committer_matches:type_name -> gitserver.v1.CommitterMatchesNode
+	68,  // 37: gitserver.v1.QueryNode.commit_before:type_name -> gitserver.v1.CommitBeforeNode
+	69,  // 38: gitserver.v1.QueryNode.commit_after:type_name -> gitserver.v1.CommitAfterNode
+	70,  // 39: gitserver.v1.QueryNode.message_matches:type_name -> gitserver.v1.MessageMatchesNode
+	71,  // 40: gitserver.v1.QueryNode.diff_matches:type_name -> gitserver.v1.DiffMatchesNode
+	72,  // 41: gitserver.v1.QueryNode.diff_modifies_file:type_name -> gitserver.v1.DiffModifiesFileNode
+	73,  // 42: gitserver.v1.QueryNode.boolean:type_name -> gitserver.v1.BooleanNode
+	74,  // 43: gitserver.v1.QueryNode.operator:type_name -> gitserver.v1.OperatorNode
+	77,  // 44: gitserver.v1.SearchResponse.match:type_name -> gitserver.v1.CommitMatch
+	196, // 45: gitserver.v1.CommitMatch.author:type_name -> gitserver.v1.CommitMatch.Signature
+	196, // 46: gitserver.v1.CommitMatch.committer:type_name -> gitserver.v1.CommitMatch.Signature
+	197, // 47: gitserver.v1.CommitMatch.message:type_name -> gitserver.v1.CommitMatch.MatchedString
+	197, // 48: gitserver.v1.CommitMatch.diff:type_name -> gitserver.v1.CommitMatch.MatchedString
+	2,   // 49: gitserver.v1.ArchiveRequest.format:type_name -> gitserver.v1.ArchiveFormat
+	202, // 50: gitserver.v1.RepoUpdateRequest.since:type_name -> google.protobuf.Duration
+	201, // 51: gitserver.v1.RepoUpdateResponse.last_fetched:type_name -> google.protobuf.Timestamp
+	201, // 52: gitserver.v1.RepoUpdateResponse.last_changed:type_name -> google.protobuf.Timestamp
+	89,  // 53: gitserver.v1.ListGitoliteResponse.repos:type_name -> gitserver.v1.GitoliteRepo
+	93,  // 54: gitserver.v1.GetObjectResponse.object:type_name -> gitserver.v1.GitObject
+	5,   // 55: gitserver.v1.GitObject.type:type_name -> gitserver.v1.GitObject.ObjectType
+	98,  // 56: gitserver.v1.IsPerforcePathCloneableRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
+	98,  // 57: gitserver.v1.CheckPerforceCredentialsRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
+	98,  // 58: gitserver.v1.PerforceGetChangelistRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
+	101, // 59: gitserver.v1.PerforceGetChangelistResponse.changelist:type_name -> gitserver.v1.PerforceChangelist
+	201, // 60: gitserver.v1.PerforceChangelist.creation_date:type_name -> google.protobuf.Timestamp
+	6,   // 61: gitserver.v1.PerforceChangelist.state:type_name -> gitserver.v1.PerforceChangelist.PerforceChangelistState
+	98,  // 62: gitserver.v1.IsPerforceSuperUserRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
+	98,  // 63: gitserver.v1.PerforceProtectsForDepotRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
+	108, // 64: gitserver.v1.PerforceProtectsForDepotResponse.protects:type_name -> gitserver.v1.PerforceProtect
+	98,  // 65: gitserver.v1.PerforceProtectsForUserRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
+	108, // 66: gitserver.v1.PerforceProtectsForUserResponse.protects:type_name -> gitserver.v1.PerforceProtect
+	98,  // 67: gitserver.v1.PerforceGroupMembersRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
+	98,  // 68: gitserver.v1.PerforceUsersRequest.connection_details:type_name -> gitserver.v1.PerforceConnectionDetails
+	113, // 69: gitserver.v1.PerforceUsersResponse.users:type_name -> gitserver.v1.PerforceUser
+	126, // 70: gitserver.v1.CommitGraphResponse.nodes:type_name -> gitserver.v1.CommitGraphNode
+	122, // 71: gitserver.v1.CherryResponse.commits:type_name -> gitserver.v1.CherryCommit
+	125, // 72: gitserver.v1.RangeDiffResponse.pairs:type_name -> gitserver.v1.RangeDiffPair
+	7,   // 73: gitserver.v1.RangeDiffPair.status:type_name -> gitserver.v1.RangeDiffPair.Status
+	27,  // 74: gitserver.v1.CreateTagRequest.tagger:type_name -> gitserver.v1.GitSignature
+	141, // 75: gitserver.v1.ListBranchesResponse.branches:type_name -> gitserver.v1.GitBranch
+	18,  // 76: gitserver.v1.GitBranch.ref:type_name -> gitserver.v1.GitRef
+	142, // 77: gitserver.v1.GitBranch.behind_ahead:type_name -> gitserver.v1.BehindAhead
+	26,  // 78: gitserver.v1.GitBranch.commit:type_name -> gitserver.v1.GitCommit
+	18,  // 79: gitserver.v1.ListTagsResponse.refs:type_name -> gitserver.v1.GitRef
+	149, // 80: gitserver.v1.OptimizeRepoResponse.tasks:type_name -> gitserver.v1.MaintenanceTaskResult
+	202, // 81: gitserver.v1.MaintenanceTaskResult.duration:type_name -> google.protobuf.Duration
+	156, // 82: gitserver.v1.ListReposResponse.repos:type_name -> gitserver.v1.HostedRepo
+	201, // 83: gitserver.v1.HostedRepo.last_fetched:type_name -> google.protobuf.Timestamp
+	161, // 84: gitserver.v1.GetTreeResponse.entries:type_name -> gitserver.v1.TreeEntry
+	93,  // 85: gitserver.v1.TreeEntry.object:type_name -> gitserver.v1.GitObject
+	161, // 86: gitserver.v1.SparseManifestResponse.entries:type_name -> gitserver.v1.TreeEntry
+	166, // 87: gitserver.v1.LastCommitsForTreeResponse.entries:type_name -> gitserver.v1.TreeEntryCommit
+	161, // 88: gitserver.v1.TreeEntryCommit.entry:type_name -> gitserver.v1.TreeEntry
+	26,  // 89: gitserver.v1.TreeEntryCommit.commit:type_name -> gitserver.v1.GitCommit
+	201, // 90: gitserver.v1.CommitActivityRequest.after:type_name -> google.protobuf.Timestamp
+	201, // 91: gitserver.v1.CommitActivityRequest.before:type_name -> google.protobuf.Timestamp
+	3,   // 92: gitserver.v1.CommitActivityRequest.interval:type_name -> gitserver.v1.CommitActivityInterval
+	169, // 93: gitserver.v1.CommitActivityResponse.buckets:type_name -> gitserver.v1.CommitActivityBucket
+	201, // 94: gitserver.v1.CommitActivityBucket.start:type_name -> google.protobuf.Timestamp
+	172, // 95: gitserver.v1.GetTagResponse.tag:type_name -> gitserver.v1.GitTag
+	93,  // 96: gitserver.v1.GitTag.target:type_name -> gitserver.v1.GitObject
+	27,  // 97: gitserver.v1.GitTag.tagger:type_name -> gitserver.v1.GitSignature
+	18,  // 98: gitserver.v1.ListRemoteRefsResponse.refs:type_name -> gitserver.v1.GitRef
+	200, // 99: gitserver.v1.ApplyBundleRequest.metadata:type_name -> gitserver.v1.ApplyBundleRequest.Metadata
+	27,  // 100: gitserver.v1.RevertCommitRequest.author:type_name -> gitserver.v1.GitSignature
+	27,  // 101: gitserver.v1.RevertCommitRequest.committer:type_name -> gitserver.v1.GitSignature
+	27,  // 102: gitserver.v1.CherryPickRequest.committer:type_name -> gitserver.v1.GitSignature
+	27,  // 103: gitserver.v1.SquashCommitsRequest.author:type_name -> gitserver.v1.GitSignature
+	27,  // 104: gitserver.v1.SquashCommitsRequest.committer:type_name -> gitserver.v1.GitSignature
+	45,  // 105: gitserver.v1.CreateCommitFromPatchBinaryRequest.Metadata.commit_info:type_name -> gitserver.v1.PatchCommitInfo
+	47,  // 106: gitserver.v1.CreateCommitFromPatchBinaryRequest.Metadata.push:type_name -> gitserver.v1.PushConfig
+	201, // 107: gitserver.v1.CommitMatch.Signature.date:type_name -> google.protobuf.Timestamp
+	198, // 108: gitserver.v1.CommitMatch.MatchedString.ranges:type_name -> gitserver.v1.CommitMatch.Range
+	199, // 109: gitserver.v1.CommitMatch.Range.start:type_name -> gitserver.v1.CommitMatch.Location
+	199, // 110: gitserver.v1.CommitMatch.Range.end:type_name -> gitserver.v1.CommitMatch.Location
+	48,  // 111: gitserver.v1.GitserverService.CreateCommitFromPatchBinary:input_type -> gitserver.v1.CreateCommitFromPatchBinaryRequest
+	41,  // 112: gitserver.v1.GitserverService.DiskInfo:input_type -> gitserver.v1.DiskInfoRequest
+	43,  // 113: gitserver.v1.GitserverService.Capabilities:input_type -> gitserver.v1.CapabilitiesRequest
+	51,  // 114: gitserver.v1.GitserverService.Exec:input_type -> gitserver.v1.ExecRequest
+	91,  // 115: gitserver.v1.GitserverService.GetObject:input_type -> gitserver.v1.GetObjectRequest
+	80,  // 116: gitserver.v1.GitserverService.IsRepoCloneable:input_type -> gitserver.v1.IsRepoCloneableRequest
+	88,  // 117: gitserver.v1.GitserverService.ListGitolite:input_type -> gitserver.v1.ListGitoliteRequest
+	64,  // 118: gitserver.v1.GitserverService.Search:input_type -> gitserver.v1.SearchRequest
+	78,  // 119: gitserver.v1.GitserverService.Archive:input_type -> gitserver.v1.ArchiveRequest
+	82,  // 120: gitserver.v1.GitserverService.RepoCloneProgress:input_type -> gitserver.v1.RepoCloneProgressRequest
+	84,  // 121: gitserver.v1.GitserverService.RepoDelete:input_type -> gitserver.v1.RepoDeleteRequest
+	86,  // 122: gitserver.v1.GitserverService.RepoUpdate:input_type -> gitserver.v1.RepoUpdateRequest
+	94,  // 123: gitserver.v1.GitserverService.IsPerforcePathCloneable:input_type -> gitserver.v1.IsPerforcePathCloneableRequest
+	96,  // 124: gitserver.v1.GitserverService.CheckPerforceCredentials:input_type -> gitserver.v1.CheckPerforceCredentialsRequest
+	111, // 125: gitserver.v1.GitserverService.PerforceUsers:input_type -> gitserver.v1.PerforceUsersRequest
+	106, // 126: gitserver.v1.GitserverService.PerforceProtectsForUser:input_type -> gitserver.v1.PerforceProtectsForUserRequest
+	104, // 127: gitserver.v1.GitserverService.PerforceProtectsForDepot:input_type -> gitserver.v1.PerforceProtectsForDepotRequest
+	109, // 128: gitserver.v1.GitserverService.PerforceGroupMembers:input_type -> gitserver.v1.PerforceGroupMembersRequest
+	102, // 129: gitserver.v1.GitserverService.IsPerforceSuperUser:input_type -> gitserver.v1.IsPerforceSuperUserRequest
+	99,  // 130: gitserver.v1.GitserverService.PerforceGetChangelist:input_type -> gitserver.v1.PerforceGetChangelistRequest
+	114, // 131: gitserver.v1.GitserverService.MergeBase:input_type -> gitserver.v1.MergeBaseRequest
+	28,  // 132: gitserver.v1.GitserverService.Blame:input_type -> gitserver.v1.BlameRequest
+	33,  // 133: gitserver.v1.GitserverService.BlameSummary:input_type -> gitserver.v1.BlameSummaryRequest
+	37,  // 134: gitserver.v1.GitserverService.DefaultBranch:input_type -> gitserver.v1.DefaultBranchRequest
+	39,  // 135: gitserver.v1.GitserverService.ReadFile:input_type -> gitserver.v1.ReadFileRequest
+	23,  // 136: gitserver.v1.GitserverService.GetCommit:input_type -> gitserver.v1.GetCommitRequest
+	19,  // 137: gitserver.v1.GitserverService.ResolveRevision:input_type -> gitserver.v1.ResolveRevisionRequest
+	8,   // 138: gitserver.v1.GitserverService.ListRefs:input_type -> gitserver.v1.ListRefsRequest
+	10,  // 139: gitserver.v1.GitserverService.RefsChangedSince:input_type -> gitserver.v1.RefsChangedSinceRequest
+	12,  // 140: gitserver.v1.GitserverService.WatchRefs:input_type -> gitserver.v1.WatchRefsRequest
+	14,  // 141: gitserver.v1.GitserverService.WatchDefaultBranchCommits:input_type -> gitserver.v1.WatchDefaultBranchCommitsRequest
+	21,  // 142: gitserver.v1.GitserverService.RevAtTime:input_type -> gitserver.v1.RevAtTimeRequest
+	116, // 143: gitserver.v1.GitserverService.FormatPatch:input_type -> gitserver.v1.FormatPatchRequest
+	118, // 144: gitserver.v1.GitserverService.CommitGraph:input_type -> gitserver.v1.CommitGraphRequest
+	120, // 145: gitserver.v1.GitserverService.Cherry:input_type -> gitserver.v1.CherryRequest
+	123, // 146: gitserver.v1.GitserverService.RangeDiff:input_type -> gitserver.v1.RangeDiffRequest
+	127, // 147: gitserver.v1.GitserverService.UpdateRef:input_type -> gitserver.v1.UpdateRefRequest
+	129, // 148: gitserver.v1.GitserverService.CreateBranch:input_type -> gitserver.v1.CreateBranchRequest
+	131, // 149: gitserver.v1.GitserverService.DeleteBranch:input_type -> gitserver.v1.DeleteBranchRequest
+	133, // 150: gitserver.v1.GitserverService.CreateTag:input_type -> gitserver.v1.CreateTagRequest
+	135, // 151: gitserver.v1.GitserverService.SymbolicRef:input_type -> gitserver.v1.SymbolicRefRequest
+	137, // 152: gitserver.v1.GitserverService.RefExists:input_type -> gitserver.v1.RefExistsRequest
+	139, // 153: gitserver.v1.GitserverService.ListBranches:input_type -> gitserver.v1.ListBranchesRequest
+	143, // 154: gitserver.v1.GitserverService.ListTags:input_type -> gitserver.v1.ListTagsRequest
+	145, // 155: gitserver.v1.GitserverService.CloneProgress:input_type -> gitserver.v1.CloneProgressRequest
+	147, // 156: gitserver.v1.GitserverService.OptimizeRepo:input_type -> gitserver.v1.OptimizeRepoRequest
+	150, // 157: gitserver.v1.GitserverService.CommitGraphStatus:input_type -> gitserver.v1.CommitGraphStatusRequest
+	152, // 158: gitserver.v1.GitserverService.CloneState:input_type -> gitserver.v1.CloneStateRequest
+	154, // 159: gitserver.v1.GitserverService.ListRepos:input_type -> gitserver.v1.ListReposRequest
+	157, // 160: gitserver.v1.GitserverService.ReadBlob:input_type -> gitserver.v1.ReadBlobRequest
+	159, // 161: gitserver.v1.GitserverService.GetTree:input_type -> gitserver.v1.GetTreeRequest
+	162, // 162: gitserver.v1.GitserverService.SparseManifest:input_type -> gitserver.v1.SparseManifestRequest
+	164, // 163: gitserver.v1.GitserverService.LastCommitsForTree:input_type -> gitserver.v1.LastCommitsForTreeRequest
+	167, // 164: gitserver.v1.GitserverService.CommitActivity:input_type -> gitserver.v1.CommitActivityRequest
+	170, // 165: gitserver.v1.GitserverService.GetTag:input_type -> gitserver.v1.GetTagRequest
+	173, // 166: gitserver.v1.GitserverService.ListRemoteRefs:input_type -> gitserver.v1.ListRemoteRefsRequest
+	175, // 167: gitserver.v1.GitserverService.FetchCommitFromRepo:input_type -> gitserver.v1.FetchCommitFromRepoRequest
+	177, // 168: gitserver.v1.GitserverService.CreateBundle:input_type -> gitserver.v1.CreateBundleRequest
+	179, // 169: gitserver.v1.GitserverService.ApplyBundle:input_type -> gitserver.v1.ApplyBundleRequest
+	181, // 170: gitserver.v1.GitserverService.FetchRefspec:input_type -> gitserver.v1.FetchRefspecRequest
+	183, // 171: gitserver.v1.GitserverService.ExecInWorktree:input_type -> gitserver.v1.ExecInWorktreeRequest
+	192, // 172: gitserver.v1.GitserverService.RebasePreview:input_type -> gitserver.v1.RebasePreviewRequest
+	185, // 173: gitserver.v1.GitserverService.RevertCommit:input_type -> gitserver.v1.RevertCommitRequest
+	187, // 174: gitserver.v1.GitserverService.CherryPick:input_type -> gitserver.v1.CherryPickRequest
+	189, // 175: gitserver.v1.GitserverService.SquashCommits:input_type -> gitserver.v1.SquashCommitsRequest
+	50,  // 176: gitserver.v1.GitserverService.CreateCommitFromPatchBinary:output_type -> gitserver.v1.CreateCommitFromPatchBinaryResponse
+	42,  // 177: gitserver.v1.GitserverService.DiskInfo:output_type -> gitserver.v1.DiskInfoResponse
+	44,  // 178: gitserver.v1.GitserverService.Capabilities:output_type -> gitserver.v1.CapabilitiesResponse
+	52,  // 179: gitserver.v1.GitserverService.Exec:output_type -> gitserver.v1.ExecResponse
+	92,  // 180: gitserver.v1.GitserverService.GetObject:output_type -> gitserver.v1.GetObjectResponse
+	81,  // 181: gitserver.v1.GitserverService.IsRepoCloneable:output_type -> gitserver.v1.IsRepoCloneableResponse
+	90,  // 182: gitserver.v1.GitserverService.ListGitolite:output_type -> gitserver.v1.ListGitoliteResponse
+	76,  // 183: gitserver.v1.GitserverService.Search:output_type -> gitserver.v1.SearchResponse
+	79,  // 184: gitserver.v1.GitserverService.Archive:output_type -> gitserver.v1.ArchiveResponse
+	83,  // 185: gitserver.v1.GitserverService.RepoCloneProgress:output_type -> gitserver.v1.RepoCloneProgressResponse
+	85,  // 186: gitserver.v1.GitserverService.RepoDelete:output_type -> gitserver.v1.RepoDeleteResponse
+	87,  // 187: gitserver.v1.GitserverService.RepoUpdate:output_type -> gitserver.v1.RepoUpdateResponse
+	95,  // 188: gitserver.v1.GitserverService.IsPerforcePathCloneable:output_type -> gitserver.v1.IsPerforcePathCloneableResponse
+	97,  // 189: gitserver.v1.GitserverService.CheckPerforceCredentials:output_type -> gitserver.v1.CheckPerforceCredentialsResponse
+	112, // 190: gitserver.v1.GitserverService.PerforceUsers:output_type -> gitserver.v1.PerforceUsersResponse
+	107, // 191: gitserver.v1.GitserverService.PerforceProtectsForUser:output_type -> gitserver.v1.PerforceProtectsForUserResponse
+	105, // 192: gitserver.v1.GitserverService.PerforceProtectsForDepot:output_type -> gitserver.v1.PerforceProtectsForDepotResponse
+	110, // 193: gitserver.v1.GitserverService.PerforceGroupMembers:output_type -> gitserver.v1.PerforceGroupMembersResponse
+	103, // 194: gitserver.v1.GitserverService.IsPerforceSuperUser:output_type -> gitserver.v1.IsPerforceSuperUserResponse
+	100, // 195: gitserver.v1.GitserverService.PerforceGetChangelist:output_type -> gitserver.v1.PerforceGetChangelistResponse
+	115, // 196: gitserver.v1.GitserverService.MergeBase:output_type -> gitserver.v1.MergeBaseResponse
+	30,  // 197: gitserver.v1.GitserverService.Blame:output_type -> gitserver.v1.BlameResponse
+	34,  // 198: gitserver.v1.GitserverService.BlameSummary:output_type -> gitserver.v1.BlameSummaryResponse
+	38,  // 199: gitserver.v1.GitserverService.DefaultBranch:output_type -> gitserver.v1.DefaultBranchResponse
+	40,  // 200: gitserver.v1.GitserverService.ReadFile:output_type -> gitserver.v1.ReadFileResponse
+	24,  // 201: gitserver.v1.GitserverService.GetCommit:output_type -> gitserver.v1.GetCommitResponse
+	20,  // 202: gitserver.v1.GitserverService.ResolveRevision:output_type -> gitserver.v1.ResolveRevisionResponse
+	9,   // 203: gitserver.v1.GitserverService.ListRefs:output_type -> gitserver.v1.ListRefsResponse
+	11,  // 204: gitserver.v1.GitserverService.RefsChangedSince:output_type -> gitserver.v1.RefsChangedSinceResponse
+	13,  // 205: gitserver.v1.GitserverService.WatchRefs:output_type -> gitserver.v1.WatchRefsResponse
+	15,  // 206: gitserver.v1.GitserverService.WatchDefaultBranchCommits:output_type -> gitserver.v1.WatchDefaultBranchCommitsResponse
+	22,  // 207: gitserver.v1.GitserverService.RevAtTime:output_type -> gitserver.v1.RevAtTimeResponse
+	117, // 208: gitserver.v1.GitserverService.FormatPatch:output_type -> gitserver.v1.FormatPatchResponse
+	119, // 209: gitserver.v1.GitserverService.CommitGraph:output_type -> gitserver.v1.CommitGraphResponse
+	121, // 210: gitserver.v1.GitserverService.Cherry:output_type -> gitserver.v1.CherryResponse
+	124, // 211: gitserver.v1.GitserverService.RangeDiff:output_type -> gitserver.v1.RangeDiffResponse
+	128, // 212: gitserver.v1.GitserverService.UpdateRef:output_type -> gitserver.v1.UpdateRefResponse
+	130, // 213: gitserver.v1.GitserverService.CreateBranch:output_type -> gitserver.v1.CreateBranchResponse
+	132, // 214: gitserver.v1.GitserverService.DeleteBranch:output_type -> gitserver.v1.DeleteBranchResponse
+	134, // 215: gitserver.v1.GitserverService.CreateTag:output_type -> gitserver.v1.CreateTagResponse
+	136, // 216: gitserver.v1.GitserverService.SymbolicRef:output_type -> gitserver.v1.SymbolicRefResponse
+	138, // 217: gitserver.v1.GitserverService.RefExists:output_type -> gitserver.v1.RefExistsResponse
+	140, // 218: gitserver.v1.GitserverService.ListBranches:output_type -> gitserver.v1.ListBranchesResponse
+	144, // 219: gitserver.v1.GitserverService.ListTags:output_type -> gitserver.v1.ListTagsResponse
+	146, // 220: gitserver.v1.GitserverService.CloneProgress:output_type -> gitserver.v1.CloneProgressResponse
+	148, // 221: gitserver.v1.GitserverService.OptimizeRepo:output_type -> gitserver.v1.OptimizeRepoResponse
+	151, // 222: gitserver.v1.GitserverService.CommitGraphStatus:output_type -> gitserver.v1.CommitGraphStatusResponse
+	153, // 223: gitserver.v1.GitserverService.CloneState:output_type -> gitserver.v1.CloneStateResponse
+	155, // 224: gitserver.v1.GitserverService.ListRepos:output_type -> gitserver.v1.ListReposResponse
+	158, // 225: gitserver.v1.GitserverService.ReadBlob:output_type -> gitserver.v1.ReadBlobResponse
+	160, // 226: gitserver.v1.GitserverService.GetTree:output_type -> gitserver.v1.GetTreeResponse
+	163, // 227: gitserver.v1.GitserverService.SparseManifest:output_type -> gitserver.v1.SparseManifestResponse
+	165, // 228: gitserver.v1.GitserverService.LastCommitsForTree:output_type -> gitserver.v1.LastCommitsForTreeResponse
+	168, // 229: gitserver.v1.GitserverService.CommitActivity:output_type -> gitserver.v1.CommitActivityResponse
+	171, // 230: gitserver.v1.GitserverService.GetTag:output_type -> gitserver.v1.GetTagResponse
+	174, // 231: gitserver.v1.GitserverService.ListRemoteRefs:output_type -> gitserver.v1.ListRemoteRefsResponse
+	176, // 232: gitserver.v1.GitserverService.FetchCommitFromRepo:output_type -> gitserver.v1.FetchCommitFromRepoResponse
+	178, // 233: gitserver.v1.GitserverService.CreateBundle:output_type -> gitserver.v1.CreateBundleResponse
+	180, // 234: gitserver.v1.GitserverService.ApplyBundle:output_type -> gitserver.v1.ApplyBundleResponse
+	182, // 235: gitserver.v1.GitserverService.FetchRefspec:output_type -> gitserver.v1.FetchRefspecResponse
+	184, // 236: gitserver.v1.GitserverService.ExecInWorktree:output_type -> gitserver.v1.ExecInWorktreeResponse
+	193, // 237: gitserver.v1.GitserverService.RebasePreview:output_type -> gitserver.v1.RebasePreviewResponse
+	186, // 238: gitserver.v1.GitserverService.RevertCommit:output_type -> gitserver.v1.RevertCommitResponse
+	188, // 239: gitserver.v1.GitserverService.CherryPick:output_type -> gitserver.v1.CherryPickResponse
+	190, // 240: gitserver.v1.GitserverService.SquashCommits:output_type -> gitserver.v1.SquashCommitsResponse
+	176, // [176:241] is the sub-list for method output_type
+	111, // [111:176] is the sub-list for method input_type
+	111, // [111:111] is the sub-list for extension type_name
+	111, // [111:111] is the sub-list for extension extendee
+	0,   // [0:111] is the sub-list for field type_name
+}
+
+func init() { file_gitserver_proto_init() }
+func file_gitserver_proto_init() {
+	if File_gitserver_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gitserver_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRefsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRefsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefsChangedSinceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefsChangedSinceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRefsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRefsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDefaultBranchCommitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDefaultBranchCommitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BranchCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveRevisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevAtTimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevAtTimeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileDiffStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlameRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlameHunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlameAuthor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlameSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlameSummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlameAuthorSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviousCommit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefaultBranchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefaultBranchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapabilitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapabilitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchCommitInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitTrailer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommitFromPatchBinaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommitFromPatchError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCommitFromPatchBinaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoNotFoundPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevisionNotFoundPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileNotFoundPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecStatusPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnauthorizedPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefUpdateConflictPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmbiguousRevisionPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmbiguousRevisionCandidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BadObjectPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShallowHistoryPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HookPermissionDeniedPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevisionSpecifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorMatchesNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitterMatchesNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitBeforeNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitAfterNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageMatchesNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffMatchesNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffModifiesFileNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BooleanNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperatorNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsRepoCloneableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsRepoCloneableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoCloneProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoCloneProgressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGitoliteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitoliteRepo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGitoliteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetObjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetObjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsPerforcePathCloneableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsPerforcePathCloneableResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPerforceCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPerforceCredentialsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceConnectionDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceGetChangelistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceGetChangelistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceChangelist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsPerforceSuperUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7248,8 +16112,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRefsResponse); i {
+		file_gitserver_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsPerforceSuperUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7260,8 +16124,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitRef); i {
+		file_gitserver_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceProtectsForDepotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7272,8 +16136,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolveRevisionRequest); i {
+		file_gitserver_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceProtectsForDepotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7284,8 +16148,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolveRevisionResponse); i {
+		file_gitserver_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceProtectsForUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7296,8 +16160,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevAtTimeRequest); i {
+		file_gitserver_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceProtectsForUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7308,8 +16172,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevAtTimeResponse); i {
+		file_gitserver_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceProtect); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7320,8 +16184,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommitRequest); i {
+		file_gitserver_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceGroupMembersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7332,8 +16196,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCommitResponse); i {
+		file_gitserver_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceGroupMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7344,8 +16208,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitCommit); i {
+		file_gitserver_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7356,8 +16220,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitSignature); i {
+		file_gitserver_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7368,8 +16232,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlameRequest); i {
+		file_gitserver_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerforceUser); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7380,8 +16244,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlameRange); i {
+		file_gitserver_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeBaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7392,8 +16256,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlameResponse); i {
+		file_gitserver_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeBaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7404,8 +16268,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlameHunk); i {
+		file_gitserver_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatPatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7416,8 +16280,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlameAuthor); i {
+		file_gitserver_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatPatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7428,8 +16292,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PreviousCommit); i {
+		file_gitserver_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitGraphRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7440,8 +16304,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultBranchRequest); i {
+		file_gitserver_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitGraphResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7452,8 +16316,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultBranchResponse); i {
+		file_gitserver_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CherryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7464,8 +16328,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadFileRequest); i {
+		file_gitserver_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CherryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7476,8 +16340,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadFileResponse); i {
+		file_gitserver_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CherryCommit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7488,8 +16352,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskInfoRequest); i {
+		file_gitserver_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeDiffRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7500,8 +16364,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskInfoResponse); i {
+		file_gitserver_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeDiffResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7512,8 +16376,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PatchCommitInfo); i {
+		file_gitserver_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeDiffPair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7524,8 +16388,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushConfig); i {
+		file_gitserver_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitGraphNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7536,8 +16400,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCommitFromPatchBinaryRequest); i {
+		file_gitserver_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRefRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7548,8 +16412,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCommitFromPatchError); i {
+		file_gitserver_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRefResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7560,8 +16424,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCommitFromPatchBinaryResponse); i {
+		file_gitserver_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBranchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7572,8 +16436,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecRequest); i {
+		file_gitserver_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBranchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7584,8 +16448,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecResponse); i {
+		file_gitserver_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBranchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7596,8 +16460,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoNotFoundPayload); i {
+		file_gitserver_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBranchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7608,8 +16472,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevisionNotFoundPayload); i {
+		file_gitserver_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7620,8 +16484,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileNotFoundPayload); i {
+		file_gitserver_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTagResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7632,8 +16496,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecStatusPayload); i {
+		file_gitserver_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbolicRefRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7644,8 +16508,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnauthorizedPayload); i {
+		file_gitserver_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbolicRefResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7656,8 +16520,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest); i {
+		file_gitserver_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefExistsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7668,8 +16532,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevisionSpecifier); i {
+		file_gitserver_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefExistsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7680,8 +16544,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorMatchesNode); i {
+		file_gitserver_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBranchesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7692,8 +16556,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitterMatchesNode); i {
+		file_gitserver_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBranchesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7704,8 +16568,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitBeforeNode); i {
+		file_gitserver_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitBranch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7716,8 +16580,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitAfterNode); i {
+		file_gitserver_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BehindAhead); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7728,8 +16592,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageMatchesNode); i {
+		file_gitserver_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7740,8 +16604,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiffMatchesNode); i {
+		file_gitserver_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7752,8 +16616,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiffModifiesFileNode); i {
+		file_gitserver_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneProgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7764,8 +16628,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BooleanNode); i {
+		file_gitserver_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneProgressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7776,8 +16640,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperatorNode); i {
+		file_gitserver_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimizeRepoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7788,8 +16652,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryNode); i {
+		file_gitserver_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimizeRepoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7800,8 +16664,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResponse); i {
+		file_gitserver_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceTaskResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7812,8 +16676,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitMatch); i {
+		file_gitserver_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitGraphStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7824,8 +16688,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveRequest); i {
+		file_gitserver_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitGraphStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7836,8 +16700,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveResponse); i {
+		file_gitserver_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7848,8 +16712,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsRepoCloneableRequest); i {
+		file_gitserver_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7860,8 +16724,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsRepoCloneableResponse); i {
+		file_gitserver_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReposRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7872,8 +16736,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoCloneProgressRequest); i {
+		file_gitserver_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReposResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7884,8 +16748,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoCloneProgressResponse); i {
+		file_gitserver_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostedRepo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7896,8 +16760,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoDeleteRequest); i {
+		file_gitserver_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadBlobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7908,8 +16772,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoDeleteResponse); i {
+		file_gitserver_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadBlobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7920,8 +16784,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoUpdateRequest); i {
+		file_gitserver_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTreeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7932,8 +16796,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoUpdateResponse); i {
+		file_gitserver_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTreeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7944,8 +16808,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGitoliteRequest); i {
+		file_gitserver_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TreeEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7956,8 +16820,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitoliteRepo); i {
+		file_gitserver_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SparseManifestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7968,8 +16832,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGitoliteResponse); i {
+		file_gitserver_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SparseManifestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7980,8 +16844,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetObjectRequest); i {
+		file_gitserver_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LastCommitsForTreeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7992,8 +16856,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetObjectResponse); i {
+		file_gitserver_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LastCommitsForTreeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8004,8 +16868,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitObject); i {
+		file_gitserver_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TreeEntryCommit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8016,8 +16880,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsPerforcePathCloneableRequest); i {
+		file_gitserver_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitActivityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8028,8 +16892,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsPerforcePathCloneableResponse); i {
+		file_gitserver_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitActivityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8040,8 +16904,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckPerforceCredentialsRequest); i {
+		file_gitserver_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitActivityBucket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8052,8 +16916,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckPerforceCredentialsResponse); i {
+		file_gitserver_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8064,8 +16928,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceConnectionDetails); i {
+		file_gitserver_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8076,8 +16940,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceGetChangelistRequest); i {
+		file_gitserver_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitTag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8088,8 +16952,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceGetChangelistResponse); i {
+		file_gitserver_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRemoteRefsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8100,8 +16964,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceChangelist); i {
+		file_gitserver_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRemoteRefsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8112,8 +16976,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsPerforceSuperUserRequest); i {
+		file_gitserver_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchCommitFromRepoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8124,8 +16988,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsPerforceSuperUserResponse); i {
+		file_gitserver_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchCommitFromRepoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8136,8 +17000,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceProtectsForDepotRequest); i {
+		file_gitserver_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBundleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8148,8 +17012,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceProtectsForDepotResponse); i {
+		file_gitserver_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBundleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8160,8 +17024,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceProtectsForUserRequest); i {
+		file_gitserver_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyBundleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8172,8 +17036,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceProtectsForUserResponse); i {
+		file_gitserver_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyBundleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8184,8 +17048,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceProtect); i {
+		file_gitserver_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchRefspecRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8196,8 +17060,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceGroupMembersRequest); i {
+		file_gitserver_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchRefspecResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8208,8 +17072,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceGroupMembersResponse); i {
+		file_gitserver_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecInWorktreeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8220,8 +17084,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceUsersRequest); i {
+		file_gitserver_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecInWorktreeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8232,8 +17096,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceUsersResponse); i {
+		file_gitserver_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertCommitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8244,8 +17108,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PerforceUser); i {
+		file_gitserver_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertCommitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8256,8 +17120,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MergeBaseRequest); i {
+		file_gitserver_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CherryPickRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8268,8 +17132,8 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MergeBaseResponse); i {
+		file_gitserver_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CherryPickResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8280,7 +17144,67 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+		file_gitserver_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SquashCommitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SquashCommitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeConflictPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebasePreviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebasePreviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gitserver_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateCommitFromPatchBinaryRequest_Metadata); i {
 			case 0:
 				return &v.state
@@ -8292,7 +17216,7 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+		file_gitserver_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateCommitFromPatchBinaryRequest_Patch); i {
 			case 0:
 				return &v.state
@@ -8304,7 +17228,7 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+		file_gitserver_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommitMatch_Signature); i {
 			case 0:
 				return &v.state
@@ -8316,7 +17240,7 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+		file_gitserver_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommitMatch_MatchedString); i {
 			case 0:
 				return &v.state
@@ -8328,7 +17252,7 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+		file_gitserver_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommitMatch_Range); i {
 			case 0:
 				return &v.state
@@ -8340,7 +17264,7 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
-		file_gitserver_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+		file_gitserver_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommitMatch_Location); i {
 			case 0:
 				return &v.state
@@ -8352,17 +17276,30 @@ func file_gitserver_proto_init() {
 				return nil
 			}
 		}
+		file_gitserver_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyBundleRequest_Metadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_gitserver_proto_msgTypes[0].OneofWrappers = []interface{}{}
-	file_gitserver_proto_msgTypes[3].OneofWrappers = []interface{}{}
 	file_gitserver_proto_msgTypes[11].OneofWrappers = []interface{}{}
-	file_gitserver_proto_msgTypes[14].OneofWrappers = []interface{}{}
-	file_gitserver_proto_msgTypes[25].OneofWrappers = []interface{}{
+	file_gitserver_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_gitserver_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_gitserver_proto_msgTypes[39].OneofWrappers = []interface{}{}
+	file_gitserver_proto_msgTypes[40].OneofWrappers = []interface{}{
 		(*CreateCommitFromPatchBinaryRequest_Metadata_)(nil),
 		(*CreateCommitFromPatchBinaryRequest_Patch_)(nil),
 	}
-	file_gitserver_proto_msgTypes[34].OneofWrappers = []interface{}{}
-	file_gitserver_proto_msgTypes[46].OneofWrappers = []interface{}{
+	file_gitserver_proto_msgTypes[49].OneofWrappers = []interface{}{}
+	file_gitserver_proto_msgTypes[67].OneofWrappers = []interface{}{
 		(*QueryNode_AuthorMatches)(nil),
 		(*QueryNode_CommitterMatches)(nil),
 		(*QueryNode_CommitBefore)(nil),
@@ -8373,18 +17310,22 @@ func file_gitserver_proto_init() {
 		(*QueryNode_Boolean)(nil),
 		(*QueryNode_Operator)(nil),
 	}
-	file_gitserver_proto_msgTypes[47].OneofWrappers = []interface{}{
+	file_gitserver_proto_msgTypes[68].OneofWrappers = []interface{}{
 		(*SearchResponse_Match)(nil),
 		(*SearchResponse_LimitHit)(nil),
 	}
-	file_gitserver_proto_msgTypes[87].OneofWrappers = []interface{}{}
+	file_gitserver_proto_msgTypes[171].OneofWrappers = []interface{}{
+		(*ApplyBundleRequest_Metadata_)(nil),
+		(*ApplyBundleRequest_Data)(nil),
+	}
+	file_gitserver_proto_msgTypes[186].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gitserver_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   93,
+			NumEnums:      8,
+			NumMessages:   193,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
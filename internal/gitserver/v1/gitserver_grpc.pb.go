@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	GitserverService_CreateCommitFromPatchBinary_FullMethodName = "/gitserver.v1.GitserverService/CreateCommitFromPatchBinary"
 	GitserverService_DiskInfo_FullMethodName                    = "/gitserver.v1.GitserverService/DiskInfo"
+	GitserverService_Capabilities_FullMethodName                = "/gitserver.v1.GitserverService/Capabilities"
 	GitserverService_Exec_FullMethodName                        = "/gitserver.v1.GitserverService/Exec"
 	GitserverService_GetObject_FullMethodName                   = "/gitserver.v1.GitserverService/GetObject"
 	GitserverService_IsRepoCloneable_FullMethodName             = "/gitserver.v1.GitserverService/IsRepoCloneable"
@@ -40,12 +41,49 @@ const (
 	GitserverService_PerforceGetChangelist_FullMethodName       = "/gitserver.v1.GitserverService/PerforceGetChangelist"
 	GitserverService_MergeBase_FullMethodName                   = "/gitserver.v1.GitserverService/MergeBase"
 	GitserverService_Blame_FullMethodName                       = "/gitserver.v1.GitserverService/Blame"
+	GitserverService_BlameSummary_FullMethodName                = "/gitserver.v1.GitserverService/BlameSummary"
 	GitserverService_DefaultBranch_FullMethodName               = "/gitserver.v1.GitserverService/DefaultBranch"
 	GitserverService_ReadFile_FullMethodName                    = "/gitserver.v1.GitserverService/ReadFile"
 	GitserverService_GetCommit_FullMethodName                   = "/gitserver.v1.GitserverService/GetCommit"
 	GitserverService_ResolveRevision_FullMethodName             = "/gitserver.v1.GitserverService/ResolveRevision"
 	GitserverService_ListRefs_FullMethodName                    = "/gitserver.v1.GitserverService/ListRefs"
+	GitserverService_RefsChangedSince_FullMethodName            = "/gitserver.v1.GitserverService/RefsChangedSince"
+	GitserverService_WatchRefs_FullMethodName                   = "/gitserver.v1.GitserverService/WatchRefs"
+	GitserverService_WatchDefaultBranchCommits_FullMethodName   = "/gitserver.v1.GitserverService/WatchDefaultBranchCommits"
 	GitserverService_RevAtTime_FullMethodName                   = "/gitserver.v1.GitserverService/RevAtTime"
+	GitserverService_FormatPatch_FullMethodName                 = "/gitserver.v1.GitserverService/FormatPatch"
+	GitserverService_CommitGraph_FullMethodName                 = "/gitserver.v1.GitserverService/CommitGraph"
+	GitserverService_Cherry_FullMethodName                      = "/gitserver.v1.GitserverService/Cherry"
+	GitserverService_RangeDiff_FullMethodName                   = "/gitserver.v1.GitserverService/RangeDiff"
+	GitserverService_UpdateRef_FullMethodName                   = "/gitserver.v1.GitserverService/UpdateRef"
+	GitserverService_CreateBranch_FullMethodName                = "/gitserver.v1.GitserverService/CreateBranch"
+	GitserverService_DeleteBranch_FullMethodName                = "/gitserver.v1.GitserverService/DeleteBranch"
+	GitserverService_CreateTag_FullMethodName                   = "/gitserver.v1.GitserverService/CreateTag"
+	GitserverService_SymbolicRef_FullMethodName                 = "/gitserver.v1.GitserverService/SymbolicRef"
+	GitserverService_RefExists_FullMethodName                   = "/gitserver.v1.GitserverService/RefExists"
+	GitserverService_ListBranches_FullMethodName                = "/gitserver.v1.GitserverService/ListBranches"
+	GitserverService_ListTags_FullMethodName                    = "/gitserver.v1.GitserverService/ListTags"
+	GitserverService_CloneProgress_FullMethodName               = "/gitserver.v1.GitserverService/CloneProgress"
+	GitserverService_OptimizeRepo_FullMethodName                = "/gitserver.v1.GitserverService/OptimizeRepo"
+	GitserverService_CommitGraphStatus_FullMethodName           = "/gitserver.v1.GitserverService/CommitGraphStatus"
+	GitserverService_CloneState_FullMethodName                  = "/gitserver.v1.GitserverService/CloneState"
+	GitserverService_ListRepos_FullMethodName                   = "/gitserver.v1.GitserverService/ListRepos"
+	GitserverService_ReadBlob_FullMethodName                    = "/gitserver.v1.GitserverService/ReadBlob"
+	GitserverService_GetTree_FullMethodName                     = "/gitserver.v1.GitserverService/GetTree"
+	GitserverService_SparseManifest_FullMethodName              = "/gitserver.v1.GitserverService/SparseManifest"
+	GitserverService_LastCommitsForTree_FullMethodName          = "/gitserver.v1.GitserverService/LastCommitsForTree"
+	GitserverService_CommitActivity_FullMethodName              = "/gitserver.v1.GitserverService/CommitActivity"
+	GitserverService_GetTag_FullMethodName                      = "/gitserver.v1.GitserverService/GetTag"
+	GitserverService_ListRemoteRefs_FullMethodName              = "/gitserver.v1.GitserverService/ListRemoteRefs"
+	GitserverService_FetchCommitFromRepo_FullMethodName         = "/gitserver.v1.GitserverService/FetchCommitFromRepo"
+	GitserverService_CreateBundle_FullMethodName                = "/gitserver.v1.GitserverService/CreateBundle"
+	GitserverService_ApplyBundle_FullMethodName                 = "/gitserver.v1.GitserverService/ApplyBundle"
+	GitserverService_FetchRefspec_FullMethodName                = "/gitserver.v1.GitserverService/FetchRefspec"
+	GitserverService_ExecInWorktree_FullMethodName              = "/gitserver.v1.GitserverService/ExecInWorktree"
+	GitserverService_RebasePreview_FullMethodName               = "/gitserver.v1.GitserverService/RebasePreview"
+	GitserverService_RevertCommit_FullMethodName                = "/gitserver.v1.GitserverService/RevertCommit"
+	GitserverService_CherryPick_FullMethodName                  = "/gitserver.v1.GitserverService/CherryPick"
+	GitserverService_SquashCommits_FullMethodName               = "/gitserver.v1.GitserverService/SquashCommits"
 )
 
 // GitserverServiceClient is the client API for GitserverService service.
@@ -54,6 +92,12 @@ const (
 type GitserverServiceClient interface {
 	CreateCommitFromPatchBinary(ctx context.Context, opts ...grpc.CallOption) (GitserverService_CreateCommitFromPatchBinaryClient, error)
 	DiskInfo(ctx context.Context, in *DiskInfoRequest, opts ...grpc.CallOption) (*DiskInfoResponse, error)
+	// Capabilities returns the optional protocol features this gitserver
+	// supports, so that clients can check before relying on them.
+	//
+	// Older gitservers return an Unimplemented error, which clients should
+	// treat as supporting none of the optional features.
+	Capabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*CapabilitiesResponse, error)
 	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (GitserverService_ExecClient, error)
 	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error)
 	IsRepoCloneable(ctx context.Context, in *IsRepoCloneableRequest, opts ...grpc.CallOption) (*IsRepoCloneableResponse, error)
@@ -100,6 +144,19 @@ type GitserverServiceClient interface {
 	// If the given repo is not cloned, it will be enqueued for cloning and a
 	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
 	Blame(ctx context.Context, in *BlameRequest, opts ...grpc.CallOption) (GitserverService_BlameClient, error)
+	// BlameSummary blames the given file, or all files below the given
+	// directory, and returns how many lines each author last touched and when.
+	// If subrepo permissions are enabled for the repo, files the user cannot
+	// read are left out of a directory summary. If access to a single file is
+	// denied, an error with a UnauthorizedPayload in the details is returned.
+	//
+	// If the path doesn't exist, an error with a FileNotFoundPayload is returned.
+	// If the commit doesn't exist, an error with a RevisionNotFoundPayload is
+	// returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	BlameSummary(ctx context.Context, in *BlameSummaryRequest, opts ...grpc.CallOption) (*BlameSummaryResponse, error)
 	// DefaultBranch resolves HEAD to ref name and current commit SHA it points
 	// to. If HEAD points to an empty branch, it returns an error with a
 	// RevisionNotFoundPayload.
@@ -118,6 +175,8 @@ type GitserverServiceClient interface {
 	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
 	ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (GitserverService_ReadFileClient, error)
 	// GetCommit gets a commit from the repo ODB.
+	// If requested, the diff stat and patch of the commit are returned as well,
+	// so that callers can render a commit in a single round trip.
 	// The endpoint will verify that the user is allowed to view the given commit.
 	//
 	// If subrepo permissions are enabled for the repo. If access is denied, an
@@ -162,6 +221,42 @@ type GitserverServiceClient interface {
 	// If the given repo is not cloned, it will be enqueued for cloning and a NotFound
 	// error will be returned, with a RepoNotFoundPayload in the details.
 	ListRefs(ctx context.Context, in *ListRefsRequest, opts ...grpc.CallOption) (GitserverService_ListRefsClient, error)
+	// RefsChangedSince returns the refs whose targets moved since the state
+	// identified by state_token, or since the given time, so that callers that
+	// poll a repo don't have to list all of its refs every time. Deleted refs
+	// are included with an empty target_commit.
+	//
+	// Ref moves are recorded when gitserver updates the repo, for example after
+	// a fetch. If the changes can't be computed, for example because the state
+	// is too old, all refs are returned and full is set.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a NotFound
+	// error will be returned, with a RepoNotFoundPayload in the details.
+	RefsChangedSince(ctx context.Context, in *RefsChangedSinceRequest, opts ...grpc.CallOption) (GitserverService_RefsChangedSinceClient, error)
+	// WatchRefs streams the refs of a repository that move, for example when
+	// gitserver fetches it, until the client cancels the call. Right away, an
+	// update with only the current state_token is sent, and then one whenever
+	// refs move. An update can span multiple messages, its last message has
+	// state_token set.
+	//
+	// The state tokens can be passed to RefsChangedSince to catch up on the
+	// changes missed after the stream ended.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a NotFound
+	// error will be returned, with a RepoNotFoundPayload in the details.
+	WatchRefs(ctx context.Context, in *WatchRefsRequest, opts ...grpc.CallOption) (GitserverService_WatchRefsClient, error)
+	// WatchDefaultBranchCommits streams the commits that land on the default
+	// branch of a repository, for example when gitserver fetches it, until the
+	// client cancels the call. Right away, an update with only the current
+	// head_commit_sha is sent, and then one whenever the default branch moves.
+	// An update can span multiple messages, its last message has
+	// head_commit_sha set.
+	//
+	// No update is sent while the default branch has no commits.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a NotFound
+	// error will be returned, with a RepoNotFoundPayload in the details.
+	WatchDefaultBranchCommits(ctx context.Context, in *WatchDefaultBranchCommitsRequest, opts ...grpc.CallOption) (GitserverService_WatchDefaultBranchCommitsClient, error)
 	// RevAtTime looks up the OID of the nearest ancestor of `spec` that has a
 	// commit time before the given time. To simplify the logic, it only follows
 	// the first parent of merge commits to linearize the commit history. The
@@ -173,6 +268,442 @@ type GitserverServiceClient interface {
 	// If the revision exists, but there is no commit in its ancestry before
 	// the requested time, an empty string is returned for the commit SHA.
 	RevAtTime(ctx context.Context, in *RevAtTimeRequest, opts ...grpc.CallOption) (*RevAtTimeResponse, error)
+	// FormatPatch streams the commits reachable from head but not from base as a series of mbox-formatted patches, as produced by
+	// git format-patch --stdout.
+	//
+	// If subrepo permissions are enabled for the repo, no patches will be
+	// created for non-internal actors and an unimplemented error will be
+	// returned, as the patches could leak the contents of restricted files.
+	//
+	// If one of the given revspecs does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	FormatPatch(ctx context.Context, in *FormatPatchRequest, opts ...grpc.CallOption) (GitserverService_FormatPatchClient, error)
+	// CommitGraph streams the commits reachable from head but not from base,
+	// together with the SHAs of their parents, without any further commit
+	// metadata. Commits are returned in topological order, children before
+	// their parents. Parents of the oldest commits in the range can lie
+	// outside of it.
+	//
+	// If subrepo permissions are enabled for the repo, no graph will be
+	// returned for non-internal actors and an unimplemented error will be
+	// returned, as commits that only touch restricted files must not be
+	// revealed.
+	//
+	// If one of the given revspecs does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CommitGraph(ctx context.Context, in *CommitGraphRequest, opts ...grpc.CallOption) (GitserverService_CommitGraphClient, error)
+	// Cherry reports for every commit reachable from head but not from upstream
+	// whether an equivalent change is already present in upstream, as done by
+	// git cherry. Two commits are equivalent if their diffs have the same
+	// patch ID, so this detects commits that were cherry-picked or rebased onto
+	// upstream. Commits are returned oldest first.
+	//
+	// If subrepo permissions are enabled for the repo, no result will be
+	// returned for non-internal actors and an unimplemented error will be
+	// returned, as the result depends on the contents of restricted files.
+	//
+	// If one of the given revspecs does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	Cherry(ctx context.Context, in *CherryRequest, opts ...grpc.CallOption) (*CherryResponse, error)
+	// RangeDiff compares two versions of a commit series, like a branch before
+	// and after a rebase, as done by git range-diff. The commits of the old
+	// series are old_base..old_head, the commits of the new series are
+	// new_base..new_head. Every commit is matched with its counterpart in the
+	// other series, if any.
+	//
+	// If subrepo permissions are enabled for the repo, no range diff will be
+	// created for non-internal actors and an unimplemented error will be
+	// returned, as the diffs could leak the contents of restricted files.
+	//
+	// If one of the given revspecs does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	RangeDiff(ctx context.Context, in *RangeDiffRequest, opts ...grpc.CallOption) (*RangeDiffResponse, error)
+	// UpdateRef atomically updates ref_name to point at new_commit_sha, but only
+	// if it currently points at expected_old_commit_sha. If
+	// expected_old_commit_sha is empty, the ref must not exist yet, so the call
+	// creates it.
+	//
+	// If the ref does not have the expected value, a FailedPrecondition error
+	// with a RefUpdateConflictPayload is returned and the ref is left untouched.
+	//
+	// If new_commit_sha does not exist in the repository, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	UpdateRef(ctx context.Context, in *UpdateRefRequest, opts ...grpc.CallOption) (*UpdateRefResponse, error)
+	// CreateBranch creates a new branch pointing at start_point. If start_point
+	// is empty, the branch is created at HEAD.
+	//
+	// If the branch already exists, a FailedPrecondition error with a
+	// RefUpdateConflictPayload is returned.
+	//
+	// If start_point cannot be resolved to a commit, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*CreateBranchResponse, error)
+	// DeleteBranch deletes the given branch. The default branch of the
+	// repository (what HEAD points to) cannot be deleted, attempting to do so
+	// returns a FailedPrecondition error.
+	//
+	// If the branch does not exist, an error with a RevisionNotFoundPayload is
+	// returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*DeleteBranchResponse, error)
+	// CreateTag creates a new tag pointing at the commit target resolves to.
+	// If message is empty and sign is false, a lightweight tag is created,
+	// otherwise an annotated tag. Signed tags use the signing key configured for
+	// git on gitserver.
+	//
+	// If the tag already exists, a FailedPrecondition error with a
+	// RefUpdateConflictPayload is returned.
+	//
+	// If target cannot be resolved to a commit, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error)
+	// SymbolicRef returns the name of the ref the symbolic ref ref_name points
+	// to, without resolving it to a commit. The target ref doesn't have to exist,
+	// for example HEAD of an empty repository points to an unborn branch.
+	//
+	// If ref_name doesn't exist or isn't a symbolic ref, an empty target is
+	// returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	SymbolicRef(ctx context.Context, in *SymbolicRefRequest, opts ...grpc.CallOption) (*SymbolicRefResponse, error)
+	// RefExists checks whether the fully qualified ref ref_name exists. A
+	// symbolic ref only exists if the ref it points to exists.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	RefExists(ctx context.Context, in *RefExistsRequest, opts ...grpc.CallOption) (*RefExistsResponse, error)
+	// ListBranches returns all branches of the repository, in the same order as
+	// ListRefs, together with the commit each of them points at and the number
+	// of commits it is behind and ahead of a base revision, the default branch
+	// unless base is set.
+	//
+	// If the repository has sub-repo permissions enabled, the commits of
+	// branches the actor can't see are left out. The branches are still listed.
+	//
+	// If base doesn't exist, no behind/ahead counts are returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	ListBranches(ctx context.Context, in *ListBranchesRequest, opts ...grpc.CallOption) (GitserverService_ListBranchesClient, error)
+	// ListTags returns the tags of the repository, newest first, or by
+	// descending version if sort_by_version is set. The target commit of
+	// annotated tags is the commit they point at, not the tag object.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (GitserverService_ListTagsClient, error)
+	// CloneProgress streams the clone progress of a repository. An update is sent
+	// right away and then whenever the progress changes, until the clone ended.
+	// The last update has clone_in_progress unset.
+	//
+	// If the repository isn't being cloned, only one update is sent.
+	CloneProgress(ctx context.Context, in *CloneProgressRequest, opts ...grpc.CallOption) (GitserverService_CloneProgressClient, error)
+	// OptimizeRepo runs the requested maintenance tasks on the repository right
+	// away, instead of waiting for the janitor, and returns once all of them
+	// finished. The tasks run in the order of the request fields.
+	//
+	// If a maintenance task is already running on the repository, an Aborted
+	// error is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	OptimizeRepo(ctx context.Context, in *OptimizeRepoRequest, opts ...grpc.CallOption) (*OptimizeRepoResponse, error)
+	// CommitGraphStatus returns whether the repository has a commit-graph and
+	// which optional data it stores. Use OptimizeRepo to write the
+	// commit-graph.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CommitGraphStatus(ctx context.Context, in *CommitGraphStatusRequest, opts ...grpc.CallOption) (*CommitGraphStatusResponse, error)
+	// CloneState returns whether the repository is a shallow or partial clone,
+	// ie. whether some of its history or objects are missing.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CloneState(ctx context.Context, in *CloneStateRequest, opts ...grpc.CallOption) (*CloneStateResponse, error)
+	// ListRepos lists the repositories cloned on this gitserver, with their
+	// size on disk and the time they were last fetched. Unlike the other RPCs,
+	// it is about the gitserver instance it is sent to, not about a repo, so it
+	// must not be routed by repo name.
+	//
+	// Computing the sizes walks all repository directories, so this can take a
+	// while on large instances. Repos are streamed as they are visited.
+	ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (GitserverService_ListReposClient, error)
+	// ReadBlob streams the content of the blob with the given object ID.
+	//
+	// There is no path to check subrepo permissions against, so if they are
+	// enabled for the repo, only internal actors may read blobs and an
+	// Unimplemented error is returned for everyone else.
+	//
+	// If no blob with the given ID exists, a NotFound error with a
+	// RevisionNotFoundPayload in the details is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	ReadBlob(ctx context.Context, in *ReadBlobRequest, opts ...grpc.CallOption) (GitserverService_ReadBlobClient, error)
+	// GetTree returns the entries of one level of a tree, as stored in the tree
+	// object. Unlike listing a directory, the entries carry their git file mode
+	// and object ID.
+	//
+	// If subrepo permissions are enabled for the repo, entries the actor can't
+	// read are left out.
+	//
+	// If the given treeish does not exist, an error with a
+	// RevisionNotFoundPayload is returned. If the path does not exist or is not
+	// a directory, an error with a FileNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	GetTree(ctx context.Context, in *GetTreeRequest, opts ...grpc.CallOption) (GitserverService_GetTreeClient, error)
+	// SparseManifest returns the files needed to materialize a sparse checkout
+	// of the given paths at a commit: every blob at or below one of the paths,
+	// with its full path, git file mode, object ID and size. Together with the
+	// blob contents, this is enough to lay out a working copy without fetching
+	// an archive of the whole tree. Submodules are left out.
+	//
+	// If subrepo permissions are enabled for the repo, files the actor can't
+	// read are left out.
+	//
+	// If the given commit does not exist, an error with a
+	// RevisionNotFoundPayload is returned. Paths that don't exist in the commit
+	// don't match any files.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	SparseManifest(ctx context.Context, in *SparseManifestRequest, opts ...grpc.CallOption) (GitserverService_SparseManifestClient, error)
+	// LastCommitsForTree returns the entries of one level of a tree, like
+	// GetTree, together with the last commit that changed each of them, as
+	// shown by the tree views of code hosts. The commits are found in a single
+	// walk of the history of the directory, which uses the changed-path Bloom
+	// filters of the commit graph if they were written.
+	//
+	// Entries whose last commit lies beyond the history of a shallow clone have
+	// no commit.
+	//
+	// If subrepo permissions are enabled for the repo, entries the actor can't
+	// read are left out.
+	//
+	// If the given commit does not exist, an error with a
+	// RevisionNotFoundPayload is returned. If the path does not exist or is not
+	// a directory, an error with a FileNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	LastCommitsForTree(ctx context.Context, in *LastCommitsForTreeRequest, opts ...grpc.CallOption) (GitserverService_LastCommitsForTreeClient, error)
+	// CommitActivity returns the number of commits in the history of a revision
+	// per day or week, computed from their committer dates in UTC. It is meant
+	// for activity sparklines, which only need the counts and not the commits.
+	// Merge commits are not counted, like in ContributorCounts.
+	//
+	// If subrepo permissions are enabled for the repo, an error with code
+	// FailedPrecondition is returned, since the counts would include commits
+	// the actor can't see.
+	//
+	// If the given revision does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CommitActivity(ctx context.Context, in *CommitActivityRequest, opts ...grpc.CallOption) (*CommitActivityResponse, error)
+	// GetTag returns the tag with the given name. For annotated tags, this
+	// includes the tagger, message and signature of the tag object, which
+	// ListRefs doesn't return.
+	//
+	// If the tag does not exist, an error with a RevisionNotFoundPayload is
+	// returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error)
+	// ListRemoteRefs lists the refs of the upstream of the repository, as
+	// reported by git ls-remote for the remote URL gitserver clones from. Unlike
+	// ListRefs, it includes refs that haven't been fetched yet, and the repo
+	// doesn't need to be cloned. The target commit of annotated tags is the
+	// commit they point at, if the remote advertises it.
+	//
+	// Only repos that are synced with git have a remote that can be listed. For
+	// all others, an Unimplemented error is returned.
+	//
+	// If the remote can't be reached, an Unavailable error is returned.
+	ListRemoteRefs(ctx context.Context, in *ListRemoteRefsRequest, opts ...grpc.CallOption) (GitserverService_ListRemoteRefsClient, error)
+	// FetchCommitFromRepo makes a commit of the repo source_repo_name available
+	// in the repo repo_name, so that the commit can be compared with the
+	// commits of repo_name, for example to compute merge bases and diffs
+	// between the repos of a fork network. The commit and the history that
+	// repo_name is missing are fetched from source_repo_name, from disk if both
+	// repos are on the same gitserver, and from the gitserver that hosts
+	// source_repo_name otherwise. If repo_name already has the commit, nothing
+	// is fetched.
+	//
+	// No ref is created for the commit, so its objects are eventually garbage
+	// collected if they aren't referenced otherwise.
+	//
+	// If sub-repo permissions are enabled for source_repo_name, only internal
+	// actors may fetch from it, and an Unimplemented error is returned for
+	// everyone else.
+	//
+	// If the commit does not exist in source_repo_name, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If one of the given repos is not cloned, it will be enqueued for cloning
+	// and a NotFound error will be returned, with a RepoNotFoundPayload in the
+	// details.
+	FetchCommitFromRepo(ctx context.Context, in *FetchCommitFromRepoRequest, opts ...grpc.CallOption) (*FetchCommitFromRepoResponse, error)
+	// CreateBundle streams a bundle of the given refs, as produced by
+	// git bundle create. The bundle can be cloned and fetched from with plain
+	// git, for example to move code into an air-gapped environment.
+	//
+	// If subrepo permissions are enabled for the repo, no bundle will be
+	// created for non-internal actors and an unimplemented error will be
+	// returned, as the bundle contains the contents of all files.
+	//
+	// If one of the given refs does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (GitserverService_CreateBundleClient, error)
+	// ApplyBundle imports a bundle, as produced by git bundle create, into the
+	// repo. This allows to update repos of air-gapped instances, where
+	// gitserver cannot reach the code host. The first message must contain the
+	// metadata, all following messages contain chunks of the bundle.
+	//
+	// Only the branches and tags of the bundle are imported. Branches are only
+	// fast-forwarded and existing tags are never moved. If a ref of the bundle
+	// would violate this, or if a ref was updated concurrently, no ref is
+	// updated and a FailedPrecondition error with a RefUpdateConflictPayload is
+	// returned.
+	//
+	// If the bundle is malformed or the repo lacks the commits the bundle was
+	// created against, a FailedPrecondition error is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	ApplyBundle(ctx context.Context, opts ...grpc.CallOption) (GitserverService_ApplyBundleClient, error)
+	// FetchRefspec fetches the refs matching the given refspec from the upstream
+	// of the repo, like pull request refs. This allows to fetch refs on demand
+	// that are not part of the regular fetches of the repo.
+	//
+	// The refspec must be valid according to gitdomain.ValidateFetchRefspec,
+	// otherwise an InvalidArgument error is returned. If the source of the
+	// refspec is not a pattern and doesn't exist in the upstream, an error with
+	// a RevisionNotFoundPayload is returned.
+	//
+	// If the repo isn't synced with git, like Perforce depots, an Unimplemented
+	// error is returned. If the code host cannot be reached, an Unavailable
+	// error is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	FetchRefspec(ctx context.Context, in *FetchRefspecRequest, opts ...grpc.CallOption) (*FetchRefspecResponse, error)
+	// ExecInWorktree checks out a commit into a temporary worktree and runs a
+	// git command in it, like git apply --check to verify that a patch applies.
+	// This allows to run cheap validations without downloading an archive of
+	// the repo. The worktree is removed after the command finished, so the
+	// command may modify it, but changes never reach the repo.
+	//
+	// Only a small set of commands and flags is allowed, which can't access
+	// files outside of the worktree or talk to other hosts. For other commands,
+	// an InvalidArgument error is returned.
+	//
+	// The exit status and output of the command are returned, a command that
+	// fails is not an error. If the command runs longer than a minute, a
+	// DeadlineExceeded error is returned.
+	//
+	// If the given commit does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	ExecInWorktree(ctx context.Context, in *ExecInWorktreeRequest, opts ...grpc.CallOption) (*ExecInWorktreeResponse, error)
+	// RebasePreview replays the commits in base_commit_sha..head_commit_sha
+	// onto onto_commit_sha in a temporary worktree, like
+	// git rebase --onto <onto> <base> <head> would, and reports whether they
+	// apply cleanly and what the IDs of the resulting commits are. Nothing is
+	// written to the repo.
+	//
+	// Merge commits are skipped and commits that end up without changes are
+	// dropped, like git rebase does. The replayed commits keep the author and
+	// committer of the original commits.
+	//
+	// If any of the given commits does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	RebasePreview(ctx context.Context, in *RebasePreviewRequest, opts ...grpc.CallOption) (*RebasePreviewResponse, error)
+	// RevertCommit creates a commit that reverts the changes of a commit, like
+	// git revert does, on top of another commit. The new commit is created in a
+	// temporary worktree and stored under the ref
+	// refs/sourcegraph/tmp/<commit_sha> of the new commit, which callers should
+	// delete with DeleteRef once they no longer need it.
+	//
+	// If the changes can't be reverted without conflicts, a FailedPrecondition
+	// error with a MergeConflictPayload is returned. If reverting results in no
+	// changes, a FailedPrecondition error is returned.
+	//
+	// If the given commit or onto does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	RevertCommit(ctx context.Context, in *RevertCommitRequest, opts ...grpc.CallOption) (*RevertCommitResponse, error)
+	// CherryPick creates a commit that applies the changes of a commit on top
+	// of another commit, like git cherry-pick does. The new commit keeps the
+	// author of the original commit. It is created in a temporary worktree and
+	// stored under the ref refs/sourcegraph/tmp/<commit_sha> of the new commit,
+	// which callers should delete with DeleteRef once they no longer need it.
+	//
+	// If the changes can't be applied without conflicts, a FailedPrecondition
+	// error with a MergeConflictPayload is returned. If the changes are already
+	// contained in onto, a FailedPrecondition error is returned.
+	//
+	// If the given commit or onto does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CherryPick(ctx context.Context, in *CherryPickRequest, opts ...grpc.CallOption) (*CherryPickResponse, error)
+	// SquashCommits creates a single commit on top of base_commit_sha that
+	// contains the changes of the commits in base_commit_sha..head_commit_sha,
+	// for example to export a branch to a code host that requires single-commit
+	// pull requests. The commit is stored under the ref
+	// refs/sourcegraph/tmp/<commit_sha> of the new commit, which callers should
+	// delete with DeleteRef once they no longer need it.
+	//
+	// If the commits don't change anything relative to base_commit_sha, a
+	// FailedPrecondition error is returned.
+	//
+	// If the given base or head commit does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	SquashCommits(ctx context.Context, in *SquashCommitsRequest, opts ...grpc.CallOption) (*SquashCommitsResponse, error)
 }
 
 type gitserverServiceClient struct {
@@ -226,6 +757,15 @@ func (c *gitserverServiceClient) DiskInfo(ctx context.Context, in *DiskInfoReque
 	return out, nil
 }
 
+func (c *gitserverServiceClient) Capabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*CapabilitiesResponse, error) {
+	out := new(CapabilitiesResponse)
+	err := c.cc.Invoke(ctx, GitserverService_Capabilities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gitserverServiceClient) Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (GitserverService_ExecClient, error) {
 	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[1], GitserverService_Exec_FullMethodName, opts...)
 	if err != nil {
@@ -489,6 +1029,15 @@ func (x *gitserverServiceBlameClient) Recv() (*BlameResponse, error) {
 	return m, nil
 }
 
+func (c *gitserverServiceClient) BlameSummary(ctx context.Context, in *BlameSummaryRequest, opts ...grpc.CallOption) (*BlameSummaryResponse, error) {
+	out := new(BlameSummaryResponse)
+	err := c.cc.Invoke(ctx, GitserverService_BlameSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gitserverServiceClient) DefaultBranch(ctx context.Context, in *DefaultBranchRequest, opts ...grpc.CallOption) (*DefaultBranchResponse, error) {
 	out := new(DefaultBranchResponse)
 	err := c.cc.Invoke(ctx, GitserverService_DefaultBranch_FullMethodName, in, out, opts...)
@@ -580,67 +1129,780 @@ func (x *gitserverServiceListRefsClient) Recv() (*ListRefsResponse, error) {
 	return m, nil
 }
 
-func (c *gitserverServiceClient) RevAtTime(ctx context.Context, in *RevAtTimeRequest, opts ...grpc.CallOption) (*RevAtTimeResponse, error) {
-	out := new(RevAtTimeResponse)
-	err := c.cc.Invoke(ctx, GitserverService_RevAtTime_FullMethodName, in, out, opts...)
+func (c *gitserverServiceClient) RefsChangedSince(ctx context.Context, in *RefsChangedSinceRequest, opts ...grpc.CallOption) (GitserverService_RefsChangedSinceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[7], GitserverService_RefsChangedSince_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &gitserverServiceRefsChangedSinceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// GitserverServiceServer is the server API for GitserverService service.
-// All implementations must embed UnimplementedGitserverServiceServer
-// for forward compatibility
-type GitserverServiceServer interface {
-	CreateCommitFromPatchBinary(GitserverService_CreateCommitFromPatchBinaryServer) error
-	DiskInfo(context.Context, *DiskInfoRequest) (*DiskInfoResponse, error)
-	Exec(*ExecRequest, GitserverService_ExecServer) error
-	GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
-	IsRepoCloneable(context.Context, *IsRepoCloneableRequest) (*IsRepoCloneableResponse, error)
-	ListGitolite(context.Context, *ListGitoliteRequest) (*ListGitoliteResponse, error)
-	Search(*SearchRequest, GitserverService_SearchServer) error
-	// Archive creates an archive for the given treeish in the given format.
-	// If paths are specified, only those paths are included in the archive.
-	//
-	// If subrepo permissions are enabled for the repo, no archive will be created
-	// for non-internal actors and an unimplemented error will be returned. We can
-	// currently not filter parts of the archive, so this would be considered
-	// leaking information.
-	//
-	// If the given treeish does not exist, an error with a
-	// RevisionNotFoundPayload is returned.
-	//
-	// If the given repo is not cloned, it will be enqueued for cloning and a
-	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
-	Archive(*ArchiveRequest, GitserverService_ArchiveServer) error
-	RepoCloneProgress(context.Context, *RepoCloneProgressRequest) (*RepoCloneProgressResponse, error)
-	RepoDelete(context.Context, *RepoDeleteRequest) (*RepoDeleteResponse, error)
-	RepoUpdate(context.Context, *RepoUpdateRequest) (*RepoUpdateResponse, error)
-	IsPerforcePathCloneable(context.Context, *IsPerforcePathCloneableRequest) (*IsPerforcePathCloneableResponse, error)
-	CheckPerforceCredentials(context.Context, *CheckPerforceCredentialsRequest) (*CheckPerforceCredentialsResponse, error)
-	PerforceUsers(context.Context, *PerforceUsersRequest) (*PerforceUsersResponse, error)
-	PerforceProtectsForUser(context.Context, *PerforceProtectsForUserRequest) (*PerforceProtectsForUserResponse, error)
-	PerforceProtectsForDepot(context.Context, *PerforceProtectsForDepotRequest) (*PerforceProtectsForDepotResponse, error)
-	PerforceGroupMembers(context.Context, *PerforceGroupMembersRequest) (*PerforceGroupMembersResponse, error)
-	IsPerforceSuperUser(context.Context, *IsPerforceSuperUserRequest) (*IsPerforceSuperUserResponse, error)
-	PerforceGetChangelist(context.Context, *PerforceGetChangelistRequest) (*PerforceGetChangelistResponse, error)
-	// MergeBase returns the merge base commit sha for the specified revspecs.
-	// If no common merge base exists, an empty string is returned.
-	//
-	// If the given repo is not cloned, it will be enqueued for cloning and a
-	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
-	MergeBase(context.Context, *MergeBaseRequest) (*MergeBaseResponse, error)
-	// Blame runs a blame operation on the specified file. It returns a stream of
-	// hunks as they are found. The --incremental flag is used on the git CLI
-	// level to achieve this behavior. The endpoint will verify that the user is
-	// allowed to blame the given file if subrepo permissions are enabled for the
-	// repo. If access is denied, an error with a UnauthorizedPayload in the
-	// details is returned.
-	//
-	// If the given repo is not cloned, it will be enqueued for cloning and a
-	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
-	Blame(*BlameRequest, GitserverService_BlameServer) error
+type GitserverService_RefsChangedSinceClient interface {
+	Recv() (*RefsChangedSinceResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceRefsChangedSinceClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceRefsChangedSinceClient) Recv() (*RefsChangedSinceResponse, error) {
+	m := new(RefsChangedSinceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) WatchRefs(ctx context.Context, in *WatchRefsRequest, opts ...grpc.CallOption) (GitserverService_WatchRefsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[8], GitserverService_WatchRefs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceWatchRefsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_WatchRefsClient interface {
+	Recv() (*WatchRefsResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceWatchRefsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceWatchRefsClient) Recv() (*WatchRefsResponse, error) {
+	m := new(WatchRefsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) WatchDefaultBranchCommits(ctx context.Context, in *WatchDefaultBranchCommitsRequest, opts ...grpc.CallOption) (GitserverService_WatchDefaultBranchCommitsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[9], GitserverService_WatchDefaultBranchCommits_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceWatchDefaultBranchCommitsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_WatchDefaultBranchCommitsClient interface {
+	Recv() (*WatchDefaultBranchCommitsResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceWatchDefaultBranchCommitsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceWatchDefaultBranchCommitsClient) Recv() (*WatchDefaultBranchCommitsResponse, error) {
+	m := new(WatchDefaultBranchCommitsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) RevAtTime(ctx context.Context, in *RevAtTimeRequest, opts ...grpc.CallOption) (*RevAtTimeResponse, error) {
+	out := new(RevAtTimeResponse)
+	err := c.cc.Invoke(ctx, GitserverService_RevAtTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) FormatPatch(ctx context.Context, in *FormatPatchRequest, opts ...grpc.CallOption) (GitserverService_FormatPatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[10], GitserverService_FormatPatch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceFormatPatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_FormatPatchClient interface {
+	Recv() (*FormatPatchResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceFormatPatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceFormatPatchClient) Recv() (*FormatPatchResponse, error) {
+	m := new(FormatPatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) CommitGraph(ctx context.Context, in *CommitGraphRequest, opts ...grpc.CallOption) (GitserverService_CommitGraphClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[11], GitserverService_CommitGraph_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceCommitGraphClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_CommitGraphClient interface {
+	Recv() (*CommitGraphResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceCommitGraphClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceCommitGraphClient) Recv() (*CommitGraphResponse, error) {
+	m := new(CommitGraphResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) Cherry(ctx context.Context, in *CherryRequest, opts ...grpc.CallOption) (*CherryResponse, error) {
+	out := new(CherryResponse)
+	err := c.cc.Invoke(ctx, GitserverService_Cherry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) RangeDiff(ctx context.Context, in *RangeDiffRequest, opts ...grpc.CallOption) (*RangeDiffResponse, error) {
+	out := new(RangeDiffResponse)
+	err := c.cc.Invoke(ctx, GitserverService_RangeDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) UpdateRef(ctx context.Context, in *UpdateRefRequest, opts ...grpc.CallOption) (*UpdateRefResponse, error) {
+	out := new(UpdateRefResponse)
+	err := c.cc.Invoke(ctx, GitserverService_UpdateRef_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*CreateBranchResponse, error) {
+	out := new(CreateBranchResponse)
+	err := c.cc.Invoke(ctx, GitserverService_CreateBranch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*DeleteBranchResponse, error) {
+	out := new(DeleteBranchResponse)
+	err := c.cc.Invoke(ctx, GitserverService_DeleteBranch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error) {
+	out := new(CreateTagResponse)
+	err := c.cc.Invoke(ctx, GitserverService_CreateTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) SymbolicRef(ctx context.Context, in *SymbolicRefRequest, opts ...grpc.CallOption) (*SymbolicRefResponse, error) {
+	out := new(SymbolicRefResponse)
+	err := c.cc.Invoke(ctx, GitserverService_SymbolicRef_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) RefExists(ctx context.Context, in *RefExistsRequest, opts ...grpc.CallOption) (*RefExistsResponse, error) {
+	out := new(RefExistsResponse)
+	err := c.cc.Invoke(ctx, GitserverService_RefExists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) ListBranches(ctx context.Context, in *ListBranchesRequest, opts ...grpc.CallOption) (GitserverService_ListBranchesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[12], GitserverService_ListBranches_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceListBranchesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_ListBranchesClient interface {
+	Recv() (*ListBranchesResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceListBranchesClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceListBranchesClient) Recv() (*ListBranchesResponse, error) {
+	m := new(ListBranchesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (GitserverService_ListTagsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[13], GitserverService_ListTags_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceListTagsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_ListTagsClient interface {
+	Recv() (*ListTagsResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceListTagsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceListTagsClient) Recv() (*ListTagsResponse, error) {
+	m := new(ListTagsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) CloneProgress(ctx context.Context, in *CloneProgressRequest, opts ...grpc.CallOption) (GitserverService_CloneProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[14], GitserverService_CloneProgress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceCloneProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_CloneProgressClient interface {
+	Recv() (*CloneProgressResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceCloneProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceCloneProgressClient) Recv() (*CloneProgressResponse, error) {
+	m := new(CloneProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) OptimizeRepo(ctx context.Context, in *OptimizeRepoRequest, opts ...grpc.CallOption) (*OptimizeRepoResponse, error) {
+	out := new(OptimizeRepoResponse)
+	err := c.cc.Invoke(ctx, GitserverService_OptimizeRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) CommitGraphStatus(ctx context.Context, in *CommitGraphStatusRequest, opts ...grpc.CallOption) (*CommitGraphStatusResponse, error) {
+	out := new(CommitGraphStatusResponse)
+	err := c.cc.Invoke(ctx, GitserverService_CommitGraphStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) CloneState(ctx context.Context, in *CloneStateRequest, opts ...grpc.CallOption) (*CloneStateResponse, error) {
+	out := new(CloneStateResponse)
+	err := c.cc.Invoke(ctx, GitserverService_CloneState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (GitserverService_ListReposClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[15], GitserverService_ListRepos_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceListReposClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_ListReposClient interface {
+	Recv() (*ListReposResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceListReposClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceListReposClient) Recv() (*ListReposResponse, error) {
+	m := new(ListReposResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) ReadBlob(ctx context.Context, in *ReadBlobRequest, opts ...grpc.CallOption) (GitserverService_ReadBlobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[16], GitserverService_ReadBlob_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceReadBlobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_ReadBlobClient interface {
+	Recv() (*ReadBlobResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceReadBlobClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceReadBlobClient) Recv() (*ReadBlobResponse, error) {
+	m := new(ReadBlobResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) GetTree(ctx context.Context, in *GetTreeRequest, opts ...grpc.CallOption) (GitserverService_GetTreeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[17], GitserverService_GetTree_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceGetTreeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_GetTreeClient interface {
+	Recv() (*GetTreeResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceGetTreeClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceGetTreeClient) Recv() (*GetTreeResponse, error) {
+	m := new(GetTreeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) SparseManifest(ctx context.Context, in *SparseManifestRequest, opts ...grpc.CallOption) (GitserverService_SparseManifestClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[18], GitserverService_SparseManifest_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceSparseManifestClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_SparseManifestClient interface {
+	Recv() (*SparseManifestResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceSparseManifestClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceSparseManifestClient) Recv() (*SparseManifestResponse, error) {
+	m := new(SparseManifestResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) LastCommitsForTree(ctx context.Context, in *LastCommitsForTreeRequest, opts ...grpc.CallOption) (GitserverService_LastCommitsForTreeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[19], GitserverService_LastCommitsForTree_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceLastCommitsForTreeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_LastCommitsForTreeClient interface {
+	Recv() (*LastCommitsForTreeResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceLastCommitsForTreeClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceLastCommitsForTreeClient) Recv() (*LastCommitsForTreeResponse, error) {
+	m := new(LastCommitsForTreeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) CommitActivity(ctx context.Context, in *CommitActivityRequest, opts ...grpc.CallOption) (*CommitActivityResponse, error) {
+	out := new(CommitActivityResponse)
+	err := c.cc.Invoke(ctx, GitserverService_CommitActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error) {
+	out := new(GetTagResponse)
+	err := c.cc.Invoke(ctx, GitserverService_GetTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) ListRemoteRefs(ctx context.Context, in *ListRemoteRefsRequest, opts ...grpc.CallOption) (GitserverService_ListRemoteRefsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[20], GitserverService_ListRemoteRefs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceListRemoteRefsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_ListRemoteRefsClient interface {
+	Recv() (*ListRemoteRefsResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceListRemoteRefsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceListRemoteRefsClient) Recv() (*ListRemoteRefsResponse, error) {
+	m := new(ListRemoteRefsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) FetchCommitFromRepo(ctx context.Context, in *FetchCommitFromRepoRequest, opts ...grpc.CallOption) (*FetchCommitFromRepoResponse, error) {
+	out := new(FetchCommitFromRepoResponse)
+	err := c.cc.Invoke(ctx, GitserverService_FetchCommitFromRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (GitserverService_CreateBundleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[21], GitserverService_CreateBundle_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceCreateBundleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_CreateBundleClient interface {
+	Recv() (*CreateBundleResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceCreateBundleClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceCreateBundleClient) Recv() (*CreateBundleResponse, error) {
+	m := new(CreateBundleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) ApplyBundle(ctx context.Context, opts ...grpc.CallOption) (GitserverService_ApplyBundleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[22], GitserverService_ApplyBundle_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceApplyBundleClient{stream}
+	return x, nil
+}
+
+type GitserverService_ApplyBundleClient interface {
+	Send(*ApplyBundleRequest) error
+	CloseAndRecv() (*ApplyBundleResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceApplyBundleClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceApplyBundleClient) Send(m *ApplyBundleRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gitserverServiceApplyBundleClient) CloseAndRecv() (*ApplyBundleResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ApplyBundleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) FetchRefspec(ctx context.Context, in *FetchRefspecRequest, opts ...grpc.CallOption) (*FetchRefspecResponse, error) {
+	out := new(FetchRefspecResponse)
+	err := c.cc.Invoke(ctx, GitserverService_FetchRefspec_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) ExecInWorktree(ctx context.Context, in *ExecInWorktreeRequest, opts ...grpc.CallOption) (*ExecInWorktreeResponse, error) {
+	out := new(ExecInWorktreeResponse)
+	err := c.cc.Invoke(ctx, GitserverService_ExecInWorktree_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) RebasePreview(ctx context.Context, in *RebasePreviewRequest, opts ...grpc.CallOption) (*RebasePreviewResponse, error) {
+	out := new(RebasePreviewResponse)
+	err := c.cc.Invoke(ctx, GitserverService_RebasePreview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) RevertCommit(ctx context.Context, in *RevertCommitRequest, opts ...grpc.CallOption) (*RevertCommitResponse, error) {
+	out := new(RevertCommitResponse)
+	err := c.cc.Invoke(ctx, GitserverService_RevertCommit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) CherryPick(ctx context.Context, in *CherryPickRequest, opts ...grpc.CallOption) (*CherryPickResponse, error) {
+	out := new(CherryPickResponse)
+	err := c.cc.Invoke(ctx, GitserverService_CherryPick_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) SquashCommits(ctx context.Context, in *SquashCommitsRequest, opts ...grpc.CallOption) (*SquashCommitsResponse, error) {
+	out := new(SquashCommitsResponse)
+	err := c.cc.Invoke(ctx, GitserverService_SquashCommits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GitserverServiceServer is the server API for GitserverService service.
+// All implementations must embed UnimplementedGitserverServiceServer
+// for forward compatibility
+type GitserverServiceServer interface {
+	CreateCommitFromPatchBinary(GitserverService_CreateCommitFromPatchBinaryServer) error
+	DiskInfo(context.Context, *DiskInfoRequest) (*DiskInfoResponse, error)
+	// Capabilities returns the optional protocol features this gitserver
+	// supports, so that clients can check before relying on them.
+	//
+	// Older gitservers return an Unimplemented error, which clients should
+	// treat as supporting none of the optional features.
+	Capabilities(context.Context, *CapabilitiesRequest) (*CapabilitiesResponse, error)
+	Exec(*ExecRequest, GitserverService_ExecServer) error
+	GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
+	IsRepoCloneable(context.Context, *IsRepoCloneableRequest) (*IsRepoCloneableResponse, error)
+	ListGitolite(context.Context, *ListGitoliteRequest) (*ListGitoliteResponse, error)
+	Search(*SearchRequest, GitserverService_SearchServer) error
+	// Archive creates an archive for the given treeish in the given format.
+	// If paths are specified, only those paths are included in the archive.
+	//
+	// If subrepo permissions are enabled for the repo, no archive will be created
+	// for non-internal actors and an unimplemented error will be returned. We can
+	// currently not filter parts of the archive, so this would be considered
+	// leaking information.
+	//
+	// If the given treeish does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	Archive(*ArchiveRequest, GitserverService_ArchiveServer) error
+	RepoCloneProgress(context.Context, *RepoCloneProgressRequest) (*RepoCloneProgressResponse, error)
+	RepoDelete(context.Context, *RepoDeleteRequest) (*RepoDeleteResponse, error)
+	RepoUpdate(context.Context, *RepoUpdateRequest) (*RepoUpdateResponse, error)
+	IsPerforcePathCloneable(context.Context, *IsPerforcePathCloneableRequest) (*IsPerforcePathCloneableResponse, error)
+	CheckPerforceCredentials(context.Context, *CheckPerforceCredentialsRequest) (*CheckPerforceCredentialsResponse, error)
+	PerforceUsers(context.Context, *PerforceUsersRequest) (*PerforceUsersResponse, error)
+	PerforceProtectsForUser(context.Context, *PerforceProtectsForUserRequest) (*PerforceProtectsForUserResponse, error)
+	PerforceProtectsForDepot(context.Context, *PerforceProtectsForDepotRequest) (*PerforceProtectsForDepotResponse, error)
+	PerforceGroupMembers(context.Context, *PerforceGroupMembersRequest) (*PerforceGroupMembersResponse, error)
+	IsPerforceSuperUser(context.Context, *IsPerforceSuperUserRequest) (*IsPerforceSuperUserResponse, error)
+	PerforceGetChangelist(context.Context, *PerforceGetChangelistRequest) (*PerforceGetChangelistResponse, error)
+	// MergeBase returns the merge base commit sha for the specified revspecs.
+	// If no common merge base exists, an empty string is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	MergeBase(context.Context, *MergeBaseRequest) (*MergeBaseResponse, error)
+	// Blame runs a blame operation on the specified file. It returns a stream of
+	// hunks as they are found. The --incremental flag is used on the git CLI
+	// level to achieve this behavior. The endpoint will verify that the user is
+	// allowed to blame the given file if subrepo permissions are enabled for the
+	// repo. If access is denied, an error with a UnauthorizedPayload in the
+	// details is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	Blame(*BlameRequest, GitserverService_BlameServer) error
+	// BlameSummary blames the given file, or all files below the given
+	// directory, and returns how many lines each author last touched and when.
+	// If subrepo permissions are enabled for the repo, files the user cannot
+	// read are left out of a directory summary. If access to a single file is
+	// denied, an error with a UnauthorizedPayload in the details is returned.
+	//
+	// If the path doesn't exist, an error with a FileNotFoundPayload is returned.
+	// If the commit doesn't exist, an error with a RevisionNotFoundPayload is
+	// returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	BlameSummary(context.Context, *BlameSummaryRequest) (*BlameSummaryResponse, error)
 	// DefaultBranch resolves HEAD to ref name and current commit SHA it points
 	// to. If HEAD points to an empty branch, it returns an error with a
 	// RevisionNotFoundPayload.
@@ -659,670 +1921,1995 @@ type GitserverServiceServer interface {
 	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
 	ReadFile(*ReadFileRequest, GitserverService_ReadFileServer) error
 	// GetCommit gets a commit from the repo ODB.
+	// If requested, the diff stat and patch of the commit are returned as well,
+	// so that callers can render a commit in a single round trip.
 	// The endpoint will verify that the user is allowed to view the given commit.
 	//
 	// If subrepo permissions are enabled for the repo. If access is denied, an
 	// error with a RevisionNotFoundPayload is returned, to not leak existence of
 	// the commit.
 	//
-	// If the commit is not found, an error with a RevisionNotFoundPayload is
-	// returned.
+	// If the commit is not found, an error with a RevisionNotFoundPayload is
+	// returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	GetCommit(context.Context, *GetCommitRequest) (*GetCommitResponse, error)
+	// ResolveRevision resolves a given revspec-ish to a commit SHA.
+	// If passed a commit sha, the endpoint will also verify that the commit
+	// exists.
+	//
+	// If the revision cannot be resolved an error with RevisionNotFoundPayload is
+	// returned.
+	//
+	// Under the hood, this endpoint currently uses git rev-parse to resolve the
+	// revspec, but we forbid certain revspecs (like HEAD) to avoid leaking
+	// existence files, and to avoid running very expensive rev-parse operations.
+	// Assume only the following are supported:
+	// - Symbolic refs
+	// - All refs under refs/, including tags
+	// - Commit hashes
+	// - Abbreviated commit hashes
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	ResolveRevision(context.Context, *ResolveRevisionRequest) (*ResolveRevisionResponse, error)
+	// ListRefs returns a list of all the refs known to the repository, this includes
+	// heads, tags, and other potential refs, but filters can be applied.
+	//
+	// The refs are ordered in the following order:
+	// HEAD first, if part of the result set.
+	// The rest will be ordered by creation date, in descending order, i.e., newest
+	// first.
+	// If two resources are created at the same timestamp, the records are ordered
+	// alphabetically.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a NotFound
+	// error will be returned, with a RepoNotFoundPayload in the details.
+	ListRefs(*ListRefsRequest, GitserverService_ListRefsServer) error
+	// RefsChangedSince returns the refs whose targets moved since the state
+	// identified by state_token, or since the given time, so that callers that
+	// poll a repo don't have to list all of its refs every time. Deleted refs
+	// are included with an empty target_commit.
+	//
+	// Ref moves are recorded when gitserver updates the repo, for example after
+	// a fetch. If the changes can't be computed, for example because the state
+	// is too old, all refs are returned and full is set.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a NotFound
+	// error will be returned, with a RepoNotFoundPayload in the details.
+	RefsChangedSince(*RefsChangedSinceRequest, GitserverService_RefsChangedSinceServer) error
+	// WatchRefs streams the refs of a repository that move, for example when
+	// gitserver fetches it, until the client cancels the call. Right away, an
+	// update with only the current state_token is sent, and then one whenever
+	// refs move. An update can span multiple messages, its last message has
+	// state_token set.
+	//
+	// The state tokens can be passed to RefsChangedSince to catch up on the
+	// changes missed after the stream ended.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a NotFound
+	// error will be returned, with a RepoNotFoundPayload in the details.
+	WatchRefs(*WatchRefsRequest, GitserverService_WatchRefsServer) error
+	// WatchDefaultBranchCommits streams the commits that land on the default
+	// branch of a repository, for example when gitserver fetches it, until the
+	// client cancels the call. Right away, an update with only the current
+	// head_commit_sha is sent, and then one whenever the default branch moves.
+	// An update can span multiple messages, its last message has
+	// head_commit_sha set.
+	//
+	// No update is sent while the default branch has no commits.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a NotFound
+	// error will be returned, with a RepoNotFoundPayload in the details.
+	WatchDefaultBranchCommits(*WatchDefaultBranchCommitsRequest, GitserverService_WatchDefaultBranchCommitsServer) error
+	// RevAtTime looks up the OID of the nearest ancestor of `spec` that has a
+	// commit time before the given time. To simplify the logic, it only follows
+	// the first parent of merge commits to linearize the commit history. The
+	// intent is to return the state of a branch at a given time.
+	//
+	// If the revision cannot be resolved an error with RevisionNotFoundPayload is
+	// returned.
+	//
+	// If the revision exists, but there is no commit in its ancestry before
+	// the requested time, an empty string is returned for the commit SHA.
+	RevAtTime(context.Context, *RevAtTimeRequest) (*RevAtTimeResponse, error)
+	// FormatPatch streams the commits reachable from head but not from base as a series of mbox-formatted patches, as produced by
+	// git format-patch --stdout.
+	//
+	// If subrepo permissions are enabled for the repo, no patches will be
+	// created for non-internal actors and an unimplemented error will be
+	// returned, as the patches could leak the contents of restricted files.
+	//
+	// If one of the given revspecs does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	FormatPatch(*FormatPatchRequest, GitserverService_FormatPatchServer) error
+	// CommitGraph streams the commits reachable from head but not from base,
+	// together with the SHAs of their parents, without any further commit
+	// metadata. Commits are returned in topological order, children before
+	// their parents. Parents of the oldest commits in the range can lie
+	// outside of it.
+	//
+	// If subrepo permissions are enabled for the repo, no graph will be
+	// returned for non-internal actors and an unimplemented error will be
+	// returned, as commits that only touch restricted files must not be
+	// revealed.
+	//
+	// If one of the given revspecs does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CommitGraph(*CommitGraphRequest, GitserverService_CommitGraphServer) error
+	// Cherry reports for every commit reachable from head but not from upstream
+	// whether an equivalent change is already present in upstream, as done by
+	// git cherry. Two commits are equivalent if their diffs have the same
+	// patch ID, so this detects commits that were cherry-picked or rebased onto
+	// upstream. Commits are returned oldest first.
+	//
+	// If subrepo permissions are enabled for the repo, no result will be
+	// returned for non-internal actors and an unimplemented error will be
+	// returned, as the result depends on the contents of restricted files.
+	//
+	// If one of the given revspecs does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	Cherry(context.Context, *CherryRequest) (*CherryResponse, error)
+	// RangeDiff compares two versions of a commit series, like a branch before
+	// and after a rebase, as done by git range-diff. The commits of the old
+	// series are old_base..old_head, the commits of the new series are
+	// new_base..new_head. Every commit is matched with its counterpart in the
+	// other series, if any.
+	//
+	// If subrepo permissions are enabled for the repo, no range diff will be
+	// created for non-internal actors and an unimplemented error will be
+	// returned, as the diffs could leak the contents of restricted files.
+	//
+	// If one of the given revspecs does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	RangeDiff(context.Context, *RangeDiffRequest) (*RangeDiffResponse, error)
+	// UpdateRef atomically updates ref_name to point at new_commit_sha, but only
+	// if it currently points at expected_old_commit_sha. If
+	// expected_old_commit_sha is empty, the ref must not exist yet, so the call
+	// creates it.
+	//
+	// If the ref does not have the expected value, a FailedPrecondition error
+	// with a RefUpdateConflictPayload is returned and the ref is left untouched.
+	//
+	// If new_commit_sha does not exist in the repository, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	UpdateRef(context.Context, *UpdateRefRequest) (*UpdateRefResponse, error)
+	// CreateBranch creates a new branch pointing at start_point. If start_point
+	// is empty, the branch is created at HEAD.
+	//
+	// If the branch already exists, a FailedPrecondition error with a
+	// RefUpdateConflictPayload is returned.
+	//
+	// If start_point cannot be resolved to a commit, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CreateBranch(context.Context, *CreateBranchRequest) (*CreateBranchResponse, error)
+	// DeleteBranch deletes the given branch. The default branch of the
+	// repository (what HEAD points to) cannot be deleted, attempting to do so
+	// returns a FailedPrecondition error.
+	//
+	// If the branch does not exist, an error with a RevisionNotFoundPayload is
+	// returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	DeleteBranch(context.Context, *DeleteBranchRequest) (*DeleteBranchResponse, error)
+	// CreateTag creates a new tag pointing at the commit target resolves to.
+	// If message is empty and sign is false, a lightweight tag is created,
+	// otherwise an annotated tag. Signed tags use the signing key configured for
+	// git on gitserver.
+	//
+	// If the tag already exists, a FailedPrecondition error with a
+	// RefUpdateConflictPayload is returned.
+	//
+	// If target cannot be resolved to a commit, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error)
+	// SymbolicRef returns the name of the ref the symbolic ref ref_name points
+	// to, without resolving it to a commit. The target ref doesn't have to exist,
+	// for example HEAD of an empty repository points to an unborn branch.
+	//
+	// If ref_name doesn't exist or isn't a symbolic ref, an empty target is
+	// returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	SymbolicRef(context.Context, *SymbolicRefRequest) (*SymbolicRefResponse, error)
+	// RefExists checks whether the fully qualified ref ref_name exists. A
+	// symbolic ref only exists if the ref it points to exists.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	RefExists(context.Context, *RefExistsRequest) (*RefExistsResponse, error)
+	// ListBranches returns all branches of the repository, in the same order as
+	// ListRefs, together with the commit each of them points at and the number
+	// of commits it is behind and ahead of a base revision, the default branch
+	// unless base is set.
+	//
+	// If the repository has sub-repo permissions enabled, the commits of
+	// branches the actor can't see are left out. The branches are still listed.
+	//
+	// If base doesn't exist, no behind/ahead counts are returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	ListBranches(*ListBranchesRequest, GitserverService_ListBranchesServer) error
+	// ListTags returns the tags of the repository, newest first, or by
+	// descending version if sort_by_version is set. The target commit of
+	// annotated tags is the commit they point at, not the tag object.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	ListTags(*ListTagsRequest, GitserverService_ListTagsServer) error
+	// CloneProgress streams the clone progress of a repository. An update is sent
+	// right away and then whenever the progress changes, until the clone ended.
+	// The last update has clone_in_progress unset.
+	//
+	// If the repository isn't being cloned, only one update is sent.
+	CloneProgress(*CloneProgressRequest, GitserverService_CloneProgressServer) error
+	// OptimizeRepo runs the requested maintenance tasks on the repository right
+	// away, instead of waiting for the janitor, and returns once all of them
+	// finished. The tasks run in the order of the request fields.
+	//
+	// If a maintenance task is already running on the repository, an Aborted
+	// error is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	OptimizeRepo(context.Context, *OptimizeRepoRequest) (*OptimizeRepoResponse, error)
+	// CommitGraphStatus returns whether the repository has a commit-graph and
+	// which optional data it stores. Use OptimizeRepo to write the
+	// commit-graph.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CommitGraphStatus(context.Context, *CommitGraphStatusRequest) (*CommitGraphStatusResponse, error)
+	// CloneState returns whether the repository is a shallow or partial clone,
+	// ie. whether some of its history or objects are missing.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CloneState(context.Context, *CloneStateRequest) (*CloneStateResponse, error)
+	// ListRepos lists the repositories cloned on this gitserver, with their
+	// size on disk and the time they were last fetched. Unlike the other RPCs,
+	// it is about the gitserver instance it is sent to, not about a repo, so it
+	// must not be routed by repo name.
+	//
+	// Computing the sizes walks all repository directories, so this can take a
+	// while on large instances. Repos are streamed as they are visited.
+	ListRepos(*ListReposRequest, GitserverService_ListReposServer) error
+	// ReadBlob streams the content of the blob with the given object ID.
+	//
+	// There is no path to check subrepo permissions against, so if they are
+	// enabled for the repo, only internal actors may read blobs and an
+	// Unimplemented error is returned for everyone else.
+	//
+	// If no blob with the given ID exists, a NotFound error with a
+	// RevisionNotFoundPayload in the details is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	ReadBlob(*ReadBlobRequest, GitserverService_ReadBlobServer) error
+	// GetTree returns the entries of one level of a tree, as stored in the tree
+	// object. Unlike listing a directory, the entries carry their git file mode
+	// and object ID.
+	//
+	// If subrepo permissions are enabled for the repo, entries the actor can't
+	// read are left out.
+	//
+	// If the given treeish does not exist, an error with a
+	// RevisionNotFoundPayload is returned. If the path does not exist or is not
+	// a directory, an error with a FileNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	GetTree(*GetTreeRequest, GitserverService_GetTreeServer) error
+	// SparseManifest returns the files needed to materialize a sparse checkout
+	// of the given paths at a commit: every blob at or below one of the paths,
+	// with its full path, git file mode, object ID and size. Together with the
+	// blob contents, this is enough to lay out a working copy without fetching
+	// an archive of the whole tree. Submodules are left out.
+	//
+	// If subrepo permissions are enabled for the repo, files the actor can't
+	// read are left out.
+	//
+	// If the given commit does not exist, an error with a
+	// RevisionNotFoundPayload is returned. Paths that don't exist in the commit
+	// don't match any files.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	SparseManifest(*SparseManifestRequest, GitserverService_SparseManifestServer) error
+	// LastCommitsForTree returns the entries of one level of a tree, like
+	// GetTree, together with the last commit that changed each of them, as
+	// shown by the tree views of code hosts. The commits are found in a single
+	// walk of the history of the directory, which uses the changed-path Bloom
+	// filters of the commit graph if they were written.
+	//
+	// Entries whose last commit lies beyond the history of a shallow clone have
+	// no commit.
+	//
+	// If subrepo permissions are enabled for the repo, entries the actor can't
+	// read are left out.
+	//
+	// If the given commit does not exist, an error with a
+	// RevisionNotFoundPayload is returned. If the path does not exist or is not
+	// a directory, an error with a FileNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	LastCommitsForTree(*LastCommitsForTreeRequest, GitserverService_LastCommitsForTreeServer) error
+	// CommitActivity returns the number of commits in the history of a revision
+	// per day or week, computed from their committer dates in UTC. It is meant
+	// for activity sparklines, which only need the counts and not the commits.
+	// Merge commits are not counted, like in ContributorCounts.
+	//
+	// If subrepo permissions are enabled for the repo, an error with code
+	// FailedPrecondition is returned, since the counts would include commits
+	// the actor can't see.
+	//
+	// If the given revision does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CommitActivity(context.Context, *CommitActivityRequest) (*CommitActivityResponse, error)
+	// GetTag returns the tag with the given name. For annotated tags, this
+	// includes the tagger, message and signature of the tag object, which
+	// ListRefs doesn't return.
+	//
+	// If the tag does not exist, an error with a RevisionNotFoundPayload is
+	// returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error)
+	// ListRemoteRefs lists the refs of the upstream of the repository, as
+	// reported by git ls-remote for the remote URL gitserver clones from. Unlike
+	// ListRefs, it includes refs that haven't been fetched yet, and the repo
+	// doesn't need to be cloned. The target commit of annotated tags is the
+	// commit they point at, if the remote advertises it.
+	//
+	// Only repos that are synced with git have a remote that can be listed. For
+	// all others, an Unimplemented error is returned.
+	//
+	// If the remote can't be reached, an Unavailable error is returned.
+	ListRemoteRefs(*ListRemoteRefsRequest, GitserverService_ListRemoteRefsServer) error
+	// FetchCommitFromRepo makes a commit of the repo source_repo_name available
+	// in the repo repo_name, so that the commit can be compared with the
+	// commits of repo_name, for example to compute merge bases and diffs
+	// between the repos of a fork network. The commit and the history that
+	// repo_name is missing are fetched from source_repo_name, from disk if both
+	// repos are on the same gitserver, and from the gitserver that hosts
+	// source_repo_name otherwise. If repo_name already has the commit, nothing
+	// is fetched.
+	//
+	// No ref is created for the commit, so its objects are eventually garbage
+	// collected if they aren't referenced otherwise.
+	//
+	// If sub-repo permissions are enabled for source_repo_name, only internal
+	// actors may fetch from it, and an Unimplemented error is returned for
+	// everyone else.
+	//
+	// If the commit does not exist in source_repo_name, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If one of the given repos is not cloned, it will be enqueued for cloning
+	// and a NotFound error will be returned, with a RepoNotFoundPayload in the
+	// details.
+	FetchCommitFromRepo(context.Context, *FetchCommitFromRepoRequest) (*FetchCommitFromRepoResponse, error)
+	// CreateBundle streams a bundle of the given refs, as produced by
+	// git bundle create. The bundle can be cloned and fetched from with plain
+	// git, for example to move code into an air-gapped environment.
+	//
+	// If subrepo permissions are enabled for the repo, no bundle will be
+	// created for non-internal actors and an unimplemented error will be
+	// returned, as the bundle contains the contents of all files.
+	//
+	// If one of the given refs does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	CreateBundle(*CreateBundleRequest, GitserverService_CreateBundleServer) error
+	// ApplyBundle imports a bundle, as produced by git bundle create, into the
+	// repo. This allows to update repos of air-gapped instances, where
+	// gitserver cannot reach the code host. The first message must contain the
+	// metadata, all following messages contain chunks of the bundle.
+	//
+	// Only the branches and tags of the bundle are imported. Branches are only
+	// fast-forwarded and existing tags are never moved. If a ref of the bundle
+	// would violate this, or if a ref was updated concurrently, no ref is
+	// updated and a FailedPrecondition error with a RefUpdateConflictPayload is
+	// returned.
+	//
+	// If the bundle is malformed or the repo lacks the commits the bundle was
+	// created against, a FailedPrecondition error is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	ApplyBundle(GitserverService_ApplyBundleServer) error
+	// FetchRefspec fetches the refs matching the given refspec from the upstream
+	// of the repo, like pull request refs. This allows to fetch refs on demand
+	// that are not part of the regular fetches of the repo.
+	//
+	// The refspec must be valid according to gitdomain.ValidateFetchRefspec,
+	// otherwise an InvalidArgument error is returned. If the source of the
+	// refspec is not a pattern and doesn't exist in the upstream, an error with
+	// a RevisionNotFoundPayload is returned.
+	//
+	// If the repo isn't synced with git, like Perforce depots, an Unimplemented
+	// error is returned. If the code host cannot be reached, an Unavailable
+	// error is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	FetchRefspec(context.Context, *FetchRefspecRequest) (*FetchRefspecResponse, error)
+	// ExecInWorktree checks out a commit into a temporary worktree and runs a
+	// git command in it, like git apply --check to verify that a patch applies.
+	// This allows to run cheap validations without downloading an archive of
+	// the repo. The worktree is removed after the command finished, so the
+	// command may modify it, but changes never reach the repo.
+	//
+	// Only a small set of commands and flags is allowed, which can't access
+	// files outside of the worktree or talk to other hosts. For other commands,
+	// an InvalidArgument error is returned.
+	//
+	// The exit status and output of the command are returned, a command that
+	// fails is not an error. If the command runs longer than a minute, a
+	// DeadlineExceeded error is returned.
+	//
+	// If the given commit does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	ExecInWorktree(context.Context, *ExecInWorktreeRequest) (*ExecInWorktreeResponse, error)
+	// RebasePreview replays the commits in base_commit_sha..head_commit_sha
+	// onto onto_commit_sha in a temporary worktree, like
+	// git rebase --onto <onto> <base> <head> would, and reports whether they
+	// apply cleanly and what the IDs of the resulting commits are. Nothing is
+	// written to the repo.
+	//
+	// Merge commits are skipped and commits that end up without changes are
+	// dropped, like git rebase does. The replayed commits keep the author and
+	// committer of the original commits.
+	//
+	// If any of the given commits does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
+	//
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	RebasePreview(context.Context, *RebasePreviewRequest) (*RebasePreviewResponse, error)
+	// RevertCommit creates a commit that reverts the changes of a commit, like
+	// git revert does, on top of another commit. The new commit is created in a
+	// temporary worktree and stored under the ref
+	// refs/sourcegraph/tmp/<commit_sha> of the new commit, which callers should
+	// delete with DeleteRef once they no longer need it.
+	//
+	// If the changes can't be reverted without conflicts, a FailedPrecondition
+	// error with a MergeConflictPayload is returned. If reverting results in no
+	// changes, a FailedPrecondition error is returned.
+	//
+	// If the given commit or onto does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
 	//
 	// If the given repo is not cloned, it will be enqueued for cloning and a
 	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
-	GetCommit(context.Context, *GetCommitRequest) (*GetCommitResponse, error)
-	// ResolveRevision resolves a given revspec-ish to a commit SHA.
-	// If passed a commit sha, the endpoint will also verify that the commit
-	// exists.
+	RevertCommit(context.Context, *RevertCommitRequest) (*RevertCommitResponse, error)
+	// CherryPick creates a commit that applies the changes of a commit on top
+	// of another commit, like git cherry-pick does. The new commit keeps the
+	// author of the original commit. It is created in a temporary worktree and
+	// stored under the ref refs/sourcegraph/tmp/<commit_sha> of the new commit,
+	// which callers should delete with DeleteRef once they no longer need it.
 	//
-	// If the revision cannot be resolved an error with RevisionNotFoundPayload is
-	// returned.
+	// If the changes can't be applied without conflicts, a FailedPrecondition
+	// error with a MergeConflictPayload is returned. If the changes are already
+	// contained in onto, a FailedPrecondition error is returned.
 	//
-	// Under the hood, this endpoint currently uses git rev-parse to resolve the
-	// revspec, but we forbid certain revspecs (like HEAD) to avoid leaking
-	// existence files, and to avoid running very expensive rev-parse operations.
-	// Assume only the following are supported:
-	// - Symbolic refs
-	// - All refs under refs/, including tags
-	// - Commit hashes
-	// - Abbreviated commit hashes
+	// If the given commit or onto does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
 	//
 	// If the given repo is not cloned, it will be enqueued for cloning and a
 	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
-	ResolveRevision(context.Context, *ResolveRevisionRequest) (*ResolveRevisionResponse, error)
-	// ListRefs returns a list of all the refs known to the repository, this includes
-	// heads, tags, and other potential refs, but filters can be applied.
-	//
-	// The refs are ordered in the following order:
-	// HEAD first, if part of the result set.
-	// The rest will be ordered by creation date, in descending order, i.e., newest
-	// first.
-	// If two resources are created at the same timestamp, the records are ordered
-	// alphabetically.
+	CherryPick(context.Context, *CherryPickRequest) (*CherryPickResponse, error)
+	// SquashCommits creates a single commit on top of base_commit_sha that
+	// contains the changes of the commits in base_commit_sha..head_commit_sha,
+	// for example to export a branch to a code host that requires single-commit
+	// pull requests. The commit is stored under the ref
+	// refs/sourcegraph/tmp/<commit_sha> of the new commit, which callers should
+	// delete with DeleteRef once they no longer need it.
 	//
-	// If the given repo is not cloned, it will be enqueued for cloning and a NotFound
-	// error will be returned, with a RepoNotFoundPayload in the details.
-	ListRefs(*ListRefsRequest, GitserverService_ListRefsServer) error
-	// RevAtTime looks up the OID of the nearest ancestor of `spec` that has a
-	// commit time before the given time. To simplify the logic, it only follows
-	// the first parent of merge commits to linearize the commit history. The
-	// intent is to return the state of a branch at a given time.
+	// If the commits don't change anything relative to base_commit_sha, a
+	// FailedPrecondition error is returned.
 	//
-	// If the revision cannot be resolved an error with RevisionNotFoundPayload is
-	// returned.
+	// If the given base or head commit does not exist, an error with a
+	// RevisionNotFoundPayload is returned.
 	//
-	// If the revision exists, but there is no commit in its ancestry before
-	// the requested time, an empty string is returned for the commit SHA.
-	RevAtTime(context.Context, *RevAtTimeRequest) (*RevAtTimeResponse, error)
+	// If the given repo is not cloned, it will be enqueued for cloning and a
+	// NotFound error will be returned, with a RepoNotFoundPayload in the details.
+	SquashCommits(context.Context, *SquashCommitsRequest) (*SquashCommitsResponse, error)
+	mustEmbedUnimplementedGitserverServiceServer()
+}
+
+// UnimplementedGitserverServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGitserverServiceServer struct {
+}
+
+func (UnimplementedGitserverServiceServer) CreateCommitFromPatchBinary(GitserverService_CreateCommitFromPatchBinaryServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateCommitFromPatchBinary not implemented")
+}
+func (UnimplementedGitserverServiceServer) DiskInfo(context.Context, *DiskInfoRequest) (*DiskInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskInfo not implemented")
+}
+func (UnimplementedGitserverServiceServer) Capabilities(context.Context, *CapabilitiesRequest) (*CapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Capabilities not implemented")
+}
+func (UnimplementedGitserverServiceServer) Exec(*ExecRequest, GitserverService_ExecServer) error {
+	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (UnimplementedGitserverServiceServer) GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObject not implemented")
+}
+func (UnimplementedGitserverServiceServer) IsRepoCloneable(context.Context, *IsRepoCloneableRequest) (*IsRepoCloneableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsRepoCloneable not implemented")
+}
+func (UnimplementedGitserverServiceServer) ListGitolite(context.Context, *ListGitoliteRequest) (*ListGitoliteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGitolite not implemented")
+}
+func (UnimplementedGitserverServiceServer) Search(*SearchRequest, GitserverService_SearchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedGitserverServiceServer) Archive(*ArchiveRequest, GitserverService_ArchiveServer) error {
+	return status.Errorf(codes.Unimplemented, "method Archive not implemented")
+}
+func (UnimplementedGitserverServiceServer) RepoCloneProgress(context.Context, *RepoCloneProgressRequest) (*RepoCloneProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepoCloneProgress not implemented")
+}
+func (UnimplementedGitserverServiceServer) RepoDelete(context.Context, *RepoDeleteRequest) (*RepoDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepoDelete not implemented")
+}
+func (UnimplementedGitserverServiceServer) RepoUpdate(context.Context, *RepoUpdateRequest) (*RepoUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepoUpdate not implemented")
+}
+func (UnimplementedGitserverServiceServer) IsPerforcePathCloneable(context.Context, *IsPerforcePathCloneableRequest) (*IsPerforcePathCloneableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsPerforcePathCloneable not implemented")
+}
+func (UnimplementedGitserverServiceServer) CheckPerforceCredentials(context.Context, *CheckPerforceCredentialsRequest) (*CheckPerforceCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPerforceCredentials not implemented")
+}
+func (UnimplementedGitserverServiceServer) PerforceUsers(context.Context, *PerforceUsersRequest) (*PerforceUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerforceUsers not implemented")
+}
+func (UnimplementedGitserverServiceServer) PerforceProtectsForUser(context.Context, *PerforceProtectsForUserRequest) (*PerforceProtectsForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerforceProtectsForUser not implemented")
+}
+func (UnimplementedGitserverServiceServer) PerforceProtectsForDepot(context.Context, *PerforceProtectsForDepotRequest) (*PerforceProtectsForDepotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerforceProtectsForDepot not implemented")
+}
+func (UnimplementedGitserverServiceServer) PerforceGroupMembers(context.Context, *PerforceGroupMembersRequest) (*PerforceGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerforceGroupMembers not implemented")
+}
+func (UnimplementedGitserverServiceServer) IsPerforceSuperUser(context.Context, *IsPerforceSuperUserRequest) (*IsPerforceSuperUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsPerforceSuperUser not implemented")
+}
+func (UnimplementedGitserverServiceServer) PerforceGetChangelist(context.Context, *PerforceGetChangelistRequest) (*PerforceGetChangelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerforceGetChangelist not implemented")
+}
+func (UnimplementedGitserverServiceServer) MergeBase(context.Context, *MergeBaseRequest) (*MergeBaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeBase not implemented")
+}
+func (UnimplementedGitserverServiceServer) Blame(*BlameRequest, GitserverService_BlameServer) error {
+	return status.Errorf(codes.Unimplemented, "method Blame not implemented")
+}
+func (UnimplementedGitserverServiceServer) BlameSummary(context.Context, *BlameSummaryRequest) (*BlameSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlameSummary not implemented")
+}
+func (UnimplementedGitserverServiceServer) DefaultBranch(context.Context, *DefaultBranchRequest) (*DefaultBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefaultBranch not implemented")
+}
+func (UnimplementedGitserverServiceServer) ReadFile(*ReadFileRequest, GitserverService_ReadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadFile not implemented")
+}
+func (UnimplementedGitserverServiceServer) GetCommit(context.Context, *GetCommitRequest) (*GetCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommit not implemented")
+}
+func (UnimplementedGitserverServiceServer) ResolveRevision(context.Context, *ResolveRevisionRequest) (*ResolveRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveRevision not implemented")
+}
+func (UnimplementedGitserverServiceServer) ListRefs(*ListRefsRequest, GitserverService_ListRefsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRefs not implemented")
+}
+func (UnimplementedGitserverServiceServer) RefsChangedSince(*RefsChangedSinceRequest, GitserverService_RefsChangedSinceServer) error {
+	return status.Errorf(codes.Unimplemented, "method RefsChangedSince not implemented")
+}
+func (UnimplementedGitserverServiceServer) WatchRefs(*WatchRefsRequest, GitserverService_WatchRefsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRefs not implemented")
+}
+func (UnimplementedGitserverServiceServer) WatchDefaultBranchCommits(*WatchDefaultBranchCommitsRequest, GitserverService_WatchDefaultBranchCommitsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDefaultBranchCommits not implemented")
+}
+func (UnimplementedGitserverServiceServer) RevAtTime(context.Context, *RevAtTimeRequest) (*RevAtTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevAtTime not implemented")
+}
+func (UnimplementedGitserverServiceServer) FormatPatch(*FormatPatchRequest, GitserverService_FormatPatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method FormatPatch not implemented")
+}
+func (UnimplementedGitserverServiceServer) CommitGraph(*CommitGraphRequest, GitserverService_CommitGraphServer) error {
+	return status.Errorf(codes.Unimplemented, "method CommitGraph not implemented")
+}
+func (UnimplementedGitserverServiceServer) Cherry(context.Context, *CherryRequest) (*CherryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cherry not implemented")
+}
+func (UnimplementedGitserverServiceServer) RangeDiff(context.Context, *RangeDiffRequest) (*RangeDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RangeDiff not implemented")
+}
+func (UnimplementedGitserverServiceServer) UpdateRef(context.Context, *UpdateRefRequest) (*UpdateRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRef not implemented")
+}
+func (UnimplementedGitserverServiceServer) CreateBranch(context.Context, *CreateBranchRequest) (*CreateBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBranch not implemented")
+}
+func (UnimplementedGitserverServiceServer) DeleteBranch(context.Context, *DeleteBranchRequest) (*DeleteBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBranch not implemented")
+}
+func (UnimplementedGitserverServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedGitserverServiceServer) SymbolicRef(context.Context, *SymbolicRefRequest) (*SymbolicRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbolicRef not implemented")
+}
+func (UnimplementedGitserverServiceServer) RefExists(context.Context, *RefExistsRequest) (*RefExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefExists not implemented")
+}
+func (UnimplementedGitserverServiceServer) ListBranches(*ListBranchesRequest, GitserverService_ListBranchesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBranches not implemented")
+}
+func (UnimplementedGitserverServiceServer) ListTags(*ListTagsRequest, GitserverService_ListTagsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedGitserverServiceServer) CloneProgress(*CloneProgressRequest, GitserverService_CloneProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method CloneProgress not implemented")
+}
+func (UnimplementedGitserverServiceServer) OptimizeRepo(context.Context, *OptimizeRepoRequest) (*OptimizeRepoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimizeRepo not implemented")
+}
+func (UnimplementedGitserverServiceServer) CommitGraphStatus(context.Context, *CommitGraphStatusRequest) (*CommitGraphStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitGraphStatus not implemented")
+}
+func (UnimplementedGitserverServiceServer) CloneState(context.Context, *CloneStateRequest) (*CloneStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneState not implemented")
+}
+func (UnimplementedGitserverServiceServer) ListRepos(*ListReposRequest, GitserverService_ListReposServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRepos not implemented")
+}
+func (UnimplementedGitserverServiceServer) ReadBlob(*ReadBlobRequest, GitserverService_ReadBlobServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadBlob not implemented")
+}
+func (UnimplementedGitserverServiceServer) GetTree(*GetTreeRequest, GitserverService_GetTreeServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTree not implemented")
+}
+func (UnimplementedGitserverServiceServer) SparseManifest(*SparseManifestRequest, GitserverService_SparseManifestServer) error {
+	return status.Errorf(codes.Unimplemented, "method SparseManifest not implemented")
+}
+func (UnimplementedGitserverServiceServer) LastCommitsForTree(*LastCommitsForTreeRequest, GitserverService_LastCommitsForTreeServer) error {
+	return status.Errorf(codes.Unimplemented, "method LastCommitsForTree not implemented")
+}
+func (UnimplementedGitserverServiceServer) CommitActivity(context.Context, *CommitActivityRequest) (*CommitActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitActivity not implemented")
+}
+func (UnimplementedGitserverServiceServer) GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTag not implemented")
+}
+func (UnimplementedGitserverServiceServer) ListRemoteRefs(*ListRemoteRefsRequest, GitserverService_ListRemoteRefsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRemoteRefs not implemented")
+}
+func (UnimplementedGitserverServiceServer) FetchCommitFromRepo(context.Context, *FetchCommitFromRepoRequest) (*FetchCommitFromRepoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchCommitFromRepo not implemented")
+}
+func (UnimplementedGitserverServiceServer) CreateBundle(*CreateBundleRequest, GitserverService_CreateBundleServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateBundle not implemented")
+}
+func (UnimplementedGitserverServiceServer) ApplyBundle(GitserverService_ApplyBundleServer) error {
+	return status.Errorf(codes.Unimplemented, "method ApplyBundle not implemented")
+}
+func (UnimplementedGitserverServiceServer) FetchRefspec(context.Context, *FetchRefspecRequest) (*FetchRefspecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchRefspec not implemented")
+}
+func (UnimplementedGitserverServiceServer) ExecInWorktree(context.Context, *ExecInWorktreeRequest) (*ExecInWorktreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecInWorktree not implemented")
+}
+func (UnimplementedGitserverServiceServer) RebasePreview(context.Context, *RebasePreviewRequest) (*RebasePreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebasePreview not implemented")
+}
+func (UnimplementedGitserverServiceServer) RevertCommit(context.Context, *RevertCommitRequest) (*RevertCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertCommit not implemented")
+}
+func (UnimplementedGitserverServiceServer) CherryPick(context.Context, *CherryPickRequest) (*CherryPickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CherryPick not implemented")
+}
+func (UnimplementedGitserverServiceServer) SquashCommits(context.Context, *SquashCommitsRequest) (*SquashCommitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SquashCommits not implemented")
+}
+func (UnimplementedGitserverServiceServer) mustEmbedUnimplementedGitserverServiceServer() {}
+
+// UnsafeGitserverServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GitserverServiceServer will
+// result in compilation errors.
+type UnsafeGitserverServiceServer interface {
 	mustEmbedUnimplementedGitserverServiceServer()
 }
 
-// UnimplementedGitserverServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedGitserverServiceServer struct {
+func RegisterGitserverServiceServer(s grpc.ServiceRegistrar, srv GitserverServiceServer) {
+	s.RegisterService(&GitserverService_ServiceDesc, srv)
+}
+
+func _GitserverService_CreateCommitFromPatchBinary_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GitserverServiceServer).CreateCommitFromPatchBinary(&gitserverServiceCreateCommitFromPatchBinaryServer{stream})
+}
+
+type GitserverService_CreateCommitFromPatchBinaryServer interface {
+	SendAndClose(*CreateCommitFromPatchBinaryResponse) error
+	Recv() (*CreateCommitFromPatchBinaryRequest, error)
+	grpc.ServerStream
+}
+
+type gitserverServiceCreateCommitFromPatchBinaryServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceCreateCommitFromPatchBinaryServer) SendAndClose(m *CreateCommitFromPatchBinaryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gitserverServiceCreateCommitFromPatchBinaryServer) Recv() (*CreateCommitFromPatchBinaryRequest, error) {
+	m := new(CreateCommitFromPatchBinaryRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GitserverService_DiskInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).DiskInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_DiskInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).DiskInfo(ctx, req.(*DiskInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_Capabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).Capabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_Capabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).Capabilities(ctx, req.(*CapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExecRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).Exec(m, &gitserverServiceExecServer{stream})
+}
+
+type GitserverService_ExecServer interface {
+	Send(*ExecResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceExecServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceExecServer) Send(m *ExecResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_GetObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).GetObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_GetObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).GetObject(ctx, req.(*GetObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_IsRepoCloneable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsRepoCloneableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).IsRepoCloneable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_IsRepoCloneable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).IsRepoCloneable(ctx, req.(*IsRepoCloneableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_ListGitolite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGitoliteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).ListGitolite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_ListGitolite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).ListGitolite(ctx, req.(*ListGitoliteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_Search_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).Search(m, &gitserverServiceSearchServer{stream})
+}
+
+type GitserverService_SearchServer interface {
+	Send(*SearchResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceSearchServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceSearchServer) Send(m *SearchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_Archive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).Archive(m, &gitserverServiceArchiveServer{stream})
+}
+
+type GitserverService_ArchiveServer interface {
+	Send(*ArchiveResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceArchiveServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceArchiveServer) Send(m *ArchiveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_RepoCloneProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoCloneProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).RepoCloneProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_RepoCloneProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).RepoCloneProgress(ctx, req.(*RepoCloneProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_RepoDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).RepoDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_RepoDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).RepoDelete(ctx, req.(*RepoDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_RepoUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).RepoUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_RepoUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).RepoUpdate(ctx, req.(*RepoUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_IsPerforcePathCloneable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsPerforcePathCloneableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).IsPerforcePathCloneable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_IsPerforcePathCloneable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).IsPerforcePathCloneable(ctx, req.(*IsPerforcePathCloneableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_CheckPerforceCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPerforceCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).CheckPerforceCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_CheckPerforceCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).CheckPerforceCredentials(ctx, req.(*CheckPerforceCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_PerforceUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PerforceUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).PerforceUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_PerforceUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).PerforceUsers(ctx, req.(*PerforceUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_PerforceProtectsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PerforceProtectsForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).PerforceProtectsForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_PerforceProtectsForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).PerforceProtectsForUser(ctx, req.(*PerforceProtectsForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_PerforceProtectsForDepot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PerforceProtectsForDepotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).PerforceProtectsForDepot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_PerforceProtectsForDepot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).PerforceProtectsForDepot(ctx, req.(*PerforceProtectsForDepotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_PerforceGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PerforceGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).PerforceGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_PerforceGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).PerforceGroupMembers(ctx, req.(*PerforceGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_IsPerforceSuperUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsPerforceSuperUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).IsPerforceSuperUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_IsPerforceSuperUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).IsPerforceSuperUser(ctx, req.(*IsPerforceSuperUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedGitserverServiceServer) CreateCommitFromPatchBinary(GitserverService_CreateCommitFromPatchBinaryServer) error {
-	return status.Errorf(codes.Unimplemented, "method CreateCommitFromPatchBinary not implemented")
-}
-func (UnimplementedGitserverServiceServer) DiskInfo(context.Context, *DiskInfoRequest) (*DiskInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DiskInfo not implemented")
-}
-func (UnimplementedGitserverServiceServer) Exec(*ExecRequest, GitserverService_ExecServer) error {
-	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
-}
-func (UnimplementedGitserverServiceServer) GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetObject not implemented")
+func _GitserverService_PerforceGetChangelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PerforceGetChangelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).PerforceGetChangelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_PerforceGetChangelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).PerforceGetChangelist(ctx, req.(*PerforceGetChangelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGitserverServiceServer) IsRepoCloneable(context.Context, *IsRepoCloneableRequest) (*IsRepoCloneableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsRepoCloneable not implemented")
+
+func _GitserverService_MergeBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeBaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).MergeBase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_MergeBase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).MergeBase(ctx, req.(*MergeBaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGitserverServiceServer) ListGitolite(context.Context, *ListGitoliteRequest) (*ListGitoliteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListGitolite not implemented")
+
+func _GitserverService_Blame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).Blame(m, &gitserverServiceBlameServer{stream})
 }
-func (UnimplementedGitserverServiceServer) Search(*SearchRequest, GitserverService_SearchServer) error {
-	return status.Errorf(codes.Unimplemented, "method Search not implemented")
+
+type GitserverService_BlameServer interface {
+	Send(*BlameResponse) error
+	grpc.ServerStream
 }
-func (UnimplementedGitserverServiceServer) Archive(*ArchiveRequest, GitserverService_ArchiveServer) error {
-	return status.Errorf(codes.Unimplemented, "method Archive not implemented")
+
+type gitserverServiceBlameServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedGitserverServiceServer) RepoCloneProgress(context.Context, *RepoCloneProgressRequest) (*RepoCloneProgressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RepoCloneProgress not implemented")
+
+func (x *gitserverServiceBlameServer) Send(m *BlameResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedGitserverServiceServer) RepoDelete(context.Context, *RepoDeleteRequest) (*RepoDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RepoDelete not implemented")
+
+func _GitserverService_BlameSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlameSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).BlameSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_BlameSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).BlameSummary(ctx, req.(*BlameSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGitserverServiceServer) RepoUpdate(context.Context, *RepoUpdateRequest) (*RepoUpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RepoUpdate not implemented")
+
+func _GitserverService_DefaultBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefaultBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).DefaultBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_DefaultBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).DefaultBranch(ctx, req.(*DefaultBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGitserverServiceServer) IsPerforcePathCloneable(context.Context, *IsPerforcePathCloneableRequest) (*IsPerforcePathCloneableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsPerforcePathCloneable not implemented")
+
+func _GitserverService_ReadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).ReadFile(m, &gitserverServiceReadFileServer{stream})
 }
-func (UnimplementedGitserverServiceServer) CheckPerforceCredentials(context.Context, *CheckPerforceCredentialsRequest) (*CheckPerforceCredentialsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckPerforceCredentials not implemented")
+
+type GitserverService_ReadFileServer interface {
+	Send(*ReadFileResponse) error
+	grpc.ServerStream
 }
-func (UnimplementedGitserverServiceServer) PerforceUsers(context.Context, *PerforceUsersRequest) (*PerforceUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PerforceUsers not implemented")
+
+type gitserverServiceReadFileServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedGitserverServiceServer) PerforceProtectsForUser(context.Context, *PerforceProtectsForUserRequest) (*PerforceProtectsForUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PerforceProtectsForUser not implemented")
+
+func (x *gitserverServiceReadFileServer) Send(m *ReadFileResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedGitserverServiceServer) PerforceProtectsForDepot(context.Context, *PerforceProtectsForDepotRequest) (*PerforceProtectsForDepotResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PerforceProtectsForDepot not implemented")
+
+func _GitserverService_GetCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).GetCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_GetCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).GetCommit(ctx, req.(*GetCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGitserverServiceServer) PerforceGroupMembers(context.Context, *PerforceGroupMembersRequest) (*PerforceGroupMembersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PerforceGroupMembers not implemented")
+
+func _GitserverService_ResolveRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).ResolveRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_ResolveRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).ResolveRevision(ctx, req.(*ResolveRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGitserverServiceServer) IsPerforceSuperUser(context.Context, *IsPerforceSuperUserRequest) (*IsPerforceSuperUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsPerforceSuperUser not implemented")
+
+func _GitserverService_ListRefs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRefsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).ListRefs(m, &gitserverServiceListRefsServer{stream})
 }
-func (UnimplementedGitserverServiceServer) PerforceGetChangelist(context.Context, *PerforceGetChangelistRequest) (*PerforceGetChangelistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PerforceGetChangelist not implemented")
+
+type GitserverService_ListRefsServer interface {
+	Send(*ListRefsResponse) error
+	grpc.ServerStream
 }
-func (UnimplementedGitserverServiceServer) MergeBase(context.Context, *MergeBaseRequest) (*MergeBaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MergeBase not implemented")
+
+type gitserverServiceListRefsServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedGitserverServiceServer) Blame(*BlameRequest, GitserverService_BlameServer) error {
-	return status.Errorf(codes.Unimplemented, "method Blame not implemented")
+
+func (x *gitserverServiceListRefsServer) Send(m *ListRefsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedGitserverServiceServer) DefaultBranch(context.Context, *DefaultBranchRequest) (*DefaultBranchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DefaultBranch not implemented")
+
+func _GitserverService_RefsChangedSince_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RefsChangedSinceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).RefsChangedSince(m, &gitserverServiceRefsChangedSinceServer{stream})
 }
-func (UnimplementedGitserverServiceServer) ReadFile(*ReadFileRequest, GitserverService_ReadFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method ReadFile not implemented")
+
+type GitserverService_RefsChangedSinceServer interface {
+	Send(*RefsChangedSinceResponse) error
+	grpc.ServerStream
 }
-func (UnimplementedGitserverServiceServer) GetCommit(context.Context, *GetCommitRequest) (*GetCommitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommit not implemented")
+
+type gitserverServiceRefsChangedSinceServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedGitserverServiceServer) ResolveRevision(context.Context, *ResolveRevisionRequest) (*ResolveRevisionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResolveRevision not implemented")
+
+func (x *gitserverServiceRefsChangedSinceServer) Send(m *RefsChangedSinceResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedGitserverServiceServer) ListRefs(*ListRefsRequest, GitserverService_ListRefsServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListRefs not implemented")
+
+func _GitserverService_WatchRefs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRefsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).WatchRefs(m, &gitserverServiceWatchRefsServer{stream})
 }
-func (UnimplementedGitserverServiceServer) RevAtTime(context.Context, *RevAtTimeRequest) (*RevAtTimeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevAtTime not implemented")
+
+type GitserverService_WatchRefsServer interface {
+	Send(*WatchRefsResponse) error
+	grpc.ServerStream
 }
-func (UnimplementedGitserverServiceServer) mustEmbedUnimplementedGitserverServiceServer() {}
 
-// UnsafeGitserverServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GitserverServiceServer will
-// result in compilation errors.
-type UnsafeGitserverServiceServer interface {
-	mustEmbedUnimplementedGitserverServiceServer()
+type gitserverServiceWatchRefsServer struct {
+	grpc.ServerStream
 }
 
-func RegisterGitserverServiceServer(s grpc.ServiceRegistrar, srv GitserverServiceServer) {
-	s.RegisterService(&GitserverService_ServiceDesc, srv)
+func (x *gitserverServiceWatchRefsServer) Send(m *WatchRefsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _GitserverService_CreateCommitFromPatchBinary_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(GitserverServiceServer).CreateCommitFromPatchBinary(&gitserverServiceCreateCommitFromPatchBinaryServer{stream})
+func _GitserverService_WatchDefaultBranchCommits_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDefaultBranchCommitsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).WatchDefaultBranchCommits(m, &gitserverServiceWatchDefaultBranchCommitsServer{stream})
 }
 
-type GitserverService_CreateCommitFromPatchBinaryServer interface {
-	SendAndClose(*CreateCommitFromPatchBinaryResponse) error
-	Recv() (*CreateCommitFromPatchBinaryRequest, error)
+type GitserverService_WatchDefaultBranchCommitsServer interface {
+	Send(*WatchDefaultBranchCommitsResponse) error
 	grpc.ServerStream
 }
 
-type gitserverServiceCreateCommitFromPatchBinaryServer struct {
+type gitserverServiceWatchDefaultBranchCommitsServer struct {
 	grpc.ServerStream
 }
 
-func (x *gitserverServiceCreateCommitFromPatchBinaryServer) SendAndClose(m *CreateCommitFromPatchBinaryResponse) error {
+func (x *gitserverServiceWatchDefaultBranchCommitsServer) Send(m *WatchDefaultBranchCommitsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *gitserverServiceCreateCommitFromPatchBinaryServer) Recv() (*CreateCommitFromPatchBinaryRequest, error) {
-	m := new(CreateCommitFromPatchBinaryRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _GitserverService_DiskInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiskInfoRequest)
+func _GitserverService_RevAtTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevAtTimeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).DiskInfo(ctx, in)
+		return srv.(GitserverServiceServer).RevAtTime(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_DiskInfo_FullMethodName,
+		FullMethod: GitserverService_RevAtTime_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).DiskInfo(ctx, req.(*DiskInfoRequest))
+		return srv.(GitserverServiceServer).RevAtTime(ctx, req.(*RevAtTimeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ExecRequest)
+func _GitserverService_FormatPatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FormatPatchRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GitserverServiceServer).Exec(m, &gitserverServiceExecServer{stream})
+	return srv.(GitserverServiceServer).FormatPatch(m, &gitserverServiceFormatPatchServer{stream})
 }
 
-type GitserverService_ExecServer interface {
-	Send(*ExecResponse) error
+type GitserverService_FormatPatchServer interface {
+	Send(*FormatPatchResponse) error
 	grpc.ServerStream
 }
 
-type gitserverServiceExecServer struct {
+type gitserverServiceFormatPatchServer struct {
 	grpc.ServerStream
 }
 
-func (x *gitserverServiceExecServer) Send(m *ExecResponse) error {
+func (x *gitserverServiceFormatPatchServer) Send(m *FormatPatchResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GitserverService_GetObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetObjectRequest)
+func _GitserverService_CommitGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CommitGraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).CommitGraph(m, &gitserverServiceCommitGraphServer{stream})
+}
+
+type GitserverService_CommitGraphServer interface {
+	Send(*CommitGraphResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceCommitGraphServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceCommitGraphServer) Send(m *CommitGraphResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_Cherry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CherryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).GetObject(ctx, in)
+		return srv.(GitserverServiceServer).Cherry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_GetObject_FullMethodName,
+		FullMethod: GitserverService_Cherry_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).GetObject(ctx, req.(*GetObjectRequest))
+		return srv.(GitserverServiceServer).Cherry(ctx, req.(*CherryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_IsRepoCloneable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsRepoCloneableRequest)
+func _GitserverService_RangeDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeDiffRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).IsRepoCloneable(ctx, in)
+		return srv.(GitserverServiceServer).RangeDiff(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_IsRepoCloneable_FullMethodName,
+		FullMethod: GitserverService_RangeDiff_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).IsRepoCloneable(ctx, req.(*IsRepoCloneableRequest))
+		return srv.(GitserverServiceServer).RangeDiff(ctx, req.(*RangeDiffRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_ListGitolite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGitoliteRequest)
+func _GitserverService_UpdateRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRefRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).ListGitolite(ctx, in)
+		return srv.(GitserverServiceServer).UpdateRef(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_ListGitolite_FullMethodName,
+		FullMethod: GitserverService_UpdateRef_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).ListGitolite(ctx, req.(*ListGitoliteRequest))
+		return srv.(GitserverServiceServer).UpdateRef(ctx, req.(*UpdateRefRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_Search_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SearchRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GitserverService_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GitserverServiceServer).Search(m, &gitserverServiceSearchServer{stream})
-}
-
-type GitserverService_SearchServer interface {
-	Send(*SearchResponse) error
-	grpc.ServerStream
-}
-
-type gitserverServiceSearchServer struct {
-	grpc.ServerStream
-}
-
-func (x *gitserverServiceSearchServer) Send(m *SearchResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GitserverService_Archive_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ArchiveRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).CreateBranch(ctx, in)
 	}
-	return srv.(GitserverServiceServer).Archive(m, &gitserverServiceArchiveServer{stream})
-}
-
-type GitserverService_ArchiveServer interface {
-	Send(*ArchiveResponse) error
-	grpc.ServerStream
-}
-
-type gitserverServiceArchiveServer struct {
-	grpc.ServerStream
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_CreateBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).CreateBranch(ctx, req.(*CreateBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *gitserverServiceArchiveServer) Send(m *ArchiveResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _GitserverService_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).DeleteBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_DeleteBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).DeleteBranch(ctx, req.(*DeleteBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_RepoCloneProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepoCloneProgressRequest)
+func _GitserverService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).RepoCloneProgress(ctx, in)
+		return srv.(GitserverServiceServer).CreateTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_RepoCloneProgress_FullMethodName,
+		FullMethod: GitserverService_CreateTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).RepoCloneProgress(ctx, req.(*RepoCloneProgressRequest))
+		return srv.(GitserverServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_RepoDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepoDeleteRequest)
+func _GitserverService_SymbolicRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolicRefRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).RepoDelete(ctx, in)
+		return srv.(GitserverServiceServer).SymbolicRef(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_RepoDelete_FullMethodName,
+		FullMethod: GitserverService_SymbolicRef_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).RepoDelete(ctx, req.(*RepoDeleteRequest))
+		return srv.(GitserverServiceServer).SymbolicRef(ctx, req.(*SymbolicRefRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_RepoUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepoUpdateRequest)
+func _GitserverService_RefExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefExistsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).RepoUpdate(ctx, in)
+		return srv.(GitserverServiceServer).RefExists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_RepoUpdate_FullMethodName,
+		FullMethod: GitserverService_RefExists_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).RepoUpdate(ctx, req.(*RepoUpdateRequest))
+		return srv.(GitserverServiceServer).RefExists(ctx, req.(*RefExistsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_IsPerforcePathCloneable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsPerforcePathCloneableRequest)
+func _GitserverService_ListBranches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBranchesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).ListBranches(m, &gitserverServiceListBranchesServer{stream})
+}
+
+type GitserverService_ListBranchesServer interface {
+	Send(*ListBranchesResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceListBranchesServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceListBranchesServer) Send(m *ListBranchesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_ListTags_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTagsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).ListTags(m, &gitserverServiceListTagsServer{stream})
+}
+
+type GitserverService_ListTagsServer interface {
+	Send(*ListTagsResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceListTagsServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceListTagsServer) Send(m *ListTagsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_CloneProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CloneProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).CloneProgress(m, &gitserverServiceCloneProgressServer{stream})
+}
+
+type GitserverService_CloneProgressServer interface {
+	Send(*CloneProgressResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceCloneProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceCloneProgressServer) Send(m *CloneProgressResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_OptimizeRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimizeRepoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).IsPerforcePathCloneable(ctx, in)
+		return srv.(GitserverServiceServer).OptimizeRepo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_IsPerforcePathCloneable_FullMethodName,
+		FullMethod: GitserverService_OptimizeRepo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).IsPerforcePathCloneable(ctx, req.(*IsPerforcePathCloneableRequest))
+		return srv.(GitserverServiceServer).OptimizeRepo(ctx, req.(*OptimizeRepoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_CheckPerforceCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckPerforceCredentialsRequest)
+func _GitserverService_CommitGraphStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitGraphStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).CheckPerforceCredentials(ctx, in)
+		return srv.(GitserverServiceServer).CommitGraphStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_CheckPerforceCredentials_FullMethodName,
+		FullMethod: GitserverService_CommitGraphStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).CheckPerforceCredentials(ctx, req.(*CheckPerforceCredentialsRequest))
+		return srv.(GitserverServiceServer).CommitGraphStatus(ctx, req.(*CommitGraphStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_PerforceUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PerforceUsersRequest)
+func _GitserverService_CloneState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).PerforceUsers(ctx, in)
+		return srv.(GitserverServiceServer).CloneState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_PerforceUsers_FullMethodName,
+		FullMethod: GitserverService_CloneState_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).PerforceUsers(ctx, req.(*PerforceUsersRequest))
+		return srv.(GitserverServiceServer).CloneState(ctx, req.(*CloneStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_PerforceProtectsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PerforceProtectsForUserRequest)
+func _GitserverService_ListRepos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListReposRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).ListRepos(m, &gitserverServiceListReposServer{stream})
+}
+
+type GitserverService_ListReposServer interface {
+	Send(*ListReposResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceListReposServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceListReposServer) Send(m *ListReposResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_ReadBlob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadBlobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).ReadBlob(m, &gitserverServiceReadBlobServer{stream})
+}
+
+type GitserverService_ReadBlobServer interface {
+	Send(*ReadBlobResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceReadBlobServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceReadBlobServer) Send(m *ReadBlobResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_GetTree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTreeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).GetTree(m, &gitserverServiceGetTreeServer{stream})
+}
+
+type GitserverService_GetTreeServer interface {
+	Send(*GetTreeResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceGetTreeServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceGetTreeServer) Send(m *GetTreeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_SparseManifest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SparseManifestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).SparseManifest(m, &gitserverServiceSparseManifestServer{stream})
+}
+
+type GitserverService_SparseManifestServer interface {
+	Send(*SparseManifestResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceSparseManifestServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceSparseManifestServer) Send(m *SparseManifestResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_LastCommitsForTree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LastCommitsForTreeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).LastCommitsForTree(m, &gitserverServiceLastCommitsForTreeServer{stream})
+}
+
+type GitserverService_LastCommitsForTreeServer interface {
+	Send(*LastCommitsForTreeResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceLastCommitsForTreeServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceLastCommitsForTreeServer) Send(m *LastCommitsForTreeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_CommitActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitActivityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).PerforceProtectsForUser(ctx, in)
+		return srv.(GitserverServiceServer).CommitActivity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_PerforceProtectsForUser_FullMethodName,
+		FullMethod: GitserverService_CommitActivity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).PerforceProtectsForUser(ctx, req.(*PerforceProtectsForUserRequest))
+		return srv.(GitserverServiceServer).CommitActivity(ctx, req.(*CommitActivityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_PerforceProtectsForDepot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PerforceProtectsForDepotRequest)
+func _GitserverService_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).PerforceProtectsForDepot(ctx, in)
+		return srv.(GitserverServiceServer).GetTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_PerforceProtectsForDepot_FullMethodName,
+		FullMethod: GitserverService_GetTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).PerforceProtectsForDepot(ctx, req.(*PerforceProtectsForDepotRequest))
+		return srv.(GitserverServiceServer).GetTag(ctx, req.(*GetTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_PerforceGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PerforceGroupMembersRequest)
+func _GitserverService_ListRemoteRefs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRemoteRefsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).ListRemoteRefs(m, &gitserverServiceListRemoteRefsServer{stream})
+}
+
+type GitserverService_ListRemoteRefsServer interface {
+	Send(*ListRemoteRefsResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceListRemoteRefsServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceListRemoteRefsServer) Send(m *ListRemoteRefsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_FetchCommitFromRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchCommitFromRepoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).PerforceGroupMembers(ctx, in)
+		return srv.(GitserverServiceServer).FetchCommitFromRepo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_PerforceGroupMembers_FullMethodName,
+		FullMethod: GitserverService_FetchCommitFromRepo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).PerforceGroupMembers(ctx, req.(*PerforceGroupMembersRequest))
+		return srv.(GitserverServiceServer).FetchCommitFromRepo(ctx, req.(*FetchCommitFromRepoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_IsPerforceSuperUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsPerforceSuperUserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GitserverServiceServer).IsPerforceSuperUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: GitserverService_IsPerforceSuperUser_FullMethodName,
+func _GitserverService_CreateBundle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CreateBundleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).IsPerforceSuperUser(ctx, req.(*IsPerforceSuperUserRequest))
+	return srv.(GitserverServiceServer).CreateBundle(m, &gitserverServiceCreateBundleServer{stream})
+}
+
+type GitserverService_CreateBundleServer interface {
+	Send(*CreateBundleResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceCreateBundleServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceCreateBundleServer) Send(m *CreateBundleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_ApplyBundle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GitserverServiceServer).ApplyBundle(&gitserverServiceApplyBundleServer{stream})
+}
+
+type GitserverService_ApplyBundleServer interface {
+	SendAndClose(*ApplyBundleResponse) error
+	Recv() (*ApplyBundleRequest, error)
+	grpc.ServerStream
+}
+
+type gitserverServiceApplyBundleServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceApplyBundleServer) SendAndClose(m *ApplyBundleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gitserverServiceApplyBundleServer) Recv() (*ApplyBundleRequest, error) {
+	m := new(ApplyBundleRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _GitserverService_PerforceGetChangelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PerforceGetChangelistRequest)
+func _GitserverService_FetchRefspec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchRefspecRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).PerforceGetChangelist(ctx, in)
+		return srv.(GitserverServiceServer).FetchRefspec(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_PerforceGetChangelist_FullMethodName,
+		FullMethod: GitserverService_FetchRefspec_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).PerforceGetChangelist(ctx, req.(*PerforceGetChangelistRequest))
+		return srv.(GitserverServiceServer).FetchRefspec(ctx, req.(*FetchRefspecRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_MergeBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MergeBaseRequest)
+func _GitserverService_ExecInWorktree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecInWorktreeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).MergeBase(ctx, in)
+		return srv.(GitserverServiceServer).ExecInWorktree(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_MergeBase_FullMethodName,
+		FullMethod: GitserverService_ExecInWorktree_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).MergeBase(ctx, req.(*MergeBaseRequest))
+		return srv.(GitserverServiceServer).ExecInWorktree(ctx, req.(*ExecInWorktreeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_Blame_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(BlameRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GitserverServiceServer).Blame(m, &gitserverServiceBlameServer{stream})
-}
-
-type GitserverService_BlameServer interface {
-	Send(*BlameResponse) error
-	grpc.ServerStream
-}
-
-type gitserverServiceBlameServer struct {
-	grpc.ServerStream
-}
-
-func (x *gitserverServiceBlameServer) Send(m *BlameResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GitserverService_DefaultBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DefaultBranchRequest)
+func _GitserverService_RebasePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebasePreviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).DefaultBranch(ctx, in)
+		return srv.(GitserverServiceServer).RebasePreview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_DefaultBranch_FullMethodName,
+		FullMethod: GitserverService_RebasePreview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).DefaultBranch(ctx, req.(*DefaultBranchRequest))
+		return srv.(GitserverServiceServer).RebasePreview(ctx, req.(*RebasePreviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_ReadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ReadFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GitserverServiceServer).ReadFile(m, &gitserverServiceReadFileServer{stream})
-}
-
-type GitserverService_ReadFileServer interface {
-	Send(*ReadFileResponse) error
-	grpc.ServerStream
-}
-
-type gitserverServiceReadFileServer struct {
-	grpc.ServerStream
-}
-
-func (x *gitserverServiceReadFileServer) Send(m *ReadFileResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GitserverService_GetCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommitRequest)
+func _GitserverService_RevertCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertCommitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).GetCommit(ctx, in)
+		return srv.(GitserverServiceServer).RevertCommit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_GetCommit_FullMethodName,
+		FullMethod: GitserverService_RevertCommit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).GetCommit(ctx, req.(*GetCommitRequest))
+		return srv.(GitserverServiceServer).RevertCommit(ctx, req.(*RevertCommitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_ResolveRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResolveRevisionRequest)
+func _GitserverService_CherryPick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CherryPickRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).ResolveRevision(ctx, in)
+		return srv.(GitserverServiceServer).CherryPick(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_ResolveRevision_FullMethodName,
+		FullMethod: GitserverService_CherryPick_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).ResolveRevision(ctx, req.(*ResolveRevisionRequest))
+		return srv.(GitserverServiceServer).CherryPick(ctx, req.(*CherryPickRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GitserverService_ListRefs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListRefsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GitserverServiceServer).ListRefs(m, &gitserverServiceListRefsServer{stream})
-}
-
-type GitserverService_ListRefsServer interface {
-	Send(*ListRefsResponse) error
-	grpc.ServerStream
-}
-
-type gitserverServiceListRefsServer struct {
-	grpc.ServerStream
-}
-
-func (x *gitserverServiceListRefsServer) Send(m *ListRefsResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GitserverService_RevAtTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevAtTimeRequest)
+func _GitserverService_SquashCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquashCommitsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GitserverServiceServer).RevAtTime(ctx, in)
+		return srv.(GitserverServiceServer).SquashCommits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GitserverService_RevAtTime_FullMethodName,
+		FullMethod: GitserverService_SquashCommits_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GitserverServiceServer).RevAtTime(ctx, req.(*RevAtTimeRequest))
+		return srv.(GitserverServiceServer).SquashCommits(ctx, req.(*SquashCommitsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1338,6 +3925,10 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DiskInfo",
 			Handler:    _GitserverService_DiskInfo_Handler,
 		},
+		{
+			MethodName: "Capabilities",
+			Handler:    _GitserverService_Capabilities_Handler,
+		},
 		{
 			MethodName: "GetObject",
 			Handler:    _GitserverService_GetObject_Handler,
@@ -1398,6 +3989,10 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MergeBase",
 			Handler:    _GitserverService_MergeBase_Handler,
 		},
+		{
+			MethodName: "BlameSummary",
+			Handler:    _GitserverService_BlameSummary_Handler,
+		},
 		{
 			MethodName: "DefaultBranch",
 			Handler:    _GitserverService_DefaultBranch_Handler,
@@ -1414,6 +4009,86 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevAtTime",
 			Handler:    _GitserverService_RevAtTime_Handler,
 		},
+		{
+			MethodName: "Cherry",
+			Handler:    _GitserverService_Cherry_Handler,
+		},
+		{
+			MethodName: "RangeDiff",
+			Handler:    _GitserverService_RangeDiff_Handler,
+		},
+		{
+			MethodName: "UpdateRef",
+			Handler:    _GitserverService_UpdateRef_Handler,
+		},
+		{
+			MethodName: "CreateBranch",
+			Handler:    _GitserverService_CreateBranch_Handler,
+		},
+		{
+			MethodName: "DeleteBranch",
+			Handler:    _GitserverService_DeleteBranch_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _GitserverService_CreateTag_Handler,
+		},
+		{
+			MethodName: "SymbolicRef",
+			Handler:    _GitserverService_SymbolicRef_Handler,
+		},
+		{
+			MethodName: "RefExists",
+			Handler:    _GitserverService_RefExists_Handler,
+		},
+		{
+			MethodName: "OptimizeRepo",
+			Handler:    _GitserverService_OptimizeRepo_Handler,
+		},
+		{
+			MethodName: "CommitGraphStatus",
+			Handler:    _GitserverService_CommitGraphStatus_Handler,
+		},
+		{
+			MethodName: "CloneState",
+			Handler:    _GitserverService_CloneState_Handler,
+		},
+		{
+			MethodName: "CommitActivity",
+			Handler:    _GitserverService_CommitActivity_Handler,
+		},
+		{
+			MethodName: "GetTag",
+			Handler:    _GitserverService_GetTag_Handler,
+		},
+		{
+			MethodName: "FetchCommitFromRepo",
+			Handler:    _GitserverService_FetchCommitFromRepo_Handler,
+		},
+		{
+			MethodName: "FetchRefspec",
+			Handler:    _GitserverService_FetchRefspec_Handler,
+		},
+		{
+			MethodName: "ExecInWorktree",
+			Handler:    _GitserverService_ExecInWorktree_Handler,
+		},
+		{
+			MethodName: "RebasePreview",
+			Handler:    _GitserverService_RebasePreview_Handler,
+		},
+		{
+			MethodName: "RevertCommit",
+			Handler:    _GitserverService_RevertCommit_Handler,
+		},
+		{
+			MethodName: "CherryPick",
+			Handler:    _GitserverService_CherryPick_Handler,
+		},
+		{
+			MethodName: "SquashCommits",
+			Handler:    _GitserverService_SquashCommits_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1451,6 +4126,86 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GitserverService_ListRefs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RefsChangedSince",
+			Handler:       _GitserverService_RefsChangedSince_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchRefs",
+			Handler:       _GitserverService_WatchRefs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchDefaultBranchCommits",
+			Handler:       _GitserverService_WatchDefaultBranchCommits_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FormatPatch",
+			Handler:       _GitserverService_FormatPatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CommitGraph",
+			Handler:       _GitserverService_CommitGraph_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListBranches",
+			Handler:       _GitserverService_ListBranches_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListTags",
+			Handler:       _GitserverService_ListTags_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CloneProgress",
+			Handler:       _GitserverService_CloneProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListRepos",
+			Handler:       _GitserverService_ListRepos_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReadBlob",
+			Handler:       _GitserverService_ReadBlob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetTree",
+			Handler:       _GitserverService_GetTree_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SparseManifest",
+			Handler:       _GitserverService_SparseManifest_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LastCommitsForTree",
+			Handler:       _GitserverService_LastCommitsForTree_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListRemoteRefs",
+			Handler:       _GitserverService_ListRemoteRefs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CreateBundle",
+			Handler:       _GitserverService_CreateBundle_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ApplyBundle",
+			Handler:       _GitserverService_ApplyBundle_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "gitserver.proto",
 }
@@ -0,0 +1,36 @@
+package gitserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+type contentVerificationKey struct{}
+
+// WithContentVerification returns a context that makes NewFileReader verify
+// the content it streams against a SHA-256 digest computed by gitserver. If the
+// digests don't match, reading the final bytes returns a *ContentMismatchError
+// instead of io.EOF. Gitservers that don't compute the digest yet aren't
+// verified against.
+func WithContentVerification(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contentVerificationKey{}, true)
+}
+
+func contentVerificationFromContext(ctx context.Context) bool {
+	verify, _ := ctx.Value(contentVerificationKey{}).(bool)
+	return verify
+}
+
+// ContentMismatchError is returned when the content read from gitserver
+// doesn't match the digest gitserver sent along with it.
+type ContentMismatchError struct {
+	Repo   api.RepoName
+	Commit api.CommitID
+	Path   string
+}
+
+func (e *ContentMismatchError) Error() string {
+	return fmt.Sprintf("content of %s at %s in %s doesn't match its SHA-256 digest", e.Path, e.Commit, e.Repo)
+}
@@ -0,0 +1,69 @@
+package gitserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// digestGitserver streams content followed by the SHA-256 of digested, if
+// the client asked for it.
+type digestGitserver struct {
+	proto.UnimplementedGitserverServiceServer
+	content  string
+	digested string
+
+	includeSha256 bool
+}
+
+func (s *digestGitserver) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverService_ReadFileServer) error {
+	s.includeSha256 = req.GetIncludeSha256()
+	if err := ss.Send(&proto.ReadFileResponse{Data: []byte(s.content)}); err != nil {
+		return err
+	}
+	if !req.GetIncludeSha256() {
+		return nil
+	}
+	sum := sha256.Sum256([]byte(s.digested))
+	return ss.Send(&proto.ReadFileResponse{Sha256: sum[:]})
+}
+
+func TestClient_WithContentVerification(t *testing.T) {
+	readFile := func(ctx context.Context, c TestClient) (string, error) {
+		r, err := c.NewFileReader(ctx, "repo", "deadbeef", "file")
+		require.NoError(t, err)
+		defer r.Close()
+		content, err := io.ReadAll(r)
+		return string(content), err
+	}
+
+	t.Run("matching digest", func(t *testing.T) {
+		s := &digestGitserver{content: "hello", digested: "hello"}
+		content, err := readFile(WithContentVerification(context.Background()), newGRPCTestClient(t, s))
+		require.NoError(t, err)
+		require.Equal(t, "hello", content)
+		require.True(t, s.includeSha256)
+	})
+
+	t.Run("mismatching digest", func(t *testing.T) {
+		s := &digestGitserver{content: "hellp", digested: "hello"}
+		_, err := readFile(WithContentVerification(context.Background()), newGRPCTestClient(t, s))
+		var mismatchErr *ContentMismatchError
+		require.True(t, errors.As(err, &mismatchErr))
+		require.Equal(t, "file", mismatchErr.Path)
+	})
+
+	t.Run("not requested", func(t *testing.T) {
+		s := &digestGitserver{content: "hellp", digested: "hello"}
+		content, err := readFile(context.Background(), newGRPCTestClient(t, s))
+		require.NoError(t, err)
+		require.Equal(t, "hellp", content)
+		require.False(t, s.includeSha256)
+	})
+}
@@ -0,0 +1,188 @@
+package gitserver
+
+import (
+	"bytes"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sourcegraph/go-diff/diff"
+)
+
+// maxWordDiffTokens is the maximum number of tokens on either side of a line
+// pair for which a word diff is computed. Longer lines, like minified code,
+// are reported as changed entirely, as the diff is quadratic in the number of
+// tokens.
+const maxWordDiffTokens = 500
+
+// LineChangeRange is a half-open byte range [Start, End) within the content of
+// a hunk line, excluding its leading '+' or '-'.
+type LineChangeRange struct {
+	Start int
+	End   int
+}
+
+// LineWordDiff contains the parts of a removed or added hunk line that
+// changed compared to its counterpart on the other side of the diff.
+type LineWordDiff struct {
+	// Line is the 0-indexed line number within the hunk body.
+	Line int
+	// Ranges are the changed parts of the line, in order.
+	Ranges []LineChangeRange
+}
+
+// HunkWordDiff computes the intra-line changes of a hunk, so that changed
+// words can be highlighted without diffing the lines again.
+//
+// Every block of removed lines that is directly followed by a block of added
+// lines is compared line by line, the first removed line with the first added
+// line and so on. Both lines of such a pair are tokenized into words, runs of
+// whitespace and single punctuation characters, and the tokens that are not
+// part of their longest common subsequence are reported as changed.
+//
+// Lines without a counterpart are entirely new or removed and are not
+// included in the result, neither are lines that only differ in their
+// "\ No newline at end of file" marker.
+func HunkWordDiff(h *diff.Hunk) []LineWordDiff {
+	lines := bytes.Split(h.Body, []byte{'\n'})
+
+	var res []LineWordDiff
+	for i := 0; i < len(lines); {
+		if !isHunkLine(lines[i], '-') {
+			i++
+			continue
+		}
+
+		removedStart := i
+		for i < len(lines) && isHunkLine(lines[i], '-') {
+			i++
+		}
+		addedStart := i
+		for i < len(lines) && isHunkLine(lines[i], '+') {
+			i++
+		}
+
+		pairs := min(addedStart-removedStart, i-addedStart)
+		for j := range pairs {
+			removed, added := removedStart+j, addedStart+j
+			removedRanges, addedRanges := wordDiff(lines[removed][1:], lines[added][1:])
+			if len(removedRanges) > 0 {
+				res = append(res, LineWordDiff{Line: removed, Ranges: removedRanges})
+			}
+			if len(addedRanges) > 0 {
+				res = append(res, LineWordDiff{Line: added, Ranges: addedRanges})
+			}
+		}
+	}
+
+	// Removed lines come before added lines within a block, but the pairs
+	// interleave them. Order the result by line.
+	slices.SortFunc(res, func(a, b LineWordDiff) int { return a.Line - b.Line })
+
+	return res
+}
+
+func isHunkLine(line []byte, prefix byte) bool {
+	return len(line) > 0 && line[0] == prefix
+}
+
+// wordDiff returns the changed ranges of a and b.
+func wordDiff(a, b []byte) (aRanges, bRanges []LineChangeRange) {
+	if bytes.Equal(a, b) {
+		return nil, nil
+	}
+
+	aTokens, bTokens := tokenizeWords(a), tokenizeWords(b)
+	if len(aTokens) > maxWordDiffTokens || len(bTokens) > maxWordDiffTokens {
+		return wholeLineRange(a), wholeLineRange(b)
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// aTokens[i:] and bTokens[j:].
+	lcs := make([][]int, len(aTokens)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bTokens)+1)
+	}
+	for i := len(aTokens) - 1; i >= 0; i-- {
+		for j := len(bTokens) - 1; j >= 0; j-- {
+			if bytes.Equal(aTokens[i].text(a), bTokens[j].text(b)) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(aTokens) || j < len(bTokens) {
+		switch {
+		case i < len(aTokens) && j < len(bTokens) && bytes.Equal(aTokens[i].text(a), bTokens[j].text(b)):
+			i++
+			j++
+		case j == len(bTokens) || (i < len(aTokens) && lcs[i+1][j] >= lcs[i][j+1]):
+			aRanges = appendChangeRange(aRanges, aTokens[i])
+			i++
+		default:
+			bRanges = appendChangeRange(bRanges, bTokens[j])
+			j++
+		}
+	}
+
+	return aRanges, bRanges
+}
+
+func wholeLineRange(line []byte) []LineChangeRange {
+	if len(line) == 0 {
+		return nil
+	}
+	return []LineChangeRange{{Start: 0, End: len(line)}}
+}
+
+// appendChangeRange appends r to ranges, merging it into the last range if
+// they are adjacent.
+func appendChangeRange(ranges []LineChangeRange, r LineChangeRange) []LineChangeRange {
+	if n := len(ranges); n > 0 && ranges[n-1].End == r.Start {
+		ranges[n-1].End = r.End
+		return ranges
+	}
+	return append(ranges, r)
+}
+
+func (r LineChangeRange) text(line []byte) []byte {
+	return line[r.Start:r.End]
+}
+
+// tokenizeWords splits line into tokens: runs of letters, digits and
+// underscores, runs of whitespace, and any other single character.
+func tokenizeWords(line []byte) []LineChangeRange {
+	var tokens []LineChangeRange
+	for start := 0; start < len(line); {
+		r, size := utf8.DecodeRune(line[start:])
+		end := start + size
+		switch {
+		case isWordRune(r):
+			for end < len(line) {
+				r, size := utf8.DecodeRune(line[end:])
+				if !isWordRune(r) {
+					break
+				}
+				end += size
+			}
+		case unicode.IsSpace(r):
+			for end < len(line) {
+				r, size := utf8.DecodeRune(line[end:])
+				if !unicode.IsSpace(r) {
+					break
+				}
+				end += size
+			}
+		}
+		tokens = append(tokens, LineChangeRange{Start: start, End: end})
+		start = end
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
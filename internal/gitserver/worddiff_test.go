@@ -0,0 +1,92 @@
+package gitserver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/go-diff/diff"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHunkWordDiff(t *testing.T) {
+	hunkBody := func(lines ...string) *diff.Hunk {
+		return &diff.Hunk{Body: []byte(strings.Join(lines, "\n") + "\n")}
+	}
+
+	t.Run("changed word", func(t *testing.T) {
+		got := HunkWordDiff(hunkBody(
+			" context",
+			"-return foo(bar)",
+			"+return foo(baz)",
+		))
+		require.Equal(t, []LineWordDiff{
+			{Line: 1, Ranges: []LineChangeRange{{Start: 11, End: 14}}},
+			{Line: 2, Ranges: []LineChangeRange{{Start: 11, End: 14}}},
+		}, got)
+	})
+
+	t.Run("inserted words", func(t *testing.T) {
+		got := HunkWordDiff(hunkBody(
+			"-a c",
+			"+a b c",
+		))
+		require.Equal(t, []LineWordDiff{
+			// Adjacent changed tokens are merged into a single range.
+			{Line: 1, Ranges: []LineChangeRange{{Start: 2, End: 4}}},
+		}, got)
+	})
+
+	t.Run("multiple ranges", func(t *testing.T) {
+		got := HunkWordDiff(hunkBody(
+			"-x := 1 + y",
+			"+z := 1 - y",
+		))
+		require.Equal(t, []LineWordDiff{
+			{Line: 0, Ranges: []LineChangeRange{{Start: 0, End: 1}, {Start: 7, End: 8}}},
+			{Line: 1, Ranges: []LineChangeRange{{Start: 0, End: 1}, {Start: 7, End: 8}}},
+		}, got)
+	})
+
+	t.Run("blocks are paired line by line", func(t *testing.T) {
+		got := HunkWordDiff(hunkBody(
+			"-one",
+			"-two",
+			"-three",
+			"+one!",
+			"+2",
+			" context",
+			"+added",
+		))
+		require.Equal(t, []LineWordDiff{
+			// Only characters were added to "one", so the removed line has no
+			// changes, and "three" has no counterpart.
+			{Line: 1, Ranges: []LineChangeRange{{Start: 0, End: 3}}},
+			{Line: 3, Ranges: []LineChangeRange{{Start: 3, End: 4}}},
+			{Line: 4, Ranges: []LineChangeRange{{Start: 0, End: 1}}},
+		}, got)
+	})
+
+	t.Run("unicode", func(t *testing.T) {
+		got := HunkWordDiff(hunkBody(
+			"-grüße welt",
+			"+grüße wält",
+		))
+		require.Equal(t, []LineWordDiff{
+			{Line: 0, Ranges: []LineChangeRange{{Start: 8, End: 12}}},
+			{Line: 1, Ranges: []LineChangeRange{{Start: 8, End: 13}}},
+		}, got)
+	})
+
+	t.Run("only additions and removals", func(t *testing.T) {
+		require.Empty(t, HunkWordDiff(hunkBody("+added", " context", "-removed")))
+	})
+
+	t.Run("long lines", func(t *testing.T) {
+		long := strings.Repeat("a ", maxWordDiffTokens)
+		got := HunkWordDiff(hunkBody("-"+long, "+"+long+"b"))
+		require.Equal(t, []LineWordDiff{
+			{Line: 0, Ranges: []LineChangeRange{{Start: 0, End: len(long)}}},
+			{Line: 1, Ranges: []LineChangeRange{{Start: 0, End: len(long) + 1}}},
+		}, got)
+	})
+}
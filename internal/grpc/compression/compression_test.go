@@ -0,0 +1,49 @@
+package compression
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+)
+
+func TestSupported(t *testing.T) {
+	require.Equal(t, []string{Zstd, Gzip}, Supported())
+}
+
+func TestZstdCompressor(t *testing.T) {
+	c := encoding.GetCompressor(Zstd)
+	require.NotNil(t, c)
+
+	roundTrip := func(t *testing.T, data []byte) {
+		var buf bytes.Buffer
+		w, err := c.Compress(&buf)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		r, err := c.Decompress(&buf)
+		require.NoError(t, err)
+		got, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data, got)
+	}
+
+	// Run several times, so that pooled encoders and decoders are reused.
+	for range 3 {
+		roundTrip(t, []byte(strings.Repeat("package main\n", 1000)))
+		roundTrip(t, []byte{})
+	}
+
+	t.Run("invalid input", func(t *testing.T) {
+		r, err := c.Decompress(strings.NewReader("not zstd"))
+		if err == nil {
+			_, err = io.ReadAll(r)
+		}
+		require.Error(t, err)
+	})
+}
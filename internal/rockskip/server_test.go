@@ -242,7 +242,11 @@ func (g SubprocessGit) Close() error {
 }
 
 func (g SubprocessGit) LogReverseEach(ctx context.Context, repo string, givenCommit string, n int, onLogEntry func(entry gitdomain.LogEntry) error) (returnError error) {
-	log := exec.Command("git", gitdomain.LogReverseArgs(n, givenCommit)...)
+	args, err := gitdomain.LogReverseArgs(n, givenCommit)
+	if err != nil {
+		return err
+	}
+	log := exec.Command("git", args...)
 	log.Dir = g.gitDir
 	output, err := log.StdoutPipe()
 	if err != nil {
@@ -264,7 +268,11 @@ func (g SubprocessGit) LogReverseEach(ctx context.Context, repo string, givenCom
 }
 
 func (g SubprocessGit) RevList(ctx context.Context, repo string, givenCommit string, onCommit func(commit string) (shouldContinue bool, err error)) (returnError error) {
-	revList := exec.Command("git", gitserver.RevListArgs(givenCommit)...)
+	args, err := gitserver.RevListArgs(givenCommit)
+	if err != nil {
+		return err
+	}
+	revList := exec.Command("git", args...)
 	revList.Dir = g.gitDir
 	output, err := revList.StdoutPipe()
 	if err != nil {